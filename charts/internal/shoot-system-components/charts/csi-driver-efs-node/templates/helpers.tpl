@@ -0,0 +1,11 @@
+{{- define "csi-driver-efs-node.extensionsGroup" -}}
+extensions.gardener.cloud
+{{- end -}}
+
+{{- define "csi-driver-efs-node.name" -}}
+provider-aws
+{{- end -}}
+
+{{- define "csi-driver-efs-node.provisioner" -}}
+efs.csi.aws.com
+{{- end -}}
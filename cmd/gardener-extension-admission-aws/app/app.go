@@ -39,6 +39,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	admissioncmd "github.com/gardener/gardener-extension-provider-aws/pkg/admission/cmd"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/admission/validator"
 	awsinstall "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/install"
 	provideraws "github.com/gardener/gardener-extension-provider-aws/pkg/aws"
 )
@@ -65,8 +66,9 @@ func NewAdmissionCommand(ctx context.Context) *cobra.Command {
 		webhookServerOptions = &webhookcmd.ServerOptions{
 			Namespace: os.Getenv("WEBHOOK_CONFIG_NAMESPACE"),
 		}
-		webhookSwitches = admissioncmd.GardenWebhookSwitchOptions()
-		webhookOptions  = webhookcmd.NewAddToManagerOptions(
+		webhookSwitches             = admissioncmd.GardenWebhookSwitchOptions()
+		credentialsVerificationOpts = &admissioncmd.CredentialsVerificationOptions{}
+		webhookOptions              = webhookcmd.NewAddToManagerOptions(
 			AdmissionName,
 			"",
 			nil,
@@ -78,6 +80,7 @@ func NewAdmissionCommand(ctx context.Context) *cobra.Command {
 			restOpts,
 			mgrOpts,
 			webhookOptions,
+			credentialsVerificationOpts,
 		)
 	)
 
@@ -152,6 +155,8 @@ func NewAdmissionCommand(ctx context.Context) *cobra.Command {
 				}
 			}
 
+			credentialsVerificationOpts.Completed().Apply(&validator.DefaultAddOptions)
+
 			log.Info("Setting up webhook server")
 			if _, err := webhookOptions.Completed().AddToManager(ctx, mgr, sourceCluster); err != nil {
 				return err
@@ -48,9 +48,12 @@ import (
 	awsbackupentry "github.com/gardener/gardener-extension-provider-aws/pkg/controller/backupentry"
 	awsbastion "github.com/gardener/gardener-extension-provider-aws/pkg/controller/bastion"
 	awscontrolplane "github.com/gardener/gardener-extension-provider-aws/pkg/controller/controlplane"
+	awscredentialsrotation "github.com/gardener/gardener-extension-provider-aws/pkg/controller/credentialsrotation"
 	awsdnsrecord "github.com/gardener/gardener-extension-provider-aws/pkg/controller/dnsrecord"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/healthcheck"
 	awsinfrastructure "github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure"
+	awsleakdetection "github.com/gardener/gardener-extension-provider-aws/pkg/controller/leakdetection"
+	awstagreconciliation "github.com/gardener/gardener-extension-provider-aws/pkg/controller/tagreconciliation"
 	awsworker "github.com/gardener/gardener-extension-provider-aws/pkg/controller/worker"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/webhook/controlplane"
 	awscontrolplaneexposure "github.com/gardener/gardener-extension-provider-aws/pkg/webhook/controlplaneexposure"
@@ -104,6 +107,11 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			MaxConcurrentReconciles: 5,
 		}
 
+		// options for the credentials rotation controller
+		credentialsRotationCtrlOpts = &controllercmd.ControllerOptions{
+			MaxConcurrentReconciles: 5,
+		}
+
 		// options for the dnsrecord controller
 		dnsRecordCtrlOpts = &awscmd.DNSRecordControllerOptions{
 			ControllerOptions: controllercmd.ControllerOptions{
@@ -112,6 +120,9 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			ProviderClientQPS:         1,
 			ProviderClientBurst:       5,
 			ProviderClientWaitTimeout: 2 * time.Second,
+			ProviderClientZoneQPS:     1,
+			ProviderClientZoneBurst:   2,
+			ProviderClientBatchWindow: 200 * time.Millisecond,
 		}
 
 		// options for the infrastructure controller
@@ -120,6 +131,16 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 		}
 		reconcileOpts = &controllercmd.ReconcilerOptions{}
 
+		// options for the leak detection controller
+		leakDetectionCtrlOpts = &controllercmd.ControllerOptions{
+			MaxConcurrentReconciles: 1,
+		}
+
+		// options for the tag reconciliation controller
+		tagReconciliationCtrlOpts = &controllercmd.ControllerOptions{
+			MaxConcurrentReconciles: 1,
+		}
+
 		// options for the worker controller
 		workerCtrlOpts = &controllercmd.ControllerOptions{
 			MaxConcurrentReconciles: 5,
@@ -148,8 +169,11 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			controllercmd.PrefixOption("backupentry-", backupEntryCtrlOpts),
 			controllercmd.PrefixOption("bastion-", bastionCtrlOpts),
 			controllercmd.PrefixOption("controlplane-", controlPlaneCtrlOpts),
+			controllercmd.PrefixOption("credentialsrotation-", credentialsRotationCtrlOpts),
 			controllercmd.PrefixOption("dnsrecord-", dnsRecordCtrlOpts),
 			controllercmd.PrefixOption("infrastructure-", infraCtrlOpts),
+			controllercmd.PrefixOption("leakdetection-", leakDetectionCtrlOpts),
+			controllercmd.PrefixOption("tagreconciliation-", tagReconciliationCtrlOpts),
 			controllercmd.PrefixOption("worker-", workerCtrlOpts),
 			controllercmd.PrefixOption("healthcheck-", healthCheckCtrlOpts),
 			controllercmd.PrefixOption("heartbeat-", heartbeatCtrlOpts),
@@ -233,15 +257,30 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			log.Info("Adding controllers to manager")
 			configFileOpts.Completed().ApplyETCDStorage(&awscontrolplaneexposure.DefaultAddOptions.ETCDStorage)
 			configFileOpts.Completed().ApplyHealthCheckConfig(&healthcheck.DefaultAddOptions.HealthCheckConfig)
+			configFileOpts.Completed().ApplyEndpoints(&aws.DefaultPartition, &aws.DefaultEndpoints)
+			if err := configFileOpts.Completed().ApplyHTTPConfig(&aws.DefaultHTTPConfig); err != nil {
+				return fmt.Errorf("could not apply HTTP configuration: %w", err)
+			}
+			configFileOpts.Completed().ApplyClientRateLimiter(&aws.DefaultRateLimiter)
+			configFileOpts.Completed().ApplyTerraformer(&aws.DefaultTerraformerConfig)
 			healthCheckCtrlOpts.Completed().Apply(&healthcheck.DefaultAddOptions.Controller)
 			heartbeatCtrlOpts.Completed().Apply(&heartbeat.DefaultAddOptions)
 			backupBucketCtrlOpts.Completed().Apply(&awsbackupbucket.DefaultAddOptions.Controller)
 			backupEntryCtrlOpts.Completed().Apply(&awsbackupentry.DefaultAddOptions.Controller)
 			bastionCtrlOpts.Completed().Apply(&awsbastion.DefaultAddOptions.Controller)
+			configFileOpts.Completed().ApplyBastion(&awsbastion.DefaultAddOptions.Config)
 			controlPlaneCtrlOpts.Completed().Apply(&awscontrolplane.DefaultAddOptions.Controller)
+			configFileOpts.Completed().ApplyPrivateLink(&awscontrolplane.DefaultAddOptions.PrivateLink)
+			configFileOpts.Completed().ApplyPrivateLink(&healthcheck.DefaultAddOptions.PrivateLink)
+			credentialsRotationCtrlOpts.Completed().Apply(&awscredentialsrotation.DefaultAddOptions.Controller)
+			awscredentialsrotation.DefaultAddOptions.GardenCluster = gardenCluster
 			dnsRecordCtrlOpts.Completed().Apply(&awsdnsrecord.DefaultAddOptions.Controller)
 			dnsRecordCtrlOpts.Completed().ApplyRateLimiter(&awsdnsrecord.DefaultAddOptions.RateLimiter)
 			infraCtrlOpts.Completed().Apply(&awsinfrastructure.DefaultAddOptions.Controller)
+			leakDetectionCtrlOpts.Completed().Apply(&awsleakdetection.DefaultAddOptions.Controller)
+			configFileOpts.Completed().ApplyLeakDetection(&awsleakdetection.DefaultAddOptions.Interval, &awsleakdetection.DefaultAddOptions.DeleteOrphans)
+			tagReconciliationCtrlOpts.Completed().Apply(&awstagreconciliation.DefaultAddOptions.Controller)
+			configFileOpts.Completed().ApplyTagReconciliation(&awstagreconciliation.DefaultAddOptions.Interval)
 			reconcileOpts.Completed().Apply(&awsinfrastructure.DefaultAddOptions.IgnoreOperationAnnotation)
 			reconcileOpts.Completed().Apply(&awscontrolplane.DefaultAddOptions.IgnoreOperationAnnotation)
 			reconcileOpts.Completed().Apply(&awsworker.DefaultAddOptions.IgnoreOperationAnnotation)
@@ -16,6 +16,7 @@ package cmd
 
 import (
 	webhookcmd "github.com/gardener/gardener/extensions/pkg/webhook/cmd"
+	"github.com/spf13/pflag"
 
 	"github.com/gardener/gardener-extension-provider-aws/pkg/admission/mutator"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/admission/validator"
@@ -29,3 +30,44 @@ func GardenWebhookSwitchOptions() *webhookcmd.SwitchOptions {
 		webhookcmd.Switch(mutator.Name, mutator.New),
 	)
 }
+
+// CredentialsVerificationOptions are command line options for the verification of credentials with the AWS API.
+type CredentialsVerificationOptions struct {
+	// Enabled controls whether the credentials referenced by SecretBindings are verified with the AWS API.
+	Enabled bool
+	// Region is the region whose AWS API endpoints are used for verifying credentials.
+	Region string
+
+	config *CredentialsVerificationConfig
+}
+
+// CredentialsVerificationConfig is a completed credentials verification configuration.
+type CredentialsVerificationConfig struct {
+	// Enabled controls whether the credentials referenced by SecretBindings are verified with the AWS API.
+	Enabled bool
+	// Region is the region whose AWS API endpoints are used for verifying credentials.
+	Region string
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (o *CredentialsVerificationOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&o.Enabled, "verify-credentials", false, "verify the credentials referenced by SecretBindings with the AWS API when they are created")
+	fs.StringVar(&o.Region, "verify-credentials-region", "us-east-1", "region whose AWS API endpoints are used for verifying credentials")
+}
+
+// Complete implements Completer.Complete.
+func (o *CredentialsVerificationOptions) Complete() error {
+	o.config = &CredentialsVerificationConfig{Enabled: o.Enabled, Region: o.Region}
+	return nil
+}
+
+// Completed returns the completed CredentialsVerificationConfig. Only call this if `Complete` was successful.
+func (o *CredentialsVerificationOptions) Completed() *CredentialsVerificationConfig {
+	return o.config
+}
+
+// Apply sets the values of this CredentialsVerificationConfig in the given validator.AddOptions.
+func (c *CredentialsVerificationConfig) Apply(opts *validator.AddOptions) {
+	opts.VerifyCredentials = c.Enabled
+	opts.CredentialsVerificationRegion = c.Region
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// credentialsVerificationTimeout is the timeout for verifying credentials with the AWS API. It is well below the
+// timeout of the admission webhook.
+const credentialsVerificationTimeout = 5 * time.Second
+
+// requiredActions are a minimal set of actions which credentials must be allowed to perform for managing shoots. They
+// are simulated with the IAM policy simulator to reject credentials which are obviously under-privileged.
+var requiredActions = []string{
+	"ec2:DescribeVpcs",
+	"ec2:CreateVpc",
+	"ec2:DeleteVpc",
+	"ec2:CreateSubnet",
+	"ec2:CreateSecurityGroup",
+	"ec2:RunInstances",
+	"ec2:TerminateInstances",
+	"elasticloadbalancing:DescribeLoadBalancers",
+	"iam:CreateRole",
+	"iam:PassRole",
+}
+
+// AddOptions are options to apply when adding the validation webhook to the manager.
+type AddOptions struct {
+	// VerifyCredentials controls whether the credentials referenced by SecretBindings are verified with the AWS API
+	// when they are created.
+	VerifyCredentials bool
+	// CredentialsVerificationRegion is the region whose AWS API endpoints are used for verifying credentials.
+	CredentialsVerificationRegion string
+}
+
+// DefaultAddOptions are the default AddOptions for New.
+var DefaultAddOptions = AddOptions{}
+
+// CredentialsVerifier verifies AWS credentials with the AWS API.
+type CredentialsVerifier interface {
+	// Verify returns an error if the given credentials are invalid or obviously under-privileged.
+	Verify(ctx context.Context, credentials *aws.Credentials) error
+}
+
+type credentialsVerifier struct {
+	awsClientFactory awsclient.Factory
+	region           string
+}
+
+// NewCredentialsVerifier returns a new CredentialsVerifier which calls the AWS API endpoints of the given region.
+func NewCredentialsVerifier(awsClientFactory awsclient.Factory, region string) CredentialsVerifier {
+	return &credentialsVerifier{
+		awsClientFactory: awsClientFactory,
+		region:           region,
+	}
+}
+
+// Verify verifies the given credentials by determining the calling identity and simulating the required actions for
+// it. Errors which are not caused by the credentials, e.g. throttling or a missing permission for the simulation
+// itself, do not fail the verification, so that SecretBindings can still be created if the AWS API is unavailable.
+func (v *credentialsVerifier) Verify(ctx context.Context, credentials *aws.Credentials) error {
+	ctx, cancel := context.WithTimeout(ctx, credentialsVerificationTimeout)
+	defer cancel()
+
+	awsClient, err := v.awsClientFactory.NewClient(aws.NewAuthConfig(credentials, v.region))
+	if err != nil {
+		return err
+	}
+
+	callerARN, err := awsClient.GetCallerARN(ctx)
+	if err != nil {
+		if isAuthError(err) {
+			return fmt.Errorf("credentials are invalid: %w", err)
+		}
+		logger.Info("Skipping verification of credentials", "reason", err.Error())
+		return nil
+	}
+
+	principalARN, err := aws.GetPolicySourceARN(ctx, awsClient, callerARN)
+	if err != nil || principalARN == "" {
+		logger.Info("Skipping simulation of required actions", "callerARN", callerARN, "reason", fmt.Sprint(err))
+		return nil
+	}
+
+	denied, err := awsClient.GetIAMDeniedActions(ctx, principalARN, requiredActions)
+	if err != nil {
+		logger.Info("Skipping simulation of required actions", "callerARN", callerARN, "reason", err.Error())
+		return nil
+	}
+	if len(denied) > 0 {
+		slices.Sort(denied)
+		return fmt.Errorf("credentials of %q are not allowed to perform the required actions %s", callerARN, strings.Join(denied, ", "))
+	}
+	return nil
+}
+
+func isAuthError(err error) bool {
+	codes := aws.DetermineErrorCodes(err)
+	return slices.Contains(codes, gardencorev1beta1.ErrorInfraUnauthenticated) || slices.Contains(codes, gardencorev1beta1.ErrorInfraUnauthorized)
+}
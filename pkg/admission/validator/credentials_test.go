@@ -0,0 +1,102 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/smithy-go"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/admission/validator"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	mockawsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/mock"
+)
+
+var _ = Describe("CredentialsVerifier", func() {
+	var (
+		ctrl      *gomock.Controller
+		awsClient *mockawsclient.MockInterface
+		verifier  validator.CredentialsVerifier
+
+		ctx         = context.TODO()
+		credentials = &aws.Credentials{AccessKeyID: []byte("access-key-id"), SecretAccessKey: []byte("secret-access-key")}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		awsClient = mockawsclient.NewMockInterface(ctrl)
+		verifier = validator.NewCredentialsVerifier(awsclient.FactoryFunc(func(authConfig awsclient.AuthConfig) (awsclient.Interface, error) {
+			Expect(authConfig.AccessKeyID).To(Equal("access-key-id"))
+			Expect(authConfig.Region).To(Equal("eu-west-1"))
+			return awsClient, nil
+		}), "eu-west-1")
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should accept credentials of a user with the required permissions", func() {
+		awsClient.EXPECT().GetCallerARN(gomock.Any()).Return("arn:aws:iam::123456789012:user/gardener", nil)
+		awsClient.EXPECT().GetIAMDeniedActions(gomock.Any(), "arn:aws:iam::123456789012:user/gardener", gomock.Any()).Return(nil, nil)
+
+		Expect(verifier.Verify(ctx, credentials)).To(Succeed())
+	})
+
+	It("should simulate the role of an assumed role session", func() {
+		awsClient.EXPECT().GetCallerARN(gomock.Any()).Return("arn:aws:sts::123456789012:assumed-role/gardener/session", nil)
+		awsClient.EXPECT().GetIAMRole(gomock.Any(), "gardener").Return(&awsclient.IAMRole{ARN: "arn:aws:iam::123456789012:role/path/gardener"}, nil)
+		awsClient.EXPECT().GetIAMDeniedActions(gomock.Any(), "arn:aws:iam::123456789012:role/path/gardener", gomock.Any()).Return(nil, nil)
+
+		Expect(verifier.Verify(ctx, credentials)).To(Succeed())
+	})
+
+	It("should reject invalid credentials", func() {
+		awsClient.EXPECT().GetCallerARN(gomock.Any()).Return("", &smithy.GenericAPIError{Code: "InvalidClientTokenId"})
+
+		Expect(verifier.Verify(ctx, credentials)).To(MatchError(ContainSubstring("credentials are invalid")))
+	})
+
+	It("should reject under-privileged credentials", func() {
+		awsClient.EXPECT().GetCallerARN(gomock.Any()).Return("arn:aws:iam::123456789012:user/gardener", nil)
+		awsClient.EXPECT().GetIAMDeniedActions(gomock.Any(), "arn:aws:iam::123456789012:user/gardener", gomock.Any()).Return([]string{"iam:PassRole", "ec2:RunInstances"}, nil)
+
+		Expect(verifier.Verify(ctx, credentials)).To(MatchError(ContainSubstring("not allowed to perform the required actions ec2:RunInstances, iam:PassRole")))
+	})
+
+	It("should accept credentials if the AWS API is unavailable", func() {
+		awsClient.EXPECT().GetCallerARN(gomock.Any()).Return("", fmt.Errorf("connection refused"))
+
+		Expect(verifier.Verify(ctx, credentials)).To(Succeed())
+	})
+
+	It("should accept credentials if the simulation is not permitted", func() {
+		awsClient.EXPECT().GetCallerARN(gomock.Any()).Return("arn:aws:iam::123456789012:user/gardener", nil)
+		awsClient.EXPECT().GetIAMDeniedActions(gomock.Any(), "arn:aws:iam::123456789012:user/gardener", gomock.Any()).Return(nil, &smithy.GenericAPIError{Code: "AccessDenied"})
+
+		Expect(verifier.Verify(ctx, credentials)).To(Succeed())
+	})
+
+	It("should not simulate the root user", func() {
+		awsClient.EXPECT().GetCallerARN(gomock.Any()).Return("arn:aws:iam::123456789012:root", nil)
+
+		Expect(verifier.Verify(ctx, credentials)).To(Succeed())
+	})
+})
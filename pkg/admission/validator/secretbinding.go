@@ -27,16 +27,20 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	awsvalidation "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/validation"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
 )
 
 type secretBinding struct {
-	apiReader client.Reader
+	apiReader           client.Reader
+	credentialsVerifier CredentialsVerifier
 }
 
-// NewSecretBindingValidator returns a new instance of a secret binding validator.
-func NewSecretBindingValidator(mgr manager.Manager) extensionswebhook.Validator {
+// NewSecretBindingValidator returns a new instance of a secret binding validator. If a CredentialsVerifier is given,
+// the credentials are verified with the AWS API in addition to the syntactical validation.
+func NewSecretBindingValidator(mgr manager.Manager, credentialsVerifier CredentialsVerifier) extensionswebhook.Validator {
 	return &secretBinding{
-		apiReader: mgr.GetAPIReader(),
+		apiReader:           mgr.GetAPIReader(),
+		credentialsVerifier: credentialsVerifier,
 	}
 }
 
@@ -69,5 +73,16 @@ func (sb *secretBinding) Validate(ctx context.Context, newObj, oldObj client.Obj
 		return err
 	}
 
-	return awsvalidation.ValidateCloudProviderSecret(secret)
+	if err := awsvalidation.ValidateCloudProviderSecret(secret); err != nil {
+		return err
+	}
+
+	if sb.credentialsVerifier == nil {
+		return nil
+	}
+	credentials, err := aws.ReadCredentialsSecret(secret, false)
+	if err != nil {
+		return err
+	}
+	return sb.credentialsVerifier.Verify(ctx, credentials)
 }
@@ -66,7 +66,7 @@ var _ = Describe("SecretBinding validator", func() {
 			apiReader = mockclient.NewMockReader(ctrl)
 			mgr.EXPECT().GetAPIReader().Return(apiReader)
 
-			secretBindingValidator = validator.NewSecretBindingValidator(mgr)
+			secretBindingValidator = validator.NewSecretBindingValidator(mgr, nil)
 		})
 
 		AfterEach(func() {
@@ -118,5 +118,31 @@ var _ = Describe("SecretBinding validator", func() {
 			err := secretBindingValidator.Validate(ctx, secretBinding, nil)
 			Expect(err).NotTo(HaveOccurred())
 		})
+
+		It("should return err when the credentials of the corresponding Secret can't be verified", func() {
+			mgr.EXPECT().GetAPIReader().Return(apiReader)
+			secretBindingValidator = validator.NewSecretBindingValidator(mgr, credentialsVerifierFunc(func(_ context.Context, credentials *aws.Credentials) error {
+				Expect(credentials.AccessKeyID).To(Equal([]byte(strings.Repeat("a", 16))))
+				return fakeErr
+			}))
+			apiReader.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, gomock.AssignableToTypeOf(&corev1.Secret{})).
+				DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *corev1.Secret, _ ...client.GetOption) error {
+					secret := &corev1.Secret{Data: map[string][]byte{
+						aws.AccessKeyID:     []byte(strings.Repeat("a", 16)),
+						aws.SecretAccessKey: []byte(strings.Repeat("b", 40)),
+					}}
+					*obj = *secret
+					return nil
+				})
+
+			err := secretBindingValidator.Validate(ctx, secretBinding, nil)
+			Expect(err).To(MatchError(fakeErr))
+		})
 	})
 })
+
+type credentialsVerifierFunc func(ctx context.Context, credentials *aws.Credentials) error
+
+func (f credentialsVerifierFunc) Verify(ctx context.Context, credentials *aws.Credentials) error {
+	return f(ctx, credentials)
+}
@@ -19,38 +19,52 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"slices"
+	"strings"
 
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorehelper "github.com/gardener/gardener/pkg/apis/core/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	api "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
 	awsvalidation "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/validation"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 )
 
 // NewShootValidator returns a new instance of a shoot validator.
-func NewShootValidator(mgr manager.Manager) extensionswebhook.Validator {
+func NewShootValidator(mgr manager.Manager, awsClientFactory awsclient.Factory) extensionswebhook.Validator {
 	return &shoot{
-		client:         mgr.GetClient(),
-		scheme:         mgr.GetScheme(),
-		decoder:        serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
-		lenientDecoder: serializer.NewCodecFactory(mgr.GetScheme()).UniversalDecoder(),
+		client:           mgr.GetClient(),
+		apiReader:        mgr.GetAPIReader(),
+		scheme:           mgr.GetScheme(),
+		decoder:          serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
+		lenientDecoder:   serializer.NewCodecFactory(mgr.GetScheme()).UniversalDecoder(),
+		awsClientFactory: awsClientFactory,
 	}
 }
 
 type shoot struct {
-	client         client.Client
-	decoder        runtime.Decoder
-	lenientDecoder runtime.Decoder
-	scheme         *runtime.Scheme
+	client           client.Client
+	apiReader        client.Reader
+	decoder          runtime.Decoder
+	lenientDecoder   runtime.Decoder
+	scheme           *runtime.Scheme
+	awsClientFactory awsclient.Factory
 }
 
 // Validate validates the given shoot object.
@@ -113,6 +127,14 @@ func (s *shoot) validateShoot(_ context.Context, shoot *core.Shoot) error {
 		if errList := awsvalidation.ValidateControlPlaneConfig(controlPlaneConfig, shoot.Spec.Kubernetes.Version, fldPath.Child("controlPlaneConfig")); len(errList) != 0 {
 			return errList.ToAggregate()
 		}
+
+		if errList := awsvalidation.ValidateControlPlaneConfigAgainstShoot(controlPlaneConfig, shoot); len(errList) != 0 {
+			return errList.ToAggregate()
+		}
+
+		if errList := awsvalidation.ValidateControlPlaneConfigAgainstInfrastructureConfig(controlPlaneConfig, infraConfig, fldPath.Child("controlPlaneConfig")); len(errList) != 0 {
+			return errList.ToAggregate()
+		}
 	}
 
 	// WorkerConfig and Shoot workers
@@ -171,7 +193,11 @@ func (s *shoot) validateShootUpdate(ctx context.Context, oldShoot, shoot *core.S
 	}
 
 	if !reflect.DeepEqual(oldInfraConfig, infraConfig) {
-		if errList := awsvalidation.ValidateInfrastructureConfigUpdate(oldInfraConfig, infraConfig); len(errList) != 0 {
+		useFlow, err := s.usesFlow(ctx, shoot, false)
+		if err != nil {
+			return err
+		}
+		if errList := awsvalidation.ValidateInfrastructureConfigUpdate(oldInfraConfig, infraConfig, useFlow); len(errList) != 0 {
 			return errList.ToAggregate()
 		}
 	}
@@ -180,11 +206,28 @@ func (s *shoot) validateShootUpdate(ctx context.Context, oldShoot, shoot *core.S
 		return err
 	}
 
+	if err := s.validateFlowOnlyFeatures(ctx, shoot, infraConfig, false, infraConfigFldPath); err != nil {
+		return err
+	}
+
 	if errList := awsvalidation.ValidateWorkersUpdate(oldShoot.Spec.Provider.Workers, shoot.Spec.Provider.Workers, fldPath.Child("workers")); len(errList) != 0 {
 		return errList.ToAggregate()
 	}
 
-	return s.validateShoot(ctx, shoot)
+	if err := s.validateShoot(ctx, shoot); err != nil {
+		return err
+	}
+
+	if err := s.validateAcceleratorDrivers(ctx, shoot); err != nil {
+		return err
+	}
+
+	secret, err := s.validateCredentials(ctx, shoot)
+	if err != nil {
+		return err
+	}
+
+	return s.validateWorkerReferences(ctx, oldShoot, shoot, infraConfig, secret)
 }
 
 func (s *shoot) validateShootCreation(ctx context.Context, shoot *core.Shoot) error {
@@ -203,7 +246,24 @@ func (s *shoot) validateShootCreation(ctx context.Context, shoot *core.Shoot) er
 		return err
 	}
 
-	return s.validateShoot(ctx, shoot)
+	if err := s.validateFlowOnlyFeatures(ctx, shoot, infraConfig, true, fldPath.Child("infrastructureConfig")); err != nil {
+		return err
+	}
+
+	if err := s.validateShoot(ctx, shoot); err != nil {
+		return err
+	}
+
+	if err := s.validateAcceleratorDrivers(ctx, shoot); err != nil {
+		return err
+	}
+
+	secret, err := s.validateCredentials(ctx, shoot)
+	if err != nil {
+		return err
+	}
+
+	return s.validateWorkerReferences(ctx, nil, shoot, infraConfig, secret)
 }
 
 func (s *shoot) validateAgainstCloudProfile(ctx context.Context, shoot *core.Shoot, oldInfraConfig, infraConfig *api.InfrastructureConfig, fldPath *field.Path) error {
@@ -218,3 +278,354 @@ func (s *shoot) validateAgainstCloudProfile(ctx context.Context, shoot *core.Sho
 
 	return nil
 }
+
+// validateAcceleratorDrivers verifies that the machine images of worker pools with an enabled device plugin contain
+// the drivers for the accelerators of their machine types according to the cloud profile. Worker pools selecting their
+// images by an image selector are not checked as their images are not listed in the cloud profile.
+// validateFlowOnlyFeatures rejects infrastructure features which are only implemented by the flow infrastructure reconciler
+// if the shoot is not reconciled with flow.
+func (s *shoot) validateFlowOnlyFeatures(ctx context.Context, shoot *core.Shoot, infraConfig *api.InfrastructureConfig, creation bool, fldPath *field.Path) error {
+	if helper.GetNATGatewayType(infraConfig) != api.NATGatewayTypeInstance {
+		return nil
+	}
+
+	useFlow, err := s.usesFlow(ctx, shoot, creation)
+	if err != nil {
+		return err
+	}
+	if !useFlow {
+		return field.Forbidden(fldPath.Child("networks", "natGateway", "type"), fmt.Sprintf("NAT instances are only supported by the flow infrastructure reconciler (annotation %s=true)", api.AnnotationKeyUseFlow))
+	}
+	return nil
+}
+
+// usesFlow determines whether the infrastructure of the given shoot is reconciled with flow. It mirrors the decision of
+// the infrastructure actuator as far as it can be made in the garden cluster: the shoot annotation or the label of the
+// seed the shoot is scheduled to. The seed label value `new` only applies on creation. Shoots which are not scheduled yet
+// are treated as flow shoots, the infrastructure actuator still rejects unsupported configurations in this case.
+// The annotation of the Infrastructure resource in the seed is not visible here.
+func (s *shoot) usesFlow(ctx context.Context, shoot *core.Shoot, creation bool) (bool, error) {
+	if strings.EqualFold(shoot.Annotations[api.AnnotationKeyUseFlow], "true") {
+		return true, nil
+	}
+	if shoot.Spec.SeedName == nil {
+		return true, nil
+	}
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := s.client.Get(ctx, kutil.Key(*shoot.Spec.SeedName), seed); err != nil {
+		return false, err
+	}
+	value := seed.Labels[api.SeedLabelKeyUseFlow]
+	return strings.EqualFold(value, "true") || (creation && value == api.SeedLabelUseFlowValueNew), nil
+}
+
+func (s *shoot) validateAcceleratorDrivers(ctx context.Context, shoot *core.Shoot) error {
+	var (
+		fldPath            = field.NewPath("spec", "provider", "workers")
+		cloudProfileConfig *api.CloudProfileConfig
+		allErrs            = field.ErrorList{}
+	)
+
+	for i, worker := range shoot.Spec.Provider.Workers {
+		if worker.ProviderConfig == nil || worker.Machine.Image == nil {
+			continue
+		}
+		workerConfig, err := decodeWorkerConfig(s.decoder, worker.ProviderConfig, fldPath.Index(i).Child("providerConfig"))
+		if err != nil {
+			return err
+		}
+		if workerConfig.DevicePlugin == nil || !workerConfig.DevicePlugin.Enabled || workerConfig.ImageSelector != nil {
+			continue
+		}
+
+		if cloudProfileConfig == nil {
+			if cloudProfileConfig, err = s.getCloudProfileConfig(ctx, shoot); err != nil {
+				return err
+			}
+		}
+
+		acceleratorType := helper.GetAcceleratorType(worker.Machine.Type)
+		if !helper.HasAcceleratorDrivers(cloudProfileConfig, worker.Machine.Image.Name, worker.Machine.Image.Version, acceleratorType) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("machine", "image"), fmt.Sprintf("%s/%s", worker.Machine.Image.Name, worker.Machine.Image.Version),
+				fmt.Sprintf("machine image does not contain the %s drivers required by the device plugin", acceleratorType)))
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+// validateWorkerReferences verifies that the AWS resources referenced by the worker pools are usable:
+//   - the additional security groups must exist and, if an existing VPC is used, belong to it.
+//   - the KMS keys for volume encryption must exist in the region of the shoot and be enabled for encryption.
+//   - the machine types must be offered in the zones of the worker pools.
+//   - the architecture of the AMIs of the machine images must be supported by the machine types.
+//
+// Only references which are newly added to a worker pool are checked to avoid calling the AWS API on every update of
+// the shoot.
+// validateCredentials reads the secret of the secret binding of the shoot and checks that it can be used by all
+// components managing the workers. The secret is returned for further validations, it is nil if the shoot does not
+// reference a secret binding.
+func (s *shoot) validateCredentials(ctx context.Context, shoot *core.Shoot) (*corev1.Secret, error) {
+	if shoot.Spec.SecretBindingName == nil {
+		return nil, nil
+	}
+
+	secret, err := s.getCredentialsSecret(ctx, shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	// The machine-controller-manager and the etcd backup do not support web identity federation yet, hence a shoot with
+	// workers can't be reconciled with such credentials. Shoots in deletion must not be blocked though.
+	if _, ok := secret.Data[aws.WebIdentityToken]; ok && shoot.DeletionTimestamp == nil {
+		return nil, field.Forbidden(field.NewPath("spec", "secretBindingName"), fmt.Sprintf("secret %s/%s contains a web identity token (field %q) which is not supported for shoots with workers", secret.Namespace, secret.Name, aws.WebIdentityToken))
+	}
+
+	return secret, nil
+}
+
+func (s *shoot) validateWorkerReferences(ctx context.Context, oldShoot, shoot *core.Shoot, infraConfig *api.InfrastructureConfig, secret *corev1.Secret) error {
+	type reference struct {
+		id      string
+		fldPath *field.Path
+	}
+	type machineReference struct {
+		machineType string
+		image       *core.ShootMachineImage
+		arch        string
+		fldPath     *field.Path
+	}
+
+	var (
+		fldPath           = field.NewPath("spec", "provider", "workers")
+		oldSecurityGroups = map[string]sets.Set[string]{}
+		oldKMSKeys        = map[string]sets.Set[string]{}
+		oldMachineTypes   = map[string]sets.Set[string]{}
+		oldMachines       = map[string]string{}
+		securityGroupRefs []reference
+		kmsKeyRefs        []reference
+		machineTypeRefs   = map[string][]reference{}
+		machineRefs       []machineReference
+	)
+
+	if oldShoot != nil {
+		for i, worker := range oldShoot.Spec.Provider.Workers {
+			oldMachines[worker.Name] = machineKey(worker.Machine)
+			oldMachineTypes[worker.Name] = sets.New[string]()
+			for _, zone := range worker.Zones {
+				oldMachineTypes[worker.Name].Insert(zone + "/" + worker.Machine.Type)
+			}
+			if worker.ProviderConfig == nil {
+				continue
+			}
+			workerConfig, err := decodeWorkerConfig(s.lenientDecoder, worker.ProviderConfig, fldPath.Index(i).Child("providerConfig"))
+			if err != nil {
+				return err
+			}
+			oldSecurityGroups[worker.Name] = sets.New(workerConfig.AdditionalSecurityGroupIDs...)
+			oldKMSKeys[worker.Name] = sets.New[string]()
+			if workerConfig.Volume != nil && workerConfig.Volume.KMSKeyID != nil {
+				oldKMSKeys[worker.Name].Insert(*workerConfig.Volume.KMSKeyID)
+			}
+			for _, dv := range workerConfig.DataVolumes {
+				if dv.KMSKeyID != nil {
+					oldKMSKeys[worker.Name].Insert(*dv.KMSKeyID)
+				}
+			}
+		}
+	}
+
+	for i, worker := range shoot.Spec.Provider.Workers {
+		for _, zone := range worker.Zones {
+			if worker.Machine.Type == "" || oldMachineTypes[worker.Name].Has(zone+"/"+worker.Machine.Type) {
+				continue
+			}
+			machineTypeRefs[zone] = append(machineTypeRefs[zone], reference{id: worker.Machine.Type, fldPath: fldPath.Index(i).Child("machine", "type")})
+		}
+		workerConfig := &api.WorkerConfig{}
+		workerConfigFldPath := fldPath.Index(i).Child("providerConfig")
+		if worker.ProviderConfig != nil {
+			var err error
+			if workerConfig, err = decodeWorkerConfig(s.decoder, worker.ProviderConfig, workerConfigFldPath); err != nil {
+				return err
+			}
+		}
+		// The AMIs of worker pools with an image selector are only known at the time of the reconciliation.
+		if worker.Machine.Type != "" && worker.Machine.Image != nil && worker.Machine.Image.Version != "" && workerConfig.ImageSelector == nil && oldMachines[worker.Name] != machineKey(worker.Machine) {
+			machineRefs = append(machineRefs, machineReference{
+				machineType: worker.Machine.Type,
+				image:       worker.Machine.Image,
+				arch:        pointer.StringDeref(worker.Machine.Architecture, v1beta1constants.ArchitectureAMD64),
+				fldPath:     fldPath.Index(i).Child("machine", "image"),
+			})
+		}
+		for j, id := range workerConfig.AdditionalSecurityGroupIDs {
+			if oldSecurityGroups[worker.Name].Has(id) {
+				continue
+			}
+			securityGroupRefs = append(securityGroupRefs, reference{id: id, fldPath: workerConfigFldPath.Child("additionalSecurityGroupIDs").Index(j)})
+		}
+		if workerConfig.Volume != nil && workerConfig.Volume.KMSKeyID != nil && !oldKMSKeys[worker.Name].Has(*workerConfig.Volume.KMSKeyID) {
+			kmsKeyRefs = append(kmsKeyRefs, reference{id: *workerConfig.Volume.KMSKeyID, fldPath: workerConfigFldPath.Child("volume", "kmsKeyID")})
+		}
+		for j, dv := range workerConfig.DataVolumes {
+			if dv.KMSKeyID != nil && !oldKMSKeys[worker.Name].Has(*dv.KMSKeyID) {
+				kmsKeyRefs = append(kmsKeyRefs, reference{id: *dv.KMSKeyID, fldPath: workerConfigFldPath.Child("dataVolumes").Index(j).Child("kmsKeyID")})
+			}
+		}
+	}
+
+	if (len(securityGroupRefs) == 0 && len(kmsKeyRefs) == 0 && len(machineTypeRefs) == 0 && len(machineRefs) == 0) || secret == nil {
+		return nil
+	}
+
+	awsClient, err := s.newAWSClient(shoot, secret)
+	if err != nil {
+		return err
+	}
+
+	allErrs := field.ErrorList{}
+	for _, ref := range securityGroupRefs {
+		securityGroup, err := awsClient.GetSecurityGroup(ctx, ref.id)
+		if err != nil {
+			return field.InternalError(ref.fldPath, fmt.Errorf("could not get security group %s: %w", ref.id, err))
+		}
+		if securityGroup == nil {
+			allErrs = append(allErrs, field.NotFound(ref.fldPath, ref.id))
+			continue
+		}
+		if vpcID := infraConfig.Networks.VPC.ID; vpcID != nil && pointer.StringDeref(securityGroup.VpcId, "") != *vpcID {
+			allErrs = append(allErrs, field.Invalid(ref.fldPath, ref.id, fmt.Sprintf("security group must belong to VPC %s", *vpcID)))
+		}
+	}
+
+	for _, ref := range kmsKeyRefs {
+		key, err := awsClient.GetKMSKey(ctx, ref.id)
+		if err != nil {
+			return field.InternalError(ref.fldPath, fmt.Errorf("could not get KMS key %s: %w", ref.id, err))
+		}
+		if key == nil {
+			allErrs = append(allErrs, field.NotFound(ref.fldPath, ref.id))
+			continue
+		}
+		if key.Region != shoot.Spec.Region {
+			allErrs = append(allErrs, field.Invalid(ref.fldPath, ref.id, fmt.Sprintf("KMS key must belong to region %s", shoot.Spec.Region)))
+		}
+		if key.KeyState != string(kmstypes.KeyStateEnabled) {
+			allErrs = append(allErrs, field.Invalid(ref.fldPath, ref.id, fmt.Sprintf("KMS key must be enabled but is in state %s", key.KeyState)))
+		}
+		if key.KeyUsage != string(kmstypes.KeyUsageTypeEncryptDecrypt) || key.KeySpec != string(kmstypes.KeySpecSymmetricDefault) {
+			allErrs = append(allErrs, field.Invalid(ref.fldPath, ref.id, "KMS key must be a symmetric encryption key"))
+		}
+	}
+	for _, zone := range sets.List(sets.KeySet(machineTypeRefs)) {
+		offered, err := awsClient.GetOfferedInstanceTypes(ctx, zone)
+		if err != nil {
+			return field.InternalError(machineTypeRefs[zone][0].fldPath, fmt.Errorf("could not get instance types offered in zone %s: %w", zone, err))
+		}
+		for _, ref := range machineTypeRefs[zone] {
+			if !offered.Has(ref.id) {
+				allErrs = append(allErrs, field.Invalid(ref.fldPath, ref.id, fmt.Sprintf("machine type is not offered in zone %s", zone)))
+			}
+		}
+	}
+
+	if len(machineRefs) > 0 {
+		cloudProfileConfig, err := s.getCloudProfileConfig(ctx, shoot)
+		if err != nil {
+			return err
+		}
+		for _, ref := range machineRefs {
+			ami, err := findAMI(ctx, awsClient, cloudProfileConfig, ref.image, shoot.Spec.Region, ref.arch)
+			if err != nil {
+				return field.InternalError(ref.fldPath, err)
+			}
+			if ami == "" {
+				// Missing AMIs are reported by the worker controller.
+				continue
+			}
+			imageArch, err := awsClient.GetImageArchitecture(ctx, ami)
+			if err != nil {
+				return field.InternalError(ref.fldPath, fmt.Errorf("could not get architecture of AMI %s: %w", ami, err))
+			}
+			if imageArch == "" {
+				continue
+			}
+			machineTypeArchs, err := awsClient.GetInstanceTypeArchitectures(ctx, ref.machineType)
+			if err != nil {
+				return field.InternalError(ref.fldPath, fmt.Errorf("could not get architectures of machine type %s: %w", ref.machineType, err))
+			}
+			if len(machineTypeArchs) > 0 && !slices.Contains(machineTypeArchs, imageArch) {
+				allErrs = append(allErrs, field.Invalid(ref.fldPath, fmt.Sprintf("%s/%s", ref.image.Name, ref.image.Version),
+					fmt.Sprintf("AMI %s of the machine image has architecture %s which is not supported by machine type %s (supported: %s)", ami, imageArch, ref.machineType, strings.Join(machineTypeArchs, ", "))))
+			}
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+// findAMI returns the AMI of the given machine image in the given region, which is either mapped in the CloudProfile or
+// resolved from the SSM parameter referenced in the CloudProfile. It returns an empty string if no AMI is found.
+func findAMI(ctx context.Context, awsClient awsclient.Interface, cloudProfileConfig *api.CloudProfileConfig, image *core.ShootMachineImage, region, arch string) (string, error) {
+	if ami, err := helper.FindAMIForRegionFromCloudProfile(cloudProfileConfig, image.Name, image.Version, region, &arch); err == nil {
+		return ami, nil
+	}
+	parameter, err := helper.FindSSMParameterFromCloudProfile(cloudProfileConfig, image.Name, image.Version, &arch)
+	if err != nil {
+		return "", nil
+	}
+	ami, err := awsClient.GetSSMParameter(ctx, parameter)
+	if err != nil {
+		return "", fmt.Errorf("could not resolve SSM parameter %s: %w", parameter, err)
+	}
+	return ami, nil
+}
+
+// machineKey returns a key identifying the machine type, image and architecture of the given machine.
+func machineKey(machine core.Machine) string {
+	key := machine.Type + "/" + pointer.StringDeref(machine.Architecture, "")
+	if machine.Image != nil {
+		key += "/" + machine.Image.Name + "/" + machine.Image.Version
+	}
+	return key
+}
+
+// getCloudProfileConfig returns the provider config of the cloud profile of the shoot.
+func (s *shoot) getCloudProfileConfig(ctx context.Context, shoot *core.Shoot) (*api.CloudProfileConfig, error) {
+	cloudProfile := &gardencorev1beta1.CloudProfile{}
+	if err := s.client.Get(ctx, kutil.Key(shoot.Spec.CloudProfileName), cloudProfile); err != nil {
+		return nil, err
+	}
+	if cloudProfile.Spec.ProviderConfig == nil {
+		return nil, nil
+	}
+	return decodeCloudProfileConfig(s.lenientDecoder, cloudProfile.Spec.ProviderConfig)
+}
+
+// getCredentialsSecret reads the secret of the secret binding of the shoot.
+func (s *shoot) getCredentialsSecret(ctx context.Context, shoot *core.Shoot) (*corev1.Secret, error) {
+	secretBinding := &gardencorev1beta1.SecretBinding{}
+	// Explicitly use the client.Reader to prevent controller-runtime to start Informers for SecretBindings and Secrets
+	// under the hood. The latter increases the memory usage of the component.
+	if err := s.apiReader.Get(ctx, kutil.Key(shoot.Namespace, *shoot.Spec.SecretBindingName), secretBinding); err != nil {
+		return nil, err
+	}
+
+	secret := &corev1.Secret{}
+	if err := s.apiReader.Get(ctx, kutil.Key(secretBinding.SecretRef.Namespace, secretBinding.SecretRef.Name), secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// newAWSClient creates an AWS client for the region of the shoot using the credentials of the given secret.
+func (s *shoot) newAWSClient(shoot *core.Shoot, secret *corev1.Secret) (awsclient.Interface, error) {
+	credentials, err := aws.ReadCredentialsSecret(secret, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.awsClientFactory.NewClient(aws.NewAuthConfig(credentials, shoot.Spec.Region))
+}
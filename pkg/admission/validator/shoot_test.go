@@ -30,13 +30,17 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/pointer"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener-extension-provider-aws/pkg/admission/validator"
 	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 	apisawsv1alpha1 "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	mockawsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/mock"
 )
 
 var _ = Describe("Shoot validator", func() {
@@ -46,11 +50,14 @@ var _ = Describe("Shoot validator", func() {
 		var (
 			shootValidator extensionswebhook.Validator
 
-			ctrl         *gomock.Controller
-			mgr          *mockmanager.MockManager
-			c            *mockclient.MockClient
-			cloudProfile *gardencorev1beta1.CloudProfile
-			shoot        *core.Shoot
+			ctrl             *gomock.Controller
+			mgr              *mockmanager.MockManager
+			c                *mockclient.MockClient
+			apiReader        *mockclient.MockReader
+			awsClientFactory *mockawsclient.MockFactory
+			awsClient        *mockawsclient.MockInterface
+			cloudProfile     *gardencorev1beta1.CloudProfile
+			shoot            *core.Shoot
 
 			ctx             = context.TODO()
 			cloudProfileKey = client.ObjectKey{Name: "aws"}
@@ -66,12 +73,16 @@ var _ = Describe("Shoot validator", func() {
 			Expect(gardencorev1beta1.AddToScheme(scheme)).To(Succeed())
 
 			c = mockclient.NewMockClient(ctrl)
+			apiReader = mockclient.NewMockReader(ctrl)
+			awsClientFactory = mockawsclient.NewMockFactory(ctrl)
+			awsClient = mockawsclient.NewMockInterface(ctrl)
 			mgr = mockmanager.NewMockManager(ctrl)
 
 			mgr.EXPECT().GetScheme().Return(scheme).Times(3)
 			mgr.EXPECT().GetClient().Return(c)
+			mgr.EXPECT().GetAPIReader().Return(apiReader)
 
-			shootValidator = validator.NewShootValidator(mgr)
+			shootValidator = validator.NewShootValidator(mgr, awsClientFactory)
 
 			cloudProfile = &gardencorev1beta1.CloudProfile{
 				ObjectMeta: metav1.ObjectMeta{
@@ -284,6 +295,474 @@ var _ = Describe("Shoot validator", func() {
 				err := shootValidator.Validate(ctx, shoot, nil)
 				Expect(err).NotTo(HaveOccurred())
 			})
+
+			Context("credentials", func() {
+				BeforeEach(func() {
+					shoot.Spec.SecretBindingName = pointer.String("secret-binding")
+
+					c.EXPECT().Get(ctx, cloudProfileKey, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+					apiReader.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "secret-binding"}, gomock.AssignableToTypeOf(&gardencorev1beta1.SecretBinding{})).
+						SetArg(2, gardencorev1beta1.SecretBinding{SecretRef: corev1.SecretReference{Namespace: namespace, Name: "secret"}})
+					apiReader.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "secret"}, gomock.AssignableToTypeOf(&corev1.Secret{})).
+						SetArg(2, corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "secret"},
+							Data: map[string][]byte{
+								"roleARN": []byte("arn:aws:iam::123456789012:role/foo"),
+								"token":   []byte("token"),
+							},
+						})
+				})
+
+				It("should return err if the secret contains a web identity token", func() {
+					err := shootValidator.Validate(ctx, shoot, nil)
+					Expect(err).To(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.secretBindingName"),
+					})))
+				})
+
+				It("should succeed if the shoot is being deleted", func() {
+					shoot.DeletionTimestamp = &metav1.Time{}
+
+					err := shootValidator.Validate(ctx, shoot, nil)
+					Expect(err).NotTo(HaveOccurred())
+				})
+			})
+
+			Context("NAT instances", func() {
+				BeforeEach(func() {
+					shoot.Spec.SeedName = pointer.String("seed")
+					shoot.Spec.Provider.InfrastructureConfig.Raw = encode(&apisawsv1alpha1.InfrastructureConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apisawsv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "InfrastructureConfig",
+						},
+						Networks: apisawsv1alpha1.Networks{
+							VPC: apisawsv1alpha1.VPC{
+								CIDR: pointer.String("10.250.0.0/16"),
+							},
+							NATGateway: &apisawsv1alpha1.NATGateway{
+								Type: ptr.To(apisawsv1alpha1.NATGatewayTypeInstance),
+								Instance: &apisawsv1alpha1.NATInstance{
+									InstanceType: "t3.small",
+									AMI:          "ami-123456",
+								},
+							},
+							Zones: []apisawsv1alpha1.Zone{
+								{
+									Name:     "zone1",
+									Internal: "10.250.112.0/26",
+									Public:   "10.250.96.0/26",
+									Workers:  "10.250.0.0/26",
+								},
+							},
+						},
+					})
+					c.EXPECT().Get(ctx, cloudProfileKey, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+				})
+
+				It("should return err if the shoot does not use flow", func() {
+					c.EXPECT().Get(ctx, client.ObjectKey{Name: "seed"}, &gardencorev1beta1.Seed{})
+
+					err := shootValidator.Validate(ctx, shoot, nil)
+					Expect(err).To(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.provider.infrastructureConfig.networks.natGateway.type"),
+					})))
+				})
+
+				It("should succeed if the shoot is annotated to use flow", func() {
+					shoot.Annotations = map[string]string{apisaws.AnnotationKeyUseFlow: "true"}
+
+					err := shootValidator.Validate(ctx, shoot, nil)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("should succeed if the seed enables flow for new shoots", func() {
+					c.EXPECT().Get(ctx, client.ObjectKey{Name: "seed"}, &gardencorev1beta1.Seed{}).SetArg(2, gardencorev1beta1.Seed{
+						ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{apisaws.SeedLabelKeyUseFlow: apisaws.SeedLabelUseFlowValueNew}},
+					})
+
+					err := shootValidator.Validate(ctx, shoot, nil)
+					Expect(err).NotTo(HaveOccurred())
+				})
+			})
+
+			Context("accelerator drivers", func() {
+				BeforeEach(func() {
+					shoot.Spec.Provider.Workers[0].Machine = core.Machine{
+						Type:  "g5.xlarge",
+						Image: &core.ShootMachineImage{Name: "gardenlinux", Version: "1.0.0"},
+					}
+					shoot.Spec.Provider.Workers[0].ProviderConfig = &runtime.RawExtension{Raw: encode(&apisawsv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apisawsv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "WorkerConfig",
+						},
+						DevicePlugin: &apisawsv1alpha1.DevicePlugin{Enabled: true},
+					})}
+				})
+
+				setAcceleratorDrivers := func(drivers ...apisawsv1alpha1.AcceleratorType) {
+					cloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: encode(&apisawsv1alpha1.CloudProfileConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apisawsv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "CloudProfileConfig",
+						},
+						MachineImages: []apisawsv1alpha1.MachineImages{{
+							Name: "gardenlinux",
+							Versions: []apisawsv1alpha1.MachineImageVersion{{
+								Version:            "1.0.0",
+								Regions:            []apisawsv1alpha1.RegionAMIMapping{{Name: "us-west", AMI: "ami-123", Architecture: pointer.String("amd64")}},
+								AcceleratorDrivers: drivers,
+							}},
+						}},
+					})}
+					c.EXPECT().Get(ctx, cloudProfileKey, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile).Times(2)
+				}
+
+				It("should succeed if the machine image contains the drivers", func() {
+					setAcceleratorDrivers(apisawsv1alpha1.AcceleratorTypeNVIDIA)
+
+					err := shootValidator.Validate(ctx, shoot, nil)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("should return err if the machine image does not contain the drivers", func() {
+					setAcceleratorDrivers(apisawsv1alpha1.AcceleratorTypeNeuron)
+
+					err := shootValidator.Validate(ctx, shoot, nil)
+					Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.provider.workers[0].machine.image"),
+						"Detail": Equal("machine image does not contain the nvidia drivers required by the device plugin"),
+					}))))
+				})
+			})
+
+			Context("worker references", func() {
+				BeforeEach(func() {
+					shoot.Spec.SecretBindingName = pointer.String("secret-binding")
+
+					c.EXPECT().Get(ctx, cloudProfileKey, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+					apiReader.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "secret-binding"}, gomock.AssignableToTypeOf(&gardencorev1beta1.SecretBinding{})).
+						SetArg(2, gardencorev1beta1.SecretBinding{SecretRef: corev1.SecretReference{Namespace: namespace, Name: "secret"}})
+					apiReader.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "secret"}, gomock.AssignableToTypeOf(&corev1.Secret{})).
+						SetArg(2, corev1.Secret{Data: map[string][]byte{
+							"accessKeyID":     []byte("access-key-id"),
+							"secretAccessKey": []byte("secret-access-key"),
+						}})
+					awsClientFactory.EXPECT().NewClient(awsclient.AuthConfig{AccessKeyID: "access-key-id", SecretAccessKey: "secret-access-key", Region: "us-west"}).Return(awsClient, nil)
+				})
+
+				Context("additional security groups", func() {
+					BeforeEach(func() {
+						shoot.Spec.Provider.Workers[0].ProviderConfig = &runtime.RawExtension{
+							Raw: encode(&apisawsv1alpha1.WorkerConfig{
+								TypeMeta: metav1.TypeMeta{
+									APIVersion: apisawsv1alpha1.SchemeGroupVersion.String(),
+									Kind:       "WorkerConfig",
+								},
+								AdditionalSecurityGroupIDs: []string{"sg-1", "sg-2"},
+							}),
+						}
+					})
+
+					It("should succeed if the security groups exist", func() {
+						awsClient.EXPECT().GetSecurityGroup(ctx, "sg-1").Return(&awsclient.SecurityGroup{GroupId: "sg-1"}, nil)
+						awsClient.EXPECT().GetSecurityGroup(ctx, "sg-2").Return(&awsclient.SecurityGroup{GroupId: "sg-2"}, nil)
+
+						err := shootValidator.Validate(ctx, shoot, nil)
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("should return err if a security group does not exist", func() {
+						awsClient.EXPECT().GetSecurityGroup(ctx, "sg-1").Return(&awsclient.SecurityGroup{GroupId: "sg-1"}, nil)
+						awsClient.EXPECT().GetSecurityGroup(ctx, "sg-2").Return(nil, nil)
+
+						err := shootValidator.Validate(ctx, shoot, nil)
+						Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotFound),
+							"Field": Equal("spec.provider.workers[0].providerConfig.additionalSecurityGroupIDs[1]"),
+						}))))
+					})
+				})
+
+				Context("KMS keys", func() {
+					BeforeEach(func() {
+						shoot.Spec.Provider.Workers[0].ProviderConfig = &runtime.RawExtension{
+							Raw: encode(&apisawsv1alpha1.WorkerConfig{
+								TypeMeta: metav1.TypeMeta{
+									APIVersion: apisawsv1alpha1.SchemeGroupVersion.String(),
+									Kind:       "WorkerConfig",
+								},
+								Volume: &apisawsv1alpha1.Volume{KMSKeyID: pointer.String("alias/my-key")},
+							}),
+						}
+					})
+
+					It("should succeed if the KMS key is usable", func() {
+						awsClient.EXPECT().GetKMSKey(ctx, "alias/my-key").Return(&awsclient.KMSKey{
+							Region:   "us-west",
+							KeyState: "Enabled",
+							KeyUsage: "ENCRYPT_DECRYPT",
+							KeySpec:  "SYMMETRIC_DEFAULT",
+						}, nil)
+
+						err := shootValidator.Validate(ctx, shoot, nil)
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("should return err if the KMS key does not exist", func() {
+						awsClient.EXPECT().GetKMSKey(ctx, "alias/my-key").Return(nil, nil)
+
+						err := shootValidator.Validate(ctx, shoot, nil)
+						Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotFound),
+							"Field": Equal("spec.provider.workers[0].providerConfig.volume.kmsKeyID"),
+						}))))
+					})
+
+					It("should return err if the KMS key is not usable in the region of the shoot", func() {
+						awsClient.EXPECT().GetKMSKey(ctx, "alias/my-key").Return(&awsclient.KMSKey{
+							Region:   "eu-west",
+							KeyState: "Disabled",
+							KeyUsage: "SIGN_VERIFY",
+							KeySpec:  "RSA_2048",
+						}, nil)
+
+						err := shootValidator.Validate(ctx, shoot, nil)
+						Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Detail": Equal("KMS key must belong to region us-west"),
+						})), PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Detail": Equal("KMS key must be enabled but is in state Disabled"),
+						})), PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Detail": Equal("KMS key must be a symmetric encryption key"),
+						}))))
+					})
+				})
+
+				Context("machine types", func() {
+					BeforeEach(func() {
+						shoot.Spec.Provider.Workers[0].Machine.Type = "m5.large"
+					})
+
+					It("should succeed if the machine type is offered in the zones", func() {
+						awsClient.EXPECT().GetOfferedInstanceTypes(ctx, "zone1").Return(sets.New("m5.large", "m5.xlarge"), nil)
+
+						err := shootValidator.Validate(ctx, shoot, nil)
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("should return err if the machine type is not offered in a zone", func() {
+						awsClient.EXPECT().GetOfferedInstanceTypes(ctx, "zone1").Return(sets.New("m5.xlarge"), nil)
+
+						err := shootValidator.Validate(ctx, shoot, nil)
+						Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("spec.provider.workers[0].machine.type"),
+							"Detail": Equal("machine type is not offered in zone zone1"),
+						}))))
+					})
+
+					It("should only check zones which are newly added to a worker pool on update", func() {
+						shoot.Spec.Provider.InfrastructureConfig.Raw = encode(&apisawsv1alpha1.InfrastructureConfig{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: apisawsv1alpha1.SchemeGroupVersion.String(),
+								Kind:       "InfrastructureConfig",
+							},
+							Networks: apisawsv1alpha1.Networks{
+								VPC: apisawsv1alpha1.VPC{
+									CIDR: pointer.String("10.250.0.0/16"),
+								},
+								Zones: []apisawsv1alpha1.Zone{
+									{
+										Name:     "zone1",
+										Internal: "10.250.112.0/26",
+										Public:   "10.250.96.0/26",
+										Workers:  "10.250.0.0/26",
+									},
+									{
+										Name:     "zone2",
+										Internal: "10.250.112.64/26",
+										Public:   "10.250.96.64/26",
+										Workers:  "10.250.0.64/26",
+									},
+								},
+							},
+						})
+						oldShoot := shoot.DeepCopy()
+						shoot.Spec.Provider.Workers[0].Zones = []string{"zone1", "zone2"}
+
+						awsClient.EXPECT().GetOfferedInstanceTypes(ctx, "zone2").Return(sets.New("m5.xlarge"), nil)
+
+						err := shootValidator.Validate(ctx, shoot, oldShoot)
+						Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("spec.provider.workers[0].machine.type"),
+							"Detail": Equal("machine type is not offered in zone zone2"),
+						}))))
+					})
+				})
+				Context("machine images", func() {
+					BeforeEach(func() {
+						shoot.Spec.Provider.Workers[0].Machine = core.Machine{
+							Type:         "m6g.large",
+							Image:        &core.ShootMachineImage{Name: "gardenlinux", Version: "1.0.0"},
+							Architecture: pointer.String("arm64"),
+						}
+
+						cloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: encode(&apisawsv1alpha1.CloudProfileConfig{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: apisawsv1alpha1.SchemeGroupVersion.String(),
+								Kind:       "CloudProfileConfig",
+							},
+							MachineImages: []apisawsv1alpha1.MachineImages{{
+								Name: "gardenlinux",
+								Versions: []apisawsv1alpha1.MachineImageVersion{{
+									Version: "1.0.0",
+									Regions: []apisawsv1alpha1.RegionAMIMapping{
+										{Name: "us-west", AMI: "ami-123", Architecture: pointer.String("arm64")},
+										{Name: "us-west", AMI: "ami-456", Architecture: pointer.String("amd64")},
+									},
+								}},
+							}},
+						})}
+						c.EXPECT().Get(ctx, cloudProfileKey, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+						awsClient.EXPECT().GetOfferedInstanceTypes(ctx, "zone1").Return(sets.New("m6g.large", "m6i.large"), nil)
+					})
+
+					It("should succeed if the architecture of the AMI is supported by the machine type", func() {
+						awsClient.EXPECT().GetImageArchitecture(ctx, "ami-123").Return("arm64", nil)
+						awsClient.EXPECT().GetInstanceTypeArchitectures(ctx, "m6g.large").Return([]string{"arm64"}, nil)
+
+						err := shootValidator.Validate(ctx, shoot, nil)
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("should return err if the architecture of the AMI is not supported by the machine type", func() {
+						awsClient.EXPECT().GetImageArchitecture(ctx, "ami-123").Return("amd64", nil)
+						awsClient.EXPECT().GetInstanceTypeArchitectures(ctx, "m6g.large").Return([]string{"arm64"}, nil)
+
+						err := shootValidator.Validate(ctx, shoot, nil)
+						Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("spec.provider.workers[0].machine.image"),
+							"Detail": Equal("AMI ami-123 of the machine image has architecture amd64 which is not supported by machine type m6g.large (supported: arm64)"),
+						}))))
+					})
+
+					It("should succeed if the AMI does not exist", func() {
+						awsClient.EXPECT().GetImageArchitecture(ctx, "ami-123").Return("", nil)
+
+						err := shootValidator.Validate(ctx, shoot, nil)
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("should validate the AMIs of worker pools with different architectures", func() {
+						amd64Worker := *shoot.Spec.Provider.Workers[0].DeepCopy()
+						amd64Worker.Name = "amd64"
+						amd64Worker.Machine = core.Machine{
+							Type:         "m6i.large",
+							Image:        &core.ShootMachineImage{Name: "gardenlinux", Version: "1.0.0"},
+							Architecture: pointer.String("amd64"),
+						}
+						shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, amd64Worker)
+
+						awsClient.EXPECT().GetImageArchitecture(ctx, "ami-123").Return("arm64", nil)
+						awsClient.EXPECT().GetInstanceTypeArchitectures(ctx, "m6g.large").Return([]string{"arm64"}, nil)
+						awsClient.EXPECT().GetImageArchitecture(ctx, "ami-456").Return("arm64", nil)
+						awsClient.EXPECT().GetInstanceTypeArchitectures(ctx, "m6i.large").Return([]string{"amd64"}, nil)
+
+						err := shootValidator.Validate(ctx, shoot, nil)
+						Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("spec.provider.workers[1].machine.image"),
+							"Detail": Equal("AMI ami-456 of the machine image has architecture arm64 which is not supported by machine type m6i.large (supported: amd64)"),
+						}))))
+					})
+				})
+			})
+		})
+
+		Context("Shoot update", func() {
+			var oldShoot *core.Shoot
+
+			BeforeEach(func() {
+				oldShoot = shoot.DeepCopy()
+				oldShoot.Spec.Provider.InfrastructureConfig.Raw = encode(&apisawsv1alpha1.InfrastructureConfig{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: apisawsv1alpha1.SchemeGroupVersion.String(),
+						Kind:       "InfrastructureConfig",
+					},
+					Networks: apisawsv1alpha1.Networks{
+						VPC: apisawsv1alpha1.VPC{
+							CIDR: pointer.String("10.250.0.0/16"),
+						},
+						Zones: []apisawsv1alpha1.Zone{
+							{
+								Name:     "zone1",
+								Internal: "10.250.112.0/26",
+								Public:   "10.250.96.0/26",
+								Workers:  "10.250.0.0/26",
+							},
+							{
+								Name:     "zone2",
+								Internal: "10.250.112.64/26",
+								Public:   "10.250.96.64/26",
+								Workers:  "10.250.0.64/26",
+							},
+						},
+					},
+				})
+			})
+
+			It("should return err when a zone is removed without flow", func() {
+				shoot.Spec.SeedName = pointer.String("seed")
+				c.EXPECT().Get(ctx, client.ObjectKey{Name: "seed"}, &gardencorev1beta1.Seed{})
+
+				err := shootValidator.Validate(ctx, shoot, oldShoot)
+				Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("networks.zones"),
+					"Detail": ContainSubstring("zone zone2 can only be removed with the flow infrastructure reconciler"),
+				}))))
+			})
+
+			It("should allow removing a zone with flow", func() {
+				shoot.Annotations = map[string]string{apisaws.AnnotationKeyUseFlow: "true"}
+				c.EXPECT().Get(ctx, cloudProfileKey, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+
+				err := shootValidator.Validate(ctx, shoot, oldShoot)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should allow removing a zone if the seed enables flow", func() {
+				shoot.Spec.SeedName = pointer.String("seed")
+				c.EXPECT().Get(ctx, client.ObjectKey{Name: "seed"}, &gardencorev1beta1.Seed{}).SetArg(2, gardencorev1beta1.Seed{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{apisaws.SeedLabelKeyUseFlow: "true"}},
+				})
+				c.EXPECT().Get(ctx, cloudProfileKey, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+
+				err := shootValidator.Validate(ctx, shoot, oldShoot)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should return err when a zone is removed and the seed only enables flow for new shoots", func() {
+				shoot.Spec.SeedName = pointer.String("seed")
+				c.EXPECT().Get(ctx, client.ObjectKey{Name: "seed"}, &gardencorev1beta1.Seed{}).SetArg(2, gardencorev1beta1.Seed{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{apisaws.SeedLabelKeyUseFlow: apisaws.SeedLabelUseFlowValueNew}},
+				})
+
+				err := shootValidator.Validate(ctx, shoot, oldShoot)
+				Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones"),
+				}))))
+			})
 		})
 
 		Context("Workerless Shoot", func() {
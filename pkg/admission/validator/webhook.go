@@ -15,6 +15,8 @@
 package validator
 
 import (
+	"time"
+
 	extensionspredicate "github.com/gardener/gardener/extensions/pkg/predicate"
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -25,6 +27,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 )
 
 const (
@@ -32,6 +35,8 @@ const (
 	Name = "validator"
 	// SecretsValidatorName is the name of the secrets validator.
 	SecretsValidatorName = "secrets." + Name
+	// awsQueryCacheTTL is the TTL of the cached results of read-only queries to the AWS API.
+	awsQueryCacheTTL = 10 * time.Minute
 )
 
 var logger = log.Log.WithName("aws-validator-webhook")
@@ -40,15 +45,20 @@ var logger = log.Log.WithName("aws-validator-webhook")
 func New(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
 	logger.Info("Setting up webhook", "name", Name)
 
+	var credentialsVerifier CredentialsVerifier
+	if DefaultAddOptions.VerifyCredentials {
+		credentialsVerifier = NewCredentialsVerifier(awsclient.NewControllerFactory(awsclient.FactoryFunc(awsclient.NewInterface), Name), DefaultAddOptions.CredentialsVerificationRegion)
+	}
+
 	return extensionswebhook.New(mgr, extensionswebhook.Args{
 		Provider:   aws.Type,
 		Name:       Name,
 		Path:       "/webhooks/validate",
 		Predicates: []predicate.Predicate{extensionspredicate.GardenCoreProviderType(aws.Type)},
 		Validators: map[extensionswebhook.Validator][]extensionswebhook.Type{
-			NewShootValidator(mgr):         {{Obj: &core.Shoot{}}},
-			NewCloudProfileValidator(mgr):  {{Obj: &core.CloudProfile{}}},
-			NewSecretBindingValidator(mgr): {{Obj: &core.SecretBinding{}}},
+			NewShootValidator(mgr, awsclient.NewControllerFactory(awsclient.NewCachingFactory(awsclient.FactoryFunc(awsclient.NewInterface), awsQueryCacheTTL), Name)): {{Obj: &core.Shoot{}}},
+			NewCloudProfileValidator(mgr):                       {{Obj: &core.CloudProfile{}}},
+			NewSecretBindingValidator(mgr, credentialsVerifier): {{Obj: &core.SecretBinding{}}},
 		},
 		Target: extensionswebhook.TargetSeed,
 		ObjectSelector: &metav1.LabelSelector{
@@ -22,6 +22,21 @@ const (
 	SeedLabelKeyUseFlow = AnnotationKeyUseFlow
 	// SeedLabelUseFlowValueNew is the value to restrict flow reconciliation to new shoot clusters
 	SeedLabelUseFlowValueNew = "new"
+	// AnnotationUseFlowValueDryRun is the value of the use-flow annotation of infrastructures or shoots to only check
+	// the migration from the terraform state to the flow state without switching to flow reconciliation.
+	AnnotationUseFlowValueDryRun = "dry-run"
+	// AnnotationKeyRotateCredentials is the annotation key used to request the rotation of the access key in a
+	// cloudprovider secret if value is `true`.
+	AnnotationKeyRotateCredentials = "aws.provider.extensions.gardener.cloud/rotate-credentials"
+	// AnnotationKeyCheckPermissions is the annotation key of infrastructures or shoots used to request the simulation of
+	// the IAM permissions required by the extension if value is `true`. The result is reported in the `IAMPermissions`
+	// condition of the infrastructure.
+	AnnotationKeyCheckPermissions = "aws.provider.extensions.gardener.cloud/check-permissions"
+	// AnnotationKeyPlan is the annotation key of infrastructures or shoots used to request that the infrastructure
+	// controller only computes the changes of the AWS resources it would perform if value is `true`, without applying
+	// them. The planned changes are published in the `<infrastructure-name>-plan` ConfigMap and summarized in the
+	// `InfrastructurePlan` condition of the infrastructure.
+	AnnotationKeyPlan = "aws.provider.extensions.gardener.cloud/plan"
 	// AnnotationKeyIPStack is the annotation key to set the IP stack for a DNSRecord.
 	AnnotationKeyIPStack = "dns.gardener.cloud/ip-stack"
 )
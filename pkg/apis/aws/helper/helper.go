@@ -15,7 +15,14 @@
 package helper
 
 import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
 	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"k8s.io/utils/pointer"
@@ -83,6 +90,24 @@ func FindSubnetForPurposeAndZone(subnets []api.Subnet, purpose, zone string) (*a
 	return nil, fmt.Errorf("no subnet with purpose %q in zone %q found", purpose, zone)
 }
 
+// GetNodesInstanceProfileName returns the name of the existing IAM instance profile which is configured for the nodes
+// in the given infrastructure config. If none is configured, an empty string is returned. The name is extracted from
+// the ARN if the instance profile is referenced by its ARN.
+func GetNodesInstanceProfileName(config *api.InfrastructureConfig) string {
+	if config == nil || config.IAM == nil || config.IAM.NodesInstanceProfile == nil {
+		return ""
+	}
+	profile := config.IAM.NodesInstanceProfile
+	if profile.Name != nil {
+		return *profile.Name
+	}
+	if profile.ARN != nil {
+		// The resource of an instance profile ARN has the format `instance-profile/<path>/<name>`.
+		return (*profile.ARN)[strings.LastIndex(*profile.ARN, "/")+1:]
+	}
+	return ""
+}
+
 // FindMachineImage takes a list of machine images and tries to find the first entry
 // whose name, version, architecture and zone matches with the given name, version, architecture and region. If no such entry is
 // found then an error will be returned.
@@ -123,6 +148,97 @@ func FindAMIForRegionFromCloudProfile(cloudProfileConfig *api.CloudProfileConfig
 	return "", fmt.Errorf("could not find an AMI for region %q, name %q and architecture %q in version %q", regionName, imageName, *arch, imageVersion)
 }
 
+// FindSSMParameterFromCloudProfile takes a list of machine images, and the desired image name, version and architecture.
+// It tries to find the path of the SSM parameter containing the AMI of the image with the given name, architecture and
+// version. If it cannot be found then an error is returned.
+func FindSSMParameterFromCloudProfile(cloudProfileConfig *api.CloudProfileConfig, imageName, imageVersion string, arch *string) (string, error) {
+	if cloudProfileConfig != nil {
+		for _, machineImage := range cloudProfileConfig.MachineImages {
+			if machineImage.Name != imageName {
+				continue
+			}
+			for _, version := range machineImage.Versions {
+				if imageVersion != version.Version {
+					continue
+				}
+				for _, parameter := range version.SSMParameters {
+					if pointer.StringEqual(arch, parameter.Architecture) {
+						return parameter.Path, nil
+					}
+				}
+			}
+		}
+	}
+
+	return "", fmt.Errorf("could not find an SSM parameter for name %q and architecture %q in version %q", imageName, *arch, imageVersion)
+}
+
+// FindSourceImageFromCloudProfile takes a list of machine images, and the desired image name, version and architecture.
+// It tries to find the source image which can be copied for the image with the given name, architecture and version. If
+// it cannot be found then an error is returned.
+func FindSourceImageFromCloudProfile(cloudProfileConfig *api.CloudProfileConfig, imageName, imageVersion string, arch *string) (*api.SourceImage, error) {
+	if cloudProfileConfig != nil {
+		for _, machineImage := range cloudProfileConfig.MachineImages {
+			if machineImage.Name != imageName {
+				continue
+			}
+			for _, version := range machineImage.Versions {
+				if imageVersion != version.Version {
+					continue
+				}
+				for _, sourceImage := range version.SourceImages {
+					if pointer.StringEqual(arch, sourceImage.Architecture) {
+						return &sourceImage, nil
+					}
+				}
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("could not find a source image for name %q and architecture %q in version %q", imageName, *arch, imageVersion)
+}
+
+// HasAcceleratorDrivers returns whether the machine image version with the given name and version contains the drivers
+// of the given accelerator type according to the cloud profile.
+func HasAcceleratorDrivers(cloudProfileConfig *api.CloudProfileConfig, imageName, imageVersion string, acceleratorType api.AcceleratorType) bool {
+	if cloudProfileConfig == nil {
+		return false
+	}
+	for _, machineImage := range cloudProfileConfig.MachineImages {
+		if machineImage.Name != imageName {
+			continue
+		}
+		for _, version := range machineImage.Versions {
+			if version.Version == imageVersion && slices.Contains(version.AcceleratorDrivers, acceleratorType) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+var (
+	nvidiaInstanceFamilyPattern = regexp.MustCompile(`^[pg]\d[a-z0-9-]*$`)
+	neuronInstanceFamilyPattern = regexp.MustCompile(`^(inf|trn)\d[a-z0-9-]*$`)
+)
+
+// GetAcceleratorType returns the type of the accelerators of the given machine type, i.e. `nvidia` for the `p` and `g`
+// instance families and `neuron` for the `inf` and `trn` instance families. It returns an empty string for machine
+// types without supported accelerators, including the `g4ad` instance family with AMD GPUs.
+func GetAcceleratorType(machineType string) api.AcceleratorType {
+	family, _, _ := strings.Cut(machineType, ".")
+	switch {
+	case family == "g4ad":
+		return ""
+	case nvidiaInstanceFamilyPattern.MatchString(family):
+		return api.AcceleratorTypeNVIDIA
+	case neuronInstanceFamilyPattern.MatchString(family):
+		return api.AcceleratorTypeNeuron
+	default:
+		return ""
+	}
+}
+
 // FindDataVolumeByName takes a list of data volumes and a data volume name. It tries to find the data volume entry for
 // the given name. If it cannot find it then `nil` will be returned.
 func FindDataVolumeByName(dataVolumes []api.DataVolume, name string) *api.DataVolume {
@@ -133,3 +249,258 @@ func FindDataVolumeByName(dataVolumes []api.DataVolume, name string) *api.DataVo
 	}
 	return nil
 }
+
+// NormalizeDeviceName returns the given EBS device name with the `/dev/xvd` prefix replaced by `/dev/sd`. EC2 treats
+// both prefixes as the same device, e.g. `/dev/xvdf` and `/dev/sdf` refer to the same block device mapping.
+func NormalizeDeviceName(deviceName string) string {
+	if suffix, ok := strings.CutPrefix(deviceName, "/dev/xvd"); ok {
+		return "/dev/sd" + suffix
+	}
+	return deviceName
+}
+
+// IsDualStack returns true if the given infrastructure config requests IPv6 in addition to IPv4, either via
+// `networks.ipFamilies` or via `dualStack.enabled`.
+func IsDualStack(config *api.InfrastructureConfig) bool {
+	if config == nil {
+		return false
+	}
+	if len(config.Networks.IPFamilies) > 0 {
+		for _, family := range config.Networks.IPFamilies {
+			if family == api.IPFamilyIPv6 {
+				return true
+			}
+		}
+		return false
+	}
+	return config.DualStack != nil && config.DualStack.Enabled
+}
+
+// GetVPCEndpointServices returns the service names of all VPC endpoints of the given type. For gateway endpoints, the
+// service names listed in `networks.vpc.gatewayEndpoints` are included.
+func GetVPCEndpointServices(vpc api.VPC, endpointType api.VPCEndpointType) []string {
+	var services []string
+	if endpointType == api.VPCEndpointTypeGateway {
+		services = append(services, vpc.GatewayEndpoints...)
+	}
+	for _, endpoint := range vpc.Endpoints {
+		if endpoint.Type == endpointType {
+			services = append(services, endpoint.Service)
+		}
+	}
+	return services
+}
+
+// GetDHCPConfigurations returns the DHCP configurations of a VPC which is created for the shoot in the given region,
+// keyed by the DHCP option names, e.g. `domain-name-servers`. The domain name and the DNS servers default to the ones
+// provided by AWS, NTP servers are only contained if configured.
+func GetDHCPConfigurations(vpc api.VPC, region string) map[string][]string {
+	domainName := "ec2.internal"
+	if region != "us-east-1" {
+		domainName = fmt.Sprintf("%s.compute.internal", region)
+	}
+	domainNameServers := []string{"AmazonProvidedDNS"}
+
+	var ntpServers []string
+	if options := vpc.DHCPOptions; options != nil {
+		if options.DomainName != nil {
+			domainName = *options.DomainName
+		}
+		if len(options.DomainNameServers) > 0 {
+			domainNameServers = options.DomainNameServers
+		}
+		ntpServers = options.NTPServers
+	}
+
+	configurations := map[string][]string{
+		"domain-name":         {domainName},
+		"domain-name-servers": domainNameServers,
+	}
+	if len(ntpServers) > 0 {
+		configurations["ntp-servers"] = ntpServers
+	}
+	return configurations
+}
+
+// GetNATGatewayMode returns the NAT gateway mode of the given infrastructure config. It defaults to `perZone`.
+// No NAT gateways are used in a shared VPC, as the egress routing is provided by the account owning the VPC.
+func GetNATGatewayMode(config *api.InfrastructureConfig) api.NATGatewayMode {
+	if config != nil && config.Networks.VPC.Shared {
+		return api.NATGatewayModeNone
+	}
+	if config == nil || config.Networks.NATGateway == nil || config.Networks.NATGateway.Mode == nil {
+		return api.NATGatewayModePerZone
+	}
+	return *config.Networks.NATGateway.Mode
+}
+
+// GetNATGatewayType returns the NAT gateway type of the given infrastructure config. It defaults to `gateway`.
+func GetNATGatewayType(config *api.InfrastructureConfig) api.NATGatewayType {
+	if config == nil || config.Networks.NATGateway == nil || config.Networks.NATGateway.Type == nil {
+		return api.NATGatewayTypeGateway
+	}
+	return *config.Networks.NATGateway.Type
+}
+
+// GetNATGatewayZoneName returns the name of the zone whose NAT gateway is used by the given zone. It returns an empty
+// string if no NAT gateway is used at all. Local Zones use the NAT gateway of the first zone and Wavelength Zones don't
+// use any NAT gateway, as they route egress traffic via a carrier gateway.
+func GetNATGatewayZoneName(config *api.InfrastructureConfig, zoneName string) string {
+	mode := GetNATGatewayMode(config)
+	if mode == api.NATGatewayModeNone || len(config.Networks.Zones) == 0 {
+		return ""
+	}
+
+	switch GetZoneType(config, zoneName) {
+	case api.ZoneTypeWavelengthZone:
+		return ""
+	case api.ZoneTypeLocalZone:
+		return config.Networks.Zones[0].Name
+	}
+	if mode == api.NATGatewayModeSingle {
+		return config.Networks.Zones[0].Name
+	}
+	return zoneName
+}
+
+// GetZoneType returns the type of the zone with the given name in the given infrastructure config. It defaults to
+// `availability-zone`.
+func GetZoneType(config *api.InfrastructureConfig, zoneName string) api.ZoneType {
+	if config == nil {
+		return api.ZoneTypeAvailabilityZone
+	}
+	for _, zone := range config.Networks.Zones {
+		if zone.Name == zoneName && zone.Type != nil {
+			return *zone.Type
+		}
+	}
+	return api.ZoneTypeAvailabilityZone
+}
+
+// HasZoneOfType returns true if the given infrastructure config contains a zone of the given type.
+func HasZoneOfType(config *api.InfrastructureConfig, zoneType api.ZoneType) bool {
+	for _, zone := range config.Networks.Zones {
+		if GetZoneType(config, zone.Name) == zoneType {
+			return true
+		}
+	}
+	return false
+}
+
+// IsInventoryEnabled returns true if the inventory of the resources created for the shoot is enabled in the given
+// infrastructure config.
+func IsInventoryEnabled(config *api.InfrastructureConfig) bool {
+	return config != nil && config.Inventory != nil && config.Inventory.Enabled
+}
+
+// IsKarpenterEnabled returns true if Karpenter is enabled in the given control plane config.
+func IsKarpenterEnabled(config *api.ControlPlaneConfig) bool {
+	return config != nil && config.Karpenter != nil && config.Karpenter.Enabled
+}
+
+// IsKarpenterInterruptionHandlingEnabled returns true if Karpenter is enabled in the given control plane config and
+// shall handle interruptions of its instances. Interruption handling defaults to true.
+func IsKarpenterInterruptionHandlingEnabled(config *api.ControlPlaneConfig) bool {
+	return IsKarpenterEnabled(config) && (config.Karpenter.InterruptionHandling == nil || *config.Karpenter.InterruptionHandling)
+}
+
+// IsCloudWatchAgentEnabled returns true if the CloudWatch agent is enabled in the given control plane config.
+func IsCloudWatchAgentEnabled(config *api.ControlPlaneConfig) bool {
+	return config != nil && config.CloudWatchAgent != nil && config.CloudWatchAgent.Enabled
+}
+
+// IsCloudWatchAgentLogsEnabled returns true if the CloudWatch agent is enabled in the given control plane config and
+// shall publish the container logs of the nodes.
+func IsCloudWatchAgentLogsEnabled(config *api.ControlPlaneConfig) bool {
+	return IsCloudWatchAgentEnabled(config) && config.CloudWatchAgent.Logs != nil && config.CloudWatchAgent.Logs.Enabled
+}
+
+// GetEBSConfig returns the configuration of the EBS CSI driver in the given control plane config or an empty one if
+// it is not set.
+func GetEBSConfig(config *api.ControlPlaneConfig) *api.EBSConfig {
+	if config == nil || config.Storage == nil || config.Storage.EBS == nil {
+		return &api.EBSConfig{}
+	}
+	return config.Storage.EBS
+}
+
+// IsEFSEnabled returns true if the EFS CSI driver is enabled in the given control plane config.
+func IsEFSEnabled(config *api.ControlPlaneConfig) bool {
+	return config != nil && config.Storage != nil && config.Storage.EFS != nil && config.Storage.EFS.Enabled
+}
+
+// GetEFSFileSystemID returns the ID of the EFS file system used by the EFS CSI driver. An explicitly configured file
+// system takes precedence over the one created by the infrastructure controller. It returns an empty string if the
+// EFS CSI driver is disabled or no file system is known.
+func GetEFSFileSystemID(config *api.ControlPlaneConfig, infraStatus *api.InfrastructureStatus) string {
+	if !IsEFSEnabled(config) {
+		return ""
+	}
+	if config.Storage.EFS.FileSystemID != nil {
+		return *config.Storage.EFS.FileSystemID
+	}
+	if infraStatus != nil && infraStatus.ElasticFileSystem != nil {
+		return infraStatus.ElasticFileSystem.ID
+	}
+	return ""
+}
+
+// GetLoadBalancerAccessLogsBucketName returns the name of the S3 bucket the access logs of load balancers are
+// delivered to. An explicitly configured bucket takes precedence over the one created by the infrastructure
+// controller. It returns an empty string if access logs are disabled or no bucket is known.
+func GetLoadBalancerAccessLogsBucketName(config *api.ControlPlaneConfig, infraStatus *api.InfrastructureStatus) string {
+	if config == nil || config.LoadBalancerDefaults == nil || config.LoadBalancerDefaults.AccessLogs == nil ||
+		!config.LoadBalancerDefaults.AccessLogs.Enabled {
+		return ""
+	}
+	if config.LoadBalancerDefaults.AccessLogs.BucketName != nil {
+		return *config.LoadBalancerDefaults.AccessLogs.BucketName
+	}
+	if infraStatus != nil && infraStatus.LoadBalancerAccessLogs != nil {
+		return infraStatus.LoadBalancerAccessLogs.BucketName
+	}
+	return ""
+}
+
+const (
+	// UserDataContentTypeShellScript is the MIME type of shell scripts in cloud-init user data, which is the default
+	// content type of user data parts.
+	UserDataContentTypeShellScript = "text/x-shellscript"
+	// MaxUserDataSize is the maximum size of the user data of EC2 instances in bytes, before it is base64 encoded.
+	MaxUserDataSize = 16 * 1024
+)
+
+// GetUserDataPartContentType returns the MIME type of the given user data part.
+func GetUserDataPartContentType(part api.UserDataPart) string {
+	return pointer.StringDeref(part.ContentType, UserDataContentTypeShellScript)
+}
+
+// DecodeUserDataPart returns the decoded content of the given user data part.
+func DecodeUserDataPart(part api.UserDataPart) ([]byte, error) {
+	if part.Encoding == nil {
+		return []byte(part.Content), nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(part.Content)
+	if err != nil {
+		return nil, fmt.Errorf("content is not base64 encoded: %w", err)
+	}
+
+	switch *part.Encoding {
+	case api.UserDataEncodingBase64:
+		return decoded, nil
+	case api.UserDataEncodingGzipBase64:
+		reader, err := gzip.NewReader(bytes.NewReader(decoded))
+		if err != nil {
+			return nil, fmt.Errorf("content is not gzip compressed: %w", err)
+		}
+		defer reader.Close()
+		content, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, fmt.Errorf("content is not gzip compressed: %w", err)
+		}
+		return content, nil
+	default:
+		return nil, fmt.Errorf("unsupported encoding %q", *part.Encoding)
+	}
+}
@@ -15,6 +15,10 @@
 package helper_test
 
 import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"k8s.io/utils/pointer"
@@ -112,6 +116,68 @@ var _ = Describe("Helper", func() {
 		Entry("profile non matching region", makeProfileMachineImages("ubuntu", "1", "europe", "ami-1234", pointer.String("foo")), "ubuntu", "1", "china", pointer.String("foo"), ""),
 	)
 
+	DescribeTable("#FindSSMParameterFromCloudProfile",
+		func(versions []api.MachineImageVersion, imageName, version string, arch *string, expectedParameter string) {
+			cfg := &api.CloudProfileConfig{MachineImages: []api.MachineImages{{Name: "ubuntu", Versions: versions}}}
+			parameter, err := FindSSMParameterFromCloudProfile(cfg, imageName, version, arch)
+
+			Expect(parameter).To(Equal(expectedParameter))
+			if expectedParameter != "" {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+
+		Entry("no SSM parameters", []api.MachineImageVersion{{Version: "1"}}, "ubuntu", "1", pointer.String("amd64"), ""),
+		Entry("image does not exist", []api.MachineImageVersion{{Version: "1", SSMParameters: []api.SSMParameter{{Path: "/amd64", Architecture: pointer.String("amd64")}}}}, "debian", "1", pointer.String("amd64"), ""),
+		Entry("version does not exist", []api.MachineImageVersion{{Version: "1", SSMParameters: []api.SSMParameter{{Path: "/amd64", Architecture: pointer.String("amd64")}}}}, "ubuntu", "2", pointer.String("amd64"), ""),
+		Entry("architecture does not exist", []api.MachineImageVersion{{Version: "1", SSMParameters: []api.SSMParameter{{Path: "/amd64", Architecture: pointer.String("amd64")}}}}, "ubuntu", "1", pointer.String("arm64"), ""),
+		Entry("parameter found", []api.MachineImageVersion{{Version: "1", SSMParameters: []api.SSMParameter{{Path: "/amd64", Architecture: pointer.String("amd64")}, {Path: "/arm64", Architecture: pointer.String("arm64")}}}}, "ubuntu", "1", pointer.String("arm64"), "/arm64"),
+	)
+
+	DescribeTable("#HasAcceleratorDrivers",
+		func(versions []api.MachineImageVersion, imageName, version string, acceleratorType api.AcceleratorType, expected bool) {
+			cfg := &api.CloudProfileConfig{MachineImages: []api.MachineImages{{Name: "ubuntu", Versions: versions}}}
+			Expect(HasAcceleratorDrivers(cfg, imageName, version, acceleratorType)).To(Equal(expected))
+		},
+
+		Entry("no drivers", []api.MachineImageVersion{{Version: "1"}}, "ubuntu", "1", api.AcceleratorTypeNVIDIA, false),
+		Entry("image does not exist", []api.MachineImageVersion{{Version: "1", AcceleratorDrivers: []api.AcceleratorType{api.AcceleratorTypeNVIDIA}}}, "debian", "1", api.AcceleratorTypeNVIDIA, false),
+		Entry("version does not exist", []api.MachineImageVersion{{Version: "1", AcceleratorDrivers: []api.AcceleratorType{api.AcceleratorTypeNVIDIA}}}, "ubuntu", "2", api.AcceleratorTypeNVIDIA, false),
+		Entry("other drivers", []api.MachineImageVersion{{Version: "1", AcceleratorDrivers: []api.AcceleratorType{api.AcceleratorTypeNeuron}}}, "ubuntu", "1", api.AcceleratorTypeNVIDIA, false),
+		Entry("drivers found", []api.MachineImageVersion{{Version: "1", AcceleratorDrivers: []api.AcceleratorType{api.AcceleratorTypeNeuron, api.AcceleratorTypeNVIDIA}}}, "ubuntu", "1", api.AcceleratorTypeNVIDIA, true),
+	)
+
+	DescribeTable("#GetAcceleratorType",
+		func(machineType string, expected api.AcceleratorType) {
+			Expect(GetAcceleratorType(machineType)).To(Equal(expected))
+		},
+
+		Entry("general purpose", "m5.large", api.AcceleratorType("")),
+		Entry("graviton", "m7g.large", api.AcceleratorType("")),
+		Entry("NVIDIA GPU", "p4d.24xlarge", api.AcceleratorTypeNVIDIA),
+		Entry("NVIDIA GPU with Graviton", "g5g.xlarge", api.AcceleratorTypeNVIDIA),
+		Entry("AMD GPU", "g4ad.xlarge", api.AcceleratorType("")),
+		Entry("Inferentia", "inf2.xlarge", api.AcceleratorTypeNeuron),
+		Entry("Trainium", "trn1n.32xlarge", api.AcceleratorTypeNeuron),
+		Entry("Habana Gaudi", "dl1.24xlarge", api.AcceleratorType("")),
+	)
+
+	DescribeTable("#FindSourceImageFromCloudProfile",
+		func(versions []api.MachineImageVersion, imageName, version string, arch *string, expectedSourceImage *api.SourceImage) {
+			cfg := &api.CloudProfileConfig{MachineImages: []api.MachineImages{{Name: "ubuntu", Versions: versions}}}
+			sourceImage, err := FindSourceImageFromCloudProfile(cfg, imageName, version, arch)
+
+			expectResults(sourceImage, expectedSourceImage, err, expectedSourceImage == nil)
+		},
+
+		Entry("no source images", []api.MachineImageVersion{{Version: "1"}}, "ubuntu", "1", pointer.String("amd64"), nil),
+		Entry("version does not exist", []api.MachineImageVersion{{Version: "1", SourceImages: []api.SourceImage{{Region: "eu", AMI: "ami-1", Architecture: pointer.String("amd64")}}}}, "ubuntu", "2", pointer.String("amd64"), nil),
+		Entry("architecture does not exist", []api.MachineImageVersion{{Version: "1", SourceImages: []api.SourceImage{{Region: "eu", AMI: "ami-1", Architecture: pointer.String("amd64")}}}}, "ubuntu", "1", pointer.String("arm64"), nil),
+		Entry("source image found", []api.MachineImageVersion{{Version: "1", SourceImages: []api.SourceImage{{Region: "eu", AMI: "ami-1", Architecture: pointer.String("amd64")}}}}, "ubuntu", "1", pointer.String("amd64"), &api.SourceImage{Region: "eu", AMI: "ami-1", Architecture: pointer.String("amd64")}),
+	)
+
 	DescribeTable("#FindDataVolumeByName",
 		func(dataVolumes []api.DataVolume, name string, expectedDataVolume *api.DataVolume) {
 			Expect(FindDataVolumeByName(dataVolumes, name)).To(Equal(expectedDataVolume))
@@ -123,8 +189,195 @@ var _ = Describe("Helper", func() {
 		Entry("volume found (single entry)", []api.DataVolume{{Name: "foo"}}, "foo", &api.DataVolume{Name: "foo"}),
 		Entry("volume found (multiple entries)", []api.DataVolume{{Name: "bar"}, {Name: "foo"}, {Name: "baz"}}, "foo", &api.DataVolume{Name: "foo"}),
 	)
+
+	DescribeTable("#NormalizeDeviceName",
+		func(deviceName, expected string) {
+			Expect(NormalizeDeviceName(deviceName)).To(Equal(expected))
+		},
+
+		Entry("sd prefix", "/dev/sdf", "/dev/sdf"),
+		Entry("xvd prefix", "/dev/xvdf", "/dev/sdf"),
+		Entry("other name", "/dev/nvme1n1", "/dev/nvme1n1"),
+	)
+
+	DescribeTable("#IsDualStack",
+		func(config *api.InfrastructureConfig, expected bool) {
+			Expect(IsDualStack(config)).To(Equal(expected))
+		},
+
+		Entry("config is nil", nil, false),
+		Entry("nothing configured", &api.InfrastructureConfig{}, false),
+		Entry("dual-stack disabled", &api.InfrastructureConfig{DualStack: &api.DualStack{Enabled: false}}, false),
+		Entry("dual-stack enabled", &api.InfrastructureConfig{DualStack: &api.DualStack{Enabled: true}}, true),
+		Entry("IPv4 only", &api.InfrastructureConfig{Networks: api.Networks{IPFamilies: []api.IPFamily{api.IPFamilyIPv4}}}, false),
+		Entry("IPv4 and IPv6", &api.InfrastructureConfig{Networks: api.Networks{IPFamilies: []api.IPFamily{api.IPFamilyIPv4, api.IPFamilyIPv6}}}, true),
+		Entry("ip families take precedence", &api.InfrastructureConfig{DualStack: &api.DualStack{Enabled: true}, Networks: api.Networks{IPFamilies: []api.IPFamily{api.IPFamilyIPv4}}}, false),
+	)
+
+	DescribeTable("#IsInventoryEnabled",
+		func(config *api.InfrastructureConfig, expected bool) {
+			Expect(IsInventoryEnabled(config)).To(Equal(expected))
+		},
+
+		Entry("config is nil", nil, false),
+		Entry("nothing configured", &api.InfrastructureConfig{}, false),
+		Entry("inventory disabled", &api.InfrastructureConfig{Inventory: &api.InventoryConfig{Enabled: false}}, false),
+		Entry("inventory enabled", &api.InfrastructureConfig{Inventory: &api.InventoryConfig{Enabled: true}}, true),
+	)
+
+	DescribeTable("#GetNodesInstanceProfileName",
+		func(config *api.InfrastructureConfig, expected string) {
+			Expect(GetNodesInstanceProfileName(config)).To(Equal(expected))
+		},
+
+		Entry("config is nil", nil, ""),
+		Entry("nothing configured", &api.InfrastructureConfig{}, ""),
+		Entry("name configured", &api.InfrastructureConfig{IAM: &api.IAMConfig{NodesInstanceProfile: &api.IAMInstanceProfile{Name: pointer.String("foo")}}}, "foo"),
+		Entry("ARN configured", &api.InfrastructureConfig{IAM: &api.IAMConfig{NodesInstanceProfile: &api.IAMInstanceProfile{ARN: pointer.String("arn:aws:iam::123456789012:instance-profile/foo")}}}, "foo"),
+		Entry("ARN with path configured", &api.InfrastructureConfig{IAM: &api.IAMConfig{NodesInstanceProfile: &api.IAMInstanceProfile{ARN: pointer.String("arn:aws:iam::123456789012:instance-profile/path/foo")}}}, "foo"),
+	)
+
+	DescribeTable("#GetVPCEndpointServices",
+		func(vpc api.VPC, endpointType api.VPCEndpointType, expected []string) {
+			Expect(GetVPCEndpointServices(vpc, endpointType)).To(Equal(expected))
+		},
+
+		Entry("no endpoints", api.VPC{}, api.VPCEndpointTypeGateway, nil),
+		Entry("gateway endpoints", api.VPC{
+			GatewayEndpoints: []string{"s3"},
+			Endpoints:        []api.VPCEndpoint{{Service: "dynamodb", Type: api.VPCEndpointTypeGateway}, {Service: "ecr.api", Type: api.VPCEndpointTypeInterface}},
+		}, api.VPCEndpointTypeGateway, []string{"s3", "dynamodb"}),
+		Entry("interface endpoints", api.VPC{
+			GatewayEndpoints: []string{"s3"},
+			Endpoints:        []api.VPCEndpoint{{Service: "dynamodb", Type: api.VPCEndpointTypeGateway}, {Service: "ecr.api", Type: api.VPCEndpointTypeInterface}},
+		}, api.VPCEndpointTypeInterface, []string{"ecr.api"}),
+	)
+
+	DescribeTable("#GetNATGatewayZoneName",
+		func(mode *api.NATGatewayMode, zoneName, expected string) {
+			config := &api.InfrastructureConfig{Networks: api.Networks{Zones: []api.Zone{
+				{Name: "zone-a"},
+				{Name: "zone-b"},
+				{Name: "local-zone", Type: zoneType(api.ZoneTypeLocalZone)},
+				{Name: "wavelength-zone", Type: zoneType(api.ZoneTypeWavelengthZone)},
+			}}}
+			if mode != nil {
+				config.Networks.NATGateway = &api.NATGateway{Mode: mode}
+			}
+			Expect(GetNATGatewayZoneName(config, zoneName)).To(Equal(expected))
+		},
+
+		Entry("default mode", nil, "zone-b", "zone-b"),
+		Entry("per zone", natGatewayMode(api.NATGatewayModePerZone), "zone-b", "zone-b"),
+		Entry("single", natGatewayMode(api.NATGatewayModeSingle), "zone-b", "zone-a"),
+		Entry("none", natGatewayMode(api.NATGatewayModeNone), "zone-b", ""),
+		Entry("local zone", nil, "local-zone", "zone-a"),
+		Entry("local zone in mode none", natGatewayMode(api.NATGatewayModeNone), "local-zone", ""),
+		Entry("wavelength zone", nil, "wavelength-zone", ""),
+	)
+
+	It("#GetNATGatewayZoneName should not use NAT gateways in a shared VPC", func() {
+		config := &api.InfrastructureConfig{Networks: api.Networks{
+			VPC:   api.VPC{ID: pointer.String("vpc-123456"), Shared: true},
+			Zones: []api.Zone{{Name: "zone-a"}},
+		}}
+		Expect(GetNATGatewayZoneName(config, "zone-a")).To(BeEmpty())
+	})
+
+	DescribeTable("#GetZoneType",
+		func(zone api.Zone, expected api.ZoneType) {
+			config := &api.InfrastructureConfig{Networks: api.Networks{Zones: []api.Zone{zone}}}
+			Expect(GetZoneType(config, zone.Name)).To(Equal(expected))
+		},
+
+		Entry("default type", api.Zone{Name: "zone-a"}, api.ZoneTypeAvailabilityZone),
+		Entry("local zone", api.Zone{Name: "zone-a", Type: zoneType(api.ZoneTypeLocalZone)}, api.ZoneTypeLocalZone),
+	)
+
+	DescribeTable("#GetDHCPConfigurations",
+		func(options *api.DHCPOptions, region string, expected map[string][]string) {
+			Expect(GetDHCPConfigurations(api.VPC{DHCPOptions: options}, region)).To(Equal(expected))
+		},
+
+		Entry("no DHCP options in us-east-1", nil, "us-east-1", map[string][]string{
+			"domain-name":         {"ec2.internal"},
+			"domain-name-servers": {"AmazonProvidedDNS"},
+		}),
+		Entry("no DHCP options in other regions", nil, "eu-west-1", map[string][]string{
+			"domain-name":         {"eu-west-1.compute.internal"},
+			"domain-name-servers": {"AmazonProvidedDNS"},
+		}),
+		Entry("custom DNS servers only", &api.DHCPOptions{DomainNameServers: []string{"10.0.0.2", "10.0.0.3"}}, "eu-west-1", map[string][]string{
+			"domain-name":         {"eu-west-1.compute.internal"},
+			"domain-name-servers": {"10.0.0.2", "10.0.0.3"},
+		}),
+		Entry("all custom DHCP options", &api.DHCPOptions{
+			DomainName:        pointer.String("corp.example.com"),
+			DomainNameServers: []string{"10.0.0.2"},
+			NTPServers:        []string{"10.0.0.4"},
+		}, "eu-west-1", map[string][]string{
+			"domain-name":         {"corp.example.com"},
+			"domain-name-servers": {"10.0.0.2"},
+			"ntp-servers":         {"10.0.0.4"},
+		}),
+	)
+
+	DescribeTable("#GetNATGatewayType",
+		func(natGateway *api.NATGateway, expected api.NATGatewayType) {
+			config := &api.InfrastructureConfig{Networks: api.Networks{NATGateway: natGateway}}
+			Expect(GetNATGatewayType(config)).To(Equal(expected))
+		},
+
+		Entry("no NAT gateway config", nil, api.NATGatewayTypeGateway),
+		Entry("no type", &api.NATGateway{}, api.NATGatewayTypeGateway),
+		Entry("instance", &api.NATGateway{Type: natGatewayType(api.NATGatewayTypeInstance)}, api.NATGatewayTypeInstance),
+	)
+
+	Describe("#DecodeUserDataPart", func() {
+		It("should return plain content as is", func() {
+			Expect(DecodeUserDataPart(api.UserDataPart{Content: "echo foo"})).To(Equal([]byte("echo foo")))
+		})
+
+		It("should decode base64 encoded content", func() {
+			Expect(DecodeUserDataPart(api.UserDataPart{
+				Content:  base64.StdEncoding.EncodeToString([]byte("echo foo")),
+				Encoding: userDataEncoding(api.UserDataEncodingBase64),
+			})).To(Equal([]byte("echo foo")))
+		})
+
+		It("should decode gzip compressed and base64 encoded content", func() {
+			var buf bytes.Buffer
+			writer := gzip.NewWriter(&buf)
+			_, err := writer.Write([]byte("echo foo"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(writer.Close()).To(Succeed())
+
+			Expect(DecodeUserDataPart(api.UserDataPart{
+				Content:  base64.StdEncoding.EncodeToString(buf.Bytes()),
+				Encoding: userDataEncoding(api.UserDataEncodingGzipBase64),
+			})).To(Equal([]byte("echo foo")))
+		})
+
+		It("should fail for invalid content", func() {
+			_, err := DecodeUserDataPart(api.UserDataPart{Content: "echo foo", Encoding: userDataEncoding(api.UserDataEncodingBase64)})
+			Expect(err).To(HaveOccurred())
+			_, err = DecodeUserDataPart(api.UserDataPart{
+				Content:  base64.StdEncoding.EncodeToString([]byte("echo foo")),
+				Encoding: userDataEncoding(api.UserDataEncodingGzipBase64),
+			})
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
 
+func natGatewayMode(mode api.NATGatewayMode) *api.NATGatewayMode {
+	return &mode
+}
+
+func zoneType(zoneType api.ZoneType) *api.ZoneType {
+	return &zoneType
+}
+
 func makeProfileMachineImages(name, version, region, ami string, arch *string) []api.MachineImages {
 	versions := []api.MachineImageVersion{
 		{
@@ -156,3 +409,11 @@ func expectResults(result, expected interface{}, err error, expectErr bool) {
 		Expect(err).To(HaveOccurred())
 	}
 }
+
+func natGatewayType(t api.NATGatewayType) *api.NATGatewayType {
+	return &t
+}
+
+func userDataEncoding(e api.UserDataEncoding) *api.UserDataEncoding {
+	return &e
+}
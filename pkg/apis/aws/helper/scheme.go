@@ -57,6 +57,32 @@ func CloudProfileConfigFromCluster(cluster *controller.Cluster) (*api.CloudProfi
 	return cloudProfileConfig, nil
 }
 
+// InfrastructureConfigFromCluster decodes the provider specific infrastructure configuration of the shoot of a cluster.
+// It returns nil if the shoot has no infrastructure configuration.
+func InfrastructureConfigFromCluster(cluster *controller.Cluster) (*api.InfrastructureConfig, error) {
+	var infrastructureConfig *api.InfrastructureConfig
+	if cluster != nil && cluster.Shoot != nil && cluster.Shoot.Spec.Provider.InfrastructureConfig != nil && cluster.Shoot.Spec.Provider.InfrastructureConfig.Raw != nil {
+		infrastructureConfig = &api.InfrastructureConfig{}
+		if _, _, err := decoder.Decode(cluster.Shoot.Spec.Provider.InfrastructureConfig.Raw, nil, infrastructureConfig); err != nil {
+			return nil, fmt.Errorf("could not decode infrastructureConfig of shoot '%s': %w", kutil.ObjectName(cluster.Shoot), err)
+		}
+	}
+	return infrastructureConfig, nil
+}
+
+// ControlPlaneConfigFromCluster decodes the provider specific control plane configuration of the shoot of a cluster.
+// It returns nil if the shoot has no control plane configuration.
+func ControlPlaneConfigFromCluster(cluster *controller.Cluster) (*api.ControlPlaneConfig, error) {
+	var controlPlaneConfig *api.ControlPlaneConfig
+	if cluster != nil && cluster.Shoot != nil && cluster.Shoot.Spec.Provider.ControlPlaneConfig != nil && cluster.Shoot.Spec.Provider.ControlPlaneConfig.Raw != nil {
+		controlPlaneConfig = &api.ControlPlaneConfig{}
+		if _, _, err := decoder.Decode(cluster.Shoot.Spec.Provider.ControlPlaneConfig.Raw, nil, controlPlaneConfig); err != nil {
+			return nil, fmt.Errorf("could not decode controlPlaneConfig of shoot '%s': %w", kutil.ObjectName(cluster.Shoot), err)
+		}
+	}
+	return controlPlaneConfig, nil
+}
+
 // InfrastructureConfigFromInfrastructure extracts the InfrastructureConfig from the
 // ProviderConfig section of the given Infrastructure.
 func InfrastructureConfigFromInfrastructure(infra *extensionsv1alpha1.Infrastructure) (*api.InfrastructureConfig, error) {
@@ -96,6 +122,25 @@ func InfrastructureStatusFromInfrastructure(infra *extensionsv1alpha1.Infrastruc
 	return nil, fmt.Errorf("provider status is not set on the infrastructure resource")
 }
 
+// WorkerConfigFromRawExtension extracts the WorkerConfig from the ProviderConfig of a worker pool. An empty
+// WorkerConfig is returned if the ProviderConfig is not set.
+func WorkerConfigFromRawExtension(raw *runtime.RawExtension) (*api.WorkerConfig, error) {
+	config := &api.WorkerConfig{}
+	if raw == nil {
+		return config, nil
+	}
+	data, err := marshalRaw(raw)
+	if err != nil {
+		return nil, err
+	}
+	if data != nil {
+		if _, _, err := decoder.Decode(data, nil, config); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
 func marshalRaw(raw *runtime.RawExtension) ([]byte, error) {
 	data, err := raw.MarshalJSON()
 	if err != nil {
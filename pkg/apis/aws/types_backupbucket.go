@@ -0,0 +1,127 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackupBucketConfig contains configuration settings for the S3 bucket of a BackupBucket.
+type BackupBucketConfig struct {
+	metav1.TypeMeta
+
+	// ObjectLock configures S3 Object Lock for the bucket, which protects the objects in the bucket from being deleted
+	// or overwritten during the retention period. Object Lock cannot be disabled once it is enabled for a bucket.
+	ObjectLock *ObjectLockConfig
+	// Encryption configures the default encryption of the bucket with a customer managed KMS key. If not set, objects
+	// are encrypted with keys managed by S3 (SSE-S3).
+	Encryption *BucketEncryption
+	// Lifecycle configures the lifecycle of the objects in the bucket, e.g. to reduce the storage costs of backups.
+	Lifecycle *BucketLifecycle
+	// Replication configures the replication of the objects in the bucket to a bucket in another region, so that
+	// backups survive a regional outage.
+	Replication *BucketReplication
+	// AccessLogging configures the delivery of the S3 server access logs of the bucket to a central bucket. If not set,
+	// access logging is disabled.
+	AccessLogging *BucketAccessLogging
+	// Hardening configures additional restrictions of the access to the bucket.
+	Hardening *BucketHardening
+}
+
+// BucketAccessLogging configures the delivery of the S3 server access logs of a bucket to a central bucket.
+type BucketAccessLogging struct {
+	// TargetBucket is the name of the bucket the access logs are delivered to. It must exist in the same region and
+	// account as the bucket, and its policy must allow the S3 logging service to write to it.
+	TargetBucket string
+	// TargetPrefix is the prefix of the keys of the log objects. Defaults to the name of the bucket followed by "/".
+	TargetPrefix *string
+}
+
+// BucketHardening configures additional restrictions of the access to a bucket.
+type BucketHardening struct {
+	// MinimumTLSVersion is the minimum TLS version of requests to the bucket, either "1.2" or "1.3". Defaults to "1.2".
+	MinimumTLSVersion *string
+	// BucketOwnerEnforced disables the ACLs of the bucket, so that the bucket owner owns all objects and the access is
+	// only controlled by policies. ACLs are not enabled again if it is unset later on.
+	BucketOwnerEnforced *bool
+}
+
+// BucketReplication configures the replication of the objects in a bucket to a bucket in another region.
+type BucketReplication struct {
+	// DestinationBucket is the name of the bucket the objects are replicated to. It is created if it does not exist,
+	// and it is not deleted together with the BackupBucket.
+	DestinationBucket string
+	// DestinationRegion is the region of the destination bucket, which must differ from the region of the bucket.
+	DestinationRegion string
+	// KMSKeyARN is the ARN of a KMS key in the destination region which is used to encrypt the replicas. It is required
+	// if the bucket is encrypted with a KMS key. If not set, replicas are encrypted with keys managed by S3.
+	KMSKeyARN *string
+	// ReplicateDeletes specifies whether deletions of objects are replicated. Defaults to false, i.e. replicas are kept
+	// if the objects are deleted in the bucket.
+	ReplicateDeletes *bool
+}
+
+// BucketLifecycle configures the lifecycle rules of the objects in a bucket.
+type BucketLifecycle struct {
+	// Transitions are the transitions of objects to other storage classes.
+	Transitions []LifecycleTransition
+	// ExpirationDays is the number of days after which objects are deleted. It must be greater than the days of all
+	// transitions.
+	ExpirationDays *int32
+	// AbortIncompleteMultipartUploadDays is the number of days after which incomplete multipart uploads are aborted.
+	// Defaults to 7.
+	AbortIncompleteMultipartUploadDays *int32
+}
+
+// LifecycleTransition is the transition of objects to another storage class.
+type LifecycleTransition struct {
+	// Days is the number of days after the creation of objects after which they are transitioned.
+	Days int32
+	// StorageClass is the storage class the objects are transitioned to, one of STANDARD_IA, ONEZONE_IA,
+	// INTELLIGENT_TIERING, GLACIER_IR, GLACIER or DEEP_ARCHIVE.
+	StorageClass string
+}
+
+// BucketEncryption configures the default encryption of a bucket with a KMS key (SSE-KMS).
+type BucketEncryption struct {
+	// KMSKeyARN is the ARN of the KMS key which is used to encrypt the objects in the bucket.
+	KMSKeyARN string
+	// BucketKeyEnabled specifies whether an S3 bucket key is used to reduce the requests to KMS. Defaults to true.
+	BucketKeyEnabled *bool
+}
+
+// ObjectLockConfig configures the default retention of the objects in a bucket with S3 Object Lock.
+type ObjectLockConfig struct {
+	// Mode is the retention mode, either COMPLIANCE or GOVERNANCE. Objects in compliance mode cannot be deleted by any
+	// user during the retention period, objects in governance mode can be deleted by users with special permissions.
+	Mode ObjectLockMode
+	// RetentionDays is the number of days the objects are retained after they have been written.
+	RetentionDays int32
+}
+
+// ObjectLockMode is the retention mode of S3 Object Lock.
+type ObjectLockMode string
+
+const (
+	// ObjectLockModeCompliance is the retention mode in which objects cannot be deleted by any user, including the root
+	// user of the account, and the retention period cannot be shortened.
+	ObjectLockModeCompliance ObjectLockMode = "COMPLIANCE"
+	// ObjectLockModeGovernance is the retention mode in which objects can only be deleted or their retention changed
+	// by users with the s3:BypassGovernanceRetention permission.
+	ObjectLockModeGovernance ObjectLockMode = "GOVERNANCE"
+)
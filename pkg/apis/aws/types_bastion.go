@@ -0,0 +1,35 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BastionConfig contains configuration settings for a bastion host, which override the defaults of the controller
+// configuration.
+type BastionConfig struct {
+	metav1.TypeMeta
+
+	// InstanceType is the instance type of the bastion host.
+	InstanceType *string
+	// AMI is the AMI of the bastion host in the region of the shoot.
+	AMI *string
+	// SessionManager specifies whether the bastion host is only accessible via AWS Systems Manager Session Manager
+	// instead of SSH. In this mode, the bastion host has no public IP address and no SSH ingress.
+	SessionManager *bool
+}
@@ -43,6 +43,15 @@ type MachineImageVersion struct {
 	Version string
 	// Regions is a mapping to the correct AMI for the machine image in the supported regions.
 	Regions []RegionAMIMapping
+	// SSMParameters are references to SSM parameters containing the AMI for the machine image, e.g. the public
+	// parameters of the image vendor. They are resolved in the region of the shoot if no AMI is mapped for the region.
+	SSMParameters []SSMParameter
+	// SourceImages are private AMIs in other regions which are copied to the region of the shoot if neither an AMI is
+	// mapped for the region nor an SSM parameter is referenced.
+	SourceImages []SourceImage
+	// AcceleratorDrivers are the accelerators whose drivers are contained in the machine image version, i.e. `nvidia`
+	// for NVIDIA GPUs or `neuron` for AWS Inferentia and Trainium. The device plugins of worker pools require them.
+	AcceleratorDrivers []AcceleratorType
 }
 
 // RegionAMIMapping is a mapping to the correct AMI for the machine image in the given region.
@@ -54,3 +63,34 @@ type RegionAMIMapping struct {
 	// Architecture is the CPU architecture of the machine image.
 	Architecture *string
 }
+
+// AcceleratorType is a type of accelerators of machines.
+type AcceleratorType string
+
+const (
+	// AcceleratorTypeNVIDIA are NVIDIA GPUs, e.g. of the `p` and `g` instance families.
+	AcceleratorTypeNVIDIA AcceleratorType = "nvidia"
+	// AcceleratorTypeNeuron are AWS Inferentia and Trainium accelerators of the `inf` and `trn` instance families.
+	AcceleratorTypeNeuron AcceleratorType = "neuron"
+)
+
+// SSMParameter is a reference to an SSM parameter containing the AMI for the machine image.
+type SSMParameter struct {
+	// Path is the path of the SSM parameter.
+	Path string
+	// Architecture is the CPU architecture of the machine image.
+	Architecture *string
+}
+
+// SourceImage is a private AMI in another region which is copied to the region of the shoot.
+type SourceImage struct {
+	// Region is the region of the AMI.
+	Region string
+	// AMI is the ID of the AMI.
+	AMI string
+	// Architecture is the CPU architecture of the machine image.
+	Architecture *string
+	// KMSKeyID is the ID, ARN or alias of the KMS key in the region of the shoot which is used for encrypting the
+	// snapshots of the copy. Defaults to the default KMS key for EBS encryption of the account.
+	KMSKeyID *string
+}
@@ -15,6 +15,7 @@
 package aws
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -30,8 +31,23 @@ type ControlPlaneConfig struct {
 	// LoadBalancerController contains configuration settings for the optional aws-load-balancer-controller (ALB).
 	LoadBalancerController *LoadBalancerControllerConfig
 
+	// LoadBalancerDefaults contains defaults for services of type LoadBalancer.
+	LoadBalancerDefaults *LoadBalancerDefaults
+
 	// Storage contains configuration for storage in the cluster.
 	Storage *Storage
+
+	// IRSA contains configuration for IAM roles for service accounts.
+	IRSA *IRSAConfig
+
+	// Karpenter contains configuration settings for the optional Karpenter node provisioner.
+	Karpenter *KarpenterConfig
+
+	// PrivateLink contains configuration settings for the exposure of the kube-apiserver as VPC endpoint service.
+	PrivateLink *PrivateLinkConfig
+
+	// CloudWatchAgent contains configuration settings for the optional CloudWatch agent on the nodes.
+	CloudWatchAgent *CloudWatchAgentConfig
 }
 
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
@@ -42,6 +58,21 @@ type CloudControllerManagerConfig struct {
 	// UseCustomRouteController controls if custom route controller should be used.
 	// Defaults to false.
 	UseCustomRouteController *bool
+
+	// ConcurrentServiceSyncs is the number of services which are synced concurrently, i.e. whose load balancers are
+	// reconciled in parallel. Defaults to 10.
+	ConcurrentServiceSyncs *int32
+
+	// NodeStatusUpdateFrequency is the period in which the addresses of the nodes are updated. Defaults to 5m.
+	NodeStatusUpdateFrequency *metav1.Duration
+
+	// NodeIPFamilies are the IP families of the node addresses in the order of preference. The first family
+	// determines the primary address of the nodes. Defaults to [IPv4].
+	NodeIPFamilies []IPFamily
+
+	// AllocateNodeCIDRs controls whether the cloud-controller-manager allocates the pod CIDRs of the nodes from the
+	// pod network of the shoot. Defaults to true.
+	AllocateNodeCIDRs *bool
 }
 
 // LoadBalancerControllerConfig contains configuration settings for the optional aws-load-balancer-controller (ALB).
@@ -53,6 +84,41 @@ type LoadBalancerControllerConfig struct {
 	IngressClassName *string
 }
 
+// LoadBalancerDefaults contains defaults for services of type LoadBalancer. They are applied when a service is created
+// and does not specify the respective setting by annotations itself.
+type LoadBalancerDefaults struct {
+	// Internal controls whether load balancers are internal, i.e. only reachable from within the VPC and connected
+	// networks, or internet-facing.
+	Internal *bool
+	// TargetType is the target type of network load balancers provisioned by the aws-load-balancer-controller,
+	// either 'instance' or 'ip'.
+	TargetType *string
+	// Subnets are the IDs or names of the subnets in which load balancers are placed, at most one per zone. If not
+	// set, the subnets are discovered by their tags.
+	Subnets []string
+	// Attributes are additional attributes of load balancers provisioned by the aws-load-balancer-controller, e.g.
+	// 'load_balancing.cross_zone.enabled'.
+	Attributes map[string]string
+	// AccessLogs contains the access log configuration of classic and network load balancers.
+	AccessLogs *LoadBalancerAccessLogs
+	// SSLNegotiationPolicy is the name of the predefined TLS security policy of TLS listeners of classic and network
+	// load balancers, e.g. 'ELBSecurityPolicy-TLS13-1-2-2021-06'.
+	SSLNegotiationPolicy *string
+}
+
+// LoadBalancerAccessLogs contains the access log configuration of load balancers.
+type LoadBalancerAccessLogs struct {
+	// Enabled controls whether access logs are delivered.
+	Enabled bool
+	// BucketName is the name of the S3 bucket the access logs are delivered to. If not set, the bucket created by the
+	// infrastructure controller is used (see `InfrastructureConfig.LoadBalancerAccessLogs`).
+	BucketName *string
+	// Prefix is the prefix of the access log objects in the S3 bucket.
+	Prefix *string
+	// EmitInterval is the interval in minutes for publishing the access logs of classic load balancers, either 5 or 60.
+	EmitInterval *int32
+}
+
 // Storage contains configuration for storage in the cluster.
 type Storage struct {
 	// ManagedDefaultClass controls if the 'default' StorageClass and 'default' VolumeSnapshotClass
@@ -60,4 +126,181 @@ type Storage struct {
 	// managed by Gardener.
 	// Defaults to true.
 	ManagedDefaultClass *bool
+	// EFS contains configuration for the EFS CSI driver.
+	EFS *EFSConfig
+	// DefaultClass contains the parameters of the 'default' StorageClass. If not set, an encrypted volume of the
+	// default type of the EBS CSI driver is provisioned.
+	DefaultClass *StorageClassParameters
+	// AdditionalClasses are further EBS StorageClasses which are managed by Gardener.
+	AdditionalClasses []StorageClass
+	// EBS contains configuration for the EBS CSI driver.
+	EBS *EBSConfig
+}
+
+// EBSConfig contains configuration for the EBS CSI driver.
+type EBSConfig struct {
+	// VolumeModification controls whether the volume modifier is deployed, which modifies the type, IOPS and
+	// throughput of volumes according to the annotations of their PersistentVolumeClaims. Defaults to true.
+	VolumeModification *bool
+	// Batching controls whether the EBS CSI controller batches the EC2 API calls for attaching and describing
+	// volumes, which reduces the number of API calls and the attach latency in large clusters. Defaults to false.
+	Batching *bool
+	// ReservedVolumeAttachments is the number of volume attachments which are reserved for volumes not managed by the
+	// EBS CSI driver (e.g. the root volume) on every node. The number of attachable volumes is still computed
+	// according to the instance type of the node. It must not be used together with the volume attach limit annotation.
+	ReservedVolumeAttachments *int32
+	// SnapshotController contains configuration for the CSI snapshot controller.
+	SnapshotController *SnapshotControllerConfig
+	// ResourceRequests are the resource requests of the containers of the EBS CSI controller. Supported keys are
+	// 'driver', 'provisioner', 'attacher', 'snapshotter', 'resizer', 'livenessProbe' and 'volumeModifier'.
+	ResourceRequests map[string]corev1.ResourceList
+	// ExtraTags are additional tags which are added to all volumes and snapshots provisioned by the EBS CSI driver,
+	// besides the tags of the InfrastructureConfig. They take precedence over the tags of the InfrastructureConfig.
+	ExtraTags map[string]string
+	// ShootMetadataTags controls whether the tags 'gardener.cloud/shoot' and 'gardener.cloud/project' with the names of
+	// the shoot and its project are added to all volumes and snapshots provisioned by the EBS CSI driver, e.g. to
+	// attribute the storage costs per tenant. Defaults to false.
+	ShootMetadataTags *bool
+}
+
+// SnapshotControllerConfig contains configuration for the CSI snapshot controller.
+type SnapshotControllerConfig struct {
+	// WorkerThreads is the number of worker threads of the snapshot controller. Defaults to 10.
+	WorkerThreads *int32
+}
+
+// StorageClass contains the configuration of an additional EBS StorageClass.
+type StorageClass struct {
+	// Name is the name of the StorageClass.
+	Name string
+	// Parameters are the parameters of the StorageClass.
+	Parameters StorageClassParameters
+}
+
+// StorageClassParameters contains the parameters of an EBS StorageClass.
+type StorageClassParameters struct {
+	// Type is the EBS volume type, e.g. gp3 or io2.
+	Type *string
+	// IOPS is the number of I/O operations per second which are provisioned for io1, io2 and gp3 volumes.
+	IOPS *int64
+	// Throughput is the throughput in MiB/s which is provisioned for gp3 volumes.
+	Throughput *int64
+	// Encrypted controls whether the volumes are encrypted. Defaults to true.
+	Encrypted *bool
+	// KMSKeyID is the ID or ARN of the KMS key which is used to encrypt the volumes. If not set, the default KMS key
+	// for EBS encryption of the AWS account is used.
+	KMSKeyID *string
+}
+
+// EFSConfig contains configuration for the EFS CSI driver.
+type EFSConfig struct {
+	// Enabled controls whether the EFS CSI driver is deployed.
+	Enabled bool
+	// FileSystemID is the ID of an existing EFS file system which is used by the 'efs' StorageClass. If not set, the
+	// file system created by the infrastructure controller is used (see `InfrastructureConfig.ElasticFileSystem`).
+	FileSystemID *string
+}
+
+// IRSAConfig contains configuration for IAM roles for service accounts (IRSA).
+type IRSAConfig struct {
+	// Enabled controls whether the service account issuer of the shoot is registered as IAM OpenID Connect provider,
+	// so that workloads can assume IAM roles with projected service account tokens.
+	Enabled bool
+}
+
+// KarpenterConfig contains configuration settings for the optional Karpenter node provisioner.
+type KarpenterConfig struct {
+	// Enabled controls whether Karpenter is deployed. Karpenter provisions nodes for pending pods according to the
+	// NodePools in the shoot, in addition to the machines of the worker pools.
+	Enabled bool
+	// InterruptionHandling controls whether Karpenter is notified about spot interruptions, rebalance recommendations,
+	// scheduled changes and state changes of its instances via an SQS queue, so that it drains the nodes in advance.
+	// Defaults to true.
+	InterruptionHandling *bool
+}
+
+// PrivateLinkConfig contains configuration settings for the exposure of the kube-apiserver as VPC endpoint service
+// (AWS PrivateLink), so that it can be reached via interface VPC endpoints from other VPCs and accounts.
+type PrivateLinkConfig struct {
+	// Enabled controls whether the kube-apiserver is exposed as VPC endpoint service.
+	Enabled bool
+	// AllowedPrincipals are the ARNs of the principals (accounts, users or roles) which are allowed to create
+	// interface VPC endpoints for the endpoint service, e.g. 'arn:aws:iam::123456789012:root'.
+	AllowedPrincipals []string
+	// AcceptanceRequired controls whether connection requests of interface VPC endpoints must be accepted manually.
+	// Defaults to false.
+	AcceptanceRequired *bool
+}
+
+// CloudWatchAgentConfig contains configuration settings for the CloudWatch agent, which publishes the metrics and
+// optionally the container logs of the nodes to CloudWatch.
+type CloudWatchAgentConfig struct {
+	// Enabled controls whether the CloudWatch agent is deployed on all nodes.
+	Enabled bool
+	// MetricsCollectionInterval is the interval in seconds in which the metrics of the nodes are collected.
+	// Defaults to 60.
+	MetricsCollectionInterval *int32
+	// Logs contains configuration for publishing the container logs of the nodes to CloudWatch Logs.
+	Logs *CloudWatchAgentLogs
+}
+
+// CloudWatchAgentLogs contains configuration for publishing the container logs of the nodes to CloudWatch Logs.
+type CloudWatchAgentLogs struct {
+	// Enabled controls whether the container logs are published to a log group managed by the AWS extension.
+	Enabled bool
+	// RetentionInDays is the number of days the log events are retained in the log group, e.g. 30. If not set, the
+	// log events never expire.
+	RetentionInDays *int32
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ControlPlaneStatus contains information about the control plane resources managed in AWS.
+type ControlPlaneStatus struct {
+	metav1.TypeMeta
+
+	// IRSA contains information about the resources for IAM roles for service accounts.
+	IRSA *IRSAStatus
+
+	// Karpenter contains information about the resources for Karpenter. It is set once Karpenter has been enabled.
+	Karpenter *KarpenterStatus
+
+	// PrivateLink contains information about the VPC endpoint service of the kube-apiserver.
+	PrivateLink *PrivateLinkStatus
+
+	// CloudWatchAgent contains information about the resources for the CloudWatch agent.
+	CloudWatchAgent *CloudWatchAgentStatus
+}
+
+// IRSAStatus contains information about the resources for IAM roles for service accounts.
+type IRSAStatus struct {
+	// OIDCProviderARN is the ARN of the IAM OpenID Connect provider of the service account issuer.
+	OIDCProviderARN string
+	// IssuerURL is the URL of the service account issuer for which the OpenID Connect provider was created.
+	IssuerURL string
+}
+
+// KarpenterStatus contains information about the resources for Karpenter.
+type KarpenterStatus struct {
+	// InterruptionQueueName is the name of the SQS queue receiving the interruption events of the instances.
+	InterruptionQueueName string
+}
+
+// PrivateLinkStatus contains information about the VPC endpoint service of the kube-apiserver.
+type PrivateLinkStatus struct {
+	// ServiceID is the ID of the VPC endpoint service.
+	ServiceID string
+	// ServiceName is the name of the VPC endpoint service, which is used to create interface VPC endpoints.
+	ServiceName string
+	// BaseEndpointDNSNames are the DNS names of the VPC endpoint service.
+	BaseEndpointDNSNames []string
+}
+
+// CloudWatchAgentStatus contains information about the resources for the CloudWatch agent.
+type CloudWatchAgentStatus struct {
+	// RolePolicyName is the name of the inline policy of the IAM role of the nodes which allows the agent to publish
+	// the metrics and logs. It is empty if the IAM role isn't managed by the AWS extension.
+	RolePolicyName string
+	// LogGroupName is the name of the log group receiving the container logs.
+	LogGroupName *string
 }
@@ -49,6 +49,20 @@ func SetDefaults_RegionAMIMapping(obj *RegionAMIMapping) {
 	}
 }
 
+// SetDefaults_SSMParameter sets the architecture of the machine image referenced by the SSM parameter.
+func SetDefaults_SSMParameter(obj *SSMParameter) {
+	if obj.Architecture == nil {
+		obj.Architecture = ptr.To(v1beta1constants.ArchitectureAMD64)
+	}
+}
+
+// SetDefaults_SourceImage sets the architecture of the source image.
+func SetDefaults_SourceImage(obj *SourceImage) {
+	if obj.Architecture == nil {
+		obj.Architecture = ptr.To(v1beta1constants.ArchitectureAMD64)
+	}
+}
+
 // SetDefaults_MachineImage set the architecture of machine image.
 func SetDefaults_MachineImage(obj *MachineImage) {
 	if obj.Architecture == nil {
@@ -52,8 +52,13 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&InfrastructureConfig{},
 		&InfrastructureStatus{},
 		&ControlPlaneConfig{},
+		&ControlPlaneStatus{},
 		&WorkerConfig{},
 		&WorkerStatus{},
+		&BastionConfig{},
+		&DNSRecordConfig{},
+		&DNSRecordStatus{},
+		&BackupBucketConfig{},
 	)
 	return nil
 }
@@ -0,0 +1,179 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DNSRecordConfig contains configuration settings for the Route53 recordset of a DNSRecord.
+type DNSRecordConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// RoutingPolicy is the routing policy of the recordset. If not set, a recordset with simple routing is created.
+	// +optional
+	RoutingPolicy *RoutingPolicy `json:"routingPolicy,omitempty"`
+	// HealthCheckID is the ID of a Route53 health check which is associated with the recordset.
+	// +optional
+	HealthCheckID *string `json:"healthCheckID,omitempty"`
+	// HealthCheck configures a Route53 health check which is created, updated and deleted together with the DNSRecord
+	// and associated with the recordset. Must not be set together with HealthCheckID.
+	// +optional
+	HealthCheck *HealthCheck `json:"healthCheck,omitempty"`
+	// PrivateHostedZone configures the private hosted zone of the recordset, e.g. for internal domains which must only
+	// resolve inside of VPCs. If not set, the hosted zone is determined as usual.
+	// +optional
+	PrivateHostedZone *PrivateHostedZone `json:"privateHostedZone,omitempty"`
+}
+
+// HealthCheck configures a Route53 health check of an endpoint. The endpoint is either specified by its IP address or
+// by its domain name.
+type HealthCheck struct {
+	// Type is the type of the health check, one of HTTP, HTTPS or TCP.
+	Type HealthCheckType `json:"type"`
+	// IPAddress is the IPv4 or IPv6 address of the endpoint.
+	// +optional
+	IPAddress *string `json:"ipAddress,omitempty"`
+	// FullyQualifiedDomainName is the domain name of the endpoint. If IPAddress is set as well, it is only used as host
+	// header of HTTP(S) health checks.
+	// +optional
+	FullyQualifiedDomainName *string `json:"fullyQualifiedDomainName,omitempty"`
+	// Port is the port of the endpoint. Defaults to 80 for HTTP and 443 for HTTPS health checks, must be set for TCP
+	// health checks.
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+	// ResourcePath is the path which is requested by HTTP(S) health checks, e.g. /healthz.
+	// +optional
+	ResourcePath *string `json:"resourcePath,omitempty"`
+	// RequestInterval is the number of seconds between two health checks, either 10 or 30. Defaults to 30.
+	// +optional
+	RequestInterval *int32 `json:"requestInterval,omitempty"`
+	// FailureThreshold is the number of consecutive failed or successful health checks which change the health status
+	// of the endpoint, between 1 and 10. Defaults to 3.
+	// +optional
+	FailureThreshold *int32 `json:"failureThreshold,omitempty"`
+}
+
+// HealthCheckType is the type of a health check.
+type HealthCheckType string
+
+const (
+	// HealthCheckTypeHTTP is the type of health checks which succeed if the endpoint responds with a 2xx or 3xx status
+	// code to an HTTP request.
+	HealthCheckTypeHTTP HealthCheckType = "HTTP"
+	// HealthCheckTypeHTTPS is the type of health checks which succeed if the endpoint responds with a 2xx or 3xx status
+	// code to an HTTPS request.
+	HealthCheckTypeHTTPS HealthCheckType = "HTTPS"
+	// HealthCheckTypeTCP is the type of health checks which succeed if a TCP connection to the endpoint can be
+	// established.
+	HealthCheckTypeTCP HealthCheckType = "TCP"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DNSRecordStatus contains information about the Route53 resources which are managed for a DNSRecord.
+type DNSRecordStatus struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// HealthCheckID is the ID of the Route53 health check which is managed for the DNSRecord.
+	// +optional
+	HealthCheckID *string `json:"healthCheckID,omitempty"`
+}
+
+// PrivateHostedZone configures the private hosted zone of a recordset.
+type PrivateHostedZone struct {
+	// ID is the ID of the private hosted zone, which takes precedence over the zone of the DNSRecord. If not set, the
+	// private hosted zone with the longest name which is a suffix of the name of the DNSRecord is used, even if a
+	// public hosted zone with the same name exists (split-horizon DNS).
+	// +optional
+	ID *string `json:"id,omitempty"`
+	// VPCs are the VPCs which are associated with the private hosted zone if they are not associated yet, so that
+	// its recordsets resolve inside of them. Associations with other VPCs are kept.
+	// +optional
+	VPCs []HostedZoneVPC `json:"vpcs,omitempty"`
+}
+
+// HostedZoneVPC is a VPC which is associated with a private hosted zone.
+type HostedZoneVPC struct {
+	// ID is the ID of the VPC.
+	ID string `json:"id"`
+	// Region is the region of the VPC. Defaults to the region of the DNSRecord.
+	// +optional
+	Region *string `json:"region,omitempty"`
+}
+
+// RoutingPolicy is the routing policy of a Route53 recordset. Exactly one of the policies must be set.
+type RoutingPolicy struct {
+	// SetIdentifier distinguishes the recordsets with the same name and type which are routed by the policy.
+	SetIdentifier string `json:"setIdentifier"`
+	// Weighted routes traffic in proportion to the weights of the recordsets.
+	// +optional
+	Weighted *WeightedRoutingPolicy `json:"weighted,omitempty"`
+	// Latency routes traffic to the recordset of the region with the lowest latency.
+	// +optional
+	Latency *LatencyRoutingPolicy `json:"latency,omitempty"`
+	// Failover routes traffic to the primary recordset if it is healthy and to the secondary one otherwise.
+	// +optional
+	Failover *FailoverRoutingPolicy `json:"failover,omitempty"`
+	// Geolocation routes traffic based on the location of the clients.
+	// +optional
+	Geolocation *GeolocationRoutingPolicy `json:"geolocation,omitempty"`
+}
+
+// WeightedRoutingPolicy is a weighted routing policy.
+type WeightedRoutingPolicy struct {
+	// Weight is the weight of the recordset, between 0 and 255.
+	Weight int64 `json:"weight"`
+}
+
+// LatencyRoutingPolicy is a latency-based routing policy.
+type LatencyRoutingPolicy struct {
+	// Region is the AWS region of the resources the recordset points to.
+	Region string `json:"region"`
+}
+
+// FailoverRoutingPolicy is a failover routing policy.
+type FailoverRoutingPolicy struct {
+	// Role is the role of the recordset, either PRIMARY or SECONDARY.
+	Role FailoverRole `json:"role"`
+}
+
+// FailoverRole is the role of a recordset with failover routing.
+type FailoverRole string
+
+const (
+	// FailoverRolePrimary is the role of the recordset which is used if it is healthy.
+	FailoverRolePrimary FailoverRole = "PRIMARY"
+	// FailoverRoleSecondary is the role of the recordset which is used if the primary one is unhealthy.
+	FailoverRoleSecondary FailoverRole = "SECONDARY"
+)
+
+// GeolocationRoutingPolicy is a geolocation routing policy. Either a continent or a country must be set, the country
+// "*" is the default location for clients which do not match any other location.
+type GeolocationRoutingPolicy struct {
+	// ContinentCode is the two-letter code of the continent, e.g. EU.
+	// +optional
+	ContinentCode *string `json:"continentCode,omitempty"`
+	// CountryCode is the two-letter ISO 3166 code of the country, e.g. DE.
+	// +optional
+	CountryCode *string `json:"countryCode,omitempty"`
+	// SubdivisionCode is the code of a subdivision of the country, which is only supported for the United States,
+	// e.g. CA.
+	// +optional
+	SubdivisionCode *string `json:"subdivisionCode,omitempty"`
+}
@@ -45,6 +45,107 @@ type InfrastructureConfig struct {
 	// for details of the underlying terraform implementation.
 	// +optional
 	IgnoreTags *IgnoreTags `json:"ignoreTags,omitempty"`
+
+	// Tags are additional tags (e.g. for cost allocation) which are added to all AWS resources created for the shoot,
+	// including the machines, volumes and load balancers.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// VPCFlowLogs contains configuration for publishing flow logs of the VPC.
+	// +optional
+	VPCFlowLogs *VPCFlowLogs `json:"vpcFlowLogs,omitempty"`
+
+	// IAM contains configuration for the IAM resources of the nodes.
+	// +optional
+	IAM *IAMConfig `json:"iam,omitempty"`
+
+	// Endpoints contains overrides for the AWS partition and the AWS service endpoints which are used for managing
+	// the infrastructure.
+	// +optional
+	Endpoints *Endpoints `json:"endpoints,omitempty"`
+
+	// ElasticFileSystem contains configuration for an EFS file system which is created for the shoot.
+	// +optional
+	ElasticFileSystem *ElasticFileSystemConfig `json:"elasticFileSystem,omitempty"`
+
+	// LoadBalancerAccessLogs contains configuration for an S3 bucket which is created for the access logs of the
+	// load balancers of the shoot.
+	// +optional
+	LoadBalancerAccessLogs *LoadBalancerAccessLogsConfig `json:"loadBalancerAccessLogs,omitempty"`
+
+	// EgressPrefixList contains configuration for a managed prefix list which is created for the egress IPs of the
+	// shoot.
+	// +optional
+	EgressPrefixList *EgressPrefixListConfig `json:"egressPrefixList,omitempty"`
+
+	// Inventory contains configuration for tagging the AWS resources created for the shoot for compliance scanners and
+	// for publishing an inventory of them.
+	// +optional
+	Inventory *InventoryConfig `json:"inventory,omitempty"`
+}
+
+// InventoryConfig contains configuration for the compliance tagging and the inventory of the AWS resources of the shoot.
+type InventoryConfig struct {
+	// Enabled controls whether the AWS resources created for the shoot are tagged with `gardener.cloud/shoot` (the
+	// technical ID of the shoot) and whether an inventory of them is published after each reconciliation.
+	Enabled bool `json:"enabled"`
+	// S3 is the S3 location the inventory is written to. If it is not set, the inventory is written to the ConfigMap
+	// `<infrastructure-name>-inventory` in the namespace of the shoot in the seed.
+	// +optional
+	S3 *InventoryS3Location `json:"s3,omitempty"`
+}
+
+// InventoryS3Location is an S3 location for the inventory of the AWS resources of the shoot.
+type InventoryS3Location struct {
+	// Bucket is the name of an existing S3 bucket the credentials of the shoot are allowed to write to.
+	Bucket string `json:"bucket"`
+	// Prefix is the key prefix of the inventory object, the inventory is written to `<prefix><technical-id>.json`.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+}
+
+// EgressPrefixListConfig contains configuration for a managed prefix list with the egress IPs of the shoot.
+type EgressPrefixListConfig struct {
+	// Enabled controls whether a customer-managed prefix list is created and kept up to date with the public IPs of
+	// the NAT gateways and NAT instances of all zones.
+	Enabled bool `json:"enabled"`
+	// IncludeNodesCIDRs controls whether the CIDRs of the nodes subnets of all zones are added to the prefix list, too.
+	// +optional
+	IncludeNodesCIDRs bool `json:"includeNodesCIDRs,omitempty"`
+}
+
+// EgressPrefixListStatus contains information about the created managed prefix list with the egress IPs of the shoot.
+type EgressPrefixListStatus struct {
+	// ID is the ID of the managed prefix list.
+	ID string `json:"id"`
+	// ARN is the ARN of the managed prefix list, which can be used to share it with other accounts.
+	ARN string `json:"arn"`
+}
+
+// LoadBalancerAccessLogsConfig contains configuration for an S3 bucket for the access logs of load balancers.
+type LoadBalancerAccessLogsConfig struct {
+	// Enabled controls whether an encrypted S3 bucket is created with a bucket policy allowing the Elastic Load
+	// Balancing service to deliver access logs of classic and network load balancers.
+	Enabled bool `json:"enabled"`
+}
+
+// LoadBalancerAccessLogsStatus contains information about the created S3 bucket for the access logs of load balancers.
+type LoadBalancerAccessLogsStatus struct {
+	// BucketName is the name of the S3 bucket.
+	BucketName string `json:"bucketName"`
+}
+
+// ElasticFileSystemConfig contains configuration for an EFS file system which is created for the shoot.
+type ElasticFileSystemConfig struct {
+	// Enabled controls whether an encrypted EFS file system with mount targets in the nodes subnets of all zones is
+	// created.
+	Enabled bool `json:"enabled"`
+}
+
+// ElasticFileSystemStatus contains information about the created EFS file system.
+type ElasticFileSystemStatus struct {
+	// ID is the ID of the EFS file system.
+	ID string `json:"id"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -58,6 +159,15 @@ type InfrastructureStatus struct {
 	IAM IAM `json:"iam"`
 	// VPC contains information about the created AWS VPC and some related resources.
 	VPC VPCStatus `json:"vpc"`
+	// ElasticFileSystem contains information about the created EFS file system.
+	// +optional
+	ElasticFileSystem *ElasticFileSystemStatus `json:"elasticFileSystem,omitempty"`
+	// LoadBalancerAccessLogs contains information about the created S3 bucket for the access logs of load balancers.
+	// +optional
+	LoadBalancerAccessLogs *LoadBalancerAccessLogsStatus `json:"loadBalancerAccessLogs,omitempty"`
+	// EgressPrefixList contains information about the created managed prefix list with the egress IPs.
+	// +optional
+	EgressPrefixList *EgressPrefixListStatus `json:"egressPrefixList,omitempty"`
 }
 
 // Networks holds information about the Kubernetes and infrastructure networks.
@@ -66,6 +176,303 @@ type Networks struct {
 	VPC VPC `json:"vpc"`
 	// Zones belonging to the same region
 	Zones []Zone `json:"zones"`
+	// TransitGateway contains configuration for attaching the VPC to an existing transit gateway.
+	// +optional
+	TransitGateway *TransitGateway `json:"transitGateway,omitempty"`
+	// IPFamilies are the IP families of the infrastructure networks. If `IPv6` is contained, an IPv6 CIDR block is
+	// assigned to the VPC and to all subnets (dual-stack). IPv6-only networks are not supported.
+	// If not set, `dualStack.enabled` determines whether IPv6 is used in addition to IPv4.
+	// +optional
+	IPFamilies []IPFamily `json:"ipFamilies,omitempty"`
+	// NATGateway contains configuration for the NAT gateways of the zones.
+	// +optional
+	NATGateway *NATGateway `json:"natGateway,omitempty"`
+	// AdditionalNodeSecurityGroupRules are additional rules which are added to the security group of the nodes.
+	// +optional
+	AdditionalNodeSecurityGroupRules []NodeSecurityGroupRule `json:"additionalNodeSecurityGroupRules,omitempty"`
+	// VPCPeerings are peering connections of the VPC to other VPCs.
+	// +optional
+	VPCPeerings []VPCPeering `json:"vpcPeerings,omitempty"`
+	// NetworkACLs contains network ACLs for the subnets of the zones by the role of the subnets. Subnets of roles
+	// without network ACL use the default network ACL of the VPC.
+	// +optional
+	NetworkACLs *NetworkACLs `json:"networkACLs,omitempty"`
+	// Route53Resolver contains configuration for Route 53 Resolver endpoints and rules of the VPC.
+	// +optional
+	Route53Resolver *Route53Resolver `json:"route53Resolver,omitempty"`
+}
+
+// IPFamily is the IP family of a network.
+type IPFamily string
+
+const (
+	// IPFamilyIPv4 is the IPv4 IP family.
+	IPFamilyIPv4 IPFamily = "IPv4"
+	// IPFamilyIPv6 is the IPv6 IP family.
+	IPFamilyIPv6 IPFamily = "IPv6"
+)
+
+// NATGateway contains configuration for the NAT gateways of the zones.
+type NATGateway struct {
+	// Mode is the NAT gateway mode. Defaults to `perZone`.
+	// +optional
+	Mode *NATGatewayMode `json:"mode,omitempty"`
+	// Type is the type of the NAT gateways. Defaults to `gateway`.
+	// +optional
+	Type *NATGatewayType `json:"type,omitempty"`
+	// Instance contains configuration for NAT instances. It is required if the type is `instance`.
+	// +optional
+	Instance *NATInstance `json:"instance,omitempty"`
+	// ElasticIPPool contains configuration for a pool of elastic IPs which are allocated for the shoot and used by the
+	// NAT gateways of the zones without `elasticIPAllocationID`.
+	// +optional
+	ElasticIPPool *ElasticIPPool `json:"elasticIPPool,omitempty"`
+}
+
+// ElasticIPPool contains configuration for a pool of elastic IPs which are retained for the lifetime of the shoot.
+type ElasticIPPool struct {
+	// Size is the number of elastic IPs in the pool. It must not be smaller than the number of NAT gateways using
+	// the pool and can't be decreased.
+	Size int32 `json:"size"`
+	// RetainOnDeletion controls whether the elastic IPs are kept in the AWS account when the shoot is deleted.
+	// +optional
+	RetainOnDeletion bool `json:"retainOnDeletion,omitempty"`
+}
+
+// NATGatewayMode is the mode for the NAT gateways of the zones.
+type NATGatewayMode string
+
+const (
+	// NATGatewayModePerZone creates a dedicated NAT gateway in every zone.
+	NATGatewayModePerZone NATGatewayMode = "perZone"
+	// NATGatewayModeSingle creates a single NAT gateway in the first zone which is used by all zones.
+	NATGatewayModeSingle NATGatewayMode = "single"
+	// NATGatewayModeNone creates no NAT gateways at all, i.e. there is no default route for the private subnets.
+	NATGatewayModeNone NATGatewayMode = "none"
+)
+
+// NATGatewayType is the type of the NAT gateways of the zones.
+type NATGatewayType string
+
+const (
+	// NATGatewayTypeGateway uses AWS managed NAT gateways.
+	NATGatewayTypeGateway NATGatewayType = "gateway"
+	// NATGatewayTypeInstance uses self-managed NAT instances, each run by an auto scaling group of size one.
+	NATGatewayTypeInstance NATGatewayType = "instance"
+)
+
+// NATInstance contains configuration for NAT instances.
+type NATInstance struct {
+	// InstanceType is the EC2 instance type of the NAT instances (e.g. `t3.small`).
+	InstanceType string `json:"instanceType"`
+	// AMI is the id of the machine image of the NAT instances (e.g. `ami-123456`). The image must support cloud-init
+	// and provide iptables, curl and the AWS CLI.
+	AMI string `json:"ami"`
+}
+
+// TransitGateway contains configuration for attaching the VPC to an existing transit gateway.
+type TransitGateway struct {
+	// ID is the id of the existing transit gateway (e.g. `tgw-123456`).
+	ID string `json:"id"`
+	// Routes is a list of destination CIDRs which are routed via the transit gateway from the private subnets
+	// (workers and internal) of all zones.
+	// +optional
+	Routes []string `json:"routes,omitempty"`
+}
+
+// VPCPeering contains configuration for a peering connection of the VPC to another VPC.
+type VPCPeering struct {
+	// PeerVPCID is the id of the VPC to peer with (e.g. `vpc-123456`).
+	PeerVPCID string `json:"peerVPCID"`
+	// PeerAccountID is the id of the AWS account owning the peer VPC. If not set, the account of the shoot is used.
+	// +optional
+	PeerAccountID *string `json:"peerAccountID,omitempty"`
+	// PeerRegion is the region of the peer VPC. If not set, the region of the shoot is used.
+	// +optional
+	PeerRegion *string `json:"peerRegion,omitempty"`
+	// Routes is a list of destination CIDRs which are routed via the peering connection from the private subnets
+	// (workers and internal) of all zones.
+	Routes []string `json:"routes"`
+}
+
+// Route53Resolver contains configuration for Route 53 Resolver endpoints and rules of the VPC.
+type Route53Resolver struct {
+	// InboundEndpoint configures an inbound endpoint, which allows connected networks to resolve DNS names of the VPC.
+	// +optional
+	InboundEndpoint *Route53ResolverInboundEndpoint `json:"inboundEndpoint,omitempty"`
+	// ForwardingRules are rules forwarding DNS queries for domains to other DNS servers. They are served by an outbound
+	// endpoint, which is created if at least one rule is configured.
+	// +optional
+	ForwardingRules []Route53ResolverForwardingRule `json:"forwardingRules,omitempty"`
+	// RuleIDs are the ids of existing resolver rules (e.g. shared via AWS RAM) which are associated with the VPC.
+	// +optional
+	RuleIDs []string `json:"ruleIDs,omitempty"`
+}
+
+// Route53ResolverInboundEndpoint contains configuration for the inbound endpoint of the VPC.
+type Route53ResolverInboundEndpoint struct {
+	// AllowedCIDRs are the IPv4 CIDR blocks which are allowed to send DNS queries to the inbound endpoint.
+	AllowedCIDRs []string `json:"allowedCIDRs"`
+}
+
+// Route53ResolverForwardingRule is a rule forwarding DNS queries for a domain to other DNS servers.
+type Route53ResolverForwardingRule struct {
+	// DomainName is the domain name whose DNS queries are forwarded, including its subdomains.
+	DomainName string `json:"domainName"`
+	// TargetIPs are the IPv4 addresses of the DNS servers the queries are forwarded to on port 53.
+	TargetIPs []string `json:"targetIPs"`
+}
+
+// NetworkACLs contains the network ACLs for the subnets of the zones by the role of the subnets.
+type NetworkACLs struct {
+	// Workers is the network ACL for the `workers` subnets and the dedicated `pods` subnets.
+	// +optional
+	Workers *NetworkACL `json:"workers,omitempty"`
+	// Public is the network ACL for the `public` subnets.
+	// +optional
+	Public *NetworkACL `json:"public,omitempty"`
+	// Internal is the network ACL for the `internal` subnets.
+	// +optional
+	Internal *NetworkACL `json:"internal,omitempty"`
+}
+
+// NetworkACL contains the rules of a network ACL. Traffic which is not allowed by any rule is denied.
+type NetworkACL struct {
+	// Rules are the rules of the network ACL.
+	// +optional
+	Rules []NetworkACLRule `json:"rules,omitempty"`
+}
+
+// NetworkACLRule is a rule of a network ACL.
+type NetworkACLRule struct {
+	// RuleNumber is the number of the rule. Rules are evaluated in increasing order of their numbers, per type.
+	RuleNumber int32 `json:"ruleNumber"`
+	// Type is the type of the rule, either `ingress` or `egress`.
+	Type NetworkACLRuleType `json:"type"`
+	// Action is the action of the rule, either `allow` or `deny`.
+	Action NetworkACLRuleAction `json:"action"`
+	// Protocol is the IP protocol of the rule, i.e. `tcp`, `udp`, `icmp` or `-1` for all protocols.
+	Protocol string `json:"protocol"`
+	// FromPort is the start of the port range for `tcp` and `udp`, or the ICMP type for `icmp` (`-1` for all types).
+	// It must not be set for protocol `-1`.
+	// +optional
+	FromPort *int64 `json:"fromPort,omitempty"`
+	// ToPort is the end of the port range for `tcp` and `udp`, or the ICMP code for `icmp` (`-1` for all codes).
+	// It must not be set for protocol `-1`.
+	// +optional
+	ToPort *int64 `json:"toPort,omitempty"`
+	// CIDRBlock is the IPv4 or IPv6 CIDR block the rule applies to.
+	CIDRBlock string `json:"cidrBlock"`
+}
+
+// NetworkACLRuleType is the type of a rule of a network ACL.
+type NetworkACLRuleType string
+
+const (
+	// NetworkACLRuleTypeIngress is the type for rules matching incoming traffic.
+	NetworkACLRuleTypeIngress NetworkACLRuleType = "ingress"
+	// NetworkACLRuleTypeEgress is the type for rules matching outgoing traffic.
+	NetworkACLRuleTypeEgress NetworkACLRuleType = "egress"
+)
+
+// NetworkACLRuleAction is the action of a rule of a network ACL.
+type NetworkACLRuleAction string
+
+const (
+	// NetworkACLRuleActionAllow is the action for allowing the matched traffic.
+	NetworkACLRuleActionAllow NetworkACLRuleAction = "allow"
+	// NetworkACLRuleActionDeny is the action for denying the matched traffic.
+	NetworkACLRuleActionDeny NetworkACLRuleAction = "deny"
+)
+
+// NodeSecurityGroupRule is an additional rule for the security group of the nodes.
+type NodeSecurityGroupRule struct {
+	// Type is the type of the rule, either `ingress` or `egress`.
+	Type NodeSecurityGroupRuleType `json:"type"`
+	// Protocol is the IP protocol of the rule, i.e. `tcp`, `udp`, `icmp` or `-1` for all protocols.
+	Protocol string `json:"protocol"`
+	// FromPort is the start of the port range for `tcp` and `udp`, or the ICMP type for `icmp` (`-1` for all types).
+	// It must not be set for protocol `-1`.
+	// +optional
+	FromPort *int64 `json:"fromPort,omitempty"`
+	// ToPort is the end of the port range for `tcp` and `udp`, or the ICMP code for `icmp` (`-1` for all codes).
+	// It must not be set for protocol `-1`.
+	// +optional
+	ToPort *int64 `json:"toPort,omitempty"`
+	// CIDRBlocks are the IPv4 CIDR blocks the rule applies to.
+	// +optional
+	CIDRBlocks []string `json:"cidrBlocks,omitempty"`
+	// SecurityGroupIDs are the ids of the security groups the rule applies to. The security groups must be
+	// in the same VPC or in a peered VPC.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIDs,omitempty"`
+}
+
+// NodeSecurityGroupRuleType is the type of a rule of the nodes security group.
+type NodeSecurityGroupRuleType string
+
+const (
+	// NodeSecurityGroupRuleTypeIngress is the type for rules allowing incoming traffic.
+	NodeSecurityGroupRuleTypeIngress NodeSecurityGroupRuleType = "ingress"
+	// NodeSecurityGroupRuleTypeEgress is the type for rules allowing outgoing traffic.
+	NodeSecurityGroupRuleTypeEgress NodeSecurityGroupRuleType = "egress"
+)
+
+// IAMConfig contains configuration for the IAM resources of the nodes.
+type IAMConfig struct {
+	// NodesInstanceProfile references an existing IAM instance profile which is used for the nodes instead of the
+	// instance profile, role and role policy which are otherwise created for them. The instance profile must have a
+	// role which allows at least `ec2:DescribeInstances`. This field is immutable.
+	// +optional
+	NodesInstanceProfile *IAMInstanceProfile `json:"nodesInstanceProfile,omitempty"`
+}
+
+// VPCFlowLogs contains configuration for publishing flow logs of the VPC.
+type VPCFlowLogs struct {
+	// Destination is the destination the flow logs are published to.
+	Destination VPCFlowLogsDestination `json:"destination"`
+	// TrafficType is the type of traffic to log. Can be `ACCEPT`, `REJECT` or `ALL`. Defaults to `ALL`.
+	// +optional
+	TrafficType *string `json:"trafficType,omitempty"`
+	// MaxAggregationInterval is the maximum interval of time in seconds during which a flow of packets is captured and
+	// aggregated into a flow log record. Can be `60` or `600`. Defaults to `600`.
+	// +optional
+	MaxAggregationInterval *int64 `json:"maxAggregationInterval,omitempty"`
+}
+
+// VPCFlowLogsDestination is the destination of the VPC flow logs. Exactly one of the fields must be set.
+type VPCFlowLogsDestination struct {
+	// CloudWatchLogs publishes the flow logs to a CloudWatch Logs log group managed by the infrastructure controller.
+	// +optional
+	CloudWatchLogs *VPCFlowLogsCloudWatchLogs `json:"cloudWatchLogs,omitempty"`
+	// S3 publishes the flow logs to an existing S3 bucket.
+	// +optional
+	S3 *VPCFlowLogsS3 `json:"s3,omitempty"`
+}
+
+// VPCFlowLogsCloudWatchLogs contains configuration for publishing VPC flow logs to CloudWatch Logs.
+type VPCFlowLogsCloudWatchLogs struct {
+	// KMSKeyARN is the ARN of an existing KMS key used to encrypt the log group.
+	// +optional
+	KMSKeyARN *string `json:"kmsKeyARN,omitempty"`
+}
+
+// VPCFlowLogsS3 contains configuration for publishing VPC flow logs to S3.
+type VPCFlowLogsS3 struct {
+	// BucketARN is the ARN of the existing S3 bucket, optionally followed by a folder
+	// (e.g. `arn:aws:s3:::my-bucket/my-folder/`). Server-side encryption is configured on the bucket.
+	BucketARN string `json:"bucketARN"`
+}
+
+// Endpoints contains overrides for the AWS partition and the AWS service endpoints.
+type Endpoints struct {
+	// Partition is the AWS partition, e.g. `aws-cn` or `aws-us-gov`. If not set, it is derived from the region.
+	// +optional
+	Partition *string `json:"partition,omitempty"`
+	// Services maps AWS service identifiers (e.g. `ec2`, `iam` or `s3`) to custom endpoint URLs which are used
+	// instead of the default endpoints of the region.
+	// +optional
+	Services map[string]string `json:"services,omitempty"`
 }
 
 // IgnoreTags holds information about ignored resource tags.
@@ -82,6 +489,17 @@ type IgnoreTags struct {
 type Zone struct {
 	// Name is the name for this zone.
 	Name string `json:"name"`
+	// ZoneID is the ID of the availability zone (e.g. `use1-az1`). Zone names are mapped to physical zones differently
+	// in each AWS account, whereas zone IDs identify the same physical zone in all accounts. If it is set, the zone name
+	// must be mapped to this zone ID in the account of the shoot. This pins the zone to a physical zone, e.g. to keep the
+	// latency to resources in other accounts low.
+	// +optional
+	ZoneID *string `json:"zoneID,omitempty"`
+	// Type is the type of the zone. Defaults to `availability-zone`.
+	// Local Zones and Wavelength Zones don't get NAT gateways of their own. The private subnets of Local Zones use the
+	// NAT gateway of the first zone, the subnets of Wavelength Zones route egress traffic via a carrier gateway.
+	// +optional
+	Type *ZoneType `json:"type,omitempty"`
 	// Internal is the private subnet range to create (used for internal load balancers).
 	Internal string `json:"internal"`
 	// Public is the public subnet range to create (used for bastion and load balancers).
@@ -96,8 +514,39 @@ type Zone struct {
 	// disrupt egress traffic for a while.
 	// +optional
 	ElasticIPAllocationID *string `json:"elasticIPAllocationID,omitempty"`
+	// Pods is the range of an optional dedicated subnet to create for pod IPs (e.g. for the custom networking of the
+	// AWS VPC CNI). It must be contained in the VPC CIDR or in one of the secondary CIDR blocks of the VPC.
+	// +optional
+	Pods *string `json:"pods,omitempty"`
+	// SecondaryWorkers is the range of an optional secondary workers subnet, which can be added to a zone whose workers
+	// subnet runs out of IP addresses, as AWS subnets can't be resized. New machines of the zone are placed in this
+	// subnet. It must be contained in the VPC CIDR or in one of the secondary CIDR blocks of the VPC.
+	// +optional
+	SecondaryWorkers *string `json:"secondaryWorkers,omitempty"`
+	// OutpostARN is the ARN of an AWS Outpost whose parent availability zone is this zone. If it is set, the workers
+	// subnet (and the pods subnet, if configured) of the zone is created on the Outpost, so that the machines of the
+	// worker pools in this zone are placed on the Outpost racks. The public and internal subnets stay in the region.
+	// +optional
+	OutpostARN *string `json:"outpostARN,omitempty"`
+	// RouteTableID is the id of an existing route table of the VPC given by `networks.vpc.id`, which is used for the
+	// private subnets of the zone instead of creating one. Only the routes added by the extension are managed.
+	// +optional
+	RouteTableID *string `json:"routeTableID,omitempty"`
 }
 
+// ZoneType is the type of a zone.
+type ZoneType string
+
+const (
+	// ZoneTypeAvailabilityZone is a regular availability zone of the region.
+	ZoneTypeAvailabilityZone ZoneType = "availability-zone"
+	// ZoneTypeLocalZone is an AWS Local Zone, i.e. an extension of the region close to a metropolitan area.
+	ZoneTypeLocalZone ZoneType = "local-zone"
+	// ZoneTypeWavelengthZone is an AWS Wavelength Zone, i.e. an extension of the region into the 5G network of a
+	// telecommunication carrier.
+	ZoneTypeWavelengthZone ZoneType = "wavelength-zone"
+)
+
 // EC2 contains information about the  AWS EC2 resources.
 type EC2 struct {
 	// KeyName is the name of the SSH key.
@@ -120,11 +569,67 @@ type VPC struct {
 	// CIDR is the VPC CIDR.
 	// +optional
 	CIDR *string `json:"cidr,omitempty"`
+	// SecondaryCidrBlocks is a list of additional IPv4 CIDR blocks to associate with the VPC, e.g. to provide
+	// address space for large pod networks.
+	// +optional
+	SecondaryCidrBlocks []string `json:"secondaryCidrBlocks,omitempty"`
 	// GatewayEndpoints service names to configure as gateway endpoints in the VPC.
 	// +optional
 	GatewayEndpoints []string `json:"gatewayEndpoints,omitempty"`
+	// Endpoints is a list of gateway and interface endpoints to configure in the VPC.
+	// +optional
+	Endpoints []VPCEndpoint `json:"endpoints,omitempty"`
+	// DHCPOptions contains custom DHCP options of a VPC which is created for the shoot.
+	// +optional
+	DHCPOptions *DHCPOptions `json:"dhcpOptions,omitempty"`
+	// InternetGatewayID is the id of the internet gateway attached to the VPC given by `id`. If not set, the internet
+	// gateway attached to the VPC is looked up.
+	// +optional
+	InternetGatewayID *string `json:"internetGatewayID,omitempty"`
+	// MainRouteTableID is the id of an existing route table of the VPC given by `id`, which is used for the public
+	// subnets instead of creating one. Only the routes added by the extension are managed.
+	// +optional
+	MainRouteTableID *string `json:"mainRouteTableID,omitempty"`
+	// Shared indicates that the VPC given by `id` is owned by another AWS account and that its subnets are shared with
+	// the account of the shoot via AWS Resource Access Manager (RAM). The subnets of the zones are looked up by their
+	// CIDRs instead of being created, and no internet gateway, NAT gateways or route tables are managed.
+	// +optional
+	Shared bool `json:"shared,omitempty"`
+}
+
+// DHCPOptions contains custom DHCP options of a VPC.
+type DHCPOptions struct {
+	// DomainName is the domain name of the instances. If not set, the default domain name of the region is used, e.g.
+	// `eu-west-1.compute.internal`.
+	// +optional
+	DomainName *string `json:"domainName,omitempty"`
+	// DomainNameServers are up to four IP addresses of DNS servers. If not set, the Amazon provided DNS server
+	// (`AmazonProvidedDNS`) is used.
+	// +optional
+	DomainNameServers []string `json:"domainNameServers,omitempty"`
+	// NTPServers are up to four IP addresses of NTP servers.
+	// +optional
+	NTPServers []string `json:"ntpServers,omitempty"`
 }
 
+// VPCEndpoint describes a VPC endpoint for an AWS service.
+type VPCEndpoint struct {
+	// Service is the service name without the `com.amazonaws.<region>.` prefix, e.g. `s3` or `ecr.api`.
+	Service string `json:"service"`
+	// Type is the type of the VPC endpoint.
+	Type VPCEndpointType `json:"type"`
+}
+
+// VPCEndpointType is the type of a VPC endpoint.
+type VPCEndpointType string
+
+const (
+	// VPCEndpointTypeGateway is the type for gateway endpoints, which are added as route to the route tables of the zones.
+	VPCEndpointTypeGateway VPCEndpointType = "Gateway"
+	// VPCEndpointTypeInterface is the type for interface endpoints, which are placed into the nodes subnets.
+	VPCEndpointTypeInterface VPCEndpointType = "Interface"
+)
+
 // VPCStatus contains information about a generated VPC or resources inside an existing VPC.
 type VPCStatus struct {
 	// ID is the VPC id.
@@ -133,6 +638,109 @@ type VPCStatus struct {
 	Subnets []Subnet `json:"subnets"`
 	// SecurityGroups is a list of security groups that have been created.
 	SecurityGroups []SecurityGroup `json:"securityGroups"`
+	// TransitGatewayAttachmentID is the id of the transit gateway VPC attachment.
+	// +optional
+	TransitGatewayAttachmentID *string `json:"transitGatewayAttachmentID,omitempty"`
+	// IPv6CIDR is the IPv6 CIDR block assigned to the VPC (only set for dual-stack).
+	// +optional
+	IPv6CIDR *string `json:"ipv6CIDR,omitempty"`
+	// Endpoints is a list of VPC endpoints that have been created.
+	// +optional
+	Endpoints []VPCEndpointStatus `json:"endpoints,omitempty"`
+	// Peerings is a list of VPC peering connections that have been created.
+	// +optional
+	Peerings []VPCPeeringStatus `json:"peerings,omitempty"`
+	// Route53Resolver contains information about the created Route 53 Resolver endpoints.
+	// +optional
+	Route53Resolver *Route53ResolverStatus `json:"route53Resolver,omitempty"`
+	// InternetGatewayID is the id of the internet gateway of the VPC.
+	// +optional
+	InternetGatewayID *string `json:"internetGatewayID,omitempty"`
+	// EgressOnlyInternetGatewayID is the id of the egress-only internet gateway of the VPC (only set for dual-stack).
+	// +optional
+	EgressOnlyInternetGatewayID *string `json:"egressOnlyInternetGatewayID,omitempty"`
+	// NATGateways is a list of the NAT gateways of the zones.
+	// +optional
+	NATGateways []NATGatewayStatus `json:"natGateways,omitempty"`
+	// RouteTables is a list of route tables that have been created.
+	// +optional
+	RouteTables []RouteTableStatus `json:"routeTables,omitempty"`
+	// ElasticIPPool is a list of the elastic IPs of the elastic IP pool.
+	// +optional
+	ElasticIPPool []ElasticIPPoolAddress `json:"elasticIPPool,omitempty"`
+}
+
+// ElasticIPPoolAddress contains information about an elastic IP of the elastic IP pool.
+type ElasticIPPoolAddress struct {
+	// AllocationID is the allocation id of the elastic IP.
+	AllocationID string `json:"allocationID"`
+	// PublicIP is the public IP address.
+	PublicIP string `json:"publicIP"`
+	// Zone is the zone whose NAT gateway uses the elastic IP (not set for unused elastic IPs).
+	// +optional
+	Zone *string `json:"zone,omitempty"`
+}
+
+// NATGatewayStatus contains information about the NAT gateway of a zone.
+type NATGatewayStatus struct {
+	// Zone is the availability zone of the NAT gateway.
+	Zone string `json:"zone"`
+	// ID is the NAT gateway id.
+	ID string `json:"id"`
+	// ElasticIPAllocationID is the allocation id of the elastic IP address of the NAT gateway.
+	// +optional
+	ElasticIPAllocationID *string `json:"elasticIPAllocationID,omitempty"`
+	// PublicIP is the public IP address of the NAT gateway.
+	// +optional
+	PublicIP *string `json:"publicIP,omitempty"`
+}
+
+// RouteTableStatus contains information about a created route table.
+type RouteTableStatus struct {
+	// Purpose is the purpose of the route table, i.e. `public` for the main route table used by the public subnets
+	// or `private` for the route table of a zone.
+	Purpose string `json:"purpose"`
+	// ID is the route table id.
+	ID string `json:"id"`
+	// Zone is the availability zone of the route table (only set for the route tables of zones).
+	// +optional
+	Zone *string `json:"zone,omitempty"`
+}
+
+// Route53ResolverStatus contains information about the created Route 53 Resolver endpoints.
+type Route53ResolverStatus struct {
+	// InboundEndpointID is the id of the inbound endpoint.
+	// +optional
+	InboundEndpointID *string `json:"inboundEndpointID,omitempty"`
+	// InboundEndpointIPs are the IP addresses of the inbound endpoint, which connected networks forward DNS queries to.
+	// +optional
+	InboundEndpointIPs []string `json:"inboundEndpointIPs,omitempty"`
+	// OutboundEndpointID is the id of the outbound endpoint.
+	// +optional
+	OutboundEndpointID *string `json:"outboundEndpointID,omitempty"`
+}
+
+// VPCPeeringStatus contains information about a created VPC peering connection.
+type VPCPeeringStatus struct {
+	// PeerVPCID is the id of the peer VPC.
+	PeerVPCID string `json:"peerVPCID"`
+	// ID is the VPC peering connection id.
+	ID string `json:"id"`
+	// State is the state of the VPC peering connection, e.g. `pending-acceptance` or `active`.
+	State string `json:"state"`
+}
+
+// VPCEndpointStatus contains information about a created VPC endpoint.
+type VPCEndpointStatus struct {
+	// Service is the service name of the VPC endpoint.
+	Service string `json:"service"`
+	// Type is the type of the VPC endpoint.
+	Type VPCEndpointType `json:"type"`
+	// ID is the VPC endpoint id.
+	ID string `json:"id"`
+	// PrefixListID is the id of the AWS-managed prefix list of the service (only set for gateway endpoints).
+	// +optional
+	PrefixListID *string `json:"prefixListID,omitempty"`
 }
 
 const (
@@ -142,6 +750,15 @@ const (
 	PurposePublic string = "public"
 	// PurposeInternal is a constant describing that the respective resource is used for internal load balancers.
 	PurposeInternal string = "internal"
+	// PurposePods is a constant describing that the respective resource is used for pod IPs.
+	PurposePods string = "pods"
+	// PurposeNodesSecondary is a constant describing that the respective resource is used for nodes in addition to the
+	// resource with purpose `nodes`.
+	PurposeNodesSecondary string = "nodes-secondary"
+	// PurposePrivate is a constant describing that the respective resource is used for the private subnets of a zone.
+	PurposePrivate string = "private"
+	// PurposeVPCFlowLogs is a constant describing that the respective resource is used for publishing VPC flow logs.
+	PurposeVPCFlowLogs string = "vpc-flow-logs"
 )
 
 // InstanceProfile is an AWS IAM instance profile.
@@ -168,6 +785,9 @@ type Subnet struct {
 	ID string `json:"id"`
 	// Zone is the availability zone into which the subnet has been created.
 	Zone string `json:"zone"`
+	// IPv6CIDR is the IPv6 CIDR block assigned to the subnet (only set for dual-stack).
+	// +optional
+	IPv6CIDR *string `json:"ipv6CIDR,omitempty"`
 }
 
 // SecurityGroup is an AWS security group related to a VPC.
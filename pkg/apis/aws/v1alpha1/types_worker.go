@@ -16,7 +16,9 @@ package v1alpha1
 
 import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // +genclient
@@ -41,6 +43,108 @@ type WorkerConfig struct {
 	IAMInstanceProfile *IAMInstanceProfile `json:"iamInstanceProfile,omitempty"`
 	// InstanceMetadataOptions contains configuration for controlling access to the metadata API.
 	InstanceMetadataOptions *InstanceMetadataOptions `json:"instanceMetadataOptions,omitempty"`
+	// AdditionalSecurityGroupIDs are the ids of existing security groups which are assigned to the machines of this
+	// worker pool in addition to the nodes security group of the shoot. They must belong to the VPC of the shoot.
+	// +optional
+	AdditionalSecurityGroupIDs []string `json:"additionalSecurityGroupIDs,omitempty"`
+	// CapacityType is the capacity type of the machines of this worker pool, either `onDemand` or `spot`.
+	// Defaults to `onDemand`.
+	// +optional
+	CapacityType *CapacityType `json:"capacityType,omitempty"`
+	// SpotMaxPrice is the maximum hourly price in USD which is paid for a spot instance (e.g. `0.05`). It is only
+	// allowed for capacity type `spot`. If not set, the on-demand price is used as maximum price.
+	// +optional
+	SpotMaxPrice *string `json:"spotMaxPrice,omitempty"`
+	// SpotInterruptionHandling contains configuration for the handling of interruption notices and rebalance
+	// recommendations of the spot instances of this worker pool. It is only allowed for capacity type `spot`.
+	// +optional
+	SpotInterruptionHandling *SpotInterruptionHandling `json:"spotInterruptionHandling,omitempty"`
+	// CapacityReservation contains configuration for launching the machines of this worker pool into capacity
+	// reservations.
+	// +optional
+	CapacityReservation *CapacityReservation `json:"capacityReservation,omitempty"`
+	// Tenancy is the tenancy of the machines of this worker pool, either `default` (shared hardware), `dedicated`
+	// (dedicated instances) or `host` (dedicated hosts). Defaults to `default`.
+	// +optional
+	Tenancy *Tenancy `json:"tenancy,omitempty"`
+	// HostResourceGroupArn is the ARN of the host resource group in which the machines of this worker pool are
+	// launched. It is only allowed for tenancy `host`.
+	// +optional
+	HostResourceGroupArn *string `json:"hostResourceGroupArn,omitempty"`
+	// PlacementGroup contains configuration for launching the machines of this worker pool into a placement group,
+	// which is managed by the worker controller.
+	// +optional
+	PlacementGroup *PlacementGroup `json:"placementGroup,omitempty"`
+	// NetworkInterfaces contains configuration for Elastic Fabric Adapters (EFA) and additional network interfaces of
+	// the machines of this worker pool, e.g. for machine learning training or HPC workloads.
+	// +optional
+	NetworkInterfaces *NetworkInterfaces `json:"networkInterfaces,omitempty"`
+	// DevicePlugin contains configuration for the device plugin of the accelerators of the machines of this worker pool.
+	// +optional
+	DevicePlugin *DevicePlugin `json:"devicePlugin,omitempty"`
+	// InstanceStorage contains configuration for using the NVMe instance store volumes of the machines as ephemeral
+	// storage of kubelet and containerd. It is only effective for machine types with instance store volumes.
+	// +optional
+	InstanceStorage *InstanceStorage `json:"instanceStorage,omitempty"`
+	// EnclaveOptions contains configuration for AWS Nitro Enclaves on the machines of this worker pool.
+	// +optional
+	EnclaveOptions *EnclaveOptions `json:"enclaveOptions,omitempty"`
+	// CPUOptions contains configuration for the processor of the machines of this worker pool.
+	// +optional
+	CPUOptions *CPUOptions `json:"cpuOptions,omitempty"`
+	// OutpostARN is the ARN of the AWS Outpost on which the machines of this worker pool are placed. All zones of the
+	// worker pool must be placed on this Outpost in the InfrastructureConfig, and the machine type must be supported by
+	// the Outpost.
+	// +optional
+	OutpostARN *string `json:"outpostARN,omitempty"`
+	// ImageSelector selects the AMI of the machines of this worker pool by owner, name and tags instead of using the
+	// AMI of the machine image in the CloudProfile. The newest matching AMI is used at the time of the reconciliation.
+	// +optional
+	ImageSelector *ImageSelector `json:"imageSelector,omitempty"`
+	// Monitoring contains configuration for the CloudWatch monitoring of the machines of this worker pool.
+	// +optional
+	Monitoring *Monitoring `json:"monitoring,omitempty"`
+	// SerialConsole contains configuration for the EC2 serial console of the machines of this worker pool.
+	// +optional
+	SerialConsole *SerialConsole `json:"serialConsole,omitempty"`
+	// Tags are additional tags which are added to the machines and volumes of this worker pool. They take precedence
+	// over the tags of the InfrastructureConfig.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+	// UserData contains custom cloud-init parts which are added to the user data of the machines of this worker pool.
+	// +optional
+	UserData *UserData `json:"userData,omitempty"`
+	// Containerd contains configuration for containerd on the machines of this worker pool.
+	// +optional
+	Containerd *ContainerdConfig `json:"containerd,omitempty"`
+	// Kubelet contains configuration for the kubelet on the machines of this worker pool.
+	// +optional
+	Kubelet *KubeletConfig `json:"kubelet,omitempty"`
+	// RollingUpdate contains configuration for rolling the machines of this worker pool when only launch settings change
+	// which don't belong to the worker pool, e.g. the AMI of its machine image version. Such changes are otherwise only
+	// applied to machines created afterwards.
+	// +optional
+	RollingUpdate *RollingUpdate `json:"rollingUpdate,omitempty"`
+	// PreProvisionedCapacity contains configuration for keeping initialized machines of this worker pool available for
+	// scale-ups.
+	// +optional
+	PreProvisionedCapacity *PreProvisionedCapacity `json:"preProvisionedCapacity,omitempty"`
+}
+
+// ImageSelector selects the newest AMI matching the given criteria.
+type ImageSelector struct {
+	// Owners are the account IDs or aliases (e.g. `self` or `amazon`) of the owners of the AMI.
+	Owners []string `json:"owners"`
+	// Name is a pattern for the name of the AMI, which may contain the wildcards `*` and `?`.
+	// +optional
+	Name *string `json:"name,omitempty"`
+	// Tags are the tags which the AMI must have.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+	// Architecture is the architecture of the AMI. Defaults to the architecture of the machine image of the worker
+	// pool.
+	// +optional
+	Architecture *string `json:"architecture,omitempty"`
 }
 
 // Volume contains configuration for the root disks attached to VMs.
@@ -67,6 +171,11 @@ type Volume struct {
 	//
 	// Valid Range: The range as of 16th Aug 2022 is from 125 MiB/s to 1000 MiB/s. For more info refer (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/EBSVolumeTypes.html)
 	Throughput *int64 `json:"throughput,omitempty"`
+
+	// KMSKeyID is the ID or ARN of the KMS key which is used to encrypt the volume. If not set, the default KMS key
+	// for EBS encryption of the AWS account is used. The volume must not be unencrypted.
+	// +optional
+	KMSKeyID *string `json:"kmsKeyID,omitempty"`
 }
 
 // DataVolume contains configuration for data volumes attached to VMs.
@@ -78,6 +187,10 @@ type DataVolume struct {
 	// SnapshotID is the ID of the snapshot.
 	// +optional
 	SnapshotID *string `json:"snapshotID,omitempty"`
+	// DeviceName is the device name under which the volume is exposed to the machine, e.g. `/dev/sdf`. If not set,
+	// the device names are assigned in the order of the data volume names.
+	// +optional
+	DeviceName *string `json:"deviceName,omitempty"`
 }
 
 // IAMInstanceProfile contains configuration for the IAM instance profile that should be used for the VMs of this
@@ -105,6 +218,10 @@ type WorkerStatus struct {
 	// reconciliation is possible.
 	// +optional
 	MachineImages []MachineImage `json:"machineImages,omitempty"`
+	// PlacementGroups are the names of the placement groups which have been created for the worker pools. They are
+	// deleted once they are no longer used by any worker pool.
+	// +optional
+	PlacementGroups []string `json:"placementGroups,omitempty"`
 }
 
 // MachineImage is a mapping from logical names and versions to provider-specific machine image data.
@@ -144,6 +261,284 @@ const (
 	HTTPTokensOptional HTTPTokensValue = "optional"
 )
 
+// CapacityReservation contains configuration for launching machines into capacity reservations. Either a preference
+// or a target (id or resource group ARN) can be specified.
+type CapacityReservation struct {
+	// ID is the id of the capacity reservation the machines are launched into (e.g. `cr-123456`).
+	// +optional
+	ID *string `json:"id,omitempty"`
+	// ResourceGroupArn is the ARN of a resource group of capacity reservations the machines are launched into.
+	// +optional
+	ResourceGroupArn *string `json:"resourceGroupArn,omitempty"`
+	// Preference is the preference for launching into capacity reservations if no target is specified, either `open`
+	// (launch into any open capacity reservation with matching attributes) or `none`.
+	// +optional
+	Preference *CapacityReservationPreference `json:"preference,omitempty"`
+}
+
+// CapacityReservationPreference is the preference for launching machines into capacity reservations.
+type CapacityReservationPreference string
+
+const (
+	// CapacityReservationPreferenceOpen launches machines into any open capacity reservation with matching attributes.
+	CapacityReservationPreferenceOpen CapacityReservationPreference = "open"
+	// CapacityReservationPreferenceNone avoids launching machines into capacity reservations.
+	CapacityReservationPreferenceNone CapacityReservationPreference = "none"
+)
+
+// EnclaveOptions contains configuration for AWS Nitro Enclaves.
+type EnclaveOptions struct {
+	// Enabled defines whether the machines are enabled for AWS Nitro Enclaves.
+	Enabled bool `json:"enabled"`
+}
+
+// Monitoring contains configuration for the CloudWatch monitoring of machines.
+type Monitoring struct {
+	// Detailed defines whether detailed monitoring is enabled for the machines, i.e. CloudWatch metrics are published
+	// in 1-minute instead of 5-minute periods. Detailed monitoring is charged by AWS.
+	Detailed bool `json:"detailed"`
+}
+
+// SerialConsole contains configuration for the EC2 serial console of machines.
+type SerialConsole struct {
+	// Enabled defines whether the access to the EC2 serial console is enabled for the region of the shoot. As this is
+	// a setting of the AWS account, it is only enabled but never disabled by the worker controller.
+	Enabled bool `json:"enabled"`
+}
+
+// ContainerdConfig contains configuration for containerd on machines.
+type ContainerdConfig struct {
+	// RegistryMirrors are mirrors of container registries which containerd uses to pull images.
+	// +optional
+	RegistryMirrors []RegistryMirror `json:"registryMirrors,omitempty"`
+	// ConfigPatch is a TOML fragment which is imported into the configuration of containerd, e.g. to configure
+	// additional runtimes.
+	// +optional
+	ConfigPatch *string `json:"configPatch,omitempty"`
+}
+
+// RegistryMirror is a mirror of a container registry.
+type RegistryMirror struct {
+	// Upstream is the host of the mirrored registry, optionally with port, e.g. `docker.io`.
+	Upstream string `json:"upstream"`
+	// Endpoints are the URLs of the mirrors in the order of preference. The upstream registry is used if none of them
+	// is available.
+	Endpoints []string `json:"endpoints"`
+}
+
+// NetworkInterfaces contains configuration for the network interfaces of machines.
+type NetworkInterfaces struct {
+	// EFA controls whether the primary network interface is an Elastic Fabric Adapter.
+	// +optional
+	EFA bool `json:"efa,omitempty"`
+	// Additional are network interfaces which are attached to the machines in addition to the primary one.
+	// +optional
+	Additional []AdditionalNetworkInterface `json:"additional,omitempty"`
+}
+
+// AdditionalNetworkInterface contains configuration for network interfaces attached to machines in addition to the
+// primary one.
+type AdditionalNetworkInterface struct {
+	// EFA controls whether the network interfaces are Elastic Fabric Adapters.
+	// +optional
+	EFA bool `json:"efa,omitempty"`
+	// Count is the number of network interfaces with this configuration. Defaults to 1.
+	// +optional
+	Count *int32 `json:"count,omitempty"`
+	// Subnets are the ids of existing subnets of the network interfaces per zone. The nodes subnet of the shoot is used
+	// for zones without subnet. Elastic Fabric Adapters must be in the nodes subnet.
+	// +optional
+	Subnets []NetworkInterfaceSubnet `json:"subnets,omitempty"`
+	// SecurityGroupIDs are the ids of existing security groups which are assigned to the network interfaces. Defaults
+	// to the security groups of the primary network interface.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIDs,omitempty"`
+}
+
+// NetworkInterfaceSubnet is the subnet of a network interface in a zone.
+type NetworkInterfaceSubnet struct {
+	// Zone is the name of the zone.
+	Zone string `json:"zone"`
+	// ID is the id of the subnet in the zone.
+	ID string `json:"id"`
+}
+
+// SpotInterruptionHandling contains configuration for the handling of interruption notices and rebalance
+// recommendations of spot instances.
+type SpotInterruptionHandling struct {
+	// Enabled controls whether the node termination handler is deployed to the nodes of the worker pool, which cordons
+	// and drains the nodes when their spot instances are about to be interrupted. Defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// DrainOnRebalanceRecommendation controls whether nodes are drained when AWS recommends to rebalance their spot
+	// instances, i.e. when they are at an elevated risk of interruption. Otherwise, they are only cordoned.
+	// +optional
+	DrainOnRebalanceRecommendation bool `json:"drainOnRebalanceRecommendation,omitempty"`
+}
+
+// DevicePlugin contains configuration for the device plugin of the accelerators of machines.
+type DevicePlugin struct {
+	// Enabled controls whether the device plugin matching the accelerators of the machine type, i.e. the NVIDIA device
+	// plugin for GPUs or the Neuron device plugin for Inferentia and Trainium, is deployed to the nodes of the worker
+	// pool. The machine image must contain the drivers of the accelerators.
+	Enabled bool `json:"enabled"`
+}
+
+// KubeletConfig contains configuration for the kubelet on machines.
+type KubeletConfig struct {
+	// KubeReservedFromInstanceType controls whether the CPU and memory reserved for Kubernetes system daemons
+	// (kubeReserved) are computed from the vCPUs of the instance type, which are retrieved from EC2, and the maximum
+	// number of pods, like on EKS. It overrides the CPU and memory of kubeReserved of the shoot.
+	// +optional
+	KubeReservedFromInstanceType bool `json:"kubeReservedFromInstanceType,omitempty"`
+	// MaxPodsFromNetworkInterfaces controls whether the maximum number of pods (maxPods) is computed from the network
+	// interfaces of the instance type, which are retrieved from EC2, like on EKS. It should only be used with CNIs
+	// assigning IP addresses of the VPC to pods, e.g. the AWS VPC CNI. It overrides the maxPods of the shoot.
+	// +optional
+	MaxPodsFromNetworkInterfaces bool `json:"maxPodsFromNetworkInterfaces,omitempty"`
+}
+
+// RollingUpdate contains configuration for rolling machines when only launch settings change which don't belong to the
+// worker pool, i.e. the AMI of the machine image version in the CloudProfile or the IAM instance profile of the nodes.
+// Changes of the worker pool and its WorkerConfig, e.g. of the instance metadata options or the user data, always roll
+// the machines.
+type RollingUpdate struct {
+	// MaxSurge is the maximum number of machines which are created above the desired number of machines during an
+	// update of the worker pool. It overrides the maxSurge of the worker pool.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+	// MaxUnavailable is the maximum number of machines which can be unavailable during an update of the worker pool. It
+	// overrides the maxUnavailable of the worker pool.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// PreProvisionedCapacity contains configuration for keeping initialized machines available for scale-ups. The capacity
+// of the machines is reserved by placeholder pods with a negative priority, which are preempted by pending pods, so
+// that these are scheduled without waiting for new machines.
+type PreProvisionedCapacity struct {
+	// Machines is the number of machines whose capacity is kept available.
+	Machines int32 `json:"machines"`
+	// Resources are the resources which are reserved on each machine. Defaults to half of the CPU and memory of the
+	// machine type.
+	// +optional
+	Resources corev1.ResourceList `json:"resources,omitempty"`
+}
+
+// UserData contains custom cloud-init parts which are added to the user data of machines. The user data is combined as
+// MIME multi-part archive, which is compressed with gzip if it exceeds the limit of EC2.
+type UserData struct {
+	// PreBootstrap are the parts which are added before the Gardener node bootstrap.
+	// +optional
+	PreBootstrap []UserDataPart `json:"preBootstrap,omitempty"`
+	// PostBootstrap are the parts which are added after the Gardener node bootstrap.
+	// +optional
+	PostBootstrap []UserDataPart `json:"postBootstrap,omitempty"`
+}
+
+// UserDataPart is a part of a MIME multi-part user data archive.
+type UserDataPart struct {
+	// ContentType is the MIME type of the part, either `text/x-shellscript`, `text/cloud-config` or
+	// `text/cloud-boothook`. Defaults to `text/x-shellscript`.
+	// +optional
+	ContentType *string `json:"contentType,omitempty"`
+	// Content is the content of the part.
+	Content string `json:"content"`
+	// Encoding is the encoding of the content, either `base64` or `gzip+base64`. If not set, the content is plain text.
+	// +optional
+	Encoding *UserDataEncoding `json:"encoding,omitempty"`
+}
+
+// UserDataEncoding is the encoding of the content of a user data part.
+type UserDataEncoding string
+
+const (
+	// UserDataEncodingBase64 is the encoding of base64 encoded content.
+	UserDataEncodingBase64 UserDataEncoding = "base64"
+	// UserDataEncodingGzipBase64 is the encoding of gzip compressed and base64 encoded content.
+	UserDataEncodingGzipBase64 UserDataEncoding = "gzip+base64"
+)
+
+// CPUOptions contains configuration for the processor of machines.
+type CPUOptions struct {
+	// AmdSevSnp defines whether AMD SEV-SNP is `enabled` or `disabled` for the machines. It is only supported by
+	// machine types with AMD processors which support it.
+	// +optional
+	AmdSevSnp *AmdSevSnpSpecification `json:"amdSevSnp,omitempty"`
+}
+
+// AmdSevSnpSpecification defines whether AMD SEV-SNP is enabled.
+type AmdSevSnpSpecification string
+
+const (
+	// AmdSevSnpEnabled enables AMD SEV-SNP.
+	AmdSevSnpEnabled AmdSevSnpSpecification = "enabled"
+	// AmdSevSnpDisabled disables AMD SEV-SNP.
+	AmdSevSnpDisabled AmdSevSnpSpecification = "disabled"
+)
+
+// InstanceStorage contains configuration for using the instance store volumes of the machines as ephemeral storage.
+type InstanceStorage struct {
+	// RAID0 defines whether multiple instance store volumes are combined into a RAID0 array. Otherwise, only the first
+	// instance store volume is used. Defaults to true.
+	// +optional
+	RAID0 *bool `json:"raid0,omitempty"`
+}
+
+// PlacementGroup contains configuration for a placement group of a worker pool.
+type PlacementGroup struct {
+	// Strategy is the placement strategy, either `cluster` (pack machines close together for low-latency networking),
+	// `spread` (place machines on distinct hardware) or `partition` (spread machines across logical partitions).
+	Strategy PlacementGroupStrategy `json:"strategy"`
+	// PartitionCount is the number of partitions. It is only allowed for strategy `partition`.
+	// +optional
+	PartitionCount *int64 `json:"partitionCount,omitempty"`
+}
+
+// PlacementGroupStrategy is the placement strategy of a placement group.
+type PlacementGroupStrategy string
+
+const (
+	// PlacementGroupStrategyCluster packs machines close together inside an availability zone.
+	PlacementGroupStrategyCluster PlacementGroupStrategy = "cluster"
+	// PlacementGroupStrategySpread places machines on distinct hardware.
+	PlacementGroupStrategySpread PlacementGroupStrategy = "spread"
+	// PlacementGroupStrategyPartition spreads machines across logical partitions which do not share hardware.
+	PlacementGroupStrategyPartition PlacementGroupStrategy = "partition"
+)
+
+// Tenancy is the tenancy of the machines of a worker pool.
+type Tenancy string
+
+const (
+	// TenancyDefault runs machines on shared hardware.
+	TenancyDefault Tenancy = "default"
+	// TenancyDedicated runs machines as dedicated instances on hardware which is dedicated to a single AWS account.
+	TenancyDedicated Tenancy = "dedicated"
+	// TenancyHost runs machines on dedicated hosts.
+	TenancyHost Tenancy = "host"
+)
+
+// CapacityType is the capacity type of the machines of a worker pool.
+type CapacityType string
+
+const (
+	// CapacityTypeOnDemand is a constant for on-demand instances.
+	CapacityTypeOnDemand CapacityType = "onDemand"
+	// CapacityTypeSpot is a constant for spot instances, which can be interrupted by AWS at any time.
+	CapacityTypeSpot CapacityType = "spot"
+)
+
+// InstanceMetadataTagsValue is a constant for InstanceMetadataTags values.
+type InstanceMetadataTagsValue string
+
+const (
+	// InstanceMetadataTagsEnabled is a constant for allowing access to the instance tags via IMDS.
+	InstanceMetadataTagsEnabled InstanceMetadataTagsValue = "enabled"
+	// InstanceMetadataTagsDisabled is a constant for denying access to the instance tags via IMDS.
+	InstanceMetadataTagsDisabled InstanceMetadataTagsValue = "disabled"
+)
+
 // InstanceMetadataOptions contains configuration for controlling access to the metadata API.
 type InstanceMetadataOptions struct {
 	// HTTPTokens enforces the use of metadata v2 API.
@@ -151,4 +546,7 @@ type InstanceMetadataOptions struct {
 	// HTTPPutResponseHopLimit is the response hop limit for instance metadata requests.
 	// Valid values are between 1 and 64.
 	HTTPPutResponseHopLimit *int64 `json:"httpPutResponseHopLimit,omitempty"`
+	// InstanceMetadataTags controls whether the tags of the instance can be accessed via the metadata API.
+	// +optional
+	InstanceMetadataTags *InstanceMetadataTagsValue `json:"instanceMetadataTags,omitempty"`
 }
@@ -26,8 +26,11 @@ import (
 
 	aws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func init() {
@@ -37,6 +40,106 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AdditionalNetworkInterface)(nil), (*aws.AdditionalNetworkInterface)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AdditionalNetworkInterface_To_aws_AdditionalNetworkInterface(a.(*AdditionalNetworkInterface), b.(*aws.AdditionalNetworkInterface), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.AdditionalNetworkInterface)(nil), (*AdditionalNetworkInterface)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_AdditionalNetworkInterface_To_v1alpha1_AdditionalNetworkInterface(a.(*aws.AdditionalNetworkInterface), b.(*AdditionalNetworkInterface), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BackupBucketConfig)(nil), (*aws.BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupBucketConfig_To_aws_BackupBucketConfig(a.(*BackupBucketConfig), b.(*aws.BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.BackupBucketConfig)(nil), (*BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(a.(*aws.BackupBucketConfig), b.(*BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BastionConfig)(nil), (*aws.BastionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BastionConfig_To_aws_BastionConfig(a.(*BastionConfig), b.(*aws.BastionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.BastionConfig)(nil), (*BastionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_BastionConfig_To_v1alpha1_BastionConfig(a.(*aws.BastionConfig), b.(*BastionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BucketAccessLogging)(nil), (*aws.BucketAccessLogging)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BucketAccessLogging_To_aws_BucketAccessLogging(a.(*BucketAccessLogging), b.(*aws.BucketAccessLogging), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.BucketAccessLogging)(nil), (*BucketAccessLogging)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_BucketAccessLogging_To_v1alpha1_BucketAccessLogging(a.(*aws.BucketAccessLogging), b.(*BucketAccessLogging), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BucketEncryption)(nil), (*aws.BucketEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BucketEncryption_To_aws_BucketEncryption(a.(*BucketEncryption), b.(*aws.BucketEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.BucketEncryption)(nil), (*BucketEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_BucketEncryption_To_v1alpha1_BucketEncryption(a.(*aws.BucketEncryption), b.(*BucketEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BucketHardening)(nil), (*aws.BucketHardening)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BucketHardening_To_aws_BucketHardening(a.(*BucketHardening), b.(*aws.BucketHardening), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.BucketHardening)(nil), (*BucketHardening)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_BucketHardening_To_v1alpha1_BucketHardening(a.(*aws.BucketHardening), b.(*BucketHardening), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BucketLifecycle)(nil), (*aws.BucketLifecycle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BucketLifecycle_To_aws_BucketLifecycle(a.(*BucketLifecycle), b.(*aws.BucketLifecycle), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.BucketLifecycle)(nil), (*BucketLifecycle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_BucketLifecycle_To_v1alpha1_BucketLifecycle(a.(*aws.BucketLifecycle), b.(*BucketLifecycle), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BucketReplication)(nil), (*aws.BucketReplication)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BucketReplication_To_aws_BucketReplication(a.(*BucketReplication), b.(*aws.BucketReplication), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.BucketReplication)(nil), (*BucketReplication)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_BucketReplication_To_v1alpha1_BucketReplication(a.(*aws.BucketReplication), b.(*BucketReplication), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CPUOptions)(nil), (*aws.CPUOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CPUOptions_To_aws_CPUOptions(a.(*CPUOptions), b.(*aws.CPUOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.CPUOptions)(nil), (*CPUOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_CPUOptions_To_v1alpha1_CPUOptions(a.(*aws.CPUOptions), b.(*CPUOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CapacityReservation)(nil), (*aws.CapacityReservation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CapacityReservation_To_aws_CapacityReservation(a.(*CapacityReservation), b.(*aws.CapacityReservation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.CapacityReservation)(nil), (*CapacityReservation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_CapacityReservation_To_v1alpha1_CapacityReservation(a.(*aws.CapacityReservation), b.(*CapacityReservation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudControllerManagerConfig)(nil), (*aws.CloudControllerManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudControllerManagerConfig_To_aws_CloudControllerManagerConfig(a.(*CloudControllerManagerConfig), b.(*aws.CloudControllerManagerConfig), scope)
 	}); err != nil {
@@ -57,6 +160,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CloudWatchAgentConfig)(nil), (*aws.CloudWatchAgentConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloudWatchAgentConfig_To_aws_CloudWatchAgentConfig(a.(*CloudWatchAgentConfig), b.(*aws.CloudWatchAgentConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.CloudWatchAgentConfig)(nil), (*CloudWatchAgentConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_CloudWatchAgentConfig_To_v1alpha1_CloudWatchAgentConfig(a.(*aws.CloudWatchAgentConfig), b.(*CloudWatchAgentConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CloudWatchAgentLogs)(nil), (*aws.CloudWatchAgentLogs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloudWatchAgentLogs_To_aws_CloudWatchAgentLogs(a.(*CloudWatchAgentLogs), b.(*aws.CloudWatchAgentLogs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.CloudWatchAgentLogs)(nil), (*CloudWatchAgentLogs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_CloudWatchAgentLogs_To_v1alpha1_CloudWatchAgentLogs(a.(*aws.CloudWatchAgentLogs), b.(*CloudWatchAgentLogs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CloudWatchAgentStatus)(nil), (*aws.CloudWatchAgentStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloudWatchAgentStatus_To_aws_CloudWatchAgentStatus(a.(*CloudWatchAgentStatus), b.(*aws.CloudWatchAgentStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.CloudWatchAgentStatus)(nil), (*CloudWatchAgentStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_CloudWatchAgentStatus_To_v1alpha1_CloudWatchAgentStatus(a.(*aws.CloudWatchAgentStatus), b.(*CloudWatchAgentStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ContainerdConfig)(nil), (*aws.ContainerdConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ContainerdConfig_To_aws_ContainerdConfig(a.(*ContainerdConfig), b.(*aws.ContainerdConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.ContainerdConfig)(nil), (*ContainerdConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_ContainerdConfig_To_v1alpha1_ContainerdConfig(a.(*aws.ContainerdConfig), b.(*ContainerdConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControlPlaneConfig)(nil), (*aws.ControlPlaneConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControlPlaneConfig_To_aws_ControlPlaneConfig(a.(*ControlPlaneConfig), b.(*aws.ControlPlaneConfig), scope)
 	}); err != nil {
@@ -67,6 +210,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControlPlaneStatus)(nil), (*aws.ControlPlaneStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ControlPlaneStatus_To_aws_ControlPlaneStatus(a.(*ControlPlaneStatus), b.(*aws.ControlPlaneStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.ControlPlaneStatus)(nil), (*ControlPlaneStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_ControlPlaneStatus_To_v1alpha1_ControlPlaneStatus(a.(*aws.ControlPlaneStatus), b.(*ControlPlaneStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DHCPOptions)(nil), (*aws.DHCPOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DHCPOptions_To_aws_DHCPOptions(a.(*DHCPOptions), b.(*aws.DHCPOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.DHCPOptions)(nil), (*DHCPOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_DHCPOptions_To_v1alpha1_DHCPOptions(a.(*aws.DHCPOptions), b.(*DHCPOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSRecordConfig)(nil), (*aws.DNSRecordConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSRecordConfig_To_aws_DNSRecordConfig(a.(*DNSRecordConfig), b.(*aws.DNSRecordConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.DNSRecordConfig)(nil), (*DNSRecordConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(a.(*aws.DNSRecordConfig), b.(*DNSRecordConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSRecordStatus)(nil), (*aws.DNSRecordStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSRecordStatus_To_aws_DNSRecordStatus(a.(*DNSRecordStatus), b.(*aws.DNSRecordStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.DNSRecordStatus)(nil), (*DNSRecordStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_DNSRecordStatus_To_v1alpha1_DNSRecordStatus(a.(*aws.DNSRecordStatus), b.(*DNSRecordStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DataVolume)(nil), (*aws.DataVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DataVolume_To_aws_DataVolume(a.(*DataVolume), b.(*aws.DataVolume), scope)
 	}); err != nil {
@@ -77,6 +260,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DevicePlugin)(nil), (*aws.DevicePlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DevicePlugin_To_aws_DevicePlugin(a.(*DevicePlugin), b.(*aws.DevicePlugin), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.DevicePlugin)(nil), (*DevicePlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_DevicePlugin_To_v1alpha1_DevicePlugin(a.(*aws.DevicePlugin), b.(*DevicePlugin), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DualStack)(nil), (*aws.DualStack)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DualStack_To_aws_DualStack(a.(*DualStack), b.(*aws.DualStack), scope)
 	}); err != nil {
@@ -87,6 +280,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EBSConfig)(nil), (*aws.EBSConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EBSConfig_To_aws_EBSConfig(a.(*EBSConfig), b.(*aws.EBSConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.EBSConfig)(nil), (*EBSConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_EBSConfig_To_v1alpha1_EBSConfig(a.(*aws.EBSConfig), b.(*EBSConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*EC2)(nil), (*aws.EC2)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_EC2_To_aws_EC2(a.(*EC2), b.(*aws.EC2), scope)
 	}); err != nil {
@@ -97,807 +300,3733 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*IAM)(nil), (*aws.IAM)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_IAM_To_aws_IAM(a.(*IAM), b.(*aws.IAM), scope)
+	if err := s.AddGeneratedConversionFunc((*EFSConfig)(nil), (*aws.EFSConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EFSConfig_To_aws_EFSConfig(a.(*EFSConfig), b.(*aws.EFSConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*aws.IAM)(nil), (*IAM)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_aws_IAM_To_v1alpha1_IAM(a.(*aws.IAM), b.(*IAM), scope)
+	if err := s.AddGeneratedConversionFunc((*aws.EFSConfig)(nil), (*EFSConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_EFSConfig_To_v1alpha1_EFSConfig(a.(*aws.EFSConfig), b.(*EFSConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*IAMInstanceProfile)(nil), (*aws.IAMInstanceProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_IAMInstanceProfile_To_aws_IAMInstanceProfile(a.(*IAMInstanceProfile), b.(*aws.IAMInstanceProfile), scope)
+	if err := s.AddGeneratedConversionFunc((*EgressPrefixListConfig)(nil), (*aws.EgressPrefixListConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EgressPrefixListConfig_To_aws_EgressPrefixListConfig(a.(*EgressPrefixListConfig), b.(*aws.EgressPrefixListConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*aws.IAMInstanceProfile)(nil), (*IAMInstanceProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_aws_IAMInstanceProfile_To_v1alpha1_IAMInstanceProfile(a.(*aws.IAMInstanceProfile), b.(*IAMInstanceProfile), scope)
+	if err := s.AddGeneratedConversionFunc((*aws.EgressPrefixListConfig)(nil), (*EgressPrefixListConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_EgressPrefixListConfig_To_v1alpha1_EgressPrefixListConfig(a.(*aws.EgressPrefixListConfig), b.(*EgressPrefixListConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*IgnoreTags)(nil), (*aws.IgnoreTags)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_IgnoreTags_To_aws_IgnoreTags(a.(*IgnoreTags), b.(*aws.IgnoreTags), scope)
+	if err := s.AddGeneratedConversionFunc((*EgressPrefixListStatus)(nil), (*aws.EgressPrefixListStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EgressPrefixListStatus_To_aws_EgressPrefixListStatus(a.(*EgressPrefixListStatus), b.(*aws.EgressPrefixListStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*aws.IgnoreTags)(nil), (*IgnoreTags)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_aws_IgnoreTags_To_v1alpha1_IgnoreTags(a.(*aws.IgnoreTags), b.(*IgnoreTags), scope)
+	if err := s.AddGeneratedConversionFunc((*aws.EgressPrefixListStatus)(nil), (*EgressPrefixListStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_EgressPrefixListStatus_To_v1alpha1_EgressPrefixListStatus(a.(*aws.EgressPrefixListStatus), b.(*EgressPrefixListStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*InfrastructureConfig)(nil), (*aws.InfrastructureConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_InfrastructureConfig_To_aws_InfrastructureConfig(a.(*InfrastructureConfig), b.(*aws.InfrastructureConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*ElasticFileSystemConfig)(nil), (*aws.ElasticFileSystemConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ElasticFileSystemConfig_To_aws_ElasticFileSystemConfig(a.(*ElasticFileSystemConfig), b.(*aws.ElasticFileSystemConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*aws.InfrastructureConfig)(nil), (*InfrastructureConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_aws_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(a.(*aws.InfrastructureConfig), b.(*InfrastructureConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*aws.ElasticFileSystemConfig)(nil), (*ElasticFileSystemConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_ElasticFileSystemConfig_To_v1alpha1_ElasticFileSystemConfig(a.(*aws.ElasticFileSystemConfig), b.(*ElasticFileSystemConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*InfrastructureStatus)(nil), (*aws.InfrastructureStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_InfrastructureStatus_To_aws_InfrastructureStatus(a.(*InfrastructureStatus), b.(*aws.InfrastructureStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*ElasticFileSystemStatus)(nil), (*aws.ElasticFileSystemStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ElasticFileSystemStatus_To_aws_ElasticFileSystemStatus(a.(*ElasticFileSystemStatus), b.(*aws.ElasticFileSystemStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*aws.InfrastructureStatus)(nil), (*InfrastructureStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_aws_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(a.(*aws.InfrastructureStatus), b.(*InfrastructureStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*aws.ElasticFileSystemStatus)(nil), (*ElasticFileSystemStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_ElasticFileSystemStatus_To_v1alpha1_ElasticFileSystemStatus(a.(*aws.ElasticFileSystemStatus), b.(*ElasticFileSystemStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*InstanceMetadataOptions)(nil), (*aws.InstanceMetadataOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_InstanceMetadataOptions_To_aws_InstanceMetadataOptions(a.(*InstanceMetadataOptions), b.(*aws.InstanceMetadataOptions), scope)
+	if err := s.AddGeneratedConversionFunc((*ElasticIPPool)(nil), (*aws.ElasticIPPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ElasticIPPool_To_aws_ElasticIPPool(a.(*ElasticIPPool), b.(*aws.ElasticIPPool), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*aws.InstanceMetadataOptions)(nil), (*InstanceMetadataOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_aws_InstanceMetadataOptions_To_v1alpha1_InstanceMetadataOptions(a.(*aws.InstanceMetadataOptions), b.(*InstanceMetadataOptions), scope)
+	if err := s.AddGeneratedConversionFunc((*aws.ElasticIPPool)(nil), (*ElasticIPPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_ElasticIPPool_To_v1alpha1_ElasticIPPool(a.(*aws.ElasticIPPool), b.(*ElasticIPPool), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*InstanceProfile)(nil), (*aws.InstanceProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_InstanceProfile_To_aws_InstanceProfile(a.(*InstanceProfile), b.(*aws.InstanceProfile), scope)
+	if err := s.AddGeneratedConversionFunc((*ElasticIPPoolAddress)(nil), (*aws.ElasticIPPoolAddress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ElasticIPPoolAddress_To_aws_ElasticIPPoolAddress(a.(*ElasticIPPoolAddress), b.(*aws.ElasticIPPoolAddress), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*aws.InstanceProfile)(nil), (*InstanceProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_aws_InstanceProfile_To_v1alpha1_InstanceProfile(a.(*aws.InstanceProfile), b.(*InstanceProfile), scope)
+	if err := s.AddGeneratedConversionFunc((*aws.ElasticIPPoolAddress)(nil), (*ElasticIPPoolAddress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_ElasticIPPoolAddress_To_v1alpha1_ElasticIPPoolAddress(a.(*aws.ElasticIPPoolAddress), b.(*ElasticIPPoolAddress), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*LoadBalancerControllerConfig)(nil), (*aws.LoadBalancerControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_LoadBalancerControllerConfig_To_aws_LoadBalancerControllerConfig(a.(*LoadBalancerControllerConfig), b.(*aws.LoadBalancerControllerConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*EnclaveOptions)(nil), (*aws.EnclaveOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EnclaveOptions_To_aws_EnclaveOptions(a.(*EnclaveOptions), b.(*aws.EnclaveOptions), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*aws.LoadBalancerControllerConfig)(nil), (*LoadBalancerControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_aws_LoadBalancerControllerConfig_To_v1alpha1_LoadBalancerControllerConfig(a.(*aws.LoadBalancerControllerConfig), b.(*LoadBalancerControllerConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*aws.EnclaveOptions)(nil), (*EnclaveOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_EnclaveOptions_To_v1alpha1_EnclaveOptions(a.(*aws.EnclaveOptions), b.(*EnclaveOptions), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*MachineImage)(nil), (*aws.MachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_MachineImage_To_aws_MachineImage(a.(*MachineImage), b.(*aws.MachineImage), scope)
+	if err := s.AddGeneratedConversionFunc((*Endpoints)(nil), (*aws.Endpoints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Endpoints_To_aws_Endpoints(a.(*Endpoints), b.(*aws.Endpoints), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*aws.MachineImage)(nil), (*MachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_aws_MachineImage_To_v1alpha1_MachineImage(a.(*aws.MachineImage), b.(*MachineImage), scope)
+	if err := s.AddGeneratedConversionFunc((*aws.Endpoints)(nil), (*Endpoints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_Endpoints_To_v1alpha1_Endpoints(a.(*aws.Endpoints), b.(*Endpoints), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*MachineImageVersion)(nil), (*aws.MachineImageVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_MachineImageVersion_To_aws_MachineImageVersion(a.(*MachineImageVersion), b.(*aws.MachineImageVersion), scope)
+	if err := s.AddGeneratedConversionFunc((*FailoverRoutingPolicy)(nil), (*aws.FailoverRoutingPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FailoverRoutingPolicy_To_aws_FailoverRoutingPolicy(a.(*FailoverRoutingPolicy), b.(*aws.FailoverRoutingPolicy), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*aws.MachineImageVersion)(nil), (*MachineImageVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_aws_MachineImageVersion_To_v1alpha1_MachineImageVersion(a.(*aws.MachineImageVersion), b.(*MachineImageVersion), scope)
+	if err := s.AddGeneratedConversionFunc((*aws.FailoverRoutingPolicy)(nil), (*FailoverRoutingPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_FailoverRoutingPolicy_To_v1alpha1_FailoverRoutingPolicy(a.(*aws.FailoverRoutingPolicy), b.(*FailoverRoutingPolicy), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*MachineImages)(nil), (*aws.MachineImages)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_MachineImages_To_aws_MachineImages(a.(*MachineImages), b.(*aws.MachineImages), scope)
+	if err := s.AddGeneratedConversionFunc((*GeolocationRoutingPolicy)(nil), (*aws.GeolocationRoutingPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GeolocationRoutingPolicy_To_aws_GeolocationRoutingPolicy(a.(*GeolocationRoutingPolicy), b.(*aws.GeolocationRoutingPolicy), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*aws.MachineImages)(nil), (*MachineImages)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_aws_MachineImages_To_v1alpha1_MachineImages(a.(*aws.MachineImages), b.(*MachineImages), scope)
+	if err := s.AddGeneratedConversionFunc((*aws.GeolocationRoutingPolicy)(nil), (*GeolocationRoutingPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_GeolocationRoutingPolicy_To_v1alpha1_GeolocationRoutingPolicy(a.(*aws.GeolocationRoutingPolicy), b.(*GeolocationRoutingPolicy), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Networks)(nil), (*aws.Networks)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_Networks_To_aws_Networks(a.(*Networks), b.(*aws.Networks), scope)
+	if err := s.AddGeneratedConversionFunc((*HealthCheck)(nil), (*aws.HealthCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HealthCheck_To_aws_HealthCheck(a.(*HealthCheck), b.(*aws.HealthCheck), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*aws.Networks)(nil), (*Networks)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_aws_Networks_To_v1alpha1_Networks(a.(*aws.Networks), b.(*Networks), scope)
+	if err := s.AddGeneratedConversionFunc((*aws.HealthCheck)(nil), (*HealthCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_HealthCheck_To_v1alpha1_HealthCheck(a.(*aws.HealthCheck), b.(*HealthCheck), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*RegionAMIMapping)(nil), (*aws.RegionAMIMapping)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_RegionAMIMapping_To_aws_RegionAMIMapping(a.(*RegionAMIMapping), b.(*aws.RegionAMIMapping), scope)
+	if err := s.AddGeneratedConversionFunc((*HostedZoneVPC)(nil), (*aws.HostedZoneVPC)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HostedZoneVPC_To_aws_HostedZoneVPC(a.(*HostedZoneVPC), b.(*aws.HostedZoneVPC), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*aws.RegionAMIMapping)(nil), (*RegionAMIMapping)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_aws_RegionAMIMapping_To_v1alpha1_RegionAMIMapping(a.(*aws.RegionAMIMapping), b.(*RegionAMIMapping), scope)
+	if err := s.AddGeneratedConversionFunc((*aws.HostedZoneVPC)(nil), (*HostedZoneVPC)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_HostedZoneVPC_To_v1alpha1_HostedZoneVPC(a.(*aws.HostedZoneVPC), b.(*HostedZoneVPC), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Role)(nil), (*aws.Role)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_Role_To_aws_Role(a.(*Role), b.(*aws.Role), scope)
+	if err := s.AddGeneratedConversionFunc((*IAM)(nil), (*aws.IAM)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IAM_To_aws_IAM(a.(*IAM), b.(*aws.IAM), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*aws.Role)(nil), (*Role)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_aws_Role_To_v1alpha1_Role(a.(*aws.Role), b.(*Role), scope)
+	if err := s.AddGeneratedConversionFunc((*aws.IAM)(nil), (*IAM)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_IAM_To_v1alpha1_IAM(a.(*aws.IAM), b.(*IAM), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*SecurityGroup)(nil), (*aws.SecurityGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_SecurityGroup_To_aws_SecurityGroup(a.(*SecurityGroup), b.(*aws.SecurityGroup), scope)
+	if err := s.AddGeneratedConversionFunc((*IAMConfig)(nil), (*aws.IAMConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IAMConfig_To_aws_IAMConfig(a.(*IAMConfig), b.(*aws.IAMConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*aws.SecurityGroup)(nil), (*SecurityGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_aws_SecurityGroup_To_v1alpha1_SecurityGroup(a.(*aws.SecurityGroup), b.(*SecurityGroup), scope)
+	if err := s.AddGeneratedConversionFunc((*aws.IAMConfig)(nil), (*IAMConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_IAMConfig_To_v1alpha1_IAMConfig(a.(*aws.IAMConfig), b.(*IAMConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Storage)(nil), (*aws.Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_Storage_To_aws_Storage(a.(*Storage), b.(*aws.Storage), scope)
+	if err := s.AddGeneratedConversionFunc((*IAMInstanceProfile)(nil), (*aws.IAMInstanceProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IAMInstanceProfile_To_aws_IAMInstanceProfile(a.(*IAMInstanceProfile), b.(*aws.IAMInstanceProfile), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*aws.Storage)(nil), (*Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_aws_Storage_To_v1alpha1_Storage(a.(*aws.Storage), b.(*Storage), scope)
+	if err := s.AddGeneratedConversionFunc((*aws.IAMInstanceProfile)(nil), (*IAMInstanceProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_IAMInstanceProfile_To_v1alpha1_IAMInstanceProfile(a.(*aws.IAMInstanceProfile), b.(*IAMInstanceProfile), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Subnet)(nil), (*aws.Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_Subnet_To_aws_Subnet(a.(*Subnet), b.(*aws.Subnet), scope)
+	if err := s.AddGeneratedConversionFunc((*IRSAConfig)(nil), (*aws.IRSAConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IRSAConfig_To_aws_IRSAConfig(a.(*IRSAConfig), b.(*aws.IRSAConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*aws.Subnet)(nil), (*Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_aws_Subnet_To_v1alpha1_Subnet(a.(*aws.Subnet), b.(*Subnet), scope)
+	if err := s.AddGeneratedConversionFunc((*aws.IRSAConfig)(nil), (*IRSAConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_IRSAConfig_To_v1alpha1_IRSAConfig(a.(*aws.IRSAConfig), b.(*IRSAConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*VPC)(nil), (*aws.VPC)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_VPC_To_aws_VPC(a.(*VPC), b.(*aws.VPC), scope)
+	if err := s.AddGeneratedConversionFunc((*IRSAStatus)(nil), (*aws.IRSAStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IRSAStatus_To_aws_IRSAStatus(a.(*IRSAStatus), b.(*aws.IRSAStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*aws.VPC)(nil), (*VPC)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_aws_VPC_To_v1alpha1_VPC(a.(*aws.VPC), b.(*VPC), scope)
+	if err := s.AddGeneratedConversionFunc((*aws.IRSAStatus)(nil), (*IRSAStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_IRSAStatus_To_v1alpha1_IRSAStatus(a.(*aws.IRSAStatus), b.(*IRSAStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*VPCStatus)(nil), (*aws.VPCStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_VPCStatus_To_aws_VPCStatus(a.(*VPCStatus), b.(*aws.VPCStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*IgnoreTags)(nil), (*aws.IgnoreTags)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IgnoreTags_To_aws_IgnoreTags(a.(*IgnoreTags), b.(*aws.IgnoreTags), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*aws.VPCStatus)(nil), (*VPCStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_aws_VPCStatus_To_v1alpha1_VPCStatus(a.(*aws.VPCStatus), b.(*VPCStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*aws.IgnoreTags)(nil), (*IgnoreTags)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_IgnoreTags_To_v1alpha1_IgnoreTags(a.(*aws.IgnoreTags), b.(*IgnoreTags), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Volume)(nil), (*aws.Volume)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_Volume_To_aws_Volume(a.(*Volume), b.(*aws.Volume), scope)
+	if err := s.AddGeneratedConversionFunc((*ImageSelector)(nil), (*aws.ImageSelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ImageSelector_To_aws_ImageSelector(a.(*ImageSelector), b.(*aws.ImageSelector), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*aws.Volume)(nil), (*Volume)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_aws_Volume_To_v1alpha1_Volume(a.(*aws.Volume), b.(*Volume), scope)
+	if err := s.AddGeneratedConversionFunc((*aws.ImageSelector)(nil), (*ImageSelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_ImageSelector_To_v1alpha1_ImageSelector(a.(*aws.ImageSelector), b.(*ImageSelector), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*aws.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_WorkerConfig_To_aws_WorkerConfig(a.(*WorkerConfig), b.(*aws.WorkerConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*InfrastructureConfig)(nil), (*aws.InfrastructureConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InfrastructureConfig_To_aws_InfrastructureConfig(a.(*InfrastructureConfig), b.(*aws.InfrastructureConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*aws.WorkerConfig)(nil), (*WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_aws_WorkerConfig_To_v1alpha1_WorkerConfig(a.(*aws.WorkerConfig), b.(*WorkerConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*aws.InfrastructureConfig)(nil), (*InfrastructureConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(a.(*aws.InfrastructureConfig), b.(*InfrastructureConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*WorkerStatus)(nil), (*aws.WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_WorkerStatus_To_aws_WorkerStatus(a.(*WorkerStatus), b.(*aws.WorkerStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*InfrastructureStatus)(nil), (*aws.InfrastructureStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InfrastructureStatus_To_aws_InfrastructureStatus(a.(*InfrastructureStatus), b.(*aws.InfrastructureStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*aws.WorkerStatus)(nil), (*WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_aws_WorkerStatus_To_v1alpha1_WorkerStatus(a.(*aws.WorkerStatus), b.(*WorkerStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*aws.InfrastructureStatus)(nil), (*InfrastructureStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(a.(*aws.InfrastructureStatus), b.(*InfrastructureStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Zone)(nil), (*aws.Zone)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_Zone_To_aws_Zone(a.(*Zone), b.(*aws.Zone), scope)
+	if err := s.AddGeneratedConversionFunc((*InstanceMetadataOptions)(nil), (*aws.InstanceMetadataOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstanceMetadataOptions_To_aws_InstanceMetadataOptions(a.(*InstanceMetadataOptions), b.(*aws.InstanceMetadataOptions), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*aws.Zone)(nil), (*Zone)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_aws_Zone_To_v1alpha1_Zone(a.(*aws.Zone), b.(*Zone), scope)
+	if err := s.AddGeneratedConversionFunc((*aws.InstanceMetadataOptions)(nil), (*InstanceMetadataOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_InstanceMetadataOptions_To_v1alpha1_InstanceMetadataOptions(a.(*aws.InstanceMetadataOptions), b.(*InstanceMetadataOptions), scope)
 	}); err != nil {
 		return err
 	}
-	return nil
-}
-
-func autoConvert_v1alpha1_CloudControllerManagerConfig_To_aws_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *aws.CloudControllerManagerConfig, s conversion.Scope) error {
-	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
-	out.UseCustomRouteController = (*bool)(unsafe.Pointer(in.UseCustomRouteController))
-	return nil
-}
-
-// Convert_v1alpha1_CloudControllerManagerConfig_To_aws_CloudControllerManagerConfig is an autogenerated conversion function.
-func Convert_v1alpha1_CloudControllerManagerConfig_To_aws_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *aws.CloudControllerManagerConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_CloudControllerManagerConfig_To_aws_CloudControllerManagerConfig(in, out, s)
-}
-
-func autoConvert_aws_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in *aws.CloudControllerManagerConfig, out *CloudControllerManagerConfig, s conversion.Scope) error {
-	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
-	out.UseCustomRouteController = (*bool)(unsafe.Pointer(in.UseCustomRouteController))
-	return nil
-}
-
+	if err := s.AddGeneratedConversionFunc((*InstanceProfile)(nil), (*aws.InstanceProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstanceProfile_To_aws_InstanceProfile(a.(*InstanceProfile), b.(*aws.InstanceProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.InstanceProfile)(nil), (*InstanceProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_InstanceProfile_To_v1alpha1_InstanceProfile(a.(*aws.InstanceProfile), b.(*InstanceProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InstanceStorage)(nil), (*aws.InstanceStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstanceStorage_To_aws_InstanceStorage(a.(*InstanceStorage), b.(*aws.InstanceStorage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.InstanceStorage)(nil), (*InstanceStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_InstanceStorage_To_v1alpha1_InstanceStorage(a.(*aws.InstanceStorage), b.(*InstanceStorage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InventoryConfig)(nil), (*aws.InventoryConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InventoryConfig_To_aws_InventoryConfig(a.(*InventoryConfig), b.(*aws.InventoryConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.InventoryConfig)(nil), (*InventoryConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_InventoryConfig_To_v1alpha1_InventoryConfig(a.(*aws.InventoryConfig), b.(*InventoryConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InventoryS3Location)(nil), (*aws.InventoryS3Location)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InventoryS3Location_To_aws_InventoryS3Location(a.(*InventoryS3Location), b.(*aws.InventoryS3Location), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.InventoryS3Location)(nil), (*InventoryS3Location)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_InventoryS3Location_To_v1alpha1_InventoryS3Location(a.(*aws.InventoryS3Location), b.(*InventoryS3Location), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KarpenterConfig)(nil), (*aws.KarpenterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KarpenterConfig_To_aws_KarpenterConfig(a.(*KarpenterConfig), b.(*aws.KarpenterConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.KarpenterConfig)(nil), (*KarpenterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_KarpenterConfig_To_v1alpha1_KarpenterConfig(a.(*aws.KarpenterConfig), b.(*KarpenterConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KarpenterStatus)(nil), (*aws.KarpenterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KarpenterStatus_To_aws_KarpenterStatus(a.(*KarpenterStatus), b.(*aws.KarpenterStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.KarpenterStatus)(nil), (*KarpenterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_KarpenterStatus_To_v1alpha1_KarpenterStatus(a.(*aws.KarpenterStatus), b.(*KarpenterStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KubeletConfig)(nil), (*aws.KubeletConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KubeletConfig_To_aws_KubeletConfig(a.(*KubeletConfig), b.(*aws.KubeletConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.KubeletConfig)(nil), (*KubeletConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_KubeletConfig_To_v1alpha1_KubeletConfig(a.(*aws.KubeletConfig), b.(*KubeletConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LatencyRoutingPolicy)(nil), (*aws.LatencyRoutingPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LatencyRoutingPolicy_To_aws_LatencyRoutingPolicy(a.(*LatencyRoutingPolicy), b.(*aws.LatencyRoutingPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.LatencyRoutingPolicy)(nil), (*LatencyRoutingPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_LatencyRoutingPolicy_To_v1alpha1_LatencyRoutingPolicy(a.(*aws.LatencyRoutingPolicy), b.(*LatencyRoutingPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LifecycleTransition)(nil), (*aws.LifecycleTransition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LifecycleTransition_To_aws_LifecycleTransition(a.(*LifecycleTransition), b.(*aws.LifecycleTransition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.LifecycleTransition)(nil), (*LifecycleTransition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_LifecycleTransition_To_v1alpha1_LifecycleTransition(a.(*aws.LifecycleTransition), b.(*LifecycleTransition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerAccessLogs)(nil), (*aws.LoadBalancerAccessLogs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerAccessLogs_To_aws_LoadBalancerAccessLogs(a.(*LoadBalancerAccessLogs), b.(*aws.LoadBalancerAccessLogs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.LoadBalancerAccessLogs)(nil), (*LoadBalancerAccessLogs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_LoadBalancerAccessLogs_To_v1alpha1_LoadBalancerAccessLogs(a.(*aws.LoadBalancerAccessLogs), b.(*LoadBalancerAccessLogs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerAccessLogsConfig)(nil), (*aws.LoadBalancerAccessLogsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerAccessLogsConfig_To_aws_LoadBalancerAccessLogsConfig(a.(*LoadBalancerAccessLogsConfig), b.(*aws.LoadBalancerAccessLogsConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.LoadBalancerAccessLogsConfig)(nil), (*LoadBalancerAccessLogsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_LoadBalancerAccessLogsConfig_To_v1alpha1_LoadBalancerAccessLogsConfig(a.(*aws.LoadBalancerAccessLogsConfig), b.(*LoadBalancerAccessLogsConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerAccessLogsStatus)(nil), (*aws.LoadBalancerAccessLogsStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerAccessLogsStatus_To_aws_LoadBalancerAccessLogsStatus(a.(*LoadBalancerAccessLogsStatus), b.(*aws.LoadBalancerAccessLogsStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.LoadBalancerAccessLogsStatus)(nil), (*LoadBalancerAccessLogsStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_LoadBalancerAccessLogsStatus_To_v1alpha1_LoadBalancerAccessLogsStatus(a.(*aws.LoadBalancerAccessLogsStatus), b.(*LoadBalancerAccessLogsStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerControllerConfig)(nil), (*aws.LoadBalancerControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerControllerConfig_To_aws_LoadBalancerControllerConfig(a.(*LoadBalancerControllerConfig), b.(*aws.LoadBalancerControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.LoadBalancerControllerConfig)(nil), (*LoadBalancerControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_LoadBalancerControllerConfig_To_v1alpha1_LoadBalancerControllerConfig(a.(*aws.LoadBalancerControllerConfig), b.(*LoadBalancerControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerDefaults)(nil), (*aws.LoadBalancerDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerDefaults_To_aws_LoadBalancerDefaults(a.(*LoadBalancerDefaults), b.(*aws.LoadBalancerDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.LoadBalancerDefaults)(nil), (*LoadBalancerDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_LoadBalancerDefaults_To_v1alpha1_LoadBalancerDefaults(a.(*aws.LoadBalancerDefaults), b.(*LoadBalancerDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineImage)(nil), (*aws.MachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineImage_To_aws_MachineImage(a.(*MachineImage), b.(*aws.MachineImage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.MachineImage)(nil), (*MachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_MachineImage_To_v1alpha1_MachineImage(a.(*aws.MachineImage), b.(*MachineImage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineImageVersion)(nil), (*aws.MachineImageVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineImageVersion_To_aws_MachineImageVersion(a.(*MachineImageVersion), b.(*aws.MachineImageVersion), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.MachineImageVersion)(nil), (*MachineImageVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_MachineImageVersion_To_v1alpha1_MachineImageVersion(a.(*aws.MachineImageVersion), b.(*MachineImageVersion), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineImages)(nil), (*aws.MachineImages)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineImages_To_aws_MachineImages(a.(*MachineImages), b.(*aws.MachineImages), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.MachineImages)(nil), (*MachineImages)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_MachineImages_To_v1alpha1_MachineImages(a.(*aws.MachineImages), b.(*MachineImages), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Monitoring)(nil), (*aws.Monitoring)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Monitoring_To_aws_Monitoring(a.(*Monitoring), b.(*aws.Monitoring), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.Monitoring)(nil), (*Monitoring)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_Monitoring_To_v1alpha1_Monitoring(a.(*aws.Monitoring), b.(*Monitoring), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NATGateway)(nil), (*aws.NATGateway)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NATGateway_To_aws_NATGateway(a.(*NATGateway), b.(*aws.NATGateway), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.NATGateway)(nil), (*NATGateway)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_NATGateway_To_v1alpha1_NATGateway(a.(*aws.NATGateway), b.(*NATGateway), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NATGatewayStatus)(nil), (*aws.NATGatewayStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NATGatewayStatus_To_aws_NATGatewayStatus(a.(*NATGatewayStatus), b.(*aws.NATGatewayStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.NATGatewayStatus)(nil), (*NATGatewayStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_NATGatewayStatus_To_v1alpha1_NATGatewayStatus(a.(*aws.NATGatewayStatus), b.(*NATGatewayStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NATInstance)(nil), (*aws.NATInstance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NATInstance_To_aws_NATInstance(a.(*NATInstance), b.(*aws.NATInstance), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.NATInstance)(nil), (*NATInstance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_NATInstance_To_v1alpha1_NATInstance(a.(*aws.NATInstance), b.(*NATInstance), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkACL)(nil), (*aws.NetworkACL)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkACL_To_aws_NetworkACL(a.(*NetworkACL), b.(*aws.NetworkACL), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.NetworkACL)(nil), (*NetworkACL)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_NetworkACL_To_v1alpha1_NetworkACL(a.(*aws.NetworkACL), b.(*NetworkACL), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkACLRule)(nil), (*aws.NetworkACLRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkACLRule_To_aws_NetworkACLRule(a.(*NetworkACLRule), b.(*aws.NetworkACLRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.NetworkACLRule)(nil), (*NetworkACLRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_NetworkACLRule_To_v1alpha1_NetworkACLRule(a.(*aws.NetworkACLRule), b.(*NetworkACLRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkACLs)(nil), (*aws.NetworkACLs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkACLs_To_aws_NetworkACLs(a.(*NetworkACLs), b.(*aws.NetworkACLs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.NetworkACLs)(nil), (*NetworkACLs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_NetworkACLs_To_v1alpha1_NetworkACLs(a.(*aws.NetworkACLs), b.(*NetworkACLs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkInterfaceSubnet)(nil), (*aws.NetworkInterfaceSubnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkInterfaceSubnet_To_aws_NetworkInterfaceSubnet(a.(*NetworkInterfaceSubnet), b.(*aws.NetworkInterfaceSubnet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.NetworkInterfaceSubnet)(nil), (*NetworkInterfaceSubnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_NetworkInterfaceSubnet_To_v1alpha1_NetworkInterfaceSubnet(a.(*aws.NetworkInterfaceSubnet), b.(*NetworkInterfaceSubnet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkInterfaces)(nil), (*aws.NetworkInterfaces)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkInterfaces_To_aws_NetworkInterfaces(a.(*NetworkInterfaces), b.(*aws.NetworkInterfaces), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.NetworkInterfaces)(nil), (*NetworkInterfaces)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_NetworkInterfaces_To_v1alpha1_NetworkInterfaces(a.(*aws.NetworkInterfaces), b.(*NetworkInterfaces), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Networks)(nil), (*aws.Networks)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Networks_To_aws_Networks(a.(*Networks), b.(*aws.Networks), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.Networks)(nil), (*Networks)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_Networks_To_v1alpha1_Networks(a.(*aws.Networks), b.(*Networks), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NodeSecurityGroupRule)(nil), (*aws.NodeSecurityGroupRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NodeSecurityGroupRule_To_aws_NodeSecurityGroupRule(a.(*NodeSecurityGroupRule), b.(*aws.NodeSecurityGroupRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.NodeSecurityGroupRule)(nil), (*NodeSecurityGroupRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_NodeSecurityGroupRule_To_v1alpha1_NodeSecurityGroupRule(a.(*aws.NodeSecurityGroupRule), b.(*NodeSecurityGroupRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ObjectLockConfig)(nil), (*aws.ObjectLockConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ObjectLockConfig_To_aws_ObjectLockConfig(a.(*ObjectLockConfig), b.(*aws.ObjectLockConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.ObjectLockConfig)(nil), (*ObjectLockConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_ObjectLockConfig_To_v1alpha1_ObjectLockConfig(a.(*aws.ObjectLockConfig), b.(*ObjectLockConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PlacementGroup)(nil), (*aws.PlacementGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PlacementGroup_To_aws_PlacementGroup(a.(*PlacementGroup), b.(*aws.PlacementGroup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.PlacementGroup)(nil), (*PlacementGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_PlacementGroup_To_v1alpha1_PlacementGroup(a.(*aws.PlacementGroup), b.(*PlacementGroup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PreProvisionedCapacity)(nil), (*aws.PreProvisionedCapacity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PreProvisionedCapacity_To_aws_PreProvisionedCapacity(a.(*PreProvisionedCapacity), b.(*aws.PreProvisionedCapacity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.PreProvisionedCapacity)(nil), (*PreProvisionedCapacity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_PreProvisionedCapacity_To_v1alpha1_PreProvisionedCapacity(a.(*aws.PreProvisionedCapacity), b.(*PreProvisionedCapacity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PrivateHostedZone)(nil), (*aws.PrivateHostedZone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PrivateHostedZone_To_aws_PrivateHostedZone(a.(*PrivateHostedZone), b.(*aws.PrivateHostedZone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.PrivateHostedZone)(nil), (*PrivateHostedZone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_PrivateHostedZone_To_v1alpha1_PrivateHostedZone(a.(*aws.PrivateHostedZone), b.(*PrivateHostedZone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PrivateLinkConfig)(nil), (*aws.PrivateLinkConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PrivateLinkConfig_To_aws_PrivateLinkConfig(a.(*PrivateLinkConfig), b.(*aws.PrivateLinkConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.PrivateLinkConfig)(nil), (*PrivateLinkConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_PrivateLinkConfig_To_v1alpha1_PrivateLinkConfig(a.(*aws.PrivateLinkConfig), b.(*PrivateLinkConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PrivateLinkStatus)(nil), (*aws.PrivateLinkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PrivateLinkStatus_To_aws_PrivateLinkStatus(a.(*PrivateLinkStatus), b.(*aws.PrivateLinkStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.PrivateLinkStatus)(nil), (*PrivateLinkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_PrivateLinkStatus_To_v1alpha1_PrivateLinkStatus(a.(*aws.PrivateLinkStatus), b.(*PrivateLinkStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RegionAMIMapping)(nil), (*aws.RegionAMIMapping)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RegionAMIMapping_To_aws_RegionAMIMapping(a.(*RegionAMIMapping), b.(*aws.RegionAMIMapping), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.RegionAMIMapping)(nil), (*RegionAMIMapping)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_RegionAMIMapping_To_v1alpha1_RegionAMIMapping(a.(*aws.RegionAMIMapping), b.(*RegionAMIMapping), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RegistryMirror)(nil), (*aws.RegistryMirror)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RegistryMirror_To_aws_RegistryMirror(a.(*RegistryMirror), b.(*aws.RegistryMirror), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.RegistryMirror)(nil), (*RegistryMirror)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_RegistryMirror_To_v1alpha1_RegistryMirror(a.(*aws.RegistryMirror), b.(*RegistryMirror), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Role)(nil), (*aws.Role)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Role_To_aws_Role(a.(*Role), b.(*aws.Role), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.Role)(nil), (*Role)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_Role_To_v1alpha1_Role(a.(*aws.Role), b.(*Role), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RollingUpdate)(nil), (*aws.RollingUpdate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RollingUpdate_To_aws_RollingUpdate(a.(*RollingUpdate), b.(*aws.RollingUpdate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.RollingUpdate)(nil), (*RollingUpdate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_RollingUpdate_To_v1alpha1_RollingUpdate(a.(*aws.RollingUpdate), b.(*RollingUpdate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Route53Resolver)(nil), (*aws.Route53Resolver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Route53Resolver_To_aws_Route53Resolver(a.(*Route53Resolver), b.(*aws.Route53Resolver), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.Route53Resolver)(nil), (*Route53Resolver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_Route53Resolver_To_v1alpha1_Route53Resolver(a.(*aws.Route53Resolver), b.(*Route53Resolver), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Route53ResolverForwardingRule)(nil), (*aws.Route53ResolverForwardingRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Route53ResolverForwardingRule_To_aws_Route53ResolverForwardingRule(a.(*Route53ResolverForwardingRule), b.(*aws.Route53ResolverForwardingRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.Route53ResolverForwardingRule)(nil), (*Route53ResolverForwardingRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_Route53ResolverForwardingRule_To_v1alpha1_Route53ResolverForwardingRule(a.(*aws.Route53ResolverForwardingRule), b.(*Route53ResolverForwardingRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Route53ResolverInboundEndpoint)(nil), (*aws.Route53ResolverInboundEndpoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Route53ResolverInboundEndpoint_To_aws_Route53ResolverInboundEndpoint(a.(*Route53ResolverInboundEndpoint), b.(*aws.Route53ResolverInboundEndpoint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.Route53ResolverInboundEndpoint)(nil), (*Route53ResolverInboundEndpoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_Route53ResolverInboundEndpoint_To_v1alpha1_Route53ResolverInboundEndpoint(a.(*aws.Route53ResolverInboundEndpoint), b.(*Route53ResolverInboundEndpoint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Route53ResolverStatus)(nil), (*aws.Route53ResolverStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Route53ResolverStatus_To_aws_Route53ResolverStatus(a.(*Route53ResolverStatus), b.(*aws.Route53ResolverStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.Route53ResolverStatus)(nil), (*Route53ResolverStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_Route53ResolverStatus_To_v1alpha1_Route53ResolverStatus(a.(*aws.Route53ResolverStatus), b.(*Route53ResolverStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RouteTableStatus)(nil), (*aws.RouteTableStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RouteTableStatus_To_aws_RouteTableStatus(a.(*RouteTableStatus), b.(*aws.RouteTableStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.RouteTableStatus)(nil), (*RouteTableStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_RouteTableStatus_To_v1alpha1_RouteTableStatus(a.(*aws.RouteTableStatus), b.(*RouteTableStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RoutingPolicy)(nil), (*aws.RoutingPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RoutingPolicy_To_aws_RoutingPolicy(a.(*RoutingPolicy), b.(*aws.RoutingPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.RoutingPolicy)(nil), (*RoutingPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_RoutingPolicy_To_v1alpha1_RoutingPolicy(a.(*aws.RoutingPolicy), b.(*RoutingPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SSMParameter)(nil), (*aws.SSMParameter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SSMParameter_To_aws_SSMParameter(a.(*SSMParameter), b.(*aws.SSMParameter), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.SSMParameter)(nil), (*SSMParameter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_SSMParameter_To_v1alpha1_SSMParameter(a.(*aws.SSMParameter), b.(*SSMParameter), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecurityGroup)(nil), (*aws.SecurityGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecurityGroup_To_aws_SecurityGroup(a.(*SecurityGroup), b.(*aws.SecurityGroup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.SecurityGroup)(nil), (*SecurityGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_SecurityGroup_To_v1alpha1_SecurityGroup(a.(*aws.SecurityGroup), b.(*SecurityGroup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SerialConsole)(nil), (*aws.SerialConsole)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SerialConsole_To_aws_SerialConsole(a.(*SerialConsole), b.(*aws.SerialConsole), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.SerialConsole)(nil), (*SerialConsole)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_SerialConsole_To_v1alpha1_SerialConsole(a.(*aws.SerialConsole), b.(*SerialConsole), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SnapshotControllerConfig)(nil), (*aws.SnapshotControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SnapshotControllerConfig_To_aws_SnapshotControllerConfig(a.(*SnapshotControllerConfig), b.(*aws.SnapshotControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.SnapshotControllerConfig)(nil), (*SnapshotControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_SnapshotControllerConfig_To_v1alpha1_SnapshotControllerConfig(a.(*aws.SnapshotControllerConfig), b.(*SnapshotControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SourceImage)(nil), (*aws.SourceImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SourceImage_To_aws_SourceImage(a.(*SourceImage), b.(*aws.SourceImage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.SourceImage)(nil), (*SourceImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_SourceImage_To_v1alpha1_SourceImage(a.(*aws.SourceImage), b.(*SourceImage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SpotInterruptionHandling)(nil), (*aws.SpotInterruptionHandling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SpotInterruptionHandling_To_aws_SpotInterruptionHandling(a.(*SpotInterruptionHandling), b.(*aws.SpotInterruptionHandling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.SpotInterruptionHandling)(nil), (*SpotInterruptionHandling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_SpotInterruptionHandling_To_v1alpha1_SpotInterruptionHandling(a.(*aws.SpotInterruptionHandling), b.(*SpotInterruptionHandling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Storage)(nil), (*aws.Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Storage_To_aws_Storage(a.(*Storage), b.(*aws.Storage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.Storage)(nil), (*Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_Storage_To_v1alpha1_Storage(a.(*aws.Storage), b.(*Storage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StorageClass)(nil), (*aws.StorageClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageClass_To_aws_StorageClass(a.(*StorageClass), b.(*aws.StorageClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.StorageClass)(nil), (*StorageClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_StorageClass_To_v1alpha1_StorageClass(a.(*aws.StorageClass), b.(*StorageClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StorageClassParameters)(nil), (*aws.StorageClassParameters)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageClassParameters_To_aws_StorageClassParameters(a.(*StorageClassParameters), b.(*aws.StorageClassParameters), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.StorageClassParameters)(nil), (*StorageClassParameters)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_StorageClassParameters_To_v1alpha1_StorageClassParameters(a.(*aws.StorageClassParameters), b.(*StorageClassParameters), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Subnet)(nil), (*aws.Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Subnet_To_aws_Subnet(a.(*Subnet), b.(*aws.Subnet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.Subnet)(nil), (*Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_Subnet_To_v1alpha1_Subnet(a.(*aws.Subnet), b.(*Subnet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TransitGateway)(nil), (*aws.TransitGateway)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TransitGateway_To_aws_TransitGateway(a.(*TransitGateway), b.(*aws.TransitGateway), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.TransitGateway)(nil), (*TransitGateway)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_TransitGateway_To_v1alpha1_TransitGateway(a.(*aws.TransitGateway), b.(*TransitGateway), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*UserData)(nil), (*aws.UserData)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_UserData_To_aws_UserData(a.(*UserData), b.(*aws.UserData), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.UserData)(nil), (*UserData)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_UserData_To_v1alpha1_UserData(a.(*aws.UserData), b.(*UserData), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*UserDataPart)(nil), (*aws.UserDataPart)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_UserDataPart_To_aws_UserDataPart(a.(*UserDataPart), b.(*aws.UserDataPart), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.UserDataPart)(nil), (*UserDataPart)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_UserDataPart_To_v1alpha1_UserDataPart(a.(*aws.UserDataPart), b.(*UserDataPart), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VPC)(nil), (*aws.VPC)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VPC_To_aws_VPC(a.(*VPC), b.(*aws.VPC), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.VPC)(nil), (*VPC)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_VPC_To_v1alpha1_VPC(a.(*aws.VPC), b.(*VPC), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VPCEndpoint)(nil), (*aws.VPCEndpoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VPCEndpoint_To_aws_VPCEndpoint(a.(*VPCEndpoint), b.(*aws.VPCEndpoint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.VPCEndpoint)(nil), (*VPCEndpoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_VPCEndpoint_To_v1alpha1_VPCEndpoint(a.(*aws.VPCEndpoint), b.(*VPCEndpoint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VPCEndpointStatus)(nil), (*aws.VPCEndpointStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VPCEndpointStatus_To_aws_VPCEndpointStatus(a.(*VPCEndpointStatus), b.(*aws.VPCEndpointStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.VPCEndpointStatus)(nil), (*VPCEndpointStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_VPCEndpointStatus_To_v1alpha1_VPCEndpointStatus(a.(*aws.VPCEndpointStatus), b.(*VPCEndpointStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VPCFlowLogs)(nil), (*aws.VPCFlowLogs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VPCFlowLogs_To_aws_VPCFlowLogs(a.(*VPCFlowLogs), b.(*aws.VPCFlowLogs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.VPCFlowLogs)(nil), (*VPCFlowLogs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_VPCFlowLogs_To_v1alpha1_VPCFlowLogs(a.(*aws.VPCFlowLogs), b.(*VPCFlowLogs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VPCFlowLogsCloudWatchLogs)(nil), (*aws.VPCFlowLogsCloudWatchLogs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VPCFlowLogsCloudWatchLogs_To_aws_VPCFlowLogsCloudWatchLogs(a.(*VPCFlowLogsCloudWatchLogs), b.(*aws.VPCFlowLogsCloudWatchLogs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.VPCFlowLogsCloudWatchLogs)(nil), (*VPCFlowLogsCloudWatchLogs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_VPCFlowLogsCloudWatchLogs_To_v1alpha1_VPCFlowLogsCloudWatchLogs(a.(*aws.VPCFlowLogsCloudWatchLogs), b.(*VPCFlowLogsCloudWatchLogs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VPCFlowLogsDestination)(nil), (*aws.VPCFlowLogsDestination)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VPCFlowLogsDestination_To_aws_VPCFlowLogsDestination(a.(*VPCFlowLogsDestination), b.(*aws.VPCFlowLogsDestination), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.VPCFlowLogsDestination)(nil), (*VPCFlowLogsDestination)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_VPCFlowLogsDestination_To_v1alpha1_VPCFlowLogsDestination(a.(*aws.VPCFlowLogsDestination), b.(*VPCFlowLogsDestination), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VPCFlowLogsS3)(nil), (*aws.VPCFlowLogsS3)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VPCFlowLogsS3_To_aws_VPCFlowLogsS3(a.(*VPCFlowLogsS3), b.(*aws.VPCFlowLogsS3), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.VPCFlowLogsS3)(nil), (*VPCFlowLogsS3)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_VPCFlowLogsS3_To_v1alpha1_VPCFlowLogsS3(a.(*aws.VPCFlowLogsS3), b.(*VPCFlowLogsS3), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VPCPeering)(nil), (*aws.VPCPeering)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VPCPeering_To_aws_VPCPeering(a.(*VPCPeering), b.(*aws.VPCPeering), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.VPCPeering)(nil), (*VPCPeering)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_VPCPeering_To_v1alpha1_VPCPeering(a.(*aws.VPCPeering), b.(*VPCPeering), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VPCPeeringStatus)(nil), (*aws.VPCPeeringStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VPCPeeringStatus_To_aws_VPCPeeringStatus(a.(*VPCPeeringStatus), b.(*aws.VPCPeeringStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.VPCPeeringStatus)(nil), (*VPCPeeringStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_VPCPeeringStatus_To_v1alpha1_VPCPeeringStatus(a.(*aws.VPCPeeringStatus), b.(*VPCPeeringStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VPCStatus)(nil), (*aws.VPCStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VPCStatus_To_aws_VPCStatus(a.(*VPCStatus), b.(*aws.VPCStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.VPCStatus)(nil), (*VPCStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_VPCStatus_To_v1alpha1_VPCStatus(a.(*aws.VPCStatus), b.(*VPCStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Volume)(nil), (*aws.Volume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Volume_To_aws_Volume(a.(*Volume), b.(*aws.Volume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.Volume)(nil), (*Volume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_Volume_To_v1alpha1_Volume(a.(*aws.Volume), b.(*Volume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WeightedRoutingPolicy)(nil), (*aws.WeightedRoutingPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WeightedRoutingPolicy_To_aws_WeightedRoutingPolicy(a.(*WeightedRoutingPolicy), b.(*aws.WeightedRoutingPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.WeightedRoutingPolicy)(nil), (*WeightedRoutingPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_WeightedRoutingPolicy_To_v1alpha1_WeightedRoutingPolicy(a.(*aws.WeightedRoutingPolicy), b.(*WeightedRoutingPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*aws.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerConfig_To_aws_WorkerConfig(a.(*WorkerConfig), b.(*aws.WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.WorkerConfig)(nil), (*WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_WorkerConfig_To_v1alpha1_WorkerConfig(a.(*aws.WorkerConfig), b.(*WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerStatus)(nil), (*aws.WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerStatus_To_aws_WorkerStatus(a.(*WorkerStatus), b.(*aws.WorkerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.WorkerStatus)(nil), (*WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_WorkerStatus_To_v1alpha1_WorkerStatus(a.(*aws.WorkerStatus), b.(*WorkerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Zone)(nil), (*aws.Zone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Zone_To_aws_Zone(a.(*Zone), b.(*aws.Zone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*aws.Zone)(nil), (*Zone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_aws_Zone_To_v1alpha1_Zone(a.(*aws.Zone), b.(*Zone), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_AdditionalNetworkInterface_To_aws_AdditionalNetworkInterface(in *AdditionalNetworkInterface, out *aws.AdditionalNetworkInterface, s conversion.Scope) error {
+	out.EFA = in.EFA
+	out.Count = (*int32)(unsafe.Pointer(in.Count))
+	out.Subnets = *(*[]aws.NetworkInterfaceSubnet)(unsafe.Pointer(&in.Subnets))
+	out.SecurityGroupIDs = *(*[]string)(unsafe.Pointer(&in.SecurityGroupIDs))
+	return nil
+}
+
+// Convert_v1alpha1_AdditionalNetworkInterface_To_aws_AdditionalNetworkInterface is an autogenerated conversion function.
+func Convert_v1alpha1_AdditionalNetworkInterface_To_aws_AdditionalNetworkInterface(in *AdditionalNetworkInterface, out *aws.AdditionalNetworkInterface, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AdditionalNetworkInterface_To_aws_AdditionalNetworkInterface(in, out, s)
+}
+
+func autoConvert_aws_AdditionalNetworkInterface_To_v1alpha1_AdditionalNetworkInterface(in *aws.AdditionalNetworkInterface, out *AdditionalNetworkInterface, s conversion.Scope) error {
+	out.EFA = in.EFA
+	out.Count = (*int32)(unsafe.Pointer(in.Count))
+	out.Subnets = *(*[]NetworkInterfaceSubnet)(unsafe.Pointer(&in.Subnets))
+	out.SecurityGroupIDs = *(*[]string)(unsafe.Pointer(&in.SecurityGroupIDs))
+	return nil
+}
+
+// Convert_aws_AdditionalNetworkInterface_To_v1alpha1_AdditionalNetworkInterface is an autogenerated conversion function.
+func Convert_aws_AdditionalNetworkInterface_To_v1alpha1_AdditionalNetworkInterface(in *aws.AdditionalNetworkInterface, out *AdditionalNetworkInterface, s conversion.Scope) error {
+	return autoConvert_aws_AdditionalNetworkInterface_To_v1alpha1_AdditionalNetworkInterface(in, out, s)
+}
+
+func autoConvert_v1alpha1_BackupBucketConfig_To_aws_BackupBucketConfig(in *BackupBucketConfig, out *aws.BackupBucketConfig, s conversion.Scope) error {
+	out.ObjectLock = (*aws.ObjectLockConfig)(unsafe.Pointer(in.ObjectLock))
+	out.Encryption = (*aws.BucketEncryption)(unsafe.Pointer(in.Encryption))
+	out.Lifecycle = (*aws.BucketLifecycle)(unsafe.Pointer(in.Lifecycle))
+	out.Replication = (*aws.BucketReplication)(unsafe.Pointer(in.Replication))
+	out.AccessLogging = (*aws.BucketAccessLogging)(unsafe.Pointer(in.AccessLogging))
+	out.Hardening = (*aws.BucketHardening)(unsafe.Pointer(in.Hardening))
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketConfig_To_aws_BackupBucketConfig is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketConfig_To_aws_BackupBucketConfig(in *BackupBucketConfig, out *aws.BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketConfig_To_aws_BackupBucketConfig(in, out, s)
+}
+
+func autoConvert_aws_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *aws.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	out.ObjectLock = (*ObjectLockConfig)(unsafe.Pointer(in.ObjectLock))
+	out.Encryption = (*BucketEncryption)(unsafe.Pointer(in.Encryption))
+	out.Lifecycle = (*BucketLifecycle)(unsafe.Pointer(in.Lifecycle))
+	out.Replication = (*BucketReplication)(unsafe.Pointer(in.Replication))
+	out.AccessLogging = (*BucketAccessLogging)(unsafe.Pointer(in.AccessLogging))
+	out.Hardening = (*BucketHardening)(unsafe.Pointer(in.Hardening))
+	return nil
+}
+
+// Convert_aws_BackupBucketConfig_To_v1alpha1_BackupBucketConfig is an autogenerated conversion function.
+func Convert_aws_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *aws.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_aws_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_BastionConfig_To_aws_BastionConfig(in *BastionConfig, out *aws.BastionConfig, s conversion.Scope) error {
+	out.InstanceType = (*string)(unsafe.Pointer(in.InstanceType))
+	out.AMI = (*string)(unsafe.Pointer(in.AMI))
+	out.SessionManager = (*bool)(unsafe.Pointer(in.SessionManager))
+	return nil
+}
+
+// Convert_v1alpha1_BastionConfig_To_aws_BastionConfig is an autogenerated conversion function.
+func Convert_v1alpha1_BastionConfig_To_aws_BastionConfig(in *BastionConfig, out *aws.BastionConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BastionConfig_To_aws_BastionConfig(in, out, s)
+}
+
+func autoConvert_aws_BastionConfig_To_v1alpha1_BastionConfig(in *aws.BastionConfig, out *BastionConfig, s conversion.Scope) error {
+	out.InstanceType = (*string)(unsafe.Pointer(in.InstanceType))
+	out.AMI = (*string)(unsafe.Pointer(in.AMI))
+	out.SessionManager = (*bool)(unsafe.Pointer(in.SessionManager))
+	return nil
+}
+
+// Convert_aws_BastionConfig_To_v1alpha1_BastionConfig is an autogenerated conversion function.
+func Convert_aws_BastionConfig_To_v1alpha1_BastionConfig(in *aws.BastionConfig, out *BastionConfig, s conversion.Scope) error {
+	return autoConvert_aws_BastionConfig_To_v1alpha1_BastionConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_BucketAccessLogging_To_aws_BucketAccessLogging(in *BucketAccessLogging, out *aws.BucketAccessLogging, s conversion.Scope) error {
+	out.TargetBucket = in.TargetBucket
+	out.TargetPrefix = (*string)(unsafe.Pointer(in.TargetPrefix))
+	return nil
+}
+
+// Convert_v1alpha1_BucketAccessLogging_To_aws_BucketAccessLogging is an autogenerated conversion function.
+func Convert_v1alpha1_BucketAccessLogging_To_aws_BucketAccessLogging(in *BucketAccessLogging, out *aws.BucketAccessLogging, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BucketAccessLogging_To_aws_BucketAccessLogging(in, out, s)
+}
+
+func autoConvert_aws_BucketAccessLogging_To_v1alpha1_BucketAccessLogging(in *aws.BucketAccessLogging, out *BucketAccessLogging, s conversion.Scope) error {
+	out.TargetBucket = in.TargetBucket
+	out.TargetPrefix = (*string)(unsafe.Pointer(in.TargetPrefix))
+	return nil
+}
+
+// Convert_aws_BucketAccessLogging_To_v1alpha1_BucketAccessLogging is an autogenerated conversion function.
+func Convert_aws_BucketAccessLogging_To_v1alpha1_BucketAccessLogging(in *aws.BucketAccessLogging, out *BucketAccessLogging, s conversion.Scope) error {
+	return autoConvert_aws_BucketAccessLogging_To_v1alpha1_BucketAccessLogging(in, out, s)
+}
+
+func autoConvert_v1alpha1_BucketEncryption_To_aws_BucketEncryption(in *BucketEncryption, out *aws.BucketEncryption, s conversion.Scope) error {
+	out.KMSKeyARN = in.KMSKeyARN
+	out.BucketKeyEnabled = (*bool)(unsafe.Pointer(in.BucketKeyEnabled))
+	return nil
+}
+
+// Convert_v1alpha1_BucketEncryption_To_aws_BucketEncryption is an autogenerated conversion function.
+func Convert_v1alpha1_BucketEncryption_To_aws_BucketEncryption(in *BucketEncryption, out *aws.BucketEncryption, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BucketEncryption_To_aws_BucketEncryption(in, out, s)
+}
+
+func autoConvert_aws_BucketEncryption_To_v1alpha1_BucketEncryption(in *aws.BucketEncryption, out *BucketEncryption, s conversion.Scope) error {
+	out.KMSKeyARN = in.KMSKeyARN
+	out.BucketKeyEnabled = (*bool)(unsafe.Pointer(in.BucketKeyEnabled))
+	return nil
+}
+
+// Convert_aws_BucketEncryption_To_v1alpha1_BucketEncryption is an autogenerated conversion function.
+func Convert_aws_BucketEncryption_To_v1alpha1_BucketEncryption(in *aws.BucketEncryption, out *BucketEncryption, s conversion.Scope) error {
+	return autoConvert_aws_BucketEncryption_To_v1alpha1_BucketEncryption(in, out, s)
+}
+
+func autoConvert_v1alpha1_BucketHardening_To_aws_BucketHardening(in *BucketHardening, out *aws.BucketHardening, s conversion.Scope) error {
+	out.MinimumTLSVersion = (*string)(unsafe.Pointer(in.MinimumTLSVersion))
+	out.BucketOwnerEnforced = (*bool)(unsafe.Pointer(in.BucketOwnerEnforced))
+	return nil
+}
+
+// Convert_v1alpha1_BucketHardening_To_aws_BucketHardening is an autogenerated conversion function.
+func Convert_v1alpha1_BucketHardening_To_aws_BucketHardening(in *BucketHardening, out *aws.BucketHardening, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BucketHardening_To_aws_BucketHardening(in, out, s)
+}
+
+func autoConvert_aws_BucketHardening_To_v1alpha1_BucketHardening(in *aws.BucketHardening, out *BucketHardening, s conversion.Scope) error {
+	out.MinimumTLSVersion = (*string)(unsafe.Pointer(in.MinimumTLSVersion))
+	out.BucketOwnerEnforced = (*bool)(unsafe.Pointer(in.BucketOwnerEnforced))
+	return nil
+}
+
+// Convert_aws_BucketHardening_To_v1alpha1_BucketHardening is an autogenerated conversion function.
+func Convert_aws_BucketHardening_To_v1alpha1_BucketHardening(in *aws.BucketHardening, out *BucketHardening, s conversion.Scope) error {
+	return autoConvert_aws_BucketHardening_To_v1alpha1_BucketHardening(in, out, s)
+}
+
+func autoConvert_v1alpha1_BucketLifecycle_To_aws_BucketLifecycle(in *BucketLifecycle, out *aws.BucketLifecycle, s conversion.Scope) error {
+	out.Transitions = *(*[]aws.LifecycleTransition)(unsafe.Pointer(&in.Transitions))
+	out.ExpirationDays = (*int32)(unsafe.Pointer(in.ExpirationDays))
+	out.AbortIncompleteMultipartUploadDays = (*int32)(unsafe.Pointer(in.AbortIncompleteMultipartUploadDays))
+	return nil
+}
+
+// Convert_v1alpha1_BucketLifecycle_To_aws_BucketLifecycle is an autogenerated conversion function.
+func Convert_v1alpha1_BucketLifecycle_To_aws_BucketLifecycle(in *BucketLifecycle, out *aws.BucketLifecycle, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BucketLifecycle_To_aws_BucketLifecycle(in, out, s)
+}
+
+func autoConvert_aws_BucketLifecycle_To_v1alpha1_BucketLifecycle(in *aws.BucketLifecycle, out *BucketLifecycle, s conversion.Scope) error {
+	out.Transitions = *(*[]LifecycleTransition)(unsafe.Pointer(&in.Transitions))
+	out.ExpirationDays = (*int32)(unsafe.Pointer(in.ExpirationDays))
+	out.AbortIncompleteMultipartUploadDays = (*int32)(unsafe.Pointer(in.AbortIncompleteMultipartUploadDays))
+	return nil
+}
+
+// Convert_aws_BucketLifecycle_To_v1alpha1_BucketLifecycle is an autogenerated conversion function.
+func Convert_aws_BucketLifecycle_To_v1alpha1_BucketLifecycle(in *aws.BucketLifecycle, out *BucketLifecycle, s conversion.Scope) error {
+	return autoConvert_aws_BucketLifecycle_To_v1alpha1_BucketLifecycle(in, out, s)
+}
+
+func autoConvert_v1alpha1_BucketReplication_To_aws_BucketReplication(in *BucketReplication, out *aws.BucketReplication, s conversion.Scope) error {
+	out.DestinationBucket = in.DestinationBucket
+	out.DestinationRegion = in.DestinationRegion
+	out.KMSKeyARN = (*string)(unsafe.Pointer(in.KMSKeyARN))
+	out.ReplicateDeletes = (*bool)(unsafe.Pointer(in.ReplicateDeletes))
+	return nil
+}
+
+// Convert_v1alpha1_BucketReplication_To_aws_BucketReplication is an autogenerated conversion function.
+func Convert_v1alpha1_BucketReplication_To_aws_BucketReplication(in *BucketReplication, out *aws.BucketReplication, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BucketReplication_To_aws_BucketReplication(in, out, s)
+}
+
+func autoConvert_aws_BucketReplication_To_v1alpha1_BucketReplication(in *aws.BucketReplication, out *BucketReplication, s conversion.Scope) error {
+	out.DestinationBucket = in.DestinationBucket
+	out.DestinationRegion = in.DestinationRegion
+	out.KMSKeyARN = (*string)(unsafe.Pointer(in.KMSKeyARN))
+	out.ReplicateDeletes = (*bool)(unsafe.Pointer(in.ReplicateDeletes))
+	return nil
+}
+
+// Convert_aws_BucketReplication_To_v1alpha1_BucketReplication is an autogenerated conversion function.
+func Convert_aws_BucketReplication_To_v1alpha1_BucketReplication(in *aws.BucketReplication, out *BucketReplication, s conversion.Scope) error {
+	return autoConvert_aws_BucketReplication_To_v1alpha1_BucketReplication(in, out, s)
+}
+
+func autoConvert_v1alpha1_CPUOptions_To_aws_CPUOptions(in *CPUOptions, out *aws.CPUOptions, s conversion.Scope) error {
+	out.AmdSevSnp = (*aws.AmdSevSnpSpecification)(unsafe.Pointer(in.AmdSevSnp))
+	return nil
+}
+
+// Convert_v1alpha1_CPUOptions_To_aws_CPUOptions is an autogenerated conversion function.
+func Convert_v1alpha1_CPUOptions_To_aws_CPUOptions(in *CPUOptions, out *aws.CPUOptions, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CPUOptions_To_aws_CPUOptions(in, out, s)
+}
+
+func autoConvert_aws_CPUOptions_To_v1alpha1_CPUOptions(in *aws.CPUOptions, out *CPUOptions, s conversion.Scope) error {
+	out.AmdSevSnp = (*AmdSevSnpSpecification)(unsafe.Pointer(in.AmdSevSnp))
+	return nil
+}
+
+// Convert_aws_CPUOptions_To_v1alpha1_CPUOptions is an autogenerated conversion function.
+func Convert_aws_CPUOptions_To_v1alpha1_CPUOptions(in *aws.CPUOptions, out *CPUOptions, s conversion.Scope) error {
+	return autoConvert_aws_CPUOptions_To_v1alpha1_CPUOptions(in, out, s)
+}
+
+func autoConvert_v1alpha1_CapacityReservation_To_aws_CapacityReservation(in *CapacityReservation, out *aws.CapacityReservation, s conversion.Scope) error {
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	out.ResourceGroupArn = (*string)(unsafe.Pointer(in.ResourceGroupArn))
+	out.Preference = (*aws.CapacityReservationPreference)(unsafe.Pointer(in.Preference))
+	return nil
+}
+
+// Convert_v1alpha1_CapacityReservation_To_aws_CapacityReservation is an autogenerated conversion function.
+func Convert_v1alpha1_CapacityReservation_To_aws_CapacityReservation(in *CapacityReservation, out *aws.CapacityReservation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CapacityReservation_To_aws_CapacityReservation(in, out, s)
+}
+
+func autoConvert_aws_CapacityReservation_To_v1alpha1_CapacityReservation(in *aws.CapacityReservation, out *CapacityReservation, s conversion.Scope) error {
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	out.ResourceGroupArn = (*string)(unsafe.Pointer(in.ResourceGroupArn))
+	out.Preference = (*CapacityReservationPreference)(unsafe.Pointer(in.Preference))
+	return nil
+}
+
+// Convert_aws_CapacityReservation_To_v1alpha1_CapacityReservation is an autogenerated conversion function.
+func Convert_aws_CapacityReservation_To_v1alpha1_CapacityReservation(in *aws.CapacityReservation, out *CapacityReservation, s conversion.Scope) error {
+	return autoConvert_aws_CapacityReservation_To_v1alpha1_CapacityReservation(in, out, s)
+}
+
+func autoConvert_v1alpha1_CloudControllerManagerConfig_To_aws_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *aws.CloudControllerManagerConfig, s conversion.Scope) error {
+	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.UseCustomRouteController = (*bool)(unsafe.Pointer(in.UseCustomRouteController))
+	out.ConcurrentServiceSyncs = (*int32)(unsafe.Pointer(in.ConcurrentServiceSyncs))
+	out.NodeStatusUpdateFrequency = (*v1.Duration)(unsafe.Pointer(in.NodeStatusUpdateFrequency))
+	out.NodeIPFamilies = *(*[]aws.IPFamily)(unsafe.Pointer(&in.NodeIPFamilies))
+	out.AllocateNodeCIDRs = (*bool)(unsafe.Pointer(in.AllocateNodeCIDRs))
+	return nil
+}
+
+// Convert_v1alpha1_CloudControllerManagerConfig_To_aws_CloudControllerManagerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CloudControllerManagerConfig_To_aws_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *aws.CloudControllerManagerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudControllerManagerConfig_To_aws_CloudControllerManagerConfig(in, out, s)
+}
+
+func autoConvert_aws_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in *aws.CloudControllerManagerConfig, out *CloudControllerManagerConfig, s conversion.Scope) error {
+	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.UseCustomRouteController = (*bool)(unsafe.Pointer(in.UseCustomRouteController))
+	out.ConcurrentServiceSyncs = (*int32)(unsafe.Pointer(in.ConcurrentServiceSyncs))
+	out.NodeStatusUpdateFrequency = (*v1.Duration)(unsafe.Pointer(in.NodeStatusUpdateFrequency))
+	out.NodeIPFamilies = *(*[]IPFamily)(unsafe.Pointer(&in.NodeIPFamilies))
+	out.AllocateNodeCIDRs = (*bool)(unsafe.Pointer(in.AllocateNodeCIDRs))
+	return nil
+}
+
 // Convert_aws_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig is an autogenerated conversion function.
 func Convert_aws_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in *aws.CloudControllerManagerConfig, out *CloudControllerManagerConfig, s conversion.Scope) error {
 	return autoConvert_aws_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in, out, s)
 }
 
-func autoConvert_v1alpha1_CloudProfileConfig_To_aws_CloudProfileConfig(in *CloudProfileConfig, out *aws.CloudProfileConfig, s conversion.Scope) error {
-	out.MachineImages = *(*[]aws.MachineImages)(unsafe.Pointer(&in.MachineImages))
+func autoConvert_v1alpha1_CloudProfileConfig_To_aws_CloudProfileConfig(in *CloudProfileConfig, out *aws.CloudProfileConfig, s conversion.Scope) error {
+	out.MachineImages = *(*[]aws.MachineImages)(unsafe.Pointer(&in.MachineImages))
+	return nil
+}
+
+// Convert_v1alpha1_CloudProfileConfig_To_aws_CloudProfileConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CloudProfileConfig_To_aws_CloudProfileConfig(in *CloudProfileConfig, out *aws.CloudProfileConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudProfileConfig_To_aws_CloudProfileConfig(in, out, s)
+}
+
+func autoConvert_aws_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *aws.CloudProfileConfig, out *CloudProfileConfig, s conversion.Scope) error {
+	out.MachineImages = *(*[]MachineImages)(unsafe.Pointer(&in.MachineImages))
+	return nil
+}
+
+// Convert_aws_CloudProfileConfig_To_v1alpha1_CloudProfileConfig is an autogenerated conversion function.
+func Convert_aws_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *aws.CloudProfileConfig, out *CloudProfileConfig, s conversion.Scope) error {
+	return autoConvert_aws_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_CloudWatchAgentConfig_To_aws_CloudWatchAgentConfig(in *CloudWatchAgentConfig, out *aws.CloudWatchAgentConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.MetricsCollectionInterval = (*int32)(unsafe.Pointer(in.MetricsCollectionInterval))
+	out.Logs = (*aws.CloudWatchAgentLogs)(unsafe.Pointer(in.Logs))
+	return nil
+}
+
+// Convert_v1alpha1_CloudWatchAgentConfig_To_aws_CloudWatchAgentConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CloudWatchAgentConfig_To_aws_CloudWatchAgentConfig(in *CloudWatchAgentConfig, out *aws.CloudWatchAgentConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudWatchAgentConfig_To_aws_CloudWatchAgentConfig(in, out, s)
+}
+
+func autoConvert_aws_CloudWatchAgentConfig_To_v1alpha1_CloudWatchAgentConfig(in *aws.CloudWatchAgentConfig, out *CloudWatchAgentConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.MetricsCollectionInterval = (*int32)(unsafe.Pointer(in.MetricsCollectionInterval))
+	out.Logs = (*CloudWatchAgentLogs)(unsafe.Pointer(in.Logs))
+	return nil
+}
+
+// Convert_aws_CloudWatchAgentConfig_To_v1alpha1_CloudWatchAgentConfig is an autogenerated conversion function.
+func Convert_aws_CloudWatchAgentConfig_To_v1alpha1_CloudWatchAgentConfig(in *aws.CloudWatchAgentConfig, out *CloudWatchAgentConfig, s conversion.Scope) error {
+	return autoConvert_aws_CloudWatchAgentConfig_To_v1alpha1_CloudWatchAgentConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_CloudWatchAgentLogs_To_aws_CloudWatchAgentLogs(in *CloudWatchAgentLogs, out *aws.CloudWatchAgentLogs, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.RetentionInDays = (*int32)(unsafe.Pointer(in.RetentionInDays))
+	return nil
+}
+
+// Convert_v1alpha1_CloudWatchAgentLogs_To_aws_CloudWatchAgentLogs is an autogenerated conversion function.
+func Convert_v1alpha1_CloudWatchAgentLogs_To_aws_CloudWatchAgentLogs(in *CloudWatchAgentLogs, out *aws.CloudWatchAgentLogs, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudWatchAgentLogs_To_aws_CloudWatchAgentLogs(in, out, s)
+}
+
+func autoConvert_aws_CloudWatchAgentLogs_To_v1alpha1_CloudWatchAgentLogs(in *aws.CloudWatchAgentLogs, out *CloudWatchAgentLogs, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.RetentionInDays = (*int32)(unsafe.Pointer(in.RetentionInDays))
+	return nil
+}
+
+// Convert_aws_CloudWatchAgentLogs_To_v1alpha1_CloudWatchAgentLogs is an autogenerated conversion function.
+func Convert_aws_CloudWatchAgentLogs_To_v1alpha1_CloudWatchAgentLogs(in *aws.CloudWatchAgentLogs, out *CloudWatchAgentLogs, s conversion.Scope) error {
+	return autoConvert_aws_CloudWatchAgentLogs_To_v1alpha1_CloudWatchAgentLogs(in, out, s)
+}
+
+func autoConvert_v1alpha1_CloudWatchAgentStatus_To_aws_CloudWatchAgentStatus(in *CloudWatchAgentStatus, out *aws.CloudWatchAgentStatus, s conversion.Scope) error {
+	out.RolePolicyName = in.RolePolicyName
+	out.LogGroupName = (*string)(unsafe.Pointer(in.LogGroupName))
+	return nil
+}
+
+// Convert_v1alpha1_CloudWatchAgentStatus_To_aws_CloudWatchAgentStatus is an autogenerated conversion function.
+func Convert_v1alpha1_CloudWatchAgentStatus_To_aws_CloudWatchAgentStatus(in *CloudWatchAgentStatus, out *aws.CloudWatchAgentStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudWatchAgentStatus_To_aws_CloudWatchAgentStatus(in, out, s)
+}
+
+func autoConvert_aws_CloudWatchAgentStatus_To_v1alpha1_CloudWatchAgentStatus(in *aws.CloudWatchAgentStatus, out *CloudWatchAgentStatus, s conversion.Scope) error {
+	out.RolePolicyName = in.RolePolicyName
+	out.LogGroupName = (*string)(unsafe.Pointer(in.LogGroupName))
+	return nil
+}
+
+// Convert_aws_CloudWatchAgentStatus_To_v1alpha1_CloudWatchAgentStatus is an autogenerated conversion function.
+func Convert_aws_CloudWatchAgentStatus_To_v1alpha1_CloudWatchAgentStatus(in *aws.CloudWatchAgentStatus, out *CloudWatchAgentStatus, s conversion.Scope) error {
+	return autoConvert_aws_CloudWatchAgentStatus_To_v1alpha1_CloudWatchAgentStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_ContainerdConfig_To_aws_ContainerdConfig(in *ContainerdConfig, out *aws.ContainerdConfig, s conversion.Scope) error {
+	out.RegistryMirrors = *(*[]aws.RegistryMirror)(unsafe.Pointer(&in.RegistryMirrors))
+	out.ConfigPatch = (*string)(unsafe.Pointer(in.ConfigPatch))
+	return nil
+}
+
+// Convert_v1alpha1_ContainerdConfig_To_aws_ContainerdConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ContainerdConfig_To_aws_ContainerdConfig(in *ContainerdConfig, out *aws.ContainerdConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ContainerdConfig_To_aws_ContainerdConfig(in, out, s)
+}
+
+func autoConvert_aws_ContainerdConfig_To_v1alpha1_ContainerdConfig(in *aws.ContainerdConfig, out *ContainerdConfig, s conversion.Scope) error {
+	out.RegistryMirrors = *(*[]RegistryMirror)(unsafe.Pointer(&in.RegistryMirrors))
+	out.ConfigPatch = (*string)(unsafe.Pointer(in.ConfigPatch))
+	return nil
+}
+
+// Convert_aws_ContainerdConfig_To_v1alpha1_ContainerdConfig is an autogenerated conversion function.
+func Convert_aws_ContainerdConfig_To_v1alpha1_ContainerdConfig(in *aws.ContainerdConfig, out *ContainerdConfig, s conversion.Scope) error {
+	return autoConvert_aws_ContainerdConfig_To_v1alpha1_ContainerdConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_ControlPlaneConfig_To_aws_ControlPlaneConfig(in *ControlPlaneConfig, out *aws.ControlPlaneConfig, s conversion.Scope) error {
+	out.CloudControllerManager = (*aws.CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.LoadBalancerController = (*aws.LoadBalancerControllerConfig)(unsafe.Pointer(in.LoadBalancerController))
+	out.LoadBalancerDefaults = (*aws.LoadBalancerDefaults)(unsafe.Pointer(in.LoadBalancerDefaults))
+	out.Storage = (*aws.Storage)(unsafe.Pointer(in.Storage))
+	out.IRSA = (*aws.IRSAConfig)(unsafe.Pointer(in.IRSA))
+	out.Karpenter = (*aws.KarpenterConfig)(unsafe.Pointer(in.Karpenter))
+	out.PrivateLink = (*aws.PrivateLinkConfig)(unsafe.Pointer(in.PrivateLink))
+	out.CloudWatchAgent = (*aws.CloudWatchAgentConfig)(unsafe.Pointer(in.CloudWatchAgent))
+	return nil
+}
+
+// Convert_v1alpha1_ControlPlaneConfig_To_aws_ControlPlaneConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ControlPlaneConfig_To_aws_ControlPlaneConfig(in *ControlPlaneConfig, out *aws.ControlPlaneConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ControlPlaneConfig_To_aws_ControlPlaneConfig(in, out, s)
+}
+
+func autoConvert_aws_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *aws.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
+	out.CloudControllerManager = (*CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.LoadBalancerController = (*LoadBalancerControllerConfig)(unsafe.Pointer(in.LoadBalancerController))
+	out.LoadBalancerDefaults = (*LoadBalancerDefaults)(unsafe.Pointer(in.LoadBalancerDefaults))
+	out.Storage = (*Storage)(unsafe.Pointer(in.Storage))
+	out.IRSA = (*IRSAConfig)(unsafe.Pointer(in.IRSA))
+	out.Karpenter = (*KarpenterConfig)(unsafe.Pointer(in.Karpenter))
+	out.PrivateLink = (*PrivateLinkConfig)(unsafe.Pointer(in.PrivateLink))
+	out.CloudWatchAgent = (*CloudWatchAgentConfig)(unsafe.Pointer(in.CloudWatchAgent))
+	return nil
+}
+
+// Convert_aws_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig is an autogenerated conversion function.
+func Convert_aws_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *aws.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
+	return autoConvert_aws_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_ControlPlaneStatus_To_aws_ControlPlaneStatus(in *ControlPlaneStatus, out *aws.ControlPlaneStatus, s conversion.Scope) error {
+	out.IRSA = (*aws.IRSAStatus)(unsafe.Pointer(in.IRSA))
+	out.Karpenter = (*aws.KarpenterStatus)(unsafe.Pointer(in.Karpenter))
+	out.PrivateLink = (*aws.PrivateLinkStatus)(unsafe.Pointer(in.PrivateLink))
+	out.CloudWatchAgent = (*aws.CloudWatchAgentStatus)(unsafe.Pointer(in.CloudWatchAgent))
+	return nil
+}
+
+// Convert_v1alpha1_ControlPlaneStatus_To_aws_ControlPlaneStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ControlPlaneStatus_To_aws_ControlPlaneStatus(in *ControlPlaneStatus, out *aws.ControlPlaneStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ControlPlaneStatus_To_aws_ControlPlaneStatus(in, out, s)
+}
+
+func autoConvert_aws_ControlPlaneStatus_To_v1alpha1_ControlPlaneStatus(in *aws.ControlPlaneStatus, out *ControlPlaneStatus, s conversion.Scope) error {
+	out.IRSA = (*IRSAStatus)(unsafe.Pointer(in.IRSA))
+	out.Karpenter = (*KarpenterStatus)(unsafe.Pointer(in.Karpenter))
+	out.PrivateLink = (*PrivateLinkStatus)(unsafe.Pointer(in.PrivateLink))
+	out.CloudWatchAgent = (*CloudWatchAgentStatus)(unsafe.Pointer(in.CloudWatchAgent))
+	return nil
+}
+
+// Convert_aws_ControlPlaneStatus_To_v1alpha1_ControlPlaneStatus is an autogenerated conversion function.
+func Convert_aws_ControlPlaneStatus_To_v1alpha1_ControlPlaneStatus(in *aws.ControlPlaneStatus, out *ControlPlaneStatus, s conversion.Scope) error {
+	return autoConvert_aws_ControlPlaneStatus_To_v1alpha1_ControlPlaneStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_DHCPOptions_To_aws_DHCPOptions(in *DHCPOptions, out *aws.DHCPOptions, s conversion.Scope) error {
+	out.DomainName = (*string)(unsafe.Pointer(in.DomainName))
+	out.DomainNameServers = *(*[]string)(unsafe.Pointer(&in.DomainNameServers))
+	out.NTPServers = *(*[]string)(unsafe.Pointer(&in.NTPServers))
+	return nil
+}
+
+// Convert_v1alpha1_DHCPOptions_To_aws_DHCPOptions is an autogenerated conversion function.
+func Convert_v1alpha1_DHCPOptions_To_aws_DHCPOptions(in *DHCPOptions, out *aws.DHCPOptions, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DHCPOptions_To_aws_DHCPOptions(in, out, s)
+}
+
+func autoConvert_aws_DHCPOptions_To_v1alpha1_DHCPOptions(in *aws.DHCPOptions, out *DHCPOptions, s conversion.Scope) error {
+	out.DomainName = (*string)(unsafe.Pointer(in.DomainName))
+	out.DomainNameServers = *(*[]string)(unsafe.Pointer(&in.DomainNameServers))
+	out.NTPServers = *(*[]string)(unsafe.Pointer(&in.NTPServers))
+	return nil
+}
+
+// Convert_aws_DHCPOptions_To_v1alpha1_DHCPOptions is an autogenerated conversion function.
+func Convert_aws_DHCPOptions_To_v1alpha1_DHCPOptions(in *aws.DHCPOptions, out *DHCPOptions, s conversion.Scope) error {
+	return autoConvert_aws_DHCPOptions_To_v1alpha1_DHCPOptions(in, out, s)
+}
+
+func autoConvert_v1alpha1_DNSRecordConfig_To_aws_DNSRecordConfig(in *DNSRecordConfig, out *aws.DNSRecordConfig, s conversion.Scope) error {
+	out.RoutingPolicy = (*aws.RoutingPolicy)(unsafe.Pointer(in.RoutingPolicy))
+	out.HealthCheckID = (*string)(unsafe.Pointer(in.HealthCheckID))
+	out.HealthCheck = (*aws.HealthCheck)(unsafe.Pointer(in.HealthCheck))
+	out.PrivateHostedZone = (*aws.PrivateHostedZone)(unsafe.Pointer(in.PrivateHostedZone))
+	return nil
+}
+
+// Convert_v1alpha1_DNSRecordConfig_To_aws_DNSRecordConfig is an autogenerated conversion function.
+func Convert_v1alpha1_DNSRecordConfig_To_aws_DNSRecordConfig(in *DNSRecordConfig, out *aws.DNSRecordConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSRecordConfig_To_aws_DNSRecordConfig(in, out, s)
+}
+
+func autoConvert_aws_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(in *aws.DNSRecordConfig, out *DNSRecordConfig, s conversion.Scope) error {
+	out.RoutingPolicy = (*RoutingPolicy)(unsafe.Pointer(in.RoutingPolicy))
+	out.HealthCheckID = (*string)(unsafe.Pointer(in.HealthCheckID))
+	out.HealthCheck = (*HealthCheck)(unsafe.Pointer(in.HealthCheck))
+	out.PrivateHostedZone = (*PrivateHostedZone)(unsafe.Pointer(in.PrivateHostedZone))
+	return nil
+}
+
+// Convert_aws_DNSRecordConfig_To_v1alpha1_DNSRecordConfig is an autogenerated conversion function.
+func Convert_aws_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(in *aws.DNSRecordConfig, out *DNSRecordConfig, s conversion.Scope) error {
+	return autoConvert_aws_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_DNSRecordStatus_To_aws_DNSRecordStatus(in *DNSRecordStatus, out *aws.DNSRecordStatus, s conversion.Scope) error {
+	out.HealthCheckID = (*string)(unsafe.Pointer(in.HealthCheckID))
+	return nil
+}
+
+// Convert_v1alpha1_DNSRecordStatus_To_aws_DNSRecordStatus is an autogenerated conversion function.
+func Convert_v1alpha1_DNSRecordStatus_To_aws_DNSRecordStatus(in *DNSRecordStatus, out *aws.DNSRecordStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSRecordStatus_To_aws_DNSRecordStatus(in, out, s)
+}
+
+func autoConvert_aws_DNSRecordStatus_To_v1alpha1_DNSRecordStatus(in *aws.DNSRecordStatus, out *DNSRecordStatus, s conversion.Scope) error {
+	out.HealthCheckID = (*string)(unsafe.Pointer(in.HealthCheckID))
+	return nil
+}
+
+// Convert_aws_DNSRecordStatus_To_v1alpha1_DNSRecordStatus is an autogenerated conversion function.
+func Convert_aws_DNSRecordStatus_To_v1alpha1_DNSRecordStatus(in *aws.DNSRecordStatus, out *DNSRecordStatus, s conversion.Scope) error {
+	return autoConvert_aws_DNSRecordStatus_To_v1alpha1_DNSRecordStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_DataVolume_To_aws_DataVolume(in *DataVolume, out *aws.DataVolume, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1alpha1_Volume_To_aws_Volume(&in.Volume, &out.Volume, s); err != nil {
+		return err
+	}
+	out.SnapshotID = (*string)(unsafe.Pointer(in.SnapshotID))
+	out.DeviceName = (*string)(unsafe.Pointer(in.DeviceName))
+	return nil
+}
+
+// Convert_v1alpha1_DataVolume_To_aws_DataVolume is an autogenerated conversion function.
+func Convert_v1alpha1_DataVolume_To_aws_DataVolume(in *DataVolume, out *aws.DataVolume, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DataVolume_To_aws_DataVolume(in, out, s)
+}
+
+func autoConvert_aws_DataVolume_To_v1alpha1_DataVolume(in *aws.DataVolume, out *DataVolume, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_aws_Volume_To_v1alpha1_Volume(&in.Volume, &out.Volume, s); err != nil {
+		return err
+	}
+	out.SnapshotID = (*string)(unsafe.Pointer(in.SnapshotID))
+	out.DeviceName = (*string)(unsafe.Pointer(in.DeviceName))
+	return nil
+}
+
+// Convert_aws_DataVolume_To_v1alpha1_DataVolume is an autogenerated conversion function.
+func Convert_aws_DataVolume_To_v1alpha1_DataVolume(in *aws.DataVolume, out *DataVolume, s conversion.Scope) error {
+	return autoConvert_aws_DataVolume_To_v1alpha1_DataVolume(in, out, s)
+}
+
+func autoConvert_v1alpha1_DevicePlugin_To_aws_DevicePlugin(in *DevicePlugin, out *aws.DevicePlugin, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha1_DevicePlugin_To_aws_DevicePlugin is an autogenerated conversion function.
+func Convert_v1alpha1_DevicePlugin_To_aws_DevicePlugin(in *DevicePlugin, out *aws.DevicePlugin, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DevicePlugin_To_aws_DevicePlugin(in, out, s)
+}
+
+func autoConvert_aws_DevicePlugin_To_v1alpha1_DevicePlugin(in *aws.DevicePlugin, out *DevicePlugin, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_aws_DevicePlugin_To_v1alpha1_DevicePlugin is an autogenerated conversion function.
+func Convert_aws_DevicePlugin_To_v1alpha1_DevicePlugin(in *aws.DevicePlugin, out *DevicePlugin, s conversion.Scope) error {
+	return autoConvert_aws_DevicePlugin_To_v1alpha1_DevicePlugin(in, out, s)
+}
+
+func autoConvert_v1alpha1_DualStack_To_aws_DualStack(in *DualStack, out *aws.DualStack, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha1_DualStack_To_aws_DualStack is an autogenerated conversion function.
+func Convert_v1alpha1_DualStack_To_aws_DualStack(in *DualStack, out *aws.DualStack, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DualStack_To_aws_DualStack(in, out, s)
+}
+
+func autoConvert_aws_DualStack_To_v1alpha1_DualStack(in *aws.DualStack, out *DualStack, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_aws_DualStack_To_v1alpha1_DualStack is an autogenerated conversion function.
+func Convert_aws_DualStack_To_v1alpha1_DualStack(in *aws.DualStack, out *DualStack, s conversion.Scope) error {
+	return autoConvert_aws_DualStack_To_v1alpha1_DualStack(in, out, s)
+}
+
+func autoConvert_v1alpha1_EBSConfig_To_aws_EBSConfig(in *EBSConfig, out *aws.EBSConfig, s conversion.Scope) error {
+	out.VolumeModification = (*bool)(unsafe.Pointer(in.VolumeModification))
+	out.Batching = (*bool)(unsafe.Pointer(in.Batching))
+	out.ReservedVolumeAttachments = (*int32)(unsafe.Pointer(in.ReservedVolumeAttachments))
+	out.SnapshotController = (*aws.SnapshotControllerConfig)(unsafe.Pointer(in.SnapshotController))
+	out.ResourceRequests = *(*map[string]corev1.ResourceList)(unsafe.Pointer(&in.ResourceRequests))
+	out.ExtraTags = *(*map[string]string)(unsafe.Pointer(&in.ExtraTags))
+	out.ShootMetadataTags = (*bool)(unsafe.Pointer(in.ShootMetadataTags))
+	return nil
+}
+
+// Convert_v1alpha1_EBSConfig_To_aws_EBSConfig is an autogenerated conversion function.
+func Convert_v1alpha1_EBSConfig_To_aws_EBSConfig(in *EBSConfig, out *aws.EBSConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EBSConfig_To_aws_EBSConfig(in, out, s)
+}
+
+func autoConvert_aws_EBSConfig_To_v1alpha1_EBSConfig(in *aws.EBSConfig, out *EBSConfig, s conversion.Scope) error {
+	out.VolumeModification = (*bool)(unsafe.Pointer(in.VolumeModification))
+	out.Batching = (*bool)(unsafe.Pointer(in.Batching))
+	out.ReservedVolumeAttachments = (*int32)(unsafe.Pointer(in.ReservedVolumeAttachments))
+	out.SnapshotController = (*SnapshotControllerConfig)(unsafe.Pointer(in.SnapshotController))
+	out.ResourceRequests = *(*map[string]corev1.ResourceList)(unsafe.Pointer(&in.ResourceRequests))
+	out.ExtraTags = *(*map[string]string)(unsafe.Pointer(&in.ExtraTags))
+	out.ShootMetadataTags = (*bool)(unsafe.Pointer(in.ShootMetadataTags))
+	return nil
+}
+
+// Convert_aws_EBSConfig_To_v1alpha1_EBSConfig is an autogenerated conversion function.
+func Convert_aws_EBSConfig_To_v1alpha1_EBSConfig(in *aws.EBSConfig, out *EBSConfig, s conversion.Scope) error {
+	return autoConvert_aws_EBSConfig_To_v1alpha1_EBSConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_EC2_To_aws_EC2(in *EC2, out *aws.EC2, s conversion.Scope) error {
+	out.KeyName = in.KeyName
+	return nil
+}
+
+// Convert_v1alpha1_EC2_To_aws_EC2 is an autogenerated conversion function.
+func Convert_v1alpha1_EC2_To_aws_EC2(in *EC2, out *aws.EC2, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EC2_To_aws_EC2(in, out, s)
+}
+
+func autoConvert_aws_EC2_To_v1alpha1_EC2(in *aws.EC2, out *EC2, s conversion.Scope) error {
+	out.KeyName = in.KeyName
+	return nil
+}
+
+// Convert_aws_EC2_To_v1alpha1_EC2 is an autogenerated conversion function.
+func Convert_aws_EC2_To_v1alpha1_EC2(in *aws.EC2, out *EC2, s conversion.Scope) error {
+	return autoConvert_aws_EC2_To_v1alpha1_EC2(in, out, s)
+}
+
+func autoConvert_v1alpha1_EFSConfig_To_aws_EFSConfig(in *EFSConfig, out *aws.EFSConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.FileSystemID = (*string)(unsafe.Pointer(in.FileSystemID))
+	return nil
+}
+
+// Convert_v1alpha1_EFSConfig_To_aws_EFSConfig is an autogenerated conversion function.
+func Convert_v1alpha1_EFSConfig_To_aws_EFSConfig(in *EFSConfig, out *aws.EFSConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EFSConfig_To_aws_EFSConfig(in, out, s)
+}
+
+func autoConvert_aws_EFSConfig_To_v1alpha1_EFSConfig(in *aws.EFSConfig, out *EFSConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.FileSystemID = (*string)(unsafe.Pointer(in.FileSystemID))
+	return nil
+}
+
+// Convert_aws_EFSConfig_To_v1alpha1_EFSConfig is an autogenerated conversion function.
+func Convert_aws_EFSConfig_To_v1alpha1_EFSConfig(in *aws.EFSConfig, out *EFSConfig, s conversion.Scope) error {
+	return autoConvert_aws_EFSConfig_To_v1alpha1_EFSConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_EgressPrefixListConfig_To_aws_EgressPrefixListConfig(in *EgressPrefixListConfig, out *aws.EgressPrefixListConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.IncludeNodesCIDRs = in.IncludeNodesCIDRs
+	return nil
+}
+
+// Convert_v1alpha1_EgressPrefixListConfig_To_aws_EgressPrefixListConfig is an autogenerated conversion function.
+func Convert_v1alpha1_EgressPrefixListConfig_To_aws_EgressPrefixListConfig(in *EgressPrefixListConfig, out *aws.EgressPrefixListConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EgressPrefixListConfig_To_aws_EgressPrefixListConfig(in, out, s)
+}
+
+func autoConvert_aws_EgressPrefixListConfig_To_v1alpha1_EgressPrefixListConfig(in *aws.EgressPrefixListConfig, out *EgressPrefixListConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.IncludeNodesCIDRs = in.IncludeNodesCIDRs
+	return nil
+}
+
+// Convert_aws_EgressPrefixListConfig_To_v1alpha1_EgressPrefixListConfig is an autogenerated conversion function.
+func Convert_aws_EgressPrefixListConfig_To_v1alpha1_EgressPrefixListConfig(in *aws.EgressPrefixListConfig, out *EgressPrefixListConfig, s conversion.Scope) error {
+	return autoConvert_aws_EgressPrefixListConfig_To_v1alpha1_EgressPrefixListConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_EgressPrefixListStatus_To_aws_EgressPrefixListStatus(in *EgressPrefixListStatus, out *aws.EgressPrefixListStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.ARN = in.ARN
+	return nil
+}
+
+// Convert_v1alpha1_EgressPrefixListStatus_To_aws_EgressPrefixListStatus is an autogenerated conversion function.
+func Convert_v1alpha1_EgressPrefixListStatus_To_aws_EgressPrefixListStatus(in *EgressPrefixListStatus, out *aws.EgressPrefixListStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EgressPrefixListStatus_To_aws_EgressPrefixListStatus(in, out, s)
+}
+
+func autoConvert_aws_EgressPrefixListStatus_To_v1alpha1_EgressPrefixListStatus(in *aws.EgressPrefixListStatus, out *EgressPrefixListStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.ARN = in.ARN
+	return nil
+}
+
+// Convert_aws_EgressPrefixListStatus_To_v1alpha1_EgressPrefixListStatus is an autogenerated conversion function.
+func Convert_aws_EgressPrefixListStatus_To_v1alpha1_EgressPrefixListStatus(in *aws.EgressPrefixListStatus, out *EgressPrefixListStatus, s conversion.Scope) error {
+	return autoConvert_aws_EgressPrefixListStatus_To_v1alpha1_EgressPrefixListStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_ElasticFileSystemConfig_To_aws_ElasticFileSystemConfig(in *ElasticFileSystemConfig, out *aws.ElasticFileSystemConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha1_ElasticFileSystemConfig_To_aws_ElasticFileSystemConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ElasticFileSystemConfig_To_aws_ElasticFileSystemConfig(in *ElasticFileSystemConfig, out *aws.ElasticFileSystemConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ElasticFileSystemConfig_To_aws_ElasticFileSystemConfig(in, out, s)
+}
+
+func autoConvert_aws_ElasticFileSystemConfig_To_v1alpha1_ElasticFileSystemConfig(in *aws.ElasticFileSystemConfig, out *ElasticFileSystemConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_aws_ElasticFileSystemConfig_To_v1alpha1_ElasticFileSystemConfig is an autogenerated conversion function.
+func Convert_aws_ElasticFileSystemConfig_To_v1alpha1_ElasticFileSystemConfig(in *aws.ElasticFileSystemConfig, out *ElasticFileSystemConfig, s conversion.Scope) error {
+	return autoConvert_aws_ElasticFileSystemConfig_To_v1alpha1_ElasticFileSystemConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_ElasticFileSystemStatus_To_aws_ElasticFileSystemStatus(in *ElasticFileSystemStatus, out *aws.ElasticFileSystemStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	return nil
+}
+
+// Convert_v1alpha1_ElasticFileSystemStatus_To_aws_ElasticFileSystemStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ElasticFileSystemStatus_To_aws_ElasticFileSystemStatus(in *ElasticFileSystemStatus, out *aws.ElasticFileSystemStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ElasticFileSystemStatus_To_aws_ElasticFileSystemStatus(in, out, s)
+}
+
+func autoConvert_aws_ElasticFileSystemStatus_To_v1alpha1_ElasticFileSystemStatus(in *aws.ElasticFileSystemStatus, out *ElasticFileSystemStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	return nil
+}
+
+// Convert_aws_ElasticFileSystemStatus_To_v1alpha1_ElasticFileSystemStatus is an autogenerated conversion function.
+func Convert_aws_ElasticFileSystemStatus_To_v1alpha1_ElasticFileSystemStatus(in *aws.ElasticFileSystemStatus, out *ElasticFileSystemStatus, s conversion.Scope) error {
+	return autoConvert_aws_ElasticFileSystemStatus_To_v1alpha1_ElasticFileSystemStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_ElasticIPPool_To_aws_ElasticIPPool(in *ElasticIPPool, out *aws.ElasticIPPool, s conversion.Scope) error {
+	out.Size = in.Size
+	out.RetainOnDeletion = in.RetainOnDeletion
+	return nil
+}
+
+// Convert_v1alpha1_ElasticIPPool_To_aws_ElasticIPPool is an autogenerated conversion function.
+func Convert_v1alpha1_ElasticIPPool_To_aws_ElasticIPPool(in *ElasticIPPool, out *aws.ElasticIPPool, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ElasticIPPool_To_aws_ElasticIPPool(in, out, s)
+}
+
+func autoConvert_aws_ElasticIPPool_To_v1alpha1_ElasticIPPool(in *aws.ElasticIPPool, out *ElasticIPPool, s conversion.Scope) error {
+	out.Size = in.Size
+	out.RetainOnDeletion = in.RetainOnDeletion
+	return nil
+}
+
+// Convert_aws_ElasticIPPool_To_v1alpha1_ElasticIPPool is an autogenerated conversion function.
+func Convert_aws_ElasticIPPool_To_v1alpha1_ElasticIPPool(in *aws.ElasticIPPool, out *ElasticIPPool, s conversion.Scope) error {
+	return autoConvert_aws_ElasticIPPool_To_v1alpha1_ElasticIPPool(in, out, s)
+}
+
+func autoConvert_v1alpha1_ElasticIPPoolAddress_To_aws_ElasticIPPoolAddress(in *ElasticIPPoolAddress, out *aws.ElasticIPPoolAddress, s conversion.Scope) error {
+	out.AllocationID = in.AllocationID
+	out.PublicIP = in.PublicIP
+	out.Zone = (*string)(unsafe.Pointer(in.Zone))
+	return nil
+}
+
+// Convert_v1alpha1_ElasticIPPoolAddress_To_aws_ElasticIPPoolAddress is an autogenerated conversion function.
+func Convert_v1alpha1_ElasticIPPoolAddress_To_aws_ElasticIPPoolAddress(in *ElasticIPPoolAddress, out *aws.ElasticIPPoolAddress, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ElasticIPPoolAddress_To_aws_ElasticIPPoolAddress(in, out, s)
+}
+
+func autoConvert_aws_ElasticIPPoolAddress_To_v1alpha1_ElasticIPPoolAddress(in *aws.ElasticIPPoolAddress, out *ElasticIPPoolAddress, s conversion.Scope) error {
+	out.AllocationID = in.AllocationID
+	out.PublicIP = in.PublicIP
+	out.Zone = (*string)(unsafe.Pointer(in.Zone))
+	return nil
+}
+
+// Convert_aws_ElasticIPPoolAddress_To_v1alpha1_ElasticIPPoolAddress is an autogenerated conversion function.
+func Convert_aws_ElasticIPPoolAddress_To_v1alpha1_ElasticIPPoolAddress(in *aws.ElasticIPPoolAddress, out *ElasticIPPoolAddress, s conversion.Scope) error {
+	return autoConvert_aws_ElasticIPPoolAddress_To_v1alpha1_ElasticIPPoolAddress(in, out, s)
+}
+
+func autoConvert_v1alpha1_EnclaveOptions_To_aws_EnclaveOptions(in *EnclaveOptions, out *aws.EnclaveOptions, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha1_EnclaveOptions_To_aws_EnclaveOptions is an autogenerated conversion function.
+func Convert_v1alpha1_EnclaveOptions_To_aws_EnclaveOptions(in *EnclaveOptions, out *aws.EnclaveOptions, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EnclaveOptions_To_aws_EnclaveOptions(in, out, s)
+}
+
+func autoConvert_aws_EnclaveOptions_To_v1alpha1_EnclaveOptions(in *aws.EnclaveOptions, out *EnclaveOptions, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_aws_EnclaveOptions_To_v1alpha1_EnclaveOptions is an autogenerated conversion function.
+func Convert_aws_EnclaveOptions_To_v1alpha1_EnclaveOptions(in *aws.EnclaveOptions, out *EnclaveOptions, s conversion.Scope) error {
+	return autoConvert_aws_EnclaveOptions_To_v1alpha1_EnclaveOptions(in, out, s)
+}
+
+func autoConvert_v1alpha1_Endpoints_To_aws_Endpoints(in *Endpoints, out *aws.Endpoints, s conversion.Scope) error {
+	out.Partition = (*string)(unsafe.Pointer(in.Partition))
+	out.Services = *(*map[string]string)(unsafe.Pointer(&in.Services))
+	return nil
+}
+
+// Convert_v1alpha1_Endpoints_To_aws_Endpoints is an autogenerated conversion function.
+func Convert_v1alpha1_Endpoints_To_aws_Endpoints(in *Endpoints, out *aws.Endpoints, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Endpoints_To_aws_Endpoints(in, out, s)
+}
+
+func autoConvert_aws_Endpoints_To_v1alpha1_Endpoints(in *aws.Endpoints, out *Endpoints, s conversion.Scope) error {
+	out.Partition = (*string)(unsafe.Pointer(in.Partition))
+	out.Services = *(*map[string]string)(unsafe.Pointer(&in.Services))
+	return nil
+}
+
+// Convert_aws_Endpoints_To_v1alpha1_Endpoints is an autogenerated conversion function.
+func Convert_aws_Endpoints_To_v1alpha1_Endpoints(in *aws.Endpoints, out *Endpoints, s conversion.Scope) error {
+	return autoConvert_aws_Endpoints_To_v1alpha1_Endpoints(in, out, s)
+}
+
+func autoConvert_v1alpha1_FailoverRoutingPolicy_To_aws_FailoverRoutingPolicy(in *FailoverRoutingPolicy, out *aws.FailoverRoutingPolicy, s conversion.Scope) error {
+	out.Role = aws.FailoverRole(in.Role)
+	return nil
+}
+
+// Convert_v1alpha1_FailoverRoutingPolicy_To_aws_FailoverRoutingPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_FailoverRoutingPolicy_To_aws_FailoverRoutingPolicy(in *FailoverRoutingPolicy, out *aws.FailoverRoutingPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FailoverRoutingPolicy_To_aws_FailoverRoutingPolicy(in, out, s)
+}
+
+func autoConvert_aws_FailoverRoutingPolicy_To_v1alpha1_FailoverRoutingPolicy(in *aws.FailoverRoutingPolicy, out *FailoverRoutingPolicy, s conversion.Scope) error {
+	out.Role = FailoverRole(in.Role)
+	return nil
+}
+
+// Convert_aws_FailoverRoutingPolicy_To_v1alpha1_FailoverRoutingPolicy is an autogenerated conversion function.
+func Convert_aws_FailoverRoutingPolicy_To_v1alpha1_FailoverRoutingPolicy(in *aws.FailoverRoutingPolicy, out *FailoverRoutingPolicy, s conversion.Scope) error {
+	return autoConvert_aws_FailoverRoutingPolicy_To_v1alpha1_FailoverRoutingPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_GeolocationRoutingPolicy_To_aws_GeolocationRoutingPolicy(in *GeolocationRoutingPolicy, out *aws.GeolocationRoutingPolicy, s conversion.Scope) error {
+	out.ContinentCode = (*string)(unsafe.Pointer(in.ContinentCode))
+	out.CountryCode = (*string)(unsafe.Pointer(in.CountryCode))
+	out.SubdivisionCode = (*string)(unsafe.Pointer(in.SubdivisionCode))
+	return nil
+}
+
+// Convert_v1alpha1_GeolocationRoutingPolicy_To_aws_GeolocationRoutingPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_GeolocationRoutingPolicy_To_aws_GeolocationRoutingPolicy(in *GeolocationRoutingPolicy, out *aws.GeolocationRoutingPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GeolocationRoutingPolicy_To_aws_GeolocationRoutingPolicy(in, out, s)
+}
+
+func autoConvert_aws_GeolocationRoutingPolicy_To_v1alpha1_GeolocationRoutingPolicy(in *aws.GeolocationRoutingPolicy, out *GeolocationRoutingPolicy, s conversion.Scope) error {
+	out.ContinentCode = (*string)(unsafe.Pointer(in.ContinentCode))
+	out.CountryCode = (*string)(unsafe.Pointer(in.CountryCode))
+	out.SubdivisionCode = (*string)(unsafe.Pointer(in.SubdivisionCode))
+	return nil
+}
+
+// Convert_aws_GeolocationRoutingPolicy_To_v1alpha1_GeolocationRoutingPolicy is an autogenerated conversion function.
+func Convert_aws_GeolocationRoutingPolicy_To_v1alpha1_GeolocationRoutingPolicy(in *aws.GeolocationRoutingPolicy, out *GeolocationRoutingPolicy, s conversion.Scope) error {
+	return autoConvert_aws_GeolocationRoutingPolicy_To_v1alpha1_GeolocationRoutingPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_HealthCheck_To_aws_HealthCheck(in *HealthCheck, out *aws.HealthCheck, s conversion.Scope) error {
+	out.Type = aws.HealthCheckType(in.Type)
+	out.IPAddress = (*string)(unsafe.Pointer(in.IPAddress))
+	out.FullyQualifiedDomainName = (*string)(unsafe.Pointer(in.FullyQualifiedDomainName))
+	out.Port = (*int32)(unsafe.Pointer(in.Port))
+	out.ResourcePath = (*string)(unsafe.Pointer(in.ResourcePath))
+	out.RequestInterval = (*int32)(unsafe.Pointer(in.RequestInterval))
+	out.FailureThreshold = (*int32)(unsafe.Pointer(in.FailureThreshold))
+	return nil
+}
+
+// Convert_v1alpha1_HealthCheck_To_aws_HealthCheck is an autogenerated conversion function.
+func Convert_v1alpha1_HealthCheck_To_aws_HealthCheck(in *HealthCheck, out *aws.HealthCheck, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HealthCheck_To_aws_HealthCheck(in, out, s)
+}
+
+func autoConvert_aws_HealthCheck_To_v1alpha1_HealthCheck(in *aws.HealthCheck, out *HealthCheck, s conversion.Scope) error {
+	out.Type = HealthCheckType(in.Type)
+	out.IPAddress = (*string)(unsafe.Pointer(in.IPAddress))
+	out.FullyQualifiedDomainName = (*string)(unsafe.Pointer(in.FullyQualifiedDomainName))
+	out.Port = (*int32)(unsafe.Pointer(in.Port))
+	out.ResourcePath = (*string)(unsafe.Pointer(in.ResourcePath))
+	out.RequestInterval = (*int32)(unsafe.Pointer(in.RequestInterval))
+	out.FailureThreshold = (*int32)(unsafe.Pointer(in.FailureThreshold))
+	return nil
+}
+
+// Convert_aws_HealthCheck_To_v1alpha1_HealthCheck is an autogenerated conversion function.
+func Convert_aws_HealthCheck_To_v1alpha1_HealthCheck(in *aws.HealthCheck, out *HealthCheck, s conversion.Scope) error {
+	return autoConvert_aws_HealthCheck_To_v1alpha1_HealthCheck(in, out, s)
+}
+
+func autoConvert_v1alpha1_HostedZoneVPC_To_aws_HostedZoneVPC(in *HostedZoneVPC, out *aws.HostedZoneVPC, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Region = (*string)(unsafe.Pointer(in.Region))
+	return nil
+}
+
+// Convert_v1alpha1_HostedZoneVPC_To_aws_HostedZoneVPC is an autogenerated conversion function.
+func Convert_v1alpha1_HostedZoneVPC_To_aws_HostedZoneVPC(in *HostedZoneVPC, out *aws.HostedZoneVPC, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HostedZoneVPC_To_aws_HostedZoneVPC(in, out, s)
+}
+
+func autoConvert_aws_HostedZoneVPC_To_v1alpha1_HostedZoneVPC(in *aws.HostedZoneVPC, out *HostedZoneVPC, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Region = (*string)(unsafe.Pointer(in.Region))
+	return nil
+}
+
+// Convert_aws_HostedZoneVPC_To_v1alpha1_HostedZoneVPC is an autogenerated conversion function.
+func Convert_aws_HostedZoneVPC_To_v1alpha1_HostedZoneVPC(in *aws.HostedZoneVPC, out *HostedZoneVPC, s conversion.Scope) error {
+	return autoConvert_aws_HostedZoneVPC_To_v1alpha1_HostedZoneVPC(in, out, s)
+}
+
+func autoConvert_v1alpha1_IAM_To_aws_IAM(in *IAM, out *aws.IAM, s conversion.Scope) error {
+	out.InstanceProfiles = *(*[]aws.InstanceProfile)(unsafe.Pointer(&in.InstanceProfiles))
+	out.Roles = *(*[]aws.Role)(unsafe.Pointer(&in.Roles))
+	return nil
+}
+
+// Convert_v1alpha1_IAM_To_aws_IAM is an autogenerated conversion function.
+func Convert_v1alpha1_IAM_To_aws_IAM(in *IAM, out *aws.IAM, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IAM_To_aws_IAM(in, out, s)
+}
+
+func autoConvert_aws_IAM_To_v1alpha1_IAM(in *aws.IAM, out *IAM, s conversion.Scope) error {
+	out.InstanceProfiles = *(*[]InstanceProfile)(unsafe.Pointer(&in.InstanceProfiles))
+	out.Roles = *(*[]Role)(unsafe.Pointer(&in.Roles))
+	return nil
+}
+
+// Convert_aws_IAM_To_v1alpha1_IAM is an autogenerated conversion function.
+func Convert_aws_IAM_To_v1alpha1_IAM(in *aws.IAM, out *IAM, s conversion.Scope) error {
+	return autoConvert_aws_IAM_To_v1alpha1_IAM(in, out, s)
+}
+
+func autoConvert_v1alpha1_IAMConfig_To_aws_IAMConfig(in *IAMConfig, out *aws.IAMConfig, s conversion.Scope) error {
+	out.NodesInstanceProfile = (*aws.IAMInstanceProfile)(unsafe.Pointer(in.NodesInstanceProfile))
+	return nil
+}
+
+// Convert_v1alpha1_IAMConfig_To_aws_IAMConfig is an autogenerated conversion function.
+func Convert_v1alpha1_IAMConfig_To_aws_IAMConfig(in *IAMConfig, out *aws.IAMConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IAMConfig_To_aws_IAMConfig(in, out, s)
+}
+
+func autoConvert_aws_IAMConfig_To_v1alpha1_IAMConfig(in *aws.IAMConfig, out *IAMConfig, s conversion.Scope) error {
+	out.NodesInstanceProfile = (*IAMInstanceProfile)(unsafe.Pointer(in.NodesInstanceProfile))
+	return nil
+}
+
+// Convert_aws_IAMConfig_To_v1alpha1_IAMConfig is an autogenerated conversion function.
+func Convert_aws_IAMConfig_To_v1alpha1_IAMConfig(in *aws.IAMConfig, out *IAMConfig, s conversion.Scope) error {
+	return autoConvert_aws_IAMConfig_To_v1alpha1_IAMConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_IAMInstanceProfile_To_aws_IAMInstanceProfile(in *IAMInstanceProfile, out *aws.IAMInstanceProfile, s conversion.Scope) error {
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.ARN = (*string)(unsafe.Pointer(in.ARN))
+	return nil
+}
+
+// Convert_v1alpha1_IAMInstanceProfile_To_aws_IAMInstanceProfile is an autogenerated conversion function.
+func Convert_v1alpha1_IAMInstanceProfile_To_aws_IAMInstanceProfile(in *IAMInstanceProfile, out *aws.IAMInstanceProfile, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IAMInstanceProfile_To_aws_IAMInstanceProfile(in, out, s)
+}
+
+func autoConvert_aws_IAMInstanceProfile_To_v1alpha1_IAMInstanceProfile(in *aws.IAMInstanceProfile, out *IAMInstanceProfile, s conversion.Scope) error {
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.ARN = (*string)(unsafe.Pointer(in.ARN))
+	return nil
+}
+
+// Convert_aws_IAMInstanceProfile_To_v1alpha1_IAMInstanceProfile is an autogenerated conversion function.
+func Convert_aws_IAMInstanceProfile_To_v1alpha1_IAMInstanceProfile(in *aws.IAMInstanceProfile, out *IAMInstanceProfile, s conversion.Scope) error {
+	return autoConvert_aws_IAMInstanceProfile_To_v1alpha1_IAMInstanceProfile(in, out, s)
+}
+
+func autoConvert_v1alpha1_IRSAConfig_To_aws_IRSAConfig(in *IRSAConfig, out *aws.IRSAConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha1_IRSAConfig_To_aws_IRSAConfig is an autogenerated conversion function.
+func Convert_v1alpha1_IRSAConfig_To_aws_IRSAConfig(in *IRSAConfig, out *aws.IRSAConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IRSAConfig_To_aws_IRSAConfig(in, out, s)
+}
+
+func autoConvert_aws_IRSAConfig_To_v1alpha1_IRSAConfig(in *aws.IRSAConfig, out *IRSAConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_aws_IRSAConfig_To_v1alpha1_IRSAConfig is an autogenerated conversion function.
+func Convert_aws_IRSAConfig_To_v1alpha1_IRSAConfig(in *aws.IRSAConfig, out *IRSAConfig, s conversion.Scope) error {
+	return autoConvert_aws_IRSAConfig_To_v1alpha1_IRSAConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_IRSAStatus_To_aws_IRSAStatus(in *IRSAStatus, out *aws.IRSAStatus, s conversion.Scope) error {
+	out.OIDCProviderARN = in.OIDCProviderARN
+	out.IssuerURL = in.IssuerURL
+	return nil
+}
+
+// Convert_v1alpha1_IRSAStatus_To_aws_IRSAStatus is an autogenerated conversion function.
+func Convert_v1alpha1_IRSAStatus_To_aws_IRSAStatus(in *IRSAStatus, out *aws.IRSAStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IRSAStatus_To_aws_IRSAStatus(in, out, s)
+}
+
+func autoConvert_aws_IRSAStatus_To_v1alpha1_IRSAStatus(in *aws.IRSAStatus, out *IRSAStatus, s conversion.Scope) error {
+	out.OIDCProviderARN = in.OIDCProviderARN
+	out.IssuerURL = in.IssuerURL
+	return nil
+}
+
+// Convert_aws_IRSAStatus_To_v1alpha1_IRSAStatus is an autogenerated conversion function.
+func Convert_aws_IRSAStatus_To_v1alpha1_IRSAStatus(in *aws.IRSAStatus, out *IRSAStatus, s conversion.Scope) error {
+	return autoConvert_aws_IRSAStatus_To_v1alpha1_IRSAStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_IgnoreTags_To_aws_IgnoreTags(in *IgnoreTags, out *aws.IgnoreTags, s conversion.Scope) error {
+	out.Keys = *(*[]string)(unsafe.Pointer(&in.Keys))
+	out.KeyPrefixes = *(*[]string)(unsafe.Pointer(&in.KeyPrefixes))
+	return nil
+}
+
+// Convert_v1alpha1_IgnoreTags_To_aws_IgnoreTags is an autogenerated conversion function.
+func Convert_v1alpha1_IgnoreTags_To_aws_IgnoreTags(in *IgnoreTags, out *aws.IgnoreTags, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IgnoreTags_To_aws_IgnoreTags(in, out, s)
+}
+
+func autoConvert_aws_IgnoreTags_To_v1alpha1_IgnoreTags(in *aws.IgnoreTags, out *IgnoreTags, s conversion.Scope) error {
+	out.Keys = *(*[]string)(unsafe.Pointer(&in.Keys))
+	out.KeyPrefixes = *(*[]string)(unsafe.Pointer(&in.KeyPrefixes))
+	return nil
+}
+
+// Convert_aws_IgnoreTags_To_v1alpha1_IgnoreTags is an autogenerated conversion function.
+func Convert_aws_IgnoreTags_To_v1alpha1_IgnoreTags(in *aws.IgnoreTags, out *IgnoreTags, s conversion.Scope) error {
+	return autoConvert_aws_IgnoreTags_To_v1alpha1_IgnoreTags(in, out, s)
+}
+
+func autoConvert_v1alpha1_ImageSelector_To_aws_ImageSelector(in *ImageSelector, out *aws.ImageSelector, s conversion.Scope) error {
+	out.Owners = *(*[]string)(unsafe.Pointer(&in.Owners))
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	return nil
+}
+
+// Convert_v1alpha1_ImageSelector_To_aws_ImageSelector is an autogenerated conversion function.
+func Convert_v1alpha1_ImageSelector_To_aws_ImageSelector(in *ImageSelector, out *aws.ImageSelector, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImageSelector_To_aws_ImageSelector(in, out, s)
+}
+
+func autoConvert_aws_ImageSelector_To_v1alpha1_ImageSelector(in *aws.ImageSelector, out *ImageSelector, s conversion.Scope) error {
+	out.Owners = *(*[]string)(unsafe.Pointer(&in.Owners))
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	return nil
+}
+
+// Convert_aws_ImageSelector_To_v1alpha1_ImageSelector is an autogenerated conversion function.
+func Convert_aws_ImageSelector_To_v1alpha1_ImageSelector(in *aws.ImageSelector, out *ImageSelector, s conversion.Scope) error {
+	return autoConvert_aws_ImageSelector_To_v1alpha1_ImageSelector(in, out, s)
+}
+
+func autoConvert_v1alpha1_InfrastructureConfig_To_aws_InfrastructureConfig(in *InfrastructureConfig, out *aws.InfrastructureConfig, s conversion.Scope) error {
+	out.EnableECRAccess = (*bool)(unsafe.Pointer(in.EnableECRAccess))
+	out.DualStack = (*aws.DualStack)(unsafe.Pointer(in.DualStack))
+	if err := Convert_v1alpha1_Networks_To_aws_Networks(&in.Networks, &out.Networks, s); err != nil {
+		return err
+	}
+	out.IgnoreTags = (*aws.IgnoreTags)(unsafe.Pointer(in.IgnoreTags))
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.VPCFlowLogs = (*aws.VPCFlowLogs)(unsafe.Pointer(in.VPCFlowLogs))
+	out.IAM = (*aws.IAMConfig)(unsafe.Pointer(in.IAM))
+	out.Endpoints = (*aws.Endpoints)(unsafe.Pointer(in.Endpoints))
+	out.ElasticFileSystem = (*aws.ElasticFileSystemConfig)(unsafe.Pointer(in.ElasticFileSystem))
+	out.LoadBalancerAccessLogs = (*aws.LoadBalancerAccessLogsConfig)(unsafe.Pointer(in.LoadBalancerAccessLogs))
+	out.EgressPrefixList = (*aws.EgressPrefixListConfig)(unsafe.Pointer(in.EgressPrefixList))
+	out.Inventory = (*aws.InventoryConfig)(unsafe.Pointer(in.Inventory))
+	return nil
+}
+
+// Convert_v1alpha1_InfrastructureConfig_To_aws_InfrastructureConfig is an autogenerated conversion function.
+func Convert_v1alpha1_InfrastructureConfig_To_aws_InfrastructureConfig(in *InfrastructureConfig, out *aws.InfrastructureConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InfrastructureConfig_To_aws_InfrastructureConfig(in, out, s)
+}
+
+func autoConvert_aws_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in *aws.InfrastructureConfig, out *InfrastructureConfig, s conversion.Scope) error {
+	out.EnableECRAccess = (*bool)(unsafe.Pointer(in.EnableECRAccess))
+	out.DualStack = (*DualStack)(unsafe.Pointer(in.DualStack))
+	if err := Convert_aws_Networks_To_v1alpha1_Networks(&in.Networks, &out.Networks, s); err != nil {
+		return err
+	}
+	out.IgnoreTags = (*IgnoreTags)(unsafe.Pointer(in.IgnoreTags))
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.VPCFlowLogs = (*VPCFlowLogs)(unsafe.Pointer(in.VPCFlowLogs))
+	out.IAM = (*IAMConfig)(unsafe.Pointer(in.IAM))
+	out.Endpoints = (*Endpoints)(unsafe.Pointer(in.Endpoints))
+	out.ElasticFileSystem = (*ElasticFileSystemConfig)(unsafe.Pointer(in.ElasticFileSystem))
+	out.LoadBalancerAccessLogs = (*LoadBalancerAccessLogsConfig)(unsafe.Pointer(in.LoadBalancerAccessLogs))
+	out.EgressPrefixList = (*EgressPrefixListConfig)(unsafe.Pointer(in.EgressPrefixList))
+	out.Inventory = (*InventoryConfig)(unsafe.Pointer(in.Inventory))
+	return nil
+}
+
+// Convert_aws_InfrastructureConfig_To_v1alpha1_InfrastructureConfig is an autogenerated conversion function.
+func Convert_aws_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in *aws.InfrastructureConfig, out *InfrastructureConfig, s conversion.Scope) error {
+	return autoConvert_aws_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_InfrastructureStatus_To_aws_InfrastructureStatus(in *InfrastructureStatus, out *aws.InfrastructureStatus, s conversion.Scope) error {
+	if err := Convert_v1alpha1_EC2_To_aws_EC2(&in.EC2, &out.EC2, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_IAM_To_aws_IAM(&in.IAM, &out.IAM, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_VPCStatus_To_aws_VPCStatus(&in.VPC, &out.VPC, s); err != nil {
+		return err
+	}
+	out.ElasticFileSystem = (*aws.ElasticFileSystemStatus)(unsafe.Pointer(in.ElasticFileSystem))
+	out.LoadBalancerAccessLogs = (*aws.LoadBalancerAccessLogsStatus)(unsafe.Pointer(in.LoadBalancerAccessLogs))
+	out.EgressPrefixList = (*aws.EgressPrefixListStatus)(unsafe.Pointer(in.EgressPrefixList))
+	return nil
+}
+
+// Convert_v1alpha1_InfrastructureStatus_To_aws_InfrastructureStatus is an autogenerated conversion function.
+func Convert_v1alpha1_InfrastructureStatus_To_aws_InfrastructureStatus(in *InfrastructureStatus, out *aws.InfrastructureStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InfrastructureStatus_To_aws_InfrastructureStatus(in, out, s)
+}
+
+func autoConvert_aws_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *aws.InfrastructureStatus, out *InfrastructureStatus, s conversion.Scope) error {
+	if err := Convert_aws_EC2_To_v1alpha1_EC2(&in.EC2, &out.EC2, s); err != nil {
+		return err
+	}
+	if err := Convert_aws_IAM_To_v1alpha1_IAM(&in.IAM, &out.IAM, s); err != nil {
+		return err
+	}
+	if err := Convert_aws_VPCStatus_To_v1alpha1_VPCStatus(&in.VPC, &out.VPC, s); err != nil {
+		return err
+	}
+	out.ElasticFileSystem = (*ElasticFileSystemStatus)(unsafe.Pointer(in.ElasticFileSystem))
+	out.LoadBalancerAccessLogs = (*LoadBalancerAccessLogsStatus)(unsafe.Pointer(in.LoadBalancerAccessLogs))
+	out.EgressPrefixList = (*EgressPrefixListStatus)(unsafe.Pointer(in.EgressPrefixList))
+	return nil
+}
+
+// Convert_aws_InfrastructureStatus_To_v1alpha1_InfrastructureStatus is an autogenerated conversion function.
+func Convert_aws_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *aws.InfrastructureStatus, out *InfrastructureStatus, s conversion.Scope) error {
+	return autoConvert_aws_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_InstanceMetadataOptions_To_aws_InstanceMetadataOptions(in *InstanceMetadataOptions, out *aws.InstanceMetadataOptions, s conversion.Scope) error {
+	out.HTTPTokens = (*aws.HTTPTokensValue)(unsafe.Pointer(in.HTTPTokens))
+	out.HTTPPutResponseHopLimit = (*int64)(unsafe.Pointer(in.HTTPPutResponseHopLimit))
+	out.InstanceMetadataTags = (*aws.InstanceMetadataTagsValue)(unsafe.Pointer(in.InstanceMetadataTags))
+	return nil
+}
+
+// Convert_v1alpha1_InstanceMetadataOptions_To_aws_InstanceMetadataOptions is an autogenerated conversion function.
+func Convert_v1alpha1_InstanceMetadataOptions_To_aws_InstanceMetadataOptions(in *InstanceMetadataOptions, out *aws.InstanceMetadataOptions, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstanceMetadataOptions_To_aws_InstanceMetadataOptions(in, out, s)
+}
+
+func autoConvert_aws_InstanceMetadataOptions_To_v1alpha1_InstanceMetadataOptions(in *aws.InstanceMetadataOptions, out *InstanceMetadataOptions, s conversion.Scope) error {
+	out.HTTPTokens = (*HTTPTokensValue)(unsafe.Pointer(in.HTTPTokens))
+	out.HTTPPutResponseHopLimit = (*int64)(unsafe.Pointer(in.HTTPPutResponseHopLimit))
+	out.InstanceMetadataTags = (*InstanceMetadataTagsValue)(unsafe.Pointer(in.InstanceMetadataTags))
+	return nil
+}
+
+// Convert_aws_InstanceMetadataOptions_To_v1alpha1_InstanceMetadataOptions is an autogenerated conversion function.
+func Convert_aws_InstanceMetadataOptions_To_v1alpha1_InstanceMetadataOptions(in *aws.InstanceMetadataOptions, out *InstanceMetadataOptions, s conversion.Scope) error {
+	return autoConvert_aws_InstanceMetadataOptions_To_v1alpha1_InstanceMetadataOptions(in, out, s)
+}
+
+func autoConvert_v1alpha1_InstanceProfile_To_aws_InstanceProfile(in *InstanceProfile, out *aws.InstanceProfile, s conversion.Scope) error {
+	out.Purpose = in.Purpose
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_InstanceProfile_To_aws_InstanceProfile is an autogenerated conversion function.
+func Convert_v1alpha1_InstanceProfile_To_aws_InstanceProfile(in *InstanceProfile, out *aws.InstanceProfile, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstanceProfile_To_aws_InstanceProfile(in, out, s)
+}
+
+func autoConvert_aws_InstanceProfile_To_v1alpha1_InstanceProfile(in *aws.InstanceProfile, out *InstanceProfile, s conversion.Scope) error {
+	out.Purpose = in.Purpose
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_aws_InstanceProfile_To_v1alpha1_InstanceProfile is an autogenerated conversion function.
+func Convert_aws_InstanceProfile_To_v1alpha1_InstanceProfile(in *aws.InstanceProfile, out *InstanceProfile, s conversion.Scope) error {
+	return autoConvert_aws_InstanceProfile_To_v1alpha1_InstanceProfile(in, out, s)
+}
+
+func autoConvert_v1alpha1_InstanceStorage_To_aws_InstanceStorage(in *InstanceStorage, out *aws.InstanceStorage, s conversion.Scope) error {
+	out.RAID0 = (*bool)(unsafe.Pointer(in.RAID0))
+	return nil
+}
+
+// Convert_v1alpha1_InstanceStorage_To_aws_InstanceStorage is an autogenerated conversion function.
+func Convert_v1alpha1_InstanceStorage_To_aws_InstanceStorage(in *InstanceStorage, out *aws.InstanceStorage, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstanceStorage_To_aws_InstanceStorage(in, out, s)
+}
+
+func autoConvert_aws_InstanceStorage_To_v1alpha1_InstanceStorage(in *aws.InstanceStorage, out *InstanceStorage, s conversion.Scope) error {
+	out.RAID0 = (*bool)(unsafe.Pointer(in.RAID0))
+	return nil
+}
+
+// Convert_aws_InstanceStorage_To_v1alpha1_InstanceStorage is an autogenerated conversion function.
+func Convert_aws_InstanceStorage_To_v1alpha1_InstanceStorage(in *aws.InstanceStorage, out *InstanceStorage, s conversion.Scope) error {
+	return autoConvert_aws_InstanceStorage_To_v1alpha1_InstanceStorage(in, out, s)
+}
+
+func autoConvert_v1alpha1_InventoryConfig_To_aws_InventoryConfig(in *InventoryConfig, out *aws.InventoryConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.S3 = (*aws.InventoryS3Location)(unsafe.Pointer(in.S3))
+	return nil
+}
+
+// Convert_v1alpha1_InventoryConfig_To_aws_InventoryConfig is an autogenerated conversion function.
+func Convert_v1alpha1_InventoryConfig_To_aws_InventoryConfig(in *InventoryConfig, out *aws.InventoryConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InventoryConfig_To_aws_InventoryConfig(in, out, s)
+}
+
+func autoConvert_aws_InventoryConfig_To_v1alpha1_InventoryConfig(in *aws.InventoryConfig, out *InventoryConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.S3 = (*InventoryS3Location)(unsafe.Pointer(in.S3))
+	return nil
+}
+
+// Convert_aws_InventoryConfig_To_v1alpha1_InventoryConfig is an autogenerated conversion function.
+func Convert_aws_InventoryConfig_To_v1alpha1_InventoryConfig(in *aws.InventoryConfig, out *InventoryConfig, s conversion.Scope) error {
+	return autoConvert_aws_InventoryConfig_To_v1alpha1_InventoryConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_InventoryS3Location_To_aws_InventoryS3Location(in *InventoryS3Location, out *aws.InventoryS3Location, s conversion.Scope) error {
+	out.Bucket = in.Bucket
+	out.Prefix = in.Prefix
+	return nil
+}
+
+// Convert_v1alpha1_InventoryS3Location_To_aws_InventoryS3Location is an autogenerated conversion function.
+func Convert_v1alpha1_InventoryS3Location_To_aws_InventoryS3Location(in *InventoryS3Location, out *aws.InventoryS3Location, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InventoryS3Location_To_aws_InventoryS3Location(in, out, s)
+}
+
+func autoConvert_aws_InventoryS3Location_To_v1alpha1_InventoryS3Location(in *aws.InventoryS3Location, out *InventoryS3Location, s conversion.Scope) error {
+	out.Bucket = in.Bucket
+	out.Prefix = in.Prefix
+	return nil
+}
+
+// Convert_aws_InventoryS3Location_To_v1alpha1_InventoryS3Location is an autogenerated conversion function.
+func Convert_aws_InventoryS3Location_To_v1alpha1_InventoryS3Location(in *aws.InventoryS3Location, out *InventoryS3Location, s conversion.Scope) error {
+	return autoConvert_aws_InventoryS3Location_To_v1alpha1_InventoryS3Location(in, out, s)
+}
+
+func autoConvert_v1alpha1_KarpenterConfig_To_aws_KarpenterConfig(in *KarpenterConfig, out *aws.KarpenterConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.InterruptionHandling = (*bool)(unsafe.Pointer(in.InterruptionHandling))
+	return nil
+}
+
+// Convert_v1alpha1_KarpenterConfig_To_aws_KarpenterConfig is an autogenerated conversion function.
+func Convert_v1alpha1_KarpenterConfig_To_aws_KarpenterConfig(in *KarpenterConfig, out *aws.KarpenterConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KarpenterConfig_To_aws_KarpenterConfig(in, out, s)
+}
+
+func autoConvert_aws_KarpenterConfig_To_v1alpha1_KarpenterConfig(in *aws.KarpenterConfig, out *KarpenterConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.InterruptionHandling = (*bool)(unsafe.Pointer(in.InterruptionHandling))
+	return nil
+}
+
+// Convert_aws_KarpenterConfig_To_v1alpha1_KarpenterConfig is an autogenerated conversion function.
+func Convert_aws_KarpenterConfig_To_v1alpha1_KarpenterConfig(in *aws.KarpenterConfig, out *KarpenterConfig, s conversion.Scope) error {
+	return autoConvert_aws_KarpenterConfig_To_v1alpha1_KarpenterConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_KarpenterStatus_To_aws_KarpenterStatus(in *KarpenterStatus, out *aws.KarpenterStatus, s conversion.Scope) error {
+	out.InterruptionQueueName = in.InterruptionQueueName
+	return nil
+}
+
+// Convert_v1alpha1_KarpenterStatus_To_aws_KarpenterStatus is an autogenerated conversion function.
+func Convert_v1alpha1_KarpenterStatus_To_aws_KarpenterStatus(in *KarpenterStatus, out *aws.KarpenterStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KarpenterStatus_To_aws_KarpenterStatus(in, out, s)
+}
+
+func autoConvert_aws_KarpenterStatus_To_v1alpha1_KarpenterStatus(in *aws.KarpenterStatus, out *KarpenterStatus, s conversion.Scope) error {
+	out.InterruptionQueueName = in.InterruptionQueueName
+	return nil
+}
+
+// Convert_aws_KarpenterStatus_To_v1alpha1_KarpenterStatus is an autogenerated conversion function.
+func Convert_aws_KarpenterStatus_To_v1alpha1_KarpenterStatus(in *aws.KarpenterStatus, out *KarpenterStatus, s conversion.Scope) error {
+	return autoConvert_aws_KarpenterStatus_To_v1alpha1_KarpenterStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_KubeletConfig_To_aws_KubeletConfig(in *KubeletConfig, out *aws.KubeletConfig, s conversion.Scope) error {
+	out.KubeReservedFromInstanceType = in.KubeReservedFromInstanceType
+	out.MaxPodsFromNetworkInterfaces = in.MaxPodsFromNetworkInterfaces
+	return nil
+}
+
+// Convert_v1alpha1_KubeletConfig_To_aws_KubeletConfig is an autogenerated conversion function.
+func Convert_v1alpha1_KubeletConfig_To_aws_KubeletConfig(in *KubeletConfig, out *aws.KubeletConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KubeletConfig_To_aws_KubeletConfig(in, out, s)
+}
+
+func autoConvert_aws_KubeletConfig_To_v1alpha1_KubeletConfig(in *aws.KubeletConfig, out *KubeletConfig, s conversion.Scope) error {
+	out.KubeReservedFromInstanceType = in.KubeReservedFromInstanceType
+	out.MaxPodsFromNetworkInterfaces = in.MaxPodsFromNetworkInterfaces
+	return nil
+}
+
+// Convert_aws_KubeletConfig_To_v1alpha1_KubeletConfig is an autogenerated conversion function.
+func Convert_aws_KubeletConfig_To_v1alpha1_KubeletConfig(in *aws.KubeletConfig, out *KubeletConfig, s conversion.Scope) error {
+	return autoConvert_aws_KubeletConfig_To_v1alpha1_KubeletConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_LatencyRoutingPolicy_To_aws_LatencyRoutingPolicy(in *LatencyRoutingPolicy, out *aws.LatencyRoutingPolicy, s conversion.Scope) error {
+	out.Region = in.Region
+	return nil
+}
+
+// Convert_v1alpha1_LatencyRoutingPolicy_To_aws_LatencyRoutingPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_LatencyRoutingPolicy_To_aws_LatencyRoutingPolicy(in *LatencyRoutingPolicy, out *aws.LatencyRoutingPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LatencyRoutingPolicy_To_aws_LatencyRoutingPolicy(in, out, s)
+}
+
+func autoConvert_aws_LatencyRoutingPolicy_To_v1alpha1_LatencyRoutingPolicy(in *aws.LatencyRoutingPolicy, out *LatencyRoutingPolicy, s conversion.Scope) error {
+	out.Region = in.Region
+	return nil
+}
+
+// Convert_aws_LatencyRoutingPolicy_To_v1alpha1_LatencyRoutingPolicy is an autogenerated conversion function.
+func Convert_aws_LatencyRoutingPolicy_To_v1alpha1_LatencyRoutingPolicy(in *aws.LatencyRoutingPolicy, out *LatencyRoutingPolicy, s conversion.Scope) error {
+	return autoConvert_aws_LatencyRoutingPolicy_To_v1alpha1_LatencyRoutingPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_LifecycleTransition_To_aws_LifecycleTransition(in *LifecycleTransition, out *aws.LifecycleTransition, s conversion.Scope) error {
+	out.Days = in.Days
+	out.StorageClass = in.StorageClass
+	return nil
+}
+
+// Convert_v1alpha1_LifecycleTransition_To_aws_LifecycleTransition is an autogenerated conversion function.
+func Convert_v1alpha1_LifecycleTransition_To_aws_LifecycleTransition(in *LifecycleTransition, out *aws.LifecycleTransition, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LifecycleTransition_To_aws_LifecycleTransition(in, out, s)
+}
+
+func autoConvert_aws_LifecycleTransition_To_v1alpha1_LifecycleTransition(in *aws.LifecycleTransition, out *LifecycleTransition, s conversion.Scope) error {
+	out.Days = in.Days
+	out.StorageClass = in.StorageClass
+	return nil
+}
+
+// Convert_aws_LifecycleTransition_To_v1alpha1_LifecycleTransition is an autogenerated conversion function.
+func Convert_aws_LifecycleTransition_To_v1alpha1_LifecycleTransition(in *aws.LifecycleTransition, out *LifecycleTransition, s conversion.Scope) error {
+	return autoConvert_aws_LifecycleTransition_To_v1alpha1_LifecycleTransition(in, out, s)
+}
+
+func autoConvert_v1alpha1_LoadBalancerAccessLogs_To_aws_LoadBalancerAccessLogs(in *LoadBalancerAccessLogs, out *aws.LoadBalancerAccessLogs, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.BucketName = (*string)(unsafe.Pointer(in.BucketName))
+	out.Prefix = (*string)(unsafe.Pointer(in.Prefix))
+	out.EmitInterval = (*int32)(unsafe.Pointer(in.EmitInterval))
+	return nil
+}
+
+// Convert_v1alpha1_LoadBalancerAccessLogs_To_aws_LoadBalancerAccessLogs is an autogenerated conversion function.
+func Convert_v1alpha1_LoadBalancerAccessLogs_To_aws_LoadBalancerAccessLogs(in *LoadBalancerAccessLogs, out *aws.LoadBalancerAccessLogs, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LoadBalancerAccessLogs_To_aws_LoadBalancerAccessLogs(in, out, s)
+}
+
+func autoConvert_aws_LoadBalancerAccessLogs_To_v1alpha1_LoadBalancerAccessLogs(in *aws.LoadBalancerAccessLogs, out *LoadBalancerAccessLogs, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.BucketName = (*string)(unsafe.Pointer(in.BucketName))
+	out.Prefix = (*string)(unsafe.Pointer(in.Prefix))
+	out.EmitInterval = (*int32)(unsafe.Pointer(in.EmitInterval))
+	return nil
+}
+
+// Convert_aws_LoadBalancerAccessLogs_To_v1alpha1_LoadBalancerAccessLogs is an autogenerated conversion function.
+func Convert_aws_LoadBalancerAccessLogs_To_v1alpha1_LoadBalancerAccessLogs(in *aws.LoadBalancerAccessLogs, out *LoadBalancerAccessLogs, s conversion.Scope) error {
+	return autoConvert_aws_LoadBalancerAccessLogs_To_v1alpha1_LoadBalancerAccessLogs(in, out, s)
+}
+
+func autoConvert_v1alpha1_LoadBalancerAccessLogsConfig_To_aws_LoadBalancerAccessLogsConfig(in *LoadBalancerAccessLogsConfig, out *aws.LoadBalancerAccessLogsConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha1_LoadBalancerAccessLogsConfig_To_aws_LoadBalancerAccessLogsConfig is an autogenerated conversion function.
+func Convert_v1alpha1_LoadBalancerAccessLogsConfig_To_aws_LoadBalancerAccessLogsConfig(in *LoadBalancerAccessLogsConfig, out *aws.LoadBalancerAccessLogsConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LoadBalancerAccessLogsConfig_To_aws_LoadBalancerAccessLogsConfig(in, out, s)
+}
+
+func autoConvert_aws_LoadBalancerAccessLogsConfig_To_v1alpha1_LoadBalancerAccessLogsConfig(in *aws.LoadBalancerAccessLogsConfig, out *LoadBalancerAccessLogsConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_aws_LoadBalancerAccessLogsConfig_To_v1alpha1_LoadBalancerAccessLogsConfig is an autogenerated conversion function.
+func Convert_aws_LoadBalancerAccessLogsConfig_To_v1alpha1_LoadBalancerAccessLogsConfig(in *aws.LoadBalancerAccessLogsConfig, out *LoadBalancerAccessLogsConfig, s conversion.Scope) error {
+	return autoConvert_aws_LoadBalancerAccessLogsConfig_To_v1alpha1_LoadBalancerAccessLogsConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_LoadBalancerAccessLogsStatus_To_aws_LoadBalancerAccessLogsStatus(in *LoadBalancerAccessLogsStatus, out *aws.LoadBalancerAccessLogsStatus, s conversion.Scope) error {
+	out.BucketName = in.BucketName
+	return nil
+}
+
+// Convert_v1alpha1_LoadBalancerAccessLogsStatus_To_aws_LoadBalancerAccessLogsStatus is an autogenerated conversion function.
+func Convert_v1alpha1_LoadBalancerAccessLogsStatus_To_aws_LoadBalancerAccessLogsStatus(in *LoadBalancerAccessLogsStatus, out *aws.LoadBalancerAccessLogsStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LoadBalancerAccessLogsStatus_To_aws_LoadBalancerAccessLogsStatus(in, out, s)
+}
+
+func autoConvert_aws_LoadBalancerAccessLogsStatus_To_v1alpha1_LoadBalancerAccessLogsStatus(in *aws.LoadBalancerAccessLogsStatus, out *LoadBalancerAccessLogsStatus, s conversion.Scope) error {
+	out.BucketName = in.BucketName
+	return nil
+}
+
+// Convert_aws_LoadBalancerAccessLogsStatus_To_v1alpha1_LoadBalancerAccessLogsStatus is an autogenerated conversion function.
+func Convert_aws_LoadBalancerAccessLogsStatus_To_v1alpha1_LoadBalancerAccessLogsStatus(in *aws.LoadBalancerAccessLogsStatus, out *LoadBalancerAccessLogsStatus, s conversion.Scope) error {
+	return autoConvert_aws_LoadBalancerAccessLogsStatus_To_v1alpha1_LoadBalancerAccessLogsStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_LoadBalancerControllerConfig_To_aws_LoadBalancerControllerConfig(in *LoadBalancerControllerConfig, out *aws.LoadBalancerControllerConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.IngressClassName = (*string)(unsafe.Pointer(in.IngressClassName))
+	return nil
+}
+
+// Convert_v1alpha1_LoadBalancerControllerConfig_To_aws_LoadBalancerControllerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_LoadBalancerControllerConfig_To_aws_LoadBalancerControllerConfig(in *LoadBalancerControllerConfig, out *aws.LoadBalancerControllerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LoadBalancerControllerConfig_To_aws_LoadBalancerControllerConfig(in, out, s)
+}
+
+func autoConvert_aws_LoadBalancerControllerConfig_To_v1alpha1_LoadBalancerControllerConfig(in *aws.LoadBalancerControllerConfig, out *LoadBalancerControllerConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.IngressClassName = (*string)(unsafe.Pointer(in.IngressClassName))
+	return nil
+}
+
+// Convert_aws_LoadBalancerControllerConfig_To_v1alpha1_LoadBalancerControllerConfig is an autogenerated conversion function.
+func Convert_aws_LoadBalancerControllerConfig_To_v1alpha1_LoadBalancerControllerConfig(in *aws.LoadBalancerControllerConfig, out *LoadBalancerControllerConfig, s conversion.Scope) error {
+	return autoConvert_aws_LoadBalancerControllerConfig_To_v1alpha1_LoadBalancerControllerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_LoadBalancerDefaults_To_aws_LoadBalancerDefaults(in *LoadBalancerDefaults, out *aws.LoadBalancerDefaults, s conversion.Scope) error {
+	out.Internal = (*bool)(unsafe.Pointer(in.Internal))
+	out.TargetType = (*string)(unsafe.Pointer(in.TargetType))
+	out.Subnets = *(*[]string)(unsafe.Pointer(&in.Subnets))
+	out.Attributes = *(*map[string]string)(unsafe.Pointer(&in.Attributes))
+	out.AccessLogs = (*aws.LoadBalancerAccessLogs)(unsafe.Pointer(in.AccessLogs))
+	out.SSLNegotiationPolicy = (*string)(unsafe.Pointer(in.SSLNegotiationPolicy))
+	return nil
+}
+
+// Convert_v1alpha1_LoadBalancerDefaults_To_aws_LoadBalancerDefaults is an autogenerated conversion function.
+func Convert_v1alpha1_LoadBalancerDefaults_To_aws_LoadBalancerDefaults(in *LoadBalancerDefaults, out *aws.LoadBalancerDefaults, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LoadBalancerDefaults_To_aws_LoadBalancerDefaults(in, out, s)
+}
+
+func autoConvert_aws_LoadBalancerDefaults_To_v1alpha1_LoadBalancerDefaults(in *aws.LoadBalancerDefaults, out *LoadBalancerDefaults, s conversion.Scope) error {
+	out.Internal = (*bool)(unsafe.Pointer(in.Internal))
+	out.TargetType = (*string)(unsafe.Pointer(in.TargetType))
+	out.Subnets = *(*[]string)(unsafe.Pointer(&in.Subnets))
+	out.Attributes = *(*map[string]string)(unsafe.Pointer(&in.Attributes))
+	out.AccessLogs = (*LoadBalancerAccessLogs)(unsafe.Pointer(in.AccessLogs))
+	out.SSLNegotiationPolicy = (*string)(unsafe.Pointer(in.SSLNegotiationPolicy))
+	return nil
+}
+
+// Convert_aws_LoadBalancerDefaults_To_v1alpha1_LoadBalancerDefaults is an autogenerated conversion function.
+func Convert_aws_LoadBalancerDefaults_To_v1alpha1_LoadBalancerDefaults(in *aws.LoadBalancerDefaults, out *LoadBalancerDefaults, s conversion.Scope) error {
+	return autoConvert_aws_LoadBalancerDefaults_To_v1alpha1_LoadBalancerDefaults(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineImage_To_aws_MachineImage(in *MachineImage, out *aws.MachineImage, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = in.Version
+	out.AMI = in.AMI
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	return nil
+}
+
+// Convert_v1alpha1_MachineImage_To_aws_MachineImage is an autogenerated conversion function.
+func Convert_v1alpha1_MachineImage_To_aws_MachineImage(in *MachineImage, out *aws.MachineImage, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineImage_To_aws_MachineImage(in, out, s)
+}
+
+func autoConvert_aws_MachineImage_To_v1alpha1_MachineImage(in *aws.MachineImage, out *MachineImage, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = in.Version
+	out.AMI = in.AMI
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	return nil
+}
+
+// Convert_aws_MachineImage_To_v1alpha1_MachineImage is an autogenerated conversion function.
+func Convert_aws_MachineImage_To_v1alpha1_MachineImage(in *aws.MachineImage, out *MachineImage, s conversion.Scope) error {
+	return autoConvert_aws_MachineImage_To_v1alpha1_MachineImage(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineImageVersion_To_aws_MachineImageVersion(in *MachineImageVersion, out *aws.MachineImageVersion, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Regions = *(*[]aws.RegionAMIMapping)(unsafe.Pointer(&in.Regions))
+	out.SSMParameters = *(*[]aws.SSMParameter)(unsafe.Pointer(&in.SSMParameters))
+	out.SourceImages = *(*[]aws.SourceImage)(unsafe.Pointer(&in.SourceImages))
+	out.AcceleratorDrivers = *(*[]aws.AcceleratorType)(unsafe.Pointer(&in.AcceleratorDrivers))
+	return nil
+}
+
+// Convert_v1alpha1_MachineImageVersion_To_aws_MachineImageVersion is an autogenerated conversion function.
+func Convert_v1alpha1_MachineImageVersion_To_aws_MachineImageVersion(in *MachineImageVersion, out *aws.MachineImageVersion, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineImageVersion_To_aws_MachineImageVersion(in, out, s)
+}
+
+func autoConvert_aws_MachineImageVersion_To_v1alpha1_MachineImageVersion(in *aws.MachineImageVersion, out *MachineImageVersion, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Regions = *(*[]RegionAMIMapping)(unsafe.Pointer(&in.Regions))
+	out.SSMParameters = *(*[]SSMParameter)(unsafe.Pointer(&in.SSMParameters))
+	out.SourceImages = *(*[]SourceImage)(unsafe.Pointer(&in.SourceImages))
+	out.AcceleratorDrivers = *(*[]AcceleratorType)(unsafe.Pointer(&in.AcceleratorDrivers))
+	return nil
+}
+
+// Convert_aws_MachineImageVersion_To_v1alpha1_MachineImageVersion is an autogenerated conversion function.
+func Convert_aws_MachineImageVersion_To_v1alpha1_MachineImageVersion(in *aws.MachineImageVersion, out *MachineImageVersion, s conversion.Scope) error {
+	return autoConvert_aws_MachineImageVersion_To_v1alpha1_MachineImageVersion(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineImages_To_aws_MachineImages(in *MachineImages, out *aws.MachineImages, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Versions = *(*[]aws.MachineImageVersion)(unsafe.Pointer(&in.Versions))
+	return nil
+}
+
+// Convert_v1alpha1_MachineImages_To_aws_MachineImages is an autogenerated conversion function.
+func Convert_v1alpha1_MachineImages_To_aws_MachineImages(in *MachineImages, out *aws.MachineImages, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineImages_To_aws_MachineImages(in, out, s)
+}
+
+func autoConvert_aws_MachineImages_To_v1alpha1_MachineImages(in *aws.MachineImages, out *MachineImages, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Versions = *(*[]MachineImageVersion)(unsafe.Pointer(&in.Versions))
+	return nil
+}
+
+// Convert_aws_MachineImages_To_v1alpha1_MachineImages is an autogenerated conversion function.
+func Convert_aws_MachineImages_To_v1alpha1_MachineImages(in *aws.MachineImages, out *MachineImages, s conversion.Scope) error {
+	return autoConvert_aws_MachineImages_To_v1alpha1_MachineImages(in, out, s)
+}
+
+func autoConvert_v1alpha1_Monitoring_To_aws_Monitoring(in *Monitoring, out *aws.Monitoring, s conversion.Scope) error {
+	out.Detailed = in.Detailed
+	return nil
+}
+
+// Convert_v1alpha1_Monitoring_To_aws_Monitoring is an autogenerated conversion function.
+func Convert_v1alpha1_Monitoring_To_aws_Monitoring(in *Monitoring, out *aws.Monitoring, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Monitoring_To_aws_Monitoring(in, out, s)
+}
+
+func autoConvert_aws_Monitoring_To_v1alpha1_Monitoring(in *aws.Monitoring, out *Monitoring, s conversion.Scope) error {
+	out.Detailed = in.Detailed
+	return nil
+}
+
+// Convert_aws_Monitoring_To_v1alpha1_Monitoring is an autogenerated conversion function.
+func Convert_aws_Monitoring_To_v1alpha1_Monitoring(in *aws.Monitoring, out *Monitoring, s conversion.Scope) error {
+	return autoConvert_aws_Monitoring_To_v1alpha1_Monitoring(in, out, s)
+}
+
+func autoConvert_v1alpha1_NATGateway_To_aws_NATGateway(in *NATGateway, out *aws.NATGateway, s conversion.Scope) error {
+	out.Mode = (*aws.NATGatewayMode)(unsafe.Pointer(in.Mode))
+	out.Type = (*aws.NATGatewayType)(unsafe.Pointer(in.Type))
+	out.Instance = (*aws.NATInstance)(unsafe.Pointer(in.Instance))
+	out.ElasticIPPool = (*aws.ElasticIPPool)(unsafe.Pointer(in.ElasticIPPool))
+	return nil
+}
+
+// Convert_v1alpha1_NATGateway_To_aws_NATGateway is an autogenerated conversion function.
+func Convert_v1alpha1_NATGateway_To_aws_NATGateway(in *NATGateway, out *aws.NATGateway, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NATGateway_To_aws_NATGateway(in, out, s)
+}
+
+func autoConvert_aws_NATGateway_To_v1alpha1_NATGateway(in *aws.NATGateway, out *NATGateway, s conversion.Scope) error {
+	out.Mode = (*NATGatewayMode)(unsafe.Pointer(in.Mode))
+	out.Type = (*NATGatewayType)(unsafe.Pointer(in.Type))
+	out.Instance = (*NATInstance)(unsafe.Pointer(in.Instance))
+	out.ElasticIPPool = (*ElasticIPPool)(unsafe.Pointer(in.ElasticIPPool))
+	return nil
+}
+
+// Convert_aws_NATGateway_To_v1alpha1_NATGateway is an autogenerated conversion function.
+func Convert_aws_NATGateway_To_v1alpha1_NATGateway(in *aws.NATGateway, out *NATGateway, s conversion.Scope) error {
+	return autoConvert_aws_NATGateway_To_v1alpha1_NATGateway(in, out, s)
+}
+
+func autoConvert_v1alpha1_NATGatewayStatus_To_aws_NATGatewayStatus(in *NATGatewayStatus, out *aws.NATGatewayStatus, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.ID = in.ID
+	out.ElasticIPAllocationID = (*string)(unsafe.Pointer(in.ElasticIPAllocationID))
+	out.PublicIP = (*string)(unsafe.Pointer(in.PublicIP))
+	return nil
+}
+
+// Convert_v1alpha1_NATGatewayStatus_To_aws_NATGatewayStatus is an autogenerated conversion function.
+func Convert_v1alpha1_NATGatewayStatus_To_aws_NATGatewayStatus(in *NATGatewayStatus, out *aws.NATGatewayStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NATGatewayStatus_To_aws_NATGatewayStatus(in, out, s)
+}
+
+func autoConvert_aws_NATGatewayStatus_To_v1alpha1_NATGatewayStatus(in *aws.NATGatewayStatus, out *NATGatewayStatus, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.ID = in.ID
+	out.ElasticIPAllocationID = (*string)(unsafe.Pointer(in.ElasticIPAllocationID))
+	out.PublicIP = (*string)(unsafe.Pointer(in.PublicIP))
+	return nil
+}
+
+// Convert_aws_NATGatewayStatus_To_v1alpha1_NATGatewayStatus is an autogenerated conversion function.
+func Convert_aws_NATGatewayStatus_To_v1alpha1_NATGatewayStatus(in *aws.NATGatewayStatus, out *NATGatewayStatus, s conversion.Scope) error {
+	return autoConvert_aws_NATGatewayStatus_To_v1alpha1_NATGatewayStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_NATInstance_To_aws_NATInstance(in *NATInstance, out *aws.NATInstance, s conversion.Scope) error {
+	out.InstanceType = in.InstanceType
+	out.AMI = in.AMI
+	return nil
+}
+
+// Convert_v1alpha1_NATInstance_To_aws_NATInstance is an autogenerated conversion function.
+func Convert_v1alpha1_NATInstance_To_aws_NATInstance(in *NATInstance, out *aws.NATInstance, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NATInstance_To_aws_NATInstance(in, out, s)
+}
+
+func autoConvert_aws_NATInstance_To_v1alpha1_NATInstance(in *aws.NATInstance, out *NATInstance, s conversion.Scope) error {
+	out.InstanceType = in.InstanceType
+	out.AMI = in.AMI
+	return nil
+}
+
+// Convert_aws_NATInstance_To_v1alpha1_NATInstance is an autogenerated conversion function.
+func Convert_aws_NATInstance_To_v1alpha1_NATInstance(in *aws.NATInstance, out *NATInstance, s conversion.Scope) error {
+	return autoConvert_aws_NATInstance_To_v1alpha1_NATInstance(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkACL_To_aws_NetworkACL(in *NetworkACL, out *aws.NetworkACL, s conversion.Scope) error {
+	out.Rules = *(*[]aws.NetworkACLRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_v1alpha1_NetworkACL_To_aws_NetworkACL is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkACL_To_aws_NetworkACL(in *NetworkACL, out *aws.NetworkACL, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkACL_To_aws_NetworkACL(in, out, s)
+}
+
+func autoConvert_aws_NetworkACL_To_v1alpha1_NetworkACL(in *aws.NetworkACL, out *NetworkACL, s conversion.Scope) error {
+	out.Rules = *(*[]NetworkACLRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_aws_NetworkACL_To_v1alpha1_NetworkACL is an autogenerated conversion function.
+func Convert_aws_NetworkACL_To_v1alpha1_NetworkACL(in *aws.NetworkACL, out *NetworkACL, s conversion.Scope) error {
+	return autoConvert_aws_NetworkACL_To_v1alpha1_NetworkACL(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkACLRule_To_aws_NetworkACLRule(in *NetworkACLRule, out *aws.NetworkACLRule, s conversion.Scope) error {
+	out.RuleNumber = in.RuleNumber
+	out.Type = aws.NetworkACLRuleType(in.Type)
+	out.Action = aws.NetworkACLRuleAction(in.Action)
+	out.Protocol = in.Protocol
+	out.FromPort = (*int64)(unsafe.Pointer(in.FromPort))
+	out.ToPort = (*int64)(unsafe.Pointer(in.ToPort))
+	out.CIDRBlock = in.CIDRBlock
+	return nil
+}
+
+// Convert_v1alpha1_NetworkACLRule_To_aws_NetworkACLRule is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkACLRule_To_aws_NetworkACLRule(in *NetworkACLRule, out *aws.NetworkACLRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkACLRule_To_aws_NetworkACLRule(in, out, s)
+}
+
+func autoConvert_aws_NetworkACLRule_To_v1alpha1_NetworkACLRule(in *aws.NetworkACLRule, out *NetworkACLRule, s conversion.Scope) error {
+	out.RuleNumber = in.RuleNumber
+	out.Type = NetworkACLRuleType(in.Type)
+	out.Action = NetworkACLRuleAction(in.Action)
+	out.Protocol = in.Protocol
+	out.FromPort = (*int64)(unsafe.Pointer(in.FromPort))
+	out.ToPort = (*int64)(unsafe.Pointer(in.ToPort))
+	out.CIDRBlock = in.CIDRBlock
+	return nil
+}
+
+// Convert_aws_NetworkACLRule_To_v1alpha1_NetworkACLRule is an autogenerated conversion function.
+func Convert_aws_NetworkACLRule_To_v1alpha1_NetworkACLRule(in *aws.NetworkACLRule, out *NetworkACLRule, s conversion.Scope) error {
+	return autoConvert_aws_NetworkACLRule_To_v1alpha1_NetworkACLRule(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkACLs_To_aws_NetworkACLs(in *NetworkACLs, out *aws.NetworkACLs, s conversion.Scope) error {
+	out.Workers = (*aws.NetworkACL)(unsafe.Pointer(in.Workers))
+	out.Public = (*aws.NetworkACL)(unsafe.Pointer(in.Public))
+	out.Internal = (*aws.NetworkACL)(unsafe.Pointer(in.Internal))
+	return nil
+}
+
+// Convert_v1alpha1_NetworkACLs_To_aws_NetworkACLs is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkACLs_To_aws_NetworkACLs(in *NetworkACLs, out *aws.NetworkACLs, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkACLs_To_aws_NetworkACLs(in, out, s)
+}
+
+func autoConvert_aws_NetworkACLs_To_v1alpha1_NetworkACLs(in *aws.NetworkACLs, out *NetworkACLs, s conversion.Scope) error {
+	out.Workers = (*NetworkACL)(unsafe.Pointer(in.Workers))
+	out.Public = (*NetworkACL)(unsafe.Pointer(in.Public))
+	out.Internal = (*NetworkACL)(unsafe.Pointer(in.Internal))
+	return nil
+}
+
+// Convert_aws_NetworkACLs_To_v1alpha1_NetworkACLs is an autogenerated conversion function.
+func Convert_aws_NetworkACLs_To_v1alpha1_NetworkACLs(in *aws.NetworkACLs, out *NetworkACLs, s conversion.Scope) error {
+	return autoConvert_aws_NetworkACLs_To_v1alpha1_NetworkACLs(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkInterfaceSubnet_To_aws_NetworkInterfaceSubnet(in *NetworkInterfaceSubnet, out *aws.NetworkInterfaceSubnet, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.ID = in.ID
+	return nil
+}
+
+// Convert_v1alpha1_NetworkInterfaceSubnet_To_aws_NetworkInterfaceSubnet is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkInterfaceSubnet_To_aws_NetworkInterfaceSubnet(in *NetworkInterfaceSubnet, out *aws.NetworkInterfaceSubnet, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkInterfaceSubnet_To_aws_NetworkInterfaceSubnet(in, out, s)
+}
+
+func autoConvert_aws_NetworkInterfaceSubnet_To_v1alpha1_NetworkInterfaceSubnet(in *aws.NetworkInterfaceSubnet, out *NetworkInterfaceSubnet, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.ID = in.ID
+	return nil
+}
+
+// Convert_aws_NetworkInterfaceSubnet_To_v1alpha1_NetworkInterfaceSubnet is an autogenerated conversion function.
+func Convert_aws_NetworkInterfaceSubnet_To_v1alpha1_NetworkInterfaceSubnet(in *aws.NetworkInterfaceSubnet, out *NetworkInterfaceSubnet, s conversion.Scope) error {
+	return autoConvert_aws_NetworkInterfaceSubnet_To_v1alpha1_NetworkInterfaceSubnet(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkInterfaces_To_aws_NetworkInterfaces(in *NetworkInterfaces, out *aws.NetworkInterfaces, s conversion.Scope) error {
+	out.EFA = in.EFA
+	out.Additional = *(*[]aws.AdditionalNetworkInterface)(unsafe.Pointer(&in.Additional))
+	return nil
+}
+
+// Convert_v1alpha1_NetworkInterfaces_To_aws_NetworkInterfaces is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkInterfaces_To_aws_NetworkInterfaces(in *NetworkInterfaces, out *aws.NetworkInterfaces, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkInterfaces_To_aws_NetworkInterfaces(in, out, s)
+}
+
+func autoConvert_aws_NetworkInterfaces_To_v1alpha1_NetworkInterfaces(in *aws.NetworkInterfaces, out *NetworkInterfaces, s conversion.Scope) error {
+	out.EFA = in.EFA
+	out.Additional = *(*[]AdditionalNetworkInterface)(unsafe.Pointer(&in.Additional))
+	return nil
+}
+
+// Convert_aws_NetworkInterfaces_To_v1alpha1_NetworkInterfaces is an autogenerated conversion function.
+func Convert_aws_NetworkInterfaces_To_v1alpha1_NetworkInterfaces(in *aws.NetworkInterfaces, out *NetworkInterfaces, s conversion.Scope) error {
+	return autoConvert_aws_NetworkInterfaces_To_v1alpha1_NetworkInterfaces(in, out, s)
+}
+
+func autoConvert_v1alpha1_Networks_To_aws_Networks(in *Networks, out *aws.Networks, s conversion.Scope) error {
+	if err := Convert_v1alpha1_VPC_To_aws_VPC(&in.VPC, &out.VPC, s); err != nil {
+		return err
+	}
+	out.Zones = *(*[]aws.Zone)(unsafe.Pointer(&in.Zones))
+	out.TransitGateway = (*aws.TransitGateway)(unsafe.Pointer(in.TransitGateway))
+	out.IPFamilies = *(*[]aws.IPFamily)(unsafe.Pointer(&in.IPFamilies))
+	out.NATGateway = (*aws.NATGateway)(unsafe.Pointer(in.NATGateway))
+	out.AdditionalNodeSecurityGroupRules = *(*[]aws.NodeSecurityGroupRule)(unsafe.Pointer(&in.AdditionalNodeSecurityGroupRules))
+	out.VPCPeerings = *(*[]aws.VPCPeering)(unsafe.Pointer(&in.VPCPeerings))
+	out.NetworkACLs = (*aws.NetworkACLs)(unsafe.Pointer(in.NetworkACLs))
+	out.Route53Resolver = (*aws.Route53Resolver)(unsafe.Pointer(in.Route53Resolver))
+	return nil
+}
+
+// Convert_v1alpha1_Networks_To_aws_Networks is an autogenerated conversion function.
+func Convert_v1alpha1_Networks_To_aws_Networks(in *Networks, out *aws.Networks, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Networks_To_aws_Networks(in, out, s)
+}
+
+func autoConvert_aws_Networks_To_v1alpha1_Networks(in *aws.Networks, out *Networks, s conversion.Scope) error {
+	if err := Convert_aws_VPC_To_v1alpha1_VPC(&in.VPC, &out.VPC, s); err != nil {
+		return err
+	}
+	out.Zones = *(*[]Zone)(unsafe.Pointer(&in.Zones))
+	out.TransitGateway = (*TransitGateway)(unsafe.Pointer(in.TransitGateway))
+	out.IPFamilies = *(*[]IPFamily)(unsafe.Pointer(&in.IPFamilies))
+	out.NATGateway = (*NATGateway)(unsafe.Pointer(in.NATGateway))
+	out.AdditionalNodeSecurityGroupRules = *(*[]NodeSecurityGroupRule)(unsafe.Pointer(&in.AdditionalNodeSecurityGroupRules))
+	out.VPCPeerings = *(*[]VPCPeering)(unsafe.Pointer(&in.VPCPeerings))
+	out.NetworkACLs = (*NetworkACLs)(unsafe.Pointer(in.NetworkACLs))
+	out.Route53Resolver = (*Route53Resolver)(unsafe.Pointer(in.Route53Resolver))
+	return nil
+}
+
+// Convert_aws_Networks_To_v1alpha1_Networks is an autogenerated conversion function.
+func Convert_aws_Networks_To_v1alpha1_Networks(in *aws.Networks, out *Networks, s conversion.Scope) error {
+	return autoConvert_aws_Networks_To_v1alpha1_Networks(in, out, s)
+}
+
+func autoConvert_v1alpha1_NodeSecurityGroupRule_To_aws_NodeSecurityGroupRule(in *NodeSecurityGroupRule, out *aws.NodeSecurityGroupRule, s conversion.Scope) error {
+	out.Type = aws.NodeSecurityGroupRuleType(in.Type)
+	out.Protocol = in.Protocol
+	out.FromPort = (*int64)(unsafe.Pointer(in.FromPort))
+	out.ToPort = (*int64)(unsafe.Pointer(in.ToPort))
+	out.CIDRBlocks = *(*[]string)(unsafe.Pointer(&in.CIDRBlocks))
+	out.SecurityGroupIDs = *(*[]string)(unsafe.Pointer(&in.SecurityGroupIDs))
+	return nil
+}
+
+// Convert_v1alpha1_NodeSecurityGroupRule_To_aws_NodeSecurityGroupRule is an autogenerated conversion function.
+func Convert_v1alpha1_NodeSecurityGroupRule_To_aws_NodeSecurityGroupRule(in *NodeSecurityGroupRule, out *aws.NodeSecurityGroupRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NodeSecurityGroupRule_To_aws_NodeSecurityGroupRule(in, out, s)
+}
+
+func autoConvert_aws_NodeSecurityGroupRule_To_v1alpha1_NodeSecurityGroupRule(in *aws.NodeSecurityGroupRule, out *NodeSecurityGroupRule, s conversion.Scope) error {
+	out.Type = NodeSecurityGroupRuleType(in.Type)
+	out.Protocol = in.Protocol
+	out.FromPort = (*int64)(unsafe.Pointer(in.FromPort))
+	out.ToPort = (*int64)(unsafe.Pointer(in.ToPort))
+	out.CIDRBlocks = *(*[]string)(unsafe.Pointer(&in.CIDRBlocks))
+	out.SecurityGroupIDs = *(*[]string)(unsafe.Pointer(&in.SecurityGroupIDs))
+	return nil
+}
+
+// Convert_aws_NodeSecurityGroupRule_To_v1alpha1_NodeSecurityGroupRule is an autogenerated conversion function.
+func Convert_aws_NodeSecurityGroupRule_To_v1alpha1_NodeSecurityGroupRule(in *aws.NodeSecurityGroupRule, out *NodeSecurityGroupRule, s conversion.Scope) error {
+	return autoConvert_aws_NodeSecurityGroupRule_To_v1alpha1_NodeSecurityGroupRule(in, out, s)
+}
+
+func autoConvert_v1alpha1_ObjectLockConfig_To_aws_ObjectLockConfig(in *ObjectLockConfig, out *aws.ObjectLockConfig, s conversion.Scope) error {
+	out.Mode = aws.ObjectLockMode(in.Mode)
+	out.RetentionDays = in.RetentionDays
+	return nil
+}
+
+// Convert_v1alpha1_ObjectLockConfig_To_aws_ObjectLockConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ObjectLockConfig_To_aws_ObjectLockConfig(in *ObjectLockConfig, out *aws.ObjectLockConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ObjectLockConfig_To_aws_ObjectLockConfig(in, out, s)
+}
+
+func autoConvert_aws_ObjectLockConfig_To_v1alpha1_ObjectLockConfig(in *aws.ObjectLockConfig, out *ObjectLockConfig, s conversion.Scope) error {
+	out.Mode = ObjectLockMode(in.Mode)
+	out.RetentionDays = in.RetentionDays
+	return nil
+}
+
+// Convert_aws_ObjectLockConfig_To_v1alpha1_ObjectLockConfig is an autogenerated conversion function.
+func Convert_aws_ObjectLockConfig_To_v1alpha1_ObjectLockConfig(in *aws.ObjectLockConfig, out *ObjectLockConfig, s conversion.Scope) error {
+	return autoConvert_aws_ObjectLockConfig_To_v1alpha1_ObjectLockConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_PlacementGroup_To_aws_PlacementGroup(in *PlacementGroup, out *aws.PlacementGroup, s conversion.Scope) error {
+	out.Strategy = aws.PlacementGroupStrategy(in.Strategy)
+	out.PartitionCount = (*int64)(unsafe.Pointer(in.PartitionCount))
+	return nil
+}
+
+// Convert_v1alpha1_PlacementGroup_To_aws_PlacementGroup is an autogenerated conversion function.
+func Convert_v1alpha1_PlacementGroup_To_aws_PlacementGroup(in *PlacementGroup, out *aws.PlacementGroup, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PlacementGroup_To_aws_PlacementGroup(in, out, s)
+}
+
+func autoConvert_aws_PlacementGroup_To_v1alpha1_PlacementGroup(in *aws.PlacementGroup, out *PlacementGroup, s conversion.Scope) error {
+	out.Strategy = PlacementGroupStrategy(in.Strategy)
+	out.PartitionCount = (*int64)(unsafe.Pointer(in.PartitionCount))
+	return nil
+}
+
+// Convert_aws_PlacementGroup_To_v1alpha1_PlacementGroup is an autogenerated conversion function.
+func Convert_aws_PlacementGroup_To_v1alpha1_PlacementGroup(in *aws.PlacementGroup, out *PlacementGroup, s conversion.Scope) error {
+	return autoConvert_aws_PlacementGroup_To_v1alpha1_PlacementGroup(in, out, s)
+}
+
+func autoConvert_v1alpha1_PreProvisionedCapacity_To_aws_PreProvisionedCapacity(in *PreProvisionedCapacity, out *aws.PreProvisionedCapacity, s conversion.Scope) error {
+	out.Machines = in.Machines
+	out.Resources = *(*corev1.ResourceList)(unsafe.Pointer(&in.Resources))
+	return nil
+}
+
+// Convert_v1alpha1_PreProvisionedCapacity_To_aws_PreProvisionedCapacity is an autogenerated conversion function.
+func Convert_v1alpha1_PreProvisionedCapacity_To_aws_PreProvisionedCapacity(in *PreProvisionedCapacity, out *aws.PreProvisionedCapacity, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PreProvisionedCapacity_To_aws_PreProvisionedCapacity(in, out, s)
+}
+
+func autoConvert_aws_PreProvisionedCapacity_To_v1alpha1_PreProvisionedCapacity(in *aws.PreProvisionedCapacity, out *PreProvisionedCapacity, s conversion.Scope) error {
+	out.Machines = in.Machines
+	out.Resources = *(*corev1.ResourceList)(unsafe.Pointer(&in.Resources))
+	return nil
+}
+
+// Convert_aws_PreProvisionedCapacity_To_v1alpha1_PreProvisionedCapacity is an autogenerated conversion function.
+func Convert_aws_PreProvisionedCapacity_To_v1alpha1_PreProvisionedCapacity(in *aws.PreProvisionedCapacity, out *PreProvisionedCapacity, s conversion.Scope) error {
+	return autoConvert_aws_PreProvisionedCapacity_To_v1alpha1_PreProvisionedCapacity(in, out, s)
+}
+
+func autoConvert_v1alpha1_PrivateHostedZone_To_aws_PrivateHostedZone(in *PrivateHostedZone, out *aws.PrivateHostedZone, s conversion.Scope) error {
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	out.VPCs = *(*[]aws.HostedZoneVPC)(unsafe.Pointer(&in.VPCs))
+	return nil
+}
+
+// Convert_v1alpha1_PrivateHostedZone_To_aws_PrivateHostedZone is an autogenerated conversion function.
+func Convert_v1alpha1_PrivateHostedZone_To_aws_PrivateHostedZone(in *PrivateHostedZone, out *aws.PrivateHostedZone, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PrivateHostedZone_To_aws_PrivateHostedZone(in, out, s)
+}
+
+func autoConvert_aws_PrivateHostedZone_To_v1alpha1_PrivateHostedZone(in *aws.PrivateHostedZone, out *PrivateHostedZone, s conversion.Scope) error {
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	out.VPCs = *(*[]HostedZoneVPC)(unsafe.Pointer(&in.VPCs))
+	return nil
+}
+
+// Convert_aws_PrivateHostedZone_To_v1alpha1_PrivateHostedZone is an autogenerated conversion function.
+func Convert_aws_PrivateHostedZone_To_v1alpha1_PrivateHostedZone(in *aws.PrivateHostedZone, out *PrivateHostedZone, s conversion.Scope) error {
+	return autoConvert_aws_PrivateHostedZone_To_v1alpha1_PrivateHostedZone(in, out, s)
+}
+
+func autoConvert_v1alpha1_PrivateLinkConfig_To_aws_PrivateLinkConfig(in *PrivateLinkConfig, out *aws.PrivateLinkConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.AllowedPrincipals = *(*[]string)(unsafe.Pointer(&in.AllowedPrincipals))
+	out.AcceptanceRequired = (*bool)(unsafe.Pointer(in.AcceptanceRequired))
+	return nil
+}
+
+// Convert_v1alpha1_PrivateLinkConfig_To_aws_PrivateLinkConfig is an autogenerated conversion function.
+func Convert_v1alpha1_PrivateLinkConfig_To_aws_PrivateLinkConfig(in *PrivateLinkConfig, out *aws.PrivateLinkConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PrivateLinkConfig_To_aws_PrivateLinkConfig(in, out, s)
+}
+
+func autoConvert_aws_PrivateLinkConfig_To_v1alpha1_PrivateLinkConfig(in *aws.PrivateLinkConfig, out *PrivateLinkConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.AllowedPrincipals = *(*[]string)(unsafe.Pointer(&in.AllowedPrincipals))
+	out.AcceptanceRequired = (*bool)(unsafe.Pointer(in.AcceptanceRequired))
+	return nil
+}
+
+// Convert_aws_PrivateLinkConfig_To_v1alpha1_PrivateLinkConfig is an autogenerated conversion function.
+func Convert_aws_PrivateLinkConfig_To_v1alpha1_PrivateLinkConfig(in *aws.PrivateLinkConfig, out *PrivateLinkConfig, s conversion.Scope) error {
+	return autoConvert_aws_PrivateLinkConfig_To_v1alpha1_PrivateLinkConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_PrivateLinkStatus_To_aws_PrivateLinkStatus(in *PrivateLinkStatus, out *aws.PrivateLinkStatus, s conversion.Scope) error {
+	out.ServiceID = in.ServiceID
+	out.ServiceName = in.ServiceName
+	out.BaseEndpointDNSNames = *(*[]string)(unsafe.Pointer(&in.BaseEndpointDNSNames))
+	return nil
+}
+
+// Convert_v1alpha1_PrivateLinkStatus_To_aws_PrivateLinkStatus is an autogenerated conversion function.
+func Convert_v1alpha1_PrivateLinkStatus_To_aws_PrivateLinkStatus(in *PrivateLinkStatus, out *aws.PrivateLinkStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PrivateLinkStatus_To_aws_PrivateLinkStatus(in, out, s)
+}
+
+func autoConvert_aws_PrivateLinkStatus_To_v1alpha1_PrivateLinkStatus(in *aws.PrivateLinkStatus, out *PrivateLinkStatus, s conversion.Scope) error {
+	out.ServiceID = in.ServiceID
+	out.ServiceName = in.ServiceName
+	out.BaseEndpointDNSNames = *(*[]string)(unsafe.Pointer(&in.BaseEndpointDNSNames))
+	return nil
+}
+
+// Convert_aws_PrivateLinkStatus_To_v1alpha1_PrivateLinkStatus is an autogenerated conversion function.
+func Convert_aws_PrivateLinkStatus_To_v1alpha1_PrivateLinkStatus(in *aws.PrivateLinkStatus, out *PrivateLinkStatus, s conversion.Scope) error {
+	return autoConvert_aws_PrivateLinkStatus_To_v1alpha1_PrivateLinkStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_RegionAMIMapping_To_aws_RegionAMIMapping(in *RegionAMIMapping, out *aws.RegionAMIMapping, s conversion.Scope) error {
+	out.Name = in.Name
+	out.AMI = in.AMI
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
 	return nil
 }
 
-// Convert_v1alpha1_CloudProfileConfig_To_aws_CloudProfileConfig is an autogenerated conversion function.
-func Convert_v1alpha1_CloudProfileConfig_To_aws_CloudProfileConfig(in *CloudProfileConfig, out *aws.CloudProfileConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_CloudProfileConfig_To_aws_CloudProfileConfig(in, out, s)
+// Convert_v1alpha1_RegionAMIMapping_To_aws_RegionAMIMapping is an autogenerated conversion function.
+func Convert_v1alpha1_RegionAMIMapping_To_aws_RegionAMIMapping(in *RegionAMIMapping, out *aws.RegionAMIMapping, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RegionAMIMapping_To_aws_RegionAMIMapping(in, out, s)
 }
 
-func autoConvert_aws_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *aws.CloudProfileConfig, out *CloudProfileConfig, s conversion.Scope) error {
-	out.MachineImages = *(*[]MachineImages)(unsafe.Pointer(&in.MachineImages))
+func autoConvert_aws_RegionAMIMapping_To_v1alpha1_RegionAMIMapping(in *aws.RegionAMIMapping, out *RegionAMIMapping, s conversion.Scope) error {
+	out.Name = in.Name
+	out.AMI = in.AMI
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
 	return nil
 }
 
-// Convert_aws_CloudProfileConfig_To_v1alpha1_CloudProfileConfig is an autogenerated conversion function.
-func Convert_aws_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *aws.CloudProfileConfig, out *CloudProfileConfig, s conversion.Scope) error {
-	return autoConvert_aws_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in, out, s)
+// Convert_aws_RegionAMIMapping_To_v1alpha1_RegionAMIMapping is an autogenerated conversion function.
+func Convert_aws_RegionAMIMapping_To_v1alpha1_RegionAMIMapping(in *aws.RegionAMIMapping, out *RegionAMIMapping, s conversion.Scope) error {
+	return autoConvert_aws_RegionAMIMapping_To_v1alpha1_RegionAMIMapping(in, out, s)
 }
 
-func autoConvert_v1alpha1_ControlPlaneConfig_To_aws_ControlPlaneConfig(in *ControlPlaneConfig, out *aws.ControlPlaneConfig, s conversion.Scope) error {
-	out.CloudControllerManager = (*aws.CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
-	out.LoadBalancerController = (*aws.LoadBalancerControllerConfig)(unsafe.Pointer(in.LoadBalancerController))
-	out.Storage = (*aws.Storage)(unsafe.Pointer(in.Storage))
+func autoConvert_v1alpha1_RegistryMirror_To_aws_RegistryMirror(in *RegistryMirror, out *aws.RegistryMirror, s conversion.Scope) error {
+	out.Upstream = in.Upstream
+	out.Endpoints = *(*[]string)(unsafe.Pointer(&in.Endpoints))
 	return nil
 }
 
-// Convert_v1alpha1_ControlPlaneConfig_To_aws_ControlPlaneConfig is an autogenerated conversion function.
-func Convert_v1alpha1_ControlPlaneConfig_To_aws_ControlPlaneConfig(in *ControlPlaneConfig, out *aws.ControlPlaneConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ControlPlaneConfig_To_aws_ControlPlaneConfig(in, out, s)
+// Convert_v1alpha1_RegistryMirror_To_aws_RegistryMirror is an autogenerated conversion function.
+func Convert_v1alpha1_RegistryMirror_To_aws_RegistryMirror(in *RegistryMirror, out *aws.RegistryMirror, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RegistryMirror_To_aws_RegistryMirror(in, out, s)
 }
 
-func autoConvert_aws_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *aws.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
-	out.CloudControllerManager = (*CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
-	out.LoadBalancerController = (*LoadBalancerControllerConfig)(unsafe.Pointer(in.LoadBalancerController))
-	out.Storage = (*Storage)(unsafe.Pointer(in.Storage))
+func autoConvert_aws_RegistryMirror_To_v1alpha1_RegistryMirror(in *aws.RegistryMirror, out *RegistryMirror, s conversion.Scope) error {
+	out.Upstream = in.Upstream
+	out.Endpoints = *(*[]string)(unsafe.Pointer(&in.Endpoints))
 	return nil
 }
 
-// Convert_aws_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig is an autogenerated conversion function.
-func Convert_aws_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *aws.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
-	return autoConvert_aws_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in, out, s)
+// Convert_aws_RegistryMirror_To_v1alpha1_RegistryMirror is an autogenerated conversion function.
+func Convert_aws_RegistryMirror_To_v1alpha1_RegistryMirror(in *aws.RegistryMirror, out *RegistryMirror, s conversion.Scope) error {
+	return autoConvert_aws_RegistryMirror_To_v1alpha1_RegistryMirror(in, out, s)
 }
 
-func autoConvert_v1alpha1_DataVolume_To_aws_DataVolume(in *DataVolume, out *aws.DataVolume, s conversion.Scope) error {
-	out.Name = in.Name
-	if err := Convert_v1alpha1_Volume_To_aws_Volume(&in.Volume, &out.Volume, s); err != nil {
-		return err
-	}
-	out.SnapshotID = (*string)(unsafe.Pointer(in.SnapshotID))
+func autoConvert_v1alpha1_Role_To_aws_Role(in *Role, out *aws.Role, s conversion.Scope) error {
+	out.Purpose = in.Purpose
+	out.ARN = in.ARN
 	return nil
 }
 
-// Convert_v1alpha1_DataVolume_To_aws_DataVolume is an autogenerated conversion function.
-func Convert_v1alpha1_DataVolume_To_aws_DataVolume(in *DataVolume, out *aws.DataVolume, s conversion.Scope) error {
-	return autoConvert_v1alpha1_DataVolume_To_aws_DataVolume(in, out, s)
+// Convert_v1alpha1_Role_To_aws_Role is an autogenerated conversion function.
+func Convert_v1alpha1_Role_To_aws_Role(in *Role, out *aws.Role, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Role_To_aws_Role(in, out, s)
 }
 
-func autoConvert_aws_DataVolume_To_v1alpha1_DataVolume(in *aws.DataVolume, out *DataVolume, s conversion.Scope) error {
-	out.Name = in.Name
-	if err := Convert_aws_Volume_To_v1alpha1_Volume(&in.Volume, &out.Volume, s); err != nil {
-		return err
-	}
-	out.SnapshotID = (*string)(unsafe.Pointer(in.SnapshotID))
+func autoConvert_aws_Role_To_v1alpha1_Role(in *aws.Role, out *Role, s conversion.Scope) error {
+	out.Purpose = in.Purpose
+	out.ARN = in.ARN
 	return nil
 }
 
-// Convert_aws_DataVolume_To_v1alpha1_DataVolume is an autogenerated conversion function.
-func Convert_aws_DataVolume_To_v1alpha1_DataVolume(in *aws.DataVolume, out *DataVolume, s conversion.Scope) error {
-	return autoConvert_aws_DataVolume_To_v1alpha1_DataVolume(in, out, s)
+// Convert_aws_Role_To_v1alpha1_Role is an autogenerated conversion function.
+func Convert_aws_Role_To_v1alpha1_Role(in *aws.Role, out *Role, s conversion.Scope) error {
+	return autoConvert_aws_Role_To_v1alpha1_Role(in, out, s)
 }
 
-func autoConvert_v1alpha1_DualStack_To_aws_DualStack(in *DualStack, out *aws.DualStack, s conversion.Scope) error {
-	out.Enabled = in.Enabled
+func autoConvert_v1alpha1_RollingUpdate_To_aws_RollingUpdate(in *RollingUpdate, out *aws.RollingUpdate, s conversion.Scope) error {
+	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
 	return nil
 }
 
-// Convert_v1alpha1_DualStack_To_aws_DualStack is an autogenerated conversion function.
-func Convert_v1alpha1_DualStack_To_aws_DualStack(in *DualStack, out *aws.DualStack, s conversion.Scope) error {
-	return autoConvert_v1alpha1_DualStack_To_aws_DualStack(in, out, s)
+// Convert_v1alpha1_RollingUpdate_To_aws_RollingUpdate is an autogenerated conversion function.
+func Convert_v1alpha1_RollingUpdate_To_aws_RollingUpdate(in *RollingUpdate, out *aws.RollingUpdate, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RollingUpdate_To_aws_RollingUpdate(in, out, s)
 }
 
-func autoConvert_aws_DualStack_To_v1alpha1_DualStack(in *aws.DualStack, out *DualStack, s conversion.Scope) error {
-	out.Enabled = in.Enabled
+func autoConvert_aws_RollingUpdate_To_v1alpha1_RollingUpdate(in *aws.RollingUpdate, out *RollingUpdate, s conversion.Scope) error {
+	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
 	return nil
 }
 
-// Convert_aws_DualStack_To_v1alpha1_DualStack is an autogenerated conversion function.
-func Convert_aws_DualStack_To_v1alpha1_DualStack(in *aws.DualStack, out *DualStack, s conversion.Scope) error {
-	return autoConvert_aws_DualStack_To_v1alpha1_DualStack(in, out, s)
+// Convert_aws_RollingUpdate_To_v1alpha1_RollingUpdate is an autogenerated conversion function.
+func Convert_aws_RollingUpdate_To_v1alpha1_RollingUpdate(in *aws.RollingUpdate, out *RollingUpdate, s conversion.Scope) error {
+	return autoConvert_aws_RollingUpdate_To_v1alpha1_RollingUpdate(in, out, s)
 }
 
-func autoConvert_v1alpha1_EC2_To_aws_EC2(in *EC2, out *aws.EC2, s conversion.Scope) error {
-	out.KeyName = in.KeyName
+func autoConvert_v1alpha1_Route53Resolver_To_aws_Route53Resolver(in *Route53Resolver, out *aws.Route53Resolver, s conversion.Scope) error {
+	out.InboundEndpoint = (*aws.Route53ResolverInboundEndpoint)(unsafe.Pointer(in.InboundEndpoint))
+	out.ForwardingRules = *(*[]aws.Route53ResolverForwardingRule)(unsafe.Pointer(&in.ForwardingRules))
+	out.RuleIDs = *(*[]string)(unsafe.Pointer(&in.RuleIDs))
 	return nil
 }
 
-// Convert_v1alpha1_EC2_To_aws_EC2 is an autogenerated conversion function.
-func Convert_v1alpha1_EC2_To_aws_EC2(in *EC2, out *aws.EC2, s conversion.Scope) error {
-	return autoConvert_v1alpha1_EC2_To_aws_EC2(in, out, s)
+// Convert_v1alpha1_Route53Resolver_To_aws_Route53Resolver is an autogenerated conversion function.
+func Convert_v1alpha1_Route53Resolver_To_aws_Route53Resolver(in *Route53Resolver, out *aws.Route53Resolver, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Route53Resolver_To_aws_Route53Resolver(in, out, s)
 }
 
-func autoConvert_aws_EC2_To_v1alpha1_EC2(in *aws.EC2, out *EC2, s conversion.Scope) error {
-	out.KeyName = in.KeyName
+func autoConvert_aws_Route53Resolver_To_v1alpha1_Route53Resolver(in *aws.Route53Resolver, out *Route53Resolver, s conversion.Scope) error {
+	out.InboundEndpoint = (*Route53ResolverInboundEndpoint)(unsafe.Pointer(in.InboundEndpoint))
+	out.ForwardingRules = *(*[]Route53ResolverForwardingRule)(unsafe.Pointer(&in.ForwardingRules))
+	out.RuleIDs = *(*[]string)(unsafe.Pointer(&in.RuleIDs))
 	return nil
 }
 
-// Convert_aws_EC2_To_v1alpha1_EC2 is an autogenerated conversion function.
-func Convert_aws_EC2_To_v1alpha1_EC2(in *aws.EC2, out *EC2, s conversion.Scope) error {
-	return autoConvert_aws_EC2_To_v1alpha1_EC2(in, out, s)
+// Convert_aws_Route53Resolver_To_v1alpha1_Route53Resolver is an autogenerated conversion function.
+func Convert_aws_Route53Resolver_To_v1alpha1_Route53Resolver(in *aws.Route53Resolver, out *Route53Resolver, s conversion.Scope) error {
+	return autoConvert_aws_Route53Resolver_To_v1alpha1_Route53Resolver(in, out, s)
 }
 
-func autoConvert_v1alpha1_IAM_To_aws_IAM(in *IAM, out *aws.IAM, s conversion.Scope) error {
-	out.InstanceProfiles = *(*[]aws.InstanceProfile)(unsafe.Pointer(&in.InstanceProfiles))
-	out.Roles = *(*[]aws.Role)(unsafe.Pointer(&in.Roles))
+func autoConvert_v1alpha1_Route53ResolverForwardingRule_To_aws_Route53ResolverForwardingRule(in *Route53ResolverForwardingRule, out *aws.Route53ResolverForwardingRule, s conversion.Scope) error {
+	out.DomainName = in.DomainName
+	out.TargetIPs = *(*[]string)(unsafe.Pointer(&in.TargetIPs))
 	return nil
 }
 
-// Convert_v1alpha1_IAM_To_aws_IAM is an autogenerated conversion function.
-func Convert_v1alpha1_IAM_To_aws_IAM(in *IAM, out *aws.IAM, s conversion.Scope) error {
-	return autoConvert_v1alpha1_IAM_To_aws_IAM(in, out, s)
+// Convert_v1alpha1_Route53ResolverForwardingRule_To_aws_Route53ResolverForwardingRule is an autogenerated conversion function.
+func Convert_v1alpha1_Route53ResolverForwardingRule_To_aws_Route53ResolverForwardingRule(in *Route53ResolverForwardingRule, out *aws.Route53ResolverForwardingRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Route53ResolverForwardingRule_To_aws_Route53ResolverForwardingRule(in, out, s)
 }
 
-func autoConvert_aws_IAM_To_v1alpha1_IAM(in *aws.IAM, out *IAM, s conversion.Scope) error {
-	out.InstanceProfiles = *(*[]InstanceProfile)(unsafe.Pointer(&in.InstanceProfiles))
-	out.Roles = *(*[]Role)(unsafe.Pointer(&in.Roles))
+func autoConvert_aws_Route53ResolverForwardingRule_To_v1alpha1_Route53ResolverForwardingRule(in *aws.Route53ResolverForwardingRule, out *Route53ResolverForwardingRule, s conversion.Scope) error {
+	out.DomainName = in.DomainName
+	out.TargetIPs = *(*[]string)(unsafe.Pointer(&in.TargetIPs))
 	return nil
 }
 
-// Convert_aws_IAM_To_v1alpha1_IAM is an autogenerated conversion function.
-func Convert_aws_IAM_To_v1alpha1_IAM(in *aws.IAM, out *IAM, s conversion.Scope) error {
-	return autoConvert_aws_IAM_To_v1alpha1_IAM(in, out, s)
+// Convert_aws_Route53ResolverForwardingRule_To_v1alpha1_Route53ResolverForwardingRule is an autogenerated conversion function.
+func Convert_aws_Route53ResolverForwardingRule_To_v1alpha1_Route53ResolverForwardingRule(in *aws.Route53ResolverForwardingRule, out *Route53ResolverForwardingRule, s conversion.Scope) error {
+	return autoConvert_aws_Route53ResolverForwardingRule_To_v1alpha1_Route53ResolverForwardingRule(in, out, s)
 }
 
-func autoConvert_v1alpha1_IAMInstanceProfile_To_aws_IAMInstanceProfile(in *IAMInstanceProfile, out *aws.IAMInstanceProfile, s conversion.Scope) error {
-	out.Name = (*string)(unsafe.Pointer(in.Name))
-	out.ARN = (*string)(unsafe.Pointer(in.ARN))
+func autoConvert_v1alpha1_Route53ResolverInboundEndpoint_To_aws_Route53ResolverInboundEndpoint(in *Route53ResolverInboundEndpoint, out *aws.Route53ResolverInboundEndpoint, s conversion.Scope) error {
+	out.AllowedCIDRs = *(*[]string)(unsafe.Pointer(&in.AllowedCIDRs))
 	return nil
 }
 
-// Convert_v1alpha1_IAMInstanceProfile_To_aws_IAMInstanceProfile is an autogenerated conversion function.
-func Convert_v1alpha1_IAMInstanceProfile_To_aws_IAMInstanceProfile(in *IAMInstanceProfile, out *aws.IAMInstanceProfile, s conversion.Scope) error {
-	return autoConvert_v1alpha1_IAMInstanceProfile_To_aws_IAMInstanceProfile(in, out, s)
+// Convert_v1alpha1_Route53ResolverInboundEndpoint_To_aws_Route53ResolverInboundEndpoint is an autogenerated conversion function.
+func Convert_v1alpha1_Route53ResolverInboundEndpoint_To_aws_Route53ResolverInboundEndpoint(in *Route53ResolverInboundEndpoint, out *aws.Route53ResolverInboundEndpoint, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Route53ResolverInboundEndpoint_To_aws_Route53ResolverInboundEndpoint(in, out, s)
 }
 
-func autoConvert_aws_IAMInstanceProfile_To_v1alpha1_IAMInstanceProfile(in *aws.IAMInstanceProfile, out *IAMInstanceProfile, s conversion.Scope) error {
-	out.Name = (*string)(unsafe.Pointer(in.Name))
-	out.ARN = (*string)(unsafe.Pointer(in.ARN))
+func autoConvert_aws_Route53ResolverInboundEndpoint_To_v1alpha1_Route53ResolverInboundEndpoint(in *aws.Route53ResolverInboundEndpoint, out *Route53ResolverInboundEndpoint, s conversion.Scope) error {
+	out.AllowedCIDRs = *(*[]string)(unsafe.Pointer(&in.AllowedCIDRs))
 	return nil
 }
 
-// Convert_aws_IAMInstanceProfile_To_v1alpha1_IAMInstanceProfile is an autogenerated conversion function.
-func Convert_aws_IAMInstanceProfile_To_v1alpha1_IAMInstanceProfile(in *aws.IAMInstanceProfile, out *IAMInstanceProfile, s conversion.Scope) error {
-	return autoConvert_aws_IAMInstanceProfile_To_v1alpha1_IAMInstanceProfile(in, out, s)
+// Convert_aws_Route53ResolverInboundEndpoint_To_v1alpha1_Route53ResolverInboundEndpoint is an autogenerated conversion function.
+func Convert_aws_Route53ResolverInboundEndpoint_To_v1alpha1_Route53ResolverInboundEndpoint(in *aws.Route53ResolverInboundEndpoint, out *Route53ResolverInboundEndpoint, s conversion.Scope) error {
+	return autoConvert_aws_Route53ResolverInboundEndpoint_To_v1alpha1_Route53ResolverInboundEndpoint(in, out, s)
 }
 
-func autoConvert_v1alpha1_IgnoreTags_To_aws_IgnoreTags(in *IgnoreTags, out *aws.IgnoreTags, s conversion.Scope) error {
-	out.Keys = *(*[]string)(unsafe.Pointer(&in.Keys))
-	out.KeyPrefixes = *(*[]string)(unsafe.Pointer(&in.KeyPrefixes))
+func autoConvert_v1alpha1_Route53ResolverStatus_To_aws_Route53ResolverStatus(in *Route53ResolverStatus, out *aws.Route53ResolverStatus, s conversion.Scope) error {
+	out.InboundEndpointID = (*string)(unsafe.Pointer(in.InboundEndpointID))
+	out.InboundEndpointIPs = *(*[]string)(unsafe.Pointer(&in.InboundEndpointIPs))
+	out.OutboundEndpointID = (*string)(unsafe.Pointer(in.OutboundEndpointID))
 	return nil
 }
 
-// Convert_v1alpha1_IgnoreTags_To_aws_IgnoreTags is an autogenerated conversion function.
-func Convert_v1alpha1_IgnoreTags_To_aws_IgnoreTags(in *IgnoreTags, out *aws.IgnoreTags, s conversion.Scope) error {
-	return autoConvert_v1alpha1_IgnoreTags_To_aws_IgnoreTags(in, out, s)
+// Convert_v1alpha1_Route53ResolverStatus_To_aws_Route53ResolverStatus is an autogenerated conversion function.
+func Convert_v1alpha1_Route53ResolverStatus_To_aws_Route53ResolverStatus(in *Route53ResolverStatus, out *aws.Route53ResolverStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Route53ResolverStatus_To_aws_Route53ResolverStatus(in, out, s)
 }
 
-func autoConvert_aws_IgnoreTags_To_v1alpha1_IgnoreTags(in *aws.IgnoreTags, out *IgnoreTags, s conversion.Scope) error {
-	out.Keys = *(*[]string)(unsafe.Pointer(&in.Keys))
-	out.KeyPrefixes = *(*[]string)(unsafe.Pointer(&in.KeyPrefixes))
+func autoConvert_aws_Route53ResolverStatus_To_v1alpha1_Route53ResolverStatus(in *aws.Route53ResolverStatus, out *Route53ResolverStatus, s conversion.Scope) error {
+	out.InboundEndpointID = (*string)(unsafe.Pointer(in.InboundEndpointID))
+	out.InboundEndpointIPs = *(*[]string)(unsafe.Pointer(&in.InboundEndpointIPs))
+	out.OutboundEndpointID = (*string)(unsafe.Pointer(in.OutboundEndpointID))
 	return nil
 }
 
-// Convert_aws_IgnoreTags_To_v1alpha1_IgnoreTags is an autogenerated conversion function.
-func Convert_aws_IgnoreTags_To_v1alpha1_IgnoreTags(in *aws.IgnoreTags, out *IgnoreTags, s conversion.Scope) error {
-	return autoConvert_aws_IgnoreTags_To_v1alpha1_IgnoreTags(in, out, s)
+// Convert_aws_Route53ResolverStatus_To_v1alpha1_Route53ResolverStatus is an autogenerated conversion function.
+func Convert_aws_Route53ResolverStatus_To_v1alpha1_Route53ResolverStatus(in *aws.Route53ResolverStatus, out *Route53ResolverStatus, s conversion.Scope) error {
+	return autoConvert_aws_Route53ResolverStatus_To_v1alpha1_Route53ResolverStatus(in, out, s)
 }
 
-func autoConvert_v1alpha1_InfrastructureConfig_To_aws_InfrastructureConfig(in *InfrastructureConfig, out *aws.InfrastructureConfig, s conversion.Scope) error {
-	out.EnableECRAccess = (*bool)(unsafe.Pointer(in.EnableECRAccess))
-	out.DualStack = (*aws.DualStack)(unsafe.Pointer(in.DualStack))
-	if err := Convert_v1alpha1_Networks_To_aws_Networks(&in.Networks, &out.Networks, s); err != nil {
-		return err
-	}
-	out.IgnoreTags = (*aws.IgnoreTags)(unsafe.Pointer(in.IgnoreTags))
+func autoConvert_v1alpha1_RouteTableStatus_To_aws_RouteTableStatus(in *RouteTableStatus, out *aws.RouteTableStatus, s conversion.Scope) error {
+	out.Purpose = in.Purpose
+	out.ID = in.ID
+	out.Zone = (*string)(unsafe.Pointer(in.Zone))
 	return nil
 }
 
-// Convert_v1alpha1_InfrastructureConfig_To_aws_InfrastructureConfig is an autogenerated conversion function.
-func Convert_v1alpha1_InfrastructureConfig_To_aws_InfrastructureConfig(in *InfrastructureConfig, out *aws.InfrastructureConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_InfrastructureConfig_To_aws_InfrastructureConfig(in, out, s)
+// Convert_v1alpha1_RouteTableStatus_To_aws_RouteTableStatus is an autogenerated conversion function.
+func Convert_v1alpha1_RouteTableStatus_To_aws_RouteTableStatus(in *RouteTableStatus, out *aws.RouteTableStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RouteTableStatus_To_aws_RouteTableStatus(in, out, s)
 }
 
-func autoConvert_aws_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in *aws.InfrastructureConfig, out *InfrastructureConfig, s conversion.Scope) error {
-	out.EnableECRAccess = (*bool)(unsafe.Pointer(in.EnableECRAccess))
-	out.DualStack = (*DualStack)(unsafe.Pointer(in.DualStack))
-	if err := Convert_aws_Networks_To_v1alpha1_Networks(&in.Networks, &out.Networks, s); err != nil {
-		return err
-	}
-	out.IgnoreTags = (*IgnoreTags)(unsafe.Pointer(in.IgnoreTags))
+func autoConvert_aws_RouteTableStatus_To_v1alpha1_RouteTableStatus(in *aws.RouteTableStatus, out *RouteTableStatus, s conversion.Scope) error {
+	out.Purpose = in.Purpose
+	out.ID = in.ID
+	out.Zone = (*string)(unsafe.Pointer(in.Zone))
+	return nil
+}
+
+// Convert_aws_RouteTableStatus_To_v1alpha1_RouteTableStatus is an autogenerated conversion function.
+func Convert_aws_RouteTableStatus_To_v1alpha1_RouteTableStatus(in *aws.RouteTableStatus, out *RouteTableStatus, s conversion.Scope) error {
+	return autoConvert_aws_RouteTableStatus_To_v1alpha1_RouteTableStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_RoutingPolicy_To_aws_RoutingPolicy(in *RoutingPolicy, out *aws.RoutingPolicy, s conversion.Scope) error {
+	out.SetIdentifier = in.SetIdentifier
+	out.Weighted = (*aws.WeightedRoutingPolicy)(unsafe.Pointer(in.Weighted))
+	out.Latency = (*aws.LatencyRoutingPolicy)(unsafe.Pointer(in.Latency))
+	out.Failover = (*aws.FailoverRoutingPolicy)(unsafe.Pointer(in.Failover))
+	out.Geolocation = (*aws.GeolocationRoutingPolicy)(unsafe.Pointer(in.Geolocation))
+	return nil
+}
+
+// Convert_v1alpha1_RoutingPolicy_To_aws_RoutingPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_RoutingPolicy_To_aws_RoutingPolicy(in *RoutingPolicy, out *aws.RoutingPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RoutingPolicy_To_aws_RoutingPolicy(in, out, s)
+}
+
+func autoConvert_aws_RoutingPolicy_To_v1alpha1_RoutingPolicy(in *aws.RoutingPolicy, out *RoutingPolicy, s conversion.Scope) error {
+	out.SetIdentifier = in.SetIdentifier
+	out.Weighted = (*WeightedRoutingPolicy)(unsafe.Pointer(in.Weighted))
+	out.Latency = (*LatencyRoutingPolicy)(unsafe.Pointer(in.Latency))
+	out.Failover = (*FailoverRoutingPolicy)(unsafe.Pointer(in.Failover))
+	out.Geolocation = (*GeolocationRoutingPolicy)(unsafe.Pointer(in.Geolocation))
+	return nil
+}
+
+// Convert_aws_RoutingPolicy_To_v1alpha1_RoutingPolicy is an autogenerated conversion function.
+func Convert_aws_RoutingPolicy_To_v1alpha1_RoutingPolicy(in *aws.RoutingPolicy, out *RoutingPolicy, s conversion.Scope) error {
+	return autoConvert_aws_RoutingPolicy_To_v1alpha1_RoutingPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_SSMParameter_To_aws_SSMParameter(in *SSMParameter, out *aws.SSMParameter, s conversion.Scope) error {
+	out.Path = in.Path
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	return nil
+}
+
+// Convert_v1alpha1_SSMParameter_To_aws_SSMParameter is an autogenerated conversion function.
+func Convert_v1alpha1_SSMParameter_To_aws_SSMParameter(in *SSMParameter, out *aws.SSMParameter, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SSMParameter_To_aws_SSMParameter(in, out, s)
+}
+
+func autoConvert_aws_SSMParameter_To_v1alpha1_SSMParameter(in *aws.SSMParameter, out *SSMParameter, s conversion.Scope) error {
+	out.Path = in.Path
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	return nil
+}
+
+// Convert_aws_SSMParameter_To_v1alpha1_SSMParameter is an autogenerated conversion function.
+func Convert_aws_SSMParameter_To_v1alpha1_SSMParameter(in *aws.SSMParameter, out *SSMParameter, s conversion.Scope) error {
+	return autoConvert_aws_SSMParameter_To_v1alpha1_SSMParameter(in, out, s)
+}
+
+func autoConvert_v1alpha1_SecurityGroup_To_aws_SecurityGroup(in *SecurityGroup, out *aws.SecurityGroup, s conversion.Scope) error {
+	out.Purpose = in.Purpose
+	out.ID = in.ID
+	return nil
+}
+
+// Convert_v1alpha1_SecurityGroup_To_aws_SecurityGroup is an autogenerated conversion function.
+func Convert_v1alpha1_SecurityGroup_To_aws_SecurityGroup(in *SecurityGroup, out *aws.SecurityGroup, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecurityGroup_To_aws_SecurityGroup(in, out, s)
+}
+
+func autoConvert_aws_SecurityGroup_To_v1alpha1_SecurityGroup(in *aws.SecurityGroup, out *SecurityGroup, s conversion.Scope) error {
+	out.Purpose = in.Purpose
+	out.ID = in.ID
+	return nil
+}
+
+// Convert_aws_SecurityGroup_To_v1alpha1_SecurityGroup is an autogenerated conversion function.
+func Convert_aws_SecurityGroup_To_v1alpha1_SecurityGroup(in *aws.SecurityGroup, out *SecurityGroup, s conversion.Scope) error {
+	return autoConvert_aws_SecurityGroup_To_v1alpha1_SecurityGroup(in, out, s)
+}
+
+func autoConvert_v1alpha1_SerialConsole_To_aws_SerialConsole(in *SerialConsole, out *aws.SerialConsole, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha1_SerialConsole_To_aws_SerialConsole is an autogenerated conversion function.
+func Convert_v1alpha1_SerialConsole_To_aws_SerialConsole(in *SerialConsole, out *aws.SerialConsole, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SerialConsole_To_aws_SerialConsole(in, out, s)
+}
+
+func autoConvert_aws_SerialConsole_To_v1alpha1_SerialConsole(in *aws.SerialConsole, out *SerialConsole, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_aws_SerialConsole_To_v1alpha1_SerialConsole is an autogenerated conversion function.
+func Convert_aws_SerialConsole_To_v1alpha1_SerialConsole(in *aws.SerialConsole, out *SerialConsole, s conversion.Scope) error {
+	return autoConvert_aws_SerialConsole_To_v1alpha1_SerialConsole(in, out, s)
+}
+
+func autoConvert_v1alpha1_SnapshotControllerConfig_To_aws_SnapshotControllerConfig(in *SnapshotControllerConfig, out *aws.SnapshotControllerConfig, s conversion.Scope) error {
+	out.WorkerThreads = (*int32)(unsafe.Pointer(in.WorkerThreads))
+	return nil
+}
+
+// Convert_v1alpha1_SnapshotControllerConfig_To_aws_SnapshotControllerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_SnapshotControllerConfig_To_aws_SnapshotControllerConfig(in *SnapshotControllerConfig, out *aws.SnapshotControllerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SnapshotControllerConfig_To_aws_SnapshotControllerConfig(in, out, s)
+}
+
+func autoConvert_aws_SnapshotControllerConfig_To_v1alpha1_SnapshotControllerConfig(in *aws.SnapshotControllerConfig, out *SnapshotControllerConfig, s conversion.Scope) error {
+	out.WorkerThreads = (*int32)(unsafe.Pointer(in.WorkerThreads))
+	return nil
+}
+
+// Convert_aws_SnapshotControllerConfig_To_v1alpha1_SnapshotControllerConfig is an autogenerated conversion function.
+func Convert_aws_SnapshotControllerConfig_To_v1alpha1_SnapshotControllerConfig(in *aws.SnapshotControllerConfig, out *SnapshotControllerConfig, s conversion.Scope) error {
+	return autoConvert_aws_SnapshotControllerConfig_To_v1alpha1_SnapshotControllerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_SourceImage_To_aws_SourceImage(in *SourceImage, out *aws.SourceImage, s conversion.Scope) error {
+	out.Region = in.Region
+	out.AMI = in.AMI
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
+	return nil
+}
+
+// Convert_v1alpha1_SourceImage_To_aws_SourceImage is an autogenerated conversion function.
+func Convert_v1alpha1_SourceImage_To_aws_SourceImage(in *SourceImage, out *aws.SourceImage, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SourceImage_To_aws_SourceImage(in, out, s)
+}
+
+func autoConvert_aws_SourceImage_To_v1alpha1_SourceImage(in *aws.SourceImage, out *SourceImage, s conversion.Scope) error {
+	out.Region = in.Region
+	out.AMI = in.AMI
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
+	return nil
+}
+
+// Convert_aws_SourceImage_To_v1alpha1_SourceImage is an autogenerated conversion function.
+func Convert_aws_SourceImage_To_v1alpha1_SourceImage(in *aws.SourceImage, out *SourceImage, s conversion.Scope) error {
+	return autoConvert_aws_SourceImage_To_v1alpha1_SourceImage(in, out, s)
+}
+
+func autoConvert_v1alpha1_SpotInterruptionHandling_To_aws_SpotInterruptionHandling(in *SpotInterruptionHandling, out *aws.SpotInterruptionHandling, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.DrainOnRebalanceRecommendation = in.DrainOnRebalanceRecommendation
+	return nil
+}
+
+// Convert_v1alpha1_SpotInterruptionHandling_To_aws_SpotInterruptionHandling is an autogenerated conversion function.
+func Convert_v1alpha1_SpotInterruptionHandling_To_aws_SpotInterruptionHandling(in *SpotInterruptionHandling, out *aws.SpotInterruptionHandling, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SpotInterruptionHandling_To_aws_SpotInterruptionHandling(in, out, s)
+}
+
+func autoConvert_aws_SpotInterruptionHandling_To_v1alpha1_SpotInterruptionHandling(in *aws.SpotInterruptionHandling, out *SpotInterruptionHandling, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.DrainOnRebalanceRecommendation = in.DrainOnRebalanceRecommendation
+	return nil
+}
+
+// Convert_aws_SpotInterruptionHandling_To_v1alpha1_SpotInterruptionHandling is an autogenerated conversion function.
+func Convert_aws_SpotInterruptionHandling_To_v1alpha1_SpotInterruptionHandling(in *aws.SpotInterruptionHandling, out *SpotInterruptionHandling, s conversion.Scope) error {
+	return autoConvert_aws_SpotInterruptionHandling_To_v1alpha1_SpotInterruptionHandling(in, out, s)
+}
+
+func autoConvert_v1alpha1_Storage_To_aws_Storage(in *Storage, out *aws.Storage, s conversion.Scope) error {
+	out.ManagedDefaultClass = (*bool)(unsafe.Pointer(in.ManagedDefaultClass))
+	out.EFS = (*aws.EFSConfig)(unsafe.Pointer(in.EFS))
+	out.DefaultClass = (*aws.StorageClassParameters)(unsafe.Pointer(in.DefaultClass))
+	out.AdditionalClasses = *(*[]aws.StorageClass)(unsafe.Pointer(&in.AdditionalClasses))
+	out.EBS = (*aws.EBSConfig)(unsafe.Pointer(in.EBS))
+	return nil
+}
+
+// Convert_v1alpha1_Storage_To_aws_Storage is an autogenerated conversion function.
+func Convert_v1alpha1_Storage_To_aws_Storage(in *Storage, out *aws.Storage, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Storage_To_aws_Storage(in, out, s)
+}
+
+func autoConvert_aws_Storage_To_v1alpha1_Storage(in *aws.Storage, out *Storage, s conversion.Scope) error {
+	out.ManagedDefaultClass = (*bool)(unsafe.Pointer(in.ManagedDefaultClass))
+	out.EFS = (*EFSConfig)(unsafe.Pointer(in.EFS))
+	out.DefaultClass = (*StorageClassParameters)(unsafe.Pointer(in.DefaultClass))
+	out.AdditionalClasses = *(*[]StorageClass)(unsafe.Pointer(&in.AdditionalClasses))
+	out.EBS = (*EBSConfig)(unsafe.Pointer(in.EBS))
 	return nil
 }
 
-// Convert_aws_InfrastructureConfig_To_v1alpha1_InfrastructureConfig is an autogenerated conversion function.
-func Convert_aws_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in *aws.InfrastructureConfig, out *InfrastructureConfig, s conversion.Scope) error {
-	return autoConvert_aws_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in, out, s)
+// Convert_aws_Storage_To_v1alpha1_Storage is an autogenerated conversion function.
+func Convert_aws_Storage_To_v1alpha1_Storage(in *aws.Storage, out *Storage, s conversion.Scope) error {
+	return autoConvert_aws_Storage_To_v1alpha1_Storage(in, out, s)
 }
 
-func autoConvert_v1alpha1_InfrastructureStatus_To_aws_InfrastructureStatus(in *InfrastructureStatus, out *aws.InfrastructureStatus, s conversion.Scope) error {
-	if err := Convert_v1alpha1_EC2_To_aws_EC2(&in.EC2, &out.EC2, s); err != nil {
-		return err
-	}
-	if err := Convert_v1alpha1_IAM_To_aws_IAM(&in.IAM, &out.IAM, s); err != nil {
-		return err
-	}
-	if err := Convert_v1alpha1_VPCStatus_To_aws_VPCStatus(&in.VPC, &out.VPC, s); err != nil {
+func autoConvert_v1alpha1_StorageClass_To_aws_StorageClass(in *StorageClass, out *aws.StorageClass, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1alpha1_StorageClassParameters_To_aws_StorageClassParameters(&in.Parameters, &out.Parameters, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1alpha1_InfrastructureStatus_To_aws_InfrastructureStatus is an autogenerated conversion function.
-func Convert_v1alpha1_InfrastructureStatus_To_aws_InfrastructureStatus(in *InfrastructureStatus, out *aws.InfrastructureStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha1_InfrastructureStatus_To_aws_InfrastructureStatus(in, out, s)
+// Convert_v1alpha1_StorageClass_To_aws_StorageClass is an autogenerated conversion function.
+func Convert_v1alpha1_StorageClass_To_aws_StorageClass(in *StorageClass, out *aws.StorageClass, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageClass_To_aws_StorageClass(in, out, s)
 }
 
-func autoConvert_aws_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *aws.InfrastructureStatus, out *InfrastructureStatus, s conversion.Scope) error {
-	if err := Convert_aws_EC2_To_v1alpha1_EC2(&in.EC2, &out.EC2, s); err != nil {
-		return err
-	}
-	if err := Convert_aws_IAM_To_v1alpha1_IAM(&in.IAM, &out.IAM, s); err != nil {
-		return err
-	}
-	if err := Convert_aws_VPCStatus_To_v1alpha1_VPCStatus(&in.VPC, &out.VPC, s); err != nil {
+func autoConvert_aws_StorageClass_To_v1alpha1_StorageClass(in *aws.StorageClass, out *StorageClass, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_aws_StorageClassParameters_To_v1alpha1_StorageClassParameters(&in.Parameters, &out.Parameters, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_aws_InfrastructureStatus_To_v1alpha1_InfrastructureStatus is an autogenerated conversion function.
-func Convert_aws_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *aws.InfrastructureStatus, out *InfrastructureStatus, s conversion.Scope) error {
-	return autoConvert_aws_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in, out, s)
+// Convert_aws_StorageClass_To_v1alpha1_StorageClass is an autogenerated conversion function.
+func Convert_aws_StorageClass_To_v1alpha1_StorageClass(in *aws.StorageClass, out *StorageClass, s conversion.Scope) error {
+	return autoConvert_aws_StorageClass_To_v1alpha1_StorageClass(in, out, s)
 }
 
-func autoConvert_v1alpha1_InstanceMetadataOptions_To_aws_InstanceMetadataOptions(in *InstanceMetadataOptions, out *aws.InstanceMetadataOptions, s conversion.Scope) error {
-	out.HTTPTokens = (*aws.HTTPTokensValue)(unsafe.Pointer(in.HTTPTokens))
-	out.HTTPPutResponseHopLimit = (*int64)(unsafe.Pointer(in.HTTPPutResponseHopLimit))
+func autoConvert_v1alpha1_StorageClassParameters_To_aws_StorageClassParameters(in *StorageClassParameters, out *aws.StorageClassParameters, s conversion.Scope) error {
+	out.Type = (*string)(unsafe.Pointer(in.Type))
+	out.IOPS = (*int64)(unsafe.Pointer(in.IOPS))
+	out.Throughput = (*int64)(unsafe.Pointer(in.Throughput))
+	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
 	return nil
 }
 
-// Convert_v1alpha1_InstanceMetadataOptions_To_aws_InstanceMetadataOptions is an autogenerated conversion function.
-func Convert_v1alpha1_InstanceMetadataOptions_To_aws_InstanceMetadataOptions(in *InstanceMetadataOptions, out *aws.InstanceMetadataOptions, s conversion.Scope) error {
-	return autoConvert_v1alpha1_InstanceMetadataOptions_To_aws_InstanceMetadataOptions(in, out, s)
+// Convert_v1alpha1_StorageClassParameters_To_aws_StorageClassParameters is an autogenerated conversion function.
+func Convert_v1alpha1_StorageClassParameters_To_aws_StorageClassParameters(in *StorageClassParameters, out *aws.StorageClassParameters, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageClassParameters_To_aws_StorageClassParameters(in, out, s)
 }
 
-func autoConvert_aws_InstanceMetadataOptions_To_v1alpha1_InstanceMetadataOptions(in *aws.InstanceMetadataOptions, out *InstanceMetadataOptions, s conversion.Scope) error {
-	out.HTTPTokens = (*HTTPTokensValue)(unsafe.Pointer(in.HTTPTokens))
-	out.HTTPPutResponseHopLimit = (*int64)(unsafe.Pointer(in.HTTPPutResponseHopLimit))
+func autoConvert_aws_StorageClassParameters_To_v1alpha1_StorageClassParameters(in *aws.StorageClassParameters, out *StorageClassParameters, s conversion.Scope) error {
+	out.Type = (*string)(unsafe.Pointer(in.Type))
+	out.IOPS = (*int64)(unsafe.Pointer(in.IOPS))
+	out.Throughput = (*int64)(unsafe.Pointer(in.Throughput))
+	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
 	return nil
 }
 
-// Convert_aws_InstanceMetadataOptions_To_v1alpha1_InstanceMetadataOptions is an autogenerated conversion function.
-func Convert_aws_InstanceMetadataOptions_To_v1alpha1_InstanceMetadataOptions(in *aws.InstanceMetadataOptions, out *InstanceMetadataOptions, s conversion.Scope) error {
-	return autoConvert_aws_InstanceMetadataOptions_To_v1alpha1_InstanceMetadataOptions(in, out, s)
+// Convert_aws_StorageClassParameters_To_v1alpha1_StorageClassParameters is an autogenerated conversion function.
+func Convert_aws_StorageClassParameters_To_v1alpha1_StorageClassParameters(in *aws.StorageClassParameters, out *StorageClassParameters, s conversion.Scope) error {
+	return autoConvert_aws_StorageClassParameters_To_v1alpha1_StorageClassParameters(in, out, s)
 }
 
-func autoConvert_v1alpha1_InstanceProfile_To_aws_InstanceProfile(in *InstanceProfile, out *aws.InstanceProfile, s conversion.Scope) error {
+func autoConvert_v1alpha1_Subnet_To_aws_Subnet(in *Subnet, out *aws.Subnet, s conversion.Scope) error {
 	out.Purpose = in.Purpose
-	out.Name = in.Name
+	out.ID = in.ID
+	out.Zone = in.Zone
+	out.IPv6CIDR = (*string)(unsafe.Pointer(in.IPv6CIDR))
 	return nil
 }
 
-// Convert_v1alpha1_InstanceProfile_To_aws_InstanceProfile is an autogenerated conversion function.
-func Convert_v1alpha1_InstanceProfile_To_aws_InstanceProfile(in *InstanceProfile, out *aws.InstanceProfile, s conversion.Scope) error {
-	return autoConvert_v1alpha1_InstanceProfile_To_aws_InstanceProfile(in, out, s)
+// Convert_v1alpha1_Subnet_To_aws_Subnet is an autogenerated conversion function.
+func Convert_v1alpha1_Subnet_To_aws_Subnet(in *Subnet, out *aws.Subnet, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Subnet_To_aws_Subnet(in, out, s)
 }
 
-func autoConvert_aws_InstanceProfile_To_v1alpha1_InstanceProfile(in *aws.InstanceProfile, out *InstanceProfile, s conversion.Scope) error {
+func autoConvert_aws_Subnet_To_v1alpha1_Subnet(in *aws.Subnet, out *Subnet, s conversion.Scope) error {
 	out.Purpose = in.Purpose
-	out.Name = in.Name
+	out.ID = in.ID
+	out.Zone = in.Zone
+	out.IPv6CIDR = (*string)(unsafe.Pointer(in.IPv6CIDR))
 	return nil
 }
 
-// Convert_aws_InstanceProfile_To_v1alpha1_InstanceProfile is an autogenerated conversion function.
-func Convert_aws_InstanceProfile_To_v1alpha1_InstanceProfile(in *aws.InstanceProfile, out *InstanceProfile, s conversion.Scope) error {
-	return autoConvert_aws_InstanceProfile_To_v1alpha1_InstanceProfile(in, out, s)
+// Convert_aws_Subnet_To_v1alpha1_Subnet is an autogenerated conversion function.
+func Convert_aws_Subnet_To_v1alpha1_Subnet(in *aws.Subnet, out *Subnet, s conversion.Scope) error {
+	return autoConvert_aws_Subnet_To_v1alpha1_Subnet(in, out, s)
 }
 
-func autoConvert_v1alpha1_LoadBalancerControllerConfig_To_aws_LoadBalancerControllerConfig(in *LoadBalancerControllerConfig, out *aws.LoadBalancerControllerConfig, s conversion.Scope) error {
-	out.Enabled = in.Enabled
-	out.IngressClassName = (*string)(unsafe.Pointer(in.IngressClassName))
+func autoConvert_v1alpha1_TransitGateway_To_aws_TransitGateway(in *TransitGateway, out *aws.TransitGateway, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Routes = *(*[]string)(unsafe.Pointer(&in.Routes))
 	return nil
 }
 
-// Convert_v1alpha1_LoadBalancerControllerConfig_To_aws_LoadBalancerControllerConfig is an autogenerated conversion function.
-func Convert_v1alpha1_LoadBalancerControllerConfig_To_aws_LoadBalancerControllerConfig(in *LoadBalancerControllerConfig, out *aws.LoadBalancerControllerConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_LoadBalancerControllerConfig_To_aws_LoadBalancerControllerConfig(in, out, s)
+// Convert_v1alpha1_TransitGateway_To_aws_TransitGateway is an autogenerated conversion function.
+func Convert_v1alpha1_TransitGateway_To_aws_TransitGateway(in *TransitGateway, out *aws.TransitGateway, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TransitGateway_To_aws_TransitGateway(in, out, s)
 }
 
-func autoConvert_aws_LoadBalancerControllerConfig_To_v1alpha1_LoadBalancerControllerConfig(in *aws.LoadBalancerControllerConfig, out *LoadBalancerControllerConfig, s conversion.Scope) error {
-	out.Enabled = in.Enabled
-	out.IngressClassName = (*string)(unsafe.Pointer(in.IngressClassName))
+func autoConvert_aws_TransitGateway_To_v1alpha1_TransitGateway(in *aws.TransitGateway, out *TransitGateway, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Routes = *(*[]string)(unsafe.Pointer(&in.Routes))
 	return nil
 }
 
-// Convert_aws_LoadBalancerControllerConfig_To_v1alpha1_LoadBalancerControllerConfig is an autogenerated conversion function.
-func Convert_aws_LoadBalancerControllerConfig_To_v1alpha1_LoadBalancerControllerConfig(in *aws.LoadBalancerControllerConfig, out *LoadBalancerControllerConfig, s conversion.Scope) error {
-	return autoConvert_aws_LoadBalancerControllerConfig_To_v1alpha1_LoadBalancerControllerConfig(in, out, s)
+// Convert_aws_TransitGateway_To_v1alpha1_TransitGateway is an autogenerated conversion function.
+func Convert_aws_TransitGateway_To_v1alpha1_TransitGateway(in *aws.TransitGateway, out *TransitGateway, s conversion.Scope) error {
+	return autoConvert_aws_TransitGateway_To_v1alpha1_TransitGateway(in, out, s)
 }
 
-func autoConvert_v1alpha1_MachineImage_To_aws_MachineImage(in *MachineImage, out *aws.MachineImage, s conversion.Scope) error {
-	out.Name = in.Name
-	out.Version = in.Version
-	out.AMI = in.AMI
-	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+func autoConvert_v1alpha1_UserData_To_aws_UserData(in *UserData, out *aws.UserData, s conversion.Scope) error {
+	out.PreBootstrap = *(*[]aws.UserDataPart)(unsafe.Pointer(&in.PreBootstrap))
+	out.PostBootstrap = *(*[]aws.UserDataPart)(unsafe.Pointer(&in.PostBootstrap))
 	return nil
 }
 
-// Convert_v1alpha1_MachineImage_To_aws_MachineImage is an autogenerated conversion function.
-func Convert_v1alpha1_MachineImage_To_aws_MachineImage(in *MachineImage, out *aws.MachineImage, s conversion.Scope) error {
-	return autoConvert_v1alpha1_MachineImage_To_aws_MachineImage(in, out, s)
+// Convert_v1alpha1_UserData_To_aws_UserData is an autogenerated conversion function.
+func Convert_v1alpha1_UserData_To_aws_UserData(in *UserData, out *aws.UserData, s conversion.Scope) error {
+	return autoConvert_v1alpha1_UserData_To_aws_UserData(in, out, s)
 }
 
-func autoConvert_aws_MachineImage_To_v1alpha1_MachineImage(in *aws.MachineImage, out *MachineImage, s conversion.Scope) error {
-	out.Name = in.Name
-	out.Version = in.Version
-	out.AMI = in.AMI
-	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+func autoConvert_aws_UserData_To_v1alpha1_UserData(in *aws.UserData, out *UserData, s conversion.Scope) error {
+	out.PreBootstrap = *(*[]UserDataPart)(unsafe.Pointer(&in.PreBootstrap))
+	out.PostBootstrap = *(*[]UserDataPart)(unsafe.Pointer(&in.PostBootstrap))
 	return nil
 }
 
-// Convert_aws_MachineImage_To_v1alpha1_MachineImage is an autogenerated conversion function.
-func Convert_aws_MachineImage_To_v1alpha1_MachineImage(in *aws.MachineImage, out *MachineImage, s conversion.Scope) error {
-	return autoConvert_aws_MachineImage_To_v1alpha1_MachineImage(in, out, s)
+// Convert_aws_UserData_To_v1alpha1_UserData is an autogenerated conversion function.
+func Convert_aws_UserData_To_v1alpha1_UserData(in *aws.UserData, out *UserData, s conversion.Scope) error {
+	return autoConvert_aws_UserData_To_v1alpha1_UserData(in, out, s)
 }
 
-func autoConvert_v1alpha1_MachineImageVersion_To_aws_MachineImageVersion(in *MachineImageVersion, out *aws.MachineImageVersion, s conversion.Scope) error {
-	out.Version = in.Version
-	out.Regions = *(*[]aws.RegionAMIMapping)(unsafe.Pointer(&in.Regions))
+func autoConvert_v1alpha1_UserDataPart_To_aws_UserDataPart(in *UserDataPart, out *aws.UserDataPart, s conversion.Scope) error {
+	out.ContentType = (*string)(unsafe.Pointer(in.ContentType))
+	out.Content = in.Content
+	out.Encoding = (*aws.UserDataEncoding)(unsafe.Pointer(in.Encoding))
 	return nil
 }
 
-// Convert_v1alpha1_MachineImageVersion_To_aws_MachineImageVersion is an autogenerated conversion function.
-func Convert_v1alpha1_MachineImageVersion_To_aws_MachineImageVersion(in *MachineImageVersion, out *aws.MachineImageVersion, s conversion.Scope) error {
-	return autoConvert_v1alpha1_MachineImageVersion_To_aws_MachineImageVersion(in, out, s)
+// Convert_v1alpha1_UserDataPart_To_aws_UserDataPart is an autogenerated conversion function.
+func Convert_v1alpha1_UserDataPart_To_aws_UserDataPart(in *UserDataPart, out *aws.UserDataPart, s conversion.Scope) error {
+	return autoConvert_v1alpha1_UserDataPart_To_aws_UserDataPart(in, out, s)
 }
 
-func autoConvert_aws_MachineImageVersion_To_v1alpha1_MachineImageVersion(in *aws.MachineImageVersion, out *MachineImageVersion, s conversion.Scope) error {
-	out.Version = in.Version
-	out.Regions = *(*[]RegionAMIMapping)(unsafe.Pointer(&in.Regions))
+func autoConvert_aws_UserDataPart_To_v1alpha1_UserDataPart(in *aws.UserDataPart, out *UserDataPart, s conversion.Scope) error {
+	out.ContentType = (*string)(unsafe.Pointer(in.ContentType))
+	out.Content = in.Content
+	out.Encoding = (*UserDataEncoding)(unsafe.Pointer(in.Encoding))
 	return nil
 }
 
-// Convert_aws_MachineImageVersion_To_v1alpha1_MachineImageVersion is an autogenerated conversion function.
-func Convert_aws_MachineImageVersion_To_v1alpha1_MachineImageVersion(in *aws.MachineImageVersion, out *MachineImageVersion, s conversion.Scope) error {
-	return autoConvert_aws_MachineImageVersion_To_v1alpha1_MachineImageVersion(in, out, s)
+// Convert_aws_UserDataPart_To_v1alpha1_UserDataPart is an autogenerated conversion function.
+func Convert_aws_UserDataPart_To_v1alpha1_UserDataPart(in *aws.UserDataPart, out *UserDataPart, s conversion.Scope) error {
+	return autoConvert_aws_UserDataPart_To_v1alpha1_UserDataPart(in, out, s)
 }
 
-func autoConvert_v1alpha1_MachineImages_To_aws_MachineImages(in *MachineImages, out *aws.MachineImages, s conversion.Scope) error {
-	out.Name = in.Name
-	out.Versions = *(*[]aws.MachineImageVersion)(unsafe.Pointer(&in.Versions))
+func autoConvert_v1alpha1_VPC_To_aws_VPC(in *VPC, out *aws.VPC, s conversion.Scope) error {
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	out.CIDR = (*string)(unsafe.Pointer(in.CIDR))
+	out.SecondaryCidrBlocks = *(*[]string)(unsafe.Pointer(&in.SecondaryCidrBlocks))
+	out.GatewayEndpoints = *(*[]string)(unsafe.Pointer(&in.GatewayEndpoints))
+	out.Endpoints = *(*[]aws.VPCEndpoint)(unsafe.Pointer(&in.Endpoints))
+	out.DHCPOptions = (*aws.DHCPOptions)(unsafe.Pointer(in.DHCPOptions))
+	out.InternetGatewayID = (*string)(unsafe.Pointer(in.InternetGatewayID))
+	out.MainRouteTableID = (*string)(unsafe.Pointer(in.MainRouteTableID))
+	out.Shared = in.Shared
 	return nil
 }
 
-// Convert_v1alpha1_MachineImages_To_aws_MachineImages is an autogenerated conversion function.
-func Convert_v1alpha1_MachineImages_To_aws_MachineImages(in *MachineImages, out *aws.MachineImages, s conversion.Scope) error {
-	return autoConvert_v1alpha1_MachineImages_To_aws_MachineImages(in, out, s)
+// Convert_v1alpha1_VPC_To_aws_VPC is an autogenerated conversion function.
+func Convert_v1alpha1_VPC_To_aws_VPC(in *VPC, out *aws.VPC, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VPC_To_aws_VPC(in, out, s)
 }
 
-func autoConvert_aws_MachineImages_To_v1alpha1_MachineImages(in *aws.MachineImages, out *MachineImages, s conversion.Scope) error {
-	out.Name = in.Name
-	out.Versions = *(*[]MachineImageVersion)(unsafe.Pointer(&in.Versions))
+func autoConvert_aws_VPC_To_v1alpha1_VPC(in *aws.VPC, out *VPC, s conversion.Scope) error {
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	out.CIDR = (*string)(unsafe.Pointer(in.CIDR))
+	out.SecondaryCidrBlocks = *(*[]string)(unsafe.Pointer(&in.SecondaryCidrBlocks))
+	out.GatewayEndpoints = *(*[]string)(unsafe.Pointer(&in.GatewayEndpoints))
+	out.Endpoints = *(*[]VPCEndpoint)(unsafe.Pointer(&in.Endpoints))
+	out.DHCPOptions = (*DHCPOptions)(unsafe.Pointer(in.DHCPOptions))
+	out.InternetGatewayID = (*string)(unsafe.Pointer(in.InternetGatewayID))
+	out.MainRouteTableID = (*string)(unsafe.Pointer(in.MainRouteTableID))
+	out.Shared = in.Shared
 	return nil
 }
 
-// Convert_aws_MachineImages_To_v1alpha1_MachineImages is an autogenerated conversion function.
-func Convert_aws_MachineImages_To_v1alpha1_MachineImages(in *aws.MachineImages, out *MachineImages, s conversion.Scope) error {
-	return autoConvert_aws_MachineImages_To_v1alpha1_MachineImages(in, out, s)
+// Convert_aws_VPC_To_v1alpha1_VPC is an autogenerated conversion function.
+func Convert_aws_VPC_To_v1alpha1_VPC(in *aws.VPC, out *VPC, s conversion.Scope) error {
+	return autoConvert_aws_VPC_To_v1alpha1_VPC(in, out, s)
 }
 
-func autoConvert_v1alpha1_Networks_To_aws_Networks(in *Networks, out *aws.Networks, s conversion.Scope) error {
-	if err := Convert_v1alpha1_VPC_To_aws_VPC(&in.VPC, &out.VPC, s); err != nil {
-		return err
-	}
-	out.Zones = *(*[]aws.Zone)(unsafe.Pointer(&in.Zones))
+func autoConvert_v1alpha1_VPCEndpoint_To_aws_VPCEndpoint(in *VPCEndpoint, out *aws.VPCEndpoint, s conversion.Scope) error {
+	out.Service = in.Service
+	out.Type = aws.VPCEndpointType(in.Type)
 	return nil
 }
 
-// Convert_v1alpha1_Networks_To_aws_Networks is an autogenerated conversion function.
-func Convert_v1alpha1_Networks_To_aws_Networks(in *Networks, out *aws.Networks, s conversion.Scope) error {
-	return autoConvert_v1alpha1_Networks_To_aws_Networks(in, out, s)
+// Convert_v1alpha1_VPCEndpoint_To_aws_VPCEndpoint is an autogenerated conversion function.
+func Convert_v1alpha1_VPCEndpoint_To_aws_VPCEndpoint(in *VPCEndpoint, out *aws.VPCEndpoint, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VPCEndpoint_To_aws_VPCEndpoint(in, out, s)
 }
 
-func autoConvert_aws_Networks_To_v1alpha1_Networks(in *aws.Networks, out *Networks, s conversion.Scope) error {
-	if err := Convert_aws_VPC_To_v1alpha1_VPC(&in.VPC, &out.VPC, s); err != nil {
-		return err
-	}
-	out.Zones = *(*[]Zone)(unsafe.Pointer(&in.Zones))
+func autoConvert_aws_VPCEndpoint_To_v1alpha1_VPCEndpoint(in *aws.VPCEndpoint, out *VPCEndpoint, s conversion.Scope) error {
+	out.Service = in.Service
+	out.Type = VPCEndpointType(in.Type)
 	return nil
 }
 
-// Convert_aws_Networks_To_v1alpha1_Networks is an autogenerated conversion function.
-func Convert_aws_Networks_To_v1alpha1_Networks(in *aws.Networks, out *Networks, s conversion.Scope) error {
-	return autoConvert_aws_Networks_To_v1alpha1_Networks(in, out, s)
+// Convert_aws_VPCEndpoint_To_v1alpha1_VPCEndpoint is an autogenerated conversion function.
+func Convert_aws_VPCEndpoint_To_v1alpha1_VPCEndpoint(in *aws.VPCEndpoint, out *VPCEndpoint, s conversion.Scope) error {
+	return autoConvert_aws_VPCEndpoint_To_v1alpha1_VPCEndpoint(in, out, s)
 }
 
-func autoConvert_v1alpha1_RegionAMIMapping_To_aws_RegionAMIMapping(in *RegionAMIMapping, out *aws.RegionAMIMapping, s conversion.Scope) error {
-	out.Name = in.Name
-	out.AMI = in.AMI
-	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+func autoConvert_v1alpha1_VPCEndpointStatus_To_aws_VPCEndpointStatus(in *VPCEndpointStatus, out *aws.VPCEndpointStatus, s conversion.Scope) error {
+	out.Service = in.Service
+	out.Type = aws.VPCEndpointType(in.Type)
+	out.ID = in.ID
+	out.PrefixListID = (*string)(unsafe.Pointer(in.PrefixListID))
 	return nil
 }
 
-// Convert_v1alpha1_RegionAMIMapping_To_aws_RegionAMIMapping is an autogenerated conversion function.
-func Convert_v1alpha1_RegionAMIMapping_To_aws_RegionAMIMapping(in *RegionAMIMapping, out *aws.RegionAMIMapping, s conversion.Scope) error {
-	return autoConvert_v1alpha1_RegionAMIMapping_To_aws_RegionAMIMapping(in, out, s)
+// Convert_v1alpha1_VPCEndpointStatus_To_aws_VPCEndpointStatus is an autogenerated conversion function.
+func Convert_v1alpha1_VPCEndpointStatus_To_aws_VPCEndpointStatus(in *VPCEndpointStatus, out *aws.VPCEndpointStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VPCEndpointStatus_To_aws_VPCEndpointStatus(in, out, s)
 }
 
-func autoConvert_aws_RegionAMIMapping_To_v1alpha1_RegionAMIMapping(in *aws.RegionAMIMapping, out *RegionAMIMapping, s conversion.Scope) error {
-	out.Name = in.Name
-	out.AMI = in.AMI
-	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+func autoConvert_aws_VPCEndpointStatus_To_v1alpha1_VPCEndpointStatus(in *aws.VPCEndpointStatus, out *VPCEndpointStatus, s conversion.Scope) error {
+	out.Service = in.Service
+	out.Type = VPCEndpointType(in.Type)
+	out.ID = in.ID
+	out.PrefixListID = (*string)(unsafe.Pointer(in.PrefixListID))
 	return nil
 }
 
-// Convert_aws_RegionAMIMapping_To_v1alpha1_RegionAMIMapping is an autogenerated conversion function.
-func Convert_aws_RegionAMIMapping_To_v1alpha1_RegionAMIMapping(in *aws.RegionAMIMapping, out *RegionAMIMapping, s conversion.Scope) error {
-	return autoConvert_aws_RegionAMIMapping_To_v1alpha1_RegionAMIMapping(in, out, s)
+// Convert_aws_VPCEndpointStatus_To_v1alpha1_VPCEndpointStatus is an autogenerated conversion function.
+func Convert_aws_VPCEndpointStatus_To_v1alpha1_VPCEndpointStatus(in *aws.VPCEndpointStatus, out *VPCEndpointStatus, s conversion.Scope) error {
+	return autoConvert_aws_VPCEndpointStatus_To_v1alpha1_VPCEndpointStatus(in, out, s)
 }
 
-func autoConvert_v1alpha1_Role_To_aws_Role(in *Role, out *aws.Role, s conversion.Scope) error {
-	out.Purpose = in.Purpose
-	out.ARN = in.ARN
+func autoConvert_v1alpha1_VPCFlowLogs_To_aws_VPCFlowLogs(in *VPCFlowLogs, out *aws.VPCFlowLogs, s conversion.Scope) error {
+	if err := Convert_v1alpha1_VPCFlowLogsDestination_To_aws_VPCFlowLogsDestination(&in.Destination, &out.Destination, s); err != nil {
+		return err
+	}
+	out.TrafficType = (*string)(unsafe.Pointer(in.TrafficType))
+	out.MaxAggregationInterval = (*int64)(unsafe.Pointer(in.MaxAggregationInterval))
 	return nil
 }
 
-// Convert_v1alpha1_Role_To_aws_Role is an autogenerated conversion function.
-func Convert_v1alpha1_Role_To_aws_Role(in *Role, out *aws.Role, s conversion.Scope) error {
-	return autoConvert_v1alpha1_Role_To_aws_Role(in, out, s)
+// Convert_v1alpha1_VPCFlowLogs_To_aws_VPCFlowLogs is an autogenerated conversion function.
+func Convert_v1alpha1_VPCFlowLogs_To_aws_VPCFlowLogs(in *VPCFlowLogs, out *aws.VPCFlowLogs, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VPCFlowLogs_To_aws_VPCFlowLogs(in, out, s)
 }
 
-func autoConvert_aws_Role_To_v1alpha1_Role(in *aws.Role, out *Role, s conversion.Scope) error {
-	out.Purpose = in.Purpose
-	out.ARN = in.ARN
+func autoConvert_aws_VPCFlowLogs_To_v1alpha1_VPCFlowLogs(in *aws.VPCFlowLogs, out *VPCFlowLogs, s conversion.Scope) error {
+	if err := Convert_aws_VPCFlowLogsDestination_To_v1alpha1_VPCFlowLogsDestination(&in.Destination, &out.Destination, s); err != nil {
+		return err
+	}
+	out.TrafficType = (*string)(unsafe.Pointer(in.TrafficType))
+	out.MaxAggregationInterval = (*int64)(unsafe.Pointer(in.MaxAggregationInterval))
 	return nil
 }
 
-// Convert_aws_Role_To_v1alpha1_Role is an autogenerated conversion function.
-func Convert_aws_Role_To_v1alpha1_Role(in *aws.Role, out *Role, s conversion.Scope) error {
-	return autoConvert_aws_Role_To_v1alpha1_Role(in, out, s)
+// Convert_aws_VPCFlowLogs_To_v1alpha1_VPCFlowLogs is an autogenerated conversion function.
+func Convert_aws_VPCFlowLogs_To_v1alpha1_VPCFlowLogs(in *aws.VPCFlowLogs, out *VPCFlowLogs, s conversion.Scope) error {
+	return autoConvert_aws_VPCFlowLogs_To_v1alpha1_VPCFlowLogs(in, out, s)
 }
 
-func autoConvert_v1alpha1_SecurityGroup_To_aws_SecurityGroup(in *SecurityGroup, out *aws.SecurityGroup, s conversion.Scope) error {
-	out.Purpose = in.Purpose
-	out.ID = in.ID
+func autoConvert_v1alpha1_VPCFlowLogsCloudWatchLogs_To_aws_VPCFlowLogsCloudWatchLogs(in *VPCFlowLogsCloudWatchLogs, out *aws.VPCFlowLogsCloudWatchLogs, s conversion.Scope) error {
+	out.KMSKeyARN = (*string)(unsafe.Pointer(in.KMSKeyARN))
 	return nil
 }
 
-// Convert_v1alpha1_SecurityGroup_To_aws_SecurityGroup is an autogenerated conversion function.
-func Convert_v1alpha1_SecurityGroup_To_aws_SecurityGroup(in *SecurityGroup, out *aws.SecurityGroup, s conversion.Scope) error {
-	return autoConvert_v1alpha1_SecurityGroup_To_aws_SecurityGroup(in, out, s)
+// Convert_v1alpha1_VPCFlowLogsCloudWatchLogs_To_aws_VPCFlowLogsCloudWatchLogs is an autogenerated conversion function.
+func Convert_v1alpha1_VPCFlowLogsCloudWatchLogs_To_aws_VPCFlowLogsCloudWatchLogs(in *VPCFlowLogsCloudWatchLogs, out *aws.VPCFlowLogsCloudWatchLogs, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VPCFlowLogsCloudWatchLogs_To_aws_VPCFlowLogsCloudWatchLogs(in, out, s)
 }
 
-func autoConvert_aws_SecurityGroup_To_v1alpha1_SecurityGroup(in *aws.SecurityGroup, out *SecurityGroup, s conversion.Scope) error {
-	out.Purpose = in.Purpose
-	out.ID = in.ID
+func autoConvert_aws_VPCFlowLogsCloudWatchLogs_To_v1alpha1_VPCFlowLogsCloudWatchLogs(in *aws.VPCFlowLogsCloudWatchLogs, out *VPCFlowLogsCloudWatchLogs, s conversion.Scope) error {
+	out.KMSKeyARN = (*string)(unsafe.Pointer(in.KMSKeyARN))
 	return nil
 }
 
-// Convert_aws_SecurityGroup_To_v1alpha1_SecurityGroup is an autogenerated conversion function.
-func Convert_aws_SecurityGroup_To_v1alpha1_SecurityGroup(in *aws.SecurityGroup, out *SecurityGroup, s conversion.Scope) error {
-	return autoConvert_aws_SecurityGroup_To_v1alpha1_SecurityGroup(in, out, s)
+// Convert_aws_VPCFlowLogsCloudWatchLogs_To_v1alpha1_VPCFlowLogsCloudWatchLogs is an autogenerated conversion function.
+func Convert_aws_VPCFlowLogsCloudWatchLogs_To_v1alpha1_VPCFlowLogsCloudWatchLogs(in *aws.VPCFlowLogsCloudWatchLogs, out *VPCFlowLogsCloudWatchLogs, s conversion.Scope) error {
+	return autoConvert_aws_VPCFlowLogsCloudWatchLogs_To_v1alpha1_VPCFlowLogsCloudWatchLogs(in, out, s)
 }
 
-func autoConvert_v1alpha1_Storage_To_aws_Storage(in *Storage, out *aws.Storage, s conversion.Scope) error {
-	out.ManagedDefaultClass = (*bool)(unsafe.Pointer(in.ManagedDefaultClass))
+func autoConvert_v1alpha1_VPCFlowLogsDestination_To_aws_VPCFlowLogsDestination(in *VPCFlowLogsDestination, out *aws.VPCFlowLogsDestination, s conversion.Scope) error {
+	out.CloudWatchLogs = (*aws.VPCFlowLogsCloudWatchLogs)(unsafe.Pointer(in.CloudWatchLogs))
+	out.S3 = (*aws.VPCFlowLogsS3)(unsafe.Pointer(in.S3))
 	return nil
 }
 
-// Convert_v1alpha1_Storage_To_aws_Storage is an autogenerated conversion function.
-func Convert_v1alpha1_Storage_To_aws_Storage(in *Storage, out *aws.Storage, s conversion.Scope) error {
-	return autoConvert_v1alpha1_Storage_To_aws_Storage(in, out, s)
+// Convert_v1alpha1_VPCFlowLogsDestination_To_aws_VPCFlowLogsDestination is an autogenerated conversion function.
+func Convert_v1alpha1_VPCFlowLogsDestination_To_aws_VPCFlowLogsDestination(in *VPCFlowLogsDestination, out *aws.VPCFlowLogsDestination, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VPCFlowLogsDestination_To_aws_VPCFlowLogsDestination(in, out, s)
 }
 
-func autoConvert_aws_Storage_To_v1alpha1_Storage(in *aws.Storage, out *Storage, s conversion.Scope) error {
-	out.ManagedDefaultClass = (*bool)(unsafe.Pointer(in.ManagedDefaultClass))
+func autoConvert_aws_VPCFlowLogsDestination_To_v1alpha1_VPCFlowLogsDestination(in *aws.VPCFlowLogsDestination, out *VPCFlowLogsDestination, s conversion.Scope) error {
+	out.CloudWatchLogs = (*VPCFlowLogsCloudWatchLogs)(unsafe.Pointer(in.CloudWatchLogs))
+	out.S3 = (*VPCFlowLogsS3)(unsafe.Pointer(in.S3))
 	return nil
 }
 
-// Convert_aws_Storage_To_v1alpha1_Storage is an autogenerated conversion function.
-func Convert_aws_Storage_To_v1alpha1_Storage(in *aws.Storage, out *Storage, s conversion.Scope) error {
-	return autoConvert_aws_Storage_To_v1alpha1_Storage(in, out, s)
+// Convert_aws_VPCFlowLogsDestination_To_v1alpha1_VPCFlowLogsDestination is an autogenerated conversion function.
+func Convert_aws_VPCFlowLogsDestination_To_v1alpha1_VPCFlowLogsDestination(in *aws.VPCFlowLogsDestination, out *VPCFlowLogsDestination, s conversion.Scope) error {
+	return autoConvert_aws_VPCFlowLogsDestination_To_v1alpha1_VPCFlowLogsDestination(in, out, s)
 }
 
-func autoConvert_v1alpha1_Subnet_To_aws_Subnet(in *Subnet, out *aws.Subnet, s conversion.Scope) error {
-	out.Purpose = in.Purpose
-	out.ID = in.ID
-	out.Zone = in.Zone
+func autoConvert_v1alpha1_VPCFlowLogsS3_To_aws_VPCFlowLogsS3(in *VPCFlowLogsS3, out *aws.VPCFlowLogsS3, s conversion.Scope) error {
+	out.BucketARN = in.BucketARN
 	return nil
 }
 
-// Convert_v1alpha1_Subnet_To_aws_Subnet is an autogenerated conversion function.
-func Convert_v1alpha1_Subnet_To_aws_Subnet(in *Subnet, out *aws.Subnet, s conversion.Scope) error {
-	return autoConvert_v1alpha1_Subnet_To_aws_Subnet(in, out, s)
+// Convert_v1alpha1_VPCFlowLogsS3_To_aws_VPCFlowLogsS3 is an autogenerated conversion function.
+func Convert_v1alpha1_VPCFlowLogsS3_To_aws_VPCFlowLogsS3(in *VPCFlowLogsS3, out *aws.VPCFlowLogsS3, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VPCFlowLogsS3_To_aws_VPCFlowLogsS3(in, out, s)
 }
 
-func autoConvert_aws_Subnet_To_v1alpha1_Subnet(in *aws.Subnet, out *Subnet, s conversion.Scope) error {
-	out.Purpose = in.Purpose
-	out.ID = in.ID
-	out.Zone = in.Zone
+func autoConvert_aws_VPCFlowLogsS3_To_v1alpha1_VPCFlowLogsS3(in *aws.VPCFlowLogsS3, out *VPCFlowLogsS3, s conversion.Scope) error {
+	out.BucketARN = in.BucketARN
 	return nil
 }
 
-// Convert_aws_Subnet_To_v1alpha1_Subnet is an autogenerated conversion function.
-func Convert_aws_Subnet_To_v1alpha1_Subnet(in *aws.Subnet, out *Subnet, s conversion.Scope) error {
-	return autoConvert_aws_Subnet_To_v1alpha1_Subnet(in, out, s)
+// Convert_aws_VPCFlowLogsS3_To_v1alpha1_VPCFlowLogsS3 is an autogenerated conversion function.
+func Convert_aws_VPCFlowLogsS3_To_v1alpha1_VPCFlowLogsS3(in *aws.VPCFlowLogsS3, out *VPCFlowLogsS3, s conversion.Scope) error {
+	return autoConvert_aws_VPCFlowLogsS3_To_v1alpha1_VPCFlowLogsS3(in, out, s)
 }
 
-func autoConvert_v1alpha1_VPC_To_aws_VPC(in *VPC, out *aws.VPC, s conversion.Scope) error {
-	out.ID = (*string)(unsafe.Pointer(in.ID))
-	out.CIDR = (*string)(unsafe.Pointer(in.CIDR))
-	out.GatewayEndpoints = *(*[]string)(unsafe.Pointer(&in.GatewayEndpoints))
+func autoConvert_v1alpha1_VPCPeering_To_aws_VPCPeering(in *VPCPeering, out *aws.VPCPeering, s conversion.Scope) error {
+	out.PeerVPCID = in.PeerVPCID
+	out.PeerAccountID = (*string)(unsafe.Pointer(in.PeerAccountID))
+	out.PeerRegion = (*string)(unsafe.Pointer(in.PeerRegion))
+	out.Routes = *(*[]string)(unsafe.Pointer(&in.Routes))
 	return nil
 }
 
-// Convert_v1alpha1_VPC_To_aws_VPC is an autogenerated conversion function.
-func Convert_v1alpha1_VPC_To_aws_VPC(in *VPC, out *aws.VPC, s conversion.Scope) error {
-	return autoConvert_v1alpha1_VPC_To_aws_VPC(in, out, s)
+// Convert_v1alpha1_VPCPeering_To_aws_VPCPeering is an autogenerated conversion function.
+func Convert_v1alpha1_VPCPeering_To_aws_VPCPeering(in *VPCPeering, out *aws.VPCPeering, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VPCPeering_To_aws_VPCPeering(in, out, s)
 }
 
-func autoConvert_aws_VPC_To_v1alpha1_VPC(in *aws.VPC, out *VPC, s conversion.Scope) error {
-	out.ID = (*string)(unsafe.Pointer(in.ID))
-	out.CIDR = (*string)(unsafe.Pointer(in.CIDR))
-	out.GatewayEndpoints = *(*[]string)(unsafe.Pointer(&in.GatewayEndpoints))
+func autoConvert_aws_VPCPeering_To_v1alpha1_VPCPeering(in *aws.VPCPeering, out *VPCPeering, s conversion.Scope) error {
+	out.PeerVPCID = in.PeerVPCID
+	out.PeerAccountID = (*string)(unsafe.Pointer(in.PeerAccountID))
+	out.PeerRegion = (*string)(unsafe.Pointer(in.PeerRegion))
+	out.Routes = *(*[]string)(unsafe.Pointer(&in.Routes))
 	return nil
 }
 
-// Convert_aws_VPC_To_v1alpha1_VPC is an autogenerated conversion function.
-func Convert_aws_VPC_To_v1alpha1_VPC(in *aws.VPC, out *VPC, s conversion.Scope) error {
-	return autoConvert_aws_VPC_To_v1alpha1_VPC(in, out, s)
+// Convert_aws_VPCPeering_To_v1alpha1_VPCPeering is an autogenerated conversion function.
+func Convert_aws_VPCPeering_To_v1alpha1_VPCPeering(in *aws.VPCPeering, out *VPCPeering, s conversion.Scope) error {
+	return autoConvert_aws_VPCPeering_To_v1alpha1_VPCPeering(in, out, s)
+}
+
+func autoConvert_v1alpha1_VPCPeeringStatus_To_aws_VPCPeeringStatus(in *VPCPeeringStatus, out *aws.VPCPeeringStatus, s conversion.Scope) error {
+	out.PeerVPCID = in.PeerVPCID
+	out.ID = in.ID
+	out.State = in.State
+	return nil
+}
+
+// Convert_v1alpha1_VPCPeeringStatus_To_aws_VPCPeeringStatus is an autogenerated conversion function.
+func Convert_v1alpha1_VPCPeeringStatus_To_aws_VPCPeeringStatus(in *VPCPeeringStatus, out *aws.VPCPeeringStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VPCPeeringStatus_To_aws_VPCPeeringStatus(in, out, s)
+}
+
+func autoConvert_aws_VPCPeeringStatus_To_v1alpha1_VPCPeeringStatus(in *aws.VPCPeeringStatus, out *VPCPeeringStatus, s conversion.Scope) error {
+	out.PeerVPCID = in.PeerVPCID
+	out.ID = in.ID
+	out.State = in.State
+	return nil
+}
+
+// Convert_aws_VPCPeeringStatus_To_v1alpha1_VPCPeeringStatus is an autogenerated conversion function.
+func Convert_aws_VPCPeeringStatus_To_v1alpha1_VPCPeeringStatus(in *aws.VPCPeeringStatus, out *VPCPeeringStatus, s conversion.Scope) error {
+	return autoConvert_aws_VPCPeeringStatus_To_v1alpha1_VPCPeeringStatus(in, out, s)
 }
 
 func autoConvert_v1alpha1_VPCStatus_To_aws_VPCStatus(in *VPCStatus, out *aws.VPCStatus, s conversion.Scope) error {
 	out.ID = in.ID
 	out.Subnets = *(*[]aws.Subnet)(unsafe.Pointer(&in.Subnets))
 	out.SecurityGroups = *(*[]aws.SecurityGroup)(unsafe.Pointer(&in.SecurityGroups))
+	out.TransitGatewayAttachmentID = (*string)(unsafe.Pointer(in.TransitGatewayAttachmentID))
+	out.IPv6CIDR = (*string)(unsafe.Pointer(in.IPv6CIDR))
+	out.Endpoints = *(*[]aws.VPCEndpointStatus)(unsafe.Pointer(&in.Endpoints))
+	out.Peerings = *(*[]aws.VPCPeeringStatus)(unsafe.Pointer(&in.Peerings))
+	out.Route53Resolver = (*aws.Route53ResolverStatus)(unsafe.Pointer(in.Route53Resolver))
+	out.InternetGatewayID = (*string)(unsafe.Pointer(in.InternetGatewayID))
+	out.EgressOnlyInternetGatewayID = (*string)(unsafe.Pointer(in.EgressOnlyInternetGatewayID))
+	out.NATGateways = *(*[]aws.NATGatewayStatus)(unsafe.Pointer(&in.NATGateways))
+	out.RouteTables = *(*[]aws.RouteTableStatus)(unsafe.Pointer(&in.RouteTables))
+	out.ElasticIPPool = *(*[]aws.ElasticIPPoolAddress)(unsafe.Pointer(&in.ElasticIPPool))
 	return nil
 }
 
@@ -910,6 +4039,16 @@ func autoConvert_aws_VPCStatus_To_v1alpha1_VPCStatus(in *aws.VPCStatus, out *VPC
 	out.ID = in.ID
 	out.Subnets = *(*[]Subnet)(unsafe.Pointer(&in.Subnets))
 	out.SecurityGroups = *(*[]SecurityGroup)(unsafe.Pointer(&in.SecurityGroups))
+	out.TransitGatewayAttachmentID = (*string)(unsafe.Pointer(in.TransitGatewayAttachmentID))
+	out.IPv6CIDR = (*string)(unsafe.Pointer(in.IPv6CIDR))
+	out.Endpoints = *(*[]VPCEndpointStatus)(unsafe.Pointer(&in.Endpoints))
+	out.Peerings = *(*[]VPCPeeringStatus)(unsafe.Pointer(&in.Peerings))
+	out.Route53Resolver = (*Route53ResolverStatus)(unsafe.Pointer(in.Route53Resolver))
+	out.InternetGatewayID = (*string)(unsafe.Pointer(in.InternetGatewayID))
+	out.EgressOnlyInternetGatewayID = (*string)(unsafe.Pointer(in.EgressOnlyInternetGatewayID))
+	out.NATGateways = *(*[]NATGatewayStatus)(unsafe.Pointer(&in.NATGateways))
+	out.RouteTables = *(*[]RouteTableStatus)(unsafe.Pointer(&in.RouteTables))
+	out.ElasticIPPool = *(*[]ElasticIPPoolAddress)(unsafe.Pointer(&in.ElasticIPPool))
 	return nil
 }
 
@@ -921,6 +4060,7 @@ func Convert_aws_VPCStatus_To_v1alpha1_VPCStatus(in *aws.VPCStatus, out *VPCStat
 func autoConvert_v1alpha1_Volume_To_aws_Volume(in *Volume, out *aws.Volume, s conversion.Scope) error {
 	out.IOPS = (*int64)(unsafe.Pointer(in.IOPS))
 	out.Throughput = (*int64)(unsafe.Pointer(in.Throughput))
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
 	return nil
 }
 
@@ -932,6 +4072,7 @@ func Convert_v1alpha1_Volume_To_aws_Volume(in *Volume, out *aws.Volume, s conver
 func autoConvert_aws_Volume_To_v1alpha1_Volume(in *aws.Volume, out *Volume, s conversion.Scope) error {
 	out.IOPS = (*int64)(unsafe.Pointer(in.IOPS))
 	out.Throughput = (*int64)(unsafe.Pointer(in.Throughput))
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
 	return nil
 }
 
@@ -940,12 +4081,55 @@ func Convert_aws_Volume_To_v1alpha1_Volume(in *aws.Volume, out *Volume, s conver
 	return autoConvert_aws_Volume_To_v1alpha1_Volume(in, out, s)
 }
 
+func autoConvert_v1alpha1_WeightedRoutingPolicy_To_aws_WeightedRoutingPolicy(in *WeightedRoutingPolicy, out *aws.WeightedRoutingPolicy, s conversion.Scope) error {
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_v1alpha1_WeightedRoutingPolicy_To_aws_WeightedRoutingPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_WeightedRoutingPolicy_To_aws_WeightedRoutingPolicy(in *WeightedRoutingPolicy, out *aws.WeightedRoutingPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WeightedRoutingPolicy_To_aws_WeightedRoutingPolicy(in, out, s)
+}
+
+func autoConvert_aws_WeightedRoutingPolicy_To_v1alpha1_WeightedRoutingPolicy(in *aws.WeightedRoutingPolicy, out *WeightedRoutingPolicy, s conversion.Scope) error {
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_aws_WeightedRoutingPolicy_To_v1alpha1_WeightedRoutingPolicy is an autogenerated conversion function.
+func Convert_aws_WeightedRoutingPolicy_To_v1alpha1_WeightedRoutingPolicy(in *aws.WeightedRoutingPolicy, out *WeightedRoutingPolicy, s conversion.Scope) error {
+	return autoConvert_aws_WeightedRoutingPolicy_To_v1alpha1_WeightedRoutingPolicy(in, out, s)
+}
+
 func autoConvert_v1alpha1_WorkerConfig_To_aws_WorkerConfig(in *WorkerConfig, out *aws.WorkerConfig, s conversion.Scope) error {
 	out.NodeTemplate = (*extensionsv1alpha1.NodeTemplate)(unsafe.Pointer(in.NodeTemplate))
 	out.Volume = (*aws.Volume)(unsafe.Pointer(in.Volume))
 	out.DataVolumes = *(*[]aws.DataVolume)(unsafe.Pointer(&in.DataVolumes))
 	out.IAMInstanceProfile = (*aws.IAMInstanceProfile)(unsafe.Pointer(in.IAMInstanceProfile))
 	out.InstanceMetadataOptions = (*aws.InstanceMetadataOptions)(unsafe.Pointer(in.InstanceMetadataOptions))
+	out.AdditionalSecurityGroupIDs = *(*[]string)(unsafe.Pointer(&in.AdditionalSecurityGroupIDs))
+	out.CapacityType = (*aws.CapacityType)(unsafe.Pointer(in.CapacityType))
+	out.SpotMaxPrice = (*string)(unsafe.Pointer(in.SpotMaxPrice))
+	out.SpotInterruptionHandling = (*aws.SpotInterruptionHandling)(unsafe.Pointer(in.SpotInterruptionHandling))
+	out.CapacityReservation = (*aws.CapacityReservation)(unsafe.Pointer(in.CapacityReservation))
+	out.Tenancy = (*aws.Tenancy)(unsafe.Pointer(in.Tenancy))
+	out.HostResourceGroupArn = (*string)(unsafe.Pointer(in.HostResourceGroupArn))
+	out.PlacementGroup = (*aws.PlacementGroup)(unsafe.Pointer(in.PlacementGroup))
+	out.NetworkInterfaces = (*aws.NetworkInterfaces)(unsafe.Pointer(in.NetworkInterfaces))
+	out.DevicePlugin = (*aws.DevicePlugin)(unsafe.Pointer(in.DevicePlugin))
+	out.InstanceStorage = (*aws.InstanceStorage)(unsafe.Pointer(in.InstanceStorage))
+	out.EnclaveOptions = (*aws.EnclaveOptions)(unsafe.Pointer(in.EnclaveOptions))
+	out.CPUOptions = (*aws.CPUOptions)(unsafe.Pointer(in.CPUOptions))
+	out.OutpostARN = (*string)(unsafe.Pointer(in.OutpostARN))
+	out.ImageSelector = (*aws.ImageSelector)(unsafe.Pointer(in.ImageSelector))
+	out.Monitoring = (*aws.Monitoring)(unsafe.Pointer(in.Monitoring))
+	out.SerialConsole = (*aws.SerialConsole)(unsafe.Pointer(in.SerialConsole))
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.UserData = (*aws.UserData)(unsafe.Pointer(in.UserData))
+	out.Containerd = (*aws.ContainerdConfig)(unsafe.Pointer(in.Containerd))
+	out.Kubelet = (*aws.KubeletConfig)(unsafe.Pointer(in.Kubelet))
+	out.RollingUpdate = (*aws.RollingUpdate)(unsafe.Pointer(in.RollingUpdate))
+	out.PreProvisionedCapacity = (*aws.PreProvisionedCapacity)(unsafe.Pointer(in.PreProvisionedCapacity))
 	return nil
 }
 
@@ -960,6 +4144,29 @@ func autoConvert_aws_WorkerConfig_To_v1alpha1_WorkerConfig(in *aws.WorkerConfig,
 	out.DataVolumes = *(*[]DataVolume)(unsafe.Pointer(&in.DataVolumes))
 	out.IAMInstanceProfile = (*IAMInstanceProfile)(unsafe.Pointer(in.IAMInstanceProfile))
 	out.InstanceMetadataOptions = (*InstanceMetadataOptions)(unsafe.Pointer(in.InstanceMetadataOptions))
+	out.AdditionalSecurityGroupIDs = *(*[]string)(unsafe.Pointer(&in.AdditionalSecurityGroupIDs))
+	out.CapacityType = (*CapacityType)(unsafe.Pointer(in.CapacityType))
+	out.SpotMaxPrice = (*string)(unsafe.Pointer(in.SpotMaxPrice))
+	out.SpotInterruptionHandling = (*SpotInterruptionHandling)(unsafe.Pointer(in.SpotInterruptionHandling))
+	out.CapacityReservation = (*CapacityReservation)(unsafe.Pointer(in.CapacityReservation))
+	out.Tenancy = (*Tenancy)(unsafe.Pointer(in.Tenancy))
+	out.HostResourceGroupArn = (*string)(unsafe.Pointer(in.HostResourceGroupArn))
+	out.PlacementGroup = (*PlacementGroup)(unsafe.Pointer(in.PlacementGroup))
+	out.NetworkInterfaces = (*NetworkInterfaces)(unsafe.Pointer(in.NetworkInterfaces))
+	out.DevicePlugin = (*DevicePlugin)(unsafe.Pointer(in.DevicePlugin))
+	out.InstanceStorage = (*InstanceStorage)(unsafe.Pointer(in.InstanceStorage))
+	out.EnclaveOptions = (*EnclaveOptions)(unsafe.Pointer(in.EnclaveOptions))
+	out.CPUOptions = (*CPUOptions)(unsafe.Pointer(in.CPUOptions))
+	out.OutpostARN = (*string)(unsafe.Pointer(in.OutpostARN))
+	out.ImageSelector = (*ImageSelector)(unsafe.Pointer(in.ImageSelector))
+	out.Monitoring = (*Monitoring)(unsafe.Pointer(in.Monitoring))
+	out.SerialConsole = (*SerialConsole)(unsafe.Pointer(in.SerialConsole))
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.UserData = (*UserData)(unsafe.Pointer(in.UserData))
+	out.Containerd = (*ContainerdConfig)(unsafe.Pointer(in.Containerd))
+	out.Kubelet = (*KubeletConfig)(unsafe.Pointer(in.Kubelet))
+	out.RollingUpdate = (*RollingUpdate)(unsafe.Pointer(in.RollingUpdate))
+	out.PreProvisionedCapacity = (*PreProvisionedCapacity)(unsafe.Pointer(in.PreProvisionedCapacity))
 	return nil
 }
 
@@ -970,6 +4177,7 @@ func Convert_aws_WorkerConfig_To_v1alpha1_WorkerConfig(in *aws.WorkerConfig, out
 
 func autoConvert_v1alpha1_WorkerStatus_To_aws_WorkerStatus(in *WorkerStatus, out *aws.WorkerStatus, s conversion.Scope) error {
 	out.MachineImages = *(*[]aws.MachineImage)(unsafe.Pointer(&in.MachineImages))
+	out.PlacementGroups = *(*[]string)(unsafe.Pointer(&in.PlacementGroups))
 	return nil
 }
 
@@ -980,6 +4188,7 @@ func Convert_v1alpha1_WorkerStatus_To_aws_WorkerStatus(in *WorkerStatus, out *aw
 
 func autoConvert_aws_WorkerStatus_To_v1alpha1_WorkerStatus(in *aws.WorkerStatus, out *WorkerStatus, s conversion.Scope) error {
 	out.MachineImages = *(*[]MachineImage)(unsafe.Pointer(&in.MachineImages))
+	out.PlacementGroups = *(*[]string)(unsafe.Pointer(&in.PlacementGroups))
 	return nil
 }
 
@@ -990,10 +4199,16 @@ func Convert_aws_WorkerStatus_To_v1alpha1_WorkerStatus(in *aws.WorkerStatus, out
 
 func autoConvert_v1alpha1_Zone_To_aws_Zone(in *Zone, out *aws.Zone, s conversion.Scope) error {
 	out.Name = in.Name
+	out.ZoneID = (*string)(unsafe.Pointer(in.ZoneID))
+	out.Type = (*aws.ZoneType)(unsafe.Pointer(in.Type))
 	out.Internal = in.Internal
 	out.Public = in.Public
 	out.Workers = in.Workers
 	out.ElasticIPAllocationID = (*string)(unsafe.Pointer(in.ElasticIPAllocationID))
+	out.Pods = (*string)(unsafe.Pointer(in.Pods))
+	out.SecondaryWorkers = (*string)(unsafe.Pointer(in.SecondaryWorkers))
+	out.OutpostARN = (*string)(unsafe.Pointer(in.OutpostARN))
+	out.RouteTableID = (*string)(unsafe.Pointer(in.RouteTableID))
 	return nil
 }
 
@@ -1004,10 +4219,16 @@ func Convert_v1alpha1_Zone_To_aws_Zone(in *Zone, out *aws.Zone, s conversion.Sco
 
 func autoConvert_aws_Zone_To_v1alpha1_Zone(in *aws.Zone, out *Zone, s conversion.Scope) error {
 	out.Name = in.Name
+	out.ZoneID = (*string)(unsafe.Pointer(in.ZoneID))
+	out.Type = (*ZoneType)(unsafe.Pointer(in.Type))
 	out.Internal = in.Internal
 	out.Public = in.Public
 	out.Workers = in.Workers
 	out.ElasticIPAllocationID = (*string)(unsafe.Pointer(in.ElasticIPAllocationID))
+	out.Pods = (*string)(unsafe.Pointer(in.Pods))
+	out.SecondaryWorkers = (*string)(unsafe.Pointer(in.SecondaryWorkers))
+	out.OutpostARN = (*string)(unsafe.Pointer(in.OutpostARN))
+	out.RouteTableID = (*string)(unsafe.Pointer(in.RouteTableID))
 	return nil
 }
 
@@ -44,6 +44,14 @@ func SetObjectDefaults_CloudProfileConfig(in *CloudProfileConfig) {
 				c := &b.Regions[k]
 				SetDefaults_RegionAMIMapping(c)
 			}
+			for k := range b.SSMParameters {
+				c := &b.SSMParameters[k]
+				SetDefaults_SSMParameter(c)
+			}
+			for k := range b.SourceImages {
+				c := &b.SourceImages[k]
+				SetDefaults_SourceImage(c)
+			}
 		}
 	}
 }
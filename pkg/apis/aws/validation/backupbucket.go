@@ -0,0 +1,154 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+)
+
+var (
+	validObjectLockModes = sets.New(string(apisaws.ObjectLockModeCompliance), string(apisaws.ObjectLockModeGovernance))
+	// only key ARNs are allowed for the bucket encryption, as the bucket policy compares the key of requests with it
+	kmsKeyARNPattern = regexp.MustCompile(`^arn:[\w-]+:kms:[a-z0-9-]+:\d{12}:key/[\w-]+$`)
+	// see https://docs.aws.amazon.com/AmazonS3/latest/userguide/lifecycle-transition-general-considerations.html
+	validTransitionStorageClasses  = sets.New("STANDARD_IA", "ONEZONE_IA", "INTELLIGENT_TIERING", "GLACIER_IR", "GLACIER", "DEEP_ARCHIVE")
+	infrequentAccessStorageClasses = sets.New("STANDARD_IA", "ONEZONE_IA")
+	// see https://docs.aws.amazon.com/AmazonS3/latest/userguide/bucketnamingrules.html
+	bucketNameRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+	// see https://docs.aws.amazon.com/AmazonS3/latest/userguide/amazon-s3-policy-keys.html#example-object-tls-version
+	validMinimumTLSVersions = sets.New("1.2", "1.3")
+)
+
+// ValidateBackupBucketConfig validates a BackupBucketConfig object.
+func ValidateBackupBucketConfig(config *apisaws.BackupBucketConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if objectLock := config.ObjectLock; objectLock != nil {
+		objectLockPath := fldPath.Child("objectLock")
+		if !validObjectLockModes.Has(string(objectLock.Mode)) {
+			allErrs = append(allErrs, field.NotSupported(objectLockPath.Child("mode"), objectLock.Mode, sets.List(validObjectLockModes)))
+		}
+		if objectLock.RetentionDays < 1 {
+			allErrs = append(allErrs, field.Invalid(objectLockPath.Child("retentionDays"), objectLock.RetentionDays, "must be at least 1"))
+		}
+	}
+
+	if config.Encryption != nil && !kmsKeyARNPattern.MatchString(config.Encryption.KMSKeyARN) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("encryption", "kmsKeyARN"), config.Encryption.KMSKeyARN, "must be a KMS key ARN, e.g. arn:aws:kms:eu-west-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"))
+	}
+
+	if config.Lifecycle != nil {
+		allErrs = append(allErrs, validateBucketLifecycle(config.Lifecycle, fldPath.Child("lifecycle"))...)
+	}
+
+	if replication := config.Replication; replication != nil {
+		replicationPath := fldPath.Child("replication")
+		if !bucketNameRegex.MatchString(replication.DestinationBucket) {
+			allErrs = append(allErrs, field.Invalid(replicationPath.Child("destinationBucket"), replication.DestinationBucket, "must be a valid bucket name"))
+		}
+		if len(replication.DestinationRegion) == 0 {
+			allErrs = append(allErrs, field.Required(replicationPath.Child("destinationRegion"), "must be set"))
+		}
+		if replication.KMSKeyARN == nil {
+			if config.Encryption != nil {
+				allErrs = append(allErrs, field.Required(replicationPath.Child("kmsKeyARN"), "must be set if the bucket is encrypted with a KMS key"))
+			}
+		} else if !kmsKeyARNPattern.MatchString(*replication.KMSKeyARN) {
+			allErrs = append(allErrs, field.Invalid(replicationPath.Child("kmsKeyARN"), *replication.KMSKeyARN, "must be a KMS key ARN"))
+		}
+	}
+
+	if accessLogging := config.AccessLogging; accessLogging != nil {
+		accessLoggingPath := fldPath.Child("accessLogging")
+		if !bucketNameRegex.MatchString(accessLogging.TargetBucket) {
+			allErrs = append(allErrs, field.Invalid(accessLoggingPath.Child("targetBucket"), accessLogging.TargetBucket, "must be a valid bucket name"))
+		}
+		if accessLogging.TargetPrefix != nil && strings.HasPrefix(*accessLogging.TargetPrefix, "/") {
+			allErrs = append(allErrs, field.Invalid(accessLoggingPath.Child("targetPrefix"), *accessLogging.TargetPrefix, "must not start with '/'"))
+		}
+	}
+
+	if hardening := config.Hardening; hardening != nil && hardening.MinimumTLSVersion != nil && !validMinimumTLSVersions.Has(*hardening.MinimumTLSVersion) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("hardening", "minimumTLSVersion"), *hardening.MinimumTLSVersion, sets.List(validMinimumTLSVersions)))
+	}
+
+	return allErrs
+}
+
+func validateBucketLifecycle(lifecycle *apisaws.BucketLifecycle, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var (
+		storageClasses = sets.New[string]()
+		maxDays        int32
+	)
+	for i, transition := range lifecycle.Transitions {
+		idxPath := fldPath.Child("transitions").Index(i)
+		if !validTransitionStorageClasses.Has(transition.StorageClass) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("storageClass"), transition.StorageClass, sets.List(validTransitionStorageClasses)))
+		} else if storageClasses.Has(transition.StorageClass) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("storageClass"), transition.StorageClass))
+		}
+		storageClasses.Insert(transition.StorageClass)
+
+		if transition.Days < 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("days"), transition.Days, "must not be negative"))
+		} else if infrequentAccessStorageClasses.Has(transition.StorageClass) && transition.Days < 30 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("days"), transition.Days, "must be at least 30 for infrequent access storage classes"))
+		}
+		maxDays = max(maxDays, transition.Days)
+	}
+
+	if lifecycle.ExpirationDays != nil && (*lifecycle.ExpirationDays < 1 || *lifecycle.ExpirationDays <= maxDays) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("expirationDays"), *lifecycle.ExpirationDays, "must be positive and greater than the days of all transitions"))
+	}
+	if lifecycle.AbortIncompleteMultipartUploadDays != nil && *lifecycle.AbortIncompleteMultipartUploadDays < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("abortIncompleteMultipartUploadDays"), *lifecycle.AbortIncompleteMultipartUploadDays, "must be at least 1"))
+	}
+
+	return allErrs
+}
+
+// ValidateBackupBucketConfigUpdate validates an update of a BackupBucketConfig object. Object Lock cannot be disabled
+// once it is enabled, and the retention of compliance mode must not be weakened.
+func ValidateBackupBucketConfigUpdate(oldConfig, newConfig *apisaws.BackupBucketConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	oldObjectLock, newObjectLock := oldConfig.ObjectLock, newConfig.ObjectLock
+	if oldObjectLock == nil {
+		return allErrs
+	}
+
+	objectLockPath := fldPath.Child("objectLock")
+	if newObjectLock == nil {
+		return append(allErrs, field.Forbidden(objectLockPath, "object lock cannot be disabled once it is enabled"))
+	}
+	if oldObjectLock.Mode == apisaws.ObjectLockModeCompliance {
+		if newObjectLock.Mode != apisaws.ObjectLockModeCompliance {
+			allErrs = append(allErrs, field.Forbidden(objectLockPath.Child("mode"), "compliance mode cannot be changed"))
+		}
+		if newObjectLock.RetentionDays < oldObjectLock.RetentionDays {
+			allErrs = append(allErrs, field.Forbidden(objectLockPath.Child("retentionDays"), "retention of compliance mode cannot be shortened"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,238 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/validation"
+)
+
+var _ = Describe("BackupBucketConfig validation", func() {
+	var fldPath = field.NewPath("providerConfig")
+
+	Describe("#ValidateBackupBucketConfig", func() {
+		It("should allow an empty config", func() {
+			Expect(ValidateBackupBucketConfig(&apisaws.BackupBucketConfig{}, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow a valid object lock config", func() {
+			config := &apisaws.BackupBucketConfig{
+				ObjectLock: &apisaws.ObjectLockConfig{Mode: apisaws.ObjectLockModeGovernance, RetentionDays: 7},
+			}
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow a valid encryption config", func() {
+			config := &apisaws.BackupBucketConfig{
+				Encryption: &apisaws.BucketEncryption{KMSKeyARN: "arn:aws:kms:eu-west-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"},
+			}
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow a valid lifecycle config", func() {
+			config := &apisaws.BackupBucketConfig{
+				Lifecycle: &apisaws.BucketLifecycle{
+					Transitions: []apisaws.LifecycleTransition{
+						{Days: 30, StorageClass: "STANDARD_IA"},
+						{Days: 90, StorageClass: "GLACIER_IR"},
+					},
+					ExpirationDays:                     pointer.Int32(365),
+					AbortIncompleteMultipartUploadDays: pointer.Int32(1),
+				},
+			}
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid lifecycle config", func() {
+			config := &apisaws.BackupBucketConfig{
+				Lifecycle: &apisaws.BucketLifecycle{
+					Transitions: []apisaws.LifecycleTransition{
+						{Days: 7, StorageClass: "STANDARD_IA"},
+						{Days: 90, StorageClass: "STANDARD_IA"},
+						{Days: -1, StorageClass: "REDUCED_REDUNDANCY"},
+					},
+					ExpirationDays:                     pointer.Int32(90),
+					AbortIncompleteMultipartUploadDays: pointer.Int32(0),
+				},
+			}
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.lifecycle.transitions[0].days"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("providerConfig.lifecycle.transitions[1].storageClass"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("providerConfig.lifecycle.transitions[2].storageClass"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.lifecycle.transitions[2].days"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.lifecycle.expirationDays"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.lifecycle.abortIncompleteMultipartUploadDays"),
+			}))))
+		})
+
+		It("should allow a valid replication config", func() {
+			config := &apisaws.BackupBucketConfig{
+				Encryption: &apisaws.BucketEncryption{KMSKeyARN: "arn:aws:kms:eu-west-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"},
+				Replication: &apisaws.BucketReplication{
+					DestinationBucket: "backup-replica",
+					DestinationRegion: "eu-central-1",
+					KMSKeyARN:         pointer.String("arn:aws:kms:eu-central-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+				},
+			}
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid replication config", func() {
+			config := &apisaws.BackupBucketConfig{
+				Encryption: &apisaws.BucketEncryption{KMSKeyARN: "arn:aws:kms:eu-west-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"},
+				Replication: &apisaws.BucketReplication{
+					DestinationBucket: "Backup_Replica",
+				},
+			}
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.replication.destinationBucket"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("providerConfig.replication.destinationRegion"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("providerConfig.replication.kmsKeyARN"),
+			}))))
+		})
+
+		It("should allow a valid access logging and hardening config", func() {
+			config := &apisaws.BackupBucketConfig{
+				AccessLogging: &apisaws.BucketAccessLogging{TargetBucket: "access-logs", TargetPrefix: pointer.String("backups/")},
+				Hardening:     &apisaws.BucketHardening{MinimumTLSVersion: pointer.String("1.3"), BucketOwnerEnforced: pointer.Bool(true)},
+			}
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid access logging and hardening config", func() {
+			config := &apisaws.BackupBucketConfig{
+				AccessLogging: &apisaws.BucketAccessLogging{TargetBucket: "", TargetPrefix: pointer.String("/backups")},
+				Hardening:     &apisaws.BucketHardening{MinimumTLSVersion: pointer.String("1.1")},
+			}
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.accessLogging.targetBucket"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.accessLogging.targetPrefix"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("providerConfig.hardening.minimumTLSVersion"),
+			}))))
+		})
+
+		DescribeTable("should forbid invalid KMS keys",
+			func(kmsKeyARN string) {
+				config := &apisaws.BackupBucketConfig{
+					Encryption: &apisaws.BucketEncryption{KMSKeyARN: kmsKeyARN},
+				}
+
+				Expect(ValidateBackupBucketConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.encryption.kmsKeyARN"),
+				}))))
+			},
+			Entry("empty", ""),
+			Entry("key id", "1234abcd-12ab-34cd-56ef-1234567890ab"),
+			Entry("alias ARN", "arn:aws:kms:eu-west-1:123456789012:alias/backup"),
+		)
+
+		It("should forbid an invalid object lock config", func() {
+			config := &apisaws.BackupBucketConfig{
+				ObjectLock: &apisaws.ObjectLockConfig{Mode: "LEGAL_HOLD"},
+			}
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("providerConfig.objectLock.mode"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.objectLock.retentionDays"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateBackupBucketConfigUpdate", func() {
+		var (
+			compliance = &apisaws.BackupBucketConfig{
+				ObjectLock: &apisaws.ObjectLockConfig{Mode: apisaws.ObjectLockModeCompliance, RetentionDays: 7},
+			}
+			governance = &apisaws.BackupBucketConfig{
+				ObjectLock: &apisaws.ObjectLockConfig{Mode: apisaws.ObjectLockModeGovernance, RetentionDays: 7},
+			}
+		)
+
+		It("should allow enabling object lock", func() {
+			Expect(ValidateBackupBucketConfigUpdate(&apisaws.BackupBucketConfig{}, compliance, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow changing the governance mode", func() {
+			Expect(ValidateBackupBucketConfigUpdate(governance, compliance, fldPath)).To(BeEmpty())
+			Expect(ValidateBackupBucketConfigUpdate(governance, &apisaws.BackupBucketConfig{
+				ObjectLock: &apisaws.ObjectLockConfig{Mode: apisaws.ObjectLockModeGovernance, RetentionDays: 1},
+			}, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow extending the retention of compliance mode", func() {
+			Expect(ValidateBackupBucketConfigUpdate(compliance, &apisaws.BackupBucketConfig{
+				ObjectLock: &apisaws.ObjectLockConfig{Mode: apisaws.ObjectLockModeCompliance, RetentionDays: 30},
+			}, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid disabling object lock", func() {
+			Expect(ValidateBackupBucketConfigUpdate(governance, &apisaws.BackupBucketConfig{}, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.objectLock"),
+			}))))
+		})
+
+		It("should forbid weakening compliance mode", func() {
+			Expect(ValidateBackupBucketConfigUpdate(compliance, &apisaws.BackupBucketConfig{
+				ObjectLock: &apisaws.ObjectLockConfig{Mode: apisaws.ObjectLockModeGovernance, RetentionDays: 1},
+			}, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.objectLock.mode"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.objectLock.retentionDays"),
+			}))))
+		})
+	})
+})
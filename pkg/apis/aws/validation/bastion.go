@@ -0,0 +1,35 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+)
+
+// ValidateBastionConfig validates a BastionConfig object.
+func ValidateBastionConfig(config *apisaws.BastionConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config.InstanceType != nil && len(*config.InstanceType) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("instanceType"), *config.InstanceType, "must not be empty"))
+	}
+	if config.AMI != nil && !amiRegex.MatchString(*config.AMI) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ami"), *config.AMI, "must be a valid AMI id, e.g. ami-0123456789abcdef0"))
+	}
+
+	return allErrs
+}
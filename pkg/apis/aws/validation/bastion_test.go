@@ -0,0 +1,59 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/validation"
+)
+
+var _ = Describe("BastionConfig validation", func() {
+	var fldPath = field.NewPath("providerConfig")
+
+	It("should allow an empty config", func() {
+		Expect(ValidateBastionConfig(&apisaws.BastionConfig{}, fldPath)).To(BeEmpty())
+	})
+
+	It("should allow a valid config", func() {
+		config := &apisaws.BastionConfig{
+			InstanceType:   pointer.String("t4g.nano"),
+			AMI:            pointer.String("ami-0123456789abcdef0"),
+			SessionManager: pointer.Bool(true),
+		}
+
+		Expect(ValidateBastionConfig(config, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid an empty instance type and invalid AMIs", func() {
+		config := &apisaws.BastionConfig{
+			InstanceType: pointer.String(""),
+			AMI:          pointer.String("image-123"),
+		}
+
+		Expect(ValidateBastionConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("providerConfig.instanceType"),
+		})), PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("providerConfig.ami"),
+		}))))
+	})
+})
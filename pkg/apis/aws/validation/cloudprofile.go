@@ -16,14 +16,25 @@ package validation
 
 import (
 	"fmt"
+	"regexp"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/strings/slices"
 
 	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 )
 
+// ssmParameterPathRegex matches absolute paths of SSM parameters, e.g. `/aws/service/canonical/ubuntu/server/22.04/stable/current/amd64/hvm/ebs-gp2/ami-id`.
+var ssmParameterPathRegex = regexp.MustCompile(`^(/[a-zA-Z0-9_.\-]+)+$`)
+
+// amiRegex matches IDs of AMIs.
+var amiRegex = regexp.MustCompile(`^ami-[0-9a-f]+$`)
+
+// supportedAcceleratorTypes are the accelerator types whose drivers can be announced for machine image versions.
+var supportedAcceleratorTypes = []string{string(apisaws.AcceleratorTypeNVIDIA), string(apisaws.AcceleratorTypeNeuron)}
+
 // ValidateCloudProfileConfig validates a CloudProfileConfig object.
 func ValidateCloudProfileConfig(cloudProfile *apisaws.CloudProfileConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -49,8 +60,8 @@ func ValidateCloudProfileConfig(cloudProfile *apisaws.CloudProfileConfig, fldPat
 				allErrs = append(allErrs, field.Required(jdxPath.Child("version"), "must provide a version"))
 			}
 
-			if len(version.Regions) == 0 {
-				allErrs = append(allErrs, field.Required(jdxPath.Child("regions"), fmt.Sprintf("must provide at least one region for machine image %q and version %q", machineImage.Name, version.Version)))
+			if len(version.Regions) == 0 && len(version.SSMParameters) == 0 && len(version.SourceImages) == 0 {
+				allErrs = append(allErrs, field.Required(jdxPath.Child("regions"), fmt.Sprintf("must provide at least one region, SSM parameter or source image for machine image %q and version %q", machineImage.Name, version.Version)))
 			}
 			for k, region := range version.Regions {
 				kdxPath := jdxPath.Child("regions").Index(k)
@@ -65,6 +76,54 @@ func ValidateCloudProfileConfig(cloudProfile *apisaws.CloudProfileConfig, fldPat
 					allErrs = append(allErrs, field.NotSupported(kdxPath.Child("architecture"), *region.Architecture, v1beta1constants.ValidArchitectures))
 				}
 			}
+
+			architectures := sets.New[string]()
+			for k, parameter := range version.SSMParameters {
+				kdxPath := jdxPath.Child("ssmParameters").Index(k)
+
+				if !ssmParameterPathRegex.MatchString(parameter.Path) {
+					allErrs = append(allErrs, field.Invalid(kdxPath.Child("path"), parameter.Path, "must be an absolute path of an SSM parameter"))
+				}
+				if !slices.Contains(v1beta1constants.ValidArchitectures, *parameter.Architecture) {
+					allErrs = append(allErrs, field.NotSupported(kdxPath.Child("architecture"), *parameter.Architecture, v1beta1constants.ValidArchitectures))
+				} else if architectures.Has(*parameter.Architecture) {
+					allErrs = append(allErrs, field.Duplicate(kdxPath.Child("architecture"), *parameter.Architecture))
+				}
+				architectures.Insert(*parameter.Architecture)
+			}
+
+			sourceImageArchitectures := sets.New[string]()
+			for k, sourceImage := range version.SourceImages {
+				kdxPath := jdxPath.Child("sourceImages").Index(k)
+
+				if len(sourceImage.Region) == 0 {
+					allErrs = append(allErrs, field.Required(kdxPath.Child("region"), "must provide a region"))
+				}
+				if !amiRegex.MatchString(sourceImage.AMI) {
+					allErrs = append(allErrs, field.Invalid(kdxPath.Child("ami"), sourceImage.AMI, "must be the ID of an AMI"))
+				}
+				if sourceImage.KMSKeyID != nil && len(*sourceImage.KMSKeyID) == 0 {
+					allErrs = append(allErrs, field.Invalid(kdxPath.Child("kmsKeyID"), *sourceImage.KMSKeyID, "must not be empty"))
+				}
+				if !slices.Contains(v1beta1constants.ValidArchitectures, *sourceImage.Architecture) {
+					allErrs = append(allErrs, field.NotSupported(kdxPath.Child("architecture"), *sourceImage.Architecture, v1beta1constants.ValidArchitectures))
+				} else if sourceImageArchitectures.Has(*sourceImage.Architecture) {
+					allErrs = append(allErrs, field.Duplicate(kdxPath.Child("architecture"), *sourceImage.Architecture))
+				}
+				sourceImageArchitectures.Insert(*sourceImage.Architecture)
+			}
+
+			acceleratorDrivers := sets.New[apisaws.AcceleratorType]()
+			for k, driver := range version.AcceleratorDrivers {
+				kdxPath := jdxPath.Child("acceleratorDrivers").Index(k)
+
+				if !slices.Contains(supportedAcceleratorTypes, string(driver)) {
+					allErrs = append(allErrs, field.NotSupported(kdxPath, driver, supportedAcceleratorTypes))
+				} else if acceleratorDrivers.Has(driver) {
+					allErrs = append(allErrs, field.Duplicate(kdxPath, driver))
+				}
+				acceleratorDrivers.Insert(driver)
+			}
 		}
 	}
 
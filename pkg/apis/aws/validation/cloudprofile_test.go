@@ -130,6 +130,90 @@ var _ = Describe("CloudProfileConfig validation", func() {
 					"Field": Equal("root.machineImages[0].versions[0].regions[0].architecture"),
 				}))))
 			})
+
+			It("should allow SSM parameters instead of regions", func() {
+				cloudProfileConfig.MachineImages[0].Versions[0].Regions = nil
+				cloudProfileConfig.MachineImages[0].Versions[0].SSMParameters = []apisaws.SSMParameter{
+					{Path: "/aws/service/canonical/ubuntu/server/22.04/stable/current/amd64/hvm/ebs-gp2/ami-id", Architecture: pointer.String("amd64")},
+					{Path: "/aws/service/canonical/ubuntu/server/22.04/stable/current/arm64/hvm/ebs-gp2/ami-id", Architecture: pointer.String("arm64")},
+				}
+
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, field.NewPath("root"))).To(BeEmpty())
+			})
+
+			It("should allow source images instead of regions", func() {
+				cloudProfileConfig.MachineImages[0].Versions[0].Regions = nil
+				cloudProfileConfig.MachineImages[0].Versions[0].SourceImages = []apisaws.SourceImage{
+					{Region: "eu-central-1", AMI: "ami-0123456789abcdef0", Architecture: pointer.String("amd64"), KMSKeyID: pointer.String("alias/images")},
+					{Region: "eu-central-1", AMI: "ami-0123456789abcdef1", Architecture: pointer.String("arm64")},
+				}
+
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, field.NewPath("root"))).To(BeEmpty())
+			})
+
+			It("should forbid invalid source images", func() {
+				cloudProfileConfig.MachineImages[0].Versions[0].SourceImages = []apisaws.SourceImage{
+					{AMI: "image", Architecture: pointer.String("amd64"), KMSKeyID: pointer.String("")},
+					{Region: "eu-central-1", AMI: "ami-0123", Architecture: pointer.String("amd64")},
+				}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, field.NewPath("root"))
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("root.machineImages[0].versions[0].sourceImages[0].region"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("root.machineImages[0].versions[0].sourceImages[0].ami"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("root.machineImages[0].versions[0].sourceImages[0].kmsKeyID"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("root.machineImages[0].versions[0].sourceImages[1].architecture"),
+				}))))
+			})
+
+			It("should allow supported accelerator drivers", func() {
+				cloudProfileConfig.MachineImages[0].Versions[0].AcceleratorDrivers = []apisaws.AcceleratorType{apisaws.AcceleratorTypeNVIDIA, apisaws.AcceleratorTypeNeuron}
+
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, field.NewPath("root"))).To(BeEmpty())
+			})
+
+			It("should forbid unsupported or duplicate accelerator drivers", func() {
+				cloudProfileConfig.MachineImages[0].Versions[0].AcceleratorDrivers = []apisaws.AcceleratorType{"amd", apisaws.AcceleratorTypeNVIDIA, apisaws.AcceleratorTypeNVIDIA}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, field.NewPath("root"))
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("root.machineImages[0].versions[0].acceleratorDrivers[0]"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("root.machineImages[0].versions[0].acceleratorDrivers[2]"),
+				}))))
+			})
+
+			It("should forbid invalid SSM parameters", func() {
+				cloudProfileConfig.MachineImages[0].Versions[0].SSMParameters = []apisaws.SSMParameter{
+					{Path: "aws/service/ami-id", Architecture: pointer.String("amd64")},
+					{Path: "/aws/service/ami-id", Architecture: pointer.String("amd64")},
+					{Path: "/aws/service/ami-id", Architecture: pointer.String("foo")},
+				}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, field.NewPath("root"))
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("root.machineImages[0].versions[0].ssmParameters[0].path"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("root.machineImages[0].versions[0].ssmParameters[1].architecture"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("root.machineImages[0].versions[0].ssmParameters[2].architecture"),
+				}))))
+			})
 		})
 	})
 })
@@ -15,10 +15,22 @@
 package validation
 
 import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/apis/core"
 	featurevalidation "github.com/gardener/gardener/pkg/utils/validation/features"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
 )
 
 // ValidateControlPlaneConfig validates a ControlPlaneConfig object.
@@ -27,6 +39,328 @@ func ValidateControlPlaneConfig(controlPlaneConfig *apisaws.ControlPlaneConfig,
 
 	if controlPlaneConfig.CloudControllerManager != nil {
 		allErrs = append(allErrs, featurevalidation.ValidateFeatureGates(controlPlaneConfig.CloudControllerManager.FeatureGates, version, fldPath.Child("cloudControllerManager", "featureGates"))...)
+		allErrs = append(allErrs, validateCloudControllerManagerConfig(controlPlaneConfig.CloudControllerManager, fldPath.Child("cloudControllerManager"))...)
+	}
+
+	if storage := controlPlaneConfig.Storage; storage != nil && storage.EFS != nil && storage.EFS.FileSystemID != nil && len(*storage.EFS.FileSystemID) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("storage", "efs", "fileSystemID"), *storage.EFS.FileSystemID, "must not be empty"))
+	}
+
+	if defaults := controlPlaneConfig.LoadBalancerDefaults; defaults != nil {
+		loadBalancerControllerEnabled := controlPlaneConfig.LoadBalancerController != nil && controlPlaneConfig.LoadBalancerController.Enabled
+		allErrs = append(allErrs, validateLoadBalancerDefaults(defaults, loadBalancerControllerEnabled, fldPath.Child("loadBalancerDefaults"))...)
+	}
+
+	if storage := controlPlaneConfig.Storage; storage != nil {
+		allErrs = append(allErrs, validateStorageClasses(storage, fldPath.Child("storage"))...)
+
+		if storage.EBS != nil {
+			allErrs = append(allErrs, validateEBSConfig(storage.EBS, fldPath.Child("storage", "ebs"))...)
+		}
+	}
+
+	if privateLink := controlPlaneConfig.PrivateLink; privateLink != nil {
+		allErrs = append(allErrs, validatePrivateLinkConfig(privateLink, fldPath.Child("privateLink"))...)
+	}
+
+	if cloudWatchAgent := controlPlaneConfig.CloudWatchAgent; cloudWatchAgent != nil {
+		allErrs = append(allErrs, validateCloudWatchAgentConfig(cloudWatchAgent, fldPath.Child("cloudWatchAgent"))...)
+	}
+
+	return allErrs
+}
+
+// logRetentionInDays are the retention periods supported by CloudWatch Logs, see
+// https://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_PutRetentionPolicy.html.
+var logRetentionInDays = sets.New[int32](1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1096, 1827, 2192, 2557, 2922, 3288, 3653)
+
+func validateCloudWatchAgentConfig(cloudWatchAgent *apisaws.CloudWatchAgentConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if interval := cloudWatchAgent.MetricsCollectionInterval; interval != nil && *interval < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("metricsCollectionInterval"), *interval, "must be at least 1"))
+	}
+
+	if logs := cloudWatchAgent.Logs; logs != nil && logs.RetentionInDays != nil && !logRetentionInDays.Has(*logs.RetentionInDays) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("logs", "retentionInDays"), *logs.RetentionInDays, fmt.Sprintf("must be one of %v", sets.List(logRetentionInDays))))
+	}
+
+	return allErrs
+}
+
+// principalARNRegex matches the ARNs of accounts, users and roles, see
+// https://docs.aws.amazon.com/vpc/latest/privatelink/configure-endpoint-service.html#add-remove-permissions.
+var principalARNRegex = regexp.MustCompile(`^arn:[\w-]+:iam::\d{12}:(root|user/.+|role/.+)$`)
+
+func validatePrivateLinkConfig(privateLink *apisaws.PrivateLinkConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	principals := sets.New[string]()
+	for i, principal := range privateLink.AllowedPrincipals {
+		idxPath := fldPath.Child("allowedPrincipals").Index(i)
+		if principal != "*" && !principalARNRegex.MatchString(principal) {
+			allErrs = append(allErrs, field.Invalid(idxPath, principal, "must be '*' or the ARN of an account, user or role"))
+		} else if principals.Has(principal) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, principal))
+		}
+		principals.Insert(principal)
+	}
+
+	return allErrs
+}
+
+func validateCloudControllerManagerConfig(ccm *apisaws.CloudControllerManagerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if ccm.ConcurrentServiceSyncs != nil && *ccm.ConcurrentServiceSyncs <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("concurrentServiceSyncs"), *ccm.ConcurrentServiceSyncs, "must be a positive value"))
+	}
+	if ccm.NodeStatusUpdateFrequency != nil && ccm.NodeStatusUpdateFrequency.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("nodeStatusUpdateFrequency"), ccm.NodeStatusUpdateFrequency.Duration.String(), "must be a positive duration"))
+	}
+
+	var (
+		supportedFamilies = []string{string(apisaws.IPFamilyIPv4), string(apisaws.IPFamilyIPv6)}
+		families          = sets.New[apisaws.IPFamily]()
+	)
+	for i, family := range ccm.NodeIPFamilies {
+		idxPath := fldPath.Child("nodeIPFamilies").Index(i)
+		switch {
+		case family != apisaws.IPFamilyIPv4 && family != apisaws.IPFamilyIPv6:
+			allErrs = append(allErrs, field.NotSupported(idxPath, family, supportedFamilies))
+		case families.Has(family):
+			allErrs = append(allErrs, field.Duplicate(idxPath, family))
+		}
+		families.Insert(family)
+	}
+
+	return allErrs
+}
+
+// loadBalancerTargetTypes are the target types of network load balancers supported by the aws-load-balancer-controller.
+var loadBalancerTargetTypes = sets.New("instance", "ip")
+
+// loadBalancerAccessLogsEmitIntervals are the intervals in minutes supported for publishing access logs of classic
+// load balancers.
+var loadBalancerAccessLogsEmitIntervals = sets.New("5", "60")
+
+var (
+	bucketNamePattern           = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+	sslNegotiationPolicyPattern = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
+)
+
+func validateLoadBalancerDefaults(defaults *apisaws.LoadBalancerDefaults, loadBalancerControllerEnabled bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if defaults.TargetType != nil {
+		if !loadBalancerTargetTypes.Has(*defaults.TargetType) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("targetType"), *defaults.TargetType, sets.List(loadBalancerTargetTypes)))
+		} else if !loadBalancerControllerEnabled {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("targetType"), "requires the aws-load-balancer-controller to be enabled"))
+		}
+	}
+
+	subnets := sets.New[string]()
+	for i, subnet := range defaults.Subnets {
+		idxPath := fldPath.Child("subnets").Index(i)
+		switch {
+		case len(subnet) == 0:
+			allErrs = append(allErrs, field.Required(idxPath, "must not be empty"))
+		case strings.ContainsAny(subnet, ", "):
+			allErrs = append(allErrs, field.Invalid(idxPath, subnet, "must be a subnet ID or name"))
+		case subnets.Has(subnet):
+			allErrs = append(allErrs, field.Duplicate(idxPath, subnet))
+		}
+		subnets.Insert(subnet)
+	}
+
+	if len(defaults.Attributes) > 0 && !loadBalancerControllerEnabled {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("attributes"), "requires the aws-load-balancer-controller to be enabled"))
+	}
+	for key, value := range defaults.Attributes {
+		if len(key) == 0 || strings.ContainsAny(key, ",=") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("attributes").Key(key), key, "must be a load balancer attribute key"))
+		}
+		if strings.Contains(value, ",") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("attributes").Key(key), value, "must not contain ','"))
+		}
+	}
+
+	if accessLogs := defaults.AccessLogs; accessLogs != nil {
+		accessLogsPath := fldPath.Child("accessLogs")
+		if accessLogs.BucketName != nil && !bucketNamePattern.MatchString(*accessLogs.BucketName) {
+			allErrs = append(allErrs, field.Invalid(accessLogsPath.Child("bucketName"), *accessLogs.BucketName, "must be a valid S3 bucket name"))
+		}
+		if prefix := accessLogs.Prefix; prefix != nil {
+			if strings.HasPrefix(*prefix, "/") || strings.HasSuffix(*prefix, "/") || strings.Contains(*prefix, "AWSLogs") {
+				allErrs = append(allErrs, field.Invalid(accessLogsPath.Child("prefix"), *prefix, "must neither start nor end with '/' and must not contain 'AWSLogs'"))
+			}
+		}
+		if interval := accessLogs.EmitInterval; interval != nil && !loadBalancerAccessLogsEmitIntervals.Has(strconv.Itoa(int(*interval))) {
+			allErrs = append(allErrs, field.NotSupported(accessLogsPath.Child("emitInterval"), *interval, sets.List(loadBalancerAccessLogsEmitIntervals)))
+		}
+	}
+
+	if policy := defaults.SSLNegotiationPolicy; policy != nil && !sslNegotiationPolicyPattern.MatchString(*policy) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("sslNegotiationPolicy"), *policy, "must be the name of a predefined security policy"))
+	}
+
+	return allErrs
+}
+
+// storageClassVolumeTypes are the EBS volume types which are supported by the EBS CSI driver.
+var storageClassVolumeTypes = sets.New("gp2", "gp3", "io1", "io2", "st1", "sc1", "standard")
+
+func validateStorageClasses(storage *apisaws.Storage, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if storage.DefaultClass != nil {
+		allErrs = append(allErrs, validateStorageClassParameters(storage.DefaultClass, fldPath.Child("defaultClass"))...)
+	}
+
+	// the 'default' and 'efs' StorageClasses are managed by Gardener already
+	names := sets.New("default", "efs")
+	for i, class := range storage.AdditionalClasses {
+		idxPath := fldPath.Child("additionalClasses").Index(i)
+
+		if names.Has(class.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), class.Name))
+		} else {
+			for _, msg := range validation.IsDNS1123Subdomain(class.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), class.Name, msg))
+			}
+		}
+		names.Insert(class.Name)
+
+		allErrs = append(allErrs, validateStorageClassParameters(&class.Parameters, idxPath.Child("parameters"))...)
+	}
+
+	return allErrs
+}
+
+func validateStorageClassParameters(parameters *apisaws.StorageClassParameters, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if parameters.Type != nil && !storageClassVolumeTypes.Has(*parameters.Type) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), *parameters.Type, sets.List(storageClassVolumeTypes)))
+	}
+	if parameters.IOPS != nil {
+		if *parameters.IOPS <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("iops"), *parameters.IOPS, "iops must be a positive value"))
+		}
+		if parameters.Type == nil || !sets.New("gp3", "io1", "io2").Has(*parameters.Type) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("iops"), "iops can only be provisioned for gp3, io1 and io2 volumes"))
+		}
+	}
+	if parameters.Throughput != nil {
+		if *parameters.Throughput <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("throughput"), *parameters.Throughput, "throughput must be a positive value"))
+		}
+		if parameters.Type == nil || *parameters.Type != string(apisaws.VolumeTypeGP3) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("throughput"), fmt.Sprintf("throughput can only be provisioned for %s volumes", apisaws.VolumeTypeGP3)))
+		}
+	}
+	if parameters.KMSKeyID != nil {
+		allErrs = append(allErrs, validateKMSKeyID(*parameters.KMSKeyID, parameters.Encrypted, fldPath.Child("kmsKeyID"))...)
+	}
+
+	return allErrs
+}
+
+// ebsResourceRequestsContainers are the containers of the EBS CSI controller whose resource requests can be configured.
+var ebsResourceRequestsContainers = sets.New("driver", "provisioner", "attacher", "snapshotter", "resizer", "livenessProbe", "volumeModifier")
+
+func validateEBSConfig(ebs *apisaws.EBSConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if ebs.ReservedVolumeAttachments != nil && *ebs.ReservedVolumeAttachments < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("reservedVolumeAttachments"), *ebs.ReservedVolumeAttachments, "must not be negative"))
+	}
+	if ebs.SnapshotController != nil && ebs.SnapshotController.WorkerThreads != nil && *ebs.SnapshotController.WorkerThreads <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("snapshotController", "workerThreads"), *ebs.SnapshotController.WorkerThreads, "must be a positive value"))
+	}
+
+	allErrs = append(allErrs, ValidateTags(fldPath.Child("extraTags"), ebs.ExtraTags)...)
+
+	for container, requests := range ebs.ResourceRequests {
+		containerPath := fldPath.Child("resourceRequests").Key(container)
+		if !ebsResourceRequestsContainers.Has(container) {
+			allErrs = append(allErrs, field.NotSupported(containerPath, container, sets.List(ebsResourceRequestsContainers)))
+			continue
+		}
+		for name, quantity := range requests {
+			if name != corev1.ResourceCPU && name != corev1.ResourceMemory {
+				allErrs = append(allErrs, field.NotSupported(containerPath.Key(string(name)), name, []string{string(corev1.ResourceCPU), string(corev1.ResourceMemory)}))
+			} else if quantity.Sign() < 0 {
+				allErrs = append(allErrs, field.Invalid(containerPath.Key(string(name)), quantity.String(), "must not be negative"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateControlPlaneConfigAgainstInfrastructureConfig validates a ControlPlaneConfig object against the
+// InfrastructureConfig of the same shoot.
+func ValidateControlPlaneConfigAgainstInfrastructureConfig(controlPlaneConfig *apisaws.ControlPlaneConfig, infraConfig *apisaws.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if storage := controlPlaneConfig.Storage; storage != nil && storage.EFS != nil && storage.EFS.Enabled && storage.EFS.FileSystemID == nil {
+		if infraConfig == nil || infraConfig.ElasticFileSystem == nil || !infraConfig.ElasticFileSystem.Enabled {
+			allErrs = append(allErrs, field.Required(fldPath.Child("storage", "efs", "fileSystemID"), "an EFS file system is required, either set its ID or enable the creation by infrastructureConfig.elasticFileSystem.enabled"))
+		}
+	}
+
+	if defaults := controlPlaneConfig.LoadBalancerDefaults; defaults != nil && defaults.AccessLogs != nil && defaults.AccessLogs.Enabled && defaults.AccessLogs.BucketName == nil {
+		if infraConfig == nil || infraConfig.LoadBalancerAccessLogs == nil || !infraConfig.LoadBalancerAccessLogs.Enabled {
+			allErrs = append(allErrs, field.Required(fldPath.Child("loadBalancerDefaults", "accessLogs", "bucketName"), "an S3 bucket is required, either set its name or enable the creation by infrastructureConfig.loadBalancerAccessLogs.enabled"))
+		}
+	}
+
+	// nodes only have IPv6 addresses in dual-stack networks
+	if ccm := controlPlaneConfig.CloudControllerManager; ccm != nil && !helper.IsDualStack(infraConfig) {
+		for i, family := range ccm.NodeIPFamilies {
+			if family == apisaws.IPFamilyIPv6 {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("cloudControllerManager", "nodeIPFamilies").Index(i), "IPv6 node addresses require a dual-stack infrastructure"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateControlPlaneConfigAgainstShoot validates a ControlPlaneConfig object against the shoot it belongs to.
+func ValidateControlPlaneConfigAgainstShoot(controlPlaneConfig *apisaws.ControlPlaneConfig, shoot *core.Shoot) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if controlPlaneConfig.IRSA != nil && controlPlaneConfig.IRSA.Enabled {
+		issuerPath := field.NewPath("spec", "kubernetes", "kubeAPIServer", "serviceAccountConfig", "issuer")
+		var issuer *string
+		if kubeAPIServer := shoot.Spec.Kubernetes.KubeAPIServer; kubeAPIServer != nil && kubeAPIServer.ServiceAccountConfig != nil {
+			issuer = kubeAPIServer.ServiceAccountConfig.Issuer
+		}
+		if issuer == nil {
+			allErrs = append(allErrs, field.Required(issuerPath, "a publicly reachable service account issuer is required for IAM roles for service accounts"))
+		} else if u, err := url.Parse(*issuer); err != nil || u.Scheme != "https" || u.Host == "" || u.RawQuery != "" || u.Fragment != "" {
+			allErrs = append(allErrs, field.Invalid(issuerPath, *issuer, "must be a https URL without query and fragment for IAM roles for service accounts"))
+		}
+	}
+
+	if controlPlaneConfig.Karpenter != nil && controlPlaneConfig.Karpenter.Enabled {
+		// Karpenter needs the endpoint of the API server for the user data of the instances it launches.
+		if shoot.Spec.DNS == nil || shoot.Spec.DNS.Domain == nil {
+			allErrs = append(allErrs, field.Required(field.NewPath("spec", "dns", "domain"), "a domain is required for Karpenter"))
+		}
+		if len(shoot.Spec.Provider.Workers) == 0 {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "provider", "controlPlaneConfig", "karpenter", "enabled"), "Karpenter requires at least one worker pool whose EC2NodeClass can be referenced"))
+		}
+	}
+
+	// the EBS CSI driver refuses to start if both, the volume attach limit and the reserved volume attachments, are set
+	if storage := controlPlaneConfig.Storage; storage != nil && storage.EBS != nil && storage.EBS.ReservedVolumeAttachments != nil {
+		if _, ok := shoot.Annotations[aws.VolumeAttachLimit]; ok {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "provider", "controlPlaneConfig", "storage", "ebs", "reservedVolumeAttachments"), fmt.Sprintf("must not be set together with the %s annotation", aws.VolumeAttachLimit)))
+		}
 	}
 
 	return allErrs
@@ -15,10 +15,17 @@
 package validation_test
 
 import (
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/core"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
 
 	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 	. "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/validation"
@@ -56,5 +63,469 @@ var _ = Describe("ControlPlaneConfig validation", func() {
 				})),
 			))
 		})
+
+		It("should allow a valid CCM configuration", func() {
+			controlPlane.CloudControllerManager = &apisaws.CloudControllerManagerConfig{
+				ConcurrentServiceSyncs:    pointer.Int32(20),
+				NodeStatusUpdateFrequency: &metav1.Duration{Duration: time.Minute},
+				NodeIPFamilies:            []apisaws.IPFamily{apisaws.IPFamilyIPv6, apisaws.IPFamilyIPv4},
+				AllocateNodeCIDRs:         pointer.Bool(false),
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, "1.24.8", fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid CCM configuration", func() {
+			controlPlane.CloudControllerManager = &apisaws.CloudControllerManagerConfig{
+				ConcurrentServiceSyncs:    pointer.Int32(0),
+				NodeStatusUpdateFrequency: &metav1.Duration{Duration: -time.Minute},
+				NodeIPFamilies:            []apisaws.IPFamily{apisaws.IPFamilyIPv4, "foo", apisaws.IPFamilyIPv4},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, "1.24.8", fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.concurrentServiceSyncs"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.nodeStatusUpdateFrequency"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("cloudControllerManager.nodeIPFamilies[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("cloudControllerManager.nodeIPFamilies[2]"),
+				})),
+			))
+		})
+
+		It("should allow valid load balancer defaults", func() {
+			controlPlane.LoadBalancerController = &apisaws.LoadBalancerControllerConfig{Enabled: true}
+			controlPlane.LoadBalancerDefaults = &apisaws.LoadBalancerDefaults{
+				Internal:   pointer.Bool(true),
+				TargetType: pointer.String("ip"),
+				Subnets:    []string{"subnet-1234", "lb-subnet-z2"},
+				Attributes: map[string]string{"load_balancing.cross_zone.enabled": "true"},
+				AccessLogs: &apisaws.LoadBalancerAccessLogs{
+					Enabled:      true,
+					BucketName:   pointer.String("my-lb-logs"),
+					Prefix:       pointer.String("shoots/foo"),
+					EmitInterval: pointer.Int32(60),
+				},
+				SSLNegotiationPolicy: pointer.String("ELBSecurityPolicy-TLS13-1-2-2021-06"),
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, "", fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid invalid load balancer defaults", func() {
+			controlPlane.LoadBalancerDefaults = &apisaws.LoadBalancerDefaults{
+				TargetType: pointer.String("ip"),
+				Subnets:    []string{"subnet-1234", "", "subnet-1234", "a,b"},
+				Attributes: map[string]string{"load_balancing.cross_zone.enabled": "true"},
+				AccessLogs: &apisaws.LoadBalancerAccessLogs{
+					Enabled:      true,
+					BucketName:   pointer.String("My_Bucket"),
+					Prefix:       pointer.String("/AWSLogs"),
+					EmitInterval: pointer.Int32(10),
+				},
+				SSLNegotiationPolicy: pointer.String("ELBSecurityPolicy TLS"),
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, "", fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("loadBalancerDefaults.targetType"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("loadBalancerDefaults.subnets[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("loadBalancerDefaults.subnets[2]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("loadBalancerDefaults.subnets[3]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("loadBalancerDefaults.attributes"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("loadBalancerDefaults.accessLogs.bucketName"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("loadBalancerDefaults.accessLogs.prefix"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("loadBalancerDefaults.accessLogs.emitInterval"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("loadBalancerDefaults.sslNegotiationPolicy"),
+				})),
+			))
+		})
+
+		It("should allow valid storage classes", func() {
+			controlPlane.Storage = &apisaws.Storage{
+				DefaultClass: &apisaws.StorageClassParameters{
+					Type:       pointer.String("gp3"),
+					IOPS:       pointer.Int64(4000),
+					Throughput: pointer.Int64(250),
+					KMSKeyID:   pointer.String("alias/my-key"),
+				},
+				AdditionalClasses: []apisaws.StorageClass{
+					{Name: "fast", Parameters: apisaws.StorageClassParameters{Type: pointer.String("io2"), IOPS: pointer.Int64(10000)}},
+					{Name: "unencrypted", Parameters: apisaws.StorageClassParameters{Encrypted: pointer.Bool(false)}},
+				},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, "", fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid invalid storage class parameters", func() {
+			controlPlane.Storage = &apisaws.Storage{
+				DefaultClass: &apisaws.StorageClassParameters{
+					Type:       pointer.String("gp2"),
+					IOPS:       pointer.Int64(4000),
+					Throughput: pointer.Int64(0),
+				},
+				AdditionalClasses: []apisaws.StorageClass{
+					{Name: "foo", Parameters: apisaws.StorageClassParameters{Type: pointer.String("io3")}},
+					{Name: "bar", Parameters: apisaws.StorageClassParameters{Encrypted: pointer.Bool(false), KMSKeyID: pointer.String("alias/my-key")}},
+				},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, "", fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("storage.defaultClass.iops"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("storage.defaultClass.throughput"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("storage.defaultClass.throughput"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("storage.additionalClasses[0].parameters.type"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("storage.additionalClasses[1].parameters.kmsKeyID"),
+				})),
+			))
+		})
+
+		It("should forbid invalid or duplicate storage class names", func() {
+			controlPlane.Storage = &apisaws.Storage{
+				AdditionalClasses: []apisaws.StorageClass{
+					{Name: "default"},
+					{Name: "Foo"},
+					{Name: "bar"},
+					{Name: "bar"},
+				},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, "", fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("storage.additionalClasses[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("storage.additionalClasses[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("storage.additionalClasses[3].name"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateControlPlaneConfig PrivateLink", func() {
+		It("should allow a valid PrivateLink configuration", func() {
+			controlPlane.PrivateLink = &apisaws.PrivateLinkConfig{
+				Enabled:            true,
+				AllowedPrincipals:  []string{"arn:aws:iam::123456789012:root", "arn:aws:iam::210987654321:role/api-access"},
+				AcceptanceRequired: pointer.Bool(true),
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, "", fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid invalid or duplicate allowed principals", func() {
+			controlPlane.PrivateLink = &apisaws.PrivateLinkConfig{
+				Enabled:           true,
+				AllowedPrincipals: []string{"123456789012", "arn:aws:iam::123456789012:root", "arn:aws:iam::123456789012:root"},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, "", fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("privateLink.allowedPrincipals[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("privateLink.allowedPrincipals[2]"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateControlPlaneConfig CloudWatch agent", func() {
+		It("should allow a valid CloudWatch agent configuration", func() {
+			controlPlane.CloudWatchAgent = &apisaws.CloudWatchAgentConfig{
+				Enabled:                   true,
+				MetricsCollectionInterval: pointer.Int32(30),
+				Logs:                      &apisaws.CloudWatchAgentLogs{Enabled: true, RetentionInDays: pointer.Int32(14)},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, "", fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid interval and retention period", func() {
+			controlPlane.CloudWatchAgent = &apisaws.CloudWatchAgentConfig{
+				Enabled:                   true,
+				MetricsCollectionInterval: pointer.Int32(0),
+				Logs:                      &apisaws.CloudWatchAgentLogs{Enabled: true, RetentionInDays: pointer.Int32(10)},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, "", fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudWatchAgent.metricsCollectionInterval"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudWatchAgent.logs.retentionInDays"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateControlPlaneConfig EBS CSI driver", func() {
+		It("should allow a valid EBS CSI driver configuration", func() {
+			controlPlane.Storage = &apisaws.Storage{
+				EBS: &apisaws.EBSConfig{
+					VolumeModification:        pointer.Bool(false),
+					Batching:                  pointer.Bool(true),
+					ReservedVolumeAttachments: pointer.Int32(1),
+					SnapshotController:        &apisaws.SnapshotControllerConfig{WorkerThreads: pointer.Int32(20)},
+					ResourceRequests: map[string]corev1.ResourceList{
+						"provisioner": {corev1.ResourceCPU: resource.MustParse("100m"), corev1.ResourceMemory: resource.MustParse("128Mi")},
+					},
+					ExtraTags:         map[string]string{"team": "storage"},
+					ShootMetadataTags: pointer.Bool(true),
+				},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, "", fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid EBS CSI driver configuration", func() {
+			controlPlane.Storage = &apisaws.Storage{
+				EBS: &apisaws.EBSConfig{
+					ReservedVolumeAttachments: pointer.Int32(-1),
+					SnapshotController:        &apisaws.SnapshotControllerConfig{WorkerThreads: pointer.Int32(0)},
+					ResourceRequests: map[string]corev1.ResourceList{
+						"foo":      {corev1.ResourceCPU: resource.MustParse("100m")},
+						"attacher": {corev1.ResourceCPU: resource.MustParse("-1"), corev1.ResourceStorage: resource.MustParse("1Gi")},
+					},
+					ExtraTags: map[string]string{"gardener.cloud/shoot": "foo"},
+				},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, "", fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("storage.ebs.reservedVolumeAttachments"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("storage.ebs.snapshotController.workerThreads"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("storage.ebs.resourceRequests[foo]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("storage.ebs.resourceRequests[attacher][cpu]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("storage.ebs.resourceRequests[attacher][storage]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("storage.ebs.extraTags[gardener.cloud/shoot]"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateControlPlaneConfigAgainstShoot", func() {
+		var shoot *core.Shoot
+
+		BeforeEach(func() {
+			controlPlane.IRSA = &apisaws.IRSAConfig{Enabled: true}
+			shoot = &core.Shoot{
+				Spec: core.ShootSpec{
+					Kubernetes: core.Kubernetes{
+						KubeAPIServer: &core.KubeAPIServerConfig{
+							ServiceAccountConfig: &core.ServiceAccountConfig{
+								Issuer: pointer.String("https://discovery.example.com/projects/foo/shoots/bar/issuer"),
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should allow IRSA with a https issuer", func() {
+			Expect(ValidateControlPlaneConfigAgainstShoot(controlPlane, shoot)).To(BeEmpty())
+		})
+
+		It("should not require an issuer if IRSA is disabled", func() {
+			controlPlane.IRSA.Enabled = false
+			shoot.Spec.Kubernetes.KubeAPIServer = nil
+
+			Expect(ValidateControlPlaneConfigAgainstShoot(controlPlane, shoot)).To(BeEmpty())
+		})
+
+		It("should require an issuer for IRSA", func() {
+			shoot.Spec.Kubernetes.KubeAPIServer = nil
+
+			Expect(ValidateControlPlaneConfigAgainstShoot(controlPlane, shoot)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.kubernetes.kubeAPIServer.serviceAccountConfig.issuer"),
+				})),
+			))
+		})
+
+		It("should forbid issuers which are no https URLs", func() {
+			shoot.Spec.Kubernetes.KubeAPIServer.ServiceAccountConfig.Issuer = pointer.String("http://discovery.example.com")
+
+			Expect(ValidateControlPlaneConfigAgainstShoot(controlPlane, shoot)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.kubernetes.kubeAPIServer.serviceAccountConfig.issuer"),
+				})),
+			))
+		})
+
+		It("should forbid reserved volume attachments together with the volume attach limit annotation", func() {
+			controlPlane.Storage = &apisaws.Storage{EBS: &apisaws.EBSConfig{ReservedVolumeAttachments: pointer.Int32(1)}}
+			shoot.Annotations = map[string]string{"aws.provider.extensions.gardener.cloud/volume-attach-limit": "20"}
+
+			Expect(ValidateControlPlaneConfigAgainstShoot(controlPlane, shoot)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.provider.controlPlaneConfig.storage.ebs.reservedVolumeAttachments"),
+				})),
+			))
+		})
+
+		It("should allow Karpenter for shoots with a domain and worker pools", func() {
+			controlPlane.Karpenter = &apisaws.KarpenterConfig{Enabled: true}
+			shoot.Spec.DNS = &core.DNS{Domain: pointer.String("shoot.example.com")}
+			shoot.Spec.Provider.Workers = []core.Worker{{Name: "worker"}}
+
+			Expect(ValidateControlPlaneConfigAgainstShoot(controlPlane, shoot)).To(BeEmpty())
+		})
+
+		It("should require a domain and worker pools for Karpenter", func() {
+			controlPlane.Karpenter = &apisaws.KarpenterConfig{Enabled: true}
+
+			Expect(ValidateControlPlaneConfigAgainstShoot(controlPlane, shoot)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.dns.domain"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.provider.controlPlaneConfig.karpenter.enabled"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateControlPlaneConfigAgainstInfrastructureConfig", func() {
+		var infraConfig *apisaws.InfrastructureConfig
+
+		BeforeEach(func() {
+			infraConfig = &apisaws.InfrastructureConfig{}
+			controlPlane.Storage = &apisaws.Storage{EFS: &apisaws.EFSConfig{Enabled: true}}
+		})
+
+		It("should allow EFS with an explicit file system ID", func() {
+			controlPlane.Storage.EFS.FileSystemID = pointer.String("fs-1234")
+
+			Expect(ValidateControlPlaneConfigAgainstInfrastructureConfig(controlPlane, infraConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow EFS with a file system created by the infrastructure", func() {
+			infraConfig.ElasticFileSystem = &apisaws.ElasticFileSystemConfig{Enabled: true}
+
+			Expect(ValidateControlPlaneConfigAgainstInfrastructureConfig(controlPlane, infraConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid IPv6 node addresses for IPv4 infrastructures", func() {
+			controlPlane.Storage = nil
+			controlPlane.CloudControllerManager = &apisaws.CloudControllerManagerConfig{
+				NodeIPFamilies: []apisaws.IPFamily{apisaws.IPFamilyIPv4, apisaws.IPFamilyIPv6},
+			}
+
+			Expect(ValidateControlPlaneConfigAgainstInfrastructureConfig(controlPlane, infraConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("cloudControllerManager.nodeIPFamilies[1]"),
+				})),
+			))
+
+			infraConfig.Networks.IPFamilies = []apisaws.IPFamily{apisaws.IPFamilyIPv4, apisaws.IPFamilyIPv6}
+			Expect(ValidateControlPlaneConfigAgainstInfrastructureConfig(controlPlane, infraConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should require a file system if EFS is enabled", func() {
+			Expect(ValidateControlPlaneConfigAgainstInfrastructureConfig(controlPlane, infraConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("storage.efs.fileSystemID"),
+				})),
+			))
+		})
+
+		It("should require a bucket if load balancer access logs are enabled", func() {
+			controlPlane.Storage = nil
+			controlPlane.LoadBalancerDefaults = &apisaws.LoadBalancerDefaults{
+				AccessLogs: &apisaws.LoadBalancerAccessLogs{Enabled: true},
+			}
+
+			Expect(ValidateControlPlaneConfigAgainstInfrastructureConfig(controlPlane, infraConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("loadBalancerDefaults.accessLogs.bucketName"),
+				})),
+			))
+
+			infraConfig.LoadBalancerAccessLogs = &apisaws.LoadBalancerAccessLogsConfig{Enabled: true}
+			Expect(ValidateControlPlaneConfigAgainstInfrastructureConfig(controlPlane, infraConfig, fldPath)).To(BeEmpty())
+		})
 	})
 })
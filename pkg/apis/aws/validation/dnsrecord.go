@@ -0,0 +1,188 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"net"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+)
+
+var (
+	validFailoverRoles = sets.New(string(apisaws.FailoverRolePrimary), string(apisaws.FailoverRoleSecondary))
+	// see https://docs.aws.amazon.com/Route53/latest/APIReference/API_GeoLocation.html
+	validContinentCodes   = sets.New("AF", "AN", "AS", "EU", "OC", "NA", "SA")
+	countryCodeRegex      = regexp.MustCompile(`^([A-Z]{2}|\*)$`)
+	vpcIDRegex            = regexp.MustCompile(`^vpc-[0-9a-f]+$`)
+	validHealthCheckTypes = sets.New(string(apisaws.HealthCheckTypeHTTP), string(apisaws.HealthCheckTypeHTTPS), string(apisaws.HealthCheckTypeTCP))
+)
+
+// ValidateDNSRecordConfig validates a DNSRecordConfig object.
+func ValidateDNSRecordConfig(config *apisaws.DNSRecordConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config.RoutingPolicy != nil {
+		allErrs = append(allErrs, validateRoutingPolicy(config.RoutingPolicy, fldPath.Child("routingPolicy"))...)
+	}
+	if config.HealthCheckID != nil && len(*config.HealthCheckID) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("healthCheckID"), *config.HealthCheckID, "must not be empty"))
+	}
+	if config.HealthCheck != nil {
+		if config.HealthCheckID != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("healthCheck"), "must not be set together with healthCheckID"))
+		}
+		allErrs = append(allErrs, validateHealthCheck(config.HealthCheck, fldPath.Child("healthCheck"))...)
+	}
+	if config.PrivateHostedZone != nil {
+		allErrs = append(allErrs, validatePrivateHostedZone(config.PrivateHostedZone, fldPath.Child("privateHostedZone"))...)
+	}
+
+	return allErrs
+}
+
+func validateRoutingPolicy(policy *apisaws.RoutingPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(policy.SetIdentifier) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("setIdentifier"), "must be set"))
+	} else if len(policy.SetIdentifier) > 128 {
+		allErrs = append(allErrs, field.TooLong(fldPath.Child("setIdentifier"), policy.SetIdentifier, 128))
+	}
+
+	policies := 0
+	if policy.Weighted != nil {
+		policies++
+		if policy.Weighted.Weight < 0 || policy.Weighted.Weight > 255 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("weighted", "weight"), policy.Weighted.Weight, "must be between 0 and 255"))
+		}
+	}
+	if policy.Latency != nil {
+		policies++
+		if len(policy.Latency.Region) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("latency", "region"), "must be set"))
+		}
+	}
+	if policy.Failover != nil {
+		policies++
+		if !validFailoverRoles.Has(string(policy.Failover.Role)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("failover", "role"), policy.Failover.Role, sets.List(validFailoverRoles)))
+		}
+	}
+	if policy.Geolocation != nil {
+		policies++
+		allErrs = append(allErrs, validateGeolocationRoutingPolicy(policy.Geolocation, fldPath.Child("geolocation"))...)
+	}
+
+	if policies != 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath, policy, "exactly one of weighted, latency, failover or geolocation must be set"))
+	}
+
+	return allErrs
+}
+
+func validateGeolocationRoutingPolicy(policy *apisaws.GeolocationRoutingPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch {
+	case policy.ContinentCode != nil && policy.CountryCode != nil:
+		allErrs = append(allErrs, field.Invalid(fldPath, policy, "only one of continentCode or countryCode must be set"))
+	case policy.ContinentCode == nil && policy.CountryCode == nil:
+		allErrs = append(allErrs, field.Required(fldPath, "either continentCode or countryCode must be set"))
+	}
+
+	if policy.ContinentCode != nil && !validContinentCodes.Has(*policy.ContinentCode) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("continentCode"), *policy.ContinentCode, sets.List(validContinentCodes)))
+	}
+	if policy.CountryCode != nil && !countryCodeRegex.MatchString(*policy.CountryCode) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("countryCode"), *policy.CountryCode, "must be a two-letter country code or *"))
+	}
+	if policy.SubdivisionCode != nil && (policy.CountryCode == nil || *policy.CountryCode != "US") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("subdivisionCode"), *policy.SubdivisionCode, "is only supported for the country code US"))
+	}
+
+	return allErrs
+}
+
+func validateHealthCheck(healthCheck *apisaws.HealthCheck, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !validHealthCheckTypes.Has(string(healthCheck.Type)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), healthCheck.Type, sets.List(validHealthCheckTypes)))
+	}
+
+	if healthCheck.IPAddress == nil && healthCheck.FullyQualifiedDomainName == nil {
+		allErrs = append(allErrs, field.Required(fldPath, "either ipAddress or fullyQualifiedDomainName must be set"))
+	}
+	if healthCheck.IPAddress != nil && net.ParseIP(*healthCheck.IPAddress) == nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ipAddress"), *healthCheck.IPAddress, "must be a valid IP address"))
+	}
+	if healthCheck.FullyQualifiedDomainName != nil && (len(*healthCheck.FullyQualifiedDomainName) == 0 || len(*healthCheck.FullyQualifiedDomainName) > 255) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("fullyQualifiedDomainName"), *healthCheck.FullyQualifiedDomainName, "must not be empty and at most 255 characters long"))
+	}
+
+	if healthCheck.Port == nil {
+		if healthCheck.Type == apisaws.HealthCheckTypeTCP {
+			allErrs = append(allErrs, field.Required(fldPath.Child("port"), "must be set for TCP health checks"))
+		}
+	} else if *healthCheck.Port < 1 || *healthCheck.Port > 65535 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("port"), *healthCheck.Port, "must be between 1 and 65535"))
+	}
+
+	if healthCheck.ResourcePath != nil {
+		if healthCheck.Type == apisaws.HealthCheckTypeTCP {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("resourcePath"), "is not supported for TCP health checks"))
+		} else if !strings.HasPrefix(*healthCheck.ResourcePath, "/") || len(*healthCheck.ResourcePath) > 255 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("resourcePath"), *healthCheck.ResourcePath, "must start with / and be at most 255 characters long"))
+		}
+	}
+
+	if healthCheck.RequestInterval != nil && *healthCheck.RequestInterval != 10 && *healthCheck.RequestInterval != 30 {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("requestInterval"), *healthCheck.RequestInterval, []string{"10", "30"}))
+	}
+	if healthCheck.FailureThreshold != nil && (*healthCheck.FailureThreshold < 1 || *healthCheck.FailureThreshold > 10) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("failureThreshold"), *healthCheck.FailureThreshold, "must be between 1 and 10"))
+	}
+
+	return allErrs
+}
+
+func validatePrivateHostedZone(zone *apisaws.PrivateHostedZone, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if zone.ID != nil && len(*zone.ID) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("id"), *zone.ID, "must not be empty"))
+	}
+
+	vpcIDs := sets.New[string]()
+	for i, vpc := range zone.VPCs {
+		idxPath := fldPath.Child("vpcs").Index(i)
+		if !vpcIDRegex.MatchString(vpc.ID) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("id"), vpc.ID, "must be a valid VPC id, e.g. vpc-0123456789abcdef0"))
+		} else if vpcIDs.Has(vpc.ID) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("id"), vpc.ID))
+		}
+		vpcIDs.Insert(vpc.ID)
+		if vpc.Region != nil && len(*vpc.Region) == 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("region"), *vpc.Region, "must not be empty"))
+		}
+	}
+
+	return allErrs
+}
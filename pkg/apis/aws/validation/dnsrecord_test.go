@@ -0,0 +1,215 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/validation"
+)
+
+var _ = Describe("DNSRecordConfig validation", func() {
+	var fldPath = field.NewPath("providerConfig")
+
+	It("should allow an empty config", func() {
+		Expect(ValidateDNSRecordConfig(&apisaws.DNSRecordConfig{}, fldPath)).To(BeEmpty())
+	})
+
+	DescribeTable("should allow valid routing policies",
+		func(policy *apisaws.RoutingPolicy) {
+			policy.SetIdentifier = "eu-west-1"
+			config := &apisaws.DNSRecordConfig{RoutingPolicy: policy, HealthCheckID: pointer.String("abcdef11-2222-3333-4444-555555fedcba")}
+
+			Expect(ValidateDNSRecordConfig(config, fldPath)).To(BeEmpty())
+		},
+		Entry("weighted", &apisaws.RoutingPolicy{Weighted: &apisaws.WeightedRoutingPolicy{Weight: 255}}),
+		Entry("latency", &apisaws.RoutingPolicy{Latency: &apisaws.LatencyRoutingPolicy{Region: "eu-west-1"}}),
+		Entry("failover", &apisaws.RoutingPolicy{Failover: &apisaws.FailoverRoutingPolicy{Role: apisaws.FailoverRoleSecondary}}),
+		Entry("geolocation by continent", &apisaws.RoutingPolicy{Geolocation: &apisaws.GeolocationRoutingPolicy{ContinentCode: pointer.String("EU")}}),
+		Entry("geolocation by subdivision", &apisaws.RoutingPolicy{Geolocation: &apisaws.GeolocationRoutingPolicy{CountryCode: pointer.String("US"), SubdivisionCode: pointer.String("CA")}}),
+		Entry("default geolocation", &apisaws.RoutingPolicy{Geolocation: &apisaws.GeolocationRoutingPolicy{CountryCode: pointer.String("*")}}),
+	)
+
+	It("should forbid routing policies without set identifier or with multiple policies", func() {
+		config := &apisaws.DNSRecordConfig{
+			RoutingPolicy: &apisaws.RoutingPolicy{
+				Weighted: &apisaws.WeightedRoutingPolicy{Weight: 256},
+				Failover: &apisaws.FailoverRoutingPolicy{Role: "TERTIARY"},
+			},
+			HealthCheckID: pointer.String(""),
+		}
+
+		Expect(ValidateDNSRecordConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("providerConfig.routingPolicy.setIdentifier"),
+		})), PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("providerConfig.routingPolicy.weighted.weight"),
+		})), PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeNotSupported),
+			"Field": Equal("providerConfig.routingPolicy.failover.role"),
+		})), PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("providerConfig.routingPolicy"),
+		})), PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("providerConfig.healthCheckID"),
+		}))))
+	})
+
+	It("should forbid routing policies without policy", func() {
+		config := &apisaws.DNSRecordConfig{RoutingPolicy: &apisaws.RoutingPolicy{SetIdentifier: "foo"}}
+
+		Expect(ValidateDNSRecordConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("providerConfig.routingPolicy"),
+		}))))
+	})
+
+	It("should allow a valid private hosted zone", func() {
+		config := &apisaws.DNSRecordConfig{
+			PrivateHostedZone: &apisaws.PrivateHostedZone{
+				ID: pointer.String("Z0123456789"),
+				VPCs: []apisaws.HostedZoneVPC{
+					{ID: "vpc-0123456789abcdef0"},
+					{ID: "vpc-0123456789abcdef1", Region: pointer.String("eu-central-1")},
+				},
+			},
+		}
+
+		Expect(ValidateDNSRecordConfig(config, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid invalid private hosted zones", func() {
+		config := &apisaws.DNSRecordConfig{
+			PrivateHostedZone: &apisaws.PrivateHostedZone{
+				ID: pointer.String(""),
+				VPCs: []apisaws.HostedZoneVPC{
+					{ID: "vpc-0123456789abcdef0"},
+					{ID: "vpc-0123456789abcdef0", Region: pointer.String("")},
+					{ID: "my-vpc"},
+				},
+			},
+		}
+
+		Expect(ValidateDNSRecordConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("providerConfig.privateHostedZone.id"),
+		})), PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeDuplicate),
+			"Field": Equal("providerConfig.privateHostedZone.vpcs[1].id"),
+		})), PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("providerConfig.privateHostedZone.vpcs[1].region"),
+		})), PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("providerConfig.privateHostedZone.vpcs[2].id"),
+		}))))
+	})
+
+	It("should allow a valid health check", func() {
+		config := &apisaws.DNSRecordConfig{
+			HealthCheck: &apisaws.HealthCheck{
+				Type:                     apisaws.HealthCheckTypeHTTPS,
+				IPAddress:                pointer.String("2001:db8::1"),
+				FullyQualifiedDomainName: pointer.String("api.example.com"),
+				ResourcePath:             pointer.String("/healthz"),
+				RequestInterval:          pointer.Int32(10),
+				FailureThreshold:         pointer.Int32(5),
+			},
+		}
+
+		Expect(ValidateDNSRecordConfig(config, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid invalid health checks", func() {
+		config := &apisaws.DNSRecordConfig{
+			HealthCheckID: pointer.String("abcdef11-2222-3333-4444-555555fedcba"),
+			HealthCheck: &apisaws.HealthCheck{
+				Type:             apisaws.HealthCheckTypeTCP,
+				IPAddress:        pointer.String("10.0.0.300"),
+				ResourcePath:     pointer.String("/healthz"),
+				RequestInterval:  pointer.Int32(20),
+				FailureThreshold: pointer.Int32(0),
+			},
+		}
+
+		Expect(ValidateDNSRecordConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeForbidden),
+			"Field": Equal("providerConfig.healthCheck"),
+		})), PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("providerConfig.healthCheck.ipAddress"),
+		})), PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("providerConfig.healthCheck.port"),
+		})), PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeForbidden),
+			"Field": Equal("providerConfig.healthCheck.resourcePath"),
+		})), PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeNotSupported),
+			"Field": Equal("providerConfig.healthCheck.requestInterval"),
+		})), PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("providerConfig.healthCheck.failureThreshold"),
+		}))))
+	})
+
+	It("should forbid health checks without endpoint", func() {
+		config := &apisaws.DNSRecordConfig{
+			HealthCheck: &apisaws.HealthCheck{Type: "ICMP"},
+		}
+
+		Expect(ValidateDNSRecordConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeNotSupported),
+			"Field": Equal("providerConfig.healthCheck.type"),
+		})), PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("providerConfig.healthCheck"),
+		}))))
+	})
+
+	It("should forbid invalid geolocations", func() {
+		config := &apisaws.DNSRecordConfig{
+			RoutingPolicy: &apisaws.RoutingPolicy{
+				SetIdentifier: "foo",
+				Geolocation: &apisaws.GeolocationRoutingPolicy{
+					ContinentCode:   pointer.String("XX"),
+					CountryCode:     pointer.String("germany"),
+					SubdivisionCode: pointer.String("BY"),
+				},
+			},
+		}
+
+		Expect(ValidateDNSRecordConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("providerConfig.routingPolicy.geolocation"),
+		})), PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeNotSupported),
+			"Field": Equal("providerConfig.routingPolicy.geolocation.continentCode"),
+		})), PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("providerConfig.routingPolicy.geolocation.countryCode"),
+		})), PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("providerConfig.routingPolicy.geolocation.subdivisionCode"),
+		}))))
+	})
+})
@@ -16,6 +16,8 @@ package validation
 
 import (
 	"fmt"
+	"net"
+	"net/url"
 	"regexp"
 	"strings"
 
@@ -24,13 +26,28 @@ import (
 	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	apisawshelper "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 )
 
-// valid values for networks.vpc.gatewayEndpoints
-var gatewayEndpointPattern = regexp.MustCompile(`^\w+(\.\w+)*$`)
+var (
+	// valid values for networks.vpc.gatewayEndpoints
+	gatewayEndpointPattern = regexp.MustCompile(`^\w+(\.\w+)*$`)
+	// valid values for vpcFlowLogs.destination.s3.bucketARN
+	s3ARNPattern = regexp.MustCompile(`^arn:[\w-]+:s3:::[a-z0-9][a-z0-9.-]+[a-z0-9](/.*)?$`)
+	// valid values for networks.zones[].zoneID, e.g. `use1-az1`
+	zoneIDPattern = regexp.MustCompile(`^[a-z]+[0-9]+(-[a-z0-9]+)?-az[0-9]+$`)
+	// valid values for networks.zones[].outpostARN and the outpostARN of the WorkerConfig
+	outpostARNPattern = regexp.MustCompile(`^arn:[\w-]+:outposts:[a-z0-9-]+:[0-9]{12}:outpost/op-[0-9a-f]{17}$`)
+	// valid values for networks.vpcPeerings[].peerAccountID
+	accountIDPattern = regexp.MustCompile(`^[0-9]{12}$`)
+	// valid values for networks.vpcPeerings[].peerRegion, e.g. `eu-central-1` or `us-gov-west-1`
+	regionPattern = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-[0-9]+$`)
+)
 
 // ValidateInfrastructureConfigAgainstCloudProfile validates the given `InfrastructureConfig` against the given `CloudProfile`.
 func ValidateInfrastructureConfigAgainstCloudProfile(oldInfra, infra *apisaws.InfrastructureConfig, shoot *core.Shoot, cloudProfile *gardencorev1beta1.CloudProfile, fldPath *field.Path) field.ErrorList {
@@ -101,6 +118,8 @@ func ValidateInfrastructureConfig(infra *apisaws.InfrastructureConfig, nodesCIDR
 		allErrs = append(allErrs, field.Required(networksPath.Child("zones"), "must specify at least the networks for one zone"))
 	}
 
+	allErrs = append(allErrs, validateIPFamilies(infra, networksPath.Child("ipFamilies"))...)
+
 	if len(infra.Networks.VPC.GatewayEndpoints) > 0 {
 		epsPath := networksPath.Child("vpc", "gatewayEndpoints")
 		for i, svc := range infra.Networks.VPC.GatewayEndpoints {
@@ -110,12 +129,47 @@ func ValidateInfrastructureConfig(infra *apisaws.InfrastructureConfig, nodesCIDR
 		}
 	}
 
+	allErrs = append(allErrs, validateVPCEndpoints(infra.Networks.VPC, networksPath.Child("vpc", "endpoints"))...)
+	if infra.Networks.VPC.DHCPOptions != nil {
+		dhcpOptionsPath := networksPath.Child("vpc", "dhcpOptions")
+		if infra.Networks.VPC.ID != nil {
+			// the DHCP options of an existing VPC are not managed by the AWS extension and might be shared with other VPCs
+			allErrs = append(allErrs, field.Forbidden(dhcpOptionsPath, "must not be set if an existing VPC is used"))
+		} else {
+			allErrs = append(allErrs, validateDHCPOptions(infra.Networks.VPC.DHCPOptions, dhcpOptionsPath)...)
+		}
+	}
+	allErrs = append(allErrs, validateExistingRouting(infra, networksPath)...)
+	allErrs = append(allErrs, validateSharedVPC(infra, networksPath)...)
+	allErrs = append(allErrs, validateNATGateway(infra, networksPath)...)
+	allErrs = append(allErrs, validateZoneTypes(infra, networksPath)...)
+	allErrs = append(allErrs, validateNodeSecurityGroupRules(infra.Networks.AdditionalNodeSecurityGroupRules, networksPath.Child("additionalNodeSecurityGroupRules"))...)
+	if acls := infra.Networks.NetworkACLs; acls != nil {
+		networkACLsPath := networksPath.Child("networkACLs")
+		allErrs = append(allErrs, validateNetworkACL(acls.Workers, networkACLsPath.Child("workers"))...)
+		allErrs = append(allErrs, validateNetworkACL(acls.Public, networkACLsPath.Child("public"))...)
+		allErrs = append(allErrs, validateNetworkACL(acls.Internal, networkACLsPath.Child("internal"))...)
+	}
+	if infra.Networks.Route53Resolver != nil {
+		allErrs = append(allErrs, validateRoute53Resolver(infra.Networks.Route53Resolver, networksPath.Child("route53Resolver"))...)
+	}
+
 	var (
 		cidrs                            = make([]cidrvalidation.CIDR, 0, len(infra.Networks.Zones)*3)
 		workerCIDRs                      = make([]cidrvalidation.CIDR, 0, len(infra.Networks.Zones))
+		podSubnetCIDRs                   []cidrvalidation.CIDR
+		secondaryWorkerCIDRs             []cidrvalidation.CIDR
+		secondaryCIDRs                   []cidrvalidation.CIDR
 		referencedElasticIPAllocationIDs []string
+		referencedZoneIDs                = sets.New[string]()
 	)
 
+	secondaryCidrBlocksPath := networksPath.Child("vpc", "secondaryCidrBlocks")
+	for i, cidr := range infra.Networks.VPC.SecondaryCidrBlocks {
+		secondaryCIDRs = append(secondaryCIDRs, cidrvalidation.NewCIDR(cidr, secondaryCidrBlocksPath.Index(i)))
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(secondaryCidrBlocksPath.Index(i), cidr)...)
+	}
+
 	for i, zone := range infra.Networks.Zones {
 		zonePath := networksPath.Child("zones").Index(i)
 
@@ -132,6 +186,18 @@ func ValidateInfrastructureConfig(infra *apisaws.InfrastructureConfig, nodesCIDR
 		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(workerPath, zone.Workers)...)
 		workerCIDRs = append(workerCIDRs, cidrvalidation.NewCIDR(zone.Workers, workerPath))
 
+		if zone.Pods != nil {
+			podsPath := zonePath.Child("pods")
+			podSubnetCIDRs = append(podSubnetCIDRs, cidrvalidation.NewCIDR(*zone.Pods, podsPath))
+			allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(podsPath, *zone.Pods)...)
+		}
+
+		if zone.SecondaryWorkers != nil {
+			secondaryWorkersPath := zonePath.Child("secondaryWorkers")
+			secondaryWorkerCIDRs = append(secondaryWorkerCIDRs, cidrvalidation.NewCIDR(*zone.SecondaryWorkers, secondaryWorkersPath))
+			allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(secondaryWorkersPath, *zone.SecondaryWorkers)...)
+		}
+
 		if zone.ElasticIPAllocationID != nil {
 			for _, eIP := range referencedElasticIPAllocationIDs {
 				if eIP == *zone.ElasticIPAllocationID {
@@ -145,12 +211,36 @@ func ValidateInfrastructureConfig(infra *apisaws.InfrastructureConfig, nodesCIDR
 				allErrs = append(allErrs, field.Invalid(zonePath.Child("elasticIPAllocationID"), *zone.ElasticIPAllocationID, "must start with eipalloc-"))
 			}
 		}
+
+		if zone.ZoneID != nil {
+			if referencedZoneIDs.Has(*zone.ZoneID) {
+				allErrs = append(allErrs, field.Duplicate(zonePath.Child("zoneID"), *zone.ZoneID))
+			}
+			referencedZoneIDs.Insert(*zone.ZoneID)
+
+			if !zoneIDPattern.MatchString(*zone.ZoneID) {
+				allErrs = append(allErrs, field.Invalid(zonePath.Child("zoneID"), *zone.ZoneID, "must be a valid availability zone ID, e.g. use1-az1"))
+			}
+		}
+
+		if zone.OutpostARN != nil {
+			if !outpostARNPattern.MatchString(*zone.OutpostARN) {
+				allErrs = append(allErrs, field.Invalid(zonePath.Child("outpostARN"), *zone.OutpostARN, "must be a valid Outpost ARN, e.g. arn:aws:outposts:eu-west-1:123456789012:outpost/op-0123456789abcdef0"))
+			}
+			if zoneType := apisawshelper.GetZoneType(infra, zone.Name); zoneType != apisaws.ZoneTypeAvailabilityZone {
+				allErrs = append(allErrs, field.Forbidden(zonePath.Child("outpostARN"), fmt.Sprintf("Outposts are not supported for zones of type %s", zoneType)))
+			}
+		}
 	}
 
 	allErrs = append(allErrs, cidrvalidation.ValidateCIDRParse(cidrs...)...)
+	allErrs = append(allErrs, cidrvalidation.ValidateCIDRParse(podSubnetCIDRs...)...)
+	allErrs = append(allErrs, cidrvalidation.ValidateCIDRParse(secondaryWorkerCIDRs...)...)
+	allErrs = append(allErrs, cidrvalidation.ValidateCIDRParse(secondaryCIDRs...)...)
 
 	if nodes != nil {
 		allErrs = append(allErrs, nodes.ValidateSubset(workerCIDRs...)...)
+		allErrs = append(allErrs, nodes.ValidateSubset(secondaryWorkerCIDRs...)...)
 	}
 
 	if (infra.Networks.VPC.ID == nil && infra.Networks.VPC.CIDR == nil) || (infra.Networks.VPC.ID != nil && infra.Networks.VPC.CIDR != nil) {
@@ -163,6 +253,24 @@ func ValidateInfrastructureConfig(infra *apisaws.InfrastructureConfig, nodesCIDR
 		allErrs = append(allErrs, vpcCIDR.ValidateSubset(nodes)...)
 		allErrs = append(allErrs, vpcCIDR.ValidateSubset(cidrs...)...)
 		allErrs = append(allErrs, vpcCIDR.ValidateNotOverlap(pods, services)...)
+		allErrs = append(allErrs, vpcCIDR.ValidateNotOverlap(secondaryCIDRs...)...)
+		allErrs = append(allErrs, validateSubnetsInVPC(append(podSubnetCIDRs, secondaryWorkerCIDRs...), append([]cidrvalidation.CIDR{vpcCIDR}, secondaryCIDRs...))...)
+	}
+
+	// dedicated pod subnets, secondary workers subnets and secondary CIDR blocks must neither overlap with each other nor
+	// with the other subnets
+	additionalSubnetCIDRs := append(podSubnetCIDRs, secondaryWorkerCIDRs...)
+	allErrs = append(allErrs, cidrvalidation.ValidateCIDROverlap(secondaryCIDRs, false)...)
+	allErrs = append(allErrs, cidrvalidation.ValidateCIDROverlap(additionalSubnetCIDRs, false)...)
+	for _, additionalSubnetCIDR := range additionalSubnetCIDRs {
+		allErrs = append(allErrs, additionalSubnetCIDR.ValidateNotOverlap(cidrs...)...)
+	}
+	if services != nil {
+		allErrs = append(allErrs, services.ValidateNotOverlap(secondaryCIDRs...)...)
+		allErrs = append(allErrs, services.ValidateNotOverlap(additionalSubnetCIDRs...)...)
+	}
+	if pods != nil {
+		allErrs = append(allErrs, pods.ValidateNotOverlap(secondaryWorkerCIDRs...)...)
 	}
 
 	// make sure that VPC cidrs don't overlap with each other
@@ -174,13 +282,681 @@ func ValidateInfrastructureConfig(infra *apisaws.InfrastructureConfig, nodesCIDR
 		allErrs = append(allErrs, services.ValidateNotOverlap(cidrs...)...)
 	}
 
+	// routes via the transit gateway and the VPC peerings must neither overlap with the shoot networks nor target the
+	// same destination
+	var (
+		routed     = sets.New[string]()
+		shootCIDRs []cidrvalidation.CIDR
+	)
+	if infra.Networks.VPC.CIDR != nil {
+		shootCIDRs = append(shootCIDRs, cidrvalidation.NewCIDR(*infra.Networks.VPC.CIDR, networksPath.Child("vpc", "cidr")))
+	}
+	for _, cidr := range []cidrvalidation.CIDR{nodes, pods, services} {
+		if cidr != nil {
+			shootCIDRs = append(shootCIDRs, cidr)
+		}
+	}
+
+	if infra.Networks.TransitGateway != nil {
+		allowDefaultRoute := apisawshelper.GetNATGatewayMode(infra) == apisaws.NATGatewayModeNone
+		allErrs = append(allErrs, validateTransitGateway(infra.Networks.TransitGateway, networksPath.Child("transitGateway"), routed, allowDefaultRoute, shootCIDRs)...)
+	}
+
+	allErrs = append(allErrs, validateVPCPeerings(infra.Networks.VPCPeerings, infra.Networks.VPC.ID, networksPath.Child("vpcPeerings"), routed, shootCIDRs)...)
+
 	allErrs = append(allErrs, ValidateIgnoreTags(field.NewPath("ignoreTags"), infra.IgnoreTags)...)
+	allErrs = append(allErrs, ValidateTags(field.NewPath("tags"), infra.Tags)...)
+
+	if infra.VPCFlowLogs != nil {
+		allErrs = append(allErrs, validateVPCFlowLogs(infra.VPCFlowLogs, field.NewPath("vpcFlowLogs"))...)
+	}
+
+	if infra.IAM != nil && infra.IAM.NodesInstanceProfile != nil {
+		allErrs = append(allErrs, validateIAMInstanceProfile(infra.IAM.NodesInstanceProfile, field.NewPath("iam", "nodesInstanceProfile"))...)
+	}
+
+	if infra.Endpoints != nil {
+		allErrs = append(allErrs, validateEndpoints(infra.Endpoints, field.NewPath("endpoints"))...)
+	}
+
+	if prefixList := infra.EgressPrefixList; prefixList != nil && !prefixList.Enabled && prefixList.IncludeNodesCIDRs {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("egressPrefixList", "includeNodesCIDRs"), prefixList.IncludeNodesCIDRs, "the prefix list must be enabled"))
+	}
+
+	if inventory := infra.Inventory; inventory != nil && inventory.S3 != nil {
+		s3Path := field.NewPath("inventory", "s3")
+		if !inventory.Enabled {
+			allErrs = append(allErrs, field.Forbidden(s3Path, "the inventory must be enabled"))
+		}
+		if inventory.S3.Bucket == "" {
+			allErrs = append(allErrs, field.Required(s3Path.Child("bucket"), "must specify the bucket of the inventory"))
+		}
+		if strings.HasPrefix(inventory.S3.Prefix, "/") {
+			allErrs = append(allErrs, field.Invalid(s3Path.Child("prefix"), inventory.S3.Prefix, "must not start with '/'"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateEndpoints validates the overrides for the AWS partition and the AWS service endpoints.
+func validateEndpoints(endpoints *apisaws.Endpoints, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if endpoints.Partition != nil && !sets.New(awsclient.Partitions...).Has(*endpoints.Partition) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("partition"), *endpoints.Partition, awsclient.Partitions))
+	}
+
+	supportedServices := sets.New(awsclient.Services...)
+	for service, endpoint := range endpoints.Services {
+		servicePath := fldPath.Child("services").Key(service)
+		if !supportedServices.Has(service) {
+			allErrs = append(allErrs, field.NotSupported(servicePath, service, awsclient.Services))
+			continue
+		}
+		if u, err := url.Parse(endpoint); err != nil || u.Scheme != "https" || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(servicePath, endpoint, "must be a valid https URL"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateVPCFlowLogs validates the VPC flow logs configuration. Exactly one destination must be configured.
+func validateVPCFlowLogs(flowLogs *apisaws.VPCFlowLogs, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	destinationPath := fldPath.Child("destination")
+	destination := flowLogs.Destination
+	switch {
+	case destination.CloudWatchLogs == nil && destination.S3 == nil:
+		allErrs = append(allErrs, field.Required(destinationPath, "must specify either cloudWatchLogs or s3"))
+	case destination.CloudWatchLogs != nil && destination.S3 != nil:
+		allErrs = append(allErrs, field.Forbidden(destinationPath, "must not specify both cloudWatchLogs and s3"))
+	}
+	if destination.CloudWatchLogs != nil && destination.CloudWatchLogs.KMSKeyARN != nil && !strings.HasPrefix(*destination.CloudWatchLogs.KMSKeyARN, "arn:") {
+		allErrs = append(allErrs, field.Invalid(destinationPath.Child("cloudWatchLogs", "kmsKeyARN"), *destination.CloudWatchLogs.KMSKeyARN, "must be a KMS key ARN"))
+	}
+	if destination.S3 != nil && !s3ARNPattern.MatchString(destination.S3.BucketARN) {
+		allErrs = append(allErrs, field.Invalid(destinationPath.Child("s3", "bucketARN"), destination.S3.BucketARN, "must be an S3 bucket ARN, e.g. arn:aws:s3:::my-bucket"))
+	}
+
+	if flowLogs.TrafficType != nil {
+		supportedTrafficTypes := sets.New("ACCEPT", "REJECT", "ALL")
+		if !supportedTrafficTypes.Has(*flowLogs.TrafficType) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("trafficType"), *flowLogs.TrafficType, sets.List(supportedTrafficTypes)))
+		}
+	}
+	if flowLogs.MaxAggregationInterval != nil && *flowLogs.MaxAggregationInterval != 60 && *flowLogs.MaxAggregationInterval != 600 {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("maxAggregationInterval"), *flowLogs.MaxAggregationInterval, []string{"60", "600"}))
+	}
+
+	return allErrs
+}
+
+// validateSubnetsInVPC validates that every dedicated pod subnet and secondary workers subnet is contained in one of
+// the given VPC CIDR blocks.
+func validateSubnetsInVPC(subnetCIDRs, vpcCIDRs []cidrvalidation.CIDR) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+outer:
+	for _, subnetCIDR := range subnetCIDRs {
+		if !subnetCIDR.Parse() {
+			continue
+		}
+		for _, vpcCIDR := range vpcCIDRs {
+			if vpcCIDR.Parse() && len(vpcCIDR.ValidateSubset(subnetCIDR)) == 0 {
+				continue outer
+			}
+		}
+		allErrs = append(allErrs, field.Invalid(subnetCIDR.GetFieldPath(), subnetCIDR.GetCIDR(), "must be a subset of the VPC CIDR or of one of the secondary CIDR blocks"))
+	}
+
+	return allErrs
+}
+
+// validateVPCEndpoints validates the VPC endpoints. A service may only be configured once, either as entry of
+// `gatewayEndpoints` or of `endpoints`.
+func validateVPCEndpoints(vpc apisaws.VPC, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	services := sets.New[string](vpc.GatewayEndpoints...)
+	for i, endpoint := range vpc.Endpoints {
+		idxPath := fldPath.Index(i)
+		if !gatewayEndpointPattern.MatchString(endpoint.Service) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("service"), endpoint.Service, "must be a valid domain name"))
+		} else if services.Has(endpoint.Service) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("service"), endpoint.Service))
+		}
+		services.Insert(endpoint.Service)
+
+		switch endpoint.Type {
+		case apisaws.VPCEndpointTypeGateway, apisaws.VPCEndpointTypeInterface:
+		default:
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("type"), endpoint.Type, []string{string(apisaws.VPCEndpointTypeGateway), string(apisaws.VPCEndpointTypeInterface)}))
+		}
+	}
+
+	return allErrs
+}
+
+// validateDHCPOptions validates the custom DHCP options of a VPC. AWS accepts up to four DNS and NTP servers each.
+func validateDHCPOptions(options *apisaws.DHCPOptions, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if options.DomainName != nil {
+		for _, msg := range validation.IsDNS1123Subdomain(*options.DomainName) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("domainName"), *options.DomainName, msg))
+		}
+	}
+
+	validateServers := func(servers []string, fldPath *field.Path, allowAmazonProvidedDNS bool) {
+		if len(servers) > 4 {
+			allErrs = append(allErrs, field.TooMany(fldPath, len(servers), 4))
+		}
+		seen := sets.New[string]()
+		for i, server := range servers {
+			idxPath := fldPath.Index(i)
+			if seen.Has(server) {
+				allErrs = append(allErrs, field.Duplicate(idxPath, server))
+				continue
+			}
+			seen.Insert(server)
+			if allowAmazonProvidedDNS && server == "AmazonProvidedDNS" {
+				continue
+			}
+			if net.ParseIP(server) == nil {
+				allErrs = append(allErrs, field.Invalid(idxPath, server, "must be a valid IP address"))
+			}
+		}
+	}
+	validateServers(options.DomainNameServers, fldPath.Child("domainNameServers"), true)
+	validateServers(options.NTPServers, fldPath.Child("ntpServers"), false)
+
+	return allErrs
+}
+
+// validateRoute53Resolver validates the Route 53 Resolver configuration. Every domain name may only be forwarded once.
+func validateRoute53Resolver(resolver *apisaws.Route53Resolver, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if resolver.InboundEndpoint != nil {
+		allowedCIDRsPath := fldPath.Child("inboundEndpoint", "allowedCIDRs")
+		if len(resolver.InboundEndpoint.AllowedCIDRs) == 0 {
+			allErrs = append(allErrs, field.Required(allowedCIDRsPath, "must specify at least one CIDR"))
+		}
+		for i, cidr := range resolver.InboundEndpoint.AllowedCIDRs {
+			if ip, _, err := net.ParseCIDR(cidr); err != nil || ip.To4() == nil {
+				allErrs = append(allErrs, field.Invalid(allowedCIDRsPath.Index(i), cidr, "must be a valid IPv4 CIDR"))
+				continue
+			}
+			allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(allowedCIDRsPath.Index(i), cidr)...)
+		}
+	}
+
+	domainNames := sets.New[string]()
+	for i, rule := range resolver.ForwardingRules {
+		idxPath := fldPath.Child("forwardingRules").Index(i)
+		domainName := strings.TrimSuffix(rule.DomainName, ".")
+		if msgs := validation.IsDNS1123Subdomain(domainName); len(msgs) > 0 {
+			for _, msg := range msgs {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("domainName"), rule.DomainName, msg))
+			}
+		} else if domainNames.Has(domainName) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("domainName"), rule.DomainName))
+		}
+		domainNames.Insert(domainName)
+
+		targetIPsPath := idxPath.Child("targetIPs")
+		if len(rule.TargetIPs) == 0 {
+			allErrs = append(allErrs, field.Required(targetIPsPath, "must specify at least one target IP"))
+		}
+		for j, targetIP := range rule.TargetIPs {
+			if ip := net.ParseIP(targetIP); ip == nil || ip.To4() == nil {
+				allErrs = append(allErrs, field.Invalid(targetIPsPath.Index(j), targetIP, "must be a valid IPv4 address"))
+			}
+		}
+	}
+
+	ruleIDs := sets.New[string]()
+	for i, id := range resolver.RuleIDs {
+		idxPath := fldPath.Child("ruleIDs").Index(i)
+		if !strings.HasPrefix(id, "rslvr-rr-") {
+			allErrs = append(allErrs, field.Invalid(idxPath, id, "must start with rslvr-rr-"))
+		} else if ruleIDs.Has(id) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, id))
+		}
+		ruleIDs.Insert(id)
+	}
+
+	return allErrs
+}
+
+// validateIPFamilies validates the IP families of the infrastructure networks. Only IPv4 and dual-stack (IPv4 and IPv6)
+// are supported.
+func validateIPFamilies(infra *apisaws.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(infra.Networks.IPFamilies) == 0 {
+		return allErrs
+	}
+
+	var (
+		supportedFamilies = []string{string(apisaws.IPFamilyIPv4), string(apisaws.IPFamilyIPv6)}
+		families          = sets.New[apisaws.IPFamily]()
+	)
+	for i, family := range infra.Networks.IPFamilies {
+		idxPath := fldPath.Index(i)
+		switch {
+		case family != apisaws.IPFamilyIPv4 && family != apisaws.IPFamilyIPv6:
+			allErrs = append(allErrs, field.NotSupported(idxPath, family, supportedFamilies))
+		case families.Has(family):
+			allErrs = append(allErrs, field.Duplicate(idxPath, family))
+		}
+		families.Insert(family)
+	}
+
+	if !families.Has(apisaws.IPFamilyIPv4) {
+		allErrs = append(allErrs, field.Invalid(fldPath, infra.Networks.IPFamilies, "must contain IPv4, IPv6-only networks are not supported"))
+	}
+	if infra.DualStack != nil && infra.DualStack.Enabled != families.Has(apisaws.IPFamilyIPv6) {
+		allErrs = append(allErrs, field.Invalid(fldPath, infra.Networks.IPFamilies, "must be consistent with dualStack.enabled"))
+	}
+
+	return allErrs
+}
+
+// validateNATGateway validates the NAT gateway mode and that Elastic IP allocations are only configured for zones which
+// get a NAT gateway.
+// validateZoneTypes validates the types of the zones. Zones which are no regular availability zones don't get NAT
+// gateways of their own, hence the first zone must be a regular one.
+func validateZoneTypes(infra *apisaws.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	supportedTypes := []string{string(apisaws.ZoneTypeAvailabilityZone), string(apisaws.ZoneTypeLocalZone), string(apisaws.ZoneTypeWavelengthZone)}
+	hasEdgeZones := false
+	for i, zone := range infra.Networks.Zones {
+		zoneType := apisawshelper.GetZoneType(infra, zone.Name)
+		zonePath := fldPath.Child("zones").Index(i)
+		switch zoneType {
+		case apisaws.ZoneTypeAvailabilityZone:
+			continue
+		case apisaws.ZoneTypeLocalZone, apisaws.ZoneTypeWavelengthZone:
+		default:
+			allErrs = append(allErrs, field.NotSupported(zonePath.Child("type"), zoneType, supportedTypes))
+			continue
+		}
+
+		hasEdgeZones = true
+		if zone.ElasticIPAllocationID != nil && apisawshelper.GetNATGatewayMode(infra) == apisaws.NATGatewayModePerZone {
+			allErrs = append(allErrs, field.Forbidden(zonePath.Child("elasticIPAllocationID"), fmt.Sprintf("zone has no NAT gateway if the zone type is %s", zoneType)))
+		}
+	}
+
+	if hasEdgeZones && apisawshelper.GetZoneType(infra, infra.Networks.Zones[0].Name) != apisaws.ZoneTypeAvailabilityZone {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("zones").Index(0).Child("type"), fmt.Sprintf("the first zone must be of type %s as its NAT gateway is used by the other zone types", apisaws.ZoneTypeAvailabilityZone)))
+	}
+
+	return allErrs
+}
+
+// validateExistingRouting validates the references to an existing internet gateway and existing route tables, which
+// may only be used together with an existing VPC. A route table can't be shared by several zones, as their default
+// routes point to different NAT gateways.
+func validateExistingRouting(infra *apisaws.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	vpcPath := fldPath.Child("vpc")
+	existingVPC := infra.Networks.VPC.ID != nil
+	if id := infra.Networks.VPC.InternetGatewayID; id != nil {
+		if !existingVPC {
+			allErrs = append(allErrs, field.Forbidden(vpcPath.Child("internetGatewayID"), "must not be set if no existing VPC is used"))
+		} else if !strings.HasPrefix(*id, "igw-") {
+			allErrs = append(allErrs, field.Invalid(vpcPath.Child("internetGatewayID"), *id, "must start with igw-"))
+		}
+	}
+
+	routeTableIDs := sets.New[string]()
+	validateRouteTableID := func(id *string, idPath *field.Path) {
+		if id == nil {
+			return
+		}
+		if !existingVPC {
+			allErrs = append(allErrs, field.Forbidden(idPath, "must not be set if no existing VPC is used"))
+			return
+		}
+		if !strings.HasPrefix(*id, "rtb-") {
+			allErrs = append(allErrs, field.Invalid(idPath, *id, "must start with rtb-"))
+		}
+		if routeTableIDs.Has(*id) {
+			allErrs = append(allErrs, field.Duplicate(idPath, *id))
+		}
+		routeTableIDs.Insert(*id)
+	}
+	validateRouteTableID(infra.Networks.VPC.MainRouteTableID, vpcPath.Child("mainRouteTableID"))
+	for i, zone := range infra.Networks.Zones {
+		validateRouteTableID(zone.RouteTableID, fldPath.Child("zones").Index(i).Child("routeTableID"))
+	}
+
+	return allErrs
+}
+
+// validateSharedVPC validates that a VPC shared via AWS RAM is only used together with settings which don't require
+// the shoot's account to create or modify resources owned by the account of the VPC (subnets, gateways, route tables,
+// network ACLs and CIDR blocks).
+func validateSharedVPC(infra *apisaws.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	vpc := infra.Networks.VPC
+	if !vpc.Shared {
+		return allErrs
+	}
+	vpcPath := fldPath.Child("vpc")
+	if vpc.ID == nil {
+		allErrs = append(allErrs, field.Required(vpcPath.Child("id"), "must be set if a shared VPC is used"))
+	}
+
+	forbidden := func(set bool, fieldPath *field.Path) {
+		if set {
+			allErrs = append(allErrs, field.Forbidden(fieldPath, "must not be set if a shared VPC is used"))
+		}
+	}
+	forbidden(len(vpc.SecondaryCidrBlocks) > 0, vpcPath.Child("secondaryCidrBlocks"))
+	forbidden(len(vpc.GatewayEndpoints) > 0, vpcPath.Child("gatewayEndpoints"))
+	forbidden(len(vpc.Endpoints) > 0, vpcPath.Child("endpoints"))
+	forbidden(vpc.InternetGatewayID != nil, vpcPath.Child("internetGatewayID"))
+	forbidden(vpc.MainRouteTableID != nil, vpcPath.Child("mainRouteTableID"))
+	forbidden(infra.Networks.NATGateway != nil, fldPath.Child("natGateway"))
+	forbidden(infra.Networks.TransitGateway != nil, fldPath.Child("transitGateway"))
+	forbidden(len(infra.Networks.VPCPeerings) > 0, fldPath.Child("vpcPeerings"))
+	forbidden(infra.Networks.NetworkACLs != nil, fldPath.Child("networkACLs"))
+	// elastic IPs of the zones are rejected by the NAT gateway validation, as no NAT gateways are used in a shared VPC
+	for i, zone := range infra.Networks.Zones {
+		zonePath := fldPath.Child("zones").Index(i)
+		forbidden(zone.Pods != nil, zonePath.Child("pods"))
+		forbidden(zone.SecondaryWorkers != nil, zonePath.Child("secondaryWorkers"))
+		forbidden(zone.OutpostARN != nil, zonePath.Child("outpostARN"))
+		forbidden(zone.RouteTableID != nil, zonePath.Child("routeTableID"))
+	}
+
+	return allErrs
+}
+
+func validateNATGateway(infra *apisaws.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	natGateway := infra.Networks.NATGateway
+	if natGateway == nil {
+		return allErrs
+	}
+
+	natGatewayPath := fldPath.Child("natGateway")
+	mode := apisawshelper.GetNATGatewayMode(infra)
+	switch mode {
+	case apisaws.NATGatewayModePerZone:
+	case apisaws.NATGatewayModeSingle, apisaws.NATGatewayModeNone:
+		for i, zone := range infra.Networks.Zones {
+			if zone.ElasticIPAllocationID != nil && apisawshelper.GetNATGatewayZoneName(infra, zone.Name) != zone.Name {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("zones").Index(i).Child("elasticIPAllocationID"), fmt.Sprintf("zone has no NAT gateway if the NAT gateway mode is %s", mode)))
+			}
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(natGatewayPath.Child("mode"), mode, []string{string(apisaws.NATGatewayModePerZone), string(apisaws.NATGatewayModeSingle), string(apisaws.NATGatewayModeNone)}))
+	}
+
+	natGatewayType := apisawshelper.GetNATGatewayType(infra)
+	switch natGatewayType {
+	case apisaws.NATGatewayTypeGateway:
+		if natGateway.Instance != nil {
+			allErrs = append(allErrs, field.Forbidden(natGatewayPath.Child("instance"), fmt.Sprintf("must not be set if the NAT gateway type is %s", natGatewayType)))
+		}
+	case apisaws.NATGatewayTypeInstance:
+		if mode == apisaws.NATGatewayModeNone {
+			allErrs = append(allErrs, field.Forbidden(natGatewayPath.Child("type"), fmt.Sprintf("must not be %s if the NAT gateway mode is %s", natGatewayType, mode)))
+		}
+		instancePath := natGatewayPath.Child("instance")
+		if natGateway.Instance == nil {
+			allErrs = append(allErrs, field.Required(instancePath, fmt.Sprintf("must be set if the NAT gateway type is %s", natGatewayType)))
+		} else {
+			if len(natGateway.Instance.InstanceType) == 0 {
+				allErrs = append(allErrs, field.Required(instancePath.Child("instanceType"), "must specify the instance type"))
+			}
+			if !strings.HasPrefix(natGateway.Instance.AMI, "ami-") {
+				allErrs = append(allErrs, field.Invalid(instancePath.Child("ami"), natGateway.Instance.AMI, "must start with ami-"))
+			}
+		}
+		for i, zone := range infra.Networks.Zones {
+			// zones without NAT gateway are already covered by the mode validation above
+			if zone.ElasticIPAllocationID != nil && apisawshelper.GetNATGatewayZoneName(infra, zone.Name) == zone.Name {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("zones").Index(i).Child("elasticIPAllocationID"), "elastic IP allocations are not supported for NAT instances"))
+			}
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(natGatewayPath.Child("type"), natGatewayType, []string{string(apisaws.NATGatewayTypeGateway), string(apisaws.NATGatewayTypeInstance)}))
+	}
+
+	if pool := natGateway.ElasticIPPool; pool != nil {
+		poolPath := natGatewayPath.Child("elasticIPPool")
+		if natGatewayType != apisaws.NATGatewayTypeGateway {
+			allErrs = append(allErrs, field.Forbidden(poolPath, fmt.Sprintf("is not supported if the NAT gateway type is %s", natGatewayType)))
+		}
+		natGateways := 0
+		for _, zone := range infra.Networks.Zones {
+			if zone.ElasticIPAllocationID == nil && apisawshelper.GetNATGatewayZoneName(infra, zone.Name) == zone.Name {
+				natGateways++
+			}
+		}
+		if pool.Size < 1 {
+			allErrs = append(allErrs, field.Invalid(poolPath.Child("size"), pool.Size, "must be greater than 0"))
+		} else if int(pool.Size) < natGateways {
+			allErrs = append(allErrs, field.Invalid(poolPath.Child("size"), pool.Size, fmt.Sprintf("must not be smaller than the number of NAT gateways without elastic IP allocation (%d)", natGateways)))
+		}
+	}
+
+	return allErrs
+}
+
+// validateNodeSecurityGroupRules validates the additional rules for the nodes security group. Ports are required for
+// `tcp`, `udp` and `icmp` and forbidden for all protocols (`-1`).
+func validateNodeSecurityGroupRules(rules []apisaws.NodeSecurityGroupRule, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	supportedTypes := sets.New(string(apisaws.NodeSecurityGroupRuleTypeIngress), string(apisaws.NodeSecurityGroupRuleTypeEgress))
+	for i, rule := range rules {
+		idxPath := fldPath.Index(i)
+
+		if !supportedTypes.Has(string(rule.Type)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("type"), rule.Type, sets.List(supportedTypes)))
+		}
+		allErrs = append(allErrs, validateRuleProtocolAndPorts(rule.Protocol, rule.FromPort, rule.ToPort, idxPath)...)
+
+		if len(rule.CIDRBlocks) == 0 && len(rule.SecurityGroupIDs) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath, "must specify at least one of cidrBlocks or securityGroupIDs"))
+		}
+		for j, cidr := range rule.CIDRBlocks {
+			cidrPath := idxPath.Child("cidrBlocks").Index(j)
+			if ip, _, err := net.ParseCIDR(cidr); err != nil || ip.To4() == nil {
+				allErrs = append(allErrs, field.Invalid(cidrPath, cidr, "must be a valid IPv4 CIDR"))
+				continue
+			}
+			allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(cidrPath, cidr)...)
+		}
+		for j, id := range rule.SecurityGroupIDs {
+			if !strings.HasPrefix(id, "sg-") {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("securityGroupIDs").Index(j), id, "must start with sg-"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateNetworkACL validates the rules of a network ACL. The rule numbers must be unique per type.
+func validateNetworkACL(acl *apisaws.NetworkACL, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if acl == nil {
+		return allErrs
+	}
+
+	supportedTypes := sets.New(string(apisaws.NetworkACLRuleTypeIngress), string(apisaws.NetworkACLRuleTypeEgress))
+	supportedActions := sets.New(string(apisaws.NetworkACLRuleActionAllow), string(apisaws.NetworkACLRuleActionDeny))
+	ruleNumbers := map[apisaws.NetworkACLRuleType]sets.Set[int32]{}
+	rulesPath := fldPath.Child("rules")
+	for i, rule := range acl.Rules {
+		idxPath := rulesPath.Index(i)
+
+		// the rule number 32767 is reserved for the rules denying all traffic, which are part of every network ACL
+		if rule.RuleNumber < 1 || rule.RuleNumber > 32766 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("ruleNumber"), rule.RuleNumber, "must be between 1 and 32766"))
+		}
+		if !supportedTypes.Has(string(rule.Type)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("type"), rule.Type, sets.List(supportedTypes)))
+		} else {
+			if ruleNumbers[rule.Type] == nil {
+				ruleNumbers[rule.Type] = sets.New[int32]()
+			}
+			if ruleNumbers[rule.Type].Has(rule.RuleNumber) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("ruleNumber"), rule.RuleNumber))
+			}
+			ruleNumbers[rule.Type].Insert(rule.RuleNumber)
+		}
+		if !supportedActions.Has(string(rule.Action)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("action"), rule.Action, sets.List(supportedActions)))
+		}
+		allErrs = append(allErrs, validateRuleProtocolAndPorts(rule.Protocol, rule.FromPort, rule.ToPort, idxPath)...)
+
+		cidrPath := idxPath.Child("cidrBlock")
+		if _, _, err := net.ParseCIDR(rule.CIDRBlock); err != nil {
+			allErrs = append(allErrs, field.Invalid(cidrPath, rule.CIDRBlock, "must be a valid IPv4 or IPv6 CIDR"))
+		} else {
+			allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(cidrPath, rule.CIDRBlock)...)
+		}
+	}
+
+	return allErrs
+}
+
+// validateRuleProtocolAndPorts validates the protocol and the ports of a security group or network ACL rule. Ports are
+// required for `tcp`, `udp` and `icmp` and forbidden for all protocols (`-1`).
+func validateRuleProtocolAndPorts(protocol string, fromPort, toPort *int64, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch protocol {
+	case "-1":
+		if fromPort != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("fromPort"), "must not be set for all protocols"))
+		}
+		if toPort != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("toPort"), "must not be set for all protocols"))
+		}
+	case "tcp", "udp", "icmp":
+		lower, upper := int64(0), int64(65535)
+		if protocol == "icmp" {
+			lower, upper = -1, 255
+		}
+		if fromPort == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("fromPort"), fmt.Sprintf("must be set for protocol %s", protocol)))
+		} else if *fromPort < lower || *fromPort > upper {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("fromPort"), *fromPort, fmt.Sprintf("must be between %d and %d", lower, upper)))
+		}
+		if toPort == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("toPort"), fmt.Sprintf("must be set for protocol %s", protocol)))
+		} else if *toPort < lower || *toPort > upper {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("toPort"), *toPort, fmt.Sprintf("must be between %d and %d", lower, upper)))
+		}
+		if protocol != "icmp" && fromPort != nil && toPort != nil && *fromPort > *toPort {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("toPort"), *toPort, "must not be less than fromPort"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("protocol"), protocol, []string{"-1", "icmp", "tcp", "udp"}))
+	}
+
+	return allErrs
+}
+
+// validateTransitGateway validates the transit gateway configuration. If allowDefaultRoute is true, the default route
+// `0.0.0.0/0` may be routed via the transit gateway as egress for the private subnets.
+func validateTransitGateway(tgw *apisaws.TransitGateway, fldPath *field.Path, routed sets.Set[string], allowDefaultRoute bool, shootCIDRs []cidrvalidation.CIDR) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !strings.HasPrefix(tgw.ID, "tgw-") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("id"), tgw.ID, "must start with tgw-"))
+	}
+	allErrs = append(allErrs, validateRoutes(tgw.Routes, fldPath.Child("routes"), routed, allowDefaultRoute, shootCIDRs)...)
+
+	return allErrs
+}
+
+// validateVPCPeerings validates the VPC peering configurations. Every peer VPC may only be peered once and must not be
+// the VPC of the shoot itself.
+func validateVPCPeerings(peerings []apisaws.VPCPeering, vpcID *string, fldPath *field.Path, routed sets.Set[string], shootCIDRs []cidrvalidation.CIDR) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	peerVPCIDs := sets.New[string]()
+	for i, peering := range peerings {
+		idxPath := fldPath.Index(i)
+
+		peerVPCIDPath := idxPath.Child("peerVPCID")
+		if !strings.HasPrefix(peering.PeerVPCID, "vpc-") {
+			allErrs = append(allErrs, field.Invalid(peerVPCIDPath, peering.PeerVPCID, "must start with vpc-"))
+		} else if vpcID != nil && peering.PeerVPCID == *vpcID {
+			allErrs = append(allErrs, field.Invalid(peerVPCIDPath, peering.PeerVPCID, "must not be the VPC of the shoot"))
+		} else if peerVPCIDs.Has(peering.PeerVPCID) {
+			allErrs = append(allErrs, field.Duplicate(peerVPCIDPath, peering.PeerVPCID))
+		}
+		peerVPCIDs.Insert(peering.PeerVPCID)
+
+		if peering.PeerAccountID != nil && !accountIDPattern.MatchString(*peering.PeerAccountID) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("peerAccountID"), *peering.PeerAccountID, "must be a 12-digit AWS account id"))
+		}
+		if peering.PeerRegion != nil && !regionPattern.MatchString(*peering.PeerRegion) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("peerRegion"), *peering.PeerRegion, "must be a valid AWS region"))
+		}
+
+		routesPath := idxPath.Child("routes")
+		if len(peering.Routes) == 0 {
+			allErrs = append(allErrs, field.Required(routesPath, "at least one route must be specified"))
+		}
+		allErrs = append(allErrs, validateRoutes(peering.Routes, routesPath, routed, false, shootCIDRs)...)
+	}
+
+	return allErrs
+}
+
+// validateRoutes validates destination CIDRs which are routed from the private subnets to a target outside of the VPC.
+// The routed CIDRs must not overlap with any of the shoot networks as they would otherwise shadow cluster-internal
+// traffic, and every CIDR may only be routed to a single target, i.e. it must not be contained in routed yet.
+func validateRoutes(routes []string, fldPath *field.Path, routed sets.Set[string], allowDefaultRoute bool, shootCIDRs []cidrvalidation.CIDR) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, route := range routes {
+		idxPath := fldPath.Index(i)
+		if routed.Has(route) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, route))
+			continue
+		}
+		routed.Insert(route)
+
+		routeCIDR := cidrvalidation.NewCIDR(route, idxPath)
+		if errs := routeCIDR.ValidateParse(); len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+			continue
+		}
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(idxPath, route)...)
+		if allowDefaultRoute && route == "0.0.0.0/0" {
+			continue
+		}
+		for _, other := range shootCIDRs {
+			allErrs = append(allErrs, other.ValidateNotOverlap(routeCIDR)...)
+		}
+	}
 
 	return allErrs
 }
 
-// ValidateInfrastructureConfigUpdate validates a InfrastructureConfig object.
-func ValidateInfrastructureConfigUpdate(oldConfig, newConfig *apisaws.InfrastructureConfig) field.ErrorList {
+// ValidateInfrastructureConfigUpdate validates a InfrastructureConfig object. Zones can only be removed if the
+// infrastructure is reconciled with flow, as the Terraformer would recreate the resources of all following zones.
+func ValidateInfrastructureConfigUpdate(oldConfig, newConfig *apisaws.InfrastructureConfig, useFlow bool) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	vpcPath := field.NewPath("networks.vpc")
@@ -188,27 +964,91 @@ func ValidateInfrastructureConfigUpdate(oldConfig, newConfig *apisaws.Infrastruc
 	newVPC := newConfig.Networks.VPC
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newVPC.ID, oldVPC.ID, vpcPath.Child("id"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newVPC.CIDR, oldVPC.CIDR, vpcPath.Child("cidr"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newVPC.MainRouteTableID, oldVPC.MainRouteTableID, vpcPath.Child("mainRouteTableID"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newVPC.Shared, oldVPC.Shared, vpcPath.Child("shared"))...)
+
+	var oldNodesInstanceProfile, newNodesInstanceProfile *apisaws.IAMInstanceProfile
+	if oldConfig.IAM != nil {
+		oldNodesInstanceProfile = oldConfig.IAM.NodesInstanceProfile
+	}
+	if newConfig.IAM != nil {
+		newNodesInstanceProfile = newConfig.IAM.NodesInstanceProfile
+	}
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newNodesInstanceProfile, oldNodesInstanceProfile, field.NewPath("iam", "nodesInstanceProfile"))...)
 
 	var (
 		oldZones = oldConfig.Networks.Zones
 		newZones = newConfig.Networks.Zones
 	)
 
-	if len(oldZones) > len(newZones) {
-		allErrs = append(allErrs, field.Forbidden(field.NewPath("networks.zones"), "removing zones is not allowed"))
-		return allErrs
+	// Zones are matched by their names, so that zones can be added and removed. The first zone can't be removed or
+	// replaced, as its NAT gateway is used by the other zones in some NAT gateway modes and by Local Zones. New zones
+	// must be added after the existing ones, as the Terraformer identifies the resources of the zones by their index.
+	zonesPath := field.NewPath("networks.zones")
+	if len(oldZones) > 0 && len(newZones) > 0 {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newZones[0].Name, oldZones[0].Name, zonesPath.Index(0).Child("name"))...)
+	}
+
+	newZoneIndices := make(map[string]int, len(newZones))
+	for i, newZone := range newZones {
+		newZoneIndices[newZone.Name] = i
+	}
+	lastIndex := -1
+	for _, oldZone := range oldZones {
+		i, ok := newZoneIndices[oldZone.Name]
+		if !ok {
+			if !useFlow {
+				allErrs = append(allErrs, field.Forbidden(zonesPath, fmt.Sprintf("zone %s can only be removed with the flow infrastructure reconciler (annotation %s=true)", oldZone.Name, apisaws.AnnotationKeyUseFlow)))
+			}
+			continue
+		}
+		idxPath := zonesPath.Index(i)
+		if i < lastIndex {
+			allErrs = append(allErrs, field.Forbidden(idxPath, "the order of the zones can't be changed"))
+		}
+		lastIndex = max(lastIndex, i)
+
+		newZone := newZones[i]
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(oldZone.Public, newZone.Public, idxPath.Child("public"))...)
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(oldZone.Internal, newZone.Internal, idxPath.Child("internal"))...)
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(oldZone.Workers, newZone.Workers, idxPath.Child("workers"))...)
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(apisawshelper.GetZoneType(newConfig, oldZone.Name), apisawshelper.GetZoneType(oldConfig, oldZone.Name), idxPath.Child("type"))...)
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newZone.OutpostARN, oldZone.OutpostARN, idxPath.Child("outpostARN"))...)
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newZone.RouteTableID, oldZone.RouteTableID, idxPath.Child("routeTableID"))...)
+		if oldZone.Pods != nil {
+			allErrs = append(allErrs, apivalidation.ValidateImmutableField(newZone.Pods, oldZone.Pods, idxPath.Child("pods"))...)
+		}
+		if oldZone.SecondaryWorkers != nil {
+			allErrs = append(allErrs, apivalidation.ValidateImmutableField(newZone.SecondaryWorkers, oldZone.SecondaryWorkers, idxPath.Child("secondaryWorkers"))...)
+		}
+	}
+	for i := 0; i < lastIndex; i++ {
+		if !hasZone(oldZones, newZones[i].Name) {
+			allErrs = append(allErrs, field.Forbidden(zonesPath.Index(i), "new zones must be added after the existing zones"))
+		}
+	}
+
+	if oldConfig.Networks.NATGateway != nil && oldConfig.Networks.NATGateway.ElasticIPPool != nil {
+		poolPath := field.NewPath("networks", "natGateway", "elasticIPPool")
+		oldPool := oldConfig.Networks.NATGateway.ElasticIPPool
+		if newConfig.Networks.NATGateway == nil || newConfig.Networks.NATGateway.ElasticIPPool == nil {
+			allErrs = append(allErrs, field.Forbidden(poolPath, "the elastic IP pool can't be removed"))
+		} else if newPool := newConfig.Networks.NATGateway.ElasticIPPool; newPool.Size < oldPool.Size {
+			allErrs = append(allErrs, field.Forbidden(poolPath.Child("size"), fmt.Sprintf("the size of the elastic IP pool can't be decreased from %d", oldPool.Size)))
+		}
 	}
 
-	for i, oldZone := range oldZones {
-		idxPath := field.NewPath("networks.zones").Index(i)
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(oldZone.Name, newConfig.Networks.Zones[i].Name, idxPath.Child("name"))...)
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(oldZone.Public, newConfig.Networks.Zones[i].Public, idxPath.Child("public"))...)
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(oldZone.Internal, newConfig.Networks.Zones[i].Internal, idxPath.Child("internal"))...)
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(oldZone.Workers, newConfig.Networks.Zones[i].Workers, idxPath.Child("workers"))...)
+	newSecondaryCidrBlocks := sets.New(newVPC.SecondaryCidrBlocks...)
+	for _, cidr := range oldVPC.SecondaryCidrBlocks {
+		if !newSecondaryCidrBlocks.Has(cidr) {
+			allErrs = append(allErrs, field.Forbidden(vpcPath.Child("secondaryCidrBlocks"), fmt.Sprintf("secondary CIDR block %q can't be removed", cidr)))
+		}
 	}
 	if oldConfig.DualStack != nil && oldConfig.DualStack.Enabled && (newConfig.DualStack == nil || !newConfig.DualStack.Enabled) {
 		dualStackPath := field.NewPath("dualStack.enabled")
 		allErrs = append(allErrs, field.Forbidden(dualStackPath, "field can't be changed from \"true\" to \"false\""))
+	} else if apisawshelper.IsDualStack(oldConfig) && !apisawshelper.IsDualStack(newConfig) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("networks.ipFamilies"), "IPv6 can't be removed once it has been enabled"))
 	}
 	return allErrs
 }
@@ -256,6 +1096,59 @@ func ValidateIgnoreTags(fldPath *field.Path, ignoreTags *apisaws.IgnoreTags) fie
 	return allErrs
 }
 
+const (
+	// maxTags is the maximum number of additional tags. AWS allows 50 tags per resource, the remaining ones are
+	// reserved for the tags managed by Gardener and the cloud-controller-manager.
+	maxTags         = 40
+	maxTagKeyLength = 128
+	maxTagValLength = 256
+	awsTagKeyPrefix = "aws:"
+)
+
+// ValidateTags validates that the given additional tags are valid AWS tags and don't use any reserved tag keys and
+// prefixes.
+func ValidateTags(fldPath *field.Path, tags map[string]string) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(tags) > maxTags {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(tags), maxTags))
+	}
+
+	for _, key := range sets.List(sets.KeySet(tags)) {
+		keyPath := fldPath.Key(key)
+		if key == "" || len(key) > maxTagKeyLength {
+			allErrs = append(allErrs, field.Invalid(keyPath, key, fmt.Sprintf("key must have between 1 and %d characters", maxTagKeyLength)))
+			continue
+		}
+		if len(tags[key]) > maxTagValLength {
+			allErrs = append(allErrs, field.TooLong(keyPath, tags[key], maxTagValLength))
+		}
+		// the tags are passed as comma separated key value pairs to the CSI driver and the load balancer controllers
+		if strings.ContainsAny(key, ",=") || strings.Contains(tags[key], ",") {
+			allErrs = append(allErrs, field.Invalid(keyPath, key, "key must not contain ',' or '=' and value must not contain ','"))
+			continue
+		}
+		if strings.HasPrefix(strings.ToLower(key), awsTagKeyPrefix) {
+			allErrs = append(allErrs, field.Invalid(keyPath, key, fmt.Sprintf("must not use key with reserved prefix %q", awsTagKeyPrefix)))
+			continue
+		}
+		for _, reserved := range reservedTagKeys {
+			if key == reserved {
+				allErrs = append(allErrs, field.Invalid(keyPath, key, fmt.Sprintf("must not use reserved key %q", reserved)))
+				break
+			}
+		}
+		for _, reserved := range reservedTagKeyPrefixes {
+			if strings.HasPrefix(key, reserved) {
+				allErrs = append(allErrs, field.Invalid(keyPath, key, fmt.Sprintf("must not use key with reserved prefix %q", reserved)))
+				break
+			}
+		}
+	}
+
+	return allErrs
+}
+
 func validateKeyIsReserved(fldPath *field.Path, key string) field.ErrorList {
 	allErrs := field.ErrorList{}
 	for _, reserved := range reservedTagKeys {
@@ -303,3 +1196,12 @@ func validatePrefixMatchesReservedPrefix(fldPath *field.Path, prefix string) fie
 	}
 	return allErrs
 }
+
+func hasZone(zones []apisaws.Zone, name string) bool {
+	for _, zone := range zones {
+		if zone.Name == name {
+			return true
+		}
+	}
+	return false
+}
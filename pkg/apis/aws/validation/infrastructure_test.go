@@ -15,6 +15,9 @@
 package validation_test
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
@@ -23,6 +26,7 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/pointer"
+	"k8s.io/utils/ptr"
 
 	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 	. "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/validation"
@@ -395,6 +399,100 @@ var _ = Describe("InfrastructureConfig validation", func() {
 				errorList = ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
 				Expect(errorList).To(BeEmpty())
 			})
+
+			It("should ensure that the zone ID is valid", func() {
+				infrastructureConfig.Networks.Zones[0].ZoneID = pointer.String("eu-west-1a")
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[0].zoneID"),
+				}))
+
+				for _, zoneID := range []string{"use1-az1", "euw1-az3", "usw2-lax1-az1"} {
+					infrastructureConfig.Networks.Zones[0].ZoneID = pointer.String(zoneID)
+					errorList = ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+					Expect(errorList).To(BeEmpty())
+				}
+			})
+
+			It("should forbid assigning the same zone ID to multiple zones", func() {
+				infrastructureConfig.Networks.Zones = append(infrastructureConfig.Networks.Zones, awsZone2)
+				infrastructureConfig.Networks.Zones[0].ZoneID = pointer.String("euw1-az1")
+				infrastructureConfig.Networks.Zones[1].ZoneID = pointer.String("euw1-az1")
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.zones[1].zoneID"),
+				}))
+			})
+		})
+
+		Context("zone types", func() {
+			It("should allow edge zones after a regular availability zone", func() {
+				localZone := awsZone2
+				localZone.Type = ptr.To(apisaws.ZoneTypeLocalZone)
+				infrastructureConfig.Networks.Zones = append(infrastructureConfig.Networks.Zones, localZone)
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)).To(BeEmpty())
+			})
+
+			It("should forbid unsupported zone types", func() {
+				infrastructureConfig.Networks.Zones[0].Type = ptr.To(apisaws.ZoneType("outpost"))
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.zones[0].type"),
+				}))
+			})
+
+			It("should forbid an edge zone as first zone and elastic IPs for edge zones", func() {
+				infrastructureConfig.Networks.Zones[0].Type = ptr.To(apisaws.ZoneTypeWavelengthZone)
+				infrastructureConfig.Networks.Zones[0].ElasticIPAllocationID = pointer.String("eipalloc-123456")
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones[0].elasticIPAllocationID"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones[0].type"),
+				}))
+			})
+		})
+
+		Context("outposts", func() {
+			const outpostARN = "arn:aws:outposts:eu-west-1:123456789012:outpost/op-0123456789abcdef0"
+
+			It("should allow placing a zone on an Outpost", func() {
+				infrastructureConfig.Networks.Zones[0].OutpostARN = pointer.String(outpostARN)
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)).To(BeEmpty())
+			})
+
+			It("should forbid invalid Outpost ARNs", func() {
+				infrastructureConfig.Networks.Zones[0].OutpostARN = pointer.String("op-0123456789abcdef0")
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[0].outpostARN"),
+				}))
+			})
+
+			It("should forbid Outposts in edge zones", func() {
+				localZone := awsZone2
+				localZone.Type = ptr.To(apisaws.ZoneTypeLocalZone)
+				localZone.OutpostARN = pointer.String(outpostARN)
+				infrastructureConfig.Networks.Zones = append(infrastructureConfig.Networks.Zones, localZone)
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones[1].outpostARN"),
+				}))
+			})
 		})
 
 		Context("gatewayEndpoints", func() {
@@ -421,142 +519,1431 @@ var _ = Describe("InfrastructureConfig validation", func() {
 			})
 		})
 
-		Context("ignoreTags", func() {
-			It("should forbid ignoring reserved tags", func() {
-				infrastructureConfig.IgnoreTags = &apisaws.IgnoreTags{
-					Keys:        []string{"Name"},
-					KeyPrefixes: []string{"kubernetes.io/", "gardener.cloud/"},
-				}
+		Context("secondaryCidrBlocks", func() {
+			It("should accept secondary CIDR blocks with dedicated pod subnets", func() {
+				infrastructureConfig.Networks.VPC.SecondaryCidrBlocks = []string{"100.80.0.0/16"}
+				infrastructureConfig.Networks.Zones[0].Pods = pointer.String("100.80.0.0/18")
 				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
-				Expect(errorList).NotTo(BeEmpty())
+				Expect(errorList).To(BeEmpty())
 			})
-		})
-	})
 
-	Describe("#ValidateInfrastructureConfigUpdate", func() {
-		It("should return no errors for an unchanged config", func() {
-			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, infrastructureConfig)).To(BeEmpty())
-		})
-
-		It("should allow adding a zone", func() {
-			newInfrastructureConfig := infrastructureConfig.DeepCopy()
-			newInfrastructureConfig.Networks.Zones = append(newInfrastructureConfig.Networks.Zones, awsZone2)
+			It("should accept dedicated pod subnets in the VPC CIDR", func() {
+				infrastructureConfig.Networks.Zones[0].Pods = pointer.String("10.251.0.0/18")
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(BeEmpty())
+			})
 
-			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)
+			It("should reject secondary CIDR blocks overlapping with the VPC CIDR or the services network", func() {
+				infrastructureConfig.Networks.VPC.SecondaryCidrBlocks = []string{"10.1.0.0/16", "100.64.0.0/16"}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.vpc.secondaryCidrBlocks[0]"),
+					"Detail": Equal(`must not overlap with "networks.vpc.cidr" ("10.0.0.0/8")`),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.vpc.secondaryCidrBlocks[1]"),
+					"Detail": Equal(`must not overlap with "networking.services" ("100.64.0.0/13")`),
+				}))
+			})
 
-			Expect(errorList).To(BeEmpty())
+			It("should reject dedicated pod subnets outside of the VPC CIDR blocks or overlapping with other subnets", func() {
+				infrastructureConfig.Networks.VPC.SecondaryCidrBlocks = []string{"100.80.0.0/16"}
+				infrastructureConfig.Networks.Zones[0].Pods = pointer.String("192.168.0.0/24")
+				infrastructureConfig.Networks.Zones = append(infrastructureConfig.Networks.Zones, awsZone2)
+				infrastructureConfig.Networks.Zones[1].Pods = pointer.String("10.250.4.0/24")
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.zones[0].pods"),
+					"Detail": Equal("must be a subset of the VPC CIDR or of one of the secondary CIDR blocks"),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.zones[1].internal"),
+					"Detail": Equal(`must not overlap with "networks.zones[1].pods" ("10.250.4.0/24")`),
+				}))
+			})
 		})
 
-		It("should allow changing gateway endpoints inside vpc", func() {
-			newInfraConfig := infrastructureConfig.DeepCopy()
-			newInfraConfig.Networks.VPC.GatewayEndpoints = []string{"myep"}
-			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfraConfig)).To(BeEmpty())
+		Context("secondaryWorkers", func() {
+			It("should accept secondary workers subnets in the nodes network", func() {
+				infrastructureConfig.Networks.Zones[0].SecondaryWorkers = pointer.String("10.250.16.0/20")
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should reject secondary workers subnets outside of the nodes network or overlapping with other subnets", func() {
+				infrastructureConfig.Networks.Zones[0].SecondaryWorkers = pointer.String("10.251.0.0/24")
+				infrastructureConfig.Networks.Zones = append(infrastructureConfig.Networks.Zones, awsZone2)
+				infrastructureConfig.Networks.Zones[1].SecondaryWorkers = pointer.String("10.250.6.0/24")
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.zones[0].secondaryWorkers"),
+					"Detail": Equal(`must be a subset of "networking.nodes" ("10.250.0.0/16")`),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.zones[1].workers"),
+					"Detail": Equal(`must not overlap with "networks.zones[1].secondaryWorkers" ("10.250.6.0/24")`),
+				}))
+			})
 		})
 
-		It("should forbid changing the VPC ID", func() {
-			newInfrastructureConfig := infrastructureConfig.DeepCopy()
-			newid := "the-new-id"
-			newInfrastructureConfig.Networks.VPC.ID = &newid
+		Context("endpoints", func() {
+			It("should accept gateway and interface endpoints", func() {
+				infrastructureConfig.Networks.VPC.GatewayEndpoints = []string{"s3"}
+				infrastructureConfig.Networks.VPC.Endpoints = []apisaws.VPCEndpoint{
+					{Service: "dynamodb", Type: apisaws.VPCEndpointTypeGateway},
+					{Service: "ecr.api", Type: apisaws.VPCEndpointTypeInterface},
+					{Service: "ecr.dkr", Type: apisaws.VPCEndpointTypeInterface},
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(BeEmpty())
+			})
 
-			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)
+			It("should reject invalid service names and types", func() {
+				infrastructureConfig.Networks.VPC.Endpoints = []apisaws.VPCEndpoint{
+					{Service: "my-endpoint", Type: apisaws.VPCEndpointTypeInterface},
+					{Service: "ec2", Type: "Foo"},
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":     Equal(field.ErrorTypeInvalid),
+					"Field":    Equal("networks.vpc.endpoints[0].service"),
+					"BadValue": Equal("my-endpoint"),
+				}, Fields{
+					"Type":     Equal(field.ErrorTypeNotSupported),
+					"Field":    Equal("networks.vpc.endpoints[1].type"),
+					"BadValue": Equal(apisaws.VPCEndpointType("Foo")),
+				}))
+			})
 
-			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
-				"Type":  Equal(field.ErrorTypeInvalid),
-				"Field": Equal("networks.vpc.id"),
-			}))))
+			It("should reject services which are configured more than once", func() {
+				infrastructureConfig.Networks.VPC.GatewayEndpoints = []string{"s3"}
+				infrastructureConfig.Networks.VPC.Endpoints = []apisaws.VPCEndpoint{
+					{Service: "s3", Type: apisaws.VPCEndpointTypeInterface},
+					{Service: "sts", Type: apisaws.VPCEndpointTypeInterface},
+					{Service: "sts", Type: apisaws.VPCEndpointTypeInterface},
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.vpc.endpoints[0].service"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.vpc.endpoints[2].service"),
+				}))
+			})
 		})
 
-		It("should forbid changing the VPC CIDR", func() {
-			newInfrastructureConfig := infrastructureConfig.DeepCopy()
-			newCIDR := "1.2.3.4/5"
-			newInfrastructureConfig.Networks.VPC.CIDR = &newCIDR
+		Context("ipFamilies", func() {
+			It("should allow IPv4 and dual-stack", func() {
+				infrastructureConfig.Networks.IPFamilies = []apisaws.IPFamily{apisaws.IPFamilyIPv4}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)).To(BeEmpty())
 
-			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)
+				infrastructureConfig.Networks.IPFamilies = []apisaws.IPFamily{apisaws.IPFamilyIPv4, apisaws.IPFamilyIPv6}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)).To(BeEmpty())
+			})
 
-			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
-				"Type":  Equal(field.ErrorTypeInvalid),
-				"Field": Equal("networks.vpc.cidr"),
-			}))))
-		})
+			It("should forbid unsupported and duplicate ip families", func() {
+				infrastructureConfig.Networks.IPFamilies = []apisaws.IPFamily{apisaws.IPFamilyIPv4, "foo", apisaws.IPFamilyIPv4}
 
-		It("should forbid changing the internal network of a zone", func() {
-			newInfrastructureConfig := infrastructureConfig.DeepCopy()
-			newInfrastructureConfig.Networks.Zones[0].Internal = awsZone2.Internal
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.ipFamilies[1]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.ipFamilies[2]"),
+				}))
+			})
 
-			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)
+			It("should forbid IPv6-only", func() {
+				infrastructureConfig.Networks.IPFamilies = []apisaws.IPFamily{apisaws.IPFamilyIPv6}
 
-			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
-				"Type":  Equal(field.ErrorTypeInvalid),
-				"Field": Equal("networks.zones[0].internal"),
-			}))))
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.ipFamilies"),
+					"Detail": Equal("must contain IPv4, IPv6-only networks are not supported"),
+				}))
+			})
+
+			It("should forbid ip families inconsistent with dualStack.enabled", func() {
+				infrastructureConfig.DualStack = &apisaws.DualStack{Enabled: true}
+				infrastructureConfig.Networks.IPFamilies = []apisaws.IPFamily{apisaws.IPFamilyIPv4}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.ipFamilies"),
+					"Detail": Equal("must be consistent with dualStack.enabled"),
+				}))
+			})
 		})
 
-		It("should forbid changing the public network of a zone", func() {
-			newInfrastructureConfig := infrastructureConfig.DeepCopy()
-			newInfrastructureConfig.Networks.Zones[0].Public = awsZone2.Public
+		Context("natGateway", func() {
+			BeforeEach(func() {
+				infrastructureConfig.Networks.Zones = append(infrastructureConfig.Networks.Zones, awsZone2)
+				infrastructureConfig.Networks.Zones[0].ElasticIPAllocationID = pointer.String("eipalloc-123456")
+			})
 
-			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)
+			It("should accept the supported modes", func() {
+				for _, mode := range []apisaws.NATGatewayMode{apisaws.NATGatewayModePerZone, apisaws.NATGatewayModeSingle} {
+					infrastructureConfig.Networks.NATGateway = &apisaws.NATGateway{Mode: &mode}
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+					Expect(errorList).To(BeEmpty())
+				}
+			})
 
-			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
-				"Type":  Equal(field.ErrorTypeInvalid),
-				"Field": Equal("networks.zones[0].public"),
-			}))))
-		})
+			It("should forbid unsupported modes", func() {
+				mode := apisaws.NATGatewayMode("foo")
+				infrastructureConfig.Networks.NATGateway = &apisaws.NATGateway{Mode: &mode}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.natGateway.mode"),
+				}))
+			})
 
-		It("should forbid changing the workers network of a zone", func() {
-			newInfrastructureConfig := infrastructureConfig.DeepCopy()
-			newInfrastructureConfig.Networks.Zones[0].Workers = awsZone2.Workers
+			It("should forbid elastic IPs for zones without NAT gateway in mode single", func() {
+				mode := apisaws.NATGatewayModeSingle
+				infrastructureConfig.Networks.NATGateway = &apisaws.NATGateway{Mode: &mode}
+				infrastructureConfig.Networks.Zones[1].ElasticIPAllocationID = pointer.String("eipalloc-654321")
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("networks.zones[1].elasticIPAllocationID"),
+					"Detail": Equal("zone has no NAT gateway if the NAT gateway mode is single"),
+				}))
+			})
 
-			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)
+			It("should forbid elastic IPs for all zones in mode none", func() {
+				mode := apisaws.NATGatewayModeNone
+				infrastructureConfig.Networks.NATGateway = &apisaws.NATGateway{Mode: &mode}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones[0].elasticIPAllocationID"),
+				}))
+			})
 
-			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
-				"Type":  Equal(field.ErrorTypeInvalid),
-				"Field": Equal("networks.zones[0].workers"),
-			}))))
-		})
+			It("should allow the default route via the transit gateway only in mode none", func() {
+				infrastructureConfig.Networks.Zones[0].ElasticIPAllocationID = nil
+				infrastructureConfig.Networks.TransitGateway = &apisaws.TransitGateway{
+					ID:     "tgw-123456",
+					Routes: []string{"0.0.0.0/0"},
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).NotTo(BeEmpty())
 
-		It("should allow changing the elastic IP allocation ID of a zone", func() {
-			newInfrastructureConfig := infrastructureConfig.DeepCopy()
-			newInfrastructureConfig.Networks.Zones[0].ElasticIPAllocationID = pointer.String("some-id")
+				mode := apisaws.NATGatewayModeNone
+				infrastructureConfig.Networks.NATGateway = &apisaws.NATGateway{Mode: &mode}
+				errorList = ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(BeEmpty())
+			})
 
-			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)
+			Context("type instance", func() {
+				var natGatewayType apisaws.NATGatewayType
+
+				BeforeEach(func() {
+					natGatewayType = apisaws.NATGatewayTypeInstance
+					infrastructureConfig.Networks.Zones[0].ElasticIPAllocationID = nil
+					infrastructureConfig.Networks.NATGateway = &apisaws.NATGateway{
+						Type: &natGatewayType,
+						Instance: &apisaws.NATInstance{
+							InstanceType: "t3.small",
+							AMI:          "ami-123456",
+						},
+					}
+				})
 
-			Expect(errorList).To(BeEmpty())
-		})
+				It("should accept a valid NAT instance configuration", func() {
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+					Expect(errorList).To(BeEmpty())
+				})
 
-		It("should forbid removing a zone", func() {
-			infrastructureConfig.Networks.Zones = append(infrastructureConfig.Networks.Zones, awsZone2)
-			newInfrastructureConfig := infrastructureConfig.DeepCopy()
-			newInfrastructureConfig.Networks.Zones = newInfrastructureConfig.Networks.Zones[:1]
+				It("should forbid unsupported types", func() {
+					natGatewayType = "foo"
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("networks.natGateway.type"),
+					}))
+				})
 
-			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)
+				It("should forbid the instance configuration for type gateway", func() {
+					natGatewayType = apisaws.NATGatewayTypeGateway
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("networks.natGateway.instance"),
+					}))
+				})
 
-			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
-				"Type":  Equal(field.ErrorTypeForbidden),
-				"Field": Equal("networks.zones"),
-			}))))
-		})
+				It("should require the instance configuration", func() {
+					infrastructureConfig.Networks.NATGateway.Instance = nil
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("networks.natGateway.instance"),
+					}))
+				})
 
-		It("should allow adding a zone but forbid changing one", func() {
-			newInfrastructureConfig := infrastructureConfig.DeepCopy()
-			newInfrastructureConfig.Networks.Zones = append(newInfrastructureConfig.Networks.Zones, awsZone2)
-			newInfrastructureConfig.Networks.Zones[0].Name = "zone3"
+				It("should validate the instance type and AMI", func() {
+					infrastructureConfig.Networks.NATGateway.Instance = &apisaws.NATInstance{AMI: "foo"}
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("networks.natGateway.instance.instanceType"),
+					}, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.natGateway.instance.ami"),
+					}))
+				})
 
-			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)
+				It("should forbid NAT instances in mode none", func() {
+					mode := apisaws.NATGatewayModeNone
+					infrastructureConfig.Networks.NATGateway.Mode = &mode
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("networks.natGateway.type"),
+					}))
+				})
 
-			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
-				"Type":  Equal(field.ErrorTypeInvalid),
-				"Field": Equal("networks.zones[0].name"),
-			}))))
-		})
+				It("should forbid elastic IPs", func() {
+					infrastructureConfig.Networks.Zones[1].ElasticIPAllocationID = pointer.String("eipalloc-654321")
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("networks.zones[1].elasticIPAllocationID"),
+						"Detail": Equal("elastic IP allocations are not supported for NAT instances"),
+					}))
+				})
+			})
 
-		It("should forbid changing the order of zones", func() {
-			infrastructureConfig.Networks.Zones = append(infrastructureConfig.Networks.Zones, awsZone2)
-			newInfrastructureConfig := infrastructureConfig.DeepCopy()
-			newInfrastructureConfig.Networks.Zones[0] = infrastructureConfig.Networks.Zones[1]
-			newInfrastructureConfig.Networks.Zones[1] = infrastructureConfig.Networks.Zones[0]
+			Context("elasticIPPool", func() {
+				It("should accept an elastic IP pool for the NAT gateways", func() {
+					infrastructureConfig.Networks.NATGateway = &apisaws.NATGateway{ElasticIPPool: &apisaws.ElasticIPPool{Size: 2, RetainOnDeletion: true}}
+					Expect(ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)).To(BeEmpty())
+				})
 
-			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)
+				It("should forbid a pool smaller than the number of NAT gateways", func() {
+					infrastructureConfig.Networks.NATGateway = &apisaws.NATGateway{ElasticIPPool: &apisaws.ElasticIPPool{Size: 1}}
+					Expect(ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)).To(BeEmpty())
+
+					infrastructureConfig.Networks.Zones[0].ElasticIPAllocationID = nil
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.natGateway.elasticIPPool.size"),
+					}))
+				})
+
+				It("should forbid an elastic IP pool for NAT instances", func() {
+					natGatewayType := apisaws.NATGatewayTypeInstance
+					infrastructureConfig.Networks.Zones[0].ElasticIPAllocationID = nil
+					infrastructureConfig.Networks.NATGateway = &apisaws.NATGateway{
+						Type:          &natGatewayType,
+						Instance:      &apisaws.NATInstance{InstanceType: "t3.small", AMI: "ami-123456"},
+						ElasticIPPool: &apisaws.ElasticIPPool{Size: 2},
+					}
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("networks.natGateway.elasticIPPool"),
+					}))
+				})
+			})
+		})
+
+		Context("transitGateway", func() {
+			BeforeEach(func() {
+				infrastructureConfig.Networks.TransitGateway = &apisaws.TransitGateway{
+					ID:     "tgw-123456",
+					Routes: []string{"192.168.0.0/16", "172.16.0.0/12"},
+				}
+			})
+
+			It("should accept a valid transit gateway configuration", func() {
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should ensure that the transit gateway id starts with `tgw-`", func() {
+				infrastructureConfig.Networks.TransitGateway.ID = "foo"
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.transitGateway.id"),
+				}))
+			})
+
+			It("should forbid invalid, non canonical and duplicate routes", func() {
+				infrastructureConfig.Networks.TransitGateway.Routes = []string{invalidCIDR, "192.168.0.1/16", "172.16.0.0/12", "172.16.0.0/12"}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.transitGateway.routes[0]"),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.transitGateway.routes[1]"),
+					"Detail": Equal("must be valid canonical CIDR"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.transitGateway.routes[3]"),
+				}))
+			})
+
+			It("should forbid routes overlapping with the shoot networks", func() {
+				infrastructureConfig.Networks.TransitGateway.Routes = []string{"10.0.0.0/16", "100.96.0.0/16", "100.64.0.0/16"}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.transitGateway.routes[0]"),
+					"Detail": Equal(`must not overlap with "networks.vpc.cidr" ("10.0.0.0/8")`),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.transitGateway.routes[1]"),
+					"Detail": Equal(`must not overlap with "networking.pods" ("100.96.0.0/11")`),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.transitGateway.routes[2]"),
+					"Detail": Equal(`must not overlap with "networking.services" ("100.64.0.0/13")`),
+				}))
+			})
+		})
+
+		Context("existing internet gateway and route tables", func() {
+			BeforeEach(func() {
+				infrastructureConfig.Networks.VPC = apisaws.VPC{
+					ID:                pointer.String("vpc-123456"),
+					InternetGatewayID: pointer.String("igw-123456"),
+					MainRouteTableID:  pointer.String("rtb-main"),
+				}
+				infrastructureConfig.Networks.Zones[0].RouteTableID = pointer.String("rtb-zone")
+			})
+
+			It("should accept existing route tables of an existing VPC", func() {
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).NotTo(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Field": Or(Equal("networks.vpc.internetGatewayID"), Equal("networks.vpc.mainRouteTableID"), Equal("networks.zones[0].routeTableID")),
+				}))))
+			})
+
+			It("should forbid invalid and shared route tables", func() {
+				infrastructureConfig.Networks.VPC.InternetGatewayID = pointer.String("foo")
+				infrastructureConfig.Networks.Zones[0].RouteTableID = pointer.String("rtb-main")
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.vpc.internetGatewayID"),
+				}))))
+				Expect(errorList).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.zones[0].routeTableID"),
+				}))))
+			})
+
+			It("should forbid existing route tables without an existing VPC", func() {
+				infrastructureConfig.Networks.VPC.ID = nil
+				infrastructureConfig.Networks.VPC.CIDR = &vpc
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.vpc.internetGatewayID"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.vpc.mainRouteTableID"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones[0].routeTableID"),
+				}))
+			})
+		})
+
+		Context("shared VPC", func() {
+			BeforeEach(func() {
+				infrastructureConfig.Networks.VPC = apisaws.VPC{
+					ID:     pointer.String("vpc-123456"),
+					Shared: true,
+				}
+			})
+
+			It("should accept a shared VPC", func() {
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should require an existing VPC", func() {
+				infrastructureConfig.Networks.VPC.ID = nil
+				infrastructureConfig.Networks.VPC.CIDR = &vpc
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.vpc.id"),
+				}))
+			})
+
+			It("should forbid settings which require modifying resources of the VPC owner", func() {
+				infrastructureConfig.Networks.VPC.MainRouteTableID = pointer.String("rtb-main")
+				infrastructureConfig.Networks.NATGateway = &apisaws.NATGateway{Mode: ptr.To(apisaws.NATGatewayModeSingle)}
+				infrastructureConfig.Networks.Zones[0].ElasticIPAllocationID = pointer.String("eipalloc-123456")
+				infrastructureConfig.Networks.Zones[0].RouteTableID = pointer.String("rtb-zone")
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.vpc.mainRouteTableID"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.natGateway"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones[0].elasticIPAllocationID"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones[0].routeTableID"),
+				}))
+			})
+		})
+
+		Context("dhcpOptions", func() {
+			BeforeEach(func() {
+				infrastructureConfig.Networks.VPC.DHCPOptions = &apisaws.DHCPOptions{
+					DomainName:        pointer.String("corp.example.com"),
+					DomainNameServers: []string{"10.1.0.2", "10.1.0.3"},
+					NTPServers:        []string{"10.1.0.4"},
+				}
+			})
+
+			It("should accept valid DHCP options", func() {
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid DHCP options for existing VPCs", func() {
+				infrastructureConfig.Networks.VPC = apisaws.VPC{
+					ID:          pointer.String("vpc-123456"),
+					DHCPOptions: infrastructureConfig.Networks.VPC.DHCPOptions,
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.vpc.dhcpOptions"),
+				}))))
+			})
+
+			It("should forbid invalid domain names and servers", func() {
+				infrastructureConfig.Networks.VPC.DHCPOptions = &apisaws.DHCPOptions{
+					DomainName:        pointer.String("Corp_Example"),
+					DomainNameServers: []string{"AmazonProvidedDNS", "10.1.0.2", "10.1.0.2", "dns.example.com"},
+					NTPServers:        []string{"AmazonProvidedDNS", "10.1.0.4", "10.1.0.5", "10.1.0.6", "10.1.0.7"},
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.vpc.dhcpOptions.domainName"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.vpc.dhcpOptions.domainNameServers[2]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.vpc.dhcpOptions.domainNameServers[3]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeTooMany),
+					"Field": Equal("networks.vpc.dhcpOptions.ntpServers"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.vpc.dhcpOptions.ntpServers[0]"),
+				}))
+			})
+		})
+
+		Context("vpcPeerings", func() {
+			BeforeEach(func() {
+				infrastructureConfig.Networks.VPCPeerings = []apisaws.VPCPeering{
+					{
+						PeerVPCID: "vpc-123456",
+						Routes:    []string{"192.168.0.0/16"},
+					},
+					{
+						PeerVPCID:     "vpc-654321",
+						PeerAccountID: pointer.String("123456789012"),
+						PeerRegion:    pointer.String("us-gov-west-1"),
+						Routes:        []string{"172.16.0.0/12"},
+					},
+				}
+			})
+
+			It("should accept valid VPC peerings", func() {
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid invalid and duplicate peer VPC ids", func() {
+				infrastructureConfig.Networks.VPCPeerings[0].PeerVPCID = "foo"
+				infrastructureConfig.Networks.VPCPeerings = append(infrastructureConfig.Networks.VPCPeerings, apisaws.VPCPeering{
+					PeerVPCID: "vpc-654321",
+					Routes:    []string{"198.51.100.0/24"},
+				})
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.vpcPeerings[0].peerVPCID"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.vpcPeerings[2].peerVPCID"),
+				}))
+			})
+
+			It("should forbid peering the VPC of the shoot", func() {
+				infrastructureConfig.Networks.VPC = apisaws.VPC{ID: pointer.String("vpc-123456")}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.vpcPeerings[0].peerVPCID"),
+					"Detail": Equal("must not be the VPC of the shoot"),
+				}))))
+			})
+
+			It("should forbid invalid peer accounts and regions", func() {
+				infrastructureConfig.Networks.VPCPeerings[1].PeerAccountID = pointer.String("1234")
+				infrastructureConfig.Networks.VPCPeerings[1].PeerRegion = pointer.String("Europe")
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.vpcPeerings[1].peerAccountID"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.vpcPeerings[1].peerRegion"),
+				}))
+			})
+
+			It("should require routes and forbid routes overlapping with the shoot networks", func() {
+				infrastructureConfig.Networks.VPCPeerings[0].Routes = nil
+				infrastructureConfig.Networks.VPCPeerings[1].Routes = []string{"100.96.0.0/16"}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.vpcPeerings[0].routes"),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.vpcPeerings[1].routes[0]"),
+					"Detail": Equal(`must not overlap with "networking.pods" ("100.96.0.0/11")`),
+				}))
+			})
+
+			It("should forbid routing the same CIDR via several targets", func() {
+				infrastructureConfig.Networks.TransitGateway = &apisaws.TransitGateway{
+					ID:     "tgw-123456",
+					Routes: []string{"172.16.0.0/12"},
+				}
+				infrastructureConfig.Networks.VPCPeerings[0].Routes = []string{"192.168.0.0/16", "172.16.0.0/12"}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.vpcPeerings[0].routes[1]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.vpcPeerings[1].routes[0]"),
+				}))
+			})
+		})
+
+		Context("ignoreTags", func() {
+			It("should forbid ignoring reserved tags", func() {
+				infrastructureConfig.IgnoreTags = &apisaws.IgnoreTags{
+					Keys:        []string{"Name"},
+					KeyPrefixes: []string{"kubernetes.io/", "gardener.cloud/"},
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).NotTo(BeEmpty())
+			})
+		})
+
+		Context("additionalNodeSecurityGroupRules", func() {
+			It("should accept valid rules", func() {
+				infrastructureConfig.Networks.AdditionalNodeSecurityGroupRules = []apisaws.NodeSecurityGroupRule{
+					{
+						Type:       apisaws.NodeSecurityGroupRuleTypeIngress,
+						Protocol:   "tcp",
+						FromPort:   pointer.Int64(443),
+						ToPort:     pointer.Int64(443),
+						CIDRBlocks: []string{"192.168.0.0/16"},
+					},
+					{
+						Type:             apisaws.NodeSecurityGroupRuleTypeIngress,
+						Protocol:         "icmp",
+						FromPort:         pointer.Int64(-1),
+						ToPort:           pointer.Int64(-1),
+						SecurityGroupIDs: []string{"sg-123456"},
+					},
+					{
+						Type:             apisaws.NodeSecurityGroupRuleTypeEgress,
+						Protocol:         "-1",
+						CIDRBlocks:       []string{"10.1.0.0/16"},
+						SecurityGroupIDs: []string{"sg-123456"},
+					},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)).To(BeEmpty())
+			})
+
+			It("should forbid unsupported types and protocols", func() {
+				infrastructureConfig.Networks.AdditionalNodeSecurityGroupRules = []apisaws.NodeSecurityGroupRule{
+					{
+						Type:       "both",
+						Protocol:   "sctp",
+						CIDRBlocks: []string{"192.168.0.0/16"},
+					},
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.additionalNodeSecurityGroupRules[0].type"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.additionalNodeSecurityGroupRules[0].protocol"),
+				}))
+			})
+
+			It("should validate the ports", func() {
+				infrastructureConfig.Networks.AdditionalNodeSecurityGroupRules = []apisaws.NodeSecurityGroupRule{
+					{
+						Type:       apisaws.NodeSecurityGroupRuleTypeIngress,
+						Protocol:   "tcp",
+						CIDRBlocks: []string{"192.168.0.0/16"},
+					},
+					{
+						Type:       apisaws.NodeSecurityGroupRuleTypeIngress,
+						Protocol:   "udp",
+						FromPort:   pointer.Int64(8000),
+						ToPort:     pointer.Int64(70000),
+						CIDRBlocks: []string{"192.168.0.0/16"},
+					},
+					{
+						Type:       apisaws.NodeSecurityGroupRuleTypeIngress,
+						Protocol:   "tcp",
+						FromPort:   pointer.Int64(9000),
+						ToPort:     pointer.Int64(8000),
+						CIDRBlocks: []string{"192.168.0.0/16"},
+					},
+					{
+						Type:       apisaws.NodeSecurityGroupRuleTypeIngress,
+						Protocol:   "-1",
+						FromPort:   pointer.Int64(0),
+						ToPort:     pointer.Int64(0),
+						CIDRBlocks: []string{"192.168.0.0/16"},
+					},
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.additionalNodeSecurityGroupRules[0].fromPort"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.additionalNodeSecurityGroupRules[0].toPort"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.additionalNodeSecurityGroupRules[1].toPort"),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.additionalNodeSecurityGroupRules[2].toPort"),
+					"Detail": Equal("must not be less than fromPort"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.additionalNodeSecurityGroupRules[3].fromPort"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.additionalNodeSecurityGroupRules[3].toPort"),
+				}))
+			})
+
+			It("should validate the peers", func() {
+				infrastructureConfig.Networks.AdditionalNodeSecurityGroupRules = []apisaws.NodeSecurityGroupRule{
+					{
+						Type:     apisaws.NodeSecurityGroupRuleTypeIngress,
+						Protocol: "-1",
+					},
+					{
+						Type:             apisaws.NodeSecurityGroupRuleTypeIngress,
+						Protocol:         "-1",
+						CIDRBlocks:       []string{"foo", "2001:db8::/64", "192.168.1.0/16"},
+						SecurityGroupIDs: []string{"123456"},
+					},
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.additionalNodeSecurityGroupRules[0]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.additionalNodeSecurityGroupRules[1].cidrBlocks[0]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.additionalNodeSecurityGroupRules[1].cidrBlocks[1]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.additionalNodeSecurityGroupRules[1].cidrBlocks[2]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.additionalNodeSecurityGroupRules[1].securityGroupIDs[0]"),
+				}))
+			})
+		})
+
+		Context("networkACLs", func() {
+			It("should accept valid network ACLs", func() {
+				infrastructureConfig.Networks.NetworkACLs = &apisaws.NetworkACLs{
+					Workers: &apisaws.NetworkACL{
+						Rules: []apisaws.NetworkACLRule{
+							{
+								RuleNumber: 100,
+								Type:       apisaws.NetworkACLRuleTypeIngress,
+								Action:     apisaws.NetworkACLRuleActionAllow,
+								Protocol:   "-1",
+								CIDRBlock:  "10.250.0.0/16",
+							},
+							{
+								RuleNumber: 100,
+								Type:       apisaws.NetworkACLRuleTypeEgress,
+								Action:     apisaws.NetworkACLRuleActionAllow,
+								Protocol:   "tcp",
+								FromPort:   pointer.Int64(443),
+								ToPort:     pointer.Int64(443),
+								CIDRBlock:  "0.0.0.0/0",
+							},
+							{
+								RuleNumber: 110,
+								Type:       apisaws.NetworkACLRuleTypeEgress,
+								Action:     apisaws.NetworkACLRuleActionDeny,
+								Protocol:   "icmp",
+								FromPort:   pointer.Int64(-1),
+								ToPort:     pointer.Int64(-1),
+								CIDRBlock:  "::/0",
+							},
+						},
+					},
+					Public: &apisaws.NetworkACL{},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)).To(BeEmpty())
+			})
+
+			It("should forbid invalid rules", func() {
+				infrastructureConfig.Networks.NetworkACLs = &apisaws.NetworkACLs{
+					Internal: &apisaws.NetworkACL{
+						Rules: []apisaws.NetworkACLRule{
+							{
+								RuleNumber: 32767,
+								Type:       "both",
+								Action:     "reject",
+								Protocol:   "sctp",
+								CIDRBlock:  "10.250.0.0",
+							},
+							{
+								RuleNumber: 100,
+								Type:       apisaws.NetworkACLRuleTypeIngress,
+								Action:     apisaws.NetworkACLRuleActionAllow,
+								Protocol:   "tcp",
+								FromPort:   pointer.Int64(9000),
+								ToPort:     pointer.Int64(8000),
+								CIDRBlock:  "10.250.0.1/16",
+							},
+							{
+								RuleNumber: 100,
+								Type:       apisaws.NetworkACLRuleTypeIngress,
+								Action:     apisaws.NetworkACLRuleActionDeny,
+								Protocol:   "-1",
+								ToPort:     pointer.Int64(0),
+								CIDRBlock:  "10.250.0.0/16",
+							},
+						},
+					},
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.networkACLs.internal.rules[0].ruleNumber"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.networkACLs.internal.rules[0].type"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.networkACLs.internal.rules[0].action"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.networkACLs.internal.rules[0].protocol"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.networkACLs.internal.rules[0].cidrBlock"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.networkACLs.internal.rules[1].toPort"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.networkACLs.internal.rules[1].cidrBlock"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.networkACLs.internal.rules[2].ruleNumber"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.networkACLs.internal.rules[2].toPort"),
+				}))
+			})
+		})
+
+		Context("route53Resolver", func() {
+			It("should accept a valid configuration", func() {
+				infrastructureConfig.Networks.Route53Resolver = &apisaws.Route53Resolver{
+					InboundEndpoint: &apisaws.Route53ResolverInboundEndpoint{
+						AllowedCIDRs: []string{"192.168.0.0/16"},
+					},
+					ForwardingRules: []apisaws.Route53ResolverForwardingRule{
+						{DomainName: "corp.example.com", TargetIPs: []string{"192.168.0.2", "192.168.0.3"}},
+						{DomainName: "onprem.example.com.", TargetIPs: []string{"192.168.0.2"}},
+					},
+					RuleIDs: []string{"rslvr-rr-123456"},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)).To(BeEmpty())
+			})
+
+			It("should forbid an invalid configuration", func() {
+				infrastructureConfig.Networks.Route53Resolver = &apisaws.Route53Resolver{
+					InboundEndpoint: &apisaws.Route53ResolverInboundEndpoint{
+						AllowedCIDRs: []string{"192.168.0.1/16", "2001:db8::/32"},
+					},
+					ForwardingRules: []apisaws.Route53ResolverForwardingRule{
+						{DomainName: "corp.example.com", TargetIPs: []string{"192.168.0.2", "2001:db8::1"}},
+						{DomainName: "corp.example.com.", TargetIPs: []string{"foo"}},
+						{DomainName: "Invalid_Domain"},
+					},
+					RuleIDs: []string{"rslvr-rr-123456", "rslvr-rr-123456", "rr-123456"},
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.route53Resolver.inboundEndpoint.allowedCIDRs[0]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.route53Resolver.inboundEndpoint.allowedCIDRs[1]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.route53Resolver.forwardingRules[0].targetIPs[1]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.route53Resolver.forwardingRules[1].domainName"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.route53Resolver.forwardingRules[1].targetIPs[0]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.route53Resolver.forwardingRules[2].domainName"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.route53Resolver.forwardingRules[2].targetIPs"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.route53Resolver.ruleIDs[1]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.route53Resolver.ruleIDs[2]"),
+				}))
+			})
+		})
+
+		Context("vpcFlowLogs", func() {
+			It("should accept valid flow logs configurations", func() {
+				infrastructureConfig.VPCFlowLogs = &apisaws.VPCFlowLogs{
+					Destination: apisaws.VPCFlowLogsDestination{
+						CloudWatchLogs: &apisaws.VPCFlowLogsCloudWatchLogs{KMSKeyARN: pointer.String("arn:aws:kms:eu-west-1:123456789012:key/foo")},
+					},
+					TrafficType:            pointer.String("REJECT"),
+					MaxAggregationInterval: pointer.Int64(60),
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)).To(BeEmpty())
+
+				infrastructureConfig.VPCFlowLogs = &apisaws.VPCFlowLogs{
+					Destination: apisaws.VPCFlowLogsDestination{
+						S3: &apisaws.VPCFlowLogsS3{BucketARN: "arn:aws:s3:::my-bucket/flow-logs/"},
+					},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)).To(BeEmpty())
+			})
+
+			It("should require exactly one destination", func() {
+				infrastructureConfig.VPCFlowLogs = &apisaws.VPCFlowLogs{}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("vpcFlowLogs.destination"),
+				}))
+
+				infrastructureConfig.VPCFlowLogs.Destination = apisaws.VPCFlowLogsDestination{
+					CloudWatchLogs: &apisaws.VPCFlowLogsCloudWatchLogs{},
+					S3:             &apisaws.VPCFlowLogsS3{BucketARN: "arn:aws:s3:::my-bucket"},
+				}
+				errorList = ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("vpcFlowLogs.destination"),
+				}))
+			})
+
+			It("should forbid invalid ARNs", func() {
+				infrastructureConfig.VPCFlowLogs = &apisaws.VPCFlowLogs{
+					Destination: apisaws.VPCFlowLogsDestination{
+						CloudWatchLogs: &apisaws.VPCFlowLogsCloudWatchLogs{KMSKeyARN: pointer.String("foo")},
+					},
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("vpcFlowLogs.destination.cloudWatchLogs.kmsKeyARN"),
+				}))
+
+				infrastructureConfig.VPCFlowLogs.Destination = apisaws.VPCFlowLogsDestination{
+					S3: &apisaws.VPCFlowLogsS3{BucketARN: "my-bucket"},
+				}
+				errorList = ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("vpcFlowLogs.destination.s3.bucketARN"),
+				}))
+			})
+
+			It("should forbid unsupported traffic types and aggregation intervals", func() {
+				infrastructureConfig.VPCFlowLogs = &apisaws.VPCFlowLogs{
+					Destination: apisaws.VPCFlowLogsDestination{
+						S3: &apisaws.VPCFlowLogsS3{BucketARN: "arn:aws:s3:::my-bucket"},
+					},
+					TrafficType:            pointer.String("foo"),
+					MaxAggregationInterval: pointer.Int64(120),
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("vpcFlowLogs.trafficType"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("vpcFlowLogs.maxAggregationInterval"),
+				}))
+			})
+		})
+
+		Context("iam", func() {
+			It("should accept an existing nodes instance profile", func() {
+				infrastructureConfig.IAM = &apisaws.IAMConfig{
+					NodesInstanceProfile: &apisaws.IAMInstanceProfile{Name: pointer.String("my-nodes")},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)).To(BeEmpty())
+			})
+
+			It("should forbid specifying both name and ARN of the nodes instance profile", func() {
+				infrastructureConfig.IAM = &apisaws.IAMConfig{
+					NodesInstanceProfile: &apisaws.IAMInstanceProfile{
+						Name: pointer.String("my-nodes"),
+						ARN:  pointer.String("arn:aws:iam::123456789012:instance-profile/my-nodes"),
+					},
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("iam.nodesInstanceProfile"),
+				}))
+			})
+		})
+
+		Context("egressPrefixList", func() {
+			It("should accept an egress prefix list with the nodes CIDRs", func() {
+				infrastructureConfig.EgressPrefixList = &apisaws.EgressPrefixListConfig{Enabled: true, IncludeNodesCIDRs: true}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)).To(BeEmpty())
+			})
+
+			It("should forbid including the nodes CIDRs if the prefix list is disabled", func() {
+				infrastructureConfig.EgressPrefixList = &apisaws.EgressPrefixListConfig{IncludeNodesCIDRs: true}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("egressPrefixList.includeNodesCIDRs"),
+				}))
+			})
+		})
+
+		Context("inventory", func() {
+			It("should accept an inventory written to S3", func() {
+				infrastructureConfig.Inventory = &apisaws.InventoryConfig{Enabled: true, S3: &apisaws.InventoryS3Location{Bucket: "compliance", Prefix: "gardener/"}}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)).To(BeEmpty())
+			})
+
+			It("should forbid an invalid S3 location or an S3 location of a disabled inventory", func() {
+				infrastructureConfig.Inventory = &apisaws.InventoryConfig{S3: &apisaws.InventoryS3Location{Prefix: "/gardener/"}}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("inventory.s3"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("inventory.s3.bucket"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("inventory.s3.prefix"),
+				}))
+			})
+		})
+
+		Context("endpoints", func() {
+			It("should accept a partition and custom service endpoints", func() {
+				infrastructureConfig.Endpoints = &apisaws.Endpoints{
+					Partition: pointer.String("aws-cn"),
+					Services: map[string]string{
+						"ec2": "https://ec2.cn-north-1.amazonaws.com.cn",
+						"iam": "https://iam.cn-north-1.amazonaws.com.cn",
+					},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)).To(BeEmpty())
+			})
+
+			It("should forbid unknown partitions and services", func() {
+				infrastructureConfig.Endpoints = &apisaws.Endpoints{
+					Partition: pointer.String("foo"),
+					Services: map[string]string{
+						"bar": "https://bar.example.com",
+					},
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("endpoints.partition"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("endpoints.services[bar]"),
+				}))
+			})
+
+			It("should forbid endpoints which are no https URLs", func() {
+				infrastructureConfig.Endpoints = &apisaws.Endpoints{
+					Services: map[string]string{
+						"ec2": "http://ec2.example.com",
+						"s3":  "s3.example.com",
+					},
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("endpoints.services[ec2]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("endpoints.services[s3]"),
+				}))
+			})
+		})
+	})
+
+	Describe("#ValidateInfrastructureConfigUpdate", func() {
+		It("should return no errors for an unchanged config", func() {
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, infrastructureConfig, false)).To(BeEmpty())
+		})
+
+		It("should allow adding a zone", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones = append(newInfrastructureConfig.Networks.Zones, awsZone2)
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, false)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid changing the zone type", func() {
+			newInfraConfig := infrastructureConfig.DeepCopy()
+			newInfraConfig.Networks.Zones[0].Type = ptr.To(apisaws.ZoneTypeLocalZone)
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfraConfig, false)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.zones[0].type"),
+			}))
+
+			newInfraConfig.Networks.Zones[0].Type = ptr.To(apisaws.ZoneTypeAvailabilityZone)
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfraConfig, false)).To(BeEmpty())
+		})
+
+		It("should forbid changing the Outpost of a zone", func() {
+			newInfraConfig := infrastructureConfig.DeepCopy()
+			newInfraConfig.Networks.Zones[0].OutpostARN = pointer.String("arn:aws:outposts:eu-west-1:123456789012:outpost/op-0123456789abcdef0")
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfraConfig, false)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.zones[0].outpostARN"),
+			}))
+		})
+
+		It("should forbid changing whether the VPC is shared", func() {
+			infrastructureConfig.Networks.VPC = apisaws.VPC{ID: pointer.String("vpc-123456")}
+			newInfraConfig := infrastructureConfig.DeepCopy()
+			newInfraConfig.Networks.VPC.Shared = true
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfraConfig, false)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.vpc.shared"),
+			}))
+		})
+
+		It("should forbid removing or shrinking the elastic IP pool", func() {
+			infrastructureConfig.Networks.NATGateway = &apisaws.NATGateway{ElasticIPPool: &apisaws.ElasticIPPool{Size: 2}}
+
+			newInfraConfig := infrastructureConfig.DeepCopy()
+			newInfraConfig.Networks.NATGateway.ElasticIPPool.Size = 3
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfraConfig, false)).To(BeEmpty())
+
+			newInfraConfig.Networks.NATGateway.ElasticIPPool.Size = 1
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfraConfig, false)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.natGateway.elasticIPPool.size"),
+			}))
+
+			newInfraConfig.Networks.NATGateway.ElasticIPPool = nil
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfraConfig, false)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.natGateway.elasticIPPool"),
+			}))
+		})
+
+		It("should allow changing gateway endpoints inside vpc", func() {
+			newInfraConfig := infrastructureConfig.DeepCopy()
+			newInfraConfig.Networks.VPC.GatewayEndpoints = []string{"myep"}
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfraConfig, false)).To(BeEmpty())
+		})
+
+		It("should forbid changing the nodes instance profile", func() {
+			newInfraConfig := infrastructureConfig.DeepCopy()
+			newInfraConfig.IAM = &apisaws.IAMConfig{
+				NodesInstanceProfile: &apisaws.IAMInstanceProfile{Name: pointer.String("my-nodes")},
+			}
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfraConfig, false)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("iam.nodesInstanceProfile"),
+			}))
+		})
+
+		It("should allow adding secondary CIDR blocks and dedicated pod subnets", func() {
+			newInfraConfig := infrastructureConfig.DeepCopy()
+			newInfraConfig.Networks.VPC.SecondaryCidrBlocks = []string{"100.80.0.0/16"}
+			newInfraConfig.Networks.Zones[0].Pods = pointer.String("100.80.0.0/18")
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfraConfig, false)).To(BeEmpty())
+		})
+
+		It("should forbid removing secondary CIDR blocks and changing dedicated pod subnets", func() {
+			infrastructureConfig.Networks.VPC.SecondaryCidrBlocks = []string{"100.80.0.0/16"}
+			infrastructureConfig.Networks.Zones[0].Pods = pointer.String("100.80.0.0/18")
+			newInfraConfig := infrastructureConfig.DeepCopy()
+			newInfraConfig.Networks.VPC.SecondaryCidrBlocks = nil
+			newInfraConfig.Networks.Zones[0].Pods = nil
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfraConfig, false)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.zones[0].pods"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.vpc.secondaryCidrBlocks"),
+			}))))
+		})
+
+		It("should allow adding secondary workers subnets but forbid changing them", func() {
+			newInfraConfig := infrastructureConfig.DeepCopy()
+			newInfraConfig.Networks.Zones[0].SecondaryWorkers = pointer.String("10.250.16.0/20")
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfraConfig, false)).To(BeEmpty())
+
+			infrastructureConfig = newInfraConfig.DeepCopy()
+			newInfraConfig.Networks.Zones[0].SecondaryWorkers = nil
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfraConfig, false)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.zones[0].secondaryWorkers"),
+			}))))
+		})
+
+		It("should forbid changing the VPC ID", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newid := "the-new-id"
+			newInfrastructureConfig.Networks.VPC.ID = &newid
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, false)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.vpc.id"),
+			}))))
+		})
+
+		It("should forbid changing the VPC CIDR", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newCIDR := "1.2.3.4/5"
+			newInfrastructureConfig.Networks.VPC.CIDR = &newCIDR
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, false)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.vpc.cidr"),
+			}))))
+		})
+
+		It("should forbid changing the internal network of a zone", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones[0].Internal = awsZone2.Internal
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, false)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.zones[0].internal"),
+			}))))
+		})
+
+		It("should forbid changing the public network of a zone", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones[0].Public = awsZone2.Public
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, false)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.zones[0].public"),
+			}))))
+		})
+
+		It("should forbid changing the workers network of a zone", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones[0].Workers = awsZone2.Workers
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, false)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.zones[0].workers"),
+			}))))
+		})
+
+		It("should allow changing the elastic IP allocation ID of a zone", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones[0].ElasticIPAllocationID = pointer.String("some-id")
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, false)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should allow removing a zone with flow", func() {
+			infrastructureConfig.Networks.Zones = append(infrastructureConfig.Networks.Zones, awsZone2)
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones = newInfrastructureConfig.Networks.Zones[:1]
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, true)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid removing a zone without flow", func() {
+			infrastructureConfig.Networks.Zones = append(infrastructureConfig.Networks.Zones, awsZone2)
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones = newInfrastructureConfig.Networks.Zones[:1]
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, false)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("networks.zones"),
+				"Detail": ContainSubstring("zone " + awsZone2.Name + " can only be removed with the flow infrastructure reconciler"),
+			}))))
+		})
+
+		It("should forbid removing the first zone", func() {
+			infrastructureConfig.Networks.Zones = append(infrastructureConfig.Networks.Zones, awsZone2)
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones = newInfrastructureConfig.Networks.Zones[1:]
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, true)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.zones[0].name"),
+			}))))
+		})
+
+		It("should forbid adding a zone before existing zones", func() {
+			infrastructureConfig.Networks.Zones = append(infrastructureConfig.Networks.Zones, awsZone2)
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones = []apisaws.Zone{
+				infrastructureConfig.Networks.Zones[0],
+				{Name: "zone3", Internal: "10.250.7.0/24", Public: "10.250.8.0/24", Workers: "10.250.9.0/24"},
+				awsZone2,
+			}
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, false)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.zones[1]"),
+			}))))
+		})
+
+		It("should forbid changing a zone which is moved by the removal of another zone", func() {
+			infrastructureConfig.Networks.Zones = append(infrastructureConfig.Networks.Zones, awsZone2, apisaws.Zone{Name: "zone3", Internal: "10.250.7.0/24", Public: "10.250.8.0/24", Workers: "10.250.9.0/24"})
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones = []apisaws.Zone{newInfrastructureConfig.Networks.Zones[0], newInfrastructureConfig.Networks.Zones[2]}
+			newInfrastructureConfig.Networks.Zones[1].Workers = "10.250.10.0/24"
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, true)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.zones[1].workers"),
+			}))))
+		})
+
+		It("should allow adding a zone but forbid changing one", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones = append(newInfrastructureConfig.Networks.Zones, awsZone2)
+			newInfrastructureConfig.Networks.Zones[0].Name = "zone3"
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, true)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.zones[0].name"),
+			}))))
+		})
+
+		It("should allow adding IPv6 to the ip families", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.IPFamilies = []apisaws.IPFamily{apisaws.IPFamilyIPv4, apisaws.IPFamilyIPv6}
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, false)).To(BeEmpty())
+		})
+
+		It("should forbid removing IPv6 from the ip families", func() {
+			infrastructureConfig.Networks.IPFamilies = []apisaws.IPFamily{apisaws.IPFamilyIPv4, apisaws.IPFamilyIPv6}
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.IPFamilies = []apisaws.IPFamily{apisaws.IPFamilyIPv4}
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, false)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.ipFamilies"),
+			}))))
+		})
+
+		It("should forbid changing the order of zones", func() {
+			infrastructureConfig.Networks.Zones = append(infrastructureConfig.Networks.Zones, awsZone2)
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones[0] = infrastructureConfig.Networks.Zones[1]
+			newInfrastructureConfig.Networks.Zones[1] = infrastructureConfig.Networks.Zones[0]
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, false)
 
 			Expect(errorList).To(ConsistOf(
 				PointTo(MatchFields(IgnoreExtras, Fields{
@@ -564,32 +1951,8 @@ var _ = Describe("InfrastructureConfig validation", func() {
 					"Field": Equal("networks.zones[0].name"),
 				})),
 				PointTo(MatchFields(IgnoreExtras, Fields{
-					"Type":  Equal(field.ErrorTypeInvalid),
-					"Field": Equal("networks.zones[0].public"),
-				})),
-				PointTo(MatchFields(IgnoreExtras, Fields{
-					"Type":  Equal(field.ErrorTypeInvalid),
-					"Field": Equal("networks.zones[0].internal"),
-				})),
-				PointTo(MatchFields(IgnoreExtras, Fields{
-					"Type":  Equal(field.ErrorTypeInvalid),
-					"Field": Equal("networks.zones[0].workers"),
-				})),
-				PointTo(MatchFields(IgnoreExtras, Fields{
-					"Type":  Equal(field.ErrorTypeInvalid),
-					"Field": Equal("networks.zones[1].name"),
-				})),
-				PointTo(MatchFields(IgnoreExtras, Fields{
-					"Type":  Equal(field.ErrorTypeInvalid),
-					"Field": Equal("networks.zones[1].public"),
-				})),
-				PointTo(MatchFields(IgnoreExtras, Fields{
-					"Type":  Equal(field.ErrorTypeInvalid),
-					"Field": Equal("networks.zones[1].internal"),
-				})),
-				PointTo(MatchFields(IgnoreExtras, Fields{
-					"Type":  Equal(field.ErrorTypeInvalid),
-					"Field": Equal("networks.zones[1].workers"),
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones[0]"),
 				})),
 			))
 		})
@@ -721,4 +2084,82 @@ var _ = Describe("InfrastructureConfig validation", func() {
 			))
 		})
 	})
+
+	Describe("#ValidateTags", func() {
+		var fldPath = field.NewPath("tags")
+
+		It("should accept empty tags", func() {
+			Expect(ValidateTags(fldPath, nil)).To(BeEmpty())
+		})
+
+		It("should accept valid tags", func() {
+			Expect(ValidateTags(fldPath, map[string]string{
+				"cost-center": "1234",
+				"team":        "",
+			})).To(BeEmpty())
+		})
+
+		It("should forbid reserved keys and prefixes", func() {
+			errorList := ValidateTags(fldPath, map[string]string{
+				"Name":                   "foo",
+				"kubernetes.io/role/elb": "1",
+				"gardener.cloud/foo":     "bar",
+				"AWS:createdBy":          "me",
+				"foo=bar":                "baz",
+				"foo":                    "bar,baz",
+			})
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("tags[Name]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("tags[kubernetes.io/role/elb]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("tags[gardener.cloud/foo]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("tags[AWS:createdBy]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("tags[foo=bar]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("tags[foo]"),
+				})),
+			))
+		})
+
+		It("should forbid too long keys and values and too many tags", func() {
+			tags := map[string]string{
+				strings.Repeat("k", 129): "foo",
+				"foo":                    strings.Repeat("v", 257),
+			}
+			for i := 0; i < 40; i++ {
+				tags[fmt.Sprintf("tag-%d", i)] = "bar"
+			}
+
+			errorList := ValidateTags(fldPath, tags)
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeTooMany),
+					"Field": Equal("tags"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("tags[" + strings.Repeat("k", 129) + "]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeTooLong),
+					"Field": Equal("tags[foo]"),
+				})),
+			))
+		})
+	})
 })
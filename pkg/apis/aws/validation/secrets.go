@@ -32,12 +32,54 @@ const (
 var (
 	accessKeyRegex       = regexp.MustCompile(`^\w+$`)
 	secretAccessKeyRegex = regexp.MustCompile(`^[A-Za-z0-9/+=]+$`)
+	roleARNRegex         = regexp.MustCompile(`^arn:aws[a-z-]*:iam::\d{12}:role/[\w+=,.@/-]+$`)
 )
 
-// ValidateCloudProviderSecret checks whether the given secret contains a valid AWS access keys.
+// ValidateCloudProviderSecret checks whether the given secret contains a valid AWS access keys or a valid web identity
+// configuration.
 func ValidateCloudProviderSecret(secret *corev1.Secret) error {
 	secretRef := fmt.Sprintf("%s/%s", secret.Namespace, secret.Name)
 
+	if _, ok := secret.Data[aws.WebIdentityToken]; ok {
+		return validateWebIdentity(secret, secretRef)
+	}
+
+	if err := validateAccessKey(secret, secretRef); err != nil {
+		return err
+	}
+
+	// The components in the control plane of the shoot, e.g. the machine-controller-manager, the cloud-controller-manager,
+	// the CSI driver and the etcd backup, use the access key directly and can't assume a role with it.
+	for _, key := range []string{aws.RoleARN, aws.ExternalID} {
+		if _, ok := secret.Data[key]; ok {
+			return fmt.Errorf("field %q in secret %s is not supported together with field %q", key, secretRef, aws.AccessKeyID)
+		}
+	}
+
+	return nil
+}
+
+// validateWebIdentity checks whether the given secret contains a valid web identity configuration. The token itself is
+// not validated as it is typically written (and renewed) by the seed after the secret has been created.
+func validateWebIdentity(secret *corev1.Secret, secretRef string) error {
+	for _, key := range []string{aws.AccessKeyID, aws.SecretAccessKey, aws.ExternalID} {
+		if _, ok := secret.Data[key]; ok {
+			return fmt.Errorf("field %q in secret %s cannot be used together with field %q", key, secretRef, aws.WebIdentityToken)
+		}
+	}
+
+	roleARN, ok := secret.Data[aws.RoleARN]
+	if !ok {
+		return fmt.Errorf("field %q in secret %s requires field %q", aws.WebIdentityToken, secretRef, aws.RoleARN)
+	}
+	if !roleARNRegex.Match(roleARN) {
+		return fmt.Errorf("field %q in secret %s must be the ARN of an IAM role", aws.RoleARN, secretRef)
+	}
+
+	return nil
+}
+
+func validateAccessKey(secret *corev1.Secret, secretRef string) error {
 	// accessKeyID must have length between 16 and 128 and only contain alphanumeric characters,
 	// see https://docs.aws.amazon.com/IAM/latest/APIReference/API_AccessKey.html
 	accessKeyID, ok := secret.Data[aws.AccessKeyID]
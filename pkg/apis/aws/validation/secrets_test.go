@@ -123,5 +123,57 @@ var _ = Describe("Secret validation", func() {
 			},
 			BeNil(),
 		),
+
+		Entry("should return error when the role ARN is given together with an access key",
+			map[string][]byte{
+				aws.AccessKeyID:     []byte(strings.Repeat("a", 16)),
+				aws.SecretAccessKey: []byte(strings.Repeat("b", 40)),
+				aws.RoleARN:         []byte("arn:aws:iam::123456789012:role/foo"),
+			},
+			HaveOccurred(),
+		),
+
+		Entry("should return error when the external ID is given together with an access key",
+			map[string][]byte{
+				aws.AccessKeyID:     []byte(strings.Repeat("a", 16)),
+				aws.SecretAccessKey: []byte(strings.Repeat("b", 40)),
+				aws.ExternalID:      []byte("external-id"),
+			},
+			HaveOccurred(),
+		),
+
+		Entry("should return error when the web identity token is given without role ARN",
+			map[string][]byte{
+				aws.WebIdentityToken: []byte("token"),
+			},
+			HaveOccurred(),
+		),
+
+		Entry("should return error when the web identity token is given together with an access key",
+			map[string][]byte{
+				aws.AccessKeyID:      []byte(strings.Repeat("a", 16)),
+				aws.SecretAccessKey:  []byte(strings.Repeat("b", 40)),
+				aws.RoleARN:          []byte("arn:aws:iam::123456789012:role/foo"),
+				aws.WebIdentityToken: []byte("token"),
+			},
+			HaveOccurred(),
+		),
+
+		Entry("should return error when the web identity token is given together with an external ID",
+			map[string][]byte{
+				aws.RoleARN:          []byte("arn:aws:iam::123456789012:role/foo"),
+				aws.ExternalID:       []byte("external-id"),
+				aws.WebIdentityToken: []byte("token"),
+			},
+			HaveOccurred(),
+		),
+
+		Entry("should succeed when the web identity configuration is valid",
+			map[string][]byte{
+				aws.RoleARN:          []byte("arn:aws:iam::123456789012:role/foo"),
+				aws.WebIdentityToken: {},
+			},
+			BeNil(),
+		),
 	)
 })
@@ -20,10 +20,13 @@ import (
 	"github.com/gardener/gardener/pkg/apis/core"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	apisawshelper "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
 )
 
 // ValidateNetworking validates the network settings of a Shoot.
@@ -80,6 +83,128 @@ func ValidateWorker(worker core.Worker, zones []apisaws.Zone, workerConfig *apis
 		allErrs = append(allErrs, ValidateWorkerConfig(workerConfig, worker.Volume, worker.DataVolumes, fldPath.Child("providerConfig"))...)
 	}
 
+	allErrs = append(allErrs, validateWorkerOutpost(worker, zones, workerConfig, fldPath)...)
+
+	if workerConfig != nil && workerConfig.RollingUpdate != nil {
+		allErrs = append(allErrs, validateWorkerRollingUpdate(worker, workerConfig.RollingUpdate, fldPath.Child("providerConfig", "rollingUpdate"))...)
+	}
+
+	if workerConfig != nil && workerConfig.PreProvisionedCapacity != nil {
+		allErrs = append(allErrs, validateWorkerPreProvisionedCapacity(worker, workerConfig.PreProvisionedCapacity, fldPath.Child("providerConfig", "preProvisionedCapacity"))...)
+	}
+
+	if workerConfig != nil && workerConfig.ImageSelector != nil && workerConfig.ImageSelector.Architecture != nil &&
+		worker.Machine.Architecture != nil && *workerConfig.ImageSelector.Architecture != *worker.Machine.Architecture {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("providerConfig", "imageSelector", "architecture"), *workerConfig.ImageSelector.Architecture, fmt.Sprintf("must match the architecture %s of the worker pool", *worker.Machine.Architecture)))
+	}
+
+	if workerConfig != nil && workerConfig.DevicePlugin != nil && workerConfig.DevicePlugin.Enabled && apisawshelper.GetAcceleratorType(worker.Machine.Type) == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("machine", "type"), worker.Machine.Type, "device plugin requires a machine type with NVIDIA GPUs, Inferentia or Trainium accelerators"))
+	}
+
+	return allErrs
+}
+
+// validateWorkerRollingUpdate validates the rolling update of a worker pool. As its values override the ones of the
+// worker pool, it also ensures that the machines can still be rolled, i.e. that maxSurge and maxUnavailable are not
+// both zero.
+func validateWorkerRollingUpdate(worker core.Worker, rollingUpdate *apisaws.RollingUpdate, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validatePositiveIntOrPercent(rollingUpdate.MaxSurge, fldPath.Child("maxSurge"))...)
+	allErrs = append(allErrs, validatePositiveIntOrPercent(rollingUpdate.MaxUnavailable, fldPath.Child("maxUnavailable"))...)
+
+	maxSurge, maxUnavailable := core.DefaultWorkerMaxSurge, core.DefaultWorkerMaxUnavailable
+	for _, value := range []*intstr.IntOrString{worker.MaxSurge, rollingUpdate.MaxSurge} {
+		if value != nil {
+			maxSurge = *value
+		}
+	}
+	for _, value := range []*intstr.IntOrString{worker.MaxUnavailable, rollingUpdate.MaxUnavailable} {
+		if value != nil {
+			maxUnavailable = *value
+		}
+	}
+	if isZeroIntOrPercent(maxSurge) && isZeroIntOrPercent(maxUnavailable) {
+		allErrs = append(allErrs, field.Invalid(fldPath, rollingUpdate, "maxSurge and maxUnavailable must not both be zero"))
+	}
+
+	return allErrs
+}
+
+// validateWorkerPreProvisionedCapacity validates the pre-provisioned capacity of a worker pool. The machines kept
+// available are added by the cluster-autoscaler, hence they must fit into the maximum of the worker pool.
+func validateWorkerPreProvisionedCapacity(worker core.Worker, capacity *apisaws.PreProvisionedCapacity, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if capacity.Machines < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("machines"), capacity.Machines, "must be at least 1"))
+	} else if capacity.Machines > worker.Maximum {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("machines"), capacity.Machines, fmt.Sprintf("must not be greater than the maximum %d of the worker pool", worker.Maximum)))
+	}
+	if worker.Minimum == worker.Maximum {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "requires a worker pool whose maximum is greater than its minimum"))
+	}
+
+	for name, value := range capacity.Resources {
+		allErrs = append(allErrs, validateResourceQuantityValue(name, value, fldPath.Child("resources").Child(string(name)))...)
+	}
+
+	return allErrs
+}
+
+func validatePositiveIntOrPercent(value *intstr.IntOrString, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if value == nil {
+		return allErrs
+	}
+
+	if value.Type == intstr.String {
+		if validation.IsValidPercent(value.StrVal) != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath, value, "must be an integer or percentage (e.g '5%')"))
+		}
+	} else {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(value.IntValue()), fldPath)...)
+	}
+
+	return allErrs
+}
+
+func isZeroIntOrPercent(value intstr.IntOrString) bool {
+	if value.Type == intstr.String {
+		return value.StrVal == "0%"
+	}
+	return value.IntVal == 0
+}
+
+// validateWorkerOutpost validates that the zones of the worker pool are placed on the Outpost of the worker pool. As
+// the workers subnet of a zone with an Outpost is created on the Outpost, worker pools in such zones must run on the
+// Outpost.
+func validateWorkerOutpost(worker core.Worker, zones []apisaws.Zone, workerConfig *apisaws.WorkerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var outpostARN *string
+	if workerConfig != nil {
+		outpostARN = workerConfig.OutpostARN
+	}
+
+	for i, zoneName := range worker.Zones {
+		for _, zone := range zones {
+			if zone.Name != zoneName {
+				continue
+			}
+			switch {
+			case outpostARN != nil && zone.OutpostARN == nil:
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("zones").Index(i), zoneName, fmt.Sprintf("zone is not placed on Outpost %s", *outpostARN)))
+			case outpostARN != nil && *zone.OutpostARN != *outpostARN:
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("zones").Index(i), zoneName, fmt.Sprintf("zone is placed on Outpost %s", *zone.OutpostARN)))
+			case outpostARN == nil && zone.OutpostARN != nil:
+				allErrs = append(allErrs, field.Required(fldPath.Child("providerConfig", "outpostARN"), fmt.Sprintf("zone %s is placed on Outpost %s", zoneName, *zone.OutpostARN)))
+			}
+		}
+	}
+
 	return allErrs
 }
 
@@ -21,7 +21,10 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/pointer"
 
@@ -218,6 +221,193 @@ var _ = Describe("Shoot validation", func() {
 					})),
 				))
 			})
+
+			Context("outposts", func() {
+				const outpostARN = "arn:aws:outposts:eu-west-1:123456789012:outpost/op-0123456789abcdef0"
+
+				BeforeEach(func() {
+					awsZones[0].OutpostARN = pointer.String(outpostARN)
+					awsZones[1].OutpostARN = pointer.String(outpostARN)
+				})
+
+				It("should pass if the worker pool runs on the Outpost of its zones", func() {
+					errorList := ValidateWorker(worker, awsZones, &apisaws.WorkerConfig{OutpostARN: pointer.String(outpostARN)}, field.NewPath("workers").Index(0))
+
+					Expect(errorList).To(BeEmpty())
+				})
+
+				It("should require the Outpost if the zones are placed on it", func() {
+					errorList := ValidateWorker(worker, awsZones, nil, field.NewPath("workers").Index(0))
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeRequired),
+							"Field":  Equal("workers[0].providerConfig.outpostARN"),
+							"Detail": Equal("zone zone1 is placed on Outpost " + outpostARN),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeRequired),
+							"Field":  Equal("workers[0].providerConfig.outpostARN"),
+							"Detail": Equal("zone zone2 is placed on Outpost " + outpostARN),
+						})),
+					))
+				})
+
+				It("should forbid zones which are not placed on the Outpost of the worker pool", func() {
+					awsZones[1].OutpostARN = nil
+					worker.Zones = append(worker.Zones, "zone3")
+					awsZones[2].OutpostARN = pointer.String("arn:aws:outposts:eu-west-1:123456789012:outpost/op-0123456789abcdef1")
+
+					errorList := ValidateWorker(worker, awsZones, &apisaws.WorkerConfig{OutpostARN: pointer.String(outpostARN)}, field.NewPath("workers").Index(0))
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("workers[0].zones[1]"),
+							"Detail": Equal("zone is not placed on Outpost " + outpostARN),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("workers[0].zones[2]"),
+							"Detail": Equal("zone is placed on Outpost arn:aws:outposts:eu-west-1:123456789012:outpost/op-0123456789abcdef1"),
+						})),
+					))
+				})
+			})
+
+			It("should forbid an image selector with an architecture different from the worker pool", func() {
+				worker.Machine.Architecture = pointer.String("amd64")
+				workerConfig := &apisaws.WorkerConfig{ImageSelector: &apisaws.ImageSelector{
+					Owners:       []string{"self"},
+					Name:         pointer.String("nightly-*"),
+					Architecture: pointer.String("arm64"),
+				}}
+
+				errorList := ValidateWorker(worker, awsZones, workerConfig, field.NewPath("workers").Index(0))
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("workers[0].providerConfig.imageSelector.architecture"),
+						"Detail": Equal("must match the architecture amd64 of the worker pool"),
+					})),
+				))
+			})
+
+			It("should allow the device plugin for accelerated machine types", func() {
+				worker.Machine.Type = "g5.xlarge"
+				workerConfig := &apisaws.WorkerConfig{DevicePlugin: &apisaws.DevicePlugin{Enabled: true}}
+
+				Expect(ValidateWorker(worker, awsZones, workerConfig, field.NewPath("workers").Index(0))).To(BeEmpty())
+			})
+
+			It("should forbid the device plugin for machine types without supported accelerators", func() {
+				worker.Machine.Type = "m5.large"
+				workerConfig := &apisaws.WorkerConfig{DevicePlugin: &apisaws.DevicePlugin{Enabled: true}}
+
+				errorList := ValidateWorker(worker, awsZones, workerConfig, field.NewPath("workers").Index(0))
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("workers[0].machine.type"),
+					})),
+				))
+			})
+
+			Context("rollingUpdate", func() {
+				It("should allow replacing the machines without surge", func() {
+					maxSurge, maxUnavailable := intstr.FromInt(0), intstr.FromString("25%")
+					workerConfig := &apisaws.WorkerConfig{RollingUpdate: &apisaws.RollingUpdate{MaxSurge: &maxSurge, MaxUnavailable: &maxUnavailable}}
+
+					Expect(ValidateWorker(worker, awsZones, workerConfig, field.NewPath("workers").Index(0))).To(BeEmpty())
+				})
+
+				It("should forbid invalid values and a rolling update without progress", func() {
+					maxSurge, maxUnavailable := intstr.FromInt(-1), intstr.FromString("foo")
+					workerConfig := &apisaws.WorkerConfig{RollingUpdate: &apisaws.RollingUpdate{MaxSurge: &maxSurge, MaxUnavailable: &maxUnavailable}}
+
+					errorList := ValidateWorker(worker, awsZones, workerConfig, field.NewPath("workers").Index(0))
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("workers[0].providerConfig.rollingUpdate.maxSurge"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("workers[0].providerConfig.rollingUpdate.maxUnavailable"),
+						})),
+					))
+
+					maxSurge = intstr.FromInt(0)
+					workerConfig.RollingUpdate.MaxUnavailable = nil
+
+					errorList = ValidateWorker(worker, awsZones, workerConfig, field.NewPath("workers").Index(0))
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("workers[0].providerConfig.rollingUpdate"),
+							"Detail": Equal("maxSurge and maxUnavailable must not both be zero"),
+						})),
+					))
+				})
+			})
+
+			Context("preProvisionedCapacity", func() {
+				BeforeEach(func() {
+					worker.Minimum = 1
+					worker.Maximum = 5
+				})
+
+				It("should allow keeping machines available", func() {
+					workerConfig := &apisaws.WorkerConfig{PreProvisionedCapacity: &apisaws.PreProvisionedCapacity{
+						Machines:  2,
+						Resources: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+					}}
+
+					Expect(ValidateWorker(worker, awsZones, workerConfig, field.NewPath("workers").Index(0))).To(BeEmpty())
+				})
+
+				It("should forbid more machines than the maximum and negative resources", func() {
+					workerConfig := &apisaws.WorkerConfig{PreProvisionedCapacity: &apisaws.PreProvisionedCapacity{
+						Machines:  6,
+						Resources: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("-1Gi")},
+					}}
+
+					errorList := ValidateWorker(worker, awsZones, workerConfig, field.NewPath("workers").Index(0))
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("workers[0].providerConfig.preProvisionedCapacity.machines"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("workers[0].providerConfig.preProvisionedCapacity.resources.memory"),
+						})),
+					))
+				})
+
+				It("should forbid no machines and worker pools which cannot be scaled up", func() {
+					worker.Minimum = 5
+					workerConfig := &apisaws.WorkerConfig{PreProvisionedCapacity: &apisaws.PreProvisionedCapacity{}}
+
+					errorList := ValidateWorker(worker, awsZones, workerConfig, field.NewPath("workers").Index(0))
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("workers[0].providerConfig.preProvisionedCapacity.machines"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("workers[0].providerConfig.preProvisionedCapacity"),
+						})),
+					))
+				})
+			})
 		})
 
 		Describe("#ValidateWorkersUpdate", func() {
@@ -16,8 +16,14 @@ package validation
 
 import (
 	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
 
+	"github.com/BurntSushi/toml"
 	"github.com/gardener/gardener/pkg/apis/core"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"golang.org/x/exp/slices"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -26,6 +32,22 @@ import (
 
 	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 	apisawshelper "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+)
+
+var (
+	// valid values for capacityReservation.id
+	capacityReservationIDPattern = regexp.MustCompile(`^cr-[0-9a-f]+$`)
+	// valid ARNs of resource groups, e.g. for capacityReservation.resourceGroupArn
+	resourceGroupARNPattern = regexp.MustCompile(`^arn:[\w-]+:resource-groups:[a-z0-9-]+:\d{12}:group/[\w.-]+$`)
+	// valid KMS key ids, key ARNs, alias names and alias ARNs for volume.kmsKeyID
+	kmsKeyIDPattern = regexp.MustCompile(`^([0-9a-f-]{36}|mrk-[0-9a-f]{32}|alias/[\w/-]+|arn:[\w-]+:kms:[a-z0-9-]+:\d{12}:(key/[\w-]+|alias/[\w/-]+))$`)
+	// valid device names for dataVolumes[].deviceName
+	deviceNamePattern = regexp.MustCompile(`^/dev/(sd|xvd)[b-z]$`)
+	// valid owners of AMIs for imageSelector.owners, i.e. account ids or owner aliases
+	imageOwnerPattern = regexp.MustCompile(`^(\d{12}|self|amazon|aws-marketplace|aws-backup-vault)$`)
+	// valid registry hosts for containerd.registryMirrors[].upstream
+	registryHostPattern = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9.-]*[a-zA-Z0-9])?(:\d+)?$`)
 )
 
 // ValidateWorkerConfig validates a WorkerConfig object.
@@ -35,6 +57,13 @@ func ValidateWorkerConfig(workerConfig *apisaws.WorkerConfig, volume *core.Volum
 	if volume != nil && volume.Type != nil {
 		allErrs = append(allErrs, validateVolumeConfig(workerConfig.Volume, *volume.Type, fldPath.Child("volume"))...)
 	}
+	if workerConfig.Volume != nil && workerConfig.Volume.KMSKeyID != nil {
+		var encrypted *bool
+		if volume != nil {
+			encrypted = volume.Encrypted
+		}
+		allErrs = append(allErrs, validateKMSKeyID(*workerConfig.Volume.KMSKeyID, encrypted, fldPath.Child("volume", "kmsKeyID"))...)
+	}
 
 	var (
 		dataVolumeNames       = sets.New[string]()
@@ -54,6 +83,7 @@ func ValidateWorkerConfig(workerConfig *apisaws.WorkerConfig, volume *core.Volum
 		}
 	}
 
+	deviceNames := sets.New[string]()
 	for i, dv := range workerConfig.DataVolumes {
 		idxPath := fldPath.Child("dataVolumes").Index(i)
 
@@ -66,20 +96,31 @@ func ValidateWorkerConfig(workerConfig *apisaws.WorkerConfig, volume *core.Volum
 		} else {
 			dataVolumeConfigNames.Insert(dv.Name)
 		}
-	}
 
-	if iam := workerConfig.IAMInstanceProfile; iam != nil {
-		if (iam.Name == nil && iam.ARN == nil) || (iam.Name != nil && iam.ARN != nil) {
-			allErrs = append(allErrs, field.Invalid(fldPath.Child("iamInstanceProfile"), iam, "either <name> or <arn> must be provided"))
-		}
-		if iam.Name != nil && len(*iam.Name) == 0 {
-			allErrs = append(allErrs, field.Required(fldPath.Child("iamInstanceProfile", "name"), "name must not be empty"))
+		if dv.KMSKeyID != nil {
+			var encrypted *bool
+			for _, poolDataVolume := range dataVolumes {
+				if poolDataVolume.Name == dv.Name {
+					encrypted = poolDataVolume.Encrypted
+				}
+			}
+			allErrs = append(allErrs, validateKMSKeyID(*dv.KMSKeyID, encrypted, idxPath.Child("kmsKeyID"))...)
 		}
-		if iam.ARN != nil && len(*iam.ARN) == 0 {
-			allErrs = append(allErrs, field.Required(fldPath.Child("iamInstanceProfile", "arn"), "arn must not be empty"))
+
+		if dv.DeviceName != nil {
+			if !deviceNamePattern.MatchString(*dv.DeviceName) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("deviceName"), *dv.DeviceName, "must be a device name like /dev/sdf or /dev/xvdf"))
+			} else if deviceNames.Has(apisawshelper.NormalizeDeviceName(*dv.DeviceName)) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("deviceName"), *dv.DeviceName))
+			}
+			deviceNames.Insert(apisawshelper.NormalizeDeviceName(*dv.DeviceName))
 		}
 	}
 
+	if iam := workerConfig.IAMInstanceProfile; iam != nil {
+		allErrs = append(allErrs, validateIAMInstanceProfile(iam, fldPath.Child("iamInstanceProfile"))...)
+	}
+
 	if nodeTemplate := workerConfig.NodeTemplate; nodeTemplate != nil {
 		for _, capacityAttribute := range []corev1.ResourceName{"cpu", "gpu", "memory"} {
 			value, ok := nodeTemplate.Capacity[capacityAttribute]
@@ -93,6 +134,349 @@ func ValidateWorkerConfig(workerConfig *apisaws.WorkerConfig, volume *core.Volum
 
 	allErrs = append(allErrs, validateInstanceMetadata(workerConfig.InstanceMetadataOptions, fldPath.Child("instanceMetadataOptions"))...)
 
+	allErrs = append(allErrs, validateCapacityType(workerConfig, fldPath)...)
+
+	if workerConfig.CapacityReservation != nil {
+		allErrs = append(allErrs, validateCapacityReservation(workerConfig.CapacityReservation, fldPath.Child("capacityReservation"))...)
+	}
+
+	allErrs = append(allErrs, validateTenancy(workerConfig, fldPath)...)
+
+	if workerConfig.PlacementGroup != nil {
+		allErrs = append(allErrs, validatePlacementGroup(workerConfig.PlacementGroup, fldPath.Child("placementGroup"))...)
+	}
+
+	if cpuOptions := workerConfig.CPUOptions; cpuOptions != nil && cpuOptions.AmdSevSnp != nil {
+		validValues := []apisaws.AmdSevSnpSpecification{apisaws.AmdSevSnpEnabled, apisaws.AmdSevSnpDisabled}
+		if !slices.Contains(validValues, *cpuOptions.AmdSevSnp) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("cpuOptions", "amdSevSnp"), *cpuOptions.AmdSevSnp, fmt.Sprintf("only the following values are allowed: %v", validValues)))
+		}
+	}
+
+	securityGroupIDs := sets.New[string]()
+	for i, id := range workerConfig.AdditionalSecurityGroupIDs {
+		idxPath := fldPath.Child("additionalSecurityGroupIDs").Index(i)
+		if !strings.HasPrefix(id, "sg-") {
+			allErrs = append(allErrs, field.Invalid(idxPath, id, "must start with sg-"))
+		}
+		if securityGroupIDs.Has(id) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, id))
+		}
+		securityGroupIDs.Insert(id)
+	}
+
+	if workerConfig.ImageSelector != nil {
+		allErrs = append(allErrs, validateImageSelector(workerConfig.ImageSelector, fldPath.Child("imageSelector"))...)
+	}
+
+	allErrs = append(allErrs, ValidateTags(fldPath.Child("tags"), workerConfig.Tags)...)
+
+	if workerConfig.UserData != nil {
+		allErrs = append(allErrs, validateUserData(workerConfig.UserData, fldPath.Child("userData"))...)
+	}
+
+	if workerConfig.Containerd != nil {
+		allErrs = append(allErrs, validateContainerd(workerConfig.Containerd, fldPath.Child("containerd"))...)
+	}
+
+	if workerConfig.NetworkInterfaces != nil {
+		allErrs = append(allErrs, validateNetworkInterfaces(workerConfig.NetworkInterfaces, workerConfig.PlacementGroup, fldPath)...)
+	}
+
+	if outpostARN := workerConfig.OutpostARN; outpostARN != nil {
+		if !outpostARNPattern.MatchString(*outpostARN) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("outpostARN"), *outpostARN, "must be a valid Outpost ARN, e.g. arn:aws:outposts:eu-west-1:123456789012:outpost/op-0123456789abcdef0"))
+		}
+		if workerConfig.CapacityType != nil && *workerConfig.CapacityType == apisaws.CapacityTypeSpot {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("capacityType"), "spot instances are not supported on Outposts"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateImageSelector(selector *apisaws.ImageSelector, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(selector.Owners) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("owners"), "at least one owner must be specified"))
+	}
+	owners := sets.New[string]()
+	for i, owner := range selector.Owners {
+		idxPath := fldPath.Child("owners").Index(i)
+		if !imageOwnerPattern.MatchString(owner) {
+			allErrs = append(allErrs, field.Invalid(idxPath, owner, "must be an account id or one of the aliases self, amazon, aws-marketplace or aws-backup-vault"))
+		} else if owners.Has(owner) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, owner))
+		}
+		owners.Insert(owner)
+	}
+
+	if selector.Name != nil && len(*selector.Name) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), *selector.Name, "must not be empty"))
+	}
+	if selector.Name == nil && len(selector.Tags) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "either name or tags must be specified"))
+	}
+	for key := range selector.Tags {
+		if len(key) == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("tags"), key, "tag keys must not be empty"))
+		}
+	}
+
+	if selector.Architecture != nil && !slices.Contains(v1beta1constants.ValidArchitectures, *selector.Architecture) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("architecture"), *selector.Architecture, v1beta1constants.ValidArchitectures))
+	}
+
+	return allErrs
+}
+
+// userDataContentTypes are the MIME types of the custom user data parts which are supported by cloud-init.
+var userDataContentTypes = sets.New(apisawshelper.UserDataContentTypeShellScript, "text/cloud-config", "text/cloud-boothook")
+
+func validateUserData(userData *apisaws.UserData, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	valid := true
+	validateParts := func(parts []apisaws.UserDataPart, partsPath *field.Path) {
+		for i, part := range parts {
+			idxPath := partsPath.Index(i)
+
+			if contentType := apisawshelper.GetUserDataPartContentType(part); !userDataContentTypes.Has(contentType) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("contentType"), contentType, sets.List(userDataContentTypes)))
+			}
+			if part.Encoding != nil && *part.Encoding != apisaws.UserDataEncodingBase64 && *part.Encoding != apisaws.UserDataEncodingGzipBase64 {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("encoding"), *part.Encoding, []string{string(apisaws.UserDataEncodingBase64), string(apisaws.UserDataEncodingGzipBase64)}))
+				valid = false
+				continue
+			}
+
+			content, err := apisawshelper.DecodeUserDataPart(part)
+			if err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("content"), part.Content, err.Error()))
+				valid = false
+				continue
+			}
+			if len(content) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("content"), "content must not be empty"))
+			}
+		}
+	}
+	validateParts(userData.PreBootstrap, fldPath.Child("preBootstrap"))
+	validateParts(userData.PostBootstrap, fldPath.Child("postBootstrap"))
+
+	// The Gardener node bootstrap is only added by the worker controller, but the user data can't fit into the limit if
+	// the custom parts alone exceed it even if compressed.
+	if valid {
+		userDataPayload, err := aws.BuildUserData(nil, userData)
+		if err == nil {
+			_, err = aws.CompressUserData(userDataPayload)
+		}
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath, len(userDataPayload), fmt.Sprintf("custom parts exceed the user data limit of EC2: %v", err)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateContainerd(containerd *apisaws.ContainerdConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	upstreams := sets.New[string]()
+	for i, mirror := range containerd.RegistryMirrors {
+		idxPath := fldPath.Child("registryMirrors").Index(i)
+
+		if len(mirror.Upstream) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("upstream"), "upstream must be provided"))
+		} else if !registryHostPattern.MatchString(mirror.Upstream) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("upstream"), mirror.Upstream, "must be a host, optionally with port, e.g. docker.io"))
+		} else if upstreams.Has(mirror.Upstream) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("upstream"), mirror.Upstream))
+		}
+		upstreams.Insert(mirror.Upstream)
+
+		if len(mirror.Endpoints) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("endpoints"), "at least one endpoint must be provided"))
+		}
+		for j, endpoint := range mirror.Endpoints {
+			if u, err := url.Parse(endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("endpoints").Index(j), endpoint, "must be a http or https URL"))
+			}
+		}
+	}
+
+	if containerd.ConfigPatch != nil {
+		if len(strings.TrimSpace(*containerd.ConfigPatch)) == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("configPatch"), *containerd.ConfigPatch, "must not be empty"))
+		} else if _, err := toml.Decode(*containerd.ConfigPatch, &map[string]interface{}{}); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("configPatch"), *containerd.ConfigPatch, fmt.Sprintf("must be valid TOML: %v", err)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateNetworkInterfaces(networkInterfaces *apisaws.NetworkInterfaces, placementGroup *apisaws.PlacementGroup, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		efa     = networkInterfaces.EFA
+		path    = fldPath.Child("networkInterfaces")
+	)
+
+	for i, networkInterface := range networkInterfaces.Additional {
+		idxPath := path.Child("additional").Index(i)
+
+		if networkInterface.Count != nil && *networkInterface.Count < 1 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("count"), *networkInterface.Count, "must be at least 1"))
+		}
+
+		if networkInterface.EFA {
+			efa = true
+			if len(networkInterface.Subnets) > 0 {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("subnets"), "Elastic Fabric Adapters must be in the nodes subnet"))
+			}
+		}
+
+		zones := sets.New[string]()
+		for j, subnet := range networkInterface.Subnets {
+			subnetPath := idxPath.Child("subnets").Index(j)
+			if len(subnet.Zone) == 0 {
+				allErrs = append(allErrs, field.Required(subnetPath.Child("zone"), "zone must be provided"))
+			} else if zones.Has(subnet.Zone) {
+				allErrs = append(allErrs, field.Duplicate(subnetPath.Child("zone"), subnet.Zone))
+			}
+			zones.Insert(subnet.Zone)
+			if !strings.HasPrefix(subnet.ID, "subnet-") {
+				allErrs = append(allErrs, field.Invalid(subnetPath.Child("id"), subnet.ID, "must start with subnet-"))
+			}
+		}
+
+		securityGroupIDs := sets.New[string]()
+		for j, id := range networkInterface.SecurityGroupIDs {
+			sgPath := idxPath.Child("securityGroupIDs").Index(j)
+			if !strings.HasPrefix(id, "sg-") {
+				allErrs = append(allErrs, field.Invalid(sgPath, id, "must start with sg-"))
+			}
+			if securityGroupIDs.Has(id) {
+				allErrs = append(allErrs, field.Duplicate(sgPath, id))
+			}
+			securityGroupIDs.Insert(id)
+		}
+	}
+
+	// Elastic Fabric Adapters only reach their low latency within a cluster placement group.
+	if efa {
+		if placementGroup == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("placementGroup"), "a placement group with strategy cluster is required for Elastic Fabric Adapters"))
+		} else if placementGroup.Strategy != apisaws.PlacementGroupStrategyCluster {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("placementGroup", "strategy"), placementGroup.Strategy, "must be cluster for Elastic Fabric Adapters"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateCapacityType(workerConfig *apisaws.WorkerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	capacityType := apisaws.CapacityTypeOnDemand
+	if workerConfig.CapacityType != nil {
+		capacityType = *workerConfig.CapacityType
+		validValues := []apisaws.CapacityType{apisaws.CapacityTypeOnDemand, apisaws.CapacityTypeSpot}
+		if !slices.Contains(validValues, capacityType) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("capacityType"), capacityType, fmt.Sprintf("only the following values are allowed: %v", validValues)))
+		}
+	}
+
+	if price := workerConfig.SpotMaxPrice; price != nil {
+		if capacityType != apisaws.CapacityTypeSpot {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("spotMaxPrice"), "is only allowed for capacity type spot"))
+		} else if value, err := strconv.ParseFloat(*price, 64); err != nil || value <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("spotMaxPrice"), *price, "must be a positive decimal number"))
+		}
+	}
+
+	if workerConfig.SpotInterruptionHandling != nil && capacityType != apisaws.CapacityTypeSpot {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("spotInterruptionHandling"), "is only allowed for capacity type spot"))
+	}
+
+	return allErrs
+}
+
+func validateTenancy(workerConfig *apisaws.WorkerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	tenancy := apisaws.TenancyDefault
+	if workerConfig.Tenancy != nil {
+		tenancy = *workerConfig.Tenancy
+		validValues := []apisaws.Tenancy{apisaws.TenancyDefault, apisaws.TenancyDedicated, apisaws.TenancyHost}
+		if !slices.Contains(validValues, tenancy) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("tenancy"), tenancy, fmt.Sprintf("only the following values are allowed: %v", validValues)))
+		}
+	}
+
+	if tenancy == apisaws.TenancyHost && workerConfig.CapacityType != nil && *workerConfig.CapacityType == apisaws.CapacityTypeSpot {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("tenancy"), "spot instances cannot be launched on dedicated hosts"))
+	}
+
+	if arn := workerConfig.HostResourceGroupArn; arn != nil {
+		if tenancy != apisaws.TenancyHost {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("hostResourceGroupArn"), "is only allowed for tenancy host"))
+		} else if !resourceGroupARNPattern.MatchString(*arn) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("hostResourceGroupArn"), *arn, "must be a resource group ARN"))
+		}
+	}
+
+	return allErrs
+}
+
+func validatePlacementGroup(placementGroup *apisaws.PlacementGroup, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	validValues := []apisaws.PlacementGroupStrategy{apisaws.PlacementGroupStrategyCluster, apisaws.PlacementGroupStrategySpread, apisaws.PlacementGroupStrategyPartition}
+	if len(placementGroup.Strategy) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("strategy"), "must specify a placement strategy"))
+	} else if !slices.Contains(validValues, placementGroup.Strategy) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("strategy"), placementGroup.Strategy, fmt.Sprintf("only the following values are allowed: %v", validValues)))
+	}
+
+	if count := placementGroup.PartitionCount; count != nil {
+		if placementGroup.Strategy != apisaws.PlacementGroupStrategyPartition {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("partitionCount"), "is only allowed for strategy partition"))
+		} else if *count < 1 || *count > 7 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("partitionCount"), *count, "must be between 1 and 7"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateCapacityReservation(reservation *apisaws.CapacityReservation, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch {
+	case reservation.ID == nil && reservation.ResourceGroupArn == nil && reservation.Preference == nil:
+		allErrs = append(allErrs, field.Required(fldPath, "must specify either id, resourceGroupArn or preference"))
+	case reservation.ID != nil && reservation.ResourceGroupArn != nil:
+		allErrs = append(allErrs, field.Forbidden(fldPath, "must not specify both id and resourceGroupArn"))
+	case (reservation.ID != nil || reservation.ResourceGroupArn != nil) && reservation.Preference != nil:
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("preference"), "must not be specified together with id or resourceGroupArn"))
+	}
+
+	if reservation.ID != nil && !capacityReservationIDPattern.MatchString(*reservation.ID) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("id"), *reservation.ID, "must be a capacity reservation id, e.g. cr-123456"))
+	}
+	if reservation.ResourceGroupArn != nil && !resourceGroupARNPattern.MatchString(*reservation.ResourceGroupArn) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("resourceGroupArn"), *reservation.ResourceGroupArn, "must be a resource group ARN"))
+	}
+	if reservation.Preference != nil {
+		validValues := []apisaws.CapacityReservationPreference{apisaws.CapacityReservationPreferenceOpen, apisaws.CapacityReservationPreferenceNone}
+		if !slices.Contains(validValues, *reservation.Preference) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("preference"), *reservation.Preference, fmt.Sprintf("only the following values are allowed: %v", validValues)))
+		}
+	}
+
 	return allErrs
 }
 
@@ -123,6 +507,17 @@ func validateVolumeConfig(volume *apisaws.Volume, volumeType string, fldPath *fi
 	return allErrs
 }
 
+func validateKMSKeyID(kmsKeyID string, encrypted *bool, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if !kmsKeyIDPattern.MatchString(kmsKeyID) {
+		allErrs = append(allErrs, field.Invalid(fldPath, kmsKeyID, "must be a KMS key id, key ARN, alias name or alias ARN"))
+	}
+	if encrypted != nil && !*encrypted {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "must not be specified for unencrypted volumes"))
+	}
+	return allErrs
+}
+
 func validateInstanceMetadata(md *apisaws.InstanceMetadataOptions, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 	if md == nil {
@@ -143,5 +538,28 @@ func validateInstanceMetadata(md *apisaws.InstanceMetadataOptions, fldPath *fiel
 			allErrs = append(allErrs, field.Invalid(fldPath.Child("httpTokens"), *md.HTTPTokens, fmt.Sprintf("only the following values are allowed: %v", validValues)))
 		}
 	}
+
+	if md.InstanceMetadataTags != nil {
+		validValues := []apisaws.InstanceMetadataTagsValue{apisaws.InstanceMetadataTagsEnabled, apisaws.InstanceMetadataTagsDisabled}
+		if !slices.Contains(validValues, *md.InstanceMetadataTags) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("instanceMetadataTags"), *md.InstanceMetadataTags, fmt.Sprintf("only the following values are allowed: %v", validValues)))
+		}
+	}
+	return allErrs
+}
+
+func validateIAMInstanceProfile(iam *apisaws.IAMInstanceProfile, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if (iam.Name == nil && iam.ARN == nil) || (iam.Name != nil && iam.ARN != nil) {
+		allErrs = append(allErrs, field.Invalid(fldPath, iam, "either <name> or <arn> must be provided"))
+	}
+	if iam.Name != nil && len(*iam.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "name must not be empty"))
+	}
+	if iam.ARN != nil && len(*iam.ARN) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("arn"), "arn must not be empty"))
+	}
+
 	return allErrs
 }
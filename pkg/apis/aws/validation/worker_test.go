@@ -15,6 +15,11 @@
 package validation_test
 
 import (
+	"encoding/base64"
+	"encoding/hex"
+	"math/rand"
+	"strings"
+
 	"github.com/gardener/gardener/pkg/apis/core"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
@@ -318,6 +323,19 @@ var _ = Describe("ValidateWorkerConfig", func() {
 				Expect(errList).To(BeEmpty())
 			})
 
+			It("should allow enforcing IMDSv2 with access to instance tags", func() {
+				v := apisaws.HTTPTokensRequired
+				tags := apisaws.InstanceMetadataTagsEnabled
+				worker.InstanceMetadataOptions = &apisaws.InstanceMetadataOptions{
+					HTTPPutResponseHopLimit: pointer.Int64(2),
+					HTTPTokens:              &v,
+					InstanceMetadataTags:    &tags,
+				}
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(BeEmpty())
+			})
+
 			It("httpTokens should only contain valid values", func() {
 				v := apisaws.HTTPTokensValue("foobar")
 				worker.InstanceMetadataOptions = &apisaws.InstanceMetadataOptions{
@@ -344,6 +362,624 @@ var _ = Describe("ValidateWorkerConfig", func() {
 					"Detail": Equal("only values between 1 and 64 are allowed"),
 				}))))
 			})
+
+			It("instanceMetadataTags should only contain valid values", func() {
+				v := apisaws.InstanceMetadataTagsValue("foobar")
+				worker.InstanceMetadataOptions = &apisaws.InstanceMetadataOptions{
+					InstanceMetadataTags: &v,
+				}
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("config.instanceMetadataOptions.instanceMetadataTags"),
+					"Detail": Equal("only the following values are allowed: [enabled disabled]"),
+				}))))
+			})
+		})
+
+		Context("capacityType", func() {
+			It("should allow spot instances with a maximum price", func() {
+				capacityType := apisaws.CapacityTypeSpot
+				worker.CapacityType = &capacityType
+				worker.SpotMaxPrice = pointer.String("0.05")
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(BeEmpty())
+			})
+
+			It("should forbid invalid capacity types and prices", func() {
+				capacityType := apisaws.CapacityType("reserved")
+				worker.CapacityType = &capacityType
+				worker.SpotMaxPrice = pointer.String("0.05")
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.capacityType"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.spotMaxPrice"),
+				}))))
+			})
+
+			It("should forbid invalid spot prices", func() {
+				capacityType := apisaws.CapacityTypeSpot
+				worker.CapacityType = &capacityType
+				worker.SpotMaxPrice = pointer.String("cheap")
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.spotMaxPrice"),
+				}))))
+			})
+
+			It("should forbid the spot interruption handling for on-demand instances", func() {
+				worker.SpotInterruptionHandling = &apisaws.SpotInterruptionHandling{DrainOnRebalanceRecommendation: true}
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.spotInterruptionHandling"),
+				}))))
+
+				capacityType := apisaws.CapacityTypeSpot
+				worker.CapacityType = &capacityType
+
+				Expect(ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)).To(BeEmpty())
+			})
+		})
+
+		Context("volume encryption and device names", func() {
+			It("should allow valid KMS keys and device names", func() {
+				worker.Volume.KMSKeyID = pointer.String("arn:aws:kms:eu-west-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab")
+				worker.DataVolumes[0].KMSKeyID = pointer.String("alias/my-key")
+				worker.DataVolumes[0].DeviceName = pointer.String("/dev/sdg")
+				worker.DataVolumes = append(worker.DataVolumes, apisaws.DataVolume{
+					Name:       dataVolume2Name,
+					Volume:     apisaws.Volume{KMSKeyID: pointer.String("1234abcd-12ab-34cd-56ef-1234567890ab")},
+					DeviceName: pointer.String("/dev/xvdf"),
+				})
+
+				Expect(ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid invalid KMS keys and KMS keys for unencrypted volumes", func() {
+				dataVolumes[0].Encrypted = pointer.Bool(false)
+				worker.Volume.KMSKeyID = pointer.String("my-key")
+				worker.DataVolumes[0].KMSKeyID = pointer.String("alias/my-key")
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.volume.kmsKeyID"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.dataVolumes[0].kmsKeyID"),
+				}))))
+			})
+
+			It("should forbid invalid and duplicate device names regardless of their prefix", func() {
+				worker.DataVolumes[0].DeviceName = pointer.String("/dev/sda1")
+				worker.DataVolumes = append(worker.DataVolumes, apisaws.DataVolume{
+					Name:       dataVolume2Name,
+					DeviceName: pointer.String("/dev/sdf"),
+				}, apisaws.DataVolume{
+					Name:       dataVolume3Name,
+					DeviceName: pointer.String("/dev/xvdf"),
+				})
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.dataVolumes[0].deviceName"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("config.dataVolumes[2].deviceName"),
+				}))))
+			})
+		})
+
+		Context("capacityReservation", func() {
+			It("should allow valid capacity reservations", func() {
+				worker.CapacityReservation = &apisaws.CapacityReservation{ID: pointer.String("cr-0123456789abcdef0")}
+				Expect(ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)).To(BeEmpty())
+
+				worker.CapacityReservation = &apisaws.CapacityReservation{ResourceGroupArn: pointer.String("arn:aws:resource-groups:eu-west-1:123456789012:group/my-reservations")}
+				Expect(ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)).To(BeEmpty())
+
+				preference := apisaws.CapacityReservationPreferenceNone
+				worker.CapacityReservation = &apisaws.CapacityReservation{Preference: &preference}
+				Expect(ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)).To(BeEmpty())
+			})
+
+			It("should require a target or preference", func() {
+				worker.CapacityReservation = &apisaws.CapacityReservation{}
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("config.capacityReservation"),
+				}))))
+			})
+
+			It("should forbid invalid targets and preferences", func() {
+				preference := apisaws.CapacityReservationPreference("always")
+				worker.CapacityReservation = &apisaws.CapacityReservation{
+					ID:               pointer.String("123456"),
+					ResourceGroupArn: pointer.String("arn:aws:iam::123456789012:role/foo"),
+					Preference:       &preference,
+				}
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.capacityReservation"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.capacityReservation.id"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.capacityReservation.resourceGroupArn"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.capacityReservation.preference"),
+				}))))
+			})
+		})
+
+		Context("tenancy", func() {
+			It("should allow valid tenancies", func() {
+				tenancy := apisaws.TenancyDedicated
+				worker.Tenancy = &tenancy
+				Expect(ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)).To(BeEmpty())
+
+				tenancy = apisaws.TenancyHost
+				worker.HostResourceGroupArn = pointer.String("arn:aws:resource-groups:eu-west-1:123456789012:group/my-hosts")
+				Expect(ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid invalid tenancies", func() {
+				tenancy := apisaws.Tenancy("shared")
+				worker.Tenancy = &tenancy
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.tenancy"),
+				}))))
+			})
+
+			It("should forbid hostResourceGroupArn for other tenancies than host", func() {
+				tenancy := apisaws.TenancyDedicated
+				worker.Tenancy = &tenancy
+				worker.HostResourceGroupArn = pointer.String("arn:aws:resource-groups:eu-west-1:123456789012:group/my-hosts")
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.hostResourceGroupArn"),
+				}))))
+			})
+
+			It("should forbid invalid hostResourceGroupArns and spot instances on dedicated hosts", func() {
+				tenancy := apisaws.TenancyHost
+				capacityType := apisaws.CapacityTypeSpot
+				worker.Tenancy = &tenancy
+				worker.CapacityType = &capacityType
+				worker.HostResourceGroupArn = pointer.String("my-hosts")
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.tenancy"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.hostResourceGroupArn"),
+				}))))
+			})
+		})
+
+		Context("placementGroup", func() {
+			It("should allow valid placement groups", func() {
+				worker.PlacementGroup = &apisaws.PlacementGroup{Strategy: apisaws.PlacementGroupStrategyCluster}
+				Expect(ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)).To(BeEmpty())
+
+				worker.PlacementGroup = &apisaws.PlacementGroup{Strategy: apisaws.PlacementGroupStrategyPartition, PartitionCount: pointer.Int64(3)}
+				Expect(ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid missing or invalid strategies", func() {
+				worker.PlacementGroup = &apisaws.PlacementGroup{}
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("config.placementGroup.strategy"),
+				}))))
+
+				worker.PlacementGroup = &apisaws.PlacementGroup{Strategy: "rack"}
+
+				errList = ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.placementGroup.strategy"),
+				}))))
+			})
+
+			It("should forbid partitionCount for other strategies than partition", func() {
+				worker.PlacementGroup = &apisaws.PlacementGroup{Strategy: apisaws.PlacementGroupStrategySpread, PartitionCount: pointer.Int64(3)}
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.placementGroup.partitionCount"),
+				}))))
+			})
+
+			It("should forbid invalid partitionCounts", func() {
+				worker.PlacementGroup = &apisaws.PlacementGroup{Strategy: apisaws.PlacementGroupStrategyPartition, PartitionCount: pointer.Int64(8)}
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.placementGroup.partitionCount"),
+				}))))
+			})
+		})
+
+		Context("cpuOptions", func() {
+			It("should allow valid amdSevSnp values", func() {
+				amdSevSnp := apisaws.AmdSevSnpEnabled
+				worker.CPUOptions = &apisaws.CPUOptions{AmdSevSnp: &amdSevSnp}
+
+				Expect(ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid invalid amdSevSnp values", func() {
+				amdSevSnp := apisaws.AmdSevSnpSpecification("true")
+				worker.CPUOptions = &apisaws.CPUOptions{AmdSevSnp: &amdSevSnp}
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.cpuOptions.amdSevSnp"),
+				}))))
+			})
+		})
+
+		Context("additionalSecurityGroupIDs", func() {
+			It("should allow valid security group ids", func() {
+				worker.AdditionalSecurityGroupIDs = []string{"sg-123456", "sg-abcdef"}
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(BeEmpty())
+			})
+
+			It("should forbid invalid and duplicate security group ids", func() {
+				worker.AdditionalSecurityGroupIDs = []string{"sg-123456", "123456", "sg-123456"}
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.additionalSecurityGroupIDs[1]"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("config.additionalSecurityGroupIDs[2]"),
+				}))))
+			})
+		})
+
+		Context("outpostARN", func() {
+			It("should allow a valid Outpost ARN", func() {
+				worker.OutpostARN = pointer.String("arn:aws:outposts:eu-west-1:123456789012:outpost/op-0123456789abcdef0")
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(BeEmpty())
+			})
+
+			It("should forbid invalid Outpost ARNs and spot instances", func() {
+				worker.OutpostARN = pointer.String("arn:aws:ec2:eu-west-1:123456789012:outpost/op-0123456789abcdef0")
+				capacityType := apisaws.CapacityTypeSpot
+				worker.CapacityType = &capacityType
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.outpostARN"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.capacityType"),
+				}))))
+			})
+		})
+
+		Context("tags", func() {
+			It("should forbid reserved tag keys", func() {
+				worker.Tags = map[string]string{"cost-center": "1234", "Name": "foo"}
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.tags[Name]"),
+				}))))
+			})
+		})
+
+		Context("userData", func() {
+			It("should allow valid user data parts", func() {
+				base64Encoding := apisaws.UserDataEncodingBase64
+				worker.UserData = &apisaws.UserData{
+					PreBootstrap: []apisaws.UserDataPart{
+						{Content: "#!/bin/bash\necho pre"},
+						{ContentType: pointer.String("text/cloud-config"), Content: base64.StdEncoding.EncodeToString([]byte("#cloud-config\n")), Encoding: &base64Encoding},
+					},
+					PostBootstrap: []apisaws.UserDataPart{{Content: "#!/bin/bash\necho post"}},
+				}
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(BeEmpty())
+			})
+
+			It("should forbid invalid user data parts", func() {
+				base64Encoding := apisaws.UserDataEncodingBase64
+				unknownEncoding := apisaws.UserDataEncoding("foo")
+				worker.UserData = &apisaws.UserData{
+					PreBootstrap: []apisaws.UserDataPart{
+						{ContentType: pointer.String("text/plain"), Content: "foo"},
+						{Content: "not base64", Encoding: &base64Encoding},
+					},
+					PostBootstrap: []apisaws.UserDataPart{
+						{Content: "foo", Encoding: &unknownEncoding},
+						{Content: ""},
+					},
+				}
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("config.userData.preBootstrap[0].contentType"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.userData.preBootstrap[1].content"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("config.userData.postBootstrap[0].encoding"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("config.userData.postBootstrap[1].content"),
+				}))))
+			})
+
+			It("should allow user data parts exceeding the limit of EC2 if they can be compressed", func() {
+				worker.UserData = &apisaws.UserData{
+					PreBootstrap:  []apisaws.UserDataPart{{Content: strings.Repeat("a", 10*1024)}},
+					PostBootstrap: []apisaws.UserDataPart{{Content: strings.Repeat("b", 10*1024)}},
+				}
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(BeEmpty())
+			})
+
+			It("should forbid user data parts exceeding the limit of EC2 even if compressed", func() {
+				random := make([]byte, 20*1024)
+				rand.New(rand.NewSource(1)).Read(random)
+				worker.UserData = &apisaws.UserData{
+					PreBootstrap: []apisaws.UserDataPart{{Content: hex.EncodeToString(random)}},
+				}
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.userData"),
+				}))))
+			})
+		})
+
+		Context("networkInterfaces", func() {
+			It("should allow Elastic Fabric Adapters and additional network interfaces in a cluster placement group", func() {
+				worker.PlacementGroup = &apisaws.PlacementGroup{Strategy: apisaws.PlacementGroupStrategyCluster}
+				worker.NetworkInterfaces = &apisaws.NetworkInterfaces{
+					EFA: true,
+					Additional: []apisaws.AdditionalNetworkInterface{
+						{EFA: true, Count: pointer.Int32(3)},
+						{Subnets: []apisaws.NetworkInterfaceSubnet{{Zone: "zone1", ID: "subnet-1"}}, SecurityGroupIDs: []string{"sg-1"}},
+					},
+				}
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(BeEmpty())
+			})
+
+			It("should allow additional network interfaces without placement group", func() {
+				worker.NetworkInterfaces = &apisaws.NetworkInterfaces{
+					Additional: []apisaws.AdditionalNetworkInterface{{Count: pointer.Int32(2)}},
+				}
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(BeEmpty())
+			})
+
+			It("should forbid invalid additional network interfaces", func() {
+				worker.NetworkInterfaces = &apisaws.NetworkInterfaces{
+					Additional: []apisaws.AdditionalNetworkInterface{
+						{Count: pointer.Int32(0)},
+						{
+							Subnets:          []apisaws.NetworkInterfaceSubnet{{Zone: "zone1", ID: "subnet-1"}, {Zone: "zone1", ID: "foo"}, {ID: "subnet-2"}},
+							SecurityGroupIDs: []string{"sg-1", "sg-1", "foo"},
+						},
+					},
+				}
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.networkInterfaces.additional[0].count"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("config.networkInterfaces.additional[1].subnets[1].zone"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.networkInterfaces.additional[1].subnets[1].id"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("config.networkInterfaces.additional[1].subnets[2].zone"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("config.networkInterfaces.additional[1].securityGroupIDs[1]"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.networkInterfaces.additional[1].securityGroupIDs[2]"),
+				}))))
+			})
+
+			It("should forbid Elastic Fabric Adapters in other subnets", func() {
+				worker.PlacementGroup = &apisaws.PlacementGroup{Strategy: apisaws.PlacementGroupStrategyCluster}
+				worker.NetworkInterfaces = &apisaws.NetworkInterfaces{
+					Additional: []apisaws.AdditionalNetworkInterface{{EFA: true, Subnets: []apisaws.NetworkInterfaceSubnet{{Zone: "zone1", ID: "subnet-1"}}}},
+				}
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.networkInterfaces.additional[0].subnets"),
+				}))))
+			})
+
+			It("should require a placement group for Elastic Fabric Adapters", func() {
+				worker.NetworkInterfaces = &apisaws.NetworkInterfaces{EFA: true}
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("config.placementGroup"),
+				}))))
+			})
+
+			It("should forbid Elastic Fabric Adapters in placement groups with another strategy", func() {
+				worker.PlacementGroup = &apisaws.PlacementGroup{Strategy: apisaws.PlacementGroupStrategySpread}
+				worker.NetworkInterfaces = &apisaws.NetworkInterfaces{
+					Additional: []apisaws.AdditionalNetworkInterface{{EFA: true}},
+				}
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.placementGroup.strategy"),
+				}))))
+			})
+		})
+
+		Context("containerd", func() {
+			It("should allow valid registry mirrors and a valid configuration patch", func() {
+				worker.Containerd = &apisaws.ContainerdConfig{
+					RegistryMirrors: []apisaws.RegistryMirror{
+						{Upstream: "docker.io", Endpoints: []string{"https://mirror.example.com"}},
+						{Upstream: "registry.example.com:5000", Endpoints: []string{"http://10.0.0.1:5000", "https://mirror.example.com"}},
+					},
+					ConfigPatch: pointer.String("[plugins.\"io.containerd.grpc.v1.cri\"]\n  enable_cdi = true\n"),
+				}
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(BeEmpty())
+			})
+
+			It("should forbid invalid registry mirrors", func() {
+				worker.Containerd = &apisaws.ContainerdConfig{
+					RegistryMirrors: []apisaws.RegistryMirror{
+						{Upstream: "", Endpoints: []string{"https://mirror.example.com"}},
+						{Upstream: "https://docker.io", Endpoints: []string{"https://mirror.example.com"}},
+						{Upstream: "ghcr.io", Endpoints: []string{"ftp://mirror.example.com", "mirror.example.com"}},
+						{Upstream: "ghcr.io"},
+					},
+				}
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("config.containerd.registryMirrors[0].upstream"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.containerd.registryMirrors[1].upstream"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.containerd.registryMirrors[2].endpoints[0]"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.containerd.registryMirrors[2].endpoints[1]"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("config.containerd.registryMirrors[3].upstream"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("config.containerd.registryMirrors[3].endpoints"),
+				}))))
+			})
+
+			DescribeTable("should forbid invalid configuration patches",
+				func(configPatch string) {
+					worker.Containerd = &apisaws.ContainerdConfig{ConfigPatch: &configPatch}
+
+					errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+					Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.containerd.configPatch"),
+					}))))
+				},
+				Entry("empty", " \n"),
+				Entry("invalid TOML", "[plugins\nfoo = "),
+			)
+		})
+
+		Context("imageSelector", func() {
+			It("should allow a valid image selector", func() {
+				worker.ImageSelector = &apisaws.ImageSelector{
+					Owners:       []string{"self", "123456789012"},
+					Name:         pointer.String("nightly-*"),
+					Tags:         map[string]string{"channel": "nightly"},
+					Architecture: pointer.String("arm64"),
+				}
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(BeEmpty())
+			})
+
+			It("should forbid image selectors without owners, name and tags", func() {
+				worker.ImageSelector = &apisaws.ImageSelector{}
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("config.imageSelector.owners"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("config.imageSelector"),
+				}))))
+			})
+
+			It("should forbid invalid and duplicate owners and unsupported architectures", func() {
+				worker.ImageSelector = &apisaws.ImageSelector{
+					Owners:       []string{"self", "someone", "self"},
+					Name:         pointer.String(""),
+					Architecture: pointer.String("s390x"),
+				}
+
+				errList := ValidateWorkerConfig(worker, rootVolumeIO1, dataVolumes, fldPath)
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.imageSelector.owners[1]"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("config.imageSelector.owners[2]"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.imageSelector.name"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("config.imageSelector.architecture"),
+				}))))
+			})
 		})
 	})
 })
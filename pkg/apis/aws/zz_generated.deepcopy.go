@@ -23,63 +23,93 @@ package aws
 
 import (
 	v1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CloudControllerManagerConfig) DeepCopyInto(out *CloudControllerManagerConfig) {
+func (in *AdditionalNetworkInterface) DeepCopyInto(out *AdditionalNetworkInterface) {
 	*out = *in
-	if in.FeatureGates != nil {
-		in, out := &in.FeatureGates, &out.FeatureGates
-		*out = make(map[string]bool, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.UseCustomRouteController != nil {
-		in, out := &in.UseCustomRouteController, &out.UseCustomRouteController
-		*out = new(bool)
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int32)
 		**out = **in
 	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]NetworkInterfaceSubnet, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudControllerManagerConfig.
-func (in *CloudControllerManagerConfig) DeepCopy() *CloudControllerManagerConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalNetworkInterface.
+func (in *AdditionalNetworkInterface) DeepCopy() *AdditionalNetworkInterface {
 	if in == nil {
 		return nil
 	}
-	out := new(CloudControllerManagerConfig)
+	out := new(AdditionalNetworkInterface)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CloudProfileConfig) DeepCopyInto(out *CloudProfileConfig) {
+func (in *BackupBucketConfig) DeepCopyInto(out *BackupBucketConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	if in.MachineImages != nil {
-		in, out := &in.MachineImages, &out.MachineImages
-		*out = make([]MachineImages, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.ObjectLock != nil {
+		in, out := &in.ObjectLock, &out.ObjectLock
+		*out = new(ObjectLockConfig)
+		**out = **in
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(BucketEncryption)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = new(BucketLifecycle)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Replication != nil {
+		in, out := &in.Replication, &out.Replication
+		*out = new(BucketReplication)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccessLogging != nil {
+		in, out := &in.AccessLogging, &out.AccessLogging
+		*out = new(BucketAccessLogging)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hardening != nil {
+		in, out := &in.Hardening, &out.Hardening
+		*out = new(BucketHardening)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudProfileConfig.
-func (in *CloudProfileConfig) DeepCopy() *CloudProfileConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupBucketConfig.
+func (in *BackupBucketConfig) DeepCopy() *BackupBucketConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(CloudProfileConfig)
+	out := new(BackupBucketConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CloudProfileConfig) DeepCopyObject() runtime.Object {
+func (in *BackupBucketConfig) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -87,39 +117,39 @@ func (in *CloudProfileConfig) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ControlPlaneConfig) DeepCopyInto(out *ControlPlaneConfig) {
+func (in *BastionConfig) DeepCopyInto(out *BastionConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	if in.CloudControllerManager != nil {
-		in, out := &in.CloudControllerManager, &out.CloudControllerManager
-		*out = new(CloudControllerManagerConfig)
-		(*in).DeepCopyInto(*out)
+	if in.InstanceType != nil {
+		in, out := &in.InstanceType, &out.InstanceType
+		*out = new(string)
+		**out = **in
 	}
-	if in.LoadBalancerController != nil {
-		in, out := &in.LoadBalancerController, &out.LoadBalancerController
-		*out = new(LoadBalancerControllerConfig)
-		(*in).DeepCopyInto(*out)
+	if in.AMI != nil {
+		in, out := &in.AMI, &out.AMI
+		*out = new(string)
+		**out = **in
 	}
-	if in.Storage != nil {
-		in, out := &in.Storage, &out.Storage
-		*out = new(Storage)
-		(*in).DeepCopyInto(*out)
+	if in.SessionManager != nil {
+		in, out := &in.SessionManager, &out.SessionManager
+		*out = new(bool)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneConfig.
-func (in *ControlPlaneConfig) DeepCopy() *ControlPlaneConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionConfig.
+func (in *BastionConfig) DeepCopy() *BastionConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ControlPlaneConfig)
+	out := new(BastionConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ControlPlaneConfig) DeepCopyObject() runtime.Object {
+func (in *BastionConfig) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -127,200 +157,256 @@ func (in *ControlPlaneConfig) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataVolume) DeepCopyInto(out *DataVolume) {
+func (in *BucketAccessLogging) DeepCopyInto(out *BucketAccessLogging) {
 	*out = *in
-	in.Volume.DeepCopyInto(&out.Volume)
-	if in.SnapshotID != nil {
-		in, out := &in.SnapshotID, &out.SnapshotID
+	if in.TargetPrefix != nil {
+		in, out := &in.TargetPrefix, &out.TargetPrefix
 		*out = new(string)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataVolume.
-func (in *DataVolume) DeepCopy() *DataVolume {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketAccessLogging.
+func (in *BucketAccessLogging) DeepCopy() *BucketAccessLogging {
 	if in == nil {
 		return nil
 	}
-	out := new(DataVolume)
+	out := new(BucketAccessLogging)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DualStack) DeepCopyInto(out *DualStack) {
+func (in *BucketEncryption) DeepCopyInto(out *BucketEncryption) {
 	*out = *in
+	if in.BucketKeyEnabled != nil {
+		in, out := &in.BucketKeyEnabled, &out.BucketKeyEnabled
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DualStack.
-func (in *DualStack) DeepCopy() *DualStack {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketEncryption.
+func (in *BucketEncryption) DeepCopy() *BucketEncryption {
 	if in == nil {
 		return nil
 	}
-	out := new(DualStack)
+	out := new(BucketEncryption)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EC2) DeepCopyInto(out *EC2) {
+func (in *BucketHardening) DeepCopyInto(out *BucketHardening) {
 	*out = *in
+	if in.MinimumTLSVersion != nil {
+		in, out := &in.MinimumTLSVersion, &out.MinimumTLSVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketOwnerEnforced != nil {
+		in, out := &in.BucketOwnerEnforced, &out.BucketOwnerEnforced
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2.
-func (in *EC2) DeepCopy() *EC2 {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketHardening.
+func (in *BucketHardening) DeepCopy() *BucketHardening {
 	if in == nil {
 		return nil
 	}
-	out := new(EC2)
+	out := new(BucketHardening)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IAM) DeepCopyInto(out *IAM) {
+func (in *BucketLifecycle) DeepCopyInto(out *BucketLifecycle) {
 	*out = *in
-	if in.InstanceProfiles != nil {
-		in, out := &in.InstanceProfiles, &out.InstanceProfiles
-		*out = make([]InstanceProfile, len(*in))
+	if in.Transitions != nil {
+		in, out := &in.Transitions, &out.Transitions
+		*out = make([]LifecycleTransition, len(*in))
 		copy(*out, *in)
 	}
-	if in.Roles != nil {
-		in, out := &in.Roles, &out.Roles
-		*out = make([]Role, len(*in))
-		copy(*out, *in)
+	if in.ExpirationDays != nil {
+		in, out := &in.ExpirationDays, &out.ExpirationDays
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AbortIncompleteMultipartUploadDays != nil {
+		in, out := &in.AbortIncompleteMultipartUploadDays, &out.AbortIncompleteMultipartUploadDays
+		*out = new(int32)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAM.
-func (in *IAM) DeepCopy() *IAM {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketLifecycle.
+func (in *BucketLifecycle) DeepCopy() *BucketLifecycle {
 	if in == nil {
 		return nil
 	}
-	out := new(IAM)
+	out := new(BucketLifecycle)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IAMInstanceProfile) DeepCopyInto(out *IAMInstanceProfile) {
+func (in *BucketReplication) DeepCopyInto(out *BucketReplication) {
 	*out = *in
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
+	if in.KMSKeyARN != nil {
+		in, out := &in.KMSKeyARN, &out.KMSKeyARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.ARN != nil {
-		in, out := &in.ARN, &out.ARN
-		*out = new(string)
+	if in.ReplicateDeletes != nil {
+		in, out := &in.ReplicateDeletes, &out.ReplicateDeletes
+		*out = new(bool)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMInstanceProfile.
-func (in *IAMInstanceProfile) DeepCopy() *IAMInstanceProfile {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketReplication.
+func (in *BucketReplication) DeepCopy() *BucketReplication {
 	if in == nil {
 		return nil
 	}
-	out := new(IAMInstanceProfile)
+	out := new(BucketReplication)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IgnoreTags) DeepCopyInto(out *IgnoreTags) {
+func (in *CPUOptions) DeepCopyInto(out *CPUOptions) {
 	*out = *in
-	if in.Keys != nil {
-		in, out := &in.Keys, &out.Keys
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.KeyPrefixes != nil {
-		in, out := &in.KeyPrefixes, &out.KeyPrefixes
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.AmdSevSnp != nil {
+		in, out := &in.AmdSevSnp, &out.AmdSevSnp
+		*out = new(AmdSevSnpSpecification)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IgnoreTags.
-func (in *IgnoreTags) DeepCopy() *IgnoreTags {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPUOptions.
+func (in *CPUOptions) DeepCopy() *CPUOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(IgnoreTags)
+	out := new(CPUOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InfrastructureConfig) DeepCopyInto(out *InfrastructureConfig) {
+func (in *CapacityReservation) DeepCopyInto(out *CapacityReservation) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	if in.EnableECRAccess != nil {
-		in, out := &in.EnableECRAccess, &out.EnableECRAccess
-		*out = new(bool)
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
 		**out = **in
 	}
-	if in.DualStack != nil {
-		in, out := &in.DualStack, &out.DualStack
-		*out = new(DualStack)
+	if in.ResourceGroupArn != nil {
+		in, out := &in.ResourceGroupArn, &out.ResourceGroupArn
+		*out = new(string)
 		**out = **in
 	}
-	in.Networks.DeepCopyInto(&out.Networks)
-	if in.IgnoreTags != nil {
-		in, out := &in.IgnoreTags, &out.IgnoreTags
-		*out = new(IgnoreTags)
-		(*in).DeepCopyInto(*out)
+	if in.Preference != nil {
+		in, out := &in.Preference, &out.Preference
+		*out = new(CapacityReservationPreference)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructureConfig.
-func (in *InfrastructureConfig) DeepCopy() *InfrastructureConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityReservation.
+func (in *CapacityReservation) DeepCopy() *CapacityReservation {
 	if in == nil {
 		return nil
 	}
-	out := new(InfrastructureConfig)
+	out := new(CapacityReservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *InfrastructureConfig) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudControllerManagerConfig) DeepCopyInto(out *CloudControllerManagerConfig) {
+	*out = *in
+	if in.FeatureGates != nil {
+		in, out := &in.FeatureGates, &out.FeatureGates
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	return nil
+	if in.UseCustomRouteController != nil {
+		in, out := &in.UseCustomRouteController, &out.UseCustomRouteController
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ConcurrentServiceSyncs != nil {
+		in, out := &in.ConcurrentServiceSyncs, &out.ConcurrentServiceSyncs
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NodeStatusUpdateFrequency != nil {
+		in, out := &in.NodeStatusUpdateFrequency, &out.NodeStatusUpdateFrequency
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.NodeIPFamilies != nil {
+		in, out := &in.NodeIPFamilies, &out.NodeIPFamilies
+		*out = make([]IPFamily, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllocateNodeCIDRs != nil {
+		in, out := &in.AllocateNodeCIDRs, &out.AllocateNodeCIDRs
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudControllerManagerConfig.
+func (in *CloudControllerManagerConfig) DeepCopy() *CloudControllerManagerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudControllerManagerConfig)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InfrastructureStatus) DeepCopyInto(out *InfrastructureStatus) {
+func (in *CloudProfileConfig) DeepCopyInto(out *CloudProfileConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	out.EC2 = in.EC2
-	in.IAM.DeepCopyInto(&out.IAM)
-	in.VPC.DeepCopyInto(&out.VPC)
+	if in.MachineImages != nil {
+		in, out := &in.MachineImages, &out.MachineImages
+		*out = make([]MachineImages, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructureStatus.
-func (in *InfrastructureStatus) DeepCopy() *InfrastructureStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudProfileConfig.
+func (in *CloudProfileConfig) DeepCopy() *CloudProfileConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(InfrastructureStatus)
+	out := new(CloudProfileConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *InfrastructureStatus) DeepCopyObject() runtime.Object {
+func (in *CloudProfileConfig) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -328,276 +414,2680 @@ func (in *InfrastructureStatus) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceMetadataOptions) DeepCopyInto(out *InstanceMetadataOptions) {
+func (in *CloudWatchAgentConfig) DeepCopyInto(out *CloudWatchAgentConfig) {
 	*out = *in
-	if in.HTTPTokens != nil {
-		in, out := &in.HTTPTokens, &out.HTTPTokens
-		*out = new(HTTPTokensValue)
+	if in.MetricsCollectionInterval != nil {
+		in, out := &in.MetricsCollectionInterval, &out.MetricsCollectionInterval
+		*out = new(int32)
 		**out = **in
 	}
-	if in.HTTPPutResponseHopLimit != nil {
-		in, out := &in.HTTPPutResponseHopLimit, &out.HTTPPutResponseHopLimit
-		*out = new(int64)
-		**out = **in
+	if in.Logs != nil {
+		in, out := &in.Logs, &out.Logs
+		*out = new(CloudWatchAgentLogs)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceMetadataOptions.
-func (in *InstanceMetadataOptions) DeepCopy() *InstanceMetadataOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudWatchAgentConfig.
+func (in *CloudWatchAgentConfig) DeepCopy() *CloudWatchAgentConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceMetadataOptions)
+	out := new(CloudWatchAgentConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceProfile) DeepCopyInto(out *InstanceProfile) {
+func (in *CloudWatchAgentLogs) DeepCopyInto(out *CloudWatchAgentLogs) {
 	*out = *in
+	if in.RetentionInDays != nil {
+		in, out := &in.RetentionInDays, &out.RetentionInDays
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceProfile.
-func (in *InstanceProfile) DeepCopy() *InstanceProfile {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudWatchAgentLogs.
+func (in *CloudWatchAgentLogs) DeepCopy() *CloudWatchAgentLogs {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceProfile)
+	out := new(CloudWatchAgentLogs)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoadBalancerControllerConfig) DeepCopyInto(out *LoadBalancerControllerConfig) {
+func (in *CloudWatchAgentStatus) DeepCopyInto(out *CloudWatchAgentStatus) {
 	*out = *in
-	if in.IngressClassName != nil {
-		in, out := &in.IngressClassName, &out.IngressClassName
+	if in.LogGroupName != nil {
+		in, out := &in.LogGroupName, &out.LogGroupName
 		*out = new(string)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerControllerConfig.
-func (in *LoadBalancerControllerConfig) DeepCopy() *LoadBalancerControllerConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudWatchAgentStatus.
+func (in *CloudWatchAgentStatus) DeepCopy() *CloudWatchAgentStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(LoadBalancerControllerConfig)
+	out := new(CloudWatchAgentStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineImage) DeepCopyInto(out *MachineImage) {
+func (in *ContainerdConfig) DeepCopyInto(out *ContainerdConfig) {
 	*out = *in
-	if in.Architecture != nil {
-		in, out := &in.Architecture, &out.Architecture
+	if in.RegistryMirrors != nil {
+		in, out := &in.RegistryMirrors, &out.RegistryMirrors
+		*out = make([]RegistryMirror, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConfigPatch != nil {
+		in, out := &in.ConfigPatch, &out.ConfigPatch
 		*out = new(string)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImage.
-func (in *MachineImage) DeepCopy() *MachineImage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerdConfig.
+func (in *ContainerdConfig) DeepCopy() *ContainerdConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerdConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneConfig) DeepCopyInto(out *ControlPlaneConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.CloudControllerManager != nil {
+		in, out := &in.CloudControllerManager, &out.CloudControllerManager
+		*out = new(CloudControllerManagerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoadBalancerController != nil {
+		in, out := &in.LoadBalancerController, &out.LoadBalancerController
+		*out = new(LoadBalancerControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoadBalancerDefaults != nil {
+		in, out := &in.LoadBalancerDefaults, &out.LoadBalancerDefaults
+		*out = new(LoadBalancerDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(Storage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IRSA != nil {
+		in, out := &in.IRSA, &out.IRSA
+		*out = new(IRSAConfig)
+		**out = **in
+	}
+	if in.Karpenter != nil {
+		in, out := &in.Karpenter, &out.Karpenter
+		*out = new(KarpenterConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateLink != nil {
+		in, out := &in.PrivateLink, &out.PrivateLink
+		*out = new(PrivateLinkConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudWatchAgent != nil {
+		in, out := &in.CloudWatchAgent, &out.CloudWatchAgent
+		*out = new(CloudWatchAgentConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneConfig.
+func (in *ControlPlaneConfig) DeepCopy() *ControlPlaneConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ControlPlaneConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneStatus) DeepCopyInto(out *ControlPlaneStatus) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.IRSA != nil {
+		in, out := &in.IRSA, &out.IRSA
+		*out = new(IRSAStatus)
+		**out = **in
+	}
+	if in.Karpenter != nil {
+		in, out := &in.Karpenter, &out.Karpenter
+		*out = new(KarpenterStatus)
+		**out = **in
+	}
+	if in.PrivateLink != nil {
+		in, out := &in.PrivateLink, &out.PrivateLink
+		*out = new(PrivateLinkStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudWatchAgent != nil {
+		in, out := &in.CloudWatchAgent, &out.CloudWatchAgent
+		*out = new(CloudWatchAgentStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneStatus.
+func (in *ControlPlaneStatus) DeepCopy() *ControlPlaneStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ControlPlaneStatus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPOptions) DeepCopyInto(out *DHCPOptions) {
+	*out = *in
+	if in.DomainName != nil {
+		in, out := &in.DomainName, &out.DomainName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DomainNameServers != nil {
+		in, out := &in.DomainNameServers, &out.DomainNameServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NTPServers != nil {
+		in, out := &in.NTPServers, &out.NTPServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptions.
+func (in *DHCPOptions) DeepCopy() *DHCPOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSRecordConfig) DeepCopyInto(out *DNSRecordConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.RoutingPolicy != nil {
+		in, out := &in.RoutingPolicy, &out.RoutingPolicy
+		*out = new(RoutingPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthCheckID != nil {
+		in, out := &in.HealthCheckID, &out.HealthCheckID
+		*out = new(string)
+		**out = **in
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(HealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateHostedZone != nil {
+		in, out := &in.PrivateHostedZone, &out.PrivateHostedZone
+		*out = new(PrivateHostedZone)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecordConfig.
+func (in *DNSRecordConfig) DeepCopy() *DNSRecordConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRecordConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DNSRecordConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSRecordStatus) DeepCopyInto(out *DNSRecordStatus) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.HealthCheckID != nil {
+		in, out := &in.HealthCheckID, &out.HealthCheckID
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecordStatus.
+func (in *DNSRecordStatus) DeepCopy() *DNSRecordStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRecordStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DNSRecordStatus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataVolume) DeepCopyInto(out *DataVolume) {
+	*out = *in
+	in.Volume.DeepCopyInto(&out.Volume)
+	if in.SnapshotID != nil {
+		in, out := &in.SnapshotID, &out.SnapshotID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeviceName != nil {
+		in, out := &in.DeviceName, &out.DeviceName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataVolume.
+func (in *DataVolume) DeepCopy() *DataVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(DataVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DevicePlugin) DeepCopyInto(out *DevicePlugin) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DevicePlugin.
+func (in *DevicePlugin) DeepCopy() *DevicePlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(DevicePlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DualStack) DeepCopyInto(out *DualStack) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DualStack.
+func (in *DualStack) DeepCopy() *DualStack {
+	if in == nil {
+		return nil
+	}
+	out := new(DualStack)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EBSConfig) DeepCopyInto(out *EBSConfig) {
+	*out = *in
+	if in.VolumeModification != nil {
+		in, out := &in.VolumeModification, &out.VolumeModification
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Batching != nil {
+		in, out := &in.Batching, &out.Batching
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReservedVolumeAttachments != nil {
+		in, out := &in.ReservedVolumeAttachments, &out.ReservedVolumeAttachments
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SnapshotController != nil {
+		in, out := &in.SnapshotController, &out.SnapshotController
+		*out = new(SnapshotControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceRequests != nil {
+		in, out := &in.ResourceRequests, &out.ResourceRequests
+		*out = make(map[string]corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			var outVal map[corev1.ResourceName]resource.Quantity
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make(corev1.ResourceList, len(*in))
+				for key, val := range *in {
+					(*out)[key] = val.DeepCopy()
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.ExtraTags != nil {
+		in, out := &in.ExtraTags, &out.ExtraTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ShootMetadataTags != nil {
+		in, out := &in.ShootMetadataTags, &out.ShootMetadataTags
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EBSConfig.
+func (in *EBSConfig) DeepCopy() *EBSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EBSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EC2) DeepCopyInto(out *EC2) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2.
+func (in *EC2) DeepCopy() *EC2 {
+	if in == nil {
+		return nil
+	}
+	out := new(EC2)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EFSConfig) DeepCopyInto(out *EFSConfig) {
+	*out = *in
+	if in.FileSystemID != nil {
+		in, out := &in.FileSystemID, &out.FileSystemID
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EFSConfig.
+func (in *EFSConfig) DeepCopy() *EFSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EFSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressPrefixListConfig) DeepCopyInto(out *EgressPrefixListConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressPrefixListConfig.
+func (in *EgressPrefixListConfig) DeepCopy() *EgressPrefixListConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressPrefixListConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressPrefixListStatus) DeepCopyInto(out *EgressPrefixListStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressPrefixListStatus.
+func (in *EgressPrefixListStatus) DeepCopy() *EgressPrefixListStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressPrefixListStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticFileSystemConfig) DeepCopyInto(out *ElasticFileSystemConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticFileSystemConfig.
+func (in *ElasticFileSystemConfig) DeepCopy() *ElasticFileSystemConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticFileSystemConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticFileSystemStatus) DeepCopyInto(out *ElasticFileSystemStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticFileSystemStatus.
+func (in *ElasticFileSystemStatus) DeepCopy() *ElasticFileSystemStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticFileSystemStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticIPPool) DeepCopyInto(out *ElasticIPPool) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticIPPool.
+func (in *ElasticIPPool) DeepCopy() *ElasticIPPool {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticIPPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticIPPoolAddress) DeepCopyInto(out *ElasticIPPoolAddress) {
+	*out = *in
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticIPPoolAddress.
+func (in *ElasticIPPoolAddress) DeepCopy() *ElasticIPPoolAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticIPPoolAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnclaveOptions) DeepCopyInto(out *EnclaveOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnclaveOptions.
+func (in *EnclaveOptions) DeepCopy() *EnclaveOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(EnclaveOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Endpoints) DeepCopyInto(out *Endpoints) {
+	*out = *in
+	if in.Partition != nil {
+		in, out := &in.Partition, &out.Partition
+		*out = new(string)
+		**out = **in
+	}
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Endpoints.
+func (in *Endpoints) DeepCopy() *Endpoints {
+	if in == nil {
+		return nil
+	}
+	out := new(Endpoints)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailoverRoutingPolicy) DeepCopyInto(out *FailoverRoutingPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailoverRoutingPolicy.
+func (in *FailoverRoutingPolicy) DeepCopy() *FailoverRoutingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(FailoverRoutingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeolocationRoutingPolicy) DeepCopyInto(out *GeolocationRoutingPolicy) {
+	*out = *in
+	if in.ContinentCode != nil {
+		in, out := &in.ContinentCode, &out.ContinentCode
+		*out = new(string)
+		**out = **in
+	}
+	if in.CountryCode != nil {
+		in, out := &in.CountryCode, &out.CountryCode
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubdivisionCode != nil {
+		in, out := &in.SubdivisionCode, &out.SubdivisionCode
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeolocationRoutingPolicy.
+func (in *GeolocationRoutingPolicy) DeepCopy() *GeolocationRoutingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(GeolocationRoutingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheck) DeepCopyInto(out *HealthCheck) {
+	*out = *in
+	if in.IPAddress != nil {
+		in, out := &in.IPAddress, &out.IPAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.FullyQualifiedDomainName != nil {
+		in, out := &in.FullyQualifiedDomainName, &out.FullyQualifiedDomainName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ResourcePath != nil {
+		in, out := &in.ResourcePath, &out.ResourcePath
+		*out = new(string)
+		**out = **in
+	}
+	if in.RequestInterval != nil {
+		in, out := &in.RequestInterval, &out.RequestInterval
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheck.
+func (in *HealthCheck) DeepCopy() *HealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostedZoneVPC) DeepCopyInto(out *HostedZoneVPC) {
+	*out = *in
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostedZoneVPC.
+func (in *HostedZoneVPC) DeepCopy() *HostedZoneVPC {
+	if in == nil {
+		return nil
+	}
+	out := new(HostedZoneVPC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAM) DeepCopyInto(out *IAM) {
+	*out = *in
+	if in.InstanceProfiles != nil {
+		in, out := &in.InstanceProfiles, &out.InstanceProfiles
+		*out = make([]InstanceProfile, len(*in))
+		copy(*out, *in)
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]Role, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAM.
+func (in *IAM) DeepCopy() *IAM {
+	if in == nil {
+		return nil
+	}
+	out := new(IAM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMConfig) DeepCopyInto(out *IAMConfig) {
+	*out = *in
+	if in.NodesInstanceProfile != nil {
+		in, out := &in.NodesInstanceProfile, &out.NodesInstanceProfile
+		*out = new(IAMInstanceProfile)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMConfig.
+func (in *IAMConfig) DeepCopy() *IAMConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMInstanceProfile) DeepCopyInto(out *IAMInstanceProfile) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMInstanceProfile.
+func (in *IAMInstanceProfile) DeepCopy() *IAMInstanceProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMInstanceProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IRSAConfig) DeepCopyInto(out *IRSAConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IRSAConfig.
+func (in *IRSAConfig) DeepCopy() *IRSAConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IRSAConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IRSAStatus) DeepCopyInto(out *IRSAStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IRSAStatus.
+func (in *IRSAStatus) DeepCopy() *IRSAStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IRSAStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IgnoreTags) DeepCopyInto(out *IgnoreTags) {
+	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KeyPrefixes != nil {
+		in, out := &in.KeyPrefixes, &out.KeyPrefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IgnoreTags.
+func (in *IgnoreTags) DeepCopy() *IgnoreTags {
+	if in == nil {
+		return nil
+	}
+	out := new(IgnoreTags)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSelector) DeepCopyInto(out *ImageSelector) {
+	*out = *in
+	if in.Owners != nil {
+		in, out := &in.Owners, &out.Owners
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSelector.
+func (in *ImageSelector) DeepCopy() *ImageSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfrastructureConfig) DeepCopyInto(out *InfrastructureConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.EnableECRAccess != nil {
+		in, out := &in.EnableECRAccess, &out.EnableECRAccess
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DualStack != nil {
+		in, out := &in.DualStack, &out.DualStack
+		*out = new(DualStack)
+		**out = **in
+	}
+	in.Networks.DeepCopyInto(&out.Networks)
+	if in.IgnoreTags != nil {
+		in, out := &in.IgnoreTags, &out.IgnoreTags
+		*out = new(IgnoreTags)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.VPCFlowLogs != nil {
+		in, out := &in.VPCFlowLogs, &out.VPCFlowLogs
+		*out = new(VPCFlowLogs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IAM != nil {
+		in, out := &in.IAM, &out.IAM
+		*out = new(IAMConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = new(Endpoints)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ElasticFileSystem != nil {
+		in, out := &in.ElasticFileSystem, &out.ElasticFileSystem
+		*out = new(ElasticFileSystemConfig)
+		**out = **in
+	}
+	if in.LoadBalancerAccessLogs != nil {
+		in, out := &in.LoadBalancerAccessLogs, &out.LoadBalancerAccessLogs
+		*out = new(LoadBalancerAccessLogsConfig)
+		**out = **in
+	}
+	if in.EgressPrefixList != nil {
+		in, out := &in.EgressPrefixList, &out.EgressPrefixList
+		*out = new(EgressPrefixListConfig)
+		**out = **in
+	}
+	if in.Inventory != nil {
+		in, out := &in.Inventory, &out.Inventory
+		*out = new(InventoryConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructureConfig.
+func (in *InfrastructureConfig) DeepCopy() *InfrastructureConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(InfrastructureConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InfrastructureConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfrastructureStatus) DeepCopyInto(out *InfrastructureStatus) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.EC2 = in.EC2
+	in.IAM.DeepCopyInto(&out.IAM)
+	in.VPC.DeepCopyInto(&out.VPC)
+	if in.ElasticFileSystem != nil {
+		in, out := &in.ElasticFileSystem, &out.ElasticFileSystem
+		*out = new(ElasticFileSystemStatus)
+		**out = **in
+	}
+	if in.LoadBalancerAccessLogs != nil {
+		in, out := &in.LoadBalancerAccessLogs, &out.LoadBalancerAccessLogs
+		*out = new(LoadBalancerAccessLogsStatus)
+		**out = **in
+	}
+	if in.EgressPrefixList != nil {
+		in, out := &in.EgressPrefixList, &out.EgressPrefixList
+		*out = new(EgressPrefixListStatus)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructureStatus.
+func (in *InfrastructureStatus) DeepCopy() *InfrastructureStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InfrastructureStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InfrastructureStatus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceMetadataOptions) DeepCopyInto(out *InstanceMetadataOptions) {
+	*out = *in
+	if in.HTTPTokens != nil {
+		in, out := &in.HTTPTokens, &out.HTTPTokens
+		*out = new(HTTPTokensValue)
+		**out = **in
+	}
+	if in.HTTPPutResponseHopLimit != nil {
+		in, out := &in.HTTPPutResponseHopLimit, &out.HTTPPutResponseHopLimit
+		*out = new(int64)
+		**out = **in
+	}
+	if in.InstanceMetadataTags != nil {
+		in, out := &in.InstanceMetadataTags, &out.InstanceMetadataTags
+		*out = new(InstanceMetadataTagsValue)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceMetadataOptions.
+func (in *InstanceMetadataOptions) DeepCopy() *InstanceMetadataOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceMetadataOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceProfile) DeepCopyInto(out *InstanceProfile) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceProfile.
+func (in *InstanceProfile) DeepCopy() *InstanceProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceStorage) DeepCopyInto(out *InstanceStorage) {
+	*out = *in
+	if in.RAID0 != nil {
+		in, out := &in.RAID0, &out.RAID0
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceStorage.
+func (in *InstanceStorage) DeepCopy() *InstanceStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryConfig) DeepCopyInto(out *InventoryConfig) {
+	*out = *in
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(InventoryS3Location)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryConfig.
+func (in *InventoryConfig) DeepCopy() *InventoryConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryS3Location) DeepCopyInto(out *InventoryS3Location) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryS3Location.
+func (in *InventoryS3Location) DeepCopy() *InventoryS3Location {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryS3Location)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KarpenterConfig) DeepCopyInto(out *KarpenterConfig) {
+	*out = *in
+	if in.InterruptionHandling != nil {
+		in, out := &in.InterruptionHandling, &out.InterruptionHandling
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KarpenterConfig.
+func (in *KarpenterConfig) DeepCopy() *KarpenterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KarpenterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KarpenterStatus) DeepCopyInto(out *KarpenterStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KarpenterStatus.
+func (in *KarpenterStatus) DeepCopy() *KarpenterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KarpenterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletConfig.
+func (in *KubeletConfig) DeepCopy() *KubeletConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LatencyRoutingPolicy) DeepCopyInto(out *LatencyRoutingPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LatencyRoutingPolicy.
+func (in *LatencyRoutingPolicy) DeepCopy() *LatencyRoutingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(LatencyRoutingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecycleTransition) DeepCopyInto(out *LifecycleTransition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleTransition.
+func (in *LifecycleTransition) DeepCopy() *LifecycleTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecycleTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerAccessLogs) DeepCopyInto(out *LoadBalancerAccessLogs) {
+	*out = *in
+	if in.BucketName != nil {
+		in, out := &in.BucketName, &out.BucketName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.EmitInterval != nil {
+		in, out := &in.EmitInterval, &out.EmitInterval
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerAccessLogs.
+func (in *LoadBalancerAccessLogs) DeepCopy() *LoadBalancerAccessLogs {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerAccessLogs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerAccessLogsConfig) DeepCopyInto(out *LoadBalancerAccessLogsConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerAccessLogsConfig.
+func (in *LoadBalancerAccessLogsConfig) DeepCopy() *LoadBalancerAccessLogsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerAccessLogsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerAccessLogsStatus) DeepCopyInto(out *LoadBalancerAccessLogsStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerAccessLogsStatus.
+func (in *LoadBalancerAccessLogsStatus) DeepCopy() *LoadBalancerAccessLogsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerAccessLogsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerControllerConfig) DeepCopyInto(out *LoadBalancerControllerConfig) {
+	*out = *in
+	if in.IngressClassName != nil {
+		in, out := &in.IngressClassName, &out.IngressClassName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerControllerConfig.
+func (in *LoadBalancerControllerConfig) DeepCopy() *LoadBalancerControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerDefaults) DeepCopyInto(out *LoadBalancerDefaults) {
+	*out = *in
+	if in.Internal != nil {
+		in, out := &in.Internal, &out.Internal
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TargetType != nil {
+		in, out := &in.TargetType, &out.TargetType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AccessLogs != nil {
+		in, out := &in.AccessLogs, &out.AccessLogs
+		*out = new(LoadBalancerAccessLogs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SSLNegotiationPolicy != nil {
+		in, out := &in.SSLNegotiationPolicy, &out.SSLNegotiationPolicy
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerDefaults.
+func (in *LoadBalancerDefaults) DeepCopy() *LoadBalancerDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImage) DeepCopyInto(out *MachineImage) {
+	*out = *in
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImage.
+func (in *MachineImage) DeepCopy() *MachineImage {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImageVersion) DeepCopyInto(out *MachineImageVersion) {
+	*out = *in
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]RegionAMIMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SSMParameters != nil {
+		in, out := &in.SSMParameters, &out.SSMParameters
+		*out = make([]SSMParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SourceImages != nil {
+		in, out := &in.SourceImages, &out.SourceImages
+		*out = make([]SourceImage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AcceleratorDrivers != nil {
+		in, out := &in.AcceleratorDrivers, &out.AcceleratorDrivers
+		*out = make([]AcceleratorType, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImageVersion.
+func (in *MachineImageVersion) DeepCopy() *MachineImageVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImageVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImages) DeepCopyInto(out *MachineImages) {
+	*out = *in
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]MachineImageVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImages.
+func (in *MachineImages) DeepCopy() *MachineImages {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImages)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Monitoring) DeepCopyInto(out *Monitoring) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Monitoring.
+func (in *Monitoring) DeepCopy() *Monitoring {
+	if in == nil {
+		return nil
+	}
+	out := new(Monitoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NATGateway) DeepCopyInto(out *NATGateway) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(NATGatewayMode)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(NATGatewayType)
+		**out = **in
+	}
+	if in.Instance != nil {
+		in, out := &in.Instance, &out.Instance
+		*out = new(NATInstance)
+		**out = **in
+	}
+	if in.ElasticIPPool != nil {
+		in, out := &in.ElasticIPPool, &out.ElasticIPPool
+		*out = new(ElasticIPPool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGateway.
+func (in *NATGateway) DeepCopy() *NATGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(NATGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NATGatewayStatus) DeepCopyInto(out *NATGatewayStatus) {
+	*out = *in
+	if in.ElasticIPAllocationID != nil {
+		in, out := &in.ElasticIPAllocationID, &out.ElasticIPAllocationID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicIP != nil {
+		in, out := &in.PublicIP, &out.PublicIP
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayStatus.
+func (in *NATGatewayStatus) DeepCopy() *NATGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NATGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NATInstance) DeepCopyInto(out *NATInstance) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATInstance.
+func (in *NATInstance) DeepCopy() *NATInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(NATInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACL) DeepCopyInto(out *NetworkACL) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]NetworkACLRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACL.
+func (in *NetworkACL) DeepCopy() *NetworkACL {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLRule) DeepCopyInto(out *NetworkACLRule) {
+	*out = *in
+	if in.FromPort != nil {
+		in, out := &in.FromPort, &out.FromPort
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ToPort != nil {
+		in, out := &in.ToPort, &out.ToPort
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLRule.
+func (in *NetworkACLRule) DeepCopy() *NetworkACLRule {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLs) DeepCopyInto(out *NetworkACLs) {
+	*out = *in
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = new(NetworkACL)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Public != nil {
+		in, out := &in.Public, &out.Public
+		*out = new(NetworkACL)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Internal != nil {
+		in, out := &in.Internal, &out.Internal
+		*out = new(NetworkACL)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLs.
+func (in *NetworkACLs) DeepCopy() *NetworkACLs {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceSubnet) DeepCopyInto(out *NetworkInterfaceSubnet) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceSubnet.
+func (in *NetworkInterfaceSubnet) DeepCopy() *NetworkInterfaceSubnet {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceSubnet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaces) DeepCopyInto(out *NetworkInterfaces) {
+	*out = *in
+	if in.Additional != nil {
+		in, out := &in.Additional, &out.Additional
+		*out = make([]AdditionalNetworkInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaces.
+func (in *NetworkInterfaces) DeepCopy() *NetworkInterfaces {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaces)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Networks) DeepCopyInto(out *Networks) {
+	*out = *in
+	in.VPC.DeepCopyInto(&out.VPC)
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]Zone, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TransitGateway != nil {
+		in, out := &in.TransitGateway, &out.TransitGateway
+		*out = new(TransitGateway)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPFamilies != nil {
+		in, out := &in.IPFamilies, &out.IPFamilies
+		*out = make([]IPFamily, len(*in))
+		copy(*out, *in)
+	}
+	if in.NATGateway != nil {
+		in, out := &in.NATGateway, &out.NATGateway
+		*out = new(NATGateway)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdditionalNodeSecurityGroupRules != nil {
+		in, out := &in.AdditionalNodeSecurityGroupRules, &out.AdditionalNodeSecurityGroupRules
+		*out = make([]NodeSecurityGroupRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VPCPeerings != nil {
+		in, out := &in.VPCPeerings, &out.VPCPeerings
+		*out = make([]VPCPeering, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NetworkACLs != nil {
+		in, out := &in.NetworkACLs, &out.NetworkACLs
+		*out = new(NetworkACLs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Route53Resolver != nil {
+		in, out := &in.Route53Resolver, &out.Route53Resolver
+		*out = new(Route53Resolver)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Networks.
+func (in *Networks) DeepCopy() *Networks {
+	if in == nil {
+		return nil
+	}
+	out := new(Networks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeSecurityGroupRule) DeepCopyInto(out *NodeSecurityGroupRule) {
+	*out = *in
+	if in.FromPort != nil {
+		in, out := &in.FromPort, &out.FromPort
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ToPort != nil {
+		in, out := &in.ToPort, &out.ToPort
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CIDRBlocks != nil {
+		in, out := &in.CIDRBlocks, &out.CIDRBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSecurityGroupRule.
+func (in *NodeSecurityGroupRule) DeepCopy() *NodeSecurityGroupRule {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeSecurityGroupRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLockConfig) DeepCopyInto(out *ObjectLockConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLockConfig.
+func (in *ObjectLockConfig) DeepCopy() *ObjectLockConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLockConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementGroup) DeepCopyInto(out *PlacementGroup) {
+	*out = *in
+	if in.PartitionCount != nil {
+		in, out := &in.PartitionCount, &out.PartitionCount
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementGroup.
+func (in *PlacementGroup) DeepCopy() *PlacementGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreProvisionedCapacity) DeepCopyInto(out *PreProvisionedCapacity) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreProvisionedCapacity.
+func (in *PreProvisionedCapacity) DeepCopy() *PreProvisionedCapacity {
+	if in == nil {
+		return nil
+	}
+	out := new(PreProvisionedCapacity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateHostedZone) DeepCopyInto(out *PrivateHostedZone) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCs != nil {
+		in, out := &in.VPCs, &out.VPCs
+		*out = make([]HostedZoneVPC, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateHostedZone.
+func (in *PrivateHostedZone) DeepCopy() *PrivateHostedZone {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateHostedZone)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateLinkConfig) DeepCopyInto(out *PrivateLinkConfig) {
+	*out = *in
+	if in.AllowedPrincipals != nil {
+		in, out := &in.AllowedPrincipals, &out.AllowedPrincipals
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AcceptanceRequired != nil {
+		in, out := &in.AcceptanceRequired, &out.AcceptanceRequired
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateLinkConfig.
+func (in *PrivateLinkConfig) DeepCopy() *PrivateLinkConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateLinkConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateLinkStatus) DeepCopyInto(out *PrivateLinkStatus) {
+	*out = *in
+	if in.BaseEndpointDNSNames != nil {
+		in, out := &in.BaseEndpointDNSNames, &out.BaseEndpointDNSNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateLinkStatus.
+func (in *PrivateLinkStatus) DeepCopy() *PrivateLinkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateLinkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegionAMIMapping) DeepCopyInto(out *RegionAMIMapping) {
+	*out = *in
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionAMIMapping.
+func (in *RegionAMIMapping) DeepCopy() *RegionAMIMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(RegionAMIMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryMirror) DeepCopyInto(out *RegistryMirror) {
+	*out = *in
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryMirror.
+func (in *RegistryMirror) DeepCopy() *RegistryMirror {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryMirror)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Role) DeepCopyInto(out *Role) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Role.
+func (in *Role) DeepCopy() *Role {
+	if in == nil {
+		return nil
+	}
+	out := new(Role)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollingUpdate) DeepCopyInto(out *RollingUpdate) {
+	*out = *in
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollingUpdate.
+func (in *RollingUpdate) DeepCopy() *RollingUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(RollingUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Route53Resolver) DeepCopyInto(out *Route53Resolver) {
+	*out = *in
+	if in.InboundEndpoint != nil {
+		in, out := &in.InboundEndpoint, &out.InboundEndpoint
+		*out = new(Route53ResolverInboundEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ForwardingRules != nil {
+		in, out := &in.ForwardingRules, &out.ForwardingRules
+		*out = make([]Route53ResolverForwardingRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RuleIDs != nil {
+		in, out := &in.RuleIDs, &out.RuleIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route53Resolver.
+func (in *Route53Resolver) DeepCopy() *Route53Resolver {
+	if in == nil {
+		return nil
+	}
+	out := new(Route53Resolver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Route53ResolverForwardingRule) DeepCopyInto(out *Route53ResolverForwardingRule) {
+	*out = *in
+	if in.TargetIPs != nil {
+		in, out := &in.TargetIPs, &out.TargetIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route53ResolverForwardingRule.
+func (in *Route53ResolverForwardingRule) DeepCopy() *Route53ResolverForwardingRule {
+	if in == nil {
+		return nil
+	}
+	out := new(Route53ResolverForwardingRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Route53ResolverInboundEndpoint) DeepCopyInto(out *Route53ResolverInboundEndpoint) {
+	*out = *in
+	if in.AllowedCIDRs != nil {
+		in, out := &in.AllowedCIDRs, &out.AllowedCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route53ResolverInboundEndpoint.
+func (in *Route53ResolverInboundEndpoint) DeepCopy() *Route53ResolverInboundEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(Route53ResolverInboundEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Route53ResolverStatus) DeepCopyInto(out *Route53ResolverStatus) {
+	*out = *in
+	if in.InboundEndpointID != nil {
+		in, out := &in.InboundEndpointID, &out.InboundEndpointID
+		*out = new(string)
+		**out = **in
+	}
+	if in.InboundEndpointIPs != nil {
+		in, out := &in.InboundEndpointIPs, &out.InboundEndpointIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OutboundEndpointID != nil {
+		in, out := &in.OutboundEndpointID, &out.OutboundEndpointID
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route53ResolverStatus.
+func (in *Route53ResolverStatus) DeepCopy() *Route53ResolverStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(Route53ResolverStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTableStatus) DeepCopyInto(out *RouteTableStatus) {
+	*out = *in
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableStatus.
+func (in *RouteTableStatus) DeepCopy() *RouteTableStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTableStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoutingPolicy) DeepCopyInto(out *RoutingPolicy) {
+	*out = *in
+	if in.Weighted != nil {
+		in, out := &in.Weighted, &out.Weighted
+		*out = new(WeightedRoutingPolicy)
+		**out = **in
+	}
+	if in.Latency != nil {
+		in, out := &in.Latency, &out.Latency
+		*out = new(LatencyRoutingPolicy)
+		**out = **in
+	}
+	if in.Failover != nil {
+		in, out := &in.Failover, &out.Failover
+		*out = new(FailoverRoutingPolicy)
+		**out = **in
+	}
+	if in.Geolocation != nil {
+		in, out := &in.Geolocation, &out.Geolocation
+		*out = new(GeolocationRoutingPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingPolicy.
+func (in *RoutingPolicy) DeepCopy() *RoutingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RoutingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSMParameter) DeepCopyInto(out *SSMParameter) {
+	*out = *in
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSMParameter.
+func (in *SSMParameter) DeepCopy() *SSMParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(SSMParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroup) DeepCopyInto(out *SecurityGroup) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroup.
+func (in *SecurityGroup) DeepCopy() *SecurityGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SerialConsole) DeepCopyInto(out *SerialConsole) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SerialConsole.
+func (in *SerialConsole) DeepCopy() *SerialConsole {
+	if in == nil {
+		return nil
+	}
+	out := new(SerialConsole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotControllerConfig) DeepCopyInto(out *SnapshotControllerConfig) {
+	*out = *in
+	if in.WorkerThreads != nil {
+		in, out := &in.WorkerThreads, &out.WorkerThreads
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotControllerConfig.
+func (in *SnapshotControllerConfig) DeepCopy() *SnapshotControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceImage) DeepCopyInto(out *SourceImage) {
+	*out = *in
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		*out = new(string)
+		**out = **in
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceImage.
+func (in *SourceImage) DeepCopy() *SourceImage {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpotInterruptionHandling) DeepCopyInto(out *SpotInterruptionHandling) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpotInterruptionHandling.
+func (in *SpotInterruptionHandling) DeepCopy() *SpotInterruptionHandling {
+	if in == nil {
+		return nil
+	}
+	out := new(SpotInterruptionHandling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Storage) DeepCopyInto(out *Storage) {
+	*out = *in
+	if in.ManagedDefaultClass != nil {
+		in, out := &in.ManagedDefaultClass, &out.ManagedDefaultClass
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EFS != nil {
+		in, out := &in.EFS, &out.EFS
+		*out = new(EFSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultClass != nil {
+		in, out := &in.DefaultClass, &out.DefaultClass
+		*out = new(StorageClassParameters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdditionalClasses != nil {
+		in, out := &in.AdditionalClasses, &out.AdditionalClasses
+		*out = make([]StorageClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EBS != nil {
+		in, out := &in.EBS, &out.EBS
+		*out = new(EBSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Storage.
+func (in *Storage) DeepCopy() *Storage {
+	if in == nil {
+		return nil
+	}
+	out := new(Storage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageClass) DeepCopyInto(out *StorageClass) {
+	*out = *in
+	in.Parameters.DeepCopyInto(&out.Parameters)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageClass.
+func (in *StorageClass) DeepCopy() *StorageClass {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageClassParameters) DeepCopyInto(out *StorageClassParameters) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.IOPS != nil {
+		in, out := &in.IOPS, &out.IOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Throughput != nil {
+		in, out := &in.Throughput, &out.Throughput
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Encrypted != nil {
+		in, out := &in.Encrypted, &out.Encrypted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageClassParameters.
+func (in *StorageClassParameters) DeepCopy() *StorageClassParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageClassParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Subnet) DeepCopyInto(out *Subnet) {
+	*out = *in
+	if in.IPv6CIDR != nil {
+		in, out := &in.IPv6CIDR, &out.IPv6CIDR
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Subnet.
+func (in *Subnet) DeepCopy() *Subnet {
+	if in == nil {
+		return nil
+	}
+	out := new(Subnet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGateway) DeepCopyInto(out *TransitGateway) {
+	*out = *in
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGateway.
+func (in *TransitGateway) DeepCopy() *TransitGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserData) DeepCopyInto(out *UserData) {
+	*out = *in
+	if in.PreBootstrap != nil {
+		in, out := &in.PreBootstrap, &out.PreBootstrap
+		*out = make([]UserDataPart, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PostBootstrap != nil {
+		in, out := &in.PostBootstrap, &out.PostBootstrap
+		*out = make([]UserDataPart, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserData.
+func (in *UserData) DeepCopy() *UserData {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineImage)
+	out := new(UserData)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineImageVersion) DeepCopyInto(out *MachineImageVersion) {
+func (in *UserDataPart) DeepCopyInto(out *UserDataPart) {
 	*out = *in
-	if in.Regions != nil {
-		in, out := &in.Regions, &out.Regions
-		*out = make([]RegionAMIMapping, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Encoding != nil {
+		in, out := &in.Encoding, &out.Encoding
+		*out = new(UserDataEncoding)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImageVersion.
-func (in *MachineImageVersion) DeepCopy() *MachineImageVersion {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserDataPart.
+func (in *UserDataPart) DeepCopy() *UserDataPart {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineImageVersion)
+	out := new(UserDataPart)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineImages) DeepCopyInto(out *MachineImages) {
+func (in *VPC) DeepCopyInto(out *VPC) {
 	*out = *in
-	if in.Versions != nil {
-		in, out := &in.Versions, &out.Versions
-		*out = make([]MachineImageVersion, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.CIDR != nil {
+		in, out := &in.CIDR, &out.CIDR
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecondaryCidrBlocks != nil {
+		in, out := &in.SecondaryCidrBlocks, &out.SecondaryCidrBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GatewayEndpoints != nil {
+		in, out := &in.GatewayEndpoints, &out.GatewayEndpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]VPCEndpoint, len(*in))
+		copy(*out, *in)
+	}
+	if in.DHCPOptions != nil {
+		in, out := &in.DHCPOptions, &out.DHCPOptions
+		*out = new(DHCPOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InternetGatewayID != nil {
+		in, out := &in.InternetGatewayID, &out.InternetGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.MainRouteTableID != nil {
+		in, out := &in.MainRouteTableID, &out.MainRouteTableID
+		*out = new(string)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImages.
-func (in *MachineImages) DeepCopy() *MachineImages {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPC.
+func (in *VPC) DeepCopy() *VPC {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineImages)
+	out := new(VPC)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Networks) DeepCopyInto(out *Networks) {
+func (in *VPCEndpoint) DeepCopyInto(out *VPCEndpoint) {
 	*out = *in
-	in.VPC.DeepCopyInto(&out.VPC)
-	if in.Zones != nil {
-		in, out := &in.Zones, &out.Zones
-		*out = make([]Zone, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Networks.
-func (in *Networks) DeepCopy() *Networks {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpoint.
+func (in *VPCEndpoint) DeepCopy() *VPCEndpoint {
 	if in == nil {
 		return nil
 	}
-	out := new(Networks)
+	out := new(VPCEndpoint)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RegionAMIMapping) DeepCopyInto(out *RegionAMIMapping) {
+func (in *VPCEndpointStatus) DeepCopyInto(out *VPCEndpointStatus) {
 	*out = *in
-	if in.Architecture != nil {
-		in, out := &in.Architecture, &out.Architecture
+	if in.PrefixListID != nil {
+		in, out := &in.PrefixListID, &out.PrefixListID
 		*out = new(string)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionAMIMapping.
-func (in *RegionAMIMapping) DeepCopy() *RegionAMIMapping {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointStatus.
+func (in *VPCEndpointStatus) DeepCopy() *VPCEndpointStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RegionAMIMapping)
+	out := new(VPCEndpointStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Role) DeepCopyInto(out *Role) {
+func (in *VPCFlowLogs) DeepCopyInto(out *VPCFlowLogs) {
 	*out = *in
+	in.Destination.DeepCopyInto(&out.Destination)
+	if in.TrafficType != nil {
+		in, out := &in.TrafficType, &out.TrafficType
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxAggregationInterval != nil {
+		in, out := &in.MaxAggregationInterval, &out.MaxAggregationInterval
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Role.
-func (in *Role) DeepCopy() *Role {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCFlowLogs.
+func (in *VPCFlowLogs) DeepCopy() *VPCFlowLogs {
 	if in == nil {
 		return nil
 	}
-	out := new(Role)
+	out := new(VPCFlowLogs)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecurityGroup) DeepCopyInto(out *SecurityGroup) {
+func (in *VPCFlowLogsCloudWatchLogs) DeepCopyInto(out *VPCFlowLogsCloudWatchLogs) {
 	*out = *in
+	if in.KMSKeyARN != nil {
+		in, out := &in.KMSKeyARN, &out.KMSKeyARN
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroup.
-func (in *SecurityGroup) DeepCopy() *SecurityGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCFlowLogsCloudWatchLogs.
+func (in *VPCFlowLogsCloudWatchLogs) DeepCopy() *VPCFlowLogsCloudWatchLogs {
 	if in == nil {
 		return nil
 	}
-	out := new(SecurityGroup)
+	out := new(VPCFlowLogsCloudWatchLogs)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Storage) DeepCopyInto(out *Storage) {
+func (in *VPCFlowLogsDestination) DeepCopyInto(out *VPCFlowLogsDestination) {
 	*out = *in
-	if in.ManagedDefaultClass != nil {
-		in, out := &in.ManagedDefaultClass, &out.ManagedDefaultClass
-		*out = new(bool)
+	if in.CloudWatchLogs != nil {
+		in, out := &in.CloudWatchLogs, &out.CloudWatchLogs
+		*out = new(VPCFlowLogsCloudWatchLogs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(VPCFlowLogsS3)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Storage.
-func (in *Storage) DeepCopy() *Storage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCFlowLogsDestination.
+func (in *VPCFlowLogsDestination) DeepCopy() *VPCFlowLogsDestination {
 	if in == nil {
 		return nil
 	}
-	out := new(Storage)
+	out := new(VPCFlowLogsDestination)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Subnet) DeepCopyInto(out *Subnet) {
+func (in *VPCFlowLogsS3) DeepCopyInto(out *VPCFlowLogsS3) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Subnet.
-func (in *Subnet) DeepCopy() *Subnet {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCFlowLogsS3.
+func (in *VPCFlowLogsS3) DeepCopy() *VPCFlowLogsS3 {
 	if in == nil {
 		return nil
 	}
-	out := new(Subnet)
+	out := new(VPCFlowLogsS3)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VPC) DeepCopyInto(out *VPC) {
+func (in *VPCPeering) DeepCopyInto(out *VPCPeering) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
+	if in.PeerAccountID != nil {
+		in, out := &in.PeerAccountID, &out.PeerAccountID
 		*out = new(string)
 		**out = **in
 	}
-	if in.CIDR != nil {
-		in, out := &in.CIDR, &out.CIDR
+	if in.PeerRegion != nil {
+		in, out := &in.PeerRegion, &out.PeerRegion
 		*out = new(string)
 		**out = **in
 	}
-	if in.GatewayEndpoints != nil {
-		in, out := &in.GatewayEndpoints, &out.GatewayEndpoints
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPC.
-func (in *VPC) DeepCopy() *VPC {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeering.
+func (in *VPCPeering) DeepCopy() *VPCPeering {
 	if in == nil {
 		return nil
 	}
-	out := new(VPC)
+	out := new(VPCPeering)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringStatus) DeepCopyInto(out *VPCPeeringStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringStatus.
+func (in *VPCPeeringStatus) DeepCopy() *VPCPeeringStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -608,13 +3098,73 @@ func (in *VPCStatus) DeepCopyInto(out *VPCStatus) {
 	if in.Subnets != nil {
 		in, out := &in.Subnets, &out.Subnets
 		*out = make([]Subnet, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.SecurityGroups != nil {
 		in, out := &in.SecurityGroups, &out.SecurityGroups
 		*out = make([]SecurityGroup, len(*in))
 		copy(*out, *in)
 	}
+	if in.TransitGatewayAttachmentID != nil {
+		in, out := &in.TransitGatewayAttachmentID, &out.TransitGatewayAttachmentID
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6CIDR != nil {
+		in, out := &in.IPv6CIDR, &out.IPv6CIDR
+		*out = new(string)
+		**out = **in
+	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]VPCEndpointStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Peerings != nil {
+		in, out := &in.Peerings, &out.Peerings
+		*out = make([]VPCPeeringStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Route53Resolver != nil {
+		in, out := &in.Route53Resolver, &out.Route53Resolver
+		*out = new(Route53ResolverStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InternetGatewayID != nil {
+		in, out := &in.InternetGatewayID, &out.InternetGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.EgressOnlyInternetGatewayID != nil {
+		in, out := &in.EgressOnlyInternetGatewayID, &out.EgressOnlyInternetGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.NATGateways != nil {
+		in, out := &in.NATGateways, &out.NATGateways
+		*out = make([]NATGatewayStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RouteTables != nil {
+		in, out := &in.RouteTables, &out.RouteTables
+		*out = make([]RouteTableStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ElasticIPPool != nil {
+		in, out := &in.ElasticIPPool, &out.ElasticIPPool
+		*out = make([]ElasticIPPoolAddress, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -641,6 +3191,11 @@ func (in *Volume) DeepCopyInto(out *Volume) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -654,6 +3209,22 @@ func (in *Volume) DeepCopy() *Volume {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeightedRoutingPolicy) DeepCopyInto(out *WeightedRoutingPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeightedRoutingPolicy.
+func (in *WeightedRoutingPolicy) DeepCopy() *WeightedRoutingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(WeightedRoutingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerConfig) DeepCopyInto(out *WorkerConfig) {
 	*out = *in
@@ -685,6 +3256,123 @@ func (in *WorkerConfig) DeepCopyInto(out *WorkerConfig) {
 		*out = new(InstanceMetadataOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AdditionalSecurityGroupIDs != nil {
+		in, out := &in.AdditionalSecurityGroupIDs, &out.AdditionalSecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CapacityType != nil {
+		in, out := &in.CapacityType, &out.CapacityType
+		*out = new(CapacityType)
+		**out = **in
+	}
+	if in.SpotMaxPrice != nil {
+		in, out := &in.SpotMaxPrice, &out.SpotMaxPrice
+		*out = new(string)
+		**out = **in
+	}
+	if in.SpotInterruptionHandling != nil {
+		in, out := &in.SpotInterruptionHandling, &out.SpotInterruptionHandling
+		*out = new(SpotInterruptionHandling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CapacityReservation != nil {
+		in, out := &in.CapacityReservation, &out.CapacityReservation
+		*out = new(CapacityReservation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tenancy != nil {
+		in, out := &in.Tenancy, &out.Tenancy
+		*out = new(Tenancy)
+		**out = **in
+	}
+	if in.HostResourceGroupArn != nil {
+		in, out := &in.HostResourceGroupArn, &out.HostResourceGroupArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.PlacementGroup != nil {
+		in, out := &in.PlacementGroup, &out.PlacementGroup
+		*out = new(PlacementGroup)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkInterfaces != nil {
+		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
+		*out = new(NetworkInterfaces)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DevicePlugin != nil {
+		in, out := &in.DevicePlugin, &out.DevicePlugin
+		*out = new(DevicePlugin)
+		**out = **in
+	}
+	if in.InstanceStorage != nil {
+		in, out := &in.InstanceStorage, &out.InstanceStorage
+		*out = new(InstanceStorage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnclaveOptions != nil {
+		in, out := &in.EnclaveOptions, &out.EnclaveOptions
+		*out = new(EnclaveOptions)
+		**out = **in
+	}
+	if in.CPUOptions != nil {
+		in, out := &in.CPUOptions, &out.CPUOptions
+		*out = new(CPUOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OutpostARN != nil {
+		in, out := &in.OutpostARN, &out.OutpostARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImageSelector != nil {
+		in, out := &in.ImageSelector, &out.ImageSelector
+		*out = new(ImageSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(Monitoring)
+		**out = **in
+	}
+	if in.SerialConsole != nil {
+		in, out := &in.SerialConsole, &out.SerialConsole
+		*out = new(SerialConsole)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.UserData != nil {
+		in, out := &in.UserData, &out.UserData
+		*out = new(UserData)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Containerd != nil {
+		in, out := &in.Containerd, &out.Containerd
+		*out = new(ContainerdConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Kubelet != nil {
+		in, out := &in.Kubelet, &out.Kubelet
+		*out = new(KubeletConfig)
+		**out = **in
+	}
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(RollingUpdate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreProvisionedCapacity != nil {
+		in, out := &in.PreProvisionedCapacity, &out.PreProvisionedCapacity
+		*out = new(PreProvisionedCapacity)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -717,6 +3405,11 @@ func (in *WorkerStatus) DeepCopyInto(out *WorkerStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PlacementGroups != nil {
+		in, out := &in.PlacementGroups, &out.PlacementGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -741,11 +3434,41 @@ func (in *WorkerStatus) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Zone) DeepCopyInto(out *Zone) {
 	*out = *in
+	if in.ZoneID != nil {
+		in, out := &in.ZoneID, &out.ZoneID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(ZoneType)
+		**out = **in
+	}
 	if in.ElasticIPAllocationID != nil {
 		in, out := &in.ElasticIPAllocationID, &out.ElasticIPAllocationID
 		*out = new(string)
 		**out = **in
 	}
+	if in.Pods != nil {
+		in, out := &in.Pods, &out.Pods
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecondaryWorkers != nil {
+		in, out := &in.SecondaryWorkers, &out.SecondaryWorkers
+		*out = new(string)
+		**out = **in
+	}
+	if in.OutpostARN != nil {
+		in, out := &in.OutpostARN, &out.OutpostARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.RouteTableID != nil {
+		in, out := &in.RouteTableID, &out.RouteTableID
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -16,6 +16,7 @@ package v1alpha1
 
 import (
 	healthcheckconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
@@ -37,6 +38,40 @@ type ControllerConfiguration struct {
 	// HealthCheckConfig is the config for the health check controller
 	// +optional
 	HealthCheckConfig *healthcheckconfigv1alpha1.HealthCheckConfig `json:"healthCheckConfig,omitempty"`
+	// Endpoints contains overrides for the AWS partition and the AWS service endpoints used by all AWS clients of the
+	// controller.
+	// +optional
+	Endpoints *Endpoints `json:"endpoints,omitempty"`
+	// Proxy contains the configuration of the HTTP proxy used for all requests to the AWS API, including the ones of
+	// the Terraformer.
+	// +optional
+	Proxy *Proxy `json:"proxy,omitempty"`
+	// CABundle contains PEM encoded CA certificates which are trusted in addition to the system CAs for all requests to
+	// the AWS API, e.g. for a proxy intercepting TLS connections.
+	// +optional
+	CABundle *string `json:"caBundle,omitempty"`
+	// ClientRateLimiter contains the configuration of the client-side rate limiting and the adaptive retrying of the
+	// requests to the AWS API. The rate limit applies to all requests with the same credentials in the same region.
+	// +optional
+	ClientRateLimiter *ClientRateLimiter `json:"clientRateLimiter,omitempty"`
+	// LeakDetection contains the configuration of the periodic detection of orphaned AWS resources of shoots.
+	// +optional
+	LeakDetection *LeakDetection `json:"leakDetection,omitempty"`
+	// TagReconciliation contains the configuration of the periodic correction of the tags of the AWS resources of
+	// shoots.
+	// +optional
+	TagReconciliation *TagReconciliation `json:"tagReconciliation,omitempty"`
+	// Bastion contains the default configuration of the bastion hosts, which can be overridden in the provider config
+	// of a Bastion.
+	// +optional
+	Bastion *BastionConfig `json:"bastion,omitempty"`
+	// PrivateLink contains the configuration of the exposure of the kube-apiservers of shoots as VPC endpoint services.
+	// If not set, shoots cannot enable it.
+	// +optional
+	PrivateLink *PrivateLinkConfig `json:"privateLink,omitempty"`
+	// Terraformer contains the configuration of the Terraformer pods of the infrastructure controller.
+	// +optional
+	Terraformer *TerraformerConfig `json:"terraformer,omitempty"`
 }
 
 // ETCD is an etcd configuration.
@@ -63,3 +98,116 @@ type ETCDBackup struct {
 	// +optional
 	Schedule *string `json:"schedule,omitempty"`
 }
+
+// Endpoints contains overrides for the AWS partition and the AWS service endpoints.
+type Endpoints struct {
+	// Partition is the AWS partition, e.g. `aws-cn` or `aws-us-gov`. If not set, it is derived from the region.
+	// +optional
+	Partition *string `json:"partition,omitempty"`
+	// Services maps AWS service identifiers (e.g. `ec2`, `iam` or `s3`) to custom endpoint URLs which are used
+	// instead of the default endpoints of the region.
+	// +optional
+	Services map[string]string `json:"services,omitempty"`
+}
+
+// Proxy contains the configuration of an HTTP proxy.
+type Proxy struct {
+	// URL is the URL of the proxy, e.g. `http://proxy.example.com:3128`.
+	URL string `json:"url"`
+	// NoProxy is a list of hosts, domains (with leading dot) and CIDRs which are reached without the proxy.
+	// +optional
+	NoProxy []string `json:"noProxy,omitempty"`
+	// CredentialsFile is the path of a file containing the credentials for the basic authentication at the proxy in
+	// the format `<username>:<password>`, e.g. of a mounted secret.
+	// +optional
+	CredentialsFile *string `json:"credentialsFile,omitempty"`
+}
+
+// ClientRateLimiter contains the configuration of the client-side rate limiting of the requests to the AWS API.
+type ClientRateLimiter struct {
+	// QPS is the maximum number of requests per second.
+	QPS float32 `json:"qps"`
+	// Burst is the maximum burst of requests.
+	Burst int `json:"burst"`
+	// WaitTimeout is the maximum duration a request waits for the rate limiter before it fails.
+	// +optional
+	WaitTimeout *metav1.Duration `json:"waitTimeout,omitempty"`
+	// MaxAttempts is the maximum number of attempts of a request, including the retries of throttled requests.
+	// +optional
+	MaxAttempts *int `json:"maxAttempts,omitempty"`
+	// MaxBackoff is the maximum delay between two attempts of a request.
+	// +optional
+	MaxBackoff *metav1.Duration `json:"maxBackoff,omitempty"`
+}
+
+// LeakDetection contains the configuration of the detection of orphaned AWS resources.
+type LeakDetection struct {
+	// Interval is the interval in which the AWS resources of a shoot are checked for orphans.
+	Interval metav1.Duration `json:"interval"`
+	// DeleteOrphans specifies whether orphaned instances, elastic IPs and volumes are deleted.
+	// +optional
+	DeleteOrphans bool `json:"deleteOrphans,omitempty"`
+}
+
+// TagReconciliation contains the configuration of the correction of the tags of AWS resources.
+type TagReconciliation struct {
+	// Interval is the interval in which the tags of the AWS resources of a shoot are checked.
+	Interval metav1.Duration `json:"interval"`
+}
+
+// PrivateLinkConfig contains the configuration of the exposure of the kube-apiservers of shoots as VPC endpoint
+// services. The network load balancers and the endpoint services are created in the AWS account of the seed.
+type PrivateLinkConfig struct {
+	// SecretRef references the secret containing the AWS credentials of the account of the seed.
+	SecretRef corev1.SecretReference `json:"secretRef"`
+}
+
+// BastionConfig contains the configuration of bastion hosts.
+type BastionConfig struct {
+	// InstanceType is the instance type of the bastion hosts. If not set, a small burstable instance type supporting
+	// the architecture of the AMI is used.
+	// +optional
+	InstanceType *string `json:"instanceType,omitempty"`
+	// MachineImage is the machine image of the CloudProfile which is used for the bastion hosts. If not set, the first
+	// AMI of the CloudProfile in the region of the shoot is used.
+	// +optional
+	MachineImage *BastionMachineImage `json:"machineImage,omitempty"`
+	// SessionManager specifies whether the bastion hosts are only accessible via AWS Systems Manager Session Manager
+	// instead of SSH.
+	// +optional
+	SessionManager *bool `json:"sessionManager,omitempty"`
+	// MaxLifetime is the duration after the creation of a Bastion after which the ingress rules of its security group
+	// and its access to the worker nodes are revoked, even if the Bastion has not been deleted yet.
+	// +optional
+	MaxLifetime *metav1.Duration `json:"maxLifetime,omitempty"`
+}
+
+// TerraformerConfig contains the configuration of the Terraformer pods and the handling of the terraform state.
+type TerraformerConfig struct {
+	// Image is the image of the Terraformer, which overrides the one of the image vector.
+	// +optional
+	Image *string `json:"image,omitempty"`
+	// Resources are the resource requests and limits of the Terraformer pods. If not set, the defaults of the
+	// Terraformer library (100m CPU and 200Mi memory requests, 500m CPU and 1.5Gi memory limits) are used.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// ActiveDeadlineSeconds is the duration in seconds after which Terraformer pods are terminated by the kubelet.
+	// +optional
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+	// PodTimeout is the maximum duration the controller waits for a Terraformer pod to complete. Defaults to 15m.
+	// +optional
+	PodTimeout *metav1.Duration `json:"podTimeout,omitempty"`
+	// StateCompressionThreshold is the size of the terraform state above which it is compressed before it is stored in
+	// the status of the Infrastructure. Defaults to 512Ki.
+	// +optional
+	StateCompressionThreshold *resource.Quantity `json:"stateCompressionThreshold,omitempty"`
+}
+
+// BastionMachineImage references a machine image of the CloudProfile.
+type BastionMachineImage struct {
+	// Name is the name of the machine image.
+	Name string `json:"name"`
+	// Version is the version of the machine image. If not set, the latest version which is not expired is used.
+	// +optional
+	Version *string `json:"version,omitempty"`
+}
@@ -27,7 +27,9 @@ import (
 	config "github.com/gardener/gardener-extension-provider-aws/pkg/apis/config"
 	apisconfig "github.com/gardener/gardener/extensions/pkg/apis/config"
 	apisconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	resource "k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	componentbaseconfig "k8s.io/component-base/config"
@@ -41,6 +43,36 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*BastionConfig)(nil), (*config.BastionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BastionConfig_To_config_BastionConfig(a.(*BastionConfig), b.(*config.BastionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.BastionConfig)(nil), (*BastionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_BastionConfig_To_v1alpha1_BastionConfig(a.(*config.BastionConfig), b.(*BastionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BastionMachineImage)(nil), (*config.BastionMachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BastionMachineImage_To_config_BastionMachineImage(a.(*BastionMachineImage), b.(*config.BastionMachineImage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.BastionMachineImage)(nil), (*BastionMachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_BastionMachineImage_To_v1alpha1_BastionMachineImage(a.(*config.BastionMachineImage), b.(*BastionMachineImage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClientRateLimiter)(nil), (*config.ClientRateLimiter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClientRateLimiter_To_config_ClientRateLimiter(a.(*ClientRateLimiter), b.(*config.ClientRateLimiter), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ClientRateLimiter)(nil), (*ClientRateLimiter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ClientRateLimiter_To_v1alpha1_ClientRateLimiter(a.(*config.ClientRateLimiter), b.(*ClientRateLimiter), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerConfiguration)(nil), (*config.ControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControllerConfiguration_To_config_ControllerConfiguration(a.(*ControllerConfiguration), b.(*config.ControllerConfiguration), scope)
 	}); err != nil {
@@ -81,15 +113,160 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Endpoints)(nil), (*config.Endpoints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Endpoints_To_config_Endpoints(a.(*Endpoints), b.(*config.Endpoints), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.Endpoints)(nil), (*Endpoints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_Endpoints_To_v1alpha1_Endpoints(a.(*config.Endpoints), b.(*Endpoints), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LeakDetection)(nil), (*config.LeakDetection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LeakDetection_To_config_LeakDetection(a.(*LeakDetection), b.(*config.LeakDetection), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.LeakDetection)(nil), (*LeakDetection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_LeakDetection_To_v1alpha1_LeakDetection(a.(*config.LeakDetection), b.(*LeakDetection), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PrivateLinkConfig)(nil), (*config.PrivateLinkConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PrivateLinkConfig_To_config_PrivateLinkConfig(a.(*PrivateLinkConfig), b.(*config.PrivateLinkConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.PrivateLinkConfig)(nil), (*PrivateLinkConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_PrivateLinkConfig_To_v1alpha1_PrivateLinkConfig(a.(*config.PrivateLinkConfig), b.(*PrivateLinkConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Proxy)(nil), (*config.Proxy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Proxy_To_config_Proxy(a.(*Proxy), b.(*config.Proxy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.Proxy)(nil), (*Proxy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_Proxy_To_v1alpha1_Proxy(a.(*config.Proxy), b.(*Proxy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TagReconciliation)(nil), (*config.TagReconciliation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TagReconciliation_To_config_TagReconciliation(a.(*TagReconciliation), b.(*config.TagReconciliation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.TagReconciliation)(nil), (*TagReconciliation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_TagReconciliation_To_v1alpha1_TagReconciliation(a.(*config.TagReconciliation), b.(*TagReconciliation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TerraformerConfig)(nil), (*config.TerraformerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TerraformerConfig_To_config_TerraformerConfig(a.(*TerraformerConfig), b.(*config.TerraformerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.TerraformerConfig)(nil), (*TerraformerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_TerraformerConfig_To_v1alpha1_TerraformerConfig(a.(*config.TerraformerConfig), b.(*TerraformerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_BastionConfig_To_config_BastionConfig(in *BastionConfig, out *config.BastionConfig, s conversion.Scope) error {
+	out.InstanceType = (*string)(unsafe.Pointer(in.InstanceType))
+	out.MachineImage = (*config.BastionMachineImage)(unsafe.Pointer(in.MachineImage))
+	out.SessionManager = (*bool)(unsafe.Pointer(in.SessionManager))
+	out.MaxLifetime = (*v1.Duration)(unsafe.Pointer(in.MaxLifetime))
+	return nil
+}
+
+// Convert_v1alpha1_BastionConfig_To_config_BastionConfig is an autogenerated conversion function.
+func Convert_v1alpha1_BastionConfig_To_config_BastionConfig(in *BastionConfig, out *config.BastionConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BastionConfig_To_config_BastionConfig(in, out, s)
+}
+
+func autoConvert_config_BastionConfig_To_v1alpha1_BastionConfig(in *config.BastionConfig, out *BastionConfig, s conversion.Scope) error {
+	out.InstanceType = (*string)(unsafe.Pointer(in.InstanceType))
+	out.MachineImage = (*BastionMachineImage)(unsafe.Pointer(in.MachineImage))
+	out.SessionManager = (*bool)(unsafe.Pointer(in.SessionManager))
+	out.MaxLifetime = (*v1.Duration)(unsafe.Pointer(in.MaxLifetime))
+	return nil
+}
+
+// Convert_config_BastionConfig_To_v1alpha1_BastionConfig is an autogenerated conversion function.
+func Convert_config_BastionConfig_To_v1alpha1_BastionConfig(in *config.BastionConfig, out *BastionConfig, s conversion.Scope) error {
+	return autoConvert_config_BastionConfig_To_v1alpha1_BastionConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_BastionMachineImage_To_config_BastionMachineImage(in *BastionMachineImage, out *config.BastionMachineImage, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = (*string)(unsafe.Pointer(in.Version))
+	return nil
+}
+
+// Convert_v1alpha1_BastionMachineImage_To_config_BastionMachineImage is an autogenerated conversion function.
+func Convert_v1alpha1_BastionMachineImage_To_config_BastionMachineImage(in *BastionMachineImage, out *config.BastionMachineImage, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BastionMachineImage_To_config_BastionMachineImage(in, out, s)
+}
+
+func autoConvert_config_BastionMachineImage_To_v1alpha1_BastionMachineImage(in *config.BastionMachineImage, out *BastionMachineImage, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = (*string)(unsafe.Pointer(in.Version))
 	return nil
 }
 
+// Convert_config_BastionMachineImage_To_v1alpha1_BastionMachineImage is an autogenerated conversion function.
+func Convert_config_BastionMachineImage_To_v1alpha1_BastionMachineImage(in *config.BastionMachineImage, out *BastionMachineImage, s conversion.Scope) error {
+	return autoConvert_config_BastionMachineImage_To_v1alpha1_BastionMachineImage(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClientRateLimiter_To_config_ClientRateLimiter(in *ClientRateLimiter, out *config.ClientRateLimiter, s conversion.Scope) error {
+	out.QPS = in.QPS
+	out.Burst = in.Burst
+	out.WaitTimeout = (*v1.Duration)(unsafe.Pointer(in.WaitTimeout))
+	out.MaxAttempts = (*int)(unsafe.Pointer(in.MaxAttempts))
+	out.MaxBackoff = (*v1.Duration)(unsafe.Pointer(in.MaxBackoff))
+	return nil
+}
+
+// Convert_v1alpha1_ClientRateLimiter_To_config_ClientRateLimiter is an autogenerated conversion function.
+func Convert_v1alpha1_ClientRateLimiter_To_config_ClientRateLimiter(in *ClientRateLimiter, out *config.ClientRateLimiter, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClientRateLimiter_To_config_ClientRateLimiter(in, out, s)
+}
+
+func autoConvert_config_ClientRateLimiter_To_v1alpha1_ClientRateLimiter(in *config.ClientRateLimiter, out *ClientRateLimiter, s conversion.Scope) error {
+	out.QPS = in.QPS
+	out.Burst = in.Burst
+	out.WaitTimeout = (*v1.Duration)(unsafe.Pointer(in.WaitTimeout))
+	out.MaxAttempts = (*int)(unsafe.Pointer(in.MaxAttempts))
+	out.MaxBackoff = (*v1.Duration)(unsafe.Pointer(in.MaxBackoff))
+	return nil
+}
+
+// Convert_config_ClientRateLimiter_To_v1alpha1_ClientRateLimiter is an autogenerated conversion function.
+func Convert_config_ClientRateLimiter_To_v1alpha1_ClientRateLimiter(in *config.ClientRateLimiter, out *ClientRateLimiter, s conversion.Scope) error {
+	return autoConvert_config_ClientRateLimiter_To_v1alpha1_ClientRateLimiter(in, out, s)
+}
+
 func autoConvert_v1alpha1_ControllerConfiguration_To_config_ControllerConfiguration(in *ControllerConfiguration, out *config.ControllerConfiguration, s conversion.Scope) error {
 	out.ClientConnection = (*componentbaseconfig.ClientConnectionConfiguration)(unsafe.Pointer(in.ClientConnection))
 	if err := Convert_v1alpha1_ETCD_To_config_ETCD(&in.ETCD, &out.ETCD, s); err != nil {
 		return err
 	}
 	out.HealthCheckConfig = (*apisconfig.HealthCheckConfig)(unsafe.Pointer(in.HealthCheckConfig))
+	out.Endpoints = (*config.Endpoints)(unsafe.Pointer(in.Endpoints))
+	out.Proxy = (*config.Proxy)(unsafe.Pointer(in.Proxy))
+	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
+	out.ClientRateLimiter = (*config.ClientRateLimiter)(unsafe.Pointer(in.ClientRateLimiter))
+	out.LeakDetection = (*config.LeakDetection)(unsafe.Pointer(in.LeakDetection))
+	out.TagReconciliation = (*config.TagReconciliation)(unsafe.Pointer(in.TagReconciliation))
+	out.Bastion = (*config.BastionConfig)(unsafe.Pointer(in.Bastion))
+	out.PrivateLink = (*config.PrivateLinkConfig)(unsafe.Pointer(in.PrivateLink))
+	out.Terraformer = (*config.TerraformerConfig)(unsafe.Pointer(in.Terraformer))
 	return nil
 }
 
@@ -104,6 +281,15 @@ func autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfigurat
 		return err
 	}
 	out.HealthCheckConfig = (*apisconfigv1alpha1.HealthCheckConfig)(unsafe.Pointer(in.HealthCheckConfig))
+	out.Endpoints = (*Endpoints)(unsafe.Pointer(in.Endpoints))
+	out.Proxy = (*Proxy)(unsafe.Pointer(in.Proxy))
+	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
+	out.ClientRateLimiter = (*ClientRateLimiter)(unsafe.Pointer(in.ClientRateLimiter))
+	out.LeakDetection = (*LeakDetection)(unsafe.Pointer(in.LeakDetection))
+	out.TagReconciliation = (*TagReconciliation)(unsafe.Pointer(in.TagReconciliation))
+	out.Bastion = (*BastionConfig)(unsafe.Pointer(in.Bastion))
+	out.PrivateLink = (*PrivateLinkConfig)(unsafe.Pointer(in.PrivateLink))
+	out.Terraformer = (*TerraformerConfig)(unsafe.Pointer(in.Terraformer))
 	return nil
 }
 
@@ -183,3 +369,139 @@ func autoConvert_config_ETCDStorage_To_v1alpha1_ETCDStorage(in *config.ETCDStora
 func Convert_config_ETCDStorage_To_v1alpha1_ETCDStorage(in *config.ETCDStorage, out *ETCDStorage, s conversion.Scope) error {
 	return autoConvert_config_ETCDStorage_To_v1alpha1_ETCDStorage(in, out, s)
 }
+
+func autoConvert_v1alpha1_Endpoints_To_config_Endpoints(in *Endpoints, out *config.Endpoints, s conversion.Scope) error {
+	out.Partition = (*string)(unsafe.Pointer(in.Partition))
+	out.Services = *(*map[string]string)(unsafe.Pointer(&in.Services))
+	return nil
+}
+
+// Convert_v1alpha1_Endpoints_To_config_Endpoints is an autogenerated conversion function.
+func Convert_v1alpha1_Endpoints_To_config_Endpoints(in *Endpoints, out *config.Endpoints, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Endpoints_To_config_Endpoints(in, out, s)
+}
+
+func autoConvert_config_Endpoints_To_v1alpha1_Endpoints(in *config.Endpoints, out *Endpoints, s conversion.Scope) error {
+	out.Partition = (*string)(unsafe.Pointer(in.Partition))
+	out.Services = *(*map[string]string)(unsafe.Pointer(&in.Services))
+	return nil
+}
+
+// Convert_config_Endpoints_To_v1alpha1_Endpoints is an autogenerated conversion function.
+func Convert_config_Endpoints_To_v1alpha1_Endpoints(in *config.Endpoints, out *Endpoints, s conversion.Scope) error {
+	return autoConvert_config_Endpoints_To_v1alpha1_Endpoints(in, out, s)
+}
+
+func autoConvert_v1alpha1_LeakDetection_To_config_LeakDetection(in *LeakDetection, out *config.LeakDetection, s conversion.Scope) error {
+	out.Interval = in.Interval
+	out.DeleteOrphans = in.DeleteOrphans
+	return nil
+}
+
+// Convert_v1alpha1_LeakDetection_To_config_LeakDetection is an autogenerated conversion function.
+func Convert_v1alpha1_LeakDetection_To_config_LeakDetection(in *LeakDetection, out *config.LeakDetection, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LeakDetection_To_config_LeakDetection(in, out, s)
+}
+
+func autoConvert_config_LeakDetection_To_v1alpha1_LeakDetection(in *config.LeakDetection, out *LeakDetection, s conversion.Scope) error {
+	out.Interval = in.Interval
+	out.DeleteOrphans = in.DeleteOrphans
+	return nil
+}
+
+// Convert_config_LeakDetection_To_v1alpha1_LeakDetection is an autogenerated conversion function.
+func Convert_config_LeakDetection_To_v1alpha1_LeakDetection(in *config.LeakDetection, out *LeakDetection, s conversion.Scope) error {
+	return autoConvert_config_LeakDetection_To_v1alpha1_LeakDetection(in, out, s)
+}
+
+func autoConvert_v1alpha1_PrivateLinkConfig_To_config_PrivateLinkConfig(in *PrivateLinkConfig, out *config.PrivateLinkConfig, s conversion.Scope) error {
+	out.SecretRef = in.SecretRef
+	return nil
+}
+
+// Convert_v1alpha1_PrivateLinkConfig_To_config_PrivateLinkConfig is an autogenerated conversion function.
+func Convert_v1alpha1_PrivateLinkConfig_To_config_PrivateLinkConfig(in *PrivateLinkConfig, out *config.PrivateLinkConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PrivateLinkConfig_To_config_PrivateLinkConfig(in, out, s)
+}
+
+func autoConvert_config_PrivateLinkConfig_To_v1alpha1_PrivateLinkConfig(in *config.PrivateLinkConfig, out *PrivateLinkConfig, s conversion.Scope) error {
+	out.SecretRef = in.SecretRef
+	return nil
+}
+
+// Convert_config_PrivateLinkConfig_To_v1alpha1_PrivateLinkConfig is an autogenerated conversion function.
+func Convert_config_PrivateLinkConfig_To_v1alpha1_PrivateLinkConfig(in *config.PrivateLinkConfig, out *PrivateLinkConfig, s conversion.Scope) error {
+	return autoConvert_config_PrivateLinkConfig_To_v1alpha1_PrivateLinkConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_Proxy_To_config_Proxy(in *Proxy, out *config.Proxy, s conversion.Scope) error {
+	out.URL = in.URL
+	out.NoProxy = *(*[]string)(unsafe.Pointer(&in.NoProxy))
+	out.CredentialsFile = (*string)(unsafe.Pointer(in.CredentialsFile))
+	return nil
+}
+
+// Convert_v1alpha1_Proxy_To_config_Proxy is an autogenerated conversion function.
+func Convert_v1alpha1_Proxy_To_config_Proxy(in *Proxy, out *config.Proxy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Proxy_To_config_Proxy(in, out, s)
+}
+
+func autoConvert_config_Proxy_To_v1alpha1_Proxy(in *config.Proxy, out *Proxy, s conversion.Scope) error {
+	out.URL = in.URL
+	out.NoProxy = *(*[]string)(unsafe.Pointer(&in.NoProxy))
+	out.CredentialsFile = (*string)(unsafe.Pointer(in.CredentialsFile))
+	return nil
+}
+
+// Convert_config_Proxy_To_v1alpha1_Proxy is an autogenerated conversion function.
+func Convert_config_Proxy_To_v1alpha1_Proxy(in *config.Proxy, out *Proxy, s conversion.Scope) error {
+	return autoConvert_config_Proxy_To_v1alpha1_Proxy(in, out, s)
+}
+
+func autoConvert_v1alpha1_TagReconciliation_To_config_TagReconciliation(in *TagReconciliation, out *config.TagReconciliation, s conversion.Scope) error {
+	out.Interval = in.Interval
+	return nil
+}
+
+// Convert_v1alpha1_TagReconciliation_To_config_TagReconciliation is an autogenerated conversion function.
+func Convert_v1alpha1_TagReconciliation_To_config_TagReconciliation(in *TagReconciliation, out *config.TagReconciliation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TagReconciliation_To_config_TagReconciliation(in, out, s)
+}
+
+func autoConvert_config_TagReconciliation_To_v1alpha1_TagReconciliation(in *config.TagReconciliation, out *TagReconciliation, s conversion.Scope) error {
+	out.Interval = in.Interval
+	return nil
+}
+
+// Convert_config_TagReconciliation_To_v1alpha1_TagReconciliation is an autogenerated conversion function.
+func Convert_config_TagReconciliation_To_v1alpha1_TagReconciliation(in *config.TagReconciliation, out *TagReconciliation, s conversion.Scope) error {
+	return autoConvert_config_TagReconciliation_To_v1alpha1_TagReconciliation(in, out, s)
+}
+
+func autoConvert_v1alpha1_TerraformerConfig_To_config_TerraformerConfig(in *TerraformerConfig, out *config.TerraformerConfig, s conversion.Scope) error {
+	out.Image = (*string)(unsafe.Pointer(in.Image))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.ActiveDeadlineSeconds = (*int64)(unsafe.Pointer(in.ActiveDeadlineSeconds))
+	out.PodTimeout = (*v1.Duration)(unsafe.Pointer(in.PodTimeout))
+	out.StateCompressionThreshold = (*resource.Quantity)(unsafe.Pointer(in.StateCompressionThreshold))
+	return nil
+}
+
+// Convert_v1alpha1_TerraformerConfig_To_config_TerraformerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_TerraformerConfig_To_config_TerraformerConfig(in *TerraformerConfig, out *config.TerraformerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TerraformerConfig_To_config_TerraformerConfig(in, out, s)
+}
+
+func autoConvert_config_TerraformerConfig_To_v1alpha1_TerraformerConfig(in *config.TerraformerConfig, out *TerraformerConfig, s conversion.Scope) error {
+	out.Image = (*string)(unsafe.Pointer(in.Image))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.ActiveDeadlineSeconds = (*int64)(unsafe.Pointer(in.ActiveDeadlineSeconds))
+	out.PodTimeout = (*v1.Duration)(unsafe.Pointer(in.PodTimeout))
+	out.StateCompressionThreshold = (*resource.Quantity)(unsafe.Pointer(in.StateCompressionThreshold))
+	return nil
+}
+
+// Convert_config_TerraformerConfig_To_v1alpha1_TerraformerConfig is an autogenerated conversion function.
+func Convert_config_TerraformerConfig_To_v1alpha1_TerraformerConfig(in *config.TerraformerConfig, out *TerraformerConfig, s conversion.Scope) error {
+	return autoConvert_config_TerraformerConfig_To_v1alpha1_TerraformerConfig(in, out, s)
+}
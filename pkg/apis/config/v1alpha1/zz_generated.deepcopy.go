@@ -23,10 +23,100 @@ package v1alpha1
 
 import (
 	apisconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionConfig) DeepCopyInto(out *BastionConfig) {
+	*out = *in
+	if in.InstanceType != nil {
+		in, out := &in.InstanceType, &out.InstanceType
+		*out = new(string)
+		**out = **in
+	}
+	if in.MachineImage != nil {
+		in, out := &in.MachineImage, &out.MachineImage
+		*out = new(BastionMachineImage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SessionManager != nil {
+		in, out := &in.SessionManager, &out.SessionManager
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxLifetime != nil {
+		in, out := &in.MaxLifetime, &out.MaxLifetime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionConfig.
+func (in *BastionConfig) DeepCopy() *BastionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionMachineImage) DeepCopyInto(out *BastionMachineImage) {
+	*out = *in
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionMachineImage.
+func (in *BastionMachineImage) DeepCopy() *BastionMachineImage {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionMachineImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientRateLimiter) DeepCopyInto(out *ClientRateLimiter) {
+	*out = *in
+	if in.WaitTimeout != nil {
+		in, out := &in.WaitTimeout, &out.WaitTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxAttempts != nil {
+		in, out := &in.MaxAttempts, &out.MaxAttempts
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxBackoff != nil {
+		in, out := &in.MaxBackoff, &out.MaxBackoff
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientRateLimiter.
+func (in *ClientRateLimiter) DeepCopy() *ClientRateLimiter {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientRateLimiter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 	*out = *in
@@ -42,6 +132,51 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 		*out = new(apisconfigv1alpha1.HealthCheckConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = new(Endpoints)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(Proxy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClientRateLimiter != nil {
+		in, out := &in.ClientRateLimiter, &out.ClientRateLimiter
+		*out = new(ClientRateLimiter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LeakDetection != nil {
+		in, out := &in.LeakDetection, &out.LeakDetection
+		*out = new(LeakDetection)
+		**out = **in
+	}
+	if in.TagReconciliation != nil {
+		in, out := &in.TagReconciliation, &out.TagReconciliation
+		*out = new(TagReconciliation)
+		**out = **in
+	}
+	if in.Bastion != nil {
+		in, out := &in.Bastion, &out.Bastion
+		*out = new(BastionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateLink != nil {
+		in, out := &in.PrivateLink, &out.PrivateLink
+		*out = new(PrivateLinkConfig)
+		**out = **in
+	}
+	if in.Terraformer != nil {
+		in, out := &in.Terraformer, &out.Terraformer
+		*out = new(TerraformerConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -127,3 +262,149 @@ func (in *ETCDStorage) DeepCopy() *ETCDStorage {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Endpoints) DeepCopyInto(out *Endpoints) {
+	*out = *in
+	if in.Partition != nil {
+		in, out := &in.Partition, &out.Partition
+		*out = new(string)
+		**out = **in
+	}
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Endpoints.
+func (in *Endpoints) DeepCopy() *Endpoints {
+	if in == nil {
+		return nil
+	}
+	out := new(Endpoints)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeakDetection) DeepCopyInto(out *LeakDetection) {
+	*out = *in
+	out.Interval = in.Interval
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeakDetection.
+func (in *LeakDetection) DeepCopy() *LeakDetection {
+	if in == nil {
+		return nil
+	}
+	out := new(LeakDetection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateLinkConfig) DeepCopyInto(out *PrivateLinkConfig) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateLinkConfig.
+func (in *PrivateLinkConfig) DeepCopy() *PrivateLinkConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateLinkConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Proxy) DeepCopyInto(out *Proxy) {
+	*out = *in
+	if in.NoProxy != nil {
+		in, out := &in.NoProxy, &out.NoProxy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CredentialsFile != nil {
+		in, out := &in.CredentialsFile, &out.CredentialsFile
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Proxy.
+func (in *Proxy) DeepCopy() *Proxy {
+	if in == nil {
+		return nil
+	}
+	out := new(Proxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TagReconciliation) DeepCopyInto(out *TagReconciliation) {
+	*out = *in
+	out.Interval = in.Interval
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagReconciliation.
+func (in *TagReconciliation) DeepCopy() *TagReconciliation {
+	if in == nil {
+		return nil
+	}
+	out := new(TagReconciliation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformerConfig) DeepCopyInto(out *TerraformerConfig) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PodTimeout != nil {
+		in, out := &in.PodTimeout, &out.PodTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.StateCompressionThreshold != nil {
+		in, out := &in.StateCompressionThreshold, &out.StateCompressionThreshold
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformerConfig.
+func (in *TerraformerConfig) DeepCopy() *TerraformerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+)
+
+const (
+	// userAgentKeyController is the key of the name of the controller in the user agent of the requests.
+	userAgentKeyController = "gardener-controller"
+	// userAgentKeyShoot is the key of the technical ID of the shoot in the user agent of the requests.
+	userAgentKeyShoot = "gardener-shoot"
+	// userAgentKeyReconcile is the key of the ID of the reconciliation in the user agent of the requests.
+	userAgentKeyReconcile = "gardener-reconcile"
+
+	// reconcileIDUserAgentMiddlewareID is the ID of the middleware adding the ID of the reconciliation to the user agent.
+	reconcileIDUserAgentMiddlewareID = "ReconcileIDUserAgent"
+
+	// maxSessionNameLength is the maximum length of the session name of an assumed role.
+	maxSessionNameLength = 64
+)
+
+// invalidSessionNameChars matches the characters which are not allowed in the session name of an assumed role.
+var invalidSessionNameChars = regexp.MustCompile(`[^\w+=,.@-]`)
+
+// sessionName returns the name of the sessions of assumed roles for the given authentication configuration, which
+// shows up in CloudTrail as part of the ARN of the assumed role, i.e. `<controller>@<shoot>`. Names exceeding the
+// maximum length are shortened and suffixed with a hash.
+func sessionName(authConfig AuthConfig) string {
+	name := assumeRoleSessionName
+	if authConfig.Controller != "" {
+		name = authConfig.Controller
+	}
+	if authConfig.Shoot != "" {
+		name += "@" + authConfig.Shoot
+	}
+	name = invalidSessionNameChars.ReplaceAllString(name, "-")
+	if len(name) > maxSessionNameLength {
+		hash := sha256.Sum256([]byte(name))
+		name = name[:maxSessionNameLength-9] + "-" + hex.EncodeToString(hash[:])[:8]
+	}
+	return name
+}
+
+// applyAuditContext adds the name of the controller, the technical ID of the shoot and the ID of the reconciliation
+// to the user agent of all requests, which is recorded by CloudTrail for every event.
+func applyAuditContext(cfg *aws.Config, authConfig AuthConfig) {
+	if authConfig.Controller != "" {
+		cfg.APIOptions = append(cfg.APIOptions, awsmiddleware.AddUserAgentKeyValue(userAgentKeyController, authConfig.Controller))
+	}
+	if authConfig.Shoot != "" {
+		cfg.APIOptions = append(cfg.APIOptions, awsmiddleware.AddUserAgentKeyValue(userAgentKeyShoot, authConfig.Shoot))
+	}
+	cfg.APIOptions = append(cfg.APIOptions, func(stack *middleware.Stack) error {
+		// The user agent header is only set by the UserAgent middleware of the build step.
+		return stack.Build.Add(reconcileIDUserAgentMiddleware(), middleware.After)
+	})
+}
+
+// reconcileIDUserAgentMiddleware returns a middleware which adds the ID of the reconciliation of the controller-runtime
+// controller, if any, to the user agent, as it is only known from the context of the request.
+func reconcileIDUserAgentMiddleware() middleware.BuildMiddleware {
+	return middleware.BuildMiddlewareFunc(reconcileIDUserAgentMiddlewareID, func(ctx context.Context, in middleware.BuildInput, next middleware.BuildHandler) (middleware.BuildOutput, middleware.Metadata, error) {
+		if reconcileID := controller.ReconcileIDFromContext(ctx); reconcileID != "" {
+			if req, ok := in.Request.(*smithyhttp.Request); ok {
+				req.Header.Set("User-Agent", req.Header.Get("User-Agent")+" "+userAgentKeyReconcile+"/"+string(reconcileID))
+			}
+		}
+		return next.HandleBuild(ctx, in)
+	})
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+const assumeRoleResponse = `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <AssumedRoleUser>
+      <Arn>arn:aws:sts::123456789012:assumed-role/gardener/session</Arn>
+      <AssumedRoleId>AROAEXAMPLE:session</AssumedRoleId>
+    </AssumedRoleUser>
+    <Credentials>
+      <AccessKeyId>ASIAEXAMPLE</AccessKeyId>
+      <SecretAccessKey>secret</SecretAccessKey>
+      <SessionToken>token</SessionToken>
+      <Expiration>2099-01-01T00:00:00Z</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</AssumeRoleResponse>`
+
+var _ = Describe("Audit", func() {
+	var (
+		ctx = context.Background()
+
+		lock         sync.Mutex
+		userAgents   []string
+		sessionNames []string
+		authConfig   AuthConfig
+	)
+
+	BeforeEach(func() {
+		userAgents, sessionNames = nil, nil
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.ParseForm()).To(Succeed())
+			lock.Lock()
+			userAgents = append(userAgents, r.UserAgent())
+			lock.Unlock()
+
+			w.Header().Set("Content-Type", "text/xml")
+			if r.Form.Get("Action") == "AssumeRole" {
+				lock.Lock()
+				sessionNames = append(sessionNames, r.Form.Get("RoleSessionName"))
+				lock.Unlock()
+				_, _ = w.Write([]byte(assumeRoleResponse))
+				return
+			}
+			_, _ = w.Write([]byte(getCallerIdentityResponse))
+		}))
+		DeferCleanup(server.Close)
+
+		authConfig = AuthConfig{
+			AccessKeyID:     "access-key-audit",
+			SecretAccessKey: "secret",
+			AssumeRole:      &AssumeRole{RoleARN: "arn:aws:iam::123456789012:role/gardener"},
+			Region:          "eu-west-1",
+			Endpoints:       map[string]string{ServiceSTS: server.URL},
+		}
+	})
+
+	It("should add the controller and the shoot to the session name and the user agent", func() {
+		authConfig.Controller = "infrastructure"
+		authConfig.Shoot = "shoot--foo--bar"
+
+		client, err := NewClient(authConfig)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(client.GetAccountID(ctx)).To(Equal("123456789012"))
+
+		Expect(sessionNames).To(ConsistOf("infrastructure@shoot--foo--bar"))
+		Expect(userAgents).To(HaveLen(2))
+		for _, userAgent := range userAgents {
+			Expect(userAgent).To(ContainSubstring("gardener-controller/infrastructure"))
+			Expect(userAgent).To(ContainSubstring("gardener-shoot/shoot--foo--bar"))
+		}
+	})
+
+	It("should use the default session name without controller and shoot", func() {
+		client, err := NewClient(authConfig)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(client.GetAccountID(ctx)).To(Equal("123456789012"))
+
+		Expect(sessionNames).To(ConsistOf("gardener-extension-provider-aws"))
+		for _, userAgent := range userAgents {
+			Expect(userAgent).NotTo(ContainSubstring("gardener-shoot/"))
+		}
+	})
+
+	It("should shorten session names exceeding the maximum length", func() {
+		authConfig.Controller = "infrastructure"
+		authConfig.Shoot = "shoot--" + strings.Repeat("a", 10) + "--" + strings.Repeat("b", 60)
+
+		client, err := NewClient(authConfig)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(client.GetAccountID(ctx)).To(Equal("123456789012"))
+
+		Expect(sessionNames).To(HaveLen(1))
+		Expect(sessionNames[0]).To(HaveLen(64))
+		Expect(sessionNames[0]).To(HavePrefix("infrastructure@shoot--aaaaaaaaaa--bbb"))
+	})
+})
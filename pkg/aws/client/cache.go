@@ -0,0 +1,176 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// NewCachingFactory creates a new Factory whose clients cache the results of read-only queries which are repeated
+// frequently, e.g. by validations, for the given TTL. The cache is shared by all clients using the same credentials in
+// the same region. Errors are not cached.
+//
+// The following queries are cached:
+// * GetVPCAttribute, GetVPCInternetGateway and GetDHCPOptions per VPC.
+// * GetAvailabilityZones, GetAvailabilityZoneIDs and GetAvailabilityZoneTypes per region.
+// * GetOfferedInstanceTypes per availability zone.
+// * GetInstanceTypeArchitectures and GetInstanceTypeInfo per instance type and GetImageArchitecture per AMI.
+// * GetSSMParameter per parameter.
+func NewCachingFactory(factory Factory, ttl time.Duration) Factory {
+	return &cachingFactory{
+		factory: factory,
+		ttl:     ttl,
+		cache:   cache.NewExpiring(),
+	}
+}
+
+type cachingFactory struct {
+	factory Factory
+	ttl     time.Duration
+	cache   *cache.Expiring
+}
+
+// NewClient creates a new instance of Interface for the given authentication configuration.
+func (f *cachingFactory) NewClient(authConfig AuthConfig) (Interface, error) {
+	c, err := f.factory.NewClient(authConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &cachingClient{
+		Interface: c,
+		factory:   f,
+		keyPrefix: credentialsKey(authConfig) + "/" + authConfig.Region,
+	}, nil
+}
+
+// cachingClient is an Interface which caches the results of some read-only queries of the wrapped Interface.
+type cachingClient struct {
+	Interface
+	factory   *cachingFactory
+	keyPrefix string
+}
+
+// getOrLoad returns the cached value for the given key parts or loads and caches it.
+func getOrLoad[T any](c *cachingClient, load func() (T, error), keyParts ...string) (T, error) {
+	key := c.keyPrefix + "/" + strings.Join(keyParts, "/")
+	if v, ok := c.factory.cache.Get(key); ok {
+		return v.(T), nil
+	}
+
+	value, err := load()
+	if err != nil {
+		return value, err
+	}
+	c.factory.cache.Set(key, value, c.factory.ttl)
+	return value, nil
+}
+
+// GetVPCAttribute returns the value of the specified VPC attribute.
+func (c *cachingClient) GetVPCAttribute(ctx context.Context, vpcID string, attribute string) (bool, error) {
+	return getOrLoad(c, func() (bool, error) {
+		return c.Interface.GetVPCAttribute(ctx, vpcID, attribute)
+	}, "vpc-attribute", vpcID, attribute)
+}
+
+// GetVPCInternetGateway returns the ID of the internet gateway attached to the given VPC <vpcID>.
+func (c *cachingClient) GetVPCInternetGateway(ctx context.Context, vpcID string) (string, error) {
+	return getOrLoad(c, func() (string, error) {
+		return c.Interface.GetVPCInternetGateway(ctx, vpcID)
+	}, "vpc-internet-gateway", vpcID)
+}
+
+// GetDHCPOptions returns DHCP options for the specified VPC ID.
+func (c *cachingClient) GetDHCPOptions(ctx context.Context, vpcID string) (map[string]string, error) {
+	options, err := getOrLoad(c, func() (map[string]string, error) {
+		return c.Interface.GetDHCPOptions(ctx, vpcID)
+	}, "vpc-dhcp-options", vpcID)
+	return maps.Clone(options), err
+}
+
+// GetAvailabilityZones returns the names of the available availability zones of the region.
+func (c *cachingClient) GetAvailabilityZones(ctx context.Context) ([]string, error) {
+	zones, err := getOrLoad(c, func() ([]string, error) {
+		return c.Interface.GetAvailabilityZones(ctx)
+	}, "availability-zones")
+	return slices.Clone(zones), err
+}
+
+// GetAvailabilityZoneIDs returns the IDs of the available availability zones of the region by their names.
+func (c *cachingClient) GetAvailabilityZoneIDs(ctx context.Context) (map[string]string, error) {
+	zoneIDs, err := getOrLoad(c, func() (map[string]string, error) {
+		return c.Interface.GetAvailabilityZoneIDs(ctx)
+	}, "availability-zone-ids")
+	return maps.Clone(zoneIDs), err
+}
+
+// GetAvailabilityZoneTypes returns the types of the available zones of the region by their names.
+func (c *cachingClient) GetAvailabilityZoneTypes(ctx context.Context) (map[string]string, error) {
+	zoneTypes, err := getOrLoad(c, func() (map[string]string, error) {
+		return c.Interface.GetAvailabilityZoneTypes(ctx)
+	}, "availability-zone-types")
+	return maps.Clone(zoneTypes), err
+}
+
+// GetOfferedInstanceTypes returns the instance types which are offered in the given availability zone.
+func (c *cachingClient) GetOfferedInstanceTypes(ctx context.Context, zone string) (sets.Set[string], error) {
+	instanceTypes, err := getOrLoad(c, func() (sets.Set[string], error) {
+		return c.Interface.GetOfferedInstanceTypes(ctx, zone)
+	}, "offered-instance-types", zone)
+	if instanceTypes == nil {
+		return nil, err
+	}
+	return instanceTypes.Clone(), err
+}
+
+// GetInstanceTypeArchitectures returns the architectures supported by the given instance type.
+func (c *cachingClient) GetInstanceTypeArchitectures(ctx context.Context, instanceType string) ([]string, error) {
+	architectures, err := getOrLoad(c, func() ([]string, error) {
+		return c.Interface.GetInstanceTypeArchitectures(ctx, instanceType)
+	}, "instance-type-architectures", instanceType)
+	return slices.Clone(architectures), err
+}
+
+// GetInstanceTypeInfo returns the vCPUs and the memory of the given instance type.
+func (c *cachingClient) GetInstanceTypeInfo(ctx context.Context, instanceType string) (*InstanceTypeInfo, error) {
+	info, err := getOrLoad(c, func() (*InstanceTypeInfo, error) {
+		return c.Interface.GetInstanceTypeInfo(ctx, instanceType)
+	}, "instance-type-info", instanceType)
+	if info == nil {
+		return nil, err
+	}
+	infoCopy := *info
+	return &infoCopy, err
+}
+
+// GetImageArchitecture returns the architecture of the given AMI.
+func (c *cachingClient) GetImageArchitecture(ctx context.Context, imageID string) (string, error) {
+	return getOrLoad(c, func() (string, error) {
+		return c.Interface.GetImageArchitecture(ctx, imageID)
+	}, "image-architecture", imageID)
+}
+
+// GetSSMParameter returns the value of the SSM parameter with the given name.
+func (c *cachingClient) GetSSMParameter(ctx context.Context, name string) (string, error) {
+	return getOrLoad(c, func() (string, error) {
+		return c.Interface.GetSSMParameter(ctx, name)
+	}, "ssm-parameter", name)
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	mockawsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/mock"
+)
+
+var _ = Describe("CachingFactory", func() {
+	var (
+		ctx = context.Background()
+
+		ctrl       *gomock.Controller
+		awsClient  *mockawsclient.MockInterface
+		factory    Factory
+		authConfig AuthConfig
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		awsClient = mockawsclient.NewMockInterface(ctrl)
+		factory = NewCachingFactory(FactoryFunc(func(AuthConfig) (Interface, error) { return awsClient, nil }), time.Minute)
+		authConfig = AuthConfig{AccessKeyID: "access-key", Region: "eu-west-1"}
+	})
+
+	It("should cache the results of read-only queries for all clients with the same credentials", func() {
+		awsClient.EXPECT().GetVPCAttribute(ctx, "vpc-1", "enableDnsSupport").Return(true, nil)
+		awsClient.EXPECT().GetAvailabilityZones(ctx).Return([]string{"eu-west-1a", "eu-west-1b"}, nil)
+		awsClient.EXPECT().GetOfferedInstanceTypes(ctx, "eu-west-1a").Return(sets.New("m5.large"), nil)
+		awsClient.EXPECT().GetInstanceTypeInfo(ctx, "m5.large").Return(&InstanceTypeInfo{InstanceType: "m5.large", VCPUs: 2, MemoryMiB: 8192}, nil)
+
+		for i := 0; i < 2; i++ {
+			c, err := factory.NewClient(authConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(c.GetVPCAttribute(ctx, "vpc-1", "enableDnsSupport")).To(BeTrue())
+			Expect(c.GetAvailabilityZones(ctx)).To(ConsistOf("eu-west-1a", "eu-west-1b"))
+			instanceTypes, err := c.GetOfferedInstanceTypes(ctx, "eu-west-1a")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(instanceTypes.UnsortedList()).To(ConsistOf("m5.large"))
+			// modifying the result must not change the cached value
+			instanceTypes.Insert("m5.xlarge")
+
+			info, err := c.GetInstanceTypeInfo(ctx, "m5.large")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info).To(Equal(&InstanceTypeInfo{InstanceType: "m5.large", VCPUs: 2, MemoryMiB: 8192}))
+			info.VCPUs = 4
+		}
+	})
+
+	It("should not share the cache between different credentials or regions", func() {
+		awsClient.EXPECT().GetAvailabilityZones(ctx).Return([]string{"eu-west-1a"}, nil)
+		awsClient.EXPECT().GetAvailabilityZones(ctx).Return([]string{"eu-west-1a"}, nil)
+		awsClient.EXPECT().GetAvailabilityZones(ctx).Return([]string{"eu-central-1a"}, nil)
+
+		for _, cfg := range []AuthConfig{
+			authConfig,
+			{AccessKeyID: "access-key", Region: "eu-west-1", AssumeRole: &AssumeRole{RoleARN: "arn:aws:iam::123456789012:role/gardener"}},
+			{AccessKeyID: "access-key", Region: "eu-central-1"},
+		} {
+			c, err := factory.NewClient(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = c.GetAvailabilityZones(ctx)
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+
+	It("should not cache errors", func() {
+		awsClient.EXPECT().GetVPCInternetGateway(ctx, "vpc-1").Return("", errors.New("error"))
+		awsClient.EXPECT().GetVPCInternetGateway(ctx, "vpc-1").Return("igw-1", nil)
+
+		c, err := factory.NewClient(authConfig)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = c.GetVPCInternetGateway(ctx, "vpc-1")
+		Expect(err).To(MatchError("error"))
+		Expect(c.GetVPCInternetGateway(ctx, "vpc-1")).To(Equal("igw-1"))
+		Expect(c.GetVPCInternetGateway(ctx, "vpc-1")).To(Equal("igw-1"))
+	})
+
+	It("should pass other calls through to the client", func() {
+		awsClient.EXPECT().GetAccountID(ctx).Return("123456789012", nil).Times(2)
+
+		c, err := factory.NewClient(authConfig)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.GetAccountID(ctx)).To(Equal("123456789012"))
+		Expect(c.GetAccountID(ctx)).To(Equal("123456789012"))
+	})
+})
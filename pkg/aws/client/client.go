@@ -15,32 +15,51 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"reflect"
 	"strings"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/aws/credentials"
-	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/ec2"
-	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
-	"github.com/aws/aws-sdk-go/service/elb"
-	"github.com/aws/aws-sdk-go/service/elb/elbiface"
-	"github.com/aws/aws-sdk-go/service/elbv2"
-	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
-	"github.com/aws/aws-sdk-go/service/iam"
-	"github.com/aws/aws-sdk-go/service/iam/iamiface"
-	"github.com/aws/aws-sdk-go/service/route53"
-	"github.com/aws/aws-sdk-go/service/route53/route53iface"
-	"github.com/aws/aws-sdk-go/service/s3"
-	"github.com/aws/aws-sdk-go/service/s3/s3iface"
-	"github.com/aws/aws-sdk-go/service/sts"
-	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+	efstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
+	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	eventbridgetypes "github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/outposts"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	taggingtypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	route53resolvertypes "github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	servicequotastypes "github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/go-logr/logr"
 	"golang.org/x/time/rate"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -57,87 +76,190 @@ import (
 // * ELB is the standard client for the ELB service.
 // * ELBv2 is the standard client for the ELBv2 service.
 // * Route53 is the standard client for the Route53 service.
+// * AutoScaling is the standard client for the AutoScaling service.
+// * CloudWatchLogs is the standard client for the CloudWatch Logs service.
+// * KMS is the standard client for the KMS service.
+// * ServiceQuotas is the standard client for the Service Quotas service.
+// * Outposts is the standard client for the Outposts service.
+// * SQS is the standard client for the SQS service.
+// * EventBridge is the standard client for the EventBridge service.
+// * EFS is the standard client for the EFS service.
+// * SSM is the standard client for the Systems Manager service.
+// * Route53Resolver is the standard client for the Route 53 Resolver service.
+// * ResourceGroupsTagging is the standard client for the Resource Groups Tagging service.
 type Client struct {
-	EC2                           ec2iface.EC2API
-	STS                           stsiface.STSAPI
-	IAM                           iamiface.IAMAPI
-	S3                            s3iface.S3API
-	ELB                           elbiface.ELBAPI
-	ELBv2                         elbv2iface.ELBV2API
-	Route53                       route53iface.Route53API
+	EC2                           *ec2.Client
+	AutoScaling                   *autoscaling.Client
+	CloudWatchLogs                *cloudwatchlogs.Client
+	KMS                           *kms.Client
+	STS                           *sts.Client
+	IAM                           *iam.Client
+	S3                            *s3.Client
+	ELB                           *elb.Client
+	ELBv2                         *elbv2.Client
+	Route53                       *route53.Client
+	ServiceQuotas                 *servicequotas.Client
+	Outposts                      *outposts.Client
+	SQS                           *sqs.Client
+	EventBridge                   *eventbridge.Client
+	EFS                           *efs.Client
+	SSM                           *ssm.Client
+	Route53Resolver               *route53resolver.Client
+	ResourceGroupsTagging         *resourcegroupstaggingapi.Client
 	Route53RateLimiter            *rate.Limiter
 	Route53RateLimiterWaitTimeout time.Duration
-	Logger                        logr.Logger
-	PollInterval                  time.Duration
+	// Route53ZoneRateLimiter returns the rate limiter for changes of the hosted zone with the given ID. If nil, changes
+	// are only limited by the Route53RateLimiter.
+	Route53ZoneRateLimiter func(zoneId string) *rate.Limiter
+	// Route53ChangeBatcher combines changes of the same hosted zone into one request. If nil, changes are not batched.
+	Route53ChangeBatcher *Route53ChangeBatcher
+	Logger               logr.Logger
+	PollInterval         time.Duration
+	// Partition is the AWS partition of the region of the client.
+	Partition string
 }
 
 var _ Interface = &Client{}
 
-// NewInterface creates a new instance of Interface for the given AWS credentials and region.
-func NewInterface(accessKeyID, secretAccessKey, region string) (Interface, error) {
-	return NewClient(accessKeyID, secretAccessKey, region)
+// assumeRoleSessionName is the name of the sessions of assumed roles of clients without controller, which shows up
+// e.g. in CloudTrail.
+const assumeRoleSessionName = "gardener-extension-provider-aws"
+
+// webIdentityToken implements stscreds.IdentityTokenRetriever for a token which has already been read.
+type webIdentityToken string
+
+// GetIdentityToken returns the token.
+func (t webIdentityToken) GetIdentityToken() ([]byte, error) {
+	return []byte(t), nil
+}
+
+// NewInterface creates a new instance of Interface for the given authentication configuration.
+func NewInterface(authConfig AuthConfig) (Interface, error) {
+	return NewClient(authConfig)
 }
 
-// NewClient creates a new Client for the given AWS credentials <accessKeyID>, <secretAccessKey>, and
-// the AWS region <region>.
+// NewClient creates a new Client for the given authentication configuration <authConfig>.
+// If a role is configured, it is assumed with the access key and all requests are made with the temporary
+// credentials of the role. All requests, including the ones for assuming the role, use the configured HTTP proxy and
+// CA bundle.
 // It initializes the clients for the various services like EC2, ELB, etc.
-func NewClient(accessKeyID, secretAccessKey, region string) (*Client, error) {
-	var (
-		awsConfig = &aws.Config{
-			Credentials: credentials.NewStaticCredentials(accessKeyID, secretAccessKey, ""),
-		}
-		config = &aws.Config{Region: aws.String(region)}
-	)
+func NewClient(authConfig AuthConfig) (*Client, error) {
+	partition := authConfig.Partition
+	if partition == "" {
+		partition = PartitionForRegion(authConfig.Region)
+	}
 
-	s, err := session.NewSession(awsConfig)
+	httpClient, err := NewHTTPClient(authConfig.HTTP)
 	if err != nil {
 		return nil, err
 	}
 
+	cfg := aws.Config{
+		Region:      authConfig.Region,
+		Credentials: credentials.NewStaticCredentialsProvider(authConfig.AccessKeyID, authConfig.SecretAccessKey, ""),
+		HTTPClient:  httpClient,
+	}
+	applyRateLimiter(&cfg, authConfig)
+	applyMetrics(&cfg, authConfig.Controller)
+	applyAuditContext(&cfg, authConfig)
+
+	switch {
+	case authConfig.WebIdentity != nil:
+		webIdentity := authConfig.WebIdentity
+		// AssumeRoleWithWebIdentity is not signed, hence no credentials are needed to call it.
+		stsClient := sts.NewFromConfig(aws.Config{Region: authConfig.Region, Credentials: aws.AnonymousCredentials{}, HTTPClient: httpClient}, stsEndpoint(authConfig.Endpoints))
+		cfg.Credentials = aws.NewCredentialsCache(stscreds.NewWebIdentityRoleProvider(stsClient, webIdentity.RoleARN, webIdentityToken(webIdentity.Token), func(o *stscreds.WebIdentityRoleOptions) {
+			o.RoleSessionName = sessionName(authConfig)
+		}))
+	case authConfig.AssumeRole != nil:
+		assumeRole := authConfig.AssumeRole
+		cfg.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(sts.NewFromConfig(cfg, stsEndpoint(authConfig.Endpoints)), assumeRole.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+			o.RoleSessionName = sessionName(authConfig)
+			if assumeRole.ExternalID != "" {
+				o.ExternalID = aws.String(assumeRole.ExternalID)
+			}
+		}))
+	}
+
+	endpoint := func(service string) *string {
+		if url, ok := authConfig.Endpoints[service]; ok && url != "" {
+			return aws.String(url)
+		}
+		return nil
+	}
+
 	return &Client{
-		EC2:                           ec2.New(s, config),
-		ELB:                           elb.New(s, config),
-		ELBv2:                         elbv2.New(s, config),
-		IAM:                           iam.New(s, config),
-		STS:                           sts.New(s, config),
-		S3:                            s3.New(s, config),
-		Route53:                       route53.New(s, config),
+		EC2:                           ec2.NewFromConfig(cfg, func(o *ec2.Options) { o.BaseEndpoint = endpoint(ServiceEC2) }),
+		AutoScaling:                   autoscaling.NewFromConfig(cfg, func(o *autoscaling.Options) { o.BaseEndpoint = endpoint(ServiceAutoScaling) }),
+		CloudWatchLogs:                cloudwatchlogs.NewFromConfig(cfg, func(o *cloudwatchlogs.Options) { o.BaseEndpoint = endpoint(ServiceCloudWatchLogs) }),
+		KMS:                           kms.NewFromConfig(cfg, func(o *kms.Options) { o.BaseEndpoint = endpoint(ServiceKMS) }),
+		ELB:                           elb.NewFromConfig(cfg, func(o *elb.Options) { o.BaseEndpoint = endpoint(ServiceELB) }),
+		ELBv2:                         elbv2.NewFromConfig(cfg, func(o *elbv2.Options) { o.BaseEndpoint = endpoint(ServiceELBv2) }),
+		IAM:                           iam.NewFromConfig(cfg, func(o *iam.Options) { o.BaseEndpoint = endpoint(ServiceIAM) }),
+		STS:                           sts.NewFromConfig(cfg, stsEndpoint(authConfig.Endpoints)),
+		S3:                            s3.NewFromConfig(cfg, func(o *s3.Options) { o.BaseEndpoint = endpoint(ServiceS3) }),
+		Route53:                       route53.NewFromConfig(cfg, func(o *route53.Options) { o.BaseEndpoint = endpoint(ServiceRoute53) }),
+		ServiceQuotas:                 servicequotas.NewFromConfig(cfg, func(o *servicequotas.Options) { o.BaseEndpoint = endpoint(ServiceServiceQuotas) }),
+		Outposts:                      outposts.NewFromConfig(cfg, func(o *outposts.Options) { o.BaseEndpoint = endpoint(ServiceOutposts) }),
+		SQS:                           sqs.NewFromConfig(cfg, func(o *sqs.Options) { o.BaseEndpoint = endpoint(ServiceSQS) }),
+		EventBridge:                   eventbridge.NewFromConfig(cfg, func(o *eventbridge.Options) { o.BaseEndpoint = endpoint(ServiceEventBridge) }),
+		EFS:                           efs.NewFromConfig(cfg, func(o *efs.Options) { o.BaseEndpoint = endpoint(ServiceEFS) }),
+		SSM:                           ssm.NewFromConfig(cfg, func(o *ssm.Options) { o.BaseEndpoint = endpoint(ServiceSSM) }),
+		Route53Resolver:               route53resolver.NewFromConfig(cfg, func(o *route53resolver.Options) { o.BaseEndpoint = endpoint(ServiceRoute53Resolver) }),
+		ResourceGroupsTagging:         resourcegroupstaggingapi.NewFromConfig(cfg, func(o *resourcegroupstaggingapi.Options) { o.BaseEndpoint = endpoint(ServiceResourceGroupsTagging) }),
 		Route53RateLimiter:            rate.NewLimiter(rate.Inf, 0),
 		Route53RateLimiterWaitTimeout: 1 * time.Second,
 		Logger:                        log.Log.WithName("aws-client"),
 		PollInterval:                  5 * time.Second,
+		Partition:                     partition,
 	}, nil
 }
 
+// stsEndpoint returns an option which sets the custom STS endpoint from the given endpoints, if any.
+func stsEndpoint(endpoints map[string]string) func(*sts.Options) {
+	return func(o *sts.Options) {
+		if url, ok := endpoints[ServiceSTS]; ok && url != "" {
+			o.BaseEndpoint = aws.String(url)
+		}
+	}
+}
+
 // GetAccountID returns the ID of the AWS account the Client is interacting with.
 func (c *Client) GetAccountID(ctx context.Context) (string, error) {
-	getCallerIdentityOutput, err := c.STS.GetCallerIdentityWithContext(ctx, &sts.GetCallerIdentityInput{})
+	getCallerIdentityOutput, err := c.STS.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
 	if err != nil {
 		return "", err
 	}
 	return *getCallerIdentityOutput.Account, nil
 }
 
+// GetCallerARN returns the ARN of the IAM user or role whose credentials are used to call the operation.
+func (c *Client) GetCallerARN(ctx context.Context) (string, error) {
+	getCallerIdentityOutput, err := c.STS.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", err
+	}
+	return aws.ToString(getCallerIdentityOutput.Arn), nil
+}
+
 // GetVPCInternetGateway returns the ID of the internet gateway attached to the given VPC <vpcID>.
 // If there is no internet gateway attached, the returned string will be empty.
 func (c *Client) GetVPCInternetGateway(ctx context.Context, vpcID string) (string, error) {
 	describeInternetGatewaysInput := &ec2.DescribeInternetGatewaysInput{
-		Filters: []*ec2.Filter{
+		Filters: []ec2types.Filter{
 			{
-				Name: aws.String("attachment.vpc-id"),
-				Values: []*string{
-					aws.String(vpcID),
-				},
+				Name:   aws.String("attachment.vpc-id"),
+				Values: []string{vpcID},
 			},
 		},
 	}
-	describeInternetGatewaysOutput, err := c.EC2.DescribeInternetGatewaysWithContext(ctx, describeInternetGatewaysInput)
+	describeInternetGatewaysOutput, err := c.EC2.DescribeInternetGateways(ctx, describeInternetGatewaysInput)
 	if err != nil {
 		return "", ignoreNotFound(err)
 	}
 
 	if len(describeInternetGatewaysOutput.InternetGateways) > 0 {
-		return aws.StringValue(describeInternetGatewaysOutput.InternetGateways[0].InternetGatewayId), nil
+		return aws.ToString(describeInternetGatewaysOutput.InternetGateways[0].InternetGatewayId), nil
 	}
 	return "", nil
 }
@@ -146,16 +268,16 @@ func (c *Client) GetVPCInternetGateway(ctx context.Context, vpcID string) (strin
 // returns a map[elasticIPAllocationID]elasticIPAssociationID or an error
 func (c *Client) GetElasticIPsAssociationIDForAllocationIDs(ctx context.Context, allocationIDs []string) (map[string]*string, error) {
 	describeAddressesInput := &ec2.DescribeAddressesInput{
-		AllocationIds: aws.StringSlice(allocationIDs),
-		Filters: []*ec2.Filter{
+		AllocationIds: allocationIDs,
+		Filters: []ec2types.Filter{
 			{
 				Name:   aws.String("domain"),
-				Values: aws.StringSlice([]string{"vpc"}),
+				Values: []string{"vpc"},
 			},
 		},
 	}
 
-	describeAddressesOutput, err := c.EC2.DescribeAddressesWithContext(ctx, describeAddressesInput)
+	describeAddressesOutput, err := c.EC2.DescribeAddresses(ctx, describeAddressesInput)
 	if err != nil {
 		return nil, ignoreNotFound(err)
 	}
@@ -179,15 +301,13 @@ func (c *Client) GetElasticIPsAssociationIDForAllocationIDs(ctx context.Context,
 // returns a slice of allocation IDs or an error
 func (c *Client) GetNATGatewayAddressAllocations(ctx context.Context, shootNamespace string) (sets.Set[string], error) {
 	describeAddressesInput := &ec2.DescribeNatGatewaysInput{
-		Filter: []*ec2.Filter{{
-			Name: aws.String(fmt.Sprintf("tag:kubernetes.io/cluster/%s", shootNamespace)),
-			Values: []*string{
-				aws.String("1"),
-			},
+		Filter: []ec2types.Filter{{
+			Name:   aws.String(fmt.Sprintf("tag:kubernetes.io/cluster/%s", shootNamespace)),
+			Values: []string{"1"},
 		}},
 	}
 
-	describeNatGatewaysOutput, err := c.EC2.DescribeNatGatewaysWithContext(ctx, describeAddressesInput)
+	describeNatGatewaysOutput, err := c.EC2.DescribeNatGateways(ctx, describeAddressesInput)
 	if err != nil {
 		return nil, ignoreNotFound(err)
 	}
@@ -205,7 +325,7 @@ func (c *Client) GetNATGatewayAddressAllocations(ctx context.Context, shootNames
 		// add all allocation IDS for the addresses for this NAT Gateway
 		// these are the allocation IDS which identify the associated EIP
 		for _, address := range natGateway.NatGatewayAddresses {
-			if address == nil {
+			if address.AllocationId == nil {
 				continue
 			}
 			result.Insert(*address.AllocationId)
@@ -217,7 +337,7 @@ func (c *Client) GetNATGatewayAddressAllocations(ctx context.Context, shootNames
 
 // GetVPCAttribute returns the value of the specified VPC attribute.
 func (c *Client) GetVPCAttribute(ctx context.Context, vpcID string, attribute string) (bool, error) {
-	vpcAttribute, err := c.EC2.DescribeVpcAttributeWithContext(ctx, &ec2.DescribeVpcAttributeInput{VpcId: &vpcID, Attribute: aws.String(attribute)})
+	vpcAttribute, err := c.EC2.DescribeVpcAttribute(ctx, &ec2.DescribeVpcAttributeInput{VpcId: &vpcID, Attribute: ec2types.VpcAttributeName(attribute)})
 	if err != nil {
 		return false, ignoreNotFound(err)
 	}
@@ -232,20 +352,345 @@ func (c *Client) GetVPCAttribute(ctx context.Context, vpcID string, attribute st
 	}
 }
 
+// GetAvailabilityZones returns the names of the available availability zones of the region.
+func (c *Client) GetAvailabilityZones(ctx context.Context) ([]string, error) {
+	output, err := c.EC2.DescribeAvailabilityZones(ctx, &ec2.DescribeAvailabilityZonesInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("state"),
+				Values: []string{string(ec2types.AvailabilityZoneStateAvailable)},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var zones []string
+	for _, zone := range output.AvailabilityZones {
+		if zone.ZoneName != nil {
+			zones = append(zones, *zone.ZoneName)
+		}
+	}
+	return zones, nil
+}
+
+// GetAvailabilityZoneIDs returns the IDs of the available availability zones of the region by their names. The
+// mapping of the names to the IDs differs between AWS accounts.
+func (c *Client) GetAvailabilityZoneIDs(ctx context.Context) (map[string]string, error) {
+	output, err := c.EC2.DescribeAvailabilityZones(ctx, &ec2.DescribeAvailabilityZonesInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("state"),
+				Values: []string{string(ec2types.AvailabilityZoneStateAvailable)},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	zoneIDs := make(map[string]string, len(output.AvailabilityZones))
+	for _, zone := range output.AvailabilityZones {
+		if zone.ZoneName != nil && zone.ZoneId != nil {
+			zoneIDs[*zone.ZoneName] = *zone.ZoneId
+		}
+	}
+	return zoneIDs, nil
+}
+
+// GetAvailabilityZoneTypes returns the types of the available zones of the region by their names, i.e.
+// "availability-zone", "local-zone" or "wavelength-zone". Local Zones and Wavelength Zones are only returned if the
+// account has opted in to their zone group.
+func (c *Client) GetAvailabilityZoneTypes(ctx context.Context) (map[string]string, error) {
+	output, err := c.EC2.DescribeAvailabilityZones(ctx, &ec2.DescribeAvailabilityZonesInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("state"),
+				Values: []string{string(ec2types.AvailabilityZoneStateAvailable)},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	zoneTypes := make(map[string]string, len(output.AvailabilityZones))
+	for _, zone := range output.AvailabilityZones {
+		if zone.ZoneName != nil && zone.ZoneType != nil {
+			zoneTypes[*zone.ZoneName] = *zone.ZoneType
+		}
+	}
+	return zoneTypes, nil
+}
+
+// GetOfferedInstanceTypes returns the instance types which are offered in the given availability zone.
+func (c *Client) GetOfferedInstanceTypes(ctx context.Context, zone string) (sets.Set[string], error) {
+	paginator := ec2.NewDescribeInstanceTypeOfferingsPaginator(c.EC2, &ec2.DescribeInstanceTypeOfferingsInput{
+		LocationType: ec2types.LocationTypeAvailabilityZone,
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("location"),
+				Values: []string{zone},
+			},
+		},
+	})
+
+	instanceTypes := sets.New[string]()
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, offering := range page.InstanceTypeOfferings {
+			instanceTypes.Insert(string(offering.InstanceType))
+		}
+	}
+	return instanceTypes, nil
+}
+
+// GetInstanceTypeVCPUs returns the default number of vCPUs of the given instance types. Unknown instance types are
+// missing in the returned map.
+func (c *Client) GetInstanceTypeVCPUs(ctx context.Context, instanceTypes []string) (map[string]int32, error) {
+	vcpus := make(map[string]int32, len(instanceTypes))
+	if len(instanceTypes) == 0 {
+		return vcpus, nil
+	}
+
+	// DescribeInstanceTypes fails for unknown instance types, hence they are filtered instead.
+	paginator := ec2.NewDescribeInstanceTypesPaginator(c.EC2, &ec2.DescribeInstanceTypesInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("instance-type"),
+				Values: instanceTypes,
+			},
+		},
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range page.InstanceTypes {
+			if info.VCpuInfo != nil {
+				vcpus[string(info.InstanceType)] = aws.ToInt32(info.VCpuInfo.DefaultVCpus)
+			}
+		}
+	}
+	return vcpus, nil
+}
+
+// GetInstanceTypeArchitectures returns the architectures (`amd64` or `arm64`) supported by the given instance type. It
+// returns nil if the instance type is unknown.
+func (c *Client) GetInstanceTypeArchitectures(ctx context.Context, instanceType string) ([]string, error) {
+	// DescribeInstanceTypes fails for unknown instance types, hence they are filtered instead.
+	output, err := c.EC2.DescribeInstanceTypes(ctx, &ec2.DescribeInstanceTypesInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("instance-type"),
+				Values: []string{instanceType},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var architectures []string
+	for _, info := range output.InstanceTypes {
+		if info.ProcessorInfo == nil {
+			continue
+		}
+		for _, architecture := range info.ProcessorInfo.SupportedArchitectures {
+			if arch := toGardenerArchitecture(string(architecture)); arch != "" {
+				architectures = append(architectures, arch)
+			}
+		}
+	}
+	return architectures, nil
+}
+
+// GetInstanceTypeInfo returns the vCPUs and the memory of the given instance type. It returns nil if the instance type
+// is unknown.
+func (c *Client) GetInstanceTypeInfo(ctx context.Context, instanceType string) (*InstanceTypeInfo, error) {
+	// DescribeInstanceTypes fails for unknown instance types, hence they are filtered instead.
+	output, err := c.EC2.DescribeInstanceTypes(ctx, &ec2.DescribeInstanceTypesInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("instance-type"),
+				Values: []string{instanceType},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(output.InstanceTypes) == 0 {
+		return nil, nil
+	}
+
+	info := &InstanceTypeInfo{InstanceType: instanceType}
+	if output.InstanceTypes[0].VCpuInfo != nil {
+		info.VCPUs = aws.ToInt32(output.InstanceTypes[0].VCpuInfo.DefaultVCpus)
+	}
+	if output.InstanceTypes[0].MemoryInfo != nil {
+		info.MemoryMiB = aws.ToInt64(output.InstanceTypes[0].MemoryInfo.SizeInMiB)
+	}
+	if output.InstanceTypes[0].NetworkInfo != nil {
+		info.MaxNetworkInterfaces = aws.ToInt32(output.InstanceTypes[0].NetworkInfo.MaximumNetworkInterfaces)
+		info.IPv4AddressesPerInterface = aws.ToInt32(output.InstanceTypes[0].NetworkInfo.Ipv4AddressesPerInterface)
+		info.MaxNetworkCards = aws.ToInt32(output.InstanceTypes[0].NetworkInfo.MaximumNetworkCards)
+		if aws.ToBool(output.InstanceTypes[0].NetworkInfo.EfaSupported) && output.InstanceTypes[0].NetworkInfo.EfaInfo != nil {
+			info.MaxEFAInterfaces = aws.ToInt32(output.InstanceTypes[0].NetworkInfo.EfaInfo.MaximumEfaInterfaces)
+		}
+	}
+	return info, nil
+}
+
+// GetImageArchitecture returns the architecture (`amd64` or `arm64`) of the given AMI. It returns an empty string if the
+// AMI does not exist or has another architecture.
+func (c *Client) GetImageArchitecture(ctx context.Context, imageID string) (string, error) {
+	output, err := c.EC2.DescribeImages(ctx, &ec2.DescribeImagesInput{ImageIds: []string{imageID}})
+	if err != nil {
+		return "", ignoreNotFound(err)
+	}
+	if len(output.Images) == 0 {
+		return "", nil
+	}
+	return toGardenerArchitecture(string(output.Images[0].Architecture)), nil
+}
+
+// toGardenerArchitecture converts the given EC2 architecture to the respective Gardener architecture. It returns an
+// empty string for architectures which are not supported by Gardener.
+func toGardenerArchitecture(architecture string) string {
+	switch architecture {
+	case string(ec2types.ArchitectureTypeX8664):
+		return v1beta1constants.ArchitectureAMD64
+	case string(ec2types.ArchitectureTypeArm64):
+		return v1beta1constants.ArchitectureARM64
+	default:
+		return ""
+	}
+}
+
+// GetSSMParameter returns the value of the SSM parameter with the given name. It returns an empty string if the
+// parameter does not exist.
+func (c *Client) GetSSMParameter(ctx context.Context, name string) (string, error) {
+	output, err := c.SSM.GetParameter(ctx, &ssm.GetParameterInput{Name: aws.String(name)})
+	if err != nil {
+		if errorCode(err) == "ParameterNotFound" {
+			return "", nil
+		}
+		return "", err
+	}
+	if output.Parameter == nil {
+		return "", nil
+	}
+	return aws.ToString(output.Parameter.Value), nil
+}
+
+// GetVpcCount returns the number of VPCs in the region.
+func (c *Client) GetVpcCount(ctx context.Context) (int, error) {
+	count := 0
+	paginator := ec2.NewDescribeVpcsPaginator(c.EC2, &ec2.DescribeVpcsInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return 0, err
+		}
+		count += len(page.Vpcs)
+	}
+	return count, nil
+}
+
+// GetServiceQuota returns the value of the quota with the given code of the given service, e.g. `L-F678F1CE` (VPCs per
+// region) of `vpc`. The applied value is returned if the quota was increased for the account, otherwise the default
+// value. Returns nil if the quota is not found.
+func (c *Client) GetServiceQuota(ctx context.Context, serviceCode, quotaCode string) (*float64, error) {
+	output, err := c.ServiceQuotas.GetServiceQuota(ctx, &servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String(serviceCode),
+		QuotaCode:   aws.String(quotaCode),
+	})
+	if err == nil {
+		return quotaValue(output.Quota), nil
+	}
+	if errorCode(err) != "NoSuchResourceException" {
+		return nil, err
+	}
+
+	// quotas which were not increased for the account may only be available as default values
+	defaultOutput, err := c.ServiceQuotas.GetAWSDefaultServiceQuota(ctx, &servicequotas.GetAWSDefaultServiceQuotaInput{
+		ServiceCode: aws.String(serviceCode),
+		QuotaCode:   aws.String(quotaCode),
+	})
+	if err != nil {
+		if errorCode(err) == "NoSuchResourceException" {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return quotaValue(defaultOutput.Quota), nil
+}
+
+func quotaValue(quota *servicequotastypes.ServiceQuota) *float64 {
+	if quota == nil {
+		return nil
+	}
+	return quota.Value
+}
+
+// GetOutpost returns the Outpost with the given ARN or ID. Returns nil if the Outpost is not found.
+func (c *Client) GetOutpost(ctx context.Context, outpostARN string) (*Outpost, error) {
+	output, err := c.Outposts.GetOutpost(ctx, &outposts.GetOutpostInput{OutpostId: aws.String(outpostARN)})
+	if err != nil {
+		if errorCode(err) == "NotFoundException" {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if output.Outpost == nil {
+		return nil, nil
+	}
+	return &Outpost{
+		OutpostArn:       aws.ToString(output.Outpost.OutpostArn),
+		OutpostId:        aws.ToString(output.Outpost.OutpostId),
+		AvailabilityZone: aws.ToString(output.Outpost.AvailabilityZone),
+		LifeCycleStatus:  aws.ToString(output.Outpost.LifeCycleStatus),
+	}, nil
+}
+
+// GetOutpostInstanceTypes returns the instance types which are supported by the Outpost with the given ARN or ID.
+func (c *Client) GetOutpostInstanceTypes(ctx context.Context, outpostARN string) (sets.Set[string], error) {
+	paginator := outposts.NewGetOutpostInstanceTypesPaginator(c.Outposts, &outposts.GetOutpostInstanceTypesInput{
+		OutpostId: aws.String(outpostARN),
+	})
+	instanceTypes := sets.New[string]()
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range page.InstanceTypes {
+			if item.InstanceType != nil {
+				instanceTypes.Insert(*item.InstanceType)
+			}
+		}
+	}
+	return instanceTypes, nil
+}
+
 // GetDHCPOptions returns DHCP options for the specified VPC ID.
 func (c *Client) GetDHCPOptions(ctx context.Context, vpcID string) (map[string]string, error) {
 	describeVpcsInput := &ec2.DescribeVpcsInput{
-		Filters: []*ec2.Filter{
+		Filters: []ec2types.Filter{
 			{
-				Name: aws.String("vpc-id"),
-				Values: []*string{
-					aws.String(vpcID),
-				},
+				Name:   aws.String("vpc-id"),
+				Values: []string{vpcID},
 			},
 		},
 	}
 
-	describeVpcsOutput, err := c.EC2.DescribeVpcsWithContext(ctx, describeVpcsInput)
+	describeVpcsOutput, err := c.EC2.DescribeVpcs(ctx, describeVpcsInput)
 	if err != nil {
 		return nil, err
 	}
@@ -257,16 +702,14 @@ func (c *Client) GetDHCPOptions(ctx context.Context, vpcID string) (map[string]s
 	}
 
 	describeDhcpOptionsInput := &ec2.DescribeDhcpOptionsInput{
-		Filters: []*ec2.Filter{
+		Filters: []ec2types.Filter{
 			{
-				Name: aws.String("dhcp-options-id"),
-				Values: []*string{
-					aws.String(*describeVpcsOutput.Vpcs[0].DhcpOptionsId),
-				},
+				Name:   aws.String("dhcp-options-id"),
+				Values: []string{*describeVpcsOutput.Vpcs[0].DhcpOptionsId},
 			},
 		},
 	}
-	describeDhcpOptionsOutput, err := c.EC2.DescribeDhcpOptionsWithContext(ctx, describeDhcpOptionsInput)
+	describeDhcpOptionsOutput, err := c.EC2.DescribeDhcpOptions(ctx, describeDhcpOptionsInput)
 	if err != nil {
 		return nil, err
 	}
@@ -283,91 +726,194 @@ func (c *Client) GetDHCPOptions(ctx context.Context, vpcID string) (map[string]s
 }
 
 // DeleteObjectsWithPrefix deletes the s3 objects with the specific <prefix> from <bucket>. If it does not exist,
-// no error is returned.
+// no error is returned. The objects are listed page by page and each page is deleted with a single DeleteObjects
+// request, with up to DefaultS3DeleteConcurrency requests in flight.
+// If versioning is enabled for the bucket, all versions of the objects are deleted as well, unless the bucket uses
+// Object Lock. In that case, only delete markers are created and the noncurrent versions are left to the lifecycle
+// rules of the bucket once their retention period has expired.
 func (c *Client) DeleteObjectsWithPrefix(ctx context.Context, bucket, prefix string) error {
-	in := &s3.ListObjectsInput{
+	versioning, err := c.S3.GetBucketVersioning(ctx, &s3.GetBucketVersioningInput{
 		Bucket: aws.String(bucket),
-		Prefix: aws.String(prefix),
+	})
+	if err != nil {
+		var noSuchBucket *s3types.NoSuchBucket
+		if errors.As(err, &noSuchBucket) || errorCode(err) == errCodeNoSuchBucket {
+			return nil
+		}
+		return err
+	}
+	if versioning.Status != "" {
+		objectLock, err := c.GetBucketObjectLockConfiguration(ctx, bucket)
+		if err != nil {
+			return err
+		}
+		if objectLock == nil {
+			return c.deleteObjectVersions(ctx, bucket, prefix)
+		}
 	}
 
-	var delErr error
-	if err := c.S3.ListObjectsPagesWithContext(ctx, in, func(page *s3.ListObjectsOutput, lastPage bool) bool {
-		objectIDs := make([]*s3.ObjectIdentifier, 0)
-		for _, key := range page.Contents {
-			obj := &s3.ObjectIdentifier{
-				Key: key.Key,
-			}
-			objectIDs = append(objectIDs, obj)
+	deleter := NewS3ObjectDeleter(ctx, DefaultS3DeleteConcurrency, c.deleteObjectsFunc(bucket))
+	paginator := s3.NewListObjectsV2Paginator(c.S3, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return errors.Join(err, deleter.Wait())
 		}
 
-		if len(objectIDs) != 0 {
-			if _, delErr = c.S3.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
-				Bucket: aws.String(bucket),
-				Delete: &s3.Delete{
-					Objects: objectIDs,
-					Quiet:   aws.Bool(true),
-				},
-			}); delErr != nil {
-				return false
-			}
+		objectIDs := make([]s3types.ObjectIdentifier, 0, len(page.Contents))
+		for _, object := range page.Contents {
+			objectIDs = append(objectIDs, s3types.ObjectIdentifier{Key: object.Key})
+		}
+		if !deleter.Delete(objectIDs) {
+			break
 		}
-		return !lastPage
-	}); err != nil {
-		return err
 	}
+	return deleter.Wait()
+}
+
+// PutObject writes the object with key <key> and the given content to the s3 bucket with name <bucket>.
+func (c *Client) PutObject(ctx context.Context, bucket, key, contentType string, body []byte) error {
+	_, err := c.S3.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(bucket),
+		Key:         aws.String(key),
+		ContentType: aws.String(contentType),
+		Body:        bytes.NewReader(body),
+	})
+	return err
+}
 
-	if delErr != nil {
-		if aerr, ok := delErr.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
+// DeleteObject deletes the object with key <key> of the s3 bucket with name <bucket>. No error is returned if the
+// bucket or the object doesn't exist.
+func (c *Client) DeleteObject(ctx context.Context, bucket, key string) error {
+	_, err := c.S3.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var noSuchBucket *s3types.NoSuchBucket
+		if errors.As(err, &noSuchBucket) {
 			return nil
 		}
-		return delErr
 	}
-	return nil
+	return err
+}
+
+// deleteObjectsFunc returns a function which deletes a batch of objects of the s3 bucket with name <bucket>. Objects
+// which cannot be deleted are reported as error.
+func (c *Client) deleteObjectsFunc(bucket string) S3ObjectBatchDeleteFunc {
+	return func(ctx context.Context, objectIDs []s3types.ObjectIdentifier) error {
+		out, err := c.S3.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3types.Delete{
+				Objects: objectIDs,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			var noSuchKey *s3types.NoSuchKey
+			if errors.As(err, &noSuchKey) {
+				return nil
+			}
+			return err
+		}
+		if len(out.Errors) > 0 {
+			return fmt.Errorf("could not delete %d objects of bucket %s, e.g. %s: %s", len(out.Errors), bucket, aws.ToString(out.Errors[0].Key), aws.ToString(out.Errors[0].Message))
+		}
+		return nil
+	}
 }
 
 // CreateBucketIfNotExists creates the s3 bucket with name <bucket> in <region>. If it already exists,
-// no error is returned.
-func (c *Client) CreateBucketIfNotExists(ctx context.Context, bucket, region string) error {
+// no error is returned. The optional <config> is applied to new and existing buckets:
+//   - If versioning is configured, noncurrent object versions expire after one day.
+//   - If Object Lock is configured, it is enabled for the bucket with the given default retention, and noncurrent object
+//     versions expire after the retention period.
+//   - If encryption is configured, objects are encrypted with the given KMS key by default and requests for other server
+//     side encryption are denied, otherwise objects are encrypted with keys managed by S3.
+//   - If a lifecycle is configured, objects are transitioned to other storage classes and expire accordingly.
+//   - Requests without HTTPS or with a TLS version lower than the configured minimum (default: 1.2) are denied.
+//   - If bucket owner enforcement is configured, the ACLs of the bucket are disabled.
+func (c *Client) CreateBucketIfNotExists(ctx context.Context, bucket, region string, config *BucketConfiguration) error {
+	if config == nil {
+		config = &BucketConfiguration{}
+	}
+	objectLock, encryption, lifecycle := config.ObjectLock, config.Encryption, config.Lifecycle
+
 	createBucketInput := &s3.CreateBucketInput{
 		Bucket: aws.String(bucket),
-		ACL:    aws.String(s3.BucketCannedACLPrivate),
-		CreateBucketConfiguration: &s3.CreateBucketConfiguration{
-			LocationConstraint: aws.String(region),
+		ACL:    s3types.BucketCannedACLPrivate,
+		CreateBucketConfiguration: &s3types.CreateBucketConfiguration{
+			LocationConstraint: s3types.BucketLocationConstraint(region),
 		},
 	}
 
 	if region == "us-east-1" {
 		createBucketInput.CreateBucketConfiguration = nil
 	}
+	if objectLock != nil {
+		createBucketInput.ObjectLockEnabledForBucket = aws.Bool(true)
+	}
+
+	if _, err := c.S3.CreateBucket(ctx, createBucketInput); err != nil {
+		var (
+			alreadyExists     *s3types.BucketAlreadyExists
+			alreadyOwnedByYou *s3types.BucketAlreadyOwnedByYou
+		)
+		if !errors.As(err, &alreadyExists) && !errors.As(err, &alreadyOwnedByYou) {
+			return err
+		}
+	}
 
-	if _, err := c.S3.CreateBucketWithContext(ctx, createBucketInput); err != nil {
-		if aerr, ok := err.(awserr.Error); !ok {
+	// Versioning is required for Object Lock on existing buckets
+	versioning := config.Versioning || objectLock != nil
+	if versioning {
+		if _, err := c.S3.PutBucketVersioning(ctx, &s3.PutBucketVersioningInput{
+			Bucket: aws.String(bucket),
+			VersioningConfiguration: &s3types.VersioningConfiguration{
+				Status: s3types.BucketVersioningStatusEnabled,
+			},
+		}); err != nil {
 			return err
-		} else if aerr.Code() != s3.ErrCodeBucketAlreadyExists && aerr.Code() != s3.ErrCodeBucketAlreadyOwnedByYou {
+		}
+	}
+	if objectLock != nil {
+		if err := c.putBucketObjectLockConfiguration(ctx, bucket, objectLock); err != nil {
 			return err
 		}
 	}
 
 	// Enable default server side encryption using AES256 algorithm. Key will be managed by S3
-	if _, err := c.S3.PutBucketEncryptionWithContext(ctx, &s3.PutBucketEncryptionInput{
-		Bucket: aws.String(bucket),
-		ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{
-			Rules: []*s3.ServerSideEncryptionRule{
-				{
-					ApplyServerSideEncryptionByDefault: &s3.ServerSideEncryptionByDefault{
-						SSEAlgorithm: aws.String("AES256"),
-					},
-				},
+	encryptionRule := s3types.ServerSideEncryptionRule{
+		ApplyServerSideEncryptionByDefault: &s3types.ServerSideEncryptionByDefault{
+			SSEAlgorithm: s3types.ServerSideEncryptionAes256,
+		},
+	}
+	if encryption != nil {
+		// Use the customer managed KMS key instead
+		encryptionRule = s3types.ServerSideEncryptionRule{
+			ApplyServerSideEncryptionByDefault: &s3types.ServerSideEncryptionByDefault{
+				SSEAlgorithm:   s3types.ServerSideEncryptionAwsKms,
+				KMSMasterKeyID: aws.String(encryption.KMSKeyARN),
 			},
+			BucketKeyEnabled: aws.Bool(encryption.BucketKeyEnabled),
+		}
+	}
+	if _, err := c.S3.PutBucketEncryption(ctx, &s3.PutBucketEncryptionInput{
+		Bucket: aws.String(bucket),
+		ServerSideEncryptionConfiguration: &s3types.ServerSideEncryptionConfiguration{
+			Rules: []s3types.ServerSideEncryptionRule{encryptionRule},
 		},
 	}); err != nil {
 		return err
 	}
 
 	// Block public access to the bucket
-	if _, err := c.S3.PutPublicAccessBlockWithContext(ctx, &s3.PutPublicAccessBlockInput{
+	if _, err := c.S3.PutPublicAccessBlock(ctx, &s3.PutPublicAccessBlockInput{
 		Bucket: aws.String(bucket),
-		PublicAccessBlockConfiguration: &s3.PublicAccessBlockConfiguration{
+		PublicAccessBlockConfiguration: &s3types.PublicAccessBlockConfiguration{
 			BlockPublicAcls:       aws.Bool(true),
 			BlockPublicPolicy:     aws.Bool(true),
 			IgnorePublicAcls:      aws.Bool(true),
@@ -377,38 +923,29 @@ func (c *Client) CreateBucketIfNotExists(ctx context.Context, bucket, region str
 		return err
 	}
 
-	// Handle bucket policy IAM ARN for different partitions (AWS region groups)
-	// Different available partitions in AWS are defined at
-	// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference-arns.html
-	arnPartition := "aws"
-	if strings.HasPrefix(region, "cn-") {
-		arnPartition = "aws-cn" // China regions
-	} else if strings.HasPrefix(region, "us-gov-") {
-		arnPartition = "aws-us-gov" // AWS GovCloud (US) regions
+	if config.BucketOwnerEnforced {
+		if _, err := c.S3.PutBucketOwnershipControls(ctx, &s3.PutBucketOwnershipControlsInput{
+			Bucket: aws.String(bucket),
+			OwnershipControls: &s3types.OwnershipControls{
+				Rules: []s3types.OwnershipControlsRule{{ObjectOwnership: s3types.ObjectOwnershipBucketOwnerEnforced}},
+			},
+		}); err != nil {
+			return err
+		}
 	}
 
-	// Set bucket policy to deny non-HTTPS requests
+	// Set bucket policy to deny non-HTTPS requests and outdated TLS versions
+	minimumTLSVersion := DefaultMinimumTLSVersion
+	if config.MinimumTLSVersion != "" {
+		minimumTLSVersion = config.MinimumTLSVersion
+	}
+	statements := denyInsecureTransportStatements(c.Partition, bucket, minimumTLSVersion)
+	if encryption != nil {
+		statements = append(statements, denyOtherEncryptionStatements(c.Partition, bucket, encryption.KMSKeyARN)...)
+	}
 	bucketPolicy := map[string]interface{}{
-		"Version": "2012-10-17",
-		"Statement": []map[string]interface{}{
-			{
-				"Effect":    "Deny",
-				"Principal": "*",
-				"Action":    "s3:*",
-				"Resource": []string{
-					fmt.Sprintf("arn:%s:s3:::%s", arnPartition, bucket),
-					fmt.Sprintf("arn:%s:s3:::%s/*", arnPartition, bucket),
-				},
-				"Condition": map[string]interface{}{
-					"Bool": map[string]string{
-						"aws:SecureTransport": "false",
-					},
-					"NumericLessThan": map[string]string{
-						"s3:TlsVersion": "1.2",
-					},
-				},
-			},
-		},
+		"Version":   "2012-10-17",
+		"Statement": statements,
 	}
 
 	bucketPolicyJSON, err := json.Marshal(bucketPolicy)
@@ -416,7 +953,7 @@ func (c *Client) CreateBucketIfNotExists(ctx context.Context, bucket, region str
 		return err
 	}
 
-	if _, err := c.S3.PutBucketPolicyWithContext(ctx, &s3.PutBucketPolicyInput{
+	if _, err := c.S3.PutBucketPolicy(ctx, &s3.PutBucketPolicyInput{
 		Bucket: aws.String(bucket),
 		Policy: aws.String(string(bucketPolicyJSON)),
 	}); err != nil {
@@ -424,68 +961,344 @@ func (c *Client) CreateBucketIfNotExists(ctx context.Context, bucket, region str
 	}
 
 	// Set lifecycle rule to purge incomplete multipart upload orphaned because of force shutdown or rescheduling or networking issue with etcd-backup-restore.
+	abortIncompleteMultipartUploadDays := int32(7)
+	if lifecycle != nil && lifecycle.AbortIncompleteMultipartUploadDays > 0 {
+		abortIncompleteMultipartUploadDays = lifecycle.AbortIncompleteMultipartUploadDays
+	}
 	putBucketLifecycleConfigurationInput := &s3.PutBucketLifecycleConfigurationInput{
 		Bucket: aws.String(bucket),
-		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
-			Rules: []*s3.LifecycleRule{
+		LifecycleConfiguration: &s3types.BucketLifecycleConfiguration{
+			Rules: []s3types.LifecycleRule{
 				{
 					// Note: Though as per documentation at https://docs.aws.amazon.com/AmazonS3/latest/API/API_LifecycleRule.html the Filter field is
 					// optional, if not specified the SDK API fails with `Malformed XML` error code. Cross verified same behavior with aws-cli client as well.
 					// Please do not remove it.
-					Filter: &s3.LifecycleRuleFilter{
-						Prefix: aws.String(""),
+					Filter: &s3types.LifecycleRuleFilterMemberPrefix{
+						Value: "",
 					},
-					AbortIncompleteMultipartUpload: &s3.AbortIncompleteMultipartUpload{
-						DaysAfterInitiation: aws.Int64(7),
+					AbortIncompleteMultipartUpload: &s3types.AbortIncompleteMultipartUpload{
+						DaysAfterInitiation: aws.Int32(abortIncompleteMultipartUploadDays),
 					},
-					Status: aws.String(s3.ExpirationStatusEnabled),
+					Status: s3types.ExpirationStatusEnabled,
+				},
+			},
+		},
+	}
+
+	if versioning {
+		// Overwritten or deleted objects are kept as noncurrent versions. With Object Lock, they can be deleted once
+		// their retention period has expired.
+		noncurrentDays := int32(1)
+		if objectLock != nil {
+			noncurrentDays = objectLock.Days
+		}
+		putBucketLifecycleConfigurationInput.LifecycleConfiguration.Rules = append(putBucketLifecycleConfigurationInput.LifecycleConfiguration.Rules, s3types.LifecycleRule{
+			ID: aws.String("expire-noncurrent-versions"),
+			Filter: &s3types.LifecycleRuleFilterMemberPrefix{
+				Value: "",
+			},
+			NoncurrentVersionExpiration: &s3types.NoncurrentVersionExpiration{
+				NoncurrentDays: aws.Int32(noncurrentDays),
+			},
+			Expiration: &s3types.LifecycleExpiration{
+				ExpiredObjectDeleteMarker: aws.Bool(true),
+			},
+			Status: s3types.ExpirationStatusEnabled,
+		})
+	}
+
+	if lifecycle != nil && (len(lifecycle.Transitions) > 0 || lifecycle.ExpirationDays != nil) {
+		rule := s3types.LifecycleRule{
+			ID: aws.String("transition-and-expire-objects"),
+			Filter: &s3types.LifecycleRuleFilterMemberPrefix{
+				Value: "",
+			},
+			Status: s3types.ExpirationStatusEnabled,
+		}
+		for _, transition := range lifecycle.Transitions {
+			rule.Transitions = append(rule.Transitions, s3types.Transition{
+				Days:         aws.Int32(transition.Days),
+				StorageClass: transition.StorageClass,
+			})
+		}
+		if lifecycle.ExpirationDays != nil {
+			rule.Expiration = &s3types.LifecycleExpiration{
+				Days: lifecycle.ExpirationDays,
+			}
+		}
+		putBucketLifecycleConfigurationInput.LifecycleConfiguration.Rules = append(putBucketLifecycleConfigurationInput.LifecycleConfiguration.Rules, rule)
+	}
+
+	_, err = c.S3.PutBucketLifecycleConfiguration(ctx, putBucketLifecycleConfigurationInput)
+	return err
+}
+
+// denyOtherEncryptionStatements returns the statements of a bucket policy which deny uploads requesting another server
+// side encryption than the one with the given KMS key. Uploads without encryption headers are encrypted with the
+// default encryption of the bucket, hence they are allowed.
+func denyOtherEncryptionStatements(partition, bucket, kmsKeyARN string) []map[string]interface{} {
+	resource := ARN(partition, "s3", "", "", bucket+"/*")
+	return []map[string]interface{}{
+		{
+			"Sid":       "DenyOtherEncryption",
+			"Effect":    "Deny",
+			"Principal": "*",
+			"Action":    "s3:PutObject",
+			"Resource":  resource,
+			"Condition": map[string]interface{}{
+				"StringNotEquals": map[string]string{
+					"s3:x-amz-server-side-encryption": string(s3types.ServerSideEncryptionAwsKms),
+				},
+				"Null": map[string]string{
+					"s3:x-amz-server-side-encryption": "false",
+				},
+			},
+		},
+		{
+			"Sid":       "DenyOtherKMSKey",
+			"Effect":    "Deny",
+			"Principal": "*",
+			"Action":    "s3:PutObject",
+			"Resource":  resource,
+			"Condition": map[string]interface{}{
+				"StringNotEquals": map[string]string{
+					"s3:x-amz-server-side-encryption-aws-kms-key-id": kmsKeyARN,
+				},
+				"Null": map[string]string{
+					"s3:x-amz-server-side-encryption-aws-kms-key-id": "false",
+				},
+			},
+		},
+	}
+}
+
+// putBucketObjectLockConfiguration enables Object Lock for the bucket with name <bucket> with the given default
+// retention. Versioning must be enabled before.
+func (c *Client) putBucketObjectLockConfiguration(ctx context.Context, bucket string, objectLock *ObjectLockConfiguration) error {
+	_, err := c.S3.PutObjectLockConfiguration(ctx, &s3.PutObjectLockConfigurationInput{
+		Bucket: aws.String(bucket),
+		ObjectLockConfiguration: &s3types.ObjectLockConfiguration{
+			ObjectLockEnabled: s3types.ObjectLockEnabledEnabled,
+			Rule: &s3types.ObjectLockRule{
+				DefaultRetention: &s3types.DefaultRetention{
+					Mode: objectLock.Mode,
+					Days: aws.Int32(objectLock.Days),
 				},
 			},
 		},
+	})
+	return err
+}
+
+// GetBucketReplication returns the replication configuration of the s3 bucket with name <bucket>. If the bucket does
+// not exist or replication is not configured for it, nil is returned.
+func (c *Client) GetBucketReplication(ctx context.Context, bucket string) (*BucketReplication, error) {
+	out, err := c.S3.GetBucketReplication(ctx, &s3.GetBucketReplicationInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		var noSuchBucket *s3types.NoSuchBucket
+		if errors.As(err, &noSuchBucket) || errorCode(err) == errCodeNoSuchBucket || errorCode(err) == errCodeReplicationConfigurationNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	config := out.ReplicationConfiguration
+	if config == nil || len(config.Rules) == 0 {
+		return nil, nil
+	}
+	rule := config.Rules[0]
+	replication := &BucketReplication{
+		RoleARN:          aws.ToString(config.Role),
+		ReplicateDeletes: rule.DeleteMarkerReplication != nil && rule.DeleteMarkerReplication.Status == s3types.DeleteMarkerReplicationStatusEnabled,
+	}
+	if rule.Destination != nil {
+		replication.DestinationBucketARN = aws.ToString(rule.Destination.Bucket)
+		if rule.Destination.EncryptionConfiguration != nil {
+			replication.ReplicaKMSKeyARN = rule.Destination.EncryptionConfiguration.ReplicaKmsKeyID
+		}
+	}
+	return replication, nil
+}
+
+// PutBucketReplication replicates all objects of the s3 bucket with name <bucket> with the given configuration.
+// Versioning must be enabled for the source and the destination bucket.
+func (c *Client) PutBucketReplication(ctx context.Context, bucket string, replication *BucketReplication) error {
+	deleteMarkerReplicationStatus := s3types.DeleteMarkerReplicationStatusDisabled
+	if replication.ReplicateDeletes {
+		deleteMarkerReplicationStatus = s3types.DeleteMarkerReplicationStatusEnabled
+	}
+	rule := s3types.ReplicationRule{
+		ID:       aws.String("replicate-all-objects"),
+		Priority: aws.Int32(1),
+		Filter: &s3types.ReplicationRuleFilterMemberPrefix{
+			Value: "",
+		},
+		Status: s3types.ReplicationRuleStatusEnabled,
+		DeleteMarkerReplication: &s3types.DeleteMarkerReplication{
+			Status: deleteMarkerReplicationStatus,
+		},
+		Destination: &s3types.Destination{
+			Bucket: aws.String(replication.DestinationBucketARN),
+		},
+	}
+	if replication.ReplicaKMSKeyARN != nil {
+		rule.Destination.EncryptionConfiguration = &s3types.EncryptionConfiguration{
+			ReplicaKmsKeyID: replication.ReplicaKMSKeyARN,
+		}
+		rule.SourceSelectionCriteria = &s3types.SourceSelectionCriteria{
+			SseKmsEncryptedObjects: &s3types.SseKmsEncryptedObjects{
+				Status: s3types.SseKmsEncryptedObjectsStatusEnabled,
+			},
+		}
+	}
+
+	_, err := c.S3.PutBucketReplication(ctx, &s3.PutBucketReplicationInput{
+		Bucket: aws.String(bucket),
+		ReplicationConfiguration: &s3types.ReplicationConfiguration{
+			Role:  aws.String(replication.RoleARN),
+			Rules: []s3types.ReplicationRule{rule},
+		},
+	})
+	return err
+}
+
+// DeleteBucketReplication deletes the replication configuration of the s3 bucket with name <bucket>. If the bucket
+// does not exist, no error is returned.
+func (c *Client) DeleteBucketReplication(ctx context.Context, bucket string) error {
+	_, err := c.S3.DeleteBucketReplication(ctx, &s3.DeleteBucketReplicationInput{
+		Bucket: aws.String(bucket),
+	})
+	var noSuchBucket *s3types.NoSuchBucket
+	if errors.As(err, &noSuchBucket) || errorCode(err) == errCodeNoSuchBucket {
+		return nil
+	}
+	return err
+}
+
+// GetBucketObjectLockConfiguration returns the default retention of the s3 bucket with name <bucket>. If the bucket
+// does not exist or Object Lock is not enabled for it, nil is returned.
+func (c *Client) GetBucketObjectLockConfiguration(ctx context.Context, bucket string) (*ObjectLockConfiguration, error) {
+	out, err := c.S3.GetObjectLockConfiguration(ctx, &s3.GetObjectLockConfigurationInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		var noSuchBucket *s3types.NoSuchBucket
+		if errors.As(err, &noSuchBucket) || errorCode(err) == errCodeNoSuchBucket || errorCode(err) == errCodeObjectLockConfigurationNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	config := out.ObjectLockConfiguration
+	if config == nil || config.ObjectLockEnabled != s3types.ObjectLockEnabledEnabled {
+		return nil, nil
 	}
+	objectLock := &ObjectLockConfiguration{}
+	if config.Rule != nil && config.Rule.DefaultRetention != nil {
+		retention := config.Rule.DefaultRetention
+		objectLock.Mode = retention.Mode
+		objectLock.Days = aws.ToInt32(retention.Days) + 365*aws.ToInt32(retention.Years)
+	}
+	return objectLock, nil
+}
+
+// PutBucketPolicy replaces the policy of the given bucket.
+func (c *Client) PutBucketPolicy(ctx context.Context, bucket, policy string) error {
+	_, err := c.S3.PutBucketPolicy(ctx, &s3.PutBucketPolicyInput{
+		Bucket: aws.String(bucket),
+		Policy: aws.String(policy),
+	})
+	return err
+}
 
-	_, err = c.S3.PutBucketLifecycleConfigurationWithContext(ctx, putBucketLifecycleConfigurationInput)
+// PutBucketAccessLogging configures the server access logging of the s3 bucket with name <bucket>. If <accessLogging>
+// is nil, access logging is disabled.
+func (c *Client) PutBucketAccessLogging(ctx context.Context, bucket string, accessLogging *BucketAccessLogging) error {
+	status := &s3types.BucketLoggingStatus{}
+	if accessLogging != nil {
+		status.LoggingEnabled = &s3types.LoggingEnabled{
+			TargetBucket: aws.String(accessLogging.TargetBucket),
+			TargetPrefix: aws.String(accessLogging.TargetPrefix),
+		}
+	}
+	_, err := c.S3.PutBucketLogging(ctx, &s3.PutBucketLoggingInput{
+		Bucket:              aws.String(bucket),
+		BucketLoggingStatus: status,
+	})
 	return err
 }
 
 // DeleteBucketIfExists deletes the s3 bucket with name <bucket>. If it does not exist,
 // no error is returned.
 func (c *Client) DeleteBucketIfExists(ctx context.Context, bucket string) error {
-	if _, err := c.S3.DeleteBucketWithContext(ctx, &s3.DeleteBucketInput{Bucket: aws.String(bucket)}); err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			if aerr.Code() == s3.ErrCodeNoSuchBucket {
-				return nil
-			}
-			if aerr.Code() == errCodeBucketNotEmpty {
-				if err := c.DeleteObjectsWithPrefix(ctx, bucket, ""); err != nil {
-					return err
-				}
-				return c.DeleteBucketIfExists(ctx, bucket)
+	if _, err := c.S3.DeleteBucket(ctx, &s3.DeleteBucketInput{Bucket: aws.String(bucket)}); err != nil {
+		var noSuchBucket *s3types.NoSuchBucket
+		if errors.As(err, &noSuchBucket) {
+			return nil
+		}
+		if errorCode(err) == errCodeBucketNotEmpty {
+			if err := c.deleteObjectVersions(ctx, bucket, ""); err != nil {
+				return err
 			}
+			return c.DeleteBucketIfExists(ctx, bucket)
 		}
 		return err
 	}
 	return nil
 }
 
+// deleteObjectVersions deletes all object versions and delete markers with the specific <prefix> of the s3 bucket with
+// name <bucket>, which are kept if versioning is enabled for the bucket. For unversioned buckets, this deletes the
+// objects themselves. Versions which cannot be deleted, e.g. because they are protected by Object Lock, are reported
+// as error.
+func (c *Client) deleteObjectVersions(ctx context.Context, bucket, prefix string) error {
+	deleter := NewS3ObjectDeleter(ctx, DefaultS3DeleteConcurrency, c.deleteObjectsFunc(bucket))
+	paginator := s3.NewListObjectVersionsPaginator(c.S3, &s3.ListObjectVersionsInput{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return errors.Join(err, deleter.Wait())
+		}
+
+		objectIDs := make([]s3types.ObjectIdentifier, 0, len(page.Versions)+len(page.DeleteMarkers))
+		for _, version := range page.Versions {
+			objectIDs = append(objectIDs, s3types.ObjectIdentifier{Key: version.Key, VersionId: version.VersionId})
+		}
+		for _, marker := range page.DeleteMarkers {
+			objectIDs = append(objectIDs, s3types.ObjectIdentifier{Key: marker.Key, VersionId: marker.VersionId})
+		}
+		if !deleter.Delete(objectIDs) {
+			break
+		}
+	}
+	return deleter.Wait()
+}
+
 // The following functions are only temporary needed due to https://github.com/gardener/gardener/issues/129.
 
 // ListKubernetesELBs returns the list of ELB loadbalancers in the given <vpcID> tagged with <clusterName>.
 func (c *Client) ListKubernetesELBs(ctx context.Context, vpcID, clusterName string) ([]string, error) {
 	var (
-		loadBalancerNamesInVPC      []*string
+		loadBalancerNamesInVPC      []string
 		loadBalancerNamesForCluster []string
 	)
 
-	if err := c.ELB.DescribeLoadBalancersPagesWithContext(ctx, &elb.DescribeLoadBalancersInput{}, func(page *elb.DescribeLoadBalancersOutput, lastPage bool) bool {
+	paginator := elb.NewDescribeLoadBalancersPaginator(c.ELB, &elb.DescribeLoadBalancersInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
 		for _, lb := range page.LoadBalancerDescriptions {
-			if lb.VPCId != nil && *lb.VPCId == vpcID {
-				loadBalancerNamesInVPC = append(loadBalancerNamesInVPC, lb.LoadBalancerName)
+			if lb.VPCId != nil && *lb.VPCId == vpcID && lb.LoadBalancerName != nil {
+				loadBalancerNamesInVPC = append(loadBalancerNamesInVPC, *lb.LoadBalancerName)
 			}
 		}
-		return !lastPage
-	}); err != nil {
-		return nil, err
 	}
 
 	if len(loadBalancerNamesInVPC) == 0 {
@@ -495,7 +1308,7 @@ func (c *Client) ListKubernetesELBs(ctx context.Context, vpcID, clusterName stri
 	const chunkSize = 20
 	loadBalancerNamesChunks := chunkSlice(loadBalancerNamesInVPC, chunkSize)
 	for _, loadBalancerNamesChunk := range loadBalancerNamesChunks {
-		tags, err := c.ELB.DescribeTagsWithContext(ctx, &elb.DescribeTagsInput{LoadBalancerNames: loadBalancerNamesChunk})
+		tags, err := c.ELB.DescribeTags(ctx, &elb.DescribeTagsInput{LoadBalancerNames: loadBalancerNamesChunk})
 		if err != nil {
 			return nil, err
 		}
@@ -518,26 +1331,28 @@ func (c *Client) ListKubernetesELBs(ctx context.Context, vpcID, clusterName stri
 // DeleteELB deletes the loadbalancer with the specific <name>. If it does not exist,
 // no error is returned.
 func (c *Client) DeleteELB(ctx context.Context, name string) error {
-	_, err := c.ELB.DeleteLoadBalancerWithContext(ctx, &elb.DeleteLoadBalancerInput{LoadBalancerName: aws.String(name)})
+	_, err := c.ELB.DeleteLoadBalancer(ctx, &elb.DeleteLoadBalancerInput{LoadBalancerName: aws.String(name)})
 	return ignoreNotFound(err)
 }
 
 // ListKubernetesELBsV2 returns the list of ELBv2 loadbalancers in the given <vpcID> tagged with <clusterName>.
 func (c *Client) ListKubernetesELBsV2(ctx context.Context, vpcID, clusterName string) ([]string, error) {
 	var (
-		loadBalancerARNsInVPC      []*string
+		loadBalancerARNsInVPC      []string
 		loadBalancerARNsForCluster []string
 	)
 
-	if err := c.ELBv2.DescribeLoadBalancersPagesWithContext(ctx, &elbv2.DescribeLoadBalancersInput{}, func(page *elbv2.DescribeLoadBalancersOutput, lastPage bool) bool {
+	paginator := elbv2.NewDescribeLoadBalancersPaginator(c.ELBv2, &elbv2.DescribeLoadBalancersInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
 		for _, lb := range page.LoadBalancers {
-			if lb.VpcId != nil && *lb.VpcId == vpcID {
-				loadBalancerARNsInVPC = append(loadBalancerARNsInVPC, lb.LoadBalancerArn)
+			if lb.VpcId != nil && *lb.VpcId == vpcID && lb.LoadBalancerArn != nil {
+				loadBalancerARNsInVPC = append(loadBalancerARNsInVPC, *lb.LoadBalancerArn)
 			}
 		}
-		return !lastPage
-	}); err != nil {
-		return nil, err
 	}
 
 	if len(loadBalancerARNsInVPC) == 0 {
@@ -547,7 +1362,7 @@ func (c *Client) ListKubernetesELBsV2(ctx context.Context, vpcID, clusterName st
 	const chunkSize = 20
 	loadBalancerARNsChunks := chunkSlice(loadBalancerARNsInVPC, chunkSize)
 	for _, loadBalancerARNsChunk := range loadBalancerARNsChunks {
-		tags, err := c.ELBv2.DescribeTagsWithContext(ctx, &elbv2.DescribeTagsInput{ResourceArns: loadBalancerARNsChunk})
+		tags, err := c.ELBv2.DescribeTags(ctx, &elbv2.DescribeTagsInput{ResourceArns: loadBalancerARNsChunk})
 		if err != nil {
 			return nil, err
 		}
@@ -569,17 +1384,17 @@ func (c *Client) ListKubernetesELBsV2(ctx context.Context, vpcID, clusterName st
 // DeleteELBV2 deletes the loadbalancer (NLB or ALB) as well as its target groups with its Amazon Resource Name (ARN). If it does not exist,
 // no error is returned.
 func (c *Client) DeleteELBV2(ctx context.Context, arn string) error {
-	targetGroups, err := c.ELBv2.DescribeTargetGroups(&elbv2.DescribeTargetGroupsInput{LoadBalancerArn: &arn})
+	targetGroups, err := c.ELBv2.DescribeTargetGroups(ctx, &elbv2.DescribeTargetGroupsInput{LoadBalancerArn: &arn})
 	if err != nil {
 		return fmt.Errorf("could not list loadbalancer target groups for arn %s: %w", arn, err)
 	}
 
-	if _, err := c.ELBv2.DeleteLoadBalancerWithContext(ctx, &elbv2.DeleteLoadBalancerInput{LoadBalancerArn: &arn}); ignoreNotFound(err) != nil {
+	if _, err := c.ELBv2.DeleteLoadBalancer(ctx, &elbv2.DeleteLoadBalancerInput{LoadBalancerArn: &arn}); ignoreNotFound(err) != nil {
 		return fmt.Errorf("could not delete loadbalancer for arn %s: %w", arn, err)
 	}
 
 	for _, group := range targetGroups.TargetGroups {
-		if _, err := c.ELBv2.DeleteTargetGroup(&elbv2.DeleteTargetGroupInput{TargetGroupArn: group.TargetGroupArn}); err != nil {
+		if _, err := c.ELBv2.DeleteTargetGroup(ctx, &elbv2.DeleteTargetGroupInput{TargetGroupArn: group.TargetGroupArn}); err != nil {
 			return fmt.Errorf("could not delete target groups after deleting loadbalancer for arn %s: %w", arn, err)
 		}
 	}
@@ -587,21 +1402,66 @@ func (c *Client) DeleteELBV2(ctx context.Context, arn string) error {
 	return nil
 }
 
+// FindLoadBalancerARNByDNSName returns the ARN of the load balancer (NLB or ALB) with the given DNS name. If it does
+// not exist, an empty string is returned.
+func (c *Client) FindLoadBalancerARNByDNSName(ctx context.Context, dnsName string) (string, error) {
+	paginator := elbv2.NewDescribeLoadBalancersPaginator(c.ELBv2, &elbv2.DescribeLoadBalancersInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return "", err
+		}
+		for _, lb := range page.LoadBalancers {
+			if strings.EqualFold(aws.ToString(lb.DNSName), dnsName) {
+				return aws.ToString(lb.LoadBalancerArn), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// GetLoadBalancerTargetHealth returns the health of the targets of all target groups of the given load balancer.
+func (c *Client) GetLoadBalancerTargetHealth(ctx context.Context, loadBalancerARN string) ([]*TargetHealth, error) {
+	targetGroups, err := c.ELBv2.DescribeTargetGroups(ctx, &elbv2.DescribeTargetGroupsInput{LoadBalancerArn: aws.String(loadBalancerARN)})
+	if err != nil {
+		return nil, err
+	}
+	var health []*TargetHealth
+	for _, targetGroup := range targetGroups.TargetGroups {
+		output, err := c.ELBv2.DescribeTargetHealth(ctx, &elbv2.DescribeTargetHealthInput{TargetGroupArn: targetGroup.TargetGroupArn})
+		if err != nil {
+			return nil, err
+		}
+		for _, description := range output.TargetHealthDescriptions {
+			th := &TargetHealth{TargetGroupArn: aws.ToString(targetGroup.TargetGroupArn)}
+			if description.Target != nil {
+				th.TargetId = aws.ToString(description.Target.Id)
+			}
+			if description.TargetHealth != nil {
+				th.State = string(description.TargetHealth.State)
+				th.Description = aws.ToString(description.TargetHealth.Description)
+			}
+			health = append(health, th)
+		}
+	}
+	return health, nil
+}
+
 // ListKubernetesSecurityGroups returns the list of security groups in the given <vpcID> tagged with <clusterName>.
 func (c *Client) ListKubernetesSecurityGroups(ctx context.Context, vpcID, clusterName string) ([]string, error) {
-	groups, err := c.EC2.DescribeSecurityGroupsWithContext(ctx, &ec2.DescribeSecurityGroupsInput{
-		Filters: []*ec2.Filter{
+	groups, err := c.EC2.DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{
+		Filters: []ec2types.Filter{
 			{
 				Name:   aws.String("vpc-id"),
-				Values: aws.StringSlice([]string{vpcID}),
+				Values: []string{vpcID},
 			},
 			{
 				Name:   aws.String("tag-key"),
-				Values: aws.StringSlice([]string{fmt.Sprintf("kubernetes.io/cluster/%s", clusterName)}),
+				Values: []string{fmt.Sprintf("kubernetes.io/cluster/%s", clusterName)},
 			},
 			{
 				Name:   aws.String("tag-value"),
-				Values: aws.StringSlice([]string{"owned"}),
+				Values: []string{"owned"},
 			},
 		},
 	})
@@ -619,19 +1479,19 @@ func (c *Client) ListKubernetesSecurityGroups(ctx context.Context, vpcID, cluste
 
 // CreateVpcDhcpOptions creates a DHCP option resource.
 func (c *Client) CreateVpcDhcpOptions(ctx context.Context, options *DhcpOptions) (*DhcpOptions, error) {
-	var newConfigs []*ec2.NewDhcpConfiguration
+	var newConfigs []ec2types.NewDhcpConfiguration
 
 	for key, values := range options.DhcpConfigurations {
-		newConfigs = append(newConfigs, &ec2.NewDhcpConfiguration{
+		newConfigs = append(newConfigs, ec2types.NewDhcpConfiguration{
 			Key:    aws.String(key),
-			Values: aws.StringSlice(values),
+			Values: values,
 		})
 	}
 	input := &ec2.CreateDhcpOptionsInput{
 		DhcpConfigurations: newConfigs,
-		TagSpecifications:  options.ToTagSpecifications(ec2.ResourceTypeDhcpOptions),
+		TagSpecifications:  options.ToTagSpecifications(ec2types.ResourceTypeDhcpOptions),
 	}
-	output, err := c.EC2.CreateDhcpOptionsWithContext(ctx, input)
+	output, err := c.EC2.CreateDhcpOptions(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -640,7 +1500,7 @@ func (c *Client) CreateVpcDhcpOptions(ctx context.Context, options *DhcpOptions)
 
 // GetVpcDhcpOptions gets a DHCP option resource by identifier.
 func (c *Client) GetVpcDhcpOptions(ctx context.Context, id string) (*DhcpOptions, error) {
-	input := &ec2.DescribeDhcpOptionsInput{DhcpOptionsIds: aws.StringSlice([]string{id})}
+	input := &ec2.DescribeDhcpOptionsInput{DhcpOptionsIds: []string{id}}
 	output, err := c.describeVpcDhcpOptions(ctx, input)
 	return single(output, err)
 }
@@ -652,13 +1512,13 @@ func (c *Client) FindVpcDhcpOptionsByTags(ctx context.Context, tags Tags) ([]*Dh
 }
 
 func (c *Client) describeVpcDhcpOptions(ctx context.Context, input *ec2.DescribeDhcpOptionsInput) ([]*DhcpOptions, error) {
-	output, err := c.EC2.DescribeDhcpOptionsWithContext(ctx, input)
+	output, err := c.EC2.DescribeDhcpOptions(ctx, input)
 	if err != nil {
 		return nil, ignoreNotFound(err)
 	}
 	var options []*DhcpOptions
 	for _, item := range output.DhcpOptions {
-		options = append(options, fromDhcpOptions(item))
+		options = append(options, fromDhcpOptions(&item))
 	}
 	return options, nil
 }
@@ -666,10 +1526,29 @@ func (c *Client) describeVpcDhcpOptions(ctx context.Context, input *ec2.Describe
 // DeleteVpcDhcpOptions deletes a DHCP option resource by identifier.
 // Returns nil, if the resource is not found.
 func (c *Client) DeleteVpcDhcpOptions(ctx context.Context, id string) error {
-	_, err := c.EC2.DeleteDhcpOptionsWithContext(ctx, &ec2.DeleteDhcpOptionsInput{DhcpOptionsId: aws.String(id)})
+	_, err := c.EC2.DeleteDhcpOptions(ctx, &ec2.DeleteDhcpOptionsInput{DhcpOptionsId: aws.String(id)})
 	return ignoreNotFound(err)
 }
 
+// FindVpcIDsByDhcpOptions returns the ids of all VPCs which are associated with the given DHCP option resource.
+func (c *Client) FindVpcIDsByDhcpOptions(ctx context.Context, dhcpOptionsId string) ([]string, error) {
+	input := &ec2.DescribeVpcsInput{
+		Filters: []ec2types.Filter{{Name: aws.String("dhcp-options-id"), Values: []string{dhcpOptionsId}}},
+	}
+	var vpcIDs []string
+	paginator := ec2.NewDescribeVpcsPaginator(c.EC2, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range output.Vpcs {
+			vpcIDs = append(vpcIDs, aws.ToString(item.VpcId))
+		}
+	}
+	return vpcIDs, nil
+}
+
 // RetryableIPv6CIDRError is a custom error type.
 type RetryableIPv6CIDRError struct{}
 
@@ -689,9 +1568,9 @@ func (c *Client) CreateVpc(ctx context.Context, desired *VPC) (*VPC, error) {
 	input := &ec2.CreateVpcInput{
 		CidrBlock:                   aws.String(desired.CidrBlock),
 		AmazonProvidedIpv6CidrBlock: aws.Bool(desired.AssignGeneratedIPv6CidrBlock),
-		TagSpecifications:           desired.ToTagSpecifications(ec2.ResourceTypeVpc),
+		TagSpecifications:           desired.ToTagSpecifications(ec2types.ResourceTypeVpc),
 	}
-	output, err := c.EC2.CreateVpc(input)
+	output, err := c.EC2.CreateVpc(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -724,15 +1603,15 @@ func (c *Client) WaitForIPv6Cidr(ctx context.Context, vpcID string) (string, err
 func (c *Client) GetIPv6Cidr(ctx context.Context, vpcID string) (string, error) {
 	var ipv6CidrBlock string
 	describeVPCInput := &ec2.DescribeVpcsInput{
-		VpcIds: []*string{aws.String(vpcID)},
+		VpcIds: []string{vpcID},
 	}
-	resp, err := c.EC2.DescribeVpcs(describeVPCInput)
+	resp, err := c.EC2.DescribeVpcs(ctx, describeVPCInput)
 	if err != nil {
 		return "", fmt.Errorf("error describing VPC: %v", err)
 	}
 	if len(resp.Vpcs) > 0 {
 		for _, assoc := range resp.Vpcs[0].Ipv6CidrBlockAssociationSet {
-			if assoc != nil && aws.StringValue(assoc.Ipv6CidrBlockState.State) == "associated" {
+			if assoc.Ipv6CidrBlockState != nil && assoc.Ipv6CidrBlockState.State == ec2types.VpcCidrBlockStateCodeAssociated {
 				ipv6CidrBlock = *assoc.Ipv6CidrBlock
 				vpc, err := c.GetVpc(ctx, vpcID)
 				if err != nil {
@@ -748,39 +1627,39 @@ func (c *Client) GetIPv6Cidr(ctx context.Context, vpcID string) (string, error)
 
 // UpdateVpcAttribute sets/updates a VPC attribute if needed.
 // Supported attribute names are
-// `enableDnsSupport` (const ec2.VpcAttributeNameEnableDnsSupport) and
-// `enableDnsHostnames` (const ec2.VpcAttributeNameEnableDnsHostnames) and
+// `enableDnsSupport` (const ec2types.VpcAttributeNameEnableDnsSupport) and
+// `enableDnsHostnames` (const ec2types.VpcAttributeNameEnableDnsHostnames) and
 func (c *Client) UpdateVpcAttribute(ctx context.Context, vpcId, attributeName string, value bool) error {
-	switch attributeName {
-	case ec2.VpcAttributeNameEnableDnsSupport:
+	switch ec2types.VpcAttributeName(attributeName) {
+	case ec2types.VpcAttributeNameEnableDnsSupport:
 		input := &ec2.ModifyVpcAttributeInput{
-			EnableDnsSupport: &ec2.AttributeBooleanValue{
+			EnableDnsSupport: &ec2types.AttributeBooleanValue{
 				Value: aws.Bool(value),
 			},
 			VpcId: aws.String(vpcId),
 		}
-		if _, err := c.EC2.ModifyVpcAttribute(input); err != nil {
+		if _, err := c.EC2.ModifyVpcAttribute(ctx, input); err != nil {
 			return err
 		}
 		if err := c.PollImmediateUntil(ctx, func(ctx context.Context) (bool, error) {
-			b, err := c.describeVpcAttributeWithContext(ctx, aws.String(vpcId), ec2.VpcAttributeNameEnableDnsSupport)
+			b, err := c.describeVpcAttribute(ctx, aws.String(vpcId), ec2types.VpcAttributeNameEnableDnsSupport)
 			return b == value, err
 		}); err != nil {
 			return err
 		}
 		return nil
-	case ec2.VpcAttributeNameEnableDnsHostnames:
+	case ec2types.VpcAttributeNameEnableDnsHostnames:
 		input := &ec2.ModifyVpcAttributeInput{
-			EnableDnsHostnames: &ec2.AttributeBooleanValue{
+			EnableDnsHostnames: &ec2types.AttributeBooleanValue{
 				Value: aws.Bool(value),
 			},
 			VpcId: aws.String(vpcId),
 		}
-		if _, err := c.EC2.ModifyVpcAttribute(input); err != nil {
+		if _, err := c.EC2.ModifyVpcAttribute(ctx, input); err != nil {
 			return err
 		}
 		if err := c.PollImmediateUntil(ctx, func(ctx context.Context) (bool, error) {
-			b, err := c.describeVpcAttributeWithContext(ctx, aws.String(vpcId), ec2.VpcAttributeNameEnableDnsHostnames)
+			b, err := c.describeVpcAttribute(ctx, aws.String(vpcId), ec2types.VpcAttributeNameEnableDnsHostnames)
 			return b == value, err
 		}); err != nil {
 			return err
@@ -792,13 +1671,11 @@ func (c *Client) UpdateVpcAttribute(ctx context.Context, vpcId, attributeName st
 }
 
 // CheckVpcIPv6Cidr checks if the vpc has an IPv6 CIDR block assigned
-func (c *Client) CheckVpcIPv6Cidr(vpcID string) (bool, error) {
+func (c *Client) CheckVpcIPv6Cidr(ctx context.Context, vpcID string) (bool, error) {
 	input := &ec2.DescribeVpcsInput{
-		VpcIds: []*string{
-			aws.String(vpcID),
-		},
+		VpcIds: []string{vpcID},
 	}
-	output, err := c.EC2.DescribeVpcs(input)
+	output, err := c.EC2.DescribeVpcs(ctx, input)
 	if err != nil {
 		return false, err
 	}
@@ -807,7 +1684,7 @@ func (c *Client) CheckVpcIPv6Cidr(vpcID string) (bool, error) {
 	}
 	vpc := output.Vpcs[0]
 	for _, cidr := range vpc.Ipv6CidrBlockAssociationSet {
-		if *cidr.Ipv6CidrBlockState.State == "associated" {
+		if cidr.Ipv6CidrBlockState != nil && cidr.Ipv6CidrBlockState.State == ec2types.VpcCidrBlockStateCodeAssociated {
 			return true, nil
 		}
 	}
@@ -818,7 +1695,7 @@ func (c *Client) CheckVpcIPv6Cidr(vpcID string) (bool, error) {
 func (c *Client) UpdateAmazonProvidedIPv6CidrBlock(ctx context.Context, desired *VPC, current *VPC) (bool, error) {
 	modified := false
 	if current.VpcId != "" && desired.AssignGeneratedIPv6CidrBlock != current.AssignGeneratedIPv6CidrBlock {
-		ipv6CidrBlockAssociated, err := c.CheckVpcIPv6Cidr(current.VpcId)
+		ipv6CidrBlockAssociated, err := c.CheckVpcIPv6Cidr(ctx, current.VpcId)
 		if err != nil {
 			return modified, err
 		}
@@ -827,7 +1704,7 @@ func (c *Client) UpdateAmazonProvidedIPv6CidrBlock(ctx context.Context, desired
 				VpcId: aws.String(current.VpcId),
 			}
 			input.AmazonProvidedIpv6CidrBlock = aws.Bool(desired.AssignGeneratedIPv6CidrBlock)
-			_, err := c.EC2.AssociateVpcCidrBlockWithContext(ctx, input)
+			_, err := c.EC2.AssociateVpcCidrBlock(ctx, input)
 			if err != nil {
 				return modified, err
 			}
@@ -837,14 +1714,60 @@ func (c *Client) UpdateAmazonProvidedIPv6CidrBlock(ctx context.Context, desired
 	return modified, nil
 }
 
+// AssociateVpcCidrBlock associates an additional IPv4 CIDR block with a VPC and waits until the association is done.
+func (c *Client) AssociateVpcCidrBlock(ctx context.Context, vpcId, cidrBlock string) (*VpcCidrBlockAssociation, error) {
+	input := &ec2.AssociateVpcCidrBlockInput{
+		VpcId:     aws.String(vpcId),
+		CidrBlock: aws.String(cidrBlock),
+	}
+	output, err := c.EC2.AssociateVpcCidrBlock(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	assoc := &VpcCidrBlockAssociation{
+		AssociationId: aws.ToString(output.CidrBlockAssociation.AssociationId),
+		CidrBlock:     aws.ToString(output.CidrBlockAssociation.CidrBlock),
+	}
+	err = c.PollImmediateUntil(ctx, func(ctx context.Context) (done bool, err error) {
+		vpc, err := c.GetVpc(ctx, vpcId)
+		if err != nil {
+			return false, err
+		}
+		if vpc == nil {
+			return false, fmt.Errorf("vpc %s not found", vpcId)
+		}
+		for _, item := range vpc.SecondaryCidrBlocks {
+			if item.AssociationId == assoc.AssociationId {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	return assoc, err
+}
+
+// DisassociateVpcCidrBlock removes an IPv4 CIDR block association from a VPC.
+// Returns nil if the association is not found.
+func (c *Client) DisassociateVpcCidrBlock(ctx context.Context, associationId string) error {
+	input := &ec2.DisassociateVpcCidrBlockInput{
+		AssociationId: aws.String(associationId),
+	}
+	_, err := c.EC2.DisassociateVpcCidrBlock(ctx, input)
+	return ignoreNotFound(err)
+}
+
+func isActiveVpcCidrBlockState(state ec2types.VpcCidrBlockStateCode) bool {
+	return state == ec2types.VpcCidrBlockStateCodeAssociated
+}
+
 // AddVpcDhcpOptionAssociation associates existing DHCP options resource to VPC resource, both identified by id.
-func (c *Client) AddVpcDhcpOptionAssociation(vpcId string, dhcpOptionsId *string) error {
+func (c *Client) AddVpcDhcpOptionAssociation(ctx context.Context, vpcId string, dhcpOptionsId *string) error {
 	if dhcpOptionsId == nil {
 		// AWS does not provide an API to disassociate a DHCP Options set from a VPC.
 		// So, we do this by setting the VPC to the default DHCP Options Set.
 		dhcpOptionsId = aws.String("default")
 	}
-	_, err := c.EC2.AssociateDhcpOptions(&ec2.AssociateDhcpOptionsInput{
+	_, err := c.EC2.AssociateDhcpOptions(ctx, &ec2.AssociateDhcpOptionsInput{
 		DhcpOptionsId: dhcpOptionsId,
 		VpcId:         aws.String(vpcId),
 	})
@@ -854,14 +1777,14 @@ func (c *Client) AddVpcDhcpOptionAssociation(vpcId string, dhcpOptionsId *string
 // DeleteVpc deletes a VPC resource by identifier.
 // Returns nil, if the resource is not found.
 func (c *Client) DeleteVpc(ctx context.Context, id string) error {
-	_, err := c.EC2.DeleteVpcWithContext(ctx, &ec2.DeleteVpcInput{VpcId: aws.String(id)})
+	_, err := c.EC2.DeleteVpc(ctx, &ec2.DeleteVpcInput{VpcId: aws.String(id)})
 	return ignoreNotFound(err)
 }
 
 // GetVpc gets a VPC resource by identifier.
 // Returns nil, if the resource is not found.
 func (c *Client) GetVpc(ctx context.Context, id string) (*VPC, error) {
-	input := &ec2.DescribeVpcsInput{VpcIds: aws.StringSlice([]string{id})}
+	input := &ec2.DescribeVpcsInput{VpcIds: []string{id}}
 	output, err := c.describeVpcs(ctx, input)
 	return single(output, err)
 }
@@ -873,13 +1796,13 @@ func (c *Client) FindVpcsByTags(ctx context.Context, tags Tags) ([]*VPC, error)
 }
 
 func (c *Client) describeVpcs(ctx context.Context, input *ec2.DescribeVpcsInput) ([]*VPC, error) {
-	output, err := c.EC2.DescribeVpcs(input)
+	output, err := c.EC2.DescribeVpcs(ctx, input)
 	if err != nil {
 		return nil, ignoreNotFound(err)
 	}
 	var vpcList []*VPC
 	for _, item := range output.Vpcs {
-		vpc, err := c.fromVpc(ctx, item, true)
+		vpc, err := c.fromVpc(ctx, &item, true)
 		if err != nil {
 			return nil, err
 		}
@@ -888,45 +1811,58 @@ func (c *Client) describeVpcs(ctx context.Context, input *ec2.DescribeVpcsInput)
 	return vpcList, nil
 }
 
-func (c *Client) fromVpc(ctx context.Context, item *ec2.Vpc, withAttributes bool) (*VPC, error) {
+func (c *Client) fromVpc(ctx context.Context, item *ec2types.Vpc, withAttributes bool) (*VPC, error) {
 	vpc := &VPC{
-		VpcId:     aws.StringValue(item.VpcId),
+		VpcId:     aws.ToString(item.VpcId),
+		OwnerId:   aws.ToString(item.OwnerId),
 		Tags:      FromTags(item.Tags),
-		CidrBlock: aws.StringValue(item.CidrBlock),
+		CidrBlock: aws.ToString(item.CidrBlock),
 		IPv6CidrBlock: func() string {
 			if item.Ipv6CidrBlockAssociationSet != nil {
-				return aws.StringValue(item.Ipv6CidrBlockAssociationSet[0].Ipv6CidrBlock)
+				return aws.ToString(item.Ipv6CidrBlockAssociationSet[0].Ipv6CidrBlock)
 			}
 			return ""
 		}(),
 		DhcpOptionsId:   item.DhcpOptionsId,
-		InstanceTenancy: item.InstanceTenancy,
-		State:           item.State,
+		InstanceTenancy: ptr.To(string(item.InstanceTenancy)),
+		State:           ptr.To(string(item.State)),
+	}
+	for _, assoc := range item.CidrBlockAssociationSet {
+		if assoc.CidrBlockState == nil || !isActiveVpcCidrBlockState(assoc.CidrBlockState.State) {
+			continue
+		}
+		if aws.ToString(assoc.CidrBlock) == vpc.CidrBlock {
+			continue
+		}
+		vpc.SecondaryCidrBlocks = append(vpc.SecondaryCidrBlocks, &VpcCidrBlockAssociation{
+			AssociationId: aws.ToString(assoc.AssociationId),
+			CidrBlock:     aws.ToString(assoc.CidrBlock),
+		})
 	}
 	var err error
 	if withAttributes {
-		if vpc.EnableDnsHostnames, err = c.describeVpcAttributeWithContext(ctx, item.VpcId, ec2.VpcAttributeNameEnableDnsHostnames); err != nil {
+		if vpc.EnableDnsHostnames, err = c.describeVpcAttribute(ctx, item.VpcId, ec2types.VpcAttributeNameEnableDnsHostnames); err != nil {
 			return nil, err
 		}
-		if vpc.EnableDnsSupport, err = c.describeVpcAttributeWithContext(ctx, item.VpcId, ec2.VpcAttributeNameEnableDnsSupport); err != nil {
+		if vpc.EnableDnsSupport, err = c.describeVpcAttribute(ctx, item.VpcId, ec2types.VpcAttributeNameEnableDnsSupport); err != nil {
 			return nil, err
 		}
 	}
 	return vpc, nil
 }
 
-func (c *Client) describeVpcAttributeWithContext(ctx context.Context, vpcId *string, attributeName string) (bool, error) {
-	output, err := c.EC2.DescribeVpcAttributeWithContext(ctx, &ec2.DescribeVpcAttributeInput{
-		Attribute: aws.String(attributeName),
+func (c *Client) describeVpcAttribute(ctx context.Context, vpcId *string, attributeName ec2types.VpcAttributeName) (bool, error) {
+	output, err := c.EC2.DescribeVpcAttribute(ctx, &ec2.DescribeVpcAttributeInput{
+		Attribute: attributeName,
 		VpcId:     vpcId,
 	})
 	if err != nil {
 		return false, ignoreNotFound(err)
 	}
 	switch attributeName {
-	case ec2.VpcAttributeNameEnableDnsHostnames:
+	case ec2types.VpcAttributeNameEnableDnsHostnames:
 		return *output.EnableDnsHostnames.Value, nil
-	case ec2.VpcAttributeNameEnableDnsSupport:
+	case ec2types.VpcAttributeNameEnableDnsSupport:
 		return *output.EnableDnsSupport.Value, nil
 	default:
 		return false, fmt.Errorf("unknown attribute: %s", attributeName)
@@ -938,11 +1874,11 @@ func (c *Client) describeVpcAttributeWithContext(ctx context.Context, vpcId *str
 func (c *Client) CreateSecurityGroup(ctx context.Context, sg *SecurityGroup) (*SecurityGroup, error) {
 	input := &ec2.CreateSecurityGroupInput{
 		GroupName:         aws.String(sg.GroupName),
-		TagSpecifications: sg.ToTagSpecifications(ec2.ResourceTypeSecurityGroup),
+		TagSpecifications: sg.ToTagSpecifications(ec2types.ResourceTypeSecurityGroup),
 		VpcId:             sg.VpcId,
 		Description:       sg.Description,
 	}
-	output, err := c.EC2.CreateSecurityGroupWithContext(ctx, input)
+	output, err := c.EC2.CreateSecurityGroup(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -963,7 +1899,7 @@ func (c *Client) AuthorizeSecurityGroupRules(ctx context.Context, groupId string
 			GroupId:       aws.String(groupId),
 			IpPermissions: ingressPermissions,
 		}
-		if _, err := c.EC2.AuthorizeSecurityGroupIngressWithContext(ctx, input); err != nil {
+		if _, err := c.EC2.AuthorizeSecurityGroupIngress(ctx, input); err != nil {
 			return err
 		}
 	}
@@ -972,7 +1908,7 @@ func (c *Client) AuthorizeSecurityGroupRules(ctx context.Context, groupId string
 			GroupId:       aws.String(groupId),
 			IpPermissions: egressPermissions,
 		}
-		if _, err := c.EC2.AuthorizeSecurityGroupEgressWithContext(ctx, input); err != nil {
+		if _, err := c.EC2.AuthorizeSecurityGroupEgress(ctx, input); err != nil {
 			return err
 		}
 	}
@@ -990,7 +1926,7 @@ func (c *Client) RevokeSecurityGroupRules(ctx context.Context, groupId string, r
 			GroupId:       aws.String(groupId),
 			IpPermissions: ingressPermissions,
 		}
-		if _, err := c.EC2.RevokeSecurityGroupIngressWithContext(ctx, input); err != nil {
+		if _, err := c.EC2.RevokeSecurityGroupIngress(ctx, input); err != nil {
 			return err
 		}
 	}
@@ -999,48 +1935,51 @@ func (c *Client) RevokeSecurityGroupRules(ctx context.Context, groupId string, r
 			GroupId:       aws.String(groupId),
 			IpPermissions: egressPermissions,
 		}
-		if _, err := c.EC2.RevokeSecurityGroupEgressWithContext(ctx, input); err != nil {
+		if _, err := c.EC2.RevokeSecurityGroupEgress(ctx, input); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (c *Client) prepareRules(groupId string, rules []*SecurityGroupRule) (ingressPermissions, egressPermissions []*ec2.IpPermission, err error) {
+func (c *Client) prepareRules(groupId string, rules []*SecurityGroupRule) (ingressPermissions, egressPermissions []ec2types.IpPermission, err error) {
 	for _, rule := range rules {
-		var ipPerm *ec2.IpPermission
+		var ipPerm *ec2types.IpPermission
 		if rule.Foreign != nil {
-			ipPerm = &ec2.IpPermission{}
+			ipPerm = &ec2types.IpPermission{}
 			if err = json.Unmarshal([]byte(*rule.Foreign), ipPerm); err != nil {
 				return
 			}
 		} else {
-			ipPerm = &ec2.IpPermission{
+			ipPerm = &ec2types.IpPermission{
 				IpProtocol:       aws.String(rule.Protocol),
 				IpRanges:         nil,
 				PrefixListIds:    nil,
 				UserIdGroupPairs: nil,
 			}
 			if rule.FromPort != 0 {
-				ipPerm.FromPort = aws.Int64(int64(rule.FromPort))
+				ipPerm.FromPort = aws.Int32(int32(rule.FromPort))
 			}
 			if rule.ToPort != 0 {
-				ipPerm.ToPort = aws.Int64(int64(rule.ToPort))
+				ipPerm.ToPort = aws.Int32(int32(rule.ToPort))
 			}
 			for _, block := range rule.CidrBlocks {
-				ipPerm.IpRanges = append(ipPerm.IpRanges, &ec2.IpRange{CidrIp: aws.String(block)})
+				ipPerm.IpRanges = append(ipPerm.IpRanges, ec2types.IpRange{CidrIp: aws.String(block)})
 			}
 			if rule.Self {
-				ipPerm.UserIdGroupPairs = []*ec2.UserIdGroupPair{
+				ipPerm.UserIdGroupPairs = []ec2types.UserIdGroupPair{
 					{GroupId: aws.String(groupId)},
 				}
 			}
+			for _, id := range rule.SecurityGroupIds {
+				ipPerm.UserIdGroupPairs = append(ipPerm.UserIdGroupPairs, ec2types.UserIdGroupPair{GroupId: aws.String(id)})
+			}
 		}
 		switch rule.Type {
 		case SecurityGroupRuleTypeIngress:
-			ingressPermissions = append(ingressPermissions, ipPerm)
+			ingressPermissions = append(ingressPermissions, *ipPerm)
 		case SecurityGroupRuleTypeEgress:
-			egressPermissions = append(egressPermissions, ipPerm)
+			egressPermissions = append(egressPermissions, *ipPerm)
 		default:
 			err = fmt.Errorf("unknown security group rule type: %s", rule.Type)
 			return
@@ -1051,7 +1990,7 @@ func (c *Client) prepareRules(groupId string, rules []*SecurityGroupRule) (ingre
 
 // GetSecurityGroup gets a security group by identifier. Ingress and egress rules are fetched, too.
 func (c *Client) GetSecurityGroup(ctx context.Context, id string) (*SecurityGroup, error) {
-	input := &ec2.DescribeSecurityGroupsInput{GroupIds: aws.StringSlice([]string{id})}
+	input := &ec2.DescribeSecurityGroupsInput{GroupIds: []string{id}}
 	output, err := c.describeSecurityGroups(ctx, input)
 	return single(output, err)
 }
@@ -1064,7 +2003,7 @@ func (c *Client) FindSecurityGroupsByTags(ctx context.Context, tags Tags) ([]*Se
 }
 
 func (c *Client) describeSecurityGroups(ctx context.Context, input *ec2.DescribeSecurityGroupsInput) ([]*SecurityGroup, error) {
-	output, err := c.EC2.DescribeSecurityGroupsWithContext(ctx, input)
+	output, err := c.EC2.DescribeSecurityGroups(ctx, input)
 	if err != nil {
 		return nil, ignoreNotFound(err)
 	}
@@ -1072,20 +2011,20 @@ func (c *Client) describeSecurityGroups(ctx context.Context, input *ec2.Describe
 	for _, item := range output.SecurityGroups {
 		sg := &SecurityGroup{
 			Tags:        FromTags(item.Tags),
-			GroupId:     aws.StringValue(item.GroupId),
-			GroupName:   aws.StringValue(item.GroupName),
+			GroupId:     aws.ToString(item.GroupId),
+			GroupName:   aws.ToString(item.GroupName),
 			VpcId:       item.VpcId,
 			Description: item.Description,
 		}
 		for _, ipPerm := range item.IpPermissions {
-			rule, err := fromIpPermission(aws.StringValue(item.GroupId), ipPerm, SecurityGroupRuleTypeIngress)
+			rule, err := fromIpPermission(aws.ToString(item.GroupId), &ipPerm, SecurityGroupRuleTypeIngress)
 			if err != nil {
 				return nil, err
 			}
 			sg.Rules = append(sg.Rules, rule)
 		}
 		for _, ipPerm := range item.IpPermissionsEgress {
-			rule, err := fromIpPermission(aws.StringValue(item.GroupId), ipPerm, SecurityGroupRuleTypeEgress)
+			rule, err := fromIpPermission(aws.ToString(item.GroupId), &ipPerm, SecurityGroupRuleTypeEgress)
 			if err != nil {
 				return nil, err
 			}
@@ -1099,8 +2038,8 @@ func (c *Client) describeSecurityGroups(ctx context.Context, input *ec2.Describe
 // FindDefaultSecurityGroupByVpcId finds the default security group for the given VPC identifier.
 func (c *Client) FindDefaultSecurityGroupByVpcId(ctx context.Context, vpcId string) (*SecurityGroup, error) {
 	input := &ec2.DescribeSecurityGroupsInput{
-		Filters: []*ec2.Filter{
-			{Name: aws.String("vpc-id"), Values: aws.StringSlice([]string{vpcId})},
+		Filters: []ec2types.Filter{
+			{Name: aws.String("vpc-id"), Values: []string{vpcId}},
 		},
 	}
 	groups, err := c.describeSecurityGroups(ctx, input)
@@ -1118,22 +2057,22 @@ func (c *Client) FindDefaultSecurityGroupByVpcId(ctx context.Context, vpcId stri
 // DeleteSecurityGroup deletes a security group resource by identifier.
 // Returns nil, if the resource is not found.
 func (c *Client) DeleteSecurityGroup(ctx context.Context, id string) error {
-	_, err := c.EC2.DeleteSecurityGroupWithContext(ctx, &ec2.DeleteSecurityGroupInput{GroupId: aws.String(id)})
+	_, err := c.EC2.DeleteSecurityGroup(ctx, &ec2.DeleteSecurityGroupInput{GroupId: aws.String(id)})
 	return ignoreNotFound(err)
 }
 
 // CreateInternetGateway creates an internet gateway.
 func (c *Client) CreateInternetGateway(ctx context.Context, gateway *InternetGateway) (*InternetGateway, error) {
 	input := &ec2.CreateInternetGatewayInput{
-		TagSpecifications: gateway.ToTagSpecifications(ec2.ResourceTypeInternetGateway),
+		TagSpecifications: gateway.ToTagSpecifications(ec2types.ResourceTypeInternetGateway),
 	}
-	output, err := c.EC2.CreateInternetGatewayWithContext(ctx, input)
+	output, err := c.EC2.CreateInternetGateway(ctx, input)
 	if err != nil {
 		return nil, err
 	}
 	return &InternetGateway{
 		Tags:              FromTags(output.InternetGateway.Tags),
-		InternetGatewayId: aws.StringValue(output.InternetGateway.InternetGatewayId),
+		InternetGatewayId: aws.ToString(output.InternetGateway.InternetGatewayId),
 	}, nil
 }
 
@@ -1144,7 +2083,7 @@ func (c *Client) AttachInternetGateway(ctx context.Context, vpcId, internetGatew
 		InternetGatewayId: aws.String(internetGatewayId),
 		VpcId:             aws.String(vpcId),
 	}
-	_, err := c.EC2.AttachInternetGatewayWithContext(ctx, input)
+	_, err := c.EC2.AttachInternetGateway(ctx, input)
 	return ignoreAlreadyAssociated(err)
 }
 
@@ -1155,13 +2094,13 @@ func (c *Client) DetachInternetGateway(ctx context.Context, vpcId, internetGatew
 		InternetGatewayId: aws.String(internetGatewayId),
 		VpcId:             aws.String(vpcId),
 	}
-	_, err := c.EC2.DetachInternetGatewayWithContext(ctx, input)
+	_, err := c.EC2.DetachInternetGateway(ctx, input)
 	return err
 }
 
 // GetInternetGateway gets an internet gateway resource by identifier.
 func (c *Client) GetInternetGateway(ctx context.Context, id string) (*InternetGateway, error) {
-	input := &ec2.DescribeInternetGatewaysInput{InternetGatewayIds: aws.StringSlice([]string{id})}
+	input := &ec2.DescribeInternetGatewaysInput{InternetGatewayIds: []string{id}}
 	output, err := c.describeInternetGateways(ctx, input)
 	return single(output, err)
 }
@@ -1174,16 +2113,16 @@ func (c *Client) FindInternetGatewaysByTags(ctx context.Context, tags Tags) ([]*
 
 // FindInternetGatewayByVPC finds an internet gateway resource attached to the given VPC.
 func (c *Client) FindInternetGatewayByVPC(ctx context.Context, vpcId string) (*InternetGateway, error) {
-	input := &ec2.DescribeInternetGatewaysInput{Filters: []*ec2.Filter{{
+	input := &ec2.DescribeInternetGatewaysInput{Filters: []ec2types.Filter{{
 		Name:   aws.String("attachment.vpc-id"),
-		Values: aws.StringSlice([]string{vpcId}),
+		Values: []string{vpcId},
 	}}}
 	output, err := c.describeInternetGateways(ctx, input)
 	return single(output, err)
 }
 
 func (c *Client) describeInternetGateways(ctx context.Context, input *ec2.DescribeInternetGatewaysInput) ([]*InternetGateway, error) {
-	output, err := c.EC2.DescribeInternetGatewaysWithContext(ctx, input)
+	output, err := c.EC2.DescribeInternetGateways(ctx, input)
 	if err != nil {
 		return nil, ignoreNotFound(err)
 	}
@@ -1191,7 +2130,7 @@ func (c *Client) describeInternetGateways(ctx context.Context, input *ec2.Descri
 	for _, item := range output.InternetGateways {
 		gw := &InternetGateway{
 			Tags:              FromTags(item.Tags),
-			InternetGatewayId: aws.StringValue(item.InternetGatewayId),
+			InternetGatewayId: aws.ToString(item.InternetGatewayId),
 		}
 		for _, attachment := range item.Attachments {
 			gw.VpcId = attachment.VpcId
@@ -1208,7 +2147,105 @@ func (c *Client) DeleteInternetGateway(ctx context.Context, id string) error {
 	input := &ec2.DeleteInternetGatewayInput{
 		InternetGatewayId: aws.String(id),
 	}
-	_, err := c.EC2.DeleteInternetGatewayWithContext(ctx, input)
+	_, err := c.EC2.DeleteInternetGateway(ctx, input)
+	return ignoreNotFound(err)
+}
+
+// CreateEgressOnlyInternetGateway creates an egress only internet gateway for the VPC.
+func (c *Client) CreateEgressOnlyInternetGateway(ctx context.Context, gateway *EgressOnlyInternetGateway) (*EgressOnlyInternetGateway, error) {
+	input := &ec2.CreateEgressOnlyInternetGatewayInput{
+		TagSpecifications: gateway.ToTagSpecifications(ec2types.ResourceTypeEgressOnlyInternetGateway),
+		VpcId:             gateway.VpcId,
+	}
+	output, err := c.EC2.CreateEgressOnlyInternetGateway(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return fromEgressOnlyInternetGateway(output.EgressOnlyInternetGateway), nil
+}
+
+// GetEgressOnlyInternetGateway gets an egress only internet gateway resource by identifier.
+func (c *Client) GetEgressOnlyInternetGateway(ctx context.Context, id string) (*EgressOnlyInternetGateway, error) {
+	input := &ec2.DescribeEgressOnlyInternetGatewaysInput{EgressOnlyInternetGatewayIds: []string{id}}
+	output, err := c.describeEgressOnlyInternetGateways(ctx, input)
+	return single(output, err)
+}
+
+// FindEgressOnlyInternetGatewaysByTags finds egress only internet gateway resources matching the given tag map.
+func (c *Client) FindEgressOnlyInternetGatewaysByTags(ctx context.Context, tags Tags) ([]*EgressOnlyInternetGateway, error) {
+	input := &ec2.DescribeEgressOnlyInternetGatewaysInput{Filters: tags.ToFilters()}
+	return c.describeEgressOnlyInternetGateways(ctx, input)
+}
+
+func (c *Client) describeEgressOnlyInternetGateways(ctx context.Context, input *ec2.DescribeEgressOnlyInternetGatewaysInput) ([]*EgressOnlyInternetGateway, error) {
+	output, err := c.EC2.DescribeEgressOnlyInternetGateways(ctx, input)
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	var gateways []*EgressOnlyInternetGateway
+	for _, item := range output.EgressOnlyInternetGateways {
+		gateways = append(gateways, fromEgressOnlyInternetGateway(&item))
+	}
+	return gateways, nil
+}
+
+// DeleteEgressOnlyInternetGateway deletes an egress only internet gateway resource.
+// Returns nil, if the resource is not found.
+func (c *Client) DeleteEgressOnlyInternetGateway(ctx context.Context, id string) error {
+	input := &ec2.DeleteEgressOnlyInternetGatewayInput{
+		EgressOnlyInternetGatewayId: aws.String(id),
+	}
+	_, err := c.EC2.DeleteEgressOnlyInternetGateway(ctx, input)
+	return ignoreNotFound(err)
+}
+
+// CreateCarrierGateway creates a carrier gateway for the VPC.
+func (c *Client) CreateCarrierGateway(ctx context.Context, gateway *CarrierGateway) (*CarrierGateway, error) {
+	input := &ec2.CreateCarrierGatewayInput{
+		TagSpecifications: gateway.ToTagSpecifications(ec2types.ResourceTypeCarrierGateway),
+		VpcId:             gateway.VpcId,
+	}
+	output, err := c.EC2.CreateCarrierGateway(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return fromCarrierGateway(output.CarrierGateway), nil
+}
+
+// GetCarrierGateway gets a carrier gateway resource by identifier.
+func (c *Client) GetCarrierGateway(ctx context.Context, id string) (*CarrierGateway, error) {
+	input := &ec2.DescribeCarrierGatewaysInput{CarrierGatewayIds: []string{id}}
+	output, err := c.describeCarrierGateways(ctx, input)
+	return single(output, err)
+}
+
+// FindCarrierGatewaysByTags finds carrier gateway resources matching the given tag map.
+func (c *Client) FindCarrierGatewaysByTags(ctx context.Context, tags Tags) ([]*CarrierGateway, error) {
+	input := &ec2.DescribeCarrierGatewaysInput{Filters: tags.ToFilters()}
+	return c.describeCarrierGateways(ctx, input)
+}
+
+func (c *Client) describeCarrierGateways(ctx context.Context, input *ec2.DescribeCarrierGatewaysInput) ([]*CarrierGateway, error) {
+	output, err := c.EC2.DescribeCarrierGateways(ctx, input)
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	var gateways []*CarrierGateway
+	for _, item := range output.CarrierGateways {
+		if gw := fromCarrierGateway(&item); gw != nil {
+			gateways = append(gateways, gw)
+		}
+	}
+	return gateways, nil
+}
+
+// DeleteCarrierGateway deletes a carrier gateway resource.
+// Returns nil, if the resource is not found.
+func (c *Client) DeleteCarrierGateway(ctx context.Context, id string) error {
+	input := &ec2.DeleteCarrierGatewayInput{
+		CarrierGatewayId: aws.String(id),
+	}
+	_, err := c.EC2.DeleteCarrierGateway(ctx, input)
 	return ignoreNotFound(err)
 }
 
@@ -1216,25 +2253,26 @@ func (c *Client) DeleteInternetGateway(ctx context.Context, id string) error {
 func (c *Client) CreateVpcEndpoint(ctx context.Context, endpoint *VpcEndpoint) (*VpcEndpoint, error) {
 	input := &ec2.CreateVpcEndpointInput{
 		ServiceName: aws.String(endpoint.ServiceName),
-		// TagSpecifications: endpoint.ToTagSpecifications(ec2.ResourceTypeClientVpnEndpoint),
+		// TagSpecifications: endpoint.ToTagSpecifications(ec2types.ResourceTypeClientVpnEndpoint),
 		VpcId: endpoint.VpcId,
 	}
-	output, err := c.EC2.CreateVpcEndpointWithContext(ctx, input)
+	if endpoint.VpcEndpointType == string(ec2types.VpcEndpointTypeInterface) {
+		input.VpcEndpointType = ec2types.VpcEndpointType(endpoint.VpcEndpointType)
+		input.SubnetIds = endpoint.SubnetIds
+		input.SecurityGroupIds = endpoint.SecurityGroupIds
+		input.PrivateDnsEnabled = aws.Bool(endpoint.PrivateDnsEnabled)
+	}
+	output, err := c.EC2.CreateVpcEndpoint(ctx, input)
 	if err != nil {
 		return nil, err
 	}
-	return &VpcEndpoint{
-		// Tags:          FromTags(output.VpcEndpoint.Tags),
-		VpcEndpointId: aws.StringValue(output.VpcEndpoint.VpcEndpointId),
-		VpcId:         output.VpcEndpoint.VpcId,
-		ServiceName:   aws.StringValue(output.VpcEndpoint.ServiceName),
-	}, nil
+	return fromVpcEndpoint(output.VpcEndpoint), nil
 }
 
 // GetVpcEndpoints gets VPC endpoint resources by identifiers.
 // Non-existing identifiers are silently ignored.
 func (c *Client) GetVpcEndpoints(ctx context.Context, ids []string) ([]*VpcEndpoint, error) {
-	input := &ec2.DescribeVpcEndpointsInput{VpcEndpointIds: aws.StringSlice(ids)}
+	input := &ec2.DescribeVpcEndpointsInput{VpcEndpointIds: ids}
 	return c.describeVpcEndpoints(ctx, input)
 }
 
@@ -1245,41 +2283,365 @@ func (c *Client) FindVpcEndpointsByTags(ctx context.Context, tags Tags) ([]*VpcE
 }
 
 func (c *Client) describeVpcEndpoints(ctx context.Context, input *ec2.DescribeVpcEndpointsInput) ([]*VpcEndpoint, error) {
-	output, err := c.EC2.DescribeVpcEndpointsWithContext(ctx, input)
+	output, err := c.EC2.DescribeVpcEndpoints(ctx, input)
 	if err != nil {
 		return nil, ignoreNotFound(err)
 	}
 	var endpoints []*VpcEndpoint
 	for _, item := range output.VpcEndpoints {
-		endpoint := &VpcEndpoint{
-			Tags:          FromTags(item.Tags),
-			VpcEndpointId: aws.StringValue(item.VpcEndpointId),
-			VpcId:         item.VpcId,
-			ServiceName:   aws.StringValue(item.ServiceName),
-		}
-		endpoints = append(endpoints, endpoint)
+		endpoints = append(endpoints, fromVpcEndpoint(&item))
 	}
 	return endpoints, nil
 }
 
+// ModifyVpcEndpointSubnets adds and removes subnets of an interface VPC endpoint.
+func (c *Client) ModifyVpcEndpointSubnets(ctx context.Context, id string, addSubnetIds, removeSubnetIds []string) error {
+	input := &ec2.ModifyVpcEndpointInput{
+		VpcEndpointId: aws.String(id),
+	}
+	if len(addSubnetIds) > 0 {
+		input.AddSubnetIds = addSubnetIds
+	}
+	if len(removeSubnetIds) > 0 {
+		input.RemoveSubnetIds = removeSubnetIds
+	}
+	_, err := c.EC2.ModifyVpcEndpoint(ctx, input)
+	return err
+}
+
+func fromVpcEndpoint(item *ec2types.VpcEndpoint) *VpcEndpoint {
+	endpoint := &VpcEndpoint{
+		Tags:              FromTags(item.Tags),
+		VpcEndpointId:     aws.ToString(item.VpcEndpointId),
+		VpcId:             item.VpcId,
+		ServiceName:       aws.ToString(item.ServiceName),
+		VpcEndpointType:   string(item.VpcEndpointType),
+		SubnetIds:         item.SubnetIds,
+		PrivateDnsEnabled: aws.ToBool(item.PrivateDnsEnabled),
+	}
+	for _, group := range item.Groups {
+		endpoint.SecurityGroupIds = append(endpoint.SecurityGroupIds, aws.ToString(group.GroupId))
+	}
+	return endpoint
+}
+
 // DeleteVpcEndpoint deletes a VPC endpoint by id.
 // Returns nil if resource is not found.
 func (c *Client) DeleteVpcEndpoint(ctx context.Context, id string) error {
 	input := &ec2.DeleteVpcEndpointsInput{
-		VpcEndpointIds: []*string{&id},
+		VpcEndpointIds: []string{id},
 	}
-	_, err := c.EC2.DeleteVpcEndpointsWithContext(ctx, input)
+	_, err := c.EC2.DeleteVpcEndpoints(ctx, input)
 	return ignoreNotFound(err)
 }
 
-// CreateVpcEndpointRouteTableAssociation creates a route for a VPC endpoint.
-// Itempotent, i.e. does nothing if the route is already existing.
-func (c *Client) CreateVpcEndpointRouteTableAssociation(ctx context.Context, routeTableId, vpcEndpointId string) error {
-	routeTable, err := c.GetRouteTable(ctx, routeTableId)
+// GetPrefixListID gets the id of the AWS-managed prefix list of the service with the given name, e.g.
+// `com.amazonaws.eu-west-1.s3`. It returns nil if there is no such prefix list.
+func (c *Client) GetPrefixListID(ctx context.Context, serviceName string) (*string, error) {
+	input := &ec2.DescribePrefixListsInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("prefix-list-name"),
+				Values: []string{serviceName},
+			},
+		},
+	}
+	output, err := c.EC2.DescribePrefixLists(ctx, input)
 	if err != nil {
-		return err
+		return nil, ignoreNotFound(err)
 	}
-	for _, route := range routeTable.Routes {
+	if len(output.PrefixLists) == 0 {
+		return nil, nil
+	}
+	return output.PrefixLists[0].PrefixListId, nil
+}
+
+// CreateManagedPrefixList creates a customer-managed prefix list with the given CIDRs as entries.
+func (c *Client) CreateManagedPrefixList(ctx context.Context, list *ManagedPrefixList, cidrs []string) (*ManagedPrefixList, error) {
+	input := &ec2.CreateManagedPrefixListInput{
+		AddressFamily:     aws.String(list.AddressFamily),
+		MaxEntries:        aws.Int32(list.MaxEntries),
+		PrefixListName:    aws.String(list.PrefixListName),
+		TagSpecifications: list.ToTagSpecifications(ec2types.ResourceTypePrefixList),
+	}
+	for _, cidr := range cidrs {
+		input.Entries = append(input.Entries, ec2types.AddPrefixListEntry{Cidr: aws.String(cidr)})
+	}
+	output, err := c.EC2.CreateManagedPrefixList(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return fromManagedPrefixList(output.PrefixList), nil
+}
+
+// GetManagedPrefixList gets a customer-managed prefix list by identifier.
+// If the resource is not found or already deleted, nil is returned.
+func (c *Client) GetManagedPrefixList(ctx context.Context, id string) (*ManagedPrefixList, error) {
+	input := &ec2.DescribeManagedPrefixListsInput{PrefixListIds: []string{id}}
+	output, err := c.describeManagedPrefixLists(ctx, input)
+	return single(output, err)
+}
+
+// FindManagedPrefixListsByTags finds customer-managed prefix lists matching the given tag map.
+func (c *Client) FindManagedPrefixListsByTags(ctx context.Context, tags Tags) ([]*ManagedPrefixList, error) {
+	input := &ec2.DescribeManagedPrefixListsInput{Filters: tags.ToFilters()}
+	return c.describeManagedPrefixLists(ctx, input)
+}
+
+func (c *Client) describeManagedPrefixLists(ctx context.Context, input *ec2.DescribeManagedPrefixListsInput) ([]*ManagedPrefixList, error) {
+	output, err := c.EC2.DescribeManagedPrefixLists(ctx, input)
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	var lists []*ManagedPrefixList
+	for _, item := range output.PrefixLists {
+		if item.State == ec2types.PrefixListStateDeleteComplete {
+			continue
+		}
+		lists = append(lists, fromManagedPrefixList(&item))
+	}
+	return lists, nil
+}
+
+// GetManagedPrefixListCIDRs gets the CIDRs of the entries of a customer-managed prefix list.
+func (c *Client) GetManagedPrefixListCIDRs(ctx context.Context, id string) ([]string, error) {
+	var cidrs []string
+	paginator := ec2.NewGetManagedPrefixListEntriesPaginator(c.EC2, &ec2.GetManagedPrefixListEntriesInput{
+		PrefixListId: aws.String(id),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range page.Entries {
+			cidrs = append(cidrs, aws.ToString(entry.Cidr))
+		}
+	}
+	return cidrs, nil
+}
+
+// ModifyManagedPrefixListCIDRs adds and removes entries of a customer-managed prefix list. The version must be the
+// current version of the prefix list, otherwise the modification is rejected.
+func (c *Client) ModifyManagedPrefixListCIDRs(ctx context.Context, id string, version int64, addCIDRs, removeCIDRs []string) error {
+	input := &ec2.ModifyManagedPrefixListInput{
+		PrefixListId:   aws.String(id),
+		CurrentVersion: aws.Int64(version),
+	}
+	for _, cidr := range addCIDRs {
+		input.AddEntries = append(input.AddEntries, ec2types.AddPrefixListEntry{Cidr: aws.String(cidr)})
+	}
+	for _, cidr := range removeCIDRs {
+		input.RemoveEntries = append(input.RemoveEntries, ec2types.RemovePrefixListEntry{Cidr: aws.String(cidr)})
+	}
+	_, err := c.EC2.ModifyManagedPrefixList(ctx, input)
+	return err
+}
+
+// ResizeManagedPrefixList changes the maximum number of entries of a customer-managed prefix list.
+// The entries can't be modified in the same request.
+func (c *Client) ResizeManagedPrefixList(ctx context.Context, id string, maxEntries int32) error {
+	input := &ec2.ModifyManagedPrefixListInput{
+		PrefixListId: aws.String(id),
+		MaxEntries:   aws.Int32(maxEntries),
+	}
+	_, err := c.EC2.ModifyManagedPrefixList(ctx, input)
+	return err
+}
+
+// WaitForManagedPrefixListModified waits until the creation or modification of a customer-managed prefix list is
+// complete.
+func (c *Client) WaitForManagedPrefixListModified(ctx context.Context, id string) error {
+	return c.PollImmediateUntil(ctx, func(ctx context.Context) (done bool, err error) {
+		item, err := c.GetManagedPrefixList(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		if item == nil {
+			return false, fmt.Errorf("managed prefix list %s not found", id)
+		}
+		switch ec2types.PrefixListState(item.State) {
+		case ec2types.PrefixListStateCreateFailed, ec2types.PrefixListStateModifyFailed:
+			return false, fmt.Errorf("managed prefix list %s is in state %s", id, item.State)
+		case ec2types.PrefixListStateCreateInProgress, ec2types.PrefixListStateModifyInProgress, ec2types.PrefixListStateRestoreInProgress:
+			return false, nil
+		}
+		return true, nil
+	})
+}
+
+// DeleteManagedPrefixList deletes a customer-managed prefix list by id.
+// Returns nil if resource is not found.
+func (c *Client) DeleteManagedPrefixList(ctx context.Context, id string) error {
+	_, err := c.EC2.DeleteManagedPrefixList(ctx, &ec2.DeleteManagedPrefixListInput{PrefixListId: aws.String(id)})
+	return ignoreNotFound(err)
+}
+
+func fromManagedPrefixList(item *ec2types.ManagedPrefixList) *ManagedPrefixList {
+	return &ManagedPrefixList{
+		Tags:           FromTags(item.Tags),
+		PrefixListId:   aws.ToString(item.PrefixListId),
+		PrefixListName: aws.ToString(item.PrefixListName),
+		PrefixListArn:  aws.ToString(item.PrefixListArn),
+		AddressFamily:  aws.ToString(item.AddressFamily),
+		MaxEntries:     aws.ToInt32(item.MaxEntries),
+		Version:        aws.ToInt64(item.Version),
+		State:          string(item.State),
+	}
+}
+
+// CreateVpcEndpointService creates an EC2 VPC endpoint service configuration for the given network load balancers.
+func (c *Client) CreateVpcEndpointService(ctx context.Context, service *VpcEndpointService) (*VpcEndpointService, error) {
+	input := &ec2.CreateVpcEndpointServiceConfigurationInput{
+		NetworkLoadBalancerArns: service.NetworkLoadBalancerARNs,
+		AcceptanceRequired:      aws.Bool(service.AcceptanceRequired),
+		TagSpecifications:       service.ToTagSpecifications(ec2types.ResourceTypeVpcEndpointService),
+	}
+	output, err := c.EC2.CreateVpcEndpointServiceConfiguration(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return fromVpcEndpointService(output.ServiceConfiguration), nil
+}
+
+// GetVpcEndpointService gets a VPC endpoint service configuration by id.
+// Returns nil if the resource is not found.
+func (c *Client) GetVpcEndpointService(ctx context.Context, id string) (*VpcEndpointService, error) {
+	input := &ec2.DescribeVpcEndpointServiceConfigurationsInput{ServiceIds: []string{id}}
+	output, err := c.EC2.DescribeVpcEndpointServiceConfigurations(ctx, input)
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	for _, item := range output.ServiceConfigurations {
+		if item.ServiceState != ec2types.ServiceStateDeleted && item.ServiceState != ec2types.ServiceStateDeleting {
+			return fromVpcEndpointService(&item), nil
+		}
+	}
+	return nil, nil
+}
+
+// UpdateVpcEndpointService updates the network load balancers and the acceptance setting of a VPC endpoint service
+// configuration.
+func (c *Client) UpdateVpcEndpointService(ctx context.Context, desired, current *VpcEndpointService) error {
+	desiredARNs, currentARNs := sets.New(desired.NetworkLoadBalancerARNs...), sets.New(current.NetworkLoadBalancerARNs...)
+	if desired.AcceptanceRequired == current.AcceptanceRequired && desiredARNs.Equal(currentARNs) {
+		return nil
+	}
+
+	input := &ec2.ModifyVpcEndpointServiceConfigurationInput{
+		ServiceId:          aws.String(current.ServiceId),
+		AcceptanceRequired: aws.Bool(desired.AcceptanceRequired),
+	}
+	if add := sets.List(desiredARNs.Difference(currentARNs)); len(add) > 0 {
+		input.AddNetworkLoadBalancerArns = add
+	}
+	if remove := sets.List(currentARNs.Difference(desiredARNs)); len(remove) > 0 {
+		input.RemoveNetworkLoadBalancerArns = remove
+	}
+	_, err := c.EC2.ModifyVpcEndpointServiceConfiguration(ctx, input)
+	return err
+}
+
+// GetVpcEndpointServicePrincipals gets the ARNs of the principals which are allowed to connect to a VPC endpoint
+// service.
+func (c *Client) GetVpcEndpointServicePrincipals(ctx context.Context, id string) ([]string, error) {
+	var principals []string
+	paginator := ec2.NewDescribeVpcEndpointServicePermissionsPaginator(c.EC2, &ec2.DescribeVpcEndpointServicePermissionsInput{
+		ServiceId: aws.String(id),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, principal := range page.AllowedPrincipals {
+			principals = append(principals, aws.ToString(principal.Principal))
+		}
+	}
+	return principals, nil
+}
+
+// UpdateVpcEndpointServicePrincipals adds and removes principals which are allowed to connect to a VPC endpoint
+// service.
+func (c *Client) UpdateVpcEndpointServicePrincipals(ctx context.Context, id string, addPrincipals, removePrincipals []string) error {
+	if len(addPrincipals) == 0 && len(removePrincipals) == 0 {
+		return nil
+	}
+	input := &ec2.ModifyVpcEndpointServicePermissionsInput{
+		ServiceId: aws.String(id),
+	}
+	if len(addPrincipals) > 0 {
+		input.AddAllowedPrincipals = addPrincipals
+	}
+	if len(removePrincipals) > 0 {
+		input.RemoveAllowedPrincipals = removePrincipals
+	}
+	_, err := c.EC2.ModifyVpcEndpointServicePermissions(ctx, input)
+	return err
+}
+
+// DeleteVpcEndpointService deletes a VPC endpoint service configuration by id. The connections of VPC endpoints are
+// rejected before, as services with active connections cannot be deleted.
+// Returns nil if the resource is not found.
+func (c *Client) DeleteVpcEndpointService(ctx context.Context, id string) error {
+	var endpointIds []string
+	paginator := ec2.NewDescribeVpcEndpointConnectionsPaginator(c.EC2, &ec2.DescribeVpcEndpointConnectionsInput{
+		Filters: []ec2types.Filter{{Name: aws.String("service-id"), Values: []string{id}}},
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return ignoreNotFound(err)
+		}
+		for _, connection := range page.VpcEndpointConnections {
+			if connection.VpcEndpointState != ec2types.StateRejected && connection.VpcEndpointState != ec2types.StateDeleted {
+				endpointIds = append(endpointIds, aws.ToString(connection.VpcEndpointId))
+			}
+		}
+	}
+	if len(endpointIds) > 0 {
+		if _, err := c.EC2.RejectVpcEndpointConnections(ctx, &ec2.RejectVpcEndpointConnectionsInput{
+			ServiceId:      aws.String(id),
+			VpcEndpointIds: endpointIds,
+		}); err != nil {
+			return ignoreNotFound(err)
+		}
+	}
+
+	output, err := c.EC2.DeleteVpcEndpointServiceConfigurations(ctx, &ec2.DeleteVpcEndpointServiceConfigurationsInput{
+		ServiceIds: []string{id},
+	})
+	if err != nil {
+		return ignoreNotFound(err)
+	}
+	for _, item := range output.Unsuccessful {
+		if item.Error != nil && !strings.HasSuffix(aws.ToString(item.Error.Code), "NotFound") {
+			return fmt.Errorf("could not delete VPC endpoint service %s: %s", id, aws.ToString(item.Error.Message))
+		}
+	}
+	return nil
+}
+
+func fromVpcEndpointService(item *ec2types.ServiceConfiguration) *VpcEndpointService {
+	return &VpcEndpointService{
+		Tags:                    FromTags(item.Tags),
+		ServiceId:               aws.ToString(item.ServiceId),
+		ServiceName:             aws.ToString(item.ServiceName),
+		ServiceState:            string(item.ServiceState),
+		NetworkLoadBalancerARNs: item.NetworkLoadBalancerArns,
+		AcceptanceRequired:      aws.ToBool(item.AcceptanceRequired),
+		BaseEndpointDnsNames:    item.BaseEndpointDnsNames,
+	}
+}
+
+// CreateVpcEndpointRouteTableAssociation creates a route for a VPC endpoint.
+// Itempotent, i.e. does nothing if the route is already existing.
+func (c *Client) CreateVpcEndpointRouteTableAssociation(ctx context.Context, routeTableId, vpcEndpointId string) error {
+	routeTable, err := c.GetRouteTable(ctx, routeTableId)
+	if err != nil {
+		return err
+	}
+	for _, route := range routeTable.Routes {
 		if route.GatewayId != nil && *route.GatewayId == vpcEndpointId {
 			return nil // already existing
 		}
@@ -1287,9 +2649,9 @@ func (c *Client) CreateVpcEndpointRouteTableAssociation(ctx context.Context, rou
 
 	input := &ec2.ModifyVpcEndpointInput{
 		VpcEndpointId:    aws.String(vpcEndpointId),
-		AddRouteTableIds: aws.StringSlice([]string{routeTableId}),
+		AddRouteTableIds: []string{routeTableId},
 	}
-	_, err = c.EC2.ModifyVpcEndpointWithContext(ctx, input)
+	_, err = c.EC2.ModifyVpcEndpoint(ctx, input)
 	return err
 }
 
@@ -1304,9 +2666,9 @@ func (c *Client) DeleteVpcEndpointRouteTableAssociation(ctx context.Context, rou
 		if route.GatewayId != nil && *route.GatewayId == vpcEndpointId {
 			input := &ec2.ModifyVpcEndpointInput{
 				VpcEndpointId:       aws.String(vpcEndpointId),
-				RemoveRouteTableIds: aws.StringSlice([]string{routeTableId}),
+				RemoveRouteTableIds: []string{routeTableId},
 			}
-			_, err = c.EC2.ModifyVpcEndpointWithContext(ctx, input)
+			_, err = c.EC2.ModifyVpcEndpoint(ctx, input)
 			return err
 		}
 	}
@@ -1317,16 +2679,16 @@ func (c *Client) DeleteVpcEndpointRouteTableAssociation(ctx context.Context, rou
 // Routes specified in the input object are ignored.
 func (c *Client) CreateRouteTable(ctx context.Context, routeTable *RouteTable) (*RouteTable, error) {
 	input := &ec2.CreateRouteTableInput{
-		TagSpecifications: routeTable.ToTagSpecifications(ec2.ResourceTypeRouteTable),
+		TagSpecifications: routeTable.ToTagSpecifications(ec2types.ResourceTypeRouteTable),
 		VpcId:             routeTable.VpcId,
 	}
-	output, err := c.EC2.CreateRouteTableWithContext(ctx, input)
+	output, err := c.EC2.CreateRouteTable(ctx, input)
 	if err != nil {
 		return nil, err
 	}
 	created := &RouteTable{
 		Tags:         FromTags(output.RouteTable.Tags),
-		RouteTableId: aws.StringValue(output.RouteTable.RouteTableId),
+		RouteTableId: aws.ToString(output.RouteTable.RouteTableId),
 		VpcId:        output.RouteTable.VpcId,
 	}
 
@@ -1336,14 +2698,19 @@ func (c *Client) CreateRouteTable(ctx context.Context, routeTable *RouteTable) (
 // CreateRoute creates a route for the given route table.
 func (c *Client) CreateRoute(ctx context.Context, routeTableId string, route *Route) error {
 	input := &ec2.CreateRouteInput{
-		DestinationCidrBlock:     route.DestinationCidrBlock,
-		DestinationIpv6CidrBlock: route.DestinationIpv6CidrBlock,
-		DestinationPrefixListId:  route.DestinationPrefixListId,
-		GatewayId:                route.GatewayId,
-		NatGatewayId:             route.NatGatewayId,
-		RouteTableId:             aws.String(routeTableId),
-	}
-	_, err := c.EC2.CreateRouteWithContext(ctx, input)
+		DestinationCidrBlock:        route.DestinationCidrBlock,
+		DestinationIpv6CidrBlock:    route.DestinationIpv6CidrBlock,
+		DestinationPrefixListId:     route.DestinationPrefixListId,
+		EgressOnlyInternetGatewayId: route.EgressOnlyInternetGatewayId,
+		GatewayId:                   route.GatewayId,
+		NatGatewayId:                route.NatGatewayId,
+		TransitGatewayId:            route.TransitGatewayId,
+		VpcPeeringConnectionId:      route.VpcPeeringConnectionId,
+		CarrierGatewayId:            route.CarrierGatewayId,
+		InstanceId:                  route.InstanceId,
+		RouteTableId:                aws.String(routeTableId),
+	}
+	_, err := c.EC2.CreateRoute(ctx, input)
 	return err
 }
 
@@ -1355,13 +2722,13 @@ func (c *Client) DeleteRoute(ctx context.Context, routeTableId string, route *Ro
 		DestinationPrefixListId:  route.DestinationPrefixListId,
 		RouteTableId:             aws.String(routeTableId),
 	}
-	_, err := c.EC2.DeleteRouteWithContext(ctx, input)
+	_, err := c.EC2.DeleteRoute(ctx, input)
 	return err
 }
 
 // GetRouteTable gets a route table by the identifier.
 func (c *Client) GetRouteTable(ctx context.Context, id string) (*RouteTable, error) {
-	input := &ec2.DescribeRouteTablesInput{RouteTableIds: aws.StringSlice([]string{id})}
+	input := &ec2.DescribeRouteTablesInput{RouteTableIds: []string{id}}
 	output, err := c.describeRouteTables(ctx, input)
 	return single(output, err)
 }
@@ -1372,8 +2739,30 @@ func (c *Client) FindRouteTablesByTags(ctx context.Context, tags Tags) ([]*Route
 	return c.describeRouteTables(ctx, input)
 }
 
+// FindBlackholeRoutesByTags returns the routes in state `blackhole` of the route tables with the given tags by the ID
+// of their route table. Routes are in this state if their target has been deleted, e.g. a NAT gateway.
+func (c *Client) FindBlackholeRoutesByTags(ctx context.Context, tags Tags) (map[string][]*Route, error) {
+	input := &ec2.DescribeRouteTablesInput{Filters: append(tags.ToFilters(), ec2types.Filter{
+		Name:   aws.String("route.state"),
+		Values: []string{string(ec2types.RouteStateBlackhole)},
+	})}
+	output, err := c.EC2.DescribeRouteTables(ctx, input)
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	routes := map[string][]*Route{}
+	for _, item := range output.RouteTables {
+		for _, route := range item.Routes {
+			if route.State == ec2types.RouteStateBlackhole {
+				routes[aws.ToString(item.RouteTableId)] = append(routes[aws.ToString(item.RouteTableId)], fromRoute(route))
+			}
+		}
+	}
+	return routes, nil
+}
+
 func (c *Client) describeRouteTables(ctx context.Context, input *ec2.DescribeRouteTablesInput) ([]*RouteTable, error) {
-	output, err := c.EC2.DescribeRouteTablesWithContext(ctx, input)
+	output, err := c.EC2.DescribeRouteTables(ctx, input)
 	if err != nil {
 		return nil, ignoreNotFound(err)
 	}
@@ -1381,21 +2770,16 @@ func (c *Client) describeRouteTables(ctx context.Context, input *ec2.DescribeRou
 	for _, item := range output.RouteTables {
 		table := &RouteTable{
 			Tags:         FromTags(item.Tags),
-			RouteTableId: aws.StringValue(item.RouteTableId),
+			RouteTableId: aws.ToString(item.RouteTableId),
 			VpcId:        item.VpcId,
 		}
 		for _, route := range item.Routes {
-			table.Routes = append(table.Routes, &Route{
-				DestinationCidrBlock:    route.DestinationCidrBlock,
-				GatewayId:               route.GatewayId,
-				NatGatewayId:            route.NatGatewayId,
-				DestinationPrefixListId: route.DestinationPrefixListId,
-			})
+			table.Routes = append(table.Routes, fromRoute(route))
 		}
 		for _, assoc := range item.Associations {
 			table.Associations = append(table.Associations, &RouteTableAssociation{
-				RouteTableAssociationId: aws.StringValue(assoc.RouteTableAssociationId),
-				Main:                    aws.BoolValue(assoc.Main),
+				RouteTableAssociationId: aws.ToString(assoc.RouteTableAssociationId),
+				Main:                    aws.ToBool(assoc.Main),
 				GatewayId:               assoc.GatewayId,
 				SubnetId:                assoc.SubnetId,
 			})
@@ -1411,7 +2795,7 @@ func (c *Client) DeleteRouteTable(ctx context.Context, id string) error {
 	input := &ec2.DeleteRouteTableInput{
 		RouteTableId: aws.String(id),
 	}
-	_, err := c.EC2.DeleteRouteTableWithContext(ctx, input)
+	_, err := c.EC2.DeleteRouteTable(ctx, input)
 	return ignoreNotFound(err)
 }
 
@@ -1420,13 +2804,14 @@ func (c *Client) CreateSubnet(ctx context.Context, subnet *Subnet) (*Subnet, err
 	input := &ec2.CreateSubnetInput{
 		AvailabilityZone:  aws.String(subnet.AvailabilityZone),
 		CidrBlock:         aws.String(subnet.CidrBlock),
-		TagSpecifications: subnet.ToTagSpecifications(ec2.ResourceTypeSubnet),
+		OutpostArn:        subnet.OutpostArn,
+		TagSpecifications: subnet.ToTagSpecifications(ec2types.ResourceTypeSubnet),
 		VpcId:             subnet.VpcId,
 	}
 	if subnet.Ipv6CidrBlocks != nil && subnet.Ipv6CidrBlocks[0] != "" {
 		input.Ipv6CidrBlock = aws.String(subnet.Ipv6CidrBlocks[0])
 	}
-	output, err := c.EC2.CreateSubnetWithContext(ctx, input)
+	output, err := c.EC2.CreateSubnet(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -1436,7 +2821,7 @@ func (c *Client) CreateSubnet(ctx context.Context, subnet *Subnet) (*Subnet, err
 // GetSubnets gets subnets for the given identifiers.
 // Non-existing identifiers are ignored silently.
 func (c *Client) GetSubnets(ctx context.Context, ids []string) ([]*Subnet, error) {
-	input := &ec2.DescribeSubnetsInput{SubnetIds: aws.StringSlice(ids)}
+	input := &ec2.DescribeSubnetsInput{SubnetIds: ids}
 	return c.describeSubnets(ctx, input)
 }
 
@@ -1446,26 +2831,37 @@ func (c *Client) FindSubnetsByTags(ctx context.Context, tags Tags) ([]*Subnet, e
 	return c.describeSubnets(ctx, input)
 }
 
+// FindSubnetsByVpcId finds all subnet resources of the given VPC.
+func (c *Client) FindSubnetsByVpcId(ctx context.Context, vpcId string) ([]*Subnet, error) {
+	input := &ec2.DescribeSubnetsInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []string{vpcId},
+			},
+		},
+	}
+	return c.describeSubnets(ctx, input)
+}
+
 func (c *Client) describeSubnets(ctx context.Context, input *ec2.DescribeSubnetsInput) ([]*Subnet, error) {
-	output, err := c.EC2.DescribeSubnetsWithContext(ctx, input)
+	output, err := c.EC2.DescribeSubnets(ctx, input)
 	if err != nil {
 		return nil, ignoreNotFound(err)
 	}
 	var subnets []*Subnet
 	for _, item := range output.Subnets {
-		subnets = append(subnets, fromSubnet(item))
+		subnets = append(subnets, fromSubnet(&item))
 	}
 	return subnets, nil
 }
 
 // CheckSubnetIPv6Cidr checks if the subnet has an IPv6 CIDR block assigned
-func (c *Client) CheckSubnetIPv6Cidr(subnetID string) (bool, error) {
+func (c *Client) CheckSubnetIPv6Cidr(ctx context.Context, subnetID string) (bool, error) {
 	input := &ec2.DescribeSubnetsInput{
-		SubnetIds: []*string{
-			aws.String(subnetID),
-		},
+		SubnetIds: []string{subnetID},
 	}
-	output, err := c.EC2.DescribeSubnets(input)
+	output, err := c.EC2.DescribeSubnets(ctx, input)
 	if err != nil {
 		return false, err
 	}
@@ -1474,7 +2870,7 @@ func (c *Client) CheckSubnetIPv6Cidr(subnetID string) (bool, error) {
 	}
 	subnet := output.Subnets[0]
 	for _, cidr := range subnet.Ipv6CidrBlockAssociationSet {
-		if *cidr.Ipv6CidrBlockState.State == "associated" {
+		if cidr.Ipv6CidrBlockState != nil && cidr.Ipv6CidrBlockState.State == ec2types.SubnetCidrBlockStateCodeAssociated {
 			return true, nil
 		}
 	}
@@ -1489,7 +2885,7 @@ func (c *Client) UpdateSubnetAttributes(ctx context.Context, desired, current *S
 			EnableDns64: toAttributeBooleanValue(desired.EnableDns64),
 			SubnetId:    aws.String(current.SubnetId),
 		}
-		if _, err := c.EC2.ModifySubnetAttributeWithContext(ctx, input); err != nil {
+		if _, err := c.EC2.ModifySubnetAttribute(ctx, input); err != nil {
 			return false, fmt.Errorf("updating EnableDns64 failed: %w", err)
 		}
 		modified = true
@@ -1499,7 +2895,7 @@ func (c *Client) UpdateSubnetAttributes(ctx context.Context, desired, current *S
 			EnableResourceNameDnsAAAARecordOnLaunch: toAttributeBooleanValue(desired.EnableResourceNameDnsAAAARecordOnLaunch),
 			SubnetId:                                aws.String(current.SubnetId),
 		}
-		if _, err := c.EC2.ModifySubnetAttributeWithContext(ctx, input); err != nil {
+		if _, err := c.EC2.ModifySubnetAttribute(ctx, input); err != nil {
 			return false, fmt.Errorf("updating EnableResourceNameDnsAAAARecordOnLaunch failed: %w", err)
 		}
 		modified = true
@@ -1509,7 +2905,7 @@ func (c *Client) UpdateSubnetAttributes(ctx context.Context, desired, current *S
 			EnableResourceNameDnsARecordOnLaunch: toAttributeBooleanValue(desired.EnableResourceNameDnsARecordOnLaunch),
 			SubnetId:                             aws.String(current.SubnetId),
 		}
-		if _, err := c.EC2.ModifySubnetAttributeWithContext(ctx, input); err != nil {
+		if _, err := c.EC2.ModifySubnetAttribute(ctx, input); err != nil {
 			return false, fmt.Errorf("updating EnableResourceNameDnsARecordOnLaunch failed: %w", err)
 		}
 		modified = true
@@ -1519,7 +2915,7 @@ func (c *Client) UpdateSubnetAttributes(ctx context.Context, desired, current *S
 			MapCustomerOwnedIpOnLaunch: toAttributeBooleanValue(desired.MapCustomerOwnedIpOnLaunch),
 			SubnetId:                   aws.String(current.SubnetId),
 		}
-		if _, err := c.EC2.ModifySubnetAttributeWithContext(ctx, input); err != nil {
+		if _, err := c.EC2.ModifySubnetAttribute(ctx, input); err != nil {
 			return false, fmt.Errorf("updating MapCustomerOwnedIpOnLaunch failed: %w", err)
 		}
 		modified = true
@@ -1529,13 +2925,13 @@ func (c *Client) UpdateSubnetAttributes(ctx context.Context, desired, current *S
 			MapPublicIpOnLaunch: toAttributeBooleanValue(desired.MapPublicIpOnLaunch),
 			SubnetId:            aws.String(current.SubnetId),
 		}
-		if _, err := c.EC2.ModifySubnetAttributeWithContext(ctx, input); err != nil {
+		if _, err := c.EC2.ModifySubnetAttribute(ctx, input); err != nil {
 			return false, fmt.Errorf("updating MapPublicIpOnLaunch failed: %w", err)
 		}
 		modified = true
 	}
 	if desired.Ipv6CidrBlocks != nil && (current.Ipv6CidrBlocks == nil || current.Ipv6CidrBlocks[0] != desired.Ipv6CidrBlocks[0]) {
-		ipv6CidrBlockAssociated, err := c.CheckSubnetIPv6Cidr(current.SubnetId)
+		ipv6CidrBlockAssociated, err := c.CheckSubnetIPv6Cidr(ctx, current.SubnetId)
 		if err != nil {
 			return modified, err
 		}
@@ -1544,7 +2940,7 @@ func (c *Client) UpdateSubnetAttributes(ctx context.Context, desired, current *S
 				Ipv6CidrBlock: &desired.Ipv6CidrBlocks[0],
 				SubnetId:      aws.String(current.SubnetId),
 			}
-			if _, err := c.EC2.AssociateSubnetCidrBlock(input); err != nil {
+			if _, err := c.EC2.AssociateSubnetCidrBlock(ctx, input); err != nil {
 				return false, fmt.Errorf("IPv6 CIDR block association failed: %w", err)
 			}
 			modified = true
@@ -1556,21 +2952,21 @@ func (c *Client) UpdateSubnetAttributes(ctx context.Context, desired, current *S
 			CustomerOwnedIpv4Pool: desired.CustomerOwnedIpv4Pool,
 			SubnetId:              aws.String(current.SubnetId),
 		}
-		if _, err := c.EC2.ModifySubnetAttributeWithContext(ctx, input); err != nil {
+		if _, err := c.EC2.ModifySubnetAttribute(ctx, input); err != nil {
 			return false, fmt.Errorf("updating CustomerOwnedIpv4Pool failed: %w", err)
 		}
 		modified = true
 	}
 	privateDnsHostnameTypeOnLaunch := desired.PrivateDnsHostnameTypeOnLaunch
 	if privateDnsHostnameTypeOnLaunch == nil {
-		privateDnsHostnameTypeOnLaunch = aws.String(ec2.HostnameTypeIpName)
+		privateDnsHostnameTypeOnLaunch = aws.String(string(ec2types.HostnameTypeIpName))
 	}
 	if !reflect.DeepEqual(current.PrivateDnsHostnameTypeOnLaunch, privateDnsHostnameTypeOnLaunch) {
 		input := &ec2.ModifySubnetAttributeInput{
-			PrivateDnsHostnameTypeOnLaunch: privateDnsHostnameTypeOnLaunch,
+			PrivateDnsHostnameTypeOnLaunch: ec2types.HostnameType(*privateDnsHostnameTypeOnLaunch),
 			SubnetId:                       aws.String(current.SubnetId),
 		}
-		if _, err := c.EC2.ModifySubnetAttributeWithContext(ctx, input); err != nil {
+		if _, err := c.EC2.ModifySubnetAttribute(ctx, input); err != nil {
 			return false, fmt.Errorf("updating PrivateDnsHostnameTypeOnLaunch failed: %w", err)
 		}
 		modified = true
@@ -1586,7 +2982,7 @@ func (c *Client) DeleteSubnet(ctx context.Context, id string) error {
 	}
 	var realErr error
 	err := c.PollImmediateUntil(ctx, func(ctx context.Context) (done bool, err error) {
-		_, realErr = c.EC2.DeleteSubnetWithContext(ctx, input)
+		_, realErr = c.EC2.DeleteSubnet(ctx, input)
 		return ignoreNotFound(realErr) == nil, nil
 	})
 	if err != nil {
@@ -1599,29 +2995,29 @@ func (c *Client) DeleteSubnet(ctx context.Context, id string) error {
 
 // CreateElasticIP creates an EC2 elastip IP resource.
 func (c *Client) CreateElasticIP(ctx context.Context, eip *ElasticIP) (*ElasticIP, error) {
-	domainOpt := ""
+	var domainOpt ec2types.DomainType
 	if eip.Vpc {
-		domainOpt = ec2.DomainTypeVpc
+		domainOpt = ec2types.DomainTypeVpc
 	}
 	input := &ec2.AllocateAddressInput{
-		Domain:            aws.String(domainOpt),
-		TagSpecifications: eip.ToTagSpecifications(ec2.ResourceTypeElasticIp),
+		Domain:            domainOpt,
+		TagSpecifications: eip.ToTagSpecifications(ec2types.ResourceTypeElasticIp),
 	}
-	output, err := c.EC2.AllocateAddressWithContext(ctx, input)
+	output, err := c.EC2.AllocateAddress(ctx, input)
 	if err != nil {
 		return nil, err
 	}
 	return &ElasticIP{
 		Tags:         eip.Tags.Clone(),
 		Vpc:          eip.Vpc,
-		AllocationId: aws.StringValue(output.AllocationId),
-		PublicIp:     aws.StringValue(output.PublicIp),
+		AllocationId: aws.ToString(output.AllocationId),
+		PublicIp:     aws.ToString(output.PublicIp),
 	}, nil
 }
 
 // GetElasticIP gets an elastic IP resource by identifier.
 func (c *Client) GetElasticIP(ctx context.Context, id string) (*ElasticIP, error) {
-	input := &ec2.DescribeAddressesInput{AllocationIds: aws.StringSlice([]string{id})}
+	input := &ec2.DescribeAddressesInput{AllocationIds: []string{id}}
 	output, err := c.describeElasticIPs(ctx, input)
 	return single(output, err)
 }
@@ -1633,13 +3029,13 @@ func (c *Client) FindElasticIPsByTags(ctx context.Context, tags Tags) ([]*Elasti
 }
 
 func (c *Client) describeElasticIPs(ctx context.Context, input *ec2.DescribeAddressesInput) ([]*ElasticIP, error) {
-	output, err := c.EC2.DescribeAddressesWithContext(ctx, input)
+	output, err := c.EC2.DescribeAddresses(ctx, input)
 	if err != nil {
 		return nil, ignoreNotFound(err)
 	}
 	var eips []*ElasticIP
 	for _, item := range output.Addresses {
-		eips = append(eips, fromAddress(item))
+		eips = append(eips, fromAddress(&item))
 	}
 	return eips, nil
 }
@@ -1652,7 +3048,7 @@ func (c *Client) DeleteElasticIP(ctx context.Context, id string) error {
 	}
 	var realErr error
 	err := c.PollImmediateUntil(ctx, func(ctx context.Context) (done bool, err error) {
-		_, realErr = c.EC2.ReleaseAddressWithContext(ctx, input)
+		_, realErr = c.EC2.ReleaseAddress(ctx, input)
 		return ignoreNotFound(realErr) == nil, nil
 	})
 	if err != nil {
@@ -1669,9 +3065,9 @@ func (c *Client) CreateNATGateway(ctx context.Context, gateway *NATGateway) (*NA
 	input := &ec2.CreateNatGatewayInput{
 		AllocationId:      aws.String(gateway.EIPAllocationId),
 		SubnetId:          aws.String(gateway.SubnetId),
-		TagSpecifications: gateway.ToTagSpecifications(ec2.ResourceTypeNatgateway),
+		TagSpecifications: gateway.ToTagSpecifications(ec2types.ResourceTypeNatgateway),
 	}
-	output, err := c.EC2.CreateNatGatewayWithContext(ctx, input)
+	output, err := c.EC2.CreateNatGateway(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -1684,7 +3080,7 @@ func (c *Client) WaitForNATGatewayAvailable(ctx context.Context, id string) erro
 		if item, err := c.GetNATGateway(ctx, id); err != nil {
 			return false, err
 		} else {
-			return strings.EqualFold(item.State, ec2.StateAvailable), nil
+			return strings.EqualFold(item.State, string(ec2types.NatGatewayStateAvailable)), nil
 		}
 	})
 }
@@ -1692,10 +3088,10 @@ func (c *Client) WaitForNATGatewayAvailable(ctx context.Context, id string) erro
 // GetNATGateway gets an NAT gateway by identifier.
 // If the resource is not found or in state "deleted", nil is returned
 func (c *Client) GetNATGateway(ctx context.Context, id string) (*NATGateway, error) {
-	input := &ec2.DescribeNatGatewaysInput{NatGatewayIds: aws.StringSlice([]string{id})}
+	input := &ec2.DescribeNatGatewaysInput{NatGatewayIds: []string{id}}
 	output, err := c.describeNATGateways(ctx, input)
 	gw, err := single(output, err)
-	if gw != nil && strings.EqualFold(gw.State, ec2.StateDeleted) {
+	if gw != nil && strings.EqualFold(gw.State, string(ec2types.NatGatewayStateDeleted)) {
 		return nil, nil
 	}
 	return gw, err
@@ -1708,13 +3104,13 @@ func (c *Client) FindNATGatewaysByTags(ctx context.Context, tags Tags) ([]*NATGa
 }
 
 func (c *Client) describeNATGateways(ctx context.Context, input *ec2.DescribeNatGatewaysInput) ([]*NATGateway, error) {
-	output, err := c.EC2.DescribeNatGatewaysWithContext(ctx, input)
+	output, err := c.EC2.DescribeNatGateways(ctx, input)
 	if err != nil {
 		return nil, ignoreNotFound(err)
 	}
 	var gateways []*NATGateway
 	for _, item := range output.NatGateways {
-		gw := fromNatGateway(item)
+		gw := fromNatGateway(&item)
 		if gw != nil {
 			gateways = append(gateways, gw)
 		}
@@ -1728,7 +3124,7 @@ func (c *Client) DeleteNATGateway(ctx context.Context, id string) error {
 	input := &ec2.DeleteNatGatewayInput{
 		NatGatewayId: aws.String(id),
 	}
-	_, err := c.EC2.DeleteNatGatewayWithContext(ctx, input)
+	_, err := c.EC2.DeleteNatGateway(ctx, input)
 	if err != nil {
 		return ignoreNotFound(err)
 	}
@@ -1742,250 +3138,2060 @@ func (c *Client) DeleteNATGateway(ctx context.Context, id string) error {
 	return ignoreNotFound(err)
 }
 
-// ImportKeyPair creates a EC2 key pair.
-func (c *Client) ImportKeyPair(ctx context.Context, keyName string, publicKey []byte, tags Tags) (*KeyPairInfo, error) {
-	input := &ec2.ImportKeyPairInput{
-		KeyName:           aws.String(keyName),
-		PublicKeyMaterial: publicKey,
-		TagSpecifications: tags.ToTagSpecifications(ec2.ResourceTypeKeyPair),
+// CreateLaunchTemplate creates an EC2 launch template.
+func (c *Client) CreateLaunchTemplate(ctx context.Context, template *LaunchTemplate) (*LaunchTemplate, error) {
+	input := &ec2.CreateLaunchTemplateInput{
+		LaunchTemplateName: aws.String(template.LaunchTemplateName),
+		LaunchTemplateData: toLaunchTemplateData(template),
+		TagSpecifications:  template.ToTagSpecifications(ec2types.ResourceTypeLaunchTemplate),
+	}
+	if _, err := c.EC2.CreateLaunchTemplate(ctx, input); err != nil {
+		return nil, err
+	}
+	return c.GetLaunchTemplate(ctx, template.LaunchTemplateName)
+}
+
+// GetLaunchTemplate gets the latest version of an EC2 launch template by name.
+// If the resource is not found, nil is returned.
+func (c *Client) GetLaunchTemplate(ctx context.Context, name string) (*LaunchTemplate, error) {
+	output, err := c.EC2.DescribeLaunchTemplateVersions(ctx, &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateName: aws.String(name),
+		Versions:           []string{"$Latest"},
+	})
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	if len(output.LaunchTemplateVersions) == 0 {
+		return nil, nil
+	}
+	version := output.LaunchTemplateVersions[0]
+	template := &LaunchTemplate{
+		LaunchTemplateId:    aws.ToString(version.LaunchTemplateId),
+		LaunchTemplateName:  aws.ToString(version.LaunchTemplateName),
+		LatestVersionNumber: aws.ToInt64(version.VersionNumber),
+	}
+	if data := version.LaunchTemplateData; data != nil {
+		template.ImageId = aws.ToString(data.ImageId)
+		template.InstanceType = string(data.InstanceType)
+		for _, ni := range data.NetworkInterfaces {
+			template.SecurityGroupIds = append(template.SecurityGroupIds, ni.Groups...)
+		}
+		if data.IamInstanceProfile != nil {
+			template.IamInstanceProfileName = aws.ToString(data.IamInstanceProfile.Name)
+		}
+		if data.UserData != nil {
+			userData, err := base64.StdEncoding.DecodeString(*data.UserData)
+			if err != nil {
+				return nil, err
+			}
+			template.UserData = string(userData)
+		}
 	}
-	output, err := c.EC2.ImportKeyPairWithContext(ctx, input)
+	return template, nil
+}
+
+// CreateLaunchTemplateVersion creates a new version of an EC2 launch template and makes it the default version.
+func (c *Client) CreateLaunchTemplateVersion(ctx context.Context, template *LaunchTemplate) (*LaunchTemplate, error) {
+	output, err := c.EC2.CreateLaunchTemplateVersion(ctx, &ec2.CreateLaunchTemplateVersionInput{
+		LaunchTemplateName: aws.String(template.LaunchTemplateName),
+		LaunchTemplateData: toLaunchTemplateData(template),
+	})
 	if err != nil {
 		return nil, err
 	}
-	return &KeyPairInfo{
-		Tags:           FromTags(output.Tags),
-		KeyName:        aws.StringValue(output.KeyName),
-		KeyFingerprint: aws.StringValue(output.KeyFingerprint),
-	}, nil
+	if _, err := c.EC2.ModifyLaunchTemplate(ctx, &ec2.ModifyLaunchTemplateInput{
+		LaunchTemplateName: aws.String(template.LaunchTemplateName),
+		DefaultVersion:     aws.String(fmt.Sprintf("%d", aws.ToInt64(output.LaunchTemplateVersion.VersionNumber))),
+	}); err != nil {
+		return nil, err
+	}
+	return c.GetLaunchTemplate(ctx, template.LaunchTemplateName)
 }
 
-// GetKeyPair gets a EC2 key pair by its key name.
-func (c *Client) GetKeyPair(ctx context.Context, keyName string) (*KeyPairInfo, error) {
-	input := &ec2.DescribeKeyPairsInput{KeyNames: aws.StringSlice([]string{keyName})}
-	output, err := c.describeKeyPairs(ctx, input)
-	return single(output, err)
+// DeleteLaunchTemplate deletes an EC2 launch template by name.
+// Returns nil if the resource is not found.
+func (c *Client) DeleteLaunchTemplate(ctx context.Context, name string) error {
+	_, err := c.EC2.DeleteLaunchTemplate(ctx, &ec2.DeleteLaunchTemplateInput{
+		LaunchTemplateName: aws.String(name),
+	})
+	return ignoreNotFound(err)
 }
 
-// FindKeyPairsByTags finds EC key pair resources matching the given tag map.
-func (c *Client) FindKeyPairsByTags(ctx context.Context, tags Tags) ([]*KeyPairInfo, error) {
-	input := &ec2.DescribeKeyPairsInput{Filters: tags.ToFilters()}
-	return c.describeKeyPairs(ctx, input)
+func toLaunchTemplateData(template *LaunchTemplate) *ec2types.RequestLaunchTemplateData {
+	data := &ec2types.RequestLaunchTemplateData{
+		ImageId:      aws.String(template.ImageId),
+		InstanceType: ec2types.InstanceType(template.InstanceType),
+		NetworkInterfaces: []ec2types.LaunchTemplateInstanceNetworkInterfaceSpecificationRequest{
+			{
+				AssociatePublicIpAddress: aws.Bool(true),
+				DeviceIndex:              aws.Int32(0),
+				Groups:                   template.SecurityGroupIds,
+			},
+		},
+		TagSpecifications: []ec2types.LaunchTemplateTagSpecificationRequest{
+			{
+				ResourceType: ec2types.ResourceTypeInstance,
+				Tags:         template.ToEC2Tags(),
+			},
+		},
+	}
+	if template.IamInstanceProfileName != "" {
+		data.IamInstanceProfile = &ec2types.LaunchTemplateIamInstanceProfileSpecificationRequest{
+			Name: aws.String(template.IamInstanceProfileName),
+		}
+	}
+	if template.UserData != "" {
+		data.UserData = aws.String(base64.StdEncoding.EncodeToString([]byte(template.UserData)))
+	}
+	return data
 }
 
-func (c *Client) describeKeyPairs(ctx context.Context, input *ec2.DescribeKeyPairsInput) ([]*KeyPairInfo, error) {
-	output, err := c.EC2.DescribeKeyPairsWithContext(ctx, input)
+// CreateAutoScalingGroup creates an auto scaling group using the given version of the launch template.
+func (c *Client) CreateAutoScalingGroup(ctx context.Context, group *AutoScalingGroup) error {
+	input := &autoscaling.CreateAutoScalingGroupInput{
+		AutoScalingGroupName: aws.String(group.AutoScalingGroupName),
+		LaunchTemplate: &autoscalingtypes.LaunchTemplateSpecification{
+			LaunchTemplateName: aws.String(group.LaunchTemplateName),
+			Version:            aws.String(group.LaunchTemplateVersion),
+		},
+		MinSize:           aws.Int32(int32(group.MinSize)),
+		MaxSize:           aws.Int32(int32(group.MaxSize)),
+		DesiredCapacity:   aws.Int32(int32(group.DesiredCapacity)),
+		VPCZoneIdentifier: aws.String(strings.Join(group.SubnetIds, ",")),
+	}
+	for k, v := range group.Tags {
+		input.Tags = append(input.Tags, autoscalingtypes.Tag{
+			Key:               aws.String(k),
+			Value:             aws.String(v),
+			PropagateAtLaunch: aws.Bool(false),
+		})
+	}
+	_, err := c.AutoScaling.CreateAutoScalingGroup(ctx, input)
+	return err
+}
+
+// GetAutoScalingGroup gets an auto scaling group by name.
+// If the resource is not found, nil is returned.
+func (c *Client) GetAutoScalingGroup(ctx context.Context, name string) (*AutoScalingGroup, error) {
+	output, err := c.AutoScaling.DescribeAutoScalingGroups(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: []string{name},
+	})
 	if err != nil {
 		return nil, ignoreNotFound(err)
 	}
-	var pairs []*KeyPairInfo
-	for _, item := range output.KeyPairs {
-		pairs = append(pairs, fromKeyPairInfo(item))
+	if len(output.AutoScalingGroups) == 0 {
+		return nil, nil
+	}
+	item := output.AutoScalingGroups[0]
+	group := &AutoScalingGroup{
+		Tags:                 Tags{},
+		AutoScalingGroupName: aws.ToString(item.AutoScalingGroupName),
+		MinSize:              int64(aws.ToInt32(item.MinSize)),
+		MaxSize:              int64(aws.ToInt32(item.MaxSize)),
+		DesiredCapacity:      int64(aws.ToInt32(item.DesiredCapacity)),
+		Status:               item.Status,
+	}
+	if item.LaunchTemplate != nil {
+		group.LaunchTemplateName = aws.ToString(item.LaunchTemplate.LaunchTemplateName)
+		group.LaunchTemplateVersion = aws.ToString(item.LaunchTemplate.Version)
+	}
+	if zoneIdentifier := aws.ToString(item.VPCZoneIdentifier); zoneIdentifier != "" {
+		group.SubnetIds = strings.Split(zoneIdentifier, ",")
+	}
+	for _, tag := range item.Tags {
+		group.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	for _, instance := range item.Instances {
+		asgInstance := &AutoScalingGroupInstance{
+			InstanceId:     aws.ToString(instance.InstanceId),
+			LifecycleState: string(instance.LifecycleState),
+			HealthStatus:   aws.ToString(instance.HealthStatus),
+		}
+		if instance.LaunchTemplate != nil {
+			asgInstance.LaunchTemplateVersion = aws.ToString(instance.LaunchTemplate.Version)
+		}
+		group.Instances = append(group.Instances, asgInstance)
+	}
+	return group, nil
+}
+
+// UpdateAutoScalingGroup updates the launch template version and the sizes of an auto scaling group.
+// Running instances are not replaced.
+func (c *Client) UpdateAutoScalingGroup(ctx context.Context, group *AutoScalingGroup) error {
+	_, err := c.AutoScaling.UpdateAutoScalingGroup(ctx, &autoscaling.UpdateAutoScalingGroupInput{
+		AutoScalingGroupName: aws.String(group.AutoScalingGroupName),
+		LaunchTemplate: &autoscalingtypes.LaunchTemplateSpecification{
+			LaunchTemplateName: aws.String(group.LaunchTemplateName),
+			Version:            aws.String(group.LaunchTemplateVersion),
+		},
+		MinSize:         aws.Int32(int32(group.MinSize)),
+		MaxSize:         aws.Int32(int32(group.MaxSize)),
+		DesiredCapacity: aws.Int32(int32(group.DesiredCapacity)),
+	})
+	return err
+}
+
+// WaitForAutoScalingGroupInstanceInService waits until an instance of the auto scaling group launched with the given
+// launch template version is in service and returns its identifier.
+func (c *Client) WaitForAutoScalingGroupInstanceInService(ctx context.Context, name, launchTemplateVersion string) (string, error) {
+	var instanceId string
+	err := c.PollImmediateUntil(ctx, func(ctx context.Context) (done bool, err error) {
+		group, err := c.GetAutoScalingGroup(ctx, name)
+		if err != nil {
+			return false, err
+		}
+		if group == nil {
+			return false, fmt.Errorf("auto scaling group %s not found", name)
+		}
+		for _, instance := range group.Instances {
+			if instance.LifecycleState == string(autoscalingtypes.LifecycleStateInService) && instance.LaunchTemplateVersion == launchTemplateVersion {
+				instanceId = instance.InstanceId
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	return instanceId, err
+}
+
+// TerminateAutoScalingGroupInstance terminates an instance of an auto scaling group without decrementing the desired
+// capacity, i.e. the instance is replaced by the auto scaling group.
+func (c *Client) TerminateAutoScalingGroupInstance(ctx context.Context, instanceId string) error {
+	_, err := c.AutoScaling.TerminateInstanceInAutoScalingGroup(ctx, &autoscaling.TerminateInstanceInAutoScalingGroupInput{
+		InstanceId:                     aws.String(instanceId),
+		ShouldDecrementDesiredCapacity: aws.Bool(false),
+	})
+	return ignoreNotFound(err)
+}
+
+// DeleteAutoScalingGroup deletes an auto scaling group together with its instances and waits until it is gone.
+// Returns nil if the resource is not found.
+func (c *Client) DeleteAutoScalingGroup(ctx context.Context, name string) error {
+	_, err := c.AutoScaling.DeleteAutoScalingGroup(ctx, &autoscaling.DeleteAutoScalingGroupInput{
+		AutoScalingGroupName: aws.String(name),
+		ForceDelete:          aws.Bool(true),
+	})
+	if err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) && apiErr.ErrorCode() == "ValidationError" && strings.Contains(apiErr.ErrorMessage(), "not found") {
+			return nil
+		}
+		return ignoreNotFound(err)
+	}
+	return c.PollUntil(ctx, func(ctx context.Context) (done bool, err error) {
+		group, err := c.GetAutoScalingGroup(ctx, name)
+		if err != nil {
+			return false, err
+		}
+		return group == nil, nil
+	})
+}
+
+// GetInstance gets an EC2 instance by identifier.
+// If the resource is not found or terminated, nil is returned.
+func (c *Client) GetInstance(ctx context.Context, id string) (*Instance, error) {
+	output, err := c.EC2.DescribeInstances(ctx, &ec2.DescribeInstancesInput{InstanceIds: []string{id}})
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	for _, reservation := range output.Reservations {
+		for _, item := range reservation.Instances {
+			instance := fromInstance(&item)
+			if instance.State == string(ec2types.InstanceStateNameTerminated) {
+				return nil, nil
+			}
+			return instance, nil
+		}
+	}
+	return nil, nil
+}
+
+// FindInstancesByTags finds EC2 instances matching the given tag map. Terminated instances are omitted.
+func (c *Client) FindInstancesByTags(ctx context.Context, tags Tags) ([]*Instance, error) {
+	var instances []*Instance
+	paginator := ec2.NewDescribeInstancesPaginator(c.EC2, &ec2.DescribeInstancesInput{Filters: tags.ToFilters()})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, reservation := range page.Reservations {
+			for _, item := range reservation.Instances {
+				if instance := fromInstance(&item); instance.State != string(ec2types.InstanceStateNameTerminated) {
+					instances = append(instances, instance)
+				}
+			}
+		}
+	}
+	return instances, nil
+}
+
+// TerminateInstance terminates an EC2 instance by identifier.
+// Returns nil if the resource is not found.
+func (c *Client) TerminateInstance(ctx context.Context, id string) error {
+	_, err := c.EC2.TerminateInstances(ctx, &ec2.TerminateInstancesInput{InstanceIds: []string{id}})
+	return ignoreNotFound(err)
+}
+
+// FindVolumesByTags finds EBS volumes matching the given tag map.
+func (c *Client) FindVolumesByTags(ctx context.Context, tags Tags) ([]*Volume, error) {
+	var volumes []*Volume
+	paginator := ec2.NewDescribeVolumesPaginator(c.EC2, &ec2.DescribeVolumesInput{Filters: tags.ToFilters()})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range page.Volumes {
+			volumes = append(volumes, &Volume{
+				Tags:       FromTags(item.Tags),
+				VolumeId:   aws.ToString(item.VolumeId),
+				State:      string(item.State),
+				CreateTime: item.CreateTime,
+			})
+		}
+	}
+	return volumes, nil
+}
+
+// DeleteVolume deletes an EBS volume by identifier.
+// Returns nil if the resource is not found.
+func (c *Client) DeleteVolume(ctx context.Context, id string) error {
+	_, err := c.EC2.DeleteVolume(ctx, &ec2.DeleteVolumeInput{VolumeId: aws.String(id)})
+	return ignoreNotFound(err)
+}
+
+// DisableInstanceSourceDestCheck disables the source/destination check of an EC2 instance, which is needed to route
+// traffic through it (e.g. for NAT instances).
+func (c *Client) DisableInstanceSourceDestCheck(ctx context.Context, id string) error {
+	_, err := c.EC2.ModifyInstanceAttribute(ctx, &ec2.ModifyInstanceAttributeInput{
+		InstanceId:      aws.String(id),
+		SourceDestCheck: &ec2types.AttributeBooleanValue{Value: aws.Bool(false)},
+	})
+	return err
+}
+
+// CreateFlowLog creates an EC2 flow log for a VPC.
+func (c *Client) CreateFlowLog(ctx context.Context, flowLog *FlowLog) (*FlowLog, error) {
+	input := &ec2.CreateFlowLogsInput{
+		ResourceIds:              []string{flowLog.ResourceId},
+		ResourceType:             ec2types.FlowLogsResourceTypeVpc,
+		TrafficType:              ec2types.TrafficType(flowLog.TrafficType),
+		LogDestinationType:       ec2types.LogDestinationType(flowLog.LogDestinationType),
+		LogDestination:           aws.String(flowLog.LogDestination),
+		DeliverLogsPermissionArn: flowLog.DeliverLogsPermissionArn,
+		MaxAggregationInterval:   aws.Int32(int32(flowLog.MaxAggregationInterval)),
+		TagSpecifications:        flowLog.ToTagSpecifications(ec2types.ResourceTypeVpcFlowLog),
+	}
+	output, err := c.EC2.CreateFlowLogs(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range output.Unsuccessful {
+		if item.Error != nil {
+			return nil, fmt.Errorf("creating flow log for %s failed: %s (%s)", aws.ToString(item.ResourceId), aws.ToString(item.Error.Message), aws.ToString(item.Error.Code))
+		}
+	}
+	if len(output.FlowLogIds) != 1 {
+		return nil, fmt.Errorf("unexpected number of flow logs created: %d", len(output.FlowLogIds))
+	}
+	created := *flowLog
+	created.FlowLogId = output.FlowLogIds[0]
+	return &created, nil
+}
+
+// GetFlowLog gets an EC2 flow log by identifier.
+// If the resource is not found, nil is returned.
+func (c *Client) GetFlowLog(ctx context.Context, id string) (*FlowLog, error) {
+	input := &ec2.DescribeFlowLogsInput{FlowLogIds: []string{id}}
+	output, err := c.describeFlowLogs(ctx, input)
+	return single(output, err)
+}
+
+// FindFlowLogsByTags finds EC2 flow log resources matching the given tag map.
+func (c *Client) FindFlowLogsByTags(ctx context.Context, tags Tags) ([]*FlowLog, error) {
+	input := &ec2.DescribeFlowLogsInput{Filter: tags.ToFilters()}
+	return c.describeFlowLogs(ctx, input)
+}
+
+func (c *Client) describeFlowLogs(ctx context.Context, input *ec2.DescribeFlowLogsInput) ([]*FlowLog, error) {
+	output, err := c.EC2.DescribeFlowLogs(ctx, input)
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	var flowLogs []*FlowLog
+	for _, item := range output.FlowLogs {
+		flowLogs = append(flowLogs, &FlowLog{
+			Tags:                     FromTags(item.Tags),
+			FlowLogId:                aws.ToString(item.FlowLogId),
+			ResourceId:               aws.ToString(item.ResourceId),
+			TrafficType:              string(item.TrafficType),
+			LogDestinationType:       string(item.LogDestinationType),
+			LogDestination:           aws.ToString(item.LogDestination),
+			DeliverLogsPermissionArn: item.DeliverLogsPermissionArn,
+			MaxAggregationInterval:   int64(aws.ToInt32(item.MaxAggregationInterval)),
+		})
+	}
+	return flowLogs, nil
+}
+
+// DeleteFlowLog deletes an EC2 flow log by identifier.
+// Returns nil if the resource is not found.
+func (c *Client) DeleteFlowLog(ctx context.Context, id string) error {
+	output, err := c.EC2.DeleteFlowLogs(ctx, &ec2.DeleteFlowLogsInput{FlowLogIds: []string{id}})
+	if err != nil {
+		return ignoreNotFound(err)
+	}
+	for _, item := range output.Unsuccessful {
+		if item.Error != nil && !strings.HasSuffix(aws.ToString(item.Error.Code), ".NotFound") {
+			return fmt.Errorf("deleting flow log %s failed: %s (%s)", id, aws.ToString(item.Error.Message), aws.ToString(item.Error.Code))
+		}
+	}
+	return nil
+}
+
+// CreateLogGroup creates a CloudWatch Logs log group.
+func (c *Client) CreateLogGroup(ctx context.Context, group *LogGroup) (*LogGroup, error) {
+	input := &cloudwatchlogs.CreateLogGroupInput{
+		LogGroupName: aws.String(group.LogGroupName),
+		KmsKeyId:     group.KmsKeyId,
+	}
+	if len(group.Tags) > 0 {
+		input.Tags = group.Tags
+	}
+	if _, err := c.CloudWatchLogs.CreateLogGroup(ctx, input); err != nil {
+		return nil, err
+	}
+	if group.RetentionInDays != nil {
+		if err := c.UpdateLogGroupRetention(ctx, group.LogGroupName, group.RetentionInDays); err != nil {
+			return nil, err
+		}
+	}
+	created, err := c.GetLogGroup(ctx, group.LogGroupName)
+	if err != nil {
+		return nil, err
+	}
+	if created == nil {
+		return nil, fmt.Errorf("log group %s not found after creation", group.LogGroupName)
+	}
+	return created, nil
+}
+
+// GetLogGroup gets a CloudWatch Logs log group by name.
+// If the resource is not found, nil is returned.
+func (c *Client) GetLogGroup(ctx context.Context, name string) (*LogGroup, error) {
+	paginator := cloudwatchlogs.NewDescribeLogGroupsPaginator(c.CloudWatchLogs, &cloudwatchlogs.DescribeLogGroupsInput{
+		LogGroupNamePrefix: aws.String(name),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, ignoreNotFound(err)
+		}
+		for _, item := range output.LogGroups {
+			if aws.ToString(item.LogGroupName) == name {
+				return &LogGroup{
+					LogGroupName:    name,
+					Arn:             strings.TrimSuffix(aws.ToString(item.Arn), ":*"),
+					KmsKeyId:        item.KmsKeyId,
+					RetentionInDays: item.RetentionInDays,
+				}, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// UpdateLogGroupKmsKey associates the given KMS key with a CloudWatch Logs log group or disassociates the current one
+// if the key is nil.
+func (c *Client) UpdateLogGroupKmsKey(ctx context.Context, name string, kmsKeyId *string) error {
+	if kmsKeyId == nil {
+		_, err := c.CloudWatchLogs.DisassociateKmsKey(ctx, &cloudwatchlogs.DisassociateKmsKeyInput{LogGroupName: aws.String(name)})
+		return err
+	}
+	_, err := c.CloudWatchLogs.AssociateKmsKey(ctx, &cloudwatchlogs.AssociateKmsKeyInput{
+		LogGroupName: aws.String(name),
+		KmsKeyId:     kmsKeyId,
+	})
+	return err
+}
+
+// UpdateLogGroupRetention sets the retention period of a CloudWatch Logs log group or removes the current one if the
+// period is nil, i.e. the log events never expire.
+func (c *Client) UpdateLogGroupRetention(ctx context.Context, name string, retentionInDays *int32) error {
+	if retentionInDays == nil {
+		_, err := c.CloudWatchLogs.DeleteRetentionPolicy(ctx, &cloudwatchlogs.DeleteRetentionPolicyInput{LogGroupName: aws.String(name)})
+		return err
+	}
+	_, err := c.CloudWatchLogs.PutRetentionPolicy(ctx, &cloudwatchlogs.PutRetentionPolicyInput{
+		LogGroupName:    aws.String(name),
+		RetentionInDays: retentionInDays,
+	})
+	return err
+}
+
+// DeleteLogGroup deletes a CloudWatch Logs log group together with its log streams.
+// Returns nil if the resource is not found.
+func (c *Client) DeleteLogGroup(ctx context.Context, name string) error {
+	_, err := c.CloudWatchLogs.DeleteLogGroup(ctx, &cloudwatchlogs.DeleteLogGroupInput{LogGroupName: aws.String(name)})
+	return ignoreNotFound(err)
+}
+
+// GetTransitGateway gets a transit gateway by identifier.
+// If the resource is not found or in state "deleted", nil is returned.
+func (c *Client) GetTransitGateway(ctx context.Context, id string) (*TransitGateway, error) {
+	input := &ec2.DescribeTransitGatewaysInput{TransitGatewayIds: []string{id}}
+	output, err := c.EC2.DescribeTransitGateways(ctx, input)
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	for _, item := range output.TransitGateways {
+		if strings.EqualFold(string(item.State), string(ec2types.TransitGatewayStateDeleted)) {
+			continue
+		}
+		return &TransitGateway{
+			Tags:             FromTags(item.Tags),
+			TransitGatewayId: aws.ToString(item.TransitGatewayId),
+			OwnerId:          aws.ToString(item.OwnerId),
+			State:            string(item.State),
+		}, nil
+	}
+	return nil, nil
+}
+
+// CreateTransitGatewayVpcAttachment attaches a VPC to a transit gateway.
+// The method does NOT wait until the attachment is available.
+func (c *Client) CreateTransitGatewayVpcAttachment(ctx context.Context, attachment *TransitGatewayVpcAttachment) (*TransitGatewayVpcAttachment, error) {
+	input := &ec2.CreateTransitGatewayVpcAttachmentInput{
+		TransitGatewayId:  aws.String(attachment.TransitGatewayId),
+		VpcId:             aws.String(attachment.VpcId),
+		SubnetIds:         attachment.SubnetIds,
+		TagSpecifications: attachment.ToTagSpecifications(ec2types.ResourceTypeTransitGatewayAttachment),
+	}
+	if attachment.Ipv6Support {
+		input.Options = &ec2types.CreateTransitGatewayVpcAttachmentRequestOptions{
+			Ipv6Support: ec2types.Ipv6SupportValueEnable,
+		}
+	}
+	output, err := c.EC2.CreateTransitGatewayVpcAttachment(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return fromTransitGatewayVpcAttachment(output.TransitGatewayVpcAttachment), nil
+}
+
+// WaitForTransitGatewayVpcAttachmentAvailable waits until the transit gateway VPC attachment has state "available" or the context is cancelled.
+func (c *Client) WaitForTransitGatewayVpcAttachmentAvailable(ctx context.Context, id string) error {
+	return c.PollImmediateUntil(ctx, func(ctx context.Context) (done bool, err error) {
+		item, err := c.GetTransitGatewayVpcAttachment(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		if item == nil {
+			return false, fmt.Errorf("transit gateway VPC attachment %s not found", id)
+		}
+		if strings.EqualFold(item.State, string(ec2types.TransitGatewayAttachmentStateFailed)) || strings.EqualFold(item.State, string(ec2types.TransitGatewayAttachmentStateRejected)) {
+			return false, fmt.Errorf("transit gateway VPC attachment %s is in state %s", id, item.State)
+		}
+		return strings.EqualFold(item.State, string(ec2types.TransitGatewayAttachmentStateAvailable)), nil
+	})
+}
+
+// GetTransitGatewayVpcAttachment gets a transit gateway VPC attachment by identifier.
+// If the resource is not found or in state "deleted", nil is returned.
+func (c *Client) GetTransitGatewayVpcAttachment(ctx context.Context, id string) (*TransitGatewayVpcAttachment, error) {
+	input := &ec2.DescribeTransitGatewayVpcAttachmentsInput{TransitGatewayAttachmentIds: []string{id}}
+	output, err := c.describeTransitGatewayVpcAttachments(ctx, input)
+	return single(output, err)
+}
+
+// FindTransitGatewayVpcAttachmentsByTags finds transit gateway VPC attachment resources matching the given tag map.
+func (c *Client) FindTransitGatewayVpcAttachmentsByTags(ctx context.Context, tags Tags) ([]*TransitGatewayVpcAttachment, error) {
+	input := &ec2.DescribeTransitGatewayVpcAttachmentsInput{Filters: tags.ToFilters()}
+	return c.describeTransitGatewayVpcAttachments(ctx, input)
+}
+
+func (c *Client) describeTransitGatewayVpcAttachments(ctx context.Context, input *ec2.DescribeTransitGatewayVpcAttachmentsInput) ([]*TransitGatewayVpcAttachment, error) {
+	output, err := c.EC2.DescribeTransitGatewayVpcAttachments(ctx, input)
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	var attachments []*TransitGatewayVpcAttachment
+	for _, item := range output.TransitGatewayVpcAttachments {
+		if attachment := fromTransitGatewayVpcAttachment(&item); attachment != nil {
+			attachments = append(attachments, attachment)
+		}
+	}
+	return attachments, nil
+}
+
+// ModifyTransitGatewayVpcAttachmentSubnets adds and removes subnets of a transit gateway VPC attachment.
+func (c *Client) ModifyTransitGatewayVpcAttachmentSubnets(ctx context.Context, id string, addSubnetIds, removeSubnetIds []string) error {
+	input := &ec2.ModifyTransitGatewayVpcAttachmentInput{
+		TransitGatewayAttachmentId: aws.String(id),
+	}
+	if len(addSubnetIds) > 0 {
+		input.AddSubnetIds = addSubnetIds
+	}
+	if len(removeSubnetIds) > 0 {
+		input.RemoveSubnetIds = removeSubnetIds
+	}
+	_, err := c.EC2.ModifyTransitGatewayVpcAttachment(ctx, input)
+	return err
+}
+
+// DeleteTransitGatewayVpcAttachment deletes a transit gateway VPC attachment by identifier and waits until it is gone.
+// Returns nil if the resource is not found.
+func (c *Client) DeleteTransitGatewayVpcAttachment(ctx context.Context, id string) error {
+	input := &ec2.DeleteTransitGatewayVpcAttachmentInput{
+		TransitGatewayAttachmentId: aws.String(id),
+	}
+	_, err := c.EC2.DeleteTransitGatewayVpcAttachment(ctx, input)
+	if err != nil {
+		return ignoreNotFound(err)
+	}
+	err = c.PollUntil(ctx, func(ctx context.Context) (done bool, err error) {
+		if item, err := c.GetTransitGatewayVpcAttachment(ctx, id); err != nil {
+			return false, err
+		} else {
+			return item == nil, nil
+		}
+	})
+	return ignoreNotFound(err)
+}
+
+// CreateVpcPeeringConnection requests a peering connection of a VPC to a peer VPC, which may be owned by another account
+// or be located in another region.
+// The method does NOT wait until the request is pending acceptance.
+func (c *Client) CreateVpcPeeringConnection(ctx context.Context, peering *VpcPeeringConnection) (*VpcPeeringConnection, error) {
+	input := &ec2.CreateVpcPeeringConnectionInput{
+		VpcId:             aws.String(peering.VpcId),
+		PeerVpcId:         aws.String(peering.PeerVpcId),
+		TagSpecifications: peering.ToTagSpecifications(ec2types.ResourceTypeVpcPeeringConnection),
+	}
+	if peering.PeerOwnerId != "" {
+		input.PeerOwnerId = aws.String(peering.PeerOwnerId)
+	}
+	if peering.PeerRegion != "" {
+		input.PeerRegion = aws.String(peering.PeerRegion)
+	}
+	output, err := c.EC2.CreateVpcPeeringConnection(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return fromVpcPeeringConnection(output.VpcPeeringConnection), nil
+}
+
+// AcceptVpcPeeringConnection accepts a VPC peering connection which is pending acceptance. As the request has to be
+// accepted in the region of the peer VPC, the region can be overridden if it differs from the region of the client.
+func (c *Client) AcceptVpcPeeringConnection(ctx context.Context, id, region string) error {
+	input := &ec2.AcceptVpcPeeringConnectionInput{
+		VpcPeeringConnectionId: aws.String(id),
+	}
+	_, err := c.EC2.AcceptVpcPeeringConnection(ctx, input, func(o *ec2.Options) {
+		if region != "" && region != o.Region {
+			// a custom endpoint only applies to the region of the client
+			o.Region = region
+			o.BaseEndpoint = nil
+		}
+	})
+	return err
+}
+
+// WaitForVpcPeeringConnectionState waits until the VPC peering connection has one of the given states or the context
+// is cancelled. It fails if the peering connection has been rejected, has expired or has failed.
+func (c *Client) WaitForVpcPeeringConnectionState(ctx context.Context, id string, states ...string) (*VpcPeeringConnection, error) {
+	var peering *VpcPeeringConnection
+	err := c.PollImmediateUntil(ctx, func(ctx context.Context) (done bool, err error) {
+		peering, err = c.GetVpcPeeringConnection(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		if peering == nil {
+			return false, fmt.Errorf("VPC peering connection %s not found", id)
+		}
+		for _, state := range states {
+			if strings.EqualFold(peering.State, state) {
+				return true, nil
+			}
+		}
+		switch ec2types.VpcPeeringConnectionStateReasonCode(peering.State) {
+		case ec2types.VpcPeeringConnectionStateReasonCodeRejected, ec2types.VpcPeeringConnectionStateReasonCodeExpired,
+			ec2types.VpcPeeringConnectionStateReasonCodeFailed, ec2types.VpcPeeringConnectionStateReasonCodeDeleting:
+			return false, fmt.Errorf("VPC peering connection %s is in state %s", id, peering.State)
+		}
+		return false, nil
+	})
+	return peering, err
+}
+
+// GetVpcPeeringConnection gets a VPC peering connection by identifier.
+// If the resource is not found or in state "deleted", nil is returned.
+func (c *Client) GetVpcPeeringConnection(ctx context.Context, id string) (*VpcPeeringConnection, error) {
+	input := &ec2.DescribeVpcPeeringConnectionsInput{VpcPeeringConnectionIds: []string{id}}
+	output, err := c.describeVpcPeeringConnections(ctx, input)
+	return single(output, err)
+}
+
+// FindVpcPeeringConnectionsByTags finds VPC peering connection resources matching the given tag map.
+func (c *Client) FindVpcPeeringConnectionsByTags(ctx context.Context, tags Tags) ([]*VpcPeeringConnection, error) {
+	input := &ec2.DescribeVpcPeeringConnectionsInput{Filters: tags.ToFilters()}
+	return c.describeVpcPeeringConnections(ctx, input)
+}
+
+func (c *Client) describeVpcPeeringConnections(ctx context.Context, input *ec2.DescribeVpcPeeringConnectionsInput) ([]*VpcPeeringConnection, error) {
+	output, err := c.EC2.DescribeVpcPeeringConnections(ctx, input)
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	var peerings []*VpcPeeringConnection
+	for _, item := range output.VpcPeeringConnections {
+		if peering := fromVpcPeeringConnection(&item); peering != nil {
+			peerings = append(peerings, peering)
+		}
+	}
+	return peerings, nil
+}
+
+// DeleteVpcPeeringConnection deletes a VPC peering connection by identifier. Requests which are pending acceptance
+// are withdrawn.
+// Returns nil if the resource is not found.
+func (c *Client) DeleteVpcPeeringConnection(ctx context.Context, id string) error {
+	input := &ec2.DeleteVpcPeeringConnectionInput{
+		VpcPeeringConnectionId: aws.String(id),
+	}
+	_, err := c.EC2.DeleteVpcPeeringConnection(ctx, input)
+	return ignoreNotFound(err)
+}
+
+// ImportKeyPair creates a EC2 key pair.
+func (c *Client) ImportKeyPair(ctx context.Context, keyName string, publicKey []byte, tags Tags) (*KeyPairInfo, error) {
+	input := &ec2.ImportKeyPairInput{
+		KeyName:           aws.String(keyName),
+		PublicKeyMaterial: publicKey,
+		TagSpecifications: tags.ToTagSpecifications(ec2types.ResourceTypeKeyPair),
+	}
+	output, err := c.EC2.ImportKeyPair(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return &KeyPairInfo{
+		Tags:           FromTags(output.Tags),
+		KeyName:        aws.ToString(output.KeyName),
+		KeyFingerprint: aws.ToString(output.KeyFingerprint),
+	}, nil
+}
+
+// GetKeyPair gets a EC2 key pair by its key name.
+func (c *Client) GetKeyPair(ctx context.Context, keyName string) (*KeyPairInfo, error) {
+	input := &ec2.DescribeKeyPairsInput{KeyNames: []string{keyName}}
+	output, err := c.describeKeyPairs(ctx, input)
+	return single(output, err)
+}
+
+// FindKeyPairsByTags finds EC key pair resources matching the given tag map.
+func (c *Client) FindKeyPairsByTags(ctx context.Context, tags Tags) ([]*KeyPairInfo, error) {
+	input := &ec2.DescribeKeyPairsInput{Filters: tags.ToFilters()}
+	return c.describeKeyPairs(ctx, input)
+}
+
+func (c *Client) describeKeyPairs(ctx context.Context, input *ec2.DescribeKeyPairsInput) ([]*KeyPairInfo, error) {
+	output, err := c.EC2.DescribeKeyPairs(ctx, input)
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	var pairs []*KeyPairInfo
+	for _, item := range output.KeyPairs {
+		pairs = append(pairs, fromKeyPairInfo(&item))
+	}
+	return pairs, nil
+}
+
+// DeleteKeyPair deletes an EC2 key pair given by the key name.
+// Returns nil if resource is not found.
+func (c *Client) DeleteKeyPair(ctx context.Context, keyName string) error {
+	input := &ec2.DeleteKeyPairInput{
+		KeyName: aws.String(keyName),
+	}
+	_, err := c.EC2.DeleteKeyPair(ctx, input)
+	return ignoreNotFound(err)
+}
+
+// GetSerialConsoleAccessStatus returns whether the access to the EC2 serial console is enabled for the account in the
+// region of the client.
+func (c *Client) GetSerialConsoleAccessStatus(ctx context.Context) (bool, error) {
+	output, err := c.EC2.GetSerialConsoleAccessStatus(ctx, &ec2.GetSerialConsoleAccessStatusInput{})
+	if err != nil {
+		return false, err
+	}
+	return aws.ToBool(output.SerialConsoleAccessEnabled), nil
+}
+
+// EnableSerialConsoleAccess enables the access to the EC2 serial console for the account in the region of the client.
+func (c *Client) EnableSerialConsoleAccess(ctx context.Context) error {
+	_, err := c.EC2.EnableSerialConsoleAccess(ctx, &ec2.EnableSerialConsoleAccessInput{})
+	return err
+}
+
+// CreatePlacementGroup creates an EC2 placement group.
+func (c *Client) CreatePlacementGroup(ctx context.Context, group *PlacementGroup) (*PlacementGroup, error) {
+	input := &ec2.CreatePlacementGroupInput{
+		GroupName:         aws.String(group.GroupName),
+		Strategy:          ec2types.PlacementStrategy(group.Strategy),
+		PartitionCount:    toInt32Ptr(group.PartitionCount),
+		TagSpecifications: group.ToTagSpecifications(ec2types.ResourceTypePlacementGroup),
+	}
+	output, err := c.EC2.CreatePlacementGroup(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return fromPlacementGroup(output.PlacementGroup), nil
+}
+
+// GetPlacementGroup gets an EC2 placement group by its name.
+// If the resource is not found, nil is returned.
+func (c *Client) GetPlacementGroup(ctx context.Context, name string) (*PlacementGroup, error) {
+	input := &ec2.DescribePlacementGroupsInput{GroupNames: []string{name}}
+	output, err := c.describePlacementGroups(ctx, input)
+	return single(output, err)
+}
+
+// FindPlacementGroupsByTags finds EC2 placement group resources matching the given tag map.
+func (c *Client) FindPlacementGroupsByTags(ctx context.Context, tags Tags) ([]*PlacementGroup, error) {
+	input := &ec2.DescribePlacementGroupsInput{Filters: tags.ToFilters()}
+	return c.describePlacementGroups(ctx, input)
+}
+
+func (c *Client) describePlacementGroups(ctx context.Context, input *ec2.DescribePlacementGroupsInput) ([]*PlacementGroup, error) {
+	output, err := c.EC2.DescribePlacementGroups(ctx, input)
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	var groups []*PlacementGroup
+	for _, item := range output.PlacementGroups {
+		if item.State == ec2types.PlacementGroupStateDeleting || item.State == ec2types.PlacementGroupStateDeleted {
+			continue
+		}
+		groups = append(groups, fromPlacementGroup(&item))
+	}
+	return groups, nil
+}
+
+// DeletePlacementGroup deletes an EC2 placement group given by its name.
+// Returns nil if the resource is not found.
+func (c *Client) DeletePlacementGroup(ctx context.Context, name string) error {
+	input := &ec2.DeletePlacementGroupInput{
+		GroupName: aws.String(name),
+	}
+	_, err := c.EC2.DeletePlacementGroup(ctx, input)
+	return ignoreNotFound(err)
+}
+
+// GetKMSKey gets a KMS key by its id, ARN, alias name or alias ARN.
+// If the resource is not found, nil is returned.
+func (c *Client) GetKMSKey(ctx context.Context, keyID string) (*KMSKey, error) {
+	output, err := c.KMS.DescribeKey(ctx, &kms.DescribeKeyInput{KeyId: aws.String(keyID)})
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	metadata := output.KeyMetadata
+	key := &KMSKey{
+		KeyId:    aws.ToString(metadata.KeyId),
+		Arn:      aws.ToString(metadata.Arn),
+		KeyState: string(metadata.KeyState),
+		KeyUsage: string(metadata.KeyUsage),
+		KeySpec:  string(metadata.KeySpec),
+	}
+	if keyARN, err := arn.Parse(key.Arn); err == nil {
+		key.Region = keyARN.Region
+	}
+	return key, nil
+}
+
+// sourceImageTagKey is the key of the tag of AMI copies containing the region and the ID of the source AMI.
+const sourceImageTagKey = "gardener.cloud/source-image"
+
+// FindImageCopy finds the copy of the given source AMI in the region of the client, which has been created by CopyImage.
+// If there are several copies, available ones are preferred over pending ones. It returns nil if there is no copy.
+func (c *Client) FindImageCopy(ctx context.Context, sourceRegion, sourceImageID string) (*Image, error) {
+	output, err := c.EC2.DescribeImages(ctx, &ec2.DescribeImagesInput{
+		Owners: []string{"self"},
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("tag:" + sourceImageTagKey),
+				Values: []string{sourceRegion + "/" + sourceImageID},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rank := func(state ec2types.ImageState) int {
+		switch state {
+		case ec2types.ImageStateAvailable:
+			return 2
+		case ec2types.ImageStatePending:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	var image *Image
+	for _, item := range output.Images {
+		if image != nil && rank(ec2types.ImageState(image.State)) >= rank(item.State) {
+			continue
+		}
+		image = &Image{
+			ImageId: aws.ToString(item.ImageId),
+			State:   string(item.State),
+		}
+		if item.StateReason != nil {
+			image.StateReason = aws.ToString(item.StateReason.Message)
+		}
+	}
+	return image, nil
+}
+
+// CopyImage starts copying the given source AMI to the region of the client and returns the ID of the copy. The
+// snapshots of the copy are encrypted with the given KMS key, or the default KMS key for EBS encryption of the account.
+// The copy is tagged, so that it can be found with FindImageCopy.
+func (c *Client) CopyImage(ctx context.Context, sourceRegion, sourceImageID, name string, kmsKeyID *string) (string, error) {
+	output, err := c.EC2.CopyImage(ctx, &ec2.CopyImageInput{
+		SourceRegion:  aws.String(sourceRegion),
+		SourceImageId: aws.String(sourceImageID),
+		Name:          aws.String(name),
+		Description:   aws.String(fmt.Sprintf("Copy of %s from %s", sourceImageID, sourceRegion)),
+		Encrypted:     aws.Bool(true),
+		KmsKeyId:      kmsKeyID,
+		TagSpecifications: []ec2types.TagSpecification{
+			{
+				ResourceType: ec2types.ResourceTypeImage,
+				Tags:         []ec2types.Tag{{Key: aws.String(sourceImageTagKey), Value: aws.String(sourceRegion + "/" + sourceImageID)}},
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	return aws.ToString(output.ImageId), nil
+}
+
+// FindNewestImage returns the ID of the newest available AMI of the given owners which matches the given name pattern,
+// tags and architecture (`amd64` or `arm64`). The name pattern may contain the wildcards `*` and `?`. It returns an
+// empty string if no AMI matches.
+func (c *Client) FindNewestImage(ctx context.Context, owners []string, name *string, tags map[string]string, architecture string) (string, error) {
+	filters := []ec2types.Filter{
+		{Name: aws.String("state"), Values: []string{string(ec2types.ImageStateAvailable)}},
+	}
+	switch architecture {
+	case v1beta1constants.ArchitectureAMD64:
+		filters = append(filters, ec2types.Filter{Name: aws.String("architecture"), Values: []string{string(ec2types.ArchitectureValuesX8664)}})
+	case v1beta1constants.ArchitectureARM64:
+		filters = append(filters, ec2types.Filter{Name: aws.String("architecture"), Values: []string{string(ec2types.ArchitectureValuesArm64)}})
+	default:
+		return "", fmt.Errorf("unsupported architecture %q", architecture)
+	}
+	if name != nil {
+		filters = append(filters, ec2types.Filter{Name: aws.String("name"), Values: []string{*name}})
+	}
+	for key, value := range tags {
+		filters = append(filters, ec2types.Filter{Name: aws.String("tag:" + key), Values: []string{value}})
+	}
+
+	var (
+		imageID      string
+		creationDate time.Time
+	)
+	paginator := ec2.NewDescribeImagesPaginator(c.EC2, &ec2.DescribeImagesInput{
+		Owners:  owners,
+		Filters: filters,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return "", err
+		}
+		for _, image := range output.Images {
+			created, err := time.Parse(time.RFC3339, aws.ToString(image.CreationDate))
+			if err != nil {
+				continue
+			}
+			if imageID == "" || created.After(creationDate) {
+				imageID, creationDate = aws.ToString(image.ImageId), created
+			}
+		}
+	}
+	return imageID, nil
+}
+
+// CreateRouteTableAssociation associates a route table with a subnet.
+// Returns association id and error.
+func (c *Client) CreateRouteTableAssociation(ctx context.Context, routeTableId, subnetId string) (*string, error) {
+	input := &ec2.AssociateRouteTableInput{
+		RouteTableId: aws.String(routeTableId),
+		SubnetId:     aws.String(subnetId),
+	}
+	output, err := c.EC2.AssociateRouteTable(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return output.AssociationId, nil
+}
+
+// DeleteRouteTableAssociation deletes the route table association by the assocation identifier.
+// Returns nil if the resource is not found.
+func (c *Client) DeleteRouteTableAssociation(ctx context.Context, associationId string) error {
+	input := &ec2.DisassociateRouteTableInput{
+		AssociationId: aws.String(associationId),
+	}
+	_, err := c.EC2.DisassociateRouteTable(ctx, input)
+	return ignoreNotFound(err)
+}
+
+// CreateNetworkAcl creates a network ACL without any rules besides the ones denying all traffic.
+func (c *Client) CreateNetworkAcl(ctx context.Context, acl *NetworkAcl) (*NetworkAcl, error) {
+	input := &ec2.CreateNetworkAclInput{
+		TagSpecifications: acl.ToTagSpecifications(ec2types.ResourceTypeNetworkAcl),
+		VpcId:             acl.VpcId,
+	}
+	output, err := c.EC2.CreateNetworkAcl(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return fromNetworkAcl(output.NetworkAcl), nil
+}
+
+// GetNetworkAcl gets a network ACL by identifier.
+func (c *Client) GetNetworkAcl(ctx context.Context, id string) (*NetworkAcl, error) {
+	input := &ec2.DescribeNetworkAclsInput{NetworkAclIds: []string{id}}
+	output, err := c.describeNetworkAcls(ctx, input)
+	return single(output, err)
+}
+
+// FindNetworkAclsByTags finds network ACLs matching the given tag map.
+func (c *Client) FindNetworkAclsByTags(ctx context.Context, tags Tags) ([]*NetworkAcl, error) {
+	input := &ec2.DescribeNetworkAclsInput{Filters: tags.ToFilters()}
+	return c.describeNetworkAcls(ctx, input)
+}
+
+// FindDefaultNetworkAclByVpcId finds the default network ACL of the given VPC identifier.
+func (c *Client) FindDefaultNetworkAclByVpcId(ctx context.Context, vpcId string) (*NetworkAcl, error) {
+	input := &ec2.DescribeNetworkAclsInput{
+		Filters: []ec2types.Filter{
+			{Name: aws.String("vpc-id"), Values: []string{vpcId}},
+			{Name: aws.String("default"), Values: []string{"true"}},
+		},
+	}
+	output, err := c.describeNetworkAcls(ctx, input)
+	return single(output, err)
+}
+
+// FindNetworkAclAssociationBySubnetId finds the association of the given subnet with its network ACL.
+// Returns nil, if the subnet is not found.
+func (c *Client) FindNetworkAclAssociationBySubnetId(ctx context.Context, subnetId string) (*NetworkAclAssociation, error) {
+	input := &ec2.DescribeNetworkAclsInput{
+		Filters: []ec2types.Filter{
+			{Name: aws.String("association.subnet-id"), Values: []string{subnetId}},
+		},
+	}
+	acls, err := c.describeNetworkAcls(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	for _, acl := range acls {
+		for _, assoc := range acl.Associations {
+			if assoc.SubnetId == subnetId {
+				return assoc, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (c *Client) describeNetworkAcls(ctx context.Context, input *ec2.DescribeNetworkAclsInput) ([]*NetworkAcl, error) {
+	output, err := c.EC2.DescribeNetworkAcls(ctx, input)
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	var acls []*NetworkAcl
+	for _, item := range output.NetworkAcls {
+		acls = append(acls, fromNetworkAcl(&item))
+	}
+	return acls, nil
+}
+
+// ReplaceNetworkAclAssociation associates the subnet of the given association with another network ACL.
+func (c *Client) ReplaceNetworkAclAssociation(ctx context.Context, associationId, networkAclId string) error {
+	input := &ec2.ReplaceNetworkAclAssociationInput{
+		AssociationId: aws.String(associationId),
+		NetworkAclId:  aws.String(networkAclId),
+	}
+	_, err := c.EC2.ReplaceNetworkAclAssociation(ctx, input)
+	return err
+}
+
+// CreateNetworkAclEntry creates a rule of a network ACL.
+func (c *Client) CreateNetworkAclEntry(ctx context.Context, networkAclId string, entry *NetworkAclEntry) error {
+	input := &ec2.CreateNetworkAclEntryInput{
+		NetworkAclId:  aws.String(networkAclId),
+		RuleNumber:    aws.Int32(entry.RuleNumber),
+		Egress:        aws.Bool(entry.Egress),
+		Protocol:      aws.String(entry.Protocol),
+		RuleAction:    ec2types.RuleAction(entry.RuleAction),
+		CidrBlock:     entry.CidrBlock,
+		Ipv6CidrBlock: entry.Ipv6CidrBlock,
+		PortRange:     toPortRange(entry),
+		IcmpTypeCode:  toIcmpTypeCode(entry),
+	}
+	_, err := c.EC2.CreateNetworkAclEntry(ctx, input)
+	return err
+}
+
+// ReplaceNetworkAclEntry replaces the rule of a network ACL with the same rule number and direction.
+func (c *Client) ReplaceNetworkAclEntry(ctx context.Context, networkAclId string, entry *NetworkAclEntry) error {
+	input := &ec2.ReplaceNetworkAclEntryInput{
+		NetworkAclId:  aws.String(networkAclId),
+		RuleNumber:    aws.Int32(entry.RuleNumber),
+		Egress:        aws.Bool(entry.Egress),
+		Protocol:      aws.String(entry.Protocol),
+		RuleAction:    ec2types.RuleAction(entry.RuleAction),
+		CidrBlock:     entry.CidrBlock,
+		Ipv6CidrBlock: entry.Ipv6CidrBlock,
+		PortRange:     toPortRange(entry),
+		IcmpTypeCode:  toIcmpTypeCode(entry),
+	}
+	_, err := c.EC2.ReplaceNetworkAclEntry(ctx, input)
+	return err
+}
+
+// DeleteNetworkAclEntry deletes the rule of a network ACL with the rule number and direction of the given entry.
+// Returns nil, if the resource is not found.
+func (c *Client) DeleteNetworkAclEntry(ctx context.Context, networkAclId string, entry *NetworkAclEntry) error {
+	input := &ec2.DeleteNetworkAclEntryInput{
+		NetworkAclId: aws.String(networkAclId),
+		RuleNumber:   aws.Int32(entry.RuleNumber),
+		Egress:       aws.Bool(entry.Egress),
+	}
+	_, err := c.EC2.DeleteNetworkAclEntry(ctx, input)
+	return ignoreNotFound(err)
+}
+
+// DeleteNetworkAcl deletes a network ACL by identifier.
+// Returns nil, if the resource is not found.
+func (c *Client) DeleteNetworkAcl(ctx context.Context, id string) error {
+	_, err := c.EC2.DeleteNetworkAcl(ctx, &ec2.DeleteNetworkAclInput{NetworkAclId: aws.String(id)})
+	return ignoreNotFound(err)
+}
+
+// CreateIAMRole creates an IAM role resource.
+func (c *Client) CreateIAMRole(ctx context.Context, role *IAMRole) (*IAMRole, error) {
+	input := &iam.CreateRoleInput{
+		AssumeRolePolicyDocument: aws.String(role.AssumeRolePolicyDocument),
+		Path:                     aws.String(role.Path),
+		RoleName:                 aws.String(role.RoleName),
+	}
+	output, err := c.IAM.CreateRole(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return fromIAMRole(output.Role), nil
+}
+
+// GetIAMRole gets an IAM role by role name.
+func (c *Client) GetIAMRole(ctx context.Context, roleName string) (*IAMRole, error) {
+	input := &iam.GetRoleInput{
+		RoleName: aws.String(roleName),
+	}
+	output, err := c.IAM.GetRole(ctx, input)
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	return fromIAMRole(output.Role), nil
+}
+
+// DeleteIAMRole deletes an IAM role by role name.
+// Returns nil if the resource is not found.
+func (c *Client) DeleteIAMRole(ctx context.Context, roleName string) error {
+	input := &iam.DeleteRoleInput{
+		RoleName: aws.String(roleName),
+	}
+	_, err := c.IAM.DeleteRole(ctx, input)
+	return ignoreNotFound(err)
+}
+
+// UpdateAssumeRolePolicy updates the assumeRolePolicy of an IAM role.
+func (c *Client) UpdateAssumeRolePolicy(ctx context.Context, roleName, assumeRolePolicy string) error {
+	input := &iam.UpdateAssumeRolePolicyInput{
+		RoleName:       aws.String(roleName),
+		PolicyDocument: aws.String(assumeRolePolicy),
+	}
+	_, err := c.IAM.UpdateAssumeRolePolicy(ctx, input)
+	return err
+}
+
+// CreateIAMInstanceProfile creates an IAM instance profile.
+func (c *Client) CreateIAMInstanceProfile(ctx context.Context, profile *IAMInstanceProfile) (*IAMInstanceProfile, error) {
+	input := &iam.CreateInstanceProfileInput{
+		InstanceProfileName: aws.String(profile.InstanceProfileName),
+		Path:                aws.String(profile.Path),
+	}
+	output, err := c.IAM.CreateInstanceProfile(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	profileName := aws.ToString(output.InstanceProfile.InstanceProfileName)
+	if err = c.PollImmediateUntil(ctx, func(ctx context.Context) (done bool, err error) {
+		if item, err := c.GetIAMInstanceProfile(ctx, profileName); err != nil {
+			return false, err
+		} else {
+			return item != nil, nil
+		}
+	}); err != nil {
+		return nil, err
+	}
+	return c.GetIAMInstanceProfile(ctx, profileName)
+}
+
+// GetIAMInstanceProfile gets an IAM instance profile by profile name.
+func (c *Client) GetIAMInstanceProfile(ctx context.Context, profileName string) (*IAMInstanceProfile, error) {
+	input := &iam.GetInstanceProfileInput{
+		InstanceProfileName: aws.String(profileName),
+	}
+	output, err := c.IAM.GetInstanceProfile(ctx, input)
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	return fromIAMInstanceProfile(output.InstanceProfile), nil
+}
+
+// AddRoleToIAMInstanceProfile adds a role to an instance profile.
+func (c *Client) AddRoleToIAMInstanceProfile(ctx context.Context, profileName, roleName string) error {
+	input := &iam.AddRoleToInstanceProfileInput{
+		InstanceProfileName: aws.String(profileName),
+		RoleName:            aws.String(roleName),
+	}
+	_, err := c.IAM.AddRoleToInstanceProfile(ctx, input)
+	return err
+}
+
+// RemoveRoleFromIAMInstanceProfile removes a role from an instance profile.
+func (c *Client) RemoveRoleFromIAMInstanceProfile(ctx context.Context, profileName, roleName string) error {
+	input := &iam.RemoveRoleFromInstanceProfileInput{
+		InstanceProfileName: aws.String(profileName),
+		RoleName:            aws.String(roleName),
+	}
+	_, err := c.IAM.RemoveRoleFromInstanceProfile(ctx, input)
+	return ignoreNotFound(err)
+}
+
+// DeleteIAMInstanceProfile deletes an IAM instance profile by profile name.
+// Returns nil if the resource is not found.
+func (c *Client) DeleteIAMInstanceProfile(ctx context.Context, profileName string) error {
+	input := &iam.DeleteInstanceProfileInput{
+		InstanceProfileName: aws.String(profileName),
+	}
+	_, err := c.IAM.DeleteInstanceProfile(ctx, input)
+	return ignoreNotFound(err)
+}
+
+// PutIAMRolePolicy creates or updates an IAM role policy.
+func (c *Client) PutIAMRolePolicy(ctx context.Context, policy *IAMRolePolicy) error {
+	input := &iam.PutRolePolicyInput{
+		PolicyDocument: aws.String(policy.PolicyDocument),
+		PolicyName:     aws.String(policy.PolicyName),
+		RoleName:       aws.String(policy.RoleName),
+	}
+	_, err := c.IAM.PutRolePolicy(ctx, input)
+	return err
+}
+
+// GetIAMRolePolicy gets an IAM role policy by policy name and role name.
+func (c *Client) GetIAMRolePolicy(ctx context.Context, policyName, roleName string) (*IAMRolePolicy, error) {
+	input := &iam.GetRolePolicyInput{
+		PolicyName: aws.String(policyName),
+		RoleName:   aws.String(roleName),
+	}
+	output, err := c.IAM.GetRolePolicy(ctx, input)
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	return &IAMRolePolicy{
+		PolicyName:     aws.ToString(output.PolicyName),
+		RoleName:       aws.ToString(output.RoleName),
+		PolicyDocument: aws.ToString(output.PolicyDocument),
+	}, nil
+}
+
+// DeleteIAMRolePolicy deletes an IAM role policy by policy name and role name.
+// Returns nil if the resource is not found.
+func (c *Client) DeleteIAMRolePolicy(ctx context.Context, policyName, roleName string) error {
+	input := &iam.DeleteRolePolicyInput{
+		PolicyName: aws.String(policyName),
+		RoleName:   aws.String(roleName),
+	}
+	_, err := c.IAM.DeleteRolePolicy(ctx, input)
+	return ignoreNotFound(err)
+}
+
+// GetIAMDeniedActions simulates the policies of the given principal (e.g. a role) for the given actions and returns
+// those actions which are not allowed.
+func (c *Client) GetIAMDeniedActions(ctx context.Context, principalARN string, actions []string) ([]string, error) {
+	input := &iam.SimulatePrincipalPolicyInput{
+		PolicySourceArn: aws.String(principalARN),
+		ActionNames:     actions,
+	}
+	var denied []string
+	paginator := iam.NewSimulatePrincipalPolicyPaginator(c.IAM, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, result := range output.EvaluationResults {
+			if result.EvalDecision != iamtypes.PolicyEvaluationDecisionTypeAllowed {
+				denied = append(denied, aws.ToString(result.EvalActionName))
+			}
+		}
+	}
+	return denied, nil
+}
+
+// CreateOpenIDConnectProvider creates an IAM OpenID Connect provider.
+func (c *Client) CreateOpenIDConnectProvider(ctx context.Context, provider *OpenIDConnectProvider) (*OpenIDConnectProvider, error) {
+	input := &iam.CreateOpenIDConnectProviderInput{
+		Url:            aws.String(provider.URL),
+		ClientIDList:   provider.ClientIDs,
+		ThumbprintList: provider.Thumbprints,
+	}
+	for k, v := range provider.Tags {
+		input.Tags = append(input.Tags, iamtypes.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	output, err := c.IAM.CreateOpenIDConnectProvider(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	created := *provider
+	created.ARN = aws.ToString(output.OpenIDConnectProviderArn)
+	return &created, nil
+}
+
+// FindOpenIDConnectProviderByURL finds the IAM OpenID Connect provider for the given issuer URL.
+// Returns nil if the provider is not found.
+func (c *Client) FindOpenIDConnectProviderByURL(ctx context.Context, url string) (*OpenIDConnectProvider, error) {
+	output, err := c.IAM.ListOpenIDConnectProviders(ctx, &iam.ListOpenIDConnectProvidersInput{})
+	if err != nil {
+		return nil, err
+	}
+	// The ARN of a provider ends with the URL of the issuer without scheme.
+	suffix := ":oidc-provider/" + strings.TrimSuffix(strings.TrimPrefix(url, "https://"), "/")
+	for _, item := range output.OpenIDConnectProviderList {
+		if arn := aws.ToString(item.Arn); strings.HasSuffix(arn, suffix) {
+			return c.GetOpenIDConnectProvider(ctx, arn)
+		}
+	}
+	return nil, nil
+}
+
+// GetOpenIDConnectProvider gets the IAM OpenID Connect provider with the given ARN including its client IDs and tags.
+// Returns nil if the provider is not found.
+func (c *Client) GetOpenIDConnectProvider(ctx context.Context, arn string) (*OpenIDConnectProvider, error) {
+	output, err := c.IAM.GetOpenIDConnectProvider(ctx, &iam.GetOpenIDConnectProviderInput{
+		OpenIDConnectProviderArn: aws.String(arn),
+	})
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	provider := &OpenIDConnectProvider{
+		Tags:        Tags{},
+		ARN:         arn,
+		URL:         "https://" + aws.ToString(output.Url),
+		ClientIDs:   output.ClientIDList,
+		Thumbprints: output.ThumbprintList,
+	}
+	for _, tag := range output.Tags {
+		provider.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	return provider, nil
+}
+
+// AddClientIDToOpenIDConnectProvider adds the given client ID (audience) to the IAM OpenID Connect provider with the
+// given ARN.
+func (c *Client) AddClientIDToOpenIDConnectProvider(ctx context.Context, arn, clientID string) error {
+	_, err := c.IAM.AddClientIDToOpenIDConnectProvider(ctx, &iam.AddClientIDToOpenIDConnectProviderInput{
+		OpenIDConnectProviderArn: aws.String(arn),
+		ClientID:                 aws.String(clientID),
+	})
+	return err
+}
+
+// DeleteOpenIDConnectProvider deletes an IAM OpenID Connect provider by its ARN.
+// Returns nil if the resource is not found.
+func (c *Client) DeleteOpenIDConnectProvider(ctx context.Context, arn string) error {
+	input := &iam.DeleteOpenIDConnectProviderInput{
+		OpenIDConnectProviderArn: aws.String(arn),
+	}
+	_, err := c.IAM.DeleteOpenIDConnectProvider(ctx, input)
+	return ignoreNotFound(err)
+}
+
+// GetQueue returns the SQS queue with the given name. Returns nil if the queue is not found.
+func (c *Client) GetQueue(ctx context.Context, name string) (*Queue, error) {
+	urlOutput, err := c.SQS.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{QueueName: aws.String(name)})
+	if err != nil {
+		if isQueueNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	queueURL := aws.ToString(urlOutput.QueueUrl)
+
+	attributesOutput, err := c.SQS.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl:       aws.String(queueURL),
+		AttributeNames: []sqstypes.QueueAttributeName{sqstypes.QueueAttributeNameAll},
+	})
+	if err != nil {
+		if isQueueNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	tagsOutput, err := c.SQS.ListQueueTags(ctx, &sqs.ListQueueTagsInput{QueueUrl: aws.String(queueURL)})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Queue{
+		Tags:       tagsOutput.Tags,
+		QueueName:  name,
+		QueueURL:   queueURL,
+		QueueARN:   attributesOutput.Attributes[string(sqstypes.QueueAttributeNameQueueArn)],
+		Attributes: attributesOutput.Attributes,
+	}, nil
+}
+
+// CreateQueue creates an SQS queue.
+func (c *Client) CreateQueue(ctx context.Context, queue *Queue) (*Queue, error) {
+	_, err := c.SQS.CreateQueue(ctx, &sqs.CreateQueueInput{
+		QueueName:  aws.String(queue.QueueName),
+		Attributes: queue.Attributes,
+		Tags:       queue.Tags,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c.GetQueue(ctx, queue.QueueName)
+}
+
+// UpdateQueueAttributes updates the attributes of the SQS queue with the given URL.
+func (c *Client) UpdateQueueAttributes(ctx context.Context, queueURL string, attributes map[string]string) error {
+	_, err := c.SQS.SetQueueAttributes(ctx, &sqs.SetQueueAttributesInput{
+		QueueUrl:   aws.String(queueURL),
+		Attributes: attributes,
+	})
+	return err
+}
+
+// DeleteQueue deletes the SQS queue with the given URL.
+// Returns nil if the queue is not found.
+func (c *Client) DeleteQueue(ctx context.Context, queueURL string) error {
+	_, err := c.SQS.DeleteQueue(ctx, &sqs.DeleteQueueInput{QueueUrl: aws.String(queueURL)})
+	if isQueueNotFoundError(err) {
+		return nil
+	}
+	return err
+}
+
+func isQueueNotFoundError(err error) bool {
+	var notFound *sqstypes.QueueDoesNotExist
+	return errors.As(err, &notFound)
+}
+
+// PutEventRule creates or updates an EventBridge rule on the default event bus and sets its target.
+func (c *Client) PutEventRule(ctx context.Context, rule *EventRule) (*EventRule, error) {
+	input := &eventbridge.PutRuleInput{
+		Name:         aws.String(rule.Name),
+		EventPattern: aws.String(rule.EventPattern),
+		State:        eventbridgetypes.RuleStateEnabled,
+	}
+	for k, v := range rule.Tags {
+		input.Tags = append(input.Tags, eventbridgetypes.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	output, err := c.EventBridge.PutRule(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	targetsOutput, err := c.EventBridge.PutTargets(ctx, &eventbridge.PutTargetsInput{
+		Rule: aws.String(rule.Name),
+		Targets: []eventbridgetypes.Target{
+			{Id: aws.String(rule.TargetID), Arn: aws.String(rule.TargetARN)},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if targetsOutput.FailedEntryCount > 0 && len(targetsOutput.FailedEntries) > 0 {
+		failed := targetsOutput.FailedEntries[0]
+		return nil, fmt.Errorf("could not put target of rule %s: %s: %s", rule.Name, aws.ToString(failed.ErrorCode), aws.ToString(failed.ErrorMessage))
+	}
+
+	created := *rule
+	created.ARN = aws.ToString(output.RuleArn)
+	return &created, nil
+}
+
+// DeleteEventRule removes the targets of the EventBridge rule with the given name and deletes it.
+// Returns nil if the rule is not found.
+func (c *Client) DeleteEventRule(ctx context.Context, name string) error {
+	targetsOutput, err := c.EventBridge.ListTargetsByRule(ctx, &eventbridge.ListTargetsByRuleInput{Rule: aws.String(name)})
+	if err != nil {
+		return ignoreNotFound(err)
+	}
+	if len(targetsOutput.Targets) > 0 {
+		var ids []string
+		for _, target := range targetsOutput.Targets {
+			ids = append(ids, aws.ToString(target.Id))
+		}
+		if _, err := c.EventBridge.RemoveTargets(ctx, &eventbridge.RemoveTargetsInput{Rule: aws.String(name), Ids: ids}); err != nil {
+			return ignoreNotFound(err)
+		}
+	}
+	_, err = c.EventBridge.DeleteRule(ctx, &eventbridge.DeleteRuleInput{Name: aws.String(name)})
+	return ignoreNotFound(err)
+}
+
+// CreateElasticFileSystem creates an encrypted EFS file system and waits until it is available.
+func (c *Client) CreateElasticFileSystem(ctx context.Context, fs *ElasticFileSystem) (*ElasticFileSystem, error) {
+	input := &efs.CreateFileSystemInput{
+		CreationToken: aws.String(fs.CreationToken),
+		Encrypted:     aws.Bool(true),
+	}
+	for k, v := range fs.Tags {
+		input.Tags = append(input.Tags, efstypes.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	output, err := c.EFS.CreateFileSystem(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	id := aws.ToString(output.FileSystemId)
+	if err := c.PollImmediateUntil(ctx, func(ctx context.Context) (bool, error) {
+		created, err := c.GetElasticFileSystem(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		return created != nil && created.LifeCycleState == string(efstypes.LifeCycleStateAvailable), nil
+	}); err != nil {
+		return nil, err
+	}
+	return c.GetElasticFileSystem(ctx, id)
+}
+
+// GetElasticFileSystem gets an EFS file system by its identifier.
+// Returns nil if the file system is not found.
+func (c *Client) GetElasticFileSystem(ctx context.Context, id string) (*ElasticFileSystem, error) {
+	output, err := c.EFS.DescribeFileSystems(ctx, &efs.DescribeFileSystemsInput{FileSystemId: aws.String(id)})
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	if len(output.FileSystems) == 0 {
+		return nil, nil
+	}
+	return fromFileSystemDescription(output.FileSystems[0]), nil
+}
+
+// FindElasticFileSystemsByTags finds the EFS file systems having all given tags.
+func (c *Client) FindElasticFileSystemsByTags(ctx context.Context, tags Tags) ([]*ElasticFileSystem, error) {
+	var fileSystems []*ElasticFileSystem
+	paginator := efs.NewDescribeFileSystemsPaginator(c.EFS, &efs.DescribeFileSystemsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range output.FileSystems {
+			fs := fromFileSystemDescription(item)
+			if fs.LifeCycleState == string(efstypes.LifeCycleStateDeleting) || fs.LifeCycleState == string(efstypes.LifeCycleStateDeleted) {
+				continue
+			}
+			if containsAllTags(fs.Tags, tags) {
+				fileSystems = append(fileSystems, fs)
+			}
+		}
+	}
+	return fileSystems, nil
+}
+
+// DeleteElasticFileSystem deletes the EFS file system with the given identifier and waits until it is gone.
+// Returns nil if the file system is not found.
+func (c *Client) DeleteElasticFileSystem(ctx context.Context, id string) error {
+	if _, err := c.EFS.DeleteFileSystem(ctx, &efs.DeleteFileSystemInput{FileSystemId: aws.String(id)}); err != nil {
+		return ignoreNotFound(err)
+	}
+	return c.PollImmediateUntil(ctx, func(ctx context.Context) (bool, error) {
+		fs, err := c.GetElasticFileSystem(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		return fs == nil || fs.LifeCycleState == string(efstypes.LifeCycleStateDeleted), nil
+	})
+}
+
+// GetMountTargets gets the mount targets of the EFS file system with the given identifier.
+func (c *Client) GetMountTargets(ctx context.Context, fileSystemID string) ([]*MountTarget, error) {
+	var mountTargets []*MountTarget
+	paginator := efs.NewDescribeMountTargetsPaginator(c.EFS, &efs.DescribeMountTargetsInput{FileSystemId: aws.String(fileSystemID)})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, ignoreNotFound(err)
+		}
+		for _, item := range output.MountTargets {
+			mountTargets = append(mountTargets, &MountTarget{
+				MountTargetId:  aws.ToString(item.MountTargetId),
+				FileSystemId:   aws.ToString(item.FileSystemId),
+				SubnetId:       aws.ToString(item.SubnetId),
+				LifeCycleState: string(item.LifeCycleState),
+			})
+		}
+	}
+	return mountTargets, nil
+}
+
+// CreateMountTarget creates a mount target for an EFS file system and waits until it is available.
+func (c *Client) CreateMountTarget(ctx context.Context, mountTarget *MountTarget) (*MountTarget, error) {
+	output, err := c.EFS.CreateMountTarget(ctx, &efs.CreateMountTargetInput{
+		FileSystemId:   aws.String(mountTarget.FileSystemId),
+		SubnetId:       aws.String(mountTarget.SubnetId),
+		SecurityGroups: mountTarget.SecurityGroupIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+	created := *mountTarget
+	created.MountTargetId = aws.ToString(output.MountTargetId)
+	created.LifeCycleState = string(output.LifeCycleState)
+	if err := c.PollImmediateUntil(ctx, func(ctx context.Context) (bool, error) {
+		output, err := c.EFS.DescribeMountTargets(ctx, &efs.DescribeMountTargetsInput{MountTargetId: aws.String(created.MountTargetId)})
+		if err != nil {
+			return false, err
+		}
+		if len(output.MountTargets) == 0 {
+			return false, nil
+		}
+		created.LifeCycleState = string(output.MountTargets[0].LifeCycleState)
+		return output.MountTargets[0].LifeCycleState == efstypes.LifeCycleStateAvailable, nil
+	}); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// DeleteMountTarget deletes the EFS mount target with the given identifier and waits until it is gone.
+// Returns nil if the mount target is not found.
+func (c *Client) DeleteMountTarget(ctx context.Context, id string) error {
+	if _, err := c.EFS.DeleteMountTarget(ctx, &efs.DeleteMountTargetInput{MountTargetId: aws.String(id)}); err != nil {
+		return ignoreNotFound(err)
+	}
+	return c.PollImmediateUntil(ctx, func(ctx context.Context) (bool, error) {
+		output, err := c.EFS.DescribeMountTargets(ctx, &efs.DescribeMountTargetsInput{MountTargetId: aws.String(id)})
+		if err != nil {
+			if IsNotFoundError(err) {
+				return true, nil
+			}
+			return false, err
+		}
+		return len(output.MountTargets) == 0 || output.MountTargets[0].LifeCycleState == efstypes.LifeCycleStateDeleted, nil
+	})
+}
+
+// CreateResolverEndpoint creates a Route 53 Resolver endpoint and waits until it is operational.
+func (c *Client) CreateResolverEndpoint(ctx context.Context, endpoint *ResolverEndpoint) (*ResolverEndpoint, error) {
+	input := &route53resolver.CreateResolverEndpointInput{
+		CreatorRequestId: aws.String(creatorRequestId(endpoint.Name)),
+		Direction:        route53resolvertypes.ResolverEndpointDirection(endpoint.Direction),
+		Name:             aws.String(endpoint.Name),
+		SecurityGroupIds: endpoint.SecurityGroupIds,
+		Tags:             toResolverTags(endpoint.Tags),
+	}
+	for _, subnetId := range endpoint.SubnetIds {
+		input.IpAddresses = append(input.IpAddresses, route53resolvertypes.IpAddressRequest{SubnetId: aws.String(subnetId)})
+	}
+	output, err := c.Route53Resolver.CreateResolverEndpoint(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	id := aws.ToString(output.ResolverEndpoint.Id)
+	var created *ResolverEndpoint
+	if err := c.PollImmediateUntil(ctx, func(ctx context.Context) (bool, error) {
+		created, err = c.GetResolverEndpoint(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		if created != nil && created.Status == string(route53resolvertypes.ResolverEndpointStatusActionNeeded) {
+			return false, fmt.Errorf("resolver endpoint %s needs action", id)
+		}
+		return created != nil && created.Status == string(route53resolvertypes.ResolverEndpointStatusOperational), nil
+	}); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// GetResolverEndpoint gets a Route 53 Resolver endpoint by its identifier.
+// Returns nil if the endpoint is not found.
+func (c *Client) GetResolverEndpoint(ctx context.Context, id string) (*ResolverEndpoint, error) {
+	output, err := c.Route53Resolver.GetResolverEndpoint(ctx, &route53resolver.GetResolverEndpointInput{ResolverEndpointId: aws.String(id)})
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	return fromResolverEndpoint(output.ResolverEndpoint), nil
+}
+
+// FindResolverEndpointsByName finds the Route 53 Resolver endpoints with the given name.
+func (c *Client) FindResolverEndpointsByName(ctx context.Context, name string) ([]*ResolverEndpoint, error) {
+	var endpoints []*ResolverEndpoint
+	paginator := route53resolver.NewListResolverEndpointsPaginator(c.Route53Resolver, &route53resolver.ListResolverEndpointsInput{
+		Filters: []route53resolvertypes.Filter{{Name: aws.String("Name"), Values: []string{name}}},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range output.ResolverEndpoints {
+			if item.Status == route53resolvertypes.ResolverEndpointStatusDeleting {
+				continue
+			}
+			endpoints = append(endpoints, fromResolverEndpoint(&item))
+		}
+	}
+	return endpoints, nil
+}
+
+// GetResolverEndpointIpAddresses gets the IP addresses of the Route 53 Resolver endpoint with the given identifier.
+func (c *Client) GetResolverEndpointIpAddresses(ctx context.Context, id string) ([]*ResolverEndpointIpAddress, error) {
+	var addresses []*ResolverEndpointIpAddress
+	paginator := route53resolver.NewListResolverEndpointIpAddressesPaginator(c.Route53Resolver, &route53resolver.ListResolverEndpointIpAddressesInput{
+		ResolverEndpointId: aws.String(id),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, ignoreNotFound(err)
+		}
+		for _, item := range output.IpAddresses {
+			addresses = append(addresses, &ResolverEndpointIpAddress{
+				IpId:     aws.ToString(item.IpId),
+				SubnetId: aws.ToString(item.SubnetId),
+				Ip:       aws.ToString(item.Ip),
+			})
+		}
 	}
-	return pairs, nil
+	return addresses, nil
 }
 
-// DeleteKeyPair deletes an EC2 key pair given by the key name.
-// Returns nil if resource is not found.
-func (c *Client) DeleteKeyPair(ctx context.Context, keyName string) error {
-	input := &ec2.DeleteKeyPairInput{
-		KeyName: aws.String(keyName),
-	}
-	_, err := c.EC2.DeleteKeyPairWithContext(ctx, input)
-	return ignoreNotFound(err)
+// AssociateResolverEndpointIpAddress assigns an additional IP address in the given subnet to the Route 53 Resolver
+// endpoint.
+func (c *Client) AssociateResolverEndpointIpAddress(ctx context.Context, id, subnetId string) error {
+	_, err := c.Route53Resolver.AssociateResolverEndpointIpAddress(ctx, &route53resolver.AssociateResolverEndpointIpAddressInput{
+		ResolverEndpointId: aws.String(id),
+		IpAddress:          &route53resolvertypes.IpAddressUpdate{SubnetId: aws.String(subnetId)},
+	})
+	return err
 }
 
-// CreateRouteTableAssociation associates a route table with a subnet.
-// Returns association id and error.
-func (c *Client) CreateRouteTableAssociation(ctx context.Context, routeTableId, subnetId string) (*string, error) {
-	input := &ec2.AssociateRouteTableInput{
-		RouteTableId: aws.String(routeTableId),
-		SubnetId:     aws.String(subnetId),
+// DeleteResolverEndpoint deletes the Route 53 Resolver endpoint with the given identifier and waits until it is gone.
+// Returns nil if the endpoint is not found.
+func (c *Client) DeleteResolverEndpoint(ctx context.Context, id string) error {
+	if _, err := c.Route53Resolver.DeleteResolverEndpoint(ctx, &route53resolver.DeleteResolverEndpointInput{ResolverEndpointId: aws.String(id)}); err != nil {
+		return ignoreNotFound(err)
 	}
-	output, err := c.EC2.AssociateRouteTableWithContext(ctx, input)
+	return c.PollImmediateUntil(ctx, func(ctx context.Context) (bool, error) {
+		endpoint, err := c.GetResolverEndpoint(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		return endpoint == nil, nil
+	})
+}
+
+// CreateResolverRule creates a Route 53 Resolver forwarding rule.
+func (c *Client) CreateResolverRule(ctx context.Context, rule *ResolverRule) (*ResolverRule, error) {
+	input := &route53resolver.CreateResolverRuleInput{
+		CreatorRequestId:   aws.String(creatorRequestId(rule.Name)),
+		DomainName:         aws.String(rule.DomainName),
+		Name:               aws.String(rule.Name),
+		ResolverEndpointId: aws.String(rule.ResolverEndpointId),
+		RuleType:           route53resolvertypes.RuleTypeOptionForward,
+		TargetIps:          toTargetAddresses(rule.TargetIps),
+		Tags:               toResolverTags(rule.Tags),
+	}
+	output, err := c.Route53Resolver.CreateResolverRule(ctx, input)
 	if err != nil {
 		return nil, err
 	}
-	return output.AssociationId, nil
+	return fromResolverRule(output.ResolverRule), nil
 }
 
-// DeleteRouteTableAssociation deletes the route table association by the assocation identifier.
-// Returns nil if the resource is not found.
-func (c *Client) DeleteRouteTableAssociation(ctx context.Context, associationId string) error {
-	input := &ec2.DisassociateRouteTableInput{
-		AssociationId: aws.String(associationId),
+// FindResolverRulesByEndpointId finds the Route 53 Resolver rules served by the given endpoint.
+func (c *Client) FindResolverRulesByEndpointId(ctx context.Context, endpointId string) ([]*ResolverRule, error) {
+	var rules []*ResolverRule
+	paginator := route53resolver.NewListResolverRulesPaginator(c.Route53Resolver, &route53resolver.ListResolverRulesInput{
+		Filters: []route53resolvertypes.Filter{{Name: aws.String("ResolverEndpointId"), Values: []string{endpointId}}},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range output.ResolverRules {
+			if item.Status == route53resolvertypes.ResolverRuleStatusDeleting {
+				continue
+			}
+			rules = append(rules, fromResolverRule(&item))
+		}
 	}
-	_, err := c.EC2.DisassociateRouteTableWithContext(ctx, input)
+	return rules, nil
+}
+
+// UpdateResolverRuleTargetIps updates the target IP addresses of a Route 53 Resolver forwarding rule.
+func (c *Client) UpdateResolverRuleTargetIps(ctx context.Context, id string, targetIps []string) error {
+	_, err := c.Route53Resolver.UpdateResolverRule(ctx, &route53resolver.UpdateResolverRuleInput{
+		ResolverRuleId: aws.String(id),
+		Config:         &route53resolvertypes.ResolverRuleConfig{TargetIps: toTargetAddresses(targetIps)},
+	})
+	return err
+}
+
+// DeleteResolverRule deletes the Route 53 Resolver rule with the given identifier.
+// Returns nil if the rule is not found.
+func (c *Client) DeleteResolverRule(ctx context.Context, id string) error {
+	_, err := c.Route53Resolver.DeleteResolverRule(ctx, &route53resolver.DeleteResolverRuleInput{ResolverRuleId: aws.String(id)})
 	return ignoreNotFound(err)
 }
 
-// CreateIAMRole creates an IAM role resource.
-func (c *Client) CreateIAMRole(ctx context.Context, role *IAMRole) (*IAMRole, error) {
-	input := &iam.CreateRoleInput{
-		AssumeRolePolicyDocument: aws.String(role.AssumeRolePolicyDocument),
-		Path:                     aws.String(role.Path),
-		RoleName:                 aws.String(role.RoleName),
-	}
-	output, err := c.IAM.CreateRoleWithContext(ctx, input)
-	if err != nil {
-		return nil, err
+// GetResolverRuleAssociations gets the associations of Route 53 Resolver rules with the given VPC.
+func (c *Client) GetResolverRuleAssociations(ctx context.Context, vpcId string) ([]*ResolverRuleAssociation, error) {
+	var associations []*ResolverRuleAssociation
+	paginator := route53resolver.NewListResolverRuleAssociationsPaginator(c.Route53Resolver, &route53resolver.ListResolverRuleAssociationsInput{
+		Filters: []route53resolvertypes.Filter{{Name: aws.String("VPCId"), Values: []string{vpcId}}},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range output.ResolverRuleAssociations {
+			if item.Status == route53resolvertypes.ResolverRuleAssociationStatusDeleting {
+				continue
+			}
+			associations = append(associations, &ResolverRuleAssociation{
+				ResolverRuleAssociationId: aws.ToString(item.Id),
+				ResolverRuleId:            aws.ToString(item.ResolverRuleId),
+				VpcId:                     aws.ToString(item.VPCId),
+			})
+		}
 	}
-	return fromIAMRole(output.Role), nil
+	return associations, nil
 }
 
-// GetIAMRole gets an IAM role by role name.
-func (c *Client) GetIAMRole(ctx context.Context, roleName string) (*IAMRole, error) {
-	input := &iam.GetRoleInput{
-		RoleName: aws.String(roleName),
-	}
-	output, err := c.IAM.GetRoleWithContext(ctx, input)
+// AssociateResolverRule associates a Route 53 Resolver rule with a VPC and waits until the association is complete.
+func (c *Client) AssociateResolverRule(ctx context.Context, ruleId, vpcId string) error {
+	output, err := c.Route53Resolver.AssociateResolverRule(ctx, &route53resolver.AssociateResolverRuleInput{
+		ResolverRuleId: aws.String(ruleId),
+		VPCId:          aws.String(vpcId),
+	})
 	if err != nil {
-		return nil, ignoreNotFound(err)
+		return err
 	}
-	return fromIAMRole(output.Role), nil
+	id := output.ResolverRuleAssociation.Id
+	return c.PollImmediateUntil(ctx, func(ctx context.Context) (bool, error) {
+		output, err := c.Route53Resolver.GetResolverRuleAssociation(ctx, &route53resolver.GetResolverRuleAssociationInput{ResolverRuleAssociationId: id})
+		if err != nil {
+			return false, err
+		}
+		switch output.ResolverRuleAssociation.Status {
+		case route53resolvertypes.ResolverRuleAssociationStatusFailed:
+			return false, fmt.Errorf("association of resolver rule %s with VPC %s failed: %s", ruleId, vpcId, aws.ToString(output.ResolverRuleAssociation.StatusMessage))
+		case route53resolvertypes.ResolverRuleAssociationStatusCreating:
+			return false, nil
+		default:
+			return true, nil
+		}
+	})
 }
 
-// DeleteIAMRole deletes an IAM role by role name.
-// Returns nil if the resource is not found.
-func (c *Client) DeleteIAMRole(ctx context.Context, roleName string) error {
-	input := &iam.DeleteRoleInput{
-		RoleName: aws.String(roleName),
+// DisassociateResolverRule removes the association of a Route 53 Resolver rule with a VPC and waits until it is gone.
+// Returns nil if the association is not found.
+func (c *Client) DisassociateResolverRule(ctx context.Context, ruleId, vpcId string) error {
+	output, err := c.Route53Resolver.DisassociateResolverRule(ctx, &route53resolver.DisassociateResolverRuleInput{
+		ResolverRuleId: aws.String(ruleId),
+		VPCId:          aws.String(vpcId),
+	})
+	if err != nil {
+		return ignoreNotFound(err)
 	}
-	_, err := c.IAM.DeleteRoleWithContext(ctx, input)
-	return ignoreNotFound(err)
+	id := output.ResolverRuleAssociation.Id
+	return c.PollImmediateUntil(ctx, func(ctx context.Context) (bool, error) {
+		_, err := c.Route53Resolver.GetResolverRuleAssociation(ctx, &route53resolver.GetResolverRuleAssociationInput{ResolverRuleAssociationId: id})
+		if IsNotFoundError(err) {
+			return true, nil
+		}
+		return false, err
+	})
 }
 
-// UpdateAssumeRolePolicy updates the assumeRolePolicy of an IAM role.
-func (c *Client) UpdateAssumeRolePolicy(ctx context.Context, roleName, assumeRolePolicy string) error {
-	input := &iam.UpdateAssumeRolePolicyInput{
-		RoleName:       aws.String(roleName),
-		PolicyDocument: aws.String(assumeRolePolicy),
+// FindTaggedResourcesByTags returns the ARNs and tags of all resources of the region which have the given tags, using
+// the Resource Groups Tagging API.
+func (c *Client) FindTaggedResourcesByTags(ctx context.Context, tags Tags) ([]*TaggedResource, error) {
+	var filters []taggingtypes.TagFilter
+	for k, v := range tags {
+		filters = append(filters, taggingtypes.TagFilter{Key: aws.String(k), Values: []string{v}})
 	}
-	_, err := c.IAM.UpdateAssumeRolePolicyWithContext(ctx, input)
-	return err
+	paginator := resourcegroupstaggingapi.NewGetResourcesPaginator(c.ResourceGroupsTagging, &resourcegroupstaggingapi.GetResourcesInput{
+		TagFilters: filters,
+	})
+	var resources []*TaggedResource
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range output.ResourceTagMappingList {
+			resource := &TaggedResource{ARN: aws.ToString(item.ResourceARN), Tags: Tags{}}
+			for _, tag := range item.Tags {
+				resource.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+			}
+			resources = append(resources, resource)
+		}
+	}
+	return resources, nil
 }
 
-// CreateIAMInstanceProfile creates an IAM instance profile.
-func (c *Client) CreateIAMInstanceProfile(ctx context.Context, profile *IAMInstanceProfile) (*IAMInstanceProfile, error) {
-	input := &iam.CreateInstanceProfileInput{
-		InstanceProfileName: aws.String(profile.InstanceProfileName),
-		Path:                aws.String(profile.Path),
-	}
-	output, err := c.IAM.CreateInstanceProfileWithContext(ctx, input)
+// TagResources adds the given tags to the resources with the given ARNs, using the Resource Groups Tagging API. At most
+// 20 resources can be tagged at once.
+func (c *Client) TagResources(ctx context.Context, arns []string, tags Tags) error {
+	output, err := c.ResourceGroupsTagging.TagResources(ctx, &resourcegroupstaggingapi.TagResourcesInput{
+		ResourceARNList: arns,
+		Tags:            tags,
+	})
 	if err != nil {
-		return nil, err
+		return err
 	}
-	profileName := aws.StringValue(output.InstanceProfile.InstanceProfileName)
-	if err = c.PollImmediateUntil(ctx, func(ctx context.Context) (done bool, err error) {
-		if item, err := c.GetIAMInstanceProfile(ctx, profileName); err != nil {
-			return false, err
-		} else {
-			return item != nil, nil
-		}
-	}); err != nil {
-		return nil, err
+	var errs []error
+	for arn, failure := range output.FailedResourcesMap {
+		errs = append(errs, fmt.Errorf("could not tag %s: %s (%s)", arn, aws.ToString(failure.ErrorMessage), failure.ErrorCode))
 	}
-	return c.GetIAMInstanceProfile(ctx, profileName)
+	return errors.Join(errs...)
 }
 
-// GetIAMInstanceProfile gets an IAM instance profile by profile name.
-func (c *Client) GetIAMInstanceProfile(ctx context.Context, profileName string) (*IAMInstanceProfile, error) {
-	input := &iam.GetInstanceProfileInput{
-		InstanceProfileName: aws.String(profileName),
-	}
-	output, err := c.IAM.GetInstanceProfileWithContext(ctx, input)
-	if err != nil {
-		return nil, ignoreNotFound(err)
+// creatorRequestId returns a unique id for the idempotent creation of Route 53 Resolver resources.
+func creatorRequestId(name string) string {
+	return fmt.Sprintf("%s-%d", name, time.Now().UnixNano())
+}
+
+func toResolverTags(tags Tags) []route53resolvertypes.Tag {
+	var resolverTags []route53resolvertypes.Tag
+	for k, v := range tags {
+		resolverTags = append(resolverTags, route53resolvertypes.Tag{Key: aws.String(k), Value: aws.String(v)})
 	}
-	return fromIAMInstanceProfile(output.InstanceProfile), nil
+	return resolverTags
 }
 
-// AddRoleToIAMInstanceProfile adds a role to an instance profile.
-func (c *Client) AddRoleToIAMInstanceProfile(ctx context.Context, profileName, roleName string) error {
-	input := &iam.AddRoleToInstanceProfileInput{
-		InstanceProfileName: aws.String(profileName),
-		RoleName:            aws.String(roleName),
+func toTargetAddresses(ips []string) []route53resolvertypes.TargetAddress {
+	var addresses []route53resolvertypes.TargetAddress
+	for _, ip := range ips {
+		addresses = append(addresses, route53resolvertypes.TargetAddress{Ip: aws.String(ip), Port: aws.Int32(53)})
 	}
-	_, err := c.IAM.AddRoleToInstanceProfileWithContext(ctx, input)
-	return err
+	return addresses
 }
 
-// RemoveRoleFromIAMInstanceProfile removes a role from an instance profile.
-func (c *Client) RemoveRoleFromIAMInstanceProfile(ctx context.Context, profileName, roleName string) error {
-	input := &iam.RemoveRoleFromInstanceProfileInput{
-		InstanceProfileName: aws.String(profileName),
-		RoleName:            aws.String(roleName),
+func fromResolverEndpoint(item *route53resolvertypes.ResolverEndpoint) *ResolverEndpoint {
+	return &ResolverEndpoint{
+		ResolverEndpointId: aws.ToString(item.Id),
+		Name:               aws.ToString(item.Name),
+		Direction:          string(item.Direction),
+		VpcId:              aws.ToString(item.HostVPCId),
+		SecurityGroupIds:   item.SecurityGroupIds,
+		Status:             string(item.Status),
 	}
-	_, err := c.IAM.RemoveRoleFromInstanceProfileWithContext(ctx, input)
-	return ignoreNotFound(err)
 }
 
-// DeleteIAMInstanceProfile deletes an IAM instance profile by profile name.
-// Returns nil if the resource is not found.
-func (c *Client) DeleteIAMInstanceProfile(ctx context.Context, profileName string) error {
-	input := &iam.DeleteInstanceProfileInput{
-		InstanceProfileName: aws.String(profileName),
+func fromResolverRule(item *route53resolvertypes.ResolverRule) *ResolverRule {
+	rule := &ResolverRule{
+		ResolverRuleId:     aws.ToString(item.Id),
+		Name:               aws.ToString(item.Name),
+		DomainName:         strings.TrimSuffix(aws.ToString(item.DomainName), "."),
+		ResolverEndpointId: aws.ToString(item.ResolverEndpointId),
 	}
-	_, err := c.IAM.DeleteInstanceProfileWithContext(ctx, input)
-	return ignoreNotFound(err)
+	for _, target := range item.TargetIps {
+		rule.TargetIps = append(rule.TargetIps, aws.ToString(target.Ip))
+	}
+	return rule
 }
 
-// PutIAMRolePolicy creates or updates an IAM role policy.
-func (c *Client) PutIAMRolePolicy(ctx context.Context, policy *IAMRolePolicy) error {
-	input := &iam.PutRolePolicyInput{
-		PolicyDocument: aws.String(policy.PolicyDocument),
-		PolicyName:     aws.String(policy.PolicyName),
-		RoleName:       aws.String(policy.RoleName),
+func containsAllTags(actual, expected Tags) bool {
+	for k, v := range expected {
+		if actual[k] != v {
+			return false
+		}
 	}
-	_, err := c.IAM.PutRolePolicyWithContext(ctx, input)
-	return err
+	return true
 }
 
-// GetIAMRolePolicy gets an IAM role policy by policy name and role name.
-func (c *Client) GetIAMRolePolicy(ctx context.Context, policyName, roleName string) (*IAMRolePolicy, error) {
-	input := &iam.GetRolePolicyInput{
-		PolicyName: aws.String(policyName),
-		RoleName:   aws.String(roleName),
+func fromFileSystemDescription(item efstypes.FileSystemDescription) *ElasticFileSystem {
+	tags := Tags{}
+	for _, tag := range item.Tags {
+		tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
 	}
-	output, err := c.IAM.GetRolePolicyWithContext(ctx, input)
+	return &ElasticFileSystem{
+		Tags:           tags,
+		FileSystemId:   aws.ToString(item.FileSystemId),
+		CreationToken:  aws.ToString(item.CreationToken),
+		LifeCycleState: string(item.LifeCycleState),
+	}
+}
+
+// CreateAccessKey creates a new access key for the IAM user the client is authenticated as.
+func (c *Client) CreateAccessKey(ctx context.Context) (*AccessKey, error) {
+	output, err := c.IAM.CreateAccessKey(ctx, &iam.CreateAccessKeyInput{})
 	if err != nil {
-		return nil, ignoreNotFound(err)
+		return nil, err
 	}
-	return &IAMRolePolicy{
-		PolicyName:     aws.StringValue(output.PolicyName),
-		RoleName:       aws.StringValue(output.RoleName),
-		PolicyDocument: aws.StringValue(output.PolicyDocument),
+	return &AccessKey{
+		AccessKeyID:     aws.ToString(output.AccessKey.AccessKeyId),
+		SecretAccessKey: aws.ToString(output.AccessKey.SecretAccessKey),
 	}, nil
 }
 
-// DeleteIAMRolePolicy deletes an IAM role policy by policy name and role name.
-// Returns nil if the resource is not found.
-func (c *Client) DeleteIAMRolePolicy(ctx context.Context, policyName, roleName string) error {
-	input := &iam.DeleteRolePolicyInput{
-		PolicyName: aws.String(policyName),
-		RoleName:   aws.String(roleName),
+// DeleteAccessKey deletes an access key of the IAM user the client is authenticated as.
+func (c *Client) DeleteAccessKey(ctx context.Context, accessKeyID string) error {
+	input := &iam.DeleteAccessKeyInput{
+		AccessKeyId: aws.String(accessKeyID),
 	}
-	_, err := c.IAM.DeleteRolePolicyWithContext(ctx, input)
+	_, err := c.IAM.DeleteAccessKey(ctx, input)
 	return ignoreNotFound(err)
 }
 
 // CreateEC2Tags creates the tags for the given EC2 resource identifiers
 func (c *Client) CreateEC2Tags(ctx context.Context, resources []string, tags Tags) error {
 	input := &ec2.CreateTagsInput{
-		Resources: aws.StringSlice(resources),
+		Resources: resources,
 		Tags:      tags.ToEC2Tags(),
 	}
-	_, err := c.EC2.CreateTagsWithContext(ctx, input)
+	_, err := c.EC2.CreateTags(ctx, input)
 	return err
 }
 
 // DeleteEC2Tags deletes the tags for the given EC2 resource identifiers
 func (c *Client) DeleteEC2Tags(ctx context.Context, resources []string, tags Tags) error {
 	input := &ec2.DeleteTagsInput{
-		Resources: aws.StringSlice(resources),
+		Resources: resources,
 		Tags:      tags.ToEC2Tags(),
 	}
-	_, err := c.EC2.DeleteTagsWithContext(ctx, input)
+	_, err := c.EC2.DeleteTags(ctx, input)
 	return err
 }
 
@@ -2001,22 +5207,27 @@ func (c *Client) PollUntil(ctx context.Context, condition wait.ConditionWithCont
 	return wait.PollUntilContextCancel(ctx, c.PollInterval, false, condition)
 }
 
-// IsNotFoundError returns true if the given error is a awserr.Error indicating that an AWS resource was not found.
+// IsNotFoundError returns true if the given error is an AWS API error indicating that an AWS resource was not found.
 func IsNotFoundError(err error) bool {
-	if aerr, ok := err.(awserr.Error); ok && (aerr.Code() == elb.ErrCodeAccessPointNotFoundException ||
-		aerr.Code() == iam.ErrCodeNoSuchEntityException || aerr.Code() == "NatGatewayNotFound" ||
-		strings.HasSuffix(aerr.Code(), ".NotFound")) {
-		return true
-	}
-	return false
+	code := errorCode(err)
+	return code == "LoadBalancerNotFound" || code == "NoSuchEntity" || code == "NatGatewayNotFound" ||
+		code == "ResourceNotFoundException" || code == "InvalidPlacementGroup.Unknown" || code == "NotFoundException" ||
+		code == "FileSystemNotFound" || code == "MountTargetNotFound" ||
+		strings.HasSuffix(code, ".NotFound") || strings.HasSuffix(code, ".NotFoundException")
 }
 
-// IsAlreadyAssociatedError returns true if the given error is a awserr.Error indicating that an AWS resource was already associated.
+// IsAlreadyAssociatedError returns true if the given error is an AWS API error indicating that an AWS resource was already associated.
 func IsAlreadyAssociatedError(err error) bool {
-	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == "Resource.AlreadyAssociated" {
-		return true
+	return errorCode(err) == "Resource.AlreadyAssociated"
+}
+
+// errorCode returns the error code if the given error is an AWS API error, otherwise an empty string.
+func errorCode(err error) string {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode()
 	}
-	return false
+	return ""
 }
 
 func ignoreNotFound(err error) error {
@@ -2033,8 +5244,8 @@ func ignoreAlreadyAssociated(err error) error {
 	return err
 }
 
-func chunkSlice(slice []*string, chunkSize int) [][]*string {
-	var chunks [][]*string
+func chunkSlice(slice []string, chunkSize int) [][]string {
+	var chunks [][]string
 	for i := 0; i < len(slice); i += chunkSize {
 		end := i + chunkSize
 
@@ -2048,7 +5259,61 @@ func chunkSlice(slice []*string, chunkSize int) [][]*string {
 	return chunks
 }
 
-func fromDhcpOptions(item *ec2.DhcpOptions) *DhcpOptions {
+func fromNetworkAcl(item *ec2types.NetworkAcl) *NetworkAcl {
+	acl := &NetworkAcl{
+		Tags:         FromTags(item.Tags),
+		NetworkAclId: aws.ToString(item.NetworkAclId),
+		VpcId:        item.VpcId,
+		IsDefault:    aws.ToBool(item.IsDefault),
+	}
+	for _, entry := range item.Entries {
+		// the rules denying all traffic can neither be modified nor deleted
+		if aws.ToInt32(entry.RuleNumber) == 32767 {
+			continue
+		}
+		e := &NetworkAclEntry{
+			RuleNumber:    aws.ToInt32(entry.RuleNumber),
+			Egress:        aws.ToBool(entry.Egress),
+			Protocol:      aws.ToString(entry.Protocol),
+			RuleAction:    string(entry.RuleAction),
+			CidrBlock:     entry.CidrBlock,
+			Ipv6CidrBlock: entry.Ipv6CidrBlock,
+		}
+		if entry.PortRange != nil {
+			e.FromPort = entry.PortRange.From
+			e.ToPort = entry.PortRange.To
+		}
+		if entry.IcmpTypeCode != nil {
+			e.IcmpType = entry.IcmpTypeCode.Type
+			e.IcmpCode = entry.IcmpTypeCode.Code
+		}
+		acl.Entries = append(acl.Entries, e)
+	}
+	for _, assoc := range item.Associations {
+		acl.Associations = append(acl.Associations, &NetworkAclAssociation{
+			NetworkAclAssociationId: aws.ToString(assoc.NetworkAclAssociationId),
+			NetworkAclId:            aws.ToString(assoc.NetworkAclId),
+			SubnetId:                aws.ToString(assoc.SubnetId),
+		})
+	}
+	return acl
+}
+
+func toPortRange(entry *NetworkAclEntry) *ec2types.PortRange {
+	if entry.FromPort == nil && entry.ToPort == nil {
+		return nil
+	}
+	return &ec2types.PortRange{From: entry.FromPort, To: entry.ToPort}
+}
+
+func toIcmpTypeCode(entry *NetworkAclEntry) *ec2types.IcmpTypeCode {
+	if entry.IcmpType == nil && entry.IcmpCode == nil {
+		return nil
+	}
+	return &ec2types.IcmpTypeCode{Type: entry.IcmpType, Code: entry.IcmpCode}
+}
+
+func fromDhcpOptions(item *ec2types.DhcpOptions) *DhcpOptions {
 	config := map[string][]string{}
 	for _, cfg := range item.DhcpConfigurations {
 		var values []string
@@ -2059,12 +5324,12 @@ func fromDhcpOptions(item *ec2.DhcpOptions) *DhcpOptions {
 	}
 	return &DhcpOptions{
 		Tags:               FromTags(item.Tags),
-		DhcpOptionsId:      aws.StringValue(item.DhcpOptionsId),
+		DhcpOptionsId:      aws.ToString(item.DhcpOptionsId),
 		DhcpConfigurations: config,
 	}
 }
 
-func fromIpPermission(groupId string, ipPerm *ec2.IpPermission, ruleType SecurityGroupRuleType) (*SecurityGroupRule, error) {
+func fromIpPermission(groupId string, ipPerm *ec2types.IpPermission, ruleType SecurityGroupRuleType) (*SecurityGroupRule, error) {
 	var foreign bool
 	var blocks []string
 	for _, block := range ipPerm.IpRanges {
@@ -2072,7 +5337,7 @@ func fromIpPermission(groupId string, ipPerm *ec2.IpPermission, ruleType Securit
 	}
 	rule := &SecurityGroupRule{
 		Type:       ruleType,
-		Protocol:   aws.StringValue(ipPerm.IpProtocol),
+		Protocol:   aws.ToString(ipPerm.IpProtocol),
 		CidrBlocks: blocks,
 	}
 	if ipPerm.FromPort != nil {
@@ -2081,10 +5346,15 @@ func fromIpPermission(groupId string, ipPerm *ec2.IpPermission, ruleType Securit
 	if ipPerm.ToPort != nil {
 		rule.ToPort = int(*ipPerm.ToPort)
 	}
-	if len(ipPerm.UserIdGroupPairs) == 1 && ipPerm.UserIdGroupPairs[0].GroupId != nil && *ipPerm.UserIdGroupPairs[0].GroupId == groupId {
-		rule.Self = true
-	} else if len(ipPerm.UserIdGroupPairs) != 0 {
-		foreign = true
+	for _, pair := range ipPerm.UserIdGroupPairs {
+		switch {
+		case pair.GroupId == nil:
+			foreign = true
+		case *pair.GroupId == groupId:
+			rule.Self = true
+		default:
+			rule.SecurityGroupIds = append(rule.SecurityGroupIds, *pair.GroupId)
+		}
 	}
 	if len(ipPerm.Ipv6Ranges) > 0 || len(ipPerm.PrefixListIds) > 0 {
 		foreign = true
@@ -2099,13 +5369,14 @@ func fromIpPermission(groupId string, ipPerm *ec2.IpPermission, ruleType Securit
 	return rule, nil
 }
 
-func fromSubnet(item *ec2.Subnet) *Subnet {
+func fromSubnet(item *ec2types.Subnet) *Subnet {
 	s := &Subnet{
 		Tags:                        FromTags(item.Tags),
-		SubnetId:                    aws.StringValue(item.SubnetId),
+		SubnetId:                    aws.ToString(item.SubnetId),
 		VpcId:                       item.VpcId,
-		CidrBlock:                   aws.StringValue(item.CidrBlock),
-		AvailabilityZone:            aws.StringValue(item.AvailabilityZone),
+		OwnerId:                     aws.ToString(item.OwnerId),
+		CidrBlock:                   aws.ToString(item.CidrBlock),
+		AvailabilityZone:            aws.ToString(item.AvailabilityZone),
 		AssignIpv6AddressOnCreation: trueOrNil(item.AssignIpv6AddressOnCreation),
 		CustomerOwnedIpv4Pool:       item.CustomerOwnedIpv4Pool,
 		EnableDns64:                 trueOrNil(item.EnableDns64),
@@ -2117,58 +5388,171 @@ func fromSubnet(item *ec2.Subnet) *Subnet {
 	if item.PrivateDnsNameOptionsOnLaunch != nil {
 		s.EnableResourceNameDnsAAAARecordOnLaunch = trueOrNil(item.PrivateDnsNameOptionsOnLaunch.EnableResourceNameDnsAAAARecord)
 		s.EnableResourceNameDnsARecordOnLaunch = trueOrNil(item.PrivateDnsNameOptionsOnLaunch.EnableResourceNameDnsARecord)
-		s.PrivateDnsHostnameTypeOnLaunch = item.PrivateDnsNameOptionsOnLaunch.HostnameType
+		if hostnameType := item.PrivateDnsNameOptionsOnLaunch.HostnameType; hostnameType != "" {
+			s.PrivateDnsHostnameTypeOnLaunch = ptr.To(string(hostnameType))
+		}
 	}
 	for _, block := range item.Ipv6CidrBlockAssociationSet {
-		s.Ipv6CidrBlocks = append(s.Ipv6CidrBlocks, aws.StringValue(block.Ipv6CidrBlock))
+		s.Ipv6CidrBlocks = append(s.Ipv6CidrBlocks, aws.ToString(block.Ipv6CidrBlock))
 	}
 	return s
 }
 
-func fromAddress(item *ec2.Address) *ElasticIP {
+func fromInstance(item *ec2types.Instance) *Instance {
+	instance := &Instance{
+		Tags:            FromTags(item.Tags),
+		InstanceId:      aws.ToString(item.InstanceId),
+		InstanceType:    string(item.InstanceType),
+		PublicIpAddress: item.PublicIpAddress,
+		SourceDestCheck: item.SourceDestCheck,
+		LaunchTime:      item.LaunchTime,
+	}
+	instance.InstanceLifecycle = string(item.InstanceLifecycle)
+	if item.State != nil {
+		instance.State = string(item.State.Name)
+	}
+	if item.CpuOptions != nil {
+		instance.VCPUs = aws.ToInt32(item.CpuOptions.CoreCount) * aws.ToInt32(item.CpuOptions.ThreadsPerCore)
+	}
+	return instance
+}
+
+func fromAddress(item *ec2types.Address) *ElasticIP {
 	return &ElasticIP{
-		Tags:         FromTags(item.Tags),
-		Vpc:          aws.StringValue(item.Domain) == ec2.DomainTypeVpc,
-		AllocationId: aws.StringValue(item.AllocationId),
-		PublicIp:     aws.StringValue(item.PublicIp),
+		Tags:          FromTags(item.Tags),
+		Vpc:           item.Domain == ec2types.DomainTypeVpc,
+		AllocationId:  aws.ToString(item.AllocationId),
+		PublicIp:      aws.ToString(item.PublicIp),
+		AssociationId: item.AssociationId,
+	}
+}
+
+func fromEgressOnlyInternetGateway(item *ec2types.EgressOnlyInternetGateway) *EgressOnlyInternetGateway {
+	gw := &EgressOnlyInternetGateway{
+		Tags:                        FromTags(item.Tags),
+		EgressOnlyInternetGatewayId: aws.ToString(item.EgressOnlyInternetGatewayId),
+	}
+	for _, attachment := range item.Attachments {
+		gw.VpcId = attachment.VpcId
+		break
+	}
+	return gw
+}
+
+func fromCarrierGateway(item *ec2types.CarrierGateway) *CarrierGateway {
+	if item.State == ec2types.CarrierGatewayStateDeleted {
+		return nil
+	}
+	return &CarrierGateway{
+		Tags:             FromTags(item.Tags),
+		CarrierGatewayId: aws.ToString(item.CarrierGatewayId),
+		VpcId:            item.VpcId,
+	}
+}
+
+func fromTransitGatewayVpcAttachment(item *ec2types.TransitGatewayVpcAttachment) *TransitGatewayVpcAttachment {
+	if strings.EqualFold(string(item.State), string(ec2types.TransitGatewayAttachmentStateDeleted)) {
+		return nil
+	}
+	attachment := &TransitGatewayVpcAttachment{
+		Tags:                       FromTags(item.Tags),
+		TransitGatewayAttachmentId: aws.ToString(item.TransitGatewayAttachmentId),
+		TransitGatewayId:           aws.ToString(item.TransitGatewayId),
+		VpcId:                      aws.ToString(item.VpcId),
+		SubnetIds:                  item.SubnetIds,
+		State:                      string(item.State),
+	}
+	if item.Options != nil {
+		attachment.Ipv6Support = strings.EqualFold(string(item.Options.Ipv6Support), string(ec2types.Ipv6SupportValueEnable))
+	}
+	return attachment
+}
+
+func fromVpcPeeringConnection(item *ec2types.VpcPeeringConnection) *VpcPeeringConnection {
+	peering := &VpcPeeringConnection{
+		Tags:                   FromTags(item.Tags),
+		VpcPeeringConnectionId: aws.ToString(item.VpcPeeringConnectionId),
+	}
+	if item.Status != nil {
+		peering.State = string(item.Status.Code)
+	}
+	if peering.State == string(ec2types.VpcPeeringConnectionStateReasonCodeDeleted) {
+		return nil
+	}
+	if item.RequesterVpcInfo != nil {
+		peering.VpcId = aws.ToString(item.RequesterVpcInfo.VpcId)
+	}
+	if item.AccepterVpcInfo != nil {
+		peering.PeerVpcId = aws.ToString(item.AccepterVpcInfo.VpcId)
+		peering.PeerOwnerId = aws.ToString(item.AccepterVpcInfo.OwnerId)
+		peering.PeerRegion = aws.ToString(item.AccepterVpcInfo.Region)
+	}
+	return peering
+}
+
+func fromRoute(route ec2types.Route) *Route {
+	return &Route{
+		DestinationCidrBlock:        route.DestinationCidrBlock,
+		DestinationIpv6CidrBlock:    route.DestinationIpv6CidrBlock,
+		GatewayId:                   route.GatewayId,
+		NatGatewayId:                route.NatGatewayId,
+		EgressOnlyInternetGatewayId: route.EgressOnlyInternetGatewayId,
+		TransitGatewayId:            route.TransitGatewayId,
+		VpcPeeringConnectionId:      route.VpcPeeringConnectionId,
+		CarrierGatewayId:            route.CarrierGatewayId,
+		InstanceId:                  route.InstanceId,
+		DestinationPrefixListId:     route.DestinationPrefixListId,
 	}
 }
 
-func fromNatGateway(item *ec2.NatGateway) *NATGateway {
-	if strings.EqualFold(aws.StringValue(item.State), ec2.StateDeleted) {
+func fromNatGateway(item *ec2types.NatGateway) *NATGateway {
+	if strings.EqualFold(string(item.State), string(ec2types.NatGatewayStateDeleted)) {
 		return nil
 	}
 	var allocationId, publicIP string
 	for _, address := range item.NatGatewayAddresses {
-		allocationId = aws.StringValue(address.AllocationId)
-		publicIP = aws.StringValue(address.PublicIp)
+		allocationId = aws.ToString(address.AllocationId)
+		publicIP = aws.ToString(address.PublicIp)
 		break
 	}
 	return &NATGateway{
 		Tags:            FromTags(item.Tags),
-		NATGatewayId:    aws.StringValue(item.NatGatewayId),
+		NATGatewayId:    aws.ToString(item.NatGatewayId),
 		EIPAllocationId: allocationId,
 		PublicIP:        publicIP,
-		SubnetId:        aws.StringValue(item.SubnetId),
-		State:           aws.StringValue(item.State),
+		SubnetId:        aws.ToString(item.SubnetId),
+		State:           string(item.State),
 	}
 }
 
-func fromKeyPairInfo(item *ec2.KeyPairInfo) *KeyPairInfo {
+func fromPlacementGroup(item *ec2types.PlacementGroup) *PlacementGroup {
+	if item == nil {
+		return nil
+	}
+	return &PlacementGroup{
+		Tags:           FromTags(item.Tags),
+		GroupId:        aws.ToString(item.GroupId),
+		GroupName:      aws.ToString(item.GroupName),
+		Strategy:       string(item.Strategy),
+		PartitionCount: toInt64Ptr(item.PartitionCount),
+	}
+}
+
+func fromKeyPairInfo(item *ec2types.KeyPairInfo) *KeyPairInfo {
 	return &KeyPairInfo{
 		Tags:           FromTags(item.Tags),
-		KeyName:        aws.StringValue(item.KeyName),
-		KeyFingerprint: aws.StringValue(item.KeyFingerprint),
+		KeyName:        aws.ToString(item.KeyName),
+		KeyFingerprint: aws.ToString(item.KeyFingerprint),
 	}
 }
 
-func fromIAMRole(item *iam.Role) *IAMRole {
+func fromIAMRole(item *iamtypes.Role) *IAMRole {
 	role := &IAMRole{
-		RoleId:                   aws.StringValue(item.RoleId),
-		RoleName:                 aws.StringValue(item.RoleName),
-		Path:                     aws.StringValue(item.Path),
-		AssumeRolePolicyDocument: aws.StringValue(item.AssumeRolePolicyDocument),
-		ARN:                      aws.StringValue(item.Arn),
+		RoleId:                   aws.ToString(item.RoleId),
+		RoleName:                 aws.ToString(item.RoleName),
+		Path:                     aws.ToString(item.Path),
+		AssumeRolePolicyDocument: aws.ToString(item.AssumeRolePolicyDocument),
+		ARN:                      aws.ToString(item.Arn),
 	}
 	if strings.Contains(role.AssumeRolePolicyDocument, "%7B") {
 		// URL decode needed, very strange API !?
@@ -2180,16 +5564,16 @@ func fromIAMRole(item *iam.Role) *IAMRole {
 	return role
 }
 
-func fromIAMInstanceProfile(item *iam.InstanceProfile) *IAMInstanceProfile {
+func fromIAMInstanceProfile(item *iamtypes.InstanceProfile) *IAMInstanceProfile {
 	var roleName string
 	for _, role := range item.Roles {
-		roleName = aws.StringValue(role.RoleName)
+		roleName = aws.ToString(role.RoleName)
 		break
 	}
 	return &IAMInstanceProfile{
-		InstanceProfileId:   aws.StringValue(item.InstanceProfileId),
-		InstanceProfileName: aws.StringValue(item.InstanceProfileName),
-		Path:                aws.StringValue(item.Path),
+		InstanceProfileId:   aws.ToString(item.InstanceProfileId),
+		InstanceProfileName: aws.ToString(item.InstanceProfileName),
+		Path:                aws.ToString(item.Path),
 		RoleName:            roleName,
 	}
 }
@@ -2204,11 +5588,25 @@ func single[T any](list []*T, err error) (*T, error) {
 	return list[0], nil
 }
 
-func toAttributeBooleanValue(value *bool) *ec2.AttributeBooleanValue {
+func toAttributeBooleanValue(value *bool) *ec2types.AttributeBooleanValue {
 	if value == nil {
 		value = aws.Bool(false)
 	}
-	return &ec2.AttributeBooleanValue{Value: value}
+	return &ec2types.AttributeBooleanValue{Value: value}
+}
+
+func toInt32Ptr(value *int64) *int32 {
+	if value == nil {
+		return nil
+	}
+	return aws.Int32(int32(*value))
+}
+
+func toInt64Ptr(value *int32) *int64 {
+	if value == nil {
+		return nil
+	}
+	return aws.Int64(int64(*value))
 }
 
 func trueOrNil(value *bool) *bool {
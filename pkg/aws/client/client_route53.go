@@ -16,33 +16,191 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/aws/smithy-go"
 	"github.com/gardener/external-dns-management/pkg/controller/provider/aws/data"
 )
 
 // GetDNSHostedZones returns a map of all DNS hosted zone names mapped to their IDs.
+// If a public and a private hosted zone have the same name (split-horizon DNS), the public one is returned.
 func (c *Client) GetDNSHostedZones(ctx context.Context) (map[string]string, error) {
 	zones := make(map[string]string)
+	err := c.listDNSHostedZones(ctx, func(zone route53types.HostedZone) {
+		name := normalizeName(aws.ToString(zone.Name))
+		if _, ok := zones[name]; ok && isPrivateHostedZone(zone) {
+			return
+		}
+		zones[name] = normalizeZoneId(aws.ToString(zone.Id))
+	})
+	return zones, err
+}
+
+// GetPrivateDNSHostedZones returns a map of all private DNS hosted zone names mapped to their IDs.
+func (c *Client) GetPrivateDNSHostedZones(ctx context.Context) (map[string]string, error) {
+	zones := make(map[string]string)
+	err := c.listDNSHostedZones(ctx, func(zone route53types.HostedZone) {
+		if isPrivateHostedZone(zone) {
+			zones[normalizeName(aws.ToString(zone.Name))] = normalizeZoneId(aws.ToString(zone.Id))
+		}
+	})
+	return zones, err
+}
+
+func (c *Client) listDNSHostedZones(ctx context.Context, fn func(zone route53types.HostedZone)) error {
 	if err := c.waitForRoute53RateLimiter(ctx); err != nil {
-		return nil, err
+		return err
 	}
-	if err := c.Route53.ListHostedZonesPagesWithContext(ctx, &route53.ListHostedZonesInput{}, func(out *route53.ListHostedZonesOutput, lastPage bool) bool {
+	paginator := route53.NewListHostedZonesPaginator(c.Route53, &route53.ListHostedZonesInput{})
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return err
+		}
 		for _, zone := range out.HostedZones {
-			zones[normalizeName(aws.StringValue(zone.Name))] = normalizeZoneId(aws.StringValue(zone.Id))
+			fn(zone)
 		}
-		return !lastPage
-	}); err != nil {
+	}
+	return nil
+}
+
+func isPrivateHostedZone(zone route53types.HostedZone) bool {
+	return zone.Config != nil && zone.Config.PrivateZone
+}
+
+// GetDNSHostedZoneVPCs returns the VPCs which are associated with the private DNS hosted zone with the given ID.
+func (c *Client) GetDNSHostedZoneVPCs(ctx context.Context, zoneId string) ([]HostedZoneVPC, error) {
+	if err := c.waitForRoute53RateLimiter(ctx); err != nil {
+		return nil, err
+	}
+	out, err := c.Route53.GetHostedZone(ctx, &route53.GetHostedZoneInput{
+		Id: aws.String(zoneId),
+	})
+	if err != nil {
 		return nil, err
 	}
-	return zones, nil
+	var vpcs []HostedZoneVPC
+	for _, vpc := range out.VPCs {
+		vpcs = append(vpcs, HostedZoneVPC{
+			VPCID:  aws.ToString(vpc.VPCId),
+			Region: string(vpc.VPCRegion),
+		})
+	}
+	return vpcs, nil
+}
+
+// AssociateVPCWithDNSHostedZone associates the VPC with the given ID in the given region with the private DNS hosted
+// zone with the given ID.
+func (c *Client) AssociateVPCWithDNSHostedZone(ctx context.Context, zoneId, vpcId, region string) error {
+	if err := c.waitForRoute53RateLimiter(ctx); err != nil {
+		return err
+	}
+	_, err := c.Route53.AssociateVPCWithHostedZone(ctx, &route53.AssociateVPCWithHostedZoneInput{
+		HostedZoneId: aws.String(zoneId),
+		VPC: &route53types.VPC{
+			VPCId:     aws.String(vpcId),
+			VPCRegion: route53types.VPCRegion(region),
+		},
+	})
+	return err
+}
+
+// GetHealthCheck returns the configuration of the health check with the given ID, or nil if it does not exist.
+func (c *Client) GetHealthCheck(ctx context.Context, id string) (*HealthCheck, error) {
+	if err := c.waitForRoute53RateLimiter(ctx); err != nil {
+		return nil, err
+	}
+	out, err := c.Route53.GetHealthCheck(ctx, &route53.GetHealthCheckInput{
+		HealthCheckId: aws.String(id),
+	})
+	if err != nil {
+		if isNoSuchHealthCheckError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	config := out.HealthCheck.HealthCheckConfig
+	return &HealthCheck{
+		Type:                     config.Type,
+		IPAddress:                config.IPAddress,
+		FullyQualifiedDomainName: config.FullyQualifiedDomainName,
+		Port:                     config.Port,
+		ResourcePath:             config.ResourcePath,
+		RequestInterval:          config.RequestInterval,
+		FailureThreshold:         config.FailureThreshold,
+	}, nil
+}
+
+// CreateHealthCheck creates a health check with the given configuration and returns its ID. Requests with the same
+// caller reference and configuration are idempotent, i.e. they return the ID of the health check created by the first
+// request.
+func (c *Client) CreateHealthCheck(ctx context.Context, callerReference string, healthCheck *HealthCheck) (string, error) {
+	if err := c.waitForRoute53RateLimiter(ctx); err != nil {
+		return "", err
+	}
+	out, err := c.Route53.CreateHealthCheck(ctx, &route53.CreateHealthCheckInput{
+		CallerReference: aws.String(callerReference),
+		HealthCheckConfig: &route53types.HealthCheckConfig{
+			Type:                     healthCheck.Type,
+			IPAddress:                healthCheck.IPAddress,
+			FullyQualifiedDomainName: healthCheck.FullyQualifiedDomainName,
+			Port:                     healthCheck.Port,
+			ResourcePath:             healthCheck.ResourcePath,
+			RequestInterval:          healthCheck.RequestInterval,
+			FailureThreshold:         healthCheck.FailureThreshold,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	return aws.ToString(out.HealthCheck.Id), nil
+}
+
+// UpdateHealthCheck updates the health check with the given ID to the given configuration. The type, the request
+// interval and whether the endpoint is specified by its IP address cannot be updated.
+func (c *Client) UpdateHealthCheck(ctx context.Context, id string, healthCheck *HealthCheck) error {
+	if err := c.waitForRoute53RateLimiter(ctx); err != nil {
+		return err
+	}
+	input := &route53.UpdateHealthCheckInput{
+		HealthCheckId:            aws.String(id),
+		IPAddress:                healthCheck.IPAddress,
+		FullyQualifiedDomainName: healthCheck.FullyQualifiedDomainName,
+		Port:                     healthCheck.Port,
+		ResourcePath:             healthCheck.ResourcePath,
+		FailureThreshold:         healthCheck.FailureThreshold,
+	}
+	if healthCheck.FullyQualifiedDomainName == nil {
+		input.ResetElements = append(input.ResetElements, route53types.ResettableElementNameFullyQualifiedDomainName)
+	}
+	if healthCheck.ResourcePath == nil {
+		input.ResetElements = append(input.ResetElements, route53types.ResettableElementNameResourcePath)
+	}
+	_, err := c.Route53.UpdateHealthCheck(ctx, input)
+	return err
+}
+
+// DeleteHealthCheck deletes the health check with the given ID. If the health check does not exist, no error is
+// returned.
+func (c *Client) DeleteHealthCheck(ctx context.Context, id string) error {
+	if err := c.waitForRoute53RateLimiter(ctx); err != nil {
+		return err
+	}
+	_, err := c.Route53.DeleteHealthCheck(ctx, &route53.DeleteHealthCheckInput{
+		HealthCheckId: aws.String(id),
+	})
+	if isNoSuchHealthCheckError(err) {
+		return nil
+	}
+	return err
 }
 
 // CreateDNSHostedZone creates the DNS hosted zone with the given name and comment, and returns the ID of the
@@ -51,17 +209,17 @@ func (c *Client) CreateDNSHostedZone(ctx context.Context, name, comment string)
 	if err := c.waitForRoute53RateLimiter(ctx); err != nil {
 		return "", err
 	}
-	out, err := c.Route53.CreateHostedZoneWithContext(ctx, &route53.CreateHostedZoneInput{
+	out, err := c.Route53.CreateHostedZone(ctx, &route53.CreateHostedZoneInput{
 		CallerReference: aws.String(strconv.Itoa(int(time.Now().Unix()))),
 		Name:            aws.String(name),
-		HostedZoneConfig: &route53.HostedZoneConfig{
+		HostedZoneConfig: &route53types.HostedZoneConfig{
 			Comment: aws.String(comment),
 		},
 	})
 	if err != nil {
 		return "", err
 	}
-	return aws.StringValue(out.HostedZone.Id), nil
+	return aws.ToString(out.HostedZone.Id), nil
 }
 
 // DeleteDNSHostedZone deletes the DNS hosted zone with the given ID.
@@ -69,7 +227,7 @@ func (c *Client) DeleteDNSHostedZone(ctx context.Context, zoneId string) error {
 	if err := c.waitForRoute53RateLimiter(ctx); err != nil {
 		return err
 	}
-	_, err := c.Route53.DeleteHostedZoneWithContext(ctx, &route53.DeleteHostedZoneInput{
+	_, err := c.Route53.DeleteHostedZone(ctx, &route53.DeleteHostedZoneInput{
 		Id: aws.String(zoneId),
 	})
 	return ignoreHostedZoneNotFound(err)
@@ -91,83 +249,82 @@ func normalizeZoneId(zoneId string) string {
 }
 
 // CreateOrUpdateDNSRecordSet creates or updates the DNS recordset in the DNS hosted zone with the given zone ID,
-// with the given name, type, values, and TTL.
+// with the given name, type, values, TTL, and optional routing policy.
 // A CNAME record for AWS load balancers in a known zone may be mapped to A and/or AAAA recordsets with alias target.
-func (c *Client) CreateOrUpdateDNSRecordSet(ctx context.Context, zoneId, name, recordType string, values []string, ttl int64, stack IPStack) error {
-	rrs := newResourceRecordSets(name, recordType, newResourceRecords(recordType, values), ttl, stack)
-	if err := c.waitForRoute53RateLimiter(ctx); err != nil {
-		return err
-	}
-	_, err := c.Route53.ChangeResourceRecordSetsWithContext(ctx, newChangeResourceRecordSetsInput(zoneId, route53.ChangeActionUpsert, rrs))
-	return err
+func (c *Client) CreateOrUpdateDNSRecordSet(ctx context.Context, zoneId, name, recordType string, values []string, ttl int64, stack IPStack, routingPolicy *RoutingPolicy) error {
+	rrs := newResourceRecordSets(name, recordType, newResourceRecords(recordType, values), ttl, stack, routingPolicy)
+	return c.changeRoute53RecordSets(ctx, zoneId, route53types.ChangeActionUpsert, rrs)
 }
 
 // DeleteDNSRecordSet deletes the DNS recordset(s) in the DNS hosted zone with the given zone ID,
-// with the given name, type, values, and TTL.
+// with the given name, type, values, TTL, and optional routing policy.
 // If values is empty and TTL is 0 or if there are potential alias targets for a CNAME type, the actual state will be
 // determined by reading the recordset(s) from the zone.
 // Otherwise, an attempt will be made to delete the recordset with the given values / TTL.
 // The idea is to ensure a consistent and foolproof behavior while sending as few requests as possible to avoid
 // rate limit issues.
-func (c *Client) DeleteDNSRecordSet(ctx context.Context, zoneId, name, recordType string, values []string, ttl int64, stack IPStack) error {
+func (c *Client) DeleteDNSRecordSet(ctx context.Context, zoneId, name, recordType string, values []string, ttl int64, stack IPStack, routingPolicy *RoutingPolicy) error {
 	if len(values) > 0 && ttl > 0 && !isPotentialAliasTarget(recordType, values[0]) {
 		// try deletion with known values, but only if it is no CNAME record with potential alias target records.
 		// For CNAME records we don't know if the record(s) have been created with or without target records, as the list of
 		// canonicalHostedZoneIds may have changed in the meantime.
-		rrss := newResourceRecordSets(name, recordType, newResourceRecords(recordType, values), ttl, stack)
-		if err := c.waitForRoute53RateLimiter(ctx); err != nil {
-			return err
-		}
-		if _, err := c.Route53.ChangeResourceRecordSetsWithContext(ctx, newChangeResourceRecordSetsInput(zoneId, route53.ChangeActionDelete, rrss)); err == nil {
+		rrss := newResourceRecordSets(name, recordType, newResourceRecords(recordType, values), ttl, stack, routingPolicy)
+		if err := c.changeRoute53RecordSets(ctx, zoneId, route53types.ChangeActionDelete, rrss); err == nil {
 			return nil
 		}
 		// if there is any error, fallback to read/delete
 	}
-	rrss, err := c.GetDNSRecordSets(ctx, zoneId, name, recordType)
+	rrss, err := c.getDNSRecordSets(ctx, zoneId, name, recordType, routingPolicy.setIdentifier())
 	if err != nil {
 		return err
 	}
 	if len(rrss) == 0 {
 		return nil
 	}
-	if err := c.waitForRoute53RateLimiter(ctx); err != nil {
-		return err
-	}
-	_, err = c.Route53.ChangeResourceRecordSetsWithContext(ctx, newChangeResourceRecordSetsInput(zoneId, route53.ChangeActionDelete, rrss))
-	return ignoreResourceRecordSetNotFound(err)
+	return ignoreResourceRecordSetNotFound(c.changeRoute53RecordSets(ctx, zoneId, route53types.ChangeActionDelete, rrss))
 }
 
 // GetDNSRecordSets returns the DNS recordset(s) in the DNS hosted zone with the given zone ID, and with the given name and type.
 // For record type CNAME there may be multiple DNS recordsets if mapped to alias targets A or AAAA recordsets.
-func (c *Client) GetDNSRecordSets(ctx context.Context, zoneId, name, recordType string) ([]*route53.ResourceRecordSet, error) {
+// Recordsets with a routing policy are not returned.
+func (c *Client) GetDNSRecordSets(ctx context.Context, zoneId, name, recordType string) ([]route53types.ResourceRecordSet, error) {
+	return c.getDNSRecordSets(ctx, zoneId, name, recordType, "")
+}
+
+// getDNSRecordSets returns the DNS recordset(s) in the DNS hosted zone with the given zone ID, and with the given name,
+// type, and set identifier. The set identifier is empty for recordsets without routing policy.
+func (c *Client) getDNSRecordSets(ctx context.Context, zoneId, name, recordType, setIdentifier string) ([]route53types.ResourceRecordSet, error) {
 	if err := c.waitForRoute53RateLimiter(ctx); err != nil {
 		return nil, err
 	}
 	input := &route53.ListResourceRecordSetsInput{
 		HostedZoneId:    aws.String(zoneId),
-		MaxItems:        aws.String("1"),
+		MaxItems:        aws.Int32(1),
 		StartRecordName: aws.String(name),
-		StartRecordType: aws.String(recordType),
+		StartRecordType: route53types.RRType(recordType),
+	}
+	if recordType == string(route53types.RRTypeCname) {
+		input.MaxItems = aws.Int32(5) // potential CNAME, AliasTarget A and AliasTarget AAAA
+		input.StartRecordType = ""
 	}
-	if recordType == route53.RRTypeCname {
-		input.MaxItems = aws.String("5") // potential CNAME, AliasTarget A and AliasTarget AAAA
-		input.StartRecordType = nil
+	if setIdentifier != "" {
+		input.MaxItems = aws.Int32(100) // all recordsets with the name and type, which differ in their set identifiers
 	}
-	out, err := c.Route53.ListResourceRecordSetsWithContext(ctx, input)
+	out, err := c.Route53.ListResourceRecordSets(ctx, input)
 	if ignoreResourceRecordSetNotFound(err) != nil {
 		return nil, err
 	}
 	if out == nil || len(out.ResourceRecordSets) == 0 { // no records in zone
 		return nil, nil
 	}
-	var recordSets []*route53.ResourceRecordSet
+	var recordSets []route53types.ResourceRecordSet
 	for _, rrs := range out.ResourceRecordSets {
-		if normalizeName(aws.StringValue(rrs.Name)) == name {
-			switch aws.StringValue(rrs.Type) {
-			case recordType:
+		if normalizeName(aws.ToString(rrs.Name)) == name && aws.ToString(rrs.SetIdentifier) == setIdentifier {
+			switch rrs.Type {
+			case route53types.RRType(recordType):
 				recordSets = append(recordSets, rrs)
-			case route53.RRTypeA, route53.RRTypeAaaa:
-				if recordType == route53.RRTypeCname && rrs.AliasTarget != nil {
+			case route53types.RRTypeA, route53types.RRTypeAaaa:
+				if recordType == string(route53types.RRTypeCname) && rrs.AliasTarget != nil {
 					recordSets = append(recordSets, rrs)
 				}
 			}
@@ -189,34 +346,38 @@ func (c *Client) waitForRoute53RateLimiter(ctx context.Context) error {
 	return nil
 }
 
-func newChangeResourceRecordSetsInput(zoneId, action string, rrss []*route53.ResourceRecordSet) *route53.ChangeResourceRecordSetsInput {
-	var changes []*route53.Change
-	for _, rrs := range rrss {
-		changes = append(changes, &route53.Change{
-			Action:            aws.String(action),
-			ResourceRecordSet: rrs,
+func newChanges(action route53types.ChangeAction, rrss []route53types.ResourceRecordSet) []route53types.Change {
+	var changes []route53types.Change
+	for i := range rrss {
+		changes = append(changes, route53types.Change{
+			Action:            action,
+			ResourceRecordSet: &rrss[i],
 		})
 	}
+	return changes
+}
+
+func newChangeResourceRecordSetsInput(zoneId string, changes []route53types.Change) *route53.ChangeResourceRecordSetsInput {
 	return &route53.ChangeResourceRecordSetsInput{
 		HostedZoneId: aws.String(zoneId),
-		ChangeBatch: &route53.ChangeBatch{
+		ChangeBatch: &route53types.ChangeBatch{
 			Changes: changes,
 		},
 	}
 }
 
-func newResourceRecords(recordType string, values []string) []*route53.ResourceRecord {
-	var resourceRecords []*route53.ResourceRecord
-	if recordType == route53.RRTypeCname {
-		resourceRecords = append(resourceRecords, &route53.ResourceRecord{
+func newResourceRecords(recordType string, values []string) []route53types.ResourceRecord {
+	var resourceRecords []route53types.ResourceRecord
+	if recordType == string(route53types.RRTypeCname) {
+		resourceRecords = append(resourceRecords, route53types.ResourceRecord{
 			Value: aws.String(values[0]),
 		})
 	} else {
 		for _, value := range values {
-			if recordType == route53.RRTypeTxt {
+			if recordType == string(route53types.RRTypeTxt) {
 				value = encloseInQuotes(value)
 			}
-			resourceRecords = append(resourceRecords, &route53.ResourceRecord{
+			resourceRecords = append(resourceRecords, route53types.ResourceRecord{
 				Value: aws.String(value),
 			})
 		}
@@ -224,51 +385,52 @@ func newResourceRecords(recordType string, values []string) []*route53.ResourceR
 	return resourceRecords
 }
 
-func newResourceRecordSets(name, recordType string, resourceRecords []*route53.ResourceRecord, ttl int64, stack IPStack) []*route53.ResourceRecordSet {
-	if recordType == route53.RRTypeCname {
-		loadBalanceHostname := aws.StringValue(resourceRecords[0].Value)
+func newResourceRecordSets(name, recordType string, resourceRecords []route53types.ResourceRecord, ttl int64, stack IPStack, routingPolicy *RoutingPolicy) []route53types.ResourceRecordSet {
+	if recordType == string(route53types.RRTypeCname) {
+		loadBalanceHostname := aws.ToString(resourceRecords[0].Value)
 		// if it is a loadbalancer in a known canoncial hosted zone, create resource sets with alias targets for IPv4 and/or IPv6
 		if zoneId := canonicalHostedZoneId(loadBalanceHostname); zoneId != "" {
-			var rrss []*route53.ResourceRecordSet
+			var rrss []route53types.ResourceRecordSet
 			for _, recordType := range GetAliasRecordTypes(stack) {
-				rrs := &route53.ResourceRecordSet{
+				rrs := route53types.ResourceRecordSet{
 					Name: aws.String(name),
-					Type: aws.String(recordType),
-					AliasTarget: &route53.AliasTarget{
+					Type: route53types.RRType(recordType),
+					AliasTarget: &route53types.AliasTarget{
 						DNSName:              &loadBalanceHostname,
 						HostedZoneId:         aws.String(zoneId),
-						EvaluateTargetHealth: aws.Bool(true),
+						EvaluateTargetHealth: true,
 					},
 				}
+				routingPolicy.apply(&rrs)
 				rrss = append(rrss, rrs)
 			}
 			return rrss
 		}
 	}
-	return []*route53.ResourceRecordSet{
-		{
-			Name:            aws.String(name),
-			Type:            aws.String(recordType),
-			ResourceRecords: resourceRecords,
-			TTL:             aws.Int64(ttl),
-		},
+	rrs := route53types.ResourceRecordSet{
+		Name:            aws.String(name),
+		Type:            route53types.RRType(recordType),
+		ResourceRecords: resourceRecords,
+		TTL:             aws.Int64(ttl),
 	}
+	routingPolicy.apply(&rrs)
+	return []route53types.ResourceRecordSet{rrs}
 }
 
 // GetAliasRecordTypes determinate the alias record types needed, depending on the requested IPStack.
 func GetAliasRecordTypes(stack IPStack) []string {
 	switch stack {
 	case IPStackIPv6:
-		return []string{route53.RRTypeAaaa}
+		return []string{string(route53types.RRTypeAaaa)}
 	case IPStackIPDualStack:
-		return []string{route53.RRTypeA, route53.RRTypeAaaa}
+		return []string{string(route53types.RRTypeA), string(route53types.RRTypeAaaa)}
 	default:
-		return []string{route53.RRTypeA}
+		return []string{string(route53types.RRTypeA)}
 	}
 }
 
 func isPotentialAliasTarget(recordType, value string) bool {
-	if recordType == route53.RRTypeCname {
+	if recordType == string(route53types.RRTypeCname) {
 		if zoneId := canonicalHostedZoneId(value); zoneId != "" {
 			return true
 		}
@@ -301,7 +463,8 @@ func ignoreResourceRecordSetNotFound(err error) error {
 	if err == nil {
 		return nil
 	}
-	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == route53.ErrCodeInvalidChangeBatch && strings.Contains(aerr.Message(), "it was not found") {
+	var invalidChangeBatch *route53types.InvalidChangeBatch
+	if errors.As(err, &invalidChangeBatch) && strings.Contains(invalidChangeBatch.ErrorMessage(), "it was not found") {
 		return nil
 	}
 	return err
@@ -311,7 +474,8 @@ func ignoreHostedZoneNotFound(err error) error {
 	if err == nil {
 		return nil
 	}
-	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == route53.ErrCodeHostedZoneNotFound {
+	var hostedZoneNotFound *route53types.HostedZoneNotFound
+	if errors.As(err, &hostedZoneNotFound) {
 		return nil
 	}
 	return err
@@ -319,26 +483,25 @@ func ignoreHostedZoneNotFound(err error) error {
 
 // IsNoSuchHostedZoneError returns true if the error indicates a non-existing route53 hosted zone.
 func IsNoSuchHostedZoneError(err error) bool {
-	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == route53.ErrCodeNoSuchHostedZone {
-		return true
-	}
-	return false
+	var noSuchHostedZone *route53types.NoSuchHostedZone
+	return errors.As(err, &noSuchHostedZone)
+}
+
+func isNoSuchHealthCheckError(err error) bool {
+	var noSuchHealthCheck *route53types.NoSuchHealthCheck
+	return errors.As(err, &noSuchHealthCheck)
 }
 
 var notPermittedInZoneRegex = regexp.MustCompile(`RRSet with DNS name [^\ ]+ is not permitted in zone [^\ ]+`)
 
 // IsNotPermittedInZoneError returns true if the error indicates that the DNS name is not permitted in the route53 hosted zone.
 func IsNotPermittedInZoneError(err error) bool {
-	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == route53.ErrCodeInvalidChangeBatch && notPermittedInZoneRegex.MatchString(aerr.Message()) {
-		return true
-	}
-	return false
+	var invalidChangeBatch *route53types.InvalidChangeBatch
+	return errors.As(err, &invalidChangeBatch) && notPermittedInZoneRegex.MatchString(invalidChangeBatch.ErrorMessage())
 }
 
 // IsThrottlingError returns true if the error is a throttling error.
 func IsThrottlingError(err error) bool {
-	if aerr, ok := err.(awserr.Error); ok && strings.Contains(aerr.Message(), "Throttling") {
-		return true
-	}
-	return false
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && strings.Contains(apiErr.ErrorMessage(), "Throttling")
 }
@@ -0,0 +1,86 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// NewHTTPClient creates a new HTTP client for the requests to the AWS API with the given configuration.
+func NewHTTPClient(config *HTTPConfig) (*awshttp.BuildableClient, error) {
+	client := awshttp.NewBuildableClient()
+	if config == nil {
+		return client, nil
+	}
+
+	var rootCAs *x509.CertPool
+	if len(config.CABundle) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			return nil, fmt.Errorf("could not load system CAs: %w", err)
+		}
+		if !pool.AppendCertsFromPEM(config.CABundle) {
+			return nil, fmt.Errorf("CA bundle does not contain any PEM encoded certificate")
+		}
+		rootCAs = pool
+	}
+
+	proxy, err := proxyFunc(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.WithTransportOptions(func(t *http.Transport) {
+		if proxy != nil {
+			t.Proxy = proxy
+		}
+		if rootCAs != nil {
+			if t.TLSClientConfig == nil {
+				t.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+			}
+			t.TLSClientConfig.RootCAs = rootCAs
+		}
+	}), nil
+}
+
+// proxyFunc returns the function which selects the proxy for a request according to the given configuration. It
+// returns nil if no proxy URL is configured, i.e. if the proxy shall be taken from the environment.
+func proxyFunc(config *HTTPConfig) (func(*http.Request) (*url.URL, error), error) {
+	if config == nil || config.ProxyURL == "" {
+		return nil, nil
+	}
+	// The parse error is not returned as it contains the URL including the credentials.
+	if _, err := url.Parse(config.ProxyURL); err != nil {
+		return nil, fmt.Errorf("proxy URL is invalid")
+	}
+
+	proxyConfig := &httpproxy.Config{
+		HTTPProxy:  config.ProxyURL,
+		HTTPSProxy: config.ProxyURL,
+		NoProxy:    strings.Join(config.NoProxy, ","),
+	}
+	fn := proxyConfig.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return fn(req.URL)
+	}, nil
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+)
+
+// elbAccountIDs are the IDs of the AWS accounts of the Elastic Load Balancing service which deliver the access logs of
+// classic load balancers in the regions available before August 2022, see
+// https://docs.aws.amazon.com/elasticloadbalancing/latest/classic/enable-access-logs.html.
+var elbAccountIDs = map[string]string{
+	"us-east-1":      "127311923021",
+	"us-east-2":      "033677994240",
+	"us-west-1":      "027434742980",
+	"us-west-2":      "797873946194",
+	"af-south-1":     "098369216593",
+	"ap-east-1":      "754344448648",
+	"ap-southeast-3": "589379963580",
+	"ap-south-1":     "718504428378",
+	"ap-northeast-3": "383597477331",
+	"ap-northeast-2": "600734575887",
+	"ap-southeast-1": "114774131450",
+	"ap-southeast-2": "783225319266",
+	"ap-northeast-1": "582318560864",
+	"ca-central-1":   "985666609251",
+	"eu-central-1":   "054676820928",
+	"eu-west-1":      "156460612806",
+	"eu-west-2":      "652711504416",
+	"eu-south-1":     "635631232127",
+	"eu-west-3":      "009996457667",
+	"eu-north-1":     "897822967062",
+	"me-south-1":     "076674570225",
+	"sa-east-1":      "507241528517",
+	"us-gov-west-1":  "048591011584",
+	"us-gov-east-1":  "190560391635",
+	"cn-north-1":     "638102146993",
+	"cn-northwest-1": "037604701340",
+}
+
+const (
+	loadBalancerAccessLogsBucketSuffix = "-lb-logs-"
+	maxBucketNameLength                = 63
+	bucketNameHashLength               = 8
+)
+
+// LoadBalancerAccessLogsBucketName returns the name of the S3 bucket for the access logs of the load balancers of the
+// shoot with the given namespace. As bucket names are globally unique, it contains a hash of the account ID.
+func LoadBalancerAccessLogsBucketName(namespace, accountID string) string {
+	hash := sha256.Sum256([]byte(accountID + "/" + namespace))
+	prefix := namespace
+	if maxLength := maxBucketNameLength - len(loadBalancerAccessLogsBucketSuffix) - bucketNameHashLength; len(prefix) > maxLength {
+		prefix = strings.TrimRight(prefix[:maxLength], "-")
+	}
+	return prefix + loadBalancerAccessLogsBucketSuffix + hex.EncodeToString(hash[:])[:bucketNameHashLength]
+}
+
+// LoadBalancerAccessLogsBucketPolicy returns the policy of the S3 bucket for the access logs of load balancers. It denies
+// insecure requests and allows the Elastic Load Balancing service to deliver the access logs of classic and network
+// load balancers of the given account.
+func LoadBalancerAccessLogsBucketPolicy(partition, region, bucket, accountID string) (string, error) {
+	// In regions available since August 2022, access logs of classic load balancers are delivered by a service
+	// principal instead of a regional account.
+	elbPrincipal := map[string]string{"Service": "logdelivery.elasticloadbalancing.amazonaws.com"}
+	if elbAccountID, ok := elbAccountIDs[region]; ok {
+		elbPrincipal = map[string]string{"AWS": ARN(partition, "iam", "", elbAccountID, "root")}
+	}
+	sourceAccount := map[string]interface{}{
+		"StringEquals": map[string]string{
+			"aws:SourceAccount": accountID,
+		},
+	}
+
+	statements := append(denyInsecureTransportStatements(partition, bucket, DefaultMinimumTLSVersion),
+		map[string]interface{}{
+			"Effect":    "Allow",
+			"Principal": elbPrincipal,
+			"Action":    "s3:PutObject",
+			"Resource":  ARN(partition, "s3", "", "", bucket+"/*"),
+		},
+		map[string]interface{}{
+			"Effect":    "Allow",
+			"Principal": map[string]string{"Service": "delivery.logs.amazonaws.com"},
+			"Action":    "s3:PutObject",
+			"Resource":  ARN(partition, "s3", "", "", bucket+"/*"),
+			"Condition": map[string]interface{}{
+				"StringEquals": map[string]string{
+					"aws:SourceAccount": accountID,
+					"s3:x-amz-acl":      "bucket-owner-full-control",
+				},
+			},
+		},
+		map[string]interface{}{
+			"Effect":    "Allow",
+			"Principal": map[string]string{"Service": "delivery.logs.amazonaws.com"},
+			"Action":    "s3:GetBucketAcl",
+			"Resource":  ARN(partition, "s3", "", "", bucket),
+			"Condition": sourceAccount,
+		},
+	)
+
+	policy, err := json.Marshal(map[string]interface{}{
+		"Version":   "2012-10-17",
+		"Statement": statements,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(policy), nil
+}
+
+// DefaultMinimumTLSVersion is the minimum TLS version of requests to buckets managed by the extension.
+const DefaultMinimumTLSVersion = "1.2"
+
+// denyInsecureTransportStatements returns the bucket policy statements which deny requests to the given bucket which
+// do not use HTTPS, or use a TLS version lower than the given one. Both conditions are separate statements, as the
+// conditions of a single statement must all match, and plain HTTP requests do not have a TLS version.
+func denyInsecureTransportStatements(partition, bucket, minimumTLSVersion string) []map[string]interface{} {
+	resources := []string{
+		ARN(partition, "s3", "", "", bucket),
+		ARN(partition, "s3", "", "", bucket+"/*"),
+	}
+	return []map[string]interface{}{
+		{
+			"Sid":       "DenyInsecureTransport",
+			"Effect":    "Deny",
+			"Principal": "*",
+			"Action":    "s3:*",
+			"Resource":  resources,
+			"Condition": map[string]interface{}{
+				"Bool": map[string]string{
+					"aws:SecureTransport": "false",
+				},
+			},
+		},
+		{
+			"Sid":       "DenyOutdatedTLS",
+			"Effect":    "Deny",
+			"Principal": "*",
+			"Action":    "s3:*",
+			"Resource":  resources,
+			"Condition": map[string]interface{}{
+				"NumericLessThan": map[string]string{
+					"s3:TlsVersion": minimumTLSVersion,
+				},
+			},
+		},
+	}
+}
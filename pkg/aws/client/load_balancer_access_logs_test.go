@@ -0,0 +1,76 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client_test
+
+import (
+	"encoding/json"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+var _ = Describe("LoadBalancerAccessLogs", func() {
+	Describe("#LoadBalancerAccessLogsBucketName", func() {
+		It("should return a name which depends on the account", func() {
+			name := LoadBalancerAccessLogsBucketName("shoot--foo--bar", "123456789012")
+			Expect(name).To(MatchRegexp(`^shoot--foo--bar-lb-logs-[0-9a-f]{8}$`))
+			Expect(LoadBalancerAccessLogsBucketName("shoot--foo--bar", "123456789012")).To(Equal(name))
+			Expect(LoadBalancerAccessLogsBucketName("shoot--foo--bar", "210987654321")).NotTo(Equal(name))
+		})
+
+		It("should shorten long namespaces", func() {
+			name := LoadBalancerAccessLogsBucketName("shoot--"+strings.Repeat("a", 38)+"--bar", "123456789012")
+			Expect(len(name)).To(BeNumerically("<=", 63))
+			Expect(name).To(MatchRegexp(`^shoot--a+-lb-logs-[0-9a-f]{8}$`))
+		})
+	})
+
+	Describe("#LoadBalancerAccessLogsBucketPolicy", func() {
+		principals := func(policy string) []interface{} {
+			document := map[string]interface{}{}
+			Expect(json.Unmarshal([]byte(policy), &document)).To(Succeed())
+			var result []interface{}
+			for _, statement := range document["Statement"].([]interface{}) {
+				result = append(result, statement.(map[string]interface{})["Principal"])
+			}
+			return result
+		}
+
+		It("should allow the regional Elastic Load Balancing account and the log delivery service", func() {
+			policy, err := LoadBalancerAccessLogsBucketPolicy(PartitionAWS, "eu-west-1", "bucket", "123456789012")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(principals(policy)).To(ConsistOf(
+				"*",
+				"*",
+				map[string]interface{}{"AWS": "arn:aws:iam::156460612806:root"},
+				map[string]interface{}{"Service": "delivery.logs.amazonaws.com"},
+				map[string]interface{}{"Service": "delivery.logs.amazonaws.com"},
+			))
+			Expect(policy).To(ContainSubstring(`"aws:SourceAccount":"123456789012"`))
+			Expect(policy).To(ContainSubstring(`"arn:aws:s3:::bucket/*"`))
+			Expect(policy).To(ContainSubstring(`"Condition":{"Bool":{"aws:SecureTransport":"false"}}`))
+			Expect(policy).To(ContainSubstring(`"Condition":{"NumericLessThan":{"s3:TlsVersion":"1.2"}}`))
+		})
+
+		It("should allow the Elastic Load Balancing service principal in newer regions", func() {
+			policy, err := LoadBalancerAccessLogsBucketPolicy(PartitionAWS, "eu-central-2", "bucket", "123456789012")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(principals(policy)).To(ContainElement(map[string]interface{}{"Service": "logdelivery.elasticloadbalancing.amazonaws.com"}))
+		})
+	})
+})
@@ -0,0 +1,132 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// requestMetricsMiddlewareID is the ID of the middleware recording the metrics of the requests.
+	requestMetricsMiddlewareID = "RequestMetrics"
+	// attemptMetricsMiddlewareID is the ID of the middleware recording the metrics of the attempts of the requests.
+	attemptMetricsMiddlewareID = "AttemptMetrics"
+
+	// errorCodeRateLimiterWaitTimeout is the error code of requests which failed because waiting for the client-side
+	// rate limiter timed out.
+	errorCodeRateLimiterWaitTimeout = "RateLimiterWaitTimeout"
+	// errorCodeCanceled is the error code of requests which failed because their context was canceled.
+	errorCodeCanceled = "Canceled"
+	// errorCodeUnknown is the error code of requests which failed without an error code of the AWS API, e.g. because
+	// of network errors.
+	errorCodeUnknown = "Unknown"
+)
+
+var (
+	requestLabels = []string{"service", "operation", "controller"}
+
+	requestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "aws_client_requests_total",
+		Help: "Number of requests to the AWS API, including their retries.",
+	}, requestLabels)
+	requestErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "aws_client_request_errors_total",
+		Help: "Number of requests to the AWS API which failed after all retries, by error code.",
+	}, append(requestLabels, "code"))
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "aws_client_request_duration_seconds",
+		Help:    "Duration of requests to the AWS API, including their retries.",
+		Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, requestLabels)
+	throttledRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "aws_client_throttled_requests_total",
+		Help: "Number of attempts of requests to the AWS API which were throttled by AWS.",
+	}, requestLabels)
+)
+
+func init() {
+	metrics.Registry.MustRegister(requestsTotal, requestErrors, requestDuration, throttledRequests)
+}
+
+// applyMetrics configures the middlewares recording the metrics of the requests to the AWS API in the given AWS
+// config. The metrics are labeled with the given controller name.
+func applyMetrics(cfg *aws.Config, controller string) {
+	cfg.APIOptions = append(cfg.APIOptions, func(stack *middleware.Stack) error {
+		// The service and the operation are only known after the service metadata has been registered in the
+		// initialize step.
+		if err := stack.Initialize.Add(requestMetricsMiddleware(controller), middleware.After); err != nil {
+			return err
+		}
+		// Throttles are recorded after the retry middleware so that every throttled attempt is counted.
+		return stack.Finalize.Insert(attemptMetricsMiddleware(controller), "Retry", middleware.After)
+	})
+}
+
+// requestMetricsMiddleware returns a middleware which records the number, the errors and the duration of requests.
+func requestMetricsMiddleware(controller string) middleware.InitializeMiddleware {
+	return middleware.InitializeMiddlewareFunc(requestMetricsMiddlewareID, func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		start := time.Now()
+		out, metadata, err := next.HandleInitialize(ctx, in)
+
+		labels := []string{awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx), controller}
+		requestsTotal.WithLabelValues(labels...).Inc()
+		requestDuration.WithLabelValues(labels...).Observe(time.Since(start).Seconds())
+		if err != nil {
+			requestErrors.WithLabelValues(append(labels, metricsErrorCode(err))...).Inc()
+		}
+		return out, metadata, err
+	})
+}
+
+// attemptMetricsMiddleware returns a middleware which records throttled attempts of requests.
+func attemptMetricsMiddleware(controller string) middleware.FinalizeMiddleware {
+	throttles := retry.IsErrorThrottles(retry.DefaultThrottles)
+
+	return middleware.FinalizeMiddlewareFunc(attemptMetricsMiddlewareID, func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		out, metadata, err := next.HandleFinalize(ctx, in)
+		if err != nil && throttles.IsErrorThrottle(err) == aws.TrueTernary {
+			throttledRequests.WithLabelValues(awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx), controller).Inc()
+		}
+		return out, metadata, err
+	})
+}
+
+// metricsErrorCode returns the error code of the given error of a request for the metrics.
+func metricsErrorCode(err error) string {
+	var (
+		apiErr  smithy.APIError
+		waitErr *RateLimiterWaitError
+	)
+	switch {
+	case errors.As(err, &apiErr):
+		return apiErr.ErrorCode()
+	case errors.As(err, &waitErr):
+		return errorCodeRateLimiterWaitTimeout
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return errorCodeCanceled
+	default:
+		return errorCodeUnknown
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+var _ = Describe("Metrics", func() {
+	var (
+		ctx = context.Background()
+
+		throttled  atomic.Int32
+		authConfig AuthConfig
+	)
+
+	BeforeEach(func() {
+		throttled.Store(0)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "text/xml")
+			if throttled.Load() > 0 {
+				throttled.Add(-1)
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(throttlingResponse))
+				return
+			}
+			_, _ = w.Write([]byte(getCallerIdentityResponse))
+		}))
+		DeferCleanup(server.Close)
+
+		authConfig = AuthConfig{
+			AccessKeyID:     "access-key-metrics-" + CurrentSpecReport().LeafNodeText,
+			SecretAccessKey: "secret",
+			Region:          "eu-west-1",
+			Endpoints:       map[string]string{ServiceSTS: server.URL},
+			RateLimiter:     &RateLimiterConfig{Limit: 100, Burst: 10, MaxAttempts: 2, MaxBackoff: 10 * time.Millisecond},
+			Controller:      CurrentSpecReport().LeafNodeText,
+		}
+	})
+
+	labels := func(extra ...string) map[string]string {
+		l := map[string]string{"service": "STS", "operation": "GetCallerIdentity", "controller": CurrentSpecReport().LeafNodeText}
+		for i := 0; i < len(extra); i += 2 {
+			l[extra[i]] = extra[i+1]
+		}
+		return l
+	}
+
+	It("should record requests", func() {
+		client, err := NewClient(authConfig)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(client.GetAccountID(ctx)).To(Equal("123456789012"))
+
+		Expect(metricValue("aws_client_requests_total", labels())).To(Equal(1.0))
+		Expect(metricValue("aws_client_request_duration_seconds", labels())).To(Equal(1.0))
+		Expect(metricValue("aws_client_throttled_requests_total", labels())).To(BeZero())
+	})
+
+	It("should record throttled attempts", func() {
+		throttled.Store(1)
+
+		client, err := NewClient(authConfig)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(client.GetAccountID(ctx)).To(Equal("123456789012"))
+
+		Expect(metricValue("aws_client_requests_total", labels())).To(Equal(1.0))
+		Expect(metricValue("aws_client_throttled_requests_total", labels())).To(Equal(1.0))
+	})
+
+	It("should record errors by code", func() {
+		authConfig.RateLimiter.MaxAttempts = 1
+		throttled.Store(1)
+
+		client, err := NewClient(authConfig)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = client.GetAccountID(ctx)
+		Expect(err).To(HaveOccurred())
+
+		Expect(metricValue("aws_client_requests_total", labels())).To(Equal(1.0))
+		Expect(metricValue("aws_client_throttled_requests_total", labels())).To(Equal(1.0))
+		Expect(metricValue("aws_client_request_errors_total", labels("code", "Throttling"))).To(Equal(1.0))
+	})
+
+	It("should label the metrics with the controller of the factory", func() {
+		factory := NewControllerFactory(FactoryFunc(NewInterface), "other")
+		authConfig.Controller = ""
+
+		client, err := factory.NewClient(authConfig)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(client.GetAccountID(ctx)).To(Equal("123456789012"))
+
+		Expect(metricValue("aws_client_requests_total", labels("controller", "other"))).To(Equal(1.0))
+	})
+})
+
+// metricValue returns the value of the counter or the sample count of the histogram with the given name and labels.
+func metricValue(name string, labels map[string]string) float64 {
+	families, err := metrics.Registry.Gather()
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+	metrics:
+		for _, m := range family.GetMetric() {
+			if len(m.GetLabel()) != len(labels) {
+				continue
+			}
+			for _, l := range m.GetLabel() {
+				if labels[l.GetName()] != l.GetValue() {
+					continue metrics
+				}
+			}
+			if m.GetHistogram() != nil {
+				return float64(m.GetHistogram().GetSampleCount())
+			}
+			return m.GetCounter().GetValue()
+		}
+	}
+	return 0
+}
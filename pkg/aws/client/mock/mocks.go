@@ -36,6 +36,34 @@ func (m *MockInterface) EXPECT() *MockInterfaceMockRecorder {
 	return m.recorder
 }
 
+// AcceptVpcPeeringConnection mocks base method.
+func (m *MockInterface) AcceptVpcPeeringConnection(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptVpcPeeringConnection", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptVpcPeeringConnection indicates an expected call of AcceptVpcPeeringConnection.
+func (mr *MockInterfaceMockRecorder) AcceptVpcPeeringConnection(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptVpcPeeringConnection", reflect.TypeOf((*MockInterface)(nil).AcceptVpcPeeringConnection), arg0, arg1, arg2)
+}
+
+// AddClientIDToOpenIDConnectProvider mocks base method.
+func (m *MockInterface) AddClientIDToOpenIDConnectProvider(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddClientIDToOpenIDConnectProvider", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddClientIDToOpenIDConnectProvider indicates an expected call of AddClientIDToOpenIDConnectProvider.
+func (mr *MockInterfaceMockRecorder) AddClientIDToOpenIDConnectProvider(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddClientIDToOpenIDConnectProvider", reflect.TypeOf((*MockInterface)(nil).AddClientIDToOpenIDConnectProvider), arg0, arg1, arg2)
+}
+
 // AddRoleToIAMInstanceProfile mocks base method.
 func (m *MockInterface) AddRoleToIAMInstanceProfile(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -51,17 +79,74 @@ func (mr *MockInterfaceMockRecorder) AddRoleToIAMInstanceProfile(arg0, arg1, arg
 }
 
 // AddVpcDhcpOptionAssociation mocks base method.
-func (m *MockInterface) AddVpcDhcpOptionAssociation(arg0 string, arg1 *string) error {
+func (m *MockInterface) AddVpcDhcpOptionAssociation(arg0 context.Context, arg1 string, arg2 *string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddVpcDhcpOptionAssociation", arg0, arg1)
+	ret := m.ctrl.Call(m, "AddVpcDhcpOptionAssociation", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // AddVpcDhcpOptionAssociation indicates an expected call of AddVpcDhcpOptionAssociation.
-func (mr *MockInterfaceMockRecorder) AddVpcDhcpOptionAssociation(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockInterfaceMockRecorder) AddVpcDhcpOptionAssociation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVpcDhcpOptionAssociation", reflect.TypeOf((*MockInterface)(nil).AddVpcDhcpOptionAssociation), arg0, arg1, arg2)
+}
+
+// AssociateResolverEndpointIpAddress mocks base method.
+func (m *MockInterface) AssociateResolverEndpointIpAddress(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssociateResolverEndpointIpAddress", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssociateResolverEndpointIpAddress indicates an expected call of AssociateResolverEndpointIpAddress.
+func (mr *MockInterfaceMockRecorder) AssociateResolverEndpointIpAddress(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateResolverEndpointIpAddress", reflect.TypeOf((*MockInterface)(nil).AssociateResolverEndpointIpAddress), arg0, arg1, arg2)
+}
+
+// AssociateResolverRule mocks base method.
+func (m *MockInterface) AssociateResolverRule(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssociateResolverRule", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssociateResolverRule indicates an expected call of AssociateResolverRule.
+func (mr *MockInterfaceMockRecorder) AssociateResolverRule(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVpcDhcpOptionAssociation", reflect.TypeOf((*MockInterface)(nil).AddVpcDhcpOptionAssociation), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateResolverRule", reflect.TypeOf((*MockInterface)(nil).AssociateResolverRule), arg0, arg1, arg2)
+}
+
+// AssociateVPCWithDNSHostedZone mocks base method.
+func (m *MockInterface) AssociateVPCWithDNSHostedZone(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssociateVPCWithDNSHostedZone", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssociateVPCWithDNSHostedZone indicates an expected call of AssociateVPCWithDNSHostedZone.
+func (mr *MockInterfaceMockRecorder) AssociateVPCWithDNSHostedZone(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateVPCWithDNSHostedZone", reflect.TypeOf((*MockInterface)(nil).AssociateVPCWithDNSHostedZone), arg0, arg1, arg2, arg3)
+}
+
+// AssociateVpcCidrBlock mocks base method.
+func (m *MockInterface) AssociateVpcCidrBlock(arg0 context.Context, arg1, arg2 string) (*client.VpcCidrBlockAssociation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssociateVpcCidrBlock", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*client.VpcCidrBlockAssociation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssociateVpcCidrBlock indicates an expected call of AssociateVpcCidrBlock.
+func (mr *MockInterfaceMockRecorder) AssociateVpcCidrBlock(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateVpcCidrBlock", reflect.TypeOf((*MockInterface)(nil).AssociateVpcCidrBlock), arg0, arg1, arg2)
 }
 
 // AttachInternetGateway mocks base method.
@@ -92,18 +177,77 @@ func (mr *MockInterfaceMockRecorder) AuthorizeSecurityGroupRules(arg0, arg1, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeSecurityGroupRules", reflect.TypeOf((*MockInterface)(nil).AuthorizeSecurityGroupRules), arg0, arg1, arg2)
 }
 
+// CopyImage mocks base method.
+func (m *MockInterface) CopyImage(arg0 context.Context, arg1, arg2, arg3 string, arg4 *string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyImage", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopyImage indicates an expected call of CopyImage.
+func (mr *MockInterfaceMockRecorder) CopyImage(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyImage", reflect.TypeOf((*MockInterface)(nil).CopyImage), arg0, arg1, arg2, arg3, arg4)
+}
+
+// CreateAccessKey mocks base method.
+func (m *MockInterface) CreateAccessKey(arg0 context.Context) (*client.AccessKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccessKey", arg0)
+	ret0, _ := ret[0].(*client.AccessKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccessKey indicates an expected call of CreateAccessKey.
+func (mr *MockInterfaceMockRecorder) CreateAccessKey(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccessKey", reflect.TypeOf((*MockInterface)(nil).CreateAccessKey), arg0)
+}
+
+// CreateAutoScalingGroup mocks base method.
+func (m *MockInterface) CreateAutoScalingGroup(arg0 context.Context, arg1 *client.AutoScalingGroup) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAutoScalingGroup", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAutoScalingGroup indicates an expected call of CreateAutoScalingGroup.
+func (mr *MockInterfaceMockRecorder) CreateAutoScalingGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAutoScalingGroup", reflect.TypeOf((*MockInterface)(nil).CreateAutoScalingGroup), arg0, arg1)
+}
+
 // CreateBucketIfNotExists mocks base method.
-func (m *MockInterface) CreateBucketIfNotExists(arg0 context.Context, arg1, arg2 string) error {
+func (m *MockInterface) CreateBucketIfNotExists(arg0 context.Context, arg1, arg2 string, arg3 *client.BucketConfiguration) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBucketIfNotExists", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "CreateBucketIfNotExists", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateBucketIfNotExists indicates an expected call of CreateBucketIfNotExists.
-func (mr *MockInterfaceMockRecorder) CreateBucketIfNotExists(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockInterfaceMockRecorder) CreateBucketIfNotExists(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBucketIfNotExists", reflect.TypeOf((*MockInterface)(nil).CreateBucketIfNotExists), arg0, arg1, arg2, arg3)
+}
+
+// CreateCarrierGateway mocks base method.
+func (m *MockInterface) CreateCarrierGateway(arg0 context.Context, arg1 *client.CarrierGateway) (*client.CarrierGateway, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCarrierGateway", arg0, arg1)
+	ret0, _ := ret[0].(*client.CarrierGateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCarrierGateway indicates an expected call of CreateCarrierGateway.
+func (mr *MockInterfaceMockRecorder) CreateCarrierGateway(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBucketIfNotExists", reflect.TypeOf((*MockInterface)(nil).CreateBucketIfNotExists), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCarrierGateway", reflect.TypeOf((*MockInterface)(nil).CreateCarrierGateway), arg0, arg1)
 }
 
 // CreateEC2Tags mocks base method.
@@ -120,6 +264,36 @@ func (mr *MockInterfaceMockRecorder) CreateEC2Tags(arg0, arg1, arg2 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEC2Tags", reflect.TypeOf((*MockInterface)(nil).CreateEC2Tags), arg0, arg1, arg2)
 }
 
+// CreateEgressOnlyInternetGateway mocks base method.
+func (m *MockInterface) CreateEgressOnlyInternetGateway(arg0 context.Context, arg1 *client.EgressOnlyInternetGateway) (*client.EgressOnlyInternetGateway, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEgressOnlyInternetGateway", arg0, arg1)
+	ret0, _ := ret[0].(*client.EgressOnlyInternetGateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEgressOnlyInternetGateway indicates an expected call of CreateEgressOnlyInternetGateway.
+func (mr *MockInterfaceMockRecorder) CreateEgressOnlyInternetGateway(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEgressOnlyInternetGateway", reflect.TypeOf((*MockInterface)(nil).CreateEgressOnlyInternetGateway), arg0, arg1)
+}
+
+// CreateElasticFileSystem mocks base method.
+func (m *MockInterface) CreateElasticFileSystem(arg0 context.Context, arg1 *client.ElasticFileSystem) (*client.ElasticFileSystem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateElasticFileSystem", arg0, arg1)
+	ret0, _ := ret[0].(*client.ElasticFileSystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateElasticFileSystem indicates an expected call of CreateElasticFileSystem.
+func (mr *MockInterfaceMockRecorder) CreateElasticFileSystem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateElasticFileSystem", reflect.TypeOf((*MockInterface)(nil).CreateElasticFileSystem), arg0, arg1)
+}
+
 // CreateElasticIP mocks base method.
 func (m *MockInterface) CreateElasticIP(arg0 context.Context, arg1 *client.ElasticIP) (*client.ElasticIP, error) {
 	m.ctrl.T.Helper()
@@ -135,6 +309,36 @@ func (mr *MockInterfaceMockRecorder) CreateElasticIP(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateElasticIP", reflect.TypeOf((*MockInterface)(nil).CreateElasticIP), arg0, arg1)
 }
 
+// CreateFlowLog mocks base method.
+func (m *MockInterface) CreateFlowLog(arg0 context.Context, arg1 *client.FlowLog) (*client.FlowLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFlowLog", arg0, arg1)
+	ret0, _ := ret[0].(*client.FlowLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFlowLog indicates an expected call of CreateFlowLog.
+func (mr *MockInterfaceMockRecorder) CreateFlowLog(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFlowLog", reflect.TypeOf((*MockInterface)(nil).CreateFlowLog), arg0, arg1)
+}
+
+// CreateHealthCheck mocks base method.
+func (m *MockInterface) CreateHealthCheck(arg0 context.Context, arg1 string, arg2 *client.HealthCheck) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHealthCheck", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateHealthCheck indicates an expected call of CreateHealthCheck.
+func (mr *MockInterfaceMockRecorder) CreateHealthCheck(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHealthCheck", reflect.TypeOf((*MockInterface)(nil).CreateHealthCheck), arg0, arg1, arg2)
+}
+
 // CreateIAMInstanceProfile mocks base method.
 func (m *MockInterface) CreateIAMInstanceProfile(arg0 context.Context, arg1 *client.IAMInstanceProfile) (*client.IAMInstanceProfile, error) {
 	m.ctrl.T.Helper()
@@ -180,6 +384,81 @@ func (mr *MockInterfaceMockRecorder) CreateInternetGateway(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInternetGateway", reflect.TypeOf((*MockInterface)(nil).CreateInternetGateway), arg0, arg1)
 }
 
+// CreateLaunchTemplate mocks base method.
+func (m *MockInterface) CreateLaunchTemplate(arg0 context.Context, arg1 *client.LaunchTemplate) (*client.LaunchTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLaunchTemplate", arg0, arg1)
+	ret0, _ := ret[0].(*client.LaunchTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLaunchTemplate indicates an expected call of CreateLaunchTemplate.
+func (mr *MockInterfaceMockRecorder) CreateLaunchTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLaunchTemplate", reflect.TypeOf((*MockInterface)(nil).CreateLaunchTemplate), arg0, arg1)
+}
+
+// CreateLaunchTemplateVersion mocks base method.
+func (m *MockInterface) CreateLaunchTemplateVersion(arg0 context.Context, arg1 *client.LaunchTemplate) (*client.LaunchTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLaunchTemplateVersion", arg0, arg1)
+	ret0, _ := ret[0].(*client.LaunchTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLaunchTemplateVersion indicates an expected call of CreateLaunchTemplateVersion.
+func (mr *MockInterfaceMockRecorder) CreateLaunchTemplateVersion(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLaunchTemplateVersion", reflect.TypeOf((*MockInterface)(nil).CreateLaunchTemplateVersion), arg0, arg1)
+}
+
+// CreateLogGroup mocks base method.
+func (m *MockInterface) CreateLogGroup(arg0 context.Context, arg1 *client.LogGroup) (*client.LogGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLogGroup", arg0, arg1)
+	ret0, _ := ret[0].(*client.LogGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLogGroup indicates an expected call of CreateLogGroup.
+func (mr *MockInterfaceMockRecorder) CreateLogGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLogGroup", reflect.TypeOf((*MockInterface)(nil).CreateLogGroup), arg0, arg1)
+}
+
+// CreateManagedPrefixList mocks base method.
+func (m *MockInterface) CreateManagedPrefixList(arg0 context.Context, arg1 *client.ManagedPrefixList, arg2 []string) (*client.ManagedPrefixList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateManagedPrefixList", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*client.ManagedPrefixList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateManagedPrefixList indicates an expected call of CreateManagedPrefixList.
+func (mr *MockInterfaceMockRecorder) CreateManagedPrefixList(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateManagedPrefixList", reflect.TypeOf((*MockInterface)(nil).CreateManagedPrefixList), arg0, arg1, arg2)
+}
+
+// CreateMountTarget mocks base method.
+func (m *MockInterface) CreateMountTarget(arg0 context.Context, arg1 *client.MountTarget) (*client.MountTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMountTarget", arg0, arg1)
+	ret0, _ := ret[0].(*client.MountTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMountTarget indicates an expected call of CreateMountTarget.
+func (mr *MockInterfaceMockRecorder) CreateMountTarget(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMountTarget", reflect.TypeOf((*MockInterface)(nil).CreateMountTarget), arg0, arg1)
+}
+
 // CreateNATGateway mocks base method.
 func (m *MockInterface) CreateNATGateway(arg0 context.Context, arg1 *client.NATGateway) (*client.NATGateway, error) {
 	m.ctrl.T.Helper()
@@ -195,18 +474,122 @@ func (mr *MockInterfaceMockRecorder) CreateNATGateway(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNATGateway", reflect.TypeOf((*MockInterface)(nil).CreateNATGateway), arg0, arg1)
 }
 
+// CreateNetworkAcl mocks base method.
+func (m *MockInterface) CreateNetworkAcl(arg0 context.Context, arg1 *client.NetworkAcl) (*client.NetworkAcl, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNetworkAcl", arg0, arg1)
+	ret0, _ := ret[0].(*client.NetworkAcl)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNetworkAcl indicates an expected call of CreateNetworkAcl.
+func (mr *MockInterfaceMockRecorder) CreateNetworkAcl(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetworkAcl", reflect.TypeOf((*MockInterface)(nil).CreateNetworkAcl), arg0, arg1)
+}
+
+// CreateNetworkAclEntry mocks base method.
+func (m *MockInterface) CreateNetworkAclEntry(arg0 context.Context, arg1 string, arg2 *client.NetworkAclEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNetworkAclEntry", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNetworkAclEntry indicates an expected call of CreateNetworkAclEntry.
+func (mr *MockInterfaceMockRecorder) CreateNetworkAclEntry(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetworkAclEntry", reflect.TypeOf((*MockInterface)(nil).CreateNetworkAclEntry), arg0, arg1, arg2)
+}
+
+// CreateOpenIDConnectProvider mocks base method.
+func (m *MockInterface) CreateOpenIDConnectProvider(arg0 context.Context, arg1 *client.OpenIDConnectProvider) (*client.OpenIDConnectProvider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOpenIDConnectProvider", arg0, arg1)
+	ret0, _ := ret[0].(*client.OpenIDConnectProvider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOpenIDConnectProvider indicates an expected call of CreateOpenIDConnectProvider.
+func (mr *MockInterfaceMockRecorder) CreateOpenIDConnectProvider(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOpenIDConnectProvider", reflect.TypeOf((*MockInterface)(nil).CreateOpenIDConnectProvider), arg0, arg1)
+}
+
 // CreateOrUpdateDNSRecordSet mocks base method.
-func (m *MockInterface) CreateOrUpdateDNSRecordSet(arg0 context.Context, arg1, arg2, arg3 string, arg4 []string, arg5 int64, arg6 client.IPStack) error {
+func (m *MockInterface) CreateOrUpdateDNSRecordSet(arg0 context.Context, arg1, arg2, arg3 string, arg4 []string, arg5 int64, arg6 client.IPStack, arg7 *client.RoutingPolicy) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOrUpdateDNSRecordSet", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret := m.ctrl.Call(m, "CreateOrUpdateDNSRecordSet", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateOrUpdateDNSRecordSet indicates an expected call of CreateOrUpdateDNSRecordSet.
-func (mr *MockInterfaceMockRecorder) CreateOrUpdateDNSRecordSet(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+func (mr *MockInterfaceMockRecorder) CreateOrUpdateDNSRecordSet(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateDNSRecordSet", reflect.TypeOf((*MockInterface)(nil).CreateOrUpdateDNSRecordSet), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+}
+
+// CreatePlacementGroup mocks base method.
+func (m *MockInterface) CreatePlacementGroup(arg0 context.Context, arg1 *client.PlacementGroup) (*client.PlacementGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePlacementGroup", arg0, arg1)
+	ret0, _ := ret[0].(*client.PlacementGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePlacementGroup indicates an expected call of CreatePlacementGroup.
+func (mr *MockInterfaceMockRecorder) CreatePlacementGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlacementGroup", reflect.TypeOf((*MockInterface)(nil).CreatePlacementGroup), arg0, arg1)
+}
+
+// CreateQueue mocks base method.
+func (m *MockInterface) CreateQueue(arg0 context.Context, arg1 *client.Queue) (*client.Queue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateQueue", arg0, arg1)
+	ret0, _ := ret[0].(*client.Queue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateQueue indicates an expected call of CreateQueue.
+func (mr *MockInterfaceMockRecorder) CreateQueue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQueue", reflect.TypeOf((*MockInterface)(nil).CreateQueue), arg0, arg1)
+}
+
+// CreateResolverEndpoint mocks base method.
+func (m *MockInterface) CreateResolverEndpoint(arg0 context.Context, arg1 *client.ResolverEndpoint) (*client.ResolverEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateResolverEndpoint", arg0, arg1)
+	ret0, _ := ret[0].(*client.ResolverEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateResolverEndpoint indicates an expected call of CreateResolverEndpoint.
+func (mr *MockInterfaceMockRecorder) CreateResolverEndpoint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResolverEndpoint", reflect.TypeOf((*MockInterface)(nil).CreateResolverEndpoint), arg0, arg1)
+}
+
+// CreateResolverRule mocks base method.
+func (m *MockInterface) CreateResolverRule(arg0 context.Context, arg1 *client.ResolverRule) (*client.ResolverRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateResolverRule", arg0, arg1)
+	ret0, _ := ret[0].(*client.ResolverRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateResolverRule indicates an expected call of CreateResolverRule.
+func (mr *MockInterfaceMockRecorder) CreateResolverRule(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateDNSRecordSet", reflect.TypeOf((*MockInterface)(nil).CreateOrUpdateDNSRecordSet), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResolverRule", reflect.TypeOf((*MockInterface)(nil).CreateResolverRule), arg0, arg1)
 }
 
 // CreateRoute mocks base method.
@@ -283,6 +666,21 @@ func (mr *MockInterfaceMockRecorder) CreateSubnet(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubnet", reflect.TypeOf((*MockInterface)(nil).CreateSubnet), arg0, arg1)
 }
 
+// CreateTransitGatewayVpcAttachment mocks base method.
+func (m *MockInterface) CreateTransitGatewayVpcAttachment(arg0 context.Context, arg1 *client.TransitGatewayVpcAttachment) (*client.TransitGatewayVpcAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransitGatewayVpcAttachment", arg0, arg1)
+	ret0, _ := ret[0].(*client.TransitGatewayVpcAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransitGatewayVpcAttachment indicates an expected call of CreateTransitGatewayVpcAttachment.
+func (mr *MockInterfaceMockRecorder) CreateTransitGatewayVpcAttachment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransitGatewayVpcAttachment", reflect.TypeOf((*MockInterface)(nil).CreateTransitGatewayVpcAttachment), arg0, arg1)
+}
+
 // CreateVpc mocks base method.
 func (m *MockInterface) CreateVpc(arg0 context.Context, arg1 *client.VPC) (*client.VPC, error) {
 	m.ctrl.T.Helper()
@@ -342,76 +740,190 @@ func (mr *MockInterfaceMockRecorder) CreateVpcEndpointRouteTableAssociation(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpcEndpointRouteTableAssociation", reflect.TypeOf((*MockInterface)(nil).CreateVpcEndpointRouteTableAssociation), arg0, arg1, arg2)
 }
 
-// DeleteBucketIfExists mocks base method.
-func (m *MockInterface) DeleteBucketIfExists(arg0 context.Context, arg1 string) error {
+// CreateVpcEndpointService mocks base method.
+func (m *MockInterface) CreateVpcEndpointService(arg0 context.Context, arg1 *client.VpcEndpointService) (*client.VpcEndpointService, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteBucketIfExists", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateVpcEndpointService", arg0, arg1)
+	ret0, _ := ret[0].(*client.VpcEndpointService)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteBucketIfExists indicates an expected call of DeleteBucketIfExists.
-func (mr *MockInterfaceMockRecorder) DeleteBucketIfExists(arg0, arg1 interface{}) *gomock.Call {
+// CreateVpcEndpointService indicates an expected call of CreateVpcEndpointService.
+func (mr *MockInterfaceMockRecorder) CreateVpcEndpointService(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBucketIfExists", reflect.TypeOf((*MockInterface)(nil).DeleteBucketIfExists), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpcEndpointService", reflect.TypeOf((*MockInterface)(nil).CreateVpcEndpointService), arg0, arg1)
 }
 
-// DeleteDNSRecordSet mocks base method.
-func (m *MockInterface) DeleteDNSRecordSet(arg0 context.Context, arg1, arg2, arg3 string, arg4 []string, arg5 int64, arg6 client.IPStack) error {
+// CreateVpcPeeringConnection mocks base method.
+func (m *MockInterface) CreateVpcPeeringConnection(arg0 context.Context, arg1 *client.VpcPeeringConnection) (*client.VpcPeeringConnection, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteDNSRecordSet", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateVpcPeeringConnection", arg0, arg1)
+	ret0, _ := ret[0].(*client.VpcPeeringConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteDNSRecordSet indicates an expected call of DeleteDNSRecordSet.
-func (mr *MockInterfaceMockRecorder) DeleteDNSRecordSet(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+// CreateVpcPeeringConnection indicates an expected call of CreateVpcPeeringConnection.
+func (mr *MockInterfaceMockRecorder) CreateVpcPeeringConnection(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDNSRecordSet", reflect.TypeOf((*MockInterface)(nil).DeleteDNSRecordSet), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpcPeeringConnection", reflect.TypeOf((*MockInterface)(nil).CreateVpcPeeringConnection), arg0, arg1)
 }
 
-// DeleteEC2Tags mocks base method.
-func (m *MockInterface) DeleteEC2Tags(arg0 context.Context, arg1 []string, arg2 client.Tags) error {
+// DeleteAccessKey mocks base method.
+func (m *MockInterface) DeleteAccessKey(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteEC2Tags", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "DeleteAccessKey", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteEC2Tags indicates an expected call of DeleteEC2Tags.
-func (mr *MockInterfaceMockRecorder) DeleteEC2Tags(arg0, arg1, arg2 interface{}) *gomock.Call {
+// DeleteAccessKey indicates an expected call of DeleteAccessKey.
+func (mr *MockInterfaceMockRecorder) DeleteAccessKey(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEC2Tags", reflect.TypeOf((*MockInterface)(nil).DeleteEC2Tags), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccessKey", reflect.TypeOf((*MockInterface)(nil).DeleteAccessKey), arg0, arg1)
 }
 
-// DeleteELB mocks base method.
-func (m *MockInterface) DeleteELB(arg0 context.Context, arg1 string) error {
+// DeleteAutoScalingGroup mocks base method.
+func (m *MockInterface) DeleteAutoScalingGroup(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteELB", arg0, arg1)
+	ret := m.ctrl.Call(m, "DeleteAutoScalingGroup", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteELB indicates an expected call of DeleteELB.
-func (mr *MockInterfaceMockRecorder) DeleteELB(arg0, arg1 interface{}) *gomock.Call {
+// DeleteAutoScalingGroup indicates an expected call of DeleteAutoScalingGroup.
+func (mr *MockInterfaceMockRecorder) DeleteAutoScalingGroup(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteELB", reflect.TypeOf((*MockInterface)(nil).DeleteELB), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAutoScalingGroup", reflect.TypeOf((*MockInterface)(nil).DeleteAutoScalingGroup), arg0, arg1)
 }
 
-// DeleteELBV2 mocks base method.
-func (m *MockInterface) DeleteELBV2(arg0 context.Context, arg1 string) error {
+// DeleteBucketIfExists mocks base method.
+func (m *MockInterface) DeleteBucketIfExists(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteELBV2", arg0, arg1)
+	ret := m.ctrl.Call(m, "DeleteBucketIfExists", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteELBV2 indicates an expected call of DeleteELBV2.
-func (mr *MockInterfaceMockRecorder) DeleteELBV2(arg0, arg1 interface{}) *gomock.Call {
+// DeleteBucketIfExists indicates an expected call of DeleteBucketIfExists.
+func (mr *MockInterfaceMockRecorder) DeleteBucketIfExists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBucketIfExists", reflect.TypeOf((*MockInterface)(nil).DeleteBucketIfExists), arg0, arg1)
+}
+
+// DeleteBucketReplication mocks base method.
+func (m *MockInterface) DeleteBucketReplication(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBucketReplication", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBucketReplication indicates an expected call of DeleteBucketReplication.
+func (mr *MockInterfaceMockRecorder) DeleteBucketReplication(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBucketReplication", reflect.TypeOf((*MockInterface)(nil).DeleteBucketReplication), arg0, arg1)
+}
+
+// DeleteCarrierGateway mocks base method.
+func (m *MockInterface) DeleteCarrierGateway(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCarrierGateway", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCarrierGateway indicates an expected call of DeleteCarrierGateway.
+func (mr *MockInterfaceMockRecorder) DeleteCarrierGateway(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCarrierGateway", reflect.TypeOf((*MockInterface)(nil).DeleteCarrierGateway), arg0, arg1)
+}
+
+// DeleteDNSRecordSet mocks base method.
+func (m *MockInterface) DeleteDNSRecordSet(arg0 context.Context, arg1, arg2, arg3 string, arg4 []string, arg5 int64, arg6 client.IPStack, arg7 *client.RoutingPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDNSRecordSet", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDNSRecordSet indicates an expected call of DeleteDNSRecordSet.
+func (mr *MockInterfaceMockRecorder) DeleteDNSRecordSet(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDNSRecordSet", reflect.TypeOf((*MockInterface)(nil).DeleteDNSRecordSet), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+}
+
+// DeleteEC2Tags mocks base method.
+func (m *MockInterface) DeleteEC2Tags(arg0 context.Context, arg1 []string, arg2 client.Tags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEC2Tags", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEC2Tags indicates an expected call of DeleteEC2Tags.
+func (mr *MockInterfaceMockRecorder) DeleteEC2Tags(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEC2Tags", reflect.TypeOf((*MockInterface)(nil).DeleteEC2Tags), arg0, arg1, arg2)
+}
+
+// DeleteELB mocks base method.
+func (m *MockInterface) DeleteELB(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteELB", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteELB indicates an expected call of DeleteELB.
+func (mr *MockInterfaceMockRecorder) DeleteELB(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteELB", reflect.TypeOf((*MockInterface)(nil).DeleteELB), arg0, arg1)
+}
+
+// DeleteELBV2 mocks base method.
+func (m *MockInterface) DeleteELBV2(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteELBV2", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteELBV2 indicates an expected call of DeleteELBV2.
+func (mr *MockInterfaceMockRecorder) DeleteELBV2(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteELBV2", reflect.TypeOf((*MockInterface)(nil).DeleteELBV2), arg0, arg1)
 }
 
+// DeleteEgressOnlyInternetGateway mocks base method.
+func (m *MockInterface) DeleteEgressOnlyInternetGateway(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEgressOnlyInternetGateway", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEgressOnlyInternetGateway indicates an expected call of DeleteEgressOnlyInternetGateway.
+func (mr *MockInterfaceMockRecorder) DeleteEgressOnlyInternetGateway(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEgressOnlyInternetGateway", reflect.TypeOf((*MockInterface)(nil).DeleteEgressOnlyInternetGateway), arg0, arg1)
+}
+
+// DeleteElasticFileSystem mocks base method.
+func (m *MockInterface) DeleteElasticFileSystem(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteElasticFileSystem", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteElasticFileSystem indicates an expected call of DeleteElasticFileSystem.
+func (mr *MockInterfaceMockRecorder) DeleteElasticFileSystem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteElasticFileSystem", reflect.TypeOf((*MockInterface)(nil).DeleteElasticFileSystem), arg0, arg1)
+}
+
 // DeleteElasticIP mocks base method.
 func (m *MockInterface) DeleteElasticIP(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -426,6 +938,48 @@ func (mr *MockInterfaceMockRecorder) DeleteElasticIP(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteElasticIP", reflect.TypeOf((*MockInterface)(nil).DeleteElasticIP), arg0, arg1)
 }
 
+// DeleteEventRule mocks base method.
+func (m *MockInterface) DeleteEventRule(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventRule", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEventRule indicates an expected call of DeleteEventRule.
+func (mr *MockInterfaceMockRecorder) DeleteEventRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventRule", reflect.TypeOf((*MockInterface)(nil).DeleteEventRule), arg0, arg1)
+}
+
+// DeleteFlowLog mocks base method.
+func (m *MockInterface) DeleteFlowLog(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFlowLog", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFlowLog indicates an expected call of DeleteFlowLog.
+func (mr *MockInterfaceMockRecorder) DeleteFlowLog(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFlowLog", reflect.TypeOf((*MockInterface)(nil).DeleteFlowLog), arg0, arg1)
+}
+
+// DeleteHealthCheck mocks base method.
+func (m *MockInterface) DeleteHealthCheck(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHealthCheck", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHealthCheck indicates an expected call of DeleteHealthCheck.
+func (mr *MockInterfaceMockRecorder) DeleteHealthCheck(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHealthCheck", reflect.TypeOf((*MockInterface)(nil).DeleteHealthCheck), arg0, arg1)
+}
+
 // DeleteIAMInstanceProfile mocks base method.
 func (m *MockInterface) DeleteIAMInstanceProfile(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -496,6 +1050,62 @@ func (mr *MockInterfaceMockRecorder) DeleteKeyPair(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKeyPair", reflect.TypeOf((*MockInterface)(nil).DeleteKeyPair), arg0, arg1)
 }
 
+// DeleteLaunchTemplate mocks base method.
+func (m *MockInterface) DeleteLaunchTemplate(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLaunchTemplate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLaunchTemplate indicates an expected call of DeleteLaunchTemplate.
+func (mr *MockInterfaceMockRecorder) DeleteLaunchTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLaunchTemplate", reflect.TypeOf((*MockInterface)(nil).DeleteLaunchTemplate), arg0, arg1)
+}
+
+// DeleteLogGroup mocks base method.
+func (m *MockInterface) DeleteLogGroup(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLogGroup", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLogGroup indicates an expected call of DeleteLogGroup.
+func (mr *MockInterfaceMockRecorder) DeleteLogGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLogGroup", reflect.TypeOf((*MockInterface)(nil).DeleteLogGroup), arg0, arg1)
+}
+
+// DeleteManagedPrefixList mocks base method.
+func (m *MockInterface) DeleteManagedPrefixList(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteManagedPrefixList", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteManagedPrefixList indicates an expected call of DeleteManagedPrefixList.
+func (mr *MockInterfaceMockRecorder) DeleteManagedPrefixList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteManagedPrefixList", reflect.TypeOf((*MockInterface)(nil).DeleteManagedPrefixList), arg0, arg1)
+}
+
+// DeleteMountTarget mocks base method.
+func (m *MockInterface) DeleteMountTarget(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMountTarget", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMountTarget indicates an expected call of DeleteMountTarget.
+func (mr *MockInterfaceMockRecorder) DeleteMountTarget(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMountTarget", reflect.TypeOf((*MockInterface)(nil).DeleteMountTarget), arg0, arg1)
+}
+
 // DeleteNATGateway mocks base method.
 func (m *MockInterface) DeleteNATGateway(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -510,6 +1120,48 @@ func (mr *MockInterfaceMockRecorder) DeleteNATGateway(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNATGateway", reflect.TypeOf((*MockInterface)(nil).DeleteNATGateway), arg0, arg1)
 }
 
+// DeleteNetworkAcl mocks base method.
+func (m *MockInterface) DeleteNetworkAcl(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetworkAcl", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNetworkAcl indicates an expected call of DeleteNetworkAcl.
+func (mr *MockInterfaceMockRecorder) DeleteNetworkAcl(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkAcl", reflect.TypeOf((*MockInterface)(nil).DeleteNetworkAcl), arg0, arg1)
+}
+
+// DeleteNetworkAclEntry mocks base method.
+func (m *MockInterface) DeleteNetworkAclEntry(arg0 context.Context, arg1 string, arg2 *client.NetworkAclEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetworkAclEntry", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNetworkAclEntry indicates an expected call of DeleteNetworkAclEntry.
+func (mr *MockInterfaceMockRecorder) DeleteNetworkAclEntry(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkAclEntry", reflect.TypeOf((*MockInterface)(nil).DeleteNetworkAclEntry), arg0, arg1, arg2)
+}
+
+// DeleteObject mocks base method.
+func (m *MockInterface) DeleteObject(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObject", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteObject indicates an expected call of DeleteObject.
+func (mr *MockInterfaceMockRecorder) DeleteObject(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObject", reflect.TypeOf((*MockInterface)(nil).DeleteObject), arg0, arg1, arg2)
+}
+
 // DeleteObjectsWithPrefix mocks base method.
 func (m *MockInterface) DeleteObjectsWithPrefix(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -524,6 +1176,76 @@ func (mr *MockInterfaceMockRecorder) DeleteObjectsWithPrefix(arg0, arg1, arg2 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObjectsWithPrefix", reflect.TypeOf((*MockInterface)(nil).DeleteObjectsWithPrefix), arg0, arg1, arg2)
 }
 
+// DeleteOpenIDConnectProvider mocks base method.
+func (m *MockInterface) DeleteOpenIDConnectProvider(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOpenIDConnectProvider", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOpenIDConnectProvider indicates an expected call of DeleteOpenIDConnectProvider.
+func (mr *MockInterfaceMockRecorder) DeleteOpenIDConnectProvider(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOpenIDConnectProvider", reflect.TypeOf((*MockInterface)(nil).DeleteOpenIDConnectProvider), arg0, arg1)
+}
+
+// DeletePlacementGroup mocks base method.
+func (m *MockInterface) DeletePlacementGroup(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePlacementGroup", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePlacementGroup indicates an expected call of DeletePlacementGroup.
+func (mr *MockInterfaceMockRecorder) DeletePlacementGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePlacementGroup", reflect.TypeOf((*MockInterface)(nil).DeletePlacementGroup), arg0, arg1)
+}
+
+// DeleteQueue mocks base method.
+func (m *MockInterface) DeleteQueue(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQueue", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteQueue indicates an expected call of DeleteQueue.
+func (mr *MockInterfaceMockRecorder) DeleteQueue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQueue", reflect.TypeOf((*MockInterface)(nil).DeleteQueue), arg0, arg1)
+}
+
+// DeleteResolverEndpoint mocks base method.
+func (m *MockInterface) DeleteResolverEndpoint(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteResolverEndpoint", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteResolverEndpoint indicates an expected call of DeleteResolverEndpoint.
+func (mr *MockInterfaceMockRecorder) DeleteResolverEndpoint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResolverEndpoint", reflect.TypeOf((*MockInterface)(nil).DeleteResolverEndpoint), arg0, arg1)
+}
+
+// DeleteResolverRule mocks base method.
+func (m *MockInterface) DeleteResolverRule(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteResolverRule", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteResolverRule indicates an expected call of DeleteResolverRule.
+func (mr *MockInterfaceMockRecorder) DeleteResolverRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResolverRule", reflect.TypeOf((*MockInterface)(nil).DeleteResolverRule), arg0, arg1)
+}
+
 // DeleteRoute mocks base method.
 func (m *MockInterface) DeleteRoute(arg0 context.Context, arg1 string, arg2 *client.Route) error {
 	m.ctrl.T.Helper()
@@ -594,6 +1316,34 @@ func (mr *MockInterfaceMockRecorder) DeleteSubnet(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubnet", reflect.TypeOf((*MockInterface)(nil).DeleteSubnet), arg0, arg1)
 }
 
+// DeleteTransitGatewayVpcAttachment mocks base method.
+func (m *MockInterface) DeleteTransitGatewayVpcAttachment(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransitGatewayVpcAttachment", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransitGatewayVpcAttachment indicates an expected call of DeleteTransitGatewayVpcAttachment.
+func (mr *MockInterfaceMockRecorder) DeleteTransitGatewayVpcAttachment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransitGatewayVpcAttachment", reflect.TypeOf((*MockInterface)(nil).DeleteTransitGatewayVpcAttachment), arg0, arg1)
+}
+
+// DeleteVolume mocks base method.
+func (m *MockInterface) DeleteVolume(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVolume", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVolume indicates an expected call of DeleteVolume.
+func (mr *MockInterfaceMockRecorder) DeleteVolume(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolume", reflect.TypeOf((*MockInterface)(nil).DeleteVolume), arg0, arg1)
+}
+
 // DeleteVpc mocks base method.
 func (m *MockInterface) DeleteVpc(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -650,6 +1400,34 @@ func (mr *MockInterfaceMockRecorder) DeleteVpcEndpointRouteTableAssociation(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpcEndpointRouteTableAssociation", reflect.TypeOf((*MockInterface)(nil).DeleteVpcEndpointRouteTableAssociation), arg0, arg1, arg2)
 }
 
+// DeleteVpcEndpointService mocks base method.
+func (m *MockInterface) DeleteVpcEndpointService(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVpcEndpointService", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVpcEndpointService indicates an expected call of DeleteVpcEndpointService.
+func (mr *MockInterfaceMockRecorder) DeleteVpcEndpointService(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpcEndpointService", reflect.TypeOf((*MockInterface)(nil).DeleteVpcEndpointService), arg0, arg1)
+}
+
+// DeleteVpcPeeringConnection mocks base method.
+func (m *MockInterface) DeleteVpcPeeringConnection(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVpcPeeringConnection", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVpcPeeringConnection indicates an expected call of DeleteVpcPeeringConnection.
+func (mr *MockInterfaceMockRecorder) DeleteVpcPeeringConnection(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpcPeeringConnection", reflect.TypeOf((*MockInterface)(nil).DeleteVpcPeeringConnection), arg0, arg1)
+}
+
 // DetachInternetGateway mocks base method.
 func (m *MockInterface) DetachInternetGateway(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -664,379 +1442,1350 @@ func (mr *MockInterfaceMockRecorder) DetachInternetGateway(arg0, arg1, arg2 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachInternetGateway", reflect.TypeOf((*MockInterface)(nil).DetachInternetGateway), arg0, arg1, arg2)
 }
 
-// FindDefaultSecurityGroupByVpcId mocks base method.
-func (m *MockInterface) FindDefaultSecurityGroupByVpcId(arg0 context.Context, arg1 string) (*client.SecurityGroup, error) {
+// DisableInstanceSourceDestCheck mocks base method.
+func (m *MockInterface) DisableInstanceSourceDestCheck(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindDefaultSecurityGroupByVpcId", arg0, arg1)
-	ret0, _ := ret[0].(*client.SecurityGroup)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DisableInstanceSourceDestCheck", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// FindDefaultSecurityGroupByVpcId indicates an expected call of FindDefaultSecurityGroupByVpcId.
-func (mr *MockInterfaceMockRecorder) FindDefaultSecurityGroupByVpcId(arg0, arg1 interface{}) *gomock.Call {
+// DisableInstanceSourceDestCheck indicates an expected call of DisableInstanceSourceDestCheck.
+func (mr *MockInterfaceMockRecorder) DisableInstanceSourceDestCheck(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDefaultSecurityGroupByVpcId", reflect.TypeOf((*MockInterface)(nil).FindDefaultSecurityGroupByVpcId), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableInstanceSourceDestCheck", reflect.TypeOf((*MockInterface)(nil).DisableInstanceSourceDestCheck), arg0, arg1)
 }
 
-// FindElasticIPsByTags mocks base method.
-func (m *MockInterface) FindElasticIPsByTags(arg0 context.Context, arg1 client.Tags) ([]*client.ElasticIP, error) {
+// DisassociateResolverRule mocks base method.
+func (m *MockInterface) DisassociateResolverRule(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindElasticIPsByTags", arg0, arg1)
-	ret0, _ := ret[0].([]*client.ElasticIP)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DisassociateResolverRule", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// FindElasticIPsByTags indicates an expected call of FindElasticIPsByTags.
+// DisassociateResolverRule indicates an expected call of DisassociateResolverRule.
+func (mr *MockInterfaceMockRecorder) DisassociateResolverRule(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisassociateResolverRule", reflect.TypeOf((*MockInterface)(nil).DisassociateResolverRule), arg0, arg1, arg2)
+}
+
+// DisassociateVpcCidrBlock mocks base method.
+func (m *MockInterface) DisassociateVpcCidrBlock(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisassociateVpcCidrBlock", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisassociateVpcCidrBlock indicates an expected call of DisassociateVpcCidrBlock.
+func (mr *MockInterfaceMockRecorder) DisassociateVpcCidrBlock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisassociateVpcCidrBlock", reflect.TypeOf((*MockInterface)(nil).DisassociateVpcCidrBlock), arg0, arg1)
+}
+
+// EnableSerialConsoleAccess mocks base method.
+func (m *MockInterface) EnableSerialConsoleAccess(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableSerialConsoleAccess", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableSerialConsoleAccess indicates an expected call of EnableSerialConsoleAccess.
+func (mr *MockInterfaceMockRecorder) EnableSerialConsoleAccess(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableSerialConsoleAccess", reflect.TypeOf((*MockInterface)(nil).EnableSerialConsoleAccess), arg0)
+}
+
+// FindBlackholeRoutesByTags mocks base method.
+func (m *MockInterface) FindBlackholeRoutesByTags(arg0 context.Context, arg1 client.Tags) (map[string][]*client.Route, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBlackholeRoutesByTags", arg0, arg1)
+	ret0, _ := ret[0].(map[string][]*client.Route)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBlackholeRoutesByTags indicates an expected call of FindBlackholeRoutesByTags.
+func (mr *MockInterfaceMockRecorder) FindBlackholeRoutesByTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBlackholeRoutesByTags", reflect.TypeOf((*MockInterface)(nil).FindBlackholeRoutesByTags), arg0, arg1)
+}
+
+// FindCarrierGatewaysByTags mocks base method.
+func (m *MockInterface) FindCarrierGatewaysByTags(arg0 context.Context, arg1 client.Tags) ([]*client.CarrierGateway, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCarrierGatewaysByTags", arg0, arg1)
+	ret0, _ := ret[0].([]*client.CarrierGateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCarrierGatewaysByTags indicates an expected call of FindCarrierGatewaysByTags.
+func (mr *MockInterfaceMockRecorder) FindCarrierGatewaysByTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCarrierGatewaysByTags", reflect.TypeOf((*MockInterface)(nil).FindCarrierGatewaysByTags), arg0, arg1)
+}
+
+// FindDefaultNetworkAclByVpcId mocks base method.
+func (m *MockInterface) FindDefaultNetworkAclByVpcId(arg0 context.Context, arg1 string) (*client.NetworkAcl, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDefaultNetworkAclByVpcId", arg0, arg1)
+	ret0, _ := ret[0].(*client.NetworkAcl)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDefaultNetworkAclByVpcId indicates an expected call of FindDefaultNetworkAclByVpcId.
+func (mr *MockInterfaceMockRecorder) FindDefaultNetworkAclByVpcId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDefaultNetworkAclByVpcId", reflect.TypeOf((*MockInterface)(nil).FindDefaultNetworkAclByVpcId), arg0, arg1)
+}
+
+// FindDefaultSecurityGroupByVpcId mocks base method.
+func (m *MockInterface) FindDefaultSecurityGroupByVpcId(arg0 context.Context, arg1 string) (*client.SecurityGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDefaultSecurityGroupByVpcId", arg0, arg1)
+	ret0, _ := ret[0].(*client.SecurityGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDefaultSecurityGroupByVpcId indicates an expected call of FindDefaultSecurityGroupByVpcId.
+func (mr *MockInterfaceMockRecorder) FindDefaultSecurityGroupByVpcId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDefaultSecurityGroupByVpcId", reflect.TypeOf((*MockInterface)(nil).FindDefaultSecurityGroupByVpcId), arg0, arg1)
+}
+
+// FindEgressOnlyInternetGatewaysByTags mocks base method.
+func (m *MockInterface) FindEgressOnlyInternetGatewaysByTags(arg0 context.Context, arg1 client.Tags) ([]*client.EgressOnlyInternetGateway, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindEgressOnlyInternetGatewaysByTags", arg0, arg1)
+	ret0, _ := ret[0].([]*client.EgressOnlyInternetGateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindEgressOnlyInternetGatewaysByTags indicates an expected call of FindEgressOnlyInternetGatewaysByTags.
+func (mr *MockInterfaceMockRecorder) FindEgressOnlyInternetGatewaysByTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEgressOnlyInternetGatewaysByTags", reflect.TypeOf((*MockInterface)(nil).FindEgressOnlyInternetGatewaysByTags), arg0, arg1)
+}
+
+// FindElasticFileSystemsByTags mocks base method.
+func (m *MockInterface) FindElasticFileSystemsByTags(arg0 context.Context, arg1 client.Tags) ([]*client.ElasticFileSystem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindElasticFileSystemsByTags", arg0, arg1)
+	ret0, _ := ret[0].([]*client.ElasticFileSystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindElasticFileSystemsByTags indicates an expected call of FindElasticFileSystemsByTags.
+func (mr *MockInterfaceMockRecorder) FindElasticFileSystemsByTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindElasticFileSystemsByTags", reflect.TypeOf((*MockInterface)(nil).FindElasticFileSystemsByTags), arg0, arg1)
+}
+
+// FindElasticIPsByTags mocks base method.
+func (m *MockInterface) FindElasticIPsByTags(arg0 context.Context, arg1 client.Tags) ([]*client.ElasticIP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindElasticIPsByTags", arg0, arg1)
+	ret0, _ := ret[0].([]*client.ElasticIP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindElasticIPsByTags indicates an expected call of FindElasticIPsByTags.
 func (mr *MockInterfaceMockRecorder) FindElasticIPsByTags(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindElasticIPsByTags", reflect.TypeOf((*MockInterface)(nil).FindElasticIPsByTags), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindElasticIPsByTags", reflect.TypeOf((*MockInterface)(nil).FindElasticIPsByTags), arg0, arg1)
+}
+
+// FindFlowLogsByTags mocks base method.
+func (m *MockInterface) FindFlowLogsByTags(arg0 context.Context, arg1 client.Tags) ([]*client.FlowLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFlowLogsByTags", arg0, arg1)
+	ret0, _ := ret[0].([]*client.FlowLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFlowLogsByTags indicates an expected call of FindFlowLogsByTags.
+func (mr *MockInterfaceMockRecorder) FindFlowLogsByTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFlowLogsByTags", reflect.TypeOf((*MockInterface)(nil).FindFlowLogsByTags), arg0, arg1)
+}
+
+// FindImageCopy mocks base method.
+func (m *MockInterface) FindImageCopy(arg0 context.Context, arg1, arg2 string) (*client.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindImageCopy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*client.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindImageCopy indicates an expected call of FindImageCopy.
+func (mr *MockInterfaceMockRecorder) FindImageCopy(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindImageCopy", reflect.TypeOf((*MockInterface)(nil).FindImageCopy), arg0, arg1, arg2)
+}
+
+// FindInstancesByTags mocks base method.
+func (m *MockInterface) FindInstancesByTags(arg0 context.Context, arg1 client.Tags) ([]*client.Instance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindInstancesByTags", arg0, arg1)
+	ret0, _ := ret[0].([]*client.Instance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindInstancesByTags indicates an expected call of FindInstancesByTags.
+func (mr *MockInterfaceMockRecorder) FindInstancesByTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindInstancesByTags", reflect.TypeOf((*MockInterface)(nil).FindInstancesByTags), arg0, arg1)
+}
+
+// FindInternetGatewayByVPC mocks base method.
+func (m *MockInterface) FindInternetGatewayByVPC(arg0 context.Context, arg1 string) (*client.InternetGateway, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindInternetGatewayByVPC", arg0, arg1)
+	ret0, _ := ret[0].(*client.InternetGateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindInternetGatewayByVPC indicates an expected call of FindInternetGatewayByVPC.
+func (mr *MockInterfaceMockRecorder) FindInternetGatewayByVPC(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindInternetGatewayByVPC", reflect.TypeOf((*MockInterface)(nil).FindInternetGatewayByVPC), arg0, arg1)
+}
+
+// FindInternetGatewaysByTags mocks base method.
+func (m *MockInterface) FindInternetGatewaysByTags(arg0 context.Context, arg1 client.Tags) ([]*client.InternetGateway, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindInternetGatewaysByTags", arg0, arg1)
+	ret0, _ := ret[0].([]*client.InternetGateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindInternetGatewaysByTags indicates an expected call of FindInternetGatewaysByTags.
+func (mr *MockInterfaceMockRecorder) FindInternetGatewaysByTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindInternetGatewaysByTags", reflect.TypeOf((*MockInterface)(nil).FindInternetGatewaysByTags), arg0, arg1)
+}
+
+// FindKeyPairsByTags mocks base method.
+func (m *MockInterface) FindKeyPairsByTags(arg0 context.Context, arg1 client.Tags) ([]*client.KeyPairInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindKeyPairsByTags", arg0, arg1)
+	ret0, _ := ret[0].([]*client.KeyPairInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindKeyPairsByTags indicates an expected call of FindKeyPairsByTags.
+func (mr *MockInterfaceMockRecorder) FindKeyPairsByTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindKeyPairsByTags", reflect.TypeOf((*MockInterface)(nil).FindKeyPairsByTags), arg0, arg1)
+}
+
+// FindLoadBalancerARNByDNSName mocks base method.
+func (m *MockInterface) FindLoadBalancerARNByDNSName(arg0 context.Context, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLoadBalancerARNByDNSName", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLoadBalancerARNByDNSName indicates an expected call of FindLoadBalancerARNByDNSName.
+func (mr *MockInterfaceMockRecorder) FindLoadBalancerARNByDNSName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLoadBalancerARNByDNSName", reflect.TypeOf((*MockInterface)(nil).FindLoadBalancerARNByDNSName), arg0, arg1)
+}
+
+// FindManagedPrefixListsByTags mocks base method.
+func (m *MockInterface) FindManagedPrefixListsByTags(arg0 context.Context, arg1 client.Tags) ([]*client.ManagedPrefixList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindManagedPrefixListsByTags", arg0, arg1)
+	ret0, _ := ret[0].([]*client.ManagedPrefixList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindManagedPrefixListsByTags indicates an expected call of FindManagedPrefixListsByTags.
+func (mr *MockInterfaceMockRecorder) FindManagedPrefixListsByTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindManagedPrefixListsByTags", reflect.TypeOf((*MockInterface)(nil).FindManagedPrefixListsByTags), arg0, arg1)
+}
+
+// FindNATGatewaysByTags mocks base method.
+func (m *MockInterface) FindNATGatewaysByTags(arg0 context.Context, arg1 client.Tags) ([]*client.NATGateway, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNATGatewaysByTags", arg0, arg1)
+	ret0, _ := ret[0].([]*client.NATGateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNATGatewaysByTags indicates an expected call of FindNATGatewaysByTags.
+func (mr *MockInterfaceMockRecorder) FindNATGatewaysByTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNATGatewaysByTags", reflect.TypeOf((*MockInterface)(nil).FindNATGatewaysByTags), arg0, arg1)
+}
+
+// FindNetworkAclAssociationBySubnetId mocks base method.
+func (m *MockInterface) FindNetworkAclAssociationBySubnetId(arg0 context.Context, arg1 string) (*client.NetworkAclAssociation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNetworkAclAssociationBySubnetId", arg0, arg1)
+	ret0, _ := ret[0].(*client.NetworkAclAssociation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNetworkAclAssociationBySubnetId indicates an expected call of FindNetworkAclAssociationBySubnetId.
+func (mr *MockInterfaceMockRecorder) FindNetworkAclAssociationBySubnetId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNetworkAclAssociationBySubnetId", reflect.TypeOf((*MockInterface)(nil).FindNetworkAclAssociationBySubnetId), arg0, arg1)
+}
+
+// FindNetworkAclsByTags mocks base method.
+func (m *MockInterface) FindNetworkAclsByTags(arg0 context.Context, arg1 client.Tags) ([]*client.NetworkAcl, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNetworkAclsByTags", arg0, arg1)
+	ret0, _ := ret[0].([]*client.NetworkAcl)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNetworkAclsByTags indicates an expected call of FindNetworkAclsByTags.
+func (mr *MockInterfaceMockRecorder) FindNetworkAclsByTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNetworkAclsByTags", reflect.TypeOf((*MockInterface)(nil).FindNetworkAclsByTags), arg0, arg1)
+}
+
+// FindNewestImage mocks base method.
+func (m *MockInterface) FindNewestImage(arg0 context.Context, arg1 []string, arg2 *string, arg3 map[string]string, arg4 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNewestImage", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNewestImage indicates an expected call of FindNewestImage.
+func (mr *MockInterfaceMockRecorder) FindNewestImage(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNewestImage", reflect.TypeOf((*MockInterface)(nil).FindNewestImage), arg0, arg1, arg2, arg3, arg4)
+}
+
+// FindOpenIDConnectProviderByURL mocks base method.
+func (m *MockInterface) FindOpenIDConnectProviderByURL(arg0 context.Context, arg1 string) (*client.OpenIDConnectProvider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOpenIDConnectProviderByURL", arg0, arg1)
+	ret0, _ := ret[0].(*client.OpenIDConnectProvider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOpenIDConnectProviderByURL indicates an expected call of FindOpenIDConnectProviderByURL.
+func (mr *MockInterfaceMockRecorder) FindOpenIDConnectProviderByURL(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOpenIDConnectProviderByURL", reflect.TypeOf((*MockInterface)(nil).FindOpenIDConnectProviderByURL), arg0, arg1)
+}
+
+// FindPlacementGroupsByTags mocks base method.
+func (m *MockInterface) FindPlacementGroupsByTags(arg0 context.Context, arg1 client.Tags) ([]*client.PlacementGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPlacementGroupsByTags", arg0, arg1)
+	ret0, _ := ret[0].([]*client.PlacementGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPlacementGroupsByTags indicates an expected call of FindPlacementGroupsByTags.
+func (mr *MockInterfaceMockRecorder) FindPlacementGroupsByTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPlacementGroupsByTags", reflect.TypeOf((*MockInterface)(nil).FindPlacementGroupsByTags), arg0, arg1)
+}
+
+// FindResolverEndpointsByName mocks base method.
+func (m *MockInterface) FindResolverEndpointsByName(arg0 context.Context, arg1 string) ([]*client.ResolverEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindResolverEndpointsByName", arg0, arg1)
+	ret0, _ := ret[0].([]*client.ResolverEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindResolverEndpointsByName indicates an expected call of FindResolverEndpointsByName.
+func (mr *MockInterfaceMockRecorder) FindResolverEndpointsByName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindResolverEndpointsByName", reflect.TypeOf((*MockInterface)(nil).FindResolverEndpointsByName), arg0, arg1)
+}
+
+// FindResolverRulesByEndpointId mocks base method.
+func (m *MockInterface) FindResolverRulesByEndpointId(arg0 context.Context, arg1 string) ([]*client.ResolverRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindResolverRulesByEndpointId", arg0, arg1)
+	ret0, _ := ret[0].([]*client.ResolverRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindResolverRulesByEndpointId indicates an expected call of FindResolverRulesByEndpointId.
+func (mr *MockInterfaceMockRecorder) FindResolverRulesByEndpointId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindResolverRulesByEndpointId", reflect.TypeOf((*MockInterface)(nil).FindResolverRulesByEndpointId), arg0, arg1)
+}
+
+// FindRouteTablesByTags mocks base method.
+func (m *MockInterface) FindRouteTablesByTags(arg0 context.Context, arg1 client.Tags) ([]*client.RouteTable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRouteTablesByTags", arg0, arg1)
+	ret0, _ := ret[0].([]*client.RouteTable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRouteTablesByTags indicates an expected call of FindRouteTablesByTags.
+func (mr *MockInterfaceMockRecorder) FindRouteTablesByTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRouteTablesByTags", reflect.TypeOf((*MockInterface)(nil).FindRouteTablesByTags), arg0, arg1)
+}
+
+// FindSecurityGroupsByTags mocks base method.
+func (m *MockInterface) FindSecurityGroupsByTags(arg0 context.Context, arg1 client.Tags) ([]*client.SecurityGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSecurityGroupsByTags", arg0, arg1)
+	ret0, _ := ret[0].([]*client.SecurityGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSecurityGroupsByTags indicates an expected call of FindSecurityGroupsByTags.
+func (mr *MockInterfaceMockRecorder) FindSecurityGroupsByTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSecurityGroupsByTags", reflect.TypeOf((*MockInterface)(nil).FindSecurityGroupsByTags), arg0, arg1)
+}
+
+// FindSubnetsByTags mocks base method.
+func (m *MockInterface) FindSubnetsByTags(arg0 context.Context, arg1 client.Tags) ([]*client.Subnet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSubnetsByTags", arg0, arg1)
+	ret0, _ := ret[0].([]*client.Subnet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSubnetsByTags indicates an expected call of FindSubnetsByTags.
+func (mr *MockInterfaceMockRecorder) FindSubnetsByTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSubnetsByTags", reflect.TypeOf((*MockInterface)(nil).FindSubnetsByTags), arg0, arg1)
+}
+
+// FindSubnetsByVpcId mocks base method.
+func (m *MockInterface) FindSubnetsByVpcId(arg0 context.Context, arg1 string) ([]*client.Subnet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSubnetsByVpcId", arg0, arg1)
+	ret0, _ := ret[0].([]*client.Subnet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSubnetsByVpcId indicates an expected call of FindSubnetsByVpcId.
+func (mr *MockInterfaceMockRecorder) FindSubnetsByVpcId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSubnetsByVpcId", reflect.TypeOf((*MockInterface)(nil).FindSubnetsByVpcId), arg0, arg1)
+}
+
+// FindTaggedResourcesByTags mocks base method.
+func (m *MockInterface) FindTaggedResourcesByTags(arg0 context.Context, arg1 client.Tags) ([]*client.TaggedResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTaggedResourcesByTags", arg0, arg1)
+	ret0, _ := ret[0].([]*client.TaggedResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTaggedResourcesByTags indicates an expected call of FindTaggedResourcesByTags.
+func (mr *MockInterfaceMockRecorder) FindTaggedResourcesByTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTaggedResourcesByTags", reflect.TypeOf((*MockInterface)(nil).FindTaggedResourcesByTags), arg0, arg1)
+}
+
+// FindTransitGatewayVpcAttachmentsByTags mocks base method.
+func (m *MockInterface) FindTransitGatewayVpcAttachmentsByTags(arg0 context.Context, arg1 client.Tags) ([]*client.TransitGatewayVpcAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTransitGatewayVpcAttachmentsByTags", arg0, arg1)
+	ret0, _ := ret[0].([]*client.TransitGatewayVpcAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTransitGatewayVpcAttachmentsByTags indicates an expected call of FindTransitGatewayVpcAttachmentsByTags.
+func (mr *MockInterfaceMockRecorder) FindTransitGatewayVpcAttachmentsByTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTransitGatewayVpcAttachmentsByTags", reflect.TypeOf((*MockInterface)(nil).FindTransitGatewayVpcAttachmentsByTags), arg0, arg1)
+}
+
+// FindVolumesByTags mocks base method.
+func (m *MockInterface) FindVolumesByTags(arg0 context.Context, arg1 client.Tags) ([]*client.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindVolumesByTags", arg0, arg1)
+	ret0, _ := ret[0].([]*client.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindVolumesByTags indicates an expected call of FindVolumesByTags.
+func (mr *MockInterfaceMockRecorder) FindVolumesByTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindVolumesByTags", reflect.TypeOf((*MockInterface)(nil).FindVolumesByTags), arg0, arg1)
+}
+
+// FindVpcDhcpOptionsByTags mocks base method.
+func (m *MockInterface) FindVpcDhcpOptionsByTags(arg0 context.Context, arg1 client.Tags) ([]*client.DhcpOptions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindVpcDhcpOptionsByTags", arg0, arg1)
+	ret0, _ := ret[0].([]*client.DhcpOptions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindVpcDhcpOptionsByTags indicates an expected call of FindVpcDhcpOptionsByTags.
+func (mr *MockInterfaceMockRecorder) FindVpcDhcpOptionsByTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindVpcDhcpOptionsByTags", reflect.TypeOf((*MockInterface)(nil).FindVpcDhcpOptionsByTags), arg0, arg1)
+}
+
+// FindVpcEndpointsByTags mocks base method.
+func (m *MockInterface) FindVpcEndpointsByTags(arg0 context.Context, arg1 client.Tags) ([]*client.VpcEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindVpcEndpointsByTags", arg0, arg1)
+	ret0, _ := ret[0].([]*client.VpcEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindVpcEndpointsByTags indicates an expected call of FindVpcEndpointsByTags.
+func (mr *MockInterfaceMockRecorder) FindVpcEndpointsByTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindVpcEndpointsByTags", reflect.TypeOf((*MockInterface)(nil).FindVpcEndpointsByTags), arg0, arg1)
+}
+
+// FindVpcIDsByDhcpOptions mocks base method.
+func (m *MockInterface) FindVpcIDsByDhcpOptions(arg0 context.Context, arg1 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindVpcIDsByDhcpOptions", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindVpcIDsByDhcpOptions indicates an expected call of FindVpcIDsByDhcpOptions.
+func (mr *MockInterfaceMockRecorder) FindVpcIDsByDhcpOptions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindVpcIDsByDhcpOptions", reflect.TypeOf((*MockInterface)(nil).FindVpcIDsByDhcpOptions), arg0, arg1)
+}
+
+// FindVpcPeeringConnectionsByTags mocks base method.
+func (m *MockInterface) FindVpcPeeringConnectionsByTags(arg0 context.Context, arg1 client.Tags) ([]*client.VpcPeeringConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindVpcPeeringConnectionsByTags", arg0, arg1)
+	ret0, _ := ret[0].([]*client.VpcPeeringConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindVpcPeeringConnectionsByTags indicates an expected call of FindVpcPeeringConnectionsByTags.
+func (mr *MockInterfaceMockRecorder) FindVpcPeeringConnectionsByTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindVpcPeeringConnectionsByTags", reflect.TypeOf((*MockInterface)(nil).FindVpcPeeringConnectionsByTags), arg0, arg1)
+}
+
+// FindVpcsByTags mocks base method.
+func (m *MockInterface) FindVpcsByTags(arg0 context.Context, arg1 client.Tags) ([]*client.VPC, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindVpcsByTags", arg0, arg1)
+	ret0, _ := ret[0].([]*client.VPC)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindVpcsByTags indicates an expected call of FindVpcsByTags.
+func (mr *MockInterfaceMockRecorder) FindVpcsByTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindVpcsByTags", reflect.TypeOf((*MockInterface)(nil).FindVpcsByTags), arg0, arg1)
+}
+
+// GetAccountID mocks base method.
+func (m *MockInterface) GetAccountID(arg0 context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountID", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountID indicates an expected call of GetAccountID.
+func (mr *MockInterfaceMockRecorder) GetAccountID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountID", reflect.TypeOf((*MockInterface)(nil).GetAccountID), arg0)
+}
+
+// GetAutoScalingGroup mocks base method.
+func (m *MockInterface) GetAutoScalingGroup(arg0 context.Context, arg1 string) (*client.AutoScalingGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutoScalingGroup", arg0, arg1)
+	ret0, _ := ret[0].(*client.AutoScalingGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAutoScalingGroup indicates an expected call of GetAutoScalingGroup.
+func (mr *MockInterfaceMockRecorder) GetAutoScalingGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoScalingGroup", reflect.TypeOf((*MockInterface)(nil).GetAutoScalingGroup), arg0, arg1)
+}
+
+// GetAvailabilityZoneIDs mocks base method.
+func (m *MockInterface) GetAvailabilityZoneIDs(arg0 context.Context) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailabilityZoneIDs", arg0)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAvailabilityZoneIDs indicates an expected call of GetAvailabilityZoneIDs.
+func (mr *MockInterfaceMockRecorder) GetAvailabilityZoneIDs(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailabilityZoneIDs", reflect.TypeOf((*MockInterface)(nil).GetAvailabilityZoneIDs), arg0)
+}
+
+// GetAvailabilityZoneTypes mocks base method.
+func (m *MockInterface) GetAvailabilityZoneTypes(arg0 context.Context) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailabilityZoneTypes", arg0)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAvailabilityZoneTypes indicates an expected call of GetAvailabilityZoneTypes.
+func (mr *MockInterfaceMockRecorder) GetAvailabilityZoneTypes(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailabilityZoneTypes", reflect.TypeOf((*MockInterface)(nil).GetAvailabilityZoneTypes), arg0)
+}
+
+// GetAvailabilityZones mocks base method.
+func (m *MockInterface) GetAvailabilityZones(arg0 context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailabilityZones", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAvailabilityZones indicates an expected call of GetAvailabilityZones.
+func (mr *MockInterfaceMockRecorder) GetAvailabilityZones(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailabilityZones", reflect.TypeOf((*MockInterface)(nil).GetAvailabilityZones), arg0)
+}
+
+// GetBucketObjectLockConfiguration mocks base method.
+func (m *MockInterface) GetBucketObjectLockConfiguration(arg0 context.Context, arg1 string) (*client.ObjectLockConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBucketObjectLockConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(*client.ObjectLockConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBucketObjectLockConfiguration indicates an expected call of GetBucketObjectLockConfiguration.
+func (mr *MockInterfaceMockRecorder) GetBucketObjectLockConfiguration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketObjectLockConfiguration", reflect.TypeOf((*MockInterface)(nil).GetBucketObjectLockConfiguration), arg0, arg1)
+}
+
+// GetBucketReplication mocks base method.
+func (m *MockInterface) GetBucketReplication(arg0 context.Context, arg1 string) (*client.BucketReplication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBucketReplication", arg0, arg1)
+	ret0, _ := ret[0].(*client.BucketReplication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBucketReplication indicates an expected call of GetBucketReplication.
+func (mr *MockInterfaceMockRecorder) GetBucketReplication(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketReplication", reflect.TypeOf((*MockInterface)(nil).GetBucketReplication), arg0, arg1)
+}
+
+// GetCallerARN mocks base method.
+func (m *MockInterface) GetCallerARN(arg0 context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCallerARN", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCallerARN indicates an expected call of GetCallerARN.
+func (mr *MockInterfaceMockRecorder) GetCallerARN(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCallerARN", reflect.TypeOf((*MockInterface)(nil).GetCallerARN), arg0)
+}
+
+// GetCarrierGateway mocks base method.
+func (m *MockInterface) GetCarrierGateway(arg0 context.Context, arg1 string) (*client.CarrierGateway, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCarrierGateway", arg0, arg1)
+	ret0, _ := ret[0].(*client.CarrierGateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCarrierGateway indicates an expected call of GetCarrierGateway.
+func (mr *MockInterfaceMockRecorder) GetCarrierGateway(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCarrierGateway", reflect.TypeOf((*MockInterface)(nil).GetCarrierGateway), arg0, arg1)
+}
+
+// GetDHCPOptions mocks base method.
+func (m *MockInterface) GetDHCPOptions(arg0 context.Context, arg1 string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDHCPOptions", arg0, arg1)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDHCPOptions indicates an expected call of GetDHCPOptions.
+func (mr *MockInterfaceMockRecorder) GetDHCPOptions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDHCPOptions", reflect.TypeOf((*MockInterface)(nil).GetDHCPOptions), arg0, arg1)
+}
+
+// GetDNSHostedZoneVPCs mocks base method.
+func (m *MockInterface) GetDNSHostedZoneVPCs(arg0 context.Context, arg1 string) ([]client.HostedZoneVPC, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDNSHostedZoneVPCs", arg0, arg1)
+	ret0, _ := ret[0].([]client.HostedZoneVPC)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDNSHostedZoneVPCs indicates an expected call of GetDNSHostedZoneVPCs.
+func (mr *MockInterfaceMockRecorder) GetDNSHostedZoneVPCs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDNSHostedZoneVPCs", reflect.TypeOf((*MockInterface)(nil).GetDNSHostedZoneVPCs), arg0, arg1)
+}
+
+// GetDNSHostedZones mocks base method.
+func (m *MockInterface) GetDNSHostedZones(arg0 context.Context) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDNSHostedZones", arg0)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDNSHostedZones indicates an expected call of GetDNSHostedZones.
+func (mr *MockInterfaceMockRecorder) GetDNSHostedZones(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDNSHostedZones", reflect.TypeOf((*MockInterface)(nil).GetDNSHostedZones), arg0)
+}
+
+// GetEgressOnlyInternetGateway mocks base method.
+func (m *MockInterface) GetEgressOnlyInternetGateway(arg0 context.Context, arg1 string) (*client.EgressOnlyInternetGateway, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEgressOnlyInternetGateway", arg0, arg1)
+	ret0, _ := ret[0].(*client.EgressOnlyInternetGateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEgressOnlyInternetGateway indicates an expected call of GetEgressOnlyInternetGateway.
+func (mr *MockInterfaceMockRecorder) GetEgressOnlyInternetGateway(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEgressOnlyInternetGateway", reflect.TypeOf((*MockInterface)(nil).GetEgressOnlyInternetGateway), arg0, arg1)
+}
+
+// GetElasticFileSystem mocks base method.
+func (m *MockInterface) GetElasticFileSystem(arg0 context.Context, arg1 string) (*client.ElasticFileSystem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetElasticFileSystem", arg0, arg1)
+	ret0, _ := ret[0].(*client.ElasticFileSystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetElasticFileSystem indicates an expected call of GetElasticFileSystem.
+func (mr *MockInterfaceMockRecorder) GetElasticFileSystem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetElasticFileSystem", reflect.TypeOf((*MockInterface)(nil).GetElasticFileSystem), arg0, arg1)
+}
+
+// GetElasticIP mocks base method.
+func (m *MockInterface) GetElasticIP(arg0 context.Context, arg1 string) (*client.ElasticIP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetElasticIP", arg0, arg1)
+	ret0, _ := ret[0].(*client.ElasticIP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetElasticIP indicates an expected call of GetElasticIP.
+func (mr *MockInterfaceMockRecorder) GetElasticIP(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetElasticIP", reflect.TypeOf((*MockInterface)(nil).GetElasticIP), arg0, arg1)
+}
+
+// GetElasticIPsAssociationIDForAllocationIDs mocks base method.
+func (m *MockInterface) GetElasticIPsAssociationIDForAllocationIDs(arg0 context.Context, arg1 []string) (map[string]*string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetElasticIPsAssociationIDForAllocationIDs", arg0, arg1)
+	ret0, _ := ret[0].(map[string]*string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetElasticIPsAssociationIDForAllocationIDs indicates an expected call of GetElasticIPsAssociationIDForAllocationIDs.
+func (mr *MockInterfaceMockRecorder) GetElasticIPsAssociationIDForAllocationIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetElasticIPsAssociationIDForAllocationIDs", reflect.TypeOf((*MockInterface)(nil).GetElasticIPsAssociationIDForAllocationIDs), arg0, arg1)
+}
+
+// GetFlowLog mocks base method.
+func (m *MockInterface) GetFlowLog(arg0 context.Context, arg1 string) (*client.FlowLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFlowLog", arg0, arg1)
+	ret0, _ := ret[0].(*client.FlowLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFlowLog indicates an expected call of GetFlowLog.
+func (mr *MockInterfaceMockRecorder) GetFlowLog(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFlowLog", reflect.TypeOf((*MockInterface)(nil).GetFlowLog), arg0, arg1)
+}
+
+// GetHealthCheck mocks base method.
+func (m *MockInterface) GetHealthCheck(arg0 context.Context, arg1 string) (*client.HealthCheck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHealthCheck", arg0, arg1)
+	ret0, _ := ret[0].(*client.HealthCheck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHealthCheck indicates an expected call of GetHealthCheck.
+func (mr *MockInterfaceMockRecorder) GetHealthCheck(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHealthCheck", reflect.TypeOf((*MockInterface)(nil).GetHealthCheck), arg0, arg1)
+}
+
+// GetIAMDeniedActions mocks base method.
+func (m *MockInterface) GetIAMDeniedActions(arg0 context.Context, arg1 string, arg2 []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIAMDeniedActions", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIAMDeniedActions indicates an expected call of GetIAMDeniedActions.
+func (mr *MockInterfaceMockRecorder) GetIAMDeniedActions(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIAMDeniedActions", reflect.TypeOf((*MockInterface)(nil).GetIAMDeniedActions), arg0, arg1, arg2)
+}
+
+// GetIAMInstanceProfile mocks base method.
+func (m *MockInterface) GetIAMInstanceProfile(arg0 context.Context, arg1 string) (*client.IAMInstanceProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIAMInstanceProfile", arg0, arg1)
+	ret0, _ := ret[0].(*client.IAMInstanceProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIAMInstanceProfile indicates an expected call of GetIAMInstanceProfile.
+func (mr *MockInterfaceMockRecorder) GetIAMInstanceProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIAMInstanceProfile", reflect.TypeOf((*MockInterface)(nil).GetIAMInstanceProfile), arg0, arg1)
+}
+
+// GetIAMRole mocks base method.
+func (m *MockInterface) GetIAMRole(arg0 context.Context, arg1 string) (*client.IAMRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIAMRole", arg0, arg1)
+	ret0, _ := ret[0].(*client.IAMRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIAMRole indicates an expected call of GetIAMRole.
+func (mr *MockInterfaceMockRecorder) GetIAMRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIAMRole", reflect.TypeOf((*MockInterface)(nil).GetIAMRole), arg0, arg1)
+}
+
+// GetIAMRolePolicy mocks base method.
+func (m *MockInterface) GetIAMRolePolicy(arg0 context.Context, arg1, arg2 string) (*client.IAMRolePolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIAMRolePolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*client.IAMRolePolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIAMRolePolicy indicates an expected call of GetIAMRolePolicy.
+func (mr *MockInterfaceMockRecorder) GetIAMRolePolicy(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIAMRolePolicy", reflect.TypeOf((*MockInterface)(nil).GetIAMRolePolicy), arg0, arg1, arg2)
+}
+
+// GetIPv6Cidr mocks base method.
+func (m *MockInterface) GetIPv6Cidr(arg0 context.Context, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIPv6Cidr", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIPv6Cidr indicates an expected call of GetIPv6Cidr.
+func (mr *MockInterfaceMockRecorder) GetIPv6Cidr(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPv6Cidr", reflect.TypeOf((*MockInterface)(nil).GetIPv6Cidr), arg0, arg1)
+}
+
+// GetImageArchitecture mocks base method.
+func (m *MockInterface) GetImageArchitecture(arg0 context.Context, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImageArchitecture", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImageArchitecture indicates an expected call of GetImageArchitecture.
+func (mr *MockInterfaceMockRecorder) GetImageArchitecture(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImageArchitecture", reflect.TypeOf((*MockInterface)(nil).GetImageArchitecture), arg0, arg1)
+}
+
+// GetInstance mocks base method.
+func (m *MockInterface) GetInstance(arg0 context.Context, arg1 string) (*client.Instance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstance", arg0, arg1)
+	ret0, _ := ret[0].(*client.Instance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstance indicates an expected call of GetInstance.
+func (mr *MockInterfaceMockRecorder) GetInstance(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstance", reflect.TypeOf((*MockInterface)(nil).GetInstance), arg0, arg1)
+}
+
+// GetInstanceTypeArchitectures mocks base method.
+func (m *MockInterface) GetInstanceTypeArchitectures(arg0 context.Context, arg1 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceTypeArchitectures", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceTypeArchitectures indicates an expected call of GetInstanceTypeArchitectures.
+func (mr *MockInterfaceMockRecorder) GetInstanceTypeArchitectures(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceTypeArchitectures", reflect.TypeOf((*MockInterface)(nil).GetInstanceTypeArchitectures), arg0, arg1)
+}
+
+// GetInstanceTypeInfo mocks base method.
+func (m *MockInterface) GetInstanceTypeInfo(arg0 context.Context, arg1 string) (*client.InstanceTypeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceTypeInfo", arg0, arg1)
+	ret0, _ := ret[0].(*client.InstanceTypeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceTypeInfo indicates an expected call of GetInstanceTypeInfo.
+func (mr *MockInterfaceMockRecorder) GetInstanceTypeInfo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceTypeInfo", reflect.TypeOf((*MockInterface)(nil).GetInstanceTypeInfo), arg0, arg1)
+}
+
+// GetInstanceTypeVCPUs mocks base method.
+func (m *MockInterface) GetInstanceTypeVCPUs(arg0 context.Context, arg1 []string) (map[string]int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceTypeVCPUs", arg0, arg1)
+	ret0, _ := ret[0].(map[string]int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceTypeVCPUs indicates an expected call of GetInstanceTypeVCPUs.
+func (mr *MockInterfaceMockRecorder) GetInstanceTypeVCPUs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceTypeVCPUs", reflect.TypeOf((*MockInterface)(nil).GetInstanceTypeVCPUs), arg0, arg1)
 }
 
-// FindInternetGatewayByVPC mocks base method.
-func (m *MockInterface) FindInternetGatewayByVPC(arg0 context.Context, arg1 string) (*client.InternetGateway, error) {
+// GetInternetGateway mocks base method.
+func (m *MockInterface) GetInternetGateway(arg0 context.Context, arg1 string) (*client.InternetGateway, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindInternetGatewayByVPC", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetInternetGateway", arg0, arg1)
 	ret0, _ := ret[0].(*client.InternetGateway)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindInternetGatewayByVPC indicates an expected call of FindInternetGatewayByVPC.
-func (mr *MockInterfaceMockRecorder) FindInternetGatewayByVPC(arg0, arg1 interface{}) *gomock.Call {
+// GetInternetGateway indicates an expected call of GetInternetGateway.
+func (mr *MockInterfaceMockRecorder) GetInternetGateway(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindInternetGatewayByVPC", reflect.TypeOf((*MockInterface)(nil).FindInternetGatewayByVPC), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInternetGateway", reflect.TypeOf((*MockInterface)(nil).GetInternetGateway), arg0, arg1)
 }
 
-// FindInternetGatewaysByTags mocks base method.
-func (m *MockInterface) FindInternetGatewaysByTags(arg0 context.Context, arg1 client.Tags) ([]*client.InternetGateway, error) {
+// GetKMSKey mocks base method.
+func (m *MockInterface) GetKMSKey(arg0 context.Context, arg1 string) (*client.KMSKey, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindInternetGatewaysByTags", arg0, arg1)
-	ret0, _ := ret[0].([]*client.InternetGateway)
+	ret := m.ctrl.Call(m, "GetKMSKey", arg0, arg1)
+	ret0, _ := ret[0].(*client.KMSKey)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindInternetGatewaysByTags indicates an expected call of FindInternetGatewaysByTags.
-func (mr *MockInterfaceMockRecorder) FindInternetGatewaysByTags(arg0, arg1 interface{}) *gomock.Call {
+// GetKMSKey indicates an expected call of GetKMSKey.
+func (mr *MockInterfaceMockRecorder) GetKMSKey(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindInternetGatewaysByTags", reflect.TypeOf((*MockInterface)(nil).FindInternetGatewaysByTags), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKMSKey", reflect.TypeOf((*MockInterface)(nil).GetKMSKey), arg0, arg1)
 }
 
-// FindKeyPairsByTags mocks base method.
-func (m *MockInterface) FindKeyPairsByTags(arg0 context.Context, arg1 client.Tags) ([]*client.KeyPairInfo, error) {
+// GetKeyPair mocks base method.
+func (m *MockInterface) GetKeyPair(arg0 context.Context, arg1 string) (*client.KeyPairInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindKeyPairsByTags", arg0, arg1)
-	ret0, _ := ret[0].([]*client.KeyPairInfo)
+	ret := m.ctrl.Call(m, "GetKeyPair", arg0, arg1)
+	ret0, _ := ret[0].(*client.KeyPairInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindKeyPairsByTags indicates an expected call of FindKeyPairsByTags.
-func (mr *MockInterfaceMockRecorder) FindKeyPairsByTags(arg0, arg1 interface{}) *gomock.Call {
+// GetKeyPair indicates an expected call of GetKeyPair.
+func (mr *MockInterfaceMockRecorder) GetKeyPair(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindKeyPairsByTags", reflect.TypeOf((*MockInterface)(nil).FindKeyPairsByTags), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeyPair", reflect.TypeOf((*MockInterface)(nil).GetKeyPair), arg0, arg1)
 }
 
-// FindNATGatewaysByTags mocks base method.
-func (m *MockInterface) FindNATGatewaysByTags(arg0 context.Context, arg1 client.Tags) ([]*client.NATGateway, error) {
+// GetLaunchTemplate mocks base method.
+func (m *MockInterface) GetLaunchTemplate(arg0 context.Context, arg1 string) (*client.LaunchTemplate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindNATGatewaysByTags", arg0, arg1)
-	ret0, _ := ret[0].([]*client.NATGateway)
+	ret := m.ctrl.Call(m, "GetLaunchTemplate", arg0, arg1)
+	ret0, _ := ret[0].(*client.LaunchTemplate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindNATGatewaysByTags indicates an expected call of FindNATGatewaysByTags.
-func (mr *MockInterfaceMockRecorder) FindNATGatewaysByTags(arg0, arg1 interface{}) *gomock.Call {
+// GetLaunchTemplate indicates an expected call of GetLaunchTemplate.
+func (mr *MockInterfaceMockRecorder) GetLaunchTemplate(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNATGatewaysByTags", reflect.TypeOf((*MockInterface)(nil).FindNATGatewaysByTags), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLaunchTemplate", reflect.TypeOf((*MockInterface)(nil).GetLaunchTemplate), arg0, arg1)
 }
 
-// FindRouteTablesByTags mocks base method.
-func (m *MockInterface) FindRouteTablesByTags(arg0 context.Context, arg1 client.Tags) ([]*client.RouteTable, error) {
+// GetLoadBalancerTargetHealth mocks base method.
+func (m *MockInterface) GetLoadBalancerTargetHealth(arg0 context.Context, arg1 string) ([]*client.TargetHealth, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindRouteTablesByTags", arg0, arg1)
-	ret0, _ := ret[0].([]*client.RouteTable)
+	ret := m.ctrl.Call(m, "GetLoadBalancerTargetHealth", arg0, arg1)
+	ret0, _ := ret[0].([]*client.TargetHealth)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindRouteTablesByTags indicates an expected call of FindRouteTablesByTags.
-func (mr *MockInterfaceMockRecorder) FindRouteTablesByTags(arg0, arg1 interface{}) *gomock.Call {
+// GetLoadBalancerTargetHealth indicates an expected call of GetLoadBalancerTargetHealth.
+func (mr *MockInterfaceMockRecorder) GetLoadBalancerTargetHealth(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRouteTablesByTags", reflect.TypeOf((*MockInterface)(nil).FindRouteTablesByTags), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerTargetHealth", reflect.TypeOf((*MockInterface)(nil).GetLoadBalancerTargetHealth), arg0, arg1)
 }
 
-// FindSecurityGroupsByTags mocks base method.
-func (m *MockInterface) FindSecurityGroupsByTags(arg0 context.Context, arg1 client.Tags) ([]*client.SecurityGroup, error) {
+// GetLogGroup mocks base method.
+func (m *MockInterface) GetLogGroup(arg0 context.Context, arg1 string) (*client.LogGroup, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindSecurityGroupsByTags", arg0, arg1)
-	ret0, _ := ret[0].([]*client.SecurityGroup)
+	ret := m.ctrl.Call(m, "GetLogGroup", arg0, arg1)
+	ret0, _ := ret[0].(*client.LogGroup)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindSecurityGroupsByTags indicates an expected call of FindSecurityGroupsByTags.
-func (mr *MockInterfaceMockRecorder) FindSecurityGroupsByTags(arg0, arg1 interface{}) *gomock.Call {
+// GetLogGroup indicates an expected call of GetLogGroup.
+func (mr *MockInterfaceMockRecorder) GetLogGroup(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSecurityGroupsByTags", reflect.TypeOf((*MockInterface)(nil).FindSecurityGroupsByTags), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogGroup", reflect.TypeOf((*MockInterface)(nil).GetLogGroup), arg0, arg1)
 }
 
-// FindSubnetsByTags mocks base method.
-func (m *MockInterface) FindSubnetsByTags(arg0 context.Context, arg1 client.Tags) ([]*client.Subnet, error) {
+// GetManagedPrefixList mocks base method.
+func (m *MockInterface) GetManagedPrefixList(arg0 context.Context, arg1 string) (*client.ManagedPrefixList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindSubnetsByTags", arg0, arg1)
-	ret0, _ := ret[0].([]*client.Subnet)
+	ret := m.ctrl.Call(m, "GetManagedPrefixList", arg0, arg1)
+	ret0, _ := ret[0].(*client.ManagedPrefixList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindSubnetsByTags indicates an expected call of FindSubnetsByTags.
-func (mr *MockInterfaceMockRecorder) FindSubnetsByTags(arg0, arg1 interface{}) *gomock.Call {
+// GetManagedPrefixList indicates an expected call of GetManagedPrefixList.
+func (mr *MockInterfaceMockRecorder) GetManagedPrefixList(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSubnetsByTags", reflect.TypeOf((*MockInterface)(nil).FindSubnetsByTags), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagedPrefixList", reflect.TypeOf((*MockInterface)(nil).GetManagedPrefixList), arg0, arg1)
 }
 
-// FindVpcDhcpOptionsByTags mocks base method.
-func (m *MockInterface) FindVpcDhcpOptionsByTags(arg0 context.Context, arg1 client.Tags) ([]*client.DhcpOptions, error) {
+// GetManagedPrefixListCIDRs mocks base method.
+func (m *MockInterface) GetManagedPrefixListCIDRs(arg0 context.Context, arg1 string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindVpcDhcpOptionsByTags", arg0, arg1)
-	ret0, _ := ret[0].([]*client.DhcpOptions)
+	ret := m.ctrl.Call(m, "GetManagedPrefixListCIDRs", arg0, arg1)
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindVpcDhcpOptionsByTags indicates an expected call of FindVpcDhcpOptionsByTags.
-func (mr *MockInterfaceMockRecorder) FindVpcDhcpOptionsByTags(arg0, arg1 interface{}) *gomock.Call {
+// GetManagedPrefixListCIDRs indicates an expected call of GetManagedPrefixListCIDRs.
+func (mr *MockInterfaceMockRecorder) GetManagedPrefixListCIDRs(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindVpcDhcpOptionsByTags", reflect.TypeOf((*MockInterface)(nil).FindVpcDhcpOptionsByTags), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagedPrefixListCIDRs", reflect.TypeOf((*MockInterface)(nil).GetManagedPrefixListCIDRs), arg0, arg1)
 }
 
-// FindVpcEndpointsByTags mocks base method.
-func (m *MockInterface) FindVpcEndpointsByTags(arg0 context.Context, arg1 client.Tags) ([]*client.VpcEndpoint, error) {
+// GetMountTargets mocks base method.
+func (m *MockInterface) GetMountTargets(arg0 context.Context, arg1 string) ([]*client.MountTarget, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindVpcEndpointsByTags", arg0, arg1)
-	ret0, _ := ret[0].([]*client.VpcEndpoint)
+	ret := m.ctrl.Call(m, "GetMountTargets", arg0, arg1)
+	ret0, _ := ret[0].([]*client.MountTarget)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindVpcEndpointsByTags indicates an expected call of FindVpcEndpointsByTags.
-func (mr *MockInterfaceMockRecorder) FindVpcEndpointsByTags(arg0, arg1 interface{}) *gomock.Call {
+// GetMountTargets indicates an expected call of GetMountTargets.
+func (mr *MockInterfaceMockRecorder) GetMountTargets(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindVpcEndpointsByTags", reflect.TypeOf((*MockInterface)(nil).FindVpcEndpointsByTags), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMountTargets", reflect.TypeOf((*MockInterface)(nil).GetMountTargets), arg0, arg1)
 }
 
-// FindVpcsByTags mocks base method.
-func (m *MockInterface) FindVpcsByTags(arg0 context.Context, arg1 client.Tags) ([]*client.VPC, error) {
+// GetNATGateway mocks base method.
+func (m *MockInterface) GetNATGateway(arg0 context.Context, arg1 string) (*client.NATGateway, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindVpcsByTags", arg0, arg1)
-	ret0, _ := ret[0].([]*client.VPC)
+	ret := m.ctrl.Call(m, "GetNATGateway", arg0, arg1)
+	ret0, _ := ret[0].(*client.NATGateway)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindVpcsByTags indicates an expected call of FindVpcsByTags.
-func (mr *MockInterfaceMockRecorder) FindVpcsByTags(arg0, arg1 interface{}) *gomock.Call {
+// GetNATGateway indicates an expected call of GetNATGateway.
+func (mr *MockInterfaceMockRecorder) GetNATGateway(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindVpcsByTags", reflect.TypeOf((*MockInterface)(nil).FindVpcsByTags), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNATGateway", reflect.TypeOf((*MockInterface)(nil).GetNATGateway), arg0, arg1)
 }
 
-// GetAccountID mocks base method.
-func (m *MockInterface) GetAccountID(arg0 context.Context) (string, error) {
+// GetNATGatewayAddressAllocations mocks base method.
+func (m *MockInterface) GetNATGatewayAddressAllocations(arg0 context.Context, arg1 string) (sets.Set[string], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccountID", arg0)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GetNATGatewayAddressAllocations", arg0, arg1)
+	ret0, _ := ret[0].(sets.Set[string])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccountID indicates an expected call of GetAccountID.
-func (mr *MockInterfaceMockRecorder) GetAccountID(arg0 interface{}) *gomock.Call {
+// GetNATGatewayAddressAllocations indicates an expected call of GetNATGatewayAddressAllocations.
+func (mr *MockInterfaceMockRecorder) GetNATGatewayAddressAllocations(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountID", reflect.TypeOf((*MockInterface)(nil).GetAccountID), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNATGatewayAddressAllocations", reflect.TypeOf((*MockInterface)(nil).GetNATGatewayAddressAllocations), arg0, arg1)
 }
 
-// GetDHCPOptions mocks base method.
-func (m *MockInterface) GetDHCPOptions(arg0 context.Context, arg1 string) (map[string]string, error) {
+// GetNetworkAcl mocks base method.
+func (m *MockInterface) GetNetworkAcl(arg0 context.Context, arg1 string) (*client.NetworkAcl, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDHCPOptions", arg0, arg1)
-	ret0, _ := ret[0].(map[string]string)
+	ret := m.ctrl.Call(m, "GetNetworkAcl", arg0, arg1)
+	ret0, _ := ret[0].(*client.NetworkAcl)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDHCPOptions indicates an expected call of GetDHCPOptions.
-func (mr *MockInterfaceMockRecorder) GetDHCPOptions(arg0, arg1 interface{}) *gomock.Call {
+// GetNetworkAcl indicates an expected call of GetNetworkAcl.
+func (mr *MockInterfaceMockRecorder) GetNetworkAcl(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDHCPOptions", reflect.TypeOf((*MockInterface)(nil).GetDHCPOptions), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkAcl", reflect.TypeOf((*MockInterface)(nil).GetNetworkAcl), arg0, arg1)
 }
 
-// GetDNSHostedZones mocks base method.
-func (m *MockInterface) GetDNSHostedZones(arg0 context.Context) (map[string]string, error) {
+// GetOfferedInstanceTypes mocks base method.
+func (m *MockInterface) GetOfferedInstanceTypes(arg0 context.Context, arg1 string) (sets.Set[string], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDNSHostedZones", arg0)
-	ret0, _ := ret[0].(map[string]string)
+	ret := m.ctrl.Call(m, "GetOfferedInstanceTypes", arg0, arg1)
+	ret0, _ := ret[0].(sets.Set[string])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDNSHostedZones indicates an expected call of GetDNSHostedZones.
-func (mr *MockInterfaceMockRecorder) GetDNSHostedZones(arg0 interface{}) *gomock.Call {
+// GetOfferedInstanceTypes indicates an expected call of GetOfferedInstanceTypes.
+func (mr *MockInterfaceMockRecorder) GetOfferedInstanceTypes(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDNSHostedZones", reflect.TypeOf((*MockInterface)(nil).GetDNSHostedZones), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOfferedInstanceTypes", reflect.TypeOf((*MockInterface)(nil).GetOfferedInstanceTypes), arg0, arg1)
 }
 
-// GetElasticIP mocks base method.
-func (m *MockInterface) GetElasticIP(arg0 context.Context, arg1 string) (*client.ElasticIP, error) {
+// GetOpenIDConnectProvider mocks base method.
+func (m *MockInterface) GetOpenIDConnectProvider(arg0 context.Context, arg1 string) (*client.OpenIDConnectProvider, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetElasticIP", arg0, arg1)
-	ret0, _ := ret[0].(*client.ElasticIP)
+	ret := m.ctrl.Call(m, "GetOpenIDConnectProvider", arg0, arg1)
+	ret0, _ := ret[0].(*client.OpenIDConnectProvider)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetElasticIP indicates an expected call of GetElasticIP.
-func (mr *MockInterfaceMockRecorder) GetElasticIP(arg0, arg1 interface{}) *gomock.Call {
+// GetOpenIDConnectProvider indicates an expected call of GetOpenIDConnectProvider.
+func (mr *MockInterfaceMockRecorder) GetOpenIDConnectProvider(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetElasticIP", reflect.TypeOf((*MockInterface)(nil).GetElasticIP), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenIDConnectProvider", reflect.TypeOf((*MockInterface)(nil).GetOpenIDConnectProvider), arg0, arg1)
 }
 
-// GetElasticIPsAssociationIDForAllocationIDs mocks base method.
-func (m *MockInterface) GetElasticIPsAssociationIDForAllocationIDs(arg0 context.Context, arg1 []string) (map[string]*string, error) {
+// GetOutpost mocks base method.
+func (m *MockInterface) GetOutpost(arg0 context.Context, arg1 string) (*client.Outpost, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetElasticIPsAssociationIDForAllocationIDs", arg0, arg1)
-	ret0, _ := ret[0].(map[string]*string)
+	ret := m.ctrl.Call(m, "GetOutpost", arg0, arg1)
+	ret0, _ := ret[0].(*client.Outpost)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetElasticIPsAssociationIDForAllocationIDs indicates an expected call of GetElasticIPsAssociationIDForAllocationIDs.
-func (mr *MockInterfaceMockRecorder) GetElasticIPsAssociationIDForAllocationIDs(arg0, arg1 interface{}) *gomock.Call {
+// GetOutpost indicates an expected call of GetOutpost.
+func (mr *MockInterfaceMockRecorder) GetOutpost(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetElasticIPsAssociationIDForAllocationIDs", reflect.TypeOf((*MockInterface)(nil).GetElasticIPsAssociationIDForAllocationIDs), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutpost", reflect.TypeOf((*MockInterface)(nil).GetOutpost), arg0, arg1)
 }
 
-// GetIAMInstanceProfile mocks base method.
-func (m *MockInterface) GetIAMInstanceProfile(arg0 context.Context, arg1 string) (*client.IAMInstanceProfile, error) {
+// GetOutpostInstanceTypes mocks base method.
+func (m *MockInterface) GetOutpostInstanceTypes(arg0 context.Context, arg1 string) (sets.Set[string], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetIAMInstanceProfile", arg0, arg1)
-	ret0, _ := ret[0].(*client.IAMInstanceProfile)
+	ret := m.ctrl.Call(m, "GetOutpostInstanceTypes", arg0, arg1)
+	ret0, _ := ret[0].(sets.Set[string])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetIAMInstanceProfile indicates an expected call of GetIAMInstanceProfile.
-func (mr *MockInterfaceMockRecorder) GetIAMInstanceProfile(arg0, arg1 interface{}) *gomock.Call {
+// GetOutpostInstanceTypes indicates an expected call of GetOutpostInstanceTypes.
+func (mr *MockInterfaceMockRecorder) GetOutpostInstanceTypes(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIAMInstanceProfile", reflect.TypeOf((*MockInterface)(nil).GetIAMInstanceProfile), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutpostInstanceTypes", reflect.TypeOf((*MockInterface)(nil).GetOutpostInstanceTypes), arg0, arg1)
 }
 
-// GetIAMRole mocks base method.
-func (m *MockInterface) GetIAMRole(arg0 context.Context, arg1 string) (*client.IAMRole, error) {
+// GetPlacementGroup mocks base method.
+func (m *MockInterface) GetPlacementGroup(arg0 context.Context, arg1 string) (*client.PlacementGroup, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetIAMRole", arg0, arg1)
-	ret0, _ := ret[0].(*client.IAMRole)
+	ret := m.ctrl.Call(m, "GetPlacementGroup", arg0, arg1)
+	ret0, _ := ret[0].(*client.PlacementGroup)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetIAMRole indicates an expected call of GetIAMRole.
-func (mr *MockInterfaceMockRecorder) GetIAMRole(arg0, arg1 interface{}) *gomock.Call {
+// GetPlacementGroup indicates an expected call of GetPlacementGroup.
+func (mr *MockInterfaceMockRecorder) GetPlacementGroup(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIAMRole", reflect.TypeOf((*MockInterface)(nil).GetIAMRole), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlacementGroup", reflect.TypeOf((*MockInterface)(nil).GetPlacementGroup), arg0, arg1)
 }
 
-// GetIAMRolePolicy mocks base method.
-func (m *MockInterface) GetIAMRolePolicy(arg0 context.Context, arg1, arg2 string) (*client.IAMRolePolicy, error) {
+// GetPrefixListID mocks base method.
+func (m *MockInterface) GetPrefixListID(arg0 context.Context, arg1 string) (*string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetIAMRolePolicy", arg0, arg1, arg2)
-	ret0, _ := ret[0].(*client.IAMRolePolicy)
+	ret := m.ctrl.Call(m, "GetPrefixListID", arg0, arg1)
+	ret0, _ := ret[0].(*string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetIAMRolePolicy indicates an expected call of GetIAMRolePolicy.
-func (mr *MockInterfaceMockRecorder) GetIAMRolePolicy(arg0, arg1, arg2 interface{}) *gomock.Call {
+// GetPrefixListID indicates an expected call of GetPrefixListID.
+func (mr *MockInterfaceMockRecorder) GetPrefixListID(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIAMRolePolicy", reflect.TypeOf((*MockInterface)(nil).GetIAMRolePolicy), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrefixListID", reflect.TypeOf((*MockInterface)(nil).GetPrefixListID), arg0, arg1)
 }
 
-// GetIPv6Cidr mocks base method.
-func (m *MockInterface) GetIPv6Cidr(arg0 context.Context, arg1 string) (string, error) {
+// GetPrivateDNSHostedZones mocks base method.
+func (m *MockInterface) GetPrivateDNSHostedZones(arg0 context.Context) (map[string]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetIPv6Cidr", arg0, arg1)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GetPrivateDNSHostedZones", arg0)
+	ret0, _ := ret[0].(map[string]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetIPv6Cidr indicates an expected call of GetIPv6Cidr.
-func (mr *MockInterfaceMockRecorder) GetIPv6Cidr(arg0, arg1 interface{}) *gomock.Call {
+// GetPrivateDNSHostedZones indicates an expected call of GetPrivateDNSHostedZones.
+func (mr *MockInterfaceMockRecorder) GetPrivateDNSHostedZones(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPv6Cidr", reflect.TypeOf((*MockInterface)(nil).GetIPv6Cidr), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivateDNSHostedZones", reflect.TypeOf((*MockInterface)(nil).GetPrivateDNSHostedZones), arg0)
 }
 
-// GetInternetGateway mocks base method.
-func (m *MockInterface) GetInternetGateway(arg0 context.Context, arg1 string) (*client.InternetGateway, error) {
+// GetQueue mocks base method.
+func (m *MockInterface) GetQueue(arg0 context.Context, arg1 string) (*client.Queue, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetInternetGateway", arg0, arg1)
-	ret0, _ := ret[0].(*client.InternetGateway)
+	ret := m.ctrl.Call(m, "GetQueue", arg0, arg1)
+	ret0, _ := ret[0].(*client.Queue)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetInternetGateway indicates an expected call of GetInternetGateway.
-func (mr *MockInterfaceMockRecorder) GetInternetGateway(arg0, arg1 interface{}) *gomock.Call {
+// GetQueue indicates an expected call of GetQueue.
+func (mr *MockInterfaceMockRecorder) GetQueue(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInternetGateway", reflect.TypeOf((*MockInterface)(nil).GetInternetGateway), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueue", reflect.TypeOf((*MockInterface)(nil).GetQueue), arg0, arg1)
 }
 
-// GetKeyPair mocks base method.
-func (m *MockInterface) GetKeyPair(arg0 context.Context, arg1 string) (*client.KeyPairInfo, error) {
+// GetResolverEndpoint mocks base method.
+func (m *MockInterface) GetResolverEndpoint(arg0 context.Context, arg1 string) (*client.ResolverEndpoint, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetKeyPair", arg0, arg1)
-	ret0, _ := ret[0].(*client.KeyPairInfo)
+	ret := m.ctrl.Call(m, "GetResolverEndpoint", arg0, arg1)
+	ret0, _ := ret[0].(*client.ResolverEndpoint)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetKeyPair indicates an expected call of GetKeyPair.
-func (mr *MockInterfaceMockRecorder) GetKeyPair(arg0, arg1 interface{}) *gomock.Call {
+// GetResolverEndpoint indicates an expected call of GetResolverEndpoint.
+func (mr *MockInterfaceMockRecorder) GetResolverEndpoint(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeyPair", reflect.TypeOf((*MockInterface)(nil).GetKeyPair), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResolverEndpoint", reflect.TypeOf((*MockInterface)(nil).GetResolverEndpoint), arg0, arg1)
 }
 
-// GetNATGateway mocks base method.
-func (m *MockInterface) GetNATGateway(arg0 context.Context, arg1 string) (*client.NATGateway, error) {
+// GetResolverEndpointIpAddresses mocks base method.
+func (m *MockInterface) GetResolverEndpointIpAddresses(arg0 context.Context, arg1 string) ([]*client.ResolverEndpointIpAddress, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNATGateway", arg0, arg1)
-	ret0, _ := ret[0].(*client.NATGateway)
+	ret := m.ctrl.Call(m, "GetResolverEndpointIpAddresses", arg0, arg1)
+	ret0, _ := ret[0].([]*client.ResolverEndpointIpAddress)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetNATGateway indicates an expected call of GetNATGateway.
-func (mr *MockInterfaceMockRecorder) GetNATGateway(arg0, arg1 interface{}) *gomock.Call {
+// GetResolverEndpointIpAddresses indicates an expected call of GetResolverEndpointIpAddresses.
+func (mr *MockInterfaceMockRecorder) GetResolverEndpointIpAddresses(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNATGateway", reflect.TypeOf((*MockInterface)(nil).GetNATGateway), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResolverEndpointIpAddresses", reflect.TypeOf((*MockInterface)(nil).GetResolverEndpointIpAddresses), arg0, arg1)
 }
 
-// GetNATGatewayAddressAllocations mocks base method.
-func (m *MockInterface) GetNATGatewayAddressAllocations(arg0 context.Context, arg1 string) (sets.Set[string], error) {
+// GetResolverRuleAssociations mocks base method.
+func (m *MockInterface) GetResolverRuleAssociations(arg0 context.Context, arg1 string) ([]*client.ResolverRuleAssociation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNATGatewayAddressAllocations", arg0, arg1)
-	ret0, _ := ret[0].(sets.Set[string])
+	ret := m.ctrl.Call(m, "GetResolverRuleAssociations", arg0, arg1)
+	ret0, _ := ret[0].([]*client.ResolverRuleAssociation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetNATGatewayAddressAllocations indicates an expected call of GetNATGatewayAddressAllocations.
-func (mr *MockInterfaceMockRecorder) GetNATGatewayAddressAllocations(arg0, arg1 interface{}) *gomock.Call {
+// GetResolverRuleAssociations indicates an expected call of GetResolverRuleAssociations.
+func (mr *MockInterfaceMockRecorder) GetResolverRuleAssociations(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNATGatewayAddressAllocations", reflect.TypeOf((*MockInterface)(nil).GetNATGatewayAddressAllocations), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResolverRuleAssociations", reflect.TypeOf((*MockInterface)(nil).GetResolverRuleAssociations), arg0, arg1)
 }
 
 // GetRouteTable mocks base method.
@@ -1054,34 +2803,109 @@ func (mr *MockInterfaceMockRecorder) GetRouteTable(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRouteTable", reflect.TypeOf((*MockInterface)(nil).GetRouteTable), arg0, arg1)
 }
 
-// GetSecurityGroup mocks base method.
-func (m *MockInterface) GetSecurityGroup(arg0 context.Context, arg1 string) (*client.SecurityGroup, error) {
+// GetSSMParameter mocks base method.
+func (m *MockInterface) GetSSMParameter(arg0 context.Context, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSSMParameter", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSSMParameter indicates an expected call of GetSSMParameter.
+func (mr *MockInterfaceMockRecorder) GetSSMParameter(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSSMParameter", reflect.TypeOf((*MockInterface)(nil).GetSSMParameter), arg0, arg1)
+}
+
+// GetSecurityGroup mocks base method.
+func (m *MockInterface) GetSecurityGroup(arg0 context.Context, arg1 string) (*client.SecurityGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecurityGroup", arg0, arg1)
+	ret0, _ := ret[0].(*client.SecurityGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecurityGroup indicates an expected call of GetSecurityGroup.
+func (mr *MockInterfaceMockRecorder) GetSecurityGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecurityGroup", reflect.TypeOf((*MockInterface)(nil).GetSecurityGroup), arg0, arg1)
+}
+
+// GetSerialConsoleAccessStatus mocks base method.
+func (m *MockInterface) GetSerialConsoleAccessStatus(arg0 context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSerialConsoleAccessStatus", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSerialConsoleAccessStatus indicates an expected call of GetSerialConsoleAccessStatus.
+func (mr *MockInterfaceMockRecorder) GetSerialConsoleAccessStatus(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSerialConsoleAccessStatus", reflect.TypeOf((*MockInterface)(nil).GetSerialConsoleAccessStatus), arg0)
+}
+
+// GetServiceQuota mocks base method.
+func (m *MockInterface) GetServiceQuota(arg0 context.Context, arg1, arg2 string) (*float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceQuota", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceQuota indicates an expected call of GetServiceQuota.
+func (mr *MockInterfaceMockRecorder) GetServiceQuota(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceQuota", reflect.TypeOf((*MockInterface)(nil).GetServiceQuota), arg0, arg1, arg2)
+}
+
+// GetSubnets mocks base method.
+func (m *MockInterface) GetSubnets(arg0 context.Context, arg1 []string) ([]*client.Subnet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubnets", arg0, arg1)
+	ret0, _ := ret[0].([]*client.Subnet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubnets indicates an expected call of GetSubnets.
+func (mr *MockInterfaceMockRecorder) GetSubnets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnets", reflect.TypeOf((*MockInterface)(nil).GetSubnets), arg0, arg1)
+}
+
+// GetTransitGateway mocks base method.
+func (m *MockInterface) GetTransitGateway(arg0 context.Context, arg1 string) (*client.TransitGateway, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSecurityGroup", arg0, arg1)
-	ret0, _ := ret[0].(*client.SecurityGroup)
+	ret := m.ctrl.Call(m, "GetTransitGateway", arg0, arg1)
+	ret0, _ := ret[0].(*client.TransitGateway)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSecurityGroup indicates an expected call of GetSecurityGroup.
-func (mr *MockInterfaceMockRecorder) GetSecurityGroup(arg0, arg1 interface{}) *gomock.Call {
+// GetTransitGateway indicates an expected call of GetTransitGateway.
+func (mr *MockInterfaceMockRecorder) GetTransitGateway(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecurityGroup", reflect.TypeOf((*MockInterface)(nil).GetSecurityGroup), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransitGateway", reflect.TypeOf((*MockInterface)(nil).GetTransitGateway), arg0, arg1)
 }
 
-// GetSubnets mocks base method.
-func (m *MockInterface) GetSubnets(arg0 context.Context, arg1 []string) ([]*client.Subnet, error) {
+// GetTransitGatewayVpcAttachment mocks base method.
+func (m *MockInterface) GetTransitGatewayVpcAttachment(arg0 context.Context, arg1 string) (*client.TransitGatewayVpcAttachment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSubnets", arg0, arg1)
-	ret0, _ := ret[0].([]*client.Subnet)
+	ret := m.ctrl.Call(m, "GetTransitGatewayVpcAttachment", arg0, arg1)
+	ret0, _ := ret[0].(*client.TransitGatewayVpcAttachment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSubnets indicates an expected call of GetSubnets.
-func (mr *MockInterfaceMockRecorder) GetSubnets(arg0, arg1 interface{}) *gomock.Call {
+// GetTransitGatewayVpcAttachment indicates an expected call of GetTransitGatewayVpcAttachment.
+func (mr *MockInterfaceMockRecorder) GetTransitGatewayVpcAttachment(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnets", reflect.TypeOf((*MockInterface)(nil).GetSubnets), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransitGatewayVpcAttachment", reflect.TypeOf((*MockInterface)(nil).GetTransitGatewayVpcAttachment), arg0, arg1)
 }
 
 // GetVPCAttribute mocks base method.
@@ -1129,6 +2953,21 @@ func (mr *MockInterfaceMockRecorder) GetVpc(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpc", reflect.TypeOf((*MockInterface)(nil).GetVpc), arg0, arg1)
 }
 
+// GetVpcCount mocks base method.
+func (m *MockInterface) GetVpcCount(arg0 context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVpcCount", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVpcCount indicates an expected call of GetVpcCount.
+func (mr *MockInterfaceMockRecorder) GetVpcCount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpcCount", reflect.TypeOf((*MockInterface)(nil).GetVpcCount), arg0)
+}
+
 // GetVpcDhcpOptions mocks base method.
 func (m *MockInterface) GetVpcDhcpOptions(arg0 context.Context, arg1 string) (*client.DhcpOptions, error) {
 	m.ctrl.T.Helper()
@@ -1144,6 +2983,36 @@ func (mr *MockInterfaceMockRecorder) GetVpcDhcpOptions(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpcDhcpOptions", reflect.TypeOf((*MockInterface)(nil).GetVpcDhcpOptions), arg0, arg1)
 }
 
+// GetVpcEndpointService mocks base method.
+func (m *MockInterface) GetVpcEndpointService(arg0 context.Context, arg1 string) (*client.VpcEndpointService, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVpcEndpointService", arg0, arg1)
+	ret0, _ := ret[0].(*client.VpcEndpointService)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVpcEndpointService indicates an expected call of GetVpcEndpointService.
+func (mr *MockInterfaceMockRecorder) GetVpcEndpointService(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpcEndpointService", reflect.TypeOf((*MockInterface)(nil).GetVpcEndpointService), arg0, arg1)
+}
+
+// GetVpcEndpointServicePrincipals mocks base method.
+func (m *MockInterface) GetVpcEndpointServicePrincipals(arg0 context.Context, arg1 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVpcEndpointServicePrincipals", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVpcEndpointServicePrincipals indicates an expected call of GetVpcEndpointServicePrincipals.
+func (mr *MockInterfaceMockRecorder) GetVpcEndpointServicePrincipals(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpcEndpointServicePrincipals", reflect.TypeOf((*MockInterface)(nil).GetVpcEndpointServicePrincipals), arg0, arg1)
+}
+
 // GetVpcEndpoints mocks base method.
 func (m *MockInterface) GetVpcEndpoints(arg0 context.Context, arg1 []string) ([]*client.VpcEndpoint, error) {
 	m.ctrl.T.Helper()
@@ -1159,6 +3028,21 @@ func (mr *MockInterfaceMockRecorder) GetVpcEndpoints(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpcEndpoints", reflect.TypeOf((*MockInterface)(nil).GetVpcEndpoints), arg0, arg1)
 }
 
+// GetVpcPeeringConnection mocks base method.
+func (m *MockInterface) GetVpcPeeringConnection(arg0 context.Context, arg1 string) (*client.VpcPeeringConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVpcPeeringConnection", arg0, arg1)
+	ret0, _ := ret[0].(*client.VpcPeeringConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVpcPeeringConnection indicates an expected call of GetVpcPeeringConnection.
+func (mr *MockInterfaceMockRecorder) GetVpcPeeringConnection(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpcPeeringConnection", reflect.TypeOf((*MockInterface)(nil).GetVpcPeeringConnection), arg0, arg1)
+}
+
 // ImportKeyPair mocks base method.
 func (m *MockInterface) ImportKeyPair(arg0 context.Context, arg1 string, arg2 []byte, arg3 client.Tags) (*client.KeyPairInfo, error) {
 	m.ctrl.T.Helper()
@@ -1219,6 +3103,105 @@ func (mr *MockInterfaceMockRecorder) ListKubernetesSecurityGroups(arg0, arg1, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKubernetesSecurityGroups", reflect.TypeOf((*MockInterface)(nil).ListKubernetesSecurityGroups), arg0, arg1, arg2)
 }
 
+// ModifyManagedPrefixListCIDRs mocks base method.
+func (m *MockInterface) ModifyManagedPrefixListCIDRs(arg0 context.Context, arg1 string, arg2 int64, arg3, arg4 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyManagedPrefixListCIDRs", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ModifyManagedPrefixListCIDRs indicates an expected call of ModifyManagedPrefixListCIDRs.
+func (mr *MockInterfaceMockRecorder) ModifyManagedPrefixListCIDRs(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyManagedPrefixListCIDRs", reflect.TypeOf((*MockInterface)(nil).ModifyManagedPrefixListCIDRs), arg0, arg1, arg2, arg3, arg4)
+}
+
+// ModifyTransitGatewayVpcAttachmentSubnets mocks base method.
+func (m *MockInterface) ModifyTransitGatewayVpcAttachmentSubnets(arg0 context.Context, arg1 string, arg2, arg3 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyTransitGatewayVpcAttachmentSubnets", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ModifyTransitGatewayVpcAttachmentSubnets indicates an expected call of ModifyTransitGatewayVpcAttachmentSubnets.
+func (mr *MockInterfaceMockRecorder) ModifyTransitGatewayVpcAttachmentSubnets(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyTransitGatewayVpcAttachmentSubnets", reflect.TypeOf((*MockInterface)(nil).ModifyTransitGatewayVpcAttachmentSubnets), arg0, arg1, arg2, arg3)
+}
+
+// ModifyVpcEndpointSubnets mocks base method.
+func (m *MockInterface) ModifyVpcEndpointSubnets(arg0 context.Context, arg1 string, arg2, arg3 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyVpcEndpointSubnets", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ModifyVpcEndpointSubnets indicates an expected call of ModifyVpcEndpointSubnets.
+func (mr *MockInterfaceMockRecorder) ModifyVpcEndpointSubnets(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyVpcEndpointSubnets", reflect.TypeOf((*MockInterface)(nil).ModifyVpcEndpointSubnets), arg0, arg1, arg2, arg3)
+}
+
+// PutBucketAccessLogging mocks base method.
+func (m *MockInterface) PutBucketAccessLogging(arg0 context.Context, arg1 string, arg2 *client.BucketAccessLogging) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutBucketAccessLogging", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutBucketAccessLogging indicates an expected call of PutBucketAccessLogging.
+func (mr *MockInterfaceMockRecorder) PutBucketAccessLogging(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutBucketAccessLogging", reflect.TypeOf((*MockInterface)(nil).PutBucketAccessLogging), arg0, arg1, arg2)
+}
+
+// PutBucketPolicy mocks base method.
+func (m *MockInterface) PutBucketPolicy(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutBucketPolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutBucketPolicy indicates an expected call of PutBucketPolicy.
+func (mr *MockInterfaceMockRecorder) PutBucketPolicy(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutBucketPolicy", reflect.TypeOf((*MockInterface)(nil).PutBucketPolicy), arg0, arg1, arg2)
+}
+
+// PutBucketReplication mocks base method.
+func (m *MockInterface) PutBucketReplication(arg0 context.Context, arg1 string, arg2 *client.BucketReplication) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutBucketReplication", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutBucketReplication indicates an expected call of PutBucketReplication.
+func (mr *MockInterfaceMockRecorder) PutBucketReplication(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutBucketReplication", reflect.TypeOf((*MockInterface)(nil).PutBucketReplication), arg0, arg1, arg2)
+}
+
+// PutEventRule mocks base method.
+func (m *MockInterface) PutEventRule(arg0 context.Context, arg1 *client.EventRule) (*client.EventRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutEventRule", arg0, arg1)
+	ret0, _ := ret[0].(*client.EventRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutEventRule indicates an expected call of PutEventRule.
+func (mr *MockInterfaceMockRecorder) PutEventRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutEventRule", reflect.TypeOf((*MockInterface)(nil).PutEventRule), arg0, arg1)
+}
+
 // PutIAMRolePolicy mocks base method.
 func (m *MockInterface) PutIAMRolePolicy(arg0 context.Context, arg1 *client.IAMRolePolicy) error {
 	m.ctrl.T.Helper()
@@ -1233,6 +3216,20 @@ func (mr *MockInterfaceMockRecorder) PutIAMRolePolicy(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutIAMRolePolicy", reflect.TypeOf((*MockInterface)(nil).PutIAMRolePolicy), arg0, arg1)
 }
 
+// PutObject mocks base method.
+func (m *MockInterface) PutObject(arg0 context.Context, arg1, arg2, arg3 string, arg4 []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutObject", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutObject indicates an expected call of PutObject.
+func (mr *MockInterfaceMockRecorder) PutObject(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutObject", reflect.TypeOf((*MockInterface)(nil).PutObject), arg0, arg1, arg2, arg3, arg4)
+}
+
 // RemoveRoleFromIAMInstanceProfile mocks base method.
 func (m *MockInterface) RemoveRoleFromIAMInstanceProfile(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -1247,6 +3244,48 @@ func (mr *MockInterfaceMockRecorder) RemoveRoleFromIAMInstanceProfile(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRoleFromIAMInstanceProfile", reflect.TypeOf((*MockInterface)(nil).RemoveRoleFromIAMInstanceProfile), arg0, arg1, arg2)
 }
 
+// ReplaceNetworkAclAssociation mocks base method.
+func (m *MockInterface) ReplaceNetworkAclAssociation(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceNetworkAclAssociation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceNetworkAclAssociation indicates an expected call of ReplaceNetworkAclAssociation.
+func (mr *MockInterfaceMockRecorder) ReplaceNetworkAclAssociation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceNetworkAclAssociation", reflect.TypeOf((*MockInterface)(nil).ReplaceNetworkAclAssociation), arg0, arg1, arg2)
+}
+
+// ReplaceNetworkAclEntry mocks base method.
+func (m *MockInterface) ReplaceNetworkAclEntry(arg0 context.Context, arg1 string, arg2 *client.NetworkAclEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceNetworkAclEntry", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceNetworkAclEntry indicates an expected call of ReplaceNetworkAclEntry.
+func (mr *MockInterfaceMockRecorder) ReplaceNetworkAclEntry(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceNetworkAclEntry", reflect.TypeOf((*MockInterface)(nil).ReplaceNetworkAclEntry), arg0, arg1, arg2)
+}
+
+// ResizeManagedPrefixList mocks base method.
+func (m *MockInterface) ResizeManagedPrefixList(arg0 context.Context, arg1 string, arg2 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResizeManagedPrefixList", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResizeManagedPrefixList indicates an expected call of ResizeManagedPrefixList.
+func (mr *MockInterfaceMockRecorder) ResizeManagedPrefixList(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeManagedPrefixList", reflect.TypeOf((*MockInterface)(nil).ResizeManagedPrefixList), arg0, arg1, arg2)
+}
+
 // RevokeSecurityGroupRules mocks base method.
 func (m *MockInterface) RevokeSecurityGroupRules(arg0 context.Context, arg1 string, arg2 []*client.SecurityGroupRule) error {
 	m.ctrl.T.Helper()
@@ -1261,6 +3300,48 @@ func (mr *MockInterfaceMockRecorder) RevokeSecurityGroupRules(arg0, arg1, arg2 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSecurityGroupRules", reflect.TypeOf((*MockInterface)(nil).RevokeSecurityGroupRules), arg0, arg1, arg2)
 }
 
+// TagResources mocks base method.
+func (m *MockInterface) TagResources(arg0 context.Context, arg1 []string, arg2 client.Tags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagResources", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TagResources indicates an expected call of TagResources.
+func (mr *MockInterfaceMockRecorder) TagResources(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagResources", reflect.TypeOf((*MockInterface)(nil).TagResources), arg0, arg1, arg2)
+}
+
+// TerminateAutoScalingGroupInstance mocks base method.
+func (m *MockInterface) TerminateAutoScalingGroupInstance(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TerminateAutoScalingGroupInstance", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TerminateAutoScalingGroupInstance indicates an expected call of TerminateAutoScalingGroupInstance.
+func (mr *MockInterfaceMockRecorder) TerminateAutoScalingGroupInstance(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateAutoScalingGroupInstance", reflect.TypeOf((*MockInterface)(nil).TerminateAutoScalingGroupInstance), arg0, arg1)
+}
+
+// TerminateInstance mocks base method.
+func (m *MockInterface) TerminateInstance(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TerminateInstance", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TerminateInstance indicates an expected call of TerminateInstance.
+func (mr *MockInterfaceMockRecorder) TerminateInstance(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateInstance", reflect.TypeOf((*MockInterface)(nil).TerminateInstance), arg0, arg1)
+}
+
 // UpdateAmazonProvidedIPv6CidrBlock mocks base method.
 func (m *MockInterface) UpdateAmazonProvidedIPv6CidrBlock(arg0 context.Context, arg1, arg2 *client.VPC) (bool, error) {
 	m.ctrl.T.Helper()
@@ -1290,6 +3371,90 @@ func (mr *MockInterfaceMockRecorder) UpdateAssumeRolePolicy(arg0, arg1, arg2 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAssumeRolePolicy", reflect.TypeOf((*MockInterface)(nil).UpdateAssumeRolePolicy), arg0, arg1, arg2)
 }
 
+// UpdateAutoScalingGroup mocks base method.
+func (m *MockInterface) UpdateAutoScalingGroup(arg0 context.Context, arg1 *client.AutoScalingGroup) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAutoScalingGroup", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAutoScalingGroup indicates an expected call of UpdateAutoScalingGroup.
+func (mr *MockInterfaceMockRecorder) UpdateAutoScalingGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAutoScalingGroup", reflect.TypeOf((*MockInterface)(nil).UpdateAutoScalingGroup), arg0, arg1)
+}
+
+// UpdateHealthCheck mocks base method.
+func (m *MockInterface) UpdateHealthCheck(arg0 context.Context, arg1 string, arg2 *client.HealthCheck) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHealthCheck", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateHealthCheck indicates an expected call of UpdateHealthCheck.
+func (mr *MockInterfaceMockRecorder) UpdateHealthCheck(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHealthCheck", reflect.TypeOf((*MockInterface)(nil).UpdateHealthCheck), arg0, arg1, arg2)
+}
+
+// UpdateLogGroupKmsKey mocks base method.
+func (m *MockInterface) UpdateLogGroupKmsKey(arg0 context.Context, arg1 string, arg2 *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLogGroupKmsKey", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLogGroupKmsKey indicates an expected call of UpdateLogGroupKmsKey.
+func (mr *MockInterfaceMockRecorder) UpdateLogGroupKmsKey(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLogGroupKmsKey", reflect.TypeOf((*MockInterface)(nil).UpdateLogGroupKmsKey), arg0, arg1, arg2)
+}
+
+// UpdateLogGroupRetention mocks base method.
+func (m *MockInterface) UpdateLogGroupRetention(arg0 context.Context, arg1 string, arg2 *int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLogGroupRetention", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLogGroupRetention indicates an expected call of UpdateLogGroupRetention.
+func (mr *MockInterfaceMockRecorder) UpdateLogGroupRetention(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLogGroupRetention", reflect.TypeOf((*MockInterface)(nil).UpdateLogGroupRetention), arg0, arg1, arg2)
+}
+
+// UpdateQueueAttributes mocks base method.
+func (m *MockInterface) UpdateQueueAttributes(arg0 context.Context, arg1 string, arg2 map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateQueueAttributes", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateQueueAttributes indicates an expected call of UpdateQueueAttributes.
+func (mr *MockInterfaceMockRecorder) UpdateQueueAttributes(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQueueAttributes", reflect.TypeOf((*MockInterface)(nil).UpdateQueueAttributes), arg0, arg1, arg2)
+}
+
+// UpdateResolverRuleTargetIps mocks base method.
+func (m *MockInterface) UpdateResolverRuleTargetIps(arg0 context.Context, arg1 string, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateResolverRuleTargetIps", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateResolverRuleTargetIps indicates an expected call of UpdateResolverRuleTargetIps.
+func (mr *MockInterfaceMockRecorder) UpdateResolverRuleTargetIps(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResolverRuleTargetIps", reflect.TypeOf((*MockInterface)(nil).UpdateResolverRuleTargetIps), arg0, arg1, arg2)
+}
+
 // UpdateSubnetAttributes mocks base method.
 func (m *MockInterface) UpdateSubnetAttributes(arg0 context.Context, arg1, arg2 *client.Subnet) (bool, error) {
 	m.ctrl.T.Helper()
@@ -1319,6 +3484,49 @@ func (mr *MockInterfaceMockRecorder) UpdateVpcAttribute(arg0, arg1, arg2, arg3 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVpcAttribute", reflect.TypeOf((*MockInterface)(nil).UpdateVpcAttribute), arg0, arg1, arg2, arg3)
 }
 
+// UpdateVpcEndpointService mocks base method.
+func (m *MockInterface) UpdateVpcEndpointService(arg0 context.Context, arg1, arg2 *client.VpcEndpointService) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVpcEndpointService", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVpcEndpointService indicates an expected call of UpdateVpcEndpointService.
+func (mr *MockInterfaceMockRecorder) UpdateVpcEndpointService(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVpcEndpointService", reflect.TypeOf((*MockInterface)(nil).UpdateVpcEndpointService), arg0, arg1, arg2)
+}
+
+// UpdateVpcEndpointServicePrincipals mocks base method.
+func (m *MockInterface) UpdateVpcEndpointServicePrincipals(arg0 context.Context, arg1 string, arg2, arg3 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVpcEndpointServicePrincipals", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVpcEndpointServicePrincipals indicates an expected call of UpdateVpcEndpointServicePrincipals.
+func (mr *MockInterfaceMockRecorder) UpdateVpcEndpointServicePrincipals(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVpcEndpointServicePrincipals", reflect.TypeOf((*MockInterface)(nil).UpdateVpcEndpointServicePrincipals), arg0, arg1, arg2, arg3)
+}
+
+// WaitForAutoScalingGroupInstanceInService mocks base method.
+func (m *MockInterface) WaitForAutoScalingGroupInstanceInService(arg0 context.Context, arg1, arg2 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForAutoScalingGroupInstanceInService", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForAutoScalingGroupInstanceInService indicates an expected call of WaitForAutoScalingGroupInstanceInService.
+func (mr *MockInterfaceMockRecorder) WaitForAutoScalingGroupInstanceInService(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForAutoScalingGroupInstanceInService", reflect.TypeOf((*MockInterface)(nil).WaitForAutoScalingGroupInstanceInService), arg0, arg1, arg2)
+}
+
 // WaitForIPv6Cidr mocks base method.
 func (m *MockInterface) WaitForIPv6Cidr(arg0 context.Context, arg1 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -1334,6 +3542,20 @@ func (mr *MockInterfaceMockRecorder) WaitForIPv6Cidr(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForIPv6Cidr", reflect.TypeOf((*MockInterface)(nil).WaitForIPv6Cidr), arg0, arg1)
 }
 
+// WaitForManagedPrefixListModified mocks base method.
+func (m *MockInterface) WaitForManagedPrefixListModified(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForManagedPrefixListModified", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitForManagedPrefixListModified indicates an expected call of WaitForManagedPrefixListModified.
+func (mr *MockInterfaceMockRecorder) WaitForManagedPrefixListModified(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForManagedPrefixListModified", reflect.TypeOf((*MockInterface)(nil).WaitForManagedPrefixListModified), arg0, arg1)
+}
+
 // WaitForNATGatewayAvailable mocks base method.
 func (m *MockInterface) WaitForNATGatewayAvailable(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -1348,6 +3570,40 @@ func (mr *MockInterfaceMockRecorder) WaitForNATGatewayAvailable(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForNATGatewayAvailable", reflect.TypeOf((*MockInterface)(nil).WaitForNATGatewayAvailable), arg0, arg1)
 }
 
+// WaitForTransitGatewayVpcAttachmentAvailable mocks base method.
+func (m *MockInterface) WaitForTransitGatewayVpcAttachmentAvailable(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForTransitGatewayVpcAttachmentAvailable", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitForTransitGatewayVpcAttachmentAvailable indicates an expected call of WaitForTransitGatewayVpcAttachmentAvailable.
+func (mr *MockInterfaceMockRecorder) WaitForTransitGatewayVpcAttachmentAvailable(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForTransitGatewayVpcAttachmentAvailable", reflect.TypeOf((*MockInterface)(nil).WaitForTransitGatewayVpcAttachmentAvailable), arg0, arg1)
+}
+
+// WaitForVpcPeeringConnectionState mocks base method.
+func (m *MockInterface) WaitForVpcPeeringConnectionState(arg0 context.Context, arg1 string, arg2 ...string) (*client.VpcPeeringConnection, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WaitForVpcPeeringConnectionState", varargs...)
+	ret0, _ := ret[0].(*client.VpcPeeringConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForVpcPeeringConnectionState indicates an expected call of WaitForVpcPeeringConnectionState.
+func (mr *MockInterfaceMockRecorder) WaitForVpcPeeringConnectionState(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForVpcPeeringConnectionState", reflect.TypeOf((*MockInterface)(nil).WaitForVpcPeeringConnectionState), varargs...)
+}
+
 // MockFactory is a mock of Factory interface.
 type MockFactory struct {
 	ctrl     *gomock.Controller
@@ -1372,16 +3628,16 @@ func (m *MockFactory) EXPECT() *MockFactoryMockRecorder {
 }
 
 // NewClient mocks base method.
-func (m *MockFactory) NewClient(arg0, arg1, arg2 string) (client.Interface, error) {
+func (m *MockFactory) NewClient(arg0 client.AuthConfig) (client.Interface, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewClient", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "NewClient", arg0)
 	ret0, _ := ret[0].(client.Interface)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // NewClient indicates an expected call of NewClient.
-func (mr *MockFactoryMockRecorder) NewClient(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockFactoryMockRecorder) NewClient(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewClient", reflect.TypeOf((*MockFactory)(nil).NewClient), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewClient", reflect.TypeOf((*MockFactory)(nil).NewClient), arg0)
 }
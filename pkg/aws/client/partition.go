@@ -0,0 +1,133 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Different available partitions in AWS are defined at
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference-arns.html
+const (
+	// PartitionAWS is the partition of the AWS standard regions.
+	PartitionAWS = "aws"
+	// PartitionAWSChina is the partition of the AWS China regions.
+	PartitionAWSChina = "aws-cn"
+	// PartitionAWSUSGov is the partition of the AWS GovCloud (US) regions.
+	PartitionAWSUSGov = "aws-us-gov"
+	// PartitionAWSISO is the partition of the AWS ISO (US) regions.
+	PartitionAWSISO = "aws-iso"
+	// PartitionAWSISOB is the partition of the AWS ISOB (US) regions.
+	PartitionAWSISOB = "aws-iso-b"
+)
+
+// Service identifiers which can be used as keys for AuthConfig.Endpoints.
+const (
+	// ServiceEC2 is the identifier of the EC2 service.
+	ServiceEC2 = "ec2"
+	// ServiceAutoScaling is the identifier of the Auto Scaling service.
+	ServiceAutoScaling = "autoscaling"
+	// ServiceCloudWatchLogs is the identifier of the CloudWatch Logs service.
+	ServiceCloudWatchLogs = "cloudwatchlogs"
+	// ServiceKMS is the identifier of the KMS service.
+	ServiceKMS = "kms"
+	// ServiceSTS is the identifier of the STS service.
+	ServiceSTS = "sts"
+	// ServiceIAM is the identifier of the IAM service.
+	ServiceIAM = "iam"
+	// ServiceS3 is the identifier of the S3 service.
+	ServiceS3 = "s3"
+	// ServiceELB is the identifier of the classic Elastic Load Balancing service.
+	ServiceELB = "elb"
+	// ServiceELBv2 is the identifier of the Elastic Load Balancing v2 service.
+	ServiceELBv2 = "elbv2"
+	// ServiceRoute53 is the identifier of the Route 53 service.
+	ServiceRoute53 = "route53"
+	// ServiceServiceQuotas is the identifier of the Service Quotas service.
+	ServiceServiceQuotas = "servicequotas"
+	// ServiceOutposts is the identifier of the Outposts service.
+	ServiceOutposts = "outposts"
+	// ServiceSQS is the identifier of the SQS service.
+	ServiceSQS = "sqs"
+	// ServiceEventBridge is the identifier of the EventBridge service.
+	ServiceEventBridge = "eventbridge"
+	// ServiceEFS is the identifier of the Elastic File System service.
+	ServiceEFS = "elasticfilesystem"
+	// ServiceSSM is the identifier of the Systems Manager service.
+	ServiceSSM = "ssm"
+	// ServiceRoute53Resolver is the identifier of the Route 53 Resolver service.
+	ServiceRoute53Resolver = "route53resolver"
+	// ServiceResourceGroupsTagging is the identifier of the Resource Groups Tagging service.
+	ServiceResourceGroupsTagging = "tagging"
+)
+
+// Partitions are the known AWS partitions.
+var Partitions = []string{PartitionAWS, PartitionAWSChina, PartitionAWSUSGov, PartitionAWSISO, PartitionAWSISOB}
+
+// Services are the identifiers of the AWS services whose endpoints can be overridden.
+var Services = []string{ServiceEC2, ServiceAutoScaling, ServiceCloudWatchLogs, ServiceKMS, ServiceSTS, ServiceIAM, ServiceS3, ServiceELB, ServiceELBv2, ServiceRoute53, ServiceServiceQuotas, ServiceOutposts, ServiceSQS, ServiceEventBridge, ServiceEFS, ServiceSSM, ServiceRoute53Resolver, ServiceResourceGroupsTagging}
+
+// PartitionForRegion returns the AWS partition the given region belongs to.
+func PartitionForRegion(region string) string {
+	switch {
+	case strings.HasPrefix(region, "cn-"):
+		return PartitionAWSChina
+	case strings.HasPrefix(region, "us-gov-"):
+		return PartitionAWSUSGov
+	case strings.HasPrefix(region, "us-isob-"):
+		return PartitionAWSISOB
+	case strings.HasPrefix(region, "us-iso-"):
+		return PartitionAWSISO
+	default:
+		return PartitionAWS
+	}
+}
+
+// DNSSuffix returns the DNS suffix of the given AWS partition.
+func DNSSuffix(partition string) string {
+	switch partition {
+	case PartitionAWSChina:
+		return "amazonaws.com.cn"
+	case PartitionAWSISO:
+		return "c2s.ic.gov"
+	case PartitionAWSISOB:
+		return "sc2s.sgov.gov"
+	default:
+		return "amazonaws.com"
+	}
+}
+
+// ServicePrincipal returns the IAM service principal of the given service (e.g. `ec2`) in the given AWS partition.
+func ServicePrincipal(partition, service string) string {
+	// Only EC2 uses the partition specific DNS suffix in the China regions, all other services keep `amazonaws.com`.
+	if partition == PartitionAWSChina && service != ServiceEC2 {
+		return fmt.Sprintf("%s.amazonaws.com", service)
+	}
+	return fmt.Sprintf("%s.%s", service, DNSSuffix(partition))
+}
+
+// VPCEndpointServiceNamePrefix returns the prefix of the names of the VPC endpoint services of the given region.
+func VPCEndpointServiceNamePrefix(partition, region string) string {
+	if partition == PartitionAWSChina {
+		return fmt.Sprintf("cn.com.amazonaws.%s.", region)
+	}
+	return fmt.Sprintf("com.amazonaws.%s.", region)
+}
+
+// ARN returns the ARN of the given resource in the given AWS partition.
+func ARN(partition, service, region, accountID, resource string) string {
+	return fmt.Sprintf("arn:%s:%s:%s:%s:%s", partition, service, region, accountID, resource)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+var _ = Describe("Partition", func() {
+	DescribeTable("#PartitionForRegion",
+		func(region, expected string) {
+			Expect(PartitionForRegion(region)).To(Equal(expected))
+		},
+		Entry("standard region", "eu-west-1", PartitionAWS),
+		Entry("China region", "cn-north-1", PartitionAWSChina),
+		Entry("GovCloud region", "us-gov-west-1", PartitionAWSUSGov),
+		Entry("ISO region", "us-iso-east-1", PartitionAWSISO),
+		Entry("ISOB region", "us-isob-east-1", PartitionAWSISOB),
+	)
+
+	DescribeTable("#ServicePrincipal",
+		func(partition, service, expected string) {
+			Expect(ServicePrincipal(partition, service)).To(Equal(expected))
+		},
+		Entry("EC2 in the standard partition", PartitionAWS, "ec2", "ec2.amazonaws.com"),
+		Entry("EC2 in the China partition", PartitionAWSChina, "ec2", "ec2.amazonaws.com.cn"),
+		Entry("VPC flow logs in the China partition", PartitionAWSChina, "vpc-flow-logs", "vpc-flow-logs.amazonaws.com"),
+		Entry("EC2 in the ISO partition", PartitionAWSISO, "ec2", "ec2.c2s.ic.gov"),
+	)
+
+	DescribeTable("#VPCEndpointServiceNamePrefix",
+		func(partition, region, expected string) {
+			Expect(VPCEndpointServiceNamePrefix(partition, region)).To(Equal(expected))
+		},
+		Entry("standard partition", PartitionAWS, "eu-west-1", "com.amazonaws.eu-west-1."),
+		Entry("China partition", PartitionAWSChina, "cn-north-1", "cn.com.amazonaws.cn-north-1."),
+	)
+
+	It("#ARN", func() {
+		Expect(ARN(PartitionAWSUSGov, "s3", "", "", "my-bucket")).To(Equal("arn:aws-us-gov:s3:::my-bucket"))
+		Expect(ARN(PartitionAWS, "iam", "", "123456789012", "role/foo")).To(Equal("arn:aws:iam::123456789012:role/foo"))
+	})
+})
@@ -0,0 +1,184 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// rateLimiterCacheTTL is the TTL to keep the rate limiters of credentials in a time-based eviction cache.
+	rateLimiterCacheTTL = 1 * time.Hour
+	// rateLimiterMiddlewareID is the ID of the middleware waiting for the client-side rate limiter.
+	rateLimiterMiddlewareID = "ClientRateLimiter"
+)
+
+var (
+	rateLimiterWaitDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "aws_client_rate_limiter_wait_duration_seconds",
+		Help:    "Duration requests to the AWS API waited for the client-side rate limiter.",
+		Buckets: []float64{0.001, 0.01, 0.1, 0.5, 1, 2.5, 5, 10, 30},
+	})
+	rateLimiterWaitTimeouts = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "aws_client_rate_limiter_wait_timeouts_total",
+		Help: "Number of requests to the AWS API which failed because waiting for the client-side rate limiter timed out.",
+	})
+)
+
+func init() {
+	metrics.Registry.MustRegister(rateLimiterWaitDuration, rateLimiterWaitTimeouts)
+}
+
+// RateLimiterConfig contains the configuration of the client-side rate limiting and retrying of the requests to the
+// AWS API. The rate limiter is shared by all clients using the same credentials in the same region.
+type RateLimiterConfig struct {
+	// Limit is the maximum number of requests per second.
+	Limit rate.Limit
+	// Burst is the maximum burst of requests.
+	Burst int
+	// WaitTimeout is the maximum duration a request waits for the rate limiter.
+	WaitTimeout time.Duration
+	// MaxAttempts is the maximum number of attempts of a request, including retries of throttled requests.
+	MaxAttempts int
+	// MaxBackoff is the maximum delay between two attempts of a request.
+	MaxBackoff time.Duration
+}
+
+// RateLimiterWaitError is an error to be reported if waiting for the client-side rate limiter fails.
+// This can only happen if the wait time would exceed the configured wait timeout.
+type RateLimiterWaitError struct {
+	Cause error
+}
+
+func (e *RateLimiterWaitError) Error() string {
+	return fmt.Sprintf("could not wait for client-side rate limiter: %+v", e.Cause)
+}
+
+// sharedRateLimiter is the rate limiter and the adaptive retryer shared by all clients using the same credentials.
+type sharedRateLimiter struct {
+	limiter *rate.Limiter
+	retryer aws.Retryer
+}
+
+var (
+	rateLimiters      = cache.NewExpiring()
+	rateLimitersMutex sync.Mutex
+)
+
+// credentialsKey returns the key identifying the credentials of the given authentication configuration. AWS throttles
+// requests per account, hence the key of an assumed role differs from the one of the access key.
+func credentialsKey(authConfig AuthConfig) string {
+	switch {
+	case authConfig.WebIdentity != nil:
+		return authConfig.WebIdentity.RoleARN
+	case authConfig.AssumeRole != nil:
+		return authConfig.AssumeRole.RoleARN
+	default:
+		return authConfig.AccessKeyID
+	}
+}
+
+// getSharedRateLimiter returns the shared rate limiter for the credentials and the region of the given authentication
+// configuration.
+func getSharedRateLimiter(authConfig AuthConfig) *sharedRateLimiter {
+	// A mutex guards against creating multiple rate limiters for the same credentials at the same time, which would
+	// exceed the desired QPS.
+	rateLimitersMutex.Lock()
+	defer rateLimitersMutex.Unlock()
+
+	key := credentialsKey(authConfig) + "/" + authConfig.Region
+	var limiter *sharedRateLimiter
+	if v, ok := rateLimiters.Get(key); ok {
+		limiter = v.(*sharedRateLimiter)
+	} else {
+		limiter = newSharedRateLimiter(authConfig.RateLimiter)
+	}
+	// Set should be called on every Get with cache.Expiring to refresh the TTL
+	rateLimiters.Set(key, limiter, rateLimiterCacheTTL)
+	return limiter
+}
+
+func newSharedRateLimiter(config *RateLimiterConfig) *sharedRateLimiter {
+	limit := config.Limit
+	if limit <= 0 {
+		limit = rate.Inf
+	}
+
+	return &sharedRateLimiter{
+		limiter: rate.NewLimiter(limit, config.Burst),
+		// The adaptive mode reduces the request rate as soon as requests are throttled and backs off with jitter. The
+		// retry quota of the standard mode is disabled as it fails requests early if many of them are throttled.
+		retryer: retry.NewAdaptiveMode(func(o *retry.AdaptiveModeOptions) {
+			o.StandardOptions = append(o.StandardOptions, func(so *retry.StandardOptions) {
+				so.RateLimiter = ratelimit.None
+				if config.MaxAttempts > 0 {
+					so.MaxAttempts = config.MaxAttempts
+				}
+				if config.MaxBackoff > 0 {
+					so.MaxBackoff = config.MaxBackoff
+					so.Backoff = retry.NewExponentialJitterBackoff(config.MaxBackoff)
+				}
+			})
+		}),
+	}
+}
+
+// applyRateLimiter configures the rate limiter and the adaptive retryer shared by all clients with the same
+// credentials in the given AWS config.
+func applyRateLimiter(cfg *aws.Config, authConfig AuthConfig) {
+	if authConfig.RateLimiter == nil {
+		return
+	}
+
+	shared := getSharedRateLimiter(authConfig)
+	cfg.Retryer = func() aws.Retryer { return shared.retryer }
+	cfg.APIOptions = append(cfg.APIOptions, func(stack *middleware.Stack) error {
+		// The middleware is added after the retry middleware so that every attempt of a request is rate limited.
+		return stack.Finalize.Insert(rateLimiterMiddleware(shared.limiter, authConfig.RateLimiter.WaitTimeout), "Retry", middleware.After)
+	})
+}
+
+// rateLimiterMiddleware returns a middleware which waits for the given rate limiter before sending a request.
+func rateLimiterMiddleware(limiter *rate.Limiter, waitTimeout time.Duration) middleware.FinalizeMiddleware {
+	return middleware.FinalizeMiddlewareFunc(rateLimiterMiddlewareID, func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		waitCtx := ctx
+		if waitTimeout > 0 {
+			var cancel context.CancelFunc
+			waitCtx, cancel = context.WithTimeout(ctx, waitTimeout)
+			defer cancel()
+		}
+
+		start := time.Now()
+		if err := limiter.Wait(waitCtx); err != nil {
+			rateLimiterWaitTimeouts.Inc()
+			return middleware.FinalizeOutput{}, middleware.Metadata{}, &RateLimiterWaitError{Cause: err}
+		}
+		rateLimiterWaitDuration.Observe(time.Since(start).Seconds())
+
+		return next.HandleFinalize(ctx, in)
+	})
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+const (
+	getCallerIdentityResponse = `<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>arn:aws:iam::123456789012:user/gardener</Arn>
+    <UserId>AIDAEXAMPLE</UserId>
+    <Account>123456789012</Account>
+  </GetCallerIdentityResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</GetCallerIdentityResponse>`
+	throttlingResponse = `<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>Throttling</Code>
+    <Message>Rate exceeded</Message>
+  </Error>
+  <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+</ErrorResponse>`
+)
+
+var _ = Describe("RateLimiter", func() {
+	var (
+		ctx = context.Background()
+
+		requests   atomic.Int32
+		throttled  atomic.Int32
+		server     *httptest.Server
+		authConfig AuthConfig
+	)
+
+	BeforeEach(func() {
+		requests.Store(0)
+		throttled.Store(0)
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			requests.Add(1)
+			w.Header().Set("Content-Type", "text/xml")
+			if throttled.Load() > 0 {
+				throttled.Add(-1)
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(throttlingResponse))
+				return
+			}
+			_, _ = w.Write([]byte(getCallerIdentityResponse))
+		}))
+		DeferCleanup(server.Close)
+
+		authConfig = AuthConfig{
+			AccessKeyID:     "access-key-" + CurrentSpecReport().LeafNodeText,
+			SecretAccessKey: "secret",
+			Region:          "eu-west-1",
+			Endpoints:       map[string]string{ServiceSTS: server.URL},
+		}
+	})
+
+	It("should fail requests if waiting for the rate limiter times out", func() {
+		authConfig.RateLimiter = &RateLimiterConfig{Limit: 0.1, Burst: 1, WaitTimeout: 10 * time.Millisecond}
+
+		client, err := NewClient(authConfig)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(client.GetAccountID(ctx)).To(Equal("123456789012"))
+
+		// the rate limiter is shared with other clients using the same credentials
+		client, err = NewClient(authConfig)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = client.GetAccountID(ctx)
+		var waitErr *RateLimiterWaitError
+		Expect(errors.As(err, &waitErr)).To(BeTrue())
+		Expect(requests.Load()).To(Equal(int32(1)))
+	})
+
+	It("should retry throttled requests", func() {
+		authConfig.RateLimiter = &RateLimiterConfig{Limit: 100, Burst: 10, MaxAttempts: 5, MaxBackoff: 10 * time.Millisecond}
+		throttled.Store(1)
+
+		client, err := NewClient(authConfig)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(client.GetAccountID(ctx)).To(Equal("123456789012"))
+		Expect(requests.Load()).To(Equal(int32(2)))
+	})
+
+	It("should give up after the maximum number of attempts", func() {
+		authConfig.RateLimiter = &RateLimiterConfig{Limit: 100, Burst: 10, MaxAttempts: 2, MaxBackoff: 10 * time.Millisecond}
+		throttled.Store(3)
+
+		client, err := NewClient(authConfig)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = client.GetAccountID(ctx)
+		Expect(err).To(MatchError(ContainSubstring("Throttling")))
+		Expect(requests.Load()).To(Equal(int32(2)))
+	})
+})
@@ -0,0 +1,156 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// route53MaxChangesPerBatch is the maximum number of changes which are combined into one ChangeResourceRecordSets
+	// request. Route53 allows up to 1000 resource records per request.
+	route53MaxChangesPerBatch = 100
+	// route53ChangeMaxAttempts is the maximum number of attempts of a ChangeResourceRecordSets request which is
+	// throttled or conflicts with a prior request for the same hosted zone.
+	route53ChangeMaxAttempts = 5
+	// route53ChangeRetryBackoff is the base delay between two attempts of a ChangeResourceRecordSets request, which is
+	// doubled and jittered for each attempt.
+	route53ChangeRetryBackoff = 500 * time.Millisecond
+)
+
+// Route53ChangeSender sends the given changes of the hosted zone with the given ID in one request.
+type Route53ChangeSender func(ctx context.Context, zoneId string, changes []route53types.Change) error
+
+// Route53ChangeBatcher combines the changes of concurrent requests for the same hosted zone into one
+// ChangeResourceRecordSets request, so that many DNSRecords in the same hosted zone do not exhaust the request quota
+// of Route53. Changes are collected for the duration of the batch window after the first change of a batch.
+// As Route53 applies the changes of a request atomically, a failed batch is retried with the changes of each request
+// on their own, so that one invalid change does not fail the other requests.
+type Route53ChangeBatcher struct {
+	window time.Duration
+
+	mutex   sync.Mutex
+	batches map[string]*route53ChangeBatch
+}
+
+type route53ChangeBatch struct {
+	changes  []route53types.Change
+	requests int
+	done     chan struct{}
+	err      error
+}
+
+// NewRoute53ChangeBatcher creates a new Route53ChangeBatcher with the given batch window.
+func NewRoute53ChangeBatcher(window time.Duration) *Route53ChangeBatcher {
+	return &Route53ChangeBatcher{
+		window:  window,
+		batches: map[string]*route53ChangeBatch{},
+	}
+}
+
+// Submit adds the given changes of the hosted zone with the given ID to the current batch of the hosted zone and waits
+// until the batch has been sent with the sender of the first request of the batch.
+func (b *Route53ChangeBatcher) Submit(ctx context.Context, zoneId string, changes []route53types.Change, send Route53ChangeSender) error {
+	if len(changes) >= route53MaxChangesPerBatch {
+		return send(ctx, zoneId, changes)
+	}
+
+	b.mutex.Lock()
+	batch, ok := b.batches[zoneId]
+	if !ok || len(batch.changes)+len(changes) > route53MaxChangesPerBatch {
+		batch = &route53ChangeBatch{done: make(chan struct{})}
+		b.batches[zoneId] = batch
+		time.AfterFunc(b.window, func() { b.flush(ctx, zoneId, batch, send) })
+	}
+	batch.changes = append(batch.changes, changes...)
+	batch.requests++
+	b.mutex.Unlock()
+
+	select {
+	case <-batch.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	if batch.err != nil && batch.requests > 1 {
+		return send(ctx, zoneId, changes)
+	}
+	return batch.err
+}
+
+func (b *Route53ChangeBatcher) flush(ctx context.Context, zoneId string, batch *route53ChangeBatch, send Route53ChangeSender) {
+	b.mutex.Lock()
+	if b.batches[zoneId] == batch {
+		delete(b.batches, zoneId)
+	}
+	b.mutex.Unlock()
+
+	// the context of the first request might be cancelled before the batch is sent, in this case the other requests
+	// send their changes on their own
+	batch.err = send(ctx, zoneId, batch.changes)
+	close(batch.done)
+}
+
+// sendRoute53Changes sends the given changes of the hosted zone with the given ID in one ChangeResourceRecordSets
+// request. Requests which are throttled or conflict with a prior request for the same hosted zone are retried with a
+// jittered exponential backoff.
+func (c *Client) sendRoute53Changes(ctx context.Context, zoneId string, changes []route53types.Change) error {
+	backoff := route53ChangeRetryBackoff
+	for attempt := 1; ; attempt++ {
+		if err := c.waitForRoute53RateLimiter(ctx); err != nil {
+			return err
+		}
+		if c.Route53ZoneRateLimiter != nil {
+			if err := c.Route53ZoneRateLimiter(zoneId).Wait(ctx); err != nil {
+				return &Route53RateLimiterWaitError{Cause: err}
+			}
+		}
+
+		_, err := c.Route53.ChangeResourceRecordSets(ctx, newChangeResourceRecordSetsInput(zoneId, changes))
+		if err == nil || attempt == route53ChangeMaxAttempts || !isRetryableRoute53ChangeError(err) {
+			return err
+		}
+
+		delay := wait.Jitter(backoff, 1.0)
+		c.Logger.Info("Retrying throttled route53 change request", "zone", zoneId, "attempt", attempt, "delay", delay.String())
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		backoff *= 2
+	}
+}
+
+// changeRoute53RecordSets applies the given action to the given recordsets of the hosted zone with the given ID. If the
+// client has a Route53ChangeBatcher, the changes are batched with the ones of other requests for the hosted zone.
+func (c *Client) changeRoute53RecordSets(ctx context.Context, zoneId string, action route53types.ChangeAction, rrss []route53types.ResourceRecordSet) error {
+	changes := newChanges(action, rrss)
+	if c.Route53ChangeBatcher != nil {
+		return c.Route53ChangeBatcher.Submit(ctx, zoneId, changes, c.sendRoute53Changes)
+	}
+	return c.sendRoute53Changes(ctx, zoneId, changes)
+}
+
+func isRetryableRoute53ChangeError(err error) bool {
+	var priorRequestNotComplete *route53types.PriorRequestNotComplete
+	return errors.As(err, &priorRequestNotComplete) || IsThrottlingError(err)
+}
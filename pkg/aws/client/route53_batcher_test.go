@@ -0,0 +1,115 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+var _ = Describe("Route53ChangeBatcher", func() {
+	var (
+		ctx = context.Background()
+
+		mutex    sync.Mutex
+		requests [][]route53types.Change
+		failing  map[string]bool
+		send     Route53ChangeSender
+		batcher  *Route53ChangeBatcher
+	)
+
+	change := func(name string) route53types.Change {
+		return route53types.Change{
+			Action:            route53types.ChangeActionUpsert,
+			ResourceRecordSet: &route53types.ResourceRecordSet{Name: aws.String(name)},
+		}
+	}
+
+	submitConcurrently := func(zoneId string, names ...string) []error {
+		errs := make([]error, len(names))
+		var wg sync.WaitGroup
+		for i, name := range names {
+			wg.Add(1)
+			go func(i int, name string) {
+				defer GinkgoRecover()
+				defer wg.Done()
+				errs[i] = batcher.Submit(ctx, zoneId, []route53types.Change{change(name)}, send)
+			}(i, name)
+		}
+		wg.Wait()
+		return errs
+	}
+
+	BeforeEach(func() {
+		requests = nil
+		failing = map[string]bool{}
+		send = func(_ context.Context, _ string, changes []route53types.Change) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+			requests = append(requests, changes)
+			for _, c := range changes {
+				if failing[*c.ResourceRecordSet.Name] {
+					return errors.New("invalid change")
+				}
+			}
+			return nil
+		}
+		batcher = NewRoute53ChangeBatcher(100 * time.Millisecond)
+	})
+
+	It("should combine concurrent changes of the same hosted zone into one request", func() {
+		Expect(submitConcurrently("zone", "a", "b", "c")).To(HaveEach(BeNil()))
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0]).To(HaveLen(3))
+	})
+
+	It("should send changes of different hosted zones in separate requests", func() {
+		var wg sync.WaitGroup
+		for _, zoneId := range []string{"zone1", "zone2"} {
+			wg.Add(1)
+			go func(zoneId string) {
+				defer GinkgoRecover()
+				defer wg.Done()
+				Expect(batcher.Submit(ctx, zoneId, []route53types.Change{change("a")}, send)).To(Succeed())
+			}(zoneId)
+		}
+		wg.Wait()
+		Expect(requests).To(HaveLen(2))
+	})
+
+	It("should send the changes of each request on their own if the batch fails", func() {
+		failing["b"] = true
+		errs := submitConcurrently("zone", "a", "b", "c")
+		Expect(errs[0]).NotTo(HaveOccurred())
+		Expect(errs[1]).To(MatchError("invalid change"))
+		Expect(errs[2]).NotTo(HaveOccurred())
+		Expect(requests).To(HaveLen(4))
+	})
+
+	It("should return the error of a single request", func() {
+		failing["a"] = true
+		Expect(batcher.Submit(ctx, "zone", []route53types.Change{change("a")}, send)).To(MatchError("invalid change"))
+		Expect(requests).To(HaveLen(1))
+	})
+})
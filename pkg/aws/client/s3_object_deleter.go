@@ -0,0 +1,106 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"sync"
+
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+const (
+	// DefaultS3DeleteConcurrency is the default number of concurrent DeleteObjects requests when purging objects of
+	// a bucket.
+	DefaultS3DeleteConcurrency = 10
+)
+
+// S3ObjectBatchDeleteFunc deletes the given batch of objects.
+type S3ObjectBatchDeleteFunc func(ctx context.Context, objectIDs []s3types.ObjectIdentifier) error
+
+// S3ObjectDeleter deletes batches of objects concurrently with a bounded number of in-flight requests. Once a batch
+// fails, the context of all other batches is cancelled and no further batches are deleted.
+type S3ObjectDeleter struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	semaphore chan struct{}
+	delete    S3ObjectBatchDeleteFunc
+
+	wg    sync.WaitGroup
+	mutex sync.Mutex
+	err   error
+}
+
+// NewS3ObjectDeleter creates a new S3ObjectDeleter which deletes at most <concurrency> batches at the same time.
+func NewS3ObjectDeleter(ctx context.Context, concurrency int, delete S3ObjectBatchDeleteFunc) *S3ObjectDeleter {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &S3ObjectDeleter{
+		ctx:       ctx,
+		cancel:    cancel,
+		semaphore: make(chan struct{}, concurrency),
+		delete:    delete,
+	}
+}
+
+// Delete deletes the given batch of objects in the background. It blocks while the maximum number of batches is
+// in flight. It returns false if the deletion has been aborted because of an error or a cancelled context.
+func (d *S3ObjectDeleter) Delete(objectIDs []s3types.ObjectIdentifier) bool {
+	if len(objectIDs) == 0 {
+		return d.ctx.Err() == nil
+	}
+
+	select {
+	case <-d.ctx.Done():
+		return false
+	case d.semaphore <- struct{}{}:
+	}
+	// The context might have been cancelled while waiting for a free slot.
+	if d.ctx.Err() != nil {
+		<-d.semaphore
+		return false
+	}
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		defer func() { <-d.semaphore }()
+
+		if err := d.delete(d.ctx, objectIDs); err != nil {
+			d.mutex.Lock()
+			if d.err == nil {
+				d.err = err
+			}
+			d.mutex.Unlock()
+			d.cancel()
+		}
+	}()
+	return true
+}
+
+// Wait waits for all batches to be deleted and returns the first error which occurred, if any.
+func (d *S3ObjectDeleter) Wait() error {
+	d.wg.Wait()
+	defer d.cancel()
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if d.err != nil {
+		return d.err
+	}
+	return d.ctx.Err()
+}
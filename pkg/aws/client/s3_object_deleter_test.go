@@ -0,0 +1,113 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+var _ = Describe("S3ObjectDeleter", func() {
+	var (
+		ctx = context.Background()
+
+		mutex       sync.Mutex
+		deleted     []string
+		inFlight    int
+		maxInFlight int
+	)
+
+	batch := func(keys ...string) []s3types.ObjectIdentifier {
+		var objectIDs []s3types.ObjectIdentifier
+		for _, key := range keys {
+			objectIDs = append(objectIDs, s3types.ObjectIdentifier{Key: aws.String(key)})
+		}
+		return objectIDs
+	}
+
+	deleteFunc := func(failingKey string) S3ObjectBatchDeleteFunc {
+		return func(ctx context.Context, objectIDs []s3types.ObjectIdentifier) error {
+			mutex.Lock()
+			inFlight++
+			if inFlight > maxInFlight {
+				maxInFlight = inFlight
+			}
+			mutex.Unlock()
+			defer func() {
+				mutex.Lock()
+				inFlight--
+				mutex.Unlock()
+			}()
+
+			time.Sleep(10 * time.Millisecond)
+			mutex.Lock()
+			defer mutex.Unlock()
+			for _, objectID := range objectIDs {
+				if aws.ToString(objectID.Key) == failingKey {
+					return fmt.Errorf("could not delete %s", failingKey)
+				}
+				deleted = append(deleted, aws.ToString(objectID.Key))
+			}
+			return nil
+		}
+	}
+
+	BeforeEach(func() {
+		deleted, inFlight, maxInFlight = nil, 0, 0
+	})
+
+	It("should delete all batches with bounded concurrency", func() {
+		deleter := NewS3ObjectDeleter(ctx, 3, deleteFunc(""))
+		for i := 0; i < 10; i++ {
+			Expect(deleter.Delete(batch(fmt.Sprintf("a%d", i), fmt.Sprintf("b%d", i)))).To(BeTrue())
+		}
+		Expect(deleter.Delete(nil)).To(BeTrue())
+
+		Expect(deleter.Wait()).To(Succeed())
+		Expect(deleted).To(HaveLen(20))
+		Expect(maxInFlight).To(BeNumerically("<=", 3))
+		Expect(maxInFlight).To(BeNumerically(">", 1))
+	})
+
+	It("should stop deleting and return the error of a failed batch", func() {
+		deleter := NewS3ObjectDeleter(ctx, 1, deleteFunc("a1"))
+		Expect(deleter.Delete(batch("a0"))).To(BeTrue())
+		Expect(deleter.Delete(batch("a1"))).To(BeTrue())
+		Eventually(func() bool { return deleter.Delete(batch("a2")) }).Should(BeFalse())
+
+		Expect(deleter.Wait()).To(MatchError("could not delete a1"))
+		Expect(deleted).NotTo(ContainElement("a1"))
+	})
+
+	It("should return the error of a cancelled context", func() {
+		ctx, cancel := context.WithCancel(ctx)
+		deleter := NewS3ObjectDeleter(ctx, 1, deleteFunc(""))
+		cancel()
+
+		Expect(deleter.Delete(batch("a0"))).To(BeFalse())
+		Expect(errors.Is(deleter.Wait(), context.Canceled)).To(BeTrue())
+		Expect(deleted).To(BeEmpty())
+	})
+})
@@ -17,58 +17,58 @@ package client
 import (
 	"fmt"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 )
 
 // Tags is map of string key to string values. Duplicate keys are not supported in AWS.
 type Tags map[string]string
 
 // FromTags creates a Tags map from the given EC2 tag array.
-func FromTags(ec2Tags []*ec2.Tag) Tags {
+func FromTags(ec2Tags []ec2types.Tag) Tags {
 	tags := Tags{}
 	for _, et := range ec2Tags {
-		tags[aws.StringValue(et.Key)] = aws.StringValue(et.Value)
+		tags[aws.ToString(et.Key)] = aws.ToString(et.Value)
 	}
 	return tags
 }
 
 // ToTagSpecification exports the tags map as a EC2 TagSpecification for the given resource type.
-func (tags Tags) ToTagSpecification(resourceType string) *ec2.TagSpecification {
-	tagspec := &ec2.TagSpecification{
-		ResourceType: aws.String(resourceType),
+func (tags Tags) ToTagSpecification(resourceType ec2types.ResourceType) *ec2types.TagSpecification {
+	tagspec := &ec2types.TagSpecification{
+		ResourceType: resourceType,
 	}
 	for k, v := range tags {
-		tagspec.Tags = append(tagspec.Tags, &ec2.Tag{Key: aws.String(k), Value: aws.String(v)})
+		tagspec.Tags = append(tagspec.Tags, ec2types.Tag{Key: aws.String(k), Value: aws.String(v)})
 	}
 	return tagspec
 }
 
 // ToTagSpecifications exports the tags map as a EC2 TagSpecification array for the given resource type.
-func (tags Tags) ToTagSpecifications(resourceType string) []*ec2.TagSpecification {
+func (tags Tags) ToTagSpecifications(resourceType ec2types.ResourceType) []ec2types.TagSpecification {
 	if tags == nil {
 		return nil
 	}
-	return []*ec2.TagSpecification{tags.ToTagSpecification(resourceType)}
+	return []ec2types.TagSpecification{*tags.ToTagSpecification(resourceType)}
 }
 
 // ToEC2Tags exports the tags map as a EC2 Tag array.
-func (tags Tags) ToEC2Tags() []*ec2.Tag {
-	var cp []*ec2.Tag
+func (tags Tags) ToEC2Tags() []ec2types.Tag {
+	var cp []ec2types.Tag
 	for k, v := range tags {
-		cp = append(cp, &ec2.Tag{Key: aws.String(k), Value: aws.String(v)})
+		cp = append(cp, ec2types.Tag{Key: aws.String(k), Value: aws.String(v)})
 	}
 	return cp
 }
 
 // ToFilters exports the tags map as a EC2 Filter array.
-func (tags Tags) ToFilters() []*ec2.Filter {
+func (tags Tags) ToFilters() []ec2types.Filter {
 	if tags == nil {
 		return nil
 	}
-	var filters []*ec2.Filter
+	var filters []ec2types.Filter
 	for k, v := range tags {
-		filters = append(filters, &ec2.Filter{Name: aws.String(fmt.Sprintf("tag:%s", k)), Values: []*string{aws.String(v)}})
+		filters = append(filters, ec2types.Filter{Name: aws.String(fmt.Sprintf("tag:%s", k)), Values: []string{v}})
 	}
 	return filters
 }
@@ -17,7 +17,9 @@ package client
 import (
 	"context"
 	"sort"
+	"time"
 
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
@@ -30,6 +32,18 @@ const (
 	//
 	// The specified bucket us exist.
 	errCodeBucketNotEmpty = "BucketNotEmpty"
+	// errCodeNoSuchBucket for service response error code "NoSuchBucket".
+	errCodeNoSuchBucket = "NoSuchBucket"
+	// errCodeObjectLockConfigurationNotFound for service response error code
+	// "ObjectLockConfigurationNotFoundError".
+	//
+	// Object Lock is not enabled for the bucket.
+	errCodeObjectLockConfigurationNotFound = "ObjectLockConfigurationNotFoundError"
+	// errCodeReplicationConfigurationNotFound for service response error code
+	// "ReplicationConfigurationNotFoundError".
+	//
+	// Replication is not configured for the bucket.
+	errCodeReplicationConfigurationNotFound = "ReplicationConfigurationNotFoundError"
 )
 
 // IPStack is an enumeration of IP stacks
@@ -47,21 +61,57 @@ const (
 // Interface is an interface which must be implemented by AWS clients.
 type Interface interface {
 	GetAccountID(ctx context.Context) (string, error)
+	GetCallerARN(ctx context.Context) (string, error)
 	GetVPCInternetGateway(ctx context.Context, vpcID string) (string, error)
 	GetVPCAttribute(ctx context.Context, vpcID string, attribute string) (bool, error)
 	GetDHCPOptions(ctx context.Context, vpcID string) (map[string]string, error)
 	GetElasticIPsAssociationIDForAllocationIDs(ctx context.Context, allocationIDs []string) (map[string]*string, error)
 	GetNATGatewayAddressAllocations(ctx context.Context, shootNamespace string) (sets.Set[string], error)
+	GetAvailabilityZones(ctx context.Context) ([]string, error)
+	GetAvailabilityZoneIDs(ctx context.Context) (map[string]string, error)
+	GetAvailabilityZoneTypes(ctx context.Context) (map[string]string, error)
+	GetOfferedInstanceTypes(ctx context.Context, zone string) (sets.Set[string], error)
+	GetInstanceTypeVCPUs(ctx context.Context, instanceTypes []string) (map[string]int32, error)
+	GetInstanceTypeArchitectures(ctx context.Context, instanceType string) ([]string, error)
+	GetInstanceTypeInfo(ctx context.Context, instanceType string) (*InstanceTypeInfo, error)
+	GetImageArchitecture(ctx context.Context, imageID string) (string, error)
+	FindNewestImage(ctx context.Context, owners []string, name *string, tags map[string]string, architecture string) (string, error)
+
+	// SSM wrappers
+	GetSSMParameter(ctx context.Context, name string) (string, error)
+	GetVpcCount(ctx context.Context) (int, error)
+
+	// Service Quotas wrappers
+	GetServiceQuota(ctx context.Context, serviceCode, quotaCode string) (*float64, error)
+
+	// Outposts wrappers
+	GetOutpost(ctx context.Context, outpostARN string) (*Outpost, error)
+	GetOutpostInstanceTypes(ctx context.Context, outpostARN string) (sets.Set[string], error)
 
 	// S3 wrappers
 	DeleteObjectsWithPrefix(ctx context.Context, bucket, prefix string) error
-	CreateBucketIfNotExists(ctx context.Context, bucket, region string) error
+	PutObject(ctx context.Context, bucket, key, contentType string, body []byte) error
+	DeleteObject(ctx context.Context, bucket, key string) error
+	CreateBucketIfNotExists(ctx context.Context, bucket, region string, config *BucketConfiguration) error
+	GetBucketObjectLockConfiguration(ctx context.Context, bucket string) (*ObjectLockConfiguration, error)
+	GetBucketReplication(ctx context.Context, bucket string) (*BucketReplication, error)
+	PutBucketReplication(ctx context.Context, bucket string, replication *BucketReplication) error
+	DeleteBucketReplication(ctx context.Context, bucket string) error
 	DeleteBucketIfExists(ctx context.Context, bucket string) error
+	PutBucketPolicy(ctx context.Context, bucket, policy string) error
+	PutBucketAccessLogging(ctx context.Context, bucket string, accessLogging *BucketAccessLogging) error
 
 	// Route53 wrappers
 	GetDNSHostedZones(ctx context.Context) (map[string]string, error)
-	CreateOrUpdateDNSRecordSet(ctx context.Context, zoneId, name, recordType string, values []string, ttl int64, stack IPStack) error
-	DeleteDNSRecordSet(ctx context.Context, zoneId, name, recordType string, values []string, ttl int64, stack IPStack) error
+	GetPrivateDNSHostedZones(ctx context.Context) (map[string]string, error)
+	GetDNSHostedZoneVPCs(ctx context.Context, zoneId string) ([]HostedZoneVPC, error)
+	AssociateVPCWithDNSHostedZone(ctx context.Context, zoneId, vpcId, region string) error
+	CreateOrUpdateDNSRecordSet(ctx context.Context, zoneId, name, recordType string, values []string, ttl int64, stack IPStack, routingPolicy *RoutingPolicy) error
+	DeleteDNSRecordSet(ctx context.Context, zoneId, name, recordType string, values []string, ttl int64, stack IPStack, routingPolicy *RoutingPolicy) error
+	GetHealthCheck(ctx context.Context, id string) (*HealthCheck, error)
+	CreateHealthCheck(ctx context.Context, callerReference string, healthCheck *HealthCheck) (string, error)
+	UpdateHealthCheck(ctx context.Context, id string, healthCheck *HealthCheck) error
+	DeleteHealthCheck(ctx context.Context, id string) error
 
 	// The following functions are only temporary needed due to https://github.com/gardener/gardener/issues/129.
 	ListKubernetesELBs(ctx context.Context, vpcID, clusterName string) ([]string, error)
@@ -70,17 +120,24 @@ type Interface interface {
 	DeleteELB(ctx context.Context, name string) error
 	DeleteELBV2(ctx context.Context, arn string) error
 
+	// Load balancers
+	FindLoadBalancerARNByDNSName(ctx context.Context, dnsName string) (string, error)
+	GetLoadBalancerTargetHealth(ctx context.Context, loadBalancerARN string) ([]*TargetHealth, error)
+
 	// VPCs
 	CreateVpcDhcpOptions(ctx context.Context, options *DhcpOptions) (*DhcpOptions, error)
 	GetVpcDhcpOptions(ctx context.Context, id string) (*DhcpOptions, error)
 	FindVpcDhcpOptionsByTags(ctx context.Context, tags Tags) ([]*DhcpOptions, error)
 	DeleteVpcDhcpOptions(ctx context.Context, id string) error
+	FindVpcIDsByDhcpOptions(ctx context.Context, dhcpOptionsId string) ([]string, error)
 	CreateVpc(ctx context.Context, vpc *VPC) (*VPC, error)
 	GetIPv6Cidr(ctx context.Context, vpcID string) (string, error)
 	WaitForIPv6Cidr(ctx context.Context, vpcID string) (string, error)
-	AddVpcDhcpOptionAssociation(vpcId string, dhcpOptionsId *string) error
+	AddVpcDhcpOptionAssociation(ctx context.Context, vpcId string, dhcpOptionsId *string) error
 	UpdateVpcAttribute(ctx context.Context, vpcId, attributeName string, value bool) error
 	UpdateAmazonProvidedIPv6CidrBlock(ctx context.Context, desired *VPC, current *VPC) (bool, error)
+	AssociateVpcCidrBlock(ctx context.Context, vpcId, cidrBlock string) (*VpcCidrBlockAssociation, error)
+	DisassociateVpcCidrBlock(ctx context.Context, associationId string) error
 	DeleteVpc(ctx context.Context, id string) error
 	GetVpc(ctx context.Context, id string) (*VPC, error)
 	FindVpcsByTags(ctx context.Context, tags Tags) ([]*VPC, error)
@@ -103,11 +160,43 @@ type Interface interface {
 	AttachInternetGateway(ctx context.Context, vpcId, internetGatewayId string) error
 	DetachInternetGateway(ctx context.Context, vpcId, internetGatewayId string) error
 
+	// Egress only internet gateways
+	CreateEgressOnlyInternetGateway(ctx context.Context, gateway *EgressOnlyInternetGateway) (*EgressOnlyInternetGateway, error)
+	GetEgressOnlyInternetGateway(ctx context.Context, id string) (*EgressOnlyInternetGateway, error)
+	FindEgressOnlyInternetGatewaysByTags(ctx context.Context, tags Tags) ([]*EgressOnlyInternetGateway, error)
+	DeleteEgressOnlyInternetGateway(ctx context.Context, id string) error
+
+	// Carrier gateways
+	CreateCarrierGateway(ctx context.Context, gateway *CarrierGateway) (*CarrierGateway, error)
+	GetCarrierGateway(ctx context.Context, id string) (*CarrierGateway, error)
+	FindCarrierGatewaysByTags(ctx context.Context, tags Tags) ([]*CarrierGateway, error)
+	DeleteCarrierGateway(ctx context.Context, id string) error
+
 	// VPC Endpoints
 	CreateVpcEndpoint(ctx context.Context, endpoint *VpcEndpoint) (*VpcEndpoint, error)
 	GetVpcEndpoints(ctx context.Context, ids []string) ([]*VpcEndpoint, error)
 	FindVpcEndpointsByTags(ctx context.Context, tags Tags) ([]*VpcEndpoint, error)
+	ModifyVpcEndpointSubnets(ctx context.Context, id string, addSubnetIds, removeSubnetIds []string) error
 	DeleteVpcEndpoint(ctx context.Context, id string) error
+	GetPrefixListID(ctx context.Context, serviceName string) (*string, error)
+
+	// Managed prefix lists
+	CreateManagedPrefixList(ctx context.Context, list *ManagedPrefixList, cidrs []string) (*ManagedPrefixList, error)
+	GetManagedPrefixList(ctx context.Context, id string) (*ManagedPrefixList, error)
+	FindManagedPrefixListsByTags(ctx context.Context, tags Tags) ([]*ManagedPrefixList, error)
+	GetManagedPrefixListCIDRs(ctx context.Context, id string) ([]string, error)
+	ModifyManagedPrefixListCIDRs(ctx context.Context, id string, version int64, addCIDRs, removeCIDRs []string) error
+	ResizeManagedPrefixList(ctx context.Context, id string, maxEntries int32) error
+	WaitForManagedPrefixListModified(ctx context.Context, id string) error
+	DeleteManagedPrefixList(ctx context.Context, id string) error
+
+	// VPC Endpoint Services
+	CreateVpcEndpointService(ctx context.Context, service *VpcEndpointService) (*VpcEndpointService, error)
+	GetVpcEndpointService(ctx context.Context, id string) (*VpcEndpointService, error)
+	UpdateVpcEndpointService(ctx context.Context, desired, current *VpcEndpointService) error
+	GetVpcEndpointServicePrincipals(ctx context.Context, id string) ([]string, error)
+	UpdateVpcEndpointServicePrincipals(ctx context.Context, id string, addPrincipals, removePrincipals []string) error
+	DeleteVpcEndpointService(ctx context.Context, id string) error
 
 	// VPC Endpoints Route table associations
 	CreateVpcEndpointRouteTableAssociation(ctx context.Context, routeTableId, vpcEndpointId string) error
@@ -117,6 +206,7 @@ type Interface interface {
 	CreateRouteTable(ctx context.Context, routeTable *RouteTable) (*RouteTable, error)
 	GetRouteTable(ctx context.Context, id string) (*RouteTable, error)
 	FindRouteTablesByTags(ctx context.Context, tags Tags) ([]*RouteTable, error)
+	FindBlackholeRoutesByTags(ctx context.Context, tags Tags) (map[string][]*Route, error)
 	DeleteRouteTable(ctx context.Context, id string) error
 	CreateRoute(ctx context.Context, routeTableId string, route *Route) error
 	DeleteRoute(ctx context.Context, routeTableId string, route *Route) error
@@ -125,6 +215,7 @@ type Interface interface {
 	CreateSubnet(ctx context.Context, subnet *Subnet) (*Subnet, error)
 	GetSubnets(ctx context.Context, ids []string) ([]*Subnet, error)
 	FindSubnetsByTags(ctx context.Context, tags Tags) ([]*Subnet, error)
+	FindSubnetsByVpcId(ctx context.Context, vpcId string) ([]*Subnet, error)
 	UpdateSubnetAttributes(ctx context.Context, desired, current *Subnet) (modified bool, err error)
 	DeleteSubnet(ctx context.Context, id string) error
 
@@ -132,6 +223,18 @@ type Interface interface {
 	CreateRouteTableAssociation(ctx context.Context, routeTableId, subnetId string) (associationId *string, err error)
 	DeleteRouteTableAssociation(ctx context.Context, associationId string) error
 
+	// Network ACLs
+	CreateNetworkAcl(ctx context.Context, acl *NetworkAcl) (*NetworkAcl, error)
+	GetNetworkAcl(ctx context.Context, id string) (*NetworkAcl, error)
+	FindNetworkAclsByTags(ctx context.Context, tags Tags) ([]*NetworkAcl, error)
+	FindDefaultNetworkAclByVpcId(ctx context.Context, vpcId string) (*NetworkAcl, error)
+	FindNetworkAclAssociationBySubnetId(ctx context.Context, subnetId string) (*NetworkAclAssociation, error)
+	ReplaceNetworkAclAssociation(ctx context.Context, associationId, networkAclId string) error
+	CreateNetworkAclEntry(ctx context.Context, networkAclId string, entry *NetworkAclEntry) error
+	ReplaceNetworkAclEntry(ctx context.Context, networkAclId string, entry *NetworkAclEntry) error
+	DeleteNetworkAclEntry(ctx context.Context, networkAclId string, entry *NetworkAclEntry) error
+	DeleteNetworkAcl(ctx context.Context, id string) error
+
 	// Elastic IP
 	CreateElasticIP(ctx context.Context, eip *ElasticIP) (*ElasticIP, error)
 	GetElasticIP(ctx context.Context, id string) (*ElasticIP, error)
@@ -145,12 +248,79 @@ type Interface interface {
 	FindNATGatewaysByTags(ctx context.Context, tags Tags) ([]*NATGateway, error)
 	DeleteNATGateway(ctx context.Context, id string) error
 
+	// NAT instances
+	CreateLaunchTemplate(ctx context.Context, template *LaunchTemplate) (*LaunchTemplate, error)
+	GetLaunchTemplate(ctx context.Context, name string) (*LaunchTemplate, error)
+	CreateLaunchTemplateVersion(ctx context.Context, template *LaunchTemplate) (*LaunchTemplate, error)
+	DeleteLaunchTemplate(ctx context.Context, name string) error
+	CreateAutoScalingGroup(ctx context.Context, group *AutoScalingGroup) error
+	GetAutoScalingGroup(ctx context.Context, name string) (*AutoScalingGroup, error)
+	UpdateAutoScalingGroup(ctx context.Context, group *AutoScalingGroup) error
+	WaitForAutoScalingGroupInstanceInService(ctx context.Context, name, launchTemplateVersion string) (string, error)
+	TerminateAutoScalingGroupInstance(ctx context.Context, instanceId string) error
+	DeleteAutoScalingGroup(ctx context.Context, name string) error
+	GetInstance(ctx context.Context, id string) (*Instance, error)
+	FindInstancesByTags(ctx context.Context, tags Tags) ([]*Instance, error)
+	TerminateInstance(ctx context.Context, id string) error
+	DisableInstanceSourceDestCheck(ctx context.Context, id string) error
+
+	// EBS volumes
+	FindVolumesByTags(ctx context.Context, tags Tags) ([]*Volume, error)
+	DeleteVolume(ctx context.Context, id string) error
+
+	// VPC flow logs
+	CreateFlowLog(ctx context.Context, flowLog *FlowLog) (*FlowLog, error)
+	GetFlowLog(ctx context.Context, id string) (*FlowLog, error)
+	FindFlowLogsByTags(ctx context.Context, tags Tags) ([]*FlowLog, error)
+	DeleteFlowLog(ctx context.Context, id string) error
+
+	// CloudWatch log groups
+	CreateLogGroup(ctx context.Context, group *LogGroup) (*LogGroup, error)
+	GetLogGroup(ctx context.Context, name string) (*LogGroup, error)
+	UpdateLogGroupKmsKey(ctx context.Context, name string, kmsKeyId *string) error
+	UpdateLogGroupRetention(ctx context.Context, name string, retentionInDays *int32) error
+	DeleteLogGroup(ctx context.Context, name string) error
+
+	// Transit gateways
+	GetTransitGateway(ctx context.Context, id string) (*TransitGateway, error)
+	CreateTransitGatewayVpcAttachment(ctx context.Context, attachment *TransitGatewayVpcAttachment) (*TransitGatewayVpcAttachment, error)
+	WaitForTransitGatewayVpcAttachmentAvailable(ctx context.Context, id string) error
+	GetTransitGatewayVpcAttachment(ctx context.Context, id string) (*TransitGatewayVpcAttachment, error)
+	FindTransitGatewayVpcAttachmentsByTags(ctx context.Context, tags Tags) ([]*TransitGatewayVpcAttachment, error)
+	ModifyTransitGatewayVpcAttachmentSubnets(ctx context.Context, id string, addSubnetIds, removeSubnetIds []string) error
+	DeleteTransitGatewayVpcAttachment(ctx context.Context, id string) error
+
+	// VPC peering connections
+	CreateVpcPeeringConnection(ctx context.Context, peering *VpcPeeringConnection) (*VpcPeeringConnection, error)
+	AcceptVpcPeeringConnection(ctx context.Context, id, region string) error
+	WaitForVpcPeeringConnectionState(ctx context.Context, id string, states ...string) (*VpcPeeringConnection, error)
+	GetVpcPeeringConnection(ctx context.Context, id string) (*VpcPeeringConnection, error)
+	FindVpcPeeringConnectionsByTags(ctx context.Context, tags Tags) ([]*VpcPeeringConnection, error)
+	DeleteVpcPeeringConnection(ctx context.Context, id string) error
+
 	// Key pairs
 	ImportKeyPair(ctx context.Context, keyName string, publicKey []byte, tags Tags) (*KeyPairInfo, error)
 	GetKeyPair(ctx context.Context, keyName string) (*KeyPairInfo, error)
 	FindKeyPairsByTags(ctx context.Context, tags Tags) ([]*KeyPairInfo, error)
 	DeleteKeyPair(ctx context.Context, keyName string) error
 
+	// Placement groups
+	CreatePlacementGroup(ctx context.Context, group *PlacementGroup) (*PlacementGroup, error)
+	GetPlacementGroup(ctx context.Context, name string) (*PlacementGroup, error)
+	FindPlacementGroupsByTags(ctx context.Context, tags Tags) ([]*PlacementGroup, error)
+	DeletePlacementGroup(ctx context.Context, name string) error
+
+	// Serial console
+	GetSerialConsoleAccessStatus(ctx context.Context) (bool, error)
+	EnableSerialConsoleAccess(ctx context.Context) error
+
+	// KMS keys
+	GetKMSKey(ctx context.Context, keyID string) (*KMSKey, error)
+
+	// Image copies
+	FindImageCopy(ctx context.Context, sourceRegion, sourceImageID string) (*Image, error)
+	CopyImage(ctx context.Context, sourceRegion, sourceImageID, name string, kmsKeyID *string) (string, error)
+
 	// IAM Role
 	CreateIAMRole(ctx context.Context, role *IAMRole) (*IAMRole, error)
 	GetIAMRole(ctx context.Context, roleName string) (*IAMRole, error)
@@ -168,6 +338,56 @@ type Interface interface {
 	PutIAMRolePolicy(ctx context.Context, policy *IAMRolePolicy) error
 	GetIAMRolePolicy(ctx context.Context, policyName, roleName string) (*IAMRolePolicy, error)
 	DeleteIAMRolePolicy(ctx context.Context, policyName, roleName string) error
+	GetIAMDeniedActions(ctx context.Context, principalARN string, actions []string) ([]string, error)
+
+	// IAM OpenID Connect providers
+	CreateOpenIDConnectProvider(ctx context.Context, provider *OpenIDConnectProvider) (*OpenIDConnectProvider, error)
+	FindOpenIDConnectProviderByURL(ctx context.Context, url string) (*OpenIDConnectProvider, error)
+	GetOpenIDConnectProvider(ctx context.Context, arn string) (*OpenIDConnectProvider, error)
+	AddClientIDToOpenIDConnectProvider(ctx context.Context, arn, clientID string) error
+	DeleteOpenIDConnectProvider(ctx context.Context, arn string) error
+
+	// SQS queues
+	GetQueue(ctx context.Context, name string) (*Queue, error)
+	CreateQueue(ctx context.Context, queue *Queue) (*Queue, error)
+	UpdateQueueAttributes(ctx context.Context, queueURL string, attributes map[string]string) error
+	DeleteQueue(ctx context.Context, queueURL string) error
+
+	// EventBridge rules
+	PutEventRule(ctx context.Context, rule *EventRule) (*EventRule, error)
+	DeleteEventRule(ctx context.Context, name string) error
+
+	// Route 53 Resolver
+	CreateResolverEndpoint(ctx context.Context, endpoint *ResolverEndpoint) (*ResolverEndpoint, error)
+	GetResolverEndpoint(ctx context.Context, id string) (*ResolverEndpoint, error)
+	FindResolverEndpointsByName(ctx context.Context, name string) ([]*ResolverEndpoint, error)
+	GetResolverEndpointIpAddresses(ctx context.Context, id string) ([]*ResolverEndpointIpAddress, error)
+	AssociateResolverEndpointIpAddress(ctx context.Context, id, subnetId string) error
+	DeleteResolverEndpoint(ctx context.Context, id string) error
+	CreateResolverRule(ctx context.Context, rule *ResolverRule) (*ResolverRule, error)
+	FindResolverRulesByEndpointId(ctx context.Context, endpointId string) ([]*ResolverRule, error)
+	UpdateResolverRuleTargetIps(ctx context.Context, id string, targetIps []string) error
+	DeleteResolverRule(ctx context.Context, id string) error
+	GetResolverRuleAssociations(ctx context.Context, vpcId string) ([]*ResolverRuleAssociation, error)
+	AssociateResolverRule(ctx context.Context, ruleId, vpcId string) error
+	DisassociateResolverRule(ctx context.Context, ruleId, vpcId string) error
+
+	// Resource Groups Tagging
+	FindTaggedResourcesByTags(ctx context.Context, tags Tags) ([]*TaggedResource, error)
+	TagResources(ctx context.Context, arns []string, tags Tags) error
+
+	// EFS file systems
+	CreateElasticFileSystem(ctx context.Context, fs *ElasticFileSystem) (*ElasticFileSystem, error)
+	GetElasticFileSystem(ctx context.Context, id string) (*ElasticFileSystem, error)
+	FindElasticFileSystemsByTags(ctx context.Context, tags Tags) ([]*ElasticFileSystem, error)
+	DeleteElasticFileSystem(ctx context.Context, id string) error
+	GetMountTargets(ctx context.Context, fileSystemID string) ([]*MountTarget, error)
+	CreateMountTarget(ctx context.Context, mountTarget *MountTarget) (*MountTarget, error)
+	DeleteMountTarget(ctx context.Context, id string) error
+
+	// IAM Access Keys
+	CreateAccessKey(ctx context.Context) (*AccessKey, error)
+	DeleteAccessKey(ctx context.Context, accessKeyID string) error
 
 	// EC2 tags
 	CreateEC2Tags(ctx context.Context, resources []string, tags Tags) error
@@ -176,16 +396,86 @@ type Interface interface {
 
 // Factory creates instances of Interface.
 type Factory interface {
-	// NewClient creates a new instance of Interface for the given AWS credentials and region.
-	NewClient(accessKeyID, secretAccessKey, region string) (Interface, error)
+	// NewClient creates a new instance of Interface for the given authentication configuration.
+	NewClient(authConfig AuthConfig) (Interface, error)
 }
 
 // FactoryFunc is a function that implements Factory.
-type FactoryFunc func(accessKeyID, secretAccessKey, region string) (Interface, error)
+type FactoryFunc func(authConfig AuthConfig) (Interface, error)
+
+// NewClient creates a new instance of Interface for the given authentication configuration.
+func (f FactoryFunc) NewClient(authConfig AuthConfig) (Interface, error) {
+	return f(authConfig)
+}
+
+// NewControllerFactory creates a new Factory which creates clients with the given factory for the given controller,
+// i.e. the metrics of the requests of the clients are labeled with the name of the controller.
+func NewControllerFactory(factory Factory, controller string) Factory {
+	return FactoryFunc(func(authConfig AuthConfig) (Interface, error) {
+		authConfig.Controller = controller
+		return factory.NewClient(authConfig)
+	})
+}
+
+// AuthConfig contains the configuration for authenticating with AWS.
+type AuthConfig struct {
+	// AccessKeyID is the ID of the access key.
+	AccessKeyID string
+	// SecretAccessKey is the secret of the access key.
+	SecretAccessKey string
+	// AssumeRole contains the role which is assumed with the access key. If not set, the access key is used directly.
+	AssumeRole *AssumeRole
+	// WebIdentity contains the role which is assumed with a web identity token. If set, the access key is not used.
+	WebIdentity *WebIdentity
+	// Region is the AWS region.
+	Region string
+	// Partition is the AWS partition, e.g. `aws-cn`. If not set, it is derived from the region.
+	Partition string
+	// Endpoints maps service identifiers (see Services) to custom endpoint URLs which are used instead of the default
+	// endpoints of the region.
+	Endpoints map[string]string
+	// HTTP contains the configuration of the HTTP client used for all requests to the AWS API, e.g. a proxy. If not
+	// set, the proxy is taken from the environment and only the system CAs are trusted.
+	HTTP *HTTPConfig
+	// RateLimiter contains the configuration of the client-side rate limiting and the adaptive retrying of the requests
+	// to the AWS API. If not set, requests are not rate limited and retried with the defaults of the AWS SDK.
+	RateLimiter *RateLimiterConfig
+	// Controller is the name of the controller using the client. It is used as label of the metrics of the requests to
+	// the AWS API.
+	Controller string
+	// Shoot is the technical ID of the shoot the client acts for, if any. Together with the controller, it is part of
+	// the session name of assumed roles and of the user agent of the requests, so that CloudTrail events can be
+	// attributed to the shoot.
+	Shoot string
+}
+
+// HTTPConfig contains the configuration of the HTTP client used for the requests to the AWS API.
+type HTTPConfig struct {
+	// ProxyURL is the URL of the proxy used for all requests, including the credentials for the basic authentication
+	// at the proxy, if any. If empty, the proxy is taken from the environment.
+	ProxyURL string
+	// NoProxy is a list of hosts, domains (with leading dot) and CIDRs which are reached without the proxy.
+	NoProxy []string
+	// CABundle contains PEM encoded CA certificates which are trusted in addition to the system CAs, e.g. for a proxy
+	// intercepting TLS connections.
+	CABundle []byte
+}
+
+// AssumeRole contains the configuration for assuming an IAM role with STS.
+type AssumeRole struct {
+	// RoleARN is the ARN of the role.
+	RoleARN string
+	// ExternalID is the external ID which is required by the trust policy of the role, if any.
+	ExternalID string
+}
 
-// NewClient creates a new instance of Interface for the given AWS credentials and region.
-func (f FactoryFunc) NewClient(accessKeyID, secretAccessKey, region string) (Interface, error) {
-	return f(accessKeyID, secretAccessKey, region)
+// WebIdentity contains the configuration for assuming an IAM role with a web identity token.
+type WebIdentity struct {
+	// RoleARN is the ARN of the role.
+	RoleARN string
+	// Token is the web identity token, e.g. a service account token issued by an OpenID Connect provider trusted by
+	// the role.
+	Token string
 }
 
 // DhcpOptions contains the relevant fields of a EC2 DHCP options resource.
@@ -199,6 +489,7 @@ type DhcpOptions struct {
 type VPC struct {
 	Tags
 	VpcId                        string
+	OwnerId                      string
 	CidrBlock                    string
 	IPv6CidrBlock                string
 	EnableDnsSupport             bool
@@ -207,6 +498,15 @@ type VPC struct {
 	DhcpOptionsId                *string
 	InstanceTenancy              *string
 	State                        *string
+	// SecondaryCidrBlocks contains the associated IPv4 CIDR blocks besides the primary CIDR block.
+	// It is filled for returned values, but ignored on creation.
+	SecondaryCidrBlocks []*VpcCidrBlockAssociation
+}
+
+// VpcCidrBlockAssociation contains the relevant fields of an IPv4 CIDR block association of a VPC.
+type VpcCidrBlockAssociation struct {
+	AssociationId string
+	CidrBlock     string
 }
 
 // SecurityGroup contains the relevant fields of a EC2 security group resource.
@@ -311,20 +611,24 @@ type SecurityGroupRule struct {
 	Protocol   string
 	CidrBlocks []string
 	Self       bool
-	Foreign    *string
+	// SecurityGroupIds are the identifiers of other security groups the rule applies to.
+	SecurityGroupIds []string
+	Foreign          *string
 }
 
 // Clone creates a copy.
 func (sgr *SecurityGroupRule) Clone() *SecurityGroupRule {
 	cp := *sgr
 	cp.CidrBlocks = copySlice(sgr.CidrBlocks)
+	cp.SecurityGroupIds = copySlice(sgr.SecurityGroupIds)
 	return &cp
 }
 
-// SortedClone creates a copy with sorted CidrBlocks array for comparing and sorting.
+// SortedClone creates a copy with sorted CidrBlocks and SecurityGroupIds arrays for comparing and sorting.
 func (sgr *SecurityGroupRule) SortedClone() *SecurityGroupRule {
 	cp := sgr.Clone()
 	sort.Strings(cp.CidrBlocks)
+	sort.Strings(cp.SecurityGroupIds)
 	return cp
 }
 
@@ -385,9 +689,44 @@ func (sgr *SecurityGroupRule) LessThan(other *SecurityGroupRule) bool {
 			return false
 		}
 	}
+	if len(sgr.SecurityGroupIds) < len(other.SecurityGroupIds) {
+		return true
+	}
+	if len(sgr.SecurityGroupIds) > len(other.SecurityGroupIds) {
+		return false
+	}
+	for i := range sgr.SecurityGroupIds {
+		if sgr.SecurityGroupIds[i] < other.SecurityGroupIds[i] {
+			return true
+		}
+		if sgr.SecurityGroupIds[i] > other.SecurityGroupIds[i] {
+			return false
+		}
+	}
 	return false
 }
 
+// MergeSecurityGroupRules merges all rules with same type, protocol and port range into a single rule, as EC2 reports
+// them when describing a security group. Foreign rules are kept as they are.
+func MergeSecurityGroupRules(rules []*SecurityGroupRule) []*SecurityGroupRule {
+	var merged []*SecurityGroupRule
+outer:
+	for _, rule := range rules {
+		if rule.Foreign == nil {
+			for _, m := range merged {
+				if m.Foreign == nil && m.Type == rule.Type && m.Protocol == rule.Protocol && m.FromPort == rule.FromPort && m.ToPort == rule.ToPort {
+					m.CidrBlocks = sets.List(sets.New(m.CidrBlocks...).Insert(rule.CidrBlocks...))
+					m.SecurityGroupIds = sets.List(sets.New(m.SecurityGroupIds...).Insert(rule.SecurityGroupIds...))
+					m.Self = m.Self || rule.Self
+					continue outer
+				}
+			}
+		}
+		merged = append(merged, rule.Clone())
+	}
+	return merged
+}
+
 // InternetGateway contains the relevant fields for an EC2 internet gateway resource.
 type InternetGateway struct {
 	Tags
@@ -395,12 +734,71 @@ type InternetGateway struct {
 	VpcId             *string
 }
 
+// EgressOnlyInternetGateway contains the relevant fields for an EC2 egress only internet gateway resource.
+type EgressOnlyInternetGateway struct {
+	Tags
+	EgressOnlyInternetGatewayId string
+	VpcId                       *string
+}
+
+// CarrierGateway contains the relevant fields for an EC2 carrier gateway resource.
+type CarrierGateway struct {
+	Tags
+	CarrierGatewayId string
+	VpcId            *string
+}
+
 // VpcEndpoint contains the relevant fields for an EC2 VPC endpoint resource.
+// SubnetIds, SecurityGroupIds and PrivateDnsEnabled are only relevant for interface endpoints.
 type VpcEndpoint struct {
 	Tags
-	VpcEndpointId string
-	VpcId         *string
-	ServiceName   string
+	VpcEndpointId     string
+	VpcId             *string
+	ServiceName       string
+	VpcEndpointType   string
+	SubnetIds         []string
+	SecurityGroupIds  []string
+	PrivateDnsEnabled bool
+}
+
+// ManagedPrefixList contains the relevant fields of a customer-managed prefix list.
+type ManagedPrefixList struct {
+	Tags
+	PrefixListId   string
+	PrefixListName string
+	PrefixListArn  string
+	AddressFamily  string
+	MaxEntries     int32
+	Version        int64
+	State          string
+}
+
+// TargetHealth contains the relevant fields of the health of a target of a target group of a load balancer.
+type TargetHealth struct {
+	TargetGroupArn string
+	TargetId       string
+	// State is the health state of the target, e.g. `healthy`, `unhealthy` or `initial`.
+	State       string
+	Description string
+}
+
+// VpcEndpointService contains the relevant fields for an EC2 VPC endpoint service configuration (AWS PrivateLink).
+type VpcEndpointService struct {
+	Tags
+	ServiceId               string
+	ServiceName             string
+	ServiceState            string
+	NetworkLoadBalancerARNs []string
+	AcceptanceRequired      bool
+	BaseEndpointDnsNames    []string
+}
+
+// Outpost contains the relevant fields of an AWS Outpost.
+type Outpost struct {
+	OutpostArn       string
+	OutpostId        string
+	AvailabilityZone string
+	LifeCycleStatus  string
 }
 
 // RouteTable contains the relevant fields for an EC2 route table resource.
@@ -415,11 +813,16 @@ type RouteTable struct {
 
 // Route contains the relevant fields for a route of an EC2 route table resource.
 type Route struct {
-	DestinationCidrBlock     *string
-	DestinationIpv6CidrBlock *string
-	GatewayId                *string
-	NatGatewayId             *string
-	DestinationPrefixListId  *string
+	DestinationCidrBlock        *string
+	DestinationIpv6CidrBlock    *string
+	GatewayId                   *string
+	NatGatewayId                *string
+	EgressOnlyInternetGatewayId *string
+	TransitGatewayId            *string
+	VpcPeeringConnectionId      *string
+	CarrierGatewayId            *string
+	InstanceId                  *string
+	DestinationPrefixListId     *string
 }
 
 // RouteTableAssociation contains the relevant fields for a route association of an EC2 route table resource.
@@ -430,11 +833,48 @@ type RouteTableAssociation struct {
 	SubnetId                *string
 }
 
+// NetworkAcl contains the relevant fields of an EC2 network ACL resource.
+type NetworkAcl struct {
+	Tags
+	NetworkAclId string
+	VpcId        *string
+	IsDefault    bool
+	// Entries are the rules of the network ACL. The rules denying all traffic which are part of every network ACL are
+	// omitted.
+	Entries      []*NetworkAclEntry
+	Associations []*NetworkAclAssociation
+}
+
+// NetworkAclEntry contains the relevant fields of a rule of an EC2 network ACL.
+type NetworkAclEntry struct {
+	RuleNumber int32
+	Egress     bool
+	// Protocol is the IP protocol number, e.g. `6` for TCP, or `-1` for all protocols.
+	Protocol      string
+	RuleAction    string
+	CidrBlock     *string
+	Ipv6CidrBlock *string
+	// FromPort and ToPort are only relevant for TCP and UDP.
+	FromPort *int32
+	ToPort   *int32
+	// IcmpType and IcmpCode are only relevant for ICMP and ICMPv6.
+	IcmpType *int32
+	IcmpCode *int32
+}
+
+// NetworkAclAssociation contains the relevant fields of the association of a subnet with an EC2 network ACL.
+type NetworkAclAssociation struct {
+	NetworkAclAssociationId string
+	NetworkAclId            string
+	SubnetId                string
+}
+
 // Subnet contains the relevant fields for an EC2 subnet resource.
 type Subnet struct {
 	Tags
 	SubnetId         string
 	VpcId            *string
+	OwnerId          string
 	CidrBlock        string
 	AvailabilityZone string
 
@@ -461,9 +901,10 @@ func (s *Subnet) Clone() *Subnet {
 // ElasticIP contains the relevant fields for an EC2 elastic IP resource.
 type ElasticIP struct {
 	Tags
-	AllocationId string
-	PublicIp     string
-	Vpc          bool
+	AllocationId  string
+	PublicIp      string
+	Vpc           bool
+	AssociationId *string
 }
 
 // NATGateway contains the relevant fields for an EC2 NAT gateway resource.
@@ -476,6 +917,128 @@ type NATGateway struct {
 	State           string
 }
 
+// LaunchTemplate contains the relevant fields of the latest version of an EC2 launch template.
+type LaunchTemplate struct {
+	Tags
+	LaunchTemplateId    string
+	LaunchTemplateName  string
+	LatestVersionNumber int64
+	ImageId             string
+	InstanceType        string
+	SecurityGroupIds    []string
+	// IamInstanceProfileName is the name of the IAM instance profile of the instances, if any.
+	IamInstanceProfileName string
+	// UserData is the plain (not base64 encoded) user data.
+	UserData string
+}
+
+// AutoScalingGroup contains the relevant fields for an auto scaling group resource.
+type AutoScalingGroup struct {
+	Tags
+	AutoScalingGroupName  string
+	LaunchTemplateName    string
+	LaunchTemplateVersion string
+	MinSize               int64
+	MaxSize               int64
+	DesiredCapacity       int64
+	SubnetIds             []string
+	Instances             []*AutoScalingGroupInstance
+	Status                *string
+}
+
+// AutoScalingGroupInstance contains the relevant fields for an instance of an auto scaling group.
+type AutoScalingGroupInstance struct {
+	InstanceId            string
+	LifecycleState        string
+	HealthStatus          string
+	LaunchTemplateVersion string
+}
+
+// Instance contains the relevant fields for an EC2 instance resource.
+type Instance struct {
+	Tags
+	InstanceId      string
+	InstanceType    string
+	VCPUs           int32
+	State           string
+	PublicIpAddress *string
+	SourceDestCheck *bool
+	LaunchTime      *time.Time
+	// InstanceLifecycle is `spot` for spot instances and empty for on-demand instances.
+	InstanceLifecycle string
+}
+
+// InstanceTypeInfo contains the relevant fields of an EC2 instance type.
+type InstanceTypeInfo struct {
+	InstanceType              string
+	VCPUs                     int32
+	MemoryMiB                 int64
+	MaxNetworkInterfaces      int32
+	IPv4AddressesPerInterface int32
+	MaxNetworkCards           int32
+	MaxEFAInterfaces          int32
+}
+
+// Volume contains the relevant fields for an EBS volume resource.
+type Volume struct {
+	Tags
+	VolumeId   string
+	State      string
+	CreateTime *time.Time
+}
+
+// FlowLog contains the relevant fields for an EC2 flow log resource.
+type FlowLog struct {
+	Tags
+	FlowLogId                string
+	ResourceId               string
+	TrafficType              string
+	LogDestinationType       string
+	LogDestination           string
+	DeliverLogsPermissionArn *string
+	MaxAggregationInterval   int64
+}
+
+// LogGroup contains the relevant fields for a CloudWatch Logs log group resource.
+type LogGroup struct {
+	Tags
+	LogGroupName string
+	// Arn is the ARN of the log group without the trailing `:*`.
+	Arn             string
+	KmsKeyId        *string
+	RetentionInDays *int32
+}
+
+// TransitGateway contains the relevant fields for an EC2 transit gateway resource.
+type TransitGateway struct {
+	Tags
+	TransitGatewayId string
+	OwnerId          string
+	State            string
+}
+
+// TransitGatewayVpcAttachment contains the relevant fields for an EC2 transit gateway VPC attachment resource.
+type TransitGatewayVpcAttachment struct {
+	Tags
+	TransitGatewayAttachmentId string
+	TransitGatewayId           string
+	VpcId                      string
+	SubnetIds                  []string
+	Ipv6Support                bool
+	State                      string
+}
+
+// VpcPeeringConnection contains the relevant fields for an EC2 VPC peering connection resource.
+type VpcPeeringConnection struct {
+	Tags
+	VpcPeeringConnectionId string
+	VpcId                  string
+	PeerVpcId              string
+	PeerOwnerId            string
+	PeerRegion             string
+	State                  string
+}
+
 // KeyPairInfo contains the relevant fields for an EC2 key pair.
 type KeyPairInfo struct {
 	Tags
@@ -483,6 +1046,32 @@ type KeyPairInfo struct {
 	KeyFingerprint string
 }
 
+// PlacementGroup contains the relevant fields for an EC2 placement group resource.
+type PlacementGroup struct {
+	Tags
+	GroupId        string
+	GroupName      string
+	Strategy       string
+	PartitionCount *int64
+}
+
+// Image contains the relevant fields for an AMI.
+type Image struct {
+	ImageId     string
+	State       string
+	StateReason string
+}
+
+// KMSKey contains the relevant fields for a KMS key resource.
+type KMSKey struct {
+	KeyId    string
+	Arn      string
+	Region   string
+	KeyState string
+	KeyUsage string
+	KeySpec  string
+}
+
 // IAMRole contains the relevant fields for an IAM role resource.
 type IAMRole struct {
 	RoleId                   string
@@ -500,9 +1089,161 @@ type IAMInstanceProfile struct {
 	RoleName            string
 }
 
+// OpenIDConnectProvider contains the relevant fields for an IAM OpenID Connect provider resource.
+type OpenIDConnectProvider struct {
+	Tags
+	ARN         string
+	URL         string
+	ClientIDs   []string
+	Thumbprints []string
+}
+
+// Queue contains the relevant fields for an SQS queue.
+type Queue struct {
+	Tags
+	QueueName  string
+	QueueURL   string
+	QueueARN   string
+	Attributes map[string]string
+}
+
+// EventRule contains the relevant fields for an EventBridge rule with a single target.
+type EventRule struct {
+	Tags
+	Name         string
+	ARN          string
+	EventPattern string
+	TargetID     string
+	TargetARN    string
+}
+
+// ElasticFileSystem contains the relevant fields for an EFS file system.
+type ElasticFileSystem struct {
+	Tags
+	FileSystemId   string
+	CreationToken  string
+	LifeCycleState string
+}
+
+// MountTarget contains the relevant fields for an EFS mount target.
+type MountTarget struct {
+	MountTargetId    string
+	FileSystemId     string
+	SubnetId         string
+	SecurityGroupIds []string
+	LifeCycleState   string
+}
+
+// ResolverEndpoint contains the relevant fields for a Route 53 Resolver endpoint.
+type ResolverEndpoint struct {
+	Tags
+	ResolverEndpointId string
+	Name               string
+	// Direction is either `INBOUND` or `OUTBOUND`.
+	Direction        string
+	VpcId            string
+	SecurityGroupIds []string
+	// SubnetIds are the subnets an IP address is assigned in on creation. A subnet may be given several times to
+	// assign several IP addresses in it. It is not filled for returned values.
+	SubnetIds []string
+	Status    string
+}
+
+// ResolverEndpointIpAddress contains the relevant fields for an IP address of a Route 53 Resolver endpoint.
+type ResolverEndpointIpAddress struct {
+	IpId     string
+	SubnetId string
+	Ip       string
+}
+
+// ResolverRule contains the relevant fields for a Route 53 Resolver forwarding rule.
+type ResolverRule struct {
+	Tags
+	ResolverRuleId string
+	Name           string
+	// DomainName is the domain name of the rule without trailing dot.
+	DomainName         string
+	ResolverEndpointId string
+	TargetIps          []string
+}
+
+// ResolverRuleAssociation contains the relevant fields for the association of a Route 53 Resolver rule with a VPC.
+type ResolverRuleAssociation struct {
+	ResolverRuleAssociationId string
+	ResolverRuleId            string
+	VpcId                     string
+}
+
+// TaggedResource contains the ARN and the tags of a resource returned by the Resource Groups Tagging API.
+type TaggedResource struct {
+	ARN  string
+	Tags Tags
+}
+
+// AccessKey contains the relevant fields for an IAM access key.
+type AccessKey struct {
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
 // IAMRolePolicy contains the relevant fields for an IAM role policy resource.
 type IAMRolePolicy struct {
 	PolicyName     string
 	RoleName       string
 	PolicyDocument string
 }
+
+// BucketConfiguration contains the optional settings of an S3 bucket.
+type BucketConfiguration struct {
+	// Versioning specifies whether versioning is enabled for the bucket. It is always enabled if ObjectLock is set.
+	Versioning bool
+	ObjectLock *ObjectLockConfiguration
+	Encryption *BucketEncryption
+	Lifecycle  *BucketLifecycle
+	// MinimumTLSVersion is the minimum TLS version of requests to the bucket. Defaults to "1.2".
+	MinimumTLSVersion string
+	// BucketOwnerEnforced specifies whether the ACLs of the bucket are disabled. If false, the object ownership of the
+	// bucket is not changed.
+	BucketOwnerEnforced bool
+}
+
+// BucketAccessLogging contains the relevant fields for the server access logging of an S3 bucket.
+type BucketAccessLogging struct {
+	TargetBucket string
+	TargetPrefix string
+}
+
+// ObjectLockConfiguration contains the relevant fields for the default retention of an S3 bucket with Object Lock.
+type ObjectLockConfiguration struct {
+	Mode s3types.ObjectLockRetentionMode
+	Days int32
+}
+
+// BucketEncryption contains the relevant fields for the default encryption of an S3 bucket with a KMS key.
+type BucketEncryption struct {
+	KMSKeyARN        string
+	BucketKeyEnabled bool
+}
+
+// BucketReplication contains the relevant fields for the replication of the objects of an S3 bucket to another bucket.
+type BucketReplication struct {
+	RoleARN              string
+	DestinationBucketARN string
+	// ReplicaKMSKeyARN is the KMS key which is used to encrypt the replicas. If set, objects encrypted with KMS keys are
+	// replicated as well.
+	ReplicaKMSKeyARN *string
+	ReplicateDeletes bool
+}
+
+// BucketLifecycle contains the relevant fields for the lifecycle rules of the objects in an S3 bucket.
+type BucketLifecycle struct {
+	Transitions                        []BucketLifecycleTransition
+	ExpirationDays                     *int32
+	AbortIncompleteMultipartUploadDays int32
+}
+
+// BucketLifecycleTransition contains the relevant fields for the transition of objects to another storage class.
+type BucketLifecycleTransition struct {
+	Days         int32
+	StorageClass s3types.TransitionStorageClass
+}
@@ -19,6 +19,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
 	"golang.org/x/time/rate"
 	"k8s.io/apimachinery/pkg/util/cache"
 )
@@ -39,13 +41,19 @@ func (e *Route53RateLimiterWaitError) Error() string {
 }
 
 // NewRoute53Factory creates a new Factory that initializes a route53 rate limiter with the given limit and burst
-// when creating new clients.
-func NewRoute53Factory(limit rate.Limit, burst int, waitTimeout time.Duration) Factory {
+// when creating new clients. If zoneLimit is positive, changes of each hosted zone are additionally limited with the
+// given zoneLimit and zoneBurst. If batchWindow is positive, changes of the same hosted zone which are submitted within
+// the batch window are combined into one request.
+func NewRoute53Factory(limit rate.Limit, burst int, waitTimeout time.Duration, zoneLimit rate.Limit, zoneBurst int, batchWindow time.Duration) Factory {
 	return &route53Factory{
 		limit:        limit,
 		burst:        burst,
 		waitTimeout:  waitTimeout,
+		zoneLimit:    zoneLimit,
+		zoneBurst:    zoneBurst,
+		batchWindow:  batchWindow,
 		rateLimiters: cache.NewExpiring(),
+		batchers:     cache.NewExpiring(),
 	}
 }
 
@@ -53,18 +61,34 @@ type route53Factory struct {
 	limit             rate.Limit
 	burst             int
 	waitTimeout       time.Duration
+	zoneLimit         rate.Limit
+	zoneBurst         int
+	batchWindow       time.Duration
 	rateLimiters      *cache.Expiring
 	rateLimitersMutex sync.Mutex
+	batchers          *cache.Expiring
+	batchersMutex     sync.Mutex
 }
 
-// NewClient creates a new instance of Interface for the given AWS credentials and region.
-func (f *route53Factory) NewClient(accessKeyID, secretAccessKey, region string) (Interface, error) {
-	c, err := NewClient(accessKeyID, secretAccessKey, region)
+// NewClient creates a new instance of Interface for the given authentication configuration.
+func (f *route53Factory) NewClient(authConfig AuthConfig) (Interface, error) {
+	c, err := NewClient(authConfig)
 	if err != nil {
 		return nil, err
 	}
-	c.Route53RateLimiter = f.getRateLimiter(accessKeyID)
+	// Route53 requests are throttled per account, hence the rate limiter of an assumed role is not shared with the
+	// access key.
+	key := credentialsKey(authConfig)
+	c.Route53RateLimiter = f.getRateLimiter(key)
 	c.Route53RateLimiterWaitTimeout = f.waitTimeout
+	if f.zoneLimit > 0 {
+		c.Route53ZoneRateLimiter = func(zoneId string) *rate.Limiter {
+			return f.getZoneRateLimiter(key + "/" + zoneId)
+		}
+	}
+	if f.batchWindow > 0 {
+		c.Route53ChangeBatcher = f.getChangeBatcher(key)
+	}
 	return c, nil
 }
 
@@ -86,3 +110,82 @@ func (f *route53Factory) getRateLimiter(accessKeyID string) *rate.Limiter {
 	f.rateLimiters.Set(accessKeyID, rateLimiter, route53RateLimiterCacheTTL)
 	return rateLimiter
 }
+
+func (f *route53Factory) getZoneRateLimiter(key string) *rate.Limiter {
+	f.rateLimitersMutex.Lock()
+	defer f.rateLimitersMutex.Unlock()
+
+	var rateLimiter *rate.Limiter
+	if v, ok := f.rateLimiters.Get(key); ok {
+		rateLimiter = v.(*rate.Limiter)
+	} else {
+		rateLimiter = rate.NewLimiter(f.zoneLimit, f.zoneBurst)
+	}
+	f.rateLimiters.Set(key, rateLimiter, route53RateLimiterCacheTTL)
+	return rateLimiter
+}
+
+func (f *route53Factory) getChangeBatcher(key string) *Route53ChangeBatcher {
+	// changes can only be batched if all clients with the same credentials share the same batcher
+	f.batchersMutex.Lock()
+	defer f.batchersMutex.Unlock()
+
+	var batcher *Route53ChangeBatcher
+	if v, ok := f.batchers.Get(key); ok {
+		batcher = v.(*Route53ChangeBatcher)
+	} else {
+		batcher = NewRoute53ChangeBatcher(f.batchWindow)
+	}
+	f.batchers.Set(key, batcher, route53RateLimiterCacheTTL)
+	return batcher
+}
+
+// RoutingPolicy is the routing policy of a route53 recordset. At most one of Weight, Region, Failover and GeoLocation
+// must be set, and SetIdentifier must be set if one of them is set. A health check can be associated with recordsets
+// with and without routing policy.
+type RoutingPolicy struct {
+	SetIdentifier string
+	Weight        *int64
+	Region        route53types.ResourceRecordSetRegion
+	Failover      route53types.ResourceRecordSetFailover
+	GeoLocation   *route53types.GeoLocation
+	HealthCheckID *string
+}
+
+func (p *RoutingPolicy) setIdentifier() string {
+	if p == nil {
+		return ""
+	}
+	return p.SetIdentifier
+}
+
+func (p *RoutingPolicy) apply(rrs *route53types.ResourceRecordSet) {
+	if p == nil {
+		return
+	}
+	if p.SetIdentifier != "" {
+		rrs.SetIdentifier = aws.String(p.SetIdentifier)
+	}
+	rrs.Weight = p.Weight
+	rrs.Region = p.Region
+	rrs.Failover = p.Failover
+	rrs.GeoLocation = p.GeoLocation
+	rrs.HealthCheckId = p.HealthCheckID
+}
+
+// HealthCheck is the configuration of a route53 health check.
+type HealthCheck struct {
+	Type                     route53types.HealthCheckType
+	IPAddress                *string
+	FullyQualifiedDomainName *string
+	Port                     *int32
+	ResourcePath             *string
+	RequestInterval          *int32
+	FailureThreshold         *int32
+}
+
+// HostedZoneVPC is a VPC which is associated with a private hosted zone.
+type HostedZoneVPC struct {
+	VPCID  string
+	Region string
+}
@@ -138,4 +138,41 @@ var _ = Describe("SecurityGroup", func() {
 		Entry("sg1-sg3", sg1, sg3, 4, 0),
 		Entry("sg3-sg1", sg3, sg1, 0, 4),
 	)
+
+	Describe("#MergeSecurityGroupRules", func() {
+		It("should merge rules with same type, protocol and ports", func() {
+			merged := MergeSecurityGroupRules(append(rules1,
+				&SecurityGroupRule{
+					Type:       SecurityGroupRuleTypeIngress,
+					FromPort:   30000,
+					ToPort:     32767,
+					Protocol:   "tcp",
+					CidrBlocks: []string{"10.0.0.0/8", "0.0.0.0/0"},
+				},
+				&SecurityGroupRule{
+					Type:             SecurityGroupRuleTypeIngress,
+					Protocol:         "-1",
+					SecurityGroupIds: []string{"sg-other"},
+				},
+			))
+			Expect(merged).To(HaveLen(4))
+			Expect((&SecurityGroup{Rules: merged}).EquivalentRulesTo(&SecurityGroup{Rules: []*SecurityGroupRule{
+				{
+					Type:             SecurityGroupRuleTypeIngress,
+					Protocol:         "-1",
+					Self:             true,
+					SecurityGroupIds: []string{"sg-other"},
+				},
+				{
+					Type:       SecurityGroupRuleTypeIngress,
+					FromPort:   30000,
+					ToPort:     32767,
+					Protocol:   "tcp",
+					CidrBlocks: []string{"0.0.0.0/0", "10.0.0.0/8"},
+				},
+				rules1[2],
+				rules1[3],
+			}})).To(BeTrue())
+		})
+	})
 })
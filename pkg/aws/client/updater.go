@@ -21,7 +21,7 @@ import (
 	"reflect"
 	"strings"
 
-	"github.com/aws/aws-sdk-go/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/go-logr/logr"
 	"k8s.io/utils/pointer"
 	"k8s.io/utils/ptr"
@@ -62,7 +62,7 @@ func (u *updater) UpdateVpc(ctx context.Context, desired, current *VPC) (modifie
 		return
 	}
 	if !reflect.DeepEqual(desired.DhcpOptionsId, current.DhcpOptionsId) {
-		if err = u.client.AddVpcDhcpOptionAssociation(current.VpcId, desired.DhcpOptionsId); err != nil {
+		if err = u.client.AddVpcDhcpOptionAssociation(ctx, current.VpcId, desired.DhcpOptionsId); err != nil {
 			return
 		}
 		modified = true
@@ -82,13 +82,13 @@ func (u *updater) UpdateVpc(ctx context.Context, desired, current *VPC) (modifie
 
 func (u *updater) updateVpcAttributes(ctx context.Context, desired, current *VPC) (modified bool, err error) {
 	if desired.EnableDnsSupport != current.EnableDnsSupport {
-		if err = u.client.UpdateVpcAttribute(ctx, current.VpcId, ec2.VpcAttributeNameEnableDnsSupport, desired.EnableDnsSupport); err != nil {
+		if err = u.client.UpdateVpcAttribute(ctx, current.VpcId, string(ec2types.VpcAttributeNameEnableDnsSupport), desired.EnableDnsSupport); err != nil {
 			return
 		}
 		modified = true
 	}
 	if desired.EnableDnsHostnames != current.EnableDnsHostnames {
-		if err = u.client.UpdateVpcAttribute(ctx, current.VpcId, ec2.VpcAttributeNameEnableDnsHostnames, desired.EnableDnsHostnames); err != nil {
+		if err = u.client.UpdateVpcAttribute(ctx, current.VpcId, string(ec2types.VpcAttributeNameEnableDnsHostnames), desired.EnableDnsHostnames); err != nil {
 			return
 		}
 		modified = true
@@ -98,19 +98,18 @@ func (u *updater) updateVpcAttributes(ctx context.Context, desired, current *VPC
 
 func (u *updater) UpdateSecurityGroup(ctx context.Context, desired, current *SecurityGroup) (modified bool, err error) {
 	added, removed := desired.DiffRules(current)
-	if len(added) == 0 && len(removed) == 0 {
-		return
-	}
-	if err = u.client.RevokeSecurityGroupRules(ctx, current.GroupId, removed); err != nil {
-		return
-	}
-	if err = u.client.AuthorizeSecurityGroupRules(ctx, current.GroupId, added); err != nil {
-		return
-	}
-	if _, err = u.UpdateEC2Tags(ctx, current.GroupId, desired.Tags, current.Tags); err != nil {
-		return
+	if len(added) > 0 || len(removed) > 0 {
+		if err = u.client.RevokeSecurityGroupRules(ctx, current.GroupId, removed); err != nil {
+			return
+		}
+		if err = u.client.AuthorizeSecurityGroupRules(ctx, current.GroupId, added); err != nil {
+			return
+		}
+		modified = true
 	}
-	return true, nil
+	tagsModified, err := u.UpdateEC2Tags(ctx, current.GroupId, desired.Tags, current.Tags)
+	modified = modified || tagsModified
+	return
 }
 
 func (u *updater) UpdateRouteTable(ctx context.Context, log logr.Logger, desired, current *RouteTable, controlledCidrBlocks ...string) (modified bool, err error) {
@@ -129,7 +128,7 @@ outerDelete:
 			// ignore VPC endpoint route table associations
 			continue outerDelete
 		}
-		routeCidrBlock := ptr.Deref(cr.DestinationCidrBlock, "")
+		routeCidrBlock := ptr.Deref(cr.DestinationCidrBlock, ptr.Deref(cr.DestinationIpv6CidrBlock, ""))
 		found := false
 		for _, cidr := range controlledCidrBlocks {
 			if routeCidrBlock == cidr {
@@ -157,9 +156,16 @@ outerCreate:
 		if err = u.client.CreateRoute(ctx, current.RouteTableId, dr); err != nil {
 			return
 		}
-		log.Info("Created route", "cidr", pointer.StringDeref(dr.DestinationCidrBlock, ""))
+		log.Info("Created route", "cidr", pointer.StringDeref(dr.DestinationCidrBlock, pointer.StringDeref(dr.DestinationIpv6CidrBlock, "")))
 		modified = true
 	}
+	if desired.Tags != nil {
+		tagsModified, err := u.UpdateEC2Tags(ctx, current.RouteTableId, desired.Tags, current.Tags)
+		if err != nil {
+			return modified, err
+		}
+		modified = modified || tagsModified
+	}
 	return
 }
 
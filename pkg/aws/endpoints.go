@@ -0,0 +1,62 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"maps"
+
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+var (
+	// DefaultPartition is the AWS partition used by all AWS clients of the controller. If empty, the partition is
+	// derived from the region. It is set from the controller configuration.
+	DefaultPartition string
+	// DefaultEndpoints maps AWS service identifiers to the custom endpoint URLs used by all AWS clients of the
+	// controller. It is set from the controller configuration.
+	DefaultEndpoints map[string]string
+)
+
+// ApplyEndpoints overrides the AWS partition and the AWS service endpoints of the given authentication configuration
+// with the given ones, e.g. from the `InfrastructureConfig` of a shoot.
+func ApplyEndpoints(authConfig *awsclient.AuthConfig, endpoints *apisaws.Endpoints) {
+	if endpoints == nil {
+		return
+	}
+	if endpoints.Partition != nil {
+		authConfig.Partition = *endpoints.Partition
+	}
+	if len(endpoints.Services) > 0 {
+		merged := maps.Clone(authConfig.Endpoints)
+		if merged == nil {
+			merged = make(map[string]string, len(endpoints.Services))
+		}
+		maps.Copy(merged, endpoints.Services)
+		authConfig.Endpoints = merged
+	}
+}
+
+// GetPartition returns the AWS partition for the given region, taking the given overrides and the controller
+// configuration into account.
+func GetPartition(region string, endpoints *apisaws.Endpoints) string {
+	if endpoints != nil && endpoints.Partition != nil {
+		return *endpoints.Partition
+	}
+	if DefaultPartition != "" {
+		return DefaultPartition
+	}
+	return awsclient.PartitionForRegion(region)
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"errors"
+
+	"github.com/aws/smithy-go"
+	"github.com/gardener/gardener/extensions/pkg/util"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// apiErrorCodes maps error codes of the AWS API to Gardener error codes.
+var apiErrorCodes = map[string]gardencorev1beta1.ErrorCode{
+	// unauthenticated
+	"AuthFailure":                 gardencorev1beta1.ErrorInfraUnauthenticated,
+	"ExpiredToken":                gardencorev1beta1.ErrorInfraUnauthenticated,
+	"ExpiredTokenException":       gardencorev1beta1.ErrorInfraUnauthenticated,
+	"IncompleteSignature":         gardencorev1beta1.ErrorInfraUnauthenticated,
+	"InvalidAccessKeyId":          gardencorev1beta1.ErrorInfraUnauthenticated,
+	"InvalidClientTokenId":        gardencorev1beta1.ErrorInfraUnauthenticated,
+	"InvalidIdentityToken":        gardencorev1beta1.ErrorInfraUnauthenticated,
+	"SignatureDoesNotMatch":       gardencorev1beta1.ErrorInfraUnauthenticated,
+	"UnrecognizedClientException": gardencorev1beta1.ErrorInfraUnauthenticated,
+
+	// unauthorized
+	"AccessDenied":          gardencorev1beta1.ErrorInfraUnauthorized,
+	"AccessDeniedException": gardencorev1beta1.ErrorInfraUnauthorized,
+	"UnauthorizedOperation": gardencorev1beta1.ErrorInfraUnauthorized,
+
+	// quota exceeded
+	"AddressLimitExceeded":                  gardencorev1beta1.ErrorInfraQuotaExceeded,
+	"InstanceLimitExceeded":                 gardencorev1beta1.ErrorInfraQuotaExceeded,
+	"InternetGatewayLimitExceeded":          gardencorev1beta1.ErrorInfraQuotaExceeded,
+	"LimitExceeded":                         gardencorev1beta1.ErrorInfraQuotaExceeded,
+	"LimitExceededException":                gardencorev1beta1.ErrorInfraQuotaExceeded,
+	"MaxSpotInstanceCountExceeded":          gardencorev1beta1.ErrorInfraQuotaExceeded,
+	"NatGatewayLimitExceeded":               gardencorev1beta1.ErrorInfraQuotaExceeded,
+	"RouteLimitExceeded":                    gardencorev1beta1.ErrorInfraQuotaExceeded,
+	"RulesPerSecurityGroupLimitExceeded":    gardencorev1beta1.ErrorInfraQuotaExceeded,
+	"SecurityGroupLimitExceeded":            gardencorev1beta1.ErrorInfraQuotaExceeded,
+	"ServiceQuotaExceededException":         gardencorev1beta1.ErrorInfraQuotaExceeded,
+	"TooManyBuckets":                        gardencorev1beta1.ErrorInfraQuotaExceeded,
+	"TooManyLoadBalancers":                  gardencorev1beta1.ErrorInfraQuotaExceeded,
+	"VcpuLimitExceeded":                     gardencorev1beta1.ErrorInfraQuotaExceeded,
+	"VolumeLimitExceeded":                   gardencorev1beta1.ErrorInfraQuotaExceeded,
+	"VpcLimitExceeded":                      gardencorev1beta1.ErrorInfraQuotaExceeded,
+	"VpcEndpointLimitExceeded":              gardencorev1beta1.ErrorInfraQuotaExceeded,
+	"TransitGatewayAttachmentLimitExceeded": gardencorev1beta1.ErrorInfraQuotaExceeded,
+
+	// rate limits exceeded
+	"PriorRequestNotComplete":   gardencorev1beta1.ErrorInfraRateLimitsExceeded,
+	"RequestLimitExceeded":      gardencorev1beta1.ErrorInfraRateLimitsExceeded,
+	"SlowDown":                  gardencorev1beta1.ErrorInfraRateLimitsExceeded,
+	"Throttling":                gardencorev1beta1.ErrorInfraRateLimitsExceeded,
+	"ThrottlingException":       gardencorev1beta1.ErrorInfraRateLimitsExceeded,
+	"TooManyRequestsException":  gardencorev1beta1.ErrorInfraRateLimitsExceeded,
+	"RequestThrottled":          gardencorev1beta1.ErrorInfraRateLimitsExceeded,
+	"RequestThrottledException": gardencorev1beta1.ErrorInfraRateLimitsExceeded,
+
+	// resources depleted
+	"InsufficientAddressCapacity":          gardencorev1beta1.ErrorInfraResourcesDepleted,
+	"InsufficientCapacity":                 gardencorev1beta1.ErrorInfraResourcesDepleted,
+	"InsufficientHostCapacity":             gardencorev1beta1.ErrorInfraResourcesDepleted,
+	"InsufficientInstanceCapacity":         gardencorev1beta1.ErrorInfraResourcesDepleted,
+	"InsufficientReservedInstanceCapacity": gardencorev1beta1.ErrorInfraResourcesDepleted,
+
+	// dependencies
+	"DeleteConflict":                    gardencorev1beta1.ErrorInfraDependencies,
+	"DependencyViolation":               gardencorev1beta1.ErrorInfraDependencies,
+	"InsufficientFreeAddressesInSubnet": gardencorev1beta1.ErrorInfraDependencies,
+	"OptInRequired":                     gardencorev1beta1.ErrorInfraDependencies,
+	"PendingVerification":               gardencorev1beta1.ErrorInfraDependencies,
+
+	// retryable dependencies
+	"InternalError":      gardencorev1beta1.ErrorRetryableInfraDependencies,
+	"InternalFailure":    gardencorev1beta1.ErrorRetryableInfraDependencies,
+	"ServiceUnavailable": gardencorev1beta1.ErrorRetryableInfraDependencies,
+	"Unavailable":        gardencorev1beta1.ErrorRetryableInfraDependencies,
+
+	// configuration problems
+	"InvalidAMIID.Malformed":      gardencorev1beta1.ErrorConfigurationProblem,
+	"InvalidAMIID.NotFound":       gardencorev1beta1.ErrorConfigurationProblem,
+	"InvalidKeyPair.NotFound":     gardencorev1beta1.ErrorConfigurationProblem,
+	"InvalidParameter":            gardencorev1beta1.ErrorConfigurationProblem,
+	"InvalidParameterCombination": gardencorev1beta1.ErrorConfigurationProblem,
+	"InvalidParameterValue":       gardencorev1beta1.ErrorConfigurationProblem,
+	"InvalidSubnet.Conflict":      gardencorev1beta1.ErrorConfigurationProblem,
+	"InvalidSubnet.Range":         gardencorev1beta1.ErrorConfigurationProblem,
+	"InvalidSubnetID.NotFound":    gardencorev1beta1.ErrorConfigurationProblem,
+	"InvalidVpcID.NotFound":       gardencorev1beta1.ErrorConfigurationProblem,
+	"NoSuchHostedZone":            gardencorev1beta1.ErrorConfigurationProblem,
+	"Unsupported":                 gardencorev1beta1.ErrorRetryableConfigurationProblem,
+	"ValidationError":             gardencorev1beta1.ErrorConfigurationProblem,
+}
+
+// DetermineError returns the given error with the Gardener error codes determined by DetermineErrorCodes, so that the
+// cause of the error is surfaced in the last error of the resource. Errors which already have codes are returned as
+// they are.
+func DetermineError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var coder v1beta1helper.Coder
+	if errors.As(err, &coder) {
+		return err
+	}
+
+	codes := DetermineErrorCodes(err)
+	if len(codes) == 0 {
+		return err
+	}
+	return v1beta1helper.NewErrorWithCodes(err, codes...)
+}
+
+// DetermineErrorCodes determines the Gardener error codes of the given error. The code of an error returned by the AWS
+// API is mapped to the respective Gardener error code. If the error doesn't contain an AWS API error with a known code,
+// e.g. if it was only formatted into the message, the codes are determined by matching the message (see
+// helper.KnownCodes).
+func DetermineErrorCodes(err error) []gardencorev1beta1.ErrorCode {
+	if err == nil {
+		return nil
+	}
+
+	var (
+		apiErr                smithy.APIError
+		rateLimiterErr        *awsclient.RateLimiterWaitError
+		route53RateLimiterErr *awsclient.Route53RateLimiterWaitError
+	)
+	if errors.As(err, &apiErr) {
+		if code, ok := apiErrorCodes[apiErr.ErrorCode()]; ok {
+			return []gardencorev1beta1.ErrorCode{code}
+		}
+	}
+	if errors.As(err, &rateLimiterErr) || errors.As(err, &route53RateLimiterErr) {
+		return []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraRateLimitsExceeded}
+	}
+
+	return util.DetermineErrorCodes(err, helper.KnownCodes)
+}
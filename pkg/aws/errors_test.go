@@ -0,0 +1,72 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws_test
+
+import (
+	"errors"
+	"fmt"
+
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/aws/smithy-go"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+var _ = Describe("Errors", func() {
+	DescribeTable("#DetermineErrorCodes",
+		func(err error, expected []gardencorev1beta1.ErrorCode) {
+			Expect(DetermineErrorCodes(err)).To(Equal(expected))
+		},
+		Entry("nil", nil, nil),
+		Entry("unauthenticated", &smithy.GenericAPIError{Code: "InvalidClientTokenId"}, []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraUnauthenticated}),
+		Entry("unauthorized", fmt.Errorf("could not create VPC: %w", &smithy.GenericAPIError{Code: "UnauthorizedOperation"}), []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraUnauthorized}),
+		Entry("quota exceeded", &smithy.GenericAPIError{Code: "VpcLimitExceeded", Message: "The maximum number of VPCs has been reached."}, []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraQuotaExceeded}),
+		Entry("rate limits exceeded", &smithy.GenericAPIError{Code: "RequestLimitExceeded"}, []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraRateLimitsExceeded}),
+		Entry("resources depleted", &smithy.GenericAPIError{Code: "InsufficientInstanceCapacity"}, []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraResourcesDepleted}),
+		Entry("typed error", &route53types.NoSuchHostedZone{}, []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorConfigurationProblem}),
+		Entry("client-side rate limiter", &awsclient.RateLimiterWaitError{Cause: errors.New("would exceed deadline")}, []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraRateLimitsExceeded}),
+		Entry("unknown code with message matching known codes", &smithy.GenericAPIError{Code: "Unknown", Message: "DependencyViolation"}, []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraDependencies}),
+		Entry("formatted error", fmt.Errorf("could not create VPC: %v", &smithy.GenericAPIError{Code: "UnauthorizedOperation"}), []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraUnauthorized}),
+		Entry("unknown error", errors.New("foo"), nil),
+	)
+
+	Describe("#DetermineError", func() {
+		It("should add the error codes", func() {
+			err := DetermineError(fmt.Errorf("could not create NAT gateway: %w", &smithy.GenericAPIError{Code: "NatGatewayLimitExceeded"}))
+
+			var coder v1beta1helper.Coder
+			Expect(errors.As(err, &coder)).To(BeTrue())
+			Expect(coder.Codes()).To(ConsistOf(gardencorev1beta1.ErrorInfraQuotaExceeded))
+			Expect(err.Error()).To(ContainSubstring("could not create NAT gateway"))
+		})
+
+		It("should keep existing error codes", func() {
+			err := v1beta1helper.NewErrorWithCodes(&smithy.GenericAPIError{Code: "VpcLimitExceeded"}, gardencorev1beta1.ErrorConfigurationProblem)
+
+			Expect(DetermineError(err)).To(BeIdenticalTo(err))
+		})
+
+		It("should return errors without codes as they are", func() {
+			err := errors.New("foo")
+
+			Expect(DetermineError(err)).To(BeIdenticalTo(err))
+		})
+	})
+})
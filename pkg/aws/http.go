@@ -0,0 +1,42 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// DefaultHTTPConfig is the configuration of the HTTP client, i.e. the proxy and the CA bundle, used by all AWS clients
+// of the controller. It is set from the controller configuration.
+var DefaultHTTPConfig *awsclient.HTTPConfig
+
+// ProxyEnvVars returns the environment variables which configure the proxy of DefaultHTTPConfig for pods that call the
+// AWS API, e.g. the Terraformer. The kube-apiserver of the cluster the pods run in is always reached without the proxy.
+func ProxyEnvVars() []corev1.EnvVar {
+	if DefaultHTTPConfig == nil || DefaultHTTPConfig.ProxyURL == "" {
+		return nil
+	}
+
+	noProxy := append([]string{"$(KUBERNETES_SERVICE_HOST)"}, DefaultHTTPConfig.NoProxy...)
+	return []corev1.EnvVar{
+		{Name: "HTTPS_PROXY", Value: DefaultHTTPConfig.ProxyURL},
+		{Name: "HTTP_PROXY", Value: DefaultHTTPConfig.ProxyURL},
+		{Name: "NO_PROXY", Value: strings.Join(noProxy, ",")},
+	}
+}
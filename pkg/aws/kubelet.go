@@ -0,0 +1,74 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+const (
+	// DefaultMaxPods is the maximum number of pods of the kubelet if it is not configured.
+	DefaultMaxPods = 110
+	// maxPodsSmallInstanceTypes and maxPodsLargeInstanceTypes are the upper limits of the maximum number of pods
+	// computed from the network interfaces of instance types with up to 30 vCPUs and with more vCPUs, like on EKS.
+	maxPodsSmallInstanceTypes = 110
+	maxPodsLargeInstanceTypes = 250
+)
+
+// ComputeKubeReserved returns the CPU and memory reserved for Kubernetes system daemons for the given number of vCPUs
+// and maximum number of pods, like on EKS: the CPU is 6% of the first core, 1% of the second core, 0.5% of the third
+// and fourth core and 0.25% of any further core, and the memory is 255Mi plus 11Mi per pod.
+func ComputeKubeReserved(vcpus int32, maxPods int32) (resource.Quantity, resource.Quantity) {
+	if maxPods <= 0 {
+		maxPods = DefaultMaxPods
+	}
+
+	// the CPU is computed in tenths of millicores
+	var cpu int64
+	for core := int32(1); core <= vcpus; core++ {
+		switch {
+		case core == 1:
+			cpu += 600
+		case core == 2:
+			cpu += 100
+		case core <= 4:
+			cpu += 50
+		default:
+			cpu += 25
+		}
+	}
+
+	return *resource.NewMilliQuantity((cpu+9)/10, resource.DecimalSI),
+		*resource.NewQuantity(int64(255+11*maxPods)*1024*1024, resource.BinarySI)
+}
+
+// ComputeMaxPodsFromNetworkInterfaces returns the maximum number of pods of the given instance type if every pod gets
+// an IP address of the VPC, e.g. with the AWS VPC CNI: each network interface provides all but its primary IP address
+// to pods, and two pods using the host network are added. Like on EKS, the result is limited to 110 for instance types
+// with up to 30 vCPUs and to 250 for larger ones.
+func ComputeMaxPodsFromNetworkInterfaces(info *awsclient.InstanceTypeInfo) int32 {
+	maxPods := info.MaxNetworkInterfaces*(info.IPv4AddressesPerInterface-1) + 2
+
+	limit := int32(maxPodsSmallInstanceTypes)
+	if info.VCPUs > 30 {
+		limit = maxPodsLargeInstanceTypes
+	}
+	if maxPods > limit {
+		return limit
+	}
+	return maxPods
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+var _ = Describe("Kubelet", func() {
+	DescribeTable("#ComputeKubeReserved",
+		func(vcpus, maxPods int32, expectedCPU, expectedMemory string) {
+			cpu, memory := ComputeKubeReserved(vcpus, maxPods)
+			Expect(cpu.Cmp(resource.MustParse(expectedCPU))).To(BeZero(), fmt.Sprintf("cpu %s", cpu.String()))
+			Expect(memory.Cmp(resource.MustParse(expectedMemory))).To(BeZero(), fmt.Sprintf("memory %s", memory.String()))
+		},
+		Entry("one core", int32(1), int32(0), "60m", "1465Mi"),
+		Entry("two cores", int32(2), int32(29), "70m", "574Mi"),
+		Entry("three cores", int32(3), int32(29), "75m", "574Mi"),
+		Entry("four cores", int32(4), int32(58), "80m", "893Mi"),
+		Entry("eight cores", int32(8), int32(110), "90m", "1465Mi"),
+		Entry("ninety-six cores", int32(96), int32(737), "310m", "8362Mi"),
+	)
+
+	DescribeTable("#ComputeMaxPodsFromNetworkInterfaces",
+		func(vcpus, maxNetworkInterfaces, ipv4AddressesPerInterface, expectedMaxPods int32) {
+			Expect(ComputeMaxPodsFromNetworkInterfaces(&awsclient.InstanceTypeInfo{
+				VCPUs:                     vcpus,
+				MaxNetworkInterfaces:      maxNetworkInterfaces,
+				IPv4AddressesPerInterface: ipv4AddressesPerInterface,
+			})).To(Equal(expectedMaxPods))
+		},
+		Entry("t3.medium", int32(2), int32(3), int32(6), int32(17)),
+		Entry("m5.xlarge", int32(4), int32(4), int32(15), int32(58)),
+		Entry("m5.4xlarge limited to 110", int32(16), int32(8), int32(30), int32(110)),
+		Entry("m5.16xlarge limited to 250", int32(64), int32(15), int32(50), int32(250)),
+	)
+})
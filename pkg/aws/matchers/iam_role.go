@@ -17,15 +17,14 @@ package infrastructure
 import (
 	"fmt"
 
-	"github.com/aws/aws-sdk-go/aws/awsutil"
-	"github.com/aws/aws-sdk-go/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/onsi/gomega/format"
 	. "github.com/onsi/gomega/gstruct"
 	"github.com/onsi/gomega/types"
 )
 
 // beSemanticallyEqualToIamRole returns a matcher that tests if actual is semantically
-// equal to the given iam.Role
+// equal to the given iamtypes.Role
 func beSemanticallyEqualToIamRole(expected interface{}) types.GomegaMatcher {
 	return &iamRoleMatcher{
 		expected: expected,
@@ -41,23 +40,23 @@ func (m *iamRoleMatcher) Match(actual interface{}) (success bool, err error) {
 		return false, fmt.Errorf("refusing to compare <nil> to <nil>.\nBe explicit and use BeNil() instead. This is to avoid mistakes where both sides of an assertion are erroneously uninitialized")
 	}
 
-	expectedRole, ok := m.expected.(iam.Role)
+	expectedRole, ok := m.expected.(iamtypes.Role)
 	if !ok {
-		expectedRolePointer, ok2 := m.expected.(*iam.Role)
+		expectedRolePointer, ok2 := m.expected.(*iamtypes.Role)
 		if ok2 {
 			expectedRole = *expectedRolePointer
 		} else {
-			return false, fmt.Errorf("refusing to compare expected which is neither a iam.Role nor a *iam.Role")
+			return false, fmt.Errorf("refusing to compare expected which is neither a iamtypes.Role nor a *iamtypes.Role")
 		}
 	}
 
-	actualRole, ok := actual.(iam.Role)
+	actualRole, ok := actual.(iamtypes.Role)
 	if !ok {
-		actualRolePointer, ok2 := actual.(*iam.Role)
+		actualRolePointer, ok2 := actual.(*iamtypes.Role)
 		if ok2 {
 			actualRole = *actualRolePointer
 		} else {
-			return false, fmt.Errorf("refusing to compare actual which is neither a iam.Role nor a *iam.Role")
+			return false, fmt.Errorf("refusing to compare actual which is neither a iamtypes.Role nor a *iamtypes.Role")
 		}
 	}
 
@@ -68,9 +67,9 @@ func (m *iamRoleMatcher) Match(actual interface{}) (success bool, err error) {
 }
 
 func (m *iamRoleMatcher) FailureMessage(actual interface{}) (message string) {
-	return format.MessageWithDiff(awsutil.Prettify(actual), "to equal", awsutil.Prettify(m.expected))
+	return format.MessageWithDiff(prettify(actual), "to equal", prettify(m.expected))
 }
 
 func (m *iamRoleMatcher) NegatedFailureMessage(actual interface{}) (message string) {
-	return format.MessageWithDiff(awsutil.Prettify(actual), "not to equal", awsutil.Prettify(m.expected))
+	return format.MessageWithDiff(prettify(actual), "not to equal", prettify(m.expected))
 }
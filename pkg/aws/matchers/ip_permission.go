@@ -17,15 +17,14 @@ package infrastructure
 import (
 	"fmt"
 
-	"github.com/aws/aws-sdk-go/aws/awsutil"
-	"github.com/aws/aws-sdk-go/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/onsi/gomega/format"
 	. "github.com/onsi/gomega/gstruct"
 	"github.com/onsi/gomega/types"
 )
 
 // beSemanticallyEqualToIpPermission returns a matcher that tests if actual is semantically
-// equal to the given ec2.IpPermission
+// equal to the given ec2types.IpPermission
 func beSemanticallyEqualToIpPermission(expected interface{}) types.GomegaMatcher {
 	return &ipPermissionMatcher{
 		expected: expected,
@@ -41,23 +40,23 @@ func (m *ipPermissionMatcher) Match(actual interface{}) (success bool, err error
 		return false, fmt.Errorf("refusing to compare <nil> to <nil>.\nBe explicit and use BeNil() instead. This is to avoid mistakes where both sides of an assertion are erroneously uninitialized")
 	}
 
-	expectedPermission, ok := m.expected.(ec2.IpPermission)
+	expectedPermission, ok := m.expected.(ec2types.IpPermission)
 	if !ok {
-		expectedPermissionPointer, ok2 := m.expected.(*ec2.IpPermission)
+		expectedPermissionPointer, ok2 := m.expected.(*ec2types.IpPermission)
 		if ok2 {
 			expectedPermission = *expectedPermissionPointer
 		} else {
-			return false, fmt.Errorf("refusing to compare expected which is neither a ec2.IpPermission nor a *ec2.IpPermission")
+			return false, fmt.Errorf("refusing to compare expected which is neither a ec2types.IpPermission nor a *ec2types.IpPermission")
 		}
 	}
 
-	actualPermission, ok := actual.(ec2.IpPermission)
+	actualPermission, ok := actual.(ec2types.IpPermission)
 	if !ok {
-		actualPermissionPointer, ok2 := actual.(*ec2.IpPermission)
+		actualPermissionPointer, ok2 := actual.(*ec2types.IpPermission)
 		if ok2 {
 			actualPermission = *actualPermissionPointer
 		} else {
-			return false, fmt.Errorf("refusing to compare actual which is neither a ec2.IpPermission nor a *ec2.IpPermission")
+			return false, fmt.Errorf("refusing to compare actual which is neither a ec2types.IpPermission nor a *ec2types.IpPermission")
 		}
 	}
 
@@ -73,9 +72,9 @@ func (m *ipPermissionMatcher) Match(actual interface{}) (success bool, err error
 }
 
 func (m *ipPermissionMatcher) FailureMessage(actual interface{}) (message string) {
-	return format.MessageWithDiff(awsutil.Prettify(actual), "to equal", awsutil.Prettify(m.expected))
+	return format.MessageWithDiff(prettify(actual), "to equal", prettify(m.expected))
 }
 
 func (m *ipPermissionMatcher) NegatedFailureMessage(actual interface{}) (message string) {
-	return format.MessageWithDiff(awsutil.Prettify(actual), "not to equal", awsutil.Prettify(m.expected))
+	return format.MessageWithDiff(prettify(actual), "not to equal", prettify(m.expected))
 }
@@ -15,11 +15,12 @@
 package infrastructure
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
 
-	"github.com/aws/aws-sdk-go/service/ec2"
-	"github.com/aws/aws-sdk-go/service/iam"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
 )
@@ -27,10 +28,10 @@ import (
 // BeSemanticallyEqualTo returns a matcher that tests if actual is semantically
 // equal to the given value from the aws sdk.
 // This is useful for checking equalities on values returned by the aws API more easily.
-// For example: ec2.IpPermission contains multiple arrays which might not be in the same order
+// For example: ec2types.IpPermission contains multiple arrays which might not be in the same order
 // each time you retrieve the object from the AWS API. Therefore the returned matcher does not
 // test for deep equality but rather uses the ConsistOf matcher for nested arrays.
-// Another example is iam.Role which contains a field `AssumeRolePolicyDocument` which is urlencoded
+// Another example is iamtypes.Role which contains a field `AssumeRolePolicyDocument` which is urlencoded
 // when returned by the AWS API. The return matcher therefore decodes the policy document before
 // comparing it to expected via the MatchJSON matcher to make the test more readable.
 func BeSemanticallyEqualTo(expected interface{}) types.GomegaMatcher {
@@ -39,13 +40,13 @@ func BeSemanticallyEqualTo(expected interface{}) types.GomegaMatcher {
 	}
 
 	switch expected.(type) {
-	case ec2.IpPermission, *ec2.IpPermission:
+	case ec2types.IpPermission, *ec2types.IpPermission:
 		return beSemanticallyEqualToIpPermission(expected)
-	case []ec2.IpPermission, []*ec2.IpPermission:
+	case []ec2types.IpPermission, []*ec2types.IpPermission:
 		return genericConsistOfSemanticallyEqual(expected)
-	case iam.Role, *iam.Role:
+	case iamtypes.Role, *iamtypes.Role:
 		return beSemanticallyEqualToIamRole(expected)
-	case []iam.Role, []*iam.Role:
+	case []iamtypes.Role, []*iamtypes.Role:
 		return genericConsistOfSemanticallyEqual(expected)
 	default:
 		panic(fmt.Errorf("unknown type for aws matcher BeSemanticallyEqualTo(): %T", expected))
@@ -82,7 +83,7 @@ func genericConsistOf(expected interface{}) types.GomegaMatcher {
 
 	for i := 0; i < value.Len(); i++ {
 		expectedElement := value.Index(i)
-		if expectedElement.IsNil() {
+		if expectedElement.Kind() == reflect.Ptr && expectedElement.IsNil() {
 			expectedElements = append(expectedElements, BeNil())
 		} else {
 			expectedElements = append(expectedElements, Equal(expectedElement.Interface()))
@@ -106,7 +107,7 @@ func genericConsistOfSemanticallyEqual(expected interface{}) types.GomegaMatcher
 
 	for i := 0; i < value.Len(); i++ {
 		expectedElement := value.Index(i)
-		if expectedElement.IsNil() {
+		if expectedElement.Kind() == reflect.Ptr && expectedElement.IsNil() {
 			expectedElements = append(expectedElements, BeNil())
 		} else {
 			expectedElements = append(expectedElements, BeSemanticallyEqualTo(expectedElement.Interface()))
@@ -115,3 +116,12 @@ func genericConsistOfSemanticallyEqual(expected interface{}) types.GomegaMatcher
 
 	return ConsistOf(expectedElements)
 }
+
+// prettify returns the string representation of the given value from the aws sdk.
+func prettify(v interface{}) string {
+	out, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Sprintf("%+v", v)
+	}
+	return string(out)
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// RequiredActions are the AWS API actions which the controllers of the extension need to perform with the credentials
+// of a shoot, keyed by the name of the controller. Actions which are only needed for optional features, e.g. Outposts or
+// Karpenter, are not contained.
+var RequiredActions = map[string][]string{
+	"infrastructure": {
+		"ec2:DescribeVpcs",
+		"ec2:CreateVpc",
+		"ec2:ModifyVpcAttribute",
+		"ec2:DeleteVpc",
+		"ec2:DescribeSubnets",
+		"ec2:CreateSubnet",
+		"ec2:DeleteSubnet",
+		"ec2:DescribeSecurityGroups",
+		"ec2:CreateSecurityGroup",
+		"ec2:AuthorizeSecurityGroupIngress",
+		"ec2:AuthorizeSecurityGroupEgress",
+		"ec2:RevokeSecurityGroupIngress",
+		"ec2:RevokeSecurityGroupEgress",
+		"ec2:DeleteSecurityGroup",
+		"ec2:DescribeInternetGateways",
+		"ec2:CreateInternetGateway",
+		"ec2:AttachInternetGateway",
+		"ec2:DetachInternetGateway",
+		"ec2:DeleteInternetGateway",
+		"ec2:DescribeRouteTables",
+		"ec2:CreateRouteTable",
+		"ec2:AssociateRouteTable",
+		"ec2:CreateRoute",
+		"ec2:DeleteRoute",
+		"ec2:DeleteRouteTable",
+		"ec2:DescribeNatGateways",
+		"ec2:CreateNatGateway",
+		"ec2:DeleteNatGateway",
+		"ec2:DescribeAddresses",
+		"ec2:AllocateAddress",
+		"ec2:ReleaseAddress",
+		"ec2:DescribeVpcEndpoints",
+		"ec2:CreateVpcEndpoint",
+		"ec2:DeleteVpcEndpoints",
+		"ec2:DescribePrefixLists",
+		"ec2:DescribeKeyPairs",
+		"ec2:ImportKeyPair",
+		"ec2:DeleteKeyPair",
+		"ec2:CreateTags",
+		"ec2:DeleteTags",
+		"iam:GetRole",
+		"iam:CreateRole",
+		"iam:DeleteRole",
+		"iam:GetRolePolicy",
+		"iam:PutRolePolicy",
+		"iam:DeleteRolePolicy",
+		"iam:GetInstanceProfile",
+		"iam:CreateInstanceProfile",
+		"iam:AddRoleToInstanceProfile",
+		"iam:RemoveRoleFromInstanceProfile",
+		"iam:DeleteInstanceProfile",
+		"elasticloadbalancing:DescribeLoadBalancers",
+		"elasticloadbalancing:DeleteLoadBalancer",
+		"s3:CreateBucket",
+		"s3:PutBucketPolicy",
+		"s3:DeleteBucket",
+	},
+	"worker": {
+		"ec2:DescribeImages",
+		"ec2:DescribeInstances",
+		"ec2:RunInstances",
+		"ec2:TerminateInstances",
+		"ec2:CreateTags",
+		"ec2:DescribeVolumes",
+		"iam:PassRole",
+	},
+	"controlplane": {
+		"ec2:DescribeInstances",
+		"ec2:DescribeRouteTables",
+		"ec2:CreateRoute",
+		"ec2:DeleteRoute",
+		"ec2:CreateVolume",
+		"ec2:AttachVolume",
+		"ec2:DetachVolume",
+		"ec2:DeleteVolume",
+		"ec2:CreateSnapshot",
+		"ec2:DeleteSnapshot",
+		"elasticloadbalancing:CreateLoadBalancer",
+		"elasticloadbalancing:ConfigureHealthCheck",
+		"elasticloadbalancing:RegisterInstancesWithLoadBalancer",
+		"elasticloadbalancing:CreateTargetGroup",
+		"elasticloadbalancing:RegisterTargets",
+		"elasticloadbalancing:CreateListener",
+		"elasticloadbalancing:ModifyLoadBalancerAttributes",
+		"elasticloadbalancing:DeleteLoadBalancer",
+		"elasticloadbalancing:DeleteTargetGroup",
+	},
+	"dnsrecord": {
+		"route53:ListHostedZones",
+		"route53:ListResourceRecordSets",
+		"route53:ChangeResourceRecordSets",
+		"route53:GetChange",
+	},
+}
+
+// GetPolicySourceARN returns the ARN of the IAM user or role whose policies apply to the caller with the given ARN, which
+// can be used for simulating its permissions. It returns an empty string for callers which can't be simulated, e.g. the
+// root user of the account.
+func GetPolicySourceARN(ctx context.Context, awsClient awsclient.Interface, callerARN string) (string, error) {
+	parsed, err := arn.Parse(callerARN)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case parsed.Service == "iam" && strings.HasPrefix(parsed.Resource, "user/"):
+		return callerARN, nil
+	case parsed.Service == "sts" && strings.HasPrefix(parsed.Resource, "assumed-role/"):
+		// The ARN of an assumed role session does not contain the path of the role, hence it has to be looked up.
+		roleName := strings.Split(parsed.Resource, "/")[1]
+		role, err := awsClient.GetIAMRole(ctx, roleName)
+		if err != nil || role == nil {
+			return "", err
+		}
+		return role.ARN, nil
+	default:
+		return "", nil
+	}
+}
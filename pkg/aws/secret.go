@@ -17,6 +17,7 @@ package aws
 import (
 	"context"
 	"fmt"
+	"maps"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	corev1 "k8s.io/api/core/v1"
@@ -42,9 +43,33 @@ func ReadCredentialsSecret(secret *corev1.Secret, allowDNSKeys bool) (*Credentia
 		return nil, fmt.Errorf("secret does not contain any data")
 	}
 
-	var altAccessKeyIDKey, altSecretAccessKeyKey, altRegionKey *string
+	var altAccessKeyIDKey, altSecretAccessKeyKey, altRegionKey, altRoleARNKey, altExternalIDKey, altWebIdentityTokenKey *string
 	if allowDNSKeys {
 		altAccessKeyIDKey, altSecretAccessKeyKey, altRegionKey = pointer.String(DNSAccessKeyID), pointer.String(DNSSecretAccessKey), pointer.String(DNSRegion)
+		altRoleARNKey, altExternalIDKey, altWebIdentityTokenKey = pointer.String(DNSRoleARN), pointer.String(DNSExternalID), pointer.String(DNSWebIdentityToken)
+	}
+
+	region, _ := getSecretDataValue(secret, Region, altRegionKey, false)
+	roleARN, _ := getSecretDataValue(secret, RoleARN, altRoleARNKey, false)
+	externalID, _ := getSecretDataValue(secret, ExternalID, altExternalIDKey, false)
+	if len(externalID) > 0 && len(roleARN) == 0 {
+		return nil, fmt.Errorf("field %q in secret requires field %q", ExternalID, RoleARN)
+	}
+
+	webIdentityToken, _ := getSecretDataValue(secret, WebIdentityToken, altWebIdentityTokenKey, false)
+	if len(webIdentityToken) > 0 {
+		if len(roleARN) == 0 {
+			return nil, fmt.Errorf("field %q in secret requires field %q", WebIdentityToken, RoleARN)
+		}
+		if len(externalID) > 0 {
+			return nil, fmt.Errorf("field %q in secret cannot be used together with field %q", ExternalID, WebIdentityToken)
+		}
+
+		return &Credentials{
+			Region:           region,
+			RoleARN:          roleARN,
+			WebIdentityToken: webIdentityToken,
+		}, nil
 	}
 
 	accessKeyID, err := getSecretDataValue(secret, AccessKeyID, altAccessKeyIDKey, true)
@@ -57,15 +82,42 @@ func ReadCredentialsSecret(secret *corev1.Secret, allowDNSKeys bool) (*Credentia
 		return nil, err
 	}
 
-	region, _ := getSecretDataValue(secret, Region, altRegionKey, false)
-
 	return &Credentials{
 		AccessKeyID:     accessKeyID,
 		SecretAccessKey: secretAccessKey,
 		Region:          region,
+		RoleARN:         roleARN,
+		ExternalID:      externalID,
 	}, nil
 }
 
+// NewAuthConfig returns the configuration for authenticating with the given credentials in the given region. The AWS
+// partition, the AWS service endpoints, the HTTP configuration and the client-side rate limiter of the controller
+// configuration are applied.
+func NewAuthConfig(credentials *Credentials, region string) awsclient.AuthConfig {
+	authConfig := awsclient.AuthConfig{
+		AccessKeyID:     string(credentials.AccessKeyID),
+		SecretAccessKey: string(credentials.SecretAccessKey),
+		Region:          region,
+		Partition:       DefaultPartition,
+		Endpoints:       maps.Clone(DefaultEndpoints),
+		HTTP:            DefaultHTTPConfig,
+		RateLimiter:     DefaultRateLimiter,
+	}
+	if len(credentials.WebIdentityToken) > 0 {
+		authConfig.WebIdentity = &awsclient.WebIdentity{
+			RoleARN: string(credentials.RoleARN),
+			Token:   string(credentials.WebIdentityToken),
+		}
+	} else if len(credentials.RoleARN) > 0 {
+		authConfig.AssumeRole = &awsclient.AssumeRole{
+			RoleARN:    string(credentials.RoleARN),
+			ExternalID: string(credentials.ExternalID),
+		}
+	}
+	return authConfig
+}
+
 // NewClientFromSecretRef creates a new Client for the given AWS credentials from given k8s <secretRef> and
 // the AWS region <region>.
 func NewClientFromSecretRef(ctx context.Context, client client.Client, secretRef corev1.SecretReference, region string) (awsclient.Interface, error) {
@@ -73,7 +125,7 @@ func NewClientFromSecretRef(ctx context.Context, client client.Client, secretRef
 	if err != nil {
 		return nil, err
 	}
-	return awsclient.NewClient(string(credentials.AccessKeyID), string(credentials.SecretAccessKey), region)
+	return awsclient.NewClient(NewAuthConfig(credentials, region))
 }
 
 func getSecretDataValue(secret *corev1.Secret, key string, altKey *string, required bool) ([]byte, error) {
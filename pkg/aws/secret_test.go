@@ -24,15 +24,22 @@ import (
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 	. "github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 )
 
 var (
 	accessKeyID     = []byte("foo")
 	secretAccessKey = []byte("bar")
 	region          = []byte("region")
+	roleARN         = []byte("arn:aws:iam::123456789012:role/foo")
+	externalID      = []byte("external-id")
+
+	webIdentityToken = []byte("token")
 )
 
 var _ = Describe("Secret", func() {
@@ -182,6 +189,66 @@ var _ = Describe("Secret", func() {
 			Expect(err).To(HaveOccurred())
 		})
 
+		It("should return the role ARN and external ID if they are given", func() {
+			secret.Data = map[string][]byte{
+				AccessKeyID:     accessKeyID,
+				SecretAccessKey: secretAccessKey,
+				RoleARN:         roleARN,
+				ExternalID:      externalID,
+			}
+
+			credentials, err := ReadCredentialsSecret(secret, false)
+
+			Expect(credentials).To(Equal(&Credentials{
+				AccessKeyID:     accessKeyID,
+				SecretAccessKey: secretAccessKey,
+				RoleARN:         roleARN,
+				ExternalID:      externalID,
+			}))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return the web identity credentials if a token is given", func() {
+			secret.Data = map[string][]byte{
+				RoleARN:          roleARN,
+				WebIdentityToken: webIdentityToken,
+				Region:           region,
+			}
+
+			credentials, err := ReadCredentialsSecret(secret, false)
+
+			Expect(credentials).To(Equal(&Credentials{
+				Region:           region,
+				RoleARN:          roleARN,
+				WebIdentityToken: webIdentityToken,
+			}))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail if the token is given without role ARN", func() {
+			secret.Data = map[string][]byte{
+				WebIdentityToken: webIdentityToken,
+			}
+
+			credentials, err := ReadCredentialsSecret(secret, false)
+
+			Expect(credentials).To(BeNil())
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail if the external ID is given without role ARN", func() {
+			secret.Data = map[string][]byte{
+				AccessKeyID:     accessKeyID,
+				SecretAccessKey: secretAccessKey,
+				ExternalID:      externalID,
+			}
+
+			credentials, err := ReadCredentialsSecret(secret, false)
+
+			Expect(credentials).To(BeNil())
+			Expect(err).To(HaveOccurred())
+		})
+
 		Context("DNS keys are not allowed", func() {
 			It("should return the correct credentials object if non-DNS keys are used", func() {
 				secret.Data = map[string][]byte{
@@ -247,4 +314,100 @@ var _ = Describe("Secret", func() {
 			})
 		})
 	})
+
+	Describe("#NewAuthConfig", func() {
+		It("should return the auth config for the access key", func() {
+			Expect(NewAuthConfig(&Credentials{
+				AccessKeyID:     accessKeyID,
+				SecretAccessKey: secretAccessKey,
+			}, "eu-west-1")).To(Equal(awsclient.AuthConfig{
+				AccessKeyID:     string(accessKeyID),
+				SecretAccessKey: string(secretAccessKey),
+				Region:          "eu-west-1",
+			}))
+		})
+
+		It("should return the auth config for assuming the role", func() {
+			Expect(NewAuthConfig(&Credentials{
+				AccessKeyID:     accessKeyID,
+				SecretAccessKey: secretAccessKey,
+				RoleARN:         roleARN,
+				ExternalID:      externalID,
+			}, "eu-west-1")).To(Equal(awsclient.AuthConfig{
+				AccessKeyID:     string(accessKeyID),
+				SecretAccessKey: string(secretAccessKey),
+				AssumeRole: &awsclient.AssumeRole{
+					RoleARN:    string(roleARN),
+					ExternalID: string(externalID),
+				},
+				Region: "eu-west-1",
+			}))
+		})
+
+		It("should return the auth config for the web identity", func() {
+			Expect(NewAuthConfig(&Credentials{
+				RoleARN:          roleARN,
+				WebIdentityToken: webIdentityToken,
+			}, "eu-west-1")).To(Equal(awsclient.AuthConfig{
+				WebIdentity: &awsclient.WebIdentity{
+					RoleARN: string(roleARN),
+					Token:   string(webIdentityToken),
+				},
+				Region: "eu-west-1",
+			}))
+		})
+
+		It("should apply the partition, the endpoints, the HTTP configuration and the rate limiter of the controller configuration", func() {
+			defer func(partition string, endpoints map[string]string, httpConfig *awsclient.HTTPConfig, rateLimiter *awsclient.RateLimiterConfig) {
+				DefaultPartition, DefaultEndpoints, DefaultHTTPConfig, DefaultRateLimiter = partition, endpoints, httpConfig, rateLimiter
+			}(DefaultPartition, DefaultEndpoints, DefaultHTTPConfig, DefaultRateLimiter)
+			DefaultPartition = "aws-cn"
+			DefaultEndpoints = map[string]string{"ec2": "https://ec2.example.com"}
+			DefaultHTTPConfig = &awsclient.HTTPConfig{ProxyURL: "http://proxy.example.com:3128"}
+			DefaultRateLimiter = &awsclient.RateLimiterConfig{Limit: 10, Burst: 20}
+
+			Expect(NewAuthConfig(&Credentials{
+				AccessKeyID:     accessKeyID,
+				SecretAccessKey: secretAccessKey,
+			}, "cn-north-1")).To(Equal(awsclient.AuthConfig{
+				AccessKeyID:     string(accessKeyID),
+				SecretAccessKey: string(secretAccessKey),
+				Region:          "cn-north-1",
+				Partition:       "aws-cn",
+				Endpoints:       map[string]string{"ec2": "https://ec2.example.com"},
+				HTTP:            &awsclient.HTTPConfig{ProxyURL: "http://proxy.example.com:3128"},
+				RateLimiter:     &awsclient.RateLimiterConfig{Limit: 10, Burst: 20},
+			}))
+		})
+	})
+
+	Describe("#ApplyEndpoints", func() {
+		It("should override the partition and merge the endpoints", func() {
+			authConfig := awsclient.AuthConfig{
+				Region:    "us-gov-west-1",
+				Endpoints: map[string]string{"ec2": "https://ec2.example.com", "iam": "https://iam.example.com"},
+			}
+
+			ApplyEndpoints(&authConfig, &apisaws.Endpoints{
+				Partition: pointer.String("aws-us-gov"),
+				Services:  map[string]string{"ec2": "https://ec2.shoot.example.com"},
+			})
+
+			Expect(authConfig.Partition).To(Equal("aws-us-gov"))
+			Expect(authConfig.Endpoints).To(Equal(map[string]string{"ec2": "https://ec2.shoot.example.com", "iam": "https://iam.example.com"}))
+		})
+
+		It("should do nothing if no overrides are given", func() {
+			authConfig := awsclient.AuthConfig{Region: "eu-west-1"}
+			ApplyEndpoints(&authConfig, nil)
+			Expect(authConfig).To(Equal(awsclient.AuthConfig{Region: "eu-west-1"}))
+		})
+	})
+
+	Describe("#GetPartition", func() {
+		It("should prefer the given override over the controller configuration and the region", func() {
+			Expect(GetPartition("cn-north-1", nil)).To(Equal("aws-cn"))
+			Expect(GetPartition("cn-north-1", &apisaws.Endpoints{Partition: pointer.String("aws-iso")})).To(Equal("aws-iso"))
+		})
+	})
 })
@@ -0,0 +1,59 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"time"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/config"
+)
+
+const (
+	// DefaultTerraformerPodTimeout is the default maximum duration the controller waits for a Terraformer pod.
+	DefaultTerraformerPodTimeout = 15 * time.Minute
+	// DefaultTerraformStateCompressionThreshold is the default size in bytes of the terraform state above which it is
+	// compressed before it is stored in the status of the Infrastructure.
+	DefaultTerraformStateCompressionThreshold int64 = 512 * 1024
+)
+
+// DefaultTerraformerConfig is the configuration of the Terraformer pods and the handling of the terraform state used
+// by the infrastructure controller. It is set from the controller configuration.
+var DefaultTerraformerConfig *config.TerraformerConfig
+
+// TerraformerImage returns the image of the Terraformer configured in DefaultTerraformerConfig, or the given default
+// image if none is configured.
+func TerraformerImage(defaultImage string) string {
+	if DefaultTerraformerConfig == nil || DefaultTerraformerConfig.Image == nil || *DefaultTerraformerConfig.Image == "" {
+		return defaultImage
+	}
+	return *DefaultTerraformerConfig.Image
+}
+
+// TerraformerPodTimeout returns the maximum duration the controller waits for a Terraformer pod.
+func TerraformerPodTimeout() time.Duration {
+	if DefaultTerraformerConfig == nil || DefaultTerraformerConfig.PodTimeout == nil {
+		return DefaultTerraformerPodTimeout
+	}
+	return DefaultTerraformerConfig.PodTimeout.Duration
+}
+
+// TerraformStateCompressionThreshold returns the size in bytes of the terraform state above which it is compressed
+// before it is stored in the status of the Infrastructure.
+func TerraformStateCompressionThreshold() int64 {
+	if DefaultTerraformerConfig == nil || DefaultTerraformerConfig.StateCompressionThreshold == nil {
+		return DefaultTerraformStateCompressionThreshold
+	}
+	return DefaultTerraformerConfig.StateCompressionThreshold.Value()
+}
@@ -25,6 +25,18 @@ const (
 	// VolumeAttachLimit is the key for an annotation on a Shoot object whose value
 	// represents the maximum number of volumes attachable for all nodes.
 	VolumeAttachLimit = "aws.provider.extensions.gardener.cloud/volume-attach-limit"
+	// CapacityTypeLabel is the key of a label on the nodes of worker pools running on spot instances, its value is the
+	// capacity type of the worker pool.
+	CapacityTypeLabel = "aws.provider.extensions.gardener.cloud/capacity-type"
+	// KubeReservedCPULabel is the key of a label on the nodes of worker pools whose kubeReserved is computed from the
+	// instance type, its value is the reserved CPU.
+	KubeReservedCPULabel = "aws.provider.extensions.gardener.cloud/kube-reserved-cpu"
+	// KubeReservedMemoryLabel is the key of a label on the nodes of worker pools whose kubeReserved is computed from the
+	// instance type, its value is the reserved memory.
+	KubeReservedMemoryLabel = "aws.provider.extensions.gardener.cloud/kube-reserved-memory"
+	// MaxPodsLabel is the key of a label on the nodes of worker pools whose maximum number of pods is computed from the
+	// network interfaces of the instance type, its value is the maximum number of pods.
+	MaxPodsLabel = "aws.provider.extensions.gardener.cloud/max-pods"
 
 	// CloudControllerManagerImageName is the name of the cloud-controller-manager image.
 	CloudControllerManagerImageName = "cloud-controller-manager"
@@ -32,6 +44,16 @@ const (
 	AWSCustomRouteControllerImageName = "aws-custom-route-controller"
 	// AWSLoacBalancerControllerImageName is the name of the ALB controller image.
 	AWSLoacBalancerControllerImageName = "aws-load-balancer-controller"
+	// KarpenterImageName is the name of the Karpenter controller image.
+	KarpenterImageName = "karpenter"
+	// NVIDIADevicePluginImageName is the name of the NVIDIA device plugin image.
+	NVIDIADevicePluginImageName = "nvidia-device-plugin"
+	// NeuronDevicePluginImageName is the name of the AWS Neuron device plugin image.
+	NeuronDevicePluginImageName = "neuron-device-plugin"
+	// PauseContainerImageName is the name of the pause container image reserving pre-provisioned capacity.
+	PauseContainerImageName = "pause-container"
+	// NodeTerminationHandlerImageName is the name of the AWS node termination handler image.
+	NodeTerminationHandlerImageName = "aws-node-termination-handler"
 
 	// CSIDriverImageName is the name of the csi-driver image.
 	CSIDriverImageName = "csi-driver"
@@ -53,6 +75,10 @@ const (
 	CSISnapshotValidationWebhookImageName = "csi-snapshot-validation-webhook"
 	// CSIVolumeModifierImageName is the name of the csi-volume-modifier image.
 	CSIVolumeModifierImageName = "csi-volume-modifier"
+	// CSIDriverEFSImageName is the name of the csi-driver-efs image.
+	CSIDriverEFSImageName = "csi-driver-efs"
+	// CloudWatchAgentImageName is the name of the cloudwatch-agent image.
+	CloudWatchAgentImageName = "cloudwatch-agent"
 
 	// MachineControllerManagerProviderAWSImageName is the name of the MachineController AWS image.
 	MachineControllerManagerProviderAWSImageName = "machine-controller-manager-provider-aws"
@@ -69,12 +95,30 @@ const (
 	SharedCredentialsFile = "credentialsFile"
 	// Region is a constant for the key in a backup secret that holds the AWS region.
 	Region = "region"
+	// RoleARN is a constant for the key in a cloud provider secret and backup secret that holds the ARN of an IAM role
+	// which is assumed with the access key.
+	RoleARN = "roleARN"
+	// ExternalID is a constant for the key in a cloud provider secret and backup secret that holds the external ID
+	// which is required for assuming the IAM role.
+	ExternalID = "externalID"
+	// WebIdentityToken is a constant for the key in a cloud provider secret that holds a web identity token (e.g. a
+	// service account token minted by the seed) which is exchanged for temporary credentials of the IAM role.
+	WebIdentityToken = "token"
 	// DNSAccessKeyID is a constant for the key in a DNS secret that holds the AWS access key id.
 	DNSAccessKeyID = "AWS_ACCESS_KEY_ID"
 	// DNSSecretAccessKey is a constant for the key in a DNS secret that holds the AWS secret access key.
 	DNSSecretAccessKey = "AWS_SECRET_ACCESS_KEY"
 	// DNSRegion is a constant for the key in a DNS secret that holds the AWS region.
 	DNSRegion = "AWS_REGION"
+	// DNSRoleARN is a constant for the key in a DNS secret that holds the ARN of an IAM role which is assumed with the
+	// access key.
+	DNSRoleARN = "AWS_ROLE_ARN"
+	// DNSExternalID is a constant for the key in a DNS secret that holds the external ID which is required for assuming
+	// the IAM role.
+	DNSExternalID = "AWS_EXTERNAL_ID"
+	// DNSWebIdentityToken is a constant for the key in a DNS secret that holds a web identity token which is exchanged
+	// for temporary credentials of the IAM role.
+	DNSWebIdentityToken = "AWS_WEB_IDENTITY_TOKEN"
 	// TerraformerPurposeInfra is a constant for the complete Terraform setup with purpose 'infrastructure'.
 	TerraformerPurposeInfra = "infra"
 	// VPCIDKey is the vpc_id tf state key
@@ -83,6 +127,28 @@ const (
 	SubnetPublicPrefix = "subnet_public_utility_z"
 	// SubnetNodesPrefix is the prefix for the subnets
 	SubnetNodesPrefix = "subnet_nodes_z"
+	// SubnetPodsPrefix is the prefix for the dedicated pods subnets
+	SubnetPodsPrefix = "subnet_pods_z"
+	// SubnetPublicIPv6Prefix is the prefix for the IPv6 CIDRs of the public subnets
+	SubnetPublicIPv6Prefix = "subnet_ipv6_public_utility_z"
+	// SubnetNodesIPv6Prefix is the prefix for the IPv6 CIDRs of the nodes subnets
+	SubnetNodesIPv6Prefix = "subnet_ipv6_nodes_z"
+	// VPCIPv6CidrKey is the vpc_ipv6_cidr tf state key
+	VPCIPv6CidrKey = "vpc_ipv6_cidr"
+	// VPCEndpointPrefix is the prefix for the VPC endpoint ids, dots in the service name are replaced by underscores
+	VPCEndpointPrefix = "vpc_endpoint_"
+	// TransitGatewayAttachmentID key for accessing the transit gateway VPC attachment id from outputs in terraform
+	TransitGatewayAttachmentID = "transit_gateway_attachment_id"
+	// ElasticFileSystemID key for accessing the EFS file system id from outputs in terraform
+	ElasticFileSystemID = "efs_file_system_id"
+	// LoadBalancerAccessLogsBucketName key for accessing the name of the S3 bucket for the access logs of load balancers
+	// from outputs in terraform
+	LoadBalancerAccessLogsBucketName = "load_balancer_access_logs_bucket_name"
+	// EgressPrefixListID key for accessing the id of the managed prefix list with the egress IPs from outputs in terraform
+	EgressPrefixListID = "egress_prefix_list_id"
+	// EgressPrefixListARN key for accessing the ARN of the managed prefix list with the egress IPs from outputs in
+	// terraform
+	EgressPrefixListARN = "egress_prefix_list_arn"
 	// SecurityGroupsNodes is the key for accessing nodes security groups from outputs in terraform
 	SecurityGroupsNodes = "security_group_nodes"
 	// SSHKeyName key for accessing SSH key name from outputs in terraform
@@ -103,12 +169,27 @@ const (
 	CloudControllerManagerName = "cloud-controller-manager"
 	// AWSCustomRouteControllerName is the constant for the name of the custom routes controller deployed by the control plane controller.
 	AWSCustomRouteControllerName = "aws-custom-route-controller"
+	// PrivateLinkServiceName is the name of the service of type LoadBalancer in the namespace of the shoot in the seed,
+	// whose network load balancer is published as VPC endpoint service.
+	PrivateLinkServiceName = "kube-apiserver-privatelink"
 	// AWSLoadBalancerControllerName is the constant for the name of the ALB controller deployed by the control plane controller.
 	AWSLoadBalancerControllerName = "aws-load-balancer-controller"
+	// KarpenterName is the constant for the name of the Karpenter controller deployed by the control plane controller.
+	KarpenterName = "karpenter"
+	// CloudWatchAgentName is the constant for the name of the CloudWatch agent deployed in the shoot.
+	CloudWatchAgentName = "cloudwatch-agent"
 	// CSIControllerName is a constant for the name of the CSI controller deployment in the seed.
 	CSIControllerName = "csi-driver-controller"
 	// CSINodeName is a constant for the name of the CSI node deployment in the shoot.
 	CSINodeName = "csi-driver-node"
+	// CSIControllerEFSName is a constant for the name of the EFS CSI controller deployment in the seed.
+	CSIControllerEFSName = "csi-driver-efs-controller"
+	// CSINodeEFSName is a constant for the name of the EFS CSI node deployment in the shoot.
+	CSINodeEFSName = "csi-driver-efs-node"
+	// CSIDriverEFSName is a constant for the name of the csi-driver-efs component.
+	CSIDriverEFSName = "csi-driver-efs"
+	// CSIEFSProvisionerName is a constant for the name of the csi-provisioner component of the EFS CSI driver.
+	CSIEFSProvisionerName = "csi-efs-provisioner"
 	// CSIDriverName is a constant for the name of the csi-driver component.
 	CSIDriverName = "csi-driver"
 	// CSIProvisionerName is a constant for the name of the csi-provisioner component.
@@ -129,6 +210,47 @@ const (
 	CSISnapshotValidationName = "csi-snapshot-validation"
 	// CSIVolumeModifierName is the constant for the name of the csi-volume-modifier.
 	CSIVolumeModifierName = "csi-volume-modifier"
+
+	// LoadBalancerDefaultsConfigMapName is the name of the config map in the kube-system namespace of the shoot which
+	// contains the default annotations of services of type LoadBalancer.
+	LoadBalancerDefaultsConfigMapName = "aws-load-balancer-defaults"
+	// LoadBalancerDefaultsAnnotationsKey is the key of the default annotations in the load balancer defaults config map.
+	LoadBalancerDefaultsAnnotationsKey = "annotations"
+
+	// AnnotationLoadBalancerInternal is the annotation of a service which controls whether its load balancer is internal.
+	AnnotationLoadBalancerInternal = "service.beta.kubernetes.io/aws-load-balancer-internal"
+	// AnnotationLoadBalancerScheme is the annotation of a service which contains the scheme of its load balancer.
+	AnnotationLoadBalancerScheme = "service.beta.kubernetes.io/aws-load-balancer-scheme"
+	// AnnotationLoadBalancerTargetType is the annotation of a service which contains the target type of its network
+	// load balancer.
+	AnnotationLoadBalancerTargetType = "service.beta.kubernetes.io/aws-load-balancer-nlb-target-type"
+	// AnnotationLoadBalancerSubnets is the annotation of a service which contains the subnets of its load balancer.
+	AnnotationLoadBalancerSubnets = "service.beta.kubernetes.io/aws-load-balancer-subnets"
+	// AnnotationLoadBalancerAttributes is the annotation of a service which contains the attributes of its load balancer.
+	AnnotationLoadBalancerAttributes = "service.beta.kubernetes.io/aws-load-balancer-attributes"
+	// AnnotationLoadBalancerAccessLogEnabled is the annotation of a service which controls whether access logs of its
+	// load balancer are delivered.
+	AnnotationLoadBalancerAccessLogEnabled = "service.beta.kubernetes.io/aws-load-balancer-access-log-enabled"
+	// AnnotationLoadBalancerAccessLogBucketName is the annotation of a service which contains the name of the S3 bucket
+	// the access logs of its load balancer are delivered to.
+	AnnotationLoadBalancerAccessLogBucketName = "service.beta.kubernetes.io/aws-load-balancer-access-log-s3-bucket-name"
+	// AnnotationLoadBalancerAccessLogBucketPrefix is the annotation of a service which contains the prefix of the access
+	// log objects of its load balancer.
+	AnnotationLoadBalancerAccessLogBucketPrefix = "service.beta.kubernetes.io/aws-load-balancer-access-log-s3-bucket-prefix"
+	// AnnotationLoadBalancerAccessLogEmitInterval is the annotation of a service which contains the interval in minutes
+	// for publishing the access logs of its classic load balancer.
+	AnnotationLoadBalancerAccessLogEmitInterval = "service.beta.kubernetes.io/aws-load-balancer-access-log-emit-interval"
+	// AnnotationLoadBalancerSSLNegotiationPolicy is the annotation of a service which contains the TLS security policy
+	// of the TLS listeners of its load balancer.
+	AnnotationLoadBalancerSSLNegotiationPolicy = "service.beta.kubernetes.io/aws-load-balancer-ssl-negotiation-policy"
+	// AnnotationLoadBalancerAdditionalResourceTags is the annotation of a service which contains additional tags of its
+	// load balancer.
+	AnnotationLoadBalancerAdditionalResourceTags = "service.beta.kubernetes.io/aws-load-balancer-additional-resource-tags"
+
+	// TagKeyShoot is the key of the tag which contains the name of the shoot.
+	TagKeyShoot = "gardener.cloud/shoot"
+	// TagKeyProject is the key of the tag which contains the name of the project of the shoot.
+	TagKeyProject = "gardener.cloud/project"
 )
 
 var (
@@ -141,4 +263,8 @@ type Credentials struct {
 	AccessKeyID     []byte
 	SecretAccessKey []byte
 	Region          []byte
+	RoleARN         []byte
+	ExternalID      []byte
+	// WebIdentityToken is set instead of the access key if the credentials are federated via web identity.
+	WebIdentityToken []byte
 }
@@ -0,0 +1,137 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+)
+
+// userDataBoundary is the boundary of the MIME multi-part user data. It is fixed, so that the user data only changes
+// if its parts change.
+const userDataBoundary = "==GARDENER-AWS-USER-DATA=="
+
+// BuildUserData combines the custom parts of the given user data configuration and the user data of the Gardener node
+// bootstrap as MIME multi-part archive, which is processed by cloud-init in the given order. The user data of the node
+// bootstrap is returned as is if there are no custom parts. If the user data of the node bootstrap is nil, only the
+// custom parts are combined, e.g. to validate their size.
+func BuildUserData(bootstrap []byte, config *apisaws.UserData) ([]byte, error) {
+	if config == nil || len(config.PreBootstrap)+len(config.PostBootstrap) == 0 {
+		return bootstrap, nil
+	}
+
+	var bootstrapContentType string
+	if bootstrap != nil {
+		var err error
+		if bootstrapContentType, err = detectUserDataContentType(bootstrap); err != nil {
+			return nil, err
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%q\r\nMIME-Version: 1.0\r\n\r\n", userDataBoundary)
+
+	writer := multipart.NewWriter(&buf)
+	if err := writer.SetBoundary(userDataBoundary); err != nil {
+		return nil, err
+	}
+
+	writePart := func(contentType string, content []byte) error {
+		part, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type": {contentType + `; charset="utf-8"`},
+			"MIME-Version": {"1.0"},
+		})
+		if err != nil {
+			return err
+		}
+		_, err = part.Write(content)
+		return err
+	}
+	writeCustomParts := func(parts []apisaws.UserDataPart) error {
+		for _, part := range parts {
+			content, err := helper.DecodeUserDataPart(part)
+			if err != nil {
+				return err
+			}
+			if err := writePart(helper.GetUserDataPartContentType(part), content); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := writeCustomParts(config.PreBootstrap); err != nil {
+		return nil, fmt.Errorf("could not add pre-bootstrap user data: %w", err)
+	}
+	if bootstrap != nil {
+		if err := writePart(bootstrapContentType, bootstrap); err != nil {
+			return nil, fmt.Errorf("could not add bootstrap user data: %w", err)
+		}
+	}
+	if err := writeCustomParts(config.PostBootstrap); err != nil {
+		return nil, fmt.Errorf("could not add post-bootstrap user data: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// CompressUserData compresses the given user data with gzip if it exceeds the limit of EC2. cloud-init and Ignition
+// detect and decompress gzip compressed user data themselves. It returns an error if the compressed user data still
+// exceeds the limit, which would otherwise only surface when the instances are launched.
+func CompressUserData(userData []byte) ([]byte, error) {
+	if len(userData) <= helper.MaxUserDataSize {
+		return userData, nil
+	}
+
+	var buf bytes.Buffer
+	writer, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := writer.Write(userData); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	if buf.Len() > helper.MaxUserDataSize {
+		return nil, fmt.Errorf("user data exceeds the limit of %d bytes even if compressed (%d bytes)", helper.MaxUserDataSize, buf.Len())
+	}
+	return buf.Bytes(), nil
+}
+
+// detectUserDataContentType returns the MIME type of the given user data according to its first line.
+func detectUserDataContentType(userData []byte) (string, error) {
+	switch {
+	case bytes.HasPrefix(userData, []byte("#cloud-config")):
+		return "text/cloud-config", nil
+	case bytes.HasPrefix(userData, []byte("#cloud-boothook")):
+		return "text/cloud-boothook", nil
+	case bytes.HasPrefix(userData, []byte("#!")):
+		return helper.UserDataContentTypeShellScript, nil
+	default:
+		return "", fmt.Errorf("custom user data parts are only supported if the user data of the node bootstrap is a cloud-config or a script")
+	}
+}
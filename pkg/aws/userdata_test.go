@@ -0,0 +1,93 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/hex"
+	"io"
+	"math/rand"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/pointer"
+
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+)
+
+var _ = Describe("UserData", func() {
+	Describe("#BuildUserData", func() {
+		It("should return the user data of the node bootstrap if there are no custom parts", func() {
+			Expect(BuildUserData([]byte("foo"), nil)).To(Equal([]byte("foo")))
+			Expect(BuildUserData([]byte("foo"), &apisaws.UserData{})).To(Equal([]byte("foo")))
+		})
+
+		It("should combine the custom parts and the node bootstrap as multi-part archive", func() {
+			userData, err := BuildUserData([]byte("#cloud-config\n"), &apisaws.UserData{
+				PreBootstrap:  []apisaws.UserDataPart{{ContentType: pointer.String("text/cloud-boothook"), Content: "#cloud-boothook\necho pre"}},
+				PostBootstrap: []apisaws.UserDataPart{{Content: "#!/bin/bash\necho post"}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(userData)).To(Equal("Content-Type: multipart/mixed; boundary=\"==GARDENER-AWS-USER-DATA==\"\r\nMIME-Version: 1.0\r\n\r\n" +
+				"--==GARDENER-AWS-USER-DATA==\r\nContent-Type: text/cloud-boothook; charset=\"utf-8\"\r\nMIME-Version: 1.0\r\n\r\n#cloud-boothook\necho pre\r\n" +
+				"--==GARDENER-AWS-USER-DATA==\r\nContent-Type: text/cloud-config; charset=\"utf-8\"\r\nMIME-Version: 1.0\r\n\r\n#cloud-config\n\r\n" +
+				"--==GARDENER-AWS-USER-DATA==\r\nContent-Type: text/x-shellscript; charset=\"utf-8\"\r\nMIME-Version: 1.0\r\n\r\n#!/bin/bash\necho post\r\n" +
+				"--==GARDENER-AWS-USER-DATA==--\r\n"))
+		})
+
+		It("should only combine the custom parts if there is no node bootstrap", func() {
+			userData, err := BuildUserData(nil, &apisaws.UserData{PreBootstrap: []apisaws.UserDataPart{{Content: "#!/bin/bash\necho pre"}}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(userData)).To(Equal("Content-Type: multipart/mixed; boundary=\"==GARDENER-AWS-USER-DATA==\"\r\nMIME-Version: 1.0\r\n\r\n" +
+				"--==GARDENER-AWS-USER-DATA==\r\nContent-Type: text/x-shellscript; charset=\"utf-8\"\r\nMIME-Version: 1.0\r\n\r\n#!/bin/bash\necho pre\r\n" +
+				"--==GARDENER-AWS-USER-DATA==--\r\n"))
+		})
+
+		It("should fail if the node bootstrap is not supported by cloud-init", func() {
+			_, err := BuildUserData([]byte(`{"ignition": {}}`), &apisaws.UserData{PreBootstrap: []apisaws.UserDataPart{{Content: "echo pre"}}})
+			Expect(err).To(MatchError(ContainSubstring("only supported if the user data of the node bootstrap is a cloud-config or a script")))
+		})
+	})
+
+	Describe("#CompressUserData", func() {
+		It("should not compress user data within the limit", func() {
+			userData := []byte(strings.Repeat("a", 16*1024))
+			Expect(CompressUserData(userData)).To(Equal(userData))
+		})
+
+		It("should compress user data exceeding the limit", func() {
+			userData := []byte(strings.Repeat("a", 32*1024))
+
+			compressed, err := CompressUserData(userData)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(compressed)).To(BeNumerically("<", 16*1024))
+
+			reader, err := gzip.NewReader(bytes.NewReader(compressed))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(io.ReadAll(reader)).To(Equal(userData))
+		})
+
+		It("should fail if the compressed user data still exceeds the limit", func() {
+			random := make([]byte, 20*1024)
+			rand.New(rand.NewSource(1)).Read(random)
+
+			_, err := CompressUserData([]byte(hex.EncodeToString(random)))
+			Expect(err).To(MatchError(ContainSubstring("exceeds the limit of 16384 bytes even if compressed")))
+		})
+	})
+})
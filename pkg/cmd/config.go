@@ -16,12 +16,18 @@ package cmd
 
 import (
 	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
 
 	healthcheckconfig "github.com/gardener/gardener/extensions/pkg/apis/config"
 	"github.com/spf13/pflag"
+	"golang.org/x/time/rate"
 
 	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/config"
 	configloader "github.com/gardener/gardener-extension-provider-aws/pkg/apis/config/loader"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 )
 
 // ConfigOptions are command line options that can be set for config.ControllerConfiguration.
@@ -94,3 +100,115 @@ func (c *Config) ApplyHealthCheckConfig(config *healthcheckconfig.HealthCheckCon
 		*config = *c.Config.HealthCheckConfig
 	}
 }
+
+// ApplyEndpoints sets the given AWS partition and AWS service endpoints to those of this Config.
+func (c *Config) ApplyEndpoints(partition *string, endpoints *map[string]string) {
+	if c.Config.Endpoints == nil {
+		return
+	}
+	if c.Config.Endpoints.Partition != nil {
+		*partition = *c.Config.Endpoints.Partition
+	}
+	*endpoints = c.Config.Endpoints.Services
+}
+
+// ApplyHTTPConfig sets the given HTTP configuration for the requests to the AWS API to the proxy and the CA bundle of
+// this Config. The credentials of the proxy are read from the configured file and added to the proxy URL.
+func (c *Config) ApplyHTTPConfig(httpConfig **awsclient.HTTPConfig) error {
+	if c.Config.Proxy == nil && c.Config.CABundle == nil {
+		return nil
+	}
+
+	cfg := &awsclient.HTTPConfig{}
+	if c.Config.CABundle != nil {
+		cfg.CABundle = []byte(*c.Config.CABundle)
+	}
+
+	if proxy := c.Config.Proxy; proxy != nil {
+		proxyURL, err := url.Parse(proxy.URL)
+		if err != nil {
+			return fmt.Errorf("could not parse proxy URL: %w", err)
+		}
+		if proxyURL.Scheme != "http" && proxyURL.Scheme != "https" {
+			return fmt.Errorf("proxy URL must use the http or https scheme")
+		}
+
+		if proxy.CredentialsFile != nil {
+			data, err := os.ReadFile(*proxy.CredentialsFile)
+			if err != nil {
+				return fmt.Errorf("could not read proxy credentials: %w", err)
+			}
+			username, password, ok := strings.Cut(strings.TrimSpace(string(data)), ":")
+			if !ok || username == "" {
+				return fmt.Errorf("proxy credentials must have the format <username>:<password>")
+			}
+			proxyURL.User = url.UserPassword(username, password)
+		}
+
+		cfg.ProxyURL = proxyURL.String()
+		cfg.NoProxy = proxy.NoProxy
+	}
+
+	*httpConfig = cfg
+	return nil
+}
+
+// ApplyClientRateLimiter sets the given rate limiter configuration for the requests to the AWS API to the one of this
+// Config.
+func (c *Config) ApplyClientRateLimiter(rateLimiter **awsclient.RateLimiterConfig) {
+	cfg := c.Config.ClientRateLimiter
+	if cfg == nil {
+		return
+	}
+
+	rateLimiterConfig := &awsclient.RateLimiterConfig{
+		Limit: rate.Limit(cfg.QPS),
+		Burst: cfg.Burst,
+	}
+	if cfg.WaitTimeout != nil {
+		rateLimiterConfig.WaitTimeout = cfg.WaitTimeout.Duration
+	}
+	if cfg.MaxAttempts != nil {
+		rateLimiterConfig.MaxAttempts = *cfg.MaxAttempts
+	}
+	if cfg.MaxBackoff != nil {
+		rateLimiterConfig.MaxBackoff = cfg.MaxBackoff.Duration
+	}
+	*rateLimiter = rateLimiterConfig
+}
+
+// ApplyLeakDetection sets the given interval and deletion flag of the detection of orphaned AWS resources to those of
+// this Config.
+func (c *Config) ApplyLeakDetection(interval *time.Duration, deleteOrphans *bool) {
+	if c.Config.LeakDetection == nil {
+		return
+	}
+	*interval = c.Config.LeakDetection.Interval.Duration
+	*deleteOrphans = c.Config.LeakDetection.DeleteOrphans
+}
+
+// ApplyTagReconciliation sets the given interval of the correction of the tags of AWS resources to the one of this
+// Config.
+func (c *Config) ApplyTagReconciliation(interval *time.Duration) {
+	if c.Config.TagReconciliation == nil {
+		return
+	}
+	*interval = c.Config.TagReconciliation.Interval.Duration
+}
+
+// ApplyBastion sets the given configuration of the bastion hosts to the one of this Config.
+func (c *Config) ApplyBastion(cfg **config.BastionConfig) {
+	*cfg = c.Config.Bastion
+}
+
+// ApplyPrivateLink sets the given configuration of the exposure of kube-apiservers as VPC endpoint services to the one
+// of this Config.
+func (c *Config) ApplyPrivateLink(cfg **config.PrivateLinkConfig) {
+	*cfg = c.Config.PrivateLink
+}
+
+// ApplyTerraformer sets the given configuration of the Terraformer pods and the terraform state to the one of this
+// Config.
+func (c *Config) ApplyTerraformer(cfg **config.TerraformerConfig) {
+	*cfg = c.Config.Terraformer
+}
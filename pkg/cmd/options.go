@@ -39,14 +39,18 @@ import (
 	backupentrycontroller "github.com/gardener/gardener-extension-provider-aws/pkg/controller/backupentry"
 	bastioncontroller "github.com/gardener/gardener-extension-provider-aws/pkg/controller/bastion"
 	controlplanecontroller "github.com/gardener/gardener-extension-provider-aws/pkg/controller/controlplane"
+	credentialsrotationcontroller "github.com/gardener/gardener-extension-provider-aws/pkg/controller/credentialsrotation"
 	dnsrecordcontroller "github.com/gardener/gardener-extension-provider-aws/pkg/controller/dnsrecord"
 	healthcheckcontroller "github.com/gardener/gardener-extension-provider-aws/pkg/controller/healthcheck"
 	infrastructurecontroller "github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure"
+	leakdetectioncontroller "github.com/gardener/gardener-extension-provider-aws/pkg/controller/leakdetection"
+	tagreconciliationcontroller "github.com/gardener/gardener-extension-provider-aws/pkg/controller/tagreconciliation"
 	workercontroller "github.com/gardener/gardener-extension-provider-aws/pkg/controller/worker"
 	cloudproviderwebhook "github.com/gardener/gardener-extension-provider-aws/pkg/webhook/cloudprovider"
 	controlplanewebhook "github.com/gardener/gardener-extension-provider-aws/pkg/webhook/controlplane"
 	controlplaneexposurewebhook "github.com/gardener/gardener-extension-provider-aws/pkg/webhook/controlplaneexposure"
 	shootwebhook "github.com/gardener/gardener-extension-provider-aws/pkg/webhook/shoot"
+	terraformerwebhook "github.com/gardener/gardener-extension-provider-aws/pkg/webhook/terraformer"
 )
 
 const (
@@ -56,6 +60,12 @@ const (
 	ProviderClientBurstFlag = "provider-client-burst"
 	// ProviderClientWaitTimeoutFlag is the name of the command line flag to specify the client wait timeout for provider operations.
 	ProviderClientWaitTimeoutFlag = "provider-client-wait-timeout"
+	// ProviderClientZoneQPSFlag is the name of the command line flag to specify the client QPS for changes of a single hosted zone.
+	ProviderClientZoneQPSFlag = "provider-client-zone-qps"
+	// ProviderClientZoneBurstFlag is the name of the command line flag to specify the client burst for changes of a single hosted zone.
+	ProviderClientZoneBurstFlag = "provider-client-zone-burst"
+	// ProviderClientBatchWindowFlag is the name of the command line flag to specify the window for batching changes of a hosted zone.
+	ProviderClientBatchWindowFlag = "provider-client-batch-window"
 )
 
 // ControllerSwitchOptions are the controllercmd.SwitchOptions for the provider controllers.
@@ -68,6 +78,9 @@ func ControllerSwitchOptions() *controllercmd.SwitchOptions {
 		controllercmd.Switch(extensionsdnsrecordcontroller.ControllerName, dnsrecordcontroller.AddToManager),
 		controllercmd.Switch(extensionsinfrastructurecontroller.ControllerName, infrastructurecontroller.AddToManager),
 		controllercmd.Switch(extensionsworkercontroller.ControllerName, workercontroller.AddToManager),
+		controllercmd.Switch(credentialsrotationcontroller.ControllerName, credentialsrotationcontroller.AddToManager),
+		controllercmd.Switch(leakdetectioncontroller.ControllerName, leakdetectioncontroller.AddToManager),
+		controllercmd.Switch(tagreconciliationcontroller.ControllerName, tagreconciliationcontroller.AddToManager),
 		controllercmd.Switch(extensionshealthcheckcontroller.ControllerName, healthcheckcontroller.AddToManager),
 		controllercmd.Switch(extensionsheartbeatcontroller.ControllerName, extensionsheartbeatcontroller.AddToManager),
 	)
@@ -79,7 +92,9 @@ func WebhookSwitchOptions() *webhookcmd.SwitchOptions {
 		webhookcmd.Switch(extensioncontrolplanewebhook.WebhookName, controlplanewebhook.AddToManager),
 		webhookcmd.Switch(extensioncontrolplanewebhook.ExposureWebhookName, controlplaneexposurewebhook.AddToManager),
 		webhookcmd.Switch(extensionshootwebhook.WebhookName, shootwebhook.AddToManager),
+		webhookcmd.Switch(shootwebhook.ServiceWebhookName, shootwebhook.AddServiceWebhookToManager),
 		webhookcmd.Switch(extensionscloudproviderwebhook.WebhookName, cloudproviderwebhook.AddToManager),
+		webhookcmd.Switch(terraformerwebhook.WebhookName, terraformerwebhook.AddToManager),
 	)
 }
 
@@ -89,6 +104,9 @@ type DNSRecordControllerOptions struct {
 	ProviderClientQPS         float64
 	ProviderClientBurst       int
 	ProviderClientWaitTimeout time.Duration
+	ProviderClientZoneQPS     float64
+	ProviderClientZoneBurst   int
+	ProviderClientBatchWindow time.Duration
 
 	config *DNSRecordControllerConfig
 }
@@ -99,6 +117,9 @@ func (c *DNSRecordControllerOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.Float64Var(&c.ProviderClientQPS, ProviderClientQPSFlag, c.ProviderClientQPS, "The client QPS for provider operations.")
 	fs.IntVar(&c.ProviderClientBurst, ProviderClientBurstFlag, c.ProviderClientBurst, "The client burst for provider operations.")
 	fs.DurationVar(&c.ProviderClientWaitTimeout, ProviderClientWaitTimeoutFlag, c.ProviderClientWaitTimeout, "The client wait timeout for provider operations.")
+	fs.Float64Var(&c.ProviderClientZoneQPS, ProviderClientZoneQPSFlag, c.ProviderClientZoneQPS, "The client QPS for changes of a single hosted zone. If zero, changes are not limited per hosted zone.")
+	fs.IntVar(&c.ProviderClientZoneBurst, ProviderClientZoneBurstFlag, c.ProviderClientZoneBurst, "The client burst for changes of a single hosted zone.")
+	fs.DurationVar(&c.ProviderClientBatchWindow, ProviderClientBatchWindowFlag, c.ProviderClientBatchWindow, "The window for batching changes of the same hosted zone into one request. If zero, changes are not batched.")
 }
 
 // Complete implements Completer.Complete.
@@ -111,6 +132,9 @@ func (c *DNSRecordControllerOptions) Complete() error {
 		ProviderClientQPS:         rate.Limit(c.ProviderClientQPS),
 		ProviderClientBurst:       c.ProviderClientBurst,
 		ProviderClientWaitTimeout: c.ProviderClientWaitTimeout,
+		ProviderClientZoneQPS:     rate.Limit(c.ProviderClientZoneQPS),
+		ProviderClientZoneBurst:   c.ProviderClientZoneBurst,
+		ProviderClientBatchWindow: c.ProviderClientBatchWindow,
 	}
 	return nil
 }
@@ -126,6 +150,9 @@ type DNSRecordControllerConfig struct {
 	ProviderClientQPS         rate.Limit
 	ProviderClientBurst       int
 	ProviderClientWaitTimeout time.Duration
+	ProviderClientZoneQPS     rate.Limit
+	ProviderClientZoneBurst   int
+	ProviderClientBatchWindow time.Duration
 }
 
 // Apply sets the values of this DNSRecordControllerConfig in the given controller.Options.
@@ -138,6 +165,9 @@ func (c *DNSRecordControllerConfig) ApplyRateLimiter(opts *dnsrecordcontroller.R
 	opts.Limit = c.ProviderClientQPS
 	opts.Burst = c.ProviderClientBurst
 	opts.WaitTimeout = c.ProviderClientWaitTimeout
+	opts.ZoneLimit = c.ProviderClientZoneQPS
+	opts.ZoneBurst = c.ProviderClientZoneBurst
+	opts.BatchWindow = c.ProviderClientBatchWindow
 }
 
 // Options initializes empty controller.Options, applies the set values and returns it.
@@ -16,43 +16,199 @@ package backupbucket
 
 import (
 	"context"
+	"fmt"
 
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/gardener/gardener/extensions/pkg/controller/backupbucket"
-	"github.com/gardener/gardener/extensions/pkg/util"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencorev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
-	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/validation"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 )
 
 type actuator struct {
 	backupbucket.Actuator
-	client client.Client
+	client  client.Client
+	decoder runtime.Decoder
 }
 
 func newActuator(mgr manager.Manager) backupbucket.Actuator {
 	return &actuator{
-		client: mgr.GetClient(),
+		client:  mgr.GetClient(),
+		decoder: serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
 	}
 }
 
-func (a *actuator) Reconcile(ctx context.Context, _ logr.Logger, bb *extensionsv1alpha1.BackupBucket) error {
-	awsClient, err := aws.NewClientFromSecretRef(ctx, a.client, bb.Spec.SecretRef, bb.Spec.Region)
+func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, bb *extensionsv1alpha1.BackupBucket) error {
+	config, err := a.decodeBackupBucketConfig(bb)
 	if err != nil {
-		return util.DetermineError(err, helper.KnownCodes)
+		return err
 	}
 
-	return util.DetermineError(awsClient.CreateBucketIfNotExists(ctx, bb.Name, bb.Spec.Region), helper.KnownCodes)
+	if config.Replication != nil && config.Replication.DestinationRegion == bb.Spec.Region {
+		return gardencorev1beta1helper.NewErrorWithCodes(fmt.Errorf("destination region of replication must differ from region %s of the bucket", bb.Spec.Region), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+
+	if config.AccessLogging != nil && config.AccessLogging.TargetBucket == bb.Name {
+		return gardencorev1beta1helper.NewErrorWithCodes(fmt.Errorf("access logs of bucket %s must not be delivered to itself", bb.Name), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+
+	awsClient, err := a.newAWSClient(ctx, bb, bb.Spec.Region)
+	if err != nil {
+		return aws.DetermineError(err)
+	}
+
+	// Validate the changes of the object lock configuration against the one of the existing bucket, as object lock
+	// cannot be disabled and its compliance mode must not be weakened.
+	current, err := awsClient.GetBucketObjectLockConfiguration(ctx, bb.Name)
+	if err != nil {
+		return aws.DetermineError(fmt.Errorf("could not get object lock configuration of bucket %s: %w", bb.Name, err))
+	}
+	if errs := validation.ValidateBackupBucketConfigUpdate(toBackupBucketConfig(current), config, field.NewPath("spec", "providerConfig")); len(errs) > 0 {
+		return gardencorev1beta1helper.NewErrorWithCodes(fmt.Errorf("invalid provider config: %w", errs.ToAggregate()), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+
+	objectLock := toObjectLockConfiguration(config)
+	if objectLock != nil && (current == nil || *current != *objectLock) {
+		log.Info("Configuring object lock of bucket", "bucket", bb.Name, "mode", objectLock.Mode, "days", objectLock.Days)
+	}
+	bucketConfig := &awsclient.BucketConfiguration{
+		// Replication requires versioning of the bucket.
+		Versioning: config.Replication != nil,
+		ObjectLock: objectLock,
+		Encryption: toBucketEncryption(config),
+		Lifecycle:  toBucketLifecycle(config),
+	}
+	if config.Hardening != nil {
+		bucketConfig.MinimumTLSVersion = pointer.StringDeref(config.Hardening.MinimumTLSVersion, "")
+		bucketConfig.BucketOwnerEnforced = pointer.BoolDeref(config.Hardening.BucketOwnerEnforced, false)
+	}
+	if err := awsClient.CreateBucketIfNotExists(ctx, bb.Name, bb.Spec.Region, bucketConfig); err != nil {
+		return aws.DetermineError(err)
+	}
+
+	if err := awsClient.PutBucketAccessLogging(ctx, bb.Name, toBucketAccessLogging(bb.Name, config)); err != nil {
+		return aws.DetermineError(fmt.Errorf("could not configure access logging of bucket %s: %w", bb.Name, err))
+	}
+
+	return aws.DetermineError(a.reconcileReplication(ctx, log, awsClient, bb, config))
 }
 
 func (a *actuator) Delete(ctx context.Context, _ logr.Logger, bb *extensionsv1alpha1.BackupBucket) error {
-	awsClient, err := aws.NewClientFromSecretRef(ctx, a.client, bb.Spec.SecretRef, bb.Spec.Region)
+	config, err := a.decodeBackupBucketConfig(bb)
+	if err != nil {
+		return err
+	}
+
+	awsClient, err := a.newAWSClient(ctx, bb, bb.Spec.Region)
+	if err != nil {
+		return aws.DetermineError(err)
+	}
+
+	if err := awsClient.DeleteBucketIfExists(ctx, bb.Name); err != nil {
+		return aws.DetermineError(err)
+	}
+	// The destination bucket of the replication is kept, as it is meant to survive the loss of the bucket.
+	if config.Replication != nil {
+		return aws.DetermineError(deleteReplicationRole(ctx, awsClient, replicationRoleName(bb.Name)))
+	}
+	return nil
+}
+
+// decodeBackupBucketConfig decodes and validates the provider config of the given BackupBucket.
+func (a *actuator) decodeBackupBucketConfig(bb *extensionsv1alpha1.BackupBucket) (*awsapi.BackupBucketConfig, error) {
+	config := &awsapi.BackupBucketConfig{}
+	if bb.Spec.ProviderConfig == nil || bb.Spec.ProviderConfig.Raw == nil {
+		return config, nil
+	}
+
+	if _, _, err := a.decoder.Decode(bb.Spec.ProviderConfig.Raw, nil, config); err != nil {
+		return nil, gardencorev1beta1helper.NewErrorWithCodes(fmt.Errorf("could not decode provider config: %w", err), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+	if errs := validation.ValidateBackupBucketConfig(config, field.NewPath("spec", "providerConfig")); len(errs) > 0 {
+		return nil, gardencorev1beta1helper.NewErrorWithCodes(fmt.Errorf("invalid provider config: %w", errs.ToAggregate()), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+	return config, nil
+}
+
+func toObjectLockConfiguration(config *awsapi.BackupBucketConfig) *awsclient.ObjectLockConfiguration {
+	if config.ObjectLock == nil {
+		return nil
+	}
+	return &awsclient.ObjectLockConfiguration{
+		Mode: s3types.ObjectLockRetentionMode(config.ObjectLock.Mode),
+		Days: config.ObjectLock.RetentionDays,
+	}
+}
+
+func toBucketEncryption(config *awsapi.BackupBucketConfig) *awsclient.BucketEncryption {
+	if config.Encryption == nil {
+		return nil
+	}
+	return &awsclient.BucketEncryption{
+		KMSKeyARN:        config.Encryption.KMSKeyARN,
+		BucketKeyEnabled: pointer.BoolDeref(config.Encryption.BucketKeyEnabled, true),
+	}
+}
+
+func toBucketLifecycle(config *awsapi.BackupBucketConfig) *awsclient.BucketLifecycle {
+	if config.Lifecycle == nil {
+		return nil
+	}
+	lifecycle := &awsclient.BucketLifecycle{
+		ExpirationDays:                     config.Lifecycle.ExpirationDays,
+		AbortIncompleteMultipartUploadDays: pointer.Int32Deref(config.Lifecycle.AbortIncompleteMultipartUploadDays, 0),
+	}
+	for _, transition := range config.Lifecycle.Transitions {
+		lifecycle.Transitions = append(lifecycle.Transitions, awsclient.BucketLifecycleTransition{
+			Days:         transition.Days,
+			StorageClass: s3types.TransitionStorageClass(transition.StorageClass),
+		})
+	}
+	return lifecycle
+}
+
+func toBucketAccessLogging(bucket string, config *awsapi.BackupBucketConfig) *awsclient.BucketAccessLogging {
+	if config.AccessLogging == nil {
+		return nil
+	}
+	return &awsclient.BucketAccessLogging{
+		TargetBucket: config.AccessLogging.TargetBucket,
+		TargetPrefix: pointer.StringDeref(config.AccessLogging.TargetPrefix, bucket+"/"),
+	}
+}
+
+func toBackupBucketConfig(objectLock *awsclient.ObjectLockConfiguration) *awsapi.BackupBucketConfig {
+	config := &awsapi.BackupBucketConfig{}
+	if objectLock != nil {
+		config.ObjectLock = &awsapi.ObjectLockConfig{
+			Mode:          awsapi.ObjectLockMode(objectLock.Mode),
+			RetentionDays: objectLock.Days,
+		}
+	}
+	return config
+}
+
+// newAWSClient creates an AWS client for the given region using the credentials of the secret of the given backup
+// bucket.
+func (a *actuator) newAWSClient(ctx context.Context, bb *extensionsv1alpha1.BackupBucket, region string) (awsclient.Interface, error) {
+	credentials, err := aws.GetCredentialsFromSecretRef(ctx, a.client, bb.Spec.SecretRef, false)
 	if err != nil {
-		return util.DetermineError(err, helper.KnownCodes)
+		return nil, err
 	}
 
-	return util.DetermineError(awsClient.DeleteBucketIfExists(ctx, bb.Name), helper.KnownCodes)
+	authConfig := aws.NewAuthConfig(credentials, region)
+	authConfig.Controller = backupbucket.ControllerName
+	return awsclient.NewClient(authConfig)
 }
@@ -0,0 +1,212 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupbucket
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	"k8s.io/utils/pointer"
+
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+const (
+	// replicationRolePolicyName is the name of the inline policy of the IAM role which is assumed by S3 to replicate
+	// the objects of a bucket.
+	replicationRolePolicyName = "replication"
+	// maxIAMRoleNameLength is the maximum length of the names of IAM roles.
+	maxIAMRoleNameLength = 64
+)
+
+// reconcileReplication configures the replication of the given backup bucket to the destination bucket of the given
+// replication config, which is created if it does not exist. If replication is not configured, an existing
+// replication configuration and its IAM role are removed.
+func (a *actuator) reconcileReplication(ctx context.Context, log logr.Logger, awsClient awsclient.Interface, bb *extensionsv1alpha1.BackupBucket, config *awsapi.BackupBucketConfig) error {
+	if config.Replication == nil {
+		current, err := awsClient.GetBucketReplication(ctx, bb.Name)
+		if err != nil {
+			return fmt.Errorf("could not get replication configuration of bucket %s: %w", bb.Name, err)
+		}
+		if current == nil {
+			return nil
+		}
+
+		log.Info("Removing replication configuration of bucket", "bucket", bb.Name)
+		if err := awsClient.DeleteBucketReplication(ctx, bb.Name); err != nil {
+			return fmt.Errorf("could not delete replication configuration of bucket %s: %w", bb.Name, err)
+		}
+		return deleteReplicationRole(ctx, awsClient, current.RoleARN[strings.LastIndex(current.RoleARN, "/")+1:])
+	}
+
+	replication := config.Replication
+	partition := awsclient.PartitionForRegion(bb.Spec.Region)
+
+	destinationClient, err := a.newAWSClient(ctx, bb, replication.DestinationRegion)
+	if err != nil {
+		return err
+	}
+	var destinationEncryption *awsclient.BucketEncryption
+	if replication.KMSKeyARN != nil {
+		destinationEncryption = &awsclient.BucketEncryption{
+			KMSKeyARN:        *replication.KMSKeyARN,
+			BucketKeyEnabled: true,
+		}
+	}
+	if err := destinationClient.CreateBucketIfNotExists(ctx, replication.DestinationBucket, replication.DestinationRegion, &awsclient.BucketConfiguration{
+		Versioning: true,
+		Encryption: destinationEncryption,
+	}); err != nil {
+		return fmt.Errorf("could not create destination bucket %s: %w", replication.DestinationBucket, err)
+	}
+
+	roleName := replicationRoleName(bb.Name)
+	role, err := awsClient.GetIAMRole(ctx, roleName)
+	if err != nil {
+		return err
+	}
+	if role == nil {
+		log.Info("Creating IAM role for replication", "roleName", roleName)
+		if role, err = awsClient.CreateIAMRole(ctx, &awsclient.IAMRole{
+			RoleName:                 roleName,
+			Path:                     "/",
+			AssumeRolePolicyDocument: fmt.Sprintf(assumeRolePolicyTemplate, awsclient.ServicePrincipal(partition, "s3")),
+		}); err != nil {
+			return err
+		}
+	}
+
+	var sourceKMSKeyARN *string
+	if config.Encryption != nil {
+		sourceKMSKeyARN = &config.Encryption.KMSKeyARN
+	}
+	policyDocument, err := replicationRolePolicyDocument(partition, bb.Name, replication.DestinationBucket, sourceKMSKeyARN, replication.KMSKeyARN)
+	if err != nil {
+		return err
+	}
+	if err := awsClient.PutIAMRolePolicy(ctx, &awsclient.IAMRolePolicy{
+		PolicyName:     replicationRolePolicyName,
+		RoleName:       roleName,
+		PolicyDocument: policyDocument,
+	}); err != nil {
+		return err
+	}
+
+	desired := &awsclient.BucketReplication{
+		RoleARN:              role.ARN,
+		DestinationBucketARN: awsclient.ARN(partition, "s3", "", "", replication.DestinationBucket),
+		ReplicaKMSKeyARN:     replication.KMSKeyARN,
+		ReplicateDeletes:     pointer.BoolDeref(replication.ReplicateDeletes, false),
+	}
+	current, err := awsClient.GetBucketReplication(ctx, bb.Name)
+	if err != nil {
+		return fmt.Errorf("could not get replication configuration of bucket %s: %w", bb.Name, err)
+	}
+	if current != nil && current.RoleARN == desired.RoleARN && current.DestinationBucketARN == desired.DestinationBucketARN &&
+		pointer.StringEqual(current.ReplicaKMSKeyARN, desired.ReplicaKMSKeyARN) && current.ReplicateDeletes == desired.ReplicateDeletes {
+		return nil
+	}
+
+	log.Info("Configuring replication of bucket", "bucket", bb.Name, "destinationBucket", replication.DestinationBucket, "destinationRegion", replication.DestinationRegion)
+	if err := awsClient.PutBucketReplication(ctx, bb.Name, desired); err != nil {
+		return fmt.Errorf("could not configure replication of bucket %s: %w", bb.Name, err)
+	}
+	return nil
+}
+
+// deleteReplicationRole deletes the IAM role with the given name together with its inline policy.
+func deleteReplicationRole(ctx context.Context, awsClient awsclient.Interface, roleName string) error {
+	if err := awsClient.DeleteIAMRolePolicy(ctx, replicationRolePolicyName, roleName); err != nil {
+		return err
+	}
+	return awsClient.DeleteIAMRole(ctx, roleName)
+}
+
+// replicationRoleName returns the name of the IAM role which is used for the replication of the bucket with the given
+// name. Names exceeding the maximum length of IAM role names are shortened with a hash suffix.
+func replicationRoleName(bucket string) string {
+	name := bucket + "-replication"
+	if len(name) <= maxIAMRoleNameLength {
+		return name
+	}
+	hash := sha256.Sum256([]byte(name))
+	suffix := "-" + hex.EncodeToString(hash[:])[:8]
+	return name[:maxIAMRoleNameLength-len(suffix)] + suffix
+}
+
+// replicationRolePolicyDocument returns the policy which allows S3 to replicate the objects of the source bucket to the
+// destination bucket. If the objects are encrypted with KMS keys, the usage of the keys is allowed as well.
+func replicationRolePolicyDocument(partition, sourceBucket, destinationBucket string, sourceKMSKeyARN, destinationKMSKeyARN *string) (string, error) {
+	statements := []map[string]interface{}{
+		{
+			"Effect":   "Allow",
+			"Action":   []string{"s3:GetReplicationConfiguration", "s3:ListBucket"},
+			"Resource": awsclient.ARN(partition, "s3", "", "", sourceBucket),
+		},
+		{
+			"Effect":   "Allow",
+			"Action":   []string{"s3:GetObjectVersionForReplication", "s3:GetObjectVersionAcl", "s3:GetObjectVersionTagging"},
+			"Resource": awsclient.ARN(partition, "s3", "", "", sourceBucket+"/*"),
+		},
+		{
+			"Effect":   "Allow",
+			"Action":   []string{"s3:ReplicateObject", "s3:ReplicateDelete", "s3:ReplicateTags"},
+			"Resource": awsclient.ARN(partition, "s3", "", "", destinationBucket+"/*"),
+		},
+	}
+	if sourceKMSKeyARN != nil {
+		statements = append(statements, map[string]interface{}{
+			"Effect":   "Allow",
+			"Action":   "kms:Decrypt",
+			"Resource": *sourceKMSKeyARN,
+		})
+	}
+	if destinationKMSKeyARN != nil {
+		statements = append(statements, map[string]interface{}{
+			"Effect":   "Allow",
+			"Action":   "kms:Encrypt",
+			"Resource": *destinationKMSKeyARN,
+		})
+	}
+
+	document, err := json.Marshal(map[string]interface{}{
+		"Version":   "2012-10-17",
+		"Statement": statements,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(document), nil
+}
+
+const assumeRolePolicyTemplate = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "%s"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}`
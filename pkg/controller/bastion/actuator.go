@@ -18,8 +18,8 @@ import (
 	"context"
 	"fmt"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ec2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/gardener/gardener/extensions/pkg/controller/bastion"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -27,10 +27,17 @@ import (
 	"github.com/gardener/gardener/pkg/utils/kubernetes"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	api "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/validation"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
 	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 )
@@ -38,6 +45,8 @@ import (
 const (
 	// SSHPort is the default SSH port.
 	SSHPort = 22
+	// HTTPSPort is the default HTTPS port.
+	HTTPSPort = 443
 	// InstanceStateShuttingDown is the AWS status code for an EC2 instance that
 	// is currently shutting down.
 	InstanceStateShuttingDown = 32
@@ -46,19 +55,74 @@ const (
 	InstanceStateTerminated = 48
 )
 
+const (
+	sessionManagerAssumeRolePolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "ec2.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}`
+	// sessionManagerRolePolicy contains the permissions of the AWS managed policy AmazonSSMManagedInstanceCore which
+	// are required for Session Manager connections.
+	sessionManagerRolePolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "ssm:UpdateInstanceInformation",
+        "ssmmessages:CreateControlChannel",
+        "ssmmessages:CreateDataChannel",
+        "ssmmessages:OpenControlChannel",
+        "ssmmessages:OpenDataChannel"
+      ],
+      "Resource": "*"
+    }
+  ]
+}`
+)
+
 type actuator struct {
-	client client.Client
+	client  client.Client
+	decoder runtime.Decoder
+	config  *config.BastionConfig
+	clock   clock.Clock
 }
 
-func newActuator(mgr manager.Manager) bastion.Actuator {
+func newActuator(mgr manager.Manager, config *config.BastionConfig) *actuator {
 	return &actuator{
-		client: mgr.GetClient(),
+		client:  mgr.GetClient(),
+		decoder: serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
+		config:  config,
+		clock:   clock.RealClock{},
+	}
+}
+
+// decodeBastionConfig decodes and validates the provider config of the given Bastion.
+func (a *actuator) decodeBastionConfig(b *extensionsv1alpha1.Bastion) (*api.BastionConfig, error) {
+	bastionConfig := &api.BastionConfig{}
+	if b.Spec.ProviderConfig == nil || b.Spec.ProviderConfig.Raw == nil {
+		return bastionConfig, nil
+	}
+
+	if _, _, err := a.decoder.Decode(b.Spec.ProviderConfig.Raw, nil, bastionConfig); err != nil {
+		return nil, fmt.Errorf("could not decode provider config: %w", err)
+	}
+	if errs := validation.ValidateBastionConfig(bastionConfig, field.NewPath("spec", "providerConfig")); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid provider config: %w", errs.ToAggregate())
 	}
+	return bastionConfig, nil
 }
 
-func (a *actuator) getAWSClient(ctx context.Context, bastion *extensionsv1alpha1.Bastion, shoot *gardencorev1beta1.Shoot) (*awsclient.Client, error) {
+func (a *actuator) getAWSClient(ctx context.Context, b *extensionsv1alpha1.Bastion, shoot *gardencorev1beta1.Shoot) (*awsclient.Client, error) {
 	secret := &corev1.Secret{}
-	key := kubernetes.Key(bastion.Namespace, v1beta1constants.SecretNameCloudProvider)
+	key := kubernetes.Key(b.Namespace, v1beta1constants.SecretNameCloudProvider)
 
 	if err := a.client.Get(ctx, key, secret); err != nil {
 		return nil, fmt.Errorf("failed to find %q Secret: %w", v1beta1constants.SecretNameCloudProvider, err)
@@ -69,16 +133,19 @@ func (a *actuator) getAWSClient(ctx context.Context, bastion *extensionsv1alpha1
 		return nil, fmt.Errorf("failed to read credentials Secret: %w", err)
 	}
 
-	return awsclient.NewClient(string(credentials.AccessKeyID), string(credentials.SecretAccessKey), shoot.Spec.Region)
+	authConfig := aws.NewAuthConfig(credentials, shoot.Spec.Region)
+	authConfig.Controller = bastion.ControllerName
+	authConfig.Shoot = b.Namespace
+	return awsclient.NewClient(authConfig)
 }
 
 // securityGroupHasPermissions checks if the given group has at least
 // the desired permission, but possibly more. Comments on IP ranges
 // are not considered when comparing current and desired states.
-func securityGroupHasPermissions(current []*ec2.IpPermission, desired *ec2.IpPermission) bool {
+func securityGroupHasPermissions(current []ec2types.IpPermission, desired *ec2types.IpPermission) bool {
 	// find a matching permission in the security group
 	for _, perm := range current {
-		if ipPermissionsEqual(perm, desired) {
+		if ipPermissionsEqual(&perm, desired) {
 			return true
 		}
 	}
@@ -87,7 +154,7 @@ func securityGroupHasPermissions(current []*ec2.IpPermission, desired *ec2.IpPer
 	return false
 }
 
-func ipPermissionsEqual(a *ec2.IpPermission, b *ec2.IpPermission) bool {
+func ipPermissionsEqual(a *ec2types.IpPermission, b *ec2types.IpPermission) bool {
 	// ports must match
 	if !equality.Semantic.DeepEqual(a.FromPort, b.FromPort) || !equality.Semantic.DeepEqual(a.ToPort, b.ToPort) {
 		return false
@@ -122,7 +189,7 @@ func ipPermissionsEqual(a *ec2.IpPermission, b *ec2.IpPermission) bool {
 	return bGroups.IsSuperset(aGroups)
 }
 
-func getIpRangeCidrs(ipRanges []*ec2.IpRange) sets.Set[string] {
+func getIpRangeCidrs(ipRanges []ec2types.IpRange) sets.Set[string] {
 	result := sets.New[string]()
 	for _, ipRange := range ipRanges {
 		result.Insert(*ipRange.CidrIp)
@@ -130,7 +197,7 @@ func getIpRangeCidrs(ipRanges []*ec2.IpRange) sets.Set[string] {
 	return result
 }
 
-func getIpv6RangeCidrs(ipRanges []*ec2.Ipv6Range) sets.Set[string] {
+func getIpv6RangeCidrs(ipRanges []ec2types.Ipv6Range) sets.Set[string] {
 	result := sets.New[string]()
 	for _, ipRange := range ipRanges {
 		result.Insert(*ipRange.CidrIpv6)
@@ -138,7 +205,7 @@ func getIpv6RangeCidrs(ipRanges []*ec2.Ipv6Range) sets.Set[string] {
 	return result
 }
 
-func getSecurityGroupIDs(userGroupPairs []*ec2.UserIdGroupPair) sets.Set[string] {
+func getSecurityGroupIDs(userGroupPairs []ec2types.UserIdGroupPair) sets.Set[string] {
 	result := sets.New[string]()
 	for _, pair := range userGroupPairs {
 		result.Insert(*pair.GroupId)
@@ -148,12 +215,12 @@ func getSecurityGroupIDs(userGroupPairs []*ec2.UserIdGroupPair) sets.Set[string]
 
 // workerSecurityGroupPermission returns the set of permissions that need to be added
 // to the worker security group to allow SSH ingress from the bastion instance.
-func workerSecurityGroupPermission(opt *Options) *ec2.IpPermission {
-	return &ec2.IpPermission{
+func workerSecurityGroupPermission(opt *Options) *ec2types.IpPermission {
+	return &ec2types.IpPermission{
 		IpProtocol: awssdk.String("tcp"),
-		FromPort:   awssdk.Int64(SSHPort),
-		ToPort:     awssdk.Int64(SSHPort),
-		UserIdGroupPairs: []*ec2.UserIdGroupPair{
+		FromPort:   awssdk.Int32(SSHPort),
+		ToPort:     awssdk.Int32(SSHPort),
+		UserIdGroupPairs: []ec2types.UserIdGroupPair{
 			{
 				GroupId: awssdk.String(opt.BastionSecurityGroupID),
 			},
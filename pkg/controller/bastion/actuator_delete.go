@@ -19,33 +19,38 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/gardener/gardener/extensions/pkg/controller"
-	"github.com/gardener/gardener/extensions/pkg/util"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
 	"github.com/go-logr/logr"
 
-	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	provideraws "github.com/gardener/gardener-extension-provider-aws/pkg/aws"
 	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 )
 
 func (a *actuator) Delete(ctx context.Context, log logr.Logger, bastion *extensionsv1alpha1.Bastion, cluster *controller.Cluster) error {
 	awsClient, err := a.getAWSClient(ctx, bastion, cluster.Shoot)
 	if err != nil {
-		return util.DetermineError(fmt.Errorf("failed to create AWS client: %w", err), helper.KnownCodes)
+		return provideraws.DetermineError(fmt.Errorf("failed to create AWS client: %w", err))
 	}
 
-	opt, err := DetermineOptions(ctx, bastion, cluster, awsClient)
+	bastionConfig, err := a.decodeBastionConfig(bastion)
 	if err != nil {
-		return util.DetermineError(fmt.Errorf("failed to setup AWS client options: %w", err), helper.KnownCodes)
+		return err
+	}
+
+	opt, err := DetermineOptions(ctx, bastion, cluster, awsClient, a.config, bastionConfig)
+	if err != nil {
+		return provideraws.DetermineError(fmt.Errorf("failed to setup AWS client options: %w", err))
 	}
 
 	// resolve security group name to its ID
 	group, err := getSecurityGroup(ctx, awsClient, opt.VPCID, opt.BastionSecurityGroupName)
 	if err != nil {
-		return util.DetermineError(fmt.Errorf("failed to list security groups: %w", err), helper.KnownCodes)
+		return provideraws.DetermineError(fmt.Errorf("failed to list security groups: %w", err))
 	}
 
 	// if the security group still exists, remove it from the worker's security group
@@ -53,17 +58,17 @@ func (a *actuator) Delete(ctx context.Context, log logr.Logger, bastion *extensi
 		opt.BastionSecurityGroupID = *group.GroupId
 
 		if err := removeWorkerPermissions(ctx, log, awsClient, opt); err != nil {
-			return util.DetermineError(fmt.Errorf("failed to remove bastion host from worker security group: %w", err), helper.KnownCodes)
+			return provideraws.DetermineError(fmt.Errorf("failed to remove bastion host from worker security group: %w", err))
 		}
 	}
 
 	if err := removeBastionInstance(ctx, log, awsClient, opt); err != nil {
-		return util.DetermineError(fmt.Errorf("failed to remove bastion instance: %w", err), helper.KnownCodes)
+		return provideraws.DetermineError(fmt.Errorf("failed to remove bastion instance: %w", err))
 	}
 
 	terminated, err := instanceIsTerminated(ctx, awsClient, opt)
 	if err != nil {
-		return util.DetermineError(fmt.Errorf("failed to check for bastion instance: %w", err), helper.KnownCodes)
+		return provideraws.DetermineError(fmt.Errorf("failed to check for bastion instance: %w", err))
 	}
 
 	if !terminated {
@@ -73,8 +78,14 @@ func (a *actuator) Delete(ctx context.Context, log logr.Logger, bastion *extensi
 		}
 	}
 
+	// the instance profile is removed regardless of the current mode, as the
+	// mode might have changed since the instance was created
+	if err := removeSessionManagerInstanceProfile(ctx, log, awsClient, opt); err != nil {
+		return provideraws.DetermineError(fmt.Errorf("failed to remove instance profile: %w", err))
+	}
+
 	if err := removeSecurityGroup(ctx, log, awsClient, opt); err != nil {
-		return util.DetermineError(fmt.Errorf("failed to remove security group: %w", err), helper.KnownCodes)
+		return provideraws.DetermineError(fmt.Errorf("failed to remove security group: %w", err))
 	}
 
 	return nil
@@ -100,9 +111,9 @@ func removeWorkerPermissions(ctx context.Context, logger logr.Logger, awsClient
 	if securityGroupHasPermissions(workerSecurityGroup.IpPermissions, permission) {
 		logger.Info("Removing SSH ingress from worker nodes")
 
-		_, err = awsClient.EC2.RevokeSecurityGroupIngressWithContext(ctx, &ec2.RevokeSecurityGroupIngressInput{
+		_, err = awsClient.EC2.RevokeSecurityGroupIngress(ctx, &ec2.RevokeSecurityGroupIngressInput{
 			GroupId:       aws.String(opt.WorkerSecurityGroupID),
-			IpPermissions: []*ec2.IpPermission{permission},
+			IpPermissions: []ec2types.IpPermission{*permission},
 		})
 	}
 
@@ -111,11 +122,11 @@ func removeWorkerPermissions(ctx context.Context, logger logr.Logger, awsClient
 
 // instanceIsTerminated returns true if a machine is in Terminated state.
 func instanceIsTerminated(ctx context.Context, awsClient *awsclient.Client, opt *Options) (bool, error) {
-	instances, err := awsClient.EC2.DescribeInstancesWithContext(ctx, &ec2.DescribeInstancesInput{
-		Filters: []*ec2.Filter{
+	instances, err := awsClient.EC2.DescribeInstances(ctx, &ec2.DescribeInstancesInput{
+		Filters: []ec2types.Filter{
 			{
 				Name:   aws.String("tag:Name"),
-				Values: []*string{aws.String(opt.InstanceName)},
+				Values: []string{opt.InstanceName},
 			},
 		},
 	})
@@ -135,10 +146,10 @@ func instanceIsTerminated(ctx context.Context, awsClient *awsclient.Client, opt
 }
 
 func removeBastionInstance(ctx context.Context, logger logr.Logger, awsClient *awsclient.Client, opt *Options) error {
-	instance, err := getFirstMatchingInstance(ctx, awsClient, []*ec2.Filter{
+	instance, err := getFirstMatchingInstance(ctx, awsClient, []ec2types.Filter{
 		{
 			Name:   aws.String("tag:Name"),
-			Values: []*string{aws.String(opt.InstanceName)},
+			Values: []string{opt.InstanceName},
 		},
 	})
 	if err != nil {
@@ -152,8 +163,8 @@ func removeBastionInstance(ctx context.Context, logger logr.Logger, awsClient *a
 
 	logger.Info("Terminating bastion instance")
 
-	_, err = awsClient.EC2.TerminateInstancesWithContext(ctx, &ec2.TerminateInstancesInput{
-		InstanceIds: aws.StringSlice([]string{*instance.InstanceId}),
+	_, err = awsClient.EC2.TerminateInstances(ctx, &ec2.TerminateInstancesInput{
+		InstanceIds: []string{*instance.InstanceId},
 	})
 	if err != nil {
 		return fmt.Errorf("failed to terminate instance: %w", err)
@@ -162,6 +173,44 @@ func removeBastionInstance(ctx context.Context, logger logr.Logger, awsClient *a
 	return nil
 }
 
+func removeSessionManagerInstanceProfile(ctx context.Context, logger logr.Logger, awsClient *awsclient.Client, opt *Options) error {
+	profile, err := awsClient.GetIAMInstanceProfile(ctx, opt.InstanceProfileName)
+	if err != nil {
+		return fmt.Errorf("failed to get IAM instance profile: %w", err)
+	}
+	if profile != nil {
+		logger.Info("Removing IAM instance profile for Session Manager")
+
+		if profile.RoleName != "" {
+			if err := awsClient.RemoveRoleFromIAMInstanceProfile(ctx, opt.InstanceProfileName, profile.RoleName); err != nil {
+				return fmt.Errorf("failed to remove IAM role from instance profile: %w", err)
+			}
+		}
+		if err := awsClient.DeleteIAMInstanceProfile(ctx, opt.InstanceProfileName); err != nil {
+			return fmt.Errorf("failed to remove IAM instance profile: %w", err)
+		}
+	}
+
+	role, err := awsClient.GetIAMRole(ctx, opt.InstanceProfileName)
+	if err != nil {
+		return fmt.Errorf("failed to get IAM role: %w", err)
+	}
+	if role == nil {
+		return nil
+	}
+
+	logger.Info("Removing IAM role for Session Manager")
+
+	if err := awsClient.DeleteIAMRolePolicy(ctx, opt.InstanceProfileName, opt.InstanceProfileName); err != nil {
+		return fmt.Errorf("failed to remove IAM role policy: %w", err)
+	}
+	if err := awsClient.DeleteIAMRole(ctx, opt.InstanceProfileName); err != nil {
+		return fmt.Errorf("failed to remove IAM role: %w", err)
+	}
+
+	return nil
+}
+
 func removeSecurityGroup(ctx context.Context, logger logr.Logger, awsClient *awsclient.Client, opt *Options) error {
 	group, err := getSecurityGroup(ctx, awsClient, opt.VPCID, opt.BastionSecurityGroupName)
 	if err != nil {
@@ -175,7 +224,7 @@ func removeSecurityGroup(ctx context.Context, logger logr.Logger, awsClient *aws
 
 	logger.Info("Removing security group")
 
-	_, err = awsClient.EC2.DeleteSecurityGroupWithContext(ctx, &ec2.DeleteSecurityGroupInput{
+	_, err = awsClient.EC2.DeleteSecurityGroup(ctx, &ec2.DeleteSecurityGroupInput{
 		GroupId: group.GroupId,
 	})
 	if err != nil {
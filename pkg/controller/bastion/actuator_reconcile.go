@@ -21,47 +21,67 @@ import (
 	"net"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/gardener/gardener/extensions/pkg/controller"
-	"github.com/gardener/gardener/extensions/pkg/util"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
-	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	provideraws "github.com/gardener/gardener-extension-provider-aws/pkg/aws"
 	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 )
 
 func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, bastion *extensionsv1alpha1.Bastion, cluster *controller.Cluster) error {
+	// the access to bastion hosts which have exceeded their maximum lifetime is not granted again
+	if a.expired(bastion) {
+		if err := a.revokeAccess(ctx, log, bastion, cluster); err != nil {
+			return err
+		}
+		return reconcile.TerminalError(fmt.Errorf("bastion has exceeded its maximum lifetime of %s", a.config.MaxLifetime.Duration))
+	}
+
 	awsClient, err := a.getAWSClient(ctx, bastion, cluster.Shoot)
 	if err != nil {
-		return util.DetermineError(fmt.Errorf("failed to create AWS client: %w", err), helper.KnownCodes)
+		return provideraws.DetermineError(fmt.Errorf("failed to create AWS client: %w", err))
 	}
 
-	opt, err := DetermineOptions(ctx, bastion, cluster, awsClient)
+	bastionConfig, err := a.decodeBastionConfig(bastion)
 	if err != nil {
-		return util.DetermineError(fmt.Errorf("failed to setup AWS client options: %w", err), helper.KnownCodes)
+		return err
+	}
+
+	opt, err := DetermineOptions(ctx, bastion, cluster, awsClient, a.config, bastionConfig)
+	if err != nil {
+		return provideraws.DetermineError(fmt.Errorf("failed to setup AWS client options: %w", err))
 	}
 
 	opt.BastionSecurityGroupID, err = ensureSecurityGroup(ctx, log, bastion, awsClient, opt)
 	if err != nil {
-		return util.DetermineError(fmt.Errorf("failed to ensure security group: %w", err), helper.KnownCodes)
+		return provideraws.DetermineError(fmt.Errorf("failed to ensure security group: %w", err))
+	}
+
+	if opt.SessionManager {
+		if err := ensureSessionManagerInstanceProfile(ctx, log, awsClient, opt); err != nil {
+			return provideraws.DetermineError(fmt.Errorf("failed to ensure instance profile: %w", err))
+		}
 	}
 
 	endpoints, err := ensureBastionInstance(ctx, log, bastion, awsClient, opt)
 	if err != nil {
-		return util.DetermineError(fmt.Errorf("failed to ensure bastion instance: %w", err), helper.KnownCodes)
+		return provideraws.DetermineError(fmt.Errorf("failed to ensure bastion instance: %w", err))
 	}
 
 	if err := ensureWorkerPermissions(ctx, log, awsClient, opt); err != nil {
-		return util.DetermineError(fmt.Errorf("failed to authorize bastion host in worker security group: %w", err), helper.KnownCodes)
+		return provideraws.DetermineError(fmt.Errorf("failed to authorize bastion host in worker security group: %w", err))
 	}
 
 	// reconcile again if the instance has not all endpoints yet
-	if !endpoints.Ready() {
+	if !endpoints.Ready(opt.SessionManager) {
 		return &reconcilerutils.RequeueAfterError{
 			// requeue rather soon, so that the user (most likely gardenctl eventually)
 			// doesn't have to wait too long for the public endpoint to become available
@@ -71,9 +91,18 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, bastion *exte
 	}
 
 	// once a public endpoint is available, publish the endpoint on the
-	// Bastion resource to notify upstream about the ready instance
+	// Bastion resource to notify upstream about the ready instance; instances
+	// which are only accessible via the Session Manager publish their private
+	// endpoint instead
 	patch := client.MergeFrom(bastion.DeepCopy())
 	bastion.Status.Ingress = endpoints.public
+	if opt.SessionManager {
+		bastion.Status.Ingress = endpoints.private
+	} else if endpoints.publicIPv6 != nil && onlyIPv6Ingress(bastion) {
+		// clients which are only allowed to connect via IPv6 need the IPv6
+		// address, as the public DNS name of the instance resolves to IPv4 only
+		bastion.Status.Ingress = endpoints.publicIPv6
+	}
 	return a.client.Status().Patch(ctx, bastion, patch)
 }
 
@@ -83,40 +112,35 @@ func ensureSecurityGroup(ctx context.Context, logger logr.Logger, bastion *exten
 		return "", err
 	}
 
-	// prepare rules
-	ingressPermission, err := ingressPermissions(ctx, bastion)
-	if err != nil {
-		return "", fmt.Errorf("invalid ingress rules configured for bastion: %w", err)
+	// prepare rules; instances which are only accessible via the Session
+	// Manager don't need any ingress
+	var ingressPermission *ec2types.IpPermission
+	if !opt.SessionManager {
+		ingressPermission, err = ingressPermissions(ctx, bastion)
+		if err != nil {
+			return "", fmt.Errorf("invalid ingress rules configured for bastion: %w", err)
+		}
 	}
 
-	egressPermission := &ec2.IpPermission{
-		FromPort:   aws.Int64(SSHPort),
-		ToPort:     aws.Int64(SSHPort),
-		IpProtocol: aws.String("tcp"),
-		UserIdGroupPairs: []*ec2.UserIdGroupPair{
-			{
-				GroupId: aws.String(opt.WorkerSecurityGroupID),
-			},
-		},
-	}
+	egressPermissions := egressPermissions(opt)
 
 	// create group if it doesn't exist yet
 	var (
-		groupID               *string
-		hasIngressPermissions = false
-		hasEgressPermissions  = false
+		groupID                 *string
+		hasIngressPermissions   = ingressPermission == nil
+		missingEgressPermission []ec2types.IpPermission
 	)
 
 	if group == nil {
 		logger.Info("Creating security group")
-		output, err := awsClient.EC2.CreateSecurityGroupWithContext(ctx, &ec2.CreateSecurityGroupInput{
+		output, err := awsClient.EC2.CreateSecurityGroup(ctx, &ec2.CreateSecurityGroupInput{
 			Description: aws.String("SSH access for Bastion"),
 			GroupName:   aws.String(opt.BastionSecurityGroupName),
 			VpcId:       aws.String(opt.VPCID),
-			TagSpecifications: []*ec2.TagSpecification{
+			TagSpecifications: []ec2types.TagSpecification{
 				{
-					ResourceType: aws.String("security-group"),
-					Tags: []*ec2.Tag{
+					ResourceType: ec2types.ResourceTypeSecurityGroup,
+					Tags: []ec2types.Tag{
 						{
 							Key:   aws.String("Name"),
 							Value: aws.String(opt.BastionSecurityGroupName),
@@ -130,30 +154,39 @@ func ensureSecurityGroup(ctx context.Context, logger logr.Logger, bastion *exten
 		}
 
 		groupID = output.GroupId
+		for _, permission := range egressPermissions {
+			missingEgressPermission = append(missingEgressPermission, *permission)
+		}
 	} else {
 		groupID = group.GroupId
-		hasIngressPermissions = securityGroupHasPermissions(group.IpPermissions, ingressPermission)
-		hasEgressPermissions = securityGroupHasPermissions(group.IpPermissionsEgress, egressPermission)
+		if ingressPermission != nil {
+			hasIngressPermissions = securityGroupHasPermissions(group.IpPermissions, ingressPermission)
+		}
+		for _, permission := range egressPermissions {
+			if !securityGroupHasPermissions(group.IpPermissionsEgress, permission) {
+				missingEgressPermission = append(missingEgressPermission, *permission)
+			}
+		}
 	}
 
 	if !hasIngressPermissions {
 		logger.Info("Authorizing SSH ingress")
 
-		_, err = awsClient.EC2.AuthorizeSecurityGroupIngressWithContext(ctx, &ec2.AuthorizeSecurityGroupIngressInput{
+		_, err = awsClient.EC2.AuthorizeSecurityGroupIngress(ctx, &ec2.AuthorizeSecurityGroupIngressInput{
 			GroupId:       groupID,
-			IpPermissions: []*ec2.IpPermission{ingressPermission},
+			IpPermissions: []ec2types.IpPermission{*ingressPermission},
 		})
 		if err != nil {
 			return "", fmt.Errorf("failed to authorize ingress: %w", err)
 		}
 	}
 
-	if !hasEgressPermissions {
+	if len(missingEgressPermission) > 0 {
 		logger.Info("Revoking bastion egress")
 
-		_, err = awsClient.EC2.AuthorizeSecurityGroupEgressWithContext(ctx, &ec2.AuthorizeSecurityGroupEgressInput{
+		_, err = awsClient.EC2.AuthorizeSecurityGroupEgress(ctx, &ec2.AuthorizeSecurityGroupEgressInput{
 			GroupId:       groupID,
-			IpPermissions: []*ec2.IpPermission{egressPermission},
+			IpPermissions: missingEgressPermission,
 		})
 		if err != nil {
 			return "", fmt.Errorf("failed to revoke egress: %w", err)
@@ -166,9 +199,16 @@ func ensureSecurityGroup(ctx context.Context, logger logr.Logger, bastion *exten
 		return "", err
 	}
 
-	permsToDelete := []*ec2.IpPermission{}
+	permsToDelete := []ec2types.IpPermission{}
 	for i, perm := range group.IpPermissionsEgress {
-		if !ipPermissionsEqual(perm, egressPermission) {
+		desired := false
+		for _, permission := range egressPermissions {
+			if ipPermissionsEqual(&perm, permission) {
+				desired = true
+				break
+			}
+		}
+		if !desired {
 			permsToDelete = append(permsToDelete, group.IpPermissionsEgress[i])
 		}
 	}
@@ -176,7 +216,7 @@ func ensureSecurityGroup(ctx context.Context, logger logr.Logger, bastion *exten
 	if len(permsToDelete) > 0 {
 		logger.Info("Revoking default bastion egress")
 
-		_, err = awsClient.EC2.RevokeSecurityGroupEgressWithContext(ctx, &ec2.RevokeSecurityGroupEgressInput{
+		_, err = awsClient.EC2.RevokeSecurityGroupEgress(ctx, &ec2.RevokeSecurityGroupEgressInput{
 			GroupId:       groupID,
 			IpPermissions: permsToDelete,
 		})
@@ -188,12 +228,45 @@ func ensureSecurityGroup(ctx context.Context, logger logr.Logger, bastion *exten
 	return *groupID, nil
 }
 
+// egressPermissions returns the egress permissions of the bastion security group, which allow SSH to the worker nodes
+// and, for instances which are only accessible via the Session Manager, HTTPS to the endpoints of the Session Manager
+// (via IPv4 and, if the subnet has an IPv6 CIDR block, via IPv6).
+func egressPermissions(opt *Options) []*ec2types.IpPermission {
+	permissions := []*ec2types.IpPermission{
+		{
+			FromPort:   aws.Int32(SSHPort),
+			ToPort:     aws.Int32(SSHPort),
+			IpProtocol: aws.String("tcp"),
+			UserIdGroupPairs: []ec2types.UserIdGroupPair{
+				{
+					GroupId: aws.String(opt.WorkerSecurityGroupID),
+				},
+			},
+		},
+	}
+
+	if opt.SessionManager {
+		permission := &ec2types.IpPermission{
+			FromPort:   aws.Int32(HTTPSPort),
+			ToPort:     aws.Int32(HTTPSPort),
+			IpProtocol: aws.String("tcp"),
+			IpRanges:   []ec2types.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+		}
+		if opt.IPv6 {
+			permission.Ipv6Ranges = []ec2types.Ipv6Range{{CidrIpv6: aws.String("::/0")}}
+		}
+		permissions = append(permissions, permission)
+	}
+
+	return permissions
+}
+
 // ingressPermissions converts the Ingress rules from the Bastion resource to EC2-compatible
 // IP permissions.
-func ingressPermissions(_ context.Context, bastion *extensionsv1alpha1.Bastion) (*ec2.IpPermission, error) {
-	permission := &ec2.IpPermission{
-		FromPort:   aws.Int64(SSHPort),
-		ToPort:     aws.Int64(SSHPort),
+func ingressPermissions(_ context.Context, bastion *extensionsv1alpha1.Bastion) (*ec2types.IpPermission, error) {
+	permission := &ec2types.IpPermission{
+		FromPort:   aws.Int32(SSHPort),
+		ToPort:     aws.Int32(SSHPort),
 		IpProtocol: aws.String("tcp"),
 		// Do not set IpRanges and Ipv6Ranges to empty slices here,
 		// as AWS makes a distinction between empty slices and nil,
@@ -217,18 +290,18 @@ func ingressPermissions(_ context.Context, bastion *extensionsv1alpha1.Bastion)
 
 		if ip.To4() != nil {
 			if permission.IpRanges == nil {
-				permission.IpRanges = []*ec2.IpRange{}
+				permission.IpRanges = []ec2types.IpRange{}
 			}
 
-			permission.IpRanges = append(permission.IpRanges, &ec2.IpRange{
+			permission.IpRanges = append(permission.IpRanges, ec2types.IpRange{
 				CidrIp: &normalisedCIDR,
 			})
 		} else if ip.To16() != nil {
 			if permission.Ipv6Ranges == nil {
-				permission.Ipv6Ranges = []*ec2.Ipv6Range{}
+				permission.Ipv6Ranges = []ec2types.Ipv6Range{}
 			}
 
-			permission.Ipv6Ranges = append(permission.Ipv6Ranges, &ec2.Ipv6Range{
+			permission.Ipv6Ranges = append(permission.Ipv6Ranges, ec2types.Ipv6Range{
 				CidrIpv6: &normalisedCIDR,
 			})
 		}
@@ -237,18 +310,40 @@ func ingressPermissions(_ context.Context, bastion *extensionsv1alpha1.Bastion)
 	return permission, nil
 }
 
+// onlyIPv6Ingress returns true if all ingress CIDRs of the Bastion are IPv6 CIDRs.
+func onlyIPv6Ingress(bastion *extensionsv1alpha1.Bastion) bool {
+	if len(bastion.Spec.Ingress) == 0 {
+		return false
+	}
+
+	for _, ingress := range bastion.Spec.Ingress {
+		ip, _, err := net.ParseCIDR(ingress.IPBlock.CIDR)
+		if err != nil || ip.To4() != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
 // bastionEndpoints collects the endpoints the bastion host provides; the
 // private endpoint is important for opening a port on the worker node
 // security group to allow SSH from that node, the public endpoint is where
-// the enduser connects to to establish the SSH connection.
+// the enduser connects to to establish the SSH connection. Instances in
+// subnets with an IPv6 CIDR block additionally have a public IPv6 endpoint.
 type bastionEndpoints struct {
-	private *corev1.LoadBalancerIngress
-	public  *corev1.LoadBalancerIngress
+	private    *corev1.LoadBalancerIngress
+	public     *corev1.LoadBalancerIngress
+	publicIPv6 *corev1.LoadBalancerIngress
 }
 
 // Ready returns true if both public and private interfaces each have either
-// an IP or a hostname or both.
-func (be *bastionEndpoints) Ready() bool {
+// an IP or a hostname or both. Instances which are only accessible via the
+// Session Manager have no public interface, so only the private one is checked.
+func (be *bastionEndpoints) Ready(sessionManager bool) bool {
+	if sessionManager {
+		return be != nil && IngressReady(be.private)
+	}
 	return be != nil && IngressReady(be.private) && IngressReady(be.public)
 }
 
@@ -272,14 +367,14 @@ func ensureBastionInstance(ctx context.Context, logger logr.Logger, bastion *ext
 	// prepare to create a new instance
 	input := &ec2.RunInstancesInput{
 		ImageId:      aws.String(opt.ImageID),
-		InstanceType: aws.String(opt.InstanceType),
+		InstanceType: ec2types.InstanceType(opt.InstanceType),
 		UserData:     aws.String(base64.StdEncoding.EncodeToString(bastion.Spec.UserData)),
-		MinCount:     aws.Int64(1),
-		MaxCount:     aws.Int64(1),
-		TagSpecifications: []*ec2.TagSpecification{
+		MinCount:     aws.Int32(1),
+		MaxCount:     aws.Int32(1),
+		TagSpecifications: []ec2types.TagSpecification{
 			{
-				ResourceType: aws.String("instance"),
-				Tags: []*ec2.Tag{
+				ResourceType: ec2types.ResourceTypeInstance,
+				Tags: []ec2types.Tag{
 					{
 						Key:   aws.String("Name"),
 						Value: aws.String(opt.InstanceName),
@@ -287,19 +382,29 @@ func ensureBastionInstance(ctx context.Context, logger logr.Logger, bastion *ext
 				},
 			},
 		},
-		NetworkInterfaces: []*ec2.InstanceNetworkInterfaceSpecification{
+		NetworkInterfaces: []ec2types.InstanceNetworkInterfaceSpecification{
 			{
-				DeviceIndex:              aws.Int64(0),
-				Groups:                   aws.StringSlice([]string{opt.BastionSecurityGroupID}),
+				DeviceIndex:              aws.Int32(0),
+				Groups:                   []string{opt.BastionSecurityGroupID},
 				SubnetId:                 aws.String(opt.SubnetID),
-				AssociatePublicIpAddress: aws.Bool(true),
+				AssociatePublicIpAddress: aws.Bool(!opt.SessionManager),
 			},
 		},
 	}
 
+	if opt.IPv6 {
+		input.NetworkInterfaces[0].Ipv6AddressCount = aws.Int32(1)
+	}
+
+	if opt.SessionManager {
+		input.IamInstanceProfile = &ec2types.IamInstanceProfileSpecification{
+			Name: aws.String(opt.InstanceProfileName),
+		}
+	}
+
 	logger.Info("Running new bastion instance")
 
-	_, err = awsClient.EC2.RunInstancesWithContext(ctx, input)
+	_, err = awsClient.EC2.RunInstances(ctx, input)
 	if err != nil {
 		return nil, fmt.Errorf("failed to run instance: %w", err)
 	}
@@ -311,15 +416,65 @@ func ensureBastionInstance(ctx context.Context, logger logr.Logger, bastion *ext
 	return getInstanceEndpoints(ctx, awsClient, opt.InstanceName)
 }
 
+// ensureSessionManagerInstanceProfile ensures the IAM role and instance profile which allow the Session Manager to
+// access the bastion instance.
+func ensureSessionManagerInstanceProfile(ctx context.Context, logger logr.Logger, awsClient *awsclient.Client, opt *Options) error {
+	role, err := awsClient.GetIAMRole(ctx, opt.InstanceProfileName)
+	if err != nil {
+		return fmt.Errorf("failed to get IAM role: %w", err)
+	}
+	if role == nil {
+		logger.Info("Creating IAM role for Session Manager")
+
+		if _, err := awsClient.CreateIAMRole(ctx, &awsclient.IAMRole{
+			RoleName:                 opt.InstanceProfileName,
+			Path:                     "/",
+			AssumeRolePolicyDocument: sessionManagerAssumeRolePolicy,
+		}); err != nil {
+			return fmt.Errorf("failed to create IAM role: %w", err)
+		}
+	}
+
+	if err := awsClient.PutIAMRolePolicy(ctx, &awsclient.IAMRolePolicy{
+		PolicyName:     opt.InstanceProfileName,
+		RoleName:       opt.InstanceProfileName,
+		PolicyDocument: sessionManagerRolePolicy,
+	}); err != nil {
+		return fmt.Errorf("failed to put IAM role policy: %w", err)
+	}
+
+	profile, err := awsClient.GetIAMInstanceProfile(ctx, opt.InstanceProfileName)
+	if err != nil {
+		return fmt.Errorf("failed to get IAM instance profile: %w", err)
+	}
+	if profile == nil {
+		logger.Info("Creating IAM instance profile for Session Manager")
+
+		if profile, err = awsClient.CreateIAMInstanceProfile(ctx, &awsclient.IAMInstanceProfile{
+			InstanceProfileName: opt.InstanceProfileName,
+			Path:                "/",
+		}); err != nil {
+			return fmt.Errorf("failed to create IAM instance profile: %w", err)
+		}
+	}
+	if profile.RoleName != opt.InstanceProfileName {
+		if err := awsClient.AddRoleToIAMInstanceProfile(ctx, opt.InstanceProfileName, opt.InstanceProfileName); err != nil {
+			return fmt.Errorf("failed to add IAM role to instance profile: %w", err)
+		}
+	}
+
+	return nil
+}
+
 // getInstanceEndpoints returns the public and private IPs/hostnames for the
 // given instance. If the instance does not exist, nil is returned.
 // Note that the public endpoint can be nil if no IP has been associated with
 // the instance yet.
 func getInstanceEndpoints(ctx context.Context, awsClient *awsclient.Client, instanceName string) (*bastionEndpoints, error) {
-	instance, err := getFirstMatchingInstance(ctx, awsClient, []*ec2.Filter{
+	instance, err := getFirstMatchingInstance(ctx, awsClient, []ec2types.Filter{
 		{
 			Name:   aws.String("tag:Name"),
-			Values: []*string{aws.String(instanceName)},
+			Values: []string{instanceName},
 		},
 	})
 	if err != nil {
@@ -339,6 +494,10 @@ func getInstanceEndpoints(ctx context.Context, awsClient *awsclient.Client, inst
 		endpoints.public = ingress
 	}
 
+	if ingress := addressToIngress(nil, instance.Ipv6Address); ingress != nil {
+		endpoints.publicIPv6 = ingress
+	}
+
 	return endpoints, nil
 }
 
@@ -379,9 +538,9 @@ func ensureWorkerPermissions(ctx context.Context, logger logr.Logger, awsClient
 	if !securityGroupHasPermissions(workerSecurityGroup.IpPermissions, permission) {
 		logger.Info("Authorizing SSH ingress to worker nodes")
 
-		_, err = awsClient.EC2.AuthorizeSecurityGroupIngressWithContext(ctx, &ec2.AuthorizeSecurityGroupIngressInput{
+		_, err = awsClient.EC2.AuthorizeSecurityGroupIngress(ctx, &ec2.AuthorizeSecurityGroupIngressInput{
 			GroupId:       aws.String(opt.WorkerSecurityGroupID),
-			IpPermissions: []*ec2.IpPermission{permission},
+			IpPermissions: []ec2types.IpPermission{*permission},
 		})
 	}
 
@@ -391,8 +550,8 @@ func ensureWorkerPermissions(ctx context.Context, logger logr.Logger, awsClient
 // getFirstMatchingInstance returns the first EC2 instances that matches
 // the filter and is not in a Terminating/Shutting-down state. If no
 // instances match, nil and no error are returned.
-func getFirstMatchingInstance(ctx context.Context, awsClient *awsclient.Client, filter []*ec2.Filter) (*ec2.Instance, error) {
-	instances, err := awsClient.EC2.DescribeInstancesWithContext(ctx, &ec2.DescribeInstancesInput{Filters: filter})
+func getFirstMatchingInstance(ctx context.Context, awsClient *awsclient.Client, filter []ec2types.Filter) (*ec2types.Instance, error) {
+	instances, err := awsClient.EC2.DescribeInstances(ctx, &ec2.DescribeInstancesInput{Filters: filter})
 	if err != nil {
 		return nil, err
 	}
@@ -405,24 +564,24 @@ func getFirstMatchingInstance(ctx context.Context, awsClient *awsclient.Client,
 				continue
 			}
 
-			return instance, nil
+			return &instance, nil
 		}
 	}
 
 	return nil, nil
 }
 
-func getSecurityGroup(ctx context.Context, awsClient *awsclient.Client, vpcID string, groupName string) (*ec2.SecurityGroup, error) {
+func getSecurityGroup(ctx context.Context, awsClient *awsclient.Client, vpcID string, groupName string) (*ec2types.SecurityGroup, error) {
 	// try to find existing SG
-	groups, err := awsClient.EC2.DescribeSecurityGroupsWithContext(ctx, &ec2.DescribeSecurityGroupsInput{
-		Filters: []*ec2.Filter{
+	groups, err := awsClient.EC2.DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{
+		Filters: []ec2types.Filter{
 			{
 				Name:   aws.String("vpc-id"),
-				Values: []*string{aws.String(vpcID)},
+				Values: []string{vpcID},
 			},
 			{
 				Name:   aws.String("group-name"),
-				Values: []*string{aws.String(groupName)},
+				Values: []string{groupName},
 			},
 		},
 	})
@@ -434,5 +593,5 @@ func getSecurityGroup(ctx context.Context, awsClient *awsclient.Client, vpcID st
 		return nil, nil
 	}
 
-	return groups.SecurityGroups[0], nil
+	return &groups.SecurityGroups[0], nil
 }
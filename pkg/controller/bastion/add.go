@@ -18,9 +18,13 @@ import (
 	"context"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/bastion"
+	extensionspredicate "github.com/gardener/gardener/extensions/pkg/predicate"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
 )
 
@@ -35,17 +39,39 @@ type AddOptions struct {
 	Controller controller.Options
 	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
 	IgnoreOperationAnnotation bool
+	// Config is the default configuration of the bastion hosts.
+	Config *config.BastionConfig
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
+// If the lifetime of bastion hosts is limited, a controller which revokes the access to expired bastion hosts is added
+// as well.
 func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
-	return bastion.Add(mgr, bastion.AddArgs{
-		Actuator:          newActuator(mgr),
+	actuator := newActuator(mgr, opts.Config)
+
+	if err := bastion.Add(mgr, bastion.AddArgs{
+		Actuator:          actuator,
 		ControllerOptions: opts.Controller,
 		Predicates:        bastion.DefaultPredicates(opts.IgnoreOperationAnnotation),
 		Type:              aws.Type,
-	})
+	}); err != nil {
+		return err
+	}
+
+	if opts.Config == nil || opts.Config.MaxLifetime == nil {
+		return nil
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ExpiryControllerName).
+		WithOptions(opts.Controller).
+		For(&extensionsv1alpha1.Bastion{}, builder.WithPredicates(extensionspredicate.HasType(aws.Type))).
+		Complete(&expiryReconciler{
+			client:   mgr.GetClient(),
+			actuator: actuator,
+		})
 }
 
 // AddToManager adds a controller with the default Options.
@@ -0,0 +1,143 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	provideraws "github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+)
+
+// ExpiryControllerName is the name of the controller which revokes the access to expired bastion hosts.
+const ExpiryControllerName = "bastion-expiry"
+
+// expiresAt returns the time after which the access to the bastion host of the given Bastion is revoked. If the
+// lifetime of bastion hosts is not limited, nil is returned.
+func (a *actuator) expiresAt(bastion *extensionsv1alpha1.Bastion) *time.Time {
+	if a.config == nil || a.config.MaxLifetime == nil {
+		return nil
+	}
+
+	expiresAt := bastion.CreationTimestamp.Add(a.config.MaxLifetime.Duration)
+	return &expiresAt
+}
+
+// expired returns true if the given Bastion has exceeded the maximum lifetime of bastion hosts.
+func (a *actuator) expired(bastion *extensionsv1alpha1.Bastion) bool {
+	expiresAt := a.expiresAt(bastion)
+	return expiresAt != nil && !a.clock.Now().Before(*expiresAt)
+}
+
+// revokeAccess revokes all ingress rules of the bastion security group as well as the SSH access of the bastion host to
+// the worker nodes. The bastion host itself is only removed once the Bastion is deleted.
+func (a *actuator) revokeAccess(ctx context.Context, log logr.Logger, bastion *extensionsv1alpha1.Bastion, cluster *controller.Cluster) error {
+	awsClient, err := a.getAWSClient(ctx, bastion, cluster.Shoot)
+	if err != nil {
+		return provideraws.DetermineError(fmt.Errorf("failed to create AWS client: %w", err))
+	}
+
+	bastionConfig, err := a.decodeBastionConfig(bastion)
+	if err != nil {
+		return err
+	}
+
+	opt, err := DetermineOptions(ctx, bastion, cluster, awsClient, a.config, bastionConfig)
+	if err != nil {
+		return provideraws.DetermineError(fmt.Errorf("failed to setup AWS client options: %w", err))
+	}
+
+	group, err := getSecurityGroup(ctx, awsClient, opt.VPCID, opt.BastionSecurityGroupName)
+	if err != nil {
+		return provideraws.DetermineError(fmt.Errorf("failed to list security groups: %w", err))
+	}
+
+	// nothing to do
+	if group == nil {
+		return nil
+	}
+
+	opt.BastionSecurityGroupID = *group.GroupId
+
+	if len(group.IpPermissions) > 0 {
+		log.Info("Revoking ingress of expired bastion")
+
+		if _, err := awsClient.EC2.RevokeSecurityGroupIngress(ctx, &ec2.RevokeSecurityGroupIngressInput{
+			GroupId:       aws.String(opt.BastionSecurityGroupID),
+			IpPermissions: group.IpPermissions,
+		}); err != nil {
+			return provideraws.DetermineError(fmt.Errorf("failed to revoke ingress: %w", err))
+		}
+	}
+
+	if err := removeWorkerPermissions(ctx, log, awsClient, opt); err != nil {
+		return provideraws.DetermineError(fmt.Errorf("failed to remove bastion host from worker security group: %w", err))
+	}
+
+	return nil
+}
+
+// expiryReconciler revokes the access to bastion hosts once their Bastion has exceeded the maximum lifetime, so that
+// they cannot be used anymore even if the deletion of the Bastion is delayed or stuck. Bastions are requeued until
+// they expire, as their specification does not change in the meantime.
+type expiryReconciler struct {
+	client   client.Client
+	actuator *actuator
+}
+
+// Reconcile revokes the access to the bastion host of the given Bastion if it has expired.
+func (r *expiryReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	bastion := &extensionsv1alpha1.Bastion{}
+	if err := r.client.Get(ctx, request.NamespacedName, bastion); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	// the bastion host is removed anyway
+	if bastion.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	expiresAt := r.actuator.expiresAt(bastion)
+	if expiresAt == nil {
+		return reconcile.Result{}, nil
+	}
+	if remaining := expiresAt.Sub(r.actuator.clock.Now()); remaining > 0 {
+		return reconcile.Result{RequeueAfter: remaining}, nil
+	}
+
+	cluster, err := controller.GetCluster(ctx, r.client, bastion.Namespace)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, r.actuator.revokeAccess(ctx, log, bastion, cluster)
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bastion
+
+import (
+	"context"
+	"time"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/config"
+)
+
+var _ = Describe("Expiry", func() {
+	var (
+		ctx       = context.TODO()
+		fakeClock *testclock.FakeClock
+		bastion   *extensionsv1alpha1.Bastion
+		a         *actuator
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC))
+		bastion = &extensionsv1alpha1.Bastion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "bastion",
+				Namespace:         "shoot--foo--bar",
+				CreationTimestamp: metav1.NewTime(fakeClock.Now().Add(-time.Hour)),
+			},
+		}
+		a = &actuator{
+			config: &config.BastionConfig{MaxLifetime: &metav1.Duration{Duration: 2 * time.Hour}},
+			clock:  fakeClock,
+		}
+	})
+
+	Describe("#expired", func() {
+		It("should not expire bastions if the lifetime is not limited", func() {
+			a.config = nil
+			Expect(a.expiresAt(bastion)).To(BeNil())
+
+			fakeClock.Step(24 * time.Hour)
+			Expect(a.expired(bastion)).To(BeFalse())
+		})
+
+		It("should expire bastions once they have exceeded the maximum lifetime", func() {
+			Expect(*a.expiresAt(bastion)).To(Equal(fakeClock.Now().Add(time.Hour)))
+			Expect(a.expired(bastion)).To(BeFalse())
+
+			fakeClock.Step(time.Hour)
+			Expect(a.expired(bastion)).To(BeTrue())
+		})
+	})
+
+	Describe("#Reconcile", func() {
+		var (
+			c client.Client
+			r *expiryReconciler
+		)
+
+		BeforeEach(func() {
+			scheme := runtime.NewScheme()
+			Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+			c = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(bastion).Build()
+			a.client = c
+			r = &expiryReconciler{client: c, actuator: a}
+		})
+
+		It("should requeue bastions until they expire", func() {
+			Expect(r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: bastion.Namespace, Name: bastion.Name}})).
+				To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		})
+
+		It("should ignore bastions which are gone", func() {
+			Expect(r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: bastion.Namespace, Name: "other"}})).
+				To(Equal(reconcile.Result{}))
+		})
+	})
+
+	Describe("#onlyIPv6Ingress", func() {
+		ingress := func(cidrs ...string) []extensionsv1alpha1.BastionIngressPolicy {
+			var policies []extensionsv1alpha1.BastionIngressPolicy
+			for _, cidr := range cidrs {
+				policies = append(policies, extensionsv1alpha1.BastionIngressPolicy{IPBlock: networkingv1.IPBlock{CIDR: cidr}})
+			}
+			return policies
+		}
+
+		It("should detect IPv6-only ingress", func() {
+			bastion.Spec.Ingress = ingress("2001:db8::/64", "2001:db8:1::1/128")
+			Expect(onlyIPv6Ingress(bastion)).To(BeTrue())
+		})
+
+		It("should not treat dual-stack or empty ingress as IPv6-only", func() {
+			bastion.Spec.Ingress = ingress("2001:db8::/64", "10.0.0.0/8")
+			Expect(onlyIPv6Ingress(bastion)).To(BeFalse())
+
+			bastion.Spec.Ingress = nil
+			Expect(onlyIPv6Ingress(bastion)).To(BeFalse())
+		})
+	})
+})
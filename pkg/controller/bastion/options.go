@@ -16,18 +16,26 @@ package bastion
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/gardener/gardener/extensions/pkg/controller"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/extensions"
+	"golang.org/x/exp/slices"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/pointer"
 
+	api "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 	awsv1alpha1 "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/config"
 	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 )
 
@@ -45,6 +53,16 @@ type Options struct {
 	InstanceName             string
 	InstanceType             string
 	ImageID                  string
+	// SessionManager specifies whether the bastion instance is only accessible via AWS Systems Manager Session
+	// Manager. In this mode, the instance is placed in a private subnet and has neither a public IP nor SSH ingress.
+	SessionManager bool
+	// InstanceProfileName is the name of the IAM role and instance profile of the bastion instance, which allow the
+	// Session Manager to access the instance.
+	InstanceProfileName string
+	// IPv6 specifies whether the subnet of the bastion instance has an IPv6 CIDR block. In this case, the instance is
+	// assigned an IPv6 address, so that it can be reached from IPv6 ingress CIDRs. The routes to the internet are
+	// maintained by the Infrastructure of dual-stack shoots.
+	IPv6 bool
 
 	// set later during reconciling phase
 	BastionSecurityGroupID string
@@ -53,15 +71,31 @@ type Options struct {
 // DetermineOptions determines the required information like VPC ID and
 // instance type that are required to reconcile a Bastion on AWS. This
 // function does not create any IaaS resources.
-func DetermineOptions(ctx context.Context, bastion *extensionsv1alpha1.Bastion, cluster *controller.Cluster, awsClient *awsclient.Client) (*Options, error) {
+// The defaults of the controller configuration and the provider config of the Bastion are optional, the latter takes
+// precedence.
+func DetermineOptions(ctx context.Context, bastion *extensionsv1alpha1.Bastion, cluster *controller.Cluster, awsClient *awsclient.Client, defaults *config.BastionConfig, bastionConfig *api.BastionConfig) (*Options, error) {
+	if defaults == nil {
+		defaults = &config.BastionConfig{}
+	}
+	if bastionConfig == nil {
+		bastionConfig = &api.BastionConfig{}
+	}
+
 	name := cluster.ObjectMeta.Name
-	subnetName := name + "-public-utility-z0"
 	instanceName := fmt.Sprintf("%s-%s-bastion", name, bastion.Name)
+	sessionManager := pointer.BoolDeref(bastionConfig.SessionManager, pointer.BoolDeref(defaults.SessionManager, false))
+
+	// instances which are only accessible via the Session Manager have no public IP and reach the Session Manager
+	// via the NAT gateway of the nodes subnet
+	subnetName := name + "-public-utility-z0"
+	if sessionManager {
+		subnetName = name + "-nodes-z0"
+	}
 
 	// this security group will be created during reconciliation
 	bastionSecurityGroupName := fmt.Sprintf("%s-%s-bsg", name, bastion.Name)
 
-	subnetID, vpcID, err := resolveSubnetName(ctx, awsClient, subnetName)
+	subnetID, vpcID, ipv6, err := resolveSubnetName(ctx, awsClient, subnetName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find subnet %q: %w", subnetName, err)
 	}
@@ -81,14 +115,29 @@ func DetermineOptions(ctx context.Context, bastion *extensionsv1alpha1.Bastion,
 		return nil, fmt.Errorf("failed to extract cloud provider config from cluster: %w", err)
 	}
 
-	imageID, err := determineImageID(cluster.Shoot, cloudProfileConfig)
-	if err != nil {
-		return nil, fmt.Errorf("failed to determine OS image for bastion host: %w", err)
+	instanceType := pointer.StringDeref(bastionConfig.InstanceType, pointer.StringDeref(defaults.InstanceType, ""))
+
+	// if the instance type is configured, the AMI must support one of its architectures
+	var architectures []string
+	if instanceType != "" {
+		if architectures, err = awsClient.GetInstanceTypeArchitectures(ctx, instanceType); err != nil {
+			return nil, fmt.Errorf("failed to determine architectures of instance type %s: %w", instanceType, err)
+		}
 	}
 
-	instanceType, err := determineInstanceType(ctx, imageID, awsClient)
-	if err != nil {
-		return nil, fmt.Errorf("failed to determine instance type: %w", err)
+	imageID := pointer.StringDeref(bastionConfig.AMI, "")
+	if imageID == "" {
+		imageID, err = determineImageID(cluster, cloudProfileConfig, defaults.MachineImage, architectures)
+		if err != nil {
+			return nil, fmt.Errorf("failed to determine OS image for bastion host: %w", err)
+		}
+	}
+
+	if instanceType == "" {
+		instanceType, err = determineInstanceType(ctx, imageID, awsClient)
+		if err != nil {
+			return nil, fmt.Errorf("failed to determine instance type: %w", err)
+		}
 	}
 
 	return &Options{
@@ -101,17 +150,31 @@ func DetermineOptions(ctx context.Context, bastion *extensionsv1alpha1.Bastion,
 		InstanceName:             instanceName,
 		InstanceType:             instanceType,
 		ImageID:                  imageID,
+		SessionManager:           sessionManager,
+		InstanceProfileName:      iamName(instanceName),
+		IPv6:                     ipv6,
 	}, nil
 }
 
-// resolveSubnetName resolves a subnet name to its ID and the VPC ID. If no subnet with the
-// given name exists, an error is returned.
-func resolveSubnetName(ctx context.Context, awsClient *awsclient.Client, subnetName string) (subnetID string, vpcID string, err error) {
-	subnets, err := awsClient.EC2.DescribeSubnetsWithContext(ctx, &ec2.DescribeSubnetsInput{
-		Filters: []*ec2.Filter{
+// iamName returns the given name if it is a valid name of an IAM role. Otherwise, it is shortened and made unique with
+// a hash of the full name.
+func iamName(name string) string {
+	const maxLength = 64
+	if len(name) <= maxLength {
+		return name
+	}
+	hash := sha256.Sum256([]byte(name))
+	return fmt.Sprintf("%s-%x", name[:maxLength-9], hash[:4])
+}
+
+// resolveSubnetName resolves a subnet name to its ID and the VPC ID and reports whether the subnet
+// has an IPv6 CIDR block. If no subnet with the given name exists, an error is returned.
+func resolveSubnetName(ctx context.Context, awsClient *awsclient.Client, subnetName string) (subnetID string, vpcID string, ipv6 bool, err error) {
+	subnets, err := awsClient.EC2.DescribeSubnets(ctx, &ec2.DescribeSubnetsInput{
+		Filters: []ec2types.Filter{
 			{
 				Name:   aws.String("tag:Name"),
-				Values: aws.StringSlice([]string{subnetName}),
+				Values: []string{subnetName},
 			},
 		},
 	})
@@ -127,6 +190,12 @@ func resolveSubnetName(ctx context.Context, awsClient *awsclient.Client, subnetN
 	subnetID = *subnets.Subnets[0].SubnetId
 	vpcID = *subnets.Subnets[0].VpcId
 
+	for _, association := range subnets.Subnets[0].Ipv6CidrBlockAssociationSet {
+		if association.Ipv6CidrBlockState != nil && association.Ipv6CidrBlockState.State == ec2types.SubnetCidrBlockStateCodeAssociated {
+			ipv6 = true
+		}
+	}
+
 	return
 }
 
@@ -147,20 +216,77 @@ func getCloudProfileConfig(cluster *extensions.Cluster) (*awsv1alpha1.CloudProfi
 	return cloudProfileConfig, nil
 }
 
-// determineImageID finds the first AMI that is configured for the same region as the shoot cluster.
-// If no image is found, an error is returned.
-func determineImageID(shoot *gardencorev1beta1.Shoot, providerConfig *awsv1alpha1.CloudProfileConfig) (string, error) {
+// determineImageID determines the AMI of the bastion host in the region of the shoot. If a machine image is given, its
+// AMI is used, otherwise the first AMI of the CloudProfile. If architectures are given, the AMI must have one of them.
+func determineImageID(cluster *controller.Cluster, providerConfig *awsv1alpha1.CloudProfileConfig, machineImage *config.BastionMachineImage, architectures []string) (string, error) {
+	var (
+		region  = cluster.Shoot.Spec.Region
+		name    string
+		version string
+	)
+
+	if machineImage != nil {
+		name = machineImage.Name
+		if machineImage.Version != nil {
+			version = *machineImage.Version
+		} else {
+			latest, err := latestMachineImageVersion(cluster, machineImage.Name)
+			if err != nil {
+				return "", err
+			}
+			version = latest
+		}
+	}
+
 	for _, image := range providerConfig.MachineImages {
-		for _, version := range image.Versions {
-			for _, region := range version.Regions {
-				if region.Name == shoot.Spec.Region {
-					return region.AMI, nil
+		if name != "" && image.Name != name {
+			continue
+		}
+		for _, v := range image.Versions {
+			if version != "" && v.Version != version {
+				continue
+			}
+			for _, r := range v.Regions {
+				if r.Name != region {
+					continue
+				}
+				if len(architectures) > 0 && !slices.Contains(architectures, pointer.StringDeref(r.Architecture, v1beta1constants.ArchitectureAMD64)) {
+					continue
 				}
+				return r.AMI, nil
 			}
 		}
 	}
 
-	return "", fmt.Errorf("found no suitable AMI for machines in region %q", shoot.Spec.Region)
+	if name != "" {
+		return "", fmt.Errorf("found no suitable AMI of machine image %s/%s for machines in region %q", name, version, region)
+	}
+	return "", fmt.Errorf("found no suitable AMI for machines in region %q", region)
+}
+
+// latestMachineImageVersion returns the latest version of the given machine image in the CloudProfile which is not
+// expired.
+func latestMachineImageVersion(cluster *controller.Cluster, name string) (string, error) {
+	for _, image := range cluster.CloudProfile.Spec.MachineImages {
+		if image.Name != name {
+			continue
+		}
+
+		versions := make([]gardencorev1beta1.ExpirableVersion, 0, len(image.Versions))
+		for _, version := range image.Versions {
+			versions = append(versions, version.ExpirableVersion)
+		}
+
+		found, latest, err := v1beta1helper.GetLatestQualifyingVersion(versions, v1beta1helper.FilterExpiredVersion())
+		if err != nil {
+			return "", err
+		}
+		if found {
+			return latest.Version, nil
+		}
+	}
+
+	return "", fmt.Errorf("found no version of machine image %s in the CloudProfile", name)
 }
 
 func determineInstanceType(ctx context.Context, imageID string, awsClient *awsclient.Client) (string, error) {
@@ -170,14 +296,14 @@ func determineInstanceType(ctx context.Context, imageID string, awsClient *awscl
 		return "", err
 	}
 
-	if imageInfo.Architecture == nil {
+	if imageInfo.Architecture == "" {
 		return "", fmt.Errorf("image architecture is empty")
 	}
 
-	imageArchitecture := imageInfo.Architecture
+	imageArchitecture := string(imageInfo.Architecture)
 
 	// default instance type
-	switch *imageArchitecture {
+	switch imageArchitecture {
 	case "x86_64":
 		preferredType = "t2.nano"
 	case "arm64":
@@ -205,17 +331,17 @@ func determineInstanceType(ctx context.Context, imageID string, awsClient *awscl
 		return "", fmt.Errorf("no t* instance type offerings available")
 	}
 
-	tTypeSet := sets.NewString()
+	tTypeSet := sets.New[ec2types.InstanceType]()
 	for _, t := range tTypes.InstanceTypeOfferings {
-		tTypeSet.Insert(*t.InstanceType)
+		tTypeSet.Insert(t.InstanceType)
 	}
 
-	result, err := awsClient.EC2.DescribeInstanceTypes(&ec2.DescribeInstanceTypesInput{
-		InstanceTypes: aws.StringSlice(tTypeSet.UnsortedList()),
-		Filters: []*ec2.Filter{
+	result, err := awsClient.EC2.DescribeInstanceTypes(ctx, &ec2.DescribeInstanceTypesInput{
+		InstanceTypes: tTypeSet.UnsortedList(),
+		Filters: []ec2types.Filter{
 			{
 				Name:   aws.String("processor-info.supported-architecture"),
-				Values: []*string{imageArchitecture},
+				Values: []string{imageArchitecture},
 			},
 		},
 	})
@@ -225,21 +351,19 @@ func determineInstanceType(ctx context.Context, imageID string, awsClient *awscl
 	}
 
 	if len(result.InstanceTypes) == 0 {
-		return "", fmt.Errorf("no instance types returned for architecture %s and instance types list %v", *imageArchitecture, tTypeSet.UnsortedList())
+		return "", fmt.Errorf("no instance types returned for architecture %s and instance types list %v", imageArchitecture, tTypeSet.UnsortedList())
 	}
 
-	if result.InstanceTypes[0].InstanceType == nil {
+	if result.InstanceTypes[0].InstanceType == "" {
 		return "", fmt.Errorf("instanceType is empty")
 	}
 
-	return *result.InstanceTypes[0].InstanceType, nil
+	return string(result.InstanceTypes[0].InstanceType), nil
 }
 
-func getImages(ctx context.Context, ami string, awsClient *awsclient.Client) (*ec2.Image, error) {
-	imageInfo, err := awsClient.EC2.DescribeImagesWithContext(ctx, &ec2.DescribeImagesInput{
-		ImageIds: []*string{
-			aws.String(ami),
-		},
+func getImages(ctx context.Context, ami string, awsClient *awsclient.Client) (*ec2types.Image, error) {
+	imageInfo, err := awsClient.EC2.DescribeImages(ctx, &ec2.DescribeImagesInput{
+		ImageIds: []string{ami},
 	})
 
 	if err != nil {
@@ -249,15 +373,15 @@ func getImages(ctx context.Context, ami string, awsClient *awsclient.Client) (*e
 	if len(imageInfo.Images) == 0 {
 		return nil, fmt.Errorf("images info not found: %w", err)
 	}
-	return imageInfo.Images[0], nil
+	return &imageInfo.Images[0], nil
 }
 
 func getInstanceTypeOfferings(ctx context.Context, filter string, awsClient *awsclient.Client) (*ec2.DescribeInstanceTypeOfferingsOutput, error) {
-	return awsClient.EC2.DescribeInstanceTypeOfferingsWithContext(ctx, &ec2.DescribeInstanceTypeOfferingsInput{
-		Filters: []*ec2.Filter{
+	return awsClient.EC2.DescribeInstanceTypeOfferings(ctx, &ec2.DescribeInstanceTypeOfferingsInput{
+		Filters: []ec2types.Filter{
 			{
 				Name:   aws.String("instance-type"),
-				Values: []*string{aws.String(filter)},
+				Values: []string{filter},
 			},
 		},
 	})
@@ -0,0 +1,154 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bastion
+
+import (
+	"strings"
+
+	"github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	awsv1alpha1 "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/config"
+)
+
+var _ = Describe("Options", func() {
+	Describe("#determineImageID", func() {
+		var (
+			cluster            *controller.Cluster
+			cloudProfileConfig *awsv1alpha1.CloudProfileConfig
+		)
+
+		BeforeEach(func() {
+			cluster = &controller.Cluster{
+				Shoot: &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Region: "eu-west-1"}},
+				CloudProfile: &gardencorev1beta1.CloudProfile{Spec: gardencorev1beta1.CloudProfileSpec{
+					MachineImages: []gardencorev1beta1.MachineImage{{
+						Name: "gardenlinux",
+						Versions: []gardencorev1beta1.MachineImageVersion{
+							{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0"}},
+							{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "2.0.0"}},
+							{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "3.0.0", ExpirationDate: &metav1.Time{}}},
+						},
+					}},
+				}},
+			}
+			cloudProfileConfig = &awsv1alpha1.CloudProfileConfig{
+				MachineImages: []awsv1alpha1.MachineImages{
+					{
+						Name: "ubuntu",
+						Versions: []awsv1alpha1.MachineImageVersion{{
+							Version: "22.04",
+							Regions: []awsv1alpha1.RegionAMIMapping{{Name: "eu-west-1", AMI: "ami-ubuntu"}},
+						}},
+					},
+					{
+						Name: "gardenlinux",
+						Versions: []awsv1alpha1.MachineImageVersion{
+							{
+								Version: "1.0.0",
+								Regions: []awsv1alpha1.RegionAMIMapping{{Name: "eu-west-1", AMI: "ami-gardenlinux-1"}},
+							},
+							{
+								Version: "2.0.0",
+								Regions: []awsv1alpha1.RegionAMIMapping{
+									{Name: "eu-west-1", AMI: "ami-gardenlinux-2-amd64", Architecture: pointer.String("amd64")},
+									{Name: "eu-west-1", AMI: "ami-gardenlinux-2-arm64", Architecture: pointer.String("arm64")},
+								},
+							},
+							{
+								Version: "3.0.0",
+								Regions: []awsv1alpha1.RegionAMIMapping{{Name: "eu-west-1", AMI: "ami-gardenlinux-3"}},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should use the first AMI in the region if no machine image is configured", func() {
+			Expect(determineImageID(cluster, cloudProfileConfig, nil, nil)).To(Equal("ami-ubuntu"))
+		})
+
+		It("should use the configured version of the machine image", func() {
+			machineImage := &config.BastionMachineImage{Name: "gardenlinux", Version: pointer.String("1.0.0")}
+
+			Expect(determineImageID(cluster, cloudProfileConfig, machineImage, nil)).To(Equal("ami-gardenlinux-1"))
+		})
+
+		It("should use the latest version of the machine image which is not expired and supports the architecture", func() {
+			machineImage := &config.BastionMachineImage{Name: "gardenlinux"}
+
+			Expect(determineImageID(cluster, cloudProfileConfig, machineImage, []string{"arm64"})).To(Equal("ami-gardenlinux-2-arm64"))
+		})
+
+		It("should fail if the machine image has no AMI of the architecture in the region", func() {
+			machineImage := &config.BastionMachineImage{Name: "gardenlinux", Version: pointer.String("1.0.0")}
+
+			_, err := determineImageID(cluster, cloudProfileConfig, machineImage, []string{"arm64"})
+			Expect(err).To(MatchError(ContainSubstring("found no suitable AMI of machine image gardenlinux/1.0.0")))
+		})
+
+		It("should fail if the machine image is not contained in the CloudProfile", func() {
+			_, err := determineImageID(cluster, cloudProfileConfig, &config.BastionMachineImage{Name: "ubuntu"}, nil)
+			Expect(err).To(MatchError(ContainSubstring("found no version of machine image ubuntu")))
+		})
+	})
+
+	Describe("#iamName", func() {
+		It("should keep short names", func() {
+			Expect(iamName("shoot--foo--bar-cli-abcdef-bastion")).To(Equal("shoot--foo--bar-cli-abcdef-bastion"))
+		})
+
+		It("should shorten long names uniquely", func() {
+			name := "shoot--my-project--my-long-shoot-name-cli-0123456789abcdef-bastion"
+			other := strings.Replace(name, "0123456789abcdef", "0123456789abcdee", 1)
+
+			Expect(iamName(name)).To(HaveLen(64))
+			Expect(iamName(name)).To(HavePrefix(name[:55]))
+			Expect(iamName(name)).NotTo(Equal(iamName(other)))
+		})
+	})
+
+	Describe("#egressPermissions", func() {
+		It("should only allow SSH to the workers", func() {
+			permissions := egressPermissions(&Options{WorkerSecurityGroupID: "sg-workers"})
+
+			Expect(permissions).To(HaveLen(1))
+			Expect(*permissions[0].FromPort).To(BeEquivalentTo(SSHPort))
+			Expect(*permissions[0].UserIdGroupPairs[0].GroupId).To(Equal("sg-workers"))
+		})
+
+		It("should additionally allow HTTPS for the Session Manager", func() {
+			permissions := egressPermissions(&Options{WorkerSecurityGroupID: "sg-workers", SessionManager: true})
+
+			Expect(permissions).To(HaveLen(2))
+			Expect(*permissions[1].FromPort).To(BeEquivalentTo(HTTPSPort))
+			Expect(*permissions[1].IpRanges[0].CidrIp).To(Equal("0.0.0.0/0"))
+		})
+
+		It("should additionally allow HTTPS via IPv6 for the Session Manager in dual-stack subnets", func() {
+			permissions := egressPermissions(&Options{WorkerSecurityGroupID: "sg-workers", SessionManager: true, IPv6: true})
+
+			Expect(permissions).To(HaveLen(2))
+			Expect(*permissions[1].IpRanges[0].CidrIp).To(Equal("0.0.0.0/0"))
+			Expect(*permissions[1].Ipv6Ranges[0].CidrIpv6).To(Equal("::/0"))
+		})
+	})
+})
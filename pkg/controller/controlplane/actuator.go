@@ -0,0 +1,362 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"crypto/sha1" // #nosec G505 -- IAM expects SHA-1 thumbprints of OpenID Connect provider certificates
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/controlplane"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	awsv1alpha1 "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/config"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// irsaClientID is the audience of the service account tokens which are exchanged for AWS credentials.
+const irsaClientID = "sts.amazonaws.com"
+
+// thumbprintFunc returns the SHA-1 thumbprint of the certificate which IAM uses to verify the given issuer. It is
+// replaced in tests.
+var thumbprintFunc = getThumbprint
+
+// NewActuator creates a new Actuator which wraps the given actuator and manages the IAM OpenID Connect provider for
+// IAM roles for service accounts, the interruption queue of Karpenter, the VPC endpoint service of the kube-apiserver
+// and the AWS resources of the CloudWatch agent in addition.
+func NewActuator(mgr manager.Manager, a controlplane.Actuator, awsClientFactory awsclient.Factory, privateLink *config.PrivateLinkConfig) controlplane.Actuator {
+	return &actuator{
+		Actuator:         a,
+		client:           mgr.GetClient(),
+		decoder:          serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
+		awsClientFactory: awsClientFactory,
+		privateLink:      privateLink,
+	}
+}
+
+type actuator struct {
+	controlplane.Actuator
+	client           client.Client
+	decoder          runtime.Decoder
+	awsClientFactory awsclient.Factory
+	privateLink      *config.PrivateLinkConfig
+}
+
+// Reconcile reconciles the control plane, the IAM OpenID Connect provider, the interruption queue of Karpenter, the
+// AWS resources of the CloudWatch agent and the VPC endpoint service of the kube-apiserver.
+func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) (bool, error) {
+	requeue, err := a.Actuator.Reconcile(ctx, log, cp, cluster)
+	if err != nil {
+		return requeue, err
+	}
+	if err := a.reconcileOIDCProvider(ctx, log, cp, cluster); err != nil {
+		return requeue, err
+	}
+	if err := a.reconcileKarpenter(ctx, log, cp, cluster); err != nil {
+		return requeue, err
+	}
+	if err := a.reconcileCloudWatchAgent(ctx, log, cp, cluster); err != nil {
+		return requeue, err
+	}
+	return requeue, a.reconcilePrivateLink(ctx, log, cp, cluster)
+}
+
+// Restore restores the control plane, the IAM OpenID Connect provider, the interruption queue of Karpenter, the AWS
+// resources of the CloudWatch agent and the VPC endpoint service of the kube-apiserver.
+func (a *actuator) Restore(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) (bool, error) {
+	requeue, err := a.Actuator.Restore(ctx, log, cp, cluster)
+	if err != nil {
+		return requeue, err
+	}
+	if err := a.reconcileOIDCProvider(ctx, log, cp, cluster); err != nil {
+		return requeue, err
+	}
+	if err := a.reconcileKarpenter(ctx, log, cp, cluster); err != nil {
+		return requeue, err
+	}
+	if err := a.reconcileCloudWatchAgent(ctx, log, cp, cluster); err != nil {
+		return requeue, err
+	}
+	return requeue, a.reconcilePrivateLink(ctx, log, cp, cluster)
+}
+
+// Delete deletes the IAM OpenID Connect provider, the instances and the interruption queue of Karpenter, the AWS
+// resources of the CloudWatch agent, the VPC endpoint service of the kube-apiserver and the control plane.
+func (a *actuator) Delete(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) error {
+	if err := a.deleteOIDCProvider(ctx, log, cp, cluster); err != nil {
+		return err
+	}
+	if err := a.deleteKarpenterResources(ctx, log, cp); err != nil {
+		return err
+	}
+	if err := a.deleteCloudWatchAgent(ctx, log, cp); err != nil {
+		return err
+	}
+	if err := a.deletePrivateLink(ctx, log, cp, cluster); err != nil {
+		return err
+	}
+	return a.Actuator.Delete(ctx, log, cp, cluster)
+}
+
+func (a *actuator) reconcileOIDCProvider(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) error {
+	if !isNormalPurpose(cp) {
+		return nil
+	}
+
+	cpConfig, status, err := a.decode(cp)
+	if err != nil {
+		return err
+	}
+
+	if cpConfig.IRSA == nil || !cpConfig.IRSA.Enabled {
+		if status.IRSA == nil {
+			return nil
+		}
+		awsClient, err := a.newAWSClient(ctx, cp)
+		if err != nil {
+			return err
+		}
+		if err := deleteOIDCProvider(ctx, log, awsClient, cp.Namespace, status.IRSA.OIDCProviderARN); err != nil {
+			return err
+		}
+		status.IRSA = nil
+		return a.updateStatus(ctx, cp, status)
+	}
+
+	issuerURL := getServiceAccountIssuer(cluster)
+	if issuerURL == "" {
+		return fmt.Errorf("the service account issuer of the shoot must be configured for IAM roles for service accounts")
+	}
+
+	awsClient, err := a.newAWSClient(ctx, cp)
+	if err != nil {
+		return err
+	}
+
+	if status.IRSA != nil && status.IRSA.IssuerURL != issuerURL {
+		log.Info("Deleting IAM OpenID Connect provider of previous issuer", "arn", status.IRSA.OIDCProviderARN, "issuer", status.IRSA.IssuerURL)
+		if err := deleteOIDCProvider(ctx, log, awsClient, cp.Namespace, status.IRSA.OIDCProviderARN); err != nil {
+			return err
+		}
+	}
+
+	// The provider is looked up by the issuer URL, so that it is also found if the status was lost. There can only be
+	// one provider per issuer URL in an account, hence a provider which is not owned by the shoot can't be used.
+	provider, err := awsClient.FindOpenIDConnectProviderByURL(ctx, issuerURL)
+	if err != nil {
+		return fmt.Errorf("could not find IAM OpenID Connect provider for issuer %s: %w", issuerURL, err)
+	}
+	if provider != nil && !isOwnedOIDCProvider(provider, cp.Namespace) {
+		return fmt.Errorf("IAM OpenID Connect provider %s for issuer %s exists already, but it is not tagged with %s", provider.ARN, issuerURL, clusterTagKey(cp.Namespace))
+	}
+	if provider != nil && !slices.Contains(provider.ClientIDs, irsaClientID) {
+		log.Info("Adding client ID to IAM OpenID Connect provider", "arn", provider.ARN, "clientID", irsaClientID)
+		if err := awsClient.AddClientIDToOpenIDConnectProvider(ctx, provider.ARN, irsaClientID); err != nil {
+			return fmt.Errorf("could not add client ID %s to IAM OpenID Connect provider %s: %w", irsaClientID, provider.ARN, err)
+		}
+	}
+	if provider == nil {
+		thumbprint, err := thumbprintFunc(ctx, issuerURL)
+		if err != nil {
+			return fmt.Errorf("could not determine certificate thumbprint of issuer %s: %w", issuerURL, err)
+		}
+		log.Info("Creating IAM OpenID Connect provider", "issuer", issuerURL)
+		provider, err = awsClient.CreateOpenIDConnectProvider(ctx, &awsclient.OpenIDConnectProvider{
+			URL:         issuerURL,
+			ClientIDs:   []string{irsaClientID},
+			Thumbprints: []string{thumbprint},
+			Tags: awsclient.Tags{
+				clusterTagKey(cp.Namespace): "1",
+				"Name":                      cp.Namespace,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("could not create IAM OpenID Connect provider for issuer %s: %w", issuerURL, err)
+		}
+	}
+
+	status.IRSA = &apisaws.IRSAStatus{
+		OIDCProviderARN: provider.ARN,
+		IssuerURL:       issuerURL,
+	}
+	return a.updateStatus(ctx, cp, status)
+}
+
+func (a *actuator) deleteOIDCProvider(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) error {
+	if !isNormalPurpose(cp) {
+		return nil
+	}
+
+	cpConfig, status, err := a.decode(cp)
+	if err != nil {
+		return err
+	}
+
+	var arn string
+	if status.IRSA != nil {
+		arn = status.IRSA.OIDCProviderARN
+	} else if cpConfig.IRSA == nil || !cpConfig.IRSA.Enabled || getServiceAccountIssuer(cluster) == "" {
+		return nil
+	}
+
+	awsClient, err := a.newAWSClient(ctx, cp)
+	if err != nil {
+		return err
+	}
+
+	if arn == "" {
+		provider, err := awsClient.FindOpenIDConnectProviderByURL(ctx, getServiceAccountIssuer(cluster))
+		if err != nil {
+			return fmt.Errorf("could not find IAM OpenID Connect provider: %w", err)
+		}
+		if provider == nil {
+			return nil
+		}
+		arn = provider.ARN
+	}
+
+	return deleteOIDCProvider(ctx, log, awsClient, cp.Namespace, arn)
+}
+
+// deleteOIDCProvider deletes the IAM OpenID Connect provider with the given ARN if it is owned by the shoot in the
+// given namespace. Providers which are not owned by the shoot are left untouched.
+func deleteOIDCProvider(ctx context.Context, log logr.Logger, awsClient awsclient.Interface, namespace, arn string) error {
+	provider, err := awsClient.GetOpenIDConnectProvider(ctx, arn)
+	if err != nil {
+		return fmt.Errorf("could not get IAM OpenID Connect provider %s: %w", arn, err)
+	}
+	if provider == nil {
+		return nil
+	}
+	if !isOwnedOIDCProvider(provider, namespace) {
+		log.Info("Skipping deletion of IAM OpenID Connect provider which is not owned by the shoot", "arn", arn)
+		return nil
+	}
+
+	log.Info("Deleting IAM OpenID Connect provider", "arn", arn)
+	if err := awsClient.DeleteOpenIDConnectProvider(ctx, arn); err != nil {
+		return fmt.Errorf("could not delete IAM OpenID Connect provider %s: %w", arn, err)
+	}
+	return nil
+}
+
+func isOwnedOIDCProvider(provider *awsclient.OpenIDConnectProvider, namespace string) bool {
+	_, ok := provider.Tags[clusterTagKey(namespace)]
+	return ok
+}
+
+func clusterTagKey(namespace string) string {
+	return fmt.Sprintf("kubernetes.io/cluster/%s", namespace)
+}
+
+func (a *actuator) decode(cp *extensionsv1alpha1.ControlPlane) (*apisaws.ControlPlaneConfig, *apisaws.ControlPlaneStatus, error) {
+	cpConfig := &apisaws.ControlPlaneConfig{}
+	if cp.Spec.ProviderConfig != nil {
+		if _, _, err := a.decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, cpConfig); err != nil {
+			return nil, nil, fmt.Errorf("could not decode providerConfig of controlplane '%s': %w", kutil.ObjectName(cp), err)
+		}
+	}
+
+	status := &apisaws.ControlPlaneStatus{}
+	if cp.Status.ProviderStatus != nil && cp.Status.ProviderStatus.Raw != nil {
+		if _, _, err := a.decoder.Decode(cp.Status.ProviderStatus.Raw, nil, status); err != nil {
+			return nil, nil, fmt.Errorf("could not decode providerStatus of controlplane '%s': %w", kutil.ObjectName(cp), err)
+		}
+	}
+
+	return cpConfig, status, nil
+}
+
+func (a *actuator) updateStatus(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, status *apisaws.ControlPlaneStatus) error {
+	out := &awsv1alpha1.ControlPlaneStatus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: awsv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "ControlPlaneStatus",
+		},
+	}
+	if err := awsv1alpha1.Convert_aws_ControlPlaneStatus_To_v1alpha1_ControlPlaneStatus(status, out, nil); err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(cp.DeepCopy())
+	cp.Status.ProviderStatus = &runtime.RawExtension{Object: out}
+	return a.client.Status().Patch(ctx, cp, patch)
+}
+
+func (a *actuator) newAWSClient(ctx context.Context, cp *extensionsv1alpha1.ControlPlane) (awsclient.Interface, error) {
+	credentials, err := aws.GetCredentialsFromSecretRef(ctx, a.client, cp.Spec.SecretRef, false)
+	if err != nil {
+		return nil, fmt.Errorf("could not get AWS credentials: %w", err)
+	}
+	authConfig := aws.NewAuthConfig(credentials, cp.Spec.Region)
+	authConfig.Shoot = cp.Namespace
+	return a.awsClientFactory.NewClient(authConfig)
+}
+
+func isNormalPurpose(cp *extensionsv1alpha1.ControlPlane) bool {
+	return cp.Spec.Purpose == nil || *cp.Spec.Purpose == extensionsv1alpha1.Normal
+}
+
+func getServiceAccountIssuer(cluster *extensionscontroller.Cluster) string {
+	if cluster == nil || cluster.Shoot == nil || cluster.Shoot.Spec.Kubernetes.KubeAPIServer == nil ||
+		cluster.Shoot.Spec.Kubernetes.KubeAPIServer.ServiceAccountConfig == nil ||
+		cluster.Shoot.Spec.Kubernetes.KubeAPIServer.ServiceAccountConfig.Issuer == nil {
+		return ""
+	}
+	return *cluster.Shoot.Spec.Kubernetes.KubeAPIServer.ServiceAccountConfig.Issuer
+}
+
+// getThumbprint returns the SHA-1 thumbprint of the top certificate of the chain which is served for the host of the
+// given issuer URL, as expected by IAM for OpenID Connect providers.
+func getThumbprint(ctx context.Context, issuerURL string) (string, error) {
+	u, err := url.Parse(issuerURL)
+	if err != nil {
+		return "", err
+	}
+	port := u.Port()
+	if port == "" {
+		port = "443"
+	}
+
+	dialer := &tls.Dialer{Config: &tls.Config{ServerName: u.Hostname(), MinVersion: tls.VersionTLS12}}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(u.Hostname(), port))
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	certs := conn.(*tls.Conn).ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return "", fmt.Errorf("no certificates served for %s", u.Host)
+	}
+	sum := sha1.Sum(certs[len(certs)-1].Raw) // #nosec G401 -- see import
+	return hex.EncodeToString(sum[:]), nil
+}
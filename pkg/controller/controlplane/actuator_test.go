@@ -0,0 +1,545 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"encoding/json"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/controlplane"
+	mockcontrolplane "github.com/gardener/gardener/extensions/pkg/controller/controlplane/mock"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	mockmanager "github.com/gardener/gardener/pkg/mock/controller-runtime/manager"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	apisawsv1alpha1 "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/config"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	mockawsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/mock"
+)
+
+var _ = Describe("Actuator", func() {
+	const (
+		issuerURL   = "https://discovery.example.com/projects/foo/shoots/bar/issuer"
+		providerARN = "arn:aws:iam::123456789012:oidc-provider/discovery.example.com/projects/foo/shoots/bar/issuer"
+		queueName   = namespace + "-karpenter"
+		queueURL    = "https://sqs.eu-west-1.amazonaws.com/123456789012/" + queueName
+		queueARN    = "arn:aws:sqs:eu-west-1:123456789012:" + queueName
+	)
+
+	var (
+		ctx = context.TODO()
+		log = logr.Discard()
+
+		ctrl             *gomock.Controller
+		fakeClient       client.Client
+		innerActuator    *mockcontrolplane.MockActuator
+		awsClientFactory *mockawsclient.MockFactory
+		awsClient        *mockawsclient.MockInterface
+		a                controlplane.Actuator
+
+		cp      *extensionsv1alpha1.ControlPlane
+		cluster *extensionscontroller.Cluster
+
+		oldThumbprintFunc func(context.Context, string) (string, error)
+
+		encode = func(obj runtime.Object) []byte {
+			data, _ := json.Marshal(obj)
+			return data
+		}
+		getStatus = func() *apisawsv1alpha1.ControlPlaneStatus {
+			current := &extensionsv1alpha1.ControlPlane{}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(cp), current)).To(Succeed())
+			if current.Status.ProviderStatus == nil {
+				return nil
+			}
+			status := &apisawsv1alpha1.ControlPlaneStatus{}
+			Expect(json.Unmarshal(current.Status.ProviderStatus.Raw, status)).To(Succeed())
+			return status
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		scheme := runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(apisaws.AddToScheme(scheme)).To(Succeed())
+		Expect(apisawsv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		cp = &extensionsv1alpha1.ControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "control-plane", Namespace: namespace},
+			Spec: extensionsv1alpha1.ControlPlaneSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					ProviderConfig: &runtime.RawExtension{Raw: encode(&apisawsv1alpha1.ControlPlaneConfig{
+						TypeMeta: metav1.TypeMeta{APIVersion: apisawsv1alpha1.SchemeGroupVersion.String(), Kind: "ControlPlaneConfig"},
+						IRSA:     &apisawsv1alpha1.IRSAConfig{Enabled: true},
+					})},
+				},
+				Region:    "eu-west-1",
+				SecretRef: corev1.SecretReference{Name: "cloudprovider", Namespace: namespace},
+			},
+		}
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloudprovider", Namespace: namespace},
+			Data: map[string][]byte{
+				aws.AccessKeyID:     []byte("accessKeyID"),
+				aws.SecretAccessKey: []byte("secretAccessKey"),
+			},
+		}
+		cluster = &extensionscontroller.Cluster{
+			Shoot: &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Kubernetes: gardencorev1beta1.Kubernetes{
+						KubeAPIServer: &gardencorev1beta1.KubeAPIServerConfig{
+							ServiceAccountConfig: &gardencorev1beta1.ServiceAccountConfig{
+								Issuer: pointer.String(issuerURL),
+							},
+						},
+					},
+				},
+			},
+		}
+
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(cp, secret).WithStatusSubresource(cp).Build()
+		mgr := mockmanager.NewMockManager(ctrl)
+		mgr.EXPECT().GetClient().Return(fakeClient)
+		mgr.EXPECT().GetScheme().Return(scheme)
+
+		innerActuator = mockcontrolplane.NewMockActuator(ctrl)
+		awsClientFactory = mockawsclient.NewMockFactory(ctrl)
+		awsClient = mockawsclient.NewMockInterface(ctrl)
+		a = NewActuator(mgr, innerActuator, awsClientFactory, nil)
+
+		oldThumbprintFunc = thumbprintFunc
+		thumbprintFunc = func(_ context.Context, url string) (string, error) {
+			Expect(url).To(Equal(issuerURL))
+			return "9e99a48a9960b14926bb7f3b02e22da2b0ab7280", nil
+		}
+	})
+
+	AfterEach(func() {
+		thumbprintFunc = oldThumbprintFunc
+		ctrl.Finish()
+	})
+
+	Describe("#Reconcile", func() {
+		It("should create the OpenID Connect provider and report it in the status", func() {
+			innerActuator.EXPECT().Reconcile(ctx, log, cp, cluster).Return(false, nil)
+			awsClientFactory.EXPECT().NewClient(awsclient.AuthConfig{AccessKeyID: "accessKeyID", SecretAccessKey: "secretAccessKey", Region: "eu-west-1", Shoot: namespace}).Return(awsClient, nil)
+			awsClient.EXPECT().FindOpenIDConnectProviderByURL(ctx, issuerURL).Return(nil, nil)
+			awsClient.EXPECT().CreateOpenIDConnectProvider(ctx, &awsclient.OpenIDConnectProvider{
+				URL:         issuerURL,
+				ClientIDs:   []string{"sts.amazonaws.com"},
+				Thumbprints: []string{"9e99a48a9960b14926bb7f3b02e22da2b0ab7280"},
+				Tags: awsclient.Tags{
+					"kubernetes.io/cluster/" + namespace: "1",
+					"Name":                               namespace,
+				},
+			}).Return(&awsclient.OpenIDConnectProvider{ARN: providerARN, URL: issuerURL}, nil)
+
+			requeue, err := a.Reconcile(ctx, log, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requeue).To(BeFalse())
+			Expect(getStatus().IRSA).To(Equal(&apisawsv1alpha1.IRSAStatus{OIDCProviderARN: providerARN, IssuerURL: issuerURL}))
+		})
+
+		It("should not create the OpenID Connect provider if the shoot owns it already", func() {
+			innerActuator.EXPECT().Reconcile(ctx, log, cp, cluster).Return(false, nil)
+			awsClientFactory.EXPECT().NewClient(awsclient.AuthConfig{AccessKeyID: "accessKeyID", SecretAccessKey: "secretAccessKey", Region: "eu-west-1", Shoot: namespace}).Return(awsClient, nil)
+			awsClient.EXPECT().FindOpenIDConnectProviderByURL(ctx, issuerURL).Return(&awsclient.OpenIDConnectProvider{
+				ARN:       providerARN,
+				URL:       issuerURL,
+				ClientIDs: []string{"sts.amazonaws.com"},
+				Tags:      awsclient.Tags{"kubernetes.io/cluster/" + namespace: "1"},
+			}, nil)
+
+			_, err := a.Reconcile(ctx, log, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getStatus().IRSA).To(Equal(&apisawsv1alpha1.IRSAStatus{OIDCProviderARN: providerARN, IssuerURL: issuerURL}))
+		})
+
+		It("should add the client ID to the OpenID Connect provider if it is missing", func() {
+			innerActuator.EXPECT().Reconcile(ctx, log, cp, cluster).Return(false, nil)
+			awsClientFactory.EXPECT().NewClient(awsclient.AuthConfig{AccessKeyID: "accessKeyID", SecretAccessKey: "secretAccessKey", Region: "eu-west-1", Shoot: namespace}).Return(awsClient, nil)
+			awsClient.EXPECT().FindOpenIDConnectProviderByURL(ctx, issuerURL).Return(&awsclient.OpenIDConnectProvider{
+				ARN:  providerARN,
+				URL:  issuerURL,
+				Tags: awsclient.Tags{"kubernetes.io/cluster/" + namespace: "1"},
+			}, nil)
+			awsClient.EXPECT().AddClientIDToOpenIDConnectProvider(ctx, providerARN, "sts.amazonaws.com")
+
+			_, err := a.Reconcile(ctx, log, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getStatus().IRSA).To(Equal(&apisawsv1alpha1.IRSAStatus{OIDCProviderARN: providerARN, IssuerURL: issuerURL}))
+		})
+
+		It("should fail if the OpenID Connect provider exists already but is not owned by the shoot", func() {
+			innerActuator.EXPECT().Reconcile(ctx, log, cp, cluster).Return(false, nil)
+			awsClientFactory.EXPECT().NewClient(awsclient.AuthConfig{AccessKeyID: "accessKeyID", SecretAccessKey: "secretAccessKey", Region: "eu-west-1", Shoot: namespace}).Return(awsClient, nil)
+			awsClient.EXPECT().FindOpenIDConnectProviderByURL(ctx, issuerURL).Return(&awsclient.OpenIDConnectProvider{
+				ARN:       providerARN,
+				URL:       issuerURL,
+				ClientIDs: []string{"sts.amazonaws.com"},
+				Tags:      awsclient.Tags{},
+			}, nil)
+
+			_, err := a.Reconcile(ctx, log, cp, cluster)
+			Expect(err).To(MatchError(ContainSubstring("is not tagged with kubernetes.io/cluster/" + namespace)))
+		})
+
+		It("should delete the OpenID Connect provider if IRSA was disabled", func() {
+			cp.Status.ProviderStatus = &runtime.RawExtension{Raw: encode(&apisawsv1alpha1.ControlPlaneStatus{
+				TypeMeta: metav1.TypeMeta{APIVersion: apisawsv1alpha1.SchemeGroupVersion.String(), Kind: "ControlPlaneStatus"},
+				IRSA:     &apisawsv1alpha1.IRSAStatus{OIDCProviderARN: providerARN, IssuerURL: issuerURL},
+			})}
+			Expect(fakeClient.Status().Update(ctx, cp)).To(Succeed())
+			cp.Spec.ProviderConfig = nil
+
+			innerActuator.EXPECT().Reconcile(ctx, log, cp, cluster).Return(false, nil)
+			awsClientFactory.EXPECT().NewClient(awsclient.AuthConfig{AccessKeyID: "accessKeyID", SecretAccessKey: "secretAccessKey", Region: "eu-west-1", Shoot: namespace}).Return(awsClient, nil)
+			awsClient.EXPECT().GetOpenIDConnectProvider(ctx, providerARN).Return(&awsclient.OpenIDConnectProvider{
+				ARN:  providerARN,
+				Tags: awsclient.Tags{"kubernetes.io/cluster/" + namespace: "1"},
+			}, nil)
+			awsClient.EXPECT().DeleteOpenIDConnectProvider(ctx, providerARN)
+
+			_, err := a.Reconcile(ctx, log, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getStatus().IRSA).To(BeNil())
+		})
+
+		It("should fail if the shoot has no service account issuer", func() {
+			cluster.Shoot.Spec.Kubernetes.KubeAPIServer = nil
+			innerActuator.EXPECT().Reconcile(ctx, log, cp, cluster).Return(false, nil)
+
+			_, err := a.Reconcile(ctx, log, cp, cluster)
+			Expect(err).To(MatchError(ContainSubstring("service account issuer")))
+		})
+	})
+
+	Describe("#Delete", func() {
+		It("should delete the OpenID Connect provider before the control plane", func() {
+			cp.Status.ProviderStatus = &runtime.RawExtension{Raw: encode(&apisawsv1alpha1.ControlPlaneStatus{
+				TypeMeta: metav1.TypeMeta{APIVersion: apisawsv1alpha1.SchemeGroupVersion.String(), Kind: "ControlPlaneStatus"},
+				IRSA:     &apisawsv1alpha1.IRSAStatus{OIDCProviderARN: providerARN, IssuerURL: issuerURL},
+			})}
+
+			awsClientFactory.EXPECT().NewClient(awsclient.AuthConfig{AccessKeyID: "accessKeyID", SecretAccessKey: "secretAccessKey", Region: "eu-west-1", Shoot: namespace}).Return(awsClient, nil)
+			gomock.InOrder(
+				awsClient.EXPECT().GetOpenIDConnectProvider(ctx, providerARN).Return(&awsclient.OpenIDConnectProvider{
+					ARN:  providerARN,
+					Tags: awsclient.Tags{"kubernetes.io/cluster/" + namespace: "1"},
+				}, nil),
+				awsClient.EXPECT().DeleteOpenIDConnectProvider(ctx, providerARN),
+				innerActuator.EXPECT().Delete(ctx, log, cp, cluster),
+			)
+
+			Expect(a.Delete(ctx, log, cp, cluster)).To(Succeed())
+		})
+
+		It("should not delete an OpenID Connect provider which is not owned by the shoot", func() {
+			awsClientFactory.EXPECT().NewClient(awsclient.AuthConfig{AccessKeyID: "accessKeyID", SecretAccessKey: "secretAccessKey", Region: "eu-west-1", Shoot: namespace}).Return(awsClient, nil)
+			awsClient.EXPECT().FindOpenIDConnectProviderByURL(ctx, issuerURL).Return(&awsclient.OpenIDConnectProvider{ARN: providerARN, URL: issuerURL, Tags: awsclient.Tags{}}, nil)
+			awsClient.EXPECT().GetOpenIDConnectProvider(ctx, providerARN).Return(&awsclient.OpenIDConnectProvider{ARN: providerARN, Tags: awsclient.Tags{}}, nil)
+			innerActuator.EXPECT().Delete(ctx, log, cp, cluster)
+
+			Expect(a.Delete(ctx, log, cp, cluster)).To(Succeed())
+		})
+
+		It("should terminate the instances of Karpenter and delete its interruption queue", func() {
+			cp.Spec.ProviderConfig = &runtime.RawExtension{Raw: encode(&apisawsv1alpha1.ControlPlaneConfig{
+				TypeMeta:  metav1.TypeMeta{APIVersion: apisawsv1alpha1.SchemeGroupVersion.String(), Kind: "ControlPlaneConfig"},
+				Karpenter: &apisawsv1alpha1.KarpenterConfig{Enabled: true},
+			})}
+
+			awsClientFactory.EXPECT().NewClient(awsclient.AuthConfig{AccessKeyID: "accessKeyID", SecretAccessKey: "secretAccessKey", Region: "eu-west-1", Shoot: namespace}).Return(awsClient, nil)
+			awsClient.EXPECT().FindInstancesByTags(ctx, awsclient.Tags{"karpenter.sh/managed-by": namespace}).Return([]*awsclient.Instance{{InstanceId: "i-1"}}, nil)
+			awsClient.EXPECT().TerminateInstance(ctx, "i-1")
+			for _, suffix := range []string{"scheduled-change", "spot-interruption", "rebalance", "instance-state"} {
+				awsClient.EXPECT().DeleteEventRule(ctx, queueName+"-"+suffix)
+			}
+			awsClient.EXPECT().GetQueue(ctx, queueName).Return(&awsclient.Queue{QueueName: queueName, QueueURL: queueURL}, nil)
+			awsClient.EXPECT().DeleteQueue(ctx, queueURL)
+			innerActuator.EXPECT().Delete(ctx, log, cp, cluster)
+
+			Expect(a.Delete(ctx, log, cp, cluster)).To(Succeed())
+		})
+
+		It("should terminate the instances of Karpenter if it was enabled before", func() {
+			cp.Spec.ProviderConfig = &runtime.RawExtension{Raw: encode(&apisawsv1alpha1.ControlPlaneConfig{
+				TypeMeta: metav1.TypeMeta{APIVersion: apisawsv1alpha1.SchemeGroupVersion.String(), Kind: "ControlPlaneConfig"},
+			})}
+			cp.Status.ProviderStatus = &runtime.RawExtension{Raw: encode(&apisawsv1alpha1.ControlPlaneStatus{
+				TypeMeta:  metav1.TypeMeta{APIVersion: apisawsv1alpha1.SchemeGroupVersion.String(), Kind: "ControlPlaneStatus"},
+				Karpenter: &apisawsv1alpha1.KarpenterStatus{},
+			})}
+
+			awsClientFactory.EXPECT().NewClient(awsclient.AuthConfig{AccessKeyID: "accessKeyID", SecretAccessKey: "secretAccessKey", Region: "eu-west-1", Shoot: namespace}).Return(awsClient, nil)
+			awsClient.EXPECT().FindInstancesByTags(ctx, awsclient.Tags{"karpenter.sh/managed-by": namespace}).Return([]*awsclient.Instance{{InstanceId: "i-1"}}, nil)
+			awsClient.EXPECT().TerminateInstance(ctx, "i-1")
+			innerActuator.EXPECT().Delete(ctx, log, cp, cluster)
+
+			Expect(a.Delete(ctx, log, cp, cluster)).To(Succeed())
+		})
+	})
+
+	Describe("#Reconcile with PrivateLink", func() {
+		const (
+			hostname        = "a1b2c3-123.elb.eu-central-1.amazonaws.com"
+			loadBalancerARN = "arn:aws:elasticloadbalancing:eu-central-1:210987654321:loadbalancer/net/a1b2c3/123"
+			serviceID       = "vpce-svc-0123456789abcdef0"
+			serviceName     = "com.amazonaws.vpce.eu-central-1." + serviceID
+		)
+
+		var seedAuthConfig = awsclient.AuthConfig{AccessKeyID: "seedAccessKeyID", SecretAccessKey: "seedSecretAccessKey", Region: "eu-central-1"}
+
+		BeforeEach(func() {
+			cp.Spec.ProviderConfig = &runtime.RawExtension{Raw: encode(&apisawsv1alpha1.ControlPlaneConfig{
+				TypeMeta: metav1.TypeMeta{APIVersion: apisawsv1alpha1.SchemeGroupVersion.String(), Kind: "ControlPlaneConfig"},
+				PrivateLink: &apisawsv1alpha1.PrivateLinkConfig{
+					Enabled:           true,
+					AllowedPrincipals: []string{"arn:aws:iam::123456789012:root", "arn:aws:iam::111111111111:root"},
+				},
+			})}
+			cluster.Seed = &gardencorev1beta1.Seed{Spec: gardencorev1beta1.SeedSpec{Provider: gardencorev1beta1.SeedProvider{Region: "eu-central-1"}}}
+
+			Expect(fakeClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "seed-credentials", Namespace: "garden"},
+				Data: map[string][]byte{
+					aws.AccessKeyID:     []byte("seedAccessKeyID"),
+					aws.SecretAccessKey: []byte("seedSecretAccessKey"),
+				},
+			})).To(Succeed())
+			mgr := mockmanager.NewMockManager(ctrl)
+			mgr.EXPECT().GetClient().Return(fakeClient)
+			mgr.EXPECT().GetScheme().Return(fakeClient.Scheme())
+			a = NewActuator(mgr, innerActuator, awsClientFactory, &config.PrivateLinkConfig{
+				SecretRef: corev1.SecretReference{Name: "seed-credentials", Namespace: "garden"},
+			})
+		})
+
+		It("should create the load balancer service and wait until it has been provisioned", func() {
+			innerActuator.EXPECT().Reconcile(ctx, log, cp, cluster).Return(false, nil)
+
+			_, err := a.Reconcile(ctx, log, cp, cluster)
+			Expect(err).To(MatchError(ContainSubstring("has not been provisioned yet")))
+
+			service := &corev1.Service{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "kube-apiserver-privatelink", Namespace: namespace}, service)).To(Succeed())
+			Expect(service.Spec.Type).To(Equal(corev1.ServiceTypeLoadBalancer))
+			Expect(service.Annotations).To(HaveKeyWithValue("service.beta.kubernetes.io/aws-load-balancer-internal", "true"))
+			Expect(service.Spec.Selector).To(Equal(map[string]string{"app": "kubernetes", "role": "apiserver"}))
+		})
+
+		It("should create the endpoint service, update its principals and report it in the status", func() {
+			service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-privatelink", Namespace: namespace}}
+			Expect(fakeClient.Create(ctx, service)).To(Succeed())
+			service.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{Hostname: hostname}}
+			Expect(fakeClient.Status().Update(ctx, service)).To(Succeed())
+
+			innerActuator.EXPECT().Reconcile(ctx, log, cp, cluster).Return(false, nil)
+			awsClientFactory.EXPECT().NewClient(seedAuthConfig).Return(awsClient, nil)
+			awsClient.EXPECT().FindLoadBalancerARNByDNSName(ctx, hostname).Return(loadBalancerARN, nil)
+			awsClient.EXPECT().CreateVpcEndpointService(ctx, &awsclient.VpcEndpointService{
+				Tags: awsclient.Tags{
+					"kubernetes.io/cluster/" + namespace: "1",
+					"Name":                               namespace + "-kube-apiserver",
+				},
+				NetworkLoadBalancerARNs: []string{loadBalancerARN},
+			}).Return(&awsclient.VpcEndpointService{ServiceId: serviceID, ServiceName: serviceName, BaseEndpointDnsNames: []string{serviceID + ".eu-central-1.vpce.amazonaws.com"}}, nil)
+			awsClient.EXPECT().GetVpcEndpointServicePrincipals(ctx, serviceID).Return([]string{"arn:aws:iam::123456789012:root", "arn:aws:iam::222222222222:root"}, nil)
+			awsClient.EXPECT().UpdateVpcEndpointServicePrincipals(ctx, serviceID, []string{"arn:aws:iam::111111111111:root"}, []string{"arn:aws:iam::222222222222:root"})
+
+			_, err := a.Reconcile(ctx, log, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getStatus().PrivateLink).To(Equal(&apisawsv1alpha1.PrivateLinkStatus{
+				ServiceID:            serviceID,
+				ServiceName:          serviceName,
+				BaseEndpointDNSNames: []string{serviceID + ".eu-central-1.vpce.amazonaws.com"},
+			}))
+		})
+
+		It("should delete the endpoint service and the load balancer service if PrivateLink was disabled", func() {
+			cp.Status.ProviderStatus = &runtime.RawExtension{Raw: encode(&apisawsv1alpha1.ControlPlaneStatus{
+				TypeMeta:    metav1.TypeMeta{APIVersion: apisawsv1alpha1.SchemeGroupVersion.String(), Kind: "ControlPlaneStatus"},
+				PrivateLink: &apisawsv1alpha1.PrivateLinkStatus{ServiceID: serviceID, ServiceName: serviceName},
+			})}
+			Expect(fakeClient.Status().Update(ctx, cp)).To(Succeed())
+			cp.Spec.ProviderConfig = nil
+			Expect(fakeClient.Create(ctx, &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-privatelink", Namespace: namespace}})).To(Succeed())
+
+			innerActuator.EXPECT().Reconcile(ctx, log, cp, cluster).Return(false, nil)
+			awsClientFactory.EXPECT().NewClient(seedAuthConfig).Return(awsClient, nil)
+			awsClient.EXPECT().DeleteVpcEndpointService(ctx, serviceID)
+
+			_, err := a.Reconcile(ctx, log, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getStatus().PrivateLink).To(BeNil())
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "kube-apiserver-privatelink", Namespace: namespace}, &corev1.Service{})).To(BeNotFoundError())
+		})
+	})
+
+	Describe("#Reconcile with Karpenter", func() {
+		BeforeEach(func() {
+			cp.Spec.ProviderConfig = &runtime.RawExtension{Raw: encode(&apisawsv1alpha1.ControlPlaneConfig{
+				TypeMeta:  metav1.TypeMeta{APIVersion: apisawsv1alpha1.SchemeGroupVersion.String(), Kind: "ControlPlaneConfig"},
+				Karpenter: &apisawsv1alpha1.KarpenterConfig{Enabled: true},
+			})}
+		})
+
+		It("should create the interruption queue and the event rules and report them in the status", func() {
+			innerActuator.EXPECT().Reconcile(ctx, log, cp, cluster).Return(false, nil)
+			awsClientFactory.EXPECT().NewClient(awsclient.AuthConfig{AccessKeyID: "accessKeyID", SecretAccessKey: "secretAccessKey", Region: "eu-west-1", Shoot: namespace}).Return(awsClient, nil)
+			awsClient.EXPECT().GetQueue(ctx, queueName).Return(nil, nil)
+			awsClient.EXPECT().CreateQueue(ctx, &awsclient.Queue{
+				QueueName: queueName,
+				Tags: awsclient.Tags{
+					"kubernetes.io/cluster/" + namespace: "1",
+					"Name":                               queueName,
+				},
+			}).Return(&awsclient.Queue{QueueName: queueName, QueueURL: queueURL, QueueARN: queueARN}, nil)
+			awsClient.EXPECT().UpdateQueueAttributes(ctx, queueURL, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, attributes map[string]string) error {
+				Expect(attributes).To(HaveKeyWithValue("MessageRetentionPeriod", "300"))
+				Expect(attributes).To(HaveKeyWithValue("Policy", ContainSubstring(queueARN)))
+				return nil
+			})
+			awsClient.EXPECT().PutEventRule(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, rule *awsclient.EventRule) (*awsclient.EventRule, error) {
+				Expect(rule.Name).To(BeElementOf(queueName+"-scheduled-change", queueName+"-spot-interruption", queueName+"-rebalance", queueName+"-instance-state"))
+				Expect(rule.TargetARN).To(Equal(queueARN))
+				return rule, nil
+			}).Times(4)
+
+			_, err := a.Reconcile(ctx, log, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getStatus().Karpenter).To(Equal(&apisawsv1alpha1.KarpenterStatus{InterruptionQueueName: queueName}))
+		})
+
+		It("should delete the interruption queue if interruption handling was disabled", func() {
+			cp.Status.ProviderStatus = &runtime.RawExtension{Raw: encode(&apisawsv1alpha1.ControlPlaneStatus{
+				TypeMeta:  metav1.TypeMeta{APIVersion: apisawsv1alpha1.SchemeGroupVersion.String(), Kind: "ControlPlaneStatus"},
+				Karpenter: &apisawsv1alpha1.KarpenterStatus{InterruptionQueueName: queueName},
+			})}
+			Expect(fakeClient.Status().Update(ctx, cp)).To(Succeed())
+			cp.Spec.ProviderConfig = &runtime.RawExtension{Raw: encode(&apisawsv1alpha1.ControlPlaneConfig{
+				TypeMeta:  metav1.TypeMeta{APIVersion: apisawsv1alpha1.SchemeGroupVersion.String(), Kind: "ControlPlaneConfig"},
+				Karpenter: &apisawsv1alpha1.KarpenterConfig{Enabled: true, InterruptionHandling: pointer.Bool(false)},
+			})}
+
+			innerActuator.EXPECT().Reconcile(ctx, log, cp, cluster).Return(false, nil)
+			awsClientFactory.EXPECT().NewClient(awsclient.AuthConfig{AccessKeyID: "accessKeyID", SecretAccessKey: "secretAccessKey", Region: "eu-west-1", Shoot: namespace}).Return(awsClient, nil)
+			awsClient.EXPECT().DeleteEventRule(ctx, gomock.Any()).Times(4)
+			awsClient.EXPECT().GetQueue(ctx, queueName).Return(&awsclient.Queue{QueueName: queueName, QueueURL: queueURL}, nil)
+			awsClient.EXPECT().DeleteQueue(ctx, queueURL)
+
+			_, err := a.Reconcile(ctx, log, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getStatus().Karpenter).To(Equal(&apisawsv1alpha1.KarpenterStatus{}))
+		})
+
+		It("should terminate the instances of Karpenter if the shoot is hibernated", func() {
+			cluster.Shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: pointer.Bool(true)}
+			cp.Spec.ProviderConfig = &runtime.RawExtension{Raw: encode(&apisawsv1alpha1.ControlPlaneConfig{
+				TypeMeta:  metav1.TypeMeta{APIVersion: apisawsv1alpha1.SchemeGroupVersion.String(), Kind: "ControlPlaneConfig"},
+				Karpenter: &apisawsv1alpha1.KarpenterConfig{Enabled: true, InterruptionHandling: pointer.Bool(false)},
+			})}
+
+			innerActuator.EXPECT().Reconcile(ctx, log, cp, cluster).Return(false, nil)
+			awsClientFactory.EXPECT().NewClient(awsclient.AuthConfig{AccessKeyID: "accessKeyID", SecretAccessKey: "secretAccessKey", Region: "eu-west-1", Shoot: namespace}).Return(awsClient, nil)
+			awsClient.EXPECT().FindInstancesByTags(ctx, awsclient.Tags{"karpenter.sh/managed-by": namespace}).Return([]*awsclient.Instance{{InstanceId: "i-1"}}, nil)
+			awsClient.EXPECT().TerminateInstance(ctx, "i-1")
+
+			_, err := a.Reconcile(ctx, log, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getStatus().Karpenter).To(Equal(&apisawsv1alpha1.KarpenterStatus{}))
+		})
+	})
+
+	Describe("#Reconcile with CloudWatch agent", func() {
+		const logGroupName = "/gardener/" + namespace + "/containers"
+
+		BeforeEach(func() {
+			cp.Spec.ProviderConfig = &runtime.RawExtension{Raw: encode(&apisawsv1alpha1.ControlPlaneConfig{
+				TypeMeta: metav1.TypeMeta{APIVersion: apisawsv1alpha1.SchemeGroupVersion.String(), Kind: "ControlPlaneConfig"},
+				CloudWatchAgent: &apisawsv1alpha1.CloudWatchAgentConfig{
+					Enabled: true,
+					Logs:    &apisawsv1alpha1.CloudWatchAgentLogs{Enabled: true, RetentionInDays: pointer.Int32(30)},
+				},
+			})}
+		})
+
+		It("should create the log group and the role policy and report them in the status", func() {
+			innerActuator.EXPECT().Reconcile(ctx, log, cp, cluster).Return(false, nil)
+			awsClientFactory.EXPECT().NewClient(awsclient.AuthConfig{AccessKeyID: "accessKeyID", SecretAccessKey: "secretAccessKey", Region: "eu-west-1", Shoot: namespace}).Return(awsClient, nil)
+			awsClient.EXPECT().GetLogGroup(ctx, logGroupName).Return(nil, nil)
+			awsClient.EXPECT().CreateLogGroup(ctx, &awsclient.LogGroup{
+				Tags:            awsclient.Tags{"kubernetes.io/cluster/" + namespace: "1"},
+				LogGroupName:    logGroupName,
+				RetentionInDays: pointer.Int32(30),
+			}).Return(&awsclient.LogGroup{LogGroupName: logGroupName, Arn: "arn:aws:logs:eu-west-1:123456789012:log-group:" + logGroupName}, nil)
+			awsClient.EXPECT().GetIAMRolePolicy(ctx, namespace+"-cloudwatch-agent", namespace+"-nodes").Return(nil, nil)
+			awsClient.EXPECT().PutIAMRolePolicy(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, policy *awsclient.IAMRolePolicy) error {
+				Expect(policy.PolicyName).To(Equal(namespace + "-cloudwatch-agent"))
+				Expect(policy.RoleName).To(Equal(namespace + "-nodes"))
+				Expect(policy.PolicyDocument).To(ContainSubstring("cloudwatch:PutMetricData"))
+				Expect(policy.PolicyDocument).To(ContainSubstring("arn:aws:logs:eu-west-1:123456789012:log-group:" + logGroupName + ":*"))
+				return nil
+			})
+
+			_, err := a.Reconcile(ctx, log, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getStatus().CloudWatchAgent).To(Equal(&apisawsv1alpha1.CloudWatchAgentStatus{
+				RolePolicyName: namespace + "-cloudwatch-agent",
+				LogGroupName:   pointer.String(logGroupName),
+			}))
+		})
+
+		It("should delete the log group and the role policy if the CloudWatch agent was disabled", func() {
+			cp.Status.ProviderStatus = &runtime.RawExtension{Raw: encode(&apisawsv1alpha1.ControlPlaneStatus{
+				TypeMeta: metav1.TypeMeta{APIVersion: apisawsv1alpha1.SchemeGroupVersion.String(), Kind: "ControlPlaneStatus"},
+				CloudWatchAgent: &apisawsv1alpha1.CloudWatchAgentStatus{
+					RolePolicyName: namespace + "-cloudwatch-agent",
+					LogGroupName:   pointer.String(logGroupName),
+				},
+			})}
+			Expect(fakeClient.Status().Update(ctx, cp)).To(Succeed())
+			cp.Spec.ProviderConfig = nil
+
+			innerActuator.EXPECT().Reconcile(ctx, log, cp, cluster).Return(false, nil)
+			awsClientFactory.EXPECT().NewClient(awsclient.AuthConfig{AccessKeyID: "accessKeyID", SecretAccessKey: "secretAccessKey", Region: "eu-west-1", Shoot: namespace}).Return(awsClient, nil)
+			awsClient.EXPECT().DeleteIAMRolePolicy(ctx, namespace+"-cloudwatch-agent", namespace+"-nodes")
+			awsClient.EXPECT().DeleteLogGroup(ctx, logGroupName)
+
+			_, err := a.Reconcile(ctx, log, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getStatus().CloudWatchAgent).To(BeNil())
+		})
+	})
+})
@@ -26,7 +26,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener-extension-provider-aws/imagevector"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 )
 
 var (
@@ -44,6 +46,8 @@ type AddOptions struct {
 	ShootWebhookConfig *atomic.Value
 	// WebhookServerNamespace is the namespace in which the webhook server runs.
 	WebhookServerNamespace string
+	// PrivateLink is the configuration of the exposure of kube-apiservers as VPC endpoint services.
+	PrivateLink *config.PrivateLinkConfig
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
@@ -60,7 +64,7 @@ func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddO
 	}
 
 	return controlplane.Add(ctx, mgr, controlplane.AddArgs{
-		Actuator:          actuator,
+		Actuator:          NewActuator(mgr, actuator, awsclient.NewControllerFactory(awsclient.FactoryFunc(awsclient.NewInterface), controlplane.ControllerName), opts.PrivateLink),
 		ControllerOptions: opts.Controller,
 		Predicates:        controlplane.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation),
 		Type:              aws.Type,
@@ -0,0 +1,220 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	"k8s.io/utils/pointer"
+
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// cloudWatchAgentMetricsNamespace is the CloudWatch namespace of the metrics published by the CloudWatch agent.
+const cloudWatchAgentMetricsNamespace = "CWAgent"
+
+// cloudWatchAgentRolePolicyName returns the name of the inline policy of the nodes role for the CloudWatch agent.
+func cloudWatchAgentRolePolicyName(namespace string) string {
+	return namespace + "-cloudwatch-agent"
+}
+
+// cloudWatchAgentLogGroupName returns the name of the log group receiving the container logs of the nodes.
+func cloudWatchAgentLogGroupName(namespace string) string {
+	return "/gardener/" + namespace + "/containers"
+}
+
+// cloudWatchAgentRolePolicyDocument returns the policy document which allows the CloudWatch agent to publish metrics
+// and, if the ARN of the log group is given, to write log events to this log group.
+func cloudWatchAgentRolePolicyDocument(logGroupARN string) (string, error) {
+	statements := []map[string]interface{}{
+		{
+			"Effect":    "Allow",
+			"Action":    []string{"cloudwatch:PutMetricData"},
+			"Resource":  "*",
+			"Condition": map[string]interface{}{"StringEquals": map[string]string{"cloudwatch:namespace": cloudWatchAgentMetricsNamespace}},
+		},
+		{
+			"Effect":   "Allow",
+			"Action":   []string{"ec2:DescribeTags", "ec2:DescribeVolumes"},
+			"Resource": "*",
+		},
+	}
+	if logGroupARN != "" {
+		statements = append(statements, map[string]interface{}{
+			"Effect":   "Allow",
+			"Action":   []string{"logs:CreateLogStream", "logs:DescribeLogStreams", "logs:PutLogEvents"},
+			"Resource": []string{logGroupARN, logGroupARN + ":*"},
+		})
+	}
+	document, err := json.Marshal(map[string]interface{}{
+		"Version":   "2012-10-17",
+		"Statement": statements,
+	})
+	return string(document), err
+}
+
+// reconcileCloudWatchAgent creates or updates the log group for the container logs and the inline policy of the nodes
+// role which allows the CloudWatch agent to publish the metrics and logs of the nodes. They are deleted if the
+// CloudWatch agent or its logs are disabled. If the nodes use an instance profile which isn't managed by the AWS
+// extension, the permissions must be granted by the owner of the instance profile.
+func (a *actuator) reconcileCloudWatchAgent(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) error {
+	if !isNormalPurpose(cp) {
+		return nil
+	}
+
+	cpConfig, status, err := a.decode(cp)
+	if err != nil {
+		return err
+	}
+
+	if !helper.IsCloudWatchAgentEnabled(cpConfig) {
+		if status.CloudWatchAgent == nil {
+			return nil
+		}
+		awsClient, err := a.newAWSClient(ctx, cp)
+		if err != nil {
+			return err
+		}
+		if err := deleteCloudWatchAgentResources(ctx, log, awsClient, cp.Namespace, status.CloudWatchAgent); err != nil {
+			return err
+		}
+		status.CloudWatchAgent = nil
+		return a.updateStatus(ctx, cp, status)
+	}
+
+	awsClient, err := a.newAWSClient(ctx, cp)
+	if err != nil {
+		return err
+	}
+
+	current := status.CloudWatchAgent
+	if current == nil {
+		current = &apisaws.CloudWatchAgentStatus{}
+	}
+	desired := &apisaws.CloudWatchAgentStatus{}
+
+	var logGroupARN string
+	if helper.IsCloudWatchAgentLogsEnabled(cpConfig) {
+		name := cloudWatchAgentLogGroupName(cp.Namespace)
+		retentionInDays := cpConfig.CloudWatchAgent.Logs.RetentionInDays
+		logGroup, err := awsClient.GetLogGroup(ctx, name)
+		if err != nil {
+			return fmt.Errorf("could not get log group %s: %w", name, err)
+		}
+		if logGroup == nil {
+			log.Info("Creating log group for CloudWatch agent", "name", name)
+			if logGroup, err = awsClient.CreateLogGroup(ctx, &awsclient.LogGroup{
+				Tags:            awsclient.Tags{fmt.Sprintf("kubernetes.io/cluster/%s", cp.Namespace): "1"},
+				LogGroupName:    name,
+				RetentionInDays: retentionInDays,
+			}); err != nil {
+				return fmt.Errorf("could not create log group %s: %w", name, err)
+			}
+		} else if !reflect.DeepEqual(logGroup.RetentionInDays, retentionInDays) {
+			if err := awsClient.UpdateLogGroupRetention(ctx, name, retentionInDays); err != nil {
+				return fmt.Errorf("could not update retention of log group %s: %w", name, err)
+			}
+		}
+		logGroupARN = logGroup.Arn
+		desired.LogGroupName = &name
+	} else if current.LogGroupName != nil {
+		log.Info("Deleting log group for CloudWatch agent", "name", *current.LogGroupName)
+		if err := awsClient.DeleteLogGroup(ctx, *current.LogGroupName); err != nil {
+			return fmt.Errorf("could not delete log group %s: %w", *current.LogGroupName, err)
+		}
+	}
+
+	infrastructureConfig, err := helper.InfrastructureConfigFromCluster(cluster)
+	if err != nil {
+		return err
+	}
+	roleName := cp.Namespace + "-nodes"
+	if helper.GetNodesInstanceProfileName(infrastructureConfig) == "" {
+		document, err := cloudWatchAgentRolePolicyDocument(logGroupARN)
+		if err != nil {
+			return err
+		}
+		policy := &awsclient.IAMRolePolicy{
+			PolicyName:     cloudWatchAgentRolePolicyName(cp.Namespace),
+			RoleName:       roleName,
+			PolicyDocument: document,
+		}
+		existing, err := awsClient.GetIAMRolePolicy(ctx, policy.PolicyName, policy.RoleName)
+		if err != nil {
+			return fmt.Errorf("could not get role policy %s: %w", policy.PolicyName, err)
+		}
+		if existing == nil || existing.PolicyDocument != policy.PolicyDocument {
+			if err := awsClient.PutIAMRolePolicy(ctx, policy); err != nil {
+				return fmt.Errorf("could not put role policy %s: %w", policy.PolicyName, err)
+			}
+		}
+		desired.RolePolicyName = policy.PolicyName
+	} else if current.RolePolicyName != "" {
+		if err := awsClient.DeleteIAMRolePolicy(ctx, current.RolePolicyName, roleName); err != nil {
+			return fmt.Errorf("could not delete role policy %s: %w", current.RolePolicyName, err)
+		}
+	}
+
+	if reflect.DeepEqual(status.CloudWatchAgent, desired) {
+		return nil
+	}
+	status.CloudWatchAgent = desired
+	return a.updateStatus(ctx, cp, status)
+}
+
+// deleteCloudWatchAgent deletes the log group and the inline policy of the nodes role of the CloudWatch agent. The
+// policy must be deleted before the nodes role can be deleted by the infrastructure controller.
+func (a *actuator) deleteCloudWatchAgent(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane) error {
+	if !isNormalPurpose(cp) {
+		return nil
+	}
+
+	_, status, err := a.decode(cp)
+	if err != nil {
+		return err
+	}
+	if status.CloudWatchAgent == nil {
+		return nil
+	}
+
+	awsClient, err := a.newAWSClient(ctx, cp)
+	if err != nil {
+		return err
+	}
+	return deleteCloudWatchAgentResources(ctx, log, awsClient, cp.Namespace, status.CloudWatchAgent)
+}
+
+func deleteCloudWatchAgentResources(ctx context.Context, log logr.Logger, awsClient awsclient.Interface, namespace string, status *apisaws.CloudWatchAgentStatus) error {
+	if status.RolePolicyName != "" {
+		if err := awsClient.DeleteIAMRolePolicy(ctx, status.RolePolicyName, namespace+"-nodes"); err != nil {
+			return fmt.Errorf("could not delete role policy %s: %w", status.RolePolicyName, err)
+		}
+	}
+	if name := pointer.StringDeref(status.LogGroupName, ""); name != "" {
+		log.Info("Deleting log group for CloudWatch agent", "name", name)
+		if err := awsClient.DeleteLogGroup(ctx, name); err != nil {
+			return fmt.Errorf("could not delete log group %s: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+const (
+	// karpenterInterruptionQueueTargetID is the ID of the target of the interruption event rules.
+	karpenterInterruptionQueueTargetID = "karpenter-interruption-queue"
+	// karpenterManagedByTag is the key of the tag which Karpenter adds to all of its instances, its value is the
+	// cluster name.
+	karpenterManagedByTag = "karpenter.sh/managed-by"
+)
+
+// karpenterInterruptionEventPatterns are the EventBridge event patterns of the events Karpenter handles by their rule
+// name suffixes.
+var karpenterInterruptionEventPatterns = map[string]string{
+	"scheduled-change":  `{"source":["aws.health"],"detail-type":["AWS Health Event"]}`,
+	"spot-interruption": `{"source":["aws.ec2"],"detail-type":["EC2 Spot Instance Interruption Warning"]}`,
+	"rebalance":         `{"source":["aws.ec2"],"detail-type":["EC2 Instance Rebalance Recommendation"]}`,
+	"instance-state":    `{"source":["aws.ec2"],"detail-type":["EC2 Instance State-change Notification"]}`,
+}
+
+// karpenterInterruptionQueueName returns the name of the SQS queue receiving the interruption events for Karpenter.
+func karpenterInterruptionQueueName(namespace string) string {
+	return namespace + "-karpenter"
+}
+
+// karpenterInterruptionEventRuleName returns the name of the EventBridge rule forwarding the events with the given
+// rule name suffix.
+func karpenterInterruptionEventRuleName(namespace, suffix string) string {
+	return karpenterInterruptionQueueName(namespace) + "-" + suffix
+}
+
+// karpenterInterruptionQueueAttributes returns the desired attributes of the interruption queue with the given ARN.
+// EventBridge is allowed to send messages to the queue, messages expire after 5 minutes as the interruption notices
+// are useless afterwards.
+func karpenterInterruptionQueueAttributes(queueARN string) (map[string]string, error) {
+	policy, err := json.Marshal(map[string]interface{}{
+		"Version": "2012-10-17",
+		"Statement": []map[string]interface{}{
+			{
+				"Effect":    "Allow",
+				"Principal": map[string]interface{}{"Service": []string{"events.amazonaws.com", "sqs.amazonaws.com"}},
+				"Action":    "sqs:SendMessage",
+				"Resource":  queueARN,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{
+		"MessageRetentionPeriod": "300",
+		"SqsManagedSseEnabled":   "true",
+		"Policy":                 string(policy),
+	}, nil
+}
+
+// reconcileKarpenter creates or updates the SQS queue and the EventBridge rules which notify Karpenter about
+// interruptions of its instances. They are deleted if Karpenter or its interruption handling is disabled. The status
+// records that Karpenter has been enabled, so that the instances it launched are terminated when the shoot is
+// hibernated or deleted, also if Karpenter has been disabled in the meantime.
+func (a *actuator) reconcileKarpenter(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) error {
+	if !isNormalPurpose(cp) {
+		return nil
+	}
+
+	cpConfig, status, err := a.decode(cp)
+	if err != nil {
+		return err
+	}
+
+	if !helper.IsKarpenterEnabled(cpConfig) && status.Karpenter == nil {
+		return nil
+	}
+
+	awsClient, err := a.newAWSClient(ctx, cp)
+	if err != nil {
+		return err
+	}
+
+	// The controller of Karpenter is scaled down during hibernation, hence the nodes it launched are not removed by it.
+	if extensionscontroller.IsHibernationEnabled(cluster) {
+		if err := terminateKarpenterInstances(ctx, log, awsClient, cp.Namespace); err != nil {
+			return err
+		}
+	}
+
+	desired := &apisaws.KarpenterStatus{}
+	if helper.IsKarpenterInterruptionHandlingEnabled(cpConfig) {
+		if desired.InterruptionQueueName, err = reconcileInterruptionQueue(ctx, log, awsClient, cp.Namespace); err != nil {
+			return err
+		}
+	} else if status.Karpenter != nil && status.Karpenter.InterruptionQueueName != "" {
+		if err := deleteInterruptionQueue(ctx, log, awsClient, cp.Namespace); err != nil {
+			return err
+		}
+	}
+
+	if status.Karpenter != nil && *status.Karpenter == *desired {
+		return nil
+	}
+	status.Karpenter = desired
+	return a.updateStatus(ctx, cp, status)
+}
+
+// reconcileInterruptionQueue creates or updates the SQS queue and the EventBridge rules which notify Karpenter about
+// interruptions of its instances and returns the name of the queue.
+func reconcileInterruptionQueue(ctx context.Context, log logr.Logger, awsClient awsclient.Interface, namespace string) (string, error) {
+	name := karpenterInterruptionQueueName(namespace)
+	queue, err := awsClient.GetQueue(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("could not get interruption queue %s: %w", name, err)
+	}
+	if queue == nil {
+		log.Info("Creating interruption queue for Karpenter", "name", name)
+		queue, err = awsClient.CreateQueue(ctx, &awsclient.Queue{
+			QueueName: name,
+			Tags: awsclient.Tags{
+				fmt.Sprintf("kubernetes.io/cluster/%s", namespace): "1",
+				"Name": name,
+			},
+		})
+		if err != nil {
+			return "", fmt.Errorf("could not create interruption queue %s: %w", name, err)
+		}
+		if queue == nil {
+			return "", fmt.Errorf("interruption queue %s not found after creation", name)
+		}
+	}
+
+	attributes, err := karpenterInterruptionQueueAttributes(queue.QueueARN)
+	if err != nil {
+		return "", err
+	}
+	for key, value := range attributes {
+		if queue.Attributes[key] != value {
+			if err := awsClient.UpdateQueueAttributes(ctx, queue.QueueURL, attributes); err != nil {
+				return "", fmt.Errorf("could not update attributes of interruption queue %s: %w", name, err)
+			}
+			break
+		}
+	}
+
+	for suffix, pattern := range karpenterInterruptionEventPatterns {
+		ruleName := karpenterInterruptionEventRuleName(namespace, suffix)
+		if _, err := awsClient.PutEventRule(ctx, &awsclient.EventRule{
+			Name:         ruleName,
+			EventPattern: pattern,
+			TargetID:     karpenterInterruptionQueueTargetID,
+			TargetARN:    queue.QueueARN,
+			Tags: awsclient.Tags{
+				fmt.Sprintf("kubernetes.io/cluster/%s", namespace): "1",
+			},
+		}); err != nil {
+			return "", fmt.Errorf("could not put interruption event rule %s: %w", ruleName, err)
+		}
+	}
+
+	return name, nil
+}
+
+// deleteKarpenterResources terminates the remaining instances launched by Karpenter and deletes its interruption
+// queue if Karpenter has been enabled. The instances are not known to the machine-controller-manager and would block
+// the deletion of the infrastructure otherwise.
+func (a *actuator) deleteKarpenterResources(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane) error {
+	if !isNormalPurpose(cp) {
+		return nil
+	}
+
+	cpConfig, status, err := a.decode(cp)
+	if err != nil {
+		return err
+	}
+
+	if !helper.IsKarpenterEnabled(cpConfig) && status.Karpenter == nil {
+		return nil
+	}
+
+	awsClient, err := a.newAWSClient(ctx, cp)
+	if err != nil {
+		return err
+	}
+
+	if err := terminateKarpenterInstances(ctx, log, awsClient, cp.Namespace); err != nil {
+		return err
+	}
+
+	if !helper.IsKarpenterInterruptionHandlingEnabled(cpConfig) && (status.Karpenter == nil || status.Karpenter.InterruptionQueueName == "") {
+		return nil
+	}
+	return deleteInterruptionQueue(ctx, log, awsClient, cp.Namespace)
+}
+
+// terminateKarpenterInstances terminates the instances launched by Karpenter for the shoot in the given namespace.
+func terminateKarpenterInstances(ctx context.Context, log logr.Logger, awsClient awsclient.Interface, namespace string) error {
+	instances, err := awsClient.FindInstancesByTags(ctx, awsclient.Tags{karpenterManagedByTag: namespace})
+	if err != nil {
+		return fmt.Errorf("could not find instances launched by Karpenter: %w", err)
+	}
+	for _, instance := range instances {
+		log.Info("Terminating instance launched by Karpenter", "id", instance.InstanceId)
+		if err := awsClient.TerminateInstance(ctx, instance.InstanceId); err != nil {
+			return fmt.Errorf("could not terminate instance %s: %w", instance.InstanceId, err)
+		}
+	}
+	return nil
+}
+
+func deleteInterruptionQueue(ctx context.Context, log logr.Logger, awsClient awsclient.Interface, namespace string) error {
+	for suffix := range karpenterInterruptionEventPatterns {
+		ruleName := karpenterInterruptionEventRuleName(namespace, suffix)
+		if err := awsClient.DeleteEventRule(ctx, ruleName); err != nil {
+			return fmt.Errorf("could not delete interruption event rule %s: %w", ruleName, err)
+		}
+	}
+
+	name := karpenterInterruptionQueueName(namespace)
+	queue, err := awsClient.GetQueue(ctx, name)
+	if err != nil {
+		return fmt.Errorf("could not get interruption queue %s: %w", name, err)
+	}
+	if queue == nil {
+		return nil
+	}
+	log.Info("Deleting interruption queue for Karpenter", "name", name)
+	if err := awsClient.DeleteQueue(ctx, queue.QueueURL); err != nil {
+		return fmt.Errorf("could not delete interruption queue %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardencorev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// privateLinkRequeueInterval is the interval in which the load balancer of the service is checked until it has been
+// provisioned.
+const privateLinkRequeueInterval = 30 * time.Second
+
+// reconcilePrivateLink exposes the kube-apiserver via an internal network load balancer of the seed, which is
+// published as VPC endpoint service, so that the allowed principals can connect to it with interface VPC endpoints.
+// If it is disabled, the endpoint service and the load balancer are deleted.
+func (a *actuator) reconcilePrivateLink(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) error {
+	if !isNormalPurpose(cp) {
+		return nil
+	}
+
+	cpConfig, status, err := a.decode(cp)
+	if err != nil {
+		return err
+	}
+
+	if cpConfig.PrivateLink == nil || !cpConfig.PrivateLink.Enabled {
+		return a.deletePrivateLink(ctx, log, cp, cluster)
+	}
+	if a.privateLink == nil {
+		return gardencorev1beta1helper.NewErrorWithCodes(fmt.Errorf("exposure of the kube-apiserver via PrivateLink is not supported by the seed"), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+
+	service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: aws.PrivateLinkServiceName, Namespace: cp.Namespace}}
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, a.client, service, func() error {
+		metav1.SetMetaDataAnnotation(&service.ObjectMeta, "service.beta.kubernetes.io/aws-load-balancer-type", "nlb")
+		metav1.SetMetaDataAnnotation(&service.ObjectMeta, "service.beta.kubernetes.io/aws-load-balancer-internal", "true")
+		metav1.SetMetaDataAnnotation(&service.ObjectMeta, resourcesv1alpha1.NetworkingFromWorldToPorts, `[{"protocol":"TCP","port":443}]`)
+		service.Spec.Type = corev1.ServiceTypeLoadBalancer
+		service.Spec.Selector = map[string]string{
+			v1beta1constants.LabelApp:  v1beta1constants.LabelKubernetes,
+			v1beta1constants.LabelRole: v1beta1constants.LabelAPIServer,
+		}
+		service.Spec.Ports = []corev1.ServicePort{{
+			Name:       "kube-apiserver",
+			Protocol:   corev1.ProtocolTCP,
+			Port:       443,
+			TargetPort: intstr.FromInt(443),
+		}}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("could not reconcile service %s: %w", aws.PrivateLinkServiceName, err)
+	}
+
+	if len(service.Status.LoadBalancer.Ingress) == 0 || service.Status.LoadBalancer.Ingress[0].Hostname == "" {
+		return &reconcilerutils.RequeueAfterError{
+			Cause:        fmt.Errorf("load balancer of service %s has not been provisioned yet", aws.PrivateLinkServiceName),
+			RequeueAfter: privateLinkRequeueInterval,
+		}
+	}
+
+	awsClient, err := a.newSeedAWSClient(ctx, cluster)
+	if err != nil {
+		return err
+	}
+
+	hostname := service.Status.LoadBalancer.Ingress[0].Hostname
+	loadBalancerARN, err := awsClient.FindLoadBalancerARNByDNSName(ctx, hostname)
+	if err != nil {
+		return fmt.Errorf("could not find load balancer %s: %w", hostname, err)
+	}
+	if loadBalancerARN == "" {
+		return &reconcilerutils.RequeueAfterError{
+			Cause:        fmt.Errorf("load balancer %s of service %s not found", hostname, aws.PrivateLinkServiceName),
+			RequeueAfter: privateLinkRequeueInterval,
+		}
+	}
+
+	desired := &awsclient.VpcEndpointService{
+		Tags: awsclient.Tags{
+			fmt.Sprintf("kubernetes.io/cluster/%s", cp.Namespace): "1",
+			"Name": cp.Namespace + "-kube-apiserver",
+		},
+		NetworkLoadBalancerARNs: []string{loadBalancerARN},
+		AcceptanceRequired:      pointer.BoolDeref(cpConfig.PrivateLink.AcceptanceRequired, false),
+	}
+
+	var endpointService *awsclient.VpcEndpointService
+	if status.PrivateLink != nil {
+		if endpointService, err = awsClient.GetVpcEndpointService(ctx, status.PrivateLink.ServiceID); err != nil {
+			return fmt.Errorf("could not get VPC endpoint service %s: %w", status.PrivateLink.ServiceID, err)
+		}
+	}
+	if endpointService == nil {
+		log.Info("Creating VPC endpoint service for kube-apiserver", "loadBalancerARN", loadBalancerARN)
+		if endpointService, err = awsClient.CreateVpcEndpointService(ctx, desired); err != nil {
+			return fmt.Errorf("could not create VPC endpoint service: %w", err)
+		}
+		// Persist the ID immediately, so that the endpoint service is not leaked if the reconciliation fails later on.
+		status.PrivateLink = toPrivateLinkStatus(endpointService)
+		if err := a.updateStatus(ctx, cp, status); err != nil {
+			return err
+		}
+	} else if err := awsClient.UpdateVpcEndpointService(ctx, desired, endpointService); err != nil {
+		return fmt.Errorf("could not update VPC endpoint service %s: %w", endpointService.ServiceId, err)
+	}
+
+	current, err := awsClient.GetVpcEndpointServicePrincipals(ctx, endpointService.ServiceId)
+	if err != nil {
+		return fmt.Errorf("could not get allowed principals of VPC endpoint service %s: %w", endpointService.ServiceId, err)
+	}
+	desiredPrincipals, currentPrincipals := sets.New(cpConfig.PrivateLink.AllowedPrincipals...), sets.New(current...)
+	if err := awsClient.UpdateVpcEndpointServicePrincipals(ctx, endpointService.ServiceId,
+		sets.List(desiredPrincipals.Difference(currentPrincipals)), sets.List(currentPrincipals.Difference(desiredPrincipals))); err != nil {
+		return fmt.Errorf("could not update allowed principals of VPC endpoint service %s: %w", endpointService.ServiceId, err)
+	}
+
+	status.PrivateLink = toPrivateLinkStatus(endpointService)
+	return a.updateStatus(ctx, cp, status)
+}
+
+// deletePrivateLink deletes the VPC endpoint service of the kube-apiserver and afterwards its load balancer, as load
+// balancers cannot be deleted while they are used by an endpoint service.
+func (a *actuator) deletePrivateLink(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) error {
+	_, status, err := a.decode(cp)
+	if err != nil {
+		return err
+	}
+
+	if status.PrivateLink != nil {
+		awsClient, err := a.newSeedAWSClient(ctx, cluster)
+		if err != nil {
+			return err
+		}
+		log.Info("Deleting VPC endpoint service of kube-apiserver", "serviceID", status.PrivateLink.ServiceID)
+		if err := awsClient.DeleteVpcEndpointService(ctx, status.PrivateLink.ServiceID); err != nil {
+			return fmt.Errorf("could not delete VPC endpoint service %s: %w", status.PrivateLink.ServiceID, err)
+		}
+		status.PrivateLink = nil
+		if err := a.updateStatus(ctx, cp, status); err != nil {
+			return err
+		}
+	}
+
+	service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: aws.PrivateLinkServiceName, Namespace: cp.Namespace}}
+	return client.IgnoreNotFound(a.client.Delete(ctx, service))
+}
+
+// newSeedAWSClient creates an AWS client for the region of the seed with the credentials configured for PrivateLink.
+func (a *actuator) newSeedAWSClient(ctx context.Context, cluster *extensionscontroller.Cluster) (awsclient.Interface, error) {
+	if a.privateLink == nil {
+		return nil, fmt.Errorf("credentials of the seed for PrivateLink are not configured")
+	}
+	if cluster == nil || cluster.Seed == nil {
+		return nil, fmt.Errorf("seed of the cluster is unknown")
+	}
+	credentials, err := aws.GetCredentialsFromSecretRef(ctx, a.client, a.privateLink.SecretRef, false)
+	if err != nil {
+		return nil, fmt.Errorf("could not get AWS credentials of the seed: %w", err)
+	}
+	authConfig := aws.NewAuthConfig(credentials, cluster.Seed.Spec.Provider.Region)
+	authConfig.Shoot = cluster.ObjectMeta.Name
+	return a.awsClientFactory.NewClient(authConfig)
+}
+
+func toPrivateLinkStatus(endpointService *awsclient.VpcEndpointService) *apisaws.PrivateLinkStatus {
+	return &apisaws.PrivateLinkStatus{
+		ServiceID:            endpointService.ServiceId,
+		ServiceName:          endpointService.ServiceName,
+		BaseEndpointDNSNames: endpointService.BaseEndpointDnsNames,
+	}
+}
@@ -18,6 +18,8 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
@@ -26,6 +28,7 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	gardencorev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/chart"
 	gutil "github.com/gardener/gardener/pkg/utils/gardener"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -44,6 +47,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	autoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
@@ -112,6 +116,7 @@ func shootAccessSecretsFunc(namespace string) []*gutil.AccessSecret {
 		gutil.NewShootAccessSecret(aws.CloudControllerManagerName, namespace),
 		gutil.NewShootAccessSecret(aws.AWSCustomRouteControllerName, namespace),
 		gutil.NewShootAccessSecret(aws.AWSLoadBalancerControllerName, namespace),
+		gutil.NewShootAccessSecret(aws.KarpenterName, namespace),
 		gutil.NewShootAccessSecret(aws.CSIProvisionerName, namespace),
 		gutil.NewShootAccessSecret(aws.CSIAttacherName, namespace),
 		gutil.NewShootAccessSecret(aws.CSISnapshotterName, namespace),
@@ -119,6 +124,7 @@ func shootAccessSecretsFunc(namespace string) []*gutil.AccessSecret {
 		gutil.NewShootAccessSecret(aws.CSISnapshotControllerName, namespace),
 		gutil.NewShootAccessSecret(aws.CSISnapshotValidationName, namespace),
 		gutil.NewShootAccessSecret(aws.CSIVolumeModifierName, namespace),
+		gutil.NewShootAccessSecret(aws.CSIEFSProvisionerName, namespace),
 	}
 }
 
@@ -172,6 +178,14 @@ var (
 					{Type: &corev1.Service{}, Name: aws.AWSLoadBalancerControllerName},
 				},
 			},
+			{
+				Name:   aws.KarpenterName,
+				Images: []string{aws.KarpenterImageName},
+				Objects: []*chart.Object{
+					{Type: &appsv1.Deployment{}, Name: aws.KarpenterName},
+					{Type: &autoscalingv1.VerticalPodAutoscaler{}, Name: aws.KarpenterName + "-vpa"},
+				},
+			},
 			{
 				Name: aws.CSIControllerName,
 				Images: []string{
@@ -198,6 +212,18 @@ var (
 					{Type: &corev1.Service{}, Name: aws.CSISnapshotValidationName},
 				},
 			},
+			{
+				Name: aws.CSIControllerEFSName,
+				Images: []string{
+					aws.CSIDriverEFSImageName,
+					aws.CSIProvisionerImageName,
+					aws.CSILivenessProbeImageName,
+				},
+				Objects: []*chart.Object{
+					{Type: &appsv1.Deployment{}, Name: aws.CSIControllerEFSName},
+					{Type: &autoscalingv1.VerticalPodAutoscaler{}, Name: aws.CSIControllerEFSName + "-vpa"},
+				},
+			},
 		},
 	}
 
@@ -232,6 +258,15 @@ var (
 					{Type: &v1.PodDisruptionBudget{}, Name: aws.AWSLoadBalancerControllerName},
 				},
 			},
+			{
+				Name: aws.KarpenterName,
+				Objects: []*chart.Object{
+					{Type: &rbacv1.ClusterRole{}, Name: "extensions.gardener.cloud:provider-aws:karpenter"},
+					{Type: &rbacv1.ClusterRoleBinding{}, Name: "extensions.gardener.cloud:provider-aws:karpenter"},
+					{Type: &rbacv1.Role{}, Name: "extensions.gardener.cloud:provider-aws:karpenter"},
+					{Type: &rbacv1.RoleBinding{}, Name: "extensions.gardener.cloud:provider-aws:karpenter"},
+				},
+			},
 			{
 				Name: aws.CSINodeName,
 				Images: []string{
@@ -285,6 +320,42 @@ var (
 					{Type: &rbacv1.RoleBinding{}, Name: aws.UsernamePrefix + aws.CSIVolumeModifierName},
 				},
 			},
+			{
+				Name: aws.CSINodeEFSName,
+				Images: []string{
+					aws.CSIDriverEFSImageName,
+					aws.CSINodeDriverRegistrarImageName,
+					aws.CSILivenessProbeImageName,
+				},
+				Objects: []*chart.Object{
+					// csi-driver-efs
+					{Type: &appsv1.DaemonSet{}, Name: aws.CSINodeEFSName},
+					{Type: &storagev1.CSIDriver{}, Name: "efs.csi.aws.com"},
+					{Type: &corev1.ServiceAccount{}, Name: aws.CSINodeEFSName},
+					{Type: &rbacv1.ClusterRole{}, Name: aws.UsernamePrefix + aws.CSIDriverEFSName},
+					{Type: &rbacv1.ClusterRoleBinding{}, Name: aws.UsernamePrefix + aws.CSIDriverEFSName},
+					{Type: &policyv1beta1.PodSecurityPolicy{}, Name: strings.Replace(aws.UsernamePrefix+aws.CSINodeEFSName, ":", ".", -1)},
+					{Type: extensionscontroller.GetVerticalPodAutoscalerObject(), Name: aws.CSINodeEFSName},
+					// csi-efs-provisioner
+					{Type: &rbacv1.ClusterRole{}, Name: aws.UsernamePrefix + aws.CSIEFSProvisionerName},
+					{Type: &rbacv1.ClusterRoleBinding{}, Name: aws.UsernamePrefix + aws.CSIEFSProvisionerName},
+					{Type: &rbacv1.Role{}, Name: aws.UsernamePrefix + aws.CSIEFSProvisionerName},
+					{Type: &rbacv1.RoleBinding{}, Name: aws.UsernamePrefix + aws.CSIEFSProvisionerName},
+				},
+			},
+			{
+				Name:   aws.CloudWatchAgentName,
+				Images: []string{aws.CloudWatchAgentImageName},
+				Objects: []*chart.Object{
+					{Type: &appsv1.DaemonSet{}, Name: aws.CloudWatchAgentName},
+					{Type: &corev1.ConfigMap{}, Name: aws.CloudWatchAgentName},
+					{Type: &corev1.ServiceAccount{}, Name: aws.CloudWatchAgentName},
+					{Type: &rbacv1.ClusterRole{}, Name: aws.UsernamePrefix + aws.CloudWatchAgentName},
+					{Type: &rbacv1.ClusterRoleBinding{}, Name: aws.UsernamePrefix + aws.CloudWatchAgentName},
+					{Type: &policyv1beta1.PodSecurityPolicy{}, Name: strings.Replace(aws.UsernamePrefix+aws.CloudWatchAgentName, ":", ".", -1)},
+					{Type: extensionscontroller.GetVerticalPodAutoscalerObject(), Name: aws.CloudWatchAgentName},
+				},
+			},
 		},
 	}
 
@@ -308,6 +379,14 @@ var (
 					{Type: &apiextensionsv1.CustomResourceDefinition{}, Name: "targetgroupbindings.elbv2.k8s.aws"},
 				},
 			},
+			{
+				Name: aws.KarpenterName,
+				Objects: []*chart.Object{
+					{Type: &apiextensionsv1.CustomResourceDefinition{}, Name: "nodepools.karpenter.sh"},
+					{Type: &apiextensionsv1.CustomResourceDefinition{}, Name: "nodeclaims.karpenter.sh"},
+					{Type: &apiextensionsv1.CustomResourceDefinition{}, Name: "ec2nodeclasses.karpenter.k8s.aws"},
+				},
+			},
 		},
 	}
 
@@ -347,8 +426,15 @@ func (vp *valuesProvider) GetConfigChartValues(
 		}
 	}
 
+	cpConfig := &apisaws.ControlPlaneConfig{}
+	if cp.Spec.ProviderConfig != nil {
+		if _, _, err := vp.decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, cpConfig); err != nil {
+			return nil, fmt.Errorf("could not decode providerConfig of controlplane '%s': %w", kutil.ObjectName(cp), err)
+		}
+	}
+
 	// Get config chart values
-	return getConfigChartValues(infraStatus, cp)
+	return getConfigChartValues(cpConfig, infraStatus, cp)
 }
 
 // GetControlPlaneChartValues returns the values for the control plane chart applied by the generic actuator.
@@ -400,7 +486,14 @@ func (vp *valuesProvider) GetControlPlaneShootChartValues(
 		}
 	}
 
-	return getControlPlaneShootChartValues(cluster, cpConfig, cp, secretsReader)
+	infraStatus := &apisaws.InfrastructureStatus{}
+	if cp.Spec.InfrastructureProviderStatus != nil {
+		if _, _, err := vp.decoder.Decode(cp.Spec.InfrastructureProviderStatus.Raw, nil, infraStatus); err != nil {
+			return nil, fmt.Errorf("could not decode infrastructureProviderStatus of controlplane '%s': %w", kutil.ObjectName(cp), err)
+		}
+	}
+
+	return getControlPlaneShootChartValues(cluster, cpConfig, infraStatus, cp, secretsReader)
 }
 
 // GetControlPlaneShootCRDsChartValues returns the values for the control plane shoot CRDs chart applied by the generic actuator.
@@ -424,6 +517,9 @@ func (vp *valuesProvider) GetControlPlaneShootCRDsChartValues(
 		"aws-load-balancer-controller": map[string]interface{}{
 			"enabled": isLoadBalancerControllerEnabled(cpConfig),
 		},
+		aws.KarpenterName: map[string]interface{}{
+			"enabled": helper.IsKarpenterEnabled(cpConfig),
+		},
 	}, nil
 }
 
@@ -435,8 +531,8 @@ func (vp *valuesProvider) GetStorageClassesChartValues(
 ) (map[string]interface{}, error) {
 	managedDefaultClass := true
 
+	cpConfig := &apisaws.ControlPlaneConfig{}
 	if cp.Spec.ProviderConfig != nil {
-		cpConfig := &apisaws.ControlPlaneConfig{}
 		_, _, err := vp.decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, cpConfig)
 		if err != nil {
 			return nil, fmt.Errorf("could not decode providerConfig of controlplane '%s': %w", kutil.ObjectName(cp), err)
@@ -450,13 +546,75 @@ func (vp *valuesProvider) GetStorageClassesChartValues(
 		}
 	}
 
+	infraStatus := &apisaws.InfrastructureStatus{}
+	if cp.Spec.InfrastructureProviderStatus != nil {
+		if _, _, err := vp.decoder.Decode(cp.Spec.InfrastructureProviderStatus.Raw, nil, infraStatus); err != nil {
+			return nil, fmt.Errorf("could not decode infrastructureProviderStatus of controlplane '%s': %w", kutil.ObjectName(cp), err)
+		}
+	}
+
+	// the 'efs' StorageClass is only deployed once the EFS file system is known
+	fileSystemID := helper.GetEFSFileSystemID(cpConfig, infraStatus)
+
+	var (
+		defaultClass      *apisaws.StorageClassParameters
+		additionalClasses = []interface{}{}
+	)
+	if cpConfig.Storage != nil {
+		defaultClass = cpConfig.Storage.DefaultClass
+		for _, class := range cpConfig.Storage.AdditionalClasses {
+			additionalClasses = append(additionalClasses, map[string]interface{}{
+				"name":       class.Name,
+				"parameters": getStorageClassParameters(&class.Parameters),
+			})
+		}
+	}
+
 	return map[string]interface{}{
 		"managedDefaultClass": managedDefaultClass,
+		"defaultClass": map[string]interface{}{
+			"parameters": getStorageClassParameters(defaultClass),
+		},
+		"additionalClasses": additionalClasses,
+		"efs": map[string]interface{}{
+			"enabled":      fileSystemID != "",
+			"fileSystemID": fileSystemID,
+		},
 	}, nil
 }
 
+// getStorageClassParameters returns the parameters of an EBS StorageClass as understood by the EBS CSI driver.
+// Volumes are encrypted unless explicitly disabled.
+func getStorageClassParameters(parameters *apisaws.StorageClassParameters) map[string]interface{} {
+	values := map[string]interface{}{
+		"encrypted": "true",
+	}
+	if parameters == nil {
+		return values
+	}
+
+	if parameters.Type != nil {
+		values["type"] = *parameters.Type
+	}
+	if parameters.IOPS != nil {
+		values["iops"] = strconv.FormatInt(*parameters.IOPS, 10)
+	}
+	if parameters.Throughput != nil {
+		values["throughput"] = strconv.FormatInt(*parameters.Throughput, 10)
+	}
+	if parameters.Encrypted != nil {
+		values["encrypted"] = strconv.FormatBool(*parameters.Encrypted)
+	}
+	if parameters.KMSKeyID != nil {
+		values["kmsKeyId"] = *parameters.KMSKeyID
+	}
+
+	return values
+}
+
 // getConfigChartValues collects and returns the configuration chart values.
 func getConfigChartValues(
+	cpConfig *apisaws.ControlPlaneConfig,
 	infraStatus *apisaws.InfrastructureStatus,
 	cp *extensionsv1alpha1.ControlPlane,
 ) (map[string]interface{}, error) {
@@ -467,12 +625,23 @@ func getConfigChartValues(
 	}
 
 	// Collect config chart values
-	return map[string]interface{}{
+	values := map[string]interface{}{
 		"vpcID":       infraStatus.VPC.ID,
 		"subnetID":    subnet.ID,
 		"clusterName": cp.Namespace,
 		"zone":        subnet.Zone,
-	}, nil
+	}
+
+	// the cloud provider config expects the IP families in lower case
+	if ccmConfig := cpConfig.CloudControllerManager; ccmConfig != nil && len(ccmConfig.NodeIPFamilies) > 0 {
+		var nodeIPFamilies []interface{}
+		for _, family := range ccmConfig.NodeIPFamilies {
+			nodeIPFamilies = append(nodeIPFamilies, strings.ToLower(string(family)))
+		}
+		values["nodeIPFamilies"] = nodeIPFamilies
+	}
+
+	return values, nil
 }
 
 // getControlPlaneChartValues collects and returns the control plane chart values.
@@ -500,11 +669,18 @@ func getControlPlaneChartValues(
 		return nil, err
 	}
 
-	csi, err := getCSIControllerChartValues(cp, cluster, secretsReader, checksums, scaledDown)
+	karpenter, err := getKarpenterChartValues(cpConfig, cp, cluster, checksums, scaledDown)
 	if err != nil {
 		return nil, err
 	}
 
+	csi, err := getCSIControllerChartValues(cpConfig, cp, cluster, secretsReader, checksums, scaledDown)
+	if err != nil {
+		return nil, err
+	}
+
+	csiEFS := getCSIEFSControllerChartValues(cpConfig, cp, cluster, checksums, scaledDown)
+
 	return map[string]interface{}{
 		"global": map[string]interface{}{
 			"genericTokenKubeconfigSecretName": extensionscontroller.GenericTokenKubeconfigSecretNameFromCluster(cluster),
@@ -512,7 +688,9 @@ func getControlPlaneChartValues(
 		aws.CloudControllerManagerName:    ccm,
 		aws.AWSCustomRouteControllerName:  crc,
 		aws.AWSLoadBalancerControllerName: alb,
+		aws.KarpenterName:                 karpenter,
 		aws.CSIControllerName:             csi,
+		aws.CSIControllerEFSName:          csiEFS,
 	}, nil
 }
 
@@ -549,8 +727,18 @@ func getCCMChartValues(
 		},
 	}
 
-	if cpConfig.CloudControllerManager != nil {
-		values["featureGates"] = cpConfig.CloudControllerManager.FeatureGates
+	if ccmConfig := cpConfig.CloudControllerManager; ccmConfig != nil {
+		values["featureGates"] = ccmConfig.FeatureGates
+
+		if ccmConfig.ConcurrentServiceSyncs != nil {
+			values["concurrentServiceSyncs"] = *ccmConfig.ConcurrentServiceSyncs
+		}
+		if ccmConfig.NodeStatusUpdateFrequency != nil {
+			values["nodeStatusUpdateFrequency"] = ccmConfig.NodeStatusUpdateFrequency.Duration.String()
+		}
+		if ccmConfig.AllocateNodeCIDRs != nil {
+			values["allocateNodeCIDRs"] = *ccmConfig.AllocateNodeCIDRs
+		}
 	}
 
 	return values, nil
@@ -611,6 +799,17 @@ func getALBChartValues(
 		return nil, fmt.Errorf("secret %q not found", caNameControlPlane)
 	}
 
+	additionalTags, err := getAdditionalTags(cluster)
+	if err != nil {
+		return nil, err
+	}
+	defaultTags := map[string]interface{}{}
+	for key, value := range additionalTags {
+		defaultTags[key] = value
+	}
+	defaultTags["KubernetesCluster"] = cp.Namespace
+	defaultTags["kubernetes.io/cluster/"+cp.Namespace] = "owned"
+
 	// ALB chart is always enabled and deployment is controlled by the replicaCount
 	// to avoid similar issue like https://github.com/gardener/gardener-extension-provider-aws/issues/628
 	values := map[string]interface{}{
@@ -623,10 +822,7 @@ func getALBChartValues(
 		"webhookTLS": map[string]interface{}{
 			"caCert": string(caSecret.Data[secretutils.DataKeyCertificateBundle]),
 		},
-		"defaultTags": map[string]interface{}{
-			"KubernetesCluster":                     cp.Namespace,
-			"kubernetes.io/cluster/" + cp.Namespace: "owned",
-		},
+		"defaultTags": defaultTags,
 	}
 	if cpConfig.LoadBalancerController != nil && cpConfig.LoadBalancerController.IngressClassName != nil {
 		values["ingressClass"] = *cpConfig.LoadBalancerController.IngressClassName
@@ -652,8 +848,134 @@ func isLoadBalancerControllerEnabled(cpConfig *apisaws.ControlPlaneConfig) bool
 	return cpConfig.LoadBalancerController != nil && cpConfig.LoadBalancerController.Enabled
 }
 
+// getLoadBalancerDefaultAnnotations returns the annotations which are added to services of type LoadBalancer by the
+// shoot service webhook according to the given defaults. Both, the scheme annotation of the aws-load-balancer-controller
+// and the legacy internal annotation of the cloud-controller-manager, are returned. The additional tags of the
+// InfrastructureConfig are added to the load balancers, too.
+func getLoadBalancerDefaultAnnotations(cpConfig *apisaws.ControlPlaneConfig, infraStatus *apisaws.InfrastructureStatus, additionalTags map[string]string) map[string]string {
+	annotations := map[string]string{}
+	if len(additionalTags) > 0 {
+		annotations[aws.AnnotationLoadBalancerAdditionalResourceTags] = tagsToCSV(additionalTags)
+	}
+
+	defaults := cpConfig.LoadBalancerDefaults
+	if defaults == nil {
+		return annotations
+	}
+
+	if defaults.Internal != nil {
+		if *defaults.Internal {
+			annotations[aws.AnnotationLoadBalancerInternal] = "true"
+			annotations[aws.AnnotationLoadBalancerScheme] = "internal"
+		} else {
+			annotations[aws.AnnotationLoadBalancerScheme] = "internet-facing"
+		}
+	}
+	if defaults.TargetType != nil {
+		annotations[aws.AnnotationLoadBalancerTargetType] = *defaults.TargetType
+	}
+	if len(defaults.Subnets) > 0 {
+		annotations[aws.AnnotationLoadBalancerSubnets] = strings.Join(defaults.Subnets, ",")
+	}
+	if len(defaults.Attributes) > 0 {
+		var attributes []string
+		for key, value := range defaults.Attributes {
+			attributes = append(attributes, key+"="+value)
+		}
+		sort.Strings(attributes)
+		annotations[aws.AnnotationLoadBalancerAttributes] = strings.Join(attributes, ",")
+	}
+	// access logs are only enabled once the bucket is known
+	if bucketName := helper.GetLoadBalancerAccessLogsBucketName(cpConfig, infraStatus); bucketName != "" {
+		annotations[aws.AnnotationLoadBalancerAccessLogEnabled] = "true"
+		annotations[aws.AnnotationLoadBalancerAccessLogBucketName] = bucketName
+		if prefix := defaults.AccessLogs.Prefix; prefix != nil {
+			annotations[aws.AnnotationLoadBalancerAccessLogBucketPrefix] = *prefix
+		}
+		if emitInterval := defaults.AccessLogs.EmitInterval; emitInterval != nil {
+			annotations[aws.AnnotationLoadBalancerAccessLogEmitInterval] = strconv.Itoa(int(*emitInterval))
+		}
+	}
+	if defaults.SSLNegotiationPolicy != nil {
+		annotations[aws.AnnotationLoadBalancerSSLNegotiationPolicy] = *defaults.SSLNegotiationPolicy
+	}
+
+	return annotations
+}
+
+// getAdditionalTags returns the additional tags of the InfrastructureConfig, which are also added to the volumes and
+// load balancers created by the components running in the shoot cluster.
+func getAdditionalTags(cluster *extensionscontroller.Cluster) (map[string]string, error) {
+	infrastructureConfig, err := helper.InfrastructureConfigFromCluster(cluster)
+	if err != nil || infrastructureConfig == nil {
+		return nil, err
+	}
+	return infrastructureConfig.Tags, nil
+}
+
+// getShootMetadataTags returns the tags with the names of the shoot and its project. The name of the project is derived
+// from the namespace of the shoot, which is `garden-<project>` (or `garden` for the `garden` project).
+func getShootMetadataTags(cluster *extensionscontroller.Cluster) map[string]string {
+	project := cluster.Shoot.Namespace
+	if project != v1beta1constants.GardenNamespace {
+		project = strings.TrimPrefix(project, v1beta1constants.GardenNamespace+"-")
+	}
+	return map[string]string{
+		aws.TagKeyShoot:   cluster.Shoot.Name,
+		aws.TagKeyProject: project,
+	}
+}
+
+// tagsToCSV returns the given tags in the format `key1=value1,key2=value2`, which is used by the flags and annotations
+// of the AWS controllers.
+func tagsToCSV(tags map[string]string) string {
+	var pairs []string
+	for key, value := range tags {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// getKarpenterChartValues collects and returns the Karpenter chart values.
+func getKarpenterChartValues(
+	cpConfig *apisaws.ControlPlaneConfig,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+	checksums map[string]string,
+	scaledDown bool,
+) (map[string]interface{}, error) {
+	// Karpenter chart is always enabled and deployment is controlled by the replicas, so that the nodes it launched are
+	// not left without a controller when it is disabled.
+	values := map[string]interface{}{
+		"enabled":     true,
+		"replicas":    extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
+		"clusterName": cp.Namespace,
+		"region":      cp.Spec.Region,
+		"podAnnotations": map[string]interface{}{
+			"checksum/secret-cloudprovider": checksums[v1beta1constants.SecretNameCloudProvider],
+		},
+		"podLabels": map[string]interface{}{
+			v1beta1constants.LabelPodMaintenanceRestart: "true",
+		},
+	}
+	if cluster.Shoot.Spec.DNS != nil && cluster.Shoot.Spec.DNS.Domain != nil {
+		values["clusterEndpoint"] = "https://" + gutil.GetAPIServerDomain(*cluster.Shoot.Spec.DNS.Domain)
+	}
+	if helper.IsKarpenterInterruptionHandlingEnabled(cpConfig) {
+		values["interruptionQueue"] = karpenterInterruptionQueueName(cp.Namespace)
+	}
+
+	if !helper.IsKarpenterEnabled(cpConfig) {
+		values["replicas"] = 0
+	}
+
+	return values, nil
+}
+
 // getCSIControllerChartValues collects and returns the CSIController chart values.
 func getCSIControllerChartValues(
+	cpConfig *apisaws.ControlPlaneConfig,
 	cp *extensionsv1alpha1.ControlPlane,
 	cluster *extensionscontroller.Cluster,
 	secretsReader secretsmanager.Reader,
@@ -665,16 +987,45 @@ func getCSIControllerChartValues(
 		return nil, fmt.Errorf("secret %q not found", csiSnapshotValidationServerName)
 	}
 
-	return map[string]interface{}{
+	ebsConfig := helper.GetEBSConfig(cpConfig)
+
+	csiSnapshotControllerValues := map[string]interface{}{
+		"replicas": extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
+	}
+	if ebsConfig.SnapshotController != nil && ebsConfig.SnapshotController.WorkerThreads != nil {
+		csiSnapshotControllerValues["workerThreads"] = *ebsConfig.SnapshotController.WorkerThreads
+	}
+
+	// the configured requests are merged with the default resources of the chart
+	resources := map[string]interface{}{}
+	for container, requests := range ebsConfig.ResourceRequests {
+		resources[container] = map[string]interface{}{
+			"requests": requests,
+		}
+	}
+
+	additionalTags, err := getAdditionalTags(cluster)
+	if err != nil {
+		return nil, err
+	}
+	volumeTags := utils.MergeStringMaps(additionalTags, ebsConfig.ExtraTags)
+	if pointer.BoolDeref(ebsConfig.ShootMetadataTags, false) {
+		volumeTags = utils.MergeStringMaps(volumeTags, getShootMetadataTags(cluster))
+	}
+
+	values := map[string]interface{}{
 		"enabled":  true,
 		"replicas": extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
 		"region":   cp.Spec.Region,
 		"podAnnotations": map[string]interface{}{
 			"checksum/secret-" + v1beta1constants.SecretNameCloudProvider: checksums[v1beta1constants.SecretNameCloudProvider],
 		},
-		"csiSnapshotController": map[string]interface{}{
-			"replicas": extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
+		"batching":  pointer.BoolDeref(ebsConfig.Batching, false),
+		"resources": resources,
+		"volumeModifier": map[string]interface{}{
+			"enabled": pointer.BoolDeref(ebsConfig.VolumeModification, true),
 		},
+		"csiSnapshotController": csiSnapshotControllerValues,
 		"csiSnapshotValidationWebhook": map[string]interface{}{
 			"replicas": extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
 			"secrets": map[string]interface{}{
@@ -682,13 +1033,44 @@ func getCSIControllerChartValues(
 			},
 			"topologyAwareRoutingEnabled": gardencorev1beta1helper.IsTopologyAwareRoutingForShootControlPlaneEnabled(cluster.Seed, cluster.Shoot),
 		},
-	}, nil
+	}
+	if len(volumeTags) > 0 {
+		values["extraTags"] = tagsToCSV(volumeTags)
+	}
+
+	return values, nil
+}
+
+// getCSIEFSControllerChartValues collects and returns the EFS CSI controller chart values.
+func getCSIEFSControllerChartValues(
+	cpConfig *apisaws.ControlPlaneConfig,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+	checksums map[string]string,
+	scaledDown bool,
+) map[string]interface{} {
+	// EFS CSI controller chart is always enabled and deployment is controlled by the replicas, so that the volumes it
+	// provisioned can still be deleted after it has been disabled.
+	values := map[string]interface{}{
+		"enabled":  true,
+		"replicas": extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
+		"region":   cp.Spec.Region,
+		"podAnnotations": map[string]interface{}{
+			"checksum/secret-" + v1beta1constants.SecretNameCloudProvider: checksums[v1beta1constants.SecretNameCloudProvider],
+		},
+	}
+	if !helper.IsEFSEnabled(cpConfig) {
+		values["replicas"] = 0
+	}
+
+	return values
 }
 
 // getControlPlaneShootChartValues collects and returns the control plane shoot chart values.
 func getControlPlaneShootChartValues(
 	cluster *extensionscontroller.Cluster,
 	cpConfig *apisaws.ControlPlaneConfig,
+	infraStatus *apisaws.InfrastructureStatus,
 	cp *extensionsv1alpha1.ControlPlane,
 	secretsReader secretsmanager.Reader,
 ) (map[string]interface{}, error) {
@@ -714,10 +1096,15 @@ func getControlPlaneShootChartValues(
 		"pspDisabled": gardencorev1beta1helper.IsPSPDisabled(cluster.Shoot),
 	}
 
+	driverValues := map[string]interface{}{}
 	if value, ok := cluster.Shoot.Annotations[aws.VolumeAttachLimit]; ok {
-		csiDriverNodeValues["driver"] = map[string]interface{}{
-			"volumeAttachLimit": value,
-		}
+		driverValues["volumeAttachLimit"] = value
+	}
+	if reserved := helper.GetEBSConfig(cpConfig).ReservedVolumeAttachments; reserved != nil {
+		driverValues["reservedVolumeAttachments"] = *reserved
+	}
+	if len(driverValues) > 0 {
+		csiDriverNodeValues["driver"] = driverValues
 	}
 
 	albValues, err := getALBChartValues(cpConfig, cp, cluster, secretsReader, nil, false, nil)
@@ -725,10 +1112,45 @@ func getControlPlaneShootChartValues(
 		return nil, err
 	}
 
+	additionalTags, err := getAdditionalTags(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	ccmValues := map[string]interface{}{"enabled": true}
+	if annotations := getLoadBalancerDefaultAnnotations(cpConfig, infraStatus, additionalTags); len(annotations) > 0 {
+		ccmValues["loadBalancerDefaults"] = annotations
+	}
+
 	return map[string]interface{}{
-		aws.CloudControllerManagerName:    map[string]interface{}{"enabled": true},
+		aws.CloudControllerManagerName:    ccmValues,
 		aws.AWSCustomRouteControllerName:  map[string]interface{}{"enabled": customRouteControllerEnabled},
 		aws.AWSLoadBalancerControllerName: albValues,
+		aws.KarpenterName:                 map[string]interface{}{"enabled": helper.IsKarpenterEnabled(cpConfig)},
 		aws.CSINodeName:                   csiDriverNodeValues,
+		aws.CSINodeEFSName: map[string]interface{}{
+			"enabled":     helper.IsEFSEnabled(cpConfig),
+			"vpaEnabled":  gardencorev1beta1helper.ShootWantsVerticalPodAutoscaler(cluster.Shoot),
+			"pspDisabled": gardencorev1beta1helper.IsPSPDisabled(cluster.Shoot),
+		},
+		aws.CloudWatchAgentName: getCloudWatchAgentChartValues(cpConfig, cp, cluster),
 	}, nil
 }
+
+// getCloudWatchAgentChartValues collects and returns the CloudWatch agent chart values.
+func getCloudWatchAgentChartValues(cpConfig *apisaws.ControlPlaneConfig, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) map[string]interface{} {
+	if !helper.IsCloudWatchAgentEnabled(cpConfig) {
+		return map[string]interface{}{"enabled": false}
+	}
+
+	values := map[string]interface{}{
+		"enabled":                   true,
+		"metricsCollectionInterval": pointer.Int32Deref(cpConfig.CloudWatchAgent.MetricsCollectionInterval, 60),
+		"vpaEnabled":                gardencorev1beta1helper.ShootWantsVerticalPodAutoscaler(cluster.Shoot),
+		"pspDisabled":               gardencorev1beta1helper.IsPSPDisabled(cluster.Shoot),
+	}
+	if helper.IsCloudWatchAgentLogsEnabled(cpConfig) {
+		values["logGroupName"] = cloudWatchAgentLogGroupName(cp.Namespace)
+	}
+	return values
+}
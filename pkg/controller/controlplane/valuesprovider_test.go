@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/controlplane/genericactuator"
@@ -35,6 +36,7 @@ import (
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
@@ -104,6 +106,38 @@ var _ = Describe("ValuesProvider", func() {
 				}),
 			}
 		}
+		setKarpenterEnabled = func(cp *extensionsv1alpha1.ControlPlane, interruptionHandling *bool) {
+			cp.Spec.ProviderConfig = &runtime.RawExtension{
+				Raw: encode(&apisawsv1alpha1.ControlPlaneConfig{
+					CloudControllerManager: &apisawsv1alpha1.CloudControllerManagerConfig{
+						FeatureGates: map[string]bool{
+							"RotateKubeletServerCertificate": true,
+						},
+					},
+					Karpenter: &apisawsv1alpha1.KarpenterConfig{
+						Enabled:              true,
+						InterruptionHandling: interruptionHandling,
+					},
+				}),
+			}
+		}
+		setEFSEnabled = func(cp *extensionsv1alpha1.ControlPlane, fileSystemID *string) {
+			cp.Spec.ProviderConfig = &runtime.RawExtension{
+				Raw: encode(&apisawsv1alpha1.ControlPlaneConfig{
+					CloudControllerManager: &apisawsv1alpha1.CloudControllerManagerConfig{
+						FeatureGates: map[string]bool{
+							"RotateKubeletServerCertificate": true,
+						},
+					},
+					Storage: &apisawsv1alpha1.Storage{
+						EFS: &apisawsv1alpha1.EFSConfig{
+							Enabled:      true,
+							FileSystemID: fileSystemID,
+						},
+					},
+				}),
+			}
+		}
 
 		fakeClient         client.Client
 		fakeSecretsManager secretsmanager.Interface
@@ -229,12 +263,26 @@ var _ = Describe("ValuesProvider", func() {
 				"zone":        "eu-west-1a",
 			}))
 		})
+
+		It("should return the configured node IP families in lower case", func() {
+			cp.Spec.DefaultSpec.ProviderConfig.Raw = encode(&apisawsv1alpha1.ControlPlaneConfig{
+				CloudControllerManager: &apisawsv1alpha1.CloudControllerManagerConfig{
+					NodeIPFamilies: []apisawsv1alpha1.IPFamily{apisawsv1alpha1.IPFamilyIPv6, apisawsv1alpha1.IPFamilyIPv4},
+				},
+			})
+
+			values, err := vp.GetConfigChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("nodeIPFamilies", []interface{}{"ipv6", "ipv4"}))
+		})
 	})
 
 	Describe("#GetControlPlaneChartValues", func() {
 		var ccmChartValues map[string]interface{}
 		var crcChartValues map[string]interface{}
 		var albChartValues map[string]interface{}
+		var karpenterChartValues map[string]interface{}
+		var csiEFSControllerChartValues map[string]interface{}
 
 		BeforeEach(func() {
 			ccmChartValues = utils.MergeMaps(enabledTrue, map[string]interface{}{
@@ -298,6 +346,27 @@ var _ = Describe("ValuesProvider", func() {
 					"checksum/secret-" + v1beta1constants.SecretNameCloudProvider: checksums[v1beta1constants.SecretNameCloudProvider],
 				},
 			}
+			karpenterChartValues = map[string]interface{}{
+				"enabled":     true,
+				"replicas":    0,
+				"clusterName": "test",
+				"region":      "europe",
+				"podAnnotations": map[string]interface{}{
+					"checksum/secret-" + v1beta1constants.SecretNameCloudProvider: checksums[v1beta1constants.SecretNameCloudProvider],
+				},
+				"podLabels": map[string]interface{}{
+					"maintenance.gardener.cloud/restart": "true",
+				},
+			}
+
+			csiEFSControllerChartValues = map[string]interface{}{
+				"enabled":  true,
+				"replicas": 0,
+				"region":   "europe",
+				"podAnnotations": map[string]interface{}{
+					"checksum/secret-" + v1beta1constants.SecretNameCloudProvider: checksums[v1beta1constants.SecretNameCloudProvider],
+				},
+			}
 
 			By("creating secrets managed outside of this package for whose secretsmanager.Get() will be called")
 			Expect(fakeClient.Create(context.TODO(), &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ca-provider-aws-controlplane", Namespace: namespace}})).To(Succeed())
@@ -319,12 +388,19 @@ var _ = Describe("ValuesProvider", func() {
 				}),
 				aws.AWSCustomRouteControllerName:  crcChartValues,
 				aws.AWSLoadBalancerControllerName: albChartValues,
+				aws.KarpenterName:                 karpenterChartValues,
+				aws.CSIControllerEFSName:          csiEFSControllerChartValues,
 				aws.CSIControllerName: utils.MergeMaps(enabledTrue, map[string]interface{}{
 					"replicas": 1,
 					"region":   region,
 					"podAnnotations": map[string]interface{}{
 						"checksum/secret-" + v1beta1constants.SecretNameCloudProvider: checksums[v1beta1constants.SecretNameCloudProvider],
 					},
+					"batching":  false,
+					"resources": map[string]interface{}{},
+					"volumeModifier": map[string]interface{}{
+						"enabled": true,
+					},
 					"csiSnapshotController": map[string]interface{}{
 						"replicas": 1,
 					},
@@ -354,12 +430,19 @@ var _ = Describe("ValuesProvider", func() {
 				}),
 				aws.AWSCustomRouteControllerName:  crcChartValues,
 				aws.AWSLoadBalancerControllerName: albChartValues,
+				aws.KarpenterName:                 karpenterChartValues,
+				aws.CSIControllerEFSName:          csiEFSControllerChartValues,
 				aws.CSIControllerName: utils.MergeMaps(enabledTrue, map[string]interface{}{
 					"replicas": 1,
 					"region":   region,
 					"podAnnotations": map[string]interface{}{
 						"checksum/secret-" + v1beta1constants.SecretNameCloudProvider: checksums[v1beta1constants.SecretNameCloudProvider],
 					},
+					"batching":  false,
+					"resources": map[string]interface{}{},
+					"volumeModifier": map[string]interface{}{
+						"enabled": true,
+					},
 					"csiSnapshotController": map[string]interface{}{
 						"replicas": 1,
 					},
@@ -388,12 +471,19 @@ var _ = Describe("ValuesProvider", func() {
 				}),
 				aws.AWSCustomRouteControllerName:  crcChartValues,
 				aws.AWSLoadBalancerControllerName: albChartValues,
+				aws.KarpenterName:                 karpenterChartValues,
+				aws.CSIControllerEFSName:          csiEFSControllerChartValues,
 				aws.CSIControllerName: utils.MergeMaps(enabledTrue, map[string]interface{}{
 					"replicas": 1,
 					"region":   region,
 					"podAnnotations": map[string]interface{}{
 						"checksum/secret-" + v1beta1constants.SecretNameCloudProvider: checksums[v1beta1constants.SecretNameCloudProvider],
 					},
+					"batching":  false,
+					"resources": map[string]interface{}{},
+					"volumeModifier": map[string]interface{}{
+						"enabled": true,
+					},
 					"csiSnapshotController": map[string]interface{}{
 						"replicas": 1,
 					},
@@ -423,12 +513,19 @@ var _ = Describe("ValuesProvider", func() {
 				}),
 				aws.AWSCustomRouteControllerName:  crcChartValues,
 				aws.AWSLoadBalancerControllerName: albChartValues,
+				aws.KarpenterName:                 karpenterChartValues,
+				aws.CSIControllerEFSName:          csiEFSControllerChartValues,
 				aws.CSIControllerName: utils.MergeMaps(enabledTrue, map[string]interface{}{
 					"replicas": 1,
 					"region":   region,
 					"podAnnotations": map[string]interface{}{
 						"checksum/secret-" + v1beta1constants.SecretNameCloudProvider: checksums[v1beta1constants.SecretNameCloudProvider],
 					},
+					"batching":  false,
+					"resources": map[string]interface{}{},
+					"volumeModifier": map[string]interface{}{
+						"enabled": true,
+					},
 					"csiSnapshotController": map[string]interface{}{
 						"replicas": 1,
 					},
@@ -443,6 +540,123 @@ var _ = Describe("ValuesProvider", func() {
 			}))
 		})
 
+		It("should return correct control plane chart values and Karpenter enabled", func() {
+			setKarpenterEnabled(cp, nil)
+			cluster.Shoot.Spec.DNS = &gardencorev1beta1.DNS{Domain: pointer.String("shoot.example.com")}
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue(aws.KarpenterName, utils.MergeMaps(karpenterChartValues, map[string]interface{}{
+				"replicas":          1,
+				"clusterEndpoint":   "https://api.shoot.example.com",
+				"interruptionQueue": "test-karpenter",
+			})))
+		})
+
+		It("should return correct control plane chart values and Karpenter enabled without interruption handling", func() {
+			setKarpenterEnabled(cp, pointer.Bool(false))
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue(aws.KarpenterName, utils.MergeMaps(karpenterChartValues, map[string]interface{}{
+				"replicas": 1,
+			})))
+		})
+
+		It("should return correct control plane chart values and EFS enabled", func() {
+			setEFSEnabled(cp, nil)
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue(aws.CSIControllerEFSName, utils.MergeMaps(csiEFSControllerChartValues, map[string]interface{}{
+				"replicas": 1,
+			})))
+		})
+
+		It("should return correct control plane chart values for a configured cloud-controller-manager", func() {
+			cp.Spec.DefaultSpec.ProviderConfig.Raw = encode(&apisawsv1alpha1.ControlPlaneConfig{
+				CloudControllerManager: &apisawsv1alpha1.CloudControllerManagerConfig{
+					ConcurrentServiceSyncs:    pointer.Int32(20),
+					NodeStatusUpdateFrequency: &metav1.Duration{Duration: time.Minute},
+					AllocateNodeCIDRs:         pointer.Bool(false),
+				},
+			})
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKey(aws.CloudControllerManagerName))
+			Expect(values[aws.CloudControllerManagerName]).To(And(
+				HaveKeyWithValue("concurrentServiceSyncs", int32(20)),
+				HaveKeyWithValue("nodeStatusUpdateFrequency", "1m0s"),
+				HaveKeyWithValue("allocateNodeCIDRs", false),
+			))
+		})
+
+		It("should return correct control plane chart values for a configured EBS CSI driver", func() {
+			cp.Spec.DefaultSpec.ProviderConfig.Raw = encode(&apisawsv1alpha1.ControlPlaneConfig{
+				Storage: &apisawsv1alpha1.Storage{
+					EBS: &apisawsv1alpha1.EBSConfig{
+						VolumeModification: pointer.Bool(false),
+						Batching:           pointer.Bool(true),
+						SnapshotController: &apisawsv1alpha1.SnapshotControllerConfig{WorkerThreads: pointer.Int32(20)},
+						ResourceRequests: map[string]corev1.ResourceList{
+							"provisioner": {corev1.ResourceCPU: resource.MustParse("100m")},
+						},
+					},
+				},
+			})
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKey(aws.CSIControllerName))
+			Expect(values[aws.CSIControllerName]).To(And(
+				HaveKeyWithValue("batching", true),
+				HaveKeyWithValue("volumeModifier", map[string]interface{}{"enabled": false}),
+				HaveKeyWithValue("csiSnapshotController", map[string]interface{}{"replicas": 1, "workerThreads": int32(20)}),
+				HaveKeyWithValue("resources", map[string]interface{}{
+					"provisioner": map[string]interface{}{
+						"requests": corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+					},
+				}),
+			))
+		})
+
+		It("should pass the additional tags of the infrastructure config to the EBS CSI driver and the aws-load-balancer-controller", func() {
+			cluster.Shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{Raw: encode(&apisawsv1alpha1.InfrastructureConfig{
+				Tags: map[string]string{"team": "foo", "cost-center": "1234"},
+			})}
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[aws.CSIControllerName]).To(HaveKeyWithValue("extraTags", "cost-center=1234,team=foo"))
+			Expect(values[aws.AWSLoadBalancerControllerName]).To(HaveKeyWithValue("defaultTags", map[string]interface{}{
+				"KubernetesCluster":                  namespace,
+				"kubernetes.io/cluster/" + namespace: "owned",
+				"team":                               "foo",
+				"cost-center":                        "1234",
+			}))
+		})
+
+		It("should pass the extra tags and the shoot metadata tags to the EBS CSI driver", func() {
+			cluster.Shoot.Name = "bar"
+			cluster.Shoot.Namespace = "garden-foo"
+			cluster.Shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{Raw: encode(&apisawsv1alpha1.InfrastructureConfig{
+				Tags: map[string]string{"team": "foo", "cost-center": "1234"},
+			})}
+			cp.Spec.DefaultSpec.ProviderConfig.Raw = encode(&apisawsv1alpha1.ControlPlaneConfig{
+				Storage: &apisawsv1alpha1.Storage{
+					EBS: &apisawsv1alpha1.EBSConfig{
+						ExtraTags:         map[string]string{"cost-center": "4321", "tier": "storage"},
+						ShootMetadataTags: pointer.Bool(true),
+					},
+				},
+			})
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[aws.CSIControllerName]).To(HaveKeyWithValue("extraTags", "cost-center=4321,gardener.cloud/project=foo,gardener.cloud/shoot=bar,team=foo,tier=storage"))
+		})
+
 		DescribeTable("topologyAwareRoutingEnabled value",
 			func(seedSettings *gardencorev1beta1.SeedSettings, shootControlPlane *gardencorev1beta1.ControlPlane, expected bool) {
 				cluster.Seed = &gardencorev1beta1.Seed{
@@ -508,6 +722,12 @@ var _ = Describe("ValuesProvider", func() {
 					aws.CloudControllerManagerName:    enabledTrue,
 					aws.AWSCustomRouteControllerName:  enabledFalse,
 					aws.AWSLoadBalancerControllerName: enabledFalse,
+					aws.KarpenterName:                 enabledFalse,
+					aws.CloudWatchAgentName:           enabledFalse,
+					aws.CSINodeEFSName: utils.MergeMaps(enabledFalse, map[string]interface{}{
+						"vpaEnabled":  true,
+						"pspDisabled": false,
+					}),
 					aws.CSINodeName: utils.MergeMaps(enabledTrue, map[string]interface{}{
 						"kubernetesVersion": "1.24.1",
 						"vpaEnabled":        true,
@@ -533,6 +753,12 @@ var _ = Describe("ValuesProvider", func() {
 					aws.CloudControllerManagerName:    enabledTrue,
 					aws.AWSCustomRouteControllerName:  enabledTrue,
 					aws.AWSLoadBalancerControllerName: enabledFalse,
+					aws.KarpenterName:                 enabledFalse,
+					aws.CloudWatchAgentName:           enabledFalse,
+					aws.CSINodeEFSName: utils.MergeMaps(enabledFalse, map[string]interface{}{
+						"vpaEnabled":  true,
+						"pspDisabled": false,
+					}),
 					aws.CSINodeName: utils.MergeMaps(enabledTrue, map[string]interface{}{
 						"kubernetesVersion": "1.24.1",
 						"vpaEnabled":        true,
@@ -573,6 +799,12 @@ var _ = Describe("ValuesProvider", func() {
 					aws.CloudControllerManagerName:    enabledTrue,
 					aws.AWSCustomRouteControllerName:  enabledFalse,
 					aws.AWSLoadBalancerControllerName: albChartValues,
+					aws.KarpenterName:                 enabledFalse,
+					aws.CloudWatchAgentName:           enabledFalse,
+					aws.CSINodeEFSName: utils.MergeMaps(enabledFalse, map[string]interface{}{
+						"vpaEnabled":  true,
+						"pspDisabled": false,
+					}),
 					aws.CSINodeName: utils.MergeMaps(enabledTrue, map[string]interface{}{
 						"kubernetesVersion": "1.24.1",
 						"vpaEnabled":        true,
@@ -589,6 +821,156 @@ var _ = Describe("ValuesProvider", func() {
 			})
 		})
 
+		Context("shoot control plane chart values and load balancer defaults", func() {
+			It("should pass the default annotations to the cloud-controller-manager chart", func() {
+				cp.Spec.DefaultSpec.ProviderConfig.Raw = encode(&apisawsv1alpha1.ControlPlaneConfig{
+					LoadBalancerDefaults: &apisawsv1alpha1.LoadBalancerDefaults{
+						Internal:   pointer.Bool(true),
+						TargetType: pointer.String("ip"),
+						Subnets:    []string{"subnet-1", "subnet-2"},
+						Attributes: map[string]string{"load_balancing.cross_zone.enabled": "true", "deletion_protection.enabled": "true"},
+					},
+				})
+
+				values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values).To(HaveKeyWithValue(aws.CloudControllerManagerName, map[string]interface{}{
+					"enabled": true,
+					"loadBalancerDefaults": map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-internal":        "true",
+						"service.beta.kubernetes.io/aws-load-balancer-scheme":          "internal",
+						"service.beta.kubernetes.io/aws-load-balancer-nlb-target-type": "ip",
+						"service.beta.kubernetes.io/aws-load-balancer-subnets":         "subnet-1,subnet-2",
+						"service.beta.kubernetes.io/aws-load-balancer-attributes":      "deletion_protection.enabled=true,load_balancing.cross_zone.enabled=true",
+					},
+				}))
+			})
+
+			It("should pass the additional tags of the infrastructure config to the cloud-controller-manager chart", func() {
+				cluster.Shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{Raw: encode(&apisawsv1alpha1.InfrastructureConfig{
+					Tags: map[string]string{"team": "foo", "cost-center": "1234"},
+				})}
+
+				values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values).To(HaveKeyWithValue(aws.CloudControllerManagerName, map[string]interface{}{
+					"enabled": true,
+					"loadBalancerDefaults": map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-additional-resource-tags": "cost-center=1234,team=foo",
+					},
+				}))
+			})
+
+			It("should default internet-facing load balancers for the aws-load-balancer-controller", func() {
+				cp.Spec.DefaultSpec.ProviderConfig.Raw = encode(&apisawsv1alpha1.ControlPlaneConfig{
+					LoadBalancerDefaults: &apisawsv1alpha1.LoadBalancerDefaults{Internal: pointer.Bool(false)},
+				})
+
+				values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values).To(HaveKeyWithValue(aws.CloudControllerManagerName, map[string]interface{}{
+					"enabled": true,
+					"loadBalancerDefaults": map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-scheme": "internet-facing",
+					},
+				}))
+			})
+
+			It("should pass the access log and TLS policy defaults to the cloud-controller-manager chart", func() {
+				cp.Spec.DefaultSpec.ProviderConfig.Raw = encode(&apisawsv1alpha1.ControlPlaneConfig{
+					LoadBalancerDefaults: &apisawsv1alpha1.LoadBalancerDefaults{
+						AccessLogs: &apisawsv1alpha1.LoadBalancerAccessLogs{
+							Enabled:      true,
+							Prefix:       pointer.String("lb"),
+							EmitInterval: pointer.Int32(5),
+						},
+						SSLNegotiationPolicy: pointer.String("ELBSecurityPolicy-TLS13-1-2-2021-06"),
+					},
+				})
+				cp.Spec.InfrastructureProviderStatus.Raw = encode(&apisawsv1alpha1.InfrastructureStatus{
+					LoadBalancerAccessLogs: &apisawsv1alpha1.LoadBalancerAccessLogsStatus{BucketName: "shoot--foo--bar-lb-logs"},
+				})
+
+				values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values).To(HaveKeyWithValue(aws.CloudControllerManagerName, map[string]interface{}{
+					"enabled": true,
+					"loadBalancerDefaults": map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-access-log-enabled":          "true",
+						"service.beta.kubernetes.io/aws-load-balancer-access-log-s3-bucket-name":   "shoot--foo--bar-lb-logs",
+						"service.beta.kubernetes.io/aws-load-balancer-access-log-s3-bucket-prefix": "lb",
+						"service.beta.kubernetes.io/aws-load-balancer-access-log-emit-interval":    "5",
+						"service.beta.kubernetes.io/aws-load-balancer-ssl-negotiation-policy":      "ELBSecurityPolicy-TLS13-1-2-2021-06",
+					},
+				}))
+			})
+
+			It("should not enable access logs as long as the bucket is unknown", func() {
+				cp.Spec.DefaultSpec.ProviderConfig.Raw = encode(&apisawsv1alpha1.ControlPlaneConfig{
+					LoadBalancerDefaults: &apisawsv1alpha1.LoadBalancerDefaults{
+						AccessLogs: &apisawsv1alpha1.LoadBalancerAccessLogs{Enabled: true},
+					},
+				})
+
+				values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values).To(HaveKeyWithValue(aws.CloudControllerManagerName, map[string]interface{}{"enabled": true}))
+			})
+		})
+
+		Context("shoot control plane chart values and reserved volume attachments", func() {
+			It("should pass the reserved volume attachments to the EBS CSI node driver", func() {
+				cp.Spec.DefaultSpec.ProviderConfig.Raw = encode(&apisawsv1alpha1.ControlPlaneConfig{
+					Storage: &apisawsv1alpha1.Storage{
+						EBS: &apisawsv1alpha1.EBSConfig{ReservedVolumeAttachments: pointer.Int32(2)},
+					},
+				})
+
+				values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values).To(HaveKey(aws.CSINodeName))
+				Expect(values[aws.CSINodeName]).To(HaveKeyWithValue("driver", map[string]interface{}{
+					"volumeAttachLimit":         "42",
+					"reservedVolumeAttachments": int32(2),
+				}))
+			})
+		})
+
+		Context("shoot control plane chart values and EFS enabled", func() {
+			It("should enable the EFS CSI node driver", func() {
+				setEFSEnabled(cp, nil)
+
+				values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values).To(HaveKeyWithValue(aws.CSINodeEFSName, utils.MergeMaps(enabledTrue, map[string]interface{}{
+					"vpaEnabled":  true,
+					"pspDisabled": false,
+				})))
+			})
+		})
+
+		Context("shoot control plane chart values and CloudWatch agent enabled", func() {
+			It("should enable the CloudWatch agent with logs", func() {
+				cp.Spec.DefaultSpec.ProviderConfig.Raw = encode(&apisawsv1alpha1.ControlPlaneConfig{
+					CloudWatchAgent: &apisawsv1alpha1.CloudWatchAgentConfig{
+						Enabled:                   true,
+						MetricsCollectionInterval: pointer.Int32(30),
+						Logs:                      &apisawsv1alpha1.CloudWatchAgentLogs{Enabled: true},
+					},
+				})
+
+				values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values).To(HaveKeyWithValue(aws.CloudWatchAgentName, map[string]interface{}{
+					"enabled":                   true,
+					"metricsCollectionInterval": int32(30),
+					"logGroupName":              "/gardener/" + namespace + "/containers",
+					"vpaEnabled":                true,
+					"pspDisabled":               false,
+				}))
+			})
+		})
+
 		Context("podSecurityPolicy", func() {
 			It("should return correct shoot control plane chart when PodSecurityPolicy admission plugin is not disabled in the shoot", func() {
 				cluster.Shoot.Spec.Kubernetes.KubeAPIServer = &gardencorev1beta1.KubeAPIServerConfig{
@@ -604,6 +986,12 @@ var _ = Describe("ValuesProvider", func() {
 					aws.CloudControllerManagerName:    enabledTrue,
 					aws.AWSCustomRouteControllerName:  enabledFalse,
 					aws.AWSLoadBalancerControllerName: enabledFalse,
+					aws.KarpenterName:                 enabledFalse,
+					aws.CloudWatchAgentName:           enabledFalse,
+					aws.CSINodeEFSName: utils.MergeMaps(enabledFalse, map[string]interface{}{
+						"vpaEnabled":  true,
+						"pspDisabled": false,
+					}),
 					aws.CSINodeName: utils.MergeMaps(enabledTrue, map[string]interface{}{
 						"kubernetesVersion": "1.24.1",
 						"vpaEnabled":        true,
@@ -633,6 +1021,12 @@ var _ = Describe("ValuesProvider", func() {
 					aws.CloudControllerManagerName:    enabledTrue,
 					aws.AWSCustomRouteControllerName:  enabledFalse,
 					aws.AWSLoadBalancerControllerName: enabledFalse,
+					aws.KarpenterName:                 enabledFalse,
+					aws.CloudWatchAgentName:           enabledFalse,
+					aws.CSINodeEFSName: utils.MergeMaps(enabledFalse, map[string]interface{}{
+						"vpaEnabled":  true,
+						"pspDisabled": true,
+					}),
 					aws.CSINodeName: utils.MergeMaps(enabledTrue, map[string]interface{}{
 						"kubernetesVersion": "1.24.1",
 						"vpaEnabled":        true,
@@ -656,6 +1050,14 @@ var _ = Describe("ValuesProvider", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(values).To(Equal(map[string]interface{}{
 				"managedDefaultClass": true,
+				"defaultClass": map[string]interface{}{
+					"parameters": map[string]interface{}{"encrypted": "true"},
+				},
+				"additionalClasses": []interface{}{},
+				"efs": map[string]interface{}{
+					"enabled":      false,
+					"fileSystemID": "",
+				},
 			}))
 		})
 
@@ -670,6 +1072,14 @@ var _ = Describe("ValuesProvider", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(values).To(Equal(map[string]interface{}{
 				"managedDefaultClass": true,
+				"defaultClass": map[string]interface{}{
+					"parameters": map[string]interface{}{"encrypted": "true"},
+				},
+				"additionalClasses": []interface{}{},
+				"efs": map[string]interface{}{
+					"enabled":      false,
+					"fileSystemID": "",
+				},
 			}))
 		})
 
@@ -684,6 +1094,90 @@ var _ = Describe("ValuesProvider", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(values).To(Equal(map[string]interface{}{
 				"managedDefaultClass": false,
+				"defaultClass": map[string]interface{}{
+					"parameters": map[string]interface{}{"encrypted": "true"},
+				},
+				"additionalClasses": []interface{}{},
+				"efs": map[string]interface{}{
+					"enabled":      false,
+					"fileSystemID": "",
+				},
+			}))
+		})
+
+		It("should return the configured default and additional storage classes", func() {
+			cp.Spec.DefaultSpec.ProviderConfig.Raw = encode(&apisawsv1alpha1.ControlPlaneConfig{
+				Storage: &apisawsv1alpha1.Storage{
+					DefaultClass: &apisawsv1alpha1.StorageClassParameters{
+						Type:       pointer.String("gp3"),
+						IOPS:       pointer.Int64(4000),
+						Throughput: pointer.Int64(250),
+						KMSKeyID:   pointer.String("alias/my-key"),
+					},
+					AdditionalClasses: []apisawsv1alpha1.StorageClass{
+						{Name: "unencrypted", Parameters: apisawsv1alpha1.StorageClassParameters{Type: pointer.String("st1"), Encrypted: pointer.Bool(false)}},
+					},
+				},
+			})
+
+			values, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("defaultClass", map[string]interface{}{
+				"parameters": map[string]interface{}{
+					"type":       "gp3",
+					"iops":       "4000",
+					"throughput": "250",
+					"encrypted":  "true",
+					"kmsKeyId":   "alias/my-key",
+				},
+			}))
+			Expect(values).To(HaveKeyWithValue("additionalClasses", []interface{}{
+				map[string]interface{}{
+					"name": "unencrypted",
+					"parameters": map[string]interface{}{
+						"type":      "st1",
+						"encrypted": "false",
+					},
+				},
+			}))
+		})
+
+		It("should return the efs storage class values for the file system created by the infrastructure", func() {
+			setEFSEnabled(cp, nil)
+			cp.Spec.InfrastructureProviderStatus.Raw = encode(&apisawsv1alpha1.InfrastructureStatus{
+				ElasticFileSystem: &apisawsv1alpha1.ElasticFileSystemStatus{ID: "fs-1234"},
+			})
+
+			values, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("efs", map[string]interface{}{
+				"enabled":      true,
+				"fileSystemID": "fs-1234",
+			}))
+		})
+
+		It("should prefer the configured EFS file system", func() {
+			setEFSEnabled(cp, pointer.String("fs-5678"))
+			cp.Spec.InfrastructureProviderStatus.Raw = encode(&apisawsv1alpha1.InfrastructureStatus{
+				ElasticFileSystem: &apisawsv1alpha1.ElasticFileSystemStatus{ID: "fs-1234"},
+			})
+
+			values, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("efs", map[string]interface{}{
+				"enabled":      true,
+				"fileSystemID": "fs-5678",
+			}))
+		})
+
+		It("should not return the efs storage class values if the file system is not known yet", func() {
+			setEFSEnabled(cp, nil)
+
+			values, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("efs", map[string]interface{}{
+				"enabled":      false,
+				"fileSystemID": "",
 			}))
 		})
 	})
@@ -695,9 +1189,17 @@ var _ = Describe("ValuesProvider", func() {
 			Expect(values).To(Equal(map[string]interface{}{
 				"volumesnapshots":                 map[string]interface{}{"enabled": true},
 				aws.AWSLoadBalancerControllerName: map[string]interface{}{"enabled": false},
+				aws.KarpenterName:                 map[string]interface{}{"enabled": false},
 			}))
 		})
 
+		It("should return correct control plane shoot CRDs if Karpenter is enabled", func() {
+			setKarpenterEnabled(cp, nil)
+			values, err := vp.GetControlPlaneShootCRDsChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue(aws.KarpenterName, map[string]interface{}{"enabled": true}))
+		})
+
 		It("should return correct control plane shoot CRDs if ALB is enabled", func() {
 			setLoadBalancerControllerEnabled(cp, nil)
 			values, err := vp.GetControlPlaneShootCRDsChartValues(ctx, cp, cluster)
@@ -705,6 +1207,7 @@ var _ = Describe("ValuesProvider", func() {
 			Expect(values).To(Equal(map[string]interface{}{
 				"volumesnapshots":                 map[string]interface{}{"enabled": true},
 				aws.AWSLoadBalancerControllerName: map[string]interface{}{"enabled": true},
+				aws.KarpenterName:                 map[string]interface{}{"enabled": false},
 			}))
 		})
 	})
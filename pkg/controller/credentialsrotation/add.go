@@ -0,0 +1,92 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package credentialsrotation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// ControllerName is the name of the controller.
+const ControllerName = "credentialsrotation"
+
+var (
+	// DefaultAddOptions are the default AddOptions for AddToManager.
+	DefaultAddOptions = AddOptions{
+		GracePeriod: 20 * time.Minute,
+	}
+)
+
+// AddOptions are options to apply when adding the AWS credentials rotation controller to the manager.
+type AddOptions struct {
+	// Controller are the controller.Options.
+	Controller controller.Options
+	// GardenCluster is the garden cluster object.
+	GardenCluster cluster.Cluster
+	// GracePeriod is the duration for which the previous access key is kept after the new one has been rolled out, so
+	// that in-flight operations (e.g. Terraformer pods) can finish.
+	GracePeriod time.Duration
+}
+
+// AddToManagerWithOptions adds a controller with the given Options to the given manager.
+func AddToManagerWithOptions(_ context.Context, mgr manager.Manager, opts AddOptions) error {
+	if opts.GardenCluster == nil {
+		return fmt.Errorf("garden cluster is required for the %s controller", ControllerName)
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(opts.Controller).
+		For(&corev1.Secret{}, builder.OnlyMetadata, builder.WithPredicates(predicate.NewPredicateFuncs(isRotationRequested))).
+		Complete(&reconciler{
+			client:           mgr.GetClient(),
+			gardenReader:     opts.GardenCluster.GetAPIReader(),
+			gardenWriter:     opts.GardenCluster.GetClient(),
+			awsClientFactory: awsclient.NewControllerFactory(awsclient.FactoryFunc(awsclient.NewInterface), ControllerName),
+			clock:            clock.RealClock{},
+			gracePeriod:      opts.GracePeriod,
+			pollInterval:     30 * time.Second,
+			verifyInterval:   5 * time.Second,
+			verifyTimeout:    2 * time.Minute,
+		})
+}
+
+// AddToManager adds a controller with the default Options.
+func AddToManager(ctx context.Context, mgr manager.Manager) error {
+	return AddToManagerWithOptions(ctx, mgr, DefaultAddOptions)
+}
+
+func isRotationRequested(obj client.Object) bool {
+	if obj.GetName() != v1beta1constants.SecretNameCloudProvider {
+		return false
+	}
+	annotations := obj.GetAnnotations()
+	return annotations[apisaws.AnnotationKeyRotateCredentials] == "true" || annotations[AnnotationKeyPhase] != ""
+}
@@ -0,0 +1,370 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package credentialsrotation
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+const (
+	// AnnotationKeyPhase is the annotation key in which the phase of a running rotation is stored.
+	AnnotationKeyPhase = "aws.provider.extensions.gardener.cloud/credentials-rotation-phase"
+	// AnnotationKeyPreviousAccessKeyID is the annotation key in which the ID of the access key which is replaced by a
+	// running rotation is stored.
+	AnnotationKeyPreviousAccessKeyID = "aws.provider.extensions.gardener.cloud/credentials-rotation-previous-access-key-id"
+	// AnnotationKeyPreparedAt is the annotation key in which the time is stored when the new access key has been
+	// written to the secret.
+	AnnotationKeyPreparedAt = "aws.provider.extensions.gardener.cloud/credentials-rotation-prepared-at"
+
+	// PhasePrepared is the phase in which the new access key has been created, verified and written to the secret.
+	PhasePrepared = "Prepared"
+	// PhaseRollingOut is the phase in which the new access key is rolled out to the components of the control plane.
+	PhaseRollingOut = "RollingOut"
+)
+
+// reconciler rotates the access key in a cloudprovider secret. It creates a new access key for the IAM user, verifies
+// it and writes it to the secret in the seed as well as to the secret in the garden (so that gardenlet does not revert
+// it). Afterwards it triggers the reconciliation of the control plane, so that the CCM, the CSI driver, etc. are rolled
+// out with the new access key, and waits for it. Finally, after a grace period for in-flight operations, it deletes the
+// previous access key. The MCM, the Terraformer and the controllers of this extension read the secret on every use.
+type reconciler struct {
+	client           client.Client
+	gardenReader     client.Reader
+	gardenWriter     client.Writer
+	awsClientFactory awsclient.Factory
+	clock            clock.Clock
+
+	gracePeriod    time.Duration
+	pollInterval   time.Duration
+	verifyInterval time.Duration
+	verifyTimeout  time.Duration
+}
+
+// Reconcile drives the rotation of the access key in the given cloudprovider secret.
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	secret := &corev1.Secret{}
+	if err := r.client.Get(ctx, request.NamespacedName, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	switch phase := secret.Annotations[AnnotationKeyPhase]; phase {
+	case "":
+		if secret.Annotations[apisaws.AnnotationKeyRotateCredentials] != "true" {
+			return reconcile.Result{}, nil
+		}
+		return r.prepare(ctx, log, secret)
+	case PhasePrepared:
+		return r.rollOut(ctx, log, secret)
+	case PhaseRollingOut:
+		return r.complete(ctx, log, secret)
+	default:
+		return reconcile.Result{}, reconcile.TerminalError(fmt.Errorf("unknown credentials rotation phase %q", phase))
+	}
+}
+
+func (r *reconciler) prepare(ctx context.Context, log logr.Logger, secret *corev1.Secret) (reconcile.Result, error) {
+	shoot, err := r.getShoot(ctx, secret.Namespace)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	credentials, err := aws.ReadCredentialsSecret(secret, false)
+	if err != nil {
+		return reconcile.Result{}, reconcile.TerminalError(fmt.Errorf("could not read credentials: %w", err))
+	}
+	if len(credentials.WebIdentityToken) > 0 {
+		return reconcile.Result{}, reconcile.TerminalError(fmt.Errorf("credentials with a web identity token cannot be rotated"))
+	}
+
+	// Ensure that the secret in the garden can be found before creating a new access key.
+	gardenSecret, err := r.getGardenSecret(ctx, shoot)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	// The previous access key is deleted at the end of the rotation, hence it must not be used by other shoots which
+	// would not be rolled out with the new one.
+	shootNames, err := r.getShootsUsingGardenSecret(ctx, gardenSecret)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if len(shootNames) > 1 {
+		return reconcile.Result{}, reconcile.TerminalError(fmt.Errorf("secret %s in garden is used by multiple shoots (%s) and cannot be rotated", client.ObjectKeyFromObject(gardenSecret), strings.Join(shootNames, ", ")))
+	}
+
+	awsClient, err := r.newAccessKeyClient(string(credentials.AccessKeyID), string(credentials.SecretAccessKey), shoot.Spec.Region)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	accountID, err := awsClient.GetAccountID(ctx)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not get account ID: %w", err)
+	}
+
+	log.Info("Creating new access key")
+	accessKey, err := awsClient.CreateAccessKey(ctx)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not create access key: %w", err)
+	}
+	if err := r.verifyAccessKey(ctx, accessKey, shoot.Spec.Region, accountID); err != nil {
+		// An IAM user can only have two access keys, hence the unusable one must not be left behind.
+		if deleteErr := awsClient.DeleteAccessKey(ctx, accessKey.AccessKeyID); deleteErr != nil {
+			err = errors.Join(err, fmt.Errorf("could not delete new access key %s: %w", accessKey.AccessKeyID, deleteErr))
+		}
+		return reconcile.Result{}, fmt.Errorf("could not verify new access key: %w", err)
+	}
+
+	log.Info("Writing new access key to secret", "accessKeyID", accessKey.AccessKeyID)
+	patch := client.MergeFrom(secret.DeepCopy())
+	secret.Data[aws.AccessKeyID] = []byte(accessKey.AccessKeyID)
+	secret.Data[aws.SecretAccessKey] = []byte(accessKey.SecretAccessKey)
+	metav1.SetMetaDataAnnotation(&secret.ObjectMeta, AnnotationKeyPhase, PhasePrepared)
+	metav1.SetMetaDataAnnotation(&secret.ObjectMeta, AnnotationKeyPreviousAccessKeyID, string(credentials.AccessKeyID))
+	metav1.SetMetaDataAnnotation(&secret.ObjectMeta, AnnotationKeyPreparedAt, r.clock.Now().UTC().Format(time.RFC3339))
+	if err := r.client.Patch(ctx, secret, patch); err != nil {
+		// The new access key would be lost otherwise, hence it must not be left behind either.
+		if deleteErr := awsClient.DeleteAccessKey(ctx, accessKey.AccessKeyID); deleteErr != nil {
+			err = errors.Join(err, fmt.Errorf("could not delete new access key %s: %w", accessKey.AccessKeyID, deleteErr))
+		}
+		return reconcile.Result{}, fmt.Errorf("could not write new access key %s to secret: %w", accessKey.AccessKeyID, err)
+	}
+
+	return r.rollOut(ctx, log, secret)
+}
+
+func (r *reconciler) rollOut(ctx context.Context, log logr.Logger, secret *corev1.Secret) (reconcile.Result, error) {
+	shoot, err := r.getShoot(ctx, secret.Namespace)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	gardenSecret, err := r.getGardenSecret(ctx, shoot)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if !bytes.Equal(gardenSecret.Data[aws.AccessKeyID], secret.Data[aws.AccessKeyID]) ||
+		!bytes.Equal(gardenSecret.Data[aws.SecretAccessKey], secret.Data[aws.SecretAccessKey]) {
+		log.Info("Writing new access key to secret in garden", "secret", client.ObjectKeyFromObject(gardenSecret))
+		patch := client.MergeFrom(gardenSecret.DeepCopy())
+		gardenSecret.Data[aws.AccessKeyID] = secret.Data[aws.AccessKeyID]
+		gardenSecret.Data[aws.SecretAccessKey] = secret.Data[aws.SecretAccessKey]
+		if err := r.gardenWriter.Patch(ctx, gardenSecret, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not write new access key to secret in garden: %w", err)
+		}
+	}
+
+	controlPlanes := &extensionsv1alpha1.ControlPlaneList{}
+	if err := r.client.List(ctx, controlPlanes, client.InNamespace(secret.Namespace)); err != nil {
+		return reconcile.Result{}, err
+	}
+	for i := range controlPlanes.Items {
+		cp := &controlPlanes.Items[i]
+		log.Info("Triggering reconciliation of control plane", "controlPlane", client.ObjectKeyFromObject(cp))
+		patch := client.MergeFrom(cp.DeepCopy())
+		metav1.SetMetaDataAnnotation(&cp.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
+		if err := r.client.Patch(ctx, cp, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not trigger reconciliation of control plane %s: %w", client.ObjectKeyFromObject(cp), err)
+		}
+	}
+
+	patch := client.MergeFrom(secret.DeepCopy())
+	metav1.SetMetaDataAnnotation(&secret.ObjectMeta, AnnotationKeyPhase, PhaseRollingOut)
+	if err := r.client.Patch(ctx, secret, patch); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.pollInterval}, nil
+}
+
+func (r *reconciler) complete(ctx context.Context, log logr.Logger, secret *corev1.Secret) (reconcile.Result, error) {
+	preparedAt, err := time.Parse(time.RFC3339, secret.Annotations[AnnotationKeyPreparedAt])
+	if err != nil {
+		return reconcile.Result{}, reconcile.TerminalError(fmt.Errorf("could not parse annotation %s: %w", AnnotationKeyPreparedAt, err))
+	}
+
+	controlPlanes := &extensionsv1alpha1.ControlPlaneList{}
+	if err := r.client.List(ctx, controlPlanes, client.InNamespace(secret.Namespace)); err != nil {
+		return reconcile.Result{}, err
+	}
+	for i := range controlPlanes.Items {
+		cp := &controlPlanes.Items[i]
+		if !isReconciledSince(cp, preparedAt) {
+			log.Info("Waiting for reconciliation of control plane", "controlPlane", client.ObjectKeyFromObject(cp))
+			return reconcile.Result{RequeueAfter: r.pollInterval}, nil
+		}
+	}
+
+	if remaining := preparedAt.Add(r.gracePeriod).Sub(r.clock.Now()); remaining > 0 {
+		log.Info("Waiting for grace period before deleting previous access key", "remaining", remaining)
+		return reconcile.Result{RequeueAfter: remaining}, nil
+	}
+
+	shoot, err := r.getShoot(ctx, secret.Namespace)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	awsClient, err := r.newAccessKeyClient(string(secret.Data[aws.AccessKeyID]), string(secret.Data[aws.SecretAccessKey]), shoot.Spec.Region)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if previousAccessKeyID := secret.Annotations[AnnotationKeyPreviousAccessKeyID]; previousAccessKeyID != "" {
+		log.Info("Deleting previous access key", "accessKeyID", previousAccessKeyID)
+		if err := awsClient.DeleteAccessKey(ctx, previousAccessKeyID); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not delete previous access key %s: %w", previousAccessKeyID, err)
+		}
+	}
+
+	patch := client.MergeFrom(secret.DeepCopy())
+	for _, key := range []string{apisaws.AnnotationKeyRotateCredentials, AnnotationKeyPhase, AnnotationKeyPreviousAccessKeyID, AnnotationKeyPreparedAt} {
+		delete(secret.Annotations, key)
+	}
+	if err := r.client.Patch(ctx, secret, patch); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	log.Info("Rotation of access key completed")
+	return reconcile.Result{}, nil
+}
+
+// newAccessKeyClient creates a client which uses the access key directly, as it acts on the IAM user of the access key.
+func (r *reconciler) newAccessKeyClient(accessKeyID, secretAccessKey, region string) (awsclient.Interface, error) {
+	return r.awsClientFactory.NewClient(aws.NewAuthConfig(&aws.Credentials{
+		AccessKeyID:     []byte(accessKeyID),
+		SecretAccessKey: []byte(secretAccessKey),
+	}, region))
+}
+
+// verifyAccessKey checks that the given access key can be used for the given account. New access keys are eventually
+// consistent, hence it is retried for some time.
+func (r *reconciler) verifyAccessKey(ctx context.Context, accessKey *awsclient.AccessKey, region, accountID string) error {
+	awsClient, err := r.newAccessKeyClient(accessKey.AccessKeyID, accessKey.SecretAccessKey, region)
+	if err != nil {
+		return err
+	}
+
+	var lastErr error
+	if err := wait.PollUntilContextTimeout(ctx, r.verifyInterval, r.verifyTimeout, true, func(ctx context.Context) (bool, error) {
+		newAccountID, err := awsClient.GetAccountID(ctx)
+		if err != nil {
+			lastErr = err
+			return false, nil
+		}
+		if newAccountID != accountID {
+			return false, fmt.Errorf("access key belongs to account %s instead of %s", newAccountID, accountID)
+		}
+		return true, nil
+	}); err != nil {
+		if lastErr != nil {
+			return fmt.Errorf("%w: %w", err, lastErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// getShoot returns the shoot of the cluster in the given namespace.
+func (r *reconciler) getShoot(ctx context.Context, namespace string) (*gardencorev1beta1.Shoot, error) {
+	cluster, err := extensionscontroller.GetCluster(ctx, r.client, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.Shoot == nil {
+		return nil, reconcile.TerminalError(fmt.Errorf("cluster %s does not contain a shoot", namespace))
+	}
+	return cluster.Shoot, nil
+}
+
+// getGardenSecret returns the secret in the garden which is referenced by the secret binding of the shoot.
+func (r *reconciler) getGardenSecret(ctx context.Context, shoot *gardencorev1beta1.Shoot) (*corev1.Secret, error) {
+	if shoot.Spec.SecretBindingName == nil {
+		return nil, reconcile.TerminalError(fmt.Errorf("shoot does not reference a secret binding"))
+	}
+
+	secretBinding := &gardencorev1beta1.SecretBinding{}
+	if err := r.gardenReader.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: *shoot.Spec.SecretBindingName}, secretBinding); err != nil {
+		return nil, fmt.Errorf("could not get secret binding: %w", err)
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.gardenReader.Get(ctx, client.ObjectKey{Namespace: secretBinding.SecretRef.Namespace, Name: secretBinding.SecretRef.Name}, secret); err != nil {
+		return nil, fmt.Errorf("could not get secret referenced by secret binding: %w", err)
+	}
+	return secret, nil
+}
+
+// getShootsUsingGardenSecret returns the names of all shoots whose secret binding references the given secret in the
+// garden.
+func (r *reconciler) getShootsUsingGardenSecret(ctx context.Context, gardenSecret *corev1.Secret) ([]string, error) {
+	secretBindings := &gardencorev1beta1.SecretBindingList{}
+	if err := r.gardenReader.List(ctx, secretBindings); err != nil {
+		return nil, fmt.Errorf("could not list secret bindings: %w", err)
+	}
+
+	var shootNames []string
+	for _, secretBinding := range secretBindings.Items {
+		if secretBinding.SecretRef.Namespace != gardenSecret.Namespace || secretBinding.SecretRef.Name != gardenSecret.Name {
+			continue
+		}
+
+		shoots := &gardencorev1beta1.ShootList{}
+		if err := r.gardenReader.List(ctx, shoots, client.InNamespace(secretBinding.Namespace)); err != nil {
+			return nil, fmt.Errorf("could not list shoots: %w", err)
+		}
+		for _, shoot := range shoots.Items {
+			if ptr.Deref(shoot.Spec.SecretBindingName, "") == secretBinding.Name {
+				shootNames = append(shootNames, client.ObjectKeyFromObject(&shoot).String())
+			}
+		}
+	}
+	return shootNames, nil
+}
+
+func isReconciledSince(cp *extensionsv1alpha1.ControlPlane, since time.Time) bool {
+	lastOperation := cp.Status.LastOperation
+	return !metav1.HasAnnotation(cp.ObjectMeta, v1beta1constants.GardenerOperation) &&
+		cp.Status.ObservedGeneration == cp.Generation &&
+		lastOperation != nil &&
+		lastOperation.State == gardencorev1beta1.LastOperationStateSucceeded &&
+		!lastOperation.LastUpdateTime.Time.Before(since)
+}
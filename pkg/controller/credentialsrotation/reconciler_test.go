@@ -0,0 +1,300 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package credentialsrotation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	mockawsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/mock"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		namespace = "shoot--foo--bar"
+		region    = "eu-west-1"
+		accountID = "123456789012"
+	)
+
+	var (
+		ctx = context.TODO()
+
+		ctrl             *gomock.Controller
+		seedClient       client.WithWatch
+		gardenClient     client.Client
+		awsClientFactory *mockawsclient.MockFactory
+		oldAWSClient     *mockawsclient.MockInterface
+		newAWSClient     *mockawsclient.MockInterface
+		fakeClock        *testclock.FakeClock
+		r                *reconciler
+
+		secret       *corev1.Secret
+		gardenSecret *corev1.Secret
+		cp           *extensionsv1alpha1.ControlPlane
+		request      reconcile.Request
+
+		oldAuthConfig = awsclient.AuthConfig{AccessKeyID: "old-access-key-id", SecretAccessKey: "old-secret-access-key", Region: region}
+		newAuthConfig = awsclient.AuthConfig{AccessKeyID: "new-access-key-id", SecretAccessKey: "new-secret-access-key", Region: region}
+
+		getSecret = func() *corev1.Secret {
+			current := &corev1.Secret{}
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(secret), current)).To(Succeed())
+			return current
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		scheme := runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+		gardenScheme := runtime.NewScheme()
+		Expect(corev1.AddToScheme(gardenScheme)).To(Succeed())
+		Expect(gardencorev1beta1.AddToScheme(gardenScheme)).To(Succeed())
+
+		shoot := &gardencorev1beta1.Shoot{
+			TypeMeta:   metav1.TypeMeta{APIVersion: gardencorev1beta1.SchemeGroupVersion.String(), Kind: "Shoot"},
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+			Spec: gardencorev1beta1.ShootSpec{
+				Region:            region,
+				SecretBindingName: pointer.String("binding"),
+			},
+		}
+		shootJSON, err := json.Marshal(shoot)
+		Expect(err).NotTo(HaveOccurred())
+		cluster := &extensionsv1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: namespace},
+			Spec:       extensionsv1alpha1.ClusterSpec{Shoot: runtime.RawExtension{Raw: shootJSON}},
+		}
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        v1beta1constants.SecretNameCloudProvider,
+				Namespace:   namespace,
+				Annotations: map[string]string{apisaws.AnnotationKeyRotateCredentials: "true"},
+			},
+			Data: map[string][]byte{
+				aws.AccessKeyID:     []byte(oldAuthConfig.AccessKeyID),
+				aws.SecretAccessKey: []byte(oldAuthConfig.SecretAccessKey),
+			},
+		}
+		cp = &extensionsv1alpha1.ControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "control-plane", Namespace: namespace},
+		}
+		seedClient = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(cluster, secret, cp).WithStatusSubresource(cp).Build()
+
+		secretBinding := &gardencorev1beta1.SecretBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "binding", Namespace: "garden-foo"},
+			SecretRef:  corev1.SecretReference{Name: "aws", Namespace: "garden-foo"},
+		}
+		gardenSecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "aws", Namespace: "garden-foo"},
+			Data: map[string][]byte{
+				aws.AccessKeyID:     []byte(oldAuthConfig.AccessKeyID),
+				aws.SecretAccessKey: []byte(oldAuthConfig.SecretAccessKey),
+			},
+		}
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(gardenScheme).WithObjects(shoot, secretBinding, gardenSecret).Build()
+
+		awsClientFactory = mockawsclient.NewMockFactory(ctrl)
+		oldAWSClient = mockawsclient.NewMockInterface(ctrl)
+		newAWSClient = mockawsclient.NewMockInterface(ctrl)
+		fakeClock = testclock.NewFakeClock(time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC))
+
+		r = &reconciler{
+			client:           seedClient,
+			gardenReader:     gardenClient,
+			gardenWriter:     gardenClient,
+			awsClientFactory: awsClientFactory,
+			clock:            fakeClock,
+			gracePeriod:      20 * time.Minute,
+			pollInterval:     30 * time.Second,
+			verifyInterval:   time.Millisecond,
+			verifyTimeout:    100 * time.Millisecond,
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(secret)}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should do nothing if no rotation is requested", func() {
+		delete(secret.Annotations, apisaws.AnnotationKeyRotateCredentials)
+		Expect(seedClient.Update(ctx, secret)).To(Succeed())
+
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(getSecret().Data).To(Equal(secret.Data))
+	})
+
+	It("should create, verify and roll out a new access key", func() {
+		awsClientFactory.EXPECT().NewClient(oldAuthConfig).Return(oldAWSClient, nil)
+		oldAWSClient.EXPECT().GetAccountID(ctx).Return(accountID, nil)
+		oldAWSClient.EXPECT().CreateAccessKey(ctx).Return(&awsclient.AccessKey{AccessKeyID: newAuthConfig.AccessKeyID, SecretAccessKey: newAuthConfig.SecretAccessKey}, nil)
+		awsClientFactory.EXPECT().NewClient(newAuthConfig).Return(newAWSClient, nil)
+		gomock.InOrder(
+			newAWSClient.EXPECT().GetAccountID(gomock.Any()).Return("", errors.New("invalid access key")),
+			newAWSClient.EXPECT().GetAccountID(gomock.Any()).Return(accountID, nil),
+		)
+
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Second}))
+
+		current := getSecret()
+		Expect(current.Data).To(Equal(map[string][]byte{
+			aws.AccessKeyID:     []byte(newAuthConfig.AccessKeyID),
+			aws.SecretAccessKey: []byte(newAuthConfig.SecretAccessKey),
+		}))
+		Expect(current.Annotations).To(Equal(map[string]string{
+			apisaws.AnnotationKeyRotateCredentials: "true",
+			AnnotationKeyPhase:                     PhaseRollingOut,
+			AnnotationKeyPreviousAccessKeyID:       oldAuthConfig.AccessKeyID,
+			AnnotationKeyPreparedAt:                "2023-10-01T12:00:00Z",
+		}))
+
+		Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(gardenSecret), gardenSecret)).To(Succeed())
+		Expect(gardenSecret.Data).To(Equal(current.Data))
+
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(cp), cp)).To(Succeed())
+		Expect(cp.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
+	})
+
+	It("should delete the new access key if it cannot be verified", func() {
+		awsClientFactory.EXPECT().NewClient(oldAuthConfig).Return(oldAWSClient, nil)
+		oldAWSClient.EXPECT().GetAccountID(ctx).Return(accountID, nil)
+		oldAWSClient.EXPECT().CreateAccessKey(ctx).Return(&awsclient.AccessKey{AccessKeyID: newAuthConfig.AccessKeyID, SecretAccessKey: newAuthConfig.SecretAccessKey}, nil)
+		awsClientFactory.EXPECT().NewClient(newAuthConfig).Return(newAWSClient, nil)
+		newAWSClient.EXPECT().GetAccountID(gomock.Any()).Return("", errors.New("invalid access key")).AnyTimes()
+		oldAWSClient.EXPECT().DeleteAccessKey(ctx, newAuthConfig.AccessKeyID)
+
+		_, err := r.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("could not verify new access key")))
+		Expect(getSecret().Data).To(Equal(secret.Data))
+	})
+
+	It("should delete the new access key if it cannot be written to the secret", func() {
+		r.client = interceptor.NewClient(seedClient, interceptor.Funcs{
+			Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+				if _, ok := obj.(*corev1.Secret); ok {
+					return errors.New("conflict")
+				}
+				return c.Patch(ctx, obj, patch, opts...)
+			},
+		})
+
+		awsClientFactory.EXPECT().NewClient(oldAuthConfig).Return(oldAWSClient, nil)
+		oldAWSClient.EXPECT().GetAccountID(ctx).Return(accountID, nil)
+		oldAWSClient.EXPECT().CreateAccessKey(ctx).Return(&awsclient.AccessKey{AccessKeyID: newAuthConfig.AccessKeyID, SecretAccessKey: newAuthConfig.SecretAccessKey}, nil)
+		awsClientFactory.EXPECT().NewClient(newAuthConfig).Return(newAWSClient, nil)
+		newAWSClient.EXPECT().GetAccountID(gomock.Any()).Return(accountID, nil)
+		oldAWSClient.EXPECT().DeleteAccessKey(ctx, newAuthConfig.AccessKeyID)
+
+		_, err := r.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("could not write new access key")))
+		Expect(getSecret().Data).To(Equal(secret.Data))
+	})
+
+	It("should not rotate credentials which are used by multiple shoots", func() {
+		Expect(gardenClient.Create(ctx, &gardencorev1beta1.SecretBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-binding", Namespace: "garden-other"},
+			SecretRef:  corev1.SecretReference{Name: "aws", Namespace: "garden-foo"},
+		})).To(Succeed())
+		Expect(gardenClient.Create(ctx, &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "baz", Namespace: "garden-other"},
+			Spec:       gardencorev1beta1.ShootSpec{SecretBindingName: pointer.String("other-binding")},
+		})).To(Succeed())
+
+		_, err := r.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("is used by multiple shoots (garden-foo/bar, garden-other/baz)")))
+		Expect(errors.Is(err, reconcile.TerminalError(nil))).To(BeTrue())
+		Expect(getSecret().Data).To(Equal(secret.Data))
+	})
+
+	It("should not rotate credentials with a web identity token", func() {
+		secret.Data = map[string][]byte{
+			aws.RoleARN:          []byte("arn:aws:iam::123456789012:role/foo"),
+			aws.WebIdentityToken: []byte("token"),
+		}
+		Expect(seedClient.Update(ctx, secret)).To(Succeed())
+
+		_, err := r.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("cannot be rotated")))
+		Expect(errors.Is(err, reconcile.TerminalError(nil))).To(BeTrue())
+	})
+
+	Context("rolling out", func() {
+		BeforeEach(func() {
+			secret.Data = map[string][]byte{
+				aws.AccessKeyID:     []byte(newAuthConfig.AccessKeyID),
+				aws.SecretAccessKey: []byte(newAuthConfig.SecretAccessKey),
+			}
+			secret.Annotations[AnnotationKeyPhase] = PhaseRollingOut
+			secret.Annotations[AnnotationKeyPreviousAccessKeyID] = oldAuthConfig.AccessKeyID
+			secret.Annotations[AnnotationKeyPreparedAt] = "2023-10-01T12:00:00Z"
+			Expect(seedClient.Update(ctx, secret)).To(Succeed())
+		})
+
+		It("should wait for the reconciliation of the control plane", func() {
+			Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Second}))
+			Expect(getSecret().Annotations).To(HaveKeyWithValue(AnnotationKeyPhase, PhaseRollingOut))
+		})
+
+		Context("control plane reconciled", func() {
+			BeforeEach(func() {
+				cp.Status.LastOperation = &gardencorev1beta1.LastOperation{
+					State:          gardencorev1beta1.LastOperationStateSucceeded,
+					LastUpdateTime: metav1.NewTime(fakeClock.Now().Add(5 * time.Minute)),
+				}
+				Expect(seedClient.Status().Update(ctx, cp)).To(Succeed())
+			})
+
+			It("should wait for the grace period", func() {
+				fakeClock.Step(5 * time.Minute)
+
+				Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 15 * time.Minute}))
+				Expect(getSecret().Annotations).To(HaveKeyWithValue(AnnotationKeyPhase, PhaseRollingOut))
+			})
+
+			It("should delete the previous access key after the grace period", func() {
+				fakeClock.Step(20 * time.Minute)
+				awsClientFactory.EXPECT().NewClient(newAuthConfig).Return(newAWSClient, nil)
+				newAWSClient.EXPECT().DeleteAccessKey(ctx, oldAuthConfig.AccessKeyID)
+
+				Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+				Expect(getSecret().Annotations).To(BeEmpty())
+			})
+		})
+	})
+})
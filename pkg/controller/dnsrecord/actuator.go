@@ -17,11 +17,12 @@ package dnsrecord
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/dnsrecord"
-	"github.com/gardener/gardener/extensions/pkg/util"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	gardencorev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -29,11 +30,14 @@ import (
 	"github.com/gardener/gardener/pkg/controllerutils/reconciler"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
-	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/validation"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
 	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 )
@@ -47,6 +51,7 @@ const (
 
 type actuator struct {
 	client           client.Client
+	decoder          runtime.Decoder
 	awsClientFactory awsclient.Factory
 }
 
@@ -54,6 +59,7 @@ type actuator struct {
 func NewActuator(mgr manager.Manager, awsClientFactory awsclient.Factory) dnsrecord.Actuator {
 	return &actuator{
 		client:           mgr.GetClient(),
+		decoder:          serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
 		awsClientFactory: awsClientFactory,
 	}
 }
@@ -63,25 +69,48 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, dns *extensio
 	// Create AWS client
 	credentials, err := aws.GetCredentialsFromSecretRef(ctx, a.client, dns.Spec.SecretRef, true)
 	if err != nil {
-		return util.DetermineError(fmt.Errorf("could not get AWS credentials: %+v", err), helper.KnownCodes)
+		return aws.DetermineError(fmt.Errorf("could not get AWS credentials: %w", err))
 	}
-	awsClient, err := a.awsClientFactory.NewClient(string(credentials.AccessKeyID), string(credentials.SecretAccessKey), getRegion(dns, credentials))
+	awsClient, err := a.awsClientFactory.NewClient(aws.NewAuthConfig(credentials, getRegion(dns, credentials)))
 	if err != nil {
-		return util.DetermineError(fmt.Errorf("could not create AWS client: %+v", err), helper.KnownCodes)
+		return aws.DetermineError(fmt.Errorf("could not create AWS client: %w", err))
+	}
+
+	config, err := a.decodeDNSRecordConfig(dns)
+	if err != nil {
+		return err
+	}
+	status, err := a.decodeDNSRecordStatus(dns)
+	if err != nil {
+		return err
 	}
 
 	// Determine DNS hosted zone ID
-	zone, err := a.getZone(ctx, log, dns, awsClient)
+	zone, err := a.getZone(ctx, log, dns, config, awsClient)
+	if err != nil {
+		return err
+	}
+
+	// Create or update the managed health check
+	healthCheckID, obsoleteHealthCheckID, err := reconcileHealthCheck(ctx, log, dns, config.HealthCheck, status.HealthCheckID, awsClient)
 	if err != nil {
 		return err
 	}
 
 	stack := getIPStack(dns)
+	routingPolicy := getRoutingPolicy(config, healthCheckID)
+
+	// Associate VPCs with the private hosted zone
+	if config.PrivateHostedZone != nil {
+		if err := ensureVPCAssociations(ctx, log, dns, zone, config.PrivateHostedZone.VPCs, getRegion(dns, credentials), awsClient); err != nil {
+			return err
+		}
+	}
 
 	// Create or update DNS recordset
 	ttl := extensionsv1alpha1helper.GetDNSRecordTTL(dns.Spec.TTL)
-	log.Info("Creating or updating DNS recordset", "zone", zone, "name", dns.Spec.Name, "type", dns.Spec.RecordType, "values", dns.Spec.Values, "dnsrecord", kutil.ObjectName(dns))
-	if err := awsClient.CreateOrUpdateDNSRecordSet(ctx, zone, dns.Spec.Name, string(dns.Spec.RecordType), dns.Spec.Values, ttl, stack); err != nil {
+	log.Info("Creating or updating DNS recordset", "zone", zone, "name", dns.Spec.Name, "type", dns.Spec.RecordType, "values", dns.Spec.Values, "setIdentifier", getSetIdentifier(routingPolicy), "dnsrecord", kutil.ObjectName(dns))
+	if err := awsClient.CreateOrUpdateDNSRecordSet(ctx, zone, dns.Spec.Name, string(dns.Spec.RecordType), dns.Spec.Values, ttl, stack, routingPolicy); err != nil {
 		return wrapAWSClientError(err, fmt.Sprintf("could not create or update DNS recordset in zone %s with name %s, type %s, and values %v", zone, dns.Spec.Name, dns.Spec.RecordType, dns.Spec.Values))
 	}
 
@@ -89,14 +118,20 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, dns *extensio
 	if dns.Status.LastOperation == nil || dns.Status.LastOperation.Type == gardencorev1beta1.LastOperationTypeCreate {
 		name, recordType := dnsrecord.GetMetaRecordName(dns.Spec.Name), "TXT"
 		log.Info("Deleting meta DNS recordset", "zone", zone, "name", name, "type", recordType, "dnsrecord", kutil.ObjectName(dns))
-		if err := awsClient.DeleteDNSRecordSet(ctx, zone, name, recordType, nil, 0, stack); err != nil {
+		if err := awsClient.DeleteDNSRecordSet(ctx, zone, name, recordType, nil, 0, stack, nil); err != nil {
 			return wrapAWSClientError(err, fmt.Sprintf("could not delete meta DNS recordset in zone %s with name %s and type %s", zone, name, recordType))
 		}
 	}
 
+	// Delete the health check which is not referred to by the recordset anymore
+	if err := deleteHealthCheck(ctx, log, dns, obsoleteHealthCheckID, awsClient); err != nil {
+		return err
+	}
+
 	// Update resource status
 	patch := client.MergeFrom(dns.DeepCopy())
 	dns.Status.Zone = &zone
+	dns.Status.ProviderStatus = newProviderStatus(healthCheckID)
 	return a.client.Status().Patch(ctx, dns, patch)
 }
 
@@ -107,27 +142,38 @@ func (a *actuator) Delete(ctx context.Context, log logr.Logger, dns *extensionsv
 	if err != nil {
 		return fmt.Errorf("could not get AWS credentials: %+v", err)
 	}
-	awsClient, err := a.awsClientFactory.NewClient(string(credentials.AccessKeyID), string(credentials.SecretAccessKey), getRegion(dns, credentials))
+	awsClient, err := a.awsClientFactory.NewClient(aws.NewAuthConfig(credentials, getRegion(dns, credentials)))
+	if err != nil {
+		return aws.DetermineError(fmt.Errorf("could not create AWS client: %w", err))
+	}
+
+	config, err := a.decodeDNSRecordConfig(dns)
+	if err != nil {
+		return err
+	}
+	status, err := a.decodeDNSRecordStatus(dns)
 	if err != nil {
-		return util.DetermineError(fmt.Errorf("could not create AWS client: %+v", err), helper.KnownCodes)
+		return err
 	}
 
 	// Determine DNS hosted zone ID
-	zone, err := a.getZone(ctx, log, dns, awsClient)
+	zone, err := a.getZone(ctx, log, dns, config, awsClient)
 	if err != nil {
 		return err
 	}
 
 	stack := getIPStack(dns)
+	routingPolicy := getRoutingPolicy(config, status.HealthCheckID)
 
 	// Delete DNS recordset
 	ttl := extensionsv1alpha1helper.GetDNSRecordTTL(dns.Spec.TTL)
-	log.Info("Deleting DNS recordset", "zone", zone, "name", dns.Spec.Name, "type", dns.Spec.RecordType, "values", dns.Spec.Values, "dnsrecord", kutil.ObjectName(dns))
-	if err := awsClient.DeleteDNSRecordSet(ctx, zone, dns.Spec.Name, string(dns.Spec.RecordType), dns.Spec.Values, ttl, stack); err != nil {
+	log.Info("Deleting DNS recordset", "zone", zone, "name", dns.Spec.Name, "type", dns.Spec.RecordType, "values", dns.Spec.Values, "setIdentifier", getSetIdentifier(routingPolicy), "dnsrecord", kutil.ObjectName(dns))
+	if err := awsClient.DeleteDNSRecordSet(ctx, zone, dns.Spec.Name, string(dns.Spec.RecordType), dns.Spec.Values, ttl, stack, routingPolicy); err != nil {
 		return wrapAWSClientError(err, fmt.Sprintf("could not delete DNS recordset in zone %s with name %s, type %s, and values %v", zone, dns.Spec.Name, dns.Spec.RecordType, dns.Spec.Values))
 	}
 
-	return nil
+	// Delete the managed health check
+	return deleteHealthCheck(ctx, log, dns, status.HealthCheckID, awsClient)
 }
 
 // Delete forcefully deletes the DNSRecord.
@@ -145,8 +191,10 @@ func (a *actuator) Migrate(_ context.Context, _ logr.Logger, _ *extensionsv1alph
 	return nil
 }
 
-func (a *actuator) getZone(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, awsClient awsclient.Interface) (string, error) {
+func (a *actuator) getZone(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, config *awsapi.DNSRecordConfig, awsClient awsclient.Interface) (string, error) {
 	switch {
+	case config.PrivateHostedZone != nil && config.PrivateHostedZone.ID != nil:
+		return *config.PrivateHostedZone.ID, nil
 	case dns.Spec.Zone != nil && *dns.Spec.Zone != "":
 		return *dns.Spec.Zone, nil
 	case dns.Status.Zone != nil && *dns.Status.Zone != "":
@@ -154,7 +202,11 @@ func (a *actuator) getZone(ctx context.Context, log logr.Logger, dns *extensions
 	default:
 		// The zone is not specified in the resource status or spec. Try to determine the zone by
 		// getting all hosted zones of the account and searching for the longest zone name that is a suffix of dns.spec.Name
-		zones, err := awsClient.GetDNSHostedZones(ctx)
+		getZones := awsClient.GetDNSHostedZones
+		if config.PrivateHostedZone != nil {
+			getZones = awsClient.GetPrivateDNSHostedZones
+		}
+		zones, err := getZones(ctx)
 		if err != nil {
 			return "", wrapAWSClientError(err, "could not get DNS hosted zones")
 		}
@@ -167,6 +219,92 @@ func (a *actuator) getZone(ctx context.Context, log logr.Logger, dns *extensions
 	}
 }
 
+// decodeDNSRecordConfig decodes and validates the provider config of the given DNSRecord.
+func (a *actuator) decodeDNSRecordConfig(dns *extensionsv1alpha1.DNSRecord) (*awsapi.DNSRecordConfig, error) {
+	config := &awsapi.DNSRecordConfig{}
+	if dns.Spec.ProviderConfig == nil || dns.Spec.ProviderConfig.Raw == nil {
+		return config, nil
+	}
+
+	if _, _, err := a.decoder.Decode(dns.Spec.ProviderConfig.Raw, nil, config); err != nil {
+		return nil, gardencorev1beta1helper.NewErrorWithCodes(fmt.Errorf("could not decode provider config: %w", err), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+	if errs := validation.ValidateDNSRecordConfig(config, field.NewPath("spec", "providerConfig")); len(errs) > 0 {
+		return nil, gardencorev1beta1helper.NewErrorWithCodes(fmt.Errorf("invalid provider config: %w", errs.ToAggregate()), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+	return config, nil
+}
+
+// getRoutingPolicy returns the routing policy of the recordset of a DNSRecord with the given config and the given ID
+// of its managed health check. If neither a routing policy nor a health check is configured, nil is returned.
+func getRoutingPolicy(config *awsapi.DNSRecordConfig, managedHealthCheckID *string) *awsclient.RoutingPolicy {
+	healthCheckID := config.HealthCheckID
+	if managedHealthCheckID != nil {
+		healthCheckID = managedHealthCheckID
+	}
+	if config.RoutingPolicy == nil && healthCheckID == nil {
+		return nil
+	}
+
+	routingPolicy := &awsclient.RoutingPolicy{
+		HealthCheckID: healthCheckID,
+	}
+	if policy := config.RoutingPolicy; policy != nil {
+		routingPolicy.SetIdentifier = policy.SetIdentifier
+		switch {
+		case policy.Weighted != nil:
+			routingPolicy.Weight = &policy.Weighted.Weight
+		case policy.Latency != nil:
+			routingPolicy.Region = route53types.ResourceRecordSetRegion(policy.Latency.Region)
+		case policy.Failover != nil:
+			routingPolicy.Failover = route53types.ResourceRecordSetFailover(policy.Failover.Role)
+		case policy.Geolocation != nil:
+			routingPolicy.GeoLocation = &route53types.GeoLocation{
+				ContinentCode:   policy.Geolocation.ContinentCode,
+				CountryCode:     policy.Geolocation.CountryCode,
+				SubdivisionCode: policy.Geolocation.SubdivisionCode,
+			}
+		}
+	}
+	return routingPolicy
+}
+
+// ensureVPCAssociations associates the given VPCs with the private hosted zone with the given ID if they are not
+// associated yet. VPCs without region are located in the given default region.
+func ensureVPCAssociations(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, zone string, vpcs []awsapi.HostedZoneVPC, defaultRegion string, awsClient awsclient.Interface) error {
+	if len(vpcs) == 0 {
+		return nil
+	}
+
+	associated, err := awsClient.GetDNSHostedZoneVPCs(ctx, zone)
+	if err != nil {
+		return wrapAWSClientError(err, fmt.Sprintf("could not get VPCs of DNS hosted zone %s", zone))
+	}
+
+	for _, vpc := range vpcs {
+		region := defaultRegion
+		if vpc.Region != nil {
+			region = *vpc.Region
+		}
+		if slices.Contains(associated, awsclient.HostedZoneVPC{VPCID: vpc.ID, Region: region}) {
+			continue
+		}
+
+		log.Info("Associating VPC with DNS hosted zone", "zone", zone, "vpc", vpc.ID, "region", region, "dnsrecord", kutil.ObjectName(dns))
+		if err := awsClient.AssociateVPCWithDNSHostedZone(ctx, zone, vpc.ID, region); err != nil {
+			return wrapAWSClientError(err, fmt.Sprintf("could not associate VPC %s in region %s with DNS hosted zone %s", vpc.ID, region, zone))
+		}
+	}
+	return nil
+}
+
+func getSetIdentifier(routingPolicy *awsclient.RoutingPolicy) string {
+	if routingPolicy == nil {
+		return ""
+	}
+	return routingPolicy.SetIdentifier
+}
+
 func getRegion(dns *extensionsv1alpha1.DNSRecord, credentials *aws.Credentials) string {
 	switch {
 	case dns.Spec.Region != nil && *dns.Spec.Region != "":
@@ -179,9 +317,11 @@ func getRegion(dns *extensionsv1alpha1.DNSRecord, credentials *aws.Credentials)
 }
 
 func wrapAWSClientError(err error, message string) error {
-	wrappedErr := fmt.Errorf("%s: %+v", message, err)
-	if awsclient.IsNoSuchHostedZoneError(err) || awsclient.IsNotPermittedInZoneError(err) {
+	wrappedErr := fmt.Errorf("%s: %w", message, err)
+	if awsclient.IsNotPermittedInZoneError(err) {
 		wrappedErr = gardencorev1beta1helper.NewErrorWithCodes(wrappedErr, gardencorev1beta1.ErrorConfigurationProblem)
+	} else {
+		wrappedErr = aws.DetermineError(wrappedErr)
 	}
 	if _, ok := err.(*awsclient.Route53RateLimiterWaitError); ok || awsclient.IsThrottlingError(err) {
 		wrappedErr = &reconciler.RequeueAfterError{
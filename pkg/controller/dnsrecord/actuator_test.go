@@ -18,8 +18,7 @@ import (
 	"context"
 	"errors"
 
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
 	"github.com/gardener/gardener/extensions/pkg/controller/dnsrecord"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	gardencorev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
@@ -30,13 +29,17 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
+	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	apisawsv1alpha1 "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
 	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 	mockawsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/mock"
@@ -79,6 +82,11 @@ var _ = Describe("Actuator", func() {
 
 		mgr.EXPECT().GetClient().Return(c)
 
+		scheme := runtime.NewScheme()
+		Expect(apisaws.AddToScheme(scheme)).To(Succeed())
+		Expect(apisawsv1alpha1.AddToScheme(scheme)).To(Succeed())
+		mgr.EXPECT().GetScheme().Return(scheme)
+
 		sw = mockclient.NewMockStatusWriter(ctrl)
 		awsClientFactory = mockawsclient.NewMockFactory(ctrl)
 		awsClient = mockawsclient.NewMockInterface(ctrl)
@@ -140,13 +148,13 @@ var _ = Describe("Actuator", func() {
 					return nil
 				},
 			)
-			awsClientFactory.EXPECT().NewClient(accessKeyID, secretAccessKey, aws.DefaultDNSRegion).Return(awsClient, nil)
+			awsClientFactory.EXPECT().NewClient(awsclient.AuthConfig{AccessKeyID: accessKeyID, SecretAccessKey: secretAccessKey, Region: aws.DefaultDNSRegion}).Return(awsClient, nil)
 		})
 
 		It("should reconcile the DNSRecord", func() {
 			awsClient.EXPECT().GetDNSHostedZones(ctx).Return(zones, nil)
-			awsClient.EXPECT().CreateOrUpdateDNSRecordSet(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120), awsclient.IPStackIPv4).Return(nil)
-			awsClient.EXPECT().DeleteDNSRecordSet(ctx, zone, "comment-"+domainName, "TXT", nil, int64(0), awsclient.IPStackIPv4).Return(nil)
+			awsClient.EXPECT().CreateOrUpdateDNSRecordSet(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120), awsclient.IPStackIPv4, nil).Return(nil)
+			awsClient.EXPECT().DeleteDNSRecordSet(ctx, zone, "comment-"+domainName, "TXT", nil, int64(0), awsclient.IPStackIPv4, nil).Return(nil)
 			sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).DoAndReturn(
 				func(_ context.Context, obj *extensionsv1alpha1.DNSRecord, _ client.Patch, opts ...client.PatchOption) error {
 					Expect(obj.Status).To(Equal(extensionsv1alpha1.DNSRecordStatus{
@@ -160,10 +168,197 @@ var _ = Describe("Actuator", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("should reconcile the DNSRecord with a routing policy and health check", func() {
+			dns.Spec.Zone = pointer.String(zone)
+			dns.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "aws.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "DNSRecordConfig",
+"routingPolicy": {
+  "setIdentifier": "eu-west-1",
+  "failover": {
+    "role": "PRIMARY"
+  }
+},
+"healthCheckID": "health-check"
+}`)}
+			routingPolicy := &awsclient.RoutingPolicy{
+				SetIdentifier: "eu-west-1",
+				Failover:      route53types.ResourceRecordSetFailoverPrimary,
+				HealthCheckID: pointer.String("health-check"),
+			}
+
+			awsClient.EXPECT().CreateOrUpdateDNSRecordSet(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120), awsclient.IPStackIPv4, routingPolicy).Return(nil)
+			awsClient.EXPECT().DeleteDNSRecordSet(ctx, zone, "comment-"+domainName, "TXT", nil, int64(0), awsclient.IPStackIPv4, nil).Return(nil)
+			sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).Return(nil)
+
+			Expect(a.Reconcile(ctx, logger, dns, nil)).To(Succeed())
+		})
+
+		Context("managed health check", func() {
+			var healthCheck *awsclient.HealthCheck
+
+			BeforeEach(func() {
+				dns.UID = "uid"
+				dns.Generation = 2
+				dns.Spec.Zone = pointer.String(zone)
+				dns.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "aws.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "DNSRecordConfig",
+"healthCheck": {
+  "type": "HTTPS",
+  "ipAddress": "1.2.3.4",
+  "resourcePath": "/healthz"
+}
+}`)}
+				healthCheck = &awsclient.HealthCheck{
+					Type:             route53types.HealthCheckTypeHttps,
+					IPAddress:        pointer.String(address),
+					Port:             pointer.Int32(443),
+					ResourcePath:     pointer.String("/healthz"),
+					RequestInterval:  pointer.Int32(30),
+					FailureThreshold: pointer.Int32(3),
+				}
+				awsClient.EXPECT().DeleteDNSRecordSet(ctx, zone, "comment-"+domainName, "TXT", nil, int64(0), awsclient.IPStackIPv4, nil).Return(nil)
+			})
+
+			expectStatusPatch := func(healthCheckID string) {
+				sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).DoAndReturn(
+					func(_ context.Context, obj *extensionsv1alpha1.DNSRecord, _ client.Patch, opts ...client.PatchOption) error {
+						Expect(obj.Status.ProviderStatus.Object).To(Equal(&apisawsv1alpha1.DNSRecordStatus{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: apisawsv1alpha1.SchemeGroupVersion.String(),
+								Kind:       "DNSRecordStatus",
+							},
+							HealthCheckID: pointer.String(healthCheckID),
+						}))
+						return nil
+					},
+				)
+			}
+
+			It("should create the health check and associate it with the recordset", func() {
+				awsClient.EXPECT().CreateHealthCheck(ctx, "uid-2", healthCheck).Return("health-check", nil)
+				awsClient.EXPECT().CreateOrUpdateDNSRecordSet(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120), awsclient.IPStackIPv4, &awsclient.RoutingPolicy{HealthCheckID: pointer.String("health-check")}).Return(nil)
+				expectStatusPatch("health-check")
+
+				Expect(a.Reconcile(ctx, logger, dns, nil)).To(Succeed())
+			})
+
+			It("should update the existing health check", func() {
+				dns.Status.ProviderStatus = &runtime.RawExtension{Raw: []byte(`{"apiVersion": "aws.provider.extensions.gardener.cloud/v1alpha1", "kind": "DNSRecordStatus", "healthCheckID": "health-check"}`)}
+				existing := *healthCheck
+				existing.ResourcePath = pointer.String("/")
+
+				awsClient.EXPECT().GetHealthCheck(ctx, "health-check").Return(&existing, nil)
+				awsClient.EXPECT().UpdateHealthCheck(ctx, "health-check", healthCheck).Return(nil)
+				awsClient.EXPECT().CreateOrUpdateDNSRecordSet(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120), awsclient.IPStackIPv4, &awsclient.RoutingPolicy{HealthCheckID: pointer.String("health-check")}).Return(nil)
+				expectStatusPatch("health-check")
+
+				Expect(a.Reconcile(ctx, logger, dns, nil)).To(Succeed())
+			})
+
+			It("should replace the existing health check if an immutable field changed", func() {
+				dns.Status.ProviderStatus = &runtime.RawExtension{Raw: []byte(`{"apiVersion": "aws.provider.extensions.gardener.cloud/v1alpha1", "kind": "DNSRecordStatus", "healthCheckID": "old-health-check"}`)}
+				existing := *healthCheck
+				existing.RequestInterval = pointer.Int32(10)
+
+				gomock.InOrder(
+					awsClient.EXPECT().GetHealthCheck(ctx, "old-health-check").Return(&existing, nil),
+					awsClient.EXPECT().CreateHealthCheck(ctx, "uid-2", healthCheck).Return("health-check", nil),
+					awsClient.EXPECT().CreateOrUpdateDNSRecordSet(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120), awsclient.IPStackIPv4, &awsclient.RoutingPolicy{HealthCheckID: pointer.String("health-check")}).Return(nil),
+					awsClient.EXPECT().DeleteHealthCheck(ctx, "old-health-check").Return(nil),
+				)
+				expectStatusPatch("health-check")
+
+				Expect(a.Reconcile(ctx, logger, dns, nil)).To(Succeed())
+			})
+		})
+
+		It("should delete the managed health check if it is not configured anymore", func() {
+			dns.Spec.Zone = pointer.String(zone)
+			dns.Status.ProviderStatus = &runtime.RawExtension{Raw: []byte(`{"apiVersion": "aws.provider.extensions.gardener.cloud/v1alpha1", "kind": "DNSRecordStatus", "healthCheckID": "health-check"}`)}
+
+			gomock.InOrder(
+				awsClient.EXPECT().CreateOrUpdateDNSRecordSet(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120), awsclient.IPStackIPv4, nil).Return(nil),
+				awsClient.EXPECT().DeleteHealthCheck(ctx, "health-check").Return(nil),
+			)
+			awsClient.EXPECT().DeleteDNSRecordSet(ctx, zone, "comment-"+domainName, "TXT", nil, int64(0), awsclient.IPStackIPv4, nil).Return(nil)
+			sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).DoAndReturn(
+				func(_ context.Context, obj *extensionsv1alpha1.DNSRecord, _ client.Patch, opts ...client.PatchOption) error {
+					Expect(obj.Status.ProviderStatus).To(BeNil())
+					return nil
+				},
+			)
+
+			Expect(a.Reconcile(ctx, logger, dns, nil)).To(Succeed())
+		})
+
+		It("should reconcile the DNSRecord in the private hosted zone and associate missing VPCs", func() {
+			dns.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "aws.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "DNSRecordConfig",
+"privateHostedZone": {
+  "id": "private-zone",
+  "vpcs": [
+    {"id": "vpc-1"},
+    {"id": "vpc-2", "region": "eu-central-1"}
+  ]
+}
+}`)}
+
+			awsClient.EXPECT().GetDNSHostedZoneVPCs(ctx, "private-zone").Return([]awsclient.HostedZoneVPC{{VPCID: "vpc-1", Region: aws.DefaultDNSRegion}}, nil)
+			awsClient.EXPECT().AssociateVPCWithDNSHostedZone(ctx, "private-zone", "vpc-2", "eu-central-1").Return(nil)
+			awsClient.EXPECT().CreateOrUpdateDNSRecordSet(ctx, "private-zone", domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120), awsclient.IPStackIPv4, nil).Return(nil)
+			awsClient.EXPECT().DeleteDNSRecordSet(ctx, "private-zone", "comment-"+domainName, "TXT", nil, int64(0), awsclient.IPStackIPv4, nil).Return(nil)
+			sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).DoAndReturn(
+				func(_ context.Context, obj *extensionsv1alpha1.DNSRecord, _ client.Patch, opts ...client.PatchOption) error {
+					Expect(obj.Status.Zone).To(PointTo(Equal("private-zone")))
+					return nil
+				},
+			)
+
+			Expect(a.Reconcile(ctx, logger, dns, nil)).To(Succeed())
+		})
+
+		It("should determine the private hosted zone if no ID is configured", func() {
+			dns.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "aws.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "DNSRecordConfig",
+"privateHostedZone": {}
+}`)}
+
+			awsClient.EXPECT().GetPrivateDNSHostedZones(ctx).Return(map[string]string{shootDomain: "private-zone"}, nil)
+			awsClient.EXPECT().CreateOrUpdateDNSRecordSet(ctx, "private-zone", domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120), awsclient.IPStackIPv4, nil).Return(nil)
+			awsClient.EXPECT().DeleteDNSRecordSet(ctx, "private-zone", "comment-"+domainName, "TXT", nil, int64(0), awsclient.IPStackIPv4, nil).Return(nil)
+			sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).Return(nil)
+
+			Expect(a.Reconcile(ctx, logger, dns, nil)).To(Succeed())
+		})
+
+		It("should fail with ERR_CONFIGURATION_PROBLEM if the routing policy is invalid", func() {
+			dns.Spec.Zone = pointer.String(zone)
+			dns.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "aws.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "DNSRecordConfig",
+"routingPolicy": {
+  "setIdentifier": "eu-west-1",
+  "weighted": {
+    "weight": 1000
+  }
+}
+}`)}
+
+			err := a.Reconcile(ctx, logger, dns, nil)
+			Expect(err).To(HaveOccurred())
+			coder, ok := err.(gardencorev1beta1helper.Coder)
+			Expect(ok).To(BeTrue())
+			Expect(coder.Codes()).To(Equal([]gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorConfigurationProblem}))
+		})
+
 		It("should fail if creating the DNS record set failed", func() {
 			dns.Spec.Zone = pointer.String(zone)
 
-			awsClient.EXPECT().CreateOrUpdateDNSRecordSet(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120), awsclient.IPStackIPv4).
+			awsClient.EXPECT().CreateOrUpdateDNSRecordSet(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120), awsclient.IPStackIPv4, nil).
 				Return(errors.New("test"))
 
 			err := a.Reconcile(ctx, logger, dns, nil)
@@ -175,8 +370,8 @@ var _ = Describe("Actuator", func() {
 		It("should fail with ERR_CONFIGURATION_PROBLEM if there is no such hosted zone", func() {
 			dns.Spec.Zone = pointer.String(zone)
 
-			awsClient.EXPECT().CreateOrUpdateDNSRecordSet(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120), awsclient.IPStackIPv4).
-				Return(awserr.New(route53.ErrCodeNoSuchHostedZone, "", nil))
+			awsClient.EXPECT().CreateOrUpdateDNSRecordSet(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120), awsclient.IPStackIPv4, nil).
+				Return(&route53types.NoSuchHostedZone{})
 
 			err := a.Reconcile(ctx, logger, dns, nil)
 			Expect(err).To(HaveOccurred())
@@ -188,8 +383,8 @@ var _ = Describe("Actuator", func() {
 		It("should fail with ERR_CONFIGURATION_PROBLEM if the domain name is not permitted in the zone", func() {
 			dns.Spec.Zone = pointer.String(zone)
 
-			awsClient.EXPECT().CreateOrUpdateDNSRecordSet(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120), awsclient.IPStackIPv4).
-				Return(awserr.New(route53.ErrCodeInvalidChangeBatch, "RRSet with DNS name api.aws.foobar.shoot.example.com. is not permitted in zone foo.com.", nil))
+			awsClient.EXPECT().CreateOrUpdateDNSRecordSet(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120), awsclient.IPStackIPv4, nil).
+				Return(&route53types.InvalidChangeBatch{Message: pointer.String("RRSet with DNS name api.aws.foobar.shoot.example.com. is not permitted in zone foo.com.")})
 
 			err := a.Reconcile(ctx, logger, dns, nil)
 			Expect(err).To(HaveOccurred())
@@ -200,7 +395,7 @@ var _ = Describe("Actuator", func() {
 	})
 
 	Describe("#Delete", func() {
-		It("should delete the DNSRecord", func() {
+		BeforeEach(func() {
 			dns.Status.Zone = pointer.String(zone)
 
 			c.EXPECT().Get(ctx, kutil.Key(namespace, name), gomock.AssignableToTypeOf(&corev1.Secret{})).DoAndReturn(
@@ -209,11 +404,25 @@ var _ = Describe("Actuator", func() {
 					return nil
 				},
 			)
-			awsClientFactory.EXPECT().NewClient(accessKeyID, secretAccessKey, aws.DefaultDNSRegion).Return(awsClient, nil)
-			awsClient.EXPECT().DeleteDNSRecordSet(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120), awsclient.IPStackIPv4).Return(nil)
+			awsClientFactory.EXPECT().NewClient(awsclient.AuthConfig{AccessKeyID: accessKeyID, SecretAccessKey: secretAccessKey, Region: aws.DefaultDNSRegion}).Return(awsClient, nil)
+		})
+
+		It("should delete the DNSRecord", func() {
+			awsClient.EXPECT().DeleteDNSRecordSet(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120), awsclient.IPStackIPv4, nil).Return(nil)
 
 			err := a.Delete(ctx, logger, dns, nil)
 			Expect(err).NotTo(HaveOccurred())
 		})
+
+		It("should delete the DNSRecord and its managed health check", func() {
+			dns.Status.ProviderStatus = &runtime.RawExtension{Raw: []byte(`{"apiVersion": "aws.provider.extensions.gardener.cloud/v1alpha1", "kind": "DNSRecordStatus", "healthCheckID": "health-check"}`)}
+
+			gomock.InOrder(
+				awsClient.EXPECT().DeleteDNSRecordSet(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120), awsclient.IPStackIPv4, &awsclient.RoutingPolicy{HealthCheckID: pointer.String("health-check")}).Return(nil),
+				awsClient.EXPECT().DeleteHealthCheck(ctx, "health-check").Return(nil),
+			)
+
+			Expect(a.Delete(ctx, logger, dns, nil)).To(Succeed())
+		})
 	})
 })
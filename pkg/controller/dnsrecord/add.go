@@ -40,6 +40,13 @@ type RateLimiterOptions struct {
 	Burst int
 	// WaitTimeout is the timeout for rate limiter waits.
 	WaitTimeout time.Duration
+	// ZoneLimit is the rate limit for changes of a single hosted zone. If zero, changes are not limited per hosted zone.
+	ZoneLimit rate.Limit
+	// ZoneBurst is the rate limiter burst for changes of a single hosted zone.
+	ZoneBurst int
+	// BatchWindow is the duration for which changes of the same hosted zone are collected to be sent in one request.
+	// If zero, changes are not batched.
+	BatchWindow time.Duration
 }
 
 // AddOptions are options to apply when adding the AWS dnsrecord controller to the manager.
@@ -56,7 +63,7 @@ type AddOptions struct {
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddOptions) error {
 	return dnsrecord.Add(ctx, mgr, dnsrecord.AddArgs{
-		Actuator:          NewActuator(mgr, awsclient.NewRoute53Factory(opts.RateLimiter.Limit, opts.RateLimiter.Burst, opts.RateLimiter.WaitTimeout)),
+		Actuator:          NewActuator(mgr, awsclient.NewControllerFactory(awsclient.NewRoute53Factory(opts.RateLimiter.Limit, opts.RateLimiter.Burst, opts.RateLimiter.WaitTimeout, opts.RateLimiter.ZoneLimit, opts.RateLimiter.ZoneBurst, opts.RateLimiter.BatchWindow), dnsrecord.ControllerName)),
 		ControllerOptions: opts.Controller,
 		Predicates:        dnsrecord.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation),
 		Type:              aws.DNSType,
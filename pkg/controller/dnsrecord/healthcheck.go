@@ -0,0 +1,165 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnsrecord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencorev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	awsv1alpha1 "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+const (
+	defaultHealthCheckRequestInterval  int32 = 30
+	defaultHealthCheckFailureThreshold int32 = 3
+)
+
+// reconcileHealthCheck creates or updates the health check with the given config and returns its ID. The health check
+// of the DNSRecord status is returned as obsolete if it is not configured anymore or has to be replaced because of an
+// immutable change. Obsolete health checks must only be deleted when the recordset does not refer to them anymore.
+func reconcileHealthCheck(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, config *awsapi.HealthCheck, currentID *string, awsClient awsclient.Interface) (*string, *string, error) {
+	if config == nil {
+		return nil, currentID, nil
+	}
+	desired := newHealthCheck(config)
+
+	if currentID != nil {
+		current, err := awsClient.GetHealthCheck(ctx, *currentID)
+		if err != nil {
+			return nil, nil, wrapAWSClientError(err, fmt.Sprintf("could not get health check %s", *currentID))
+		}
+		switch {
+		case current == nil:
+			// the health check was deleted, hence a new one is created
+			currentID = nil
+		case requiresHealthCheckReplacement(current, desired):
+			log.Info("Replacing health check", "healthCheck", *currentID, "dnsrecord", kutil.ObjectName(dns))
+		case reflect.DeepEqual(current, desired):
+			return currentID, nil, nil
+		default:
+			log.Info("Updating health check", "healthCheck", *currentID, "dnsrecord", kutil.ObjectName(dns))
+			if err := awsClient.UpdateHealthCheck(ctx, *currentID, desired); err != nil {
+				return nil, nil, wrapAWSClientError(err, fmt.Sprintf("could not update health check %s", *currentID))
+			}
+			return currentID, nil, nil
+		}
+	}
+
+	// The caller reference makes the creation idempotent, so that no additional health check is created if the status
+	// could not be updated after the health check was created. It changes with the generation, as a caller reference
+	// cannot be reused for health checks with different settings.
+	callerReference := fmt.Sprintf("%s-%d", dns.UID, dns.Generation)
+	log.Info("Creating health check", "callerReference", callerReference, "dnsrecord", kutil.ObjectName(dns))
+	id, err := awsClient.CreateHealthCheck(ctx, callerReference, desired)
+	var alreadyExists *route53types.HealthCheckAlreadyExists
+	if errors.As(err, &alreadyExists) {
+		// the caller reference has already been used for a health check which was deleted in the meantime
+		id, err = awsClient.CreateHealthCheck(ctx, fmt.Sprintf("%s-%d", callerReference, time.Now().Unix()), desired)
+	}
+	if err != nil {
+		return nil, nil, wrapAWSClientError(err, "could not create health check")
+	}
+	return &id, currentID, nil
+}
+
+// deleteHealthCheck deletes the health check with the given ID if it is set.
+func deleteHealthCheck(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, id *string, awsClient awsclient.Interface) error {
+	if id == nil {
+		return nil
+	}
+	log.Info("Deleting health check", "healthCheck", *id, "dnsrecord", kutil.ObjectName(dns))
+	if err := awsClient.DeleteHealthCheck(ctx, *id); err != nil {
+		return wrapAWSClientError(err, fmt.Sprintf("could not delete health check %s", *id))
+	}
+	return nil
+}
+
+func newHealthCheck(config *awsapi.HealthCheck) *awsclient.HealthCheck {
+	healthCheck := &awsclient.HealthCheck{
+		Type:                     route53types.HealthCheckType(config.Type),
+		IPAddress:                config.IPAddress,
+		FullyQualifiedDomainName: config.FullyQualifiedDomainName,
+		Port:                     config.Port,
+		ResourcePath:             config.ResourcePath,
+		RequestInterval:          config.RequestInterval,
+		FailureThreshold:         config.FailureThreshold,
+	}
+	// Set the defaults of Route53, so that the health check can be compared with the existing one
+	if healthCheck.Port == nil {
+		switch config.Type {
+		case awsapi.HealthCheckTypeHTTP:
+			healthCheck.Port = pointer.Int32(80)
+		case awsapi.HealthCheckTypeHTTPS:
+			healthCheck.Port = pointer.Int32(443)
+		}
+	}
+	if healthCheck.RequestInterval == nil {
+		healthCheck.RequestInterval = pointer.Int32(defaultHealthCheckRequestInterval)
+	}
+	if healthCheck.FailureThreshold == nil {
+		healthCheck.FailureThreshold = pointer.Int32(defaultHealthCheckFailureThreshold)
+	}
+	return healthCheck
+}
+
+// requiresHealthCheckReplacement returns true if the given health check cannot be updated to the desired one.
+func requiresHealthCheckReplacement(current, desired *awsclient.HealthCheck) bool {
+	return current.Type != desired.Type ||
+		!reflect.DeepEqual(current.RequestInterval, desired.RequestInterval) ||
+		(current.IPAddress == nil) != (desired.IPAddress == nil)
+}
+
+// decodeDNSRecordStatus decodes the provider status of the given DNSRecord.
+func (a *actuator) decodeDNSRecordStatus(dns *extensionsv1alpha1.DNSRecord) (*awsapi.DNSRecordStatus, error) {
+	status := &awsapi.DNSRecordStatus{}
+	if dns.Status.ProviderStatus == nil || dns.Status.ProviderStatus.Raw == nil {
+		return status, nil
+	}
+
+	if _, _, err := a.decoder.Decode(dns.Status.ProviderStatus.Raw, nil, status); err != nil {
+		return nil, gardencorev1beta1helper.NewErrorWithCodes(fmt.Errorf("could not decode provider status: %w", err), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+	return status, nil
+}
+
+// newProviderStatus returns the provider status of a DNSRecord with the health check with the given ID, or nil if no
+// health check is managed for the DNSRecord.
+func newProviderStatus(healthCheckID *string) *runtime.RawExtension {
+	if healthCheckID == nil {
+		return nil
+	}
+	return &runtime.RawExtension{Object: &awsv1alpha1.DNSRecordStatus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: awsv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "DNSRecordStatus",
+		},
+		HealthCheckID: healthCheckID,
+	}}
+}
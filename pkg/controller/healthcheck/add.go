@@ -23,37 +23,50 @@ import (
 	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck/general"
 	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck/worker"
 	extensionspredicate "github.com/gardener/gardener/extensions/pkg/predicate"
-	"github.com/gardener/gardener/extensions/pkg/util"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
-	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 )
 
 var (
 	defaultSyncPeriod = time.Second * 30
-	// DefaultAddOptions are the default DefaultAddArgs for AddToManager.
-	DefaultAddOptions = healthcheck.DefaultAddArgs{
-		HealthCheckConfig: healthcheckconfig.HealthCheckConfig{
-			SyncPeriod: metav1.Duration{Duration: defaultSyncPeriod},
-			ShootRESTOptions: &healthcheckconfig.RESTOptions{
-				QPS:   pointer.Float32(100),
-				Burst: pointer.Int(130),
+	// DefaultAddOptions are the default AddOptions for AddToManager.
+	DefaultAddOptions = AddOptions{
+		DefaultAddArgs: healthcheck.DefaultAddArgs{
+			HealthCheckConfig: healthcheckconfig.HealthCheckConfig{
+				SyncPeriod: metav1.Duration{Duration: defaultSyncPeriod},
+				ShootRESTOptions: &healthcheckconfig.RESTOptions{
+					QPS:   pointer.Float32(100),
+					Burst: pointer.Int(130),
+				},
 			},
 		},
 	}
 )
 
+// AddOptions are options to apply when adding the health check controllers to the manager.
+type AddOptions struct {
+	healthcheck.DefaultAddArgs
+	// PrivateLink is the configuration of the exposure of kube-apiservers as VPC endpoint services, whose load balancers
+	// are checked with the credentials of the seed.
+	PrivateLink *config.PrivateLinkConfig
+}
+
 // RegisterHealthChecks registers health checks for each extension resource
 // HealthChecks are grouped by extension (e.g worker), extension.type (e.g aws) and  Health Check Type (e.g SystemComponentsHealthy)
-func RegisterHealthChecks(ctx context.Context, mgr manager.Manager, opts healthcheck.DefaultAddArgs) error {
+func RegisterHealthChecks(ctx context.Context, mgr manager.Manager, opts AddOptions) error {
+	awsClientFactory := awsclient.NewControllerFactory(awsclient.FactoryFunc(awsclient.NewInterface), healthcheck.ControllerName)
+
 	if err := healthcheck.DefaultRegistration(
 		ctx,
 		aws.Type,
@@ -61,7 +74,7 @@ func RegisterHealthChecks(ctx context.Context, mgr manager.Manager, opts healthc
 		func() client.ObjectList { return &extensionsv1alpha1.ControlPlaneList{} },
 		func() extensionsv1alpha1.Object { return &extensionsv1alpha1.ControlPlane{} },
 		mgr,
-		opts,
+		opts.DefaultAddArgs,
 		[]predicate.Predicate{extensionspredicate.HasPurpose(extensionsv1alpha1.Normal)},
 		[]healthcheck.ConditionTypeToHealthCheck{
 			{
@@ -85,12 +98,34 @@ func RegisterHealthChecks(ctx context.Context, mgr manager.Manager, opts healthc
 				HealthCheck:   newCustomRouteControllerHealthCheck(general.NewSeedDeploymentHealthChecker(aws.AWSCustomRouteControllerName)),
 				// no precheck needed, as the deployment is always created (with replicas=0 if not enabled, see valuesprovider.go)
 			},
+			{
+				ConditionType: string(gardencorev1beta1.ShootControlPlaneHealthy),
+				HealthCheck:   newPrivateLinkHealthCheck(awsClientFactory, newProbeCache(clock.RealClock{}), opts.PrivateLink),
+			},
 		},
 		sets.New[gardencorev1beta1.ConditionType](),
 	); err != nil {
 		return err
 	}
 
+	if err := healthcheck.DefaultRegistration(
+		ctx,
+		aws.Type,
+		extensionsv1alpha1.SchemeGroupVersion.WithKind(extensionsv1alpha1.InfrastructureResource),
+		func() client.ObjectList { return &extensionsv1alpha1.InfrastructureList{} },
+		func() extensionsv1alpha1.Object { return &extensionsv1alpha1.Infrastructure{} },
+		mgr,
+		opts.DefaultAddArgs,
+		nil,
+		[]healthcheck.ConditionTypeToHealthCheck{{
+			ConditionType: string(gardencorev1beta1.ShootSystemComponentsHealthy),
+			HealthCheck:   newInfrastructureHealthCheck(awsClientFactory, newProbeCache(clock.RealClock{})),
+		}},
+		sets.New[gardencorev1beta1.ConditionType](),
+	); err != nil {
+		return err
+	}
+
 	return healthcheck.DefaultRegistration(
 		ctx,
 		aws.Type,
@@ -98,13 +133,13 @@ func RegisterHealthChecks(ctx context.Context, mgr manager.Manager, opts healthc
 		func() client.ObjectList { return &extensionsv1alpha1.WorkerList{} },
 		func() extensionsv1alpha1.Object { return &extensionsv1alpha1.Worker{} },
 		mgr,
-		opts,
+		opts.DefaultAddArgs,
 		nil,
 		[]healthcheck.ConditionTypeToHealthCheck{{
 			ConditionType: string(gardencorev1beta1.ShootEveryNodeReady),
 			HealthCheck:   worker.NewNodesChecker(),
 			ErrorCodeCheckFunc: func(err error) []gardencorev1beta1.ErrorCode {
-				return util.DetermineErrorCodes(err, helper.KnownCodes)
+				return aws.DetermineErrorCodes(err)
 			},
 		}},
 		sets.New(gardencorev1beta1.ShootControlPlaneHealthy),
@@ -0,0 +1,171 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	awsprovider "github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// infrastructureProgressingThreshold is the duration after which NAT gateways which are still pending and elastic IPs
+// which are still unassociated are reported as unhealthy. Both are expected while the infrastructure is reconciled.
+const infrastructureProgressingThreshold = 10 * time.Minute
+
+// infrastructureHealthCheck verifies the AWS resources of the infrastructure of a shoot: the NAT gateways must be
+// available, the elastic IPs must be associated and the route tables must not contain blackhole routes, i.e. routes
+// whose target has been deleted.
+type infrastructureHealthCheck struct {
+	seedClient       client.Client
+	awsClientFactory awsclient.Factory
+	cache            *probeCache
+	logger           logr.Logger
+}
+
+var _ healthcheck.HealthCheck = &infrastructureHealthCheck{}
+
+func newInfrastructureHealthCheck(awsClientFactory awsclient.Factory, cache *probeCache) *infrastructureHealthCheck {
+	return &infrastructureHealthCheck{awsClientFactory: awsClientFactory, cache: cache}
+}
+
+func (hc *infrastructureHealthCheck) Check(ctx context.Context, request types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	return hc.cache.probe(ctx, request, hc.check)
+}
+
+func (hc *infrastructureHealthCheck) check(ctx context.Context, request types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	infra := &extensionsv1alpha1.Infrastructure{}
+	if err := hc.seedClient.Get(ctx, request, infra); err != nil {
+		err := fmt.Errorf("failed to retrieve infrastructure %s: %w", request, err)
+		hc.logger.Error(err, "Health check failed")
+		return nil, err
+	}
+
+	credentials, err := awsprovider.GetCredentialsFromSecretRef(ctx, hc.seedClient, infra.Spec.SecretRef, false)
+	if err != nil {
+		err := fmt.Errorf("failed to get AWS credentials of infrastructure %s: %w", request, err)
+		hc.logger.Error(err, "Health check failed")
+		return nil, err
+	}
+	authConfig := awsprovider.NewAuthConfig(credentials, infra.Spec.Region)
+	authConfig.Shoot = infra.Namespace
+	awsClient, err := hc.awsClientFactory.NewClient(authConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		tags        = awsclient.Tags{fmt.Sprintf("kubernetes.io/cluster/%s", infra.Namespace): "1"}
+		problems    []string
+		progressing []string
+	)
+
+	gateways, err := awsClient.FindNATGatewaysByTags(ctx, tags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get NAT gateways: %w", err)
+	}
+	for _, gateway := range gateways {
+		switch ec2types.NatGatewayState(gateway.State) {
+		case ec2types.NatGatewayStateAvailable, ec2types.NatGatewayStateDeleting:
+		case ec2types.NatGatewayStatePending:
+			progressing = append(progressing, fmt.Sprintf("NAT gateway %s is pending", gateway.NATGatewayId))
+		default:
+			problems = append(problems, fmt.Sprintf("NAT gateway %s is in state %s", gateway.NATGatewayId, gateway.State))
+		}
+	}
+
+	addresses, err := awsClient.FindElasticIPsByTags(ctx, tags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get elastic IPs: %w", err)
+	}
+	for _, address := range addresses {
+		if address.AssociationId == nil {
+			progressing = append(progressing, fmt.Sprintf("elastic IP %s (%s) is not associated", address.PublicIp, address.AllocationId))
+		}
+	}
+
+	blackholeRoutes, err := awsClient.FindBlackholeRoutesByTags(ctx, tags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blackhole routes: %w", err)
+	}
+	for routeTableID, routes := range blackholeRoutes {
+		for _, route := range routes {
+			destination := aws.ToString(route.DestinationCidrBlock)
+			if route.DestinationIpv6CidrBlock != nil {
+				destination = *route.DestinationIpv6CidrBlock
+			} else if route.DestinationPrefixListId != nil {
+				destination = *route.DestinationPrefixListId
+			}
+			problems = append(problems, fmt.Sprintf("route to %s of route table %s is a blackhole", destination, routeTableID))
+		}
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		detail := "unhealthy AWS resources: " + strings.Join(problems, ", ")
+		hc.logger.Info("Health check failed", "infrastructure", request, "detail", detail)
+		return &healthcheck.SingleCheckResult{
+			Status: gardencorev1beta1.ConditionFalse,
+			Detail: detail,
+			Codes:  []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraDependencies},
+		}, nil
+	}
+	if len(progressing) > 0 {
+		sort.Strings(progressing)
+		threshold := infrastructureProgressingThreshold
+		return &healthcheck.SingleCheckResult{
+			Status:               gardencorev1beta1.ConditionProgressing,
+			Detail:               "AWS resources are not ready yet: " + strings.Join(progressing, ", "),
+			ProgressingThreshold: &threshold,
+		}, nil
+	}
+
+	return &healthcheck.SingleCheckResult{
+		Status: gardencorev1beta1.ConditionTrue,
+	}, nil
+}
+
+func (hc *infrastructureHealthCheck) SetLoggerSuffix(provider, _ string) {
+	hc.logger = log.Log.WithName(fmt.Sprintf("%s-healthcheck-infrastructure", provider))
+}
+
+// DeepCopy clones the healthCheck
+func (hc *infrastructureHealthCheck) DeepCopy() healthcheck.HealthCheck {
+	return &infrastructureHealthCheck{
+		seedClient:       hc.seedClient,
+		awsClientFactory: hc.awsClientFactory,
+		cache:            hc.cache,
+		logger:           hc.logger,
+	}
+}
+
+// InjectSeedClient injects the seed client
+func (hc *infrastructureHealthCheck) InjectSeedClient(seedClient client.Client) {
+	hc.seedClient = seedClient
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	testclock "k8s.io/utils/clock/testing"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	awsprovider "github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	mockawsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/mock"
+)
+
+var _ = Describe("InfrastructureHealthCheck", func() {
+	const (
+		namespace = "shoot--foo--bar"
+		region    = "eu-west-1"
+	)
+
+	var (
+		ctx     = context.TODO()
+		request = types.NamespacedName{Namespace: namespace, Name: "bar"}
+		tags    = awsclient.Tags{"kubernetes.io/cluster/" + namespace: "1"}
+
+		ctrl             *gomock.Controller
+		awsClientFactory *mockawsclient.MockFactory
+		awsClient        *mockawsclient.MockInterface
+		fakeClock        *testclock.FakeClock
+		healthCheck      *infrastructureHealthCheck
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		awsClientFactory = mockawsclient.NewMockFactory(ctrl)
+		awsClient = mockawsclient.NewMockInterface(ctrl)
+		fakeClock = testclock.NewFakeClock(time.Now())
+
+		seedClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(
+			&extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "bar"},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					SecretRef: corev1.SecretReference{Namespace: namespace, Name: "cloudprovider"},
+					Region:    region,
+				},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "cloudprovider"},
+				Data: map[string][]byte{
+					awsprovider.AccessKeyID:     []byte("access-key-id"),
+					awsprovider.SecretAccessKey: []byte("secret-access-key"),
+				},
+			},
+		).Build()
+
+		healthCheck = newInfrastructureHealthCheck(awsClientFactory, newProbeCache(fakeClock))
+		healthCheck.SetLoggerSuffix("aws", "infrastructure")
+		healthCheck.InjectSeedClient(seedClient)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	expectProbe := func(gateways []*awsclient.NATGateway, addresses []*awsclient.ElasticIP, blackholeRoutes map[string][]*awsclient.Route) {
+		awsClientFactory.EXPECT().NewClient(awsclient.AuthConfig{AccessKeyID: "access-key-id", SecretAccessKey: "secret-access-key", Region: region, Shoot: namespace}).Return(awsClient, nil)
+		awsClient.EXPECT().FindNATGatewaysByTags(ctx, tags).Return(gateways, nil)
+		awsClient.EXPECT().FindElasticIPsByTags(ctx, tags).Return(addresses, nil)
+		awsClient.EXPECT().FindBlackholeRoutesByTags(ctx, tags).Return(blackholeRoutes, nil)
+	}
+
+	It("should be healthy if all AWS resources are healthy", func() {
+		expectProbe(
+			[]*awsclient.NATGateway{{NATGatewayId: "nat-1", State: "available"}},
+			[]*awsclient.ElasticIP{{AllocationId: "eipalloc-1", PublicIp: "1.2.3.4", AssociationId: aws.String("eipassoc-1")}},
+			nil,
+		)
+
+		result, err := healthCheck.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+	})
+
+	It("should be progressing while NAT gateways are pending and elastic IPs are unassociated", func() {
+		expectProbe(
+			[]*awsclient.NATGateway{{NATGatewayId: "nat-1", State: "pending"}},
+			[]*awsclient.ElasticIP{{AllocationId: "eipalloc-1", PublicIp: "1.2.3.4"}},
+			nil,
+		)
+
+		result, err := healthCheck.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionProgressing))
+		Expect(result.Detail).To(Equal("AWS resources are not ready yet: NAT gateway nat-1 is pending, elastic IP 1.2.3.4 (eipalloc-1) is not associated"))
+		Expect(result.ProgressingThreshold).To(PointTo(Equal(infrastructureProgressingThreshold)))
+	})
+
+	It("should be unhealthy if NAT gateways failed or routes are blackholes", func() {
+		expectProbe(
+			[]*awsclient.NATGateway{{NATGatewayId: "nat-1", State: "failed"}, {NATGatewayId: "nat-2", State: "available"}},
+			nil,
+			map[string][]*awsclient.Route{"rtb-1": {{DestinationCidrBlock: aws.String("0.0.0.0/0"), NatGatewayId: aws.String("nat-0")}}},
+		)
+
+		result, err := healthCheck.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(result.Detail).To(Equal("unhealthy AWS resources: NAT gateway nat-1 is in state failed, route to 0.0.0.0/0 of route table rtb-1 is a blackhole"))
+		Expect(result.Codes).To(ConsistOf(gardencorev1beta1.ErrorInfraDependencies))
+	})
+
+	It("should only probe the AWS resources once per probe interval", func() {
+		expectProbe([]*awsclient.NATGateway{{NATGatewayId: "nat-1", State: "failed"}}, nil, nil)
+
+		result, err := healthCheck.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+
+		fakeClock.Step(awsProbeInterval - time.Second)
+		result, err = healthCheck.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+
+		fakeClock.Step(time.Second)
+		expectProbe([]*awsclient.NATGateway{{NATGatewayId: "nat-1", State: "available"}}, nil, nil)
+		result, err = healthCheck.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+	})
+})
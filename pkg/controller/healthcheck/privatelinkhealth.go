@@ -0,0 +1,156 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/config"
+	awsprovider "github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// privateLinkHealthCheck verifies that the network load balancer exposing the kube-apiserver via PrivateLink has at
+// least one healthy target. Shoots without PrivateLink are always healthy.
+type privateLinkHealthCheck struct {
+	seedClient       client.Client
+	awsClientFactory awsclient.Factory
+	cache            *probeCache
+	privateLink      *config.PrivateLinkConfig
+	logger           logr.Logger
+}
+
+var _ healthcheck.HealthCheck = &privateLinkHealthCheck{}
+
+func newPrivateLinkHealthCheck(awsClientFactory awsclient.Factory, cache *probeCache, privateLink *config.PrivateLinkConfig) *privateLinkHealthCheck {
+	return &privateLinkHealthCheck{awsClientFactory: awsClientFactory, cache: cache, privateLink: privateLink}
+}
+
+func (hc *privateLinkHealthCheck) Check(ctx context.Context, request types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	return hc.cache.probe(ctx, request, hc.check)
+}
+
+func (hc *privateLinkHealthCheck) check(ctx context.Context, request types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	cluster, err := extensionscontroller.GetCluster(ctx, hc.seedClient, request.Namespace)
+	if err != nil {
+		err := fmt.Errorf("failed to retrieve cluster %s: %w", request.Namespace, err)
+		hc.logger.Error(err, "Health check failed")
+		return nil, err
+	}
+	cpConfig, err := helper.ControlPlaneConfigFromCluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+	if hc.privateLink == nil || cpConfig == nil || cpConfig.PrivateLink == nil || !cpConfig.PrivateLink.Enabled || cluster.Seed == nil {
+		// A missing configuration of the seed is reported by the control plane controller.
+		return &healthcheck.SingleCheckResult{Status: gardencorev1beta1.ConditionTrue}, nil
+	}
+
+	service := &corev1.Service{}
+	if err := hc.seedClient.Get(ctx, client.ObjectKey{Namespace: request.Namespace, Name: awsprovider.PrivateLinkServiceName}, service); err != nil {
+		if apierrors.IsNotFound(err) {
+			return &healthcheck.SingleCheckResult{
+				Status: gardencorev1beta1.ConditionFalse,
+				Detail: fmt.Sprintf("service %q in namespace %q not found", awsprovider.PrivateLinkServiceName, request.Namespace),
+			}, nil
+		}
+		return nil, fmt.Errorf("failed to retrieve service %q in namespace %q: %w", awsprovider.PrivateLinkServiceName, request.Namespace, err)
+	}
+	if len(service.Status.LoadBalancer.Ingress) == 0 || service.Status.LoadBalancer.Ingress[0].Hostname == "" {
+		return &healthcheck.SingleCheckResult{
+			Status: gardencorev1beta1.ConditionProgressing,
+			Detail: fmt.Sprintf("load balancer of service %q has not been provisioned yet", awsprovider.PrivateLinkServiceName),
+		}, nil
+	}
+
+	credentials, err := awsprovider.GetCredentialsFromSecretRef(ctx, hc.seedClient, hc.privateLink.SecretRef, false)
+	if err != nil {
+		return nil, fmt.Errorf("could not get AWS credentials of the seed: %w", err)
+	}
+	authConfig := awsprovider.NewAuthConfig(credentials, cluster.Seed.Spec.Provider.Region)
+	authConfig.Shoot = request.Namespace
+	awsClient, err := hc.awsClientFactory.NewClient(authConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	hostname := service.Status.LoadBalancer.Ingress[0].Hostname
+	loadBalancerARN, err := awsClient.FindLoadBalancerARNByDNSName(ctx, hostname)
+	if err != nil {
+		return nil, fmt.Errorf("could not find load balancer %s: %w", hostname, err)
+	}
+	if loadBalancerARN == "" {
+		return &healthcheck.SingleCheckResult{
+			Status: gardencorev1beta1.ConditionFalse,
+			Detail: fmt.Sprintf("load balancer %s of the kube-apiserver not found", hostname),
+		}, nil
+	}
+
+	targets, err := awsClient.GetLoadBalancerTargetHealth(ctx, loadBalancerARN)
+	if err != nil {
+		return nil, fmt.Errorf("could not get target health of load balancer %s: %w", loadBalancerARN, err)
+	}
+	var unhealthy []string
+	for _, target := range targets {
+		if target.State == string(elbv2types.TargetHealthStateEnumHealthy) {
+			return &healthcheck.SingleCheckResult{Status: gardencorev1beta1.ConditionTrue}, nil
+		}
+		unhealthy = append(unhealthy, fmt.Sprintf("%s is %s", target.TargetId, target.State))
+	}
+
+	detail := fmt.Sprintf("load balancer %s of the kube-apiserver has no healthy targets", hostname)
+	if len(unhealthy) > 0 {
+		detail += ": " + strings.Join(unhealthy, ", ")
+	}
+	hc.logger.Info("Health check failed", "controlPlane", request, "detail", detail)
+	return &healthcheck.SingleCheckResult{
+		Status: gardencorev1beta1.ConditionFalse,
+		Detail: detail,
+	}, nil
+}
+
+func (hc *privateLinkHealthCheck) SetLoggerSuffix(provider, _ string) {
+	hc.logger = log.Log.WithName(fmt.Sprintf("%s-healthcheck-privatelink", provider))
+}
+
+// DeepCopy clones the healthCheck
+func (hc *privateLinkHealthCheck) DeepCopy() healthcheck.HealthCheck {
+	return &privateLinkHealthCheck{
+		seedClient:       hc.seedClient,
+		awsClientFactory: hc.awsClientFactory,
+		cache:            hc.cache,
+		privateLink:      hc.privateLink,
+		logger:           hc.logger,
+	}
+}
+
+// InjectSeedClient injects the seed client
+func (hc *privateLinkHealthCheck) InjectSeedClient(seedClient client.Client) {
+	hc.seedClient = seedClient
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+)
+
+// awsProbeInterval is the minimum interval in which the AWS resources of an extension object are probed. The health
+// checks run more frequently, hence the results of the probes are cached in between to limit the AWS API requests.
+const awsProbeInterval = 5 * time.Minute
+
+// probeCache caches the results of probes of AWS resources by extension object. Errors are not cached.
+type probeCache struct {
+	clock   clock.Clock
+	lock    sync.Mutex
+	results map[types.NamespacedName]cachedProbeResult
+}
+
+type cachedProbeResult struct {
+	result    *healthcheck.SingleCheckResult
+	timestamp time.Time
+}
+
+func newProbeCache(clock clock.Clock) *probeCache {
+	return &probeCache{clock: clock, results: map[types.NamespacedName]cachedProbeResult{}}
+}
+
+// probe returns the cached result of the given extension object if it is younger than the probe interval, otherwise
+// it calls the probe function and caches its result.
+func (c *probeCache) probe(ctx context.Context, request types.NamespacedName, probe func(context.Context, types.NamespacedName) (*healthcheck.SingleCheckResult, error)) (*healthcheck.SingleCheckResult, error) {
+	c.lock.Lock()
+	cached, ok := c.results[request]
+	c.lock.Unlock()
+	if ok && c.clock.Since(cached.timestamp) < awsProbeInterval {
+		return cached.result, nil
+	}
+
+	result, err := probe(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	// Expired results are removed, so that the results of deleted extension objects do not pile up.
+	for key, cached := range c.results {
+		if c.clock.Since(cached.timestamp) >= awsProbeInterval {
+			delete(c.results, key)
+		}
+	}
+	c.results[request] = cachedProbeResult{result: result, timestamp: c.clock.Now()}
+	return result, nil
+}
@@ -15,6 +15,7 @@
 package infrastructure
 
 import (
+	"context"
 	"time"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/infrastructure"
@@ -26,11 +27,14 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/client-go/rest"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener-extension-provider-aws/imagevector"
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 )
 
 type actuator struct {
@@ -62,7 +66,7 @@ func newTerraformer(
 	terraformer.Terraformer,
 	error,
 ) {
-	tf, err := terraformer.NewForConfig(logger, restConfig, purpose, infra.Namespace, infra.Name, imagevector.TerraformerImage())
+	tf, err := terraformer.NewForConfig(logger, restConfig, purpose, infra.Namespace, infra.Name, aws.TerraformerImage(imagevector.TerraformerImage()))
 	if err != nil {
 		return nil, err
 	}
@@ -72,18 +76,22 @@ func newTerraformer(
 		UseProjectedTokenMount(!disableProjectedTokenMount).
 		SetTerminationGracePeriodSeconds(630).
 		SetDeadlineCleaning(5 * time.Minute).
-		SetDeadlinePod(15 * time.Minute).
+		SetDeadlinePod(aws.TerraformerPodTimeout()).
 		SetOwnerRef(owner), nil
 }
 
+// generateTerraformerEnvVars returns the environment variables for the Terraformer pod. All of them are optional as
+// the secret contains either an access key or a web identity token. The proxy of the controller configuration is
+// passed on as well.
 func generateTerraformerEnvVars(secretRef corev1.SecretReference) []corev1.EnvVar {
-	return []corev1.EnvVar{{
+	return append([]corev1.EnvVar{{
 		Name: "TF_VAR_ACCESS_KEY_ID",
 		ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
 			LocalObjectReference: corev1.LocalObjectReference{
 				Name: secretRef.Name,
 			},
-			Key: aws.AccessKeyID,
+			Key:      aws.AccessKeyID,
+			Optional: pointer.Bool(true),
 		}},
 	}, {
 		Name: "TF_VAR_SECRET_ACCESS_KEY",
@@ -91,7 +99,52 @@ func generateTerraformerEnvVars(secretRef corev1.SecretReference) []corev1.EnvVa
 			LocalObjectReference: corev1.LocalObjectReference{
 				Name: secretRef.Name,
 			},
-			Key: aws.SecretAccessKey,
+			Key:      aws.SecretAccessKey,
+			Optional: pointer.Bool(true),
 		}},
-	}}
+	}, {
+		Name: "TF_VAR_ROLE_ARN",
+		ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{
+				Name: secretRef.Name,
+			},
+			Key:      aws.RoleARN,
+			Optional: pointer.Bool(true),
+		}},
+	}, {
+		Name: "TF_VAR_EXTERNAL_ID",
+		ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{
+				Name: secretRef.Name,
+			},
+			Key:      aws.ExternalID,
+			Optional: pointer.Bool(true),
+		}},
+	}, {
+		Name: "TF_VAR_WEB_IDENTITY_TOKEN",
+		ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{
+				Name: secretRef.Name,
+			},
+			Key:      aws.WebIdentityToken,
+			Optional: pointer.Bool(true),
+		}},
+	}}, aws.ProxyEnvVars()...)
+}
+
+// newAWSClient creates a new AWS client for the given infrastructure. The AWS partition and the AWS service endpoints
+// of the given InfrastructureConfig take precedence over the ones of the controller configuration.
+func newAWSClient(ctx context.Context, c client.Client, infra *extensionsv1alpha1.Infrastructure, infrastructureConfig *awsapi.InfrastructureConfig) (awsclient.Interface, error) {
+	credentials, err := aws.GetCredentialsFromSecretRef(ctx, c, infra.Spec.SecretRef, false)
+	if err != nil {
+		return nil, err
+	}
+
+	authConfig := aws.NewAuthConfig(credentials, infra.Spec.Region)
+	authConfig.Controller = infrastructure.ControllerName
+	authConfig.Shoot = infra.Namespace
+	if infrastructureConfig != nil {
+		aws.ApplyEndpoints(&authConfig, infrastructureConfig.Endpoints)
+	}
+	return awsclient.NewClient(authConfig)
 }
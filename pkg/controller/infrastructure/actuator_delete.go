@@ -21,9 +21,10 @@ import (
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/terraformer"
-	"github.com/gardener/gardener/extensions/pkg/util"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/flow"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/go-logr/logr"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -31,12 +32,22 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
-	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow/shared"
 )
 
+// forceDeletionTimeout is the maximum duration of the best-effort deletion of the AWS resources during a forceful
+// deletion.
+const forceDeletionTimeout = 10 * time.Minute
+
 func (a *actuator) Delete(ctx context.Context, log logr.Logger, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	// Operators can force the deletion of a single infrastructure, e.g. if the shoot can't be annotated for forceful
+	// deletion.
+	if kubernetesutils.HasMetaDataAnnotation(&infrastructure.ObjectMeta, v1beta1constants.AnnotationConfirmationForceDeletion, "true") {
+		return a.ForceDelete(ctx, log, infrastructure, cluster)
+	}
+
 	state, err := a.getStateFromInfraStatus(infrastructure)
 	if err != nil {
 		return err
@@ -48,8 +59,69 @@ func (a *actuator) Delete(ctx context.Context, log logr.Logger, infrastructure *
 	return Delete(ctx, log, a.restConfig, a.client, a.decoder, infrastructure, a.disableProjectedTokenMount)
 }
 
-func (a *actuator) ForceDelete(_ context.Context, _ logr.Logger, _ *extensionsv1alpha1.Infrastructure, _ *extensionscontroller.Cluster) error {
-	return nil
+// ForceDelete forcefully deletes the given Infrastructure, e.g. if its credentials are invalid or were revoked. The AWS
+// resources are deleted on a best-effort basis only, i.e. errors are logged and resources might be left behind.
+// Afterwards, the Terraformer resources holding the state are removed, so that the finalizer can be removed.
+func (a *actuator) ForceDelete(ctx context.Context, log logr.Logger, infrastructure *extensionsv1alpha1.Infrastructure, _ *extensionscontroller.Cluster) error {
+	log.Info("Deleting infrastructure forcefully")
+	if err := a.deleteBestEffort(ctx, log, infrastructure); err != nil {
+		log.Error(err, "Could not delete all AWS resources, they might have to be deleted manually")
+	}
+
+	tf, err := newTerraformer(log, a.restConfig, aws.TerraformerPurposeInfra, infrastructure, a.disableProjectedTokenMount)
+	if err != nil {
+		return fmt.Errorf("could not create terraformer object: %w", err)
+	}
+	if err := tf.EnsureCleanedUp(ctx); err != nil {
+		return err
+	}
+	if err := tf.CleanupConfiguration(ctx); err != nil {
+		return err
+	}
+	return tf.RemoveTerraformerFinalizerFromConfig(ctx)
+}
+
+// deleteBestEffort deletes the AWS resources of the given Infrastructure with the flow, which finds the resources by
+// their IDs in the (flow or migrated terraform) state or by their tags if the infrastructure has no state. Failing
+// deletions don't prevent the deletion of the remaining resources, their errors are returned joined. It is skipped if
+// the credentials can't be read or are invalid.
+func (a *actuator) deleteBestEffort(ctx context.Context, log logr.Logger, infrastructure *extensionsv1alpha1.Infrastructure) error {
+	ctx, cancel := context.WithTimeout(ctx, forceDeletionTimeout)
+	defer cancel()
+
+	infrastructureConfig, err := a.decodeInfrastructureConfig(infrastructure)
+	if err != nil {
+		return err
+	}
+	awsClient, err := newAWSClient(ctx, a.client, infrastructure, infrastructureConfig)
+	if err != nil {
+		log.Info("Skipping deletion of AWS resources as no AWS client can be created", "error", err.Error())
+		return nil
+	}
+	if _, err := awsClient.GetAccountID(ctx); err != nil {
+		log.Info("Skipping deletion of AWS resources as credentials are invalid", "error", err.Error())
+		return nil
+	}
+
+	state, err := a.getStateFromInfraStatus(infrastructure)
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		// Without any state, migrateTerraformStateToFlowState returns an empty state, i.e. the resources are found by
+		// their tags.
+		if state, err = migrateTerraformStateToFlowState(infrastructure.Status.State, infrastructureConfig.Networks.Zones); err != nil {
+			return err
+		}
+	}
+
+	// The state is not persisted, as the infrastructure is gone afterwards anyway.
+	flowContext, err := infraflow.NewFlowContext(log, awsClient, infrastructure, infrastructureConfig, state.ToFlatMap(),
+		func(context.Context, shared.FlatMap) error { return nil })
+	if err != nil {
+		return err
+	}
+	return flowContext.DeleteBestEffort(ctx)
 }
 
 func (a *actuator) deleteWithFlow(ctx context.Context, log logr.Logger, infrastructure *extensionsv1alpha1.Infrastructure,
@@ -62,9 +134,12 @@ func (a *actuator) deleteWithFlow(ctx context.Context, log logr.Logger, infrastr
 	}
 	if err = flowContext.Delete(ctx); err != nil {
 		_ = flowContext.PersistState(ctx, true)
-		return util.DetermineError(err, helper.KnownCodes)
+		return aws.DetermineError(err)
+	}
+	if err := flowContext.PersistState(ctx, true); err != nil {
+		return err
 	}
-	return flowContext.PersistState(ctx, true)
+	return a.deleteInventory(ctx, infrastructure)
 }
 
 // Delete deletes the given Infrastructure.
@@ -88,7 +163,7 @@ func Delete(
 
 	tf, err := newTerraformer(logger, restConfig, aws.TerraformerPurposeInfra, infrastructure, disableProjectedTokenMount)
 	if err != nil {
-		return util.DetermineError(fmt.Errorf("could not create the Terraformer: %+v", err), helper.KnownCodes)
+		return aws.DetermineError(fmt.Errorf("could not create the Terraformer: %w", err))
 	}
 
 	// terraform pod from previous reconciliation might still be running, ensure they are gone before doing any operations
@@ -108,9 +183,9 @@ func Delete(
 		return fmt.Errorf("error while checking whether terraform config exists: %+v", err)
 	}
 
-	awsClient, err := aws.NewClientFromSecretRef(ctx, c, infrastructure.Spec.SecretRef, infrastructure.Spec.Region)
+	awsClient, err := newAWSClient(ctx, c, infrastructure, infrastructureConfig)
 	if err != nil {
-		return util.DetermineError(fmt.Errorf("failed to create new AWS client: %+v", err), helper.KnownCodes)
+		return aws.DetermineError(fmt.Errorf("failed to create new AWS client: %w", err))
 	}
 
 	var (
@@ -138,7 +213,7 @@ func Delete(
 				}
 
 				if err := infraflow.DestroyKubernetesLoadBalancersAndSecurityGroups(ctx, awsClient, vpcID, infrastructure.Namespace); err != nil {
-					return util.DetermineError(fmt.Errorf("failed to destroy load balancers and security groups: %w", err), helper.KnownCodes)
+					return aws.DetermineError(fmt.Errorf("failed to destroy load balancers and security groups: %w", err))
 				}
 
 				return nil
@@ -156,7 +231,7 @@ func Delete(
 	)
 
 	if err := f.Run(ctx, flow.Opts{}); err != nil {
-		return util.DetermineError(flow.Errors(err), helper.KnownCodes)
+		return aws.DetermineError(flow.Errors(err))
 	}
 
 	return nil
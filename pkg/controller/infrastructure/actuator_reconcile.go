@@ -23,12 +23,16 @@ import (
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/terraformer"
-	"github.com/gardener/gardener/extensions/pkg/util"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/rest"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
@@ -41,21 +45,41 @@ import (
 )
 
 func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	if a.shouldPlan(infrastructure, cluster) {
+		return a.plan(ctx, log, infrastructure, cluster)
+	}
+	if err := a.cleanupPlan(ctx, infrastructure); err != nil {
+		return err
+	}
+
+	progress := a.newProgressReporter(log, infrastructure)
+	progress.Report(ctx, progressPreflightChecks, "Checking permissions and quotas")
+	if err := a.preflightChecks(ctx, log, infrastructure, cluster); err != nil {
+		return err
+	}
+
 	flowState, err := a.getStateFromInfraStatus(infrastructure)
 	if err != nil {
 		return err
 	}
 	if flowState != nil {
-		return a.reconcileWithFlow(ctx, log, infrastructure, flowState)
+		return a.reconcileWithFlow(ctx, log, infrastructure, flowState, progress)
 	}
 	if a.shouldUseFlow(infrastructure, cluster) {
+		progress.Report(ctx, progressMigration, "Migrating terraform state")
 		flowState, err = a.migrateFromTerraformerState(ctx, log, infrastructure)
 		if err != nil {
 			return err
 		}
-		return a.reconcileWithFlow(ctx, log, infrastructure, flowState)
+		return a.reconcileWithFlow(ctx, log, infrastructure, flowState, progress)
+	}
+	if a.shouldDryRunFlowMigration(infrastructure, cluster) {
+		if err := a.dryRunFlowMigration(ctx, log, infrastructure); err != nil {
+			return err
+		}
 	}
 
+	progress.Report(ctx, progressApply, "Applying terraform configuration")
 	infrastructureStatus, state, err := ReconcileWithTerraformer(
 		ctx,
 		log,
@@ -69,9 +93,55 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, infrastructur
 		return err
 	}
 
+	progress.Report(ctx, progressStatus, "Updating infrastructure status")
 	return a.updateProviderStatusTf(ctx, a.client, infrastructure, infrastructureStatus, state)
 }
 
+// preflightChecks simulates the IAM permissions required by the extension if requested and checks that the AWS service
+// quotas can accommodate the resources which are still to be created for the shoot before any of them is created.
+func (a *actuator) preflightChecks(ctx context.Context, log logr.Logger, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	infrastructureConfig, err := a.decodeInfrastructureConfig(infrastructure)
+	if err != nil {
+		return err
+	}
+	awsClient, err := newAWSClient(ctx, a.client, infrastructure, infrastructureConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create new AWS client: %w", err)
+	}
+
+	if a.shouldCheckPermissions(infrastructure, cluster) {
+		status, reason, message := checkPermissions(ctx, awsClient)
+		if err := a.updateCondition(ctx, infrastructure, ConditionTypeIAMPermissions, status, reason, message); err != nil {
+			log.Error(err, "Could not update condition", "type", ConditionTypeIAMPermissions)
+		}
+	}
+
+	warnings, err := checkQuotas(ctx, log, awsClient, a.decoder, infrastructure.Namespace, infrastructureConfig, cluster)
+	if err != nil {
+		return err
+	}
+	if len(warnings) > 0 {
+		if err := a.updateCondition(ctx, infrastructure, ConditionTypeVCPUQuota, gardencorev1beta1.ConditionFalse, ReasonQuotaExceeded,
+			fmt.Sprintf("AWS service quotas are exceeded, worker nodes may fail to be created: %s", strings.Join(warnings, "; "))); err != nil {
+			log.Error(err, "Could not update condition", "type", ConditionTypeVCPUQuota)
+		}
+	} else if v1beta1helper.GetCondition(infrastructure.Status.Conditions, ConditionTypeVCPUQuota) != nil {
+		if err := a.updateCondition(ctx, infrastructure, ConditionTypeVCPUQuota, gardencorev1beta1.ConditionTrue, ReasonQuotaSufficient,
+			"The AWS service quotas can accommodate the worker nodes."); err != nil {
+			log.Error(err, "Could not update condition", "type", ConditionTypeVCPUQuota)
+		}
+	}
+	return nil
+}
+
+// shouldCheckPermissions checks if the IAM permissions required by the extension should be simulated, i.e. if the
+// annotation `aws.provider.extensions.gardener.cloud/check-permissions=true` is set on the infrastructure or the shoot
+// resource.
+func (a *actuator) shouldCheckPermissions(infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) bool {
+	return strings.EqualFold(infrastructure.Annotations[awsapi.AnnotationKeyCheckPermissions], "true") ||
+		(cluster.Shoot != nil && strings.EqualFold(cluster.Shoot.Annotations[awsapi.AnnotationKeyCheckPermissions], "true"))
+}
+
 // shouldUseFlow checks if flow reconciliation should be used, by any of these conditions:
 // - annotation `aws.provider.extensions.gardener.cloud/use-flow=true` on infrastructure resource
 // - annotation `aws.provider.extensions.gardener.cloud/use-flow=true` on shoot resource
@@ -85,6 +155,14 @@ func (a *actuator) shouldUseFlow(infrastructure *extensionsv1alpha1.Infrastructu
 		(cluster.Seed != nil && strings.EqualFold(cluster.Seed.Labels[awsapi.SeedLabelKeyUseFlow], "true"))
 }
 
+// shouldDryRunFlowMigration checks if the migration from the terraform state to the flow state should be checked
+// without switching to flow reconciliation, i.e. if the annotation `aws.provider.extensions.gardener.cloud/use-flow=dry-run`
+// is set on the infrastructure or the shoot resource.
+func (a *actuator) shouldDryRunFlowMigration(infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) bool {
+	return strings.EqualFold(infrastructure.Annotations[awsapi.AnnotationKeyUseFlow], awsapi.AnnotationUseFlowValueDryRun) ||
+		(cluster.Shoot != nil && strings.EqualFold(cluster.Shoot.Annotations[awsapi.AnnotationKeyUseFlow], awsapi.AnnotationUseFlowValueDryRun))
+}
+
 func (a *actuator) getStateFromInfraStatus(infrastructure *extensionsv1alpha1.Infrastructure) (*infraflow.PersistentState, error) {
 	if infrastructure.Status.State != nil {
 		return infraflow.NewPersistentStateFromJSON(infrastructure.Status.State.Raw)
@@ -100,7 +178,11 @@ func (a *actuator) migrateFromTerraformerState(ctx context.Context, log logr.Log
 	}
 	state, err := migrateTerraformStateToFlowState(infrastructure.Status.State, infrastructureConfig.Networks.Zones)
 	if err != nil {
-		return nil, fmt.Errorf("migration from terraform state failed: %w", err)
+		err = fmt.Errorf("migration from terraform state failed: %w", err)
+		if condErr := a.updateFlowMigrationCondition(ctx, infrastructure, gardencorev1beta1.ConditionFalse, ReasonMigrationFailed, err.Error()); condErr != nil {
+			log.Error(condErr, "Could not update condition", "type", ConditionTypeFlowMigration)
+		}
+		return nil, err
 	}
 
 	if err := a.updateStatusState(ctx, infrastructure, state, nil); err != nil {
@@ -108,9 +190,73 @@ func (a *actuator) migrateFromTerraformerState(ctx context.Context, log logr.Log
 	}
 	log.Info("terraform state migrated successfully")
 
+	message := fmt.Sprintf("Terraform state with %d resources migrated, waiting for the first reconciliation with flow.", len(state.Data))
+	if err := a.updateFlowMigrationCondition(ctx, infrastructure, gardencorev1beta1.ConditionProgressing, ReasonMigrationInProgress, message); err != nil {
+		return nil, err
+	}
+
 	return state, nil
 }
 
+// dryRunFlowMigration migrates the terraform state to the flow state without persisting it and checks that the
+// migrated resources exist. The result is reported in the FlowMigration condition of the infrastructure, i.e. a failing
+// dry run doesn't fail the reconciliation.
+func (a *actuator) dryRunFlowMigration(ctx context.Context, log logr.Logger, infrastructure *extensionsv1alpha1.Infrastructure) error {
+	log.Info("Checking migration from terraform state (dry run)")
+
+	infrastructureConfig, err := a.decodeInfrastructureConfig(infrastructure)
+	if err != nil {
+		return err
+	}
+
+	var (
+		status  = gardencorev1beta1.ConditionFalse
+		reason  = ReasonMigrationDryRunFailed
+		message string
+	)
+	if awsClient, err := newAWSClient(ctx, a.client, infrastructure, infrastructureConfig); err != nil {
+		message = fmt.Sprintf("failed to create new AWS client: %s", err)
+	} else {
+		status, reason, message = checkFlowMigration(ctx, awsClient, infrastructure.Status.State, infrastructureConfig.Networks.Zones)
+	}
+	log.Info("Checked migration from terraform state (dry run)", "reason", reason, "message", message)
+
+	return a.updateFlowMigrationCondition(ctx, infrastructure, status, reason, message)
+}
+
+// checkFlowMigration migrates the given terraform state to the flow state without persisting it and checks that the
+// migrated resources exist. It returns the status, reason and message of the FlowMigration condition.
+func checkFlowMigration(ctx context.Context, awsClient awsclient.Interface, terraformState *runtime.RawExtension, zones []awsapi.Zone) (gardencorev1beta1.ConditionStatus, string, string) {
+	state, err := migrateTerraformStateToFlowState(terraformState, zones)
+	if err != nil {
+		return gardencorev1beta1.ConditionFalse, ReasonMigrationDryRunFailed, fmt.Sprintf("migration from terraform state failed: %s", err)
+	}
+
+	missing, err := findMissingMigratedResources(ctx, awsClient, state)
+	if err != nil {
+		return gardencorev1beta1.ConditionFalse, ReasonMigrationDryRunFailed, fmt.Sprintf("could not resolve migrated resources: %s", err)
+	}
+	if len(missing) > 0 {
+		return gardencorev1beta1.ConditionFalse, ReasonMigrationDryRunFailed,
+			fmt.Sprintf("Terraform state with %d resources can be migrated, but the following resources don't exist: %s.", len(state.Data), strings.Join(missing, ", "))
+	}
+	return gardencorev1beta1.ConditionFalse, ReasonMigrationDryRunSucceeded, fmt.Sprintf("Terraform state with %d resources can be migrated.", len(state.Data))
+}
+
+// updateFlowMigrationCondition updates the FlowMigration condition of the given infrastructure.
+func (a *actuator) updateFlowMigrationCondition(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, status gardencorev1beta1.ConditionStatus, reason, message string) error {
+	return a.updateCondition(ctx, infrastructure, ConditionTypeFlowMigration, status, reason, message)
+}
+
+// updateCondition updates the condition of the given type of the given infrastructure.
+func (a *actuator) updateCondition(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, conditionType gardencorev1beta1.ConditionType, status gardencorev1beta1.ConditionStatus, reason, message string) error {
+	patch := client.MergeFrom(infrastructure.DeepCopy())
+	condition := v1beta1helper.GetOrInitConditionWithClock(clock.RealClock{}, infrastructure.Status.Conditions, conditionType)
+	condition = v1beta1helper.UpdatedConditionWithClock(clock.RealClock{}, condition, status, reason, message)
+	infrastructure.Status.Conditions = v1beta1helper.MergeConditions(infrastructure.Status.Conditions, condition)
+	return a.client.Status().Patch(ctx, infrastructure, patch)
+}
+
 func (a *actuator) decodeInfrastructureConfig(infrastructure *extensionsv1alpha1.Infrastructure) (*awsapi.InfrastructureConfig, error) {
 	infrastructureConfig := &awsapi.InfrastructureConfig{}
 	if _, _, err := a.decoder.Decode(infrastructure.Spec.ProviderConfig.Raw, nil, infrastructureConfig); err != nil {
@@ -137,7 +283,7 @@ func (a *actuator) createFlowContext(ctx context.Context, log logr.Logger,
 		return nil, err
 	}
 
-	awsClient, err := aws.NewClientFromSecretRef(ctx, a.client, infrastructure.Spec.SecretRef, infrastructure.Spec.Region)
+	awsClient, err := newAWSClient(ctx, a.client, infrastructure, infrastructureConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create new AWS client: %w", err)
 	}
@@ -184,18 +330,33 @@ func (a *actuator) cleanupTerraformerResources(ctx context.Context, log logr.Log
 }
 
 func (a *actuator) reconcileWithFlow(ctx context.Context, log logr.Logger, infrastructure *extensionsv1alpha1.Infrastructure,
-	oldState *infraflow.PersistentState) error {
+	oldState *infraflow.PersistentState, progress *progressReporter) error {
 	log.Info("reconcileWithFlow")
 
 	flowContext, err := a.createFlowContext(ctx, log, infrastructure, oldState)
 	if err != nil {
 		return err
 	}
+	flowContext.SetProgressReporter(progress.Stage("Reconciling AWS resources", progressApply, progressStatus))
 	if err = flowContext.Reconcile(ctx); err != nil {
 		_ = flowContext.PersistState(ctx, true)
-		return util.DetermineError(err, helper.KnownCodes)
+		return aws.DetermineError(err)
+	}
+	progress.Report(ctx, progressStatus, "Updating infrastructure status")
+	if err := flowContext.PersistState(ctx, true); err != nil {
+		return err
+	}
+	if err := a.updateSharedVPCCondition(ctx, infrastructure); err != nil {
+		return err
 	}
-	return flowContext.PersistState(ctx, true)
+	if err := a.publishInventory(ctx, infrastructure, flowContext); err != nil {
+		return err
+	}
+
+	if condition := v1beta1helper.GetCondition(infrastructure.Status.Conditions, ConditionTypeFlowMigration); condition != nil && condition.Status == gardencorev1beta1.ConditionProgressing {
+		return a.updateFlowMigrationCondition(ctx, infrastructure, gardencorev1beta1.ConditionTrue, ReasonMigrationSucceeded, "Terraform state migrated and infrastructure reconciled with flow.")
+	}
+	return nil
 }
 
 func (a *actuator) updateStatusState(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, state *infraflow.PersistentState, egressCIDRs []string) error {
@@ -222,7 +383,12 @@ func (a *actuator) updateStatusState(ctx context.Context, infra *extensionsv1alp
 }
 
 func (a *actuator) computeEgressCIDRs(ctx context.Context, infra *extensionsv1alpha1.Infrastructure) ([]string, error) {
-	awsClient, err := aws.NewClientFromSecretRef(ctx, a.client, infra.Spec.SecretRef, infra.Spec.Region)
+	infrastructureConfig, err := a.decodeInfrastructureConfig(infra)
+	if err != nil {
+		return nil, err
+	}
+
+	awsClient, err := newAWSClient(ctx, a.client, infra, infrastructureConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create new AWS client: %w", err)
 	}
@@ -279,14 +445,22 @@ func computeProviderStatusFromFlowState(config *awsapi.InfrastructureConfig, sta
 					purpose = awsapi.PurposePublic
 				case infraflow.IdentifierZoneSubnetWorkers:
 					purpose = awsapi.PurposeNodes
+				case infraflow.IdentifierZoneSubnetPods:
+					purpose = awsapi.PurposePods
+				case infraflow.IdentifierZoneSubnetWorkersSecondary:
+					purpose = awsapi.PurposeNodesSecondary
 				default:
 					continue
 				}
-				subnets = append(subnets, awsv1alpha1.Subnet{
+				subnet := awsv1alpha1.Subnet{
 					ID:      v,
 					Purpose: purpose,
 					Zone:    parts[1],
-				})
+				}
+				if ipv6CIDR := state.Data[k+infraflow.IdentifierZoneSubnetIPv6CIDRSuffix]; shared.IsValidValue(ipv6CIDR) {
+					subnet.IPv6CIDR = &ipv6CIDR
+				}
+				subnets = append(subnets, subnet)
 			}
 		}
 
@@ -304,25 +478,171 @@ func computeProviderStatusFromFlowState(config *awsapi.InfrastructureConfig, sta
 		}
 	}
 
+	if ipv6CIDR := state.Data[infraflow.IdentifierVpcIPv6CidrBlock]; vpcID != "" && shared.IsValidValue(ipv6CIDR) {
+		status.VPC.IPv6CIDR = &ipv6CIDR
+	}
+
+	if vpcID != "" {
+		if id := state.Data[infraflow.IdentifierInternetGateway]; shared.IsValidValue(id) {
+			status.VPC.InternetGatewayID = &id
+		}
+		if id := state.Data[infraflow.IdentifierEgressOnlyInternetGateway]; shared.IsValidValue(id) {
+			status.VPC.EgressOnlyInternetGatewayID = &id
+		}
+		if id := state.Data[infraflow.IdentifierMainRouteTable]; shared.IsValidValue(id) {
+			status.VPC.RouteTables = append(status.VPC.RouteTables, awsv1alpha1.RouteTableStatus{
+				Purpose: awsapi.PurposePublic,
+				ID:      id,
+			})
+		}
+		for _, zone := range config.Networks.Zones {
+			prefix := infraflow.ChildIdZones + shared.Separator + zone.Name + shared.Separator
+			if id := state.Data[prefix+infraflow.IdentifierZoneRouteTable]; shared.IsValidValue(id) {
+				status.VPC.RouteTables = append(status.VPC.RouteTables, awsv1alpha1.RouteTableStatus{
+					Purpose: awsapi.PurposePrivate,
+					ID:      id,
+					Zone:    pointer.String(zone.Name),
+				})
+			}
+			if id := state.Data[prefix+infraflow.IdentifierZoneNATGateway]; shared.IsValidValue(id) {
+				natGateway := awsv1alpha1.NATGatewayStatus{
+					Zone: zone.Name,
+					ID:   id,
+				}
+				if zone.ElasticIPAllocationID != nil {
+					natGateway.ElasticIPAllocationID = pointer.String(*zone.ElasticIPAllocationID)
+				} else if allocationID := state.Data[prefix+infraflow.IdentifierZoneNATGWPoolElasticIP]; shared.IsValidValue(allocationID) {
+					natGateway.ElasticIPAllocationID = &allocationID
+				} else if allocationID := state.Data[prefix+infraflow.IdentifierZoneNATGWElasticIP]; shared.IsValidValue(allocationID) {
+					natGateway.ElasticIPAllocationID = &allocationID
+				}
+				if publicIP := state.Data[prefix+infraflow.IdentifierZoneNATGatewayPublicIP]; shared.IsValidValue(publicIP) {
+					natGateway.PublicIP = &publicIP
+				}
+				status.VPC.NATGateways = append(status.VPC.NATGateways, natGateway)
+			}
+		}
+	}
+
+	poolPrefix := infraflow.ChildIdElasticIPPool + shared.Separator
+	for _, key := range sets.List(sets.KeySet(state.Data)) {
+		allocationID, found := strings.CutPrefix(key, poolPrefix)
+		if !found || !shared.IsValidValue(state.Data[key]) {
+			continue
+		}
+		address := awsv1alpha1.ElasticIPPoolAddress{
+			AllocationID: allocationID,
+			PublicIP:     state.Data[key],
+		}
+		for _, zone := range config.Networks.Zones {
+			if state.Data[infraflow.ChildIdZones+shared.Separator+zone.Name+shared.Separator+infraflow.IdentifierZoneNATGWPoolElasticIP] == allocationID {
+				address.Zone = pointer.String(zone.Name)
+			}
+		}
+		status.VPC.ElasticIPPool = append(status.VPC.ElasticIPPool, address)
+	}
+
+	if vpcID != "" {
+		endpoints := vpcEndpointsByService(config)
+		for _, service := range sets.List(sets.KeySet(endpoints)) {
+			if id := state.Data[infraflow.ChildIdVPCEndpoints+shared.Separator+service]; shared.IsValidValue(id) {
+				endpoint := awsv1alpha1.VPCEndpointStatus{
+					Service: service,
+					Type:    awsv1alpha1.VPCEndpointType(endpoints[service]),
+					ID:      id,
+				}
+				if prefixListID := state.Data[infraflow.ChildIdPrefixLists+shared.Separator+service]; shared.IsValidValue(prefixListID) {
+					endpoint.PrefixListID = &prefixListID
+				}
+				status.VPC.Endpoints = append(status.VPC.Endpoints, endpoint)
+			}
+		}
+	}
+
+	if attachmentID := state.Data[infraflow.IdentifierTransitGatewayAttachment]; vpcID != "" && shared.IsValidValue(attachmentID) {
+		status.VPC.TransitGatewayAttachmentID = &attachmentID
+	}
+
+	if vpcID != "" {
+		for _, peering := range config.Networks.VPCPeerings {
+			prefix := infraflow.ChildIdVPCPeerings + shared.Separator + peering.PeerVPCID + shared.Separator
+			if id := state.Data[prefix+infraflow.IdentifierVPCPeeringConnection]; shared.IsValidValue(id) {
+				status.VPC.Peerings = append(status.VPC.Peerings, awsv1alpha1.VPCPeeringStatus{
+					PeerVPCID: peering.PeerVPCID,
+					ID:        id,
+					State:     state.Data[prefix+infraflow.IdentifierVPCPeeringConnectionState],
+				})
+			}
+		}
+	}
+
+	if vpcID != "" {
+		inboundID := state.Data[infraflow.IdentifierRoute53ResolverInboundEndpoint]
+		outboundID := state.Data[infraflow.IdentifierRoute53ResolverOutboundEndpoint]
+		if shared.IsValidValue(inboundID) || shared.IsValidValue(outboundID) {
+			resolverStatus := &awsv1alpha1.Route53ResolverStatus{}
+			if shared.IsValidValue(inboundID) {
+				resolverStatus.InboundEndpointID = &inboundID
+				if ips := state.Data[infraflow.IdentifierRoute53ResolverInboundEndpointIPs]; shared.IsValidValue(ips) && ips != "" {
+					resolverStatus.InboundEndpointIPs = strings.Split(ips, ",")
+				}
+			}
+			if shared.IsValidValue(outboundID) {
+				resolverStatus.OutboundEndpointID = &outboundID
+			}
+			status.VPC.Route53Resolver = resolverStatus
+		}
+	}
+
 	if keyName := state.Data[infraflow.NameKeyPair]; shared.IsValidValue(keyName) {
 		status.EC2.KeyName = keyName
 	}
 
-	if name := state.Data[infraflow.NameIAMInstanceProfile]; shared.IsValidValue(name) {
+	if fileSystemID := state.Data[infraflow.IdentifierElasticFileSystem]; shared.IsValidValue(fileSystemID) {
+		status.ElasticFileSystem = &awsv1alpha1.ElasticFileSystemStatus{ID: fileSystemID}
+	}
+
+	if bucketName := state.Data[infraflow.IdentifierLoadBalancerAccessLogsBucket]; shared.IsValidValue(bucketName) {
+		status.LoadBalancerAccessLogs = &awsv1alpha1.LoadBalancerAccessLogsStatus{BucketName: bucketName}
+	}
+
+	if prefixListID := state.Data[infraflow.IdentifierEgressPrefixList]; shared.IsValidValue(prefixListID) {
+		status.EgressPrefixList = &awsv1alpha1.EgressPrefixListStatus{
+			ID:  prefixListID,
+			ARN: state.Data[infraflow.ARNEgressPrefixList],
+		}
+	}
+
+	if name := helper.GetNodesInstanceProfileName(config); name != "" {
 		status.IAM.InstanceProfiles = []awsv1alpha1.InstanceProfile{
 			{
 				Purpose: awsapi.PurposeNodes,
 				Name:    name,
 			},
 		}
-	}
-	if arn := state.Data[infraflow.ARNIAMRole]; shared.IsValidValue(arn) {
-		status.IAM.Roles = []awsv1alpha1.Role{
-			{
-				Purpose: awsapi.PurposeNodes,
-				ARN:     arn,
-			},
+	} else {
+		if name := state.Data[infraflow.NameIAMInstanceProfile]; shared.IsValidValue(name) {
+			status.IAM.InstanceProfiles = []awsv1alpha1.InstanceProfile{
+				{
+					Purpose: awsapi.PurposeNodes,
+					Name:    name,
+				},
+			}
 		}
+		if arn := state.Data[infraflow.ARNIAMRole]; shared.IsValidValue(arn) {
+			status.IAM.Roles = []awsv1alpha1.Role{
+				{
+					Purpose: awsapi.PurposeNodes,
+					ARN:     arn,
+				},
+			}
+		}
+	}
+	if arn := state.Data[infraflow.ARNVPCFlowLogsIAMRole]; shared.IsValidValue(arn) {
+		status.IAM.Roles = append(status.IAM.Roles, awsv1alpha1.Role{
+			Purpose: awsapi.PurposeVPCFlowLogs,
+			ARN:     arn,
+		})
 	}
 
 	return status, nil
@@ -349,24 +669,28 @@ func ReconcileWithTerraformer(
 		return nil, nil, fmt.Errorf("could not decode provider config: %+v", err)
 	}
 
-	awsClient, err := aws.NewClientFromSecretRef(ctx, c, infrastructure.Spec.SecretRef, infrastructure.Spec.Region)
+	if err := checkRemovedZones(decoder, infrastructure, infrastructureConfig); err != nil {
+		return nil, nil, err
+	}
+
+	awsClient, err := newAWSClient(ctx, c, infrastructure, infrastructureConfig)
 	if err != nil {
-		return nil, nil, util.DetermineError(fmt.Errorf("failed to create new AWS client: %+v", err), helper.KnownCodes)
+		return nil, nil, aws.DetermineError(fmt.Errorf("failed to create new AWS client: %w", err))
 	}
 
 	terraformConfig, err := generateTerraformInfraConfig(ctx, infrastructure, infrastructureConfig, awsClient)
 	if err != nil {
-		return nil, nil, util.DetermineError(fmt.Errorf("failed to generate Terraform config: %+v", err), helper.KnownCodes)
+		return nil, nil, aws.DetermineError(fmt.Errorf("failed to generate Terraform config: %w", err))
 	}
 
 	var mainTF bytes.Buffer
 	if err := tplMainTF.Execute(&mainTF, terraformConfig); err != nil {
-		return nil, nil, util.DetermineError(fmt.Errorf("could not render Terraform template: %+v", err), helper.KnownCodes)
+		return nil, nil, aws.DetermineError(fmt.Errorf("could not render Terraform template: %w", err))
 	}
 
 	tf, err := newTerraformer(logger, restConfig, aws.TerraformerPurposeInfra, infrastructure, disableProjectedTokenMount)
 	if err != nil {
-		return nil, nil, util.DetermineError(fmt.Errorf("could not create terraformer object: %+v", err), helper.KnownCodes)
+		return nil, nil, aws.DetermineError(fmt.Errorf("could not create terraformer object: %w", err))
 	}
 
 	if err := tf.
@@ -382,15 +706,38 @@ func ReconcileWithTerraformer(
 			)).
 		Apply(ctx); err != nil {
 
-		return nil, nil, util.DetermineError(fmt.Errorf("failed to apply the terraform config: %w", err), helper.KnownCodes)
+		return nil, nil, aws.DetermineError(fmt.Errorf("failed to apply the terraform config: %w", err))
 	}
 
 	return computeProviderStatus(ctx, tf, infrastructureConfig)
 }
 
+// checkRemovedZones returns an error if zones of the infrastructure status were removed from the infrastructure config.
+// The Terraformer identifies the resources of the zones by their index, hence it would recreate the resources of all
+// following zones.
+func checkRemovedZones(decoder runtime.Decoder, infrastructure *extensionsv1alpha1.Infrastructure, infrastructureConfig *awsapi.InfrastructureConfig) error {
+	if infrastructure.Status.ProviderStatus == nil || infrastructure.Status.ProviderStatus.Raw == nil {
+		return nil
+	}
+	infrastructureStatus := &awsapi.InfrastructureStatus{}
+	if _, _, err := decoder.Decode(infrastructure.Status.ProviderStatus.Raw, nil, infrastructureStatus); err != nil {
+		return fmt.Errorf("could not decode infrastructure status: %w", err)
+	}
+
+	zones := sets.New[string]()
+	for _, zone := range infrastructureConfig.Networks.Zones {
+		zones.Insert(zone.Name)
+	}
+	for _, subnet := range infrastructureStatus.VPC.Subnets {
+		if !zones.Has(subnet.Zone) {
+			return fmt.Errorf("removing zones (%s) is only supported by the flow infrastructure reconciler (annotation %s=true)", subnet.Zone, awsapi.AnnotationKeyUseFlow)
+		}
+	}
+	return nil
+}
+
 func generateTerraformInfraConfig(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, infrastructureConfig *awsapi.InfrastructureConfig, awsClient awsclient.Interface) (map[string]interface{}, error) {
 	var (
-		dhcpDomainName    = "ec2.internal"
 		createVPC         = true
 		vpcID             = "aws_vpc.vpc.id"
 		vpcCIDR           = ""
@@ -401,22 +748,71 @@ func generateTerraformInfraConfig(ctx context.Context, infrastructure *extension
 		ignoreTagKeyPrefixes []string
 	)
 
-	if infrastructure.Spec.Region != "us-east-1" {
-		dhcpDomainName = fmt.Sprintf("%s.compute.internal", infrastructure.Spec.Region)
+	if helper.GetNATGatewayType(infrastructureConfig) == awsapi.NATGatewayTypeInstance {
+		return nil, fmt.Errorf("NAT instances are only supported by the flow infrastructure reconciler (annotation %s=true)", awsapi.AnnotationKeyUseFlow)
+	}
+
+	if helper.HasZoneOfType(infrastructureConfig, awsapi.ZoneTypeLocalZone) || helper.HasZoneOfType(infrastructureConfig, awsapi.ZoneTypeWavelengthZone) {
+		return nil, fmt.Errorf("zones of type %s or %s are only supported by the flow infrastructure reconciler (annotation %s=true)", awsapi.ZoneTypeLocalZone, awsapi.ZoneTypeWavelengthZone, awsapi.AnnotationKeyUseFlow)
+	}
+
+	if len(infrastructureConfig.Networks.VPCPeerings) > 0 {
+		return nil, fmt.Errorf("VPC peerings are only supported by the flow infrastructure reconciler (annotation %s=true)", awsapi.AnnotationKeyUseFlow)
+	}
+
+	if infrastructureConfig.Networks.NetworkACLs != nil {
+		return nil, fmt.Errorf("network ACLs are only supported by the flow infrastructure reconciler (annotation %s=true)", awsapi.AnnotationKeyUseFlow)
+	}
+
+	if infrastructureConfig.Networks.NATGateway != nil && infrastructureConfig.Networks.NATGateway.ElasticIPPool != nil {
+		return nil, fmt.Errorf("elastic IP pools are only supported by the flow infrastructure reconciler (annotation %s=true)", awsapi.AnnotationKeyUseFlow)
 	}
 
+	if infrastructureConfig.Networks.Route53Resolver != nil {
+		return nil, fmt.Errorf("Route 53 Resolver endpoints and rules are only supported by the flow infrastructure reconciler (annotation %s=true)", awsapi.AnnotationKeyUseFlow)
+	}
+
+	for _, zone := range infrastructureConfig.Networks.Zones {
+		if zone.OutpostARN != nil {
+			return nil, fmt.Errorf("Outposts are only supported by the flow infrastructure reconciler (annotation %s=true)", awsapi.AnnotationKeyUseFlow)
+		}
+		if zone.RouteTableID != nil {
+			return nil, fmt.Errorf("existing route tables are only supported by the flow infrastructure reconciler (annotation %s=true)", awsapi.AnnotationKeyUseFlow)
+		}
+		if zone.SecondaryWorkers != nil {
+			return nil, fmt.Errorf("secondary workers subnets are only supported by the flow infrastructure reconciler (annotation %s=true)", awsapi.AnnotationKeyUseFlow)
+		}
+	}
+
+	if infrastructureConfig.Networks.VPC.MainRouteTableID != nil {
+		return nil, fmt.Errorf("existing route tables are only supported by the flow infrastructure reconciler (annotation %s=true)", awsapi.AnnotationKeyUseFlow)
+	}
+
+	if infrastructureConfig.Networks.VPC.Shared {
+		return nil, fmt.Errorf("shared VPCs are only supported by the flow infrastructure reconciler (annotation %s=true)", awsapi.AnnotationKeyUseFlow)
+	}
+
+	if helper.IsInventoryEnabled(infrastructureConfig) {
+		return nil, fmt.Errorf("the inventory is only supported by the flow infrastructure reconciler (annotation %s=true)", awsapi.AnnotationKeyUseFlow)
+	}
+
+	dhcpConfigurations := helper.GetDHCPConfigurations(infrastructureConfig.Networks.VPC, infrastructure.Spec.Region)
+
 	switch {
 	case infrastructureConfig.Networks.VPC.ID != nil:
 		createVPC = false
 		existingVpcID := *infrastructureConfig.Networks.VPC.ID
-		existingInternetGatewayID, err := awsClient.GetVPCInternetGateway(ctx, existingVpcID)
-		if err != nil {
-			return nil, err
+		existingInternetGatewayID := pointer.StringDeref(infrastructureConfig.Networks.VPC.InternetGatewayID, "")
+		if existingInternetGatewayID == "" {
+			var err error
+			if existingInternetGatewayID, err = awsClient.GetVPCInternetGateway(ctx, existingVpcID); err != nil {
+				return nil, err
+			}
 		}
 		vpcID = strconv.Quote(existingVpcID)
 		internetGatewayID = strconv.Quote(existingInternetGatewayID)
 		// if dual stack is enabled, then we wait for until the target VPC has a ipv6 CIDR assigned.
-		if infrastructureConfig.DualStack != nil && infrastructureConfig.DualStack.Enabled {
+		if helper.IsDualStack(infrastructureConfig) {
 			existingIPv6CidrBlock, err := awsClient.WaitForIPv6Cidr(ctx, existingVpcID)
 			if err != nil {
 				return nil, err
@@ -428,14 +824,27 @@ func generateTerraformInfraConfig(ctx context.Context, infrastructure *extension
 		vpcCIDR = *infrastructureConfig.Networks.VPC.CIDR
 	}
 
+	natGatewayIndices := map[string]int{}
+	for index, zone := range infrastructureConfig.Networks.Zones {
+		natGatewayIndices[zone.Name] = index
+	}
+
 	var zones []map[string]interface{}
 	for _, zone := range infrastructureConfig.Networks.Zones {
+		// the name of the NAT gateway resource used by the private route table of the zone, empty if there is none
+		var natGateway string
+		if natGatewayZoneName := helper.GetNATGatewayZoneName(infrastructureConfig, zone.Name); natGatewayZoneName != "" {
+			natGateway = fmt.Sprintf("natgw_z%d", natGatewayIndices[natGatewayZoneName])
+		}
 		zones = append(zones, map[string]interface{}{
 			"name":                  zone.Name,
 			"worker":                zone.Workers,
 			"public":                zone.Public,
 			"internal":              zone.Internal,
 			"elasticIPAllocationID": zone.ElasticIPAllocationID,
+			"pods":                  pointer.StringDeref(zone.Pods, ""),
+			"createNATGateway":      helper.GetNATGatewayZoneName(infrastructureConfig, zone.Name) == zone.Name,
+			"natGateway":            natGateway,
 		})
 	}
 
@@ -444,49 +853,139 @@ func generateTerraformInfraConfig(ctx context.Context, infrastructure *extension
 		enableECRAccess = *v
 	}
 
-	enableDualStack := false
-	if infrastructureConfig.DualStack != nil {
-		enableDualStack = infrastructureConfig.DualStack.Enabled
-	}
-
 	if tags := infrastructureConfig.IgnoreTags; tags != nil {
 		ignoreTagKeys = tags.Keys
 		ignoreTagKeyPrefixes = tags.KeyPrefixes
 	}
 
+	transitGateway := map[string]interface{}{}
+	if tgw := infrastructureConfig.Networks.TransitGateway; tgw != nil {
+		transitGateway["id"] = tgw.ID
+		transitGateway["routes"] = tgw.Routes
+	}
+
+	var additionalNodeSecurityGroupRules []map[string]interface{}
+	for _, rule := range infrastructureConfig.Networks.AdditionalNodeSecurityGroupRules {
+		additionalNodeSecurityGroupRules = append(additionalNodeSecurityGroupRules, map[string]interface{}{
+			"type":             string(rule.Type),
+			"protocol":         rule.Protocol,
+			"fromPort":         pointer.Int64Deref(rule.FromPort, 0),
+			"toPort":           pointer.Int64Deref(rule.ToPort, 0),
+			"cidrBlocks":       rule.CIDRBlocks,
+			"securityGroupIDs": rule.SecurityGroupIDs,
+		})
+	}
+
+	vpcFlowLogs := map[string]interface{}{}
+	if flowLogs := infrastructureConfig.VPCFlowLogs; flowLogs != nil {
+		vpcFlowLogs["trafficType"] = pointer.StringDeref(flowLogs.TrafficType, "ALL")
+		vpcFlowLogs["maxAggregationInterval"] = pointer.Int64Deref(flowLogs.MaxAggregationInterval, 600)
+		if cloudWatchLogs := flowLogs.Destination.CloudWatchLogs; cloudWatchLogs != nil {
+			vpcFlowLogs["cloudWatchLogs"] = map[string]interface{}{
+				"kmsKeyARN": pointer.StringDeref(cloudWatchLogs.KMSKeyARN, ""),
+			}
+		}
+		if s3 := flowLogs.Destination.S3; s3 != nil {
+			vpcFlowLogs["s3"] = map[string]interface{}{
+				"bucketARN": s3.BucketARN,
+			}
+		}
+	}
+
+	elasticFileSystem := map[string]interface{}{
+		"enabled": infrastructureConfig.ElasticFileSystem != nil && infrastructureConfig.ElasticFileSystem.Enabled,
+	}
+
+	egressPrefixList := map[string]interface{}{
+		"enabled": infrastructureConfig.EgressPrefixList != nil && infrastructureConfig.EgressPrefixList.Enabled,
+	}
+	if egressPrefixList["enabled"] == true {
+		egressPrefixList["includeNodesCIDRs"] = infrastructureConfig.EgressPrefixList.IncludeNodesCIDRs
+		egressPrefixList["maxEntries"] = max(2*len(infrastructureConfig.Networks.Zones), 1)
+	}
+
+	partition := aws.GetPartition(infrastructure.Spec.Region, infrastructureConfig.Endpoints)
+
+	loadBalancerAccessLogs := map[string]interface{}{
+		"enabled": infrastructureConfig.LoadBalancerAccessLogs != nil && infrastructureConfig.LoadBalancerAccessLogs.Enabled,
+	}
+	if loadBalancerAccessLogs["enabled"] == true {
+		accountID, err := awsClient.GetAccountID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		bucketName := awsclient.LoadBalancerAccessLogsBucketName(infrastructure.Namespace, accountID)
+		bucketPolicy, err := awsclient.LoadBalancerAccessLogsBucketPolicy(partition, infrastructure.Spec.Region, bucketName, accountID)
+		if err != nil {
+			return nil, err
+		}
+		loadBalancerAccessLogs["bucketName"] = bucketName
+		loadBalancerAccessLogs["bucketPolicy"] = bucketPolicy
+	}
+
+	endpoints := awsclient.AuthConfig{Endpoints: aws.DefaultEndpoints}
+	aws.ApplyEndpoints(&endpoints, infrastructureConfig.Endpoints)
+
 	terraformInfraConfig := map[string]interface{}{
 		"aws": map[string]interface{}{
-			"region": infrastructure.Spec.Region,
+			"region":                   infrastructure.Spec.Region,
+			"endpoints":                endpoints.Endpoints,
+			"vpcEndpointServicePrefix": awsclient.VPCEndpointServiceNamePrefix(partition, infrastructure.Spec.Region),
+			"ec2ServicePrincipal":      awsclient.ServicePrincipal(partition, awsclient.ServiceEC2),
+			"flowLogsServicePrincipal": awsclient.ServicePrincipal(partition, "vpc-flow-logs"),
 		},
 		"create": map[string]interface{}{
 			"vpc": createVPC,
 		},
 		"enableECRAccess": enableECRAccess,
+		// no IAM resources are created for the nodes if an existing instance profile is used
+		"createNodesIAM": helper.GetNodesInstanceProfileName(infrastructureConfig) == "",
 		"dualStack": map[string]interface{}{
-			"enabled": enableDualStack,
+			"enabled": helper.IsDualStack(infrastructureConfig),
 		},
 		"sshPublicKey": string(infrastructure.Spec.SSHPublicKey),
 		"vpc": map[string]interface{}{
-			"id":                vpcID,
-			"cidr":              vpcCIDR,
-			"dhcpDomainName":    dhcpDomainName,
-			"internetGatewayID": internetGatewayID,
-			"gatewayEndpoints":  infrastructureConfig.Networks.VPC.GatewayEndpoints,
-			"ipv6CidrBlock":     ipv6CidrBlock,
+			"id":                    vpcID,
+			"cidr":                  vpcCIDR,
+			"dhcpDomainName":        dhcpConfigurations["domain-name"][0],
+			"dhcpDomainNameServers": dhcpConfigurations["domain-name-servers"],
+			"dhcpNTPServers":        dhcpConfigurations["ntp-servers"],
+			"internetGatewayID":     internetGatewayID,
+			"gatewayEndpoints":      helper.GetVPCEndpointServices(infrastructureConfig.Networks.VPC, awsapi.VPCEndpointTypeGateway),
+			"interfaceEndpoints":    helper.GetVPCEndpointServices(infrastructureConfig.Networks.VPC, awsapi.VPCEndpointTypeInterface),
+			"secondaryCidrBlocks":   infrastructureConfig.Networks.VPC.SecondaryCidrBlocks,
+			"ipv6CidrBlock":         ipv6CidrBlock,
 		},
-		"clusterName": infrastructure.Namespace,
-		"zones":       zones,
+		"clusterName":                      infrastructure.Namespace,
+		"zones":                            zones,
+		"transitGateway":                   transitGateway,
+		"vpcFlowLogs":                      vpcFlowLogs,
+		"elasticFileSystem":                elasticFileSystem,
+		"loadBalancerAccessLogs":           loadBalancerAccessLogs,
+		"egressPrefixList":                 egressPrefixList,
+		"additionalNodeSecurityGroupRules": additionalNodeSecurityGroupRules,
 		"ignoreTags": map[string]interface{}{
 			"keys":        ignoreTagKeys,
 			"keyPrefixes": ignoreTagKeyPrefixes,
 		},
+		"tags": infrastructureConfig.Tags,
 		"outputKeys": map[string]interface{}{
-			"vpcIdKey":                aws.VPCIDKey,
-			"subnetsPublicPrefix":     aws.SubnetPublicPrefix,
-			"subnetsNodesPrefix":      aws.SubnetNodesPrefix,
-			"securityGroupsNodes":     aws.SecurityGroupsNodes,
-			"iamInstanceProfileNodes": aws.IAMInstanceProfileNodes,
-			"nodesRole":               aws.NodesRole,
+			"vpcIdKey":                         aws.VPCIDKey,
+			"vpcIPv6CidrKey":                   aws.VPCIPv6CidrKey,
+			"subnetsPublicPrefix":              aws.SubnetPublicPrefix,
+			"subnetsNodesPrefix":               aws.SubnetNodesPrefix,
+			"subnetsPodsPrefix":                aws.SubnetPodsPrefix,
+			"subnetsPublicIPv6Prefix":          aws.SubnetPublicIPv6Prefix,
+			"subnetsNodesIPv6Prefix":           aws.SubnetNodesIPv6Prefix,
+			"vpcEndpointPrefix":                aws.VPCEndpointPrefix,
+			"securityGroupsNodes":              aws.SecurityGroupsNodes,
+			"iamInstanceProfileNodes":          aws.IAMInstanceProfileNodes,
+			"nodesRole":                        aws.NodesRole,
+			"transitGatewayAttachmentID":       aws.TransitGatewayAttachmentID,
+			"elasticFileSystemID":              aws.ElasticFileSystemID,
+			"loadBalancerAccessLogsBucketName": aws.LoadBalancerAccessLogsBucketName,
+			"egressPrefixListID":               aws.EgressPrefixListID,
+			"egressPrefixListARN":              aws.EgressPrefixListARN,
 		},
 	}
 
@@ -500,7 +999,7 @@ func (a *actuator) updateProviderStatusTf(ctx context.Context, c client.Client,
 	var stateBytes []byte
 	if state != nil {
 		var err error
-		stateBytes, err = state.Marshal()
+		stateBytes, err = marshalTerraformState(state, aws.TerraformStateCompressionThreshold())
 		if err != nil {
 			return err
 		}
@@ -532,18 +1031,54 @@ func computeProviderStatus(ctx context.Context, tf terraformer.Terraformer, infr
 
 	outputVarKeys := []string{
 		aws.VPCIDKey,
-		aws.IAMInstanceProfileNodes,
-		aws.NodesRole,
 		aws.SecurityGroupsNodes,
 	}
 
+	nodesInstanceProfileName := helper.GetNodesInstanceProfileName(infrastructureConfig)
+	if nodesInstanceProfileName == "" {
+		outputVarKeys = append(outputVarKeys, aws.IAMInstanceProfileNodes, aws.NodesRole)
+	}
+
 	if _, err := tf.GetStateOutputVariables(ctx, aws.SSHKeyName); err == nil {
 		outputVarKeys = append(outputVarKeys, aws.SSHKeyName)
 	}
 
-	for zoneIndex := range infrastructureConfig.Networks.Zones {
+	if infrastructureConfig.Networks.TransitGateway != nil {
+		outputVarKeys = append(outputVarKeys, aws.TransitGatewayAttachmentID)
+	}
+
+	if infrastructureConfig.ElasticFileSystem != nil && infrastructureConfig.ElasticFileSystem.Enabled {
+		outputVarKeys = append(outputVarKeys, aws.ElasticFileSystemID)
+	}
+
+	if infrastructureConfig.LoadBalancerAccessLogs != nil && infrastructureConfig.LoadBalancerAccessLogs.Enabled {
+		outputVarKeys = append(outputVarKeys, aws.LoadBalancerAccessLogsBucketName)
+	}
+
+	if infrastructureConfig.EgressPrefixList != nil && infrastructureConfig.EgressPrefixList.Enabled {
+		outputVarKeys = append(outputVarKeys, aws.EgressPrefixListID, aws.EgressPrefixListARN)
+	}
+
+	dualStack := helper.IsDualStack(infrastructureConfig)
+	if dualStack {
+		outputVarKeys = append(outputVarKeys, aws.VPCIPv6CidrKey)
+	}
+
+	for zoneIndex, zone := range infrastructureConfig.Networks.Zones {
 		outputVarKeys = append(outputVarKeys, fmt.Sprintf("%s%d", aws.SubnetNodesPrefix, zoneIndex))
 		outputVarKeys = append(outputVarKeys, fmt.Sprintf("%s%d", aws.SubnetPublicPrefix, zoneIndex))
+		if zone.Pods != nil {
+			outputVarKeys = append(outputVarKeys, fmt.Sprintf("%s%d", aws.SubnetPodsPrefix, zoneIndex))
+		}
+		if dualStack {
+			outputVarKeys = append(outputVarKeys, fmt.Sprintf("%s%d", aws.SubnetNodesIPv6Prefix, zoneIndex))
+			outputVarKeys = append(outputVarKeys, fmt.Sprintf("%s%d", aws.SubnetPublicIPv6Prefix, zoneIndex))
+		}
+	}
+
+	endpoints := vpcEndpointsByService(infrastructureConfig)
+	for service := range endpoints {
+		outputVarKeys = append(outputVarKeys, vpcEndpointOutputKey(service))
 	}
 
 	output, err := tf.GetStateOutputVariables(ctx, outputVarKeys...)
@@ -587,6 +1122,50 @@ func computeProviderStatus(ctx context.Context, tf terraformer.Terraformer, infr
 		},
 	}
 
+	if nodesInstanceProfileName != "" {
+		infrastructureStatus.IAM = awsv1alpha1.IAM{
+			InstanceProfiles: []awsv1alpha1.InstanceProfile{
+				{
+					Purpose: awsapi.PurposeNodes,
+					Name:    nodesInstanceProfileName,
+				},
+			},
+		}
+	}
+
+	if attachmentID, ok := output[aws.TransitGatewayAttachmentID]; ok {
+		infrastructureStatus.VPC.TransitGatewayAttachmentID = &attachmentID
+	}
+
+	if fileSystemID, ok := output[aws.ElasticFileSystemID]; ok {
+		infrastructureStatus.ElasticFileSystem = &awsv1alpha1.ElasticFileSystemStatus{ID: fileSystemID}
+	}
+
+	if bucketName, ok := output[aws.LoadBalancerAccessLogsBucketName]; ok {
+		infrastructureStatus.LoadBalancerAccessLogs = &awsv1alpha1.LoadBalancerAccessLogsStatus{BucketName: bucketName}
+	}
+
+	if prefixListID, ok := output[aws.EgressPrefixListID]; ok {
+		infrastructureStatus.EgressPrefixList = &awsv1alpha1.EgressPrefixListStatus{
+			ID:  prefixListID,
+			ARN: output[aws.EgressPrefixListARN],
+		}
+	}
+
+	if ipv6CIDR, ok := output[aws.VPCIPv6CidrKey]; ok && ipv6CIDR != "" {
+		infrastructureStatus.VPC.IPv6CIDR = &ipv6CIDR
+	}
+
+	for _, service := range sets.List(sets.KeySet(endpoints)) {
+		if id, ok := output[vpcEndpointOutputKey(service)]; ok {
+			infrastructureStatus.VPC.Endpoints = append(infrastructureStatus.VPC.Endpoints, awsv1alpha1.VPCEndpointStatus{
+				Service: service,
+				Type:    awsv1alpha1.VPCEndpointType(endpoints[service]),
+				ID:      id,
+			})
+		}
+	}
+
 	if keyName, ok := output[aws.SSHKeyName]; ok {
 		infrastructureStatus.EC2 = awsv1alpha1.EC2{
 			KeyName: keyName,
@@ -600,15 +1179,21 @@ func computeProviderStatusSubnets(infrastructure *awsapi.InfrastructureConfig, v
 	var subnetsToReturn []awsv1alpha1.Subnet
 
 	for key, value := range values {
-		var prefix, purpose string
+		var prefix, ipv6Prefix, purpose string
 		if strings.HasPrefix(key, aws.SubnetPublicPrefix) {
 			prefix = aws.SubnetPublicPrefix
+			ipv6Prefix = aws.SubnetPublicIPv6Prefix
 			purpose = awsapi.PurposePublic
 		}
 		if strings.HasPrefix(key, aws.SubnetNodesPrefix) {
 			prefix = aws.SubnetNodesPrefix
+			ipv6Prefix = aws.SubnetNodesIPv6Prefix
 			purpose = awsv1alpha1.PurposeNodes
 		}
+		if strings.HasPrefix(key, aws.SubnetPodsPrefix) {
+			prefix = aws.SubnetPodsPrefix
+			purpose = awsv1alpha1.PurposePods
+		}
 
 		if len(prefix) == 0 {
 			continue
@@ -618,12 +1203,31 @@ func computeProviderStatusSubnets(infrastructure *awsapi.InfrastructureConfig, v
 		if err != nil {
 			return nil, err
 		}
-		subnetsToReturn = append(subnetsToReturn, awsv1alpha1.Subnet{
+		subnet := awsv1alpha1.Subnet{
 			ID:      value,
 			Purpose: purpose,
 			Zone:    infrastructure.Networks.Zones[zoneID].Name,
-		})
+		}
+		if ipv6CIDR := values[ipv6Prefix+strconv.Itoa(zoneID)]; ipv6Prefix != "" && ipv6CIDR != "" {
+			subnet.IPv6CIDR = &ipv6CIDR
+		}
+		subnetsToReturn = append(subnetsToReturn, subnet)
 	}
 
 	return subnetsToReturn, nil
 }
+
+// vpcEndpointsByService returns the types of all configured VPC endpoints by their service names.
+func vpcEndpointsByService(config *awsapi.InfrastructureConfig) map[string]awsapi.VPCEndpointType {
+	endpoints := map[string]awsapi.VPCEndpointType{}
+	for _, endpointType := range []awsapi.VPCEndpointType{awsapi.VPCEndpointTypeGateway, awsapi.VPCEndpointTypeInterface} {
+		for _, service := range helper.GetVPCEndpointServices(config.Networks.VPC, endpointType) {
+			endpoints[service] = endpointType
+		}
+	}
+	return endpoints
+}
+
+func vpcEndpointOutputKey(service string) string {
+	return aws.VPCEndpointPrefix + strings.ReplaceAll(service, ".", "_")
+}
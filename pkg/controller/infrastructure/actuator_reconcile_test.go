@@ -0,0 +1,276 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	awsinstall "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/install"
+	awsv1alpha1 "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow/shared"
+)
+
+var _ = Describe("ActuatorReconcile", func() {
+	Describe("#computeProviderStatusFromFlowState", func() {
+		var (
+			config *awsapi.InfrastructureConfig
+			state  *infraflow.PersistentState
+		)
+
+		zoneKey := func(zone, identifier string) string {
+			return infraflow.ChildIdZones + shared.Separator + zone + shared.Separator + identifier
+		}
+
+		BeforeEach(func() {
+			config = &awsapi.InfrastructureConfig{
+				Networks: awsapi.Networks{
+					VPC: awsapi.VPC{
+						GatewayEndpoints: []string{"s3"},
+					},
+					Zones: []awsapi.Zone{
+						{Name: "zone-a"},
+						{Name: "zone-b", ElasticIPAllocationID: pointer.String("eipalloc-user")},
+					},
+				},
+			}
+
+			state = infraflow.NewPersistentState()
+			state.Data[infraflow.IdentifierVPC] = "vpc-1"
+			state.Data[infraflow.IdentifierInternetGateway] = "igw-1"
+			state.Data[infraflow.IdentifierMainRouteTable] = "rtb-main"
+			state.Data[infraflow.ChildIdVPCEndpoints+shared.Separator+"s3"] = "vpce-1"
+			state.Data[infraflow.ChildIdPrefixLists+shared.Separator+"s3"] = "pl-1"
+			state.Data[infraflow.ARNVPCFlowLogsIAMRole] = "arn:aws:iam::123456789012:role/flow-logs"
+			state.Data[infraflow.IdentifierEgressPrefixList] = "pl-egress"
+			state.Data[infraflow.ARNEgressPrefixList] = "arn:aws:ec2:eu-west-1:123456789012:prefix-list/pl-egress"
+			state.Data[zoneKey("zone-a", infraflow.IdentifierZoneRouteTable)] = "rtb-a"
+			state.Data[zoneKey("zone-a", infraflow.IdentifierZoneNATGateway)] = "nat-a"
+			state.Data[zoneKey("zone-a", infraflow.IdentifierZoneNATGWElasticIP)] = "eipalloc-a"
+			state.Data[zoneKey("zone-a", infraflow.IdentifierZoneNATGatewayPublicIP)] = "1.2.3.4"
+			state.Data[zoneKey("zone-b", infraflow.IdentifierZoneRouteTable)] = "rtb-b"
+			state.Data[zoneKey("zone-b", infraflow.IdentifierZoneNATGateway)] = "nat-b"
+		})
+
+		It("should report the gateways, route tables, prefix lists and IAM roles", func() {
+			status, err := computeProviderStatusFromFlowState(config, state)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(status.VPC.InternetGatewayID).To(Equal(pointer.String("igw-1")))
+			Expect(status.VPC.EgressOnlyInternetGatewayID).To(BeNil())
+			Expect(status.VPC.RouteTables).To(Equal([]awsv1alpha1.RouteTableStatus{
+				{Purpose: awsapi.PurposePublic, ID: "rtb-main"},
+				{Purpose: awsapi.PurposePrivate, ID: "rtb-a", Zone: pointer.String("zone-a")},
+				{Purpose: awsapi.PurposePrivate, ID: "rtb-b", Zone: pointer.String("zone-b")},
+			}))
+			Expect(status.VPC.NATGateways).To(Equal([]awsv1alpha1.NATGatewayStatus{
+				{Zone: "zone-a", ID: "nat-a", ElasticIPAllocationID: pointer.String("eipalloc-a"), PublicIP: pointer.String("1.2.3.4")},
+				{Zone: "zone-b", ID: "nat-b", ElasticIPAllocationID: pointer.String("eipalloc-user")},
+			}))
+			Expect(status.VPC.Endpoints).To(Equal([]awsv1alpha1.VPCEndpointStatus{
+				{Service: "s3", Type: awsv1alpha1.VPCEndpointTypeGateway, ID: "vpce-1", PrefixListID: pointer.String("pl-1")},
+			}))
+			Expect(status.IAM.Roles).To(ContainElement(awsv1alpha1.Role{
+				Purpose: awsapi.PurposeVPCFlowLogs,
+				ARN:     "arn:aws:iam::123456789012:role/flow-logs",
+			}))
+		})
+
+		It("should report the egress prefix list", func() {
+			status, err := computeProviderStatusFromFlowState(config, state)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(status.EgressPrefixList).To(Equal(&awsv1alpha1.EgressPrefixListStatus{
+				ID:  "pl-egress",
+				ARN: "arn:aws:ec2:eu-west-1:123456789012:prefix-list/pl-egress",
+			}))
+		})
+
+		It("should report the elastic IP pool", func() {
+			delete(state.Data, zoneKey("zone-a", infraflow.IdentifierZoneNATGWElasticIP))
+			state.Data[zoneKey("zone-a", infraflow.IdentifierZoneNATGWPoolElasticIP)] = "eipalloc-pool-1"
+			state.Data[infraflow.ChildIdElasticIPPool+shared.Separator+"eipalloc-pool-1"] = "1.2.3.4"
+			state.Data[infraflow.ChildIdElasticIPPool+shared.Separator+"eipalloc-pool-2"] = "5.6.7.8"
+
+			status, err := computeProviderStatusFromFlowState(config, state)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(status.VPC.ElasticIPPool).To(Equal([]awsv1alpha1.ElasticIPPoolAddress{
+				{AllocationID: "eipalloc-pool-1", PublicIP: "1.2.3.4", Zone: pointer.String("zone-a")},
+				{AllocationID: "eipalloc-pool-2", PublicIP: "5.6.7.8"},
+			}))
+			Expect(status.VPC.NATGateways[0].ElasticIPAllocationID).To(Equal(pointer.String("eipalloc-pool-1")))
+		})
+
+		It("should not report zones without NAT gateway", func() {
+			delete(state.Data, zoneKey("zone-a", infraflow.IdentifierZoneNATGateway))
+
+			status, err := computeProviderStatusFromFlowState(config, state)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(status.VPC.NATGateways).To(HaveLen(1))
+			Expect(status.VPC.NATGateways[0].Zone).To(Equal("zone-b"))
+		})
+	})
+	Describe("#checkRemovedZones", func() {
+		var (
+			decoder        runtime.Decoder
+			infrastructure *extensionsv1alpha1.Infrastructure
+			config         *awsapi.InfrastructureConfig
+		)
+
+		BeforeEach(func() {
+			scheme := runtime.NewScheme()
+			Expect(awsinstall.AddToScheme(scheme)).To(Succeed())
+			decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
+
+			status, err := json.Marshal(&awsv1alpha1.InfrastructureStatus{
+				TypeMeta: metav1.TypeMeta{APIVersion: awsv1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureStatus"},
+				VPC: awsv1alpha1.VPCStatus{
+					Subnets: []awsv1alpha1.Subnet{
+						{Purpose: awsapi.PurposeNodes, ID: "subnet-1", Zone: "zone-a"},
+						{Purpose: awsapi.PurposeNodes, ID: "subnet-2", Zone: "zone-b"},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			infrastructure = &extensionsv1alpha1.Infrastructure{
+				Status: extensionsv1alpha1.InfrastructureStatus{
+					DefaultStatus: extensionsv1alpha1.DefaultStatus{ProviderStatus: &runtime.RawExtension{Raw: status}},
+				},
+			}
+			config = &awsapi.InfrastructureConfig{
+				Networks: awsapi.Networks{
+					Zones: []awsapi.Zone{{Name: "zone-a"}, {Name: "zone-b"}, {Name: "zone-c"}},
+				},
+			}
+		})
+
+		It("should allow adding zones", func() {
+			Expect(checkRemovedZones(decoder, infrastructure, config)).To(Succeed())
+		})
+
+		It("should allow infrastructures without status", func() {
+			infrastructure.Status.ProviderStatus = nil
+			config.Networks.Zones = config.Networks.Zones[2:]
+
+			Expect(checkRemovedZones(decoder, infrastructure, config)).To(Succeed())
+		})
+
+		It("should refuse removing zones", func() {
+			config.Networks.Zones = config.Networks.Zones[1:]
+
+			Expect(checkRemovedZones(decoder, infrastructure, config)).To(MatchError(ContainSubstring("removing zones (zone-a) is only supported by the flow infrastructure reconciler")))
+		})
+	})
+
+	Describe("FlowMigration condition", func() {
+		var (
+			ctx = context.TODO()
+			log = logr.Discard()
+
+			c              client.Client
+			a              *actuator
+			infrastructure *extensionsv1alpha1.Infrastructure
+		)
+
+		BeforeEach(func() {
+			scheme := runtime.NewScheme()
+			Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+			Expect(awsinstall.AddToScheme(scheme)).To(Succeed())
+
+			config, err := json.Marshal(&awsv1alpha1.InfrastructureConfig{
+				TypeMeta: metav1.TypeMeta{APIVersion: awsv1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureConfig"},
+				Networks: awsv1alpha1.Networks{
+					Zones: []awsv1alpha1.Zone{{Name: "zone-a"}},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			infrastructure = &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "infrastructure", Namespace: "shoot--foo--bar"},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{ProviderConfig: &runtime.RawExtension{Raw: config}},
+					SecretRef:   corev1.SecretReference{Name: "cloudprovider", Namespace: "shoot--foo--bar"},
+				},
+				Status: extensionsv1alpha1.InfrastructureStatus{
+					DefaultStatus: extensionsv1alpha1.DefaultStatus{State: newTerraformRawState(map[string]string{"aws_subnet.nodes_z0": "subnet-1"})},
+				},
+			}
+
+			c = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(infrastructure).WithStatusSubresource(infrastructure).Build()
+			a = &actuator{
+				client:  c,
+				decoder: serializer.NewCodecFactory(scheme).UniversalDecoder(),
+			}
+		})
+
+		getCondition := func() *gardencorev1beta1.Condition {
+			current := &extensionsv1alpha1.Infrastructure{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(infrastructure), current)).To(Succeed())
+			return v1beta1helper.GetCondition(current.Status.Conditions, ConditionTypeFlowMigration)
+		}
+
+		It("should report the migration in progress after migrating the terraform state", func() {
+			state, err := a.migrateFromTerraformerState(ctx, log, infrastructure)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state.MigratedFromTerraform()).To(BeTrue())
+
+			condition := getCondition()
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionProgressing))
+			Expect(condition.Reason).To(Equal(ReasonMigrationInProgress))
+			Expect(condition.Message).To(Equal("Terraform state with 4 resources migrated, waiting for the first reconciliation with flow."))
+		})
+
+		It("should report the failed migration of the terraform state", func() {
+			infrastructure.Status.State = &runtime.RawExtension{Raw: []byte(`{"data":1}`)}
+
+			_, err := a.migrateFromTerraformerState(ctx, log, infrastructure)
+			Expect(err).To(MatchError(ContainSubstring("migration from terraform state failed")))
+
+			condition := getCondition()
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(ReasonMigrationFailed))
+		})
+
+		It("should report a failed dry run without failing the reconciliation", func() {
+			// the cloudprovider secret doesn't exist, hence no AWS client can be created
+			Expect(a.dryRunFlowMigration(ctx, log, infrastructure)).To(Succeed())
+
+			condition := getCondition()
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(ReasonMigrationDryRunFailed))
+			Expect(condition.Message).To(HavePrefix("failed to create new AWS client: "))
+		})
+	})
+})
@@ -19,34 +19,38 @@ import (
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/terraformer"
-	"github.com/gardener/gardener/extensions/pkg/util"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
 
-	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
 )
 
 // Restore takes the infrastructure state and deploys it as terraform state ConfigMap before calling the terraformer
 func (a *actuator) Restore(ctx context.Context, log logr.Logger, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	progress := a.newProgressReporter(log, infrastructure)
 	flowState, err := a.getStateFromInfraStatus(infrastructure)
 	if err != nil {
 		return err
 	}
 	if flowState != nil {
-		return a.reconcileWithFlow(ctx, log, infrastructure, flowState)
+		return a.reconcileWithFlow(ctx, log, infrastructure, flowState, progress)
 	}
 	if a.shouldUseFlow(infrastructure, cluster) {
 		flowState, err = a.migrateFromTerraformerState(ctx, log, infrastructure)
 		if err != nil {
-			return util.DetermineError(err, helper.KnownCodes)
+			return aws.DetermineError(err)
 		}
-		return a.reconcileWithFlow(ctx, log, infrastructure, flowState)
+		return a.reconcileWithFlow(ctx, log, infrastructure, flowState, progress)
 	}
 	return a.restoreWithTerraformer(ctx, log, infrastructure)
 }
 
 func (a *actuator) restoreWithTerraformer(ctx context.Context, log logr.Logger, infrastructure *extensionsv1alpha1.Infrastructure) error {
-	terraformState, err := terraformer.UnmarshalRawState(infrastructure.Status.State)
+	var raw []byte
+	if infrastructure.Status.State != nil {
+		raw = infrastructure.Status.State.Raw
+	}
+	terraformState, err := unmarshalTerraformState(raw)
 	if err != nil {
 		return err
 	}
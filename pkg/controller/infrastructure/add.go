@@ -16,6 +16,7 @@ package infrastructure
 
 import (
 	"context"
+	"time"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/infrastructure"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -31,6 +32,10 @@ var (
 	DefaultAddOptions = AddOptions{}
 )
 
+// validationCacheTTL is the TTL of the results of read-only AWS queries of the ConfigValidator. It is short as the
+// validated resources (e.g. the attributes of an existing VPC) may be fixed by the user after a failed validation.
+const validationCacheTTL = 1 * time.Minute
+
 // AddOptions are options to apply when adding the AWS infrastructure controller to the manager.
 type AddOptions struct {
 	// Controller are the controller.Options.
@@ -47,7 +52,7 @@ type AddOptions struct {
 func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddOptions) error {
 	return infrastructure.Add(ctx, mgr, infrastructure.AddArgs{
 		Actuator:          NewActuator(mgr, opts.DisableProjectedTokenMount),
-		ConfigValidator:   NewConfigValidator(mgr, awsclient.FactoryFunc(awsclient.NewInterface), log.Log),
+		ConfigValidator:   NewConfigValidator(mgr, awsclient.NewControllerFactory(awsclient.NewCachingFactory(awsclient.FactoryFunc(awsclient.NewInterface), validationCacheTTL), infrastructure.ControllerName), log.Log),
 		ControllerOptions: opts.Controller,
 		Predicates:        infrastructure.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation),
 		Type:              aws.Type,
@@ -17,18 +17,27 @@ package infrastructure
 import (
 	"context"
 	"fmt"
+	"net"
+	"slices"
+	"strings"
 
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/infrastructure"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
 	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow"
 )
 
 // configValidator implements ConfigValidator for aws infrastructure resources.
@@ -66,16 +75,51 @@ func (c *configValidator) Validate(ctx context.Context, infra *extensionsv1alpha
 		allErrs = append(allErrs, field.InternalError(nil, fmt.Errorf("could not get AWS credentials: %+v", err)))
 		return allErrs
 	}
-	awsClient, err := c.awsClientFactory.NewClient(string(credentials.AccessKeyID), string(credentials.SecretAccessKey), infra.Spec.Region)
+	authConfig := aws.NewAuthConfig(credentials, infra.Spec.Region)
+	authConfig.Shoot = infra.Namespace
+	aws.ApplyEndpoints(&authConfig, config.Endpoints)
+	awsClient, err := c.awsClientFactory.NewClient(authConfig)
 	if err != nil {
 		allErrs = append(allErrs, field.InternalError(nil, fmt.Errorf("could not create AWS client: %+v", err)))
 		return allErrs
 	}
 
 	// Validate infrastructure config
-	if config.Networks.VPC.ID != nil {
+	if vpcID := config.Networks.VPC.ID; vpcID != nil {
 		logger.Info("Validating infrastructure networks.vpc.id")
-		allErrs = append(allErrs, c.validateVPC(ctx, awsClient, *config.Networks.VPC.ID, infra.Spec.Region, field.NewPath("networks", "vpc", "id"), config.DualStack != nil && config.DualStack.Enabled)...)
+		vpc, vpcErrs := c.getVPC(ctx, awsClient, *vpcID, field.NewPath("networks", "vpc", "id"))
+		if vpc != nil {
+			vpcErrs = c.validateVPC(ctx, awsClient, vpc, infra.Spec.Region, field.NewPath("networks", "vpc", "id"), helper.IsDualStack(config), config.Networks.VPC.Shared)
+		}
+		allErrs = append(allErrs, vpcErrs...)
+
+		// The CIDR blocks and routing can only be checked if the VPC exists and is usable.
+		if len(vpcErrs) == 0 {
+			logger.Info("Validating infrastructure networks against the CIDR blocks of the VPC")
+			allErrs = append(allErrs, c.validateVPCCIDRBlocks(ctx, awsClient, vpc, infra.Namespace, config, field.NewPath("networks", "vpc"))...)
+			logger.Info("Validating infrastructure existing internet gateway and route tables")
+			allErrs = append(allErrs, c.validateExistingRouting(ctx, awsClient, config, field.NewPath("networks"))...)
+		}
+	}
+
+	if len(config.Networks.Zones) > 0 {
+		logger.Info("Validating infrastructure networks.zones")
+		allErrs = append(allErrs, c.validateZones(ctx, awsClient, config, field.NewPath("networks"))...)
+	}
+
+	if slices.ContainsFunc(config.Networks.Zones, func(zone awsapi.Zone) bool { return zone.OutpostARN != nil }) {
+		logger.Info("Validating infrastructure networks.zones[].outpostARN")
+		allErrs = append(allErrs, c.validateOutposts(ctx, awsClient, infra.Namespace, config, field.NewPath("networks", "zones"))...)
+	}
+
+	if tgw := config.Networks.TransitGateway; tgw != nil {
+		logger.Info("Validating infrastructure networks.transitGateway.id")
+		allErrs = append(allErrs, c.validateTransitGateway(ctx, awsClient, tgw.ID, field.NewPath("networks", "transitGateway", "id"))...)
+	}
+
+	if config.IAM != nil && config.IAM.NodesInstanceProfile != nil {
+		logger.Info("Validating infrastructure iam.nodesInstanceProfile")
+		allErrs = append(allErrs, c.validateNodesInstanceProfile(ctx, awsClient, infra.Namespace, helper.GetNodesInstanceProfileName(config), field.NewPath("iam", "nodesInstanceProfile"))...)
 	}
 
 	var (
@@ -97,54 +141,547 @@ func (c *configValidator) Validate(ctx context.Context, infra *extensionsv1alpha
 	return allErrs
 }
 
-func (c *configValidator) validateVPC(ctx context.Context, awsClient awsclient.Interface, vpcID, region string, fldPath *field.Path, dualStack bool) field.ErrorList {
+// getVPC fetches the VPC with the given id. The VPC is fetched only once per validation and passed on to all
+// validations which need it.
+func (c *configValidator) getVPC(ctx context.Context, awsClient awsclient.Interface, vpcID string, fldPath *field.Path) (*awsclient.VPC, field.ErrorList) {
+	allErrs := field.ErrorList{}
+
+	vpc, err := awsClient.GetVpc(ctx, vpcID)
+	if err != nil {
+		allErrs = append(allErrs, field.InternalError(fldPath, fmt.Errorf("could not get VPC %s: %w", vpcID, err)))
+		return nil, allErrs
+	}
+	if vpc == nil {
+		allErrs = append(allErrs, field.NotFound(fldPath, vpcID))
+		return nil, allErrs
+	}
+
+	return vpc, allErrs
+}
+
+func (c *configValidator) validateVPC(ctx context.Context, awsClient awsclient.Interface, vpc *awsclient.VPC, region string, fldPath *field.Path, dualStack, shared bool) field.ErrorList {
 	allErrs := field.ErrorList{}
+	vpcID := vpc.VpcId
+
+	// Verify that the enableDnsSupport and enableDnsHostnames VPC attributes are both true
+	if !vpc.EnableDnsSupport {
+		allErrs = append(allErrs, field.Invalid(fldPath, vpcID, "VPC attribute enableDnsSupport must be set to true"))
+	}
+	if !vpc.EnableDnsHostnames {
+		allErrs = append(allErrs, field.Invalid(fldPath, vpcID, "VPC attribute enableDnsHostnames must be set to true"))
+	}
+
+	if dualStack && vpc.IPv6CidrBlock == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath, vpcID, fmt.Sprintf("VPC %s has no ipv6 CIDR", vpcID)))
+		return allErrs
+	}
 
-	// Verify that the VPC exists and the enableDnsSupport and enableDnsHostnames VPC attributes are both true
-	for _, attribute := range []string{"enableDnsSupport", "enableDnsHostnames"} {
-		value, err := awsClient.GetVPCAttribute(ctx, vpcID, attribute)
+	// Verify that there is an internet gateway attached to the VPC. The internet gateway of a shared VPC belongs to the
+	// account owning the VPC and isn't visible for the account of the shoot.
+	if !shared {
+		internetGatewayID, err := awsClient.GetVPCInternetGateway(ctx, vpcID)
 		if err != nil {
-			if awsclient.IsNotFoundError(err) {
-				allErrs = append(allErrs, field.NotFound(fldPath, vpcID))
-			} else {
-				allErrs = append(allErrs, field.InternalError(fldPath, fmt.Errorf("could not get VPC attribute %s for VPC %s: %w", attribute, vpcID, err)))
-			}
+			allErrs = append(allErrs, field.InternalError(fldPath, fmt.Errorf("could not get internet gateway for VPC %s: %w", vpcID, err)))
 			return allErrs
 		}
-		if !value {
-			allErrs = append(allErrs, field.Invalid(fldPath, vpcID, fmt.Sprintf("VPC attribute %s must be set to true", attribute)))
+		if internetGatewayID == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath, vpcID, "no attached internet gateway found"))
+		}
+	}
+
+	// Verify DHCP options
+	dhcpOptions, err := awsClient.GetDHCPOptions(ctx, vpcID)
+	if err != nil {
+		allErrs = append(allErrs, field.InternalError(fldPath, fmt.Errorf("could not get DHCP options for VPC %s: %w", vpcID, err)))
+		return allErrs
+	}
+
+	if domainName, ok := dhcpOptions["domain-name"]; !ok {
+		allErrs = append(allErrs, field.Invalid(fldPath, vpcID, "missing domain-name value in DHCP options used by the VPC"))
+	} else if (region == "us-east-1" && domainName != "ec2.internal") || (region != "us-east-1" && domainName != region+".compute.internal") {
+		allErrs = append(allErrs, field.Invalid(fldPath, vpcID, fmt.Sprintf("invalid domain-name specified in DHCP options used by VPC: %s", domainName)))
+	}
+
+	return allErrs
+}
+
+// validateExistingRouting validates that the referenced internet gateway is attached to the VPC and that the
+// referenced route tables belong to the VPC.
+func (c *configValidator) validateExistingRouting(ctx context.Context, awsClient awsclient.Interface, config *awsapi.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	vpcID := *config.Networks.VPC.ID
+
+	if id := config.Networks.VPC.InternetGatewayID; id != nil {
+		idPath := fldPath.Child("vpc", "internetGatewayID")
+		gw, err := awsClient.GetInternetGateway(ctx, *id)
+		if err != nil {
+			allErrs = append(allErrs, field.InternalError(idPath, fmt.Errorf("could not get internet gateway %s: %w", *id, err)))
+		} else if gw == nil {
+			allErrs = append(allErrs, field.NotFound(idPath, *id))
+		} else if ptr.Deref(gw.VpcId, "") != vpcID {
+			allErrs = append(allErrs, field.Invalid(idPath, *id, fmt.Sprintf("internet gateway is not attached to VPC %s", vpcID)))
+		}
+	}
+
+	validateRouteTable := func(id *string, idPath *field.Path) {
+		if id == nil {
+			return
+		}
+		routeTable, err := awsClient.GetRouteTable(ctx, *id)
+		if err != nil {
+			allErrs = append(allErrs, field.InternalError(idPath, fmt.Errorf("could not get route table %s: %w", *id, err)))
+		} else if routeTable == nil {
+			allErrs = append(allErrs, field.NotFound(idPath, *id))
+		} else if ptr.Deref(routeTable.VpcId, "") != vpcID {
+			allErrs = append(allErrs, field.Invalid(idPath, *id, fmt.Sprintf("route table does not belong to VPC %s", vpcID)))
 		}
 	}
+	validateRouteTable(config.Networks.VPC.MainRouteTableID, fldPath.Child("vpc", "mainRouteTableID"))
+	for i, zone := range config.Networks.Zones {
+		validateRouteTable(zone.RouteTableID, fldPath.Child("zones").Index(i).Child("routeTableID"))
+	}
+
+	return allErrs
+}
+
+// validateVPCCIDRBlocks validates the secondary CIDR blocks, the networks of the shoot and the subnets of the zones
+// against the CIDR blocks and subnets which already exist in the VPC.
+func (c *configValidator) validateVPCCIDRBlocks(ctx context.Context, awsClient awsclient.Interface, vpc *awsclient.VPC, namespace string, config *awsapi.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	vpcID := vpc.VpcId
+
+	allErrs = append(allErrs, validateVPCSecondaryCidrBlocks(vpc, config.Networks.VPC.SecondaryCidrBlocks, fldPath.Child("secondaryCidrBlocks"))...)
+
+	shoot, err := extensionscontroller.GetShoot(ctx, c.client, namespace)
+	if err != nil {
+		allErrs = append(allErrs, field.InternalError(nil, fmt.Errorf("could not get shoot: %w", err)))
+		return allErrs
+	}
+	if shoot != nil && shoot.Spec.Networking != nil {
+		allErrs = append(allErrs, validateShootNetworksInVPC(vpc, shoot.Spec.Networking, field.NewPath("networking"))...)
+	}
 
-	if dualStack {
-		_, err := awsClient.GetIPv6Cidr(ctx, vpcID)
+	subnets, err := awsClient.FindSubnetsByVpcId(ctx, vpcID)
+	if err != nil {
+		allErrs = append(allErrs, field.InternalError(fldPath.Child("id"), fmt.Errorf("could not get subnets of VPC %s: %w", vpcID, err)))
+		return allErrs
+	}
+	if config.Networks.VPC.Shared {
+		accountID, err := awsClient.GetAccountID(ctx)
 		if err != nil {
-			allErrs = append(allErrs, field.Invalid(fldPath, vpcID, fmt.Sprintf("VPC %s has no ipv6 CIDR", vpcID)))
+			allErrs = append(allErrs, field.InternalError(fldPath.Child("shared"), fmt.Errorf("could not get account ID: %w", err)))
 			return allErrs
 		}
+		allErrs = append(allErrs, validateSharedSubnets(vpc, accountID, subnets, config.Networks.Zones, fldPath.Child("shared"), field.NewPath("networks", "zones"))...)
+		return allErrs
+	}
+	vpcCidrBlocks := append(getVPCCidrBlocks(vpc), config.Networks.VPC.SecondaryCidrBlocks...)
+	allErrs = append(allErrs, validateZoneSubnetsInVPC(vpc.VpcId, vpcCidrBlocks, subnets, config.Networks.Zones, fmt.Sprintf(infraflow.TagKeyClusterTemplate, namespace), field.NewPath("networks", "zones"))...)
+
+	return allErrs
+}
+
+// validateSharedSubnets validates that the VPC is owned by another account and that the subnets of the zones exist in
+// the VPC and are owned by the account of the VPC, i.e. that they are shared with the account of the shoot via AWS RAM.
+func validateSharedSubnets(vpc *awsclient.VPC, accountID string, subnets []*awsclient.Subnet, zones []awsapi.Zone, sharedPath, zonesPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if vpc.OwnerId == accountID {
+		allErrs = append(allErrs, field.Invalid(sharedPath, true, fmt.Sprintf("VPC %s is owned by the account of the shoot and not shared", vpc.VpcId)))
+		return allErrs
+	}
+
+	for i, zone := range zones {
+		for _, purpose := range []struct {
+			name string
+			cidr string
+		}{
+			{"internal", zone.Internal},
+			{"public", zone.Public},
+			{"workers", zone.Workers},
+		} {
+			purposePath := zonesPath.Index(i).Child(purpose.name)
+			idx := slices.IndexFunc(subnets, func(subnet *awsclient.Subnet) bool { return subnet.CidrBlock == purpose.cidr })
+			if idx < 0 {
+				allErrs = append(allErrs, field.Invalid(purposePath, purpose.cidr, fmt.Sprintf("no subnet with this CIDR block is shared with the account of the shoot in VPC %s", vpc.VpcId)))
+				continue
+			}
+			subnet := subnets[idx]
+			if subnet.AvailabilityZone != zone.Name {
+				allErrs = append(allErrs, field.Invalid(purposePath, purpose.cidr, fmt.Sprintf("shared subnet %s is located in zone %s", subnet.SubnetId, subnet.AvailabilityZone)))
+			} else if subnet.OwnerId != vpc.OwnerId {
+				allErrs = append(allErrs, field.Invalid(purposePath, purpose.cidr, fmt.Sprintf("subnet %s is not owned by account %s of VPC %s", subnet.SubnetId, vpc.OwnerId, vpc.VpcId)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateZoneSubnetsInVPC validates that the subnet CIDRs of the zones are contained in the given CIDR blocks of the
+// VPC and don't collide with existing subnets which were not created for the shoot.
+func validateZoneSubnetsInVPC(vpcID string, vpcCidrBlocks []string, subnets []*awsclient.Subnet, zones []awsapi.Zone, tagKeyCluster string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var foreignSubnets []*awsclient.Subnet
+	for _, subnet := range subnets {
+		if _, ok := subnet.Tags[tagKeyCluster]; !ok {
+			foreignSubnets = append(foreignSubnets, subnet)
+		}
+	}
+
+	for i, zone := range zones {
+		zonePath := fldPath.Index(i)
+		zoneCIDRs := map[string]string{
+			"internal": zone.Internal,
+			"public":   zone.Public,
+			"workers":  zone.Workers,
+		}
+		if zone.Pods != nil {
+			zoneCIDRs["pods"] = *zone.Pods
+		}
+		if zone.SecondaryWorkers != nil {
+			zoneCIDRs["secondaryWorkers"] = *zone.SecondaryWorkers
+		}
+
+		for _, purpose := range []string{"internal", "public", "workers", "pods", "secondaryWorkers"} {
+			cidr, ok := zoneCIDRs[purpose]
+			if !ok || cidr == "" {
+				continue
+			}
+
+			contained := false
+			for _, cidrBlock := range vpcCidrBlocks {
+				if cidrContains(cidrBlock, cidr) {
+					contained = true
+					break
+				}
+			}
+			if !contained {
+				allErrs = append(allErrs, field.Invalid(zonePath.Child(purpose), cidr, fmt.Sprintf("must be a subset of one of the CIDR blocks of VPC %s (%s)", vpcID, strings.Join(vpcCidrBlocks, ", "))))
+				continue
+			}
+
+			for _, subnet := range foreignSubnets {
+				if cidrsOverlap(subnet.CidrBlock, cidr) {
+					allErrs = append(allErrs, field.Invalid(zonePath.Child(purpose), cidr, fmt.Sprintf("must not overlap with CIDR block %s of existing subnet %s in VPC %s", subnet.CidrBlock, subnet.SubnetId, vpcID)))
+					break
+				}
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateVPCSecondaryCidrBlocks validates that the given secondary CIDR blocks don't overlap with any CIDR block
+// which is already associated with the VPC. Blocks which are associated already are skipped.
+func validateVPCSecondaryCidrBlocks(vpc *awsclient.VPC, cidrBlocks []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	associated := getVPCCidrBlocks(vpc)
+
+	for i, cidrBlock := range cidrBlocks {
+		for _, associatedCidrBlock := range associated {
+			if associatedCidrBlock == cidrBlock {
+				// already associated, e.g. by a previous reconciliation
+				break
+			}
+			if cidrsOverlap(associatedCidrBlock, cidrBlock) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Index(i), cidrBlock, fmt.Sprintf("must not overlap with CIDR block %s associated with VPC %s", associatedCidrBlock, vpc.VpcId)))
+				break
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateShootNetworksInVPC validates that the nodes network of the shoot is contained in one of the CIDR blocks of
+// the VPC and that the pods and services networks don't overlap with them.
+func validateShootNetworksInVPC(vpc *awsclient.VPC, networking *gardencorev1beta1.Networking, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	vpcCidrBlocks := getVPCCidrBlocks(vpc)
+
+	if nodes := networking.Nodes; nodes != nil {
+		contained := false
+		for _, cidrBlock := range vpcCidrBlocks {
+			if cidrContains(cidrBlock, *nodes) {
+				contained = true
+				break
+			}
+		}
+		if !contained {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("nodes"), *nodes, fmt.Sprintf("must be a subset of one of the CIDR blocks of VPC %s (%s)", vpc.VpcId, strings.Join(vpcCidrBlocks, ", "))))
+		}
+	}
+
+	// Pods may be assigned addresses from secondary CIDR blocks (e.g. by the AWS VPC CNI), hence only the primary
+	// CIDR block is checked for them.
+	if pods := networking.Pods; pods != nil && cidrsOverlap(vpc.CidrBlock, *pods) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("pods"), *pods, fmt.Sprintf("must not overlap with CIDR block %s of VPC %s", vpc.CidrBlock, vpc.VpcId)))
+	}
+
+	if services := networking.Services; services != nil {
+		for _, cidrBlock := range vpcCidrBlocks {
+			if cidrsOverlap(cidrBlock, *services) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("services"), *services, fmt.Sprintf("must not overlap with CIDR block %s of VPC %s", cidrBlock, vpc.VpcId)))
+				break
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// getVPCCidrBlocks returns the primary CIDR block followed by the associated secondary CIDR blocks of the VPC.
+func getVPCCidrBlocks(vpc *awsclient.VPC) []string {
+	cidrBlocks := []string{vpc.CidrBlock}
+	for _, assoc := range vpc.SecondaryCidrBlocks {
+		cidrBlocks = append(cidrBlocks, assoc.CidrBlock)
+	}
+	return cidrBlocks
+}
+
+// cidrsOverlap returns true if both CIDRs can be parsed and overlap.
+func cidrsOverlap(a, b string) bool {
+	_, netA, errA := net.ParseCIDR(a)
+	_, netB, errB := net.ParseCIDR(b)
+	if errA != nil || errB != nil {
+		return false
+	}
+	return netA.Contains(netB.IP) || netB.Contains(netA.IP)
+}
+
+// cidrContains returns true if both CIDRs can be parsed and the CIDR b is a subset of the CIDR a.
+func cidrContains(a, b string) bool {
+	_, netA, errA := net.ParseCIDR(a)
+	_, netB, errB := net.ParseCIDR(b)
+	if errA != nil || errB != nil {
+		return false
+	}
+	onesA, _ := netA.Mask.Size()
+	onesB, _ := netB.Mask.Size()
+	return netA.Contains(netB.IP) && onesA <= onesB
+}
+
+// validateZones validates that the zones exist in the region, that they are mapped to the configured zone IDs, that
+// they are of the configured zone types and, if NAT instances are used, that their instance type is offered in the zones
+// of the NAT instances.
+func (c *configValidator) validateZones(ctx context.Context, awsClient awsclient.Interface, config *awsapi.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	availabilityZones, err := awsClient.GetAvailabilityZones(ctx)
+	if err != nil {
+		return append(allErrs, field.InternalError(fldPath.Child("zones"), fmt.Errorf("could not get availability zones: %w", err)))
+	}
+
+	validZones := sets.New(availabilityZones...)
+	for i, zone := range config.Networks.Zones {
+		if !validZones.Has(zone.Name) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("zones").Index(i).Child("name"), zone.Name, sets.List(validZones)))
+		}
+	}
+	if len(allErrs) > 0 {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, c.validateZoneIDs(ctx, awsClient, config, fldPath)...)
+	allErrs = append(allErrs, c.validateZoneTypes(ctx, awsClient, config, fldPath)...)
+	if len(allErrs) > 0 || helper.GetNATGatewayType(config) != awsapi.NATGatewayTypeInstance || config.Networks.NATGateway.Instance == nil {
+		return allErrs
+	}
+
+	instanceType := config.Networks.NATGateway.Instance.InstanceType
+	natZones := sets.New[string]()
+	for _, zone := range config.Networks.Zones {
+		if natZone := helper.GetNATGatewayZoneName(config, zone.Name); natZone != "" {
+			natZones.Insert(natZone)
+		}
+	}
+	for _, zone := range sets.List(natZones) {
+		instanceTypes, err := awsClient.GetOfferedInstanceTypes(ctx, zone)
+		if err != nil {
+			return append(allErrs, field.InternalError(fldPath.Child("natGateway", "instance", "instanceType"), fmt.Errorf("could not get instance types offered in zone %s: %w", zone, err)))
+		}
+		if !instanceTypes.Has(instanceType) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("natGateway", "instance", "instanceType"), instanceType, fmt.Sprintf("instance type is not offered in zone %s", zone)))
+		}
+	}
+
+	return allErrs
+}
+
+// validateZoneIDs validates that the zone names are mapped to the configured zone IDs in the account of the shoot.
+func (c *configValidator) validateZoneIDs(ctx context.Context, awsClient awsclient.Interface, config *awsapi.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !slices.ContainsFunc(config.Networks.Zones, func(zone awsapi.Zone) bool { return zone.ZoneID != nil }) {
+		return allErrs
+	}
+
+	zoneIDs, err := awsClient.GetAvailabilityZoneIDs(ctx)
+	if err != nil {
+		return append(allErrs, field.InternalError(fldPath.Child("zones"), fmt.Errorf("could not get availability zone IDs: %w", err)))
+	}
+
+	for i, zone := range config.Networks.Zones {
+		if zone.ZoneID == nil || zoneIDs[zone.Name] == *zone.ZoneID {
+			continue
+		}
+		detail := fmt.Sprintf("zone %s is mapped to zone ID %s in the account", zone.Name, zoneIDs[zone.Name])
+		for name, id := range zoneIDs {
+			if id == *zone.ZoneID {
+				detail += fmt.Sprintf(", zone ID %s is mapped to zone %s", id, name)
+				break
+			}
+		}
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("zones").Index(i).Child("zoneID"), *zone.ZoneID, detail))
+	}
+
+	return allErrs
+}
+
+// validateZoneTypes validates that the zones are of the configured zone types, i.e. that Local Zones and Wavelength
+// Zones are configured as such.
+func (c *configValidator) validateZoneTypes(ctx context.Context, awsClient awsclient.Interface, config *awsapi.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	zoneTypes, err := awsClient.GetAvailabilityZoneTypes(ctx)
+	if err != nil {
+		return append(allErrs, field.InternalError(fldPath.Child("zones"), fmt.Errorf("could not get availability zone types: %w", err)))
+	}
+
+	for i, zone := range config.Networks.Zones {
+		actual, ok := zoneTypes[zone.Name]
+		if !ok {
+			continue
+		}
+		if zoneType := helper.GetZoneType(config, zone.Name); string(zoneType) != actual {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("zones").Index(i).Child("type"), zoneType, fmt.Sprintf("zone %s is of type %s", zone.Name, actual)))
+		}
+	}
+
+	return allErrs
+}
+
+// validateOutposts validates that the Outposts of the zones exist, that their parent availability zone is the zone and
+// that they support the machine types of the worker pools of the shoot in the zone.
+func (c *configValidator) validateOutposts(ctx context.Context, awsClient awsclient.Interface, namespace string, config *awsapi.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	shoot, err := extensionscontroller.GetShoot(ctx, c.client, namespace)
+	if err != nil {
+		return append(allErrs, field.InternalError(nil, fmt.Errorf("could not get shoot: %w", err)))
+	}
+
+	for i, zone := range config.Networks.Zones {
+		if zone.OutpostARN == nil {
+			continue
+		}
+		outpostPath := fldPath.Index(i).Child("outpostARN")
+
+		outpost, err := awsClient.GetOutpost(ctx, *zone.OutpostARN)
+		if err != nil {
+			allErrs = append(allErrs, field.InternalError(outpostPath, fmt.Errorf("could not get Outpost: %w", err)))
+			continue
+		}
+		if outpost == nil {
+			allErrs = append(allErrs, field.NotFound(outpostPath, *zone.OutpostARN))
+			continue
+		}
+		if outpost.AvailabilityZone != zone.Name {
+			allErrs = append(allErrs, field.Invalid(outpostPath, *zone.OutpostARN, fmt.Sprintf("Outpost is anchored to zone %s", outpost.AvailabilityZone)))
+			continue
+		}
+
+		machineTypes := sets.New[string]()
+		if shoot != nil {
+			for _, worker := range shoot.Spec.Provider.Workers {
+				if slices.Contains(worker.Zones, zone.Name) {
+					machineTypes.Insert(worker.Machine.Type)
+				}
+			}
+		}
+		if machineTypes.Len() == 0 {
+			continue
+		}
+		instanceTypes, err := awsClient.GetOutpostInstanceTypes(ctx, *zone.OutpostARN)
+		if err != nil {
+			allErrs = append(allErrs, field.InternalError(outpostPath, fmt.Errorf("could not get instance types of Outpost: %w", err)))
+			continue
+		}
+		if notSupported := machineTypes.Difference(instanceTypes); notSupported.Len() > 0 {
+			allErrs = append(allErrs, field.Invalid(outpostPath, *zone.OutpostARN, fmt.Sprintf("Outpost does not support the machine types %v of the worker pools in zone %s", sets.List(notSupported), zone.Name)))
+		}
+	}
+
+	return allErrs
+}
+
+// validateTransitGateway validates that the given transit gateway exists and is available.
+func (c *configValidator) validateTransitGateway(ctx context.Context, awsClient awsclient.Interface, transitGatewayID string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	tgw, err := awsClient.GetTransitGateway(ctx, transitGatewayID)
+	if err != nil {
+		allErrs = append(allErrs, field.InternalError(fldPath, fmt.Errorf("could not get transit gateway %s: %w", transitGatewayID, err)))
+		return allErrs
+	}
+	if tgw == nil {
+		allErrs = append(allErrs, field.NotFound(fldPath, transitGatewayID))
+		return allErrs
+	}
+	if tgw.State != string(ec2types.TransitGatewayStateAvailable) {
+		allErrs = append(allErrs, field.Invalid(fldPath, transitGatewayID, fmt.Sprintf("transit gateway must be in state %s, but is %s", ec2types.TransitGatewayStateAvailable, tgw.State)))
+	}
+
+	return allErrs
+}
+
+// nodesRequiredActions are the actions which the role of an existing instance profile for the nodes must allow.
+var nodesRequiredActions = []string{"ec2:DescribeInstances"}
+
+// validateNodesInstanceProfile validates that the given instance profile exists, is not the instance profile which
+// is managed for the nodes otherwise and has a role which allows the actions required by the nodes.
+func (c *configValidator) validateNodesInstanceProfile(ctx context.Context, awsClient awsclient.Interface, namespace, profileName string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if profileName == fmt.Sprintf("%s-nodes", namespace) {
+		allErrs = append(allErrs, field.Invalid(fldPath, profileName, "must not reference the instance profile managed for the nodes of the shoot"))
+		return allErrs
 	}
 
-	// Verify that there is an internet gateway attached to the VPC
-	internetGatewayID, err := awsClient.GetVPCInternetGateway(ctx, vpcID)
+	profile, err := awsClient.GetIAMInstanceProfile(ctx, profileName)
 	if err != nil {
-		allErrs = append(allErrs, field.InternalError(fldPath, fmt.Errorf("could not get internet gateway for VPC %s: %w", vpcID, err)))
+		allErrs = append(allErrs, field.InternalError(fldPath, fmt.Errorf("could not get IAM instance profile %s: %w", profileName, err)))
+		return allErrs
+	}
+	if profile == nil {
+		allErrs = append(allErrs, field.NotFound(fldPath, profileName))
 		return allErrs
 	}
-	if internetGatewayID == "" {
-		allErrs = append(allErrs, field.Invalid(fldPath, vpcID, "no attached internet gateway found"))
+	if profile.RoleName == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath, profileName, "instance profile must have a role"))
+		return allErrs
 	}
 
-	// Verify DHCP options
-	dhcpOptions, err := awsClient.GetDHCPOptions(ctx, vpcID)
+	role, err := awsClient.GetIAMRole(ctx, profile.RoleName)
 	if err != nil {
-		allErrs = append(allErrs, field.InternalError(fldPath, fmt.Errorf("could not get DHCP options for VPC %s: %w", vpcID, err)))
+		allErrs = append(allErrs, field.InternalError(fldPath, fmt.Errorf("could not get IAM role %s: %w", profile.RoleName, err)))
+		return allErrs
+	}
+	if role == nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, profileName, fmt.Sprintf("role %s of instance profile does not exist", profile.RoleName)))
 		return allErrs
 	}
 
-	if domainName, ok := dhcpOptions["domain-name"]; !ok {
-		allErrs = append(allErrs, field.Invalid(fldPath, vpcID, "missing domain-name value in DHCP options used by the VPC"))
-	} else if (region == "us-east-1" && domainName != "ec2.internal") || (region != "us-east-1" && domainName != region+".compute.internal") {
-		allErrs = append(allErrs, field.Invalid(fldPath, vpcID, fmt.Sprintf("invalid domain-name specified in DHCP options used by VPC: %s", domainName)))
+	denied, err := awsClient.GetIAMDeniedActions(ctx, role.ARN, nodesRequiredActions)
+	if err != nil {
+		allErrs = append(allErrs, field.InternalError(fldPath, fmt.Errorf("could not simulate policies of IAM role %s: %w", profile.RoleName, err)))
+		return allErrs
+	}
+	if len(denied) > 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, profileName, fmt.Sprintf("role %s of instance profile must allow the actions %s", profile.RoleName, strings.Join(denied, ", "))))
 	}
 
 	return allErrs
@@ -20,8 +20,9 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/aws/aws-sdk-go/aws/awserr"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/gardener/gardener/extensions/pkg/controller/infrastructure"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
 	mockmanager "github.com/gardener/gardener/pkg/mock/controller-runtime/manager"
@@ -39,11 +40,13 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/pointer"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	apisaws "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 	mockawsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/mock"
 	. "github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure"
 )
@@ -133,6 +136,23 @@ var _ = Describe("ConfigValidator", func() {
 	Describe("#Validate", func() {
 		var (
 			validDHCPOptions map[string]string
+			zoneTypes        map[string]string
+			validVPC         *awsclient.VPC
+			shoot            *gardencorev1beta1.Shoot
+
+			expectGetShoot = func() *gomock.Call {
+				return c.EXPECT().Get(ctx, kutil.Key(namespace), gomock.AssignableToTypeOf(&extensionsv1alpha1.Cluster{})).DoAndReturn(
+					func(_ context.Context, _ client.ObjectKey, obj *extensionsv1alpha1.Cluster, _ ...client.GetOption) error {
+						*obj = extensionsv1alpha1.Cluster{
+							ObjectMeta: metav1.ObjectMeta{Name: namespace},
+							Spec: extensionsv1alpha1.ClusterSpec{
+								Shoot: runtime.RawExtension{Raw: encode(shoot)},
+							},
+						}
+						return nil
+					},
+				)
+			}
 		)
 
 		BeforeEach(func() {
@@ -142,15 +162,43 @@ var _ = Describe("ConfigValidator", func() {
 					return nil
 				},
 			)
-			awsClientFactory.EXPECT().NewClient(accessKeyID, secretAccessKey, region).Return(awsClient, nil)
+			awsClientFactory.EXPECT().NewClient(awsclient.AuthConfig{AccessKeyID: accessKeyID, SecretAccessKey: secretAccessKey, Region: region, Shoot: namespace}).Return(awsClient, nil)
+			awsClient.EXPECT().GetAvailabilityZones(ctx).Return([]string{region + "a", region + "b", region + "c"}, nil).AnyTimes()
+			zoneTypes = map[string]string{
+				region + "a": "availability-zone",
+				region + "b": "availability-zone",
+				region + "c": "availability-zone",
+			}
+			awsClient.EXPECT().GetAvailabilityZoneTypes(ctx).DoAndReturn(func(_ context.Context) (map[string]string, error) {
+				return zoneTypes, nil
+			}).AnyTimes()
 
 			validDHCPOptions = map[string]string{
 				"domain-name": region + ".compute.internal",
 			}
+			validVPC = &awsclient.VPC{
+				VpcId:              vpcID,
+				CidrBlock:          "10.0.0.0/16",
+				EnableDnsSupport:   true,
+				EnableDnsHostnames: true,
+			}
+			shoot = &gardencorev1beta1.Shoot{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: gardencorev1beta1.SchemeGroupVersion.String(),
+					Kind:       "Shoot",
+				},
+				Spec: gardencorev1beta1.ShootSpec{
+					Networking: &gardencorev1beta1.Networking{
+						Nodes:    pointer.String("10.0.0.0/19"),
+						Pods:     pointer.String("10.96.0.0/11"),
+						Services: pointer.String("10.64.0.0/13"),
+					},
+				},
+			}
 		})
 
 		It("should forbid VPC that doesn't exist", func() {
-			awsClient.EXPECT().GetVPCAttribute(ctx, vpcID, "enableDnsSupport").Return(false, awserr.New("InvalidVpcID.NotFound", "", nil))
+			awsClient.EXPECT().GetVpc(ctx, vpcID).Return(nil, nil)
 
 			errorList := cv.Validate(ctx, infra)
 			Expect(errorList).To(ConsistOfFields(Fields{
@@ -160,8 +208,9 @@ var _ = Describe("ConfigValidator", func() {
 		})
 
 		It("should forbid VPC that exists but has wrong attribute values or no attached internet gateway", func() {
-			awsClient.EXPECT().GetVPCAttribute(ctx, vpcID, "enableDnsSupport").Return(false, nil)
-			awsClient.EXPECT().GetVPCAttribute(ctx, vpcID, "enableDnsHostnames").Return(false, nil)
+			validVPC.EnableDnsSupport = false
+			validVPC.EnableDnsHostnames = false
+			awsClient.EXPECT().GetVpc(ctx, vpcID).Return(validVPC, nil)
 			awsClient.EXPECT().GetVPCInternetGateway(ctx, vpcID).Return("", nil)
 			awsClient.EXPECT().GetDHCPOptions(ctx, vpcID).Return(validDHCPOptions, nil)
 
@@ -181,38 +230,58 @@ var _ = Describe("ConfigValidator", func() {
 			}))
 		})
 
+		It("should forbid dual-stack VPC without IPv6 CIDR block", func() {
+			infra.Spec.ProviderConfig.Raw = encode(&apisaws.InfrastructureConfig{
+				Networks: apisaws.Networks{
+					VPC:        apisaws.VPC{ID: pointer.String(vpcID)},
+					IPFamilies: []apisaws.IPFamily{apisaws.IPFamilyIPv4, apisaws.IPFamilyIPv6},
+				},
+			})
+			awsClient.EXPECT().GetVpc(ctx, vpcID).Return(validVPC, nil)
+
+			errorList := cv.Validate(ctx, infra)
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("networks.vpc.id"),
+				"Detail": Equal(fmt.Sprintf("VPC %s has no ipv6 CIDR", vpcID)),
+			}))
+		})
+
 		It("should allow VPC that exists and has correct attribute values and an attached internet gateway", func() {
-			awsClient.EXPECT().GetVPCAttribute(ctx, vpcID, "enableDnsSupport").Return(true, nil)
-			awsClient.EXPECT().GetVPCAttribute(ctx, vpcID, "enableDnsHostnames").Return(true, nil)
+			awsClient.EXPECT().GetVpc(ctx, vpcID).Return(validVPC, nil)
 			awsClient.EXPECT().GetVPCInternetGateway(ctx, vpcID).Return(vpcID, nil)
 			awsClient.EXPECT().GetDHCPOptions(ctx, vpcID).Return(validDHCPOptions, nil)
+			expectGetShoot()
+			awsClient.EXPECT().FindSubnetsByVpcId(ctx, vpcID)
 
 			errorList := cv.Validate(ctx, infra)
 			Expect(errorList).To(BeEmpty())
 		})
 
-		It("should fail with InternalError if getting VPC attributes failed", func() {
-			awsClient.EXPECT().GetVPCAttribute(ctx, vpcID, "enableDnsSupport").Return(false, errors.New("test"))
+		It("should fail with InternalError if getting the VPC failed", func() {
+			awsClient.EXPECT().GetVpc(ctx, vpcID).Return(nil, errors.New("test"))
 
 			errorList := cv.Validate(ctx, infra)
 			Expect(errorList).To(ConsistOfFields(Fields{
 				"Type":   Equal(field.ErrorTypeInternal),
 				"Field":  Equal("networks.vpc.id"),
-				"Detail": Equal(fmt.Sprintf("could not get VPC attribute enableDnsSupport for VPC %s: test", vpcID)),
+				"Detail": Equal(fmt.Sprintf("could not get VPC %s: test", vpcID)),
 			}))
 		})
 
 		DescribeTable("validate DHCP options", func(newRegion string, mapping map[string]string, err error, matcher gomegatypes.GomegaMatcher) {
 			if newRegion != "" {
 				infra.Spec.Region = newRegion
-				awsClientFactory.NewClient(accessKeyID, secretAccessKey, region) //nolint:errcheck
-				awsClientFactory.EXPECT().NewClient(accessKeyID, secretAccessKey, newRegion).Return(awsClient, nil)
+				awsClientFactory.NewClient(awsclient.AuthConfig{AccessKeyID: accessKeyID, SecretAccessKey: secretAccessKey, Region: region, Shoot: namespace}) //nolint:errcheck
+				awsClientFactory.EXPECT().NewClient(awsclient.AuthConfig{AccessKeyID: accessKeyID, SecretAccessKey: secretAccessKey, Region: newRegion, Shoot: namespace}).Return(awsClient, nil)
 			}
 
-			awsClient.EXPECT().GetVPCAttribute(ctx, vpcID, "enableDnsSupport").Return(true, nil)
-			awsClient.EXPECT().GetVPCAttribute(ctx, vpcID, "enableDnsHostnames").Return(true, nil)
+			awsClient.EXPECT().GetVpc(ctx, vpcID).Return(validVPC, nil)
 			awsClient.EXPECT().GetVPCInternetGateway(ctx, vpcID).Return(vpcID, nil)
 			awsClient.EXPECT().GetDHCPOptions(ctx, vpcID).Return(mapping, err)
+			// the CIDR blocks are only validated if the VPC is valid
+			expectGetShoot().MaxTimes(1)
+			awsClient.EXPECT().FindSubnetsByVpcId(ctx, vpcID).MaxTimes(1)
 
 			errorList := cv.Validate(ctx, infra)
 			Expect(errorList).To(matcher)
@@ -242,6 +311,217 @@ var _ = Describe("ConfigValidator", func() {
 			})),
 		)
 
+		Describe("validate zones", func() {
+			var natGateway *apisaws.NATGateway
+
+			BeforeEach(func() {
+				natGateway = &apisaws.NATGateway{
+					Type:     ptr.To(apisaws.NATGatewayTypeInstance),
+					Instance: &apisaws.NATInstance{InstanceType: "t3.small", AMI: "ami-123456"},
+				}
+			})
+
+			encodeZones := func(natGateway *apisaws.NATGateway, zones ...string) []byte {
+				config := &apisaws.InfrastructureConfig{
+					Networks: apisaws.Networks{
+						VPC:        apisaws.VPC{CIDR: pointer.String("10.0.0.0/16")},
+						NATGateway: natGateway,
+					},
+				}
+				for _, zone := range zones {
+					config.Networks.Zones = append(config.Networks.Zones, apisaws.Zone{Name: zone})
+				}
+				return encode(config)
+			}
+
+			It("should forbid zones which don't exist in the region", func() {
+				infra.Spec.ProviderConfig.Raw = encodeZones(nil, region+"a", "eu-central-1a")
+
+				errorList := cv.Validate(ctx, infra)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.zones[1].name"),
+				}))
+			})
+
+			It("should succeed if the NAT instance type is offered in all zones", func() {
+				infra.Spec.ProviderConfig.Raw = encodeZones(natGateway, region+"a", region+"b")
+				awsClient.EXPECT().GetOfferedInstanceTypes(ctx, region+"a").Return(sets.New("t3.small"), nil)
+				awsClient.EXPECT().GetOfferedInstanceTypes(ctx, region+"b").Return(sets.New("t3.small", "m5.large"), nil)
+
+				Expect(cv.Validate(ctx, infra)).To(BeEmpty())
+			})
+
+			It("should forbid a NAT instance type which is not offered in a zone", func() {
+				infra.Spec.ProviderConfig.Raw = encodeZones(natGateway, region+"a", region+"b")
+				awsClient.EXPECT().GetOfferedInstanceTypes(ctx, region+"a").Return(sets.New("t3.small"), nil)
+				awsClient.EXPECT().GetOfferedInstanceTypes(ctx, region+"b").Return(sets.New("m5.large"), nil)
+
+				errorList := cv.Validate(ctx, infra)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.natGateway.instance.instanceType"),
+					"Detail": Equal("instance type is not offered in zone eu-west-1b"),
+				}))
+			})
+
+			It("should only check the zone of the NAT instance in single mode", func() {
+				natGateway.Mode = ptr.To(apisaws.NATGatewayModeSingle)
+				infra.Spec.ProviderConfig.Raw = encodeZones(natGateway, region+"a", region+"b")
+				awsClient.EXPECT().GetOfferedInstanceTypes(ctx, region+"a").Return(sets.New("t3.small"), nil)
+
+				Expect(cv.Validate(ctx, infra)).To(BeEmpty())
+			})
+
+			Context("zone types", func() {
+				BeforeEach(func() {
+					zoneTypes[region+"c"] = "local-zone"
+				})
+
+				It("should succeed if the zones are of the configured types", func() {
+					config := &apisaws.InfrastructureConfig{
+						Networks: apisaws.Networks{
+							VPC: apisaws.VPC{CIDR: pointer.String("10.0.0.0/16")},
+							Zones: []apisaws.Zone{
+								{Name: region + "a"},
+								{Name: region + "c", Type: ptr.To(apisaws.ZoneTypeLocalZone)},
+							},
+						},
+					}
+					infra.Spec.ProviderConfig.Raw = encode(config)
+
+					Expect(cv.Validate(ctx, infra)).To(BeEmpty())
+				})
+
+				It("should forbid zones which are of other types", func() {
+					config := &apisaws.InfrastructureConfig{
+						Networks: apisaws.Networks{
+							VPC: apisaws.VPC{CIDR: pointer.String("10.0.0.0/16")},
+							Zones: []apisaws.Zone{
+								{Name: region + "a", Type: ptr.To(apisaws.ZoneTypeWavelengthZone)},
+								{Name: region + "c"},
+							},
+						},
+					}
+					infra.Spec.ProviderConfig.Raw = encode(config)
+
+					errorList := cv.Validate(ctx, infra)
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("networks.zones[0].type"),
+							"Detail": Equal("zone eu-west-1a is of type availability-zone"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("networks.zones[1].type"),
+							"Detail": Equal("zone eu-west-1c is of type local-zone"),
+						})),
+					))
+				})
+			})
+
+			Context("zone IDs", func() {
+				encodeZoneIDs := func(zoneIDs map[string]string) []byte {
+					config := &apisaws.InfrastructureConfig{
+						Networks: apisaws.Networks{
+							VPC: apisaws.VPC{CIDR: pointer.String("10.0.0.0/16")},
+						},
+					}
+					for _, zone := range []string{region + "a", region + "b"} {
+						config.Networks.Zones = append(config.Networks.Zones, apisaws.Zone{Name: zone, ZoneID: ptr.To(zoneIDs[zone])})
+					}
+					return encode(config)
+				}
+
+				BeforeEach(func() {
+					awsClient.EXPECT().GetAvailabilityZoneIDs(ctx).Return(map[string]string{
+						region + "a": "euw1-az3",
+						region + "b": "euw1-az1",
+						region + "c": "euw1-az2",
+					}, nil)
+				})
+
+				It("should succeed if the zones are mapped to the zone IDs", func() {
+					infra.Spec.ProviderConfig.Raw = encodeZoneIDs(map[string]string{region + "a": "euw1-az3", region + "b": "euw1-az1"})
+
+					Expect(cv.Validate(ctx, infra)).To(BeEmpty())
+				})
+
+				It("should forbid zones which are mapped to other zone IDs", func() {
+					infra.Spec.ProviderConfig.Raw = encodeZoneIDs(map[string]string{region + "a": "euw1-az3", region + "b": "euw1-az2"})
+
+					errorList := cv.Validate(ctx, infra)
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("networks.zones[1].zoneID"),
+						"Detail": Equal("zone eu-west-1b is mapped to zone ID euw1-az1 in the account, zone ID euw1-az2 is mapped to zone eu-west-1c"),
+					}))
+				})
+			})
+		})
+
+		Describe("validate Outposts", func() {
+			const outpostARN = "arn:aws:outposts:eu-west-1:123456789012:outpost/op-0123456789abcdef0"
+
+			BeforeEach(func() {
+				infra.Spec.ProviderConfig.Raw = encode(&apisaws.InfrastructureConfig{
+					Networks: apisaws.Networks{
+						VPC: apisaws.VPC{CIDR: pointer.String("10.0.0.0/16")},
+						Zones: []apisaws.Zone{
+							{Name: region + "a", OutpostARN: pointer.String(outpostARN)},
+							{Name: region + "b"},
+						},
+					},
+				})
+				shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{
+					{Name: "outpost", Zones: []string{region + "a"}, Machine: gardencorev1beta1.Machine{Type: "m5.xlarge"}},
+					{Name: "region", Zones: []string{region + "b"}, Machine: gardencorev1beta1.Machine{Type: "m6i.large"}},
+				}
+				expectGetShoot()
+			})
+
+			It("should succeed if the Outpost supports the machine types of the worker pools in its zone", func() {
+				awsClient.EXPECT().GetOutpost(ctx, outpostARN).Return(&awsclient.Outpost{OutpostArn: outpostARN, AvailabilityZone: region + "a"}, nil)
+				awsClient.EXPECT().GetOutpostInstanceTypes(ctx, outpostARN).Return(sets.New("m5.xlarge", "c5.xlarge"), nil)
+
+				Expect(cv.Validate(ctx, infra)).To(BeEmpty())
+			})
+
+			It("should forbid Outposts which don't exist", func() {
+				awsClient.EXPECT().GetOutpost(ctx, outpostARN).Return(nil, nil)
+
+				errorList := cv.Validate(ctx, infra)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotFound),
+					"Field": Equal("networks.zones[0].outpostARN"),
+				}))
+			})
+
+			It("should forbid Outposts which are anchored to another zone", func() {
+				awsClient.EXPECT().GetOutpost(ctx, outpostARN).Return(&awsclient.Outpost{OutpostArn: outpostARN, AvailabilityZone: region + "c"}, nil)
+
+				errorList := cv.Validate(ctx, infra)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.zones[0].outpostARN"),
+					"Detail": Equal("Outpost is anchored to zone eu-west-1c"),
+				}))
+			})
+
+			It("should forbid Outposts which don't support the machine types of the worker pools", func() {
+				awsClient.EXPECT().GetOutpost(ctx, outpostARN).Return(&awsclient.Outpost{OutpostArn: outpostARN, AvailabilityZone: region + "a"}, nil)
+				awsClient.EXPECT().GetOutpostInstanceTypes(ctx, outpostARN).Return(sets.New("c5.xlarge"), nil)
+
+				errorList := cv.Validate(ctx, infra)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.zones[0].outpostARN"),
+					"Detail": Equal("Outpost does not support the machine types [m5.xlarge] of the worker pools in zone eu-west-1a"),
+				}))
+			})
+		})
+
 		Describe("validate Elastic IP addresses", func() {
 			BeforeEach(func() {
 				infra.Spec.ProviderConfig.Raw = encode(&apisaws.InfrastructureConfig{
@@ -249,12 +529,15 @@ var _ = Describe("ConfigValidator", func() {
 						VPC: apisaws.VPC{},
 						Zones: []apisaws.Zone{
 							{
+								Name:                  region + "a",
 								ElasticIPAllocationID: pointer.String("eipalloc-0e2669d4b46150ee4"),
 							},
 							{
+								Name:                  region + "b",
 								ElasticIPAllocationID: pointer.String("eipalloc-0e2669d4b46150ee5"),
 							},
 							{
+								Name:                  region + "c",
 								ElasticIPAllocationID: pointer.String("eipalloc-0e2669d4b46150ee6"),
 							},
 						},
@@ -356,6 +639,465 @@ var _ = Describe("ConfigValidator", func() {
 				}))
 			})
 		})
+
+		Describe("validate shoot networks against VPC CIDR blocks", func() {
+			BeforeEach(func() {
+				awsClient.EXPECT().GetVPCInternetGateway(ctx, vpcID).Return(vpcID, nil)
+				awsClient.EXPECT().GetDHCPOptions(ctx, vpcID).Return(validDHCPOptions, nil)
+			})
+
+			It("should succeed - nodes network is contained in a secondary CIDR block", func() {
+				validVPC.SecondaryCidrBlocks = []*awsclient.VpcCidrBlockAssociation{
+					{AssociationId: "vpc-cidr-assoc-1", CidrBlock: "10.1.0.0/16"},
+				}
+				shoot.Spec.Networking.Nodes = pointer.String("10.1.0.0/17")
+				awsClient.EXPECT().GetVpc(ctx, vpcID).Return(validVPC, nil)
+				expectGetShoot()
+				awsClient.EXPECT().FindSubnetsByVpcId(ctx, vpcID)
+
+				errorList := cv.Validate(ctx, infra)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should fail - nodes network is not contained in the VPC and pods and services networks overlap", func() {
+				shoot.Spec.Networking.Nodes = pointer.String("10.0.0.0/15")
+				shoot.Spec.Networking.Pods = pointer.String("10.0.128.0/17")
+				shoot.Spec.Networking.Services = pointer.String("10.0.0.0/8")
+				awsClient.EXPECT().GetVpc(ctx, vpcID).Return(validVPC, nil)
+				expectGetShoot()
+				awsClient.EXPECT().FindSubnetsByVpcId(ctx, vpcID)
+
+				errorList := cv.Validate(ctx, infra)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networking.nodes"),
+					"Detail": Equal("must be a subset of one of the CIDR blocks of VPC " + vpcID + " (10.0.0.0/16)"),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networking.pods"),
+					"Detail": Equal("must not overlap with CIDR block 10.0.0.0/16 of VPC " + vpcID),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networking.services"),
+					"Detail": Equal("must not overlap with CIDR block 10.0.0.0/16 of VPC " + vpcID),
+				}))
+			})
+
+			It("should fail with InternalError if getting the shoot failed", func() {
+				awsClient.EXPECT().GetVpc(ctx, vpcID).Return(validVPC, nil)
+				c.EXPECT().Get(ctx, kutil.Key(namespace), gomock.AssignableToTypeOf(&extensionsv1alpha1.Cluster{})).Return(errors.New("test"))
+
+				errorList := cv.Validate(ctx, infra)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInternal),
+					"Detail": Equal("could not get shoot: test"),
+				}))
+			})
+		})
+
+		Describe("validate existing internet gateway and route tables", func() {
+			BeforeEach(func() {
+				infra.Spec.ProviderConfig.Raw = encode(&apisaws.InfrastructureConfig{
+					Networks: apisaws.Networks{
+						VPC: apisaws.VPC{
+							ID:                pointer.String(vpcID),
+							InternetGatewayID: pointer.String("igw-1"),
+							MainRouteTableID:  pointer.String("rtb-main"),
+						},
+						Zones: []apisaws.Zone{
+							{
+								Name:         "eu-west-1a",
+								Internal:     "10.0.16.0/22",
+								Public:       "10.0.20.0/22",
+								Workers:      "10.0.0.0/20",
+								RouteTableID: pointer.String("rtb-zone"),
+							},
+						},
+					},
+				})
+
+				awsClient.EXPECT().GetVPCInternetGateway(ctx, vpcID).Return(vpcID, nil)
+				awsClient.EXPECT().GetDHCPOptions(ctx, vpcID).Return(validDHCPOptions, nil)
+				awsClient.EXPECT().GetVpc(ctx, vpcID).Return(validVPC, nil)
+				expectGetShoot()
+				awsClient.EXPECT().FindSubnetsByVpcId(ctx, vpcID)
+			})
+
+			It("should succeed - internet gateway and route tables belong to the VPC", func() {
+				awsClient.EXPECT().GetInternetGateway(ctx, "igw-1").Return(&awsclient.InternetGateway{InternetGatewayId: "igw-1", VpcId: pointer.String(vpcID)}, nil)
+				awsClient.EXPECT().GetRouteTable(ctx, "rtb-main").Return(&awsclient.RouteTable{RouteTableId: "rtb-main", VpcId: pointer.String(vpcID)}, nil)
+				awsClient.EXPECT().GetRouteTable(ctx, "rtb-zone").Return(&awsclient.RouteTable{RouteTableId: "rtb-zone", VpcId: pointer.String(vpcID)}, nil)
+
+				errorList := cv.Validate(ctx, infra)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should fail - internet gateway is not attached and route tables don't belong to the VPC", func() {
+				awsClient.EXPECT().GetInternetGateway(ctx, "igw-1").Return(&awsclient.InternetGateway{InternetGatewayId: "igw-1"}, nil)
+				awsClient.EXPECT().GetRouteTable(ctx, "rtb-main").Return(nil, nil)
+				awsClient.EXPECT().GetRouteTable(ctx, "rtb-zone").Return(&awsclient.RouteTable{RouteTableId: "rtb-zone", VpcId: pointer.String("vpc-other")}, nil)
+
+				errorList := cv.Validate(ctx, infra)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.vpc.internetGatewayID"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeNotFound),
+					"Field": Equal("networks.vpc.mainRouteTableID"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[0].routeTableID"),
+				}))
+			})
+		})
+
+		Describe("validate zone subnets against existing subnets", func() {
+			BeforeEach(func() {
+				infra.Spec.ProviderConfig.Raw = encode(&apisaws.InfrastructureConfig{
+					Networks: apisaws.Networks{
+						VPC: apisaws.VPC{
+							ID: pointer.String(vpcID),
+						},
+						Zones: []apisaws.Zone{
+							{
+								Name:     "eu-west-1a",
+								Internal: "10.0.16.0/22",
+								Public:   "10.0.20.0/22",
+								Workers:  "10.0.0.0/20",
+							},
+						},
+					},
+				})
+
+				awsClient.EXPECT().GetVPCInternetGateway(ctx, vpcID).Return(vpcID, nil)
+				awsClient.EXPECT().GetDHCPOptions(ctx, vpcID).Return(validDHCPOptions, nil)
+				awsClient.EXPECT().GetVpc(ctx, vpcID).Return(validVPC, nil)
+				expectGetShoot()
+			})
+
+			It("should succeed - subnets overlap only with subnets of the shoot", func() {
+				awsClient.EXPECT().FindSubnetsByVpcId(ctx, vpcID).Return([]*awsclient.Subnet{
+					{SubnetId: "subnet-1", CidrBlock: "10.0.0.0/20", Tags: awsclient.Tags{"kubernetes.io/cluster/" + namespace: "1"}},
+					{SubnetId: "subnet-2", CidrBlock: "10.0.128.0/20"},
+				}, nil)
+
+				errorList := cv.Validate(ctx, infra)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should fail - subnets overlap with foreign subnets", func() {
+				awsClient.EXPECT().FindSubnetsByVpcId(ctx, vpcID).Return([]*awsclient.Subnet{
+					{SubnetId: "subnet-1", CidrBlock: "10.0.0.0/24"},
+					{SubnetId: "subnet-2", CidrBlock: "10.0.20.0/24", Tags: awsclient.Tags{"kubernetes.io/cluster/other": "1"}},
+				}, nil)
+
+				errorList := cv.Validate(ctx, infra)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.zones[0].workers"),
+					"Detail": Equal("must not overlap with CIDR block 10.0.0.0/24 of existing subnet subnet-1 in VPC " + vpcID),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.zones[0].public"),
+					"Detail": Equal("must not overlap with CIDR block 10.0.20.0/24 of existing subnet subnet-2 in VPC " + vpcID),
+				}))
+			})
+
+			It("should fail - subnets are not contained in the VPC", func() {
+				infra.Spec.ProviderConfig.Raw = encode(&apisaws.InfrastructureConfig{
+					Networks: apisaws.Networks{
+						VPC: apisaws.VPC{
+							ID: pointer.String(vpcID),
+						},
+						Zones: []apisaws.Zone{
+							{
+								Name:     "eu-west-1a",
+								Internal: "10.1.16.0/22",
+								Public:   "10.0.20.0/22",
+								Workers:  "10.0.0.0/20",
+							},
+						},
+					},
+				})
+				awsClient.EXPECT().FindSubnetsByVpcId(ctx, vpcID)
+
+				errorList := cv.Validate(ctx, infra)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.zones[0].internal"),
+					"Detail": Equal("must be a subset of one of the CIDR blocks of VPC " + vpcID + " (10.0.0.0/16)"),
+				}))
+			})
+
+			It("should fail with InternalError if getting the subnets failed", func() {
+				awsClient.EXPECT().FindSubnetsByVpcId(ctx, vpcID).Return(nil, errors.New("test"))
+
+				errorList := cv.Validate(ctx, infra)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInternal),
+					"Field":  Equal("networks.vpc.id"),
+					"Detail": Equal(fmt.Sprintf("could not get subnets of VPC %s: test", vpcID)),
+				}))
+			})
+		})
+
+		Describe("validate shared VPC", func() {
+			BeforeEach(func() {
+				infra.Spec.ProviderConfig.Raw = encode(&apisaws.InfrastructureConfig{
+					Networks: apisaws.Networks{
+						VPC: apisaws.VPC{
+							ID:     pointer.String(vpcID),
+							Shared: true,
+						},
+						Zones: []apisaws.Zone{
+							{
+								Name:     "eu-west-1a",
+								Internal: "10.0.16.0/22",
+								Public:   "10.0.20.0/22",
+								Workers:  "10.0.0.0/20",
+							},
+						},
+					},
+				})
+				validVPC.OwnerId = "111111111111"
+
+				awsClient.EXPECT().GetDHCPOptions(ctx, vpcID).Return(validDHCPOptions, nil)
+				awsClient.EXPECT().GetVpc(ctx, vpcID).Return(validVPC, nil)
+				expectGetShoot()
+			})
+
+			It("should succeed - subnets of the zones are shared by the owner of the VPC", func() {
+				awsClient.EXPECT().GetAccountID(ctx).Return("222222222222", nil)
+				awsClient.EXPECT().FindSubnetsByVpcId(ctx, vpcID).Return([]*awsclient.Subnet{
+					{SubnetId: "subnet-1", CidrBlock: "10.0.0.0/20", AvailabilityZone: "eu-west-1a", OwnerId: "111111111111"},
+					{SubnetId: "subnet-2", CidrBlock: "10.0.16.0/22", AvailabilityZone: "eu-west-1a", OwnerId: "111111111111"},
+					{SubnetId: "subnet-3", CidrBlock: "10.0.20.0/22", AvailabilityZone: "eu-west-1a", OwnerId: "111111111111"},
+				}, nil)
+
+				errorList := cv.Validate(ctx, infra)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should fail - subnets are missing, in another zone or not owned by the owner of the VPC", func() {
+				awsClient.EXPECT().GetAccountID(ctx).Return("222222222222", nil)
+				awsClient.EXPECT().FindSubnetsByVpcId(ctx, vpcID).Return([]*awsclient.Subnet{
+					{SubnetId: "subnet-1", CidrBlock: "10.0.0.0/20", AvailabilityZone: "eu-west-1b", OwnerId: "111111111111"},
+					{SubnetId: "subnet-3", CidrBlock: "10.0.20.0/22", AvailabilityZone: "eu-west-1a", OwnerId: "222222222222"},
+				}, nil)
+
+				errorList := cv.Validate(ctx, infra)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[0].internal"),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.zones[0].public"),
+					"Detail": Equal("subnet subnet-3 is not owned by account 111111111111 of VPC " + vpcID),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.zones[0].workers"),
+					"Detail": Equal("shared subnet subnet-1 is located in zone eu-west-1b"),
+				}))
+			})
+
+			It("should fail - VPC is owned by the account of the shoot", func() {
+				awsClient.EXPECT().GetAccountID(ctx).Return("111111111111", nil)
+				awsClient.EXPECT().FindSubnetsByVpcId(ctx, vpcID)
+
+				errorList := cv.Validate(ctx, infra)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.vpc.shared"),
+				}))
+			})
+		})
+
+		Describe("validate secondary CIDR blocks", func() {
+			BeforeEach(func() {
+				infra.Spec.ProviderConfig.Raw = encode(&apisaws.InfrastructureConfig{
+					Networks: apisaws.Networks{
+						VPC: apisaws.VPC{
+							ID:                  pointer.String(vpcID),
+							SecondaryCidrBlocks: []string{"100.64.0.0/16", "100.65.0.0/16"},
+						},
+					},
+				})
+
+				awsClient.EXPECT().GetVPCInternetGateway(ctx, vpcID).Return(vpcID, nil)
+				awsClient.EXPECT().GetDHCPOptions(ctx, vpcID).Return(validDHCPOptions, nil)
+			})
+
+			It("should succeed - secondary CIDR blocks are not associated yet or already associated", func() {
+				awsClient.EXPECT().GetVpc(ctx, vpcID).Return(&awsclient.VPC{
+					VpcId:              vpcID,
+					CidrBlock:          "10.0.0.0/16",
+					EnableDnsSupport:   true,
+					EnableDnsHostnames: true,
+					SecondaryCidrBlocks: []*awsclient.VpcCidrBlockAssociation{
+						{AssociationId: "vpc-cidr-assoc-1", CidrBlock: "100.64.0.0/16"},
+					},
+				}, nil)
+				expectGetShoot()
+				awsClient.EXPECT().FindSubnetsByVpcId(ctx, vpcID)
+
+				errorList := cv.Validate(ctx, infra)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should fail - secondary CIDR block overlaps with an existing association", func() {
+				awsClient.EXPECT().GetVpc(ctx, vpcID).Return(&awsclient.VPC{
+					VpcId:              vpcID,
+					CidrBlock:          "10.0.0.0/16",
+					EnableDnsSupport:   true,
+					EnableDnsHostnames: true,
+					SecondaryCidrBlocks: []*awsclient.VpcCidrBlockAssociation{
+						{AssociationId: "vpc-cidr-assoc-1", CidrBlock: "100.65.128.0/17"},
+					},
+				}, nil)
+				expectGetShoot()
+				awsClient.EXPECT().FindSubnetsByVpcId(ctx, vpcID)
+
+				errorList := cv.Validate(ctx, infra)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.vpc.secondaryCidrBlocks[1]"),
+					"Detail": Equal("must not overlap with CIDR block 100.65.128.0/17 associated with VPC " + vpcID),
+				}))
+			})
+		})
+
+		Describe("validate transit gateway", func() {
+			const transitGatewayID = "tgw-123456"
+
+			BeforeEach(func() {
+				infra.Spec.ProviderConfig.Raw = encode(&apisaws.InfrastructureConfig{
+					Networks: apisaws.Networks{
+						VPC: apisaws.VPC{},
+						TransitGateway: &apisaws.TransitGateway{
+							ID:     transitGatewayID,
+							Routes: []string{"192.168.0.0/16"},
+						},
+					},
+				})
+			})
+
+			It("should succeed - transit gateway exists and is available", func() {
+				awsClient.EXPECT().GetTransitGateway(ctx, transitGatewayID).Return(&awsclient.TransitGateway{
+					TransitGatewayId: transitGatewayID,
+					State:            string(ec2types.TransitGatewayStateAvailable),
+				}, nil)
+
+				errorList := cv.Validate(ctx, infra)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should fail - transit gateway does not exist", func() {
+				awsClient.EXPECT().GetTransitGateway(ctx, transitGatewayID).Return(nil, nil)
+
+				errorList := cv.Validate(ctx, infra)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotFound),
+					"Field": Equal("networks.transitGateway.id"),
+				}))
+			})
+
+			It("should fail - transit gateway is not available", func() {
+				awsClient.EXPECT().GetTransitGateway(ctx, transitGatewayID).Return(&awsclient.TransitGateway{
+					TransitGatewayId: transitGatewayID,
+					State:            string(ec2types.TransitGatewayStatePending),
+				}, nil)
+
+				errorList := cv.Validate(ctx, infra)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.transitGateway.id"),
+					"Detail": Equal("transit gateway must be in state available, but is pending"),
+				}))
+			})
+		})
+
+		Describe("validate nodes instance profile", func() {
+			const (
+				profileName = "my-nodes"
+				roleName    = "my-nodes-role"
+				roleARN     = "arn:aws:iam::123456789012:role/my-nodes-role"
+			)
+
+			BeforeEach(func() {
+				infra.Spec.ProviderConfig.Raw = encode(&apisaws.InfrastructureConfig{
+					Networks: apisaws.Networks{
+						VPC: apisaws.VPC{},
+					},
+					IAM: &apisaws.IAMConfig{
+						NodesInstanceProfile: &apisaws.IAMInstanceProfile{
+							ARN: pointer.String("arn:aws:iam::123456789012:instance-profile/path/" + profileName),
+						},
+					},
+				})
+			})
+
+			It("should succeed - instance profile exists and its role allows the required actions", func() {
+				awsClient.EXPECT().GetIAMInstanceProfile(ctx, profileName).Return(&awsclient.IAMInstanceProfile{InstanceProfileName: profileName, RoleName: roleName}, nil)
+				awsClient.EXPECT().GetIAMRole(ctx, roleName).Return(&awsclient.IAMRole{RoleName: roleName, ARN: roleARN}, nil)
+				awsClient.EXPECT().GetIAMDeniedActions(ctx, roleARN, []string{"ec2:DescribeInstances"}).Return(nil, nil)
+
+				errorList := cv.Validate(ctx, infra)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should fail - instance profile is the one managed for the nodes", func() {
+				infra.Spec.ProviderConfig.Raw = encode(&apisaws.InfrastructureConfig{
+					Networks: apisaws.Networks{
+						VPC: apisaws.VPC{},
+					},
+					IAM: &apisaws.IAMConfig{
+						NodesInstanceProfile: &apisaws.IAMInstanceProfile{
+							Name: pointer.String(namespace + "-nodes"),
+						},
+					},
+				})
+
+				errorList := cv.Validate(ctx, infra)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("iam.nodesInstanceProfile"),
+				}))
+			})
+
+			It("should fail - instance profile does not exist", func() {
+				awsClient.EXPECT().GetIAMInstanceProfile(ctx, profileName).Return(nil, nil)
+
+				errorList := cv.Validate(ctx, infra)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotFound),
+					"Field": Equal("iam.nodesInstanceProfile"),
+				}))
+			})
+
+			It("should fail - instance profile has no role", func() {
+				awsClient.EXPECT().GetIAMInstanceProfile(ctx, profileName).Return(&awsclient.IAMInstanceProfile{InstanceProfileName: profileName}, nil)
+
+				errorList := cv.Validate(ctx, infra)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("iam.nodesInstanceProfile"),
+					"Detail": Equal("instance profile must have a role"),
+				}))
+			})
+
+			It("should fail - role of instance profile does not allow the required actions", func() {
+				awsClient.EXPECT().GetIAMInstanceProfile(ctx, profileName).Return(&awsclient.IAMInstanceProfile{InstanceProfileName: profileName, RoleName: roleName}, nil)
+				awsClient.EXPECT().GetIAMRole(ctx, roleName).Return(&awsclient.IAMRole{RoleName: roleName, ARN: roleARN}, nil)
+				awsClient.EXPECT().GetIAMDeniedActions(ctx, roleARN, []string{"ec2:DescribeInstances"}).Return([]string{"ec2:DescribeInstances"}, nil)
+
+				errorList := cv.Validate(ctx, infra)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("iam.nodesInstanceProfile"),
+					"Detail": Equal("role my-nodes-role of instance profile must allow the actions ec2:DescribeInstances"),
+				}))
+			})
+		})
 	})
 })
 
@@ -23,6 +23,8 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
 	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow/shared"
 )
@@ -36,6 +38,16 @@ const (
 	TagKeyRolePublicELB = "kubernetes.io/role/elb"
 	// TagKeyRolePrivateELB is the tag key for the internal ELB
 	TagKeyRolePrivateELB = "kubernetes.io/role/internal-elb"
+	// TagKeyOwnedRoutesTemplate is the template for the tag key of existing route tables, whose value contains the
+	// space separated destinations of the routes added by the extension
+	TagKeyOwnedRoutesTemplate = "gardener.cloud/owned-routes/%s"
+	// TagKeyElasticIPPool is the tag key marking the elastic IPs of the elastic IP pool
+	TagKeyElasticIPPool = "gardener.cloud/elastic-ip-pool"
+	// TagKeyNATInstance is the tag key of the private route tables whose default route points to a NAT instance, its
+	// value is the name of the auto scaling group of the NAT instance
+	TagKeyNATInstance = "gardener.cloud/nat-instance"
+	// TagKeyShoot is the tag key for the technical ID of the shoot, added to all resources if the inventory is enabled
+	TagKeyShoot = "gardener.cloud/shoot"
 	// TagValueCluster is the tag value for the cluster tag
 	TagValueCluster = "1"
 	// TagValueELB is the tag value for the ELB tag keys
@@ -49,6 +61,10 @@ const (
 	IdentifierDefaultSecurityGroup = "DefaultSecurityGroup"
 	// IdentifierInternetGateway is the key for the id of the internet gateway resource
 	IdentifierInternetGateway = "InternetGateway"
+	// IdentifierEgressOnlyInternetGateway is the key for the id of the egress only internet gateway resource
+	IdentifierEgressOnlyInternetGateway = "EgressOnlyInternetGateway"
+	// IdentifierCarrierGateway is the key for the id of the carrier gateway resource
+	IdentifierCarrierGateway = "CarrierGateway"
 	// IdentifierMainRouteTable is the key for the id of the main route table
 	IdentifierMainRouteTable = "MainRouteTable"
 	// IdentifierNodesSecurityGroup is the key for the id of the nodes security group
@@ -59,12 +75,28 @@ const (
 	IdentifierZoneSubnetPublic = "SubnetPublicUtility"
 	// IdentifierZoneSubnetPrivate is the key for the id of the private utility subnet
 	IdentifierZoneSubnetPrivate = "SubnetPrivateUtility"
+	// IdentifierZoneSubnetPods is the key for the id of the optional dedicated pods subnet
+	IdentifierZoneSubnetPods = "SubnetPods"
+	// IdentifierZoneSubnetWorkersSecondary is the key for the id of the optional secondary workers subnet
+	IdentifierZoneSubnetWorkersSecondary = "SubnetWorkersSecondary"
+	// IdentifierZoneSubnetIPv6CIDRSuffix is the suffix appended to the subnet keys for storing the IPv6 CIDR block of the subnet
+	IdentifierZoneSubnetIPv6CIDRSuffix = "IPv6CIDR"
 	// IdentifierZoneSuffix is the key for the suffix used for a zone
 	IdentifierZoneSuffix = "Suffix"
 	// IdentifierZoneNATGWElasticIP is the key for the id of the elastic IP resource used for the NAT gateway
 	IdentifierZoneNATGWElasticIP = "NATGatewayElasticIP"
+	// IdentifierZoneNATGWPoolElasticIP is the key for the id of the elastic IP of the elastic IP pool assigned to the NAT gateway
+	IdentifierZoneNATGWPoolElasticIP = "NATGatewayPoolElasticIP"
 	// IdentifierZoneNATGateway is the key for the id of the NAT gateway resource
 	IdentifierZoneNATGateway = "NATGateway"
+	// IdentifierZoneNATGatewayPublicIP is the key for the public IP address of the NAT gateway
+	IdentifierZoneNATGatewayPublicIP = "NATGatewayPublicIP"
+	// IdentifierZoneNATInstance is the key for the id of the current NAT instance
+	IdentifierZoneNATInstance = "NATInstance"
+	// IdentifierZoneNATInstanceLaunchTemplate is the key for the name of the launch template of the NAT instance
+	IdentifierZoneNATInstanceLaunchTemplate = "NATInstanceLaunchTemplate"
+	// IdentifierZoneNATInstanceAutoScalingGroup is the key for the name of the auto scaling group running the NAT instance
+	IdentifierZoneNATInstanceAutoScalingGroup = "NATInstanceAutoScalingGroup"
 	// IdentifierZoneRouteTable is the key for the id of route table of the zone
 	IdentifierZoneRouteTable = "ZoneRouteTable"
 	// IdentifierZoneSubnetPublicRouteTableAssoc is the key for the id of the public route table association resource
@@ -73,10 +105,42 @@ const (
 	IdentifierZoneSubnetPrivateRouteTableAssoc = "SubnetPrivateRouteTableAssoc"
 	// IdentifierZoneSubnetWorkersRouteTableAssoc is key for the id of the workers route table association resource
 	IdentifierZoneSubnetWorkersRouteTableAssoc = "SubnetWorkersRouteTableAssoc"
+	// IdentifierZoneSubnetPodsRouteTableAssoc is key for the id of the pods route table association resource
+	IdentifierZoneSubnetPodsRouteTableAssoc = "SubnetPodsRouteTableAssoc"
+	// IdentifierZoneSubnetWorkersSecondaryRouteTableAssoc is key for the id of the secondary workers route table association resource
+	IdentifierZoneSubnetWorkersSecondaryRouteTableAssoc = "SubnetWorkersSecondaryRouteTableAssoc"
+	// IdentifierNATInstanceSecurityGroup is the key for the id of the security group of the NAT instances
+	IdentifierNATInstanceSecurityGroup = "NATInstanceSecurityGroup"
 	// IdentifierVpcIPv6CidrBlock is the IPv6 CIDR block attached to the vpc
 	IdentifierVpcIPv6CidrBlock = "VPCIPv6CidrBlock"
+	// IdentifierZoneNames is the key for the comma separated names of the zones of the last reconciliation
+	IdentifierZoneNames = "ZoneNames"
+	// IdentifierVpcSecondaryCidrBlocks is the key for the comma separated secondary CIDR blocks associated with the vpc
+	IdentifierVpcSecondaryCidrBlocks = "VPCSecondaryCidrBlocks"
 	// IdentifierEgressCIDRs is the key for the slice containing egress CIDRs strings.
 	IdentifierEgressCIDRs = "EgressCIDRs"
+	// IdentifierTransitGatewayAttachment is the key for the id of the transit gateway VPC attachment
+	IdentifierTransitGatewayAttachment = "TransitGatewayAttachment"
+	// IdentifierTransitGatewayRoutes is the key for the comma separated destination CIDRs routed via the transit gateway
+	IdentifierTransitGatewayRoutes = "TransitGatewayRoutes"
+	// IdentifierVPCPeeringConnection is the key for the id of a VPC peering connection
+	IdentifierVPCPeeringConnection = "VPCPeeringConnection"
+	// IdentifierVPCPeeringConnectionState is the key for the state of a VPC peering connection
+	IdentifierVPCPeeringConnectionState = "VPCPeeringConnectionState"
+	// IdentifierVPCPeeringRoutes is the key for the comma separated destination CIDRs routed via VPC peering connections
+	IdentifierVPCPeeringRoutes = "VPCPeeringRoutes"
+	// IdentifierRoute53ResolverInboundEndpoint is the key for the id of the Route 53 Resolver inbound endpoint
+	IdentifierRoute53ResolverInboundEndpoint = "Route53ResolverInboundEndpoint"
+	// IdentifierRoute53ResolverInboundEndpointIPs is the key for the comma separated IP addresses of the Route 53 Resolver inbound endpoint
+	IdentifierRoute53ResolverInboundEndpointIPs = "Route53ResolverInboundEndpointIPs"
+	// IdentifierRoute53ResolverInboundSecurityGroup is the key for the id of the security group of the Route 53 Resolver inbound endpoint
+	IdentifierRoute53ResolverInboundSecurityGroup = "Route53ResolverInboundSecurityGroup"
+	// IdentifierRoute53ResolverOutboundEndpoint is the key for the id of the Route 53 Resolver outbound endpoint
+	IdentifierRoute53ResolverOutboundEndpoint = "Route53ResolverOutboundEndpoint"
+	// IdentifierRoute53ResolverOutboundSecurityGroup is the key for the id of the security group of the Route 53 Resolver outbound endpoint
+	IdentifierRoute53ResolverOutboundSecurityGroup = "Route53ResolverOutboundSecurityGroup"
+	// IdentifierRoute53ResolverRuleIDs is the key for the comma separated ids of the existing Route 53 Resolver rules associated with the VPC
+	IdentifierRoute53ResolverRuleIDs = "Route53ResolverRuleIDs"
 	// NameIAMRole is the key for the name of the IAM role
 	NameIAMRole = "IAMRoleName"
 	// NameIAMInstanceProfile is the key for the name of the IAM instance profile
@@ -85,6 +149,30 @@ const (
 	NameIAMRolePolicy = "IAMRolePolicyName"
 	// NameKeyPair is the key for the name of the EC2 key pair resource
 	NameKeyPair = "KeyPair"
+	// IdentifierVPCFlowLog is the key for the id of the VPC flow log
+	IdentifierVPCFlowLog = "VPCFlowLog"
+	// NameVPCFlowLogsLogGroup is the key for the name of the CloudWatch Logs log group of the VPC flow logs
+	NameVPCFlowLogsLogGroup = "VPCFlowLogsLogGroupName"
+	// NameVPCFlowLogsIAMRole is the key for the name of the IAM role used to publish the VPC flow logs to CloudWatch Logs
+	NameVPCFlowLogsIAMRole = "VPCFlowLogsIAMRoleName"
+	// ARNVPCFlowLogsIAMRole is the key for the ARN of the IAM role used to publish the VPC flow logs to CloudWatch Logs
+	ARNVPCFlowLogsIAMRole = "VPCFlowLogsIAMRoleARN"
+	// NameVPCFlowLogsIAMRolePolicy is the key for the name of the IAM role policy used to publish the VPC flow logs
+	NameVPCFlowLogsIAMRolePolicy = "VPCFlowLogsIAMRolePolicyName"
+	// NameNATInstanceIAMRole is the key for the name of the IAM role of the NAT instances
+	NameNATInstanceIAMRole = "NATInstanceIAMRoleName"
+	// NameNATInstanceIAMInstanceProfile is the key for the name of the IAM instance profile of the NAT instances
+	NameNATInstanceIAMInstanceProfile = "NATInstanceIAMInstanceProfileName"
+	// NameNATInstanceIAMRolePolicy is the key for the name of the IAM role policy of the NAT instances
+	NameNATInstanceIAMRolePolicy = "NATInstanceIAMRolePolicyName"
+	// IdentifierElasticFileSystem is the key for the id of the EFS file system
+	IdentifierElasticFileSystem = "ElasticFileSystem"
+	// IdentifierLoadBalancerAccessLogsBucket is the key for the name of the S3 bucket for the access logs of load balancers
+	IdentifierLoadBalancerAccessLogsBucket = "LoadBalancerAccessLogsBucket"
+	// IdentifierEgressPrefixList is the key for the id of the managed prefix list with the egress IPs
+	IdentifierEgressPrefixList = "EgressPrefixList"
+	// ARNEgressPrefixList is the key for the ARN of the managed prefix list with the egress IPs
+	ARNEgressPrefixList = "EgressPrefixListARN"
 	// ARNIAMRole is the key for the ARN of the IAM role
 	ARNIAMRole = "IAMRoleARN"
 	// KeyPairFingerprint is the key to store the fingerprint of the key pair
@@ -94,8 +182,17 @@ const (
 
 	// ChildIdVPCEndpoints is the child key for the VPC endpoints
 	ChildIdVPCEndpoints = "VPCEndpoints"
+	// ChildIdPrefixLists is the child key for the ids of the AWS-managed prefix lists of the gateway endpoints, which are
+	// keyed by the service of the endpoint
+	ChildIdPrefixLists = "PrefixLists"
 	// ChildIdZones is the child key for the zones
 	ChildIdZones = "Zones"
+	// ChildIdElasticIPPool is the child key for the public IPs of the elastic IP pool, which are keyed by the allocation id
+	ChildIdElasticIPPool = "ElasticIPPool"
+	// ChildIdVPCPeerings is the child key for the VPC peering connections, which are keyed by the id of the peer VPC
+	ChildIdVPCPeerings = "VPCPeerings"
+	// ChildIdNetworkACLs is the child key for the ids of the network ACLs, which are keyed by the role of the subnets
+	ChildIdNetworkACLs = "NetworkACLs"
 
 	// ObjectMainRouteTable is the object key used for caching the main route table object
 	ObjectMainRouteTable = "MainRouteTable"
@@ -118,9 +215,12 @@ type FlowContext struct {
 	namespace  string
 	infraSpec  extensionsv1alpha1.InfrastructureSpec
 	config     *awsapi.InfrastructureConfig
+	partition  string
 	client     awsclient.Interface
 	updater    awsclient.Updater
 	commonTags awsclient.Tags
+
+	zoneTaskLimiter shared.TaskLimiter
 }
 
 // NewFlowContext creates a new FlowContext object
@@ -139,13 +239,21 @@ func NewFlowContext(log logr.Logger, awsClient awsclient.Interface,
 		namespace:        infra.Namespace,
 		infraSpec:        infra.Spec,
 		config:           config,
+		partition:        aws.GetPartition(infra.Spec.Region, config.Endpoints),
 		client:           awsClient,
 		updater:          awsclient.NewUpdater(awsClient, config.IgnoreTags),
+		zoneTaskLimiter:  shared.NewTaskLimiter(MaxParallelZoneTasks),
 	}
 	flowContext.commonTags = awsclient.Tags{
 		flowContext.tagKeyCluster(): TagValueCluster,
 		TagKeyName:                  infra.Namespace,
 	}
+	if helper.IsInventoryEnabled(config) {
+		flowContext.commonTags[TagKeyShoot] = infra.Namespace
+	}
+	for k, v := range config.Tags {
+		flowContext.commonTags[k] = v
+	}
 	if config.Networks.VPC.ID != nil {
 		flowContext.state.SetPtr(IdentifierVPC, config.Networks.VPC.ID)
 	}
@@ -178,7 +286,7 @@ func (c *FlowContext) clusterTags() awsclient.Tags {
 }
 
 func (c *FlowContext) vpcEndpointServiceNamePrefix() string {
-	return fmt.Sprintf("com.amazonaws.%s.", c.infraSpec.Region)
+	return awsclient.VPCEndpointServiceNamePrefix(c.partition, c.infraSpec.Region)
 }
 
 func (c *FlowContext) extractVpcEndpointName(item *awsclient.VpcEndpoint) string {
@@ -227,6 +335,16 @@ func (h *ZoneSuffixHelper) GetSuffixSubnetPrivate() string {
 	return fmt.Sprintf("private-utility-%s", h.suffix)
 }
 
+// GetSuffixSubnetPods builds the suffix for the dedicated pods subnet
+func (h *ZoneSuffixHelper) GetSuffixSubnetPods() string {
+	return fmt.Sprintf("pods-%s", h.suffix)
+}
+
+// GetSuffixSubnetWorkersSecondary builds the suffix for the secondary workers subnet
+func (h *ZoneSuffixHelper) GetSuffixSubnetWorkersSecondary() string {
+	return fmt.Sprintf("nodes-secondary-%s", h.suffix)
+}
+
 // GetSuffixElasticIP builds the suffix for the elastic IP of the NAT gateway
 func (h *ZoneSuffixHelper) GetSuffixElasticIP() string {
 	return fmt.Sprintf("eip-natgw-%s", h.suffix)
@@ -236,3 +354,8 @@ func (h *ZoneSuffixHelper) GetSuffixElasticIP() string {
 func (h *ZoneSuffixHelper) GetSuffixNATGateway() string {
 	return fmt.Sprintf("natgw-%s", h.suffix)
 }
+
+// GetSuffixNATInstance builds the suffix for the launch template and auto scaling group of the NAT instance
+func (h *ZoneSuffixHelper) GetSuffixNATInstance() string {
+	return fmt.Sprintf("natinstance-%s", h.suffix)
+}
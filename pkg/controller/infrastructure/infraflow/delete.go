@@ -16,13 +16,15 @@ package infraflow
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
-	"github.com/gardener/gardener/extensions/pkg/util"
 	"github.com/gardener/gardener/pkg/utils/flow"
 
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
 	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 	. "github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow/shared"
 )
@@ -35,12 +37,49 @@ func (c *FlowContext) Delete(ctx context.Context) error {
 	}
 	g := c.buildDeleteGraph()
 	f := g.Compile()
-	if err := f.Run(ctx, flow.Opts{Log: c.Log}); err != nil {
+	if err := f.Run(ctx, c.FlowOpts()); err != nil {
 		return flow.Causes(err)
 	}
 	return nil
 }
 
+// DeleteBestEffort creates and runs the flow to delete the AWS infrastructure like `Delete`, but continues with the
+// remaining tasks if a task fails, e.g. for a forceful deletion. The errors of all failed tasks are returned joined. If
+// the ID of the VPC is unknown (e.g. because the infrastructure has no state), the VPC is found by its tags.
+func (c *FlowContext) DeleteBestEffort(ctx context.Context) error {
+	if err := c.discoverVPC(ctx); err != nil {
+		return err
+	}
+
+	c.SetBestEffort(true)
+	defer c.SetBestEffort(false)
+
+	g := c.buildDeleteGraph()
+	f := g.Compile()
+	if err := f.Run(ctx, c.FlowOpts()); err != nil {
+		return errors.Join(flow.Causes(err), c.BestEffortError())
+	}
+	return c.BestEffortError()
+}
+
+// discoverVPC finds the VPC by its tags if its ID is not in the state. If no VPC is found, it is marked as deleted, so
+// that the tasks for the resources within the VPC are skipped.
+func (c *FlowContext) discoverVPC(ctx context.Context) error {
+	if c.state.Get(IdentifierVPC) != nil || c.state.IsAlreadyDeleted(IdentifierVPC) {
+		return nil
+	}
+	current, err := findExisting(ctx, nil, c.commonTags, c.client.GetVpc, c.client.FindVpcsByTags)
+	if err != nil {
+		return fmt.Errorf("could not find VPC by tags: %w", err)
+	}
+	if current == nil {
+		c.state.SetAsDeleted(IdentifierVPC)
+		return nil
+	}
+	c.state.Set(IdentifierVPC, current.VpcId)
+	return nil
+}
+
 func (c *FlowContext) buildDeleteGraph() *flow.Graph {
 	g := flow.NewGraph("AWS infrastructure destruction")
 
@@ -66,34 +105,90 @@ func (c *FlowContext) buildDeleteGraph() *flow.Graph {
 		c.deleteIAMRole,
 		Timeout(defaultTimeout), Dependencies(deleteIAMInstanceProfile, deleteIAMRolePolicy))
 
+	deleteTransitGatewayAttachment := c.AddTask(g, "delete transit gateway attachment",
+		c.deleteTransitGatewayAttachment,
+		DoIf(c.hasVPC()), Timeout(defaultLongTimeout))
+
+	deleteVPCFlowLogs := c.AddTask(g, "delete VPC flow logs",
+		c.deleteVPCFlowLogs,
+		Timeout(defaultTimeout))
+
+	deleteVPCEndpoints := c.AddTask(g, "delete VPC endpoints",
+		c.deleteVPCEndpoints,
+		DoIf(c.hasVPC()), Timeout(defaultTimeout))
+
+	deleteElasticFileSystem := c.AddTask(g, "delete EFS file system",
+		c.deleteElasticFileSystem,
+		DoIf(c.state.Get(IdentifierElasticFileSystem) != nil || (c.config.ElasticFileSystem != nil && c.config.ElasticFileSystem.Enabled)), Timeout(defaultLongTimeout))
+
+	_ = c.AddTask(g, "delete egress prefix list",
+		c.deleteEgressPrefixList,
+		DoIf(c.state.Get(IdentifierEgressPrefixList) != nil || (c.config.EgressPrefixList != nil && c.config.EgressPrefixList.Enabled)), Timeout(defaultTimeout))
+
+	_ = c.AddTask(g, "delete load balancer access logs bucket",
+		c.deleteLoadBalancerAccessLogsBucket,
+		DoIf(c.state.Get(IdentifierLoadBalancerAccessLogsBucket) != nil || (c.config.LoadBalancerAccessLogs != nil && c.config.LoadBalancerAccessLogs.Enabled)), Timeout(defaultLongTimeout))
+
+	deleteVPCPeerings := c.AddTask(g, "delete VPC peerings",
+		c.deleteVPCPeerings,
+		DoIf(c.hasVPC()), Timeout(defaultTimeout))
+
+	deleteRoute53Resolver := c.AddTask(g, "delete Route53 Resolver",
+		c.deleteRoute53Resolver,
+		DoIf(c.hasVPC() && c.hasRoute53Resolver()), Timeout(defaultLongTimeout))
+
 	deleteZones := c.AddTask(g, "delete zones resources",
 		c.deleteZones,
-		DoIf(c.hasVPC()), Timeout(defaultLongTimeout))
+		DoIf(c.hasVPC() && !c.config.Networks.VPC.Shared), Timeout(defaultLongTimeout), Dependencies(deleteTransitGatewayAttachment, deleteVPCPeerings, deleteVPCEndpoints, deleteElasticFileSystem, deleteRoute53Resolver))
+
+	_ = c.AddTask(g, "delete elastic IP pool",
+		c.deleteElasticIPPool,
+		DoIf(c.state.HasChild(ChildIdElasticIPPool) || c.useElasticIPPool()), Timeout(defaultTimeout), Dependencies(deleteZones))
+
+	deleteNetworkACLs := c.AddTask(g, "delete network ACLs",
+		c.deleteNetworkACLs,
+		DoIf(c.hasVPC() && c.hasNetworkACLs()), Timeout(defaultTimeout), Dependencies(deleteZones))
+
+	_ = c.AddTask(g, "delete VPC secondary CIDR blocks",
+		c.deleteVpcSecondaryCidrBlocks,
+		DoIf(!deleteVPC && c.hasVPC()), Timeout(defaultTimeout), Dependencies(deleteZones))
 
 	deleteNodesSecurityGroup := c.AddTask(g, "delete nodes security group",
 		c.deleteNodesSecurityGroup,
+		DoIf(c.hasVPC()), Timeout(defaultTimeout), Dependencies(deleteZones, deleteElasticFileSystem))
+
+	deleteNATInstanceSecurityGroup := c.AddTask(g, "delete NAT instance security group",
+		c.deleteNATInstanceSecurityGroup,
 		DoIf(c.hasVPC()), Timeout(defaultTimeout), Dependencies(deleteZones))
 
+	_ = c.AddTask(g, "delete NAT instance IAM role",
+		c.deleteNATInstanceIAM,
+		DoIf(c.state.Get(NameNATInstanceIAMRole) != nil || helper.GetNATGatewayType(c.config) == awsapi.NATGatewayTypeInstance), Timeout(defaultTimeout), Dependencies(deleteZones))
+
 	deleteMainRouteTable := c.AddTask(g, "delete main route table",
 		c.deleteMainRouteTable,
 		DoIf(c.hasVPC()), Timeout(defaultTimeout), Dependencies(deleteZones))
 
-	deleteGatewayEndpoints := c.AddTask(g, "delete gateway endpoints",
-		c.deleteGatewayEndpoints,
-		DoIf(c.hasVPC()), Timeout(defaultTimeout))
-
 	deleteInternetGateway := c.AddTask(g, "delete internet gateway",
 		c.deleteInternetGateway,
-		DoIf(deleteVPC && c.hasVPC()), Timeout(defaultTimeout), Dependencies(deleteGatewayEndpoints, deleteMainRouteTable))
+		DoIf(deleteVPC && c.hasVPC()), Timeout(defaultTimeout), Dependencies(deleteVPCEndpoints, deleteMainRouteTable))
+
+	deleteEgressOnlyInternetGateway := c.AddTask(g, "delete egress only internet gateway",
+		c.deleteEgressOnlyInternetGateway,
+		DoIf(c.hasVPC()), Timeout(defaultTimeout), Dependencies(deleteZones))
+
+	deleteCarrierGateway := c.AddTask(g, "delete carrier gateway",
+		c.deleteCarrierGateway,
+		DoIf(c.hasVPC()), Timeout(defaultTimeout), Dependencies(deleteZones))
 
 	deleteDefaultSecurityGroup := c.AddTask(g, "delete default security group",
 		c.deleteDefaultSecurityGroup,
-		DoIf(deleteVPC && c.hasVPC()), Timeout(defaultTimeout), Dependencies(deleteGatewayEndpoints))
+		DoIf(deleteVPC && c.hasVPC()), Timeout(defaultTimeout), Dependencies(deleteVPCEndpoints))
 
 	deleteVpc := c.AddTask(g, "delete VPC",
 		c.deleteVpc,
 		DoIf(deleteVPC && c.hasVPC()), Timeout(defaultTimeout),
-		Dependencies(deleteInternetGateway, deleteDefaultSecurityGroup, deleteNodesSecurityGroup, destroyLoadBalancersAndSecurityGroups))
+		Dependencies(deleteInternetGateway, deleteEgressOnlyInternetGateway, deleteCarrierGateway, deleteDefaultSecurityGroup, deleteNodesSecurityGroup, deleteNATInstanceSecurityGroup, deleteNetworkACLs, deleteVPCFlowLogs, destroyLoadBalancersAndSecurityGroups))
 
 	_ = c.AddTask(g, "delete DHCP options for VPC",
 		c.deleteDhcpOptions,
@@ -105,7 +200,7 @@ func (c *FlowContext) buildDeleteGraph() *flow.Graph {
 
 func (c *FlowContext) deleteKubernetesLoadBalancersAndSecurityGroups(ctx context.Context) error {
 	if err := DestroyKubernetesLoadBalancersAndSecurityGroups(ctx, c.client, *c.state.Get(IdentifierVPC), c.namespace); err != nil {
-		return util.DetermineError(fmt.Errorf("failed to destroy load balancers and security groups: %w", err), helper.KnownCodes)
+		return aws.DetermineError(fmt.Errorf("failed to destroy load balancers and security groups: %w", err))
 	}
 
 	c.state.Set(MarkerLoadBalancersAndSecurityGroupsDestroyed, "true")
@@ -168,10 +263,75 @@ func (c *FlowContext) deleteInternetGateway(ctx context.Context) error {
 	return nil
 }
 
-func (c *FlowContext) deleteGatewayEndpoints(ctx context.Context) error {
+func (c *FlowContext) deleteVpcSecondaryCidrBlocks(ctx context.Context) error {
+	owned := c.getOwnedVpcSecondaryCidrBlocks()
+	if owned.Len() == 0 {
+		return nil
+	}
+	log := c.LogFromContext(ctx)
+	current, err := c.client.GetVpc(ctx, *c.state.Get(IdentifierVPC))
+	if err != nil {
+		return err
+	}
+	if current != nil {
+		for _, assoc := range current.SecondaryCidrBlocks {
+			if !owned.Has(assoc.CidrBlock) {
+				continue
+			}
+			log.Info("disassociating...", "CidrBlock", assoc.CidrBlock)
+			if err := c.client.DisassociateVpcCidrBlock(ctx, assoc.AssociationId); err != nil {
+				return err
+			}
+		}
+	}
+	c.state.Set(IdentifierVpcSecondaryCidrBlocks, "")
+	return nil
+}
+
+func (c *FlowContext) deleteEgressOnlyInternetGateway(ctx context.Context) error {
+	if c.state.IsAlreadyDeleted(IdentifierEgressOnlyInternetGateway) {
+		return nil
+	}
+	log := c.LogFromContext(ctx)
+	current, err := findExisting(ctx, c.state.Get(IdentifierEgressOnlyInternetGateway), c.commonTags,
+		c.client.GetEgressOnlyInternetGateway, c.client.FindEgressOnlyInternetGatewaysByTags)
+	if err != nil {
+		return err
+	}
+	if current != nil {
+		log.Info("deleting...", "EgressOnlyInternetGatewayId", current.EgressOnlyInternetGatewayId)
+		if err := c.client.DeleteEgressOnlyInternetGateway(ctx, current.EgressOnlyInternetGatewayId); err != nil {
+			return err
+		}
+	}
+	c.state.SetAsDeleted(IdentifierEgressOnlyInternetGateway)
+	return nil
+}
+
+func (c *FlowContext) deleteCarrierGateway(ctx context.Context) error {
+	if c.state.IsAlreadyDeleted(IdentifierCarrierGateway) {
+		return nil
+	}
+	log := c.LogFromContext(ctx)
+	current, err := findExisting(ctx, c.state.Get(IdentifierCarrierGateway), c.commonTags,
+		c.client.GetCarrierGateway, c.client.FindCarrierGatewaysByTags)
+	if err != nil {
+		return err
+	}
+	if current != nil {
+		log.Info("deleting...", "CarrierGatewayId", current.CarrierGatewayId)
+		if err := c.client.DeleteCarrierGateway(ctx, current.CarrierGatewayId); err != nil {
+			return err
+		}
+	}
+	c.state.SetAsDeleted(IdentifierCarrierGateway)
+	return nil
+}
+
+func (c *FlowContext) deleteVPCEndpoints(ctx context.Context) error {
 	log := c.LogFromContext(ctx)
 	child := c.state.GetChild(ChildIdVPCEndpoints)
-	current, err := c.collectExistingVPCEndpoints(ctx)
+	current, err := c.collectExistingVPCEndpoints(ctx, nil)
 	if err != nil {
 		return err
 	}
@@ -222,13 +382,13 @@ func (c *FlowContext) deleteDhcpOptions(ctx context.Context) error {
 		return err
 	}
 	if current != nil {
-		log.Info("deleting...", "DhcpOptionsId", current.DhcpOptionsId)
-		if err := c.client.DeleteVpcDhcpOptions(ctx, current.DhcpOptionsId); err != nil {
+		if err := c.deleteDhcpOptionsIfUnused(ctx, log, current.DhcpOptionsId); err != nil {
 			return err
 		}
 		c.state.SetAsDeleted(IdentifierDHCPOptions)
 	}
-	return nil
+	// DHCP options which have been replaced but not deleted yet
+	return c.deleteObsoleteDhcpOptions(ctx)
 }
 
 func (c *FlowContext) deleteMainRouteTable(ctx context.Context) error {
@@ -236,6 +396,13 @@ func (c *FlowContext) deleteMainRouteTable(ctx context.Context) error {
 		return nil
 	}
 	log := c.LogFromContext(ctx)
+	if id := c.config.Networks.VPC.MainRouteTableID; id != nil {
+		if err := c.deleteOwnedRoutes(ctx, log, *id); err != nil {
+			return err
+		}
+		c.state.SetAsDeleted(IdentifierMainRouteTable)
+		return nil
+	}
 	current, err := findExisting(ctx, c.state.Get(IdentifierMainRouteTable), c.commonTags,
 		c.client.GetRouteTable, c.client.FindRouteTablesByTags)
 	if err != nil {
@@ -273,6 +440,28 @@ func (c *FlowContext) deleteNodesSecurityGroup(ctx context.Context) error {
 	return nil
 }
 
+func (c *FlowContext) deleteNATInstanceSecurityGroup(ctx context.Context) error {
+	if c.state.IsAlreadyDeleted(IdentifierNATInstanceSecurityGroup) {
+		return nil
+	}
+	log := c.LogFromContext(ctx)
+	groupName := fmt.Sprintf("%s-nat-instance", c.namespace)
+	current, err := findExisting(ctx, c.state.Get(IdentifierNATInstanceSecurityGroup), c.commonTagsWithSuffix("nat-instance"),
+		c.client.GetSecurityGroup, c.client.FindSecurityGroupsByTags,
+		func(item *awsclient.SecurityGroup) bool { return item.GroupName == groupName })
+	if err != nil {
+		return err
+	}
+	if current != nil {
+		log.Info("deleting...", "GroupId", current.GroupId)
+		if err := c.client.DeleteSecurityGroup(ctx, current.GroupId); err != nil {
+			return err
+		}
+	}
+	c.state.SetAsDeleted(IdentifierNATInstanceSecurityGroup)
+	return nil
+}
+
 func (c *FlowContext) deleteZones(ctx context.Context) error {
 	current, err := c.collectExistingSubnets(ctx)
 	if err != nil {
@@ -283,7 +472,7 @@ func (c *FlowContext) deleteZones(ctx context.Context) error {
 		return err
 	}
 	f := g.Compile()
-	if err := f.Run(ctx, flow.Opts{Log: c.Log}); err != nil {
+	if err := f.Run(ctx, c.SubFlowOpts()); err != nil {
 		return flow.Causes(err)
 	}
 	return nil
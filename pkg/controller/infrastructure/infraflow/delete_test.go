@@ -0,0 +1,120 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infraflow_test
+
+import (
+	"context"
+	"errors"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	mockawsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/mock"
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow/shared"
+)
+
+var _ = Describe("Delete", func() {
+	const (
+		namespace    = "shoot--foo--bar"
+		nodesName    = namespace + "-nodes"
+		flowLogsName = namespace + "-vpc-flow-logs"
+	)
+
+	var (
+		ctx = context.TODO()
+
+		ctrl      *gomock.Controller
+		awsClient *mockawsclient.MockInterface
+		infra     *extensionsv1alpha1.Infrastructure
+		config    *awsapi.InfrastructureConfig
+
+		identifyingTags = awsclient.Tags{
+			"kubernetes.io/cluster/" + namespace: "1",
+			"Name":                               namespace,
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		awsClient = mockawsclient.NewMockInterface(ctrl)
+
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infrastructure", Namespace: namespace},
+			Spec:       extensionsv1alpha1.InfrastructureSpec{Region: "eu-west-1"},
+		}
+		config = &awsapi.InfrastructureConfig{}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#DeleteBestEffort", func() {
+		newFlowContext := func(state shared.FlatMap) *FlowContext {
+			flowContext, err := NewFlowContext(logr.Discard(), awsClient, infra, config, state, nil)
+			Expect(err).NotTo(HaveOccurred())
+			return flowContext
+		}
+
+		// expectDeleteResourcesOutsideOfVPC expects the deletion of the resources which don't belong to the VPC and
+		// returns the calls, so that they can be made to fail.
+		expectDeleteResourcesOutsideOfVPC := func() (removeRoleFromInstanceProfile, deleteKeyPair *gomock.Call) {
+			deleteKeyPair = awsClient.EXPECT().DeleteKeyPair(gomock.Any(), namespace+"-ssh-publickey")
+			removeRoleFromInstanceProfile = awsClient.EXPECT().RemoveRoleFromIAMInstanceProfile(gomock.Any(), nodesName, nodesName)
+			awsClient.EXPECT().DeleteIAMRolePolicy(gomock.Any(), nodesName, nodesName).MaxTimes(1)
+			awsClient.EXPECT().DeleteIAMInstanceProfile(gomock.Any(), nodesName)
+			awsClient.EXPECT().DeleteIAMRole(gomock.Any(), nodesName)
+			awsClient.EXPECT().FindFlowLogsByTags(gomock.Any(), gomock.Any())
+			awsClient.EXPECT().DeleteIAMRolePolicy(gomock.Any(), flowLogsName, flowLogsName)
+			awsClient.EXPECT().DeleteIAMRole(gomock.Any(), flowLogsName)
+			awsClient.EXPECT().DeleteLogGroup(gomock.Any(), flowLogsName)
+			awsClient.EXPECT().FindVpcDhcpOptionsByTags(gomock.Any(), identifyingTags)
+			return
+		}
+
+		It("should find the VPC by its tags if the state is empty", func() {
+			awsClient.EXPECT().FindVpcsByTags(ctx, identifyingTags)
+			expectDeleteResourcesOutsideOfVPC()
+
+			Expect(newFlowContext(nil).DeleteBestEffort(ctx)).To(Succeed())
+		})
+
+		It("should continue after failed tasks and return all errors", func() {
+			awsClient.EXPECT().FindVpcsByTags(ctx, identifyingTags)
+			removeRoleFromInstanceProfile, deleteKeyPair := expectDeleteResourcesOutsideOfVPC()
+			removeRoleFromInstanceProfile.Return(errors.New("role error"))
+			deleteKeyPair.Return(errors.New("key pair error"))
+
+			// The IAM instance profile and role are deleted although the deletion of the IAM role policy they depend on
+			// failed.
+			err := newFlowContext(nil).DeleteBestEffort(ctx)
+			Expect(err).To(MatchError(ContainSubstring("failed to delete IAM role policy: role error")))
+			Expect(err).To(MatchError(ContainSubstring("failed to delete key pair: key pair error")))
+		})
+
+		It("should fail if the VPC cannot be found by its tags", func() {
+			awsClient.EXPECT().FindVpcsByTags(ctx, identifyingTags).Return(nil, errors.New("test"))
+
+			Expect(newFlowContext(nil).DeleteBestEffort(ctx)).To(MatchError("could not find VPC by tags: test"))
+		})
+	})
+})
@@ -0,0 +1,162 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow/shared"
+)
+
+// InventoryItem is an entry of the inventory of the AWS resources created for a shoot.
+type InventoryItem struct {
+	// ResourceType is the AWS Config resource type, e.g. `AWS::EC2::VPC`.
+	ResourceType string `json:"resourceType"`
+	// ID is the id or name of the resource.
+	ID string `json:"id"`
+	// ARN is the ARN of the resource.
+	ARN string `json:"arn"`
+	// Shoot is the technical ID of the shoot the resource belongs to.
+	Shoot string `json:"shoot"`
+}
+
+// Inventory returns the inventory of the AWS resources which have been created for the shoot according to the flow
+// state. Existing resources referenced in the infrastructure config are not part of the inventory.
+func (c *FlowContext) Inventory(ctx context.Context) ([]InventoryItem, error) {
+	accountID, err := c.client.GetAccountID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get account ID: %w", err)
+	}
+
+	var items []InventoryItem
+	add := func(resourceType string, id *string, arn func(id string) string) {
+		if id == nil {
+			return
+		}
+		items = append(items, InventoryItem{ResourceType: resourceType, ID: *id, ARN: arn(*id), Shoot: c.namespace})
+	}
+	ec2ARN := func(resource string) func(id string) string {
+		return func(id string) string {
+			return fmt.Sprintf("arn:%s:ec2:%s:%s:%s/%s", c.partition, c.infraSpec.Region, accountID, resource, id)
+		}
+	}
+	iamARN := func(resource string) func(id string) string {
+		return func(id string) string {
+			return fmt.Sprintf("arn:%s:iam::%s:%s/%s", c.partition, accountID, resource, id)
+		}
+	}
+	existingVPC := c.config.Networks.VPC.ID != nil
+
+	if !existingVPC {
+		add("AWS::EC2::VPC", c.state.Get(IdentifierVPC), ec2ARN("vpc"))
+		add("AWS::EC2::DHCPOptions", c.state.Get(IdentifierDHCPOptions), ec2ARN("dhcp-options"))
+		add("AWS::EC2::InternetGateway", c.state.Get(IdentifierInternetGateway), ec2ARN("internet-gateway"))
+	}
+	if c.config.Networks.VPC.MainRouteTableID == nil {
+		add("AWS::EC2::RouteTable", c.state.Get(IdentifierMainRouteTable), ec2ARN("route-table"))
+	}
+	add("AWS::EC2::EgressOnlyInternetGateway", c.state.Get(IdentifierEgressOnlyInternetGateway), ec2ARN("egress-only-internet-gateway"))
+	add("AWS::EC2::CarrierGateway", c.state.Get(IdentifierCarrierGateway), ec2ARN("carrier-gateway"))
+	add("AWS::EC2::SecurityGroup", c.state.Get(IdentifierNodesSecurityGroup), ec2ARN("security-group"))
+	add("AWS::EC2::SecurityGroup", c.state.Get(IdentifierNATInstanceSecurityGroup), ec2ARN("security-group"))
+	add("AWS::EC2::TransitGatewayAttachment", c.state.Get(IdentifierTransitGatewayAttachment), ec2ARN("transit-gateway-attachment"))
+	add("AWS::EC2::PrefixList", c.state.Get(IdentifierEgressPrefixList), ec2ARN("prefix-list"))
+	add("AWS::EC2::FlowLog", c.state.Get(IdentifierVPCFlowLog), ec2ARN("vpc-flow-log"))
+	add("AWS::EC2::KeyPair", c.state.Get(NameKeyPair), ec2ARN("key-pair"))
+	add("AWS::Route53Resolver::ResolverEndpoint", c.state.Get(IdentifierRoute53ResolverInboundEndpoint), func(id string) string {
+		return fmt.Sprintf("arn:%s:route53resolver:%s:%s:resolver-endpoint/%s", c.partition, c.infraSpec.Region, accountID, id)
+	})
+	add("AWS::Route53Resolver::ResolverEndpoint", c.state.Get(IdentifierRoute53ResolverOutboundEndpoint), func(id string) string {
+		return fmt.Sprintf("arn:%s:route53resolver:%s:%s:resolver-endpoint/%s", c.partition, c.infraSpec.Region, accountID, id)
+	})
+	add("AWS::EC2::SecurityGroup", c.state.Get(IdentifierRoute53ResolverInboundSecurityGroup), ec2ARN("security-group"))
+	add("AWS::EC2::SecurityGroup", c.state.Get(IdentifierRoute53ResolverOutboundSecurityGroup), ec2ARN("security-group"))
+	add("AWS::EFS::FileSystem", c.state.Get(IdentifierElasticFileSystem), func(id string) string {
+		return fmt.Sprintf("arn:%s:elasticfilesystem:%s:%s:file-system/%s", c.partition, c.infraSpec.Region, accountID, id)
+	})
+	add("AWS::S3::Bucket", c.state.Get(IdentifierLoadBalancerAccessLogsBucket), func(id string) string {
+		return fmt.Sprintf("arn:%s:s3:::%s", c.partition, id)
+	})
+	add("AWS::Logs::LogGroup", c.state.Get(NameVPCFlowLogsLogGroup), func(id string) string {
+		return fmt.Sprintf("arn:%s:logs:%s:%s:log-group:%s", c.partition, c.infraSpec.Region, accountID, id)
+	})
+	add("AWS::IAM::Role", c.state.Get(NameIAMRole), iamARN("role"))
+	add("AWS::IAM::InstanceProfile", c.state.Get(NameIAMInstanceProfile), iamARN("instance-profile"))
+	add("AWS::IAM::Role", c.state.Get(NameVPCFlowLogsIAMRole), iamARN("role"))
+	add("AWS::IAM::Role", c.state.Get(NameNATInstanceIAMRole), iamARN("role"))
+	add("AWS::IAM::InstanceProfile", c.state.Get(NameNATInstanceIAMInstanceProfile), iamARN("instance-profile"))
+
+	for _, id := range sortedValues(c.state.GetChild(ChildIdVPCEndpoints)) {
+		add("AWS::EC2::VPCEndpoint", &id, ec2ARN("vpc-endpoint"))
+	}
+	for _, id := range sortedValues(c.state.GetChild(ChildIdNetworkACLs)) {
+		add("AWS::EC2::NetworkAcl", &id, ec2ARN("network-acl"))
+	}
+	for _, id := range sortedKeys(c.state.GetChild(ChildIdElasticIPPool)) {
+		add("AWS::EC2::EIP", &id, ec2ARN("elastic-ip"))
+	}
+	peerings := c.state.GetChild(ChildIdVPCPeerings)
+	for _, key := range sortedChildrenKeys(peerings) {
+		add("AWS::EC2::VPCPeeringConnection", peerings.GetChild(key).Get(IdentifierVPCPeeringConnection), ec2ARN("vpc-peering-connection"))
+	}
+
+	zones := c.state.GetChild(ChildIdZones)
+	for _, zoneName := range sortedChildrenKeys(zones) {
+		zoneChild := zones.GetChild(zoneName)
+		if !c.config.Networks.VPC.Shared {
+			for _, key := range []string{IdentifierZoneSubnetWorkers, IdentifierZoneSubnetPublic, IdentifierZoneSubnetPrivate, IdentifierZoneSubnetPods, IdentifierZoneSubnetWorkersSecondary} {
+				add("AWS::EC2::Subnet", zoneChild.Get(key), ec2ARN("subnet"))
+			}
+		}
+		if c.getZoneRouteTableID(zoneName) == nil {
+			add("AWS::EC2::RouteTable", zoneChild.Get(IdentifierZoneRouteTable), ec2ARN("route-table"))
+		}
+		add("AWS::EC2::NatGateway", zoneChild.Get(IdentifierZoneNATGateway), ec2ARN("natgateway"))
+		add("AWS::EC2::EIP", zoneChild.Get(IdentifierZoneNATGWElasticIP), ec2ARN("elastic-ip"))
+		add("AWS::EC2::Instance", zoneChild.Get(IdentifierZoneNATInstance), ec2ARN("instance"))
+		add("AWS::EC2::LaunchTemplate", zoneChild.Get(IdentifierZoneNATInstanceLaunchTemplate), ec2ARN("launch-template"))
+		add("AWS::AutoScaling::AutoScalingGroup", zoneChild.Get(IdentifierZoneNATInstanceAutoScalingGroup), func(id string) string {
+			return fmt.Sprintf("arn:%s:autoscaling:%s:%s:autoScalingGroup:*:autoScalingGroupName/%s", c.partition, c.infraSpec.Region, accountID, id)
+		})
+	}
+
+	return items, nil
+}
+
+func sortedValues(wb Whiteboard) []string {
+	var values []string
+	for _, value := range wb.AsMap() {
+		values = append(values, value)
+	}
+	sort.Strings(values)
+	return values
+}
+
+func sortedKeys(wb Whiteboard) []string {
+	var keys []string
+	for key := range wb.AsMap() {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedChildrenKeys(wb Whiteboard) []string {
+	keys := wb.GetChildrenKeys()
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,254 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/pointer"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// PlanAction is the action of a planned change.
+type PlanAction string
+
+const (
+	// PlanActionCreate is the action of a resource which would be created.
+	PlanActionCreate PlanAction = "Create"
+	// PlanActionDelete is the action of a resource which would be deleted.
+	PlanActionDelete PlanAction = "Delete"
+)
+
+// PlannedChange is a change of an AWS resource which the reconciliation of the infrastructure would perform.
+type PlannedChange struct {
+	// Action is the action performed on the resource.
+	Action PlanAction `json:"action"`
+	// ResourceType is the type of the resource, e.g. `subnet`.
+	ResourceType string `json:"resourceType"`
+	// Name describes the resource, e.g. the zone and the CIDR of a subnet.
+	Name string `json:"name"`
+	// ID is the id of the resource if it exists already.
+	ID string `json:"id,omitempty"`
+}
+
+// Plan computes the AWS resources which a reconciliation with the current infrastructure config would create or
+// delete, without changing anything. The resources in the flow state are compared with the desired ones; only the
+// subnets are looked up, as changing their CIDRs replaces them. Changes of attributes which are updated in place, e.g.
+// tags or routes, are not part of the plan.
+func (c *FlowContext) Plan(ctx context.Context) ([]PlannedChange, error) {
+	var (
+		changes []PlannedChange
+
+		createVPC   = c.config.Networks.VPC.ID == nil
+		sharedVPC   = c.config.Networks.VPC.Shared
+		natType     = helper.GetNATGatewayType(c.config)
+		zoneNames   = sets.New[string]()
+		createIAM   = helper.GetNodesInstanceProfileName(c.config) == ""
+		useEFS      = c.config.ElasticFileSystem != nil && c.config.ElasticFileSystem.Enabled
+		useLBLogs   = c.config.LoadBalancerAccessLogs != nil && c.config.LoadBalancerAccessLogs.Enabled
+		usePrefixes = c.config.EgressPrefixList != nil && c.config.EgressPrefixList.Enabled
+	)
+	create := func(resourceType, name string) {
+		changes = append(changes, PlannedChange{Action: PlanActionCreate, ResourceType: resourceType, Name: name})
+	}
+	remove := func(resourceType, name, id string) {
+		changes = append(changes, PlannedChange{Action: PlanActionDelete, ResourceType: resourceType, Name: name, ID: id})
+	}
+	// ensure plans the creation of a resource which is desired but not in the state, and the deletion of a resource
+	// which is in the state but not desired.
+	ensure := func(desired bool, key, resourceType, name string) {
+		id := c.state.Get(key)
+		switch {
+		case desired && id == nil:
+			create(resourceType, name)
+		case !desired && id != nil:
+			remove(resourceType, name, *id)
+		}
+	}
+	// ensureCreated plans the creation of a resource which is desired but not in the state. Such resources are only
+	// deleted together with the infrastructure.
+	ensureCreated := func(desired bool, key, resourceType, name string) {
+		ensure(desired || c.state.Get(key) != nil, key, resourceType, name)
+	}
+
+	ensureCreated(createVPC, IdentifierDHCPOptions, "dhcp-options", "DHCP options")
+	ensureCreated(createVPC, IdentifierVPC, "vpc", "VPC "+pointer.StringDeref(c.config.Networks.VPC.CIDR, ""))
+	ensureCreated(createVPC, IdentifierInternetGateway, "internet-gateway", "internet gateway")
+	ensureCreated(!sharedVPC && c.config.Networks.VPC.MainRouteTableID == nil, IdentifierMainRouteTable, "route-table", "main route table")
+	ensureCreated(true, IdentifierNodesSecurityGroup, "security-group", "nodes security group")
+	ensureCreated(helper.IsDualStack(c.config) && !sharedVPC, IdentifierEgressOnlyInternetGateway, "egress-only-internet-gateway", "egress-only internet gateway")
+	ensure(helper.HasZoneOfType(c.config, aws.ZoneTypeWavelengthZone) && !sharedVPC, IdentifierCarrierGateway, "carrier-gateway", "carrier gateway")
+	ensure(natType == aws.NATGatewayTypeInstance, IdentifierNATInstanceSecurityGroup, "security-group", "NAT instance security group")
+	ensure(c.config.Networks.TransitGateway != nil, IdentifierTransitGatewayAttachment, "transit-gateway-attachment", "transit gateway attachment")
+	ensure(usePrefixes, IdentifierEgressPrefixList, "prefix-list", "egress prefix list")
+	ensure(c.config.VPCFlowLogs != nil, IdentifierVPCFlowLog, "vpc-flow-log", "VPC flow log")
+	ensure(useEFS, IdentifierElasticFileSystem, "file-system", "EFS file system")
+	ensure(useLBLogs, IdentifierLoadBalancerAccessLogsBucket, "bucket", "load balancer access logs bucket")
+	ensureCreated(createIAM, NameIAMRole, "iam-role", "nodes IAM role")
+	ensureCreated(createIAM, NameIAMInstanceProfile, "iam-instance-profile", "nodes IAM instance profile")
+	ensureCreated(true, NameKeyPair, "key-pair", "nodes key pair")
+
+	switch {
+	case c.config.Networks.Route53Resolver != nil && !c.hasRoute53Resolver():
+		create("route53-resolver", "Route 53 Resolver endpoints and rules")
+	case c.config.Networks.Route53Resolver == nil && c.hasRoute53Resolver():
+		remove("route53-resolver", "Route 53 Resolver endpoints and rules", pointer.StringDeref(c.state.Get(IdentifierRoute53ResolverOutboundEndpoint), ""))
+	}
+
+	networkACLs := c.state.GetChild(ChildIdNetworkACLs)
+	switch {
+	case c.config.Networks.NetworkACLs != nil && !c.hasNetworkACLs():
+		create("network-acl", "network ACLs")
+	case c.config.Networks.NetworkACLs == nil:
+		for _, key := range sortedKeys(networkACLs) {
+			remove("network-acl", key+" network ACL", *networkACLs.Get(key))
+		}
+	}
+
+	endpoints := c.state.GetChild(ChildIdVPCEndpoints)
+	desiredEndpoints := sets.New(helper.GetVPCEndpointServices(c.config.Networks.VPC, aws.VPCEndpointTypeGateway)...).
+		Insert(helper.GetVPCEndpointServices(c.config.Networks.VPC, aws.VPCEndpointTypeInterface)...)
+	for _, name := range sets.List(desiredEndpoints) {
+		if endpoints.Get(name) == nil {
+			create("vpc-endpoint", name)
+		}
+	}
+	for _, name := range sortedKeys(endpoints) {
+		if !desiredEndpoints.Has(name) {
+			remove("vpc-endpoint", name, *endpoints.Get(name))
+		}
+	}
+
+	peerings := c.state.GetChild(ChildIdVPCPeerings)
+	desiredPeerings := sets.New[string]()
+	for _, peering := range c.config.Networks.VPCPeerings {
+		desiredPeerings.Insert(peering.PeerVPCID)
+		if peerings.GetChild(peering.PeerVPCID).Get(IdentifierVPCPeeringConnection) == nil {
+			create("vpc-peering-connection", peering.PeerVPCID)
+		}
+	}
+	for _, key := range sortedChildrenKeys(peerings) {
+		if id := peerings.GetChild(key).Get(IdentifierVPCPeeringConnection); id != nil && !desiredPeerings.Has(key) {
+			remove("vpc-peering-connection", key, *id)
+		}
+	}
+
+	if !sharedVPC {
+		subnetChanges, err := c.planSubnets(ctx)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, subnetChanges...)
+	}
+
+	zones := c.state.GetChild(ChildIdZones)
+	for _, zone := range c.config.Networks.Zones {
+		zoneNames.Insert(zone.Name)
+		zoneChild := zones.GetChild(zone.Name)
+		hasNAT := helper.GetNATGatewayZoneName(c.config, zone.Name) == zone.Name
+
+		if !sharedVPC && zone.RouteTableID == nil && zoneChild.Get(IdentifierZoneRouteTable) == nil {
+			create("route-table", "route table "+zone.Name)
+		}
+		if zoneChild.Get(IdentifierZoneNATGWElasticIP) == nil && hasNAT && natType == aws.NATGatewayTypeGateway &&
+			zone.ElasticIPAllocationID == nil && !c.useElasticIPPool() {
+			create("elastic-ip", "NAT gateway elastic IP "+zone.Name)
+		}
+		ensureZoneResource := func(desired bool, key, resourceType, name string) {
+			id := zoneChild.Get(key)
+			switch {
+			case desired && id == nil:
+				create(resourceType, name)
+			case !desired && id != nil:
+				remove(resourceType, name, *id)
+			}
+		}
+		ensureZoneResource(hasNAT && natType == aws.NATGatewayTypeGateway, IdentifierZoneNATGateway, "natgateway", "NAT gateway "+zone.Name)
+		ensureZoneResource(hasNAT && natType == aws.NATGatewayTypeInstance, IdentifierZoneNATInstanceAutoScalingGroup, "autoscaling-group", "NAT instance "+zone.Name)
+	}
+
+	// all resources of zones which have been removed are deleted, the subnets have been planned already
+	for _, zoneName := range sortedChildrenKeys(zones) {
+		if zoneNames.Has(zoneName) {
+			continue
+		}
+		zoneChild := zones.GetChild(zoneName)
+		for _, resource := range []struct{ key, resourceType, name string }{
+			{IdentifierZoneNATGateway, "natgateway", "NAT gateway "},
+			{IdentifierZoneNATGWElasticIP, "elastic-ip", "NAT gateway elastic IP "},
+			{IdentifierZoneNATInstanceAutoScalingGroup, "autoscaling-group", "NAT instance "},
+			{IdentifierZoneRouteTable, "route-table", "route table "},
+		} {
+			if id := zoneChild.Get(resource.key); id != nil {
+				remove(resource.resourceType, resource.name+zoneName, *id)
+			}
+		}
+	}
+
+	return changes, nil
+}
+
+// planSubnets compares the subnets of the zones with the existing ones by their zones and CIDRs, as the CIDR of a
+// subnet can't be changed.
+func (c *FlowContext) planSubnets(ctx context.Context) ([]PlannedChange, error) {
+	type subnet struct{ role, zone, cidr string }
+	var desired []subnet
+	for _, zone := range c.config.Networks.Zones {
+		desired = append(desired,
+			subnet{"workers", zone.Name, zone.Workers},
+			subnet{"public", zone.Name, zone.Public},
+			subnet{"internal", zone.Name, zone.Internal})
+		if zone.Pods != nil {
+			desired = append(desired, subnet{"pods", zone.Name, *zone.Pods})
+		}
+		if zone.SecondaryWorkers != nil {
+			desired = append(desired, subnet{"workers-secondary", zone.Name, *zone.SecondaryWorkers})
+		}
+	}
+
+	var current []*awsclient.Subnet
+	if c.state.Get(IdentifierVPC) != nil {
+		var err error
+		if current, err = c.collectExistingSubnets(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		changes  []PlannedChange
+		existing = sets.New[string]()
+	)
+	for _, item := range current {
+		existing.Insert(item.AvailabilityZone + "-" + item.CidrBlock)
+	}
+	desiredKeys := sets.New[string]()
+	for _, item := range desired {
+		desiredKeys.Insert(item.zone + "-" + item.cidr)
+		if !existing.Has(item.zone + "-" + item.cidr) {
+			changes = append(changes, PlannedChange{Action: PlanActionCreate, ResourceType: "subnet", Name: fmt.Sprintf("%s subnet %s (%s)", item.role, item.cidr, item.zone)})
+		}
+	}
+	for _, item := range current {
+		if !desiredKeys.Has(item.AvailabilityZone + "-" + item.CidrBlock) {
+			changes = append(changes, PlannedChange{Action: PlanActionDelete, ResourceType: "subnet", Name: fmt.Sprintf("subnet %s (%s)", item.CidrBlock, item.AvailabilityZone), ID: item.SubnetId})
+		}
+	}
+	return changes, nil
+}
@@ -22,16 +22,20 @@ import (
 	"math/big"
 	"net"
 	"reflect"
+	"slices"
 	"strings"
 	"text/template"
 	"time"
 
-	"github.com/aws/aws-sdk-go/service/ec2"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/pointer"
 
 	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
 	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 	. "github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow/shared"
 )
@@ -39,13 +43,17 @@ import (
 const (
 	defaultTimeout     = 90 * time.Second
 	defaultLongTimeout = 3 * time.Minute
+
+	// MaxParallelZoneTasks is the maximum number of tasks creating, updating or deleting the resources of the zones
+	// (subnets, NAT gateways, elastic IPs and route tables) which run concurrently.
+	MaxParallelZoneTasks = 10
 )
 
 // Reconcile creates and runs the flow to reconcile the AWS infrastructure.
 func (c *FlowContext) Reconcile(ctx context.Context) error {
 	g := c.buildReconcileGraph()
 	f := g.Compile()
-	if err := f.Run(ctx, flow.Opts{Log: c.Log}); err != nil {
+	if err := f.Run(ctx, c.FlowOpts()); err != nil {
 		return flow.Causes(err)
 	}
 	return nil
@@ -53,6 +61,9 @@ func (c *FlowContext) Reconcile(ctx context.Context) error {
 
 func (c *FlowContext) buildReconcileGraph() *flow.Graph {
 	createVPC := c.config.Networks.VPC.ID == nil
+	// the gateways and route tables of a shared VPC are owned by the account of the VPC
+	sharedVPC := c.config.Networks.VPC.Shared
+	useNATInstances := helper.GetNATGatewayType(c.config) == aws.NATGatewayTypeInstance
 	g := flow.NewGraph("AWS infrastructure reconcilation")
 
 	ensureDhcpOptions := c.AddTask(g, "ensure DHCP options for VPC",
@@ -63,10 +74,18 @@ func (c *FlowContext) buildReconcileGraph() *flow.Graph {
 		c.ensureVpc,
 		Timeout(defaultTimeout), Dependencies(ensureDhcpOptions))
 
+	_ = c.AddTask(g, "delete obsolete DHCP options",
+		c.deleteObsoleteDhcpOptions,
+		DoIf(createVPC), Timeout(defaultTimeout), Dependencies(ensureVpc))
+
 	ensureVpcIPv6CidrBloc := c.AddTask(g, "ensure IPv6 CIDR Block",
 		c.ensureVpcIPv6CidrBlock,
 		Timeout(defaultTimeout), Dependencies(ensureVpc))
 
+	ensureVpcSecondaryCidrBlocks := c.AddTask(g, "ensure VPC secondary CIDR blocks",
+		c.ensureVpcSecondaryCidrBlocks,
+		Timeout(defaultTimeout), Dependencies(ensureVpc))
+
 	ensureDefaultSecurityGroup := c.AddTask(g, "ensure default security group",
 		c.ensureDefaultSecurityGroup,
 		DoIf(createVPC), Timeout(defaultTimeout), Dependencies(ensureVpc))
@@ -75,37 +94,153 @@ func (c *FlowContext) buildReconcileGraph() *flow.Graph {
 		c.ensureInternetGateway,
 		DoIf(createVPC), Timeout(defaultTimeout), Dependencies(ensureVpc))
 
-	_ = c.AddTask(g, "ensure gateway endpoints",
+	ensureGatewayEndpoints := c.AddTask(g, "ensure gateway endpoints",
 		c.ensureGatewayEndpoints,
 		Timeout(defaultTimeout), Dependencies(ensureVpc, ensureDefaultSecurityGroup, ensureInternetGateway))
 
 	ensureMainRouteTable := c.AddTask(g, "ensure main route table",
 		c.ensureMainRouteTable,
-		Timeout(defaultTimeout), Dependencies(ensureVpc, ensureVpcIPv6CidrBloc, ensureDefaultSecurityGroup, ensureInternetGateway))
+		DoIf(!sharedVPC), Timeout(defaultTimeout), Dependencies(ensureVpc, ensureVpcIPv6CidrBloc, ensureDefaultSecurityGroup, ensureInternetGateway))
 
 	ensureNodesSecurityGroup := c.AddTask(g, "ensure nodes security group",
 		c.ensureNodesSecurityGroup,
 		Timeout(defaultTimeout), Dependencies(ensureVpc))
 
+	ensureEgressOnlyInternetGateway := c.AddTask(g, "ensure egress only internet gateway",
+		c.ensureEgressOnlyInternetGateway,
+		DoIf(helper.IsDualStack(c.config) && !sharedVPC), Timeout(defaultTimeout), Dependencies(ensureVpc))
+
+	// a carrier gateway is needed for the public subnets of Wavelength Zones
+	useCarrierGateway := helper.HasZoneOfType(c.config, aws.ZoneTypeWavelengthZone)
+
+	ensureCarrierGateway := c.AddTask(g, "ensure carrier gateway",
+		c.ensureCarrierGateway,
+		DoIf(useCarrierGateway && !sharedVPC), Timeout(defaultTimeout), Dependencies(ensureVpc))
+
+	ensureNATInstanceSecurityGroup := c.AddTask(g, "ensure NAT instance security group",
+		c.ensureNATInstanceSecurityGroup,
+		DoIf(useNATInstances), Timeout(defaultTimeout), Dependencies(ensureVpc))
+
+	ensureNATInstanceIAM := c.AddTask(g, "ensure NAT instance IAM role",
+		c.ensureNATInstanceIAM,
+		DoIf(useNATInstances), Timeout(defaultTimeout))
+
+	ensureElasticIPPool := c.AddTask(g, "ensure elastic IP pool",
+		c.ensureElasticIPPool,
+		DoIf(c.useElasticIPPool()), Timeout(defaultTimeout))
+
+	// the network interfaces of interface endpoints block the deletion of the workers subnets of removed zones
+	removedZonesWorkersSubnetIDs := c.removedZonesWorkersSubnetIDs()
+
+	detachInterfaceEndpoints := c.AddTask(g, "detach interface endpoints from removed zones",
+		c.detachInterfaceEndpointsFromRemovedZones,
+		DoIf(removedZonesWorkersSubnetIDs.Len() > 0), Timeout(defaultTimeout), Dependencies(ensureVpc))
+
+	// the same applies to the EFS mount targets
+	deleteRemovedZonesMountTargets := c.AddTask(g, "delete EFS mount targets of removed zones",
+		c.deleteElasticFileSystemMountTargetsOfRemovedZones,
+		DoIf(removedZonesWorkersSubnetIDs.Len() > 0 && c.state.Get(IdentifierElasticFileSystem) != nil), Timeout(defaultLongTimeout))
+
 	ensureZones := c.AddTask(g, "ensure zones resources",
 		c.ensureZones,
-		Timeout(defaultLongTimeout), Dependencies(ensureVpc, ensureNodesSecurityGroup, ensureVpcIPv6CidrBloc, ensureMainRouteTable))
+		Timeout(defaultLongTimeout), Dependencies(ensureVpc, ensureNodesSecurityGroup, ensureVpcIPv6CidrBloc, ensureVpcSecondaryCidrBlocks, ensureMainRouteTable, ensureEgressOnlyInternetGateway, ensureCarrierGateway, ensureNATInstanceSecurityGroup, ensureNATInstanceIAM, ensureElasticIPPool, detachInterfaceEndpoints, deleteRemovedZonesMountTargets))
+
+	_ = c.AddTask(g, "delete NAT instance security group",
+		c.deleteNATInstanceSecurityGroup,
+		DoIf(!useNATInstances && c.state.Get(IdentifierNATInstanceSecurityGroup) != nil), Timeout(defaultTimeout), Dependencies(ensureZones))
+
+	_ = c.AddTask(g, "delete NAT instance IAM role",
+		c.deleteNATInstanceIAM,
+		DoIf(!useNATInstances && c.state.Get(NameNATInstanceIAMRole) != nil), Timeout(defaultTimeout), Dependencies(ensureZones))
 
-	_ = c.AddTask(g, "ensure egress CIDRs",
+	_ = c.AddTask(g, "delete carrier gateway",
+		c.deleteCarrierGateway,
+		DoIf(!useCarrierGateway && c.state.Get(IdentifierCarrierGateway) != nil), Timeout(defaultTimeout), Dependencies(ensureZones))
+
+	_ = c.AddTask(g, "ensure interface endpoints",
+		c.ensureInterfaceEndpoints,
+		Timeout(defaultTimeout), Dependencies(ensureZones, ensureGatewayEndpoints))
+
+	ensureEgressCIDRs := c.AddTask(g, "ensure egress CIDRs",
 		c.ensureEgressCIDRs,
 		Timeout(defaultLongTimeout), Dependencies(ensureZones))
 
+	useEgressPrefixList := c.config.EgressPrefixList != nil && c.config.EgressPrefixList.Enabled
+
+	_ = c.AddTask(g, "ensure egress prefix list",
+		c.ensureEgressPrefixList,
+		DoIf(useEgressPrefixList), Timeout(defaultLongTimeout), Dependencies(ensureEgressCIDRs))
+
+	_ = c.AddTask(g, "delete egress prefix list",
+		c.deleteEgressPrefixList,
+		DoIf(!useEgressPrefixList && c.state.Get(IdentifierEgressPrefixList) != nil), Timeout(defaultTimeout))
+
+	ensureTransitGatewayAttachment := c.AddTask(g, "ensure transit gateway attachment",
+		c.ensureTransitGatewayAttachment,
+		Timeout(defaultLongTimeout), Dependencies(ensureZones))
+
+	_ = c.AddTask(g, "ensure transit gateway routes",
+		c.ensureTransitGatewayRoutes,
+		Timeout(defaultTimeout), Dependencies(ensureTransitGatewayAttachment))
+
+	ensureVPCPeerings := c.AddTask(g, "ensure VPC peerings",
+		c.ensureVPCPeerings,
+		Timeout(defaultLongTimeout), Dependencies(ensureVpc))
+
+	_ = c.AddTask(g, "ensure VPC peering routes",
+		c.ensureVPCPeeringRoutes,
+		Timeout(defaultTimeout), Dependencies(ensureZones, ensureVPCPeerings))
+
+	_ = c.AddTask(g, "ensure Route53 Resolver",
+		c.ensureRoute53Resolver,
+		DoIf(c.config.Networks.Route53Resolver != nil || c.hasRoute53Resolver()), Timeout(defaultLongTimeout), Dependencies(ensureZones))
+
+	_ = c.AddTask(g, "ensure network ACLs",
+		c.ensureNetworkACLs,
+		DoIf(c.config.Networks.NetworkACLs != nil || c.hasNetworkACLs()), Timeout(defaultTimeout), Dependencies(ensureZones))
+
+	_ = c.AddTask(g, "ensure VPC flow logs",
+		c.ensureVPCFlowLogs,
+		DoIf(c.config.VPCFlowLogs != nil), Timeout(defaultTimeout), Dependencies(ensureVpc))
+
+	_ = c.AddTask(g, "delete VPC flow logs",
+		c.deleteVPCFlowLogs,
+		DoIf(c.config.VPCFlowLogs == nil && c.hasVPCFlowLogs()), Timeout(defaultTimeout))
+
+	useElasticFileSystem := c.config.ElasticFileSystem != nil && c.config.ElasticFileSystem.Enabled
+
+	_ = c.AddTask(g, "ensure EFS file system",
+		c.ensureElasticFileSystem,
+		DoIf(useElasticFileSystem), Timeout(defaultLongTimeout), Dependencies(ensureZones))
+
+	_ = c.AddTask(g, "delete EFS file system",
+		c.deleteElasticFileSystem,
+		DoIf(!useElasticFileSystem && c.state.Get(IdentifierElasticFileSystem) != nil), Timeout(defaultLongTimeout))
+
+	useLoadBalancerAccessLogs := c.config.LoadBalancerAccessLogs != nil && c.config.LoadBalancerAccessLogs.Enabled
+
+	_ = c.AddTask(g, "ensure load balancer access logs bucket",
+		c.ensureLoadBalancerAccessLogsBucket,
+		DoIf(useLoadBalancerAccessLogs), Timeout(defaultTimeout))
+
+	_ = c.AddTask(g, "delete load balancer access logs bucket",
+		c.deleteLoadBalancerAccessLogsBucket,
+		DoIf(!useLoadBalancerAccessLogs && c.state.Get(IdentifierLoadBalancerAccessLogsBucket) != nil), Timeout(defaultLongTimeout))
+
+	// no IAM resources are created for the nodes if an existing instance profile is used
+	createNodesIAM := helper.GetNodesInstanceProfileName(c.config) == ""
+
 	ensureIAMRole := c.AddTask(g, "ensure IAM role",
 		c.ensureIAMRole,
-		Timeout(defaultTimeout))
+		DoIf(createNodesIAM), Timeout(defaultTimeout))
 
 	_ = c.AddTask(g, "ensure IAM instance profile",
 		c.ensureIAMInstanceProfile,
-		Timeout(defaultTimeout), Dependencies(ensureIAMRole))
+		DoIf(createNodesIAM), Timeout(defaultTimeout), Dependencies(ensureIAMRole))
 
 	_ = c.AddTask(g, "ensure IAM role policy",
 		c.ensureIAMRolePolicy,
-		Timeout(defaultTimeout), Dependencies(ensureIAMRole))
+		DoIf(createNodesIAM), Timeout(defaultTimeout), Dependencies(ensureIAMRole))
 
 	_ = c.AddTask(g, "ensure key pair",
 		c.ensureKeyPair,
@@ -115,25 +250,21 @@ func (c *FlowContext) buildReconcileGraph() *flow.Graph {
 }
 
 func (c *FlowContext) getDesiredDhcpOptions() *awsclient.DhcpOptions {
-	dhcpDomainName := "ec2.internal"
-	if c.infraSpec.Region != "us-east-1" {
-		dhcpDomainName = fmt.Sprintf("%s.compute.internal", c.infraSpec.Region)
-	}
-
 	return &awsclient.DhcpOptions{
-		Tags: c.commonTags,
-		DhcpConfigurations: map[string][]string{
-			"domain-name":         {dhcpDomainName},
-			"domain-name-servers": {"AmazonProvidedDNS"},
-		},
+		Tags:               c.commonTags,
+		DhcpConfigurations: helper.GetDHCPConfigurations(c.config.Networks.VPC, c.infraSpec.Region),
 	}
 }
 
 func (c *FlowContext) ensureDhcpOptions(ctx context.Context) error {
 	log := c.LogFromContext(ctx)
 	desired := c.getDesiredDhcpOptions()
+	// DHCP options are immutable, hence a new resource is created if the configurations have been changed, which
+	// replaces the former one in the association with the VPC
 	current, err := findExisting(ctx, c.state.Get(IdentifierDHCPOptions), c.commonTags,
-		c.client.GetVpcDhcpOptions, c.client.FindVpcDhcpOptionsByTags)
+		c.client.GetVpcDhcpOptions, c.client.FindVpcDhcpOptionsByTags, func(item *awsclient.DhcpOptions) bool {
+			return reflect.DeepEqual(item.DhcpConfigurations, desired.DhcpConfigurations)
+		})
 	if err != nil {
 		return err
 	}
@@ -154,6 +285,42 @@ func (c *FlowContext) ensureDhcpOptions(ctx context.Context) error {
 	return nil
 }
 
+func (c *FlowContext) deleteObsoleteDhcpOptions(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+	current := c.state.Get(IdentifierDHCPOptions)
+	if current == nil {
+		return nil
+	}
+	found, err := c.client.FindVpcDhcpOptionsByTags(ctx, identifyingTags(c.commonTags))
+	if err != nil {
+		return err
+	}
+	for _, item := range found {
+		if item.DhcpOptionsId == *current {
+			continue
+		}
+		if err := c.deleteDhcpOptionsIfUnused(ctx, log, item.DhcpOptionsId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteDhcpOptionsIfUnused deletes the DHCP options unless they are still associated with any VPC, e.g. because they
+// have been associated with another VPC manually.
+func (c *FlowContext) deleteDhcpOptionsIfUnused(ctx context.Context, log logr.Logger, id string) error {
+	vpcIDs, err := c.client.FindVpcIDsByDhcpOptions(ctx, id)
+	if err != nil {
+		return err
+	}
+	if len(vpcIDs) > 0 {
+		log.Info("not deleting DHCP options which are still associated with other VPCs", "DhcpOptionsId", id, "VpcIds", vpcIDs)
+		return nil
+	}
+	log.Info("deleting...", "DhcpOptionsId", id)
+	return c.client.DeleteVpcDhcpOptions(ctx, id)
+}
+
 func (c *FlowContext) ensureVpc(ctx context.Context) error {
 	if c.config.Networks.VPC.ID != nil {
 		return c.ensureExistingVpc(ctx)
@@ -168,7 +335,7 @@ func (c *FlowContext) ensureManagedVpc(ctx context.Context) error {
 		Tags:                         c.commonTags,
 		EnableDnsSupport:             true,
 		EnableDnsHostnames:           true,
-		AssignGeneratedIPv6CidrBlock: c.config.DualStack != nil && c.config.DualStack.Enabled,
+		AssignGeneratedIPv6CidrBlock: helper.IsDualStack(c.config),
 		DhcpOptionsId:                c.state.Get(IdentifierDHCPOptions),
 	}
 	if c.config.Networks.VPC.CIDR == nil {
@@ -204,7 +371,7 @@ func (c *FlowContext) ensureManagedVpc(ctx context.Context) error {
 }
 
 func (c *FlowContext) ensureVpcIPv6CidrBlock(ctx context.Context) error {
-	if c.config.DualStack != nil && c.config.DualStack.Enabled {
+	if helper.IsDualStack(c.config) {
 		current, err := findExisting(ctx, c.state.Get(IdentifierVPC), c.commonTags,
 			c.client.GetVpc, c.client.FindVpcsByTags)
 		if err != nil {
@@ -234,6 +401,21 @@ func (c *FlowContext) ensureExistingVpc(ctx context.Context) error {
 	if err := c.validateVpc(ctx, current); err != nil {
 		return err
 	}
+	if c.config.Networks.VPC.Shared {
+		// the internet gateway of a shared VPC belongs to the account of the VPC and isn't visible for the shoot
+		return nil
+	}
+	if id := c.config.Networks.VPC.InternetGatewayID; id != nil {
+		gw, err := c.client.GetInternetGateway(ctx, *id)
+		if err != nil {
+			return err
+		}
+		if gw == nil || pointer.StringDeref(gw.VpcId, "") != vpcID {
+			return fmt.Errorf("internet gateway %s is not attached to VPC %s", *id, vpcID)
+		}
+		c.state.Set(IdentifierInternetGateway, gw.InternetGatewayId)
+		return nil
+	}
 	gw, err := c.client.FindInternetGatewayByVPC(ctx, vpcID)
 	if err != nil {
 		return fmt.Errorf("Internet Gateway not found for VPC %s", vpcID)
@@ -266,12 +448,62 @@ func (c *FlowContext) validateVpc(ctx context.Context, item *awsclient.VPC) erro
 				k, strings.Join(v, ","), strings.Join(options.DhcpConfigurations[k], ","))
 		}
 	}
-	if c.config.DualStack != nil && c.config.DualStack.Enabled && item.IPv6CidrBlock == "" {
+	if helper.IsDualStack(c.config) && item.IPv6CidrBlock == "" {
 		return fmt.Errorf("VPC has no ipv6 CIDR")
 	}
 	return nil
 }
 
+func (c *FlowContext) ensureVpcSecondaryCidrBlocks(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+	vpcID := *c.state.Get(IdentifierVPC)
+	current, err := c.client.GetVpc(ctx, vpcID)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return fmt.Errorf("vpc %s not found", vpcID)
+	}
+	associated := map[string]string{}
+	for _, assoc := range current.SecondaryCidrBlocks {
+		associated[assoc.CidrBlock] = assoc.AssociationId
+	}
+
+	// only CIDR blocks associated by this controller are tracked in the state and may be disassociated again
+	owned := c.getOwnedVpcSecondaryCidrBlocks()
+	desired := sets.New(c.config.Networks.VPC.SecondaryCidrBlocks...)
+	for _, cidr := range sets.List(owned.Difference(desired)) {
+		if associationID, ok := associated[cidr]; ok {
+			log.Info("disassociating...", "CidrBlock", cidr)
+			if err := c.client.DisassociateVpcCidrBlock(ctx, associationID); err != nil {
+				return err
+			}
+		}
+		owned.Delete(cidr)
+		c.state.Set(IdentifierVpcSecondaryCidrBlocks, strings.Join(sets.List(owned), ","))
+	}
+	for _, cidr := range sets.List(desired) {
+		if _, ok := associated[cidr]; ok {
+			continue
+		}
+		log.Info("associating...", "CidrBlock", cidr)
+		if _, err := c.client.AssociateVpcCidrBlock(ctx, vpcID, cidr); err != nil {
+			return err
+		}
+		owned.Insert(cidr)
+		c.state.Set(IdentifierVpcSecondaryCidrBlocks, strings.Join(sets.List(owned), ","))
+	}
+	return nil
+}
+
+func (c *FlowContext) getOwnedVpcSecondaryCidrBlocks() sets.Set[string] {
+	owned := sets.New[string]()
+	if value := c.state.Get(IdentifierVpcSecondaryCidrBlocks); value != nil && *value != "" {
+		owned.Insert(strings.Split(*value, ",")...)
+	}
+	return owned
+}
+
 func (c *FlowContext) ensureDefaultSecurityGroup(ctx context.Context) error {
 	current, err := c.client.FindDefaultSecurityGroupByVpcId(ctx, *c.state.Get(IdentifierVPC))
 	if err != nil {
@@ -324,18 +556,72 @@ func (c *FlowContext) ensureInternetGateway(ctx context.Context) error {
 	return nil
 }
 
+func (c *FlowContext) ensureEgressOnlyInternetGateway(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+	desired := &awsclient.EgressOnlyInternetGateway{
+		Tags:  c.commonTags,
+		VpcId: c.state.Get(IdentifierVPC),
+	}
+	current, err := findExisting(ctx, c.state.Get(IdentifierEgressOnlyInternetGateway), c.commonTags,
+		c.client.GetEgressOnlyInternetGateway, c.client.FindEgressOnlyInternetGatewaysByTags)
+	if err != nil {
+		return err
+	}
+	if current != nil {
+		c.state.Set(IdentifierEgressOnlyInternetGateway, current.EgressOnlyInternetGatewayId)
+		if _, err := c.updater.UpdateEC2Tags(ctx, current.EgressOnlyInternetGatewayId, c.commonTags, current.Tags); err != nil {
+			return err
+		}
+	} else {
+		log.Info("creating...")
+		created, err := c.client.CreateEgressOnlyInternetGateway(ctx, desired)
+		if err != nil {
+			return err
+		}
+		c.state.Set(IdentifierEgressOnlyInternetGateway, created.EgressOnlyInternetGatewayId)
+	}
+	return nil
+}
+
+func (c *FlowContext) ensureCarrierGateway(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+	desired := &awsclient.CarrierGateway{
+		Tags:  c.commonTags,
+		VpcId: c.state.Get(IdentifierVPC),
+	}
+	current, err := findExisting(ctx, c.state.Get(IdentifierCarrierGateway), c.commonTags,
+		c.client.GetCarrierGateway, c.client.FindCarrierGatewaysByTags)
+	if err != nil {
+		return err
+	}
+	if current != nil {
+		c.state.Set(IdentifierCarrierGateway, current.CarrierGatewayId)
+		if _, err := c.updater.UpdateEC2Tags(ctx, current.CarrierGatewayId, c.commonTags, current.Tags); err != nil {
+			return err
+		}
+	} else {
+		log.Info("creating...")
+		created, err := c.client.CreateCarrierGateway(ctx, desired)
+		if err != nil {
+			return err
+		}
+		c.state.Set(IdentifierCarrierGateway, created.CarrierGatewayId)
+	}
+	return nil
+}
+
 func (c *FlowContext) ensureGatewayEndpoints(ctx context.Context) error {
 	log := c.LogFromContext(ctx)
 	child := c.state.GetChild(ChildIdVPCEndpoints)
 	var desired []*awsclient.VpcEndpoint
-	for _, endpoint := range c.config.Networks.VPC.GatewayEndpoints {
+	for _, endpoint := range helper.GetVPCEndpointServices(c.config.Networks.VPC, aws.VPCEndpointTypeGateway) {
 		desired = append(desired, &awsclient.VpcEndpoint{
 			Tags:        c.commonTagsWithSuffix(fmt.Sprintf("gw-%s", endpoint)),
 			VpcId:       c.state.Get(IdentifierVPC),
 			ServiceName: c.vpcEndpointServiceNamePrefix() + endpoint,
 		})
 	}
-	current, err := c.collectExistingVPCEndpoints(ctx)
+	current, err := c.collectExistingVPCEndpoints(ctx, isGatewayVpcEndpoint)
 	if err != nil {
 		return err
 	}
@@ -373,10 +659,131 @@ func (c *FlowContext) ensureGatewayEndpoints(ctx context.Context) error {
 			return err
 		}
 	}
+	return c.ensurePrefixListIDs(ctx, desired)
+}
+
+// ensurePrefixListIDs looks up the ids of the AWS-managed prefix lists of the given gateway endpoints, so that they
+// can be reported in the infrastructure status. The ids never change, hence they are only looked up once.
+func (c *FlowContext) ensurePrefixListIDs(ctx context.Context, endpoints []*awsclient.VpcEndpoint) error {
+	child := c.state.GetChild(ChildIdPrefixLists)
+	services := sets.New[string]()
+	for _, endpoint := range endpoints {
+		service := c.extractVpcEndpointName(endpoint)
+		services.Insert(service)
+		if child.Get(service) != nil {
+			continue
+		}
+		id, err := c.client.GetPrefixListID(ctx, endpoint.ServiceName)
+		if err != nil {
+			return err
+		}
+		child.SetPtr(service, id)
+	}
+	for _, service := range child.Keys() {
+		if !services.Has(service) {
+			child.SetPtr(service, nil)
+		}
+	}
+	return nil
+}
+
+func (c *FlowContext) ensureInterfaceEndpoints(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+	child := c.state.GetChild(ChildIdVPCEndpoints)
+	var subnetIDs []string
+	for _, zone := range c.config.Networks.Zones {
+		subnetID := c.getSubnetZoneChild(zone.Name).Get(IdentifierZoneSubnetWorkers)
+		if subnetID == nil {
+			return fmt.Errorf("missing workers subnet id for zone %s", zone.Name)
+		}
+		subnetIDs = append(subnetIDs, *subnetID)
+	}
+	var desired []*awsclient.VpcEndpoint
+	for _, endpoint := range helper.GetVPCEndpointServices(c.config.Networks.VPC, aws.VPCEndpointTypeInterface) {
+		desired = append(desired, &awsclient.VpcEndpoint{
+			Tags:              c.commonTagsWithSuffix(fmt.Sprintf("if-%s", endpoint)),
+			VpcId:             c.state.Get(IdentifierVPC),
+			ServiceName:       c.vpcEndpointServiceNamePrefix() + endpoint,
+			VpcEndpointType:   string(ec2types.VpcEndpointTypeInterface),
+			SubnetIds:         subnetIDs,
+			SecurityGroupIds:  []string{*c.state.Get(IdentifierNodesSecurityGroup)},
+			PrivateDnsEnabled: true,
+		})
+	}
+	current, err := c.collectExistingVPCEndpoints(ctx, isInterfaceVpcEndpoint)
+	if err != nil {
+		return err
+	}
+
+	toBeDeleted, toBeCreated, toBeChecked := diffByID(desired, current, c.extractVpcEndpointName)
+	for _, item := range toBeDeleted {
+		log.Info("deleting...", "serviceName", item.ServiceName)
+		if err := c.client.DeleteVpcEndpoint(ctx, item.VpcEndpointId); err != nil {
+			return err
+		}
+		child.SetPtr(c.extractVpcEndpointName(item), nil)
+	}
+	for _, item := range toBeCreated {
+		log.Info("creating...", "serviceName", item.ServiceName)
+		created, err := c.client.CreateVpcEndpoint(ctx, item)
+		if err != nil {
+			return err
+		}
+		child.Set(c.extractVpcEndpointName(item), created.VpcEndpointId)
+		if _, err := c.updater.UpdateEC2Tags(ctx, created.VpcEndpointId, item.Tags, created.Tags); err != nil {
+			return err
+		}
+	}
+	for _, pair := range toBeChecked {
+		child.Set(c.extractVpcEndpointName(pair.current), pair.current.VpcEndpointId)
+		if _, err := c.updater.UpdateEC2Tags(ctx, pair.current.VpcEndpointId, pair.desired.Tags, pair.current.Tags); err != nil {
+			return err
+		}
+		existing := sets.New(pair.current.SubnetIds...)
+		wanted := sets.New(pair.desired.SubnetIds...)
+		toAdd, toRemove := sets.List(wanted.Difference(existing)), sets.List(existing.Difference(wanted))
+		if len(toAdd) == 0 && len(toRemove) == 0 {
+			continue
+		}
+		log.Info("updating subnets...", "serviceName", pair.current.ServiceName)
+		if err := c.client.ModifyVpcEndpointSubnets(ctx, pair.current.VpcEndpointId, toAdd, toRemove); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// detachInterfaceEndpointsFromRemovedZones removes the workers subnets of removed zones from the interface endpoints,
+// so that the subnets can be deleted afterwards.
+func (c *FlowContext) detachInterfaceEndpointsFromRemovedZones(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+	removedSubnetIDs := c.removedZonesWorkersSubnetIDs()
+	current, err := c.collectExistingVPCEndpoints(ctx, isInterfaceVpcEndpoint)
+	if err != nil {
+		return err
+	}
+	for _, item := range current {
+		toRemove := sets.List(sets.New(item.SubnetIds...).Intersection(removedSubnetIDs))
+		if len(toRemove) == 0 {
+			continue
+		}
+		log.Info("removing subnets of removed zones...", "serviceName", item.ServiceName, "subnetIDs", toRemove)
+		if err := c.client.ModifyVpcEndpointSubnets(ctx, item.VpcEndpointId, nil, toRemove); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-func (c *FlowContext) collectExistingVPCEndpoints(ctx context.Context) ([]*awsclient.VpcEndpoint, error) {
+func isGatewayVpcEndpoint(item *awsclient.VpcEndpoint) bool {
+	return !isInterfaceVpcEndpoint(item)
+}
+
+func isInterfaceVpcEndpoint(item *awsclient.VpcEndpoint) bool {
+	return item.VpcEndpointType == string(ec2types.VpcEndpointTypeInterface)
+}
+
+func (c *FlowContext) collectExistingVPCEndpoints(ctx context.Context, filter func(item *awsclient.VpcEndpoint) bool) ([]*awsclient.VpcEndpoint, error) {
 	child := c.state.GetChild(ChildIdVPCEndpoints)
 	var ids []string
 	for _, id := range child.AsMap() {
@@ -403,7 +810,16 @@ outer:
 		}
 		current = append(current, item)
 	}
-	return current, nil
+	if filter == nil {
+		return current, nil
+	}
+	var filtered []*awsclient.VpcEndpoint
+	for _, item := range current {
+		if filter(item) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered, nil
 }
 
 func (c *FlowContext) ensureMainRouteTable(ctx context.Context) error {
@@ -426,6 +842,15 @@ func (c *FlowContext) ensureMainRouteTable(ctx context.Context) error {
 			GatewayId:                c.state.Get(IdentifierInternetGateway),
 		})
 	}
+	if id := c.config.Networks.VPC.MainRouteTableID; id != nil {
+		current, err := c.ensureExistingRouteTable(ctx, log, *id, desired.Routes)
+		if err != nil {
+			return err
+		}
+		c.state.Set(IdentifierMainRouteTable, current.RouteTableId)
+		c.state.SetObject(ObjectMainRouteTable, current)
+		return nil
+	}
 	current, err := findExisting(ctx, c.state.Get(IdentifierMainRouteTable), c.commonTags,
 		c.client.GetRouteTable, c.client.FindRouteTablesByTags)
 	if err != nil {
@@ -455,48 +880,166 @@ func (c *FlowContext) ensureMainRouteTable(ctx context.Context) error {
 	return nil
 }
 
-func (c *FlowContext) ensureNodesSecurityGroup(ctx context.Context) error {
-	log := c.LogFromContext(ctx)
-	groupName := fmt.Sprintf("%s-nodes", c.namespace)
-	desired := &awsclient.SecurityGroup{
-		Tags:        c.commonTagsWithSuffix("nodes"),
-		GroupName:   groupName,
-		VpcId:       c.state.Get(IdentifierVPC),
-		Description: pointer.String("Security group for nodes"),
-		Rules: []*awsclient.SecurityGroupRule{
-			{
-				Type:     awsclient.SecurityGroupRuleTypeIngress,
-				Protocol: "-1",
-				Self:     true,
-			},
-			{
-				Type:       awsclient.SecurityGroupRuleTypeIngress,
-				FromPort:   30000,
-				ToPort:     32767,
-				Protocol:   "tcp",
-				CidrBlocks: []string{"0.0.0.0/0"},
-			},
-			{
-				Type:       awsclient.SecurityGroupRuleTypeIngress,
-				FromPort:   30000,
-				ToPort:     32767,
-				Protocol:   "udp",
-				CidrBlocks: []string{"0.0.0.0/0"},
-			},
-			{
-				Type:       awsclient.SecurityGroupRuleTypeEgress,
-				Protocol:   "-1",
-				CidrBlocks: []string{"0.0.0.0/0"},
-			},
-		},
+func (c *FlowContext) tagKeyOwnedRoutes() string {
+	return fmt.Sprintf(TagKeyOwnedRoutesTemplate, c.namespace)
+}
+
+func routeDestination(route *awsclient.Route) string {
+	return pointer.StringDeref(route.DestinationCidrBlock, pointer.StringDeref(route.DestinationIpv6CidrBlock, ""))
+}
+
+// ensureExistingRouteTable adds the desired routes to an existing route table, which is not owned by the extension.
+// The destinations of the added routes are tracked in a tag of the route table, so that only these routes are replaced
+// or removed later on. Routes for other destinations are left untouched, a foreign route for a desired destination is
+// reported as conflict.
+func (c *FlowContext) ensureExistingRouteTable(ctx context.Context, log logr.Logger, id string, desiredRoutes []*awsclient.Route) (*awsclient.RouteTable, error) {
+	current, err := c.client.GetRouteTable(ctx, id)
+	if err != nil {
+		return nil, err
 	}
-	for _, zone := range c.config.Networks.Zones {
-		desired.Rules = append(desired.Rules,
-			&awsclient.SecurityGroupRule{
-				Type:       awsclient.SecurityGroupRuleTypeIngress,
-				FromPort:   30000,
-				ToPort:     32767,
-				Protocol:   "tcp",
+	vpcID := *c.state.Get(IdentifierVPC)
+	if current == nil || pointer.StringDeref(current.VpcId, "") != vpcID {
+		return nil, fmt.Errorf("route table %s not found in VPC %s", id, vpcID)
+	}
+
+	owned := c.getOwnedRoutes(current)
+	desiredOwned := sets.New[string]()
+outer:
+	for _, route := range desiredRoutes {
+		destination := routeDestination(route)
+		if !owned.Has(destination) {
+			for _, cr := range current.Routes {
+				if routeDestination(cr) != destination {
+					continue
+				}
+				if reflect.DeepEqual(cr, route) {
+					// the route has been added by someone else
+					continue outer
+				}
+				return nil, fmt.Errorf("route table %s already contains a route for %s which has not been added by the extension", id, destination)
+			}
+		}
+		desiredOwned.Insert(destination)
+	}
+
+	// the routes are tracked before they are created, so that they are cleaned up even if the update fails
+	if err := c.updateOwnedRoutes(ctx, current, owned.Union(desiredOwned)); err != nil {
+		return nil, err
+	}
+	desired := &awsclient.RouteTable{Routes: desiredRoutes}
+	if _, err := c.updater.UpdateRouteTable(ctx, log, desired, current, sets.List(owned)...); err != nil {
+		return nil, err
+	}
+	if err := c.updateOwnedRoutes(ctx, current, desiredOwned); err != nil {
+		return nil, err
+	}
+	return current, nil
+}
+
+// deleteOwnedRoutes removes the routes added by the extension from an existing route table. Additional destinations
+// are routes which are tracked in the state, e.g. for the transit gateway.
+func (c *FlowContext) deleteOwnedRoutes(ctx context.Context, log logr.Logger, id string, additionalDestinations ...string) error {
+	current, err := c.client.GetRouteTable(ctx, id)
+	if err != nil || current == nil {
+		return err
+	}
+	owned := c.getOwnedRoutes(current).Insert(additionalDestinations...)
+	for _, route := range current.Routes {
+		if destination := routeDestination(route); owned.Has(destination) {
+			log.Info("deleting route...", "RouteTableId", id, "destination", destination)
+			if err := c.client.DeleteRoute(ctx, id, route); err != nil {
+				return err
+			}
+		}
+	}
+	return c.updateOwnedRoutes(ctx, current, nil)
+}
+
+// getStateRouteDestinations returns the destinations of the routes to the transit gateway and the VPC peering
+// connections, which are tracked in the state.
+func (c *FlowContext) getStateRouteDestinations() []string {
+	var destinations []string
+	for _, key := range []string{IdentifierTransitGatewayRoutes, IdentifierVPCPeeringRoutes} {
+		if value := c.state.Get(key); value != nil && *value != "" {
+			destinations = append(destinations, strings.Split(*value, ",")...)
+		}
+	}
+	return destinations
+}
+
+func (c *FlowContext) getOwnedRoutes(routeTable *awsclient.RouteTable) sets.Set[string] {
+	owned := sets.New[string]()
+	if value := routeTable.Tags[c.tagKeyOwnedRoutes()]; value != "" {
+		owned.Insert(strings.Fields(value)...)
+	}
+	return owned
+}
+
+func (c *FlowContext) updateOwnedRoutes(ctx context.Context, routeTable *awsclient.RouteTable, owned sets.Set[string]) error {
+	key := c.tagKeyOwnedRoutes()
+	value := strings.Join(sets.List(owned), " ")
+	if routeTable.Tags[key] == value {
+		return nil
+	}
+	if value == "" {
+		if err := c.client.DeleteEC2Tags(ctx, []string{routeTable.RouteTableId}, awsclient.Tags{key: routeTable.Tags[key]}); err != nil {
+			return err
+		}
+		delete(routeTable.Tags, key)
+		return nil
+	}
+	if err := c.client.CreateEC2Tags(ctx, []string{routeTable.RouteTableId}, awsclient.Tags{key: value}); err != nil {
+		return err
+	}
+	if routeTable.Tags == nil {
+		routeTable.Tags = awsclient.Tags{}
+	}
+	routeTable.Tags[key] = value
+	return nil
+}
+
+func (c *FlowContext) ensureNodesSecurityGroup(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+	groupName := fmt.Sprintf("%s-nodes", c.namespace)
+	desired := &awsclient.SecurityGroup{
+		Tags:        c.commonTagsWithSuffix("nodes"),
+		GroupName:   groupName,
+		VpcId:       c.state.Get(IdentifierVPC),
+		Description: pointer.String("Security group for nodes"),
+		Rules: []*awsclient.SecurityGroupRule{
+			{
+				Type:     awsclient.SecurityGroupRuleTypeIngress,
+				Protocol: "-1",
+				Self:     true,
+			},
+			{
+				Type:       awsclient.SecurityGroupRuleTypeIngress,
+				FromPort:   30000,
+				ToPort:     32767,
+				Protocol:   "tcp",
+				CidrBlocks: []string{"0.0.0.0/0"},
+			},
+			{
+				Type:       awsclient.SecurityGroupRuleTypeIngress,
+				FromPort:   30000,
+				ToPort:     32767,
+				Protocol:   "udp",
+				CidrBlocks: []string{"0.0.0.0/0"},
+			},
+			{
+				Type:       awsclient.SecurityGroupRuleTypeEgress,
+				Protocol:   "-1",
+				CidrBlocks: []string{"0.0.0.0/0"},
+			},
+		},
+	}
+	for _, zone := range c.config.Networks.Zones {
+		desired.Rules = append(desired.Rules,
+			&awsclient.SecurityGroupRule{
+				Type:       awsclient.SecurityGroupRuleTypeIngress,
+				FromPort:   30000,
+				ToPort:     32767,
+				Protocol:   "tcp",
 				CidrBlocks: []string{zone.Internal},
 			},
 			&awsclient.SecurityGroupRule{
@@ -521,6 +1064,19 @@ func (c *FlowContext) ensureNodesSecurityGroup(ctx context.Context) error {
 				CidrBlocks: []string{zone.Public},
 			})
 	}
+	for _, rule := range c.config.Networks.AdditionalNodeSecurityGroupRules {
+		desired.Rules = append(desired.Rules, &awsclient.SecurityGroupRule{
+			Type:             awsclient.SecurityGroupRuleType(rule.Type),
+			FromPort:         int(pointer.Int64Deref(rule.FromPort, 0)),
+			ToPort:           int(pointer.Int64Deref(rule.ToPort, 0)),
+			Protocol:         rule.Protocol,
+			CidrBlocks:       rule.CIDRBlocks,
+			SecurityGroupIds: rule.SecurityGroupIDs,
+		})
+	}
+	// EC2 reports rules with same protocol and ports as a single rule, so merge them to avoid revoking and authorizing
+	// them again on every reconciliation.
+	desired.Rules = awsclient.MergeSecurityGroupRules(desired.Rules)
 	current, err := findExisting(ctx, c.state.Get(IdentifierNodesSecurityGroup), c.commonTagsWithSuffix("nodes"),
 		c.client.GetSecurityGroup, c.client.FindSecurityGroupsByTags,
 		func(item *awsclient.SecurityGroup) bool { return item.GroupName == groupName })
@@ -551,6 +1107,68 @@ func (c *FlowContext) ensureNodesSecurityGroup(ctx context.Context) error {
 	return nil
 }
 
+func (c *FlowContext) ensureNATInstanceSecurityGroup(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+	groupName := fmt.Sprintf("%s-nat-instance", c.namespace)
+	desired := &awsclient.SecurityGroup{
+		Tags:        c.commonTagsWithSuffix("nat-instance"),
+		GroupName:   groupName,
+		VpcId:       c.state.Get(IdentifierVPC),
+		Description: pointer.String("Security group for NAT instances"),
+		Rules: []*awsclient.SecurityGroupRule{
+			{
+				Type:       awsclient.SecurityGroupRuleTypeEgress,
+				Protocol:   "-1",
+				CidrBlocks: []string{"0.0.0.0/0"},
+			},
+		},
+	}
+	// the NAT instances accept all traffic from the private subnets routed via them
+	var privateCidrBlocks []string
+	for _, zone := range c.config.Networks.Zones {
+		privateCidrBlocks = append(privateCidrBlocks, zone.Workers, zone.Internal)
+		if zone.Pods != nil {
+			privateCidrBlocks = append(privateCidrBlocks, *zone.Pods)
+		}
+		if zone.SecondaryWorkers != nil {
+			privateCidrBlocks = append(privateCidrBlocks, *zone.SecondaryWorkers)
+		}
+	}
+	desired.Rules = append(desired.Rules, &awsclient.SecurityGroupRule{
+		Type:       awsclient.SecurityGroupRuleTypeIngress,
+		Protocol:   "-1",
+		CidrBlocks: privateCidrBlocks,
+	})
+	current, err := findExisting(ctx, c.state.Get(IdentifierNATInstanceSecurityGroup), desired.Tags,
+		c.client.GetSecurityGroup, c.client.FindSecurityGroupsByTags,
+		func(item *awsclient.SecurityGroup) bool { return item.GroupName == groupName })
+	if err != nil {
+		return err
+	}
+	if current != nil {
+		c.state.Set(IdentifierNATInstanceSecurityGroup, current.GroupId)
+		if _, err := c.updater.UpdateSecurityGroup(ctx, desired, current); err != nil {
+			return err
+		}
+	} else {
+		log.Info("creating...")
+		created, err := c.client.CreateSecurityGroup(ctx, desired)
+		if err != nil {
+			return err
+		}
+		c.state.Set(IdentifierNATInstanceSecurityGroup, created.GroupId)
+		current, err = c.client.GetSecurityGroup(ctx, created.GroupId)
+		if err != nil {
+			return err
+		}
+		if _, err := c.updater.UpdateSecurityGroup(ctx, desired, current); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (c *FlowContext) ensureEgressCIDRs(ctx context.Context) error {
 	var egressIPs []string
 	tags := awsclient.Tags{
@@ -563,157 +1181,398 @@ func (c *FlowContext) ensureEgressCIDRs(ctx context.Context) error {
 	for _, nat := range nats {
 		egressIPs = append(egressIPs, fmt.Sprintf("%s/32", nat.PublicIP))
 	}
+	for _, zone := range c.config.Networks.Zones {
+		id := c.getSubnetZoneChild(zone.Name).Get(IdentifierZoneNATInstance)
+		if id == nil || *id == "" {
+			continue
+		}
+		instance, err := c.client.GetInstance(ctx, *id)
+		if err != nil {
+			return err
+		}
+		if instance != nil && instance.PublicIpAddress != nil {
+			egressIPs = append(egressIPs, fmt.Sprintf("%s/32", *instance.PublicIpAddress))
+		}
+	}
 	c.state.Set(IdentifierEgressCIDRs, strings.Join(egressIPs, ","))
 	return nil
 }
 
-func (c *FlowContext) ensureZones(ctx context.Context) error {
-	var desired []*awsclient.Subnet
-
-	for index, zone := range c.config.Networks.Zones {
-		ipv6CidrBlock := c.state.Get(IdentifierVpcIPv6CidrBlock)
-		subnetPrefixLength := 64
-		var subnetCIDRs []string
-		if ipv6CidrBlock != nil {
-			for i := 0; i < 3; i++ {
-				subnetCIDR, err := cidrSubnet(*ipv6CidrBlock, subnetPrefixLength, i+3*index)
-				if err != nil {
-					return err
-				}
-				subnetCIDRs = append(subnetCIDRs, subnetCIDR)
-			}
-		}
-		helper := c.zoneSuffixHelpers(zone.Name)
-		tagsWorkers := c.commonTagsWithSuffix(helper.GetSuffixSubnetWorkers())
-		tagsPublic := c.commonTagsWithSuffix(helper.GetSuffixSubnetPublic())
-		tagsPublic[TagKeyRolePublicELB] = TagValueELB
-		tagsPrivate := c.commonTagsWithSuffix(helper.GetSuffixSubnetPrivate())
-		tagsPrivate[TagKeyRolePrivateELB] = TagValueELB
-		desired = append(desired,
-			&awsclient.Subnet{
-				Tags:                        tagsWorkers,
-				VpcId:                       c.state.Get(IdentifierVPC),
-				CidrBlock:                   zone.Workers,
-				AvailabilityZone:            zone.Name,
-				AssignIpv6AddressOnCreation: pointer.Bool(false),
-			},
-			&awsclient.Subnet{
-				Tags:                        tagsPublic,
-				VpcId:                       c.state.Get(IdentifierVPC),
-				CidrBlock:                   zone.Public,
-				AvailabilityZone:            zone.Name,
-				AssignIpv6AddressOnCreation: pointer.Bool(false),
-			},
-			&awsclient.Subnet{
-				Tags:                        tagsPrivate,
-				VpcId:                       c.state.Get(IdentifierVPC),
-				CidrBlock:                   zone.Internal,
-				AvailabilityZone:            zone.Name,
-				AssignIpv6AddressOnCreation: pointer.Bool(false),
-			})
+func (c *FlowContext) ensureTransitGatewayAttachment(ctx context.Context) error {
+	tgw := c.config.Networks.TransitGateway
+	if tgw == nil {
+		return c.deleteTransitGatewayAttachment(ctx)
+	}
 
-		for i := 0; i < 3; i++ {
-			if len(subnetCIDRs) == 3 && subnetCIDRs[i] != "" {
-				desired[i].Ipv6CidrBlocks = []string{subnetCIDRs[i]}
-			} else {
-				desired[i].Ipv6CidrBlocks = nil
-			}
+	log := c.LogFromContext(ctx)
+	var subnetIDs []string
+	for _, zone := range c.config.Networks.Zones {
+		subnetID := c.getSubnetZoneChild(zone.Name).Get(IdentifierZoneSubnetWorkers)
+		if subnetID == nil {
+			return fmt.Errorf("missing workers subnet id for zone %s", zone.Name)
 		}
-
+		subnetIDs = append(subnetIDs, *subnetID)
 	}
-	// update flow state if subnet suffixes have been added
-	if err := c.PersistState(ctx, true); err != nil {
-		return err
+	desired := &awsclient.TransitGatewayVpcAttachment{
+		Tags:             c.commonTagsWithSuffix("tgw-attachment"),
+		TransitGatewayId: tgw.ID,
+		VpcId:            *c.state.Get(IdentifierVPC),
+		SubnetIds:        subnetIDs,
+		Ipv6Support:      helper.IsDualStack(c.config),
 	}
-	current, err := c.collectExistingSubnets(ctx)
+	current, err := findExisting(ctx, c.state.Get(IdentifierTransitGatewayAttachment), desired.Tags,
+		c.client.GetTransitGatewayVpcAttachment, c.client.FindTransitGatewayVpcAttachmentsByTags, isActiveTransitGatewayVpcAttachment)
 	if err != nil {
 		return err
 	}
-	toBeDeleted, toBeCreated, toBeChecked := diffByID(desired, current, func(item *awsclient.Subnet) string {
-		return item.AvailabilityZone + "-" + item.CidrBlock
-	})
-
-	g := flow.NewGraph("AWS infrastructure reconcilation: zones")
 
-	if err := c.addZoneDeletionTasksBySubnets(g, toBeDeleted); err != nil {
-		return err
+	if current != nil && current.TransitGatewayId != desired.TransitGatewayId {
+		log.Info("transit gateway changed, replacing attachment...", "TransitGatewayAttachmentId", current.TransitGatewayAttachmentId)
+		if err := c.deleteTransitGatewayAttachment(ctx); err != nil {
+			return err
+		}
+		current = nil
 	}
 
-	dependencies := newZoneDependencies()
-	for _, item := range toBeCreated {
-		taskID, err := c.addSubnetReconcileTasks(g, item, nil)
-		if err != nil {
+	if current != nil {
+		c.state.Set(IdentifierTransitGatewayAttachment, current.TransitGatewayAttachmentId)
+		if _, err := c.updater.UpdateEC2Tags(ctx, current.TransitGatewayAttachmentId, desired.Tags, current.Tags); err != nil {
 			return err
 		}
-		dependencies.Append(item.AvailabilityZone, taskID)
-	}
-	for _, pair := range toBeChecked {
-		taskID, err := c.addSubnetReconcileTasks(g, pair.desired, pair.current)
-		if err != nil {
+		existing := sets.New(current.SubnetIds...)
+		wanted := sets.New(desired.SubnetIds...)
+		toAdd, toRemove := sets.List(wanted.Difference(existing)), sets.List(existing.Difference(wanted))
+		if len(toAdd) == 0 && len(toRemove) == 0 {
+			return nil
+		}
+		log.Info("updating subnets...", "TransitGatewayAttachmentId", current.TransitGatewayAttachmentId)
+		if err := c.client.ModifyTransitGatewayVpcAttachmentSubnets(ctx, current.TransitGatewayAttachmentId, toAdd, toRemove); err != nil {
 			return err
 		}
-		dependencies.Append(pair.desired.AvailabilityZone, taskID)
+		waiter := informOnWaiting(log, 10*time.Second, "waiting until available...")
+		err := c.client.WaitForTransitGatewayVpcAttachmentAvailable(ctx, current.TransitGatewayAttachmentId)
+		waiter.Done(err)
+		return err
 	}
-	for _, item := range c.config.Networks.Zones {
-		zone := item
-		c.addZoneReconcileTasks(g, &zone, dependencies.Get(zone.Name))
+
+	log.Info("creating...")
+	created, err := c.client.CreateTransitGatewayVpcAttachment(ctx, desired)
+	if err != nil {
+		return err
 	}
-	f := g.Compile()
-	if err := f.Run(ctx, flow.Opts{Log: c.Log}); err != nil {
-		return flow.Causes(err)
+	c.state.Set(IdentifierTransitGatewayAttachment, created.TransitGatewayAttachmentId)
+	if perr := c.PersistState(ctx, true); perr != nil {
+		log.Info("persisting state failed", "error", perr)
 	}
-	return nil
+	waiter := informOnWaiting(log, 10*time.Second, "waiting until available...")
+	err = c.client.WaitForTransitGatewayVpcAttachmentAvailable(ctx, created.TransitGatewayAttachmentId)
+	waiter.Done(err)
+	return err
 }
 
-func (c *FlowContext) addZoneDeletionTasksBySubnets(g *flow.Graph, toBeDeleted []*awsclient.Subnet) error {
-	toBeDeletedZones := sets.NewString()
-	for _, item := range toBeDeleted {
-		toBeDeletedZones.Insert(getZoneName(item))
+func (c *FlowContext) ensureTransitGatewayRoutes(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+	var (
+		desiredCIDRs []string
+		desired      = &awsclient.RouteTable{}
+	)
+	if tgw := c.config.Networks.TransitGateway; tgw != nil {
+		desiredCIDRs = tgw.Routes
+		for _, cidr := range tgw.Routes {
+			desired.Routes = append(desired.Routes, &awsclient.Route{
+				DestinationCidrBlock: pointer.String(cidr),
+				TransitGatewayId:     pointer.String(tgw.ID),
+			})
+		}
 	}
-	dependencies := newZoneDependencies()
-	for zoneName := range toBeDeletedZones {
-		taskID := c.addZoneDeletionTasks(g, zoneName)
-		dependencies.Append(zoneName, taskID)
+
+	// routes which are not desired anymore are only removed if they have been created by a former reconciliation
+	controlledCIDRs := sets.New(desiredCIDRs...)
+	if previous := c.state.Get(IdentifierTransitGatewayRoutes); previous != nil {
+		controlledCIDRs.Insert(strings.Split(*previous, ",")...)
 	}
-	for _, item := range toBeDeleted {
-		if err := c.addSubnetDeletionTasks(g, item, dependencies.Get(item.AvailabilityZone)); err != nil {
+	if controlledCIDRs.Len() == 0 {
+		return nil
+	}
+
+	for _, zone := range c.config.Networks.Zones {
+		id := c.getSubnetZoneChild(zone.Name).Get(IdentifierZoneRouteTable)
+		if id == nil {
+			return fmt.Errorf("missing route table id for zone %s", zone.Name)
+		}
+		current, err := c.client.GetRouteTable(ctx, *id)
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			return fmt.Errorf("route table %s of zone %s not found", *id, zone.Name)
+		}
+		// only routes via a transit gateway are managed here, e.g. the default route may point to a NAT gateway
+		transitGatewayRoutes := &awsclient.RouteTable{RouteTableId: current.RouteTableId}
+		for _, route := range current.Routes {
+			if route.TransitGatewayId != nil {
+				transitGatewayRoutes.Routes = append(transitGatewayRoutes.Routes, route)
+			}
+		}
+		if _, err := c.updater.UpdateRouteTable(ctx, log.WithValues("zone", zone.Name), desired, transitGatewayRoutes, sets.List(controlledCIDRs)...); err != nil {
 			return err
 		}
 	}
+
+	c.state.Set(IdentifierTransitGatewayRoutes, strings.Join(desiredCIDRs, ","))
 	return nil
 }
 
-func (c *FlowContext) collectExistingSubnets(ctx context.Context) ([]*awsclient.Subnet, error) {
-	child := c.state.GetChild(ChildIdZones)
-	var ids []string
-	for _, zoneKey := range child.GetChildrenKeys() {
-		zoneChild := child.GetChild(zoneKey)
-		if id := zoneChild.Get(IdentifierZoneSubnetWorkers); id != nil {
-			ids = append(ids, *id)
+func (c *FlowContext) deleteTransitGatewayAttachment(ctx context.Context) error {
+	if c.state.IsAlreadyDeleted(IdentifierTransitGatewayAttachment) {
+		return nil
+	}
+	current, err := findExisting(ctx, c.state.Get(IdentifierTransitGatewayAttachment), c.commonTagsWithSuffix("tgw-attachment"),
+		c.client.GetTransitGatewayVpcAttachment, c.client.FindTransitGatewayVpcAttachmentsByTags, isActiveTransitGatewayVpcAttachment)
+	if err != nil {
+		return err
+	}
+	if current != nil {
+		log := c.LogFromContext(ctx)
+		log.Info("deleting...", "TransitGatewayAttachmentId", current.TransitGatewayAttachmentId)
+		waiter := informOnWaiting(log, 10*time.Second, "still deleting...", "TransitGatewayAttachmentId", current.TransitGatewayAttachmentId)
+		err := c.client.DeleteTransitGatewayVpcAttachment(ctx, current.TransitGatewayAttachmentId)
+		waiter.Done(err)
+		if err != nil {
+			return err
 		}
-		if id := zoneChild.Get(IdentifierZoneSubnetPublic); id != nil {
-			ids = append(ids, *id)
+	}
+	c.state.SetAsDeleted(IdentifierTransitGatewayAttachment)
+	return nil
+}
+
+func (c *FlowContext) ensureVPCPeerings(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+	child := c.state.GetChild(ChildIdVPCPeerings)
+	if len(c.config.Networks.VPCPeerings) == 0 && len(child.GetChildrenKeys()) == 0 {
+		// avoid requiring permissions for VPC peering connections if the feature has never been used
+		return nil
+	}
+
+	var desired []*awsclient.VpcPeeringConnection
+	for _, peering := range c.config.Networks.VPCPeerings {
+		desired = append(desired, &awsclient.VpcPeeringConnection{
+			Tags:        c.commonTagsWithSuffix("peering-" + peering.PeerVPCID),
+			VpcId:       *c.state.Get(IdentifierVPC),
+			PeerVpcId:   peering.PeerVPCID,
+			PeerOwnerId: pointer.StringDeref(peering.PeerAccountID, ""),
+			PeerRegion:  pointer.StringDeref(peering.PeerRegion, ""),
+		})
+	}
+	current, err := c.collectExistingVPCPeeringConnections(ctx)
+	if err != nil {
+		return err
+	}
+
+	toBeDeleted, toBeCreated, toBeChecked := diffByID(desired, current, func(item *awsclient.VpcPeeringConnection) string {
+		return item.PeerVpcId
+	})
+	for _, item := range toBeDeleted {
+		log.Info("deleting...", "VpcPeeringConnectionId", item.VpcPeeringConnectionId, "PeerVpcId", item.PeerVpcId)
+		if err := c.client.DeleteVpcPeeringConnection(ctx, item.VpcPeeringConnectionId); err != nil {
+			return err
 		}
-		if id := zoneChild.Get(IdentifierZoneSubnetPrivate); id != nil {
-			ids = append(ids, *id)
+		peeringChild := child.GetChild(item.PeerVpcId)
+		peeringChild.SetPtr(IdentifierVPCPeeringConnection, nil)
+		peeringChild.SetPtr(IdentifierVPCPeeringConnectionState, nil)
+	}
+	var peerings []*awsclient.VpcPeeringConnection
+	for _, item := range toBeCreated {
+		log.Info("creating...", "PeerVpcId", item.PeerVpcId)
+		created, err := c.client.CreateVpcPeeringConnection(ctx, item)
+		if err != nil {
+			return err
+		}
+		child.GetChild(item.PeerVpcId).Set(IdentifierVPCPeeringConnection, created.VpcPeeringConnectionId)
+		if perr := c.PersistState(ctx, true); perr != nil {
+			log.Info("persisting state failed", "error", perr)
 		}
+		peerings = append(peerings, created)
 	}
-	var current []*awsclient.Subnet
-	if len(ids) > 0 {
-		found, err := c.client.GetSubnets(ctx, ids)
+	for _, pair := range toBeChecked {
+		child.GetChild(pair.current.PeerVpcId).Set(IdentifierVPCPeeringConnection, pair.current.VpcPeeringConnectionId)
+		if _, err := c.updater.UpdateEC2Tags(ctx, pair.current.VpcPeeringConnectionId, pair.desired.Tags, pair.current.Tags); err != nil {
+			return err
+		}
+		peerings = append(peerings, pair.current)
+	}
+
+	var accountID string
+	for _, peering := range c.config.Networks.VPCPeerings {
+		var current *awsclient.VpcPeeringConnection
+		for _, item := range peerings {
+			if item.PeerVpcId == peering.PeerVPCID {
+				current = item
+			}
+		}
+		if current == nil {
+			continue
+		}
+
+		// peering connections of the same account are accepted, requests to other accounts have to be accepted by the
+		// owner of the peer VPC
+		if peering.PeerAccountID != nil && accountID == "" {
+			if accountID, err = c.client.GetAccountID(ctx); err != nil {
+				return err
+			}
+		}
+		sameAccount := peering.PeerAccountID == nil || *peering.PeerAccountID == accountID
+		if current, err = c.ensureVPCPeeringAccepted(ctx, current, peering.PeerRegion, sameAccount); err != nil {
+			return err
+		}
+		child.GetChild(peering.PeerVPCID).Set(IdentifierVPCPeeringConnectionState, current.State)
+	}
+	return nil
+}
+
+func (c *FlowContext) ensureVPCPeeringAccepted(ctx context.Context, peering *awsclient.VpcPeeringConnection, peerRegion *string, sameAccount bool) (*awsclient.VpcPeeringConnection, error) {
+	log := c.LogFromContext(ctx).WithValues("VpcPeeringConnectionId", peering.VpcPeeringConnectionId)
+	if peering.State == string(ec2types.VpcPeeringConnectionStateReasonCodeInitiatingRequest) {
+		waiter := informOnWaiting(log, 10*time.Second, "waiting until requested...")
+		current, err := c.client.WaitForVpcPeeringConnectionState(ctx, peering.VpcPeeringConnectionId,
+			string(ec2types.VpcPeeringConnectionStateReasonCodePendingAcceptance),
+			string(ec2types.VpcPeeringConnectionStateReasonCodeProvisioning),
+			string(ec2types.VpcPeeringConnectionStateReasonCodeActive))
+		waiter.Done(err)
 		if err != nil {
 			return nil, err
 		}
-		current = found
+		peering = current
 	}
-	foundByTags, err := c.client.FindSubnetsByTags(ctx, c.clusterTags())
+
+	if peering.State == string(ec2types.VpcPeeringConnectionStateReasonCodePendingAcceptance) {
+		if !sameAccount {
+			log.Info("waiting for acceptance by the owner of the peer VPC", "PeerOwnerId", peering.PeerOwnerId)
+			return peering, nil
+		}
+		log.Info("accepting...")
+		if err := c.client.AcceptVpcPeeringConnection(ctx, peering.VpcPeeringConnectionId, pointer.StringDeref(peerRegion, "")); err != nil {
+			return nil, err
+		}
+	} else if peering.State != string(ec2types.VpcPeeringConnectionStateReasonCodeProvisioning) {
+		return peering, nil
+	}
+
+	waiter := informOnWaiting(log, 10*time.Second, "waiting until active...")
+	current, err := c.client.WaitForVpcPeeringConnectionState(ctx, peering.VpcPeeringConnectionId, string(ec2types.VpcPeeringConnectionStateReasonCodeActive))
+	waiter.Done(err)
+	return current, err
+}
+
+func (c *FlowContext) ensureVPCPeeringRoutes(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+	var (
+		desiredCIDRs []string
+		desired      = &awsclient.RouteTable{}
+	)
+	for _, peering := range c.config.Networks.VPCPeerings {
+		desiredCIDRs = append(desiredCIDRs, peering.Routes...)
+		// routes are only added once the peering connection is active
+		peeringChild := c.state.GetChild(ChildIdVPCPeerings).GetChild(peering.PeerVPCID)
+		id := peeringChild.Get(IdentifierVPCPeeringConnection)
+		if id == nil || pointer.StringDeref(peeringChild.Get(IdentifierVPCPeeringConnectionState), "") != string(ec2types.VpcPeeringConnectionStateReasonCodeActive) {
+			continue
+		}
+		for _, cidr := range peering.Routes {
+			desired.Routes = append(desired.Routes, &awsclient.Route{
+				DestinationCidrBlock:   pointer.String(cidr),
+				VpcPeeringConnectionId: id,
+			})
+		}
+	}
+
+	// routes which are not desired anymore are only removed if they have been created by a former reconciliation
+	controlledCIDRs := sets.New(desiredCIDRs...)
+	if previous := c.state.Get(IdentifierVPCPeeringRoutes); previous != nil {
+		controlledCIDRs.Insert(strings.Split(*previous, ",")...)
+	}
+	if controlledCIDRs.Len() == 0 {
+		return nil
+	}
+
+	for _, zone := range c.config.Networks.Zones {
+		id := c.getSubnetZoneChild(zone.Name).Get(IdentifierZoneRouteTable)
+		if id == nil {
+			return fmt.Errorf("missing route table id for zone %s", zone.Name)
+		}
+		current, err := c.client.GetRouteTable(ctx, *id)
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			return fmt.Errorf("route table %s of zone %s not found", *id, zone.Name)
+		}
+		// only routes via a VPC peering connection are managed here
+		peeringRoutes := &awsclient.RouteTable{RouteTableId: current.RouteTableId}
+		for _, route := range current.Routes {
+			if route.VpcPeeringConnectionId != nil {
+				peeringRoutes.Routes = append(peeringRoutes.Routes, route)
+			}
+		}
+		if _, err := c.updater.UpdateRouteTable(ctx, log.WithValues("zone", zone.Name), desired, peeringRoutes, sets.List(controlledCIDRs)...); err != nil {
+			return err
+		}
+	}
+
+	c.state.Set(IdentifierVPCPeeringRoutes, strings.Join(desiredCIDRs, ","))
+	return nil
+}
+
+func (c *FlowContext) deleteVPCPeerings(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+	child := c.state.GetChild(ChildIdVPCPeerings)
+	if len(c.config.Networks.VPCPeerings) == 0 && len(child.GetChildrenKeys()) == 0 {
+		return nil
+	}
+	current, err := c.collectExistingVPCPeeringConnections(ctx)
+	if err != nil {
+		return err
+	}
+	for _, item := range current {
+		log.Info("deleting...", "VpcPeeringConnectionId", item.VpcPeeringConnectionId, "PeerVpcId", item.PeerVpcId)
+		if err := c.client.DeleteVpcPeeringConnection(ctx, item.VpcPeeringConnectionId); err != nil {
+			return err
+		}
+	}
+	for _, key := range child.GetChildrenKeys() {
+		child.GetChild(key).SetAsDeleted(IdentifierVPCPeeringConnection)
+		child.GetChild(key).SetPtr(IdentifierVPCPeeringConnectionState, nil)
+	}
+	return nil
+}
+
+func (c *FlowContext) collectExistingVPCPeeringConnections(ctx context.Context) ([]*awsclient.VpcPeeringConnection, error) {
+	child := c.state.GetChild(ChildIdVPCPeerings)
+	var current []*awsclient.VpcPeeringConnection
+	for _, key := range child.GetChildrenKeys() {
+		id := child.GetChild(key).Get(IdentifierVPCPeeringConnection)
+		if id == nil {
+			continue
+		}
+		item, err := c.client.GetVpcPeeringConnection(ctx, *id)
+		if err != nil {
+			return nil, err
+		}
+		if item != nil && isActiveVpcPeeringConnection(item) {
+			current = append(current, item)
+		}
+	}
+	foundByTags, err := c.client.FindVpcPeeringConnectionsByTags(ctx, c.clusterTags())
 	if err != nil {
 		return nil, err
 	}
 outer:
 	for _, item := range foundByTags {
+		if !isActiveVpcPeeringConnection(item) {
+			continue
+		}
 		for _, currentItem := range current {
-			if item.SubnetId == currentItem.SubnetId {
+			if item.VpcPeeringConnectionId == currentItem.VpcPeeringConnectionId {
 				continue outer
 			}
 		}
@@ -722,494 +1581,2522 @@ outer:
 	return current, nil
 }
 
-func (c *FlowContext) addSubnetReconcileTasks(g *flow.Graph, desired, current *awsclient.Subnet) (flow.TaskIDer, error) {
-	zoneName, subnetKey, err := c.getSubnetKey(desired)
-	if err != nil {
-		return nil, err
+// isActiveVpcPeeringConnection returns false for peering connections which are deleted or have been rejected, have
+// expired or have failed, as they can't become active anymore.
+func isActiveVpcPeeringConnection(item *awsclient.VpcPeeringConnection) bool {
+	switch ec2types.VpcPeeringConnectionStateReasonCode(item.State) {
+	case ec2types.VpcPeeringConnectionStateReasonCodeDeleting, ec2types.VpcPeeringConnectionStateReasonCodeDeleted,
+		ec2types.VpcPeeringConnectionStateReasonCodeRejected, ec2types.VpcPeeringConnectionStateReasonCodeExpired,
+		ec2types.VpcPeeringConnectionStateReasonCodeFailed:
+		return false
 	}
-	suffix := fmt.Sprintf("%s-%s", zoneName, subnetKey)
-	return c.AddTask(g, "ensure subnet "+suffix,
-		c.ensureSubnet(subnetKey, desired, current),
-		Timeout(defaultTimeout)), nil
+	return true
 }
 
-func (c *FlowContext) addZoneReconcileTasks(g *flow.Graph, zone *aws.Zone, dependencies []flow.TaskIDer) {
-	ensureElasticIP := c.AddTask(g, "ensure NAT gateway elastic IP "+zone.Name,
-		c.ensureElasticIP(zone),
-		Timeout(defaultTimeout), Dependencies(dependencies...))
+func isActiveTransitGatewayVpcAttachment(item *awsclient.TransitGatewayVpcAttachment) bool {
+	return !strings.EqualFold(item.State, string(ec2types.TransitGatewayAttachmentStateDeleting)) &&
+		!strings.EqualFold(item.State, string(ec2types.TransitGatewayAttachmentStateFailed)) &&
+		!strings.EqualFold(item.State, string(ec2types.TransitGatewayAttachmentStateRejected))
+}
 
-	ensureNATGateway := c.AddTask(g, "ensure NAT gateway "+zone.Name,
-		c.ensureNATGateway(zone),
-		Timeout(defaultLongTimeout), Dependencies(dependencies...), Dependencies(ensureElasticIP))
+func (c *FlowContext) ensureZones(ctx context.Context) error {
+	if c.config.Networks.VPC.Shared {
+		return c.ensureSharedSubnets(ctx)
+	}
+	var desired []*awsclient.Subnet
 
-	ensureRoutingTable := c.AddTask(g, "ensure route table "+zone.Name,
-		c.ensurePrivateRoutingTable(zone.Name),
-		Timeout(defaultTimeout), Dependencies(dependencies...), Dependencies(ensureNATGateway))
+	for index, zone := range c.config.Networks.Zones {
+		ipv6CidrBlock := c.state.Get(IdentifierVpcIPv6CidrBlock)
+		subnetPrefixLength := 64
+		var subnetCIDRs []string
+		if ipv6CidrBlock != nil {
+			for i := 0; i < 3; i++ {
+				subnetCIDR, err := cidrSubnet(*ipv6CidrBlock, subnetPrefixLength, i+3*index)
+				if err != nil {
+					return err
+				}
+				subnetCIDRs = append(subnetCIDRs, subnetCIDR)
+			}
+		}
+		helper := c.zoneSuffixHelpers(zone.Name)
+		tagsWorkers := c.commonTagsWithSuffix(helper.GetSuffixSubnetWorkers())
+		tagsPublic := c.commonTagsWithSuffix(helper.GetSuffixSubnetPublic())
+		tagsPublic[TagKeyRolePublicELB] = TagValueELB
+		tagsPrivate := c.commonTagsWithSuffix(helper.GetSuffixSubnetPrivate())
+		tagsPrivate[TagKeyRolePrivateELB] = TagValueELB
+		desired = append(desired,
+			&awsclient.Subnet{
+				Tags:                        tagsWorkers,
+				VpcId:                       c.state.Get(IdentifierVPC),
+				CidrBlock:                   zone.Workers,
+				AvailabilityZone:            zone.Name,
+				AssignIpv6AddressOnCreation: pointer.Bool(false),
+				OutpostArn:                  zone.OutpostARN,
+			},
+			&awsclient.Subnet{
+				Tags:                        tagsPublic,
+				VpcId:                       c.state.Get(IdentifierVPC),
+				CidrBlock:                   zone.Public,
+				AvailabilityZone:            zone.Name,
+				AssignIpv6AddressOnCreation: pointer.Bool(false),
+			},
+			&awsclient.Subnet{
+				Tags:                        tagsPrivate,
+				VpcId:                       c.state.Get(IdentifierVPC),
+				CidrBlock:                   zone.Internal,
+				AvailabilityZone:            zone.Name,
+				AssignIpv6AddressOnCreation: pointer.Bool(false),
+			})
 
-	_ = c.AddTask(g, "ensure route table associations "+zone.Name,
-		c.ensureRoutingTableAssociations(zone.Name),
-		Timeout(defaultTimeout), Dependencies(dependencies...), Dependencies(ensureRoutingTable))
+		zoneSubnets := desired[len(desired)-3:]
+		for i := 0; i < 3; i++ {
+			if len(subnetCIDRs) == 3 && subnetCIDRs[i] != "" {
+				zoneSubnets[i].Ipv6CidrBlocks = []string{subnetCIDRs[i]}
+			} else {
+				zoneSubnets[i].Ipv6CidrBlocks = nil
+			}
+		}
 
-	_ = c.AddTask(g, "ensure VPC endpoints route table associations "+zone.Name,
-		c.ensureVPCEndpointsRoutingTableAssociations(zone.Name),
-		Timeout(defaultTimeout), Dependencies(dependencies...), Dependencies(ensureRoutingTable))
-}
+		if zone.Pods != nil {
+			desired = append(desired, &awsclient.Subnet{
+				Tags:                        c.commonTagsWithSuffix(helper.GetSuffixSubnetPods()),
+				VpcId:                       c.state.Get(IdentifierVPC),
+				CidrBlock:                   *zone.Pods,
+				AvailabilityZone:            zone.Name,
+				AssignIpv6AddressOnCreation: pointer.Bool(false),
+				OutpostArn:                  zone.OutpostARN,
+			})
+		}
+		if zone.SecondaryWorkers != nil {
+			desired = append(desired, &awsclient.Subnet{
+				Tags:                        c.commonTagsWithSuffix(helper.GetSuffixSubnetWorkersSecondary()),
+				VpcId:                       c.state.Get(IdentifierVPC),
+				CidrBlock:                   *zone.SecondaryWorkers,
+				AvailabilityZone:            zone.Name,
+				AssignIpv6AddressOnCreation: pointer.Bool(false),
+				OutpostArn:                  zone.OutpostARN,
+			})
+		}
 
-func (c *FlowContext) addZoneDeletionTasks(g *flow.Graph, zoneName string) flow.TaskIDer {
-	deleteRoutingTableAssocs := c.AddTask(g, "delete route table associations "+zoneName,
-		c.deleteRoutingTableAssociations(zoneName),
-		Timeout(defaultTimeout))
+	}
+	// update flow state if subnet suffixes have been added
+	if err := c.PersistState(ctx, true); err != nil {
+		return err
+	}
+	current, err := c.collectExistingSubnets(ctx)
+	if err != nil {
+		return err
+	}
+	toBeDeleted, toBeCreated, toBeChecked := diffByID(desired, current, func(item *awsclient.Subnet) string {
+		return item.AvailabilityZone + "-" + item.CidrBlock
+	})
+	addedZones, incremental := c.zoneChanges()
+	if incremental {
+		c.Log.Info("zones were added or removed, only reconciling their resources", "addedZones", sets.List(addedZones))
+	}
+	// reconcile reports whether the resources of the given zone are reconciled. If zones were added or removed, the
+	// resources of the other zones are left untouched, so that they are never recreated while the zones change.
+	reconcile := func(zoneName string) bool {
+		return !incremental || addedZones.Has(zoneName)
+	}
 
-	deleteRoutingTable := c.AddTask(g, "delete route table "+zoneName,
-		c.deletePrivateRoutingTable(zoneName),
-		Timeout(defaultTimeout), Dependencies(deleteRoutingTableAssocs))
+	g := flow.NewGraph("AWS infrastructure reconcilation: zones")
 
-	deleteNATGateway := c.AddTask(g, "delete NAT gateway "+zoneName,
-		c.deleteNATGateway(zoneName),
-		Timeout(defaultLongTimeout), Dependencies(deleteRoutingTable))
+	if err := c.addZoneDeletionTasksBySubnets(g, toBeDeleted); err != nil {
+		return err
+	}
 
-	_ = c.AddTask(g, "delete NAT gateway elastic IP "+zoneName,
-		c.deleteElasticIP(zoneName),
-		Timeout(defaultTimeout), Dependencies(deleteNATGateway))
+	dependencies := newZoneDependencies()
+	for _, item := range toBeCreated {
+		taskID, err := c.addSubnetReconcileTasks(g, item, nil)
+		if err != nil {
+			return err
+		}
+		dependencies.Append(item.AvailabilityZone, taskID)
+	}
+	for _, pair := range toBeChecked {
+		if !reconcile(pair.desired.AvailabilityZone) {
+			if err := c.recordSubnet(pair.desired, pair.current); err != nil {
+				return err
+			}
+			continue
+		}
+		taskID, err := c.addSubnetReconcileTasks(g, pair.desired, pair.current)
+		if err != nil {
+			return err
+		}
+		dependencies.Append(pair.desired.AvailabilityZone, taskID)
+	}
+	natGatewayType := helper.GetNATGatewayType(c.config)
+	natGatewayTasks := map[string]flow.TaskIDer{}
+	for _, item := range c.config.Networks.Zones {
+		zone := item
+		if helper.GetNATGatewayZoneName(c.config, zone.Name) != zone.Name || !reconcile(zone.Name) {
+			continue
+		}
+		if natGatewayType == aws.NATGatewayTypeInstance {
+			natGatewayTasks[zone.Name] = c.addNATInstanceReconcileTasks(g, &zone, dependencies.Get(zone.Name))
+		} else {
+			natGatewayTasks[zone.Name] = c.addNATGatewayReconcileTasks(g, &zone, dependencies.Get(zone.Name))
+		}
+	}
+	var routingTableTasks []flow.TaskIDer
+	for _, item := range c.config.Networks.Zones {
+		zone := item
+		if !reconcile(zone.Name) {
+			continue
+		}
+		natGatewayZoneName := helper.GetNATGatewayZoneName(c.config, zone.Name)
+		zoneDependencies := dependencies.Get(zone.Name)
+		if natGatewayTask, ok := natGatewayTasks[natGatewayZoneName]; ok {
+			zoneDependencies = append(zoneDependencies, natGatewayTask)
+		}
+		routingTableTasks = append(routingTableTasks, c.addZoneReconcileTasks(g, &zone, natGatewayZoneName, zoneDependencies))
+	}
+	// NAT gateways and instances of zones which don't need them anymore (e.g. after switching the NAT gateway mode or
+	// type) are deleted after the routes to them have been replaced.
+	for _, zone := range c.config.Networks.Zones {
+		if !reconcile(zone.Name) {
+			continue
+		}
+		hasNAT := helper.GetNATGatewayZoneName(c.config, zone.Name) == zone.Name
+		if !hasNAT || natGatewayType != aws.NATGatewayTypeGateway {
+			_ = c.addNATGatewayDeletionTasks(g, zone.Name, routingTableTasks...)
+		}
+		if !hasNAT || natGatewayType != aws.NATGatewayTypeInstance {
+			_ = c.addNATInstanceDeletionTasks(g, zone.Name, routingTableTasks...)
+		}
+	}
+	f := g.Compile()
+	if err := f.Run(ctx, c.SubFlowOpts()); err != nil {
+		return flow.Causes(err)
+	}
+	c.state.Set(IdentifierZoneNames, strings.Join(sets.List(c.zoneNames()), ","))
+	return nil
+}
+
+// zoneChanges returns the names of the zones which were added since the last reconciliation and whether zones were
+// added or removed at all. It returns false if the zones of the last reconciliation are unknown.
+func (c *FlowContext) zoneChanges() (sets.Set[string], bool) {
+	previous := c.state.Get(IdentifierZoneNames)
+	if previous == nil || *previous == "" {
+		return nil, false
+	}
+	previousZones := sets.New(strings.Split(*previous, ",")...)
+	zones := c.zoneNames()
+	if zones.Equal(previousZones) {
+		return nil, false
+	}
+	return zones.Difference(previousZones), true
+}
+
+// removedZonesWorkersSubnetIDs returns the ids of the workers subnets which are recorded in the state for zones which
+// were removed from the infrastructure config.
+func (c *FlowContext) removedZonesWorkersSubnetIDs() sets.Set[string] {
+	zoneNames := c.zoneNames()
+	subnetIDs := sets.New[string]()
+	zonesChild := c.state.GetChild(ChildIdZones)
+	for _, zoneName := range zonesChild.GetChildrenKeys() {
+		if zoneNames.Has(zoneName) {
+			continue
+		}
+		if subnetID := zonesChild.GetChild(zoneName).Get(IdentifierZoneSubnetWorkers); subnetID != nil {
+			subnetIDs.Insert(*subnetID)
+		}
+	}
+	return subnetIDs
+}
+
+func (c *FlowContext) zoneNames() sets.Set[string] {
+	names := sets.New[string]()
+	for _, zone := range c.config.Networks.Zones {
+		names.Insert(zone.Name)
+	}
+	return names
+}
+
+// recordSubnet records the id of an existing subnet in the state without updating it.
+func (c *FlowContext) recordSubnet(desired, current *awsclient.Subnet) error {
+	_, subnetKey, err := c.getSubnetKey(desired)
+	if err != nil {
+		return err
+	}
+	c.getSubnetZoneChildByItem(desired).Set(subnetKey, current.SubnetId)
+	return nil
+}
+
+// ensureSharedSubnets looks up the subnets of the zones in a VPC shared via AWS RAM by their CIDRs. The subnets
+// belong to the account of the VPC, so they are only recorded in the state, but never created, updated or deleted.
+func (c *FlowContext) ensureSharedSubnets(ctx context.Context) error {
+	vpcID := *c.state.Get(IdentifierVPC)
+	current, err := c.client.FindSubnetsByVpcId(ctx, vpcID)
+	if err != nil {
+		return err
+	}
+
+	zoneNames := sets.New[string]()
+	for _, zone := range c.config.Networks.Zones {
+		zoneNames.Insert(zone.Name)
+		zoneChild := c.getSubnetZoneChild(zone.Name)
+		for _, subnet := range []struct {
+			key  string
+			cidr string
+		}{
+			{IdentifierZoneSubnetWorkers, zone.Workers},
+			{IdentifierZoneSubnetPublic, zone.Public},
+			{IdentifierZoneSubnetPrivate, zone.Internal},
+		} {
+			idx := slices.IndexFunc(current, func(item *awsclient.Subnet) bool {
+				return item.AvailabilityZone == zone.Name && item.CidrBlock == subnet.cidr
+			})
+			if idx < 0 {
+				return fmt.Errorf("subnet %s in zone %s has not been found in shared VPC %s", subnet.cidr, zone.Name, vpcID)
+			}
+			zoneChild.Set(subnet.key, current[idx].SubnetId)
+			zoneChild.Set(subnet.key+IdentifierZoneSubnetIPv6CIDRSuffix, firstOrEmpty(current[idx].Ipv6CidrBlocks))
+		}
+	}
+
+	// forget the subnets of removed zones
+	zonesChild := c.state.GetChild(ChildIdZones)
+	for _, zoneName := range zonesChild.GetChildrenKeys() {
+		if zoneNames.Has(zoneName) {
+			continue
+		}
+		zoneChild := zonesChild.GetChild(zoneName)
+		for _, key := range []string{IdentifierZoneSubnetWorkers, IdentifierZoneSubnetPublic, IdentifierZoneSubnetPrivate} {
+			zoneChild.Set(key, "")
+			zoneChild.Set(key+IdentifierZoneSubnetIPv6CIDRSuffix, "")
+		}
+	}
+	return nil
+}
+
+func (c *FlowContext) addZoneDeletionTasksBySubnets(g *flow.Graph, toBeDeleted []*awsclient.Subnet) error {
+	toBeDeletedZones := sets.NewString()
+	for _, item := range toBeDeleted {
+		toBeDeletedZones.Insert(getZoneName(item))
+	}
+	dependencies := newZoneDependencies()
+	for zoneName := range toBeDeletedZones {
+		dependencies.Append(zoneName, c.addZoneDeletionTasks(g, zoneName)...)
+	}
+	for _, item := range toBeDeleted {
+		if err := c.addSubnetDeletionTasks(g, item, dependencies.Get(item.AvailabilityZone)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *FlowContext) collectExistingSubnets(ctx context.Context) ([]*awsclient.Subnet, error) {
+	child := c.state.GetChild(ChildIdZones)
+	var ids []string
+	for _, zoneKey := range child.GetChildrenKeys() {
+		zoneChild := child.GetChild(zoneKey)
+		if id := zoneChild.Get(IdentifierZoneSubnetWorkers); id != nil {
+			ids = append(ids, *id)
+		}
+		if id := zoneChild.Get(IdentifierZoneSubnetPublic); id != nil {
+			ids = append(ids, *id)
+		}
+		if id := zoneChild.Get(IdentifierZoneSubnetPrivate); id != nil {
+			ids = append(ids, *id)
+		}
+		if id := zoneChild.Get(IdentifierZoneSubnetPods); id != nil {
+			ids = append(ids, *id)
+		}
+		if id := zoneChild.Get(IdentifierZoneSubnetWorkersSecondary); id != nil {
+			ids = append(ids, *id)
+		}
+	}
+	var current []*awsclient.Subnet
+	if len(ids) > 0 {
+		found, err := c.client.GetSubnets(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		current = found
+	}
+	foundByTags, err := c.client.FindSubnetsByTags(ctx, c.clusterTags())
+	if err != nil {
+		return nil, err
+	}
+outer:
+	for _, item := range foundByTags {
+		for _, currentItem := range current {
+			if item.SubnetId == currentItem.SubnetId {
+				continue outer
+			}
+		}
+		current = append(current, item)
+	}
+	return current, nil
+}
+
+// addZoneTask adds a task for a resource of a zone, which is limited to run with at most MaxParallelZoneTasks other
+// zone tasks.
+func (c *FlowContext) addZoneTask(g *flow.Graph, name string, fn flow.TaskFn, options ...TaskOption) flow.TaskIDer {
+	return c.AddTask(g, name, fn, append(options, Limit(c.zoneTaskLimiter))...)
+}
+
+func (c *FlowContext) addSubnetReconcileTasks(g *flow.Graph, desired, current *awsclient.Subnet) (flow.TaskIDer, error) {
+	zoneName, subnetKey, err := c.getSubnetKey(desired)
+	if err != nil {
+		return nil, err
+	}
+	suffix := fmt.Sprintf("%s-%s", zoneName, subnetKey)
+	return c.addZoneTask(g, "ensure subnet "+suffix,
+		c.ensureSubnet(subnetKey, desired, current),
+		Timeout(defaultTimeout)), nil
+}
+
+func (c *FlowContext) addNATGatewayReconcileTasks(g *flow.Graph, zone *aws.Zone, dependencies []flow.TaskIDer) flow.TaskIDer {
+	ensureElasticIP := c.addZoneTask(g, "ensure NAT gateway elastic IP "+zone.Name,
+		c.ensureElasticIP(zone),
+		Timeout(defaultTimeout), Dependencies(dependencies...))
+
+	return c.addZoneTask(g, "ensure NAT gateway "+zone.Name,
+		c.ensureNATGateway(zone),
+		Timeout(defaultLongTimeout), Dependencies(dependencies...), Dependencies(ensureElasticIP))
+}
+
+func (c *FlowContext) addNATInstanceReconcileTasks(g *flow.Graph, zone *aws.Zone, dependencies []flow.TaskIDer) flow.TaskIDer {
+	return c.addZoneTask(g, "ensure NAT instance "+zone.Name,
+		c.ensureNATInstance(zone),
+		Timeout(defaultLongTimeout), Dependencies(dependencies...))
+}
+
+func (c *FlowContext) addZoneReconcileTasks(g *flow.Graph, zone *aws.Zone, natGatewayZoneName string, dependencies []flow.TaskIDer) flow.TaskIDer {
+	ensureRoutingTable := c.addZoneTask(g, "ensure route table "+zone.Name,
+		c.ensurePrivateRoutingTable(zone.Name, natGatewayZoneName),
+		Timeout(defaultTimeout), Dependencies(dependencies...))
+
+	_ = c.addZoneTask(g, "ensure route table associations "+zone.Name,
+		c.ensureRoutingTableAssociations(zone.Name),
+		Timeout(defaultTimeout), Dependencies(dependencies...), Dependencies(ensureRoutingTable))
+
+	_ = c.addZoneTask(g, "ensure VPC endpoints route table associations "+zone.Name,
+		c.ensureVPCEndpointsRoutingTableAssociations(zone.Name),
+		Timeout(defaultTimeout), Dependencies(dependencies...), Dependencies(ensureRoutingTable))
+
+	return ensureRoutingTable
+}
+
+func (c *FlowContext) addZoneDeletionTasks(g *flow.Graph, zoneName string) []flow.TaskIDer {
+	deleteRoutingTableAssocs := c.addZoneTask(g, "delete route table associations "+zoneName,
+		c.deleteRoutingTableAssociations(zoneName),
+		Timeout(defaultTimeout))
+
+	deleteRoutingTable := c.addZoneTask(g, "delete route table "+zoneName,
+		c.deletePrivateRoutingTable(zoneName),
+		Timeout(defaultTimeout), Dependencies(deleteRoutingTableAssocs))
+
+	return []flow.TaskIDer{
+		c.addNATGatewayDeletionTasks(g, zoneName, deleteRoutingTable),
+		c.addNATInstanceDeletionTasks(g, zoneName, deleteRoutingTable),
+	}
+}
+
+func (c *FlowContext) addNATGatewayDeletionTasks(g *flow.Graph, zoneName string, dependencies ...flow.TaskIDer) flow.TaskIDer {
+	deleteNATGateway := c.addZoneTask(g, "delete NAT gateway "+zoneName,
+		c.deleteNATGateway(zoneName),
+		Timeout(defaultLongTimeout), Dependencies(dependencies...))
+
+	_ = c.addZoneTask(g, "delete NAT gateway elastic IP "+zoneName,
+		c.deleteElasticIP(zoneName),
+		Timeout(defaultTimeout), Dependencies(deleteNATGateway))
+
+	return deleteNATGateway
+}
+
+func (c *FlowContext) addNATInstanceDeletionTasks(g *flow.Graph, zoneName string, dependencies ...flow.TaskIDer) flow.TaskIDer {
+	return c.addZoneTask(g, "delete NAT instance "+zoneName,
+		c.deleteNATInstance(zoneName),
+		Timeout(defaultLongTimeout), Dependencies(dependencies...))
+}
+
+func (c *FlowContext) addSubnetDeletionTasks(g *flow.Graph, item *awsclient.Subnet, dependencies []flow.TaskIDer) error {
+	zoneName, subnetKey, err := c.getSubnetKey(item)
+	if err != nil {
+		return err
+	}
+	suffix := fmt.Sprintf("%s-%s", zoneName, subnetKey)
+	_ = c.addZoneTask(g, "delete subnet resource "+suffix,
+		c.deleteSubnet(subnetKey, item),
+		Timeout(defaultTimeout), Dependencies(dependencies...))
+	return nil
+}
+
+func (c *FlowContext) deleteSubnet(subnetKey string, item *awsclient.Subnet) flow.TaskFn {
+	zoneChild := c.getSubnetZoneChildByItem(item)
+	return func(ctx context.Context) error {
+		if zoneChild.IsAlreadyDeleted(subnetKey) {
+			return nil
+		}
+		log := c.LogFromContext(ctx)
+		log.Info("deleting...", "SubnetID", item.SubnetId)
+		waiter := informOnWaiting(log, 10*time.Second, "still deleting...", "SubnetID", item.SubnetId)
+		err := c.client.DeleteSubnet(ctx, item.SubnetId)
+		waiter.Done(err)
+		if err != nil {
+			return err
+		}
+		zoneChild.SetAsDeleted(subnetKey)
+		zoneChild.Set(subnetKey+IdentifierZoneSubnetIPv6CIDRSuffix, "")
+		// the route table associations are removed together with the subnets
+		switch subnetKey {
+		case IdentifierZoneSubnetPods:
+			zoneChild.Set(IdentifierZoneSubnetPodsRouteTableAssoc, "")
+		case IdentifierZoneSubnetWorkersSecondary:
+			zoneChild.Set(IdentifierZoneSubnetWorkersSecondaryRouteTableAssoc, "")
+		}
+		return nil
+	}
+}
+
+func (c *FlowContext) ensureSubnet(subnetKey string, desired, current *awsclient.Subnet) flow.TaskFn {
+	zoneChild := c.getSubnetZoneChildByItem(desired)
+	if current == nil {
+		return func(ctx context.Context) error {
+			log := c.LogFromContext(ctx)
+			log.Info("creating...")
+			created, err := c.client.CreateSubnet(ctx, desired)
+			if err != nil {
+				return err
+			}
+			zoneChild.Set(subnetKey, created.SubnetId)
+			zoneChild.Set(subnetKey+IdentifierZoneSubnetIPv6CIDRSuffix, firstOrEmpty(created.Ipv6CidrBlocks, desired.Ipv6CidrBlocks))
+			return nil
+		}
+	}
+	return func(ctx context.Context) error {
+		zoneChild.Set(subnetKey, current.SubnetId)
+		modified, err := c.updater.UpdateSubnet(ctx, desired, current)
+		if err != nil {
+			return err
+		}
+		zoneChild.Set(subnetKey+IdentifierZoneSubnetIPv6CIDRSuffix, firstOrEmpty(current.Ipv6CidrBlocks, desired.Ipv6CidrBlocks))
+		if modified {
+			log := c.LogFromContext(ctx)
+			log.Info("updated")
+		}
+		return nil
+	}
+}
+
+func (c *FlowContext) ensureElasticIP(zone *aws.Zone) flow.TaskFn {
+	return func(ctx context.Context) error {
+		if zone.ElasticIPAllocationID != nil || c.useElasticIPPool() {
+			return nil
+		}
+		log := c.LogFromContext(ctx)
+		helper := c.zoneSuffixHelpers(zone.Name)
+		child := c.getSubnetZoneChild(zone.Name)
+		id := child.Get(IdentifierZoneNATGWElasticIP)
+		desired := &awsclient.ElasticIP{
+			Tags: c.commonTagsWithSuffix(helper.GetSuffixElasticIP()),
+			Vpc:  true,
+		}
+		current, err := findExisting(ctx, id, desired.Tags, c.client.GetElasticIP, c.client.FindElasticIPsByTags)
+		if err != nil {
+			return err
+		}
+
+		if current != nil {
+			child.Set(IdentifierZoneNATGWElasticIP, current.AllocationId)
+			if _, err := c.updater.UpdateEC2Tags(ctx, current.AllocationId, desired.Tags, current.Tags); err != nil {
+				return err
+			}
+		} else {
+			log.Info("creating...")
+			created, err := c.client.CreateElasticIP(ctx, desired)
+			if err != nil {
+				return err
+			}
+			child.Set(IdentifierZoneNATGWElasticIP, created.AllocationId)
+		}
+
+		return nil
+	}
+}
+
+func (c *FlowContext) deleteElasticIP(zoneName string) flow.TaskFn {
+	return func(ctx context.Context) error {
+		child := c.getSubnetZoneChild(zoneName)
+		if child.IsAlreadyDeleted(IdentifierZoneNATGWElasticIP) {
+			return nil
+		}
+		helper := c.zoneSuffixHelpers(zoneName)
+		tags := c.commonTagsWithSuffix(helper.GetSuffixElasticIP())
+		current, err := findExisting(ctx, child.Get(IdentifierZoneNATGWElasticIP), tags, c.client.GetElasticIP, c.client.FindElasticIPsByTags)
+		if err != nil {
+			return err
+		}
+		if current != nil && current.Tags[TagKeyElasticIPPool] == "" {
+			log := c.LogFromContext(ctx)
+			log.Info("deleting...", "AllocationId", current.AllocationId)
+			waiter := informOnWaiting(log, 10*time.Second, "still deleting...", "AllocationId", current.AllocationId)
+			err = c.client.DeleteElasticIP(ctx, current.AllocationId)
+			waiter.Done(err)
+			if err != nil {
+				return err
+			}
+		}
+		child.SetAsDeleted(IdentifierZoneNATGWElasticIP)
+		return nil
+	}
+}
+
+func (c *FlowContext) useElasticIPPool() bool {
+	return c.config.Networks.NATGateway != nil && c.config.Networks.NATGateway.ElasticIPPool != nil
+}
+
+func (c *FlowContext) elasticIPPoolTags() awsclient.Tags {
+	tags := c.clusterTags()
+	tags[TagKeyElasticIPPool] = TagValueCluster
+	return tags
+}
+
+// ensureElasticIPPool ensures the elastic IPs of the pool and assigns them to the NAT gateways of the zones without
+// an elastic IP allocation of their own. Elastic IPs which have been created for the NAT gateways before the pool was
+// configured are adopted, so that the egress IPs don't change. Assignments are kept as long as the zone has a NAT
+// gateway, so that recreated NAT gateways get the same IP.
+func (c *FlowContext) ensureElasticIPPool(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+	current, err := c.client.FindElasticIPsByTags(ctx, c.elasticIPPoolTags())
+	if err != nil {
+		return err
+	}
+	names := sets.New[string]()
+	for _, item := range current {
+		names.Insert(item.Tags[TagKeyName])
+	}
+	nextTags := func() awsclient.Tags {
+		for i := 0; ; i++ {
+			tags := c.commonTagsWithSuffix(fmt.Sprintf("eip-pool-%d", i))
+			if !names.Has(tags[TagKeyName]) {
+				names.Insert(tags[TagKeyName])
+				tags[TagKeyElasticIPPool] = TagValueCluster
+				return tags
+			}
+		}
+	}
+
+	var poolZones []string
+	for _, zone := range c.config.Networks.Zones {
+		if zone.ElasticIPAllocationID == nil && helper.GetNATGatewayZoneName(c.config, zone.Name) == zone.Name {
+			poolZones = append(poolZones, zone.Name)
+		}
+	}
+
+	for _, zoneName := range poolZones {
+		child := c.getSubnetZoneChild(zoneName)
+		id := child.Get(IdentifierZoneNATGWElasticIP)
+		if id == nil {
+			continue
+		}
+		eip, err := c.client.GetElasticIP(ctx, *id)
+		if err != nil {
+			return err
+		}
+		if eip != nil {
+			log.Info("adopting...", "AllocationId", eip.AllocationId)
+			tags := nextTags()
+			if _, err := c.updater.UpdateEC2Tags(ctx, eip.AllocationId, tags, eip.Tags); err != nil {
+				return err
+			}
+			eip.Tags = tags
+			current = append(current, eip)
+			child.Set(IdentifierZoneNATGWPoolElasticIP, eip.AllocationId)
+		}
+		child.SetAsDeleted(IdentifierZoneNATGWElasticIP)
+	}
+
+	for len(current) < int(c.config.Networks.NATGateway.ElasticIPPool.Size) {
+		log.Info("creating...")
+		created, err := c.client.CreateElasticIP(ctx, &awsclient.ElasticIP{Tags: nextTags(), Vpc: true})
+		if err != nil {
+			return err
+		}
+		current = append(current, created)
+	}
+	slices.SortFunc(current, func(a, b *awsclient.ElasticIP) int {
+		return strings.Compare(a.Tags[TagKeyName], b.Tags[TagKeyName])
+	})
+
+	pool := c.state.GetChild(ChildIdElasticIPPool)
+	allocationIDs := sets.New[string]()
+	for _, item := range current {
+		allocationIDs.Insert(item.AllocationId)
+		pool.Set(item.AllocationId, item.PublicIp)
+	}
+	for _, key := range pool.Keys() {
+		if !allocationIDs.Has(key) {
+			pool.Set(key, "")
+		}
+	}
+
+	assigned := sets.New[string]()
+	for _, zoneName := range poolZones {
+		child := c.getSubnetZoneChild(zoneName)
+		if id := child.Get(IdentifierZoneNATGWPoolElasticIP); id != nil && allocationIDs.Has(*id) && !assigned.Has(*id) {
+			assigned.Insert(*id)
+			continue
+		}
+		child.SetAsDeleted(IdentifierZoneNATGWPoolElasticIP)
+	}
+	for _, zoneName := range poolZones {
+		child := c.getSubnetZoneChild(zoneName)
+		if child.Get(IdentifierZoneNATGWPoolElasticIP) != nil {
+			continue
+		}
+		for _, item := range current {
+			if !assigned.Has(item.AllocationId) {
+				assigned.Insert(item.AllocationId)
+				child.Set(IdentifierZoneNATGWPoolElasticIP, item.AllocationId)
+				break
+			}
+		}
+		if child.Get(IdentifierZoneNATGWPoolElasticIP) == nil {
+			return fmt.Errorf("no unassigned elastic IP left in the elastic IP pool for the NAT gateway of zone %s", zoneName)
+		}
+	}
+	// zones without NAT gateway release their assignment, the elastic IP stays in the pool
+	for _, zone := range c.config.Networks.Zones {
+		if !slices.Contains(poolZones, zone.Name) {
+			c.getSubnetZoneChild(zone.Name).SetAsDeleted(IdentifierZoneNATGWPoolElasticIP)
+		}
+	}
+
+	return c.PersistState(ctx, true)
+}
+
+// deleteElasticIPPool releases the elastic IPs of the pool after the NAT gateways have been deleted. If the pool is
+// configured to be retained, the elastic IPs are only removed from the state. They keep their tags, so that they are
+// adopted again by a shoot with the same technical id.
+func (c *FlowContext) deleteElasticIPPool(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+	pool := c.state.GetChild(ChildIdElasticIPPool)
+	current, err := c.client.FindElasticIPsByTags(ctx, c.elasticIPPoolTags())
+	if err != nil {
+		return err
+	}
+	if c.useElasticIPPool() && c.config.Networks.NATGateway.ElasticIPPool.RetainOnDeletion {
+		for _, item := range current {
+			log.Info("retaining...", "AllocationId", item.AllocationId, "PublicIp", item.PublicIp)
+		}
+		current = nil
+	}
+	for _, item := range current {
+		log.Info("deleting...", "AllocationId", item.AllocationId)
+		if err := c.client.DeleteElasticIP(ctx, item.AllocationId); err != nil {
+			return err
+		}
+	}
+	for _, key := range pool.Keys() {
+		pool.Set(key, "")
+	}
+	return nil
+}
+
+func (c *FlowContext) ensureNATGateway(zone *aws.Zone) flow.TaskFn {
+	return func(ctx context.Context) error {
+		log := c.LogFromContext(ctx)
+		child := c.getSubnetZoneChild(zone.Name)
+		helper := c.zoneSuffixHelpers(zone.Name)
+		desired := &awsclient.NATGateway{
+			Tags:     c.commonTagsWithSuffix(helper.GetSuffixNATGateway()),
+			SubnetId: *child.Get(IdentifierZoneSubnetPublic),
+		}
+		if zone.ElasticIPAllocationID != nil {
+			desired.EIPAllocationId = *zone.ElasticIPAllocationID
+		} else if c.useElasticIPPool() {
+			desired.EIPAllocationId = *child.Get(IdentifierZoneNATGWPoolElasticIP)
+		} else {
+			desired.EIPAllocationId = *child.Get(IdentifierZoneNATGWElasticIP)
+		}
+		current, err := findExisting(ctx, child.Get(IdentifierZoneNATGateway), desired.Tags, c.client.GetNATGateway, c.client.FindNATGatewaysByTags,
+			func(item *awsclient.NATGateway) bool {
+				return !strings.EqualFold(item.State, string(ec2types.StateDeleting)) && !strings.EqualFold(item.State, string(ec2types.StateFailed))
+			})
+		if err != nil {
+			return err
+		}
+
+		if current != nil {
+			child.Set(IdentifierZoneNATGateway, current.NATGatewayId)
+			if current.PublicIP != "" {
+				child.Set(IdentifierZoneNATGatewayPublicIP, current.PublicIP)
+			}
+			if _, err := c.updater.UpdateEC2Tags(ctx, current.NATGatewayId, desired.Tags, current.Tags); err != nil {
+				return err
+			}
+		} else {
+			child.Set(IdentifierZoneNATGateway, "")
+			log.Info("creating...")
+			waiter := informOnWaiting(log, 10*time.Second, "still creating...")
+			created, err := c.client.CreateNATGateway(ctx, desired)
+			if created != nil {
+				waiter.UpdateMessage("waiting until available...")
+				if perr := c.PersistState(ctx, true); perr != nil {
+					log.Info("persisting state failed", "error", perr)
+				}
+				child.Set(IdentifierZoneNATGateway, created.NATGatewayId)
+				if created.PublicIP != "" {
+					child.Set(IdentifierZoneNATGatewayPublicIP, created.PublicIP)
+				}
+				err = c.client.WaitForNATGatewayAvailable(ctx, created.NATGatewayId)
+			}
+			waiter.Done(err)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func (c *FlowContext) deleteNATGateway(zoneName string) flow.TaskFn {
+	return func(ctx context.Context) error {
+		child := c.getSubnetZoneChild(zoneName)
+		if child.IsAlreadyDeleted(IdentifierZoneNATGateway) {
+			return nil
+		}
+		log := c.LogFromContext(ctx)
+		helper := c.zoneSuffixHelpers(zoneName)
+		tags := c.commonTagsWithSuffix(helper.GetSuffixNATGateway())
+		current, err := findExisting(ctx, child.Get(IdentifierZoneNATGateway), tags, c.client.GetNATGateway, c.client.FindNATGatewaysByTags,
+			func(item *awsclient.NATGateway) bool {
+				return !strings.EqualFold(item.State, string(ec2types.StateDeleting)) && !strings.EqualFold(item.State, string(ec2types.StateFailed))
+			})
+		if err != nil {
+			return err
+		}
+		if current != nil {
+			log.Info("deleting...", "NATGatewayId", current.NATGatewayId)
+			waiter := informOnWaiting(log, 10*time.Second, "still deleting...", "NATGatewayId", current.NATGatewayId)
+			err := c.client.DeleteNATGateway(ctx, current.NATGatewayId)
+			waiter.Done(err)
+			if err != nil {
+				return err
+			}
+		}
+		child.SetAsDeleted(IdentifierZoneNATGateway)
+		return nil
+	}
+}
+
+// natInstanceUserDataTemplate configures the NAT instance to masquerade the traffic of the private subnets. A NAT
+// instance which replaced a failed one disables its source/destination check and takes over the default routes of the
+// private route tables tagged with the name of its auto scaling group, so that egress traffic recovers without waiting
+// for the next reconciliation of the infrastructure.
+const natInstanceUserDataTemplate = `#!/bin/bash
+set -e
+sysctl -w net.ipv4.ip_forward=1
+echo "net.ipv4.ip_forward = 1" > /etc/sysctl.d/90-nat-instance.conf
+iface=$(ip route show default | awk '{print $5; exit}')
+iptables -t nat -C POSTROUTING -o "$iface" -j MASQUERADE 2>/dev/null || iptables -t nat -A POSTROUTING -o "$iface" -j MASQUERADE
+
+token=$(curl -sf -X PUT -H "X-aws-ec2-metadata-token-ttl-seconds: 300" http://169.254.169.254/latest/api/token)
+imds() { curl -sf -H "X-aws-ec2-metadata-token: $token" "http://169.254.169.254/latest/meta-data/$1"; }
+instance_id=$(imds instance-id)
+export AWS_DEFAULT_REGION=$(imds placement/region)
+aws ec2 modify-instance-attribute --instance-id "$instance_id" --no-source-dest-check
+for route_table_id in $(aws ec2 describe-route-tables --filters "Name=vpc-id,Values={{ .vpcID }}" "Name=tag:{{ .tagKey }},Values={{ .name }}" --query "RouteTables[].RouteTableId" --output text); do
+  aws ec2 replace-route --route-table-id "$route_table_id" --destination-cidr-block 0.0.0.0/0 --instance-id "$instance_id" ||
+    aws ec2 create-route --route-table-id "$route_table_id" --destination-cidr-block 0.0.0.0/0 --instance-id "$instance_id"
+done
+`
+
+func (c *FlowContext) natInstanceUserData(name string) (string, error) {
+	t, err := template.New("userData").Parse(natInstanceUserDataTemplate)
+	if err != nil {
+		return "", fmt.Errorf("parsing user data template failed: %w", err)
+	}
+	var buffer bytes.Buffer
+	if err := t.Execute(&buffer, map[string]any{
+		"vpcID":  *c.state.Get(IdentifierVPC),
+		"tagKey": TagKeyNATInstance,
+		"name":   name,
+	}); err != nil {
+		return "", fmt.Errorf("executing user data template failed: %w", err)
+	}
+	return buffer.String(), nil
+}
+
+func (c *FlowContext) ensureNATInstance(zone *aws.Zone) flow.TaskFn {
+	return func(ctx context.Context) error {
+		log := c.LogFromContext(ctx)
+		child := c.getSubnetZoneChild(zone.Name)
+		helper := c.zoneSuffixHelpers(zone.Name)
+		name := fmt.Sprintf("%s-%s", c.namespace, helper.GetSuffixNATInstance())
+		instanceConfig := c.config.Networks.NATGateway.Instance
+		userData, err := c.natInstanceUserData(name)
+		if err != nil {
+			return err
+		}
+		desiredTemplate := &awsclient.LaunchTemplate{
+			Tags:                   c.commonTagsWithSuffix(helper.GetSuffixNATInstance()),
+			LaunchTemplateName:     name,
+			ImageId:                instanceConfig.AMI,
+			InstanceType:           instanceConfig.InstanceType,
+			SecurityGroupIds:       []string{*c.state.Get(IdentifierNATInstanceSecurityGroup)},
+			IamInstanceProfileName: *c.state.Get(NameNATInstanceIAMInstanceProfile),
+			UserData:               userData,
+		}
+		child.Set(IdentifierZoneNATInstanceLaunchTemplate, name)
+		template, err := c.client.GetLaunchTemplate(ctx, name)
+		if err != nil {
+			return err
+		}
+		if template == nil {
+			log.Info("creating launch template...", "LaunchTemplateName", name)
+			if template, err = c.client.CreateLaunchTemplate(ctx, desiredTemplate); err != nil {
+				return err
+			}
+		} else if !equivalentLaunchTemplates(desiredTemplate, template) {
+			log.Info("creating launch template version...", "LaunchTemplateName", name)
+			if template, err = c.client.CreateLaunchTemplateVersion(ctx, desiredTemplate); err != nil {
+				return err
+			}
+		}
+		version := fmt.Sprintf("%d", template.LatestVersionNumber)
+
+		desiredGroup := &awsclient.AutoScalingGroup{
+			Tags:                  desiredTemplate.Tags,
+			AutoScalingGroupName:  name,
+			LaunchTemplateName:    name,
+			LaunchTemplateVersion: version,
+			MinSize:               1,
+			MaxSize:               1,
+			DesiredCapacity:       1,
+			SubnetIds:             []string{*child.Get(IdentifierZoneSubnetPublic)},
+		}
+		child.Set(IdentifierZoneNATInstanceAutoScalingGroup, name)
+		group, err := c.client.GetAutoScalingGroup(ctx, name)
+		if err != nil {
+			return err
+		}
+		if group == nil {
+			log.Info("creating auto scaling group...", "AutoScalingGroupName", name)
+			if err := c.client.CreateAutoScalingGroup(ctx, desiredGroup); err != nil {
+				return err
+			}
+		} else {
+			if group.Status != nil {
+				return fmt.Errorf("auto scaling group %s is in status %q", name, *group.Status)
+			}
+			if group.LaunchTemplateVersion != version || group.MinSize != desiredGroup.MinSize ||
+				group.MaxSize != desiredGroup.MaxSize || group.DesiredCapacity != desiredGroup.DesiredCapacity {
+				log.Info("updating auto scaling group...", "AutoScalingGroupName", name, "LaunchTemplateVersion", version)
+				if err := c.client.UpdateAutoScalingGroup(ctx, desiredGroup); err != nil {
+					return err
+				}
+			}
+			// the auto scaling group replaces instances launched with an outdated launch template version
+			for _, instance := range group.Instances {
+				if instance.LaunchTemplateVersion == version || !isActiveAutoScalingGroupInstance(instance) {
+					continue
+				}
+				log.Info("terminating outdated NAT instance...", "InstanceId", instance.InstanceId)
+				if err := c.client.TerminateAutoScalingGroupInstance(ctx, instance.InstanceId); err != nil {
+					return err
+				}
+			}
+		}
+
+		waiter := informOnWaiting(log, 10*time.Second, "waiting until NAT instance is in service...")
+		instanceId, err := c.client.WaitForAutoScalingGroupInstanceInService(ctx, name, version)
+		waiter.Done(err)
+		if err != nil {
+			return err
+		}
+		child.Set(IdentifierZoneNATInstance, instanceId)
+		instance, err := c.client.GetInstance(ctx, instanceId)
+		if err != nil {
+			return err
+		}
+		if instance == nil {
+			return fmt.Errorf("NAT instance %s not found", instanceId)
+		}
+		if pointer.BoolDeref(instance.SourceDestCheck, true) {
+			log.Info("disabling source/destination check...", "InstanceId", instanceId)
+			if err := c.client.DisableInstanceSourceDestCheck(ctx, instanceId); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func equivalentLaunchTemplates(desired, current *awsclient.LaunchTemplate) bool {
+	return desired.ImageId == current.ImageId &&
+		desired.InstanceType == current.InstanceType &&
+		desired.IamInstanceProfileName == current.IamInstanceProfileName &&
+		desired.UserData == current.UserData &&
+		sets.New(desired.SecurityGroupIds...).Equal(sets.New(current.SecurityGroupIds...))
+}
+
+func isActiveAutoScalingGroupInstance(instance *awsclient.AutoScalingGroupInstance) bool {
+	return !strings.HasPrefix(instance.LifecycleState, string(autoscalingtypes.LifecycleStateTerminating)) &&
+		instance.LifecycleState != string(autoscalingtypes.LifecycleStateTerminated)
+}
+
+const natInstanceIAMRolePolicyTemplate = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "ec2:DescribeRouteTables"
+      ],
+      "Resource": [
+        "*"
+      ]
+    },
+    {
+      "Effect": "Allow",
+      "Action": [
+        "ec2:ModifyInstanceAttribute"
+      ],
+      "Resource": [
+        "*"
+      ],
+      "Condition": {
+        "StringLike": {
+          "ec2:ResourceTag/%[1]s": "%[3]s"
+        }
+      }
+    },
+    {
+      "Effect": "Allow",
+      "Action": [
+        "ec2:CreateRoute",
+        "ec2:ReplaceRoute"
+      ],
+      "Resource": [
+        "*"
+      ],
+      "Condition": {
+        "StringLike": {
+          "ec2:ResourceTag/%[2]s": "%[3]s"
+        }
+      }
+    }
+  ]
+}`
+
+// ensureNATInstanceIAM ensures the IAM role and instance profile of the NAT instances, which allow a NAT instance to
+// disable its source/destination check and to take over the routes of the NAT instance it replaced.
+func (c *FlowContext) ensureNATInstanceIAM(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+	name := fmt.Sprintf("%s-nat-instance", c.namespace)
+
+	desiredRole := &awsclient.IAMRole{
+		RoleName:                 name,
+		Path:                     "/",
+		AssumeRolePolicyDocument: fmt.Sprintf(assumeRolePolicyTemplate, awsclient.ServicePrincipal(c.partition, "ec2")),
+	}
+	role, err := c.client.GetIAMRole(ctx, name)
+	if err != nil {
+		return err
+	}
+	if role != nil {
+		if _, err := c.updater.UpdateIAMRole(ctx, desiredRole, role); err != nil {
+			return err
+		}
+	} else {
+		log.Info("creating IAM role...", "RoleName", name)
+		if _, err := c.client.CreateIAMRole(ctx, desiredRole); err != nil {
+			return err
+		}
+	}
+	c.state.Set(NameNATInstanceIAMRole, name)
+
+	// the auto scaling groups and the private route tables are tagged with the names of the auto scaling groups
+	namePattern := fmt.Sprintf("%s-%s", c.namespace, (&ZoneSuffixHelper{suffix: "*"}).GetSuffixNATInstance())
+	desiredPolicy := &awsclient.IAMRolePolicy{
+		PolicyName:     name,
+		RoleName:       name,
+		PolicyDocument: fmt.Sprintf(natInstanceIAMRolePolicyTemplate, TagKeyName, TagKeyNATInstance, namePattern),
+	}
+	policy, err := c.client.GetIAMRolePolicy(ctx, name, name)
+	if err != nil {
+		return err
+	}
+	if policy == nil || policy.PolicyDocument != desiredPolicy.PolicyDocument {
+		log.Info("putting IAM role policy...", "PolicyName", name)
+		if err := c.client.PutIAMRolePolicy(ctx, desiredPolicy); err != nil {
+			return err
+		}
+	}
+	c.state.Set(NameNATInstanceIAMRolePolicy, name)
+
+	desiredInstanceProfile := &awsclient.IAMInstanceProfile{
+		InstanceProfileName: name,
+		Path:                "/",
+		RoleName:            name,
+	}
+	instanceProfile, err := c.client.GetIAMInstanceProfile(ctx, name)
+	if err != nil {
+		return err
+	}
+	if instanceProfile == nil {
+		log.Info("creating IAM instance profile...", "InstanceProfileName", name)
+		if instanceProfile, err = c.client.CreateIAMInstanceProfile(ctx, desiredInstanceProfile); err != nil {
+			return err
+		}
+	}
+	c.state.Set(NameNATInstanceIAMInstanceProfile, name)
+	if _, err := c.updater.UpdateIAMInstanceProfile(ctx, desiredInstanceProfile, instanceProfile); err != nil {
+		return err
+	}
+	return nil
+}
+
+// deleteNATInstanceIAM deletes the IAM role and instance profile of the NAT instances.
+func (c *FlowContext) deleteNATInstanceIAM(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+	name := fmt.Sprintf("%s-nat-instance", c.namespace)
+	if !c.state.IsAlreadyDeleted(NameNATInstanceIAMInstanceProfile) {
+		log.Info("deleting IAM instance profile...", "InstanceProfileName", name)
+		if err := c.client.RemoveRoleFromIAMInstanceProfile(ctx, name, name); err != nil {
+			return err
+		}
+		if err := c.client.DeleteIAMInstanceProfile(ctx, name); err != nil {
+			return err
+		}
+		c.state.SetAsDeleted(NameNATInstanceIAMInstanceProfile)
+	}
+	if !c.state.IsAlreadyDeleted(NameNATInstanceIAMRolePolicy) {
+		log.Info("deleting IAM role policy...", "PolicyName", name)
+		if err := c.client.DeleteIAMRolePolicy(ctx, name, name); err != nil {
+			return err
+		}
+		c.state.SetAsDeleted(NameNATInstanceIAMRolePolicy)
+	}
+	if !c.state.IsAlreadyDeleted(NameNATInstanceIAMRole) {
+		log.Info("deleting IAM role...", "RoleName", name)
+		if err := c.client.DeleteIAMRole(ctx, name); err != nil {
+			return err
+		}
+		c.state.SetAsDeleted(NameNATInstanceIAMRole)
+	}
+	return nil
+}
+
+func (c *FlowContext) deleteNATInstance(zoneName string) flow.TaskFn {
+	return func(ctx context.Context) error {
+		child := c.getSubnetZoneChild(zoneName)
+		if child.IsAlreadyDeleted(IdentifierZoneNATInstanceAutoScalingGroup) && child.IsAlreadyDeleted(IdentifierZoneNATInstanceLaunchTemplate) {
+			return nil
+		}
+		log := c.LogFromContext(ctx)
+		helper := c.zoneSuffixHelpers(zoneName)
+		name := fmt.Sprintf("%s-%s", c.namespace, helper.GetSuffixNATInstance())
+		group, err := c.client.GetAutoScalingGroup(ctx, name)
+		if err != nil {
+			return err
+		}
+		if group != nil {
+			log.Info("deleting...", "AutoScalingGroupName", name)
+			waiter := informOnWaiting(log, 10*time.Second, "still deleting...", "AutoScalingGroupName", name)
+			err := c.client.DeleteAutoScalingGroup(ctx, name)
+			waiter.Done(err)
+			if err != nil {
+				return err
+			}
+		}
+		child.SetAsDeleted(IdentifierZoneNATInstanceAutoScalingGroup)
+		child.SetAsDeleted(IdentifierZoneNATInstance)
+		if err := c.client.DeleteLaunchTemplate(ctx, name); err != nil {
+			return err
+		}
+		child.SetAsDeleted(IdentifierZoneNATInstanceLaunchTemplate)
+		return nil
+	}
+}
+
+func (c *FlowContext) ensurePrivateRoutingTable(zoneName, natGatewayZoneName string) flow.TaskFn {
+	return func(ctx context.Context) error {
+		log := c.LogFromContext(ctx)
+		child := c.getSubnetZoneChild(zoneName)
+		id := child.Get(IdentifierZoneRouteTable)
+		cidrBlock := "0.0.0.0/0"
+		desired := &awsclient.RouteTable{
+			Tags:  c.commonTagsWithSuffix(fmt.Sprintf("private-%s", zoneName)),
+			VpcId: c.state.Get(IdentifierVPC),
+		}
+		if natGatewayZoneName != "" {
+			natChild := c.getSubnetZoneChild(natGatewayZoneName)
+			route := &awsclient.Route{
+				DestinationCidrBlock: pointer.String(cidrBlock),
+			}
+			if helper.GetNATGatewayType(c.config) == aws.NATGatewayTypeInstance {
+				route.InstanceId = natChild.Get(IdentifierZoneNATInstance)
+				// a replaced NAT instance takes over the routes of the route tables tagged with its name
+				desired.Tags[TagKeyNATInstance] = fmt.Sprintf("%s-%s", c.namespace, c.zoneSuffixHelpers(natGatewayZoneName).GetSuffixNATInstance())
+			} else {
+				route.NatGatewayId = natChild.Get(IdentifierZoneNATGateway)
+			}
+			desired.Routes = append(desired.Routes, route)
+		}
+		useCarrierGateway := helper.GetZoneType(c.config, zoneName) == aws.ZoneTypeWavelengthZone
+		if useCarrierGateway {
+			// Wavelength Zones have no NAT gateways, the default route points to the carrier gateway instead.
+			desired.Routes = append(desired.Routes, &awsclient.Route{
+				DestinationCidrBlock: pointer.String(cidrBlock),
+				CarrierGatewayId:     c.state.Get(IdentifierCarrierGateway),
+			})
+		}
+		if egressOnlyInternetGatewayID := c.state.Get(IdentifierEgressOnlyInternetGateway); egressOnlyInternetGatewayID != nil {
+			desired.Routes = append(desired.Routes, &awsclient.Route{
+				DestinationIpv6CidrBlock:    pointer.String("::/0"),
+				EgressOnlyInternetGatewayId: egressOnlyInternetGatewayID,
+			})
+		}
+		if existingID := c.getZoneRouteTableID(zoneName); existingID != nil {
+			current, err := c.ensureExistingRouteTable(ctx, log, *existingID, desired.Routes)
+			if err != nil {
+				return err
+			}
+			child.Set(IdentifierZoneRouteTable, current.RouteTableId)
+			child.SetObject(ObjectZoneRouteTable, current)
+			return nil
+		}
+		current, err := findExisting(ctx, id, desired.Tags, c.client.GetRouteTable, c.client.FindRouteTablesByTags)
+		if err != nil {
+			return err
+		}
+
+		if current != nil {
+			child.Set(IdentifierZoneRouteTable, current.RouteTableId)
+			child.SetObject(ObjectZoneRouteTable, current)
+			if _, err := c.updater.UpdateEC2Tags(ctx, current.RouteTableId, desired.Tags, current.Tags); err != nil {
+				return err
+			}
+			var controlledCidrBlocks []string
+			if natGatewayZoneName != "" || useCarrierGateway || hasNATRoute(current, cidrBlock) {
+				// the default route is only replaced or removed if it points to a NAT gateway, NAT instance or carrier
+				// gateway, e.g. it may be routed via a transit gateway otherwise.
+				controlledCidrBlocks = append(controlledCidrBlocks, cidrBlock)
+			}
+			if _, err := c.updater.UpdateRouteTable(ctx, log, desired, current, controlledCidrBlocks...); err != nil {
+				return err
+			}
+		} else {
+			log.Info("creating...", "zone", zoneName)
+			created, err := c.client.CreateRouteTable(ctx, desired)
+			if err != nil {
+				return err
+			}
+			child.Set(IdentifierZoneRouteTable, created.RouteTableId)
+			child.SetObject(ObjectZoneRouteTable, created)
+			if _, err := c.updater.UpdateRouteTable(ctx, log, desired, created, cidrBlock); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func (c *FlowContext) getZoneRouteTableID(zoneName string) *string {
+	for _, zone := range c.config.Networks.Zones {
+		if zone.Name == zoneName {
+			return zone.RouteTableID
+		}
+	}
+	return nil
+}
+
+func hasNATRoute(routeTable *awsclient.RouteTable, cidrBlock string) bool {
+	for _, route := range routeTable.Routes {
+		if pointer.StringDeref(route.DestinationCidrBlock, "") == cidrBlock && (route.NatGatewayId != nil || route.InstanceId != nil || route.CarrierGatewayId != nil) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *FlowContext) deletePrivateRoutingTable(zoneName string) flow.TaskFn {
+	return func(ctx context.Context) error {
+		log := c.LogFromContext(ctx)
+		child := c.getSubnetZoneChild(zoneName)
+		if child.IsAlreadyDeleted(IdentifierZoneRouteTable) {
+			return nil
+		}
+		if existingID := c.getZoneRouteTableID(zoneName); existingID != nil {
+			if err := c.deleteOwnedRoutes(ctx, log, *existingID, c.getStateRouteDestinations()...); err != nil {
+				return err
+			}
+			child.SetAsDeleted(IdentifierZoneRouteTable)
+			return nil
+		}
+		tags := c.commonTagsWithSuffix(fmt.Sprintf("private-%s", zoneName))
+		current, err := findExisting(ctx, child.Get(IdentifierZoneRouteTable), tags, c.client.GetRouteTable, c.client.FindRouteTablesByTags)
+		if err != nil {
+			return err
+		}
+		if current != nil {
+			log.Info("deleting...", "RouteTableId", current.RouteTableId)
+			if err := c.client.DeleteRouteTable(ctx, current.RouteTableId); err != nil {
+				return err
+			}
+		}
+		child.SetAsDeleted(IdentifierZoneRouteTable)
+		return nil
+	}
+}
+
+func (c *FlowContext) ensureRoutingTableAssociations(zoneName string) flow.TaskFn {
+	return func(ctx context.Context) error {
+		// the public subnet of a Wavelength Zone is routed via the carrier gateway of the zone route table
+		publicZoneRouteTable := helper.GetZoneType(c.config, zoneName) == aws.ZoneTypeWavelengthZone
+		if err := c.ensureZoneRoutingTableAssociation(ctx, zoneName, publicZoneRouteTable,
+			IdentifierZoneSubnetPublic, IdentifierZoneSubnetPublicRouteTableAssoc); err != nil {
+			return err
+		}
+		if err := c.ensureZoneRoutingTableAssociation(ctx, zoneName, true,
+			IdentifierZoneSubnetPrivate, IdentifierZoneSubnetPrivateRouteTableAssoc); err != nil {
+			return err
+		}
+		if err := c.ensureZoneRoutingTableAssociation(ctx, zoneName, true,
+			IdentifierZoneSubnetWorkers, IdentifierZoneSubnetWorkersRouteTableAssoc); err != nil {
+			return err
+		}
+		if c.getSubnetZoneChild(zoneName).Get(IdentifierZoneSubnetPods) != nil {
+			if err := c.ensureZoneRoutingTableAssociation(ctx, zoneName, true,
+				IdentifierZoneSubnetPods, IdentifierZoneSubnetPodsRouteTableAssoc); err != nil {
+				return err
+			}
+		}
+		if c.getSubnetZoneChild(zoneName).Get(IdentifierZoneSubnetWorkersSecondary) == nil {
+			return nil
+		}
+		return c.ensureZoneRoutingTableAssociation(ctx, zoneName, true,
+			IdentifierZoneSubnetWorkersSecondary, IdentifierZoneSubnetWorkersSecondaryRouteTableAssoc)
+	}
+}
+
+func (c *FlowContext) ensureZoneRoutingTableAssociation(ctx context.Context, zoneName string,
+	zoneRouteTable bool, subnetKey, assocKey string) error {
+	child := c.getSubnetZoneChild(zoneName)
+	assocID := child.Get(assocKey)
+	if assocID != nil {
+		return nil
+	}
+	subnetID := child.Get(subnetKey)
+	if subnetID == nil {
+		return fmt.Errorf("missing subnet id")
+	}
+	var obj any
+	if zoneRouteTable {
+		obj = child.GetObject(ObjectZoneRouteTable)
+	} else {
+		obj = c.state.GetObject(ObjectMainRouteTable)
+	}
+	if obj == nil {
+		return fmt.Errorf("missing route table object")
+	}
+	routeTable := obj.(*awsclient.RouteTable)
+	for _, assoc := range routeTable.Associations {
+		if reflect.DeepEqual(assoc.SubnetId, subnetID) {
+			child.Set(assocKey, assoc.RouteTableAssociationId)
+			return nil
+		}
+	}
+	log := c.LogFromContext(ctx)
+	log.Info("creating...")
+	assocID, err := c.client.CreateRouteTableAssociation(ctx, routeTable.RouteTableId, *subnetID)
+	if err != nil {
+		return err
+	}
+	child.Set(assocKey, *assocID)
+	return nil
+}
+
+func (c *FlowContext) ensureVPCEndpointsRoutingTableAssociations(zoneName string) flow.TaskFn {
+	return func(ctx context.Context) error {
+		for _, endpoint := range helper.GetVPCEndpointServices(c.config.Networks.VPC, aws.VPCEndpointTypeGateway) {
+			if err := c.ensureVPCEndpointZoneRoutingTableAssociation(ctx, zoneName, endpoint); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func (c *FlowContext) ensureVPCEndpointZoneRoutingTableAssociation(ctx context.Context, zoneName, endpointName string) error {
+	child := c.getSubnetZoneChild(zoneName)
+	subnetID := child.Get(IdentifierZoneSubnetWorkers)
+	if subnetID == nil {
+		return fmt.Errorf("missing subnet id")
+	}
+	vpcEndpointID := c.state.GetChild(ChildIdVPCEndpoints).Get(endpointName)
+	if vpcEndpointID == nil {
+		return fmt.Errorf("missing VPC endpoint: %s", endpointName)
+	}
+	obj := child.GetObject(ObjectZoneRouteTable)
+	if obj == nil {
+		return fmt.Errorf("missing route table object")
+	}
+	routeTable := obj.(*awsclient.RouteTable)
+	for _, route := range routeTable.Routes {
+		if reflect.DeepEqual(route.GatewayId, vpcEndpointID) {
+			return nil
+		}
+	}
+	log := c.LogFromContext(ctx)
+	log.Info("creating...", "endpoint", endpointName)
+
+	return c.client.CreateVpcEndpointRouteTableAssociation(ctx, routeTable.RouteTableId, *vpcEndpointID)
+}
+
+func (c *FlowContext) deleteRoutingTableAssociations(zoneName string) flow.TaskFn {
+	return func(ctx context.Context) error {
+		publicZoneRouteTable := helper.GetZoneType(c.config, zoneName) == aws.ZoneTypeWavelengthZone
+		if err := c.deleteZoneRoutingTableAssociation(ctx, zoneName, publicZoneRouteTable,
+			IdentifierZoneSubnetPublic, IdentifierZoneSubnetPublicRouteTableAssoc); err != nil {
+			return err
+		}
+		if err := c.deleteZoneRoutingTableAssociation(ctx, zoneName, true,
+			IdentifierZoneSubnetPrivate, IdentifierZoneSubnetPrivateRouteTableAssoc); err != nil {
+			return err
+		}
+		if err := c.deleteZoneRoutingTableAssociation(ctx, zoneName, true,
+			IdentifierZoneSubnetWorkers, IdentifierZoneSubnetWorkersRouteTableAssoc); err != nil {
+			return err
+		}
+		if c.getSubnetZoneChild(zoneName).Get(IdentifierZoneSubnetPods) != nil {
+			if err := c.deleteZoneRoutingTableAssociation(ctx, zoneName, true,
+				IdentifierZoneSubnetPods, IdentifierZoneSubnetPodsRouteTableAssoc); err != nil {
+				return err
+			}
+		}
+		if c.getSubnetZoneChild(zoneName).Get(IdentifierZoneSubnetWorkersSecondary) == nil {
+			return nil
+		}
+		return c.deleteZoneRoutingTableAssociation(ctx, zoneName, true,
+			IdentifierZoneSubnetWorkersSecondary, IdentifierZoneSubnetWorkersSecondaryRouteTableAssoc)
+	}
+}
+
+func (c *FlowContext) deleteZoneRoutingTableAssociation(ctx context.Context, zoneName string,
+	zoneRouteTable bool, subnetKey, assocKey string) error {
+	child := c.getSubnetZoneChild(zoneName)
+	if child.IsAlreadyDeleted(assocKey) {
+		return nil
+	}
+	subnetID := child.Get(subnetKey)
+	if subnetID == nil {
+		return fmt.Errorf("missing subnet id")
+	}
+	assocID := child.Get(assocKey)
+	if assocID == nil {
+		// unclear situation: load route table to search for association
+		var routeTableID *string
+		if zoneRouteTable {
+			routeTableID = child.Get(IdentifierZoneRouteTable)
+		} else {
+			routeTableID = c.state.Get(IdentifierMainRouteTable)
+		}
+		if routeTableID != nil {
+			routeTable, err := c.client.GetRouteTable(ctx, *routeTableID)
+			if err != nil {
+				return err
+			}
+			for _, assoc := range routeTable.Associations {
+				if reflect.DeepEqual(subnetID, assoc.SubnetId) {
+					assocID = &assoc.RouteTableAssociationId
+					break
+				}
+			}
+		}
+	}
+	if assocID == nil {
+		child.SetAsDeleted(assocKey)
+		return nil
+	}
+	log := c.LogFromContext(ctx)
+	log.Info("deleting...", "RouteTableAssociationId", *assocID)
+	if err := c.client.DeleteRouteTableAssociation(ctx, *assocID); err != nil {
+		return err
+	}
+	child.SetAsDeleted(assocKey)
+	return nil
+}
+
+// route53ResolverEndpoint contains the keys of a Route 53 Resolver endpoint and its security group in the state.
+type route53ResolverEndpoint struct {
+	direction        string
+	endpointKey      string
+	securityGroupKey string
+}
+
+var (
+	route53ResolverInboundEndpoint = route53ResolverEndpoint{
+		direction:        "INBOUND",
+		endpointKey:      IdentifierRoute53ResolverInboundEndpoint,
+		securityGroupKey: IdentifierRoute53ResolverInboundSecurityGroup,
+	}
+	route53ResolverOutboundEndpoint = route53ResolverEndpoint{
+		direction:        "OUTBOUND",
+		endpointKey:      IdentifierRoute53ResolverOutboundEndpoint,
+		securityGroupKey: IdentifierRoute53ResolverOutboundSecurityGroup,
+	}
+)
+
+func (c *FlowContext) route53ResolverEndpointName(endpoint route53ResolverEndpoint) string {
+	return fmt.Sprintf("%s-resolver-%s", c.namespace, strings.ToLower(endpoint.direction))
+}
+
+func (c *FlowContext) hasRoute53Resolver() bool {
+	for _, key := range []string{IdentifierRoute53ResolverInboundEndpoint, IdentifierRoute53ResolverInboundSecurityGroup,
+		IdentifierRoute53ResolverOutboundEndpoint, IdentifierRoute53ResolverOutboundSecurityGroup, IdentifierRoute53ResolverRuleIDs} {
+		if c.state.Get(key) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *FlowContext) ensureRoute53Resolver(ctx context.Context) error {
+	resolver := c.config.Networks.Route53Resolver
+	if resolver == nil {
+		resolver = &aws.Route53Resolver{}
+	}
+
+	if resolver.InboundEndpoint != nil {
+		var rules []*awsclient.SecurityGroupRule
+		for _, protocol := range []string{"tcp", "udp"} {
+			rules = append(rules, &awsclient.SecurityGroupRule{
+				Type:       awsclient.SecurityGroupRuleTypeIngress,
+				Protocol:   protocol,
+				FromPort:   53,
+				ToPort:     53,
+				CidrBlocks: resolver.InboundEndpoint.AllowedCIDRs,
+			})
+		}
+		endpointID, err := c.ensureRoute53ResolverEndpoint(ctx, route53ResolverInboundEndpoint, rules)
+		if err != nil {
+			return err
+		}
+		addresses, err := c.client.GetResolverEndpointIpAddresses(ctx, endpointID)
+		if err != nil {
+			return err
+		}
+		ips := sets.New[string]()
+		for _, address := range addresses {
+			ips.Insert(address.Ip)
+		}
+		c.state.Set(IdentifierRoute53ResolverInboundEndpointIPs, strings.Join(sets.List(ips), ","))
+	} else {
+		if err := c.deleteRoute53ResolverEndpoint(ctx, route53ResolverInboundEndpoint); err != nil {
+			return err
+		}
+		c.state.SetPtr(IdentifierRoute53ResolverInboundEndpointIPs, nil)
+	}
+
+	var managedRuleIDs []string
+	if len(resolver.ForwardingRules) > 0 {
+		targetCIDRs := sets.New[string]()
+		for _, rule := range resolver.ForwardingRules {
+			for _, ip := range rule.TargetIPs {
+				targetCIDRs.Insert(ip + "/32")
+			}
+		}
+		var rules []*awsclient.SecurityGroupRule
+		for _, protocol := range []string{"tcp", "udp"} {
+			rules = append(rules, &awsclient.SecurityGroupRule{
+				Type:       awsclient.SecurityGroupRuleTypeEgress,
+				Protocol:   protocol,
+				FromPort:   53,
+				ToPort:     53,
+				CidrBlocks: sets.List(targetCIDRs),
+			})
+		}
+		endpointID, err := c.ensureRoute53ResolverEndpoint(ctx, route53ResolverOutboundEndpoint, rules)
+		if err != nil {
+			return err
+		}
+		if managedRuleIDs, err = c.ensureRoute53ResolverForwardingRules(ctx, endpointID, resolver.ForwardingRules); err != nil {
+			return err
+		}
+	} else if err := c.deleteRoute53ResolverEndpoint(ctx, route53ResolverOutboundEndpoint); err != nil {
+		return err
+	}
+
+	return c.ensureRoute53ResolverRuleAssociations(ctx, append(managedRuleIDs, resolver.RuleIDs...), resolver.RuleIDs)
+}
+
+// ensureRoute53ResolverEndpoint ensures the endpoint of the given direction with a security group having the given
+// rules. The endpoint has an IP address in the internal subnet of every availability zone, and at least two IP
+// addresses as required by Route 53 Resolver.
+func (c *FlowContext) ensureRoute53ResolverEndpoint(ctx context.Context, endpoint route53ResolverEndpoint, rules []*awsclient.SecurityGroupRule) (string, error) {
+	log := c.LogFromContext(ctx).WithValues("direction", endpoint.direction)
+	name := c.route53ResolverEndpointName(endpoint)
+	desiredGroup := &awsclient.SecurityGroup{
+		Tags:        c.commonTagsWithSuffix(fmt.Sprintf("resolver-%s", strings.ToLower(endpoint.direction))),
+		GroupName:   name,
+		VpcId:       c.state.Get(IdentifierVPC),
+		Description: pointer.String(fmt.Sprintf("Security group for the Route 53 Resolver %s endpoint", strings.ToLower(endpoint.direction))),
+		Rules:       rules,
+	}
+	group, err := findExisting(ctx, c.state.Get(endpoint.securityGroupKey), desiredGroup.Tags,
+		c.client.GetSecurityGroup, c.client.FindSecurityGroupsByTags,
+		func(item *awsclient.SecurityGroup) bool { return item.GroupName == name })
+	if err != nil {
+		return "", err
+	}
+	if group == nil {
+		log.Info("creating security group...")
+		created, err := c.client.CreateSecurityGroup(ctx, desiredGroup)
+		if err != nil {
+			return "", err
+		}
+		c.state.Set(endpoint.securityGroupKey, created.GroupId)
+		if group, err = c.client.GetSecurityGroup(ctx, created.GroupId); err != nil {
+			return "", err
+		}
+	}
+	c.state.Set(endpoint.securityGroupKey, group.GroupId)
+	if _, err := c.updater.UpdateSecurityGroup(ctx, desiredGroup, group); err != nil {
+		return "", err
+	}
+
+	var subnetIDs []string
+	for _, zone := range c.config.Networks.Zones {
+		if helper.GetZoneType(c.config, zone.Name) != aws.ZoneTypeAvailabilityZone {
+			continue
+		}
+		if subnetID := c.getSubnetZoneChild(zone.Name).Get(IdentifierZoneSubnetPrivate); subnetID != nil {
+			subnetIDs = append(subnetIDs, *subnetID)
+		}
+	}
+	if len(subnetIDs) == 0 {
+		return "", fmt.Errorf("no internal subnet found for the Route 53 Resolver %s endpoint", strings.ToLower(endpoint.direction))
+	}
+
+	current, err := c.findRoute53ResolverEndpoint(ctx, endpoint)
+	if err != nil {
+		return "", err
+	}
+	if current == nil {
+		if len(subnetIDs) == 1 {
+			subnetIDs = append(subnetIDs, subnetIDs[0])
+		}
+		log.Info("creating...")
+		current, err = c.client.CreateResolverEndpoint(ctx, &awsclient.ResolverEndpoint{
+			Tags:             c.commonTags,
+			Name:             name,
+			Direction:        endpoint.direction,
+			SecurityGroupIds: []string{group.GroupId},
+			SubnetIds:        subnetIDs,
+		})
+		if err != nil {
+			return "", err
+		}
+		c.state.Set(endpoint.endpointKey, current.ResolverEndpointId)
+		return current.ResolverEndpointId, nil
+	}
+	c.state.Set(endpoint.endpointKey, current.ResolverEndpointId)
+
+	// zones added later on get an IP address of the endpoint, too
+	addresses, err := c.client.GetResolverEndpointIpAddresses(ctx, current.ResolverEndpointId)
+	if err != nil {
+		return "", err
+	}
+	usedSubnetIDs := sets.New[string]()
+	for _, address := range addresses {
+		usedSubnetIDs.Insert(address.SubnetId)
+	}
+	for _, subnetID := range subnetIDs {
+		if usedSubnetIDs.Has(subnetID) {
+			continue
+		}
+		log.Info("adding IP address...", "ResolverEndpointId", current.ResolverEndpointId, "SubnetId", subnetID)
+		if err := c.client.AssociateResolverEndpointIpAddress(ctx, current.ResolverEndpointId, subnetID); err != nil {
+			return "", err
+		}
+	}
+	return current.ResolverEndpointId, nil
+}
+
+func (c *FlowContext) findRoute53ResolverEndpoint(ctx context.Context, endpoint route53ResolverEndpoint) (*awsclient.ResolverEndpoint, error) {
+	if id := c.state.Get(endpoint.endpointKey); id != nil {
+		current, err := c.client.GetResolverEndpoint(ctx, *id)
+		if err != nil || current != nil {
+			return current, err
+		}
+	}
+	found, err := c.client.FindResolverEndpointsByName(ctx, c.route53ResolverEndpointName(endpoint))
+	if err != nil || len(found) == 0 {
+		return nil, err
+	}
+	return found[0], nil
+}
+
+// ensureRoute53ResolverForwardingRules ensures the forwarding rules served by the given outbound endpoint and returns
+// their ids. Rules which are not configured anymore are disassociated from the VPC and deleted.
+func (c *FlowContext) ensureRoute53ResolverForwardingRules(ctx context.Context, endpointID string, rules []aws.Route53ResolverForwardingRule) ([]string, error) {
+	log := c.LogFromContext(ctx)
+	var desired []*awsclient.ResolverRule
+	for _, rule := range rules {
+		desired = append(desired, &awsclient.ResolverRule{
+			Tags:               c.commonTags,
+			Name:               c.namespace,
+			DomainName:         strings.TrimSuffix(rule.DomainName, "."),
+			ResolverEndpointId: endpointID,
+			TargetIps:          rule.TargetIPs,
+		})
+	}
+	current, err := c.client.FindResolverRulesByEndpointId(ctx, endpointID)
+	if err != nil {
+		return nil, err
+	}
+	toBeDeleted, toBeCreated, toBeChecked := diffByID(desired, current, func(item *awsclient.ResolverRule) string {
+		return item.DomainName
+	})
+
+	var ruleIDs []string
+	if err := c.deleteRoute53ResolverForwardingRules(ctx, toBeDeleted); err != nil {
+		return nil, err
+	}
+	for _, pair := range toBeChecked {
+		ruleIDs = append(ruleIDs, pair.current.ResolverRuleId)
+		if sets.New(pair.desired.TargetIps...).Equal(sets.New(pair.current.TargetIps...)) {
+			continue
+		}
+		log.Info("updating target IPs of resolver rule...", "ResolverRuleId", pair.current.ResolverRuleId, "DomainName", pair.desired.DomainName)
+		if err := c.client.UpdateResolverRuleTargetIps(ctx, pair.current.ResolverRuleId, pair.desired.TargetIps); err != nil {
+			return nil, err
+		}
+	}
+	for _, item := range toBeCreated {
+		log.Info("creating resolver rule...", "DomainName", item.DomainName)
+		created, err := c.client.CreateResolverRule(ctx, item)
+		if err != nil {
+			return nil, err
+		}
+		ruleIDs = append(ruleIDs, created.ResolverRuleId)
+	}
+	return ruleIDs, nil
+}
+
+func (c *FlowContext) deleteRoute53ResolverForwardingRules(ctx context.Context, rules []*awsclient.ResolverRule) error {
+	log := c.LogFromContext(ctx)
+	for _, item := range rules {
+		if vpcID := c.state.Get(IdentifierVPC); vpcID != nil {
+			if err := c.client.DisassociateResolverRule(ctx, item.ResolverRuleId, *vpcID); err != nil {
+				return err
+			}
+		}
+		log.Info("deleting resolver rule...", "ResolverRuleId", item.ResolverRuleId, "DomainName", item.DomainName)
+		if err := c.client.DeleteResolverRule(ctx, item.ResolverRuleId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ensureRoute53ResolverRuleAssociations associates the given rules with the VPC. Existing rules which have been
+// associated before, but are not configured anymore, are disassociated.
+func (c *FlowContext) ensureRoute53ResolverRuleAssociations(ctx context.Context, ruleIDs, existingRuleIDs []string) error {
+	log := c.LogFromContext(ctx)
+	vpcID := *c.state.Get(IdentifierVPC)
+	current, err := c.client.GetResolverRuleAssociations(ctx, vpcID)
+	if err != nil {
+		return err
+	}
+	associated := sets.New[string]()
+	for _, item := range current {
+		associated.Insert(item.ResolverRuleId)
+	}
+	for _, ruleID := range ruleIDs {
+		if associated.Has(ruleID) {
+			continue
+		}
+		log.Info("associating resolver rule...", "ResolverRuleId", ruleID)
+		if err := c.client.AssociateResolverRule(ctx, ruleID, vpcID); err != nil {
+			return err
+		}
+	}
+	if previous := c.state.Get(IdentifierRoute53ResolverRuleIDs); previous != nil {
+		for _, ruleID := range sets.List(sets.New(strings.Split(*previous, ",")...).Delete(existingRuleIDs...)) {
+			if !associated.Has(ruleID) {
+				continue
+			}
+			log.Info("disassociating resolver rule...", "ResolverRuleId", ruleID)
+			if err := c.client.DisassociateResolverRule(ctx, ruleID, vpcID); err != nil {
+				return err
+			}
+		}
+	}
+	c.state.Set(IdentifierRoute53ResolverRuleIDs, strings.Join(existingRuleIDs, ","))
+	return nil
+}
+
+// deleteRoute53ResolverEndpoint deletes the endpoint of the given direction together with its security group. The
+// forwarding rules served by an outbound endpoint are deleted beforehand.
+func (c *FlowContext) deleteRoute53ResolverEndpoint(ctx context.Context, endpoint route53ResolverEndpoint) error {
+	if c.state.Get(endpoint.endpointKey) == nil && c.state.Get(endpoint.securityGroupKey) == nil {
+		return nil
+	}
+	log := c.LogFromContext(ctx).WithValues("direction", endpoint.direction)
+	current, err := c.findRoute53ResolverEndpoint(ctx, endpoint)
+	if err != nil {
+		return err
+	}
+	if current != nil {
+		rules, err := c.client.FindResolverRulesByEndpointId(ctx, current.ResolverEndpointId)
+		if err != nil {
+			return err
+		}
+		if err := c.deleteRoute53ResolverForwardingRules(ctx, rules); err != nil {
+			return err
+		}
+		log.Info("deleting...", "ResolverEndpointId", current.ResolverEndpointId)
+		if err := c.client.DeleteResolverEndpoint(ctx, current.ResolverEndpointId); err != nil {
+			return err
+		}
+	}
+	c.state.SetAsDeleted(endpoint.endpointKey)
+
+	name := c.route53ResolverEndpointName(endpoint)
+	group, err := findExisting(ctx, c.state.Get(endpoint.securityGroupKey), c.commonTagsWithSuffix(fmt.Sprintf("resolver-%s", strings.ToLower(endpoint.direction))),
+		c.client.GetSecurityGroup, c.client.FindSecurityGroupsByTags,
+		func(item *awsclient.SecurityGroup) bool { return item.GroupName == name })
+	if err != nil {
+		return err
+	}
+	if group != nil {
+		log.Info("deleting security group...", "GroupId", group.GroupId)
+		if err := c.client.DeleteSecurityGroup(ctx, group.GroupId); err != nil {
+			return err
+		}
+	}
+	c.state.SetAsDeleted(endpoint.securityGroupKey)
+	return nil
+}
+
+func (c *FlowContext) deleteRoute53Resolver(ctx context.Context) error {
+	if err := c.ensureRoute53ResolverRuleAssociations(ctx, nil, nil); err != nil {
+		return err
+	}
+	c.state.SetPtr(IdentifierRoute53ResolverRuleIDs, nil)
+	if err := c.deleteRoute53ResolverEndpoint(ctx, route53ResolverOutboundEndpoint); err != nil {
+		return err
+	}
+	c.state.SetPtr(IdentifierRoute53ResolverInboundEndpointIPs, nil)
+	return c.deleteRoute53ResolverEndpoint(ctx, route53ResolverInboundEndpoint)
+}
 
-	return deleteNATGateway
+// networkACLRole is a role of subnets which can have a network ACL.
+type networkACLRole struct {
+	name       string
+	config     *aws.NetworkACL
+	subnetKeys []string
 }
 
-func (c *FlowContext) addSubnetDeletionTasks(g *flow.Graph, item *awsclient.Subnet, dependencies []flow.TaskIDer) error {
-	zoneName, subnetKey, err := c.getSubnetKey(item)
-	if err != nil {
-		return err
+func (c *FlowContext) getNetworkACLRoles() []networkACLRole {
+	var acls aws.NetworkACLs
+	if c.config.Networks.NetworkACLs != nil {
+		acls = *c.config.Networks.NetworkACLs
+	}
+	return []networkACLRole{
+		{name: "workers", config: acls.Workers, subnetKeys: []string{IdentifierZoneSubnetWorkers, IdentifierZoneSubnetWorkersSecondary, IdentifierZoneSubnetPods}},
+		{name: "public", config: acls.Public, subnetKeys: []string{IdentifierZoneSubnetPublic}},
+		{name: "internal", config: acls.Internal, subnetKeys: []string{IdentifierZoneSubnetPrivate}},
 	}
-	suffix := fmt.Sprintf("%s-%s", zoneName, subnetKey)
-	_ = c.AddTask(g, "delete subnet resource "+suffix,
-		c.deleteSubnet(subnetKey, item),
-		Timeout(defaultTimeout), Dependencies(dependencies...))
-	return nil
 }
 
-func (c *FlowContext) deleteSubnet(subnetKey string, item *awsclient.Subnet) flow.TaskFn {
-	zoneChild := c.getSubnetZoneChildByItem(item)
-	return func(ctx context.Context) error {
-		if zoneChild.IsAlreadyDeleted(subnetKey) {
-			return nil
+func (c *FlowContext) hasNetworkACLs() bool {
+	return len(c.state.GetChild(ChildIdNetworkACLs).AsMap()) > 0
+}
+
+func (c *FlowContext) ensureNetworkACLs(ctx context.Context) error {
+	for _, role := range c.getNetworkACLRoles() {
+		if role.config == nil {
+			if err := c.deleteNetworkACL(ctx, role); err != nil {
+				return err
+			}
+			continue
 		}
-		log := c.LogFromContext(ctx)
-		log.Info("deleting...", "SubnetID", item.SubnetId)
-		waiter := informOnWaiting(log, 10*time.Second, "still deleting...", "SubnetID", item.SubnetId)
-		err := c.client.DeleteSubnet(ctx, item.SubnetId)
-		waiter.Done(err)
-		if err != nil {
+		if err := c.ensureNetworkACL(ctx, role); err != nil {
 			return err
 		}
-		zoneChild.SetAsDeleted(subnetKey)
-		return nil
 	}
+	return nil
 }
 
-func (c *FlowContext) ensureSubnet(subnetKey string, desired, current *awsclient.Subnet) flow.TaskFn {
-	zoneChild := c.getSubnetZoneChildByItem(desired)
-	if current == nil {
-		return func(ctx context.Context) error {
-			log := c.LogFromContext(ctx)
-			log.Info("creating...")
-			created, err := c.client.CreateSubnet(ctx, desired)
-			if err != nil {
-				return err
-			}
-			zoneChild.Set(subnetKey, created.SubnetId)
-			return nil
-		}
+func (c *FlowContext) ensureNetworkACL(ctx context.Context, role networkACLRole) error {
+	log := c.LogFromContext(ctx).WithValues("role", role.name)
+	child := c.state.GetChild(ChildIdNetworkACLs)
+	tags := c.commonTagsWithSuffix("nacl-" + role.name)
+	current, err := findExisting(ctx, child.Get(role.name), tags,
+		c.client.GetNetworkAcl, c.client.FindNetworkAclsByTags)
+	if err != nil {
+		return err
 	}
-	return func(ctx context.Context) error {
-		zoneChild.Set(subnetKey, current.SubnetId)
-		modified, err := c.updater.UpdateSubnet(ctx, desired, current)
-		if err != nil {
+	if current != nil {
+		if _, err := c.updater.UpdateEC2Tags(ctx, current.NetworkAclId, tags, current.Tags); err != nil {
 			return err
 		}
-		if modified {
-			log := c.LogFromContext(ctx)
-			log.Info("updated")
+	} else {
+		log.Info("creating...")
+		current, err = c.client.CreateNetworkAcl(ctx, &awsclient.NetworkAcl{
+			Tags:  tags,
+			VpcId: c.state.Get(IdentifierVPC),
+		})
+		if err != nil {
+			return err
 		}
-		return nil
 	}
-}
+	child.Set(role.name, current.NetworkAclId)
 
-func (c *FlowContext) ensureElasticIP(zone *aws.Zone) flow.TaskFn {
-	return func(ctx context.Context) error {
-		if zone.ElasticIPAllocationID != nil {
-			return nil
+	// the rules are updated before the subnets are associated, so that they don't deny all traffic in between
+	toBeDeleted, toBeCreated, toBeChecked := diffByID(toNetworkAclEntries(role.config.Rules), current.Entries, func(item *awsclient.NetworkAclEntry) string {
+		return fmt.Sprintf("%t-%d", item.Egress, item.RuleNumber)
+	})
+	for _, entry := range toBeDeleted {
+		log.Info("deleting rule...", "NetworkAclId", current.NetworkAclId, "RuleNumber", entry.RuleNumber, "Egress", entry.Egress)
+		if err := c.client.DeleteNetworkAclEntry(ctx, current.NetworkAclId, entry); err != nil {
+			return err
 		}
-		log := c.LogFromContext(ctx)
-		helper := c.zoneSuffixHelpers(zone.Name)
-		child := c.getSubnetZoneChild(zone.Name)
-		id := child.Get(IdentifierZoneNATGWElasticIP)
-		desired := &awsclient.ElasticIP{
-			Tags: c.commonTagsWithSuffix(helper.GetSuffixElasticIP()),
-			Vpc:  true,
+	}
+	for _, pair := range toBeChecked {
+		if reflect.DeepEqual(pair.desired, pair.current) {
+			continue
 		}
-		current, err := findExisting(ctx, id, desired.Tags, c.client.GetElasticIP, c.client.FindElasticIPsByTags)
-		if err != nil {
+		log.Info("replacing rule...", "NetworkAclId", current.NetworkAclId, "RuleNumber", pair.desired.RuleNumber, "Egress", pair.desired.Egress)
+		if err := c.client.ReplaceNetworkAclEntry(ctx, current.NetworkAclId, pair.desired); err != nil {
+			return err
+		}
+	}
+	for _, entry := range toBeCreated {
+		log.Info("creating rule...", "NetworkAclId", current.NetworkAclId, "RuleNumber", entry.RuleNumber, "Egress", entry.Egress)
+		if err := c.client.CreateNetworkAclEntry(ctx, current.NetworkAclId, entry); err != nil {
 			return err
 		}
+	}
 
-		if current != nil {
-			child.Set(IdentifierZoneNATGWElasticIP, current.AllocationId)
-			if _, err := c.updater.UpdateEC2Tags(ctx, current.AllocationId, desired.Tags, current.Tags); err != nil {
-				return err
+	associated := sets.New[string]()
+	for _, assoc := range current.Associations {
+		associated.Insert(assoc.SubnetId)
+	}
+	for _, zone := range c.config.Networks.Zones {
+		zoneChild := c.getSubnetZoneChild(zone.Name)
+		for _, subnetKey := range role.subnetKeys {
+			subnetID := zoneChild.Get(subnetKey)
+			if subnetID == nil || associated.Has(*subnetID) {
+				continue
 			}
-		} else {
-			log.Info("creating...")
-			created, err := c.client.CreateElasticIP(ctx, desired)
+			assoc, err := c.client.FindNetworkAclAssociationBySubnetId(ctx, *subnetID)
 			if err != nil {
 				return err
 			}
-			child.Set(IdentifierZoneNATGWElasticIP, created.AllocationId)
+			if assoc == nil {
+				return fmt.Errorf("network ACL association of subnet %s not found", *subnetID)
+			}
+			log.Info("associating subnet...", "NetworkAclId", current.NetworkAclId, "SubnetId", *subnetID)
+			if err := c.client.ReplaceNetworkAclAssociation(ctx, assoc.NetworkAclAssociationId, current.NetworkAclId); err != nil {
+				return err
+			}
 		}
-
-		return nil
 	}
+	return nil
 }
 
-func (c *FlowContext) deleteElasticIP(zoneName string) flow.TaskFn {
-	return func(ctx context.Context) error {
-		child := c.getSubnetZoneChild(zoneName)
-		if child.IsAlreadyDeleted(IdentifierZoneNATGWElasticIP) {
-			return nil
-		}
-		helper := c.zoneSuffixHelpers(zoneName)
-		tags := c.commonTagsWithSuffix(helper.GetSuffixElasticIP())
-		current, err := findExisting(ctx, child.Get(IdentifierZoneNATGWElasticIP), tags, c.client.GetElasticIP, c.client.FindElasticIPsByTags)
-		if err != nil {
+func (c *FlowContext) deleteNetworkACLs(ctx context.Context) error {
+	for _, role := range c.getNetworkACLRoles() {
+		if err := c.deleteNetworkACL(ctx, role); err != nil {
 			return err
 		}
-		if current != nil {
-			log := c.LogFromContext(ctx)
-			log.Info("deleting...", "AllocationId", current.AllocationId)
-			waiter := informOnWaiting(log, 10*time.Second, "still deleting...", "AllocationId", current.AllocationId)
-			err = c.client.DeleteElasticIP(ctx, current.AllocationId)
-			waiter.Done(err)
+	}
+	return nil
+}
+
+// deleteNetworkACL deletes the network ACL of the given role. Subnets which are still associated with it are
+// associated with the default network ACL of the VPC again.
+func (c *FlowContext) deleteNetworkACL(ctx context.Context, role networkACLRole) error {
+	child := c.state.GetChild(ChildIdNetworkACLs)
+	if child.Get(role.name) == nil {
+		return nil
+	}
+	log := c.LogFromContext(ctx).WithValues("role", role.name)
+	current, err := findExisting(ctx, child.Get(role.name), c.commonTagsWithSuffix("nacl-"+role.name),
+		c.client.GetNetworkAcl, c.client.FindNetworkAclsByTags)
+	if err != nil {
+		return err
+	}
+	if current != nil {
+		if len(current.Associations) > 0 {
+			defaultACL, err := c.client.FindDefaultNetworkAclByVpcId(ctx, pointer.StringDeref(current.VpcId, ""))
 			if err != nil {
 				return err
 			}
+			if defaultACL == nil {
+				return fmt.Errorf("default network ACL of VPC %s not found", pointer.StringDeref(current.VpcId, ""))
+			}
+			for _, assoc := range current.Associations {
+				log.Info("associating subnet with default network ACL...", "NetworkAclId", defaultACL.NetworkAclId, "SubnetId", assoc.SubnetId)
+				if err := c.client.ReplaceNetworkAclAssociation(ctx, assoc.NetworkAclAssociationId, defaultACL.NetworkAclId); err != nil {
+					return err
+				}
+			}
+		}
+		log.Info("deleting...", "NetworkAclId", current.NetworkAclId)
+		if err := c.client.DeleteNetworkAcl(ctx, current.NetworkAclId); err != nil {
+			return err
 		}
-		child.SetAsDeleted(IdentifierZoneNATGWElasticIP)
-		return nil
 	}
+	child.SetAsDeleted(role.name)
+	return nil
 }
 
-func (c *FlowContext) ensureNATGateway(zone *aws.Zone) flow.TaskFn {
-	return func(ctx context.Context) error {
-		log := c.LogFromContext(ctx)
-		child := c.getSubnetZoneChild(zone.Name)
-		helper := c.zoneSuffixHelpers(zone.Name)
-		desired := &awsclient.NATGateway{
-			Tags:     c.commonTagsWithSuffix(helper.GetSuffixNATGateway()),
-			SubnetId: *child.Get(IdentifierZoneSubnetPublic),
+// toNetworkAclEntries converts the rules of a network ACL to entries as reported by EC2, i.e. with protocol numbers
+// and the ports as ICMP type and code for ICMP.
+func toNetworkAclEntries(rules []aws.NetworkACLRule) []*awsclient.NetworkAclEntry {
+	var entries []*awsclient.NetworkAclEntry
+	for _, rule := range rules {
+		entry := &awsclient.NetworkAclEntry{
+			RuleNumber: rule.RuleNumber,
+			Egress:     rule.Type == aws.NetworkACLRuleTypeEgress,
+			Protocol:   rule.Protocol,
+			RuleAction: string(rule.Action),
 		}
-		if zone.ElasticIPAllocationID != nil {
-			desired.EIPAllocationId = *zone.ElasticIPAllocationID
+		isIPv6 := strings.Contains(rule.CIDRBlock, ":")
+		if isIPv6 {
+			entry.Ipv6CidrBlock = pointer.String(rule.CIDRBlock)
 		} else {
-			desired.EIPAllocationId = *child.Get(IdentifierZoneNATGWElasticIP)
-		}
-		current, err := findExisting(ctx, child.Get(IdentifierZoneNATGateway), desired.Tags, c.client.GetNATGateway, c.client.FindNATGatewaysByTags,
-			func(item *awsclient.NATGateway) bool {
-				return !strings.EqualFold(item.State, ec2.StateDeleting) && !strings.EqualFold(item.State, ec2.StateFailed)
-			})
-		if err != nil {
-			return err
+			entry.CidrBlock = pointer.String(rule.CIDRBlock)
 		}
-
-		if current != nil {
-			child.Set(IdentifierZoneNATGateway, current.NATGatewayId)
-			if _, err := c.updater.UpdateEC2Tags(ctx, current.NATGatewayId, desired.Tags, current.Tags); err != nil {
-				return err
-			}
-		} else {
-			child.Set(IdentifierZoneNATGateway, "")
-			log.Info("creating...")
-			waiter := informOnWaiting(log, 10*time.Second, "still creating...")
-			created, err := c.client.CreateNATGateway(ctx, desired)
-			if created != nil {
-				waiter.UpdateMessage("waiting until available...")
-				if perr := c.PersistState(ctx, true); perr != nil {
-					log.Info("persisting state failed", "error", perr)
-				}
-				child.Set(IdentifierZoneNATGateway, created.NATGatewayId)
-				err = c.client.WaitForNATGatewayAvailable(ctx, created.NATGatewayId)
-			}
-			waiter.Done(err)
-			if err != nil {
-				return err
+		fromPort, toPort := toInt32Ptr(rule.FromPort), toInt32Ptr(rule.ToPort)
+		switch rule.Protocol {
+		case "tcp":
+			entry.Protocol = "6"
+			entry.FromPort, entry.ToPort = fromPort, toPort
+		case "udp":
+			entry.Protocol = "17"
+			entry.FromPort, entry.ToPort = fromPort, toPort
+		case "icmp":
+			// ICMPv6 is used for IPv6 CIDR blocks
+			entry.Protocol = "1"
+			if isIPv6 {
+				entry.Protocol = "58"
 			}
+			entry.IcmpType, entry.IcmpCode = fromPort, toPort
 		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
 
+func toInt32Ptr(value *int64) *int32 {
+	if value == nil {
 		return nil
 	}
+	return pointer.Int32(int32(*value))
 }
 
-func (c *FlowContext) deleteNATGateway(zoneName string) flow.TaskFn {
-	return func(ctx context.Context) error {
-		child := c.getSubnetZoneChild(zoneName)
-		if child.IsAlreadyDeleted(IdentifierZoneNATGateway) {
-			return nil
-		}
-		log := c.LogFromContext(ctx)
-		helper := c.zoneSuffixHelpers(zoneName)
-		tags := c.commonTagsWithSuffix(helper.GetSuffixNATGateway())
-		current, err := findExisting(ctx, child.Get(IdentifierZoneNATGateway), tags, c.client.GetNATGateway, c.client.FindNATGatewaysByTags,
-			func(item *awsclient.NATGateway) bool {
-				return !strings.EqualFold(item.State, ec2.StateDeleting) && !strings.EqualFold(item.State, ec2.StateFailed)
-			})
+func (c *FlowContext) hasVPCFlowLogs() bool {
+	return c.state.Get(IdentifierVPCFlowLog) != nil || c.state.Get(NameVPCFlowLogsLogGroup) != nil ||
+		c.state.Get(NameVPCFlowLogsIAMRole) != nil || c.state.Get(NameVPCFlowLogsIAMRolePolicy) != nil
+}
+
+func (c *FlowContext) ensureVPCFlowLogs(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+	config := c.config.VPCFlowLogs
+	desired := &awsclient.FlowLog{
+		Tags:                   c.commonTagsWithSuffix("vpc-flow-logs"),
+		ResourceId:             *c.state.Get(IdentifierVPC),
+		TrafficType:            pointer.StringDeref(config.TrafficType, string(ec2types.TrafficTypeAll)),
+		MaxAggregationInterval: pointer.Int64Deref(config.MaxAggregationInterval, 600),
+	}
+	if cloudWatchLogs := config.Destination.CloudWatchLogs; cloudWatchLogs != nil {
+		logGroupArn, roleArn, err := c.ensureVPCFlowLogsCloudWatchLogs(ctx, cloudWatchLogs)
 		if err != nil {
 			return err
 		}
-		if current != nil {
-			log.Info("deleting...", "NATGatewayId", current.NATGatewayId)
-			waiter := informOnWaiting(log, 10*time.Second, "still deleting...", "NATGatewayId", current.NATGatewayId)
-			err := c.client.DeleteNATGateway(ctx, current.NATGatewayId)
-			waiter.Done(err)
-			if err != nil {
-				return err
-			}
-		}
-		child.SetAsDeleted(IdentifierZoneNATGateway)
-		return nil
+		desired.LogDestinationType = string(ec2types.LogDestinationTypeCloudWatchLogs)
+		desired.LogDestination = logGroupArn
+		desired.DeliverLogsPermissionArn = &roleArn
+	} else {
+		desired.LogDestinationType = string(ec2types.LogDestinationTypeS3)
+		desired.LogDestination = config.Destination.S3.BucketARN
 	}
-}
 
-func (c *FlowContext) ensurePrivateRoutingTable(zoneName string) flow.TaskFn {
-	return func(ctx context.Context) error {
-		log := c.LogFromContext(ctx)
-		child := c.getSubnetZoneChild(zoneName)
-		id := child.Get(IdentifierZoneRouteTable)
-		cidrBlock := "0.0.0.0/0"
-		desired := &awsclient.RouteTable{
-			Tags:  c.commonTagsWithSuffix(fmt.Sprintf("private-%s", zoneName)),
-			VpcId: c.state.Get(IdentifierVPC),
-			Routes: []*awsclient.Route{
-				{
-					DestinationCidrBlock: pointer.String(cidrBlock),
-					NatGatewayId:         child.Get(IdentifierZoneNATGateway),
-				},
-			},
+	current, err := findExisting(ctx, c.state.Get(IdentifierVPCFlowLog), desired.Tags, c.client.GetFlowLog, c.client.FindFlowLogsByTags)
+	if err != nil {
+		return err
+	}
+	if current != nil && !equivalentFlowLogs(desired, current) {
+		// flow logs cannot be modified, hence they are replaced
+		log.Info("deleting outdated...", "FlowLogId", current.FlowLogId)
+		if err := c.client.DeleteFlowLog(ctx, current.FlowLogId); err != nil {
+			return err
 		}
-		current, err := findExisting(ctx, id, desired.Tags, c.client.GetRouteTable, c.client.FindRouteTablesByTags)
+		current = nil
+	}
+	if current != nil {
+		c.state.Set(IdentifierVPCFlowLog, current.FlowLogId)
+		if _, err := c.updater.UpdateEC2Tags(ctx, current.FlowLogId, desired.Tags, current.Tags); err != nil {
+			return err
+		}
+	} else {
+		log.Info("creating...")
+		created, err := c.client.CreateFlowLog(ctx, desired)
 		if err != nil {
 			return err
 		}
+		c.state.Set(IdentifierVPCFlowLog, created.FlowLogId)
+	}
 
-		if current != nil {
-			child.Set(IdentifierZoneRouteTable, current.RouteTableId)
-			child.SetObject(ObjectZoneRouteTable, current)
-			if _, err := c.updater.UpdateRouteTable(ctx, log, desired, current); err != nil {
-				return err
-			}
-		} else {
-			log.Info("creating...", "zone", zoneName)
-			created, err := c.client.CreateRouteTable(ctx, desired)
-			if err != nil {
-				return err
-			}
-			child.Set(IdentifierZoneRouteTable, created.RouteTableId)
-			child.SetObject(ObjectZoneRouteTable, created)
-			if _, err := c.updater.UpdateRouteTable(ctx, log, desired, created, cidrBlock); err != nil {
-				return err
+	if config.Destination.CloudWatchLogs == nil {
+		return c.deleteVPCFlowLogsCloudWatchLogs(ctx)
+	}
+	return nil
+}
+
+func equivalentFlowLogs(desired, current *awsclient.FlowLog) bool {
+	return desired.ResourceId == current.ResourceId &&
+		desired.TrafficType == current.TrafficType &&
+		desired.LogDestinationType == current.LogDestinationType &&
+		desired.LogDestination == current.LogDestination &&
+		pointer.StringDeref(desired.DeliverLogsPermissionArn, "") == pointer.StringDeref(current.DeliverLogsPermissionArn, "") &&
+		desired.MaxAggregationInterval == current.MaxAggregationInterval
+}
+
+// ensureVPCFlowLogsCloudWatchLogs ensures the log group and the IAM role needed for publishing the VPC flow logs to
+// CloudWatch Logs. It returns the ARNs of the log group and of the IAM role.
+func (c *FlowContext) ensureVPCFlowLogsCloudWatchLogs(ctx context.Context, config *aws.VPCFlowLogsCloudWatchLogs) (string, string, error) {
+	log := c.LogFromContext(ctx)
+	name := fmt.Sprintf("%s-vpc-flow-logs", c.namespace)
+
+	desiredLogGroup := &awsclient.LogGroup{
+		Tags:         c.commonTagsWithSuffix("vpc-flow-logs"),
+		LogGroupName: name,
+		KmsKeyId:     config.KMSKeyARN,
+	}
+	logGroup, err := c.client.GetLogGroup(ctx, name)
+	if err != nil {
+		return "", "", err
+	}
+	if logGroup != nil {
+		c.state.Set(NameVPCFlowLogsLogGroup, name)
+		if pointer.StringDeref(logGroup.KmsKeyId, "") != pointer.StringDeref(desiredLogGroup.KmsKeyId, "") {
+			log.Info("updating KMS key of log group...", "LogGroupName", name)
+			if err := c.client.UpdateLogGroupKmsKey(ctx, name, desiredLogGroup.KmsKeyId); err != nil {
+				return "", "", err
 			}
 		}
+	} else {
+		log.Info("creating log group...", "LogGroupName", name)
+		if logGroup, err = c.client.CreateLogGroup(ctx, desiredLogGroup); err != nil {
+			return "", "", err
+		}
+		c.state.Set(NameVPCFlowLogsLogGroup, name)
+	}
+
+	desiredRole := &awsclient.IAMRole{
+		RoleName:                 name,
+		Path:                     "/",
+		AssumeRolePolicyDocument: fmt.Sprintf(assumeRolePolicyTemplate, awsclient.ServicePrincipal(c.partition, "vpc-flow-logs")),
+	}
+	role, err := c.client.GetIAMRole(ctx, name)
+	if err != nil {
+		return "", "", err
+	}
+	if role != nil {
+		c.state.Set(NameVPCFlowLogsIAMRole, name)
+		if _, err := c.updater.UpdateIAMRole(ctx, desiredRole, role); err != nil {
+			return "", "", err
+		}
+	} else {
+		log.Info("creating IAM role...", "RoleName", name)
+		if role, err = c.client.CreateIAMRole(ctx, desiredRole); err != nil {
+			return "", "", err
+		}
+		c.state.Set(NameVPCFlowLogsIAMRole, name)
+	}
+	c.state.Set(ARNVPCFlowLogsIAMRole, role.ARN)
 
-		return nil
+	desiredPolicy := &awsclient.IAMRolePolicy{
+		PolicyName:     name,
+		RoleName:       name,
+		PolicyDocument: fmt.Sprintf(vpcFlowLogsIAMRolePolicyTemplate, logGroup.Arn, logGroup.Arn),
+	}
+	policy, err := c.client.GetIAMRolePolicy(ctx, name, name)
+	if err != nil {
+		return "", "", err
+	}
+	if policy == nil || policy.PolicyDocument != desiredPolicy.PolicyDocument {
+		log.Info("putting IAM role policy...", "PolicyName", name)
+		if err := c.client.PutIAMRolePolicy(ctx, desiredPolicy); err != nil {
+			return "", "", err
+		}
 	}
+	c.state.Set(NameVPCFlowLogsIAMRolePolicy, name)
+
+	return logGroup.Arn, role.ARN, nil
 }
 
-func (c *FlowContext) deletePrivateRoutingTable(zoneName string) flow.TaskFn {
-	return func(ctx context.Context) error {
-		log := c.LogFromContext(ctx)
-		child := c.getSubnetZoneChild(zoneName)
-		if child.IsAlreadyDeleted(IdentifierZoneRouteTable) {
-			return nil
-		}
-		tags := c.commonTagsWithSuffix(fmt.Sprintf("private-%s", zoneName))
-		current, err := findExisting(ctx, child.Get(IdentifierZoneRouteTable), tags, c.client.GetRouteTable, c.client.FindRouteTablesByTags)
+// assumeRolePolicyTemplate is the trust policy of an IAM role which can be assumed by the given service principal.
+const assumeRolePolicyTemplate = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "%s"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}`
+
+const vpcFlowLogsIAMRolePolicyTemplate = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "logs:CreateLogStream",
+        "logs:PutLogEvents",
+        "logs:DescribeLogGroups",
+        "logs:DescribeLogStreams"
+      ],
+      "Resource": [
+        "%s",
+        "%s:*"
+      ]
+    }
+  ]
+}`
+
+func (c *FlowContext) deleteVPCFlowLogs(ctx context.Context) error {
+	if !c.state.IsAlreadyDeleted(IdentifierVPCFlowLog) {
+		current, err := findExisting(ctx, c.state.Get(IdentifierVPCFlowLog), c.commonTagsWithSuffix("vpc-flow-logs"),
+			c.client.GetFlowLog, c.client.FindFlowLogsByTags)
 		if err != nil {
 			return err
 		}
 		if current != nil {
-			log.Info("deleting...", "RouteTableId", current.RouteTableId)
-			if err := c.client.DeleteRouteTable(ctx, current.RouteTableId); err != nil {
+			c.LogFromContext(ctx).Info("deleting...", "FlowLogId", current.FlowLogId)
+			if err := c.client.DeleteFlowLog(ctx, current.FlowLogId); err != nil {
 				return err
 			}
 		}
-		child.SetAsDeleted(IdentifierZoneRouteTable)
-		return nil
+		c.state.SetAsDeleted(IdentifierVPCFlowLog)
 	}
+	return c.deleteVPCFlowLogsCloudWatchLogs(ctx)
 }
 
-func (c *FlowContext) ensureRoutingTableAssociations(zoneName string) flow.TaskFn {
-	return func(ctx context.Context) error {
-		if err := c.ensureZoneRoutingTableAssociation(ctx, zoneName, false,
-			IdentifierZoneSubnetPublic, IdentifierZoneSubnetPublicRouteTableAssoc); err != nil {
+// deleteVPCFlowLogsCloudWatchLogs deletes the log group and the IAM role used for publishing the VPC flow logs to
+// CloudWatch Logs. The log group is deleted together with all flow logs published to it.
+func (c *FlowContext) deleteVPCFlowLogsCloudWatchLogs(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+	name := fmt.Sprintf("%s-vpc-flow-logs", c.namespace)
+	if !c.state.IsAlreadyDeleted(NameVPCFlowLogsIAMRolePolicy) {
+		log.Info("deleting IAM role policy...", "PolicyName", name)
+		if err := c.client.DeleteIAMRolePolicy(ctx, name, name); err != nil {
 			return err
 		}
-		if err := c.ensureZoneRoutingTableAssociation(ctx, zoneName, true,
-			IdentifierZoneSubnetPrivate, IdentifierZoneSubnetPrivateRouteTableAssoc); err != nil {
+		c.state.SetAsDeleted(NameVPCFlowLogsIAMRolePolicy)
+	}
+	if !c.state.IsAlreadyDeleted(NameVPCFlowLogsIAMRole) {
+		log.Info("deleting IAM role...", "RoleName", name)
+		if err := c.client.DeleteIAMRole(ctx, name); err != nil {
 			return err
 		}
-		return c.ensureZoneRoutingTableAssociation(ctx, zoneName, true,
-			IdentifierZoneSubnetWorkers, IdentifierZoneSubnetWorkersRouteTableAssoc)
+		c.state.SetAsDeleted(NameVPCFlowLogsIAMRole)
+		c.state.SetAsDeleted(ARNVPCFlowLogsIAMRole)
+	}
+	if !c.state.IsAlreadyDeleted(NameVPCFlowLogsLogGroup) {
+		log.Info("deleting log group...", "LogGroupName", name)
+		if err := c.client.DeleteLogGroup(ctx, name); err != nil {
+			return err
+		}
+		c.state.SetAsDeleted(NameVPCFlowLogsLogGroup)
 	}
+	return nil
 }
 
-func (c *FlowContext) ensureZoneRoutingTableAssociation(ctx context.Context, zoneName string,
-	zoneRouteTable bool, subnetKey, assocKey string) error {
-	child := c.getSubnetZoneChild(zoneName)
-	assocID := child.Get(assocKey)
-	if assocID != nil {
-		return nil
+func (c *FlowContext) ensureElasticFileSystem(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+	desired := &awsclient.ElasticFileSystem{
+		Tags:          c.commonTagsWithSuffix("efs"),
+		CreationToken: c.namespace,
 	}
-	subnetID := child.Get(subnetKey)
-	if subnetID == nil {
-		return fmt.Errorf("missing subnet id")
+	current, err := findExisting(ctx, c.state.Get(IdentifierElasticFileSystem), desired.Tags,
+		c.client.GetElasticFileSystem, c.client.FindElasticFileSystemsByTags)
+	if err != nil {
+		return err
 	}
-	var obj any
-	if zoneRouteTable {
-		obj = child.GetObject(ObjectZoneRouteTable)
-	} else {
-		obj = c.state.GetObject(ObjectMainRouteTable)
+	if current == nil {
+		log.Info("creating...")
+		if current, err = c.client.CreateElasticFileSystem(ctx, desired); err != nil {
+			return err
+		}
 	}
-	if obj == nil {
-		return fmt.Errorf("missing route table object")
+	c.state.Set(IdentifierElasticFileSystem, current.FileSystemId)
+
+	// mount targets are only supported in availability zones
+	desiredSubnetIDs := sets.New[string]()
+	for _, zone := range c.config.Networks.Zones {
+		if helper.GetZoneType(c.config, zone.Name) != aws.ZoneTypeAvailabilityZone {
+			continue
+		}
+		if subnetID := c.getSubnetZoneChild(zone.Name).Get(IdentifierZoneSubnetWorkers); subnetID != nil {
+			desiredSubnetIDs.Insert(*subnetID)
+		}
 	}
-	routeTable := obj.(*awsclient.RouteTable)
-	for _, assoc := range routeTable.Associations {
-		if reflect.DeepEqual(assoc.SubnetId, subnetID) {
-			child.Set(assocKey, assoc.RouteTableAssociationId)
-			return nil
+	mountTargets, err := c.client.GetMountTargets(ctx, current.FileSystemId)
+	if err != nil {
+		return err
+	}
+	for _, mountTarget := range mountTargets {
+		if desiredSubnetIDs.Has(mountTarget.SubnetId) {
+			desiredSubnetIDs.Delete(mountTarget.SubnetId)
+			continue
+		}
+		log.Info("deleting mount target...", "MountTargetId", mountTarget.MountTargetId)
+		if err := c.client.DeleteMountTarget(ctx, mountTarget.MountTargetId); err != nil {
+			return err
+		}
+	}
+	for _, subnetID := range sets.List(desiredSubnetIDs) {
+		log.Info("creating mount target...", "SubnetId", subnetID)
+		if _, err := c.client.CreateMountTarget(ctx, &awsclient.MountTarget{
+			FileSystemId:     current.FileSystemId,
+			SubnetId:         subnetID,
+			SecurityGroupIds: []string{*c.state.Get(IdentifierNodesSecurityGroup)},
+		}); err != nil {
+			return err
 		}
 	}
+	return nil
+}
+
+func (c *FlowContext) deleteElasticFileSystemMountTargetsOfRemovedZones(ctx context.Context) error {
 	log := c.LogFromContext(ctx)
-	log.Info("creating...")
-	assocID, err := c.client.CreateRouteTableAssociation(ctx, routeTable.RouteTableId, *subnetID)
+	removedSubnetIDs := c.removedZonesWorkersSubnetIDs()
+	mountTargets, err := c.client.GetMountTargets(ctx, *c.state.Get(IdentifierElasticFileSystem))
 	if err != nil {
 		return err
 	}
-	child.Set(assocKey, *assocID)
+	for _, mountTarget := range mountTargets {
+		if !removedSubnetIDs.Has(mountTarget.SubnetId) {
+			continue
+		}
+		log.Info("deleting mount target...", "MountTargetId", mountTarget.MountTargetId)
+		if err := c.client.DeleteMountTarget(ctx, mountTarget.MountTargetId); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-func (c *FlowContext) ensureVPCEndpointsRoutingTableAssociations(zoneName string) flow.TaskFn {
-	return func(ctx context.Context) error {
-		for _, endpoint := range c.config.Networks.VPC.GatewayEndpoints {
-			if err := c.ensureVPCEndpointZoneRoutingTableAssociation(ctx, zoneName, endpoint); err != nil {
+func (c *FlowContext) deleteElasticFileSystem(ctx context.Context) error {
+	if c.state.IsAlreadyDeleted(IdentifierElasticFileSystem) {
+		return nil
+	}
+	log := c.LogFromContext(ctx)
+	current, err := findExisting(ctx, c.state.Get(IdentifierElasticFileSystem), c.commonTagsWithSuffix("efs"),
+		c.client.GetElasticFileSystem, c.client.FindElasticFileSystemsByTags)
+	if err != nil {
+		return err
+	}
+	if current != nil {
+		// a file system can only be deleted after all of its mount targets are gone
+		mountTargets, err := c.client.GetMountTargets(ctx, current.FileSystemId)
+		if err != nil {
+			return err
+		}
+		for _, mountTarget := range mountTargets {
+			log.Info("deleting mount target...", "MountTargetId", mountTarget.MountTargetId)
+			if err := c.client.DeleteMountTarget(ctx, mountTarget.MountTargetId); err != nil {
 				return err
 			}
 		}
-		return nil
+		log.Info("deleting...", "FileSystemId", current.FileSystemId)
+		if err := c.client.DeleteElasticFileSystem(ctx, current.FileSystemId); err != nil {
+			return err
+		}
 	}
+	c.state.SetAsDeleted(IdentifierElasticFileSystem)
+	return nil
 }
 
-func (c *FlowContext) ensureVPCEndpointZoneRoutingTableAssociation(ctx context.Context, zoneName, endpointName string) error {
-	child := c.getSubnetZoneChild(zoneName)
-	subnetID := child.Get(IdentifierZoneSubnetWorkers)
-	if subnetID == nil {
-		return fmt.Errorf("missing subnet id")
+// ensureEgressPrefixList ensures a customer-managed prefix list containing the egress IPs of all zones and optionally
+// the CIDRs of the nodes subnets. Only IPv4 addresses are contained, as a prefix list has a single address family.
+func (c *FlowContext) ensureEgressPrefixList(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+	desiredCIDRs := sets.New[string]()
+	if egressCIDRs := c.state.Get(IdentifierEgressCIDRs); egressCIDRs != nil && *egressCIDRs != "" {
+		for _, cidr := range strings.Split(*egressCIDRs, ",") {
+			if !strings.Contains(cidr, ":") {
+				desiredCIDRs.Insert(cidr)
+			}
+		}
 	}
-	vpcEndpointID := c.state.GetChild(ChildIdVPCEndpoints).Get(endpointName)
-	if vpcEndpointID == nil {
-		return fmt.Errorf("missing VPC endpoint: %s", endpointName)
+	if c.config.EgressPrefixList.IncludeNodesCIDRs {
+		for _, zone := range c.config.Networks.Zones {
+			if zone.Workers != "" {
+				desiredCIDRs.Insert(zone.Workers)
+			}
+			if zone.SecondaryWorkers != nil {
+				desiredCIDRs.Insert(*zone.SecondaryWorkers)
+			}
+		}
 	}
-	obj := child.GetObject(ObjectZoneRouteTable)
-	if obj == nil {
-		return fmt.Errorf("missing route table object")
+
+	// reserve an entry for the egress IP and the nodes CIDR of each zone, so that the prefix list doesn't need to be
+	// resized for every change
+	maxEntries := int32(max(2*len(c.config.Networks.Zones), desiredCIDRs.Len(), 1))
+	desired := &awsclient.ManagedPrefixList{
+		Tags:           c.commonTagsWithSuffix("egress"),
+		PrefixListName: fmt.Sprintf("%s-egress", c.namespace),
+		AddressFamily:  "IPv4",
+		MaxEntries:     maxEntries,
 	}
-	routeTable := obj.(*awsclient.RouteTable)
-	for _, route := range routeTable.Routes {
-		if reflect.DeepEqual(route.GatewayId, vpcEndpointID) {
-			return nil
+	current, err := findExisting(ctx, c.state.Get(IdentifierEgressPrefixList), desired.Tags,
+		c.client.GetManagedPrefixList, c.client.FindManagedPrefixListsByTags)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		log.Info("creating...")
+		created, err := c.client.CreateManagedPrefixList(ctx, desired, sets.List(desiredCIDRs))
+		if err != nil {
+			return err
 		}
+		c.state.Set(IdentifierEgressPrefixList, created.PrefixListId)
+		c.state.Set(ARNEgressPrefixList, created.PrefixListArn)
+		return c.client.WaitForManagedPrefixListModified(ctx, created.PrefixListId)
+	}
+	c.state.Set(IdentifierEgressPrefixList, current.PrefixListId)
+	c.state.Set(ARNEgressPrefixList, current.PrefixListArn)
+	if _, err := c.updater.UpdateEC2Tags(ctx, current.PrefixListId, desired.Tags, current.Tags); err != nil {
+		return err
 	}
-	log := c.LogFromContext(ctx)
-	log.Info("creating...", "endpoint", endpointName)
 
-	return c.client.CreateVpcEndpointRouteTableAssociation(ctx, routeTable.RouteTableId, *vpcEndpointID)
-}
+	// a previous modification may still be in progress
+	if err := c.client.WaitForManagedPrefixListModified(ctx, current.PrefixListId); err != nil {
+		return err
+	}
+	cidrs, err := c.client.GetManagedPrefixListCIDRs(ctx, current.PrefixListId)
+	if err != nil {
+		return err
+	}
+	currentCIDRs := sets.New(cidrs...)
+	addCIDRs := sets.List(desiredCIDRs.Difference(currentCIDRs))
+	removeCIDRs := sets.List(currentCIDRs.Difference(desiredCIDRs))
+	if len(addCIDRs) == 0 && len(removeCIDRs) == 0 {
+		return nil
+	}
 
-func (c *FlowContext) deleteRoutingTableAssociations(zoneName string) flow.TaskFn {
-	return func(ctx context.Context) error {
-		if err := c.deleteZoneRoutingTableAssociation(ctx, zoneName, false,
-			IdentifierZoneSubnetPublic, IdentifierZoneSubnetPublicRouteTableAssoc); err != nil {
+	if current.MaxEntries < int32(currentCIDRs.Len()+len(addCIDRs)) {
+		log.Info("resizing...", "PrefixListId", current.PrefixListId, "MaxEntries", maxEntries)
+		if err := c.client.ResizeManagedPrefixList(ctx, current.PrefixListId, maxEntries); err != nil {
 			return err
 		}
-		if err := c.deleteZoneRoutingTableAssociation(ctx, zoneName, true,
-			IdentifierZoneSubnetPrivate, IdentifierZoneSubnetPrivateRouteTableAssoc); err != nil {
+		if err := c.client.WaitForManagedPrefixListModified(ctx, current.PrefixListId); err != nil {
 			return err
 		}
-		return c.deleteZoneRoutingTableAssociation(ctx, zoneName, true,
-			IdentifierZoneSubnetWorkers, IdentifierZoneSubnetWorkersRouteTableAssoc)
+		if current, err = c.client.GetManagedPrefixList(ctx, current.PrefixListId); err != nil {
+			return err
+		} else if current == nil {
+			return fmt.Errorf("managed prefix list %s not found", *c.state.Get(IdentifierEgressPrefixList))
+		}
+	}
+
+	log.Info("updating entries...", "PrefixListId", current.PrefixListId, "add", addCIDRs, "remove", removeCIDRs)
+	if err := c.client.ModifyManagedPrefixListCIDRs(ctx, current.PrefixListId, current.Version, addCIDRs, removeCIDRs); err != nil {
+		return err
 	}
+	return c.client.WaitForManagedPrefixListModified(ctx, current.PrefixListId)
 }
 
-func (c *FlowContext) deleteZoneRoutingTableAssociation(ctx context.Context, zoneName string,
-	zoneRouteTable bool, subnetKey, assocKey string) error {
-	child := c.getSubnetZoneChild(zoneName)
-	if child.IsAlreadyDeleted(assocKey) {
+func (c *FlowContext) deleteEgressPrefixList(ctx context.Context) error {
+	if c.state.IsAlreadyDeleted(IdentifierEgressPrefixList) {
 		return nil
 	}
-	subnetID := child.Get(subnetKey)
-	if subnetID == nil {
-		return fmt.Errorf("missing subnet id")
+	current, err := findExisting(ctx, c.state.Get(IdentifierEgressPrefixList), c.commonTagsWithSuffix("egress"),
+		c.client.GetManagedPrefixList, c.client.FindManagedPrefixListsByTags)
+	if err != nil {
+		return err
 	}
-	assocID := child.Get(assocKey)
-	if assocID == nil {
-		// unclear situation: load route table to search for association
-		var routeTableID *string
-		if zoneRouteTable {
-			routeTableID = child.Get(IdentifierZoneRouteTable)
-		} else {
-			routeTableID = c.state.Get(IdentifierMainRouteTable)
-		}
-		if routeTableID != nil {
-			routeTable, err := c.client.GetRouteTable(ctx, *routeTableID)
-			if err != nil {
-				return err
-			}
-			for _, assoc := range routeTable.Associations {
-				if reflect.DeepEqual(subnetID, assoc.SubnetId) {
-					assocID = &assoc.RouteTableAssociationId
-					break
-				}
-			}
+	if current != nil {
+		c.LogFromContext(ctx).Info("deleting...", "PrefixListId", current.PrefixListId)
+		// the deletion fails as long as the prefix list is referenced, e.g. by security groups of other accounts
+		if err := c.client.DeleteManagedPrefixList(ctx, current.PrefixListId); err != nil {
+			return err
 		}
 	}
-	if assocID == nil {
-		child.SetAsDeleted(assocKey)
+	c.state.SetAsDeleted(IdentifierEgressPrefixList)
+	c.state.SetAsDeleted(ARNEgressPrefixList)
+	return nil
+}
+
+func (c *FlowContext) ensureLoadBalancerAccessLogsBucket(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+	accountID, err := c.client.GetAccountID(ctx)
+	if err != nil {
+		return err
+	}
+	bucket := awsclient.LoadBalancerAccessLogsBucketName(c.namespace, accountID)
+	if current := c.state.Get(IdentifierLoadBalancerAccessLogsBucket); current != nil {
+		bucket = *current
+	}
+
+	log.Info("ensuring...", "Bucket", bucket)
+	if err := c.client.CreateBucketIfNotExists(ctx, bucket, c.infraSpec.Region, nil); err != nil {
+		return err
+	}
+	c.state.Set(IdentifierLoadBalancerAccessLogsBucket, bucket)
+
+	policy, err := awsclient.LoadBalancerAccessLogsBucketPolicy(c.partition, c.infraSpec.Region, bucket, accountID)
+	if err != nil {
+		return err
+	}
+	return c.client.PutBucketPolicy(ctx, bucket, policy)
+}
+
+func (c *FlowContext) deleteLoadBalancerAccessLogsBucket(ctx context.Context) error {
+	if c.state.IsAlreadyDeleted(IdentifierLoadBalancerAccessLogsBucket) {
 		return nil
 	}
 	log := c.LogFromContext(ctx)
-	log.Info("deleting...", "RouteTableAssociationId", *assocID)
-	if err := c.client.DeleteRouteTableAssociation(ctx, *assocID); err != nil {
+	var bucket string
+	if current := c.state.Get(IdentifierLoadBalancerAccessLogsBucket); current != nil {
+		bucket = *current
+	} else {
+		accountID, err := c.client.GetAccountID(ctx)
+		if err != nil {
+			return err
+		}
+		bucket = awsclient.LoadBalancerAccessLogsBucketName(c.namespace, accountID)
+	}
+
+	log.Info("deleting...", "Bucket", bucket)
+	if err := c.client.DeleteBucketIfExists(ctx, bucket); err != nil {
 		return err
 	}
-	child.SetAsDeleted(assocKey)
+	c.state.SetAsDeleted(IdentifierLoadBalancerAccessLogsBucket)
 	return nil
 }
 
 func (c *FlowContext) ensureIAMRole(ctx context.Context) error {
 	log := c.LogFromContext(ctx)
 	desired := &awsclient.IAMRole{
-		RoleName: fmt.Sprintf("%s-nodes", c.namespace),
-		Path:     "/",
-		AssumeRolePolicyDocument: `{
-  "Version": "2012-10-17",
-  "Statement": [
-    {
-      "Effect": "Allow",
-      "Principal": {
-        "Service": "ec2.amazonaws.com"
-      },
-      "Action": "sts:AssumeRole"
-    }
-  ]
-}`,
+		RoleName:                 fmt.Sprintf("%s-nodes", c.namespace),
+		Path:                     "/",
+		AssumeRolePolicyDocument: fmt.Sprintf(assumeRolePolicyTemplate, awsclient.ServicePrincipal(c.partition, "ec2")),
 	}
 	current, err := c.client.GetIAMRole(ctx, desired.RoleName)
 	if err != nil {
@@ -1405,7 +4292,7 @@ func (c *FlowContext) getSubnetKey(item *awsclient.Subnet) (zoneName, subnetKey
 		zoneName = item.AvailabilityZone
 		if item.SubnetId != "" {
 			zoneChild := c.getSubnetZoneChild(zoneName)
-			for _, key := range []string{IdentifierZoneSubnetWorkers, IdentifierZoneSubnetPublic, IdentifierZoneSubnetPrivate} {
+			for _, key := range []string{IdentifierZoneSubnetWorkers, IdentifierZoneSubnetPublic, IdentifierZoneSubnetPrivate, IdentifierZoneSubnetPods, IdentifierZoneSubnetWorkersSecondary} {
 				if s := zoneChild.Get(key); s != nil && *s == item.SubnetId {
 					subnetKey = key
 					return
@@ -1415,7 +4302,7 @@ func (c *FlowContext) getSubnetKey(item *awsclient.Subnet) (zoneName, subnetKey
 		if item.Tags != nil && item.Tags[TagKeyName] != "" {
 			value := item.Tags[TagKeyName]
 			helper := c.zoneSuffixHelpers(zone.Name)
-			for _, key := range []string{IdentifierZoneSubnetWorkers, IdentifierZoneSubnetPublic, IdentifierZoneSubnetPrivate} {
+			for _, key := range []string{IdentifierZoneSubnetWorkers, IdentifierZoneSubnetPublic, IdentifierZoneSubnetPrivate, IdentifierZoneSubnetPods, IdentifierZoneSubnetWorkersSecondary} {
 				switch key {
 				case IdentifierZoneSubnetWorkers:
 					if value == helper.GetSuffixSubnetWorkers() {
@@ -1432,6 +4319,16 @@ func (c *FlowContext) getSubnetKey(item *awsclient.Subnet) (zoneName, subnetKey
 						subnetKey = key
 						return
 					}
+				case IdentifierZoneSubnetPods:
+					if value == helper.GetSuffixSubnetPods() {
+						subnetKey = key
+						return
+					}
+				case IdentifierZoneSubnetWorkersSecondary:
+					if value == helper.GetSuffixSubnetWorkersSecondary() {
+						subnetKey = key
+						return
+					}
 				}
 			}
 		}
@@ -1446,6 +4343,10 @@ func (c *FlowContext) getSubnetKey(item *awsclient.Subnet) (zoneName, subnetKey
 		subnetKey = IdentifierZoneSubnetPublic
 	case zone.Internal:
 		subnetKey = IdentifierZoneSubnetPrivate
+	case pointer.StringDeref(zone.Pods, ""):
+		subnetKey = IdentifierZoneSubnetPods
+	case pointer.StringDeref(zone.SecondaryWorkers, ""):
+		subnetKey = IdentifierZoneSubnetWorkersSecondary
 	}
 	return
 }
@@ -0,0 +1,110 @@
+// Copyright (c) 2022 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infraflow
+
+import (
+	"context"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	mockawsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/mock"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow/shared"
+)
+
+var _ = Describe("Reconcile", func() {
+	var (
+		ctx = context.TODO()
+
+		ctrl      *gomock.Controller
+		awsClient *mockawsclient.MockInterface
+		c         *FlowContext
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		awsClient = mockawsclient.NewMockInterface(ctrl)
+
+		infra := &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infrastructure", Namespace: "shoot--foo--bar"},
+			Spec:       extensionsv1alpha1.InfrastructureSpec{Region: "eu-west-1"},
+		}
+		config := &awsapi.InfrastructureConfig{
+			Networks: awsapi.Networks{
+				Zones: []awsapi.Zone{{Name: "zone1"}},
+			},
+		}
+		state := shared.FlatMap{
+			"VPC":                       "vpc-1",
+			"Zones/zone1/SubnetWorkers": "subnet-1",
+			"Zones/zone2/SubnetWorkers": "subnet-2",
+		}
+
+		var err error
+		c, err = NewFlowContext(logr.Discard(), awsClient, infra, config, state, nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#removedZonesWorkersSubnetIDs", func() {
+		It("should return the workers subnets of the removed zones", func() {
+			Expect(c.removedZonesWorkersSubnetIDs().UnsortedList()).To(ConsistOf("subnet-2"))
+		})
+	})
+
+	Describe("#detachInterfaceEndpointsFromRemovedZones", func() {
+		It("should remove the workers subnets of removed zones from the interface endpoints", func() {
+			awsClient.EXPECT().FindVpcEndpointsByTags(ctx, gomock.Any()).Return([]*awsclient.VpcEndpoint{
+				{VpcEndpointId: "vpce-1", VpcEndpointType: "Interface", SubnetIds: []string{"subnet-1", "subnet-2"}},
+				{VpcEndpointId: "vpce-2", VpcEndpointType: "Interface", SubnetIds: []string{"subnet-1"}},
+				{VpcEndpointId: "vpce-3", VpcEndpointType: "Gateway"},
+			}, nil)
+			awsClient.EXPECT().ModifyVpcEndpointSubnets(ctx, "vpce-1", nil, []string{"subnet-2"})
+
+			Expect(c.detachInterfaceEndpointsFromRemovedZones(ctx)).To(Succeed())
+		})
+	})
+
+	Describe("#deleteElasticFileSystemMountTargetsOfRemovedZones", func() {
+		It("should delete the mount targets in the workers subnets of removed zones", func() {
+			c.state.Set(IdentifierElasticFileSystem, "fs-1")
+			awsClient.EXPECT().GetMountTargets(ctx, "fs-1").Return([]*awsclient.MountTarget{
+				{MountTargetId: "fsmt-1", FileSystemId: "fs-1", SubnetId: "subnet-1"},
+				{MountTargetId: "fsmt-2", FileSystemId: "fs-1", SubnetId: "subnet-2"},
+			}, nil)
+			awsClient.EXPECT().DeleteMountTarget(ctx, "fsmt-2")
+
+			Expect(c.deleteElasticFileSystemMountTargetsOfRemovedZones(ctx)).To(Succeed())
+		})
+	})
+
+	Describe("#natInstanceUserData", func() {
+		It("should take over the routes of the route tables tagged with the NAT instance name", func() {
+			userData, err := c.natInstanceUserData("shoot--foo--bar-natinstance-z0")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(userData).To(ContainSubstring("--no-source-dest-check"))
+			Expect(userData).To(ContainSubstring(`"Name=vpc-id,Values=vpc-1" "Name=tag:gardener.cloud/nat-instance,Values=shoot--foo--bar-natinstance-z0"`))
+		})
+	})
+})
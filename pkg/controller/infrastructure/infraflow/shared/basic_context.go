@@ -16,8 +16,11 @@ package shared
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gardener/gardener/pkg/utils/flow"
@@ -31,6 +34,7 @@ type TaskOption struct {
 	Dependencies []flow.TaskIDer
 	Timeout      time.Duration
 	DoIf         *bool
+	Limiter      TaskLimiter
 }
 
 // Dependencies creates a TaskOption for dependencies
@@ -48,9 +52,38 @@ func DoIf(condition bool) TaskOption {
 	return TaskOption{DoIf: pointer.Bool(condition)}
 }
 
+// Limit creates a TaskOption which limits the number of concurrently running tasks with the given limiter
+func Limit(limiter TaskLimiter) TaskOption {
+	return TaskOption{Limiter: limiter}
+}
+
+// TaskLimiter limits the number of concurrently running tasks which are added with the `Limit` option. Tasks wait for
+// a free slot before they are run, the time spent waiting doesn't count towards their timeout.
+type TaskLimiter chan struct{}
+
+// NewTaskLimiter creates a TaskLimiter which allows the given number of tasks to run concurrently.
+func NewTaskLimiter(limit int) TaskLimiter {
+	return make(TaskLimiter, limit)
+}
+
+func (l TaskLimiter) limit(fn flow.TaskFn) flow.TaskFn {
+	return func(ctx context.Context) error {
+		select {
+		case l <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		defer func() { <-l }()
+		return fn(ctx)
+	}
+}
+
 // FlowStatePersistor persists the flat map to the provider status
 type FlowStatePersistor func(ctx context.Context, flatMap FlatMap) error
 
+// FlowProgressReporter reports the progress of a flow in percent and a description of its running tasks
+type FlowProgressReporter func(ctx context.Context, progress int32, description string)
+
 // BasicFlowContext provides logic for persisting the state and add tasks to the flow graph.
 type BasicFlowContext struct {
 	Log logr.Logger
@@ -61,6 +94,11 @@ type BasicFlowContext struct {
 	lastPersistedGeneration int64
 	lastPersistedAt         time.Time
 	PersistInterval         time.Duration
+	progressReporter        FlowProgressReporter
+	progress                atomic.Int32
+	bestEffort              bool
+	bestEffortLock          sync.Mutex
+	bestEffortErrors        []error
 }
 
 // StateExporter knows how to export the internal state to a flat string map.
@@ -106,6 +144,59 @@ func (c *BasicFlowContext) PersistState(ctx context.Context, force bool) error {
 	return nil
 }
 
+// SetProgressReporter sets the reporter which is called whenever a task of a flow run with the options returned by
+// `FlowOpts` or `SubFlowOpts` starts or completes.
+func (c *BasicFlowContext) SetProgressReporter(reporter FlowProgressReporter) {
+	c.progressReporter = reporter
+}
+
+// FlowOpts returns the options for running a flow, which report the progress and the running tasks of the flow.
+func (c *BasicFlowContext) FlowOpts() flow.Opts {
+	opts := flow.Opts{Log: c.Log}
+	if c.progressReporter != nil {
+		opts.ProgressReporter = flow.NewImmediateProgressReporter(func(ctx context.Context, stats *flow.Stats) {
+			c.progress.Store(stats.ProgressPercent())
+			c.progressReporter(ctx, stats.ProgressPercent(), describeProgress(stats))
+		})
+	}
+	return opts
+}
+
+// SubFlowOpts returns the options for running a flow within a task of another flow, which report the running tasks of
+// the flow with the progress of the enclosing flow.
+func (c *BasicFlowContext) SubFlowOpts() flow.Opts {
+	opts := flow.Opts{Log: c.Log}
+	if c.progressReporter != nil {
+		opts.ProgressReporter = flow.NewImmediateProgressReporter(func(ctx context.Context, stats *flow.Stats) {
+			c.progressReporter(ctx, c.progress.Load(), describeProgress(stats))
+		})
+	}
+	return opts
+}
+
+// describeProgress returns the running tasks of a flow, or whether it is starting or finished.
+func describeProgress(stats *flow.Stats) string {
+	if stats.Running.Len() > 0 {
+		return strings.Join(stats.Running.StringList(), ", ")
+	}
+	return flow.MakeDescription(stats)
+}
+
+// SetBestEffort makes the tasks added with the `AddTask` method succeed even if their task function fails, so that the
+// tasks depending on them are run nevertheless. The errors of the task functions are collected instead and returned by
+// the `BestEffortError` method.
+func (c *BasicFlowContext) SetBestEffort(bestEffort bool) {
+	c.bestEffort = bestEffort
+}
+
+// BestEffortError returns the errors of the task functions which failed while the best-effort mode was set, joined
+// into a single error.
+func (c *BasicFlowContext) BestEffortError() error {
+	c.bestEffortLock.Lock()
+	defer c.bestEffortLock.Unlock()
+	return errors.Join(c.bestEffortErrors...)
+}
+
 // LogFromContext returns the log from the context when called within a task function added with the `AddTask` method.
 func (c *BasicFlowContext) LogFromContext(ctx context.Context) logr.Logger {
 	if log, err := logr.FromContext(ctx); err != nil {
@@ -133,12 +224,18 @@ func (c *BasicFlowContext) AddTask(g *flow.Graph, name string, fn flow.TaskFn, o
 			condition = condition && *opt.DoIf
 			allOptions.DoIf = pointer.Bool(condition)
 		}
+		if opt.Limiter != nil {
+			allOptions.Limiter = opt.Limiter
+		}
 	}
 
 	tunedFn := fn
 	if allOptions.Timeout > 0 {
 		tunedFn = tunedFn.Timeout(allOptions.Timeout)
 	}
+	if allOptions.Limiter != nil {
+		tunedFn = allOptions.Limiter.limit(tunedFn)
+	}
 	task := flow.Task{
 		Name:   name,
 		Fn:     c.wrapTaskFn(g.Name(), name, tunedFn),
@@ -167,6 +264,13 @@ func (c *BasicFlowContext) wrapTaskFn(flowName, taskName string, fn flow.TaskFn)
 				err = perr
 			}
 		}
+		if err != nil && c.bestEffort {
+			c.Log.Error(err, "Continuing after failed task", "flow", flowName, "task", taskName)
+			c.bestEffortLock.Lock()
+			c.bestEffortErrors = append(c.bestEffortErrors, err)
+			c.bestEffortLock.Unlock()
+			return nil
+		}
 		return err
 	}
 }
@@ -18,6 +18,8 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gardener/gardener/pkg/utils/flow"
@@ -162,4 +164,73 @@ var _ = Describe("BasicFlowContext", func() {
 			Expect(err).To(BeNil())
 		})
 	})
+
+	It("should report the progress of flows and sub flows", func() {
+		var (
+			ctx      = context.Background()
+			lock     sync.Mutex
+			reported []string
+		)
+
+		c := newTestFlowContext(logr.Discard(), shared.NewWhiteboard(), nil)
+		c.SetProgressReporter(func(_ context.Context, progress int32, description string) {
+			lock.Lock()
+			defer lock.Unlock()
+			reported = append(reported, fmt.Sprintf("%d %s", progress, description))
+		})
+
+		g := flow.NewGraph("test")
+		task1 := c.AddTask(g, "task1", func(_ context.Context) error { return nil })
+		_ = c.AddTask(g, "task2", func(ctx context.Context) error {
+			sub := flow.NewGraph("sub")
+			_ = c.AddTask(sub, "subtask", func(_ context.Context) error { return nil })
+			return sub.Compile().Run(ctx, c.SubFlowOpts())
+		}, shared.Dependencies(task1))
+		Expect(g.Compile().Run(ctx, c.FlowOpts())).To(Succeed())
+
+		Expect(reported).To(ContainElements(
+			"0 Starting test",
+			"0 task1",
+			"50 task2",
+			"50 subtask",
+			"50 sub finished",
+			"100 test finished",
+		))
+	})
+
+	It("should limit the number of concurrently running tasks and aggregate their errors", func() {
+		var (
+			ctx               = context.Background()
+			limiter           = shared.NewTaskLimiter(2)
+			running, maxTasks atomic.Int32
+		)
+
+		c := newTestFlowContext(logr.Discard(), shared.NewWhiteboard(), nil)
+		g := flow.NewGraph("test")
+		for i := 0; i < 6; i++ {
+			name := fmt.Sprintf("task%d", i)
+			_ = c.AddTask(g, name, func(_ context.Context) error {
+				current := running.Add(1)
+				defer running.Add(-1)
+				for {
+					highest := maxTasks.Load()
+					if current <= highest || maxTasks.CompareAndSwap(highest, current) {
+						break
+					}
+				}
+				time.Sleep(20 * time.Millisecond)
+				if name == "task1" || name == "task4" {
+					return fmt.Errorf("forced error")
+				}
+				return nil
+			}, shared.Limit(limiter))
+		}
+
+		err := g.Compile().Run(ctx, flow.Opts{Log: logr.Discard()})
+		Expect(flow.Causes(err).Errors).To(ConsistOf(
+			MatchError("failed to task1: forced error"),
+			MatchError("failed to task4: forced error"),
+		))
+		Expect(maxTasks.Load()).To(Equal(int32(2)))
+	})
 })
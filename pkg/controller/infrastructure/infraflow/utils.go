@@ -17,6 +17,7 @@ package infraflow
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/gardener/gardener/pkg/utils/flow"
@@ -73,6 +74,18 @@ outerCreate:
 	return
 }
 
+// identifyingTags returns the subset of the given tags which identifies a resource. The additional tags of the
+// InfrastructureConfig are not considered, as they may be changed by the user at any time.
+func identifyingTags(tags awsclient.Tags) awsclient.Tags {
+	identifying := awsclient.Tags{}
+	for k, v := range tags {
+		if k == TagKeyName || strings.HasPrefix(k, "kubernetes.io/") {
+			identifying[k] = v
+		}
+	}
+	return identifying
+}
+
 func findExisting[T any](ctx context.Context, id *string, tags awsclient.Tags,
 	getter func(ctx context.Context, id string) (*T, error),
 	finder func(ctx context.Context, tags awsclient.Tags) ([]*T, error),
@@ -88,7 +101,7 @@ func findExisting[T any](ctx context.Context, id *string, tags awsclient.Tags,
 		}
 	}
 
-	found, err := finder(ctx, tags)
+	found, err := finder(ctx, identifyingTags(tags))
 	if err != nil {
 		return nil, err
 	}
@@ -165,3 +178,13 @@ func copyMap(src map[string]string) map[string]string {
 	}
 	return dst
 }
+
+// firstOrEmpty returns the first element of the first non-empty slice or an empty string.
+func firstOrEmpty(slices ...[]string) string {
+	for _, slice := range slices {
+		if len(slice) > 0 {
+			return slice[0]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow"
+)
+
+// InventoryConfigMapDataKey is the key of the inventory in the data of the inventory ConfigMap.
+const InventoryConfigMapDataKey = "inventory.json"
+
+// publishInventory writes the inventory of the AWS resources created for the shoot to the S3 location or the
+// ConfigMap configured in the infrastructure config. The ConfigMap is removed if the inventory is disabled.
+func (a *actuator) publishInventory(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, flowContext *infraflow.FlowContext) error {
+	config := flowContext.GetInfrastructureConfig()
+	if !helper.IsInventoryEnabled(config) {
+		return client.IgnoreNotFound(a.client.Delete(ctx, emptyInventoryConfigMap(infrastructure)))
+	}
+
+	items, err := flowContext.Inventory(ctx)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(items)
+	if err != nil {
+		return fmt.Errorf("could not marshal inventory: %w", err)
+	}
+
+	if s3 := config.Inventory.S3; s3 != nil {
+		awsClient, err := newAWSClient(ctx, a.client, infrastructure, config)
+		if err != nil {
+			return err
+		}
+		if err := awsClient.PutObject(ctx, s3.Bucket, inventoryObjectKey(infrastructure, s3), "application/json", data); err != nil {
+			return fmt.Errorf("could not write inventory to S3 bucket %s: %w", s3.Bucket, err)
+		}
+		return client.IgnoreNotFound(a.client.Delete(ctx, emptyInventoryConfigMap(infrastructure)))
+	}
+
+	configMap := emptyInventoryConfigMap(infrastructure)
+	_, err = controllerutil.CreateOrUpdate(ctx, a.client, configMap, func() error {
+		configMap.Data = map[string]string{InventoryConfigMapDataKey: string(data)}
+		return nil
+	})
+	return err
+}
+
+// deleteInventory removes the inventory object from the S3 location configured in the infrastructure config. The
+// inventory ConfigMap is deleted together with the namespace of the shoot.
+func (a *actuator) deleteInventory(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure) error {
+	config, err := a.decodeInfrastructureConfig(infrastructure)
+	if err != nil {
+		return err
+	}
+	if !helper.IsInventoryEnabled(config) || config.Inventory.S3 == nil {
+		return nil
+	}
+
+	awsClient, err := newAWSClient(ctx, a.client, infrastructure, config)
+	if err != nil {
+		return err
+	}
+	if err := awsClient.DeleteObject(ctx, config.Inventory.S3.Bucket, inventoryObjectKey(infrastructure, config.Inventory.S3)); err != nil {
+		return fmt.Errorf("could not delete inventory from S3 bucket %s: %w", config.Inventory.S3.Bucket, err)
+	}
+	return nil
+}
+
+func inventoryObjectKey(infrastructure *extensionsv1alpha1.Infrastructure, s3 *awsapi.InventoryS3Location) string {
+	return s3.Prefix + infrastructure.Namespace + ".json"
+}
+
+func emptyInventoryConfigMap(infrastructure *extensionsv1alpha1.Infrastructure) *corev1.ConfigMap {
+	return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: infrastructure.Name + "-inventory", Namespace: infrastructure.Namespace}}
+}
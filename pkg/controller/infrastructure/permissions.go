@@ -0,0 +1,95 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+const (
+	// ConditionTypeIAMPermissions is the type of the Infrastructure condition reporting the result of the simulation of
+	// the IAM permissions required by the extension.
+	ConditionTypeIAMPermissions gardencorev1beta1.ConditionType = "IAMPermissions"
+	// ReasonPermissionsGranted is the reason of the IAMPermissions condition if all required actions are allowed.
+	ReasonPermissionsGranted = "PermissionsGranted"
+	// ReasonPermissionsMissing is the reason of the IAMPermissions condition if some required actions are denied.
+	ReasonPermissionsMissing = "PermissionsMissing"
+	// ReasonPermissionsNotSimulated is the reason of the IAMPermissions condition if the permissions could not be
+	// simulated.
+	ReasonPermissionsNotSimulated = "PermissionsNotSimulated"
+)
+
+// checkPermissions simulates the actions required by the controllers of the extension (see aws.RequiredActions) with the
+// policies of the IAM user or role of the given client using `iam:SimulatePrincipalPolicy`. It returns the status,
+// reason and message of the IAMPermissions condition. The message lists the denied actions per controller.
+func checkPermissions(ctx context.Context, awsClient awsclient.Interface) (gardencorev1beta1.ConditionStatus, string, string) {
+	notSimulated := func(message string) (gardencorev1beta1.ConditionStatus, string, string) {
+		return gardencorev1beta1.ConditionUnknown, ReasonPermissionsNotSimulated, message
+	}
+
+	callerARN, err := awsClient.GetCallerARN(ctx)
+	if err != nil {
+		return notSimulated(fmt.Sprintf("Could not determine the caller identity: %s", err))
+	}
+	principalARN, err := aws.GetPolicySourceARN(ctx, awsClient, callerARN)
+	if err != nil {
+		return notSimulated(fmt.Sprintf("Could not determine the IAM principal of %q: %s", callerARN, err))
+	}
+	if principalARN == "" {
+		return notSimulated(fmt.Sprintf("The permissions of %q cannot be simulated.", callerARN))
+	}
+
+	actions := sets.New[string]()
+	for _, controllerActions := range aws.RequiredActions {
+		actions.Insert(controllerActions...)
+	}
+	denied, err := awsClient.GetIAMDeniedActions(ctx, principalARN, sets.List(actions))
+	if err != nil {
+		return notSimulated(fmt.Sprintf("Could not simulate the permissions of %q: %s", principalARN, err))
+	}
+	if len(denied) == 0 {
+		return gardencorev1beta1.ConditionTrue, ReasonPermissionsGranted,
+			fmt.Sprintf("All %d actions required by the extension are allowed for %q.", actions.Len(), principalARN)
+	}
+
+	deniedActions := sets.New(denied...)
+	controllers := make([]string, 0, len(aws.RequiredActions))
+	for controller := range aws.RequiredActions {
+		controllers = append(controllers, controller)
+	}
+	slices.Sort(controllers)
+
+	var lines []string
+	for _, controller := range controllers {
+		controllerActions := sets.New(aws.RequiredActions[controller]...)
+		controllerDenied := sets.List(controllerActions.Intersection(deniedActions))
+		if len(controllerDenied) == 0 {
+			lines = append(lines, fmt.Sprintf("%s: all %d actions allowed", controller, controllerActions.Len()))
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%s: %d of %d actions denied: %s", controller, len(controllerDenied), controllerActions.Len(), strings.Join(controllerDenied, ", ")))
+	}
+	return gardencorev1beta1.ConditionFalse, ReasonPermissionsMissing,
+		fmt.Sprintf("Actions required by the extension are denied for %q:\n%s", principalARN, strings.Join(lines, "\n"))
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"errors"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	mockawsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/mock"
+)
+
+var _ = Describe("Permissions", func() {
+	Describe("#checkPermissions", func() {
+		const (
+			userARN = "arn:aws:iam::123456789012:user/gardener"
+			roleARN = "arn:aws:iam::123456789012:role/path/gardener"
+		)
+
+		var (
+			ctx = context.TODO()
+
+			ctrl      *gomock.Controller
+			awsClient *mockawsclient.MockInterface
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			awsClient = mockawsclient.NewMockInterface(ctrl)
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should report that all actions are allowed", func() {
+			awsClient.EXPECT().GetCallerARN(ctx).Return(userARN, nil)
+			awsClient.EXPECT().GetIAMDeniedActions(ctx, userARN, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ string, actions []string) ([]string, error) {
+					Expect(actions).To(ContainElements("ec2:CreateVpc", "iam:PassRole", "elasticloadbalancing:CreateLoadBalancer", "route53:ChangeResourceRecordSets", "s3:CreateBucket"))
+					return nil, nil
+				})
+
+			status, reason, message := checkPermissions(ctx, awsClient)
+			Expect(status).To(Equal(gardencorev1beta1.ConditionTrue))
+			Expect(reason).To(Equal(ReasonPermissionsGranted))
+			Expect(message).To(ContainSubstring(userARN))
+		})
+
+		It("should report the denied actions per controller", func() {
+			awsClient.EXPECT().GetCallerARN(ctx).Return("arn:aws:sts::123456789012:assumed-role/gardener/session", nil)
+			awsClient.EXPECT().GetIAMRole(ctx, "gardener").Return(&awsclient.IAMRole{ARN: roleARN}, nil)
+			awsClient.EXPECT().GetIAMDeniedActions(ctx, roleARN, gomock.Any()).Return([]string{"route53:GetChange", "ec2:CreateTags", "iam:PassRole"}, nil)
+
+			status, reason, message := checkPermissions(ctx, awsClient)
+			Expect(status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(reason).To(Equal(ReasonPermissionsMissing))
+			Expect(message).To(ContainSubstring(`Actions required by the extension are denied for "` + roleARN + `":`))
+			Expect(message).To(ContainSubstring("\ncontrolplane: all 19 actions allowed"))
+			Expect(message).To(ContainSubstring("\ndnsrecord: 1 of 4 actions denied: route53:GetChange"))
+			Expect(message).To(MatchRegexp(`\ninfrastructure: 1 of \d+ actions denied: ec2:CreateTags`))
+			Expect(message).To(ContainSubstring("\nworker: 2 of 7 actions denied: ec2:CreateTags, iam:PassRole"))
+		})
+
+		It("should report unknown if the caller cannot be simulated", func() {
+			awsClient.EXPECT().GetCallerARN(ctx).Return("arn:aws:iam::123456789012:root", nil)
+
+			status, reason, _ := checkPermissions(ctx, awsClient)
+			Expect(status).To(Equal(gardencorev1beta1.ConditionUnknown))
+			Expect(reason).To(Equal(ReasonPermissionsNotSimulated))
+		})
+
+		It("should report unknown if the simulation fails", func() {
+			awsClient.EXPECT().GetCallerARN(ctx).Return(userARN, nil)
+			awsClient.EXPECT().GetIAMDeniedActions(ctx, userARN, gomock.Any()).Return(nil, errors.New("AccessDenied"))
+
+			status, reason, message := checkPermissions(ctx, awsClient)
+			Expect(status).To(Equal(gardencorev1beta1.ConditionUnknown))
+			Expect(reason).To(Equal(ReasonPermissionsNotSimulated))
+			Expect(message).To(ContainSubstring("AccessDenied"))
+		})
+	})
+})
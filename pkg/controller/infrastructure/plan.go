@@ -0,0 +1,180 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow/shared"
+)
+
+const (
+	// ConditionTypeInfrastructurePlan is the type of the Infrastructure condition summarizing the changes of the AWS
+	// resources planned with the annotation `aws.provider.extensions.gardener.cloud/plan=true`.
+	ConditionTypeInfrastructurePlan gardencorev1beta1.ConditionType = "InfrastructurePlan"
+
+	// ReasonChangesPlanned is the reason of the InfrastructurePlan condition if the reconciliation would create or
+	// delete AWS resources.
+	ReasonChangesPlanned = "ChangesPlanned"
+	// ReasonNoChangesPlanned is the reason of the InfrastructurePlan condition if the reconciliation would neither
+	// create nor delete AWS resources.
+	ReasonNoChangesPlanned = "NoChangesPlanned"
+	// ReasonPlanFailed is the reason of the InfrastructurePlan condition if the changes could not be computed.
+	ReasonPlanFailed = "PlanFailed"
+	// ReasonPlanNotSupported is the reason of the InfrastructurePlan condition if the infrastructure is reconciled with
+	// terraform.
+	ReasonPlanNotSupported = "PlanNotSupported"
+
+	// PlanConfigMapDataKey is the key of the planned changes in the data of the plan ConfigMap.
+	PlanConfigMapDataKey = "plan.json"
+
+	// maxPlanConditionChanges is the maximum number of planned changes listed in the message of the condition.
+	maxPlanConditionChanges = 10
+)
+
+// shouldPlan checks if the changes of the AWS resources should only be planned instead of being applied, i.e. if the
+// annotation `aws.provider.extensions.gardener.cloud/plan=true` is set on the infrastructure or the shoot resource.
+func (a *actuator) shouldPlan(infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) bool {
+	return strings.EqualFold(infrastructure.Annotations[awsapi.AnnotationKeyPlan], "true") ||
+		(cluster.Shoot != nil && strings.EqualFold(cluster.Shoot.Annotations[awsapi.AnnotationKeyPlan], "true"))
+}
+
+// plan computes the changes of the AWS resources which the reconciliation of the given infrastructure would perform
+// and publishes them in the plan ConfigMap and the InfrastructurePlan condition, without changing any AWS resource or
+// the state of the infrastructure.
+func (a *actuator) plan(ctx context.Context, log logr.Logger, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	log.Info("Planning infrastructure changes")
+
+	flowState, err := a.getStateFromInfraStatus(infrastructure)
+	if err != nil {
+		return err
+	}
+	if flowState == nil {
+		if infrastructure.Status.State != nil || !a.shouldUseFlow(infrastructure, cluster) {
+			return a.updateCondition(ctx, infrastructure, ConditionTypeInfrastructurePlan, gardencorev1beta1.ConditionFalse, ReasonPlanNotSupported,
+				fmt.Sprintf("Planning is only supported by the flow infrastructure reconciler (annotation %s=true).", awsapi.AnnotationKeyUseFlow))
+		}
+		flowState = infraflow.NewPersistentState()
+	}
+
+	changes, err := a.computePlan(ctx, log, infrastructure, flowState)
+	if err != nil {
+		if condErr := a.updateCondition(ctx, infrastructure, ConditionTypeInfrastructurePlan, gardencorev1beta1.ConditionFalse, ReasonPlanFailed, err.Error()); condErr != nil {
+			log.Error(condErr, "Could not update condition", "type", ConditionTypeInfrastructurePlan)
+		}
+		return err
+	}
+
+	data, err := json.MarshalIndent(changes, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not marshal planned changes: %w", err)
+	}
+	configMap := emptyPlanConfigMap(infrastructure)
+	if _, err := controllerutil.CreateOrUpdate(ctx, a.client, configMap, func() error {
+		configMap.Data = map[string]string{PlanConfigMapDataKey: string(data)}
+		return nil
+	}); err != nil {
+		return err
+	}
+	log.Info("Planned infrastructure changes", "changes", len(changes))
+
+	reason, message := planSummary(changes)
+	return a.updateCondition(ctx, infrastructure, ConditionTypeInfrastructurePlan, gardencorev1beta1.ConditionTrue, reason, message)
+}
+
+// computePlan computes the changes of the AWS resources with a flow context whose state is never persisted.
+func (a *actuator) computePlan(ctx context.Context, log logr.Logger, infrastructure *extensionsv1alpha1.Infrastructure, flowState *infraflow.PersistentState) ([]infraflow.PlannedChange, error) {
+	if valid, err := flowState.HasValidVersion(); !valid {
+		return nil, err
+	}
+	infrastructureConfig, err := a.decodeInfrastructureConfig(infrastructure)
+	if err != nil {
+		return nil, err
+	}
+	awsClient, err := newAWSClient(ctx, a.client, infrastructure, infrastructureConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new AWS client: %w", err)
+	}
+
+	persistor := func(context.Context, shared.FlatMap) error { return nil }
+	flowContext, err := infraflow.NewFlowContext(log, awsClient, infrastructure, infrastructureConfig, flowState.ToFlatMap(), persistor)
+	if err != nil {
+		return nil, err
+	}
+	return flowContext.Plan(ctx)
+}
+
+// cleanupPlan removes the plan ConfigMap and the InfrastructurePlan condition once the infrastructure is reconciled
+// without the plan annotation.
+func (a *actuator) cleanupPlan(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure) error {
+	if err := client.IgnoreNotFound(a.client.Delete(ctx, emptyPlanConfigMap(infrastructure))); err != nil {
+		return err
+	}
+	if v1beta1helper.GetCondition(infrastructure.Status.Conditions, ConditionTypeInfrastructurePlan) == nil {
+		return nil
+	}
+	patch := client.MergeFrom(infrastructure.DeepCopy())
+	infrastructure.Status.Conditions = v1beta1helper.RemoveConditions(infrastructure.Status.Conditions, ConditionTypeInfrastructurePlan)
+	return a.client.Status().Patch(ctx, infrastructure, patch)
+}
+
+// planSummary returns the reason and the message of the InfrastructurePlan condition for the given changes.
+func planSummary(changes []infraflow.PlannedChange) (string, string) {
+	if len(changes) == 0 {
+		return ReasonNoChangesPlanned, "No AWS resources would be created or deleted."
+	}
+
+	var (
+		created, deleted int
+		items            []string
+	)
+	for _, change := range changes {
+		if change.Action == infraflow.PlanActionCreate {
+			created++
+		} else {
+			deleted++
+		}
+		if len(items) < maxPlanConditionChanges {
+			item := fmt.Sprintf("%s %s %s", strings.ToLower(string(change.Action)), change.ResourceType, change.Name)
+			if change.ID != "" {
+				item += " (" + change.ID + ")"
+			}
+			items = append(items, item)
+		}
+	}
+	if len(changes) > maxPlanConditionChanges {
+		items = append(items, fmt.Sprintf("and %d more", len(changes)-maxPlanConditionChanges))
+	}
+	return ReasonChangesPlanned, fmt.Sprintf("%d AWS resources would be created and %d deleted: %s.", created, deleted, strings.Join(items, ", "))
+}
+
+func emptyPlanConfigMap(infrastructure *extensionsv1alpha1.Infrastructure) *corev1.ConfigMap {
+	return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: infrastructure.Name + "-plan", Namespace: infrastructure.Namespace}}
+}
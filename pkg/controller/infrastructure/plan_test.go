@@ -0,0 +1,54 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow"
+)
+
+var _ = Describe("Plan", func() {
+	Describe("#planSummary", func() {
+		It("should report that no changes are planned", func() {
+			reason, message := planSummary(nil)
+			Expect(reason).To(Equal(ReasonNoChangesPlanned))
+			Expect(message).To(Equal("No AWS resources would be created or deleted."))
+		})
+
+		It("should count and list the planned changes", func() {
+			reason, message := planSummary([]infraflow.PlannedChange{
+				{Action: infraflow.PlanActionCreate, ResourceType: "subnet", Name: "workers eu-west-1c"},
+				{Action: infraflow.PlanActionDelete, ResourceType: "NAT gateway", Name: "eu-west-1b", ID: "nat-123456"},
+			})
+			Expect(reason).To(Equal(ReasonChangesPlanned))
+			Expect(message).To(Equal("1 AWS resources would be created and 1 deleted: " +
+				"create subnet workers eu-west-1c, delete NAT gateway eu-west-1b (nat-123456)."))
+		})
+
+		It("should truncate the list of planned changes", func() {
+			var changes []infraflow.PlannedChange
+			for i := 0; i < maxPlanConditionChanges+2; i++ {
+				changes = append(changes, infraflow.PlannedChange{Action: infraflow.PlanActionCreate, ResourceType: "subnet", Name: fmt.Sprintf("subnet-%d", i)})
+			}
+			_, message := planSummary(changes)
+			Expect(message).To(HavePrefix("12 AWS resources would be created and 0 deleted: create subnet subnet-0,"))
+			Expect(message).To(HaveSuffix("create subnet subnet-9, and 2 more."))
+		})
+	})
+})
@@ -0,0 +1,106 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow/shared"
+)
+
+const (
+	// progressPreflightChecks is the progress of the reconciliation when the preflight checks are running.
+	progressPreflightChecks int32 = 5
+	// progressMigration is the progress of the reconciliation when the terraform state is migrated to the flow state.
+	progressMigration int32 = 8
+	// progressApply is the progress of the reconciliation when the AWS resources are being reconciled.
+	progressApply int32 = 10
+	// progressStatus is the progress of the reconciliation when the status of the infrastructure is updated.
+	progressStatus int32 = 95
+)
+
+// progressReporter updates the progress and the description of the last operation of an infrastructure while it is
+// processing, so that users can see which stage of a long-running reconciliation is running. The progress never
+// decreases.
+// As the progress of flows is reported from their goroutines, the last operation is patched with a separate object.
+type progressReporter struct {
+	client client.Client
+	log    logr.Logger
+	key    client.ObjectKey
+
+	lock          sync.Mutex
+	lastOperation *gardencorev1beta1.LastOperation
+}
+
+// newProgressReporter creates a progress reporter for the given infrastructure. It doesn't report anything if the
+// last operation of the infrastructure is not processing.
+func (a *actuator) newProgressReporter(log logr.Logger, infrastructure *extensionsv1alpha1.Infrastructure) *progressReporter {
+	reporter := &progressReporter{
+		client: a.client,
+		log:    log,
+		key:    client.ObjectKeyFromObject(infrastructure),
+	}
+	if lastOperation := infrastructure.Status.LastOperation; lastOperation != nil && lastOperation.State == gardencorev1beta1.LastOperationStateProcessing {
+		reporter.lastOperation = lastOperation.DeepCopy()
+	}
+	return reporter
+}
+
+// Report updates the progress and the description of the last operation. Errors are only logged, as the progress is
+// purely informational.
+func (r *progressReporter) Report(ctx context.Context, progress int32, description string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.lastOperation == nil {
+		return
+	}
+	if progress < r.lastOperation.Progress {
+		progress = r.lastOperation.Progress
+	}
+	if progress == r.lastOperation.Progress && description == r.lastOperation.Description {
+		return
+	}
+
+	r.lastOperation.Progress = progress
+	r.lastOperation.Description = description
+	r.lastOperation.LastUpdateTime = metav1.Now()
+	data, err := json.Marshal(map[string]interface{}{"status": map[string]interface{}{"lastOperation": r.lastOperation}})
+	if err != nil {
+		r.log.Error(err, "Could not report progress")
+		return
+	}
+	infrastructure := &extensionsv1alpha1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Name: r.key.Name, Namespace: r.key.Namespace}}
+	if err := r.client.Status().Patch(ctx, infrastructure, client.RawPatch(types.MergePatchType, data)); err != nil {
+		r.log.Error(err, "Could not report progress", "progress", progress, "description", description)
+	}
+}
+
+// Stage returns a reporter for a flow, which maps the progress of the flow to the range between from and to and
+// prefixes the running tasks with the given stage.
+func (r *progressReporter) Stage(stage string, from, to int32) shared.FlowProgressReporter {
+	return func(ctx context.Context, progress int32, description string) {
+		r.Report(ctx, from+(to-from)*progress/100, stage+": "+description)
+	}
+}
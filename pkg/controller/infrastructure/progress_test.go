@@ -0,0 +1,93 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Progress", func() {
+	var (
+		ctx            = context.Background()
+		c              client.Client
+		a              *actuator
+		infrastructure *extensionsv1alpha1.Infrastructure
+	)
+
+	BeforeEach(func() {
+		infrastructure = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: "shoot--foo--bar"},
+			Status: extensionsv1alpha1.InfrastructureStatus{
+				DefaultStatus: extensionsv1alpha1.DefaultStatus{
+					LastOperation: &gardencorev1beta1.LastOperation{
+						Type:        gardencorev1beta1.LastOperationTypeReconcile,
+						State:       gardencorev1beta1.LastOperationStateProcessing,
+						Progress:    1,
+						Description: "Reconciling the Infrastructure",
+					},
+				},
+			},
+		}
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(infrastructure).WithStatusSubresource(infrastructure).Build()
+		a = &actuator{client: c}
+	})
+
+	lastOperation := func() *gardencorev1beta1.LastOperation {
+		current := &extensionsv1alpha1.Infrastructure{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(infrastructure), current)).To(Succeed())
+		return current.Status.LastOperation
+	}
+
+	It("should report the progress and the stages of flows", func() {
+		progress := a.newProgressReporter(logr.Discard(), infrastructure)
+
+		progress.Report(ctx, progressPreflightChecks, "Checking permissions and quotas")
+		Expect(lastOperation().Progress).To(Equal(progressPreflightChecks))
+		Expect(lastOperation().Description).To(Equal("Checking permissions and quotas"))
+		Expect(lastOperation().Type).To(Equal(gardencorev1beta1.LastOperationTypeReconcile))
+		Expect(lastOperation().State).To(Equal(gardencorev1beta1.LastOperationStateProcessing))
+
+		progress.Stage("Reconciling AWS resources", 10, 90)(ctx, 50, "ensure VPC")
+		Expect(lastOperation().Progress).To(Equal(int32(50)))
+		Expect(lastOperation().Description).To(Equal("Reconciling AWS resources: ensure VPC"))
+	})
+
+	It("should not decrease the progress", func() {
+		progress := a.newProgressReporter(logr.Discard(), infrastructure)
+
+		progress.Report(ctx, 50, "ensure zones resources")
+		progress.Report(ctx, 10, "ensure subnets")
+		Expect(lastOperation().Progress).To(Equal(int32(50)))
+		Expect(lastOperation().Description).To(Equal("ensure subnets"))
+	})
+
+	It("should not report the progress if the last operation is not processing", func() {
+		infrastructure.Status.LastOperation.State = gardencorev1beta1.LastOperationStateSucceeded
+		progress := a.newProgressReporter(logr.Discard(), infrastructure)
+
+		progress.Report(ctx, progressPreflightChecks, "Checking permissions and quotas")
+		Expect(lastOperation().Progress).To(Equal(int32(1)))
+	})
+})
@@ -0,0 +1,355 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow"
+)
+
+const (
+	// serviceCodeVPC is the code of the Amazon VPC service in the Service Quotas API.
+	serviceCodeVPC = "vpc"
+	// serviceCodeEC2 is the code of the Amazon EC2 service in the Service Quotas API.
+	serviceCodeEC2 = "ec2"
+
+	// QuotaCodeVPCsPerRegion is the code of the quota for VPCs per region.
+	QuotaCodeVPCsPerRegion = "L-F678F1CE"
+	// QuotaCodeNATGatewaysPerZone is the code of the quota for NAT gateways per availability zone.
+	QuotaCodeNATGatewaysPerZone = "L-FE5A380F"
+	// QuotaCodeElasticIPs is the code of the quota for elastic IP addresses per region.
+	QuotaCodeElasticIPs = "L-0263D0A3"
+	// QuotaCodeStandardOnDemandVCPUs is the code of the quota for vCPUs of running on-demand standard (A, C, D, H, I, M,
+	// R, T, Z) instances per region.
+	QuotaCodeStandardOnDemandVCPUs = "L-1216C47A"
+
+	// ConditionTypeVCPUQuota is the type of the Infrastructure condition reporting whether the vCPU quota of on-demand
+	// standard instances can accommodate the minimum number of worker nodes.
+	ConditionTypeVCPUQuota gardencorev1beta1.ConditionType = "VCPUQuota"
+	// ReasonQuotaSufficient is the reason of the VCPUQuota condition if the quota is sufficient.
+	ReasonQuotaSufficient = "QuotaSufficient"
+	// ReasonQuotaExceeded is the reason of the VCPUQuota condition if the quota is exceeded.
+	ReasonQuotaExceeded = "QuotaExceeded"
+)
+
+// instanceDemand is the number of instances of an instance type which are desired for the shoot.
+type instanceDemand struct {
+	instanceType string
+	count        int
+}
+
+// quotaRequirement is the number of resources which are still to be created for the shoot and count against a quota.
+type quotaRequirement struct {
+	serviceCode string
+	quotaCode   string
+	description string
+	required    int
+	// usage returns the number of resources which count against the quota already.
+	usage func(ctx context.Context) (int, error)
+	// warnOnly is true if an exceeded quota is only reported, but does not fail the reconciliation.
+	warnOnly bool
+}
+
+// checkQuotas checks with the Service Quotas API that the quotas of the VPCs, elastic IPs, NAT gateways and vCPUs of
+// standard instances can accommodate the resources which are still to be created for the shoot. Resources which exist
+// already, i.e. which are tagged with the cluster tag, don't require quota anymore. This way, reconciliations fail fast
+// instead of leaving half-built infrastructure behind.
+// The check is best-effort: if a quota or the usage cannot be determined, e.g. because the permission
+// `servicequotas:GetServiceQuota` is missing, the quota is not checked. Exceeded quotas are returned as error with the
+// `ERR_INFRA_QUOTA_EXCEEDED` code, except for the vCPU quota, which is only returned as warning.
+func checkQuotas(ctx context.Context, log logr.Logger, awsClient awsclient.Interface, decoder runtime.Decoder, namespace string, config *awsapi.InfrastructureConfig, cluster *extensionscontroller.Cluster) ([]string, error) {
+	requirements, err := getQuotaRequirements(ctx, awsClient, decoder, namespace, config, cluster)
+	if err != nil {
+		log.Info("Skipping preflight quota checks as the required resources could not be determined", "error", err.Error())
+		return nil, nil
+	}
+
+	var exceeded, warnings []string
+	for _, req := range requirements {
+		quota, err := awsClient.GetServiceQuota(ctx, req.serviceCode, req.quotaCode)
+		if err != nil || quota == nil {
+			log.Info("Skipping preflight quota check as the quota could not be determined", "quotaCode", req.quotaCode, "error", err)
+			continue
+		}
+		usage, err := req.usage(ctx)
+		if err != nil {
+			log.Info("Skipping preflight quota check as the usage could not be determined", "quotaCode", req.quotaCode, "error", err.Error())
+			continue
+		}
+		if float64(usage+req.required) <= *quota {
+			continue
+		}
+		message := fmt.Sprintf("%s (quota code %s): %d required, %d of %.0f in use", req.description, req.quotaCode, req.required, usage, *quota)
+		if req.warnOnly {
+			warnings = append(warnings, message)
+		} else {
+			exceeded = append(exceeded, message)
+		}
+	}
+
+	if len(exceeded) > 0 {
+		return nil, v1beta1helper.NewErrorWithCodes(fmt.Errorf("AWS service quotas are exceeded: %s", strings.Join(exceeded, "; ")), gardencorev1beta1.ErrorInfraQuotaExceeded)
+	}
+	return warnings, nil
+}
+
+// getQuotaRequirements returns the quotas required by the resources which are still to be created for the shoot.
+func getQuotaRequirements(ctx context.Context, awsClient awsclient.Interface, decoder runtime.Decoder, namespace string, config *awsapi.InfrastructureConfig, cluster *extensionscontroller.Cluster) ([]quotaRequirement, error) {
+	var (
+		requirements  []quotaRequirement
+		clusterTagKey = fmt.Sprintf(infraflow.TagKeyClusterTemplate, namespace)
+		clusterTags   = awsclient.Tags{clusterTagKey: infraflow.TagValueCluster}
+	)
+
+	if config.Networks.VPC.ID == nil {
+		vpcs, err := awsClient.FindVpcsByTags(ctx, clusterTags)
+		if err != nil {
+			return nil, err
+		}
+		if len(vpcs) == 0 {
+			requirements = append(requirements, quotaRequirement{
+				serviceCode: serviceCodeVPC,
+				quotaCode:   QuotaCodeVPCsPerRegion,
+				description: "VPCs per region",
+				required:    1,
+				usage:       awsClient.GetVpcCount,
+			})
+		}
+	}
+
+	natGatewayZones := sets.New[string]()
+	for _, zone := range config.Networks.Zones {
+		if natZone := helper.GetNATGatewayZoneName(config, zone.Name); natZone != "" {
+			natGatewayZones.Insert(natZone)
+		}
+	}
+
+	natGatewayType := helper.GetNATGatewayType(config)
+	if natGatewayType == awsapi.NATGatewayTypeGateway && natGatewayZones.Len() > 0 {
+		natRequirements, err := getNATGatewayQuotaRequirements(ctx, awsClient, clusterTagKey, config, natGatewayZones)
+		if err != nil {
+			return nil, err
+		}
+		requirements = append(requirements, natRequirements...)
+	}
+
+	// The instances of the workers are created later by the worker controller, but they are checked already as the
+	// infrastructure is of no use without them. Spot instances don't count against the quota of on-demand instances.
+	var instances []instanceDemand
+	if natGatewayType == awsapi.NATGatewayTypeInstance && config.Networks.NATGateway.Instance != nil {
+		instances = append(instances, instanceDemand{instanceType: config.Networks.NATGateway.Instance.InstanceType, count: natGatewayZones.Len()})
+	}
+	if cluster != nil && cluster.Shoot != nil && !extensionscontroller.IsHibernationEnabled(cluster) {
+		for _, worker := range cluster.Shoot.Spec.Provider.Workers {
+			workerConfig := &awsapi.WorkerConfig{}
+			if worker.ProviderConfig != nil && worker.ProviderConfig.Raw != nil {
+				if _, _, err := decoder.Decode(worker.ProviderConfig.Raw, nil, workerConfig); err != nil {
+					return nil, fmt.Errorf("could not decode provider config of worker pool %s: %w", worker.Name, err)
+				}
+			}
+			if workerConfig.CapacityType != nil && *workerConfig.CapacityType == awsapi.CapacityTypeSpot {
+				continue
+			}
+			instances = append(instances, instanceDemand{instanceType: worker.Machine.Type, count: int(worker.Minimum)})
+		}
+	}
+	if len(instances) > 0 {
+		vcpuRequirement, err := getVCPUQuotaRequirement(ctx, awsClient, clusterTags, instances)
+		if err != nil {
+			return nil, err
+		}
+		if vcpuRequirement != nil {
+			requirements = append(requirements, *vcpuRequirement)
+		}
+	}
+
+	return requirements, nil
+}
+
+// getNATGatewayQuotaRequirements returns the quotas required by the NAT gateways and their elastic IPs which are still
+// to be created in the given zones.
+func getNATGatewayQuotaRequirements(ctx context.Context, awsClient awsclient.Interface, clusterTagKey string, config *awsapi.InfrastructureConfig, natGatewayZones sets.Set[string]) ([]quotaRequirement, error) {
+	var requirements []quotaRequirement
+
+	natGateways, err := awsClient.FindNATGatewaysByTags(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	var subnetIDs []string
+	for _, natGateway := range natGateways {
+		subnetIDs = append(subnetIDs, natGateway.SubnetId)
+	}
+	subnetZones := make(map[string]string, len(subnetIDs))
+	if len(subnetIDs) > 0 {
+		subnets, err := awsClient.GetSubnets(ctx, sets.List(sets.New(subnetIDs...)))
+		if err != nil {
+			return nil, err
+		}
+		for _, subnet := range subnets {
+			subnetZones[subnet.SubnetId] = subnet.AvailabilityZone
+		}
+	}
+
+	var (
+		natGatewaysPerZone      = make(map[string]int)
+		ownedNATGatewaysPerZone = make(map[string]int)
+	)
+	for _, natGateway := range natGateways {
+		if natGateway.State == string(ec2types.NatGatewayStateDeleting) || natGateway.State == string(ec2types.NatGatewayStateFailed) {
+			continue
+		}
+		zone := subnetZones[natGateway.SubnetId]
+		natGatewaysPerZone[zone]++
+		if natGateway.Tags[clusterTagKey] == infraflow.TagValueCluster {
+			ownedNATGatewaysPerZone[zone]++
+		}
+	}
+
+	requiredElasticIPs := 0
+	for _, zoneName := range sets.List(natGatewayZones) {
+		if ownedNATGatewaysPerZone[zoneName] > 0 {
+			continue
+		}
+		requirements = append(requirements, quotaRequirement{
+			serviceCode: serviceCodeVPC,
+			quotaCode:   QuotaCodeNATGatewaysPerZone,
+			description: fmt.Sprintf("NAT gateways in zone %s", zoneName),
+			required:    1,
+			usage: func(_ context.Context) (int, error) {
+				return natGatewaysPerZone[zoneName], nil
+			},
+		})
+		for _, zone := range config.Networks.Zones {
+			if zone.Name == zoneName && zone.ElasticIPAllocationID == nil {
+				requiredElasticIPs++
+			}
+		}
+	}
+
+	if requiredElasticIPs > 0 {
+		ownedElasticIPs, err := awsClient.FindElasticIPsByTags(ctx, awsclient.Tags{clusterTagKey: infraflow.TagValueCluster})
+		if err != nil {
+			return nil, err
+		}
+		// elastic IPs of NAT gateways which were deleted in the meantime are reused
+		if required := requiredElasticIPs - len(ownedElasticIPs); required > 0 {
+			requirements = append(requirements, quotaRequirement{
+				serviceCode: serviceCodeEC2,
+				quotaCode:   QuotaCodeElasticIPs,
+				description: "elastic IP addresses",
+				required:    required,
+				usage: func(ctx context.Context) (int, error) {
+					elasticIPs, err := awsClient.FindElasticIPsByTags(ctx, nil)
+					return len(elasticIPs), err
+				},
+			})
+		}
+	}
+
+	return requirements, nil
+}
+
+// getVCPUQuotaRequirement returns the quota required by the vCPUs of the given instances which don't run yet. Only
+// standard instance types are considered as the other instance families have quotas of their own. An exceeded quota is
+// only reported as warning as the worker nodes are created by the worker controller and must not block the
+// infrastructure.
+func getVCPUQuotaRequirement(ctx context.Context, awsClient awsclient.Interface, clusterTags awsclient.Tags, instances []instanceDemand) (*quotaRequirement, error) {
+	instanceTypes := sets.New[string]()
+	for _, instance := range instances {
+		if instance.count > 0 && isStandardInstanceType(instance.instanceType) {
+			instanceTypes.Insert(instance.instanceType)
+		}
+	}
+	if instanceTypes.Len() == 0 {
+		return nil, nil
+	}
+
+	vcpus, err := awsClient.GetInstanceTypeVCPUs(ctx, sets.List(instanceTypes))
+	if err != nil {
+		return nil, err
+	}
+	desired := 0
+	for _, instance := range instances {
+		if instanceTypes.Has(instance.instanceType) {
+			desired += instance.count * int(vcpus[instance.instanceType])
+		}
+	}
+
+	ownedInstances, err := awsClient.FindInstancesByTags(ctx, clusterTags)
+	if err != nil {
+		return nil, err
+	}
+	required := desired - standardVCPUs(ownedInstances)
+	if required <= 0 {
+		return nil, nil
+	}
+
+	return &quotaRequirement{
+		serviceCode: serviceCodeEC2,
+		quotaCode:   QuotaCodeStandardOnDemandVCPUs,
+		description: "vCPUs of running on-demand standard instances",
+		required:    required,
+		usage: func(ctx context.Context) (int, error) {
+			allInstances, err := awsClient.FindInstancesByTags(ctx, nil)
+			return standardVCPUs(allInstances), err
+		},
+		warnOnly: true,
+	}, nil
+}
+
+// standardVCPUs returns the sum of the vCPUs of the given instances which are on-demand instances of standard instance
+// types and count against the vCPU quota, i.e. which are pending or running.
+func standardVCPUs(instances []*awsclient.Instance) int {
+	sum := 0
+	for _, instance := range instances {
+		if (instance.State == string(ec2types.InstanceStateNamePending) || instance.State == string(ec2types.InstanceStateNameRunning)) &&
+			instance.InstanceLifecycle != string(ec2types.InstanceLifecycleTypeSpot) && isStandardInstanceType(instance.InstanceType) {
+			sum += int(instance.VCPUs)
+		}
+	}
+	return sum
+}
+
+// nonStandardInstanceFamilyPrefixes are the prefixes of instance families which start like a standard instance family,
+// but have quotas of their own.
+var nonStandardInstanceFamilyPrefixes = []string{"dl", "hpc", "inf", "mac", "trn", "u-"}
+
+// isStandardInstanceType returns true if the given instance type belongs to the standard instance families (A, C, D,
+// H, I, M, R, T, Z), whose vCPUs count against the quota `L-1216C47A`.
+func isStandardInstanceType(instanceType string) bool {
+	if instanceType == "" || !strings.ContainsRune("acdhimrtz", rune(instanceType[0])) {
+		return false
+	}
+	for _, prefix := range nonStandardInstanceFamilyPrefixes {
+		if strings.HasPrefix(instanceType, prefix) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,224 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/aws/smithy-go"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/utils/ptr"
+
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	awsinstall "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/install"
+	awsv1alpha1 "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	mockawsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/mock"
+)
+
+var _ = Describe("Quota", func() {
+	Describe("#checkQuotas", func() {
+		const namespace = "shoot--foo--bar"
+
+		var (
+			ctx = context.TODO()
+			log = logr.Discard()
+
+			ctrl      *gomock.Controller
+			awsClient *mockawsclient.MockInterface
+			decoder   runtime.Decoder
+			config    *awsapi.InfrastructureConfig
+			cluster   *extensionscontroller.Cluster
+
+			clusterTags = awsclient.Tags{"kubernetes.io/cluster/" + namespace: "1"}
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			awsClient = mockawsclient.NewMockInterface(ctrl)
+			scheme := runtime.NewScheme()
+			Expect(awsinstall.AddToScheme(scheme)).To(Succeed())
+			decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
+
+			config = &awsapi.InfrastructureConfig{
+				Networks: awsapi.Networks{
+					VPC:   awsapi.VPC{CIDR: ptr.To("10.0.0.0/16")},
+					Zones: []awsapi.Zone{{Name: "zone-a"}},
+				},
+			}
+			cluster = &extensionscontroller.Cluster{
+				Shoot: &gardencorev1beta1.Shoot{
+					Spec: gardencorev1beta1.ShootSpec{
+						Provider: gardencorev1beta1.Provider{
+							Workers: []gardencorev1beta1.Worker{{
+								Name:    "worker",
+								Machine: gardencorev1beta1.Machine{Type: "m5.large"},
+								Minimum: 3,
+							}},
+						},
+					},
+				},
+			}
+		})
+
+		expectOwnedResources := func(vpcs []*awsclient.VPC, natGateways []*awsclient.NATGateway, instances []*awsclient.Instance) {
+			awsClient.EXPECT().FindVpcsByTags(ctx, clusterTags).Return(vpcs, nil)
+			awsClient.EXPECT().FindNATGatewaysByTags(ctx, nil).Return(natGateways, nil)
+			if len(natGateways) > 0 {
+				awsClient.EXPECT().GetSubnets(ctx, []string{"subnet-a"}).Return([]*awsclient.Subnet{{SubnetId: "subnet-a", AvailabilityZone: "zone-a"}}, nil)
+			}
+			awsClient.EXPECT().GetInstanceTypeVCPUs(ctx, []string{"m5.large"}).Return(map[string]int32{"m5.large": 2}, nil)
+			awsClient.EXPECT().FindInstancesByTags(ctx, clusterTags).Return(instances, nil)
+		}
+
+		It("should succeed if the quotas can accommodate the shoot", func() {
+			expectOwnedResources(nil, nil, nil)
+			awsClient.EXPECT().FindElasticIPsByTags(ctx, clusterTags).Return(nil, nil)
+
+			awsClient.EXPECT().GetServiceQuota(ctx, "vpc", QuotaCodeVPCsPerRegion).Return(ptr.To(5.0), nil)
+			awsClient.EXPECT().GetVpcCount(ctx).Return(4, nil)
+			awsClient.EXPECT().GetServiceQuota(ctx, "vpc", QuotaCodeNATGatewaysPerZone).Return(ptr.To(5.0), nil)
+			awsClient.EXPECT().GetServiceQuota(ctx, "ec2", QuotaCodeElasticIPs).Return(ptr.To(5.0), nil)
+			awsClient.EXPECT().FindElasticIPsByTags(ctx, nil).Return([]*awsclient.ElasticIP{{AllocationId: "eipalloc-1"}}, nil)
+			awsClient.EXPECT().GetServiceQuota(ctx, "ec2", QuotaCodeStandardOnDemandVCPUs).Return(ptr.To(32.0), nil)
+			awsClient.EXPECT().FindInstancesByTags(ctx, nil).Return([]*awsclient.Instance{
+				{InstanceType: "m5.xlarge", VCPUs: 4, State: "running"},
+				{InstanceType: "g4dn.xlarge", VCPUs: 4, State: "running"},
+			}, nil)
+
+			Expect(checkQuotas(ctx, log, awsClient, decoder, namespace, config, cluster)).To(BeEmpty())
+		})
+
+		It("should fail with the quota code if quotas are exceeded", func() {
+			expectOwnedResources(nil, nil, nil)
+			awsClient.EXPECT().FindElasticIPsByTags(ctx, clusterTags).Return(nil, nil)
+
+			awsClient.EXPECT().GetServiceQuota(ctx, "vpc", QuotaCodeVPCsPerRegion).Return(ptr.To(5.0), nil)
+			awsClient.EXPECT().GetVpcCount(ctx).Return(5, nil)
+			awsClient.EXPECT().GetServiceQuota(ctx, "vpc", QuotaCodeNATGatewaysPerZone).Return(ptr.To(5.0), nil)
+			awsClient.EXPECT().GetServiceQuota(ctx, "ec2", QuotaCodeElasticIPs).Return(ptr.To(5.0), nil)
+			awsClient.EXPECT().FindElasticIPsByTags(ctx, nil).Return(nil, nil)
+			awsClient.EXPECT().GetServiceQuota(ctx, "ec2", QuotaCodeStandardOnDemandVCPUs).Return(ptr.To(32.0), nil)
+			awsClient.EXPECT().FindInstancesByTags(ctx, nil).Return([]*awsclient.Instance{
+				{InstanceType: "m5.8xlarge", VCPUs: 32, State: "running"},
+			}, nil)
+
+			warnings, err := checkQuotas(ctx, log, awsClient, decoder, namespace, config, cluster)
+			Expect(warnings).To(BeEmpty())
+			Expect(err).To(MatchError(ContainSubstring("VPCs per region (quota code L-F678F1CE): 1 required, 5 of 5 in use")))
+			Expect(err).NotTo(MatchError(ContainSubstring("L-1216C47A")))
+			var coder v1beta1helper.Coder
+			Expect(errors.As(err, &coder)).To(BeTrue())
+			Expect(coder.Codes()).To(ConsistOf(gardencorev1beta1.ErrorInfraQuotaExceeded))
+		})
+
+		It("should only warn if the vCPU quota is exceeded", func() {
+			config.Networks.NATGateway = &awsapi.NATGateway{Mode: ptr.To(awsapi.NATGatewayModeNone)}
+
+			awsClient.EXPECT().FindVpcsByTags(ctx, clusterTags).Return([]*awsclient.VPC{{VpcId: "vpc-1"}}, nil)
+			awsClient.EXPECT().GetInstanceTypeVCPUs(ctx, []string{"m5.large"}).Return(map[string]int32{"m5.large": 2}, nil)
+			awsClient.EXPECT().FindInstancesByTags(ctx, clusterTags).Return(nil, nil)
+			awsClient.EXPECT().GetServiceQuota(ctx, "ec2", QuotaCodeStandardOnDemandVCPUs).Return(ptr.To(32.0), nil)
+			awsClient.EXPECT().FindInstancesByTags(ctx, nil).Return([]*awsclient.Instance{
+				{InstanceType: "m5.8xlarge", VCPUs: 32, State: "running"},
+				{InstanceType: "m5.8xlarge", VCPUs: 32, State: "running", InstanceLifecycle: "spot"},
+			}, nil)
+
+			warnings, err := checkQuotas(ctx, log, awsClient, decoder, namespace, config, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("vCPUs of running on-demand standard instances (quota code L-1216C47A): 6 required, 32 of 32 in use"))
+		})
+
+		It("should not check the vCPUs of spot worker pools", func() {
+			config.Networks.NATGateway = &awsapi.NATGateway{Mode: ptr.To(awsapi.NATGatewayModeNone)}
+			workerConfig, err := json.Marshal(&awsv1alpha1.WorkerConfig{
+				TypeMeta:     metav1.TypeMeta{APIVersion: awsv1alpha1.SchemeGroupVersion.String(), Kind: "WorkerConfig"},
+				CapacityType: ptr.To(awsv1alpha1.CapacityTypeSpot),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			cluster.Shoot.Spec.Provider.Workers[0].ProviderConfig = &runtime.RawExtension{Raw: workerConfig}
+
+			awsClient.EXPECT().FindVpcsByTags(ctx, clusterTags).Return([]*awsclient.VPC{{VpcId: "vpc-1"}}, nil)
+
+			Expect(checkQuotas(ctx, log, awsClient, decoder, namespace, config, cluster)).To(BeEmpty())
+		})
+
+		It("should not check any quota if all resources exist already", func() {
+			expectOwnedResources(
+				[]*awsclient.VPC{{VpcId: "vpc-1"}},
+				[]*awsclient.NATGateway{{NATGatewayId: "nat-1", SubnetId: "subnet-a", State: "available", Tags: clusterTags}},
+				[]*awsclient.Instance{
+					{InstanceType: "m5.large", VCPUs: 2, State: "running"},
+					{InstanceType: "m5.large", VCPUs: 2, State: "running"},
+					{InstanceType: "m5.large", VCPUs: 2, State: "running"},
+				},
+			)
+
+			Expect(checkQuotas(ctx, log, awsClient, decoder, namespace, config, cluster)).To(BeEmpty())
+		})
+
+		It("should not check the vCPUs of hibernated shoots and the VPC if it exists", func() {
+			config.Networks.VPC = awsapi.VPC{ID: ptr.To("vpc-1")}
+			config.Networks.Zones[0].ElasticIPAllocationID = ptr.To("eipalloc-1")
+			cluster.Shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}
+
+			awsClient.EXPECT().FindNATGatewaysByTags(ctx, nil).Return(nil, nil)
+			awsClient.EXPECT().GetServiceQuota(ctx, "vpc", QuotaCodeNATGatewaysPerZone).Return(ptr.To(5.0), nil)
+
+			Expect(checkQuotas(ctx, log, awsClient, decoder, namespace, config, cluster)).To(BeEmpty())
+		})
+
+		It("should skip quotas which cannot be determined", func() {
+			config.Networks.NATGateway = &awsapi.NATGateway{Mode: ptr.To(awsapi.NATGatewayModeNone)}
+			cluster.Shoot.Spec.Provider.Workers = nil
+
+			awsClient.EXPECT().FindVpcsByTags(ctx, clusterTags).Return(nil, nil)
+			awsClient.EXPECT().GetServiceQuota(ctx, "vpc", QuotaCodeVPCsPerRegion).Return(nil, &smithy.GenericAPIError{Code: "AccessDeniedException"})
+
+			Expect(checkQuotas(ctx, log, awsClient, decoder, namespace, config, cluster)).To(BeEmpty())
+		})
+
+		It("should skip the checks if the required resources cannot be determined", func() {
+			awsClient.EXPECT().FindVpcsByTags(ctx, clusterTags).Return(nil, errors.New("fake"))
+
+			Expect(checkQuotas(ctx, log, awsClient, decoder, namespace, config, cluster)).To(BeEmpty())
+		})
+	})
+
+	DescribeTable("#isStandardInstanceType",
+		func(instanceType string, expected bool) {
+			Expect(isStandardInstanceType(instanceType)).To(Equal(expected))
+		},
+		Entry("m5", "m5.large", true),
+		Entry("t3", "t3.medium", true),
+		Entry("c6gn", "c6gn.xlarge", true),
+		Entry("g4dn", "g4dn.xlarge", false),
+		Entry("p3", "p3.2xlarge", false),
+		Entry("inf1", "inf1.xlarge", false),
+		Entry("trn1", "trn1.2xlarge", false),
+		Entry("empty", "", false),
+	)
+})
@@ -0,0 +1,78 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow"
+)
+
+const (
+	// ConditionTypeSharedVPC is the type of the Infrastructure condition listing the resources which must be provided
+	// by the account owning a VPC shared via AWS RAM.
+	ConditionTypeSharedVPC gardencorev1beta1.ConditionType = "SharedVPC"
+
+	// ReasonSharedVPCOwnerResourcesRequired is the reason of the SharedVPC condition if the infrastructure has been
+	// reconciled in a shared VPC.
+	ReasonSharedVPCOwnerResourcesRequired = "OwnerResourcesRequired"
+)
+
+// updateSharedVPCCondition updates the SharedVPC condition of the given infrastructure if it uses a shared VPC.
+func (a *actuator) updateSharedVPCCondition(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure) error {
+	infrastructureConfig, err := a.decodeInfrastructureConfig(infrastructure)
+	if err != nil {
+		return err
+	}
+	if !infrastructureConfig.Networks.VPC.Shared {
+		return nil
+	}
+	return a.updateCondition(ctx, infrastructure, ConditionTypeSharedVPC, gardencorev1beta1.ConditionTrue, ReasonSharedVPCOwnerResourcesRequired,
+		sharedVPCOwnerRequirements(infrastructure.Namespace, infrastructureConfig))
+}
+
+// sharedVPCOwnerRequirements describes the resources which the account owning a shared VPC must provide, as the
+// account of the shoot is not allowed to create or modify them.
+func sharedVPCOwnerRequirements(namespace string, config *awsapi.InfrastructureConfig) string {
+	var (
+		vpcID      = *config.Networks.VPC.ID
+		clusterTag = fmt.Sprintf(infraflow.TagKeyClusterTemplate, namespace)
+		workers    []string
+		public     []string
+		internal   []string
+		all        []string
+	)
+	for _, zone := range config.Networks.Zones {
+		workers = append(workers, fmt.Sprintf("%s (%s)", zone.Workers, zone.Name))
+		public = append(public, fmt.Sprintf("%s (%s)", zone.Public, zone.Name))
+		internal = append(internal, fmt.Sprintf("%s (%s)", zone.Internal, zone.Name))
+		all = append(all, workers[len(workers)-1], public[len(public)-1], internal[len(internal)-1])
+	}
+
+	requirements := []string{
+		fmt.Sprintf("the subnets %s must be shared with the account of the shoot", strings.Join(all, ", ")),
+		fmt.Sprintf("the route table of the public subnets %s must route 0.0.0.0/0 to an internet gateway", strings.Join(public, ", ")),
+		fmt.Sprintf("the route tables of the workers subnets %s and internal subnets %s must provide egress to the internet, e.g. via a NAT gateway", strings.Join(workers, ", "), strings.Join(internal, ", ")),
+		fmt.Sprintf("all subnets must be tagged with %s=%s, the public subnets with %s=%s and the internal subnets with %s=%s",
+			clusterTag, infraflow.TagValueCluster, infraflow.TagKeyRolePublicELB, infraflow.TagValueELB, infraflow.TagKeyRolePrivateELB, infraflow.TagValueELB),
+	}
+	return fmt.Sprintf("VPC %s is shared via AWS RAM. The account owning the VPC must provide the following resources: %s.", vpcID, strings.Join(requirements, "; "))
+}
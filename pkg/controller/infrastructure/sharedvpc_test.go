@@ -0,0 +1,49 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+)
+
+var _ = Describe("SharedVPC", func() {
+	Describe("#sharedVPCOwnerRequirements", func() {
+		It("should list the subnets, routes and tags to be provided by the owner of the VPC", func() {
+			config := &awsapi.InfrastructureConfig{
+				Networks: awsapi.Networks{
+					VPC: awsapi.VPC{ID: ptr.To("vpc-123456"), Shared: true},
+					Zones: []awsapi.Zone{
+						{Name: "eu-west-1a", Workers: "10.0.0.0/20", Public: "10.0.16.0/22", Internal: "10.0.20.0/22"},
+						{Name: "eu-west-1b", Workers: "10.0.32.0/20", Public: "10.0.48.0/22", Internal: "10.0.52.0/22"},
+					},
+				},
+			}
+
+			Expect(sharedVPCOwnerRequirements("shoot--foo--bar", config)).To(Equal("VPC vpc-123456 is shared via AWS RAM. " +
+				"The account owning the VPC must provide the following resources: " +
+				"the subnets 10.0.0.0/20 (eu-west-1a), 10.0.16.0/22 (eu-west-1a), 10.0.20.0/22 (eu-west-1a), " +
+				"10.0.32.0/20 (eu-west-1b), 10.0.48.0/22 (eu-west-1b), 10.0.52.0/22 (eu-west-1b) must be shared with the account of the shoot; " +
+				"the route table of the public subnets 10.0.16.0/22 (eu-west-1a), 10.0.48.0/22 (eu-west-1b) must route 0.0.0.0/0 to an internet gateway; " +
+				"the route tables of the workers subnets 10.0.0.0/20 (eu-west-1a), 10.0.32.0/20 (eu-west-1b) and internal subnets " +
+				"10.0.20.0/22 (eu-west-1a), 10.0.52.0/22 (eu-west-1b) must provide egress to the internet, e.g. via a NAT gateway; " +
+				"all subnets must be tagged with kubernetes.io/cluster/shoot--foo--bar=1, the public subnets with kubernetes.io/role/elb=1 " +
+				"and the internal subnets with kubernetes.io/role/internal-elb=1."))
+		})
+	})
+})
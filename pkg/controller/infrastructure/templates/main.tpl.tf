@@ -1,7 +1,30 @@
 provider "aws" {
-  access_key = var.ACCESS_KEY_ID
-  secret_key = var.SECRET_ACCESS_KEY
+  access_key = var.ACCESS_KEY_ID != "" ? var.ACCESS_KEY_ID : null
+  secret_key = var.SECRET_ACCESS_KEY != "" ? var.SECRET_ACCESS_KEY : null
   region     = "{{ .aws.region }}"
+  {{- if .aws.endpoints }}
+  endpoints {
+    {{- range $service, $url := .aws.endpoints }}
+    {{ $service }} = "{{ $url }}"
+    {{- end }}
+  }
+  {{- end }}
+  dynamic "assume_role" {
+    for_each = var.ROLE_ARN != "" && var.WEB_IDENTITY_TOKEN == "" ? [1] : []
+    content {
+      role_arn     = var.ROLE_ARN
+      external_id  = var.EXTERNAL_ID != "" ? var.EXTERNAL_ID : null
+      session_name = "gardener-extension-provider-aws"
+    }
+  }
+  dynamic "assume_role_with_web_identity" {
+    for_each = var.WEB_IDENTITY_TOKEN != "" ? [1] : []
+    content {
+      role_arn           = var.ROLE_ARN
+      web_identity_token = var.WEB_IDENTITY_TOKEN
+      session_name       = "gardener-extension-provider-aws"
+    }
+  }
   {{- if or .ignoreTags.keys .ignoreTags.keyPrefixes }}
   ignore_tags {
     {{- if .ignoreTags.keys }}
@@ -12,6 +35,15 @@ provider "aws" {
     {{- end }}
   }
   {{- end }}
+  {{- if .tags }}
+  default_tags {
+    tags = {
+      {{- range $key, $value := .tags }}
+      {{ quote $key }} = {{ quote $value }}
+      {{- end }}
+    }
+  }
+  {{- end }}
 }
 
 //=====================================================================
@@ -21,7 +53,10 @@ provider "aws" {
 {{ if .create.vpc -}}
 resource "aws_vpc_dhcp_options" "vpc_dhcp_options" {
   domain_name         = "{{ .vpc.dhcpDomainName }}"
-  domain_name_servers = ["AmazonProvidedDNS"]
+  domain_name_servers = [{{ joinQuotes .vpc.dhcpDomainNameServers }}]
+  {{- if .vpc.dhcpNTPServers }}
+  ntp_servers         = [{{ joinQuotes .vpc.dhcpNTPServers }}]
+  {{- end }}
 
 {{ commonTags .clusterName | indent 2 }}
 }
@@ -58,13 +93,24 @@ resource "aws_internet_gateway" "igw" {
 }
 {{- end}}
 
+{{ range $cidr := .vpc.secondaryCidrBlocks }}
+resource "aws_vpc_ipv4_cidr_block_association" "secondary_{{ $cidr | replace "." "_" | replace "/" "_" }}" {
+  vpc_id     = {{ $.vpc.id }}
+  cidr_block = "{{ $cidr }}"
+}
+{{ end }}
+
 {{ range $ep := .vpc.gatewayEndpoints }}
 resource "aws_vpc_endpoint" "vpc_gwep_{{ $ep }}" {
   vpc_id       = {{ $.vpc.id }}
-  service_name = "com.amazonaws.{{ $.aws.region }}.{{ $ep }}"
+  service_name = "{{ $.aws.vpcEndpointServicePrefix }}{{ $ep }}"
 
 {{ commonTagsWithSuffix $.clusterName (print "gw-" $ep) | indent 2 }}
 }
+
+output "{{ $.outputKeys.vpcEndpointPrefix }}{{ $ep | replace "." "_" }}" {
+  value = aws_vpc_endpoint.vpc_gwep_{{ $ep }}.id
+}
 {{ end }}
 
 resource "aws_route_table" "routetable_main" {
@@ -97,6 +143,12 @@ resource "aws_route" "public-ipv6" {
     create = "5m"
   }
 }
+
+resource "aws_egress_only_internet_gateway" "egw" {
+  vpc_id = {{ .vpc.id }}
+
+{{ commonTags .clusterName | indent 2 }}
+}
 {{ end }}
 
 resource "aws_security_group" "nodes" {
@@ -147,6 +199,28 @@ resource "aws_security_group_rule" "nodes_egress_all" {
   cidr_blocks       = ["0.0.0.0/0"]
   security_group_id = aws_security_group.nodes.id
 }
+{{ range $index, $rule := .additionalNodeSecurityGroupRules }}
+{{- if $rule.cidrBlocks }}
+resource "aws_security_group_rule" "nodes_additional_{{ $index }}" {
+  type              = "{{ $rule.type }}"
+  from_port         = {{ $rule.fromPort }}
+  to_port           = {{ $rule.toPort }}
+  protocol          = "{{ $rule.protocol }}"
+  cidr_blocks       = [{{ joinQuotes $rule.cidrBlocks }}]
+  security_group_id = aws_security_group.nodes.id
+}
+{{ end }}
+{{- range $sgIndex, $sg := $rule.securityGroupIDs }}
+resource "aws_security_group_rule" "nodes_additional_{{ $index }}_sg{{ $sgIndex }}" {
+  type                     = "{{ $rule.type }}"
+  from_port                = {{ $rule.fromPort }}
+  to_port                  = {{ $rule.toPort }}
+  protocol                 = "{{ $rule.protocol }}"
+  source_security_group_id = "{{ $sg }}"
+  security_group_id        = aws_security_group.nodes.id
+}
+{{ end }}
+{{- end }}
 
 {{ range $index, $zone := .zones }}
 resource "aws_subnet" "nodes_z{{ $index }}" {
@@ -168,6 +242,12 @@ resource "aws_subnet" "nodes_z{{ $index }}" {
 output "{{ $.outputKeys.subnetsNodesPrefix }}{{ $index }}" {
   value = aws_subnet.nodes_z{{ $index }}.id
 }
+{{- if $.dualStack.enabled }}
+
+output "{{ $.outputKeys.subnetsNodesIPv6Prefix }}{{ $index }}" {
+  value = aws_subnet.nodes_z{{ $index }}.ipv6_cidr_block
+}
+{{- end }}
 
 resource "aws_subnet" "private_utility_z{{ $index }}" {
   vpc_id            = {{ $.vpc.id }}
@@ -189,6 +269,28 @@ resource "aws_subnet" "private_utility_z{{ $index }}" {
   }
 }
 
+{{- if $zone.pods }}
+resource "aws_subnet" "pods_z{{ $index }}" {
+  vpc_id            = {{ $.vpc.id }}
+  cidr_block        = "{{ $zone.pods }}"
+  availability_zone = "{{ $zone.name }}"
+  timeouts {
+    create = "5m"
+    delete = "5m"
+  }
+{{- if $.vpc.secondaryCidrBlocks }}
+
+  depends_on = [{{ range $i, $cidr := $.vpc.secondaryCidrBlocks }}{{ if $i }}, {{ end }}aws_vpc_ipv4_cidr_block_association.secondary_{{ $cidr | replace "." "_" | replace "/" "_" }}{{ end }}]
+{{- end }}
+
+{{ commonTagsWithSuffix $.clusterName (print "pods-z" $index) | indent 2 }}
+}
+
+output "{{ $.outputKeys.subnetsPodsPrefix }}{{ $index }}" {
+  value = aws_subnet.pods_z{{ $index }}.id
+}
+{{- end }}
+
 resource "aws_security_group_rule" "nodes_tcp_internal_z{{ $index }}" {
   type              = "ingress"
   from_port         = 30000
@@ -230,6 +332,12 @@ resource "aws_subnet" "public_utility_z{{ $index }}" {
 output "{{ $.outputKeys.subnetsPublicPrefix }}{{ $index }}" {
   value = aws_subnet.public_utility_z{{ $index }}.id
 }
+{{- if $.dualStack.enabled }}
+
+output "{{ $.outputKeys.subnetsPublicIPv6Prefix }}{{ $index }}" {
+  value = aws_subnet.public_utility_z{{ $index }}.ipv6_cidr_block
+}
+{{- end }}
 
 resource "aws_security_group_rule" "nodes_tcp_public_z{{ $index }}" {
   type              = "ingress"
@@ -249,7 +357,9 @@ resource "aws_security_group_rule" "nodes_udp_public_z{{ $index }}" {
   security_group_id = aws_security_group.nodes.id
 }
 
+{{- if $zone.createNATGateway }}
 {{- if not $zone.elasticIPAllocationID }}
+
 resource "aws_eip" "eip_natgw_z{{ $index }}" {
   vpc = true
 
@@ -273,6 +383,7 @@ resource "aws_nat_gateway" "natgw_z{{ $index }}" {
     "kubernetes.io/cluster/{{ $.clusterName }}"  = "1"
   }
 }
+{{- end }}
 
 resource "aws_route_table" "routetable_private_utility_z{{ $index }}" {
   vpc_id = {{ $.vpc.id }}
@@ -284,15 +395,30 @@ resource "aws_route_table" "routetable_private_utility_z{{ $index }}" {
 {{ commonTagsWithSuffix $.clusterName (print "private-" $zone.name) | indent 2 }}
 }
 
+{{- if $zone.natGateway }}
+
 resource "aws_route" "private_utility_z{{ $index }}_nat" {
   route_table_id         = aws_route_table.routetable_private_utility_z{{ $index }}.id
   destination_cidr_block = "0.0.0.0/0"
-  nat_gateway_id         = aws_nat_gateway.natgw_z{{ $index }}.id
+  nat_gateway_id         = aws_nat_gateway.{{ $zone.natGateway }}.id
 
   timeouts {
     create = "5m"
   }
 }
+{{- end }}
+{{- if $.dualStack.enabled }}
+
+resource "aws_route" "private_utility_z{{ $index }}_ipv6_egress" {
+  route_table_id              = aws_route_table.routetable_private_utility_z{{ $index }}.id
+  destination_ipv6_cidr_block = "::/0"
+  egress_only_gateway_id      = aws_egress_only_internet_gateway.egw.id
+
+  timeouts {
+    create = "5m"
+  }
+}
+{{- end }}
 
 resource "aws_route_table_association" "routetable_private_utility_z{{ $index }}_association_private_utility_z{{ $index }}" {
   subnet_id      = aws_subnet.private_utility_z{{ $index }}.id
@@ -308,6 +434,29 @@ resource "aws_route_table_association" "routetable_private_utility_z{{ $index }}
   subnet_id      = aws_subnet.nodes_z{{ $index }}.id
   route_table_id = aws_route_table.routetable_private_utility_z{{ $index }}.id
 }
+{{- if $zone.pods }}
+
+resource "aws_route_table_association" "routetable_private_utility_z{{ $index }}_association_pods_z{{ $index }}" {
+  subnet_id      = aws_subnet.pods_z{{ $index }}.id
+  route_table_id = aws_route_table.routetable_private_utility_z{{ $index }}.id
+}
+{{- end }}
+
+{{- if $.transitGateway.id }}
+{{ range $routeIndex, $route := $.transitGateway.routes }}
+resource "aws_route" "private_utility_z{{ $index }}_tgw_{{ $routeIndex }}" {
+  route_table_id         = aws_route_table.routetable_private_utility_z{{ $index }}.id
+  destination_cidr_block = "{{ $route }}"
+  transit_gateway_id     = "{{ $.transitGateway.id }}"
+
+  depends_on = [aws_ec2_transit_gateway_vpc_attachment.tgw_attachment]
+
+  timeouts {
+    create = "5m"
+  }
+}
+{{ end }}
+{{- end }}
 
 {{ range $ep := $.vpc.gatewayEndpoints }}
 resource "aws_vpc_endpoint_route_table_association" "vpc_gwep_{{ $ep }}_z{{ $index }}" {
@@ -318,9 +467,200 @@ resource "aws_vpc_endpoint_route_table_association" "vpc_gwep_{{ $ep }}_z{{ $ind
 
 {{end}}
 
+{{- if .transitGateway.id }}
+//=====================================================================
+//= Transit gateway attachment
+//=====================================================================
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "tgw_attachment" {
+  transit_gateway_id = "{{ .transitGateway.id }}"
+  vpc_id             = {{ .vpc.id }}
+  subnet_ids         = [{{ range $index, $zone := .zones }}{{ if $index }}, {{ end }}aws_subnet.nodes_z{{ $index }}.id{{ end }}]
+{{- if .dualStack.enabled }}
+  ipv6_support       = "enable"
+{{- end }}
+
+{{ commonTagsWithSuffix .clusterName "tgw-attachment" | indent 2 }}
+}
+
+output "{{ .outputKeys.transitGatewayAttachmentID }}" {
+  value = aws_ec2_transit_gateway_vpc_attachment.tgw_attachment.id
+}
+{{- end }}
+
+{{- if .vpc.interfaceEndpoints }}
+//=====================================================================
+//= Interface VPC endpoints
+//=====================================================================
+{{ range $ep := .vpc.interfaceEndpoints }}
+resource "aws_vpc_endpoint" "vpc_ifep_{{ $ep | replace "." "_" }}" {
+  vpc_id              = {{ $.vpc.id }}
+  service_name        = "{{ $.aws.vpcEndpointServicePrefix }}{{ $ep }}"
+  vpc_endpoint_type   = "Interface"
+  subnet_ids          = [{{ range $index, $zone := $.zones }}{{ if $index }}, {{ end }}aws_subnet.nodes_z{{ $index }}.id{{ end }}]
+  security_group_ids  = [aws_security_group.nodes.id]
+  private_dns_enabled = true
+
+{{ commonTagsWithSuffix $.clusterName (print "if-" $ep) | indent 2 }}
+}
+
+output "{{ $.outputKeys.vpcEndpointPrefix }}{{ $ep | replace "." "_" }}" {
+  value = aws_vpc_endpoint.vpc_ifep_{{ $ep | replace "." "_" }}.id
+}
+{{ end }}
+{{- end }}
+
+{{- if .vpcFlowLogs }}
+//=====================================================================
+//= VPC flow logs
+//=====================================================================
+
+{{ if .vpcFlowLogs.cloudWatchLogs -}}
+resource "aws_cloudwatch_log_group" "vpc_flow_logs" {
+  name = "{{ .clusterName }}-vpc-flow-logs"
+  {{- if .vpcFlowLogs.cloudWatchLogs.kmsKeyARN }}
+  kms_key_id = "{{ .vpcFlowLogs.cloudWatchLogs.kmsKeyARN }}"
+  {{- end }}
+
+{{ commonTagsWithSuffix .clusterName "vpc-flow-logs" | indent 2 }}
+}
+
+resource "aws_iam_role" "vpc_flow_logs" {
+  name = "{{ .clusterName }}-vpc-flow-logs"
+  path = "/"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "{{ .aws.flowLogsServicePrincipal }}"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "vpc_flow_logs" {
+  name = "{{ .clusterName }}-vpc-flow-logs"
+  role = aws_iam_role.vpc_flow_logs.id
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "logs:CreateLogStream",
+        "logs:PutLogEvents",
+        "logs:DescribeLogGroups",
+        "logs:DescribeLogStreams"
+      ],
+      "Resource": [
+        "${aws_cloudwatch_log_group.vpc_flow_logs.arn}",
+        "${aws_cloudwatch_log_group.vpc_flow_logs.arn}:*"
+      ]
+    }
+  ]
+}
+EOF
+}
+{{- end }}
+
+resource "aws_flow_log" "vpc_flow_logs" {
+  vpc_id                   = {{ .vpc.id }}
+  traffic_type             = "{{ .vpcFlowLogs.trafficType }}"
+  max_aggregation_interval = {{ .vpcFlowLogs.maxAggregationInterval }}
+  {{- if .vpcFlowLogs.cloudWatchLogs }}
+  log_destination_type     = "cloud-watch-logs"
+  log_destination          = aws_cloudwatch_log_group.vpc_flow_logs.arn
+  iam_role_arn             = aws_iam_role.vpc_flow_logs.arn
+  {{- else }}
+  log_destination_type     = "s3"
+  log_destination          = "{{ .vpcFlowLogs.s3.bucketARN }}"
+  {{- end }}
+
+{{ commonTagsWithSuffix .clusterName "vpc-flow-logs" | indent 2 }}
+}
+{{- end }}
+
+{{- if .elasticFileSystem.enabled }}
+//=====================================================================
+//= EFS file system
+//=====================================================================
+
+resource "aws_efs_file_system" "efs" {
+  creation_token = "{{ .clusterName }}"
+  encrypted      = true
+
+{{ commonTagsWithSuffix .clusterName "efs" | indent 2 }}
+}
+{{ range $index, $zone := .zones }}
+resource "aws_efs_mount_target" "efs_z{{ $index }}" {
+  file_system_id  = aws_efs_file_system.efs.id
+  subnet_id       = aws_subnet.nodes_z{{ $index }}.id
+  security_groups = [aws_security_group.nodes.id]
+}
+{{ end }}
+output "{{ .outputKeys.elasticFileSystemID }}" {
+  value = aws_efs_file_system.efs.id
+}
+{{- end }}
+
+{{- if .loadBalancerAccessLogs.enabled }}
+//=====================================================================
+//= S3 bucket for load balancer access logs
+//=====================================================================
+
+resource "aws_s3_bucket" "lb_access_logs" {
+  bucket        = "{{ .loadBalancerAccessLogs.bucketName }}"
+  force_destroy = true
+
+{{ commonTagsWithSuffix .clusterName "lb-access-logs" | indent 2 }}
+}
+
+resource "aws_s3_bucket_server_side_encryption_configuration" "lb_access_logs" {
+  bucket = aws_s3_bucket.lb_access_logs.id
+
+  rule {
+    apply_server_side_encryption_by_default {
+      sse_algorithm = "AES256"
+    }
+  }
+}
+
+resource "aws_s3_bucket_public_access_block" "lb_access_logs" {
+  bucket = aws_s3_bucket.lb_access_logs.id
+
+  block_public_acls       = true
+  block_public_policy     = true
+  ignore_public_acls      = true
+  restrict_public_buckets = true
+}
+
+resource "aws_s3_bucket_policy" "lb_access_logs" {
+  bucket = aws_s3_bucket.lb_access_logs.id
+  policy = <<EOF
+{{ .loadBalancerAccessLogs.bucketPolicy }}
+EOF
+
+  depends_on = [aws_s3_bucket_public_access_block.lb_access_logs]
+}
+
+output "{{ .outputKeys.loadBalancerAccessLogsBucketName }}" {
+  value = aws_s3_bucket.lb_access_logs.id
+}
+{{- end }}
+
 //=====================================================================
 //= IAM instance profiles
 //=====================================================================
+{{- if .createNodesIAM }}
 
 resource "aws_iam_role" "nodes" {
   name = "{{ .clusterName }}-nodes"
@@ -338,7 +678,7 @@ resource "aws_iam_role" "nodes" {
     {
       "Effect": "Allow",
       "Principal": {
-        "Service": "ec2.amazonaws.com"
+        "Service": "{{ .aws.ec2ServicePrincipal }}"
       },
       "Action": "sts:AssumeRole"
     }
@@ -388,6 +728,7 @@ resource "aws_iam_role_policy" "nodes" {
 }
 EOF
 }
+{{- end }}
 
 //=====================================================================
 //= EC2 Key Pair
@@ -407,10 +748,19 @@ resource "aws_key_pair" "kubernetes" {
 output "{{ .outputKeys.vpcIdKey }}" {
   value = {{ .vpc.id }}
 }
+{{- if .dualStack.enabled }}
+
+output "{{ .outputKeys.vpcIPv6CidrKey }}" {
+  value = {{ .vpc.ipv6CidrBlock }}
+}
+{{- end }}
+
+{{- if .createNodesIAM }}
 
 output "{{ .outputKeys.iamInstanceProfileNodes }}" {
   value = aws_iam_instance_profile.nodes.name
 }
+{{- end }}
 
 {{- if .sshPublicKey }}
 output "{{ .outputKeys.sshKeyName }}" {
@@ -422,6 +772,9 @@ output "{{ .outputKeys.securityGroupsNodes }}" {
   value = aws_security_group.nodes.id
 }
 
+{{- if .createNodesIAM }}
+
 output "{{ .outputKeys.nodesRole }}" {
   value = aws_iam_role.nodes.arn
 }
+{{- end }}
@@ -52,11 +52,31 @@ const (
 	variablesTF = `variable "ACCESS_KEY_ID" {
   description = "AWS Access Key ID of technical user"
   type        = string
+  default     = ""
 }
 
 variable "SECRET_ACCESS_KEY" {
   description = "AWS Secret Access Key of technical user"
   type        = string
+  default     = ""
+}
+
+variable "ROLE_ARN" {
+  description = "ARN of the IAM role assumed with the credentials of the technical user"
+  type        = string
+  default     = ""
+}
+
+variable "EXTERNAL_ID" {
+  description = "External ID required for assuming the IAM role"
+  type        = string
+  default     = ""
+}
+
+variable "WEB_IDENTITY_TOKEN" {
+  description = "Web identity token used for assuming the IAM role instead of the credentials of a technical user"
+  type        = string
+  default     = ""
 }`
 )
 
@@ -0,0 +1,75 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/gardener/gardener/extensions/pkg/terraformer"
+)
+
+// gzipEncoding denotes the encoding of terraform states which are gzip compressed and base64 encoded before they are
+// stored in the status of the Infrastructure.
+const gzipEncoding = "gzip"
+
+// marshalTerraformState marshals the given terraform state for the status of the Infrastructure. The state is
+// compressed if it is larger than the given threshold, so that large states still fit into the Infrastructure resource.
+func marshalTerraformState(state *terraformer.RawState, threshold int64) ([]byte, error) {
+	if state.Encoding != terraformer.NoneEncoding || int64(len(state.Data)) <= threshold {
+		return state.Marshal()
+	}
+
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write([]byte(state.Data)); err != nil {
+		return nil, fmt.Errorf("could not compress terraform state: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("could not compress terraform state: %w", err)
+	}
+	return json.Marshal(&terraformer.RawState{
+		Data:     base64.StdEncoding.EncodeToString(buf.Bytes()),
+		Encoding: gzipEncoding,
+	})
+}
+
+// unmarshalTerraformState unmarshals the terraform state of the status of the Infrastructure, which may be compressed.
+// Uncompressed states are decoded by the Terraformer library.
+func unmarshalTerraformState(raw []byte) (*terraformer.RawState, error) {
+	state := &terraformer.RawState{}
+	if len(raw) == 0 || json.Unmarshal(raw, state) != nil || state.Encoding != gzipEncoding {
+		return terraformer.UnmarshalRawState(raw)
+	}
+
+	compressed, err := base64.StdEncoding.DecodeString(state.Data)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode compressed terraform state: %w", err)
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, fmt.Errorf("could not decompress terraform state: %w", err)
+	}
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("could not decompress terraform state: %w", err)
+	}
+	return &terraformer.RawState{Data: string(data), Encoding: terraformer.NoneEncoding}, nil
+}
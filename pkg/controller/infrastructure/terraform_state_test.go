@@ -0,0 +1,68 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/gardener/gardener/extensions/pkg/terraformer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TerraformState", func() {
+	var (
+		data  string
+		state *terraformer.RawState
+	)
+
+	BeforeEach(func() {
+		data = `{"version":4,"resources":[` + strings.Repeat(`{"type":"aws_subnet","name":"nodes_z0"},`, 100) + `{}]}`
+		state = &terraformer.RawState{Data: data, Encoding: terraformer.NoneEncoding}
+	})
+
+	It("should store small states uncompressed", func() {
+		raw, err := marshalTerraformState(state, int64(len(data)))
+		Expect(err).NotTo(HaveOccurred())
+
+		stored := &terraformer.RawState{}
+		Expect(json.Unmarshal(raw, stored)).To(Succeed())
+		Expect(stored.Encoding).To(Equal(terraformer.Base64Encoding))
+
+		unmarshaled, err := unmarshalTerraformState(raw)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(unmarshaled.Data).To(Equal(data))
+	})
+
+	It("should compress large states", func() {
+		raw, err := marshalTerraformState(state, 1024)
+		Expect(err).NotTo(HaveOccurred())
+
+		stored := &terraformer.RawState{}
+		Expect(json.Unmarshal(raw, stored)).To(Succeed())
+		Expect(stored.Encoding).To(Equal(gzipEncoding))
+		Expect(len(raw)).To(BeNumerically("<", len(data)))
+
+		unmarshaled, err := unmarshalTerraformState(raw)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(unmarshaled).To(Equal(&terraformer.RawState{Data: data, Encoding: terraformer.NoneEncoding}))
+	})
+
+	It("should fail for corrupted compressed states", func() {
+		_, err := unmarshalTerraformState([]byte(`{"data":"bm90IGd6aXA=","encoding":"gzip"}`))
+		Expect(err).To(MatchError(ContainSubstring("could not decompress terraform state")))
+	})
+})
@@ -15,18 +15,42 @@
 package infrastructure
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/gardener/gardener/extensions/pkg/terraformer"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow/shared"
 )
 
+const (
+	// ConditionTypeFlowMigration is the type of the Infrastructure condition reporting the progress of the migration
+	// from the terraform state to the flow state.
+	ConditionTypeFlowMigration gardencorev1beta1.ConditionType = "FlowMigration"
+
+	// ReasonMigrationDryRunSucceeded is the reason of the FlowMigration condition if the dry run of the migration
+	// succeeded.
+	ReasonMigrationDryRunSucceeded = "MigrationDryRunSucceeded"
+	// ReasonMigrationDryRunFailed is the reason of the FlowMigration condition if the dry run of the migration failed.
+	ReasonMigrationDryRunFailed = "MigrationDryRunFailed"
+	// ReasonMigrationInProgress is the reason of the FlowMigration condition if the terraform state has been migrated
+	// but the infrastructure has not yet been reconciled successfully with flow.
+	ReasonMigrationInProgress = "MigrationInProgress"
+	// ReasonMigrationFailed is the reason of the FlowMigration condition if the migration of the terraform state failed.
+	ReasonMigrationFailed = "MigrationFailed"
+	// ReasonMigrationSucceeded is the reason of the FlowMigration condition if the infrastructure has been reconciled
+	// successfully with flow after the migration.
+	ReasonMigrationSucceeded = "MigrationSucceeded"
+)
+
 func migrateTerraformStateToFlowState(rawExtension *runtime.RawExtension, zones []awsapi.Zone) (*infraflow.PersistentState, error) {
 	var (
 		tfRawState *terraformer.RawState
@@ -61,6 +85,8 @@ func migrateTerraformStateToFlowState(rawExtension *runtime.RawExtension, zones
 		tfState.GetManagedResourceInstanceID("aws_default_security_group", "default"))
 	setFlowStateData(flowState, infraflow.IdentifierInternetGateway,
 		tfState.GetManagedResourceInstanceID("aws_internet_gateway", "igw"))
+	setFlowStateData(flowState, infraflow.IdentifierEgressOnlyInternetGateway,
+		tfState.GetManagedResourceInstanceID("aws_egress_only_internet_gateway", "egw"))
 	setFlowStateData(flowState, infraflow.IdentifierMainRouteTable,
 		tfState.GetManagedResourceInstanceID("aws_route_table", "public"))
 	setFlowStateData(flowState, infraflow.IdentifierNodesSecurityGroup,
@@ -68,13 +94,58 @@ func migrateTerraformStateToFlowState(rawExtension *runtime.RawExtension, zones
 
 	if instances := tfState.GetManagedResourceInstances("aws_vpc_endpoint"); len(instances) > 0 {
 		for name, id := range instances {
-			key := infraflow.ChildIdVPCEndpoints + shared.Separator + strings.TrimPrefix(name, "vpc_gwep_")
+			endpointName := strings.TrimPrefix(name, "vpc_gwep_")
+			if strings.HasPrefix(name, "vpc_ifep_") {
+				// resource names of interface endpoints have dots replaced, hence the name is taken from the service name
+				serviceName := tfState.GetManagedResourceInstanceAttribute("aws_vpc_endpoint", name, "service_name")
+				if serviceName == nil {
+					continue
+				}
+				// service names have the format com.amazonaws.<region>.<name>
+				parts := strings.SplitN(*serviceName, ".", 4)
+				if len(parts) != 4 {
+					continue
+				}
+				endpointName = parts[3]
+			}
+			key := infraflow.ChildIdVPCEndpoints + shared.Separator + endpointName
 			setFlowStateData(flowState, key, &id)
 		}
 	}
 
-	tfNamePrefixes := []string{"nodes_", "private_utility_", "public_utility"}
-	flowNames := []string{infraflow.IdentifierZoneSubnetWorkers, infraflow.IdentifierZoneSubnetPrivate, infraflow.IdentifierZoneSubnetPublic}
+	setFlowStateData(flowState, infraflow.IdentifierTransitGatewayAttachment,
+		tfState.GetManagedResourceInstanceID("aws_ec2_transit_gateway_vpc_attachment", "tgw_attachment"))
+	if instances := tfState.GetManagedResourceInstances("aws_route"); len(instances) > 0 {
+		tgwRoutes := sets.New[string]()
+		for name := range instances {
+			if !strings.Contains(name, "_tgw_") {
+				continue
+			}
+			if cidr := tfState.GetManagedResourceInstanceAttribute("aws_route", name, "destination_cidr_block"); cidr != nil {
+				tgwRoutes.Insert(*cidr)
+			}
+		}
+		if tgwRoutes.Len() > 0 {
+			routes := strings.Join(sets.List(tgwRoutes), ",")
+			setFlowStateData(flowState, infraflow.IdentifierTransitGatewayRoutes, &routes)
+		}
+	}
+
+	if instances := tfState.GetManagedResourceInstances("aws_vpc_ipv4_cidr_block_association"); len(instances) > 0 {
+		secondaryCidrBlocks := sets.New[string]()
+		for name := range instances {
+			if cidr := tfState.GetManagedResourceInstanceAttribute("aws_vpc_ipv4_cidr_block_association", name, "cidr_block"); cidr != nil {
+				secondaryCidrBlocks.Insert(*cidr)
+			}
+		}
+		if secondaryCidrBlocks.Len() > 0 {
+			value := strings.Join(sets.List(secondaryCidrBlocks), ",")
+			setFlowStateData(flowState, infraflow.IdentifierVpcSecondaryCidrBlocks, &value)
+		}
+	}
+
+	tfNamePrefixes := []string{"nodes_", "private_utility_", "public_utility_", "pods_"}
+	flowNames := []string{infraflow.IdentifierZoneSubnetWorkers, infraflow.IdentifierZoneSubnetPrivate, infraflow.IdentifierZoneSubnetPublic, infraflow.IdentifierZoneSubnetPods}
 	for i, zone := range zones {
 		keyPrefix := infraflow.ChildIdZones + shared.Separator + zone.Name + shared.Separator
 		suffix := fmt.Sprintf("z%d", i)
@@ -88,8 +159,8 @@ func migrateTerraformStateToFlowState(rawExtension *runtime.RawExtension, zones
 			tfState.GetManagedResourceInstanceID("aws_eip", "eip_natgw_"+suffix))
 		setFlowStateData(flowState, keyPrefix+infraflow.IdentifierZoneNATGateway,
 			tfState.GetManagedResourceInstanceID("aws_nat_gateway", "natgw_"+suffix))
-		setFlowStateData(flowState, keyPrefix+infraflow.IdentifierZoneNATGateway,
-			tfState.GetManagedResourceInstanceID("aws_route_table", "private_utility_"+suffix))
+		setFlowStateData(flowState, keyPrefix+infraflow.IdentifierZoneRouteTable,
+			tfState.GetManagedResourceInstanceID("aws_route_table", "routetable_private_utility_"+suffix))
 
 		setFlowStateData(flowState, keyPrefix+infraflow.IdentifierZoneSubnetPublicRouteTableAssoc,
 			tfState.GetManagedResourceInstanceID("aws_route_table_association", "routetable_main_association_public_utility_"+suffix))
@@ -97,6 +168,8 @@ func migrateTerraformStateToFlowState(rawExtension *runtime.RawExtension, zones
 			tfState.GetManagedResourceInstanceID("aws_route_table_association", "routetable_private_utility_"+suffix+"_association_private_utility_"+suffix))
 		setFlowStateData(flowState, keyPrefix+infraflow.IdentifierZoneSubnetWorkersRouteTableAssoc,
 			tfState.GetManagedResourceInstanceID("aws_route_table_association", "routetable_private_utility_"+suffix+"_association_nodes_"+suffix))
+		setFlowStateData(flowState, keyPrefix+infraflow.IdentifierZoneSubnetPodsRouteTableAssoc,
+			tfState.GetManagedResourceInstanceID("aws_route_table_association", "routetable_private_utility_"+suffix+"_association_pods_"+suffix))
 	}
 
 	setFlowStateData(flowState, infraflow.NameIAMRole,
@@ -108,6 +181,20 @@ func migrateTerraformStateToFlowState(rawExtension *runtime.RawExtension, zones
 	setFlowStateData(flowState, infraflow.ARNIAMRole,
 		tfState.GetManagedResourceInstanceAttribute("aws_iam_role", "nodes", "arn"))
 
+	setFlowStateData(flowState, infraflow.IdentifierVPCFlowLog,
+		tfState.GetManagedResourceInstanceID("aws_flow_log", "vpc_flow_logs"))
+	setFlowStateData(flowState, infraflow.NameVPCFlowLogsLogGroup,
+		tfState.GetManagedResourceInstanceName("aws_cloudwatch_log_group", "vpc_flow_logs"))
+	setFlowStateData(flowState, infraflow.NameVPCFlowLogsIAMRole,
+		tfState.GetManagedResourceInstanceName("aws_iam_role", "vpc_flow_logs"))
+	setFlowStateData(flowState, infraflow.NameVPCFlowLogsIAMRolePolicy,
+		tfState.GetManagedResourceInstanceName("aws_iam_role_policy", "vpc_flow_logs"))
+
+	setFlowStateData(flowState, infraflow.IdentifierElasticFileSystem,
+		tfState.GetManagedResourceInstanceID("aws_efs_file_system", "efs"))
+	setFlowStateData(flowState, infraflow.IdentifierLoadBalancerAccessLogsBucket,
+		tfState.GetManagedResourceInstanceID("aws_s3_bucket", "lb_access_logs"))
+
 	setFlowStateData(flowState, infraflow.NameKeyPair,
 		tfState.GetManagedResourceInstanceAttribute("aws_key_pair", "nodes", "key_pair_id"))
 
@@ -128,9 +215,76 @@ func getTerraformerRawState(state *runtime.RawExtension) (*terraformer.RawState,
 	if state == nil {
 		return nil, nil
 	}
-	tfRawState, err := terraformer.UnmarshalRawState(state)
+	tfRawState, err := unmarshalTerraformState(state.Raw)
 	if err != nil {
 		return nil, fmt.Errorf("could not decode terraform raw state: %+v", err)
 	}
 	return tfRawState, nil
 }
+
+// findMissingMigratedResources resolves the IDs of the VPC, the nodes security group, the subnets and the NAT gateways
+// of the given migrated flow state and returns the ones which don't exist (anymore).
+func findMissingMigratedResources(ctx context.Context, awsClient awsclient.Interface, state *infraflow.PersistentState) ([]string, error) {
+	var (
+		missing       []string
+		subnetIDs     = sets.New[string]()
+		natGatewayIDs = sets.New[string]()
+		zonePrefix    = infraflow.ChildIdZones + shared.Separator
+	)
+
+	for key, id := range state.Data {
+		if !strings.HasPrefix(key, zonePrefix) || !shared.IsValidValue(id) {
+			continue
+		}
+		switch key[strings.LastIndex(key, shared.Separator)+1:] {
+		case infraflow.IdentifierZoneSubnetWorkers, infraflow.IdentifierZoneSubnetPublic, infraflow.IdentifierZoneSubnetPrivate, infraflow.IdentifierZoneSubnetPods:
+			subnetIDs.Insert(id)
+		case infraflow.IdentifierZoneNATGateway:
+			natGatewayIDs.Insert(id)
+		}
+	}
+
+	if id := state.Data[infraflow.IdentifierVPC]; shared.IsValidValue(id) {
+		vpc, err := awsClient.GetVpc(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if vpc == nil {
+			missing = append(missing, "VPC "+id)
+		} else {
+			// The subnets are listed by VPC as describing them by ID fails if any of them doesn't exist.
+			subnets, err := awsClient.FindSubnetsByVpcId(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			for _, subnet := range subnets {
+				subnetIDs.Delete(subnet.SubnetId)
+			}
+		}
+		for _, id := range sets.List(subnetIDs) {
+			missing = append(missing, "subnet "+id)
+		}
+	}
+
+	if id := state.Data[infraflow.IdentifierNodesSecurityGroup]; shared.IsValidValue(id) {
+		group, err := awsClient.GetSecurityGroup(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if group == nil {
+			missing = append(missing, "security group "+id)
+		}
+	}
+
+	for _, id := range sets.List(natGatewayIDs) {
+		natGateway, err := awsClient.GetNATGateway(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if natGateway == nil {
+			missing = append(missing, "NAT gateway "+id)
+		}
+	}
+
+	return missing, nil
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/gardener/gardener/extensions/pkg/terraformer"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	mockawsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/mock"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow/shared"
+)
+
+var _ = Describe("TerraformMigration", func() {
+	zoneKey := func(zone, identifier string) string {
+		return infraflow.ChildIdZones + shared.Separator + zone + shared.Separator + identifier
+	}
+
+	Describe("#migrateTerraformStateToFlowState", func() {
+		It("should migrate the subnets of the zones", func() {
+			flowState, err := migrateTerraformStateToFlowState(newTerraformRawState(map[string]string{
+				"aws_subnet.nodes_z0":           "subnet-nodes",
+				"aws_subnet.private_utility_z0": "subnet-private",
+				"aws_subnet.public_utility_z0":  "subnet-public",
+				"aws_subnet.pods_z0":            "subnet-pods",
+			}), []awsapi.Zone{{Name: "zone-a"}})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(flowState.Data).To(HaveKeyWithValue(zoneKey("zone-a", infraflow.IdentifierZoneSubnetWorkers), "subnet-nodes"))
+			Expect(flowState.Data).To(HaveKeyWithValue(zoneKey("zone-a", infraflow.IdentifierZoneSubnetPrivate), "subnet-private"))
+			// The terraform resource of the public subnet is named like the other subnets, i.e. with a separator
+			// before the zone suffix.
+			Expect(flowState.Data).To(HaveKeyWithValue(zoneKey("zone-a", infraflow.IdentifierZoneSubnetPublic), "subnet-public"))
+			Expect(flowState.Data).To(HaveKeyWithValue(zoneKey("zone-a", infraflow.IdentifierZoneSubnetPods), "subnet-pods"))
+		})
+
+		It("should migrate the NAT gateways and route tables of the zones", func() {
+			flowState, err := migrateTerraformStateToFlowState(newTerraformRawState(map[string]string{
+				"aws_eip.eip_natgw_z0":                          "eipalloc-1",
+				"aws_nat_gateway.natgw_z0":                      "nat-1",
+				"aws_route_table.routetable_private_utility_z0": "rtb-1",
+			}), []awsapi.Zone{{Name: "zone-a"}})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(flowState.Data).To(HaveKeyWithValue(zoneKey("zone-a", infraflow.IdentifierZoneNATGWElasticIP), "eipalloc-1"))
+			// The route table must neither overwrite the NAT gateway nor be dropped.
+			Expect(flowState.Data).To(HaveKeyWithValue(zoneKey("zone-a", infraflow.IdentifierZoneNATGateway), "nat-1"))
+			Expect(flowState.Data).To(HaveKeyWithValue(zoneKey("zone-a", infraflow.IdentifierZoneRouteTable), "rtb-1"))
+		})
+	})
+
+	Describe("#checkFlowMigration", func() {
+		var (
+			ctx = context.TODO()
+
+			ctrl      *gomock.Controller
+			awsClient *mockawsclient.MockInterface
+			tfState   *runtime.RawExtension
+			zones     = []awsapi.Zone{{Name: "zone-a"}}
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			awsClient = mockawsclient.NewMockInterface(ctrl)
+
+			tfState = newTerraformRawState(map[string]string{
+				"aws_subnet.nodes_z0": "subnet-1",
+			})
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should report that the terraform state can be migrated", func() {
+			awsClient.EXPECT().GetVpc(ctx, "vpc-1").Return(&awsclient.VPC{VpcId: "vpc-1"}, nil)
+			awsClient.EXPECT().FindSubnetsByVpcId(ctx, "vpc-1").Return([]*awsclient.Subnet{{SubnetId: "subnet-1"}}, nil)
+
+			status, reason, message := checkFlowMigration(ctx, awsClient, tfState, zones)
+			Expect(status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(reason).To(Equal(ReasonMigrationDryRunSucceeded))
+			Expect(message).To(Equal("Terraform state with 4 resources can be migrated."))
+		})
+
+		It("should report the migrated resources which don't exist", func() {
+			awsClient.EXPECT().GetVpc(ctx, "vpc-1").Return(&awsclient.VPC{VpcId: "vpc-1"}, nil)
+			awsClient.EXPECT().FindSubnetsByVpcId(ctx, "vpc-1").Return(nil, nil)
+
+			status, reason, message := checkFlowMigration(ctx, awsClient, tfState, zones)
+			Expect(status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(reason).To(Equal(ReasonMigrationDryRunFailed))
+			Expect(message).To(Equal("Terraform state with 4 resources can be migrated, but the following resources don't exist: subnet subnet-1."))
+		})
+
+		It("should report that the migrated resources could not be resolved", func() {
+			awsClient.EXPECT().GetVpc(ctx, "vpc-1").Return(nil, errors.New("test"))
+
+			status, reason, message := checkFlowMigration(ctx, awsClient, tfState, zones)
+			Expect(status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(reason).To(Equal(ReasonMigrationDryRunFailed))
+			Expect(message).To(Equal("could not resolve migrated resources: test"))
+		})
+
+		It("should report that the terraform state cannot be migrated", func() {
+			status, reason, message := checkFlowMigration(ctx, awsClient, &runtime.RawExtension{Raw: []byte("{")}, zones)
+			Expect(status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(reason).To(Equal(ReasonMigrationDryRunFailed))
+			Expect(message).To(HavePrefix("migration from terraform state failed: "))
+		})
+	})
+
+	Describe("#findMissingMigratedResources", func() {
+		var (
+			ctx = context.TODO()
+
+			ctrl      *gomock.Controller
+			awsClient *mockawsclient.MockInterface
+			state     *infraflow.PersistentState
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			awsClient = mockawsclient.NewMockInterface(ctrl)
+
+			state = infraflow.NewPersistentState()
+			state.Data[infraflow.IdentifierVPC] = "vpc-1"
+			state.Data[infraflow.IdentifierNodesSecurityGroup] = "sg-1"
+			state.Data[zoneKey("zone-a", infraflow.IdentifierZoneSubnetWorkers)] = "subnet-1"
+			state.Data[zoneKey("zone-a", infraflow.IdentifierZoneSubnetPublic)] = "subnet-2"
+			state.Data[zoneKey("zone-a", infraflow.IdentifierZoneNATGateway)] = "nat-1"
+			state.Data[zoneKey("zone-a", infraflow.IdentifierZoneRouteTable)] = "rtb-1"
+		})
+
+		It("should return nothing if all resources exist", func() {
+			awsClient.EXPECT().GetVpc(ctx, "vpc-1").Return(&awsclient.VPC{VpcId: "vpc-1"}, nil)
+			awsClient.EXPECT().FindSubnetsByVpcId(ctx, "vpc-1").Return([]*awsclient.Subnet{{SubnetId: "subnet-1"}, {SubnetId: "subnet-2"}, {SubnetId: "subnet-3"}}, nil)
+			awsClient.EXPECT().GetSecurityGroup(ctx, "sg-1").Return(&awsclient.SecurityGroup{GroupId: "sg-1"}, nil)
+			awsClient.EXPECT().GetNATGateway(ctx, "nat-1").Return(&awsclient.NATGateway{NATGatewayId: "nat-1"}, nil)
+
+			Expect(findMissingMigratedResources(ctx, awsClient, state)).To(BeEmpty())
+		})
+
+		It("should return the resources which don't exist", func() {
+			awsClient.EXPECT().GetVpc(ctx, "vpc-1").Return(&awsclient.VPC{VpcId: "vpc-1"}, nil)
+			awsClient.EXPECT().FindSubnetsByVpcId(ctx, "vpc-1").Return([]*awsclient.Subnet{{SubnetId: "subnet-1"}}, nil)
+			awsClient.EXPECT().GetSecurityGroup(ctx, "sg-1").Return(nil, nil)
+			awsClient.EXPECT().GetNATGateway(ctx, "nat-1").Return(nil, nil)
+
+			Expect(findMissingMigratedResources(ctx, awsClient, state)).To(ConsistOf("subnet subnet-2", "security group sg-1", "NAT gateway nat-1"))
+		})
+
+		It("should return all subnets if the VPC doesn't exist", func() {
+			delete(state.Data, infraflow.IdentifierNodesSecurityGroup)
+			delete(state.Data, zoneKey("zone-a", infraflow.IdentifierZoneNATGateway))
+			awsClient.EXPECT().GetVpc(ctx, "vpc-1").Return(nil, nil)
+
+			Expect(findMissingMigratedResources(ctx, awsClient, state)).To(ConsistOf("VPC vpc-1", "subnet subnet-1", "subnet subnet-2"))
+		})
+	})
+})
+
+// newTerraformRawState returns the raw terraformer state containing the given resources, which are keyed by their type
+// and name (e.g. `aws_subnet.nodes_z0`) and map to their IDs.
+func newTerraformRawState(resources map[string]string) *runtime.RawExtension {
+	state := &shared.TerraformState{
+		Version: 4,
+		Outputs: map[string]shared.TFOutput{"vpc_id": {Value: "vpc-1", Type: "string"}},
+	}
+	for key, id := range resources {
+		tfType, name, _ := strings.Cut(key, ".")
+		state.Resources = append(state.Resources, shared.TFResource{
+			Mode:      shared.ModeManaged,
+			Type:      tfType,
+			Name:      name,
+			Instances: []shared.TFInstance{{Attributes: map[string]interface{}{shared.AttributeKeyId: id}}},
+		})
+	}
+	data, err := json.Marshal(state)
+	Expect(err).NotTo(HaveOccurred())
+	raw, err := (&terraformer.RawState{Data: string(data), Encoding: terraformer.NoneEncoding}).Marshal()
+	Expect(err).NotTo(HaveOccurred())
+	return &runtime.RawExtension{Raw: raw}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package leakdetection
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	orphanedResources = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "aws_orphaned_resources",
+		Help: "Number of orphaned AWS resources of a shoot found by the last check.",
+	}, []string{"namespace", "type"})
+	orphanedResourcesDeleted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "aws_orphaned_resources_deleted_total",
+		Help: "Number of orphaned AWS resources which were deleted.",
+	}, []string{"type"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(orphanedResources, orphanedResourcesDeleted)
+}
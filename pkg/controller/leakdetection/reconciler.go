@@ -0,0 +1,495 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package leakdetection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	extensionsconfig "github.com/gardener/gardener/extensions/pkg/apis/config"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/util"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/controller/infrastructure/infraflow"
+)
+
+const (
+	// ConditionTypeOrphanedResources is the type of the condition of the Infrastructure which reports orphaned AWS
+	// resources of the shoot.
+	ConditionTypeOrphanedResources gardencorev1beta1.ConditionType = "OrphanedResources"
+
+	// ReasonOrphanedResourcesFound is the reason of the condition if orphaned resources were found.
+	ReasonOrphanedResourcesFound = "OrphanedResourcesFound"
+	// ReasonNoOrphanedResources is the reason of the condition if no orphaned resources were found.
+	ReasonNoOrphanedResources = "NoOrphanedResources"
+	// ReasonDetectionFailed is the reason of the condition if the AWS resources could not be checked.
+	ReasonDetectionFailed = "DetectionFailed"
+
+	// ResourceTypeInstance is the type of orphaned EC2 instances.
+	ResourceTypeInstance = "instance"
+	// ResourceTypeElasticIP is the type of orphaned elastic IPs.
+	ResourceTypeElasticIP = "elastic-ip"
+	// ResourceTypeVolume is the type of orphaned EBS volumes.
+	ResourceTypeVolume = "volume"
+	// ResourceTypeLoadBalancer is the type of orphaned (classic, network or application) load balancers.
+	ResourceTypeLoadBalancer = "load-balancer"
+
+	// gracePeriod is the minimum age of instances and volumes before they are considered as orphans, as the machine
+	// and the persistent volume are only updated after they have been created. Elastic IPs are only considered as
+	// orphans if they have not been associated for this period.
+	gracePeriod = 30 * time.Minute
+	// karpenterManagedByTag is the key of the tag which Karpenter adds to all of its instances.
+	karpenterManagedByTag = "karpenter.sh/managed-by"
+	// maxReportedResources is the maximum number of orphaned resources listed in the message of the condition.
+	maxReportedResources = 10
+)
+
+var resourceTypes = []string{ResourceTypeInstance, ResourceTypeElasticIP, ResourceTypeVolume, ResourceTypeLoadBalancer}
+
+// orphan is an AWS resource of a shoot which is not expected by the Infrastructure, the Worker or the shoot.
+type orphan struct {
+	resourceType string
+	id           string
+}
+
+func (o orphan) String() string {
+	return o.resourceType + "/" + o.id
+}
+
+// reconciler periodically checks the AWS resources tagged with the cluster tag of a shoot for orphans, i.e. resources
+// which were left behind by failed deletions. The result is reported in a condition of the Infrastructure and in
+// metrics. Optionally, orphaned instances, elastic IPs and volumes are deleted. Orphaned load balancers are only
+// reported, as their security groups and target groups are managed by the cloud-controller-manager.
+type reconciler struct {
+	client           client.Client
+	awsClientFactory awsclient.Factory
+	newShootClient   func(ctx context.Context, c client.Client, namespace string) (client.Client, error)
+	clock            clock.Clock
+
+	interval      time.Duration
+	deleteOrphans bool
+
+	lock sync.Mutex
+	// unassociatedElasticIPs contains the time when the unassociated elastic IPs were seen first by namespace and
+	// allocation ID.
+	unassociatedElasticIPs map[string]map[string]time.Time
+}
+
+// Reconcile checks the AWS resources of the shoot of the given Infrastructure for orphans.
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	infra := &extensionsv1alpha1.Infrastructure{}
+	if err := r.client.Get(ctx, request.NamespacedName, infra); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			orphanedResources.DeletePartialMatch(map[string]string{"namespace": request.Namespace})
+			r.forgetElasticIPs(request.Namespace)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if infra.DeletionTimestamp != nil {
+		orphanedResources.DeletePartialMatch(map[string]string{"namespace": infra.Namespace})
+		r.forgetElasticIPs(infra.Namespace)
+		return reconcile.Result{}, nil
+	}
+	// Resources are expected to be missing or superfluous while the Infrastructure is reconciled.
+	if lastOp := infra.Status.LastOperation; lastOp == nil || lastOp.State != gardencorev1beta1.LastOperationStateSucceeded ||
+		lastOp.Type == gardencorev1beta1.LastOperationTypeDelete {
+		return reconcile.Result{RequeueAfter: r.interval}, nil
+	}
+
+	awsClient, err := r.newAWSClient(ctx, infra)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	orphans, err := r.detectOrphans(ctx, log, awsClient, infra)
+	if err != nil {
+		if updateErr := r.updateCondition(ctx, infra, gardencorev1beta1.ConditionUnknown, ReasonDetectionFailed, err.Error()); updateErr != nil {
+			err = errors.Join(err, updateErr)
+		}
+		return reconcile.Result{}, fmt.Errorf("could not detect orphaned resources: %w", err)
+	}
+
+	if r.deleteOrphans && len(orphans) > 0 {
+		if orphans, err = r.deleteOrphanedResources(ctx, log, awsClient, orphans); err != nil {
+			log.Error(err, "Could not delete all orphaned resources")
+		}
+	}
+
+	counts := map[string]int{}
+	for _, o := range orphans {
+		counts[o.resourceType]++
+	}
+	for _, resourceType := range resourceTypes {
+		orphanedResources.WithLabelValues(infra.Namespace, resourceType).Set(float64(counts[resourceType]))
+	}
+
+	if len(orphans) == 0 {
+		return reconcile.Result{RequeueAfter: r.interval}, r.updateCondition(ctx, infra, gardencorev1beta1.ConditionFalse, ReasonNoOrphanedResources, "No orphaned resources found.")
+	}
+
+	log.Info("Found orphaned resources", "count", len(orphans))
+	return reconcile.Result{RequeueAfter: r.interval}, r.updateCondition(ctx, infra, gardencorev1beta1.ConditionTrue, ReasonOrphanedResourcesFound, orphansMessage(orphans))
+}
+
+func (r *reconciler) detectOrphans(ctx context.Context, log logr.Logger, awsClient awsclient.Interface, infra *extensionsv1alpha1.Infrastructure) ([]orphan, error) {
+	var (
+		clusterTag = fmt.Sprintf("kubernetes.io/cluster/%s", infra.Namespace)
+		orphans    []orphan
+	)
+
+	instances, err := awsClient.FindInstancesByTags(ctx, awsclient.Tags{clusterTag: "1", "kubernetes.io/role/node": "1"})
+	if err != nil {
+		return nil, fmt.Errorf("could not list instances: %w", err)
+	}
+	machines := &machinev1alpha1.MachineList{}
+	if err := r.client.List(ctx, machines, client.InNamespace(infra.Namespace)); err != nil {
+		return nil, fmt.Errorf("could not list machines: %w", err)
+	}
+	instanceIDs := sets.New[string]()
+	for _, machine := range machines.Items {
+		if machine.Spec.ProviderID != "" {
+			instanceIDs.Insert(lastPathElement(machine.Spec.ProviderID))
+		}
+	}
+	for _, instance := range instances {
+		// The instances launched by Karpenter don't belong to machines, they are removed by Karpenter itself.
+		if _, ok := instance.Tags[karpenterManagedByTag]; ok {
+			continue
+		}
+		if !instanceIDs.Has(instance.InstanceId) && r.isOlderThanGracePeriod(instance.LaunchTime) {
+			orphans = append(orphans, orphan{resourceType: ResourceTypeInstance, id: instance.InstanceId})
+		}
+	}
+
+	elasticIPs, err := awsClient.FindElasticIPsByTags(ctx, awsclient.Tags{clusterTag: "1"})
+	if err != nil {
+		return nil, fmt.Errorf("could not list elastic IPs: %w", err)
+	}
+	trackedAllocationIDs, err := trackedElasticIPAllocationIDs(infra)
+	if err != nil {
+		return nil, err
+	}
+	var unassociatedAllocationIDs []string
+	for _, eip := range elasticIPs {
+		// The elastic IPs of the pool and the ones tracked by the Infrastructure may be unassociated while they are
+		// expected, e.g. if they are not used by a NAT gateway or while a NAT gateway is recreated.
+		if _, ok := eip.Tags[infraflow.TagKeyElasticIPPool]; ok || trackedAllocationIDs.Has(eip.AllocationId) {
+			continue
+		}
+		if eip.AssociationId == nil {
+			unassociatedAllocationIDs = append(unassociatedAllocationIDs, eip.AllocationId)
+		}
+	}
+	for allocationID, since := range r.elasticIPsUnassociatedSince(infra.Namespace, unassociatedAllocationIDs) {
+		if r.isOlderThanGracePeriod(&since) {
+			orphans = append(orphans, orphan{resourceType: ResourceTypeElasticIP, id: allocationID})
+		}
+	}
+
+	// Load balancers and volumes are created for services and persistent volumes of the shoot, hence they can only be
+	// checked if the shoot can be reached.
+	cluster, err := extensionscontroller.GetCluster(ctx, r.client, infra.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("could not get cluster: %w", err)
+	}
+	if extensionscontroller.IsHibernationEnabled(cluster) || cluster.Shoot.Status.IsHibernated {
+		return orphans, nil
+	}
+	shootClient, err := r.newShootClient(ctx, r.client, infra.Namespace)
+	if err != nil {
+		log.Info("Skipping check of load balancers and volumes as shoot client could not be created", "error", err.Error())
+		return orphans, nil
+	}
+
+	loadBalancerOrphans, err := r.detectOrphanedLoadBalancers(ctx, awsClient, shootClient, infra)
+	if err != nil {
+		return nil, err
+	}
+	orphans = append(orphans, loadBalancerOrphans...)
+
+	volumes, err := awsClient.FindVolumesByTags(ctx, awsclient.Tags{clusterTag: "owned"})
+	if err != nil {
+		return nil, fmt.Errorf("could not list volumes: %w", err)
+	}
+	persistentVolumes := &corev1.PersistentVolumeList{}
+	if err := shootClient.List(ctx, persistentVolumes); err != nil {
+		return nil, fmt.Errorf("could not list persistent volumes of shoot: %w", err)
+	}
+	volumeIDs := sets.New[string]()
+	for _, pv := range persistentVolumes.Items {
+		if pv.Spec.CSI != nil {
+			volumeIDs.Insert(pv.Spec.CSI.VolumeHandle)
+		}
+		if pv.Spec.AWSElasticBlockStore != nil {
+			volumeIDs.Insert(lastPathElement(pv.Spec.AWSElasticBlockStore.VolumeID))
+		}
+	}
+	for _, volume := range volumes {
+		if volume.State == "available" && !volumeIDs.Has(volume.VolumeId) && r.isOlderThanGracePeriod(volume.CreateTime) {
+			orphans = append(orphans, orphan{resourceType: ResourceTypeVolume, id: volume.VolumeId})
+		}
+	}
+
+	return orphans, nil
+}
+
+func (r *reconciler) detectOrphanedLoadBalancers(ctx context.Context, awsClient awsclient.Interface, shootClient client.Client, infra *extensionsv1alpha1.Infrastructure) ([]orphan, error) {
+	infrastructureStatus, err := helper.InfrastructureStatusFromInfrastructure(infra)
+	if err != nil {
+		return nil, err
+	}
+	vpcID := infrastructureStatus.VPC.ID
+	if vpcID == "" {
+		return nil, nil
+	}
+
+	services := &corev1.ServiceList{}
+	if err := shootClient.List(ctx, services); err != nil {
+		return nil, fmt.Errorf("could not list services of shoot: %w", err)
+	}
+	var hostnames []string
+	for _, service := range services.Items {
+		if service.Spec.Type != corev1.ServiceTypeLoadBalancer {
+			continue
+		}
+		for _, ingress := range service.Status.LoadBalancer.Ingress {
+			hostnames = append(hostnames, strings.TrimPrefix(ingress.Hostname, "internal-"))
+		}
+	}
+	// The DNS name of a load balancer starts with its name followed by a hyphen.
+	isUsed := func(name string) bool {
+		return slices.ContainsFunc(hostnames, func(hostname string) bool {
+			return strings.HasPrefix(hostname, name+"-")
+		})
+	}
+
+	var orphans []orphan
+	loadBalancerNames, err := awsClient.ListKubernetesELBs(ctx, vpcID, infra.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("could not list classic load balancers: %w", err)
+	}
+	for _, name := range loadBalancerNames {
+		if !isUsed(name) {
+			orphans = append(orphans, orphan{resourceType: ResourceTypeLoadBalancer, id: name})
+		}
+	}
+
+	loadBalancerARNs, err := awsClient.ListKubernetesELBsV2(ctx, vpcID, infra.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("could not list load balancers: %w", err)
+	}
+	for _, arn := range loadBalancerARNs {
+		// The ARN has the format arn:<partition>:elasticloadbalancing:<region>:<account>:loadbalancer/<type>/<name>/<id>.
+		parts := strings.Split(arn, "/")
+		if len(parts) != 4 || !isUsed(parts[2]) {
+			orphans = append(orphans, orphan{resourceType: ResourceTypeLoadBalancer, id: arn})
+		}
+	}
+
+	return orphans, nil
+}
+
+// deleteOrphanedResources deletes the given orphaned instances, elastic IPs and volumes. It returns the orphaned
+// resources which are left.
+func (r *reconciler) deleteOrphanedResources(ctx context.Context, log logr.Logger, awsClient awsclient.Interface, orphans []orphan) ([]orphan, error) {
+	var (
+		remaining []orphan
+		errs      []error
+	)
+	for _, o := range orphans {
+		var err error
+		switch o.resourceType {
+		case ResourceTypeInstance:
+			err = awsClient.TerminateInstance(ctx, o.id)
+		case ResourceTypeElasticIP:
+			err = awsClient.DeleteElasticIP(ctx, o.id)
+		case ResourceTypeVolume:
+			err = awsClient.DeleteVolume(ctx, o.id)
+		default:
+			remaining = append(remaining, o)
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("could not delete %s: %w", o, err))
+			remaining = append(remaining, o)
+			continue
+		}
+		log.Info("Deleted orphaned resource", "resource", o.String())
+		orphanedResourcesDeleted.WithLabelValues(o.resourceType).Inc()
+	}
+	return remaining, errors.Join(errs...)
+}
+
+func (r *reconciler) newAWSClient(ctx context.Context, infra *extensionsv1alpha1.Infrastructure) (awsclient.Interface, error) {
+	credentials, err := aws.GetCredentialsFromSecretRef(ctx, r.client, infra.Spec.SecretRef, false)
+	if err != nil {
+		return nil, fmt.Errorf("could not get AWS credentials: %w", err)
+	}
+	infrastructureConfig, err := helper.InfrastructureConfigFromInfrastructure(infra)
+	if err != nil {
+		return nil, err
+	}
+
+	authConfig := aws.NewAuthConfig(credentials, infra.Spec.Region)
+	authConfig.Shoot = infra.Namespace
+	aws.ApplyEndpoints(&authConfig, infrastructureConfig.Endpoints)
+	return r.awsClientFactory.NewClient(authConfig)
+}
+
+// elasticIPsUnassociatedSince returns when the given unassociated elastic IPs of the shoot in the given namespace were
+// seen first. Elastic IPs have no creation time, hence the grace period starts with the first check which finds them
+// unassociated, e.g. while the NAT gateway using them is created.
+func (r *reconciler) elasticIPsUnassociatedSince(namespace string, allocationIDs []string) map[string]time.Time {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.unassociatedElasticIPs == nil {
+		r.unassociatedElasticIPs = map[string]map[string]time.Time{}
+	}
+	seen := r.unassociatedElasticIPs[namespace]
+	since := make(map[string]time.Time, len(allocationIDs))
+	for _, allocationID := range allocationIDs {
+		t, ok := seen[allocationID]
+		if !ok {
+			t = r.clock.Now()
+		}
+		since[allocationID] = t
+	}
+	r.unassociatedElasticIPs[namespace] = since
+	return since
+}
+
+func (r *reconciler) forgetElasticIPs(namespace string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.unassociatedElasticIPs, namespace)
+}
+
+// trackedElasticIPAllocationIDs returns the allocation IDs of the elastic IPs which are configured or created for the
+// given Infrastructure according to its configuration, status and state.
+func trackedElasticIPAllocationIDs(infra *extensionsv1alpha1.Infrastructure) (sets.Set[string], error) {
+	allocationIDs := sets.New[string]()
+
+	infrastructureConfig, err := helper.InfrastructureConfigFromInfrastructure(infra)
+	if err != nil {
+		return nil, err
+	}
+	for _, zone := range infrastructureConfig.Networks.Zones {
+		if zone.ElasticIPAllocationID != nil {
+			allocationIDs.Insert(*zone.ElasticIPAllocationID)
+		}
+	}
+
+	infrastructureStatus, err := helper.InfrastructureStatusFromInfrastructure(infra)
+	if err != nil {
+		return nil, err
+	}
+	for _, address := range infrastructureStatus.VPC.ElasticIPPool {
+		allocationIDs.Insert(address.AllocationID)
+	}
+	for _, natGateway := range infrastructureStatus.VPC.NATGateways {
+		if natGateway.ElasticIPAllocationID != nil {
+			allocationIDs.Insert(*natGateway.ElasticIPAllocationID)
+		}
+	}
+
+	if infra.Status.State != nil {
+		state, err := infraflow.NewPersistentStateFromJSON(infra.Status.State.Raw)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode state: %w", err)
+		}
+		// The state of the Terraformer is not decoded, the Terraformer tracks the elastic IPs by their position.
+		if state != nil {
+			for key, value := range state.ToFlatMap() {
+				if strings.HasPrefix(value, "eipalloc-") {
+					allocationIDs.Insert(value)
+				}
+				if strings.HasPrefix(key, infraflow.ChildIdElasticIPPool+"/") {
+					allocationIDs.Insert(strings.TrimPrefix(key, infraflow.ChildIdElasticIPPool+"/"))
+				}
+			}
+		}
+	}
+
+	return allocationIDs, nil
+}
+
+func (r *reconciler) isOlderThanGracePeriod(t *time.Time) bool {
+	return t != nil && r.clock.Since(*t) > gracePeriod
+}
+
+// updateCondition updates the condition of the orphaned resources of the given Infrastructure if it has changed.
+func (r *reconciler) updateCondition(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, status gardencorev1beta1.ConditionStatus, reason, message string) error {
+	if condition := v1beta1helper.GetCondition(infra.Status.Conditions, ConditionTypeOrphanedResources); condition != nil &&
+		condition.Status == status && condition.Reason == reason && condition.Message == message {
+		return nil
+	}
+
+	patch := client.MergeFrom(infra.DeepCopy())
+	condition := v1beta1helper.GetOrInitConditionWithClock(r.clock, infra.Status.Conditions, ConditionTypeOrphanedResources)
+	condition = v1beta1helper.UpdatedConditionWithClock(r.clock, condition, status, reason, message)
+	infra.Status.Conditions = v1beta1helper.MergeConditions(infra.Status.Conditions, condition)
+	return r.client.Status().Patch(ctx, infra, patch)
+}
+
+func orphansMessage(orphans []orphan) string {
+	var names []string
+	for _, o := range orphans {
+		names = append(names, o.String())
+	}
+	slices.Sort(names)
+
+	message := fmt.Sprintf("Found %d orphaned resource(s): %s", len(names), strings.Join(names[:min(len(names), maxReportedResources)], ", "))
+	if len(names) > maxReportedResources {
+		message += ", ..."
+	}
+	return message + "."
+}
+
+// lastPathElement returns the last element of the given path, e.g. the instance ID of the provider ID
+// aws:///eu-west-1a/i-0123456789abcdef0.
+func lastPathElement(path string) string {
+	return path[strings.LastIndex(path, "/")+1:]
+}
+
+func newShootClient(ctx context.Context, c client.Client, namespace string) (client.Client, error) {
+	_, shootClient, err := util.NewClientForShoot(ctx, c, namespace, client.Options{}, extensionsconfig.RESTOptions{})
+	return shootClient, err
+}
@@ -0,0 +1,288 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package leakdetection
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	mockawsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/mock"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		namespace  = "shoot--foo--bar"
+		region     = "eu-west-1"
+		vpcID      = "vpc-0123456789"
+		clusterTag = "kubernetes.io/cluster/" + namespace
+		interval   = 6 * time.Hour
+	)
+
+	var (
+		ctx = context.TODO()
+
+		ctrl             *gomock.Controller
+		seedClient       client.Client
+		shootClient      client.Client
+		awsClientFactory *mockawsclient.MockFactory
+		awsClient        *mockawsclient.MockInterface
+		fakeClock        *testclock.FakeClock
+		r                *reconciler
+
+		shoot   *gardencorev1beta1.Shoot
+		infra   *extensionsv1alpha1.Infrastructure
+		request reconcile.Request
+
+		old    time.Time
+		recent time.Time
+
+		createSeedClient = func() {
+			scheme := runtime.NewScheme()
+			Expect(corev1.AddToScheme(scheme)).To(Succeed())
+			Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+			Expect(machinev1alpha1.AddToScheme(scheme)).To(Succeed())
+
+			shootJSON, err := json.Marshal(shoot)
+			Expect(err).NotTo(HaveOccurred())
+			cluster := &extensionsv1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: namespace},
+				Spec:       extensionsv1alpha1.ClusterSpec{Shoot: runtime.RawExtension{Raw: shootJSON}},
+			}
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "cloudprovider", Namespace: namespace},
+				Data: map[string][]byte{
+					aws.AccessKeyID:     []byte("access-key-id"),
+					aws.SecretAccessKey: []byte("secret-access-key"),
+				},
+			}
+			machine := &machinev1alpha1.Machine{
+				ObjectMeta: metav1.ObjectMeta{Name: "machine", Namespace: namespace},
+				Spec:       machinev1alpha1.MachineSpec{ProviderID: "aws:///eu-west-1a/i-used"},
+			}
+			seedClient = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(cluster, secret, machine, infra).WithStatusSubresource(infra).Build()
+			r.client = seedClient
+		}
+
+		getCondition = func() *gardencorev1beta1.Condition {
+			current := &extensionsv1alpha1.Infrastructure{}
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(infra), current)).To(Succeed())
+			return v1beta1helper.GetCondition(current.Status.Conditions, ConditionTypeOrphanedResources)
+		}
+
+		expectInstancesAndElasticIPs = func() {
+			awsClient.EXPECT().FindInstancesByTags(ctx, awsclient.Tags{clusterTag: "1", "kubernetes.io/role/node": "1"}).Return([]*awsclient.Instance{
+				{InstanceId: "i-used", LaunchTime: &old},
+				{InstanceId: "i-orphan", LaunchTime: &old},
+				{InstanceId: "i-new", LaunchTime: &recent},
+				{InstanceId: "i-karpenter", LaunchTime: &old, Tags: awsclient.Tags{"karpenter.sh/managed-by": namespace}},
+			}, nil)
+			awsClient.EXPECT().FindElasticIPsByTags(ctx, awsclient.Tags{clusterTag: "1"}).Return([]*awsclient.ElasticIP{
+				{AllocationId: "eipalloc-used", AssociationId: pointer.String("eipassoc-1")},
+				{AllocationId: "eipalloc-orphan"},
+				{AllocationId: "eipalloc-pool-tag", Tags: awsclient.Tags{"gardener.cloud/elastic-ip-pool": "1"}},
+				{AllocationId: "eipalloc-pool-status"},
+				{AllocationId: "eipalloc-nat-status"},
+				{AllocationId: "eipalloc-nat-state"},
+				{AllocationId: "eipalloc-new"},
+			}, nil)
+		}
+
+		expectLoadBalancersAndVolumes = func() {
+			awsClient.EXPECT().ListKubernetesELBs(ctx, vpcID, namespace).Return([]string{"a1used", "a2orphan"}, nil)
+			awsClient.EXPECT().ListKubernetesELBsV2(ctx, vpcID, namespace).Return([]string{
+				"arn:aws:elasticloadbalancing:eu-west-1:123456789012:loadbalancer/net/nlbused/0123",
+			}, nil)
+			awsClient.EXPECT().FindVolumesByTags(ctx, awsclient.Tags{clusterTag: "owned"}).Return([]*awsclient.Volume{
+				{VolumeId: "vol-used", State: "available", CreateTime: &old},
+				{VolumeId: "vol-in-use", State: "in-use", CreateTime: &old},
+				{VolumeId: "vol-orphan", State: "available", CreateTime: &old},
+				{VolumeId: "vol-new", State: "available", CreateTime: &recent},
+			}, nil)
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		shoot = &gardencorev1beta1.Shoot{
+			TypeMeta:   metav1.TypeMeta{APIVersion: gardencorev1beta1.SchemeGroupVersion.String(), Kind: "Shoot"},
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+			Spec:       gardencorev1beta1.ShootSpec{Region: region},
+		}
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infrastructure", Namespace: namespace},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					Type: aws.Type,
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "aws.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "InfrastructureConfig",
+"networks": {"zones": []}
+}`)},
+				},
+				Region:    region,
+				SecretRef: corev1.SecretReference{Name: "cloudprovider", Namespace: namespace},
+			},
+			Status: extensionsv1alpha1.InfrastructureStatus{
+				DefaultStatus: extensionsv1alpha1.DefaultStatus{
+					LastOperation: &gardencorev1beta1.LastOperation{
+						Type:  gardencorev1beta1.LastOperationTypeReconcile,
+						State: gardencorev1beta1.LastOperationStateSucceeded,
+					},
+					ProviderStatus: &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "aws.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "InfrastructureStatus",
+"vpc": {"id": "` + vpcID + `", "subnets": [], "securityGroups": [], "elasticIPPool": [{"allocationID": "eipalloc-pool-status", "publicIP": "1.2.3.4"}],
+  "natGateways": [{"zone": "eu-west-1a", "id": "nat-1", "elasticIPAllocationID": "eipalloc-nat-status"}]}
+}`)},
+					State: &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "aws.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "FlowState",
+"data": {"Zones/eu-west-1b/NATGatewayElasticIP": "eipalloc-nat-state"}
+}`)},
+				},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(infra)}
+
+		shootClient = fakeclient.NewClientBuilder().WithObjects(
+			&corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "classic", Namespace: "default"},
+				Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+				Status: corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{Ingress: []corev1.LoadBalancerIngress{
+					{Hostname: "internal-a1used-1234567890.eu-west-1.elb.amazonaws.com"},
+				}}},
+			},
+			&corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "nlb", Namespace: "default"},
+				Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+				Status: corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{Ingress: []corev1.LoadBalancerIngress{
+					{Hostname: "nlbused-0123.elb.eu-west-1.amazonaws.com"},
+				}}},
+			},
+			&corev1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{Name: "pv"},
+				Spec: corev1.PersistentVolumeSpec{PersistentVolumeSource: corev1.PersistentVolumeSource{
+					CSI: &corev1.CSIPersistentVolumeSource{Driver: "ebs.csi.aws.com", VolumeHandle: "vol-used"},
+				}},
+			},
+		).Build()
+
+		awsClientFactory = mockawsclient.NewMockFactory(ctrl)
+		awsClient = mockawsclient.NewMockInterface(ctrl)
+		awsClientFactory.EXPECT().NewClient(awsclient.AuthConfig{AccessKeyID: "access-key-id", SecretAccessKey: "secret-access-key", Region: region, Shoot: namespace}).Return(awsClient, nil).AnyTimes()
+		fakeClock = testclock.NewFakeClock(time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC))
+		old = fakeClock.Now().Add(-2 * time.Hour)
+		recent = fakeClock.Now().Add(-5 * time.Minute)
+
+		r = &reconciler{
+			awsClientFactory: awsClientFactory,
+			newShootClient: func(_ context.Context, _ client.Client, _ string) (client.Client, error) {
+				return shootClient, nil
+			},
+			clock:    fakeClock,
+			interval: interval,
+			unassociatedElasticIPs: map[string]map[string]time.Time{
+				namespace: {"eipalloc-orphan": old},
+			},
+		}
+	})
+
+	It("should report the orphaned resources", func() {
+		createSeedClient()
+		expectInstancesAndElasticIPs()
+		expectLoadBalancersAndVolumes()
+
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: interval}))
+
+		condition := getCondition()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(ReasonOrphanedResourcesFound))
+		Expect(condition.Message).To(Equal("Found 4 orphaned resource(s): elastic-ip/eipalloc-orphan, instance/i-orphan, load-balancer/a2orphan, volume/vol-orphan."))
+	})
+
+	It("should delete the orphaned instances, elastic IPs and volumes", func() {
+		r.deleteOrphans = true
+		createSeedClient()
+		expectInstancesAndElasticIPs()
+		expectLoadBalancersAndVolumes()
+		awsClient.EXPECT().TerminateInstance(ctx, "i-orphan")
+		awsClient.EXPECT().DeleteElasticIP(ctx, "eipalloc-orphan")
+		awsClient.EXPECT().DeleteVolume(ctx, "vol-orphan")
+
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: interval}))
+
+		condition := getCondition()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		Expect(condition.Message).To(Equal("Found 1 orphaned resource(s): load-balancer/a2orphan."))
+	})
+
+	It("should report unassociated elastic IPs after the grace period", func() {
+		createSeedClient()
+		expectInstancesAndElasticIPs()
+		expectLoadBalancersAndVolumes()
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: interval}))
+		Expect(getCondition().Message).NotTo(ContainSubstring("eipalloc-new"))
+
+		fakeClock.Step(time.Hour)
+		expectInstancesAndElasticIPs()
+		expectLoadBalancersAndVolumes()
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: interval}))
+		Expect(getCondition().Message).To(ContainSubstring("elastic-ip/eipalloc-new"))
+	})
+
+	It("should skip the load balancers and volumes of hibernated shoots", func() {
+		shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: pointer.Bool(true)}
+		createSeedClient()
+		awsClient.EXPECT().FindInstancesByTags(ctx, gomock.Any()).Return(nil, nil)
+		awsClient.EXPECT().FindElasticIPsByTags(ctx, gomock.Any()).Return(nil, nil)
+
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: interval}))
+
+		condition := getCondition()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(ReasonNoOrphanedResources))
+	})
+
+	It("should not check the resources if the last operation has not succeeded", func() {
+		infra.Status.LastOperation.State = gardencorev1beta1.LastOperationStateProcessing
+		createSeedClient()
+
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: interval}))
+		Expect(getCondition()).To(BeNil())
+	})
+})
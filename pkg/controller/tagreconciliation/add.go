@@ -0,0 +1,74 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tagreconciliation
+
+import (
+	"context"
+	"time"
+
+	extensionspredicate "github.com/gardener/gardener/extensions/pkg/predicate"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// ControllerName is the name of the controller.
+const ControllerName = "tagreconciliation"
+
+var (
+	// DefaultAddOptions are the default AddOptions for AddToManager.
+	DefaultAddOptions = AddOptions{}
+)
+
+// AddOptions are options to apply when adding the AWS tag reconciliation controller to the manager.
+type AddOptions struct {
+	// Controller are the controller.Options.
+	Controller controller.Options
+	// Interval is the interval in which the tags of the AWS resources of a shoot are checked. The controller is not
+	// added to the manager if it is zero.
+	Interval time.Duration
+}
+
+// AddToManagerWithOptions adds a controller with the given Options to the given manager.
+func AddToManagerWithOptions(_ context.Context, mgr manager.Manager, opts AddOptions) error {
+	if opts.Interval <= 0 {
+		mgr.GetLogger().Info("Tag reconciliation is disabled as no interval is configured")
+		return nil
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(opts.Controller).
+		For(&extensionsv1alpha1.Infrastructure{}, builder.WithPredicates(
+			extensionspredicate.HasType(aws.Type),
+			predicate.GenerationChangedPredicate{},
+		)).
+		Complete(&reconciler{
+			client:           mgr.GetClient(),
+			awsClientFactory: awsclient.NewControllerFactory(awsclient.FactoryFunc(awsclient.NewInterface), ControllerName),
+			interval:         opts.Interval,
+		})
+}
+
+// AddToManager adds a controller with the default Options.
+func AddToManager(ctx context.Context, mgr manager.Manager) error {
+	return AddToManagerWithOptions(ctx, mgr, DefaultAddOptions)
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tagreconciliation
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	driftedResources = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "aws_tag_drift_resources",
+		Help: "Number of AWS resources of a shoot with missing or modified tags found by the last check.",
+	}, []string{"namespace"})
+	driftedResourcesCorrected = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "aws_tag_drift_resources_corrected_total",
+		Help: "Number of AWS resources whose missing or modified tags were restored.",
+	}, []string{"type"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(driftedResources, driftedResourcesCorrected)
+}
@@ -0,0 +1,234 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tagreconciliation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// maxResourcesPerRequest is the maximum number of resources which can be tagged with one request of the Resource
+// Groups Tagging API.
+const maxResourcesPerRequest = 20
+
+// machineResourceTypes are the types of the resources created by the machine controller manager, whose tags may be
+// overridden by the tags of the worker pools.
+var machineResourceTypes = sets.New("ec2/instance", "ec2/volume", "ec2/network-interface")
+
+// drift is an AWS resource of a shoot with missing or modified tags.
+type drift struct {
+	arn     string
+	missing awsclient.Tags
+}
+
+// reconciler periodically checks the tags of the AWS resources created by the extension for a shoot, i.e. the
+// resources tagged with `kubernetes.io/cluster/<namespace>=1`, and restores the tags of the InfrastructureConfig if
+// they are missing or were modified. The number of resources with drifted tags is reported in metrics. Without this
+// controller, manual changes of the tags persist until the next reconciliation of the Infrastructure, and they are
+// never corrected on machines.
+type reconciler struct {
+	client           client.Client
+	awsClientFactory awsclient.Factory
+
+	interval time.Duration
+}
+
+// Reconcile checks and corrects the tags of the AWS resources of the shoot of the given Infrastructure.
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	infra := &extensionsv1alpha1.Infrastructure{}
+	if err := r.client.Get(ctx, request.NamespacedName, infra); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			driftedResources.DeleteLabelValues(request.Namespace)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if infra.DeletionTimestamp != nil {
+		driftedResources.DeleteLabelValues(infra.Namespace)
+		return reconcile.Result{}, nil
+	}
+	// The tags are set by the reconciliation of the Infrastructure itself.
+	if lastOp := infra.Status.LastOperation; lastOp == nil || lastOp.State != gardencorev1beta1.LastOperationStateSucceeded ||
+		lastOp.Type == gardencorev1beta1.LastOperationTypeDelete {
+		return reconcile.Result{RequeueAfter: r.interval}, nil
+	}
+
+	infrastructureConfig, err := helper.InfrastructureConfigFromInfrastructure(infra)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if len(infrastructureConfig.Tags) == 0 {
+		driftedResources.WithLabelValues(infra.Namespace).Set(0)
+		return reconcile.Result{RequeueAfter: r.interval}, nil
+	}
+
+	cluster, err := extensionscontroller.GetCluster(ctx, r.client, infra.Namespace)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not get cluster: %w", err)
+	}
+	workerPoolTagKeys, err := getWorkerPoolTagKeys(cluster)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	credentials, err := aws.GetCredentialsFromSecretRef(ctx, r.client, infra.Spec.SecretRef, false)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not get AWS credentials: %w", err)
+	}
+	authConfig := aws.NewAuthConfig(credentials, infra.Spec.Region)
+	authConfig.Shoot = infra.Namespace
+	aws.ApplyEndpoints(&authConfig, infrastructureConfig.Endpoints)
+	awsClient, err := r.awsClientFactory.NewClient(authConfig)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	resources, err := awsClient.FindTaggedResourcesByTags(ctx, awsclient.Tags{fmt.Sprintf("kubernetes.io/cluster/%s", infra.Namespace): "1"})
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not list tagged resources: %w", err)
+	}
+
+	drifts := detectDrifts(resources, infrastructureConfig.Tags, workerPoolTagKeys)
+	driftedResources.WithLabelValues(infra.Namespace).Set(float64(len(drifts)))
+	if len(drifts) == 0 {
+		return reconcile.Result{RequeueAfter: r.interval}, nil
+	}
+
+	log.Info("Found resources with drifted tags", "count", len(drifts))
+	if err := r.correctDrifts(ctx, log, awsClient, drifts); err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not restore tags: %w", err)
+	}
+	return reconcile.Result{RequeueAfter: r.interval}, nil
+}
+
+// detectDrifts returns the given resources which miss any of the given tags or have a different value. The tags whose
+// keys are overridden by the worker pools are not checked on the resources of the machines.
+func detectDrifts(resources []*awsclient.TaggedResource, tags map[string]string, workerPoolTagKeys sets.Set[string]) []drift {
+	var drifts []drift
+	for _, resource := range resources {
+		isMachineResource := machineResourceTypes.Has(resourceType(resource.ARN))
+		missing := awsclient.Tags{}
+		for key, value := range tags {
+			if isMachineResource && workerPoolTagKeys.Has(key) {
+				continue
+			}
+			if current, ok := resource.Tags[key]; !ok || current != value {
+				missing[key] = value
+			}
+		}
+		if len(missing) > 0 {
+			drifts = append(drifts, drift{arn: resource.ARN, missing: missing})
+		}
+	}
+	return drifts
+}
+
+// correctDrifts restores the missing tags of the given resources. Resources missing the same tags are tagged together.
+func (r *reconciler) correctDrifts(ctx context.Context, log logr.Logger, awsClient awsclient.Interface, drifts []drift) error {
+	var (
+		groups = map[string][]drift{}
+		keys   []string
+		errs   []error
+	)
+	for _, d := range drifts {
+		key := tagsKey(d.missing)
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], d)
+	}
+
+	for _, key := range keys {
+		group := groups[key]
+		for start := 0; start < len(group); start += maxResourcesPerRequest {
+			chunk := group[start:min(start+maxResourcesPerRequest, len(group))]
+			var arns []string
+			for _, d := range chunk {
+				arns = append(arns, d.arn)
+			}
+			if err := awsClient.TagResources(ctx, arns, chunk[0].missing); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			for _, d := range chunk {
+				log.Info("Restored tags", "resource", d.arn, "keys", sets.List(sets.KeySet(d.missing)))
+				driftedResourcesCorrected.WithLabelValues(resourceType(d.arn)).Inc()
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// getWorkerPoolTagKeys returns the keys of the tags of all worker pools of the shoot of the given cluster.
+func getWorkerPoolTagKeys(cluster *extensionscontroller.Cluster) (sets.Set[string], error) {
+	keys := sets.New[string]()
+	if cluster.Shoot == nil {
+		return keys, nil
+	}
+	for _, worker := range cluster.Shoot.Spec.Provider.Workers {
+		workerConfig, err := helper.WorkerConfigFromRawExtension(worker.ProviderConfig)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode provider config of worker pool %s: %w", worker.Name, err)
+		}
+		keys.Insert(sets.List(sets.KeySet(workerConfig.Tags))...)
+	}
+	return keys, nil
+}
+
+// resourceType returns the service and the type of the resource with the given ARN, e.g. `ec2/vpc` for
+// arn:aws:ec2:eu-west-1:123456789012:vpc/vpc-0123456789abcdef0.
+func resourceType(arn string) string {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) != 6 {
+		return "unknown"
+	}
+	resource := parts[5]
+	if i := strings.IndexAny(resource, "/:"); i >= 0 {
+		resource = resource[:i]
+	}
+	return parts[2] + "/" + resource
+}
+
+// tagsKey returns a canonical representation of the given tags.
+func tagsKey(tags awsclient.Tags) string {
+	var pairs []string
+	for key, value := range tags {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "\n")
+}
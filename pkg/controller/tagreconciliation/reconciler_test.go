@@ -0,0 +1,195 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tagreconciliation
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	mockawsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/mock"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		namespace  = "shoot--foo--bar"
+		region     = "eu-west-1"
+		clusterTag = "kubernetes.io/cluster/" + namespace
+		interval   = time.Hour
+
+		vpcARN      = "arn:aws:ec2:eu-west-1:123456789012:vpc/vpc-0123456789"
+		subnetARN   = "arn:aws:ec2:eu-west-1:123456789012:subnet/subnet-0123456789"
+		instanceARN = "arn:aws:ec2:eu-west-1:123456789012:instance/i-0123456789"
+	)
+
+	var (
+		ctx = context.TODO()
+
+		ctrl             *gomock.Controller
+		awsClientFactory *mockawsclient.MockFactory
+		awsClient        *mockawsclient.MockInterface
+		r                *reconciler
+
+		shoot   *gardencorev1beta1.Shoot
+		infra   *extensionsv1alpha1.Infrastructure
+		request reconcile.Request
+
+		createSeedClient = func() {
+			scheme := runtime.NewScheme()
+			Expect(corev1.AddToScheme(scheme)).To(Succeed())
+			Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+			shootJSON, err := json.Marshal(shoot)
+			Expect(err).NotTo(HaveOccurred())
+			cluster := &extensionsv1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: namespace},
+				Spec:       extensionsv1alpha1.ClusterSpec{Shoot: runtime.RawExtension{Raw: shootJSON}},
+			}
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "cloudprovider", Namespace: namespace},
+				Data: map[string][]byte{
+					aws.AccessKeyID:     []byte("access-key-id"),
+					aws.SecretAccessKey: []byte("secret-access-key"),
+				},
+			}
+			r.client = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(cluster, secret, infra).Build()
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		shoot = &gardencorev1beta1.Shoot{
+			TypeMeta:   metav1.TypeMeta{APIVersion: gardencorev1beta1.SchemeGroupVersion.String(), Kind: "Shoot"},
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+			Spec: gardencorev1beta1.ShootSpec{
+				Region: region,
+				Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{{
+					Name: "pool",
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "aws.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "WorkerConfig",
+"tags": {"team": "pool-team"}
+}`)},
+				}}},
+			},
+		}
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infrastructure", Namespace: namespace},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					Type: aws.Type,
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "aws.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "InfrastructureConfig",
+"networks": {"zones": []},
+"tags": {"cost-center": "1234", "team": "infra-team"}
+}`)},
+				},
+				Region:    region,
+				SecretRef: corev1.SecretReference{Name: "cloudprovider", Namespace: namespace},
+			},
+			Status: extensionsv1alpha1.InfrastructureStatus{
+				DefaultStatus: extensionsv1alpha1.DefaultStatus{
+					LastOperation: &gardencorev1beta1.LastOperation{
+						Type:  gardencorev1beta1.LastOperationTypeReconcile,
+						State: gardencorev1beta1.LastOperationStateSucceeded,
+					},
+				},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(infra)}
+
+		awsClientFactory = mockawsclient.NewMockFactory(ctrl)
+		awsClient = mockawsclient.NewMockInterface(ctrl)
+		awsClientFactory.EXPECT().NewClient(awsclient.AuthConfig{AccessKeyID: "access-key-id", SecretAccessKey: "secret-access-key", Region: region, Shoot: namespace}).Return(awsClient, nil).AnyTimes()
+
+		r = &reconciler{
+			awsClientFactory: awsClientFactory,
+			interval:         interval,
+		}
+	})
+
+	It("should restore the missing and modified tags", func() {
+		createSeedClient()
+		awsClient.EXPECT().FindTaggedResourcesByTags(ctx, awsclient.Tags{clusterTag: "1"}).Return([]*awsclient.TaggedResource{
+			{ARN: vpcARN, Tags: awsclient.Tags{clusterTag: "1", "cost-center": "1234", "team": "infra-team"}},
+			{ARN: subnetARN, Tags: awsclient.Tags{clusterTag: "1", "cost-center": "4321"}},
+			{ARN: instanceARN, Tags: awsclient.Tags{clusterTag: "1", "team": "pool-team"}},
+		}, nil)
+		awsClient.EXPECT().TagResources(ctx, []string{subnetARN}, awsclient.Tags{"cost-center": "1234", "team": "infra-team"})
+		awsClient.EXPECT().TagResources(ctx, []string{instanceARN}, awsclient.Tags{"cost-center": "1234"})
+
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: interval}))
+	})
+
+	It("should not tag any resource if there is no drift", func() {
+		createSeedClient()
+		awsClient.EXPECT().FindTaggedResourcesByTags(ctx, awsclient.Tags{clusterTag: "1"}).Return([]*awsclient.TaggedResource{
+			{ARN: vpcARN, Tags: awsclient.Tags{clusterTag: "1", "cost-center": "1234", "team": "infra-team"}},
+			{ARN: instanceARN, Tags: awsclient.Tags{clusterTag: "1", "cost-center": "1234", "team": "pool-team"}},
+		}, nil)
+
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: interval}))
+	})
+
+	It("should group the resources missing the same tags", func() {
+		createSeedClient()
+		awsClient.EXPECT().FindTaggedResourcesByTags(ctx, awsclient.Tags{clusterTag: "1"}).Return([]*awsclient.TaggedResource{
+			{ARN: vpcARN, Tags: awsclient.Tags{clusterTag: "1"}},
+			{ARN: subnetARN, Tags: awsclient.Tags{clusterTag: "1"}},
+		}, nil)
+		awsClient.EXPECT().TagResources(ctx, []string{vpcARN, subnetARN}, awsclient.Tags{"cost-center": "1234", "team": "infra-team"})
+
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: interval}))
+	})
+
+	It("should not check the resources if the last operation has not succeeded", func() {
+		infra.Status.LastOperation.State = gardencorev1beta1.LastOperationStateProcessing
+		createSeedClient()
+
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: interval}))
+	})
+
+	It("should stop reconciling if the infrastructure is gone", func() {
+		createSeedClient()
+		Expect(r.client.Delete(ctx, infra)).To(Succeed())
+
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	Describe("#resourceType", func() {
+		It("should return the service and type of the resource", func() {
+			Expect(resourceType(vpcARN)).To(Equal("ec2/vpc"))
+			Expect(resourceType("arn:aws:elasticloadbalancing:eu-west-1:123456789012:loadbalancer/net/foo/0123")).To(Equal("elasticloadbalancing/loadbalancer"))
+			Expect(resourceType("arn:aws:iam::123456789012:role/foo")).To(Equal("iam/role"))
+			Expect(resourceType("foo")).To(Equal("unknown"))
+		})
+	})
+})
@@ -16,11 +16,11 @@ package worker
 
 import (
 	"context"
+	"time"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/worker"
 	"github.com/gardener/gardener/extensions/pkg/controller/worker/genericactuator"
-	"github.com/gardener/gardener/extensions/pkg/util"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	gardener "github.com/gardener/gardener/pkg/client/kubernetes"
@@ -34,24 +34,32 @@ import (
 
 	api "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 )
 
+// awsQueryCacheTTL is the TTL of the cached results of read-only queries to the AWS API, e.g. the resolved SSM
+// parameters of machine images.
+const awsQueryCacheTTL = 10 * time.Minute
+
 type delegateFactory struct {
-	gardenReader client.Reader
-	seedClient   client.Client
-	decoder      runtime.Decoder
-	restConfig   *rest.Config
-	scheme       *runtime.Scheme
+	gardenReader     client.Reader
+	seedClient       client.Client
+	decoder          runtime.Decoder
+	restConfig       *rest.Config
+	scheme           *runtime.Scheme
+	awsClientFactory awsclient.Factory
 }
 
 // NewActuator creates a new Actuator that updates the status of the handled WorkerPoolConfigs.
 func NewActuator(mgr manager.Manager, gardenCluster cluster.Cluster) worker.Actuator {
 	workerDelegate := &delegateFactory{
-		gardenReader: gardenCluster.GetAPIReader(),
-		seedClient:   mgr.GetClient(),
-		decoder:      serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
-		restConfig:   mgr.GetConfig(),
-		scheme:       mgr.GetScheme(),
+		gardenReader:     gardenCluster.GetAPIReader(),
+		seedClient:       mgr.GetClient(),
+		decoder:          serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
+		restConfig:       mgr.GetConfig(),
+		scheme:           mgr.GetScheme(),
+		awsClientFactory: awsclient.NewControllerFactory(awsclient.NewCachingFactory(awsclient.FactoryFunc(awsclient.NewInterface), awsQueryCacheTTL), worker.ControllerName),
 	}
 
 	return genericactuator.NewActuator(
@@ -59,7 +67,7 @@ func NewActuator(mgr manager.Manager, gardenCluster cluster.Cluster) worker.Actu
 		gardenCluster,
 		workerDelegate,
 		func(err error) []gardencorev1beta1.ErrorCode {
-			return util.DetermineErrorCodes(err, helper.KnownCodes)
+			return aws.DetermineErrorCodes(err)
 		},
 	)
 }
@@ -84,6 +92,7 @@ func (d *delegateFactory) WorkerDelegate(_ context.Context, worker *extensionsv1
 		d.seedClient,
 		d.decoder,
 		d.scheme,
+		d.awsClientFactory,
 
 		seedChartApplier,
 		serverVersion.GitVersion,
@@ -94,9 +103,10 @@ func (d *delegateFactory) WorkerDelegate(_ context.Context, worker *extensionsv1
 }
 
 type workerDelegate struct {
-	client  client.Client
-	decoder runtime.Decoder
-	scheme  *runtime.Scheme
+	client           client.Client
+	decoder          runtime.Decoder
+	scheme           *runtime.Scheme
+	awsClientFactory awsclient.Factory
 
 	seedChartApplier gardener.ChartApplier
 	serverVersion    string
@@ -108,6 +118,7 @@ type workerDelegate struct {
 	machineClasses     []map[string]interface{}
 	machineDeployments worker.MachineDeployments
 	machineImages      []api.MachineImage
+	ec2NodeClasses     []map[string]interface{}
 }
 
 // NewWorkerDelegate creates a new context for a worker reconciliation.
@@ -115,6 +126,7 @@ func NewWorkerDelegate(
 	client client.Client,
 	decoder runtime.Decoder,
 	scheme *runtime.Scheme,
+	awsClientFactory awsclient.Factory,
 
 	seedChartApplier gardener.ChartApplier,
 	serverVersion string,
@@ -127,9 +139,10 @@ func NewWorkerDelegate(
 		return nil, err
 	}
 	return &workerDelegate{
-		client:  client,
-		decoder: decoder,
-		scheme:  scheme,
+		client:           client,
+		decoder:          decoder,
+		scheme:           scheme,
+		awsClientFactory: awsClientFactory,
 
 		seedChartApplier: seedChartApplier,
 		serverVersion:    serverVersion,
@@ -0,0 +1,213 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-aws/imagevector"
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+)
+
+const (
+	// devicePluginsManagedResourceName is the name of the managed resource containing the device plugins of the worker pools.
+	devicePluginsManagedResourceName = "extension-worker-device-plugins"
+
+	nvidiaDevicePluginName = "nvidia-device-plugin"
+	neuronDevicePluginName = "neuron-device-plugin"
+
+	devicePluginsPath = "/var/lib/kubelet/device-plugins"
+)
+
+// reconcileDevicePlugins deploys the device plugins for the accelerators of the worker pools with an enabled device
+// plugin to the shoot. The device plugins only run on the nodes of these worker pools. If no worker pool requires a
+// device plugin, the managed resource is deleted.
+func (w *workerDelegate) reconcileDevicePlugins(ctx context.Context) error {
+	pools, err := w.devicePluginPools()
+	if err != nil {
+		return err
+	}
+	if len(pools) == 0 {
+		return w.deleteDevicePlugins(ctx)
+	}
+
+	var objects []client.Object
+	for _, acceleratorType := range []awsapi.AcceleratorType{awsapi.AcceleratorTypeNVIDIA, awsapi.AcceleratorTypeNeuron} {
+		if len(pools[acceleratorType]) == 0 {
+			continue
+		}
+		objs, err := devicePluginObjects(acceleratorType, pools[acceleratorType])
+		if err != nil {
+			return err
+		}
+		objects = append(objects, objs...)
+	}
+
+	registry := managedresources.NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer)
+	data, err := registry.AddAllAndSerialize(objects...)
+	if err != nil {
+		return err
+	}
+	return managedresources.CreateForShoot(ctx, w.client, w.worker.Namespace, devicePluginsManagedResourceName, aws.Name, false, data)
+}
+
+func (w *workerDelegate) deleteDevicePlugins(ctx context.Context) error {
+	if err := managedresources.DeleteForShoot(ctx, w.client, w.worker.Namespace, devicePluginsManagedResourceName); err != nil {
+		return fmt.Errorf("failed to delete managed resource of device plugins: %w", err)
+	}
+	return nil
+}
+
+// devicePluginPools returns the names of the worker pools with an enabled device plugin by the accelerator type of
+// their machine types.
+func (w *workerDelegate) devicePluginPools() (map[awsapi.AcceleratorType][]string, error) {
+	pools := map[awsapi.AcceleratorType][]string{}
+	for _, pool := range w.worker.Spec.Pools {
+		workerConfig, err := w.decodeWorkerConfig(pool.ProviderConfig)
+		if err != nil {
+			return nil, err
+		}
+		if workerConfig.DevicePlugin == nil || !workerConfig.DevicePlugin.Enabled {
+			continue
+		}
+		acceleratorType := helper.GetAcceleratorType(pool.MachineType)
+		if acceleratorType == "" {
+			continue
+		}
+		pools[acceleratorType] = append(pools[acceleratorType], pool.Name)
+	}
+	return pools, nil
+}
+
+// devicePluginObjects returns the objects of the device plugin for the given accelerator type running on the nodes of
+// the given worker pools.
+func devicePluginObjects(acceleratorType awsapi.AcceleratorType, pools []string) ([]client.Object, error) {
+	var (
+		name      string
+		imageName string
+		env       []corev1.EnvVar
+		objects   []client.Object
+	)
+
+	switch acceleratorType {
+	case awsapi.AcceleratorTypeNVIDIA:
+		name, imageName = nvidiaDevicePluginName, aws.NVIDIADevicePluginImageName
+		env = []corev1.EnvVar{{Name: "FAIL_ON_INIT_ERROR", Value: "false"}}
+	case awsapi.AcceleratorTypeNeuron:
+		name, imageName = neuronDevicePluginName, aws.NeuronDevicePluginImageName
+		env = []corev1.EnvVar{
+			{Name: "KUBECONFIG", Value: ""},
+			{Name: "NODE_NAME", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "spec.nodeName"}}},
+		}
+	default:
+		return nil, fmt.Errorf("unsupported accelerator type %q", acceleratorType)
+	}
+
+	image, err := imagevector.ImageVector().FindImage(imageName)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := map[string]string{v1beta1constants.LabelApp: name}
+	daemonSet := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: metav1.NamespaceSystem,
+			Labels:    labels,
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			UpdateStrategy: appsv1.DaemonSetUpdateStrategy{
+				Type: appsv1.RollingUpdateDaemonSetStrategyType,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					PriorityClassName: "system-node-critical",
+					Affinity: &corev1.Affinity{
+						NodeAffinity: &corev1.NodeAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+								NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+									MatchExpressions: []corev1.NodeSelectorRequirement{{
+										Key:      v1beta1constants.LabelWorkerPool,
+										Operator: corev1.NodeSelectorOpIn,
+										Values:   sets.List(sets.New(pools...)),
+									}},
+								}},
+							},
+						},
+					},
+					// Accelerated nodes are commonly tainted to keep other workload away, hence all taints are tolerated.
+					Tolerations: []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+					Containers: []corev1.Container{{
+						Name:  name,
+						Image: image.String(),
+						Env:   env,
+						SecurityContext: &corev1.SecurityContext{
+							AllowPrivilegeEscalation: pointer.Bool(false),
+							Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+						},
+						VolumeMounts: []corev1.VolumeMount{{Name: "device-plugins", MountPath: devicePluginsPath}},
+					}},
+					Volumes: []corev1.Volume{{
+						Name: "device-plugins",
+						VolumeSource: corev1.VolumeSource{
+							HostPath: &corev1.HostPathVolumeSource{Path: devicePluginsPath},
+						},
+					}},
+				},
+			},
+		},
+	}
+
+	if acceleratorType == awsapi.AcceleratorTypeNeuron {
+		// The Neuron device plugin allocates the devices of pods itself and hence needs access to the nodes and pods.
+		serviceAccount := &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceSystem},
+		}
+		clusterRole := &rbacv1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{Name: "gardener.cloud:aws:" + name},
+			Rules: []rbacv1.PolicyRule{
+				{APIGroups: []string{""}, Resources: []string{"nodes"}, Verbs: []string{"get", "list", "watch"}},
+				{APIGroups: []string{""}, Resources: []string{"nodes/status"}, Verbs: []string{"patch", "update"}},
+				{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get", "list", "watch", "patch", "update"}},
+				{APIGroups: []string{""}, Resources: []string{"events"}, Verbs: []string{"create", "patch"}},
+			},
+		}
+		clusterRoleBinding := &rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: clusterRole.Name},
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: clusterRole.Name},
+			Subjects:   []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: serviceAccount.Name, Namespace: serviceAccount.Namespace}},
+		}
+		daemonSet.Spec.Template.Spec.ServiceAccountName = serviceAccount.Name
+		objects = append(objects, serviceAccount, clusterRole, clusterRoleBinding)
+	}
+
+	return append(objects, daemonSet), nil
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+)
+
+// karpenterManagedResourceName is the name of the managed resource containing the EC2NodeClasses of the worker pools.
+const karpenterManagedResourceName = "extension-worker-karpenter"
+
+// reconcileKarpenterNodeClasses deploys an EC2NodeClass for every worker pool to the shoot if Karpenter is enabled, so
+// that NodePools can reference the machine image, the subnets, the security groups and the instance profile of an
+// existing worker pool. Otherwise, the EC2NodeClasses are deleted.
+func (w *workerDelegate) reconcileKarpenterNodeClasses(ctx context.Context) error {
+	cpConfig, err := helper.ControlPlaneConfigFromCluster(w.cluster)
+	if err != nil {
+		return err
+	}
+	if !helper.IsKarpenterEnabled(cpConfig) {
+		return w.deleteKarpenterNodeClasses(ctx)
+	}
+
+	if w.ec2NodeClasses == nil {
+		if err := w.generateMachineConfig(ctx); err != nil {
+			return err
+		}
+	}
+
+	data := make(map[string][]byte, len(w.ec2NodeClasses))
+	for _, nodeClass := range w.ec2NodeClasses {
+		raw, err := yaml.Marshal(nodeClass)
+		if err != nil {
+			return err
+		}
+		name := nodeClass["metadata"].(map[string]interface{})["name"].(string)
+		data[fmt.Sprintf("ec2nodeclass__%s.yaml", name)] = raw
+	}
+
+	// The objects are kept when the managed resource is deleted, as the finalizers of the EC2NodeClasses can only be
+	// removed by Karpenter, which is not running anymore when it is disabled.
+	return managedresources.CreateForShoot(ctx, w.client, w.worker.Namespace, karpenterManagedResourceName, aws.Name, true, data)
+}
+
+func (w *workerDelegate) deleteKarpenterNodeClasses(ctx context.Context) error {
+	if err := managedresources.DeleteForShoot(ctx, w.client, w.worker.Namespace, karpenterManagedResourceName); err != nil {
+		return fmt.Errorf("failed to delete managed resource of Karpenter node classes: %w", err)
+	}
+	return nil
+}
+
+// computeEC2NodeClass returns the EC2NodeClass of the given worker pool. Karpenter launches the instances with the
+// same AMI, user data, network configuration and root volume as the machine controller manager.
+func (w *workerDelegate) computeEC2NodeClass(
+	pool extensionsv1alpha1.WorkerPool,
+	ami string,
+	userData []byte,
+	subnetIDs []string,
+	securityGroupIDs []string,
+	iamInstanceProfile map[string]interface{},
+	rootVolume map[string]interface{},
+	instanceMetadataOptions map[string]interface{},
+	detailedMonitoring bool,
+	additionalTags map[string]string,
+) map[string]interface{} {
+	var subnetSelectorTerms, securityGroupSelectorTerms []interface{}
+	for _, id := range subnetIDs {
+		subnetSelectorTerms = append(subnetSelectorTerms, map[string]interface{}{"id": id})
+	}
+	for _, id := range securityGroupIDs {
+		securityGroupSelectorTerms = append(securityGroupSelectorTerms, map[string]interface{}{"id": id})
+	}
+
+	ebs := map[string]interface{}{}
+	for key, value := range rootVolume {
+		ebs[key] = value
+	}
+	// Karpenter expects the volume size as quantity.
+	ebs["volumeSize"] = fmt.Sprintf("%dGi", rootVolume["volumeSize"])
+
+	// Karpenter only accepts the name of the instance profile, which is the last segment of its ARN.
+	instanceProfile, ok := iamInstanceProfile["name"].(string)
+	if !ok {
+		arn := iamInstanceProfile["arn"].(string)
+		instanceProfile = arn[strings.LastIndex(arn, "/")+1:]
+	}
+
+	spec := map[string]interface{}{
+		"amiFamily":                  "Custom",
+		"amiSelectorTerms":           []interface{}{map[string]interface{}{"id": ami}},
+		"subnetSelectorTerms":        subnetSelectorTerms,
+		"securityGroupSelectorTerms": securityGroupSelectorTerms,
+		"instanceProfile":            instanceProfile,
+		"userData":                   string(userData),
+		"tags": utils.MergeStringMaps(
+			map[string]string{
+				fmt.Sprintf("kubernetes.io/cluster/%s", w.worker.Namespace): "1",
+				"kubernetes.io/role/node":                                   "1",
+				"karpenter.sh/managed-by":                                   w.worker.Namespace,
+			},
+			pool.Labels,
+			additionalTags,
+		),
+		"blockDeviceMappings": []interface{}{
+			map[string]interface{}{
+				"deviceName": "/dev/xvda",
+				"rootVolume": true,
+				"ebs":        ebs,
+			},
+		},
+	}
+	if len(instanceMetadataOptions) > 0 {
+		metadataOptions := map[string]interface{}{}
+		for _, key := range []string{"httpPutResponseHopLimit", "httpTokens"} {
+			if value, ok := instanceMetadataOptions[key]; ok {
+				metadataOptions[key] = value
+			}
+		}
+		spec["metadataOptions"] = metadataOptions
+	}
+	if detailedMonitoring {
+		spec["detailedMonitoring"] = true
+	}
+
+	return map[string]interface{}{
+		"apiVersion": "karpenter.k8s.aws/v1beta1",
+		"kind":       "EC2NodeClass",
+		"metadata": map[string]interface{}{
+			"name": pool.Name,
+		},
+		"spec": spec,
+	}
+}
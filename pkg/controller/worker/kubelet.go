@@ -0,0 +1,68 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"strconv"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	api "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+)
+
+// computeKubeletLabels returns the labels of the nodes of the given worker pool which expose the maximum number of pods
+// and the CPU and memory of kubeReserved if they are computed from the instance type. The kubelet configuration itself
+// is adapted by the control plane webhook, which computes the same values.
+func (w *workerDelegate) computeKubeletLabels(ctx context.Context, pool extensionsv1alpha1.WorkerPool, config *api.KubeletConfig) (map[string]string, error) {
+	if config == nil || (!config.KubeReservedFromInstanceType && !config.MaxPodsFromNetworkInterfaces) {
+		return nil, nil
+	}
+
+	info, err := w.instanceTypeInfo(ctx, pool)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := map[string]string{}
+	maxPods := w.maxPods(pool.Name)
+	if config.MaxPodsFromNetworkInterfaces {
+		maxPods = aws.ComputeMaxPodsFromNetworkInterfaces(info)
+		labels[aws.MaxPodsLabel] = strconv.Itoa(int(maxPods))
+	}
+	if config.KubeReservedFromInstanceType {
+		cpu, memory := aws.ComputeKubeReserved(info.VCPUs, maxPods)
+		labels[aws.KubeReservedCPULabel] = cpu.String()
+		labels[aws.KubeReservedMemoryLabel] = memory.String()
+	}
+	return labels, nil
+}
+
+// maxPods returns the maximum number of pods configured for the kubelet of the given worker pool in the shoot.
+func (w *workerDelegate) maxPods(poolName string) int32 {
+	if w.cluster == nil || w.cluster.Shoot == nil {
+		return aws.DefaultMaxPods
+	}
+	for _, worker := range w.cluster.Shoot.Spec.Provider.Workers {
+		if worker.Name == poolName && worker.Kubernetes != nil && worker.Kubernetes.Kubelet != nil && worker.Kubernetes.Kubelet.MaxPods != nil {
+			return *worker.Kubernetes.Kubelet.MaxPods
+		}
+	}
+	if kubelet := w.cluster.Shoot.Spec.Kubernetes.Kubelet; kubelet != nil && kubelet.MaxPods != nil {
+		return *kubelet.MaxPods
+	}
+	return aws.DefaultMaxPods
+}
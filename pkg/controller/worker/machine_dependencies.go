@@ -16,6 +16,19 @@ package worker
 
 import (
 	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/smithy-go"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 )
 
 // DeployMachineDependencies implements genericactuator.WorkerDelegate.
@@ -29,13 +42,99 @@ func (w *workerDelegate) CleanupMachineDependencies(_ context.Context) error {
 }
 
 // PreReconcileHook implements genericactuator.WorkerDelegate.
-func (w *workerDelegate) PreReconcileHook(_ context.Context) error {
+// It validates that the machine types of worker pools in Local Zones and Wavelength Zones are offered in these zones
+// and that the machine types support the network interfaces of the worker pools, creates the placement groups of the worker pools before the machine classes referencing them are deployed and enables
+// the access to the EC2 serial console if a worker pool requires it.
+func (w *workerDelegate) PreReconcileHook(ctx context.Context) error {
+	w.reportProgress(ctx, progressMachineDependencies, "Validating machine types and creating placement groups")
+
+	edgeZoneMachineTypes, err := w.edgeZoneMachineTypes()
+	if err != nil {
+		return err
+	}
+	desired, err := w.desiredPlacementGroups()
+	if err != nil {
+		return err
+	}
+	serialConsole, err := w.serialConsoleRequired()
+	if err != nil {
+		return err
+	}
+	networkInterfaces, err := w.networkInterfacesConfigured()
+	if err != nil {
+		return err
+	}
+	if len(edgeZoneMachineTypes) == 0 && len(desired) == 0 && !serialConsole && !networkInterfaces {
+		return nil
+	}
+
+	awsClient, err := w.newAWSClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := validateEdgeZoneMachineTypes(ctx, awsClient, edgeZoneMachineTypes); err != nil {
+		return err
+	}
+	if err := w.validateNetworkInterfaces(ctx, awsClient); err != nil {
+		return err
+	}
+
+	if len(desired) > 0 {
+		workerStatus, err := w.decodeWorkerProviderStatus()
+		if err != nil {
+			return err
+		}
+		// The placement groups are recorded before they are created, so that they are deleted even if they are removed
+		// from the worker pools in the meantime.
+		if err := w.updatePlacementGroupsStatus(ctx, workerStatus, sets.New(workerStatus.PlacementGroups...).Union(sets.KeySet(desired))); err != nil {
+			return fmt.Errorf("failed to record placement groups: %w", err)
+		}
+	}
+	for name, group := range desired {
+		current, err := awsClient.GetPlacementGroup(ctx, name)
+		if err != nil {
+			return fmt.Errorf("failed to get placement group %s: %w", name, err)
+		}
+		if current != nil {
+			continue
+		}
+		if _, err := awsClient.CreatePlacementGroup(ctx, group); err != nil {
+			return fmt.Errorf("failed to create placement group %s: %w", name, err)
+		}
+	}
+
+	if serialConsole {
+		return enableSerialConsoleAccess(ctx, awsClient)
+	}
 	return nil
 }
 
 // PostReconcileHook implements genericactuator.WorkerDelegate.
-func (w *workerDelegate) PostReconcileHook(_ context.Context) error {
-	return nil
+// It deletes the placement groups which are no longer used by any worker pool, deploys the EC2NodeClasses of the
+// worker pools if Karpenter is enabled, deploys the device plugins of accelerated worker pools, the placeholder pods
+// reserving the pre-provisioned capacity of worker pools and the node termination handler of spot worker pools.
+func (w *workerDelegate) PostReconcileHook(ctx context.Context) error {
+	w.reportProgress(ctx, progressPostReconcile, "Reconciling node classes, device plugins and placement groups")
+
+	if err := w.reconcileKarpenterNodeClasses(ctx); err != nil {
+		return err
+	}
+	if err := w.reconcileDevicePlugins(ctx); err != nil {
+		return err
+	}
+	if err := w.reconcilePreProvisionedCapacity(ctx); err != nil {
+		return err
+	}
+	if err := w.reconcileSpotInterruptionHandler(ctx); err != nil {
+		return err
+	}
+
+	desired, err := w.desiredPlacementGroups()
+	if err != nil {
+		return err
+	}
+	return w.cleanupPlacementGroups(ctx, desired, true)
 }
 
 // PreDeleteHook implements genericactuator.WorkerDelegate.
@@ -44,6 +143,220 @@ func (w *workerDelegate) PreDeleteHook(_ context.Context) error {
 }
 
 // PostDeleteHook implements genericactuator.WorkerDelegate.
-func (w *workerDelegate) PostDeleteHook(_ context.Context) error {
+// It deletes all placement groups of the shoot after all machines are gone, the EC2NodeClasses of the worker pools, the
+// device plugins, the placeholder pods of the pre-provisioned capacity and the node termination handler.
+func (w *workerDelegate) PostDeleteHook(ctx context.Context) error {
+	if err := w.deleteKarpenterNodeClasses(ctx); err != nil {
+		return err
+	}
+	if err := w.deleteDevicePlugins(ctx); err != nil {
+		return err
+	}
+	if err := w.deletePreProvisionedCapacity(ctx); err != nil {
+		return err
+	}
+	if err := w.deleteSpotInterruptionHandler(ctx); err != nil {
+		return err
+	}
+	return w.cleanupPlacementGroups(ctx, nil, false)
+}
+
+// cleanupPlacementGroups deletes the placement groups of the shoot which are not desired anymore. It is skipped if no
+// worker pool uses a placement group and the worker status does not record any created placement group.
+func (w *workerDelegate) cleanupPlacementGroups(ctx context.Context, desired map[string]*awsclient.PlacementGroup, ignoreInUse bool) error {
+	workerStatus, err := w.decodeWorkerProviderStatus()
+	if err != nil {
+		return err
+	}
+	if len(desired) == 0 && len(workerStatus.PlacementGroups) == 0 {
+		return nil
+	}
+
+	awsClient, err := w.newAWSClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	current, err := awsClient.FindPlacementGroupsByTags(ctx, w.placementGroupTags())
+	if err != nil {
+		return fmt.Errorf("failed to list placement groups: %w", err)
+	}
+
+	remaining := sets.KeySet(desired)
+	for _, group := range current {
+		if _, ok := desired[group.GroupName]; ok {
+			continue
+		}
+		if err := awsClient.DeletePlacementGroup(ctx, group.GroupName); err != nil {
+			// Terminated machines can still be members of the placement group for a while after a rolling update, the
+			// deletion is retried with the next reconciliation.
+			if ignoreInUse && isPlacementGroupInUseError(err) {
+				remaining.Insert(group.GroupName)
+				continue
+			}
+			return fmt.Errorf("failed to delete placement group %s: %w", group.GroupName, err)
+		}
+	}
+
+	// The status is not updated anymore once the worker is deleted.
+	if !ignoreInUse {
+		return nil
+	}
+	if err := w.updatePlacementGroupsStatus(ctx, workerStatus, remaining); err != nil {
+		return fmt.Errorf("failed to record placement groups: %w", err)
+	}
+	return nil
+}
+
+// updatePlacementGroupsStatus records the names of the given placement groups in the worker status, if they changed.
+func (w *workerDelegate) updatePlacementGroupsStatus(ctx context.Context, workerStatus *awsapi.WorkerStatus, names sets.Set[string]) error {
+	if sets.New(workerStatus.PlacementGroups...).Equal(names) {
+		return nil
+	}
+	workerStatus.PlacementGroups = sets.List(names)
+	return w.updateWorkerProviderStatus(ctx, workerStatus)
+}
+
+// edgeZoneMachineTypes returns the machine types of all worker pools by the Local Zones and Wavelength Zones they are
+// used in. Only a subset of the instance types is offered in these zones.
+func (w *workerDelegate) edgeZoneMachineTypes() (map[string]sets.Set[string], error) {
+	infrastructureConfig, err := helper.InfrastructureConfigFromCluster(w.cluster)
+	if err != nil {
+		return nil, err
+	}
+	if infrastructureConfig == nil {
+		return nil, nil
+	}
+
+	machineTypes := map[string]sets.Set[string]{}
+	for _, pool := range w.worker.Spec.Pools {
+		for _, zone := range pool.Zones {
+			if helper.GetZoneType(infrastructureConfig, zone) == awsapi.ZoneTypeAvailabilityZone {
+				continue
+			}
+			if machineTypes[zone] == nil {
+				machineTypes[zone] = sets.New[string]()
+			}
+			machineTypes[zone].Insert(pool.MachineType)
+		}
+	}
+	return machineTypes, nil
+}
+
+// validateEdgeZoneMachineTypes validates that the given machine types are offered in the Local Zones and Wavelength
+// Zones they are used in.
+func validateEdgeZoneMachineTypes(ctx context.Context, awsClient awsclient.Interface, machineTypes map[string]sets.Set[string]) error {
+	for _, zone := range sets.List(sets.KeySet(machineTypes)) {
+		offered, err := awsClient.GetOfferedInstanceTypes(ctx, zone)
+		if err != nil {
+			return fmt.Errorf("failed to get instance types offered in zone %s: %w", zone, err)
+		}
+		if notOffered := machineTypes[zone].Difference(offered); notOffered.Len() > 0 {
+			return v1beta1helper.NewErrorWithCodes(fmt.Errorf("machine types %v are not offered in zone %s", sets.List(notOffered), zone), gardencorev1beta1.ErrorConfigurationProblem)
+		}
+	}
 	return nil
 }
+
+// serialConsoleRequired returns whether any worker pool requires the access to the EC2 serial console.
+func (w *workerDelegate) serialConsoleRequired() (bool, error) {
+	for _, pool := range w.worker.Spec.Pools {
+		workerConfig, err := w.decodeWorkerConfig(pool.ProviderConfig)
+		if err != nil {
+			return false, err
+		}
+		if workerConfig.SerialConsole != nil && workerConfig.SerialConsole.Enabled {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// enableSerialConsoleAccess enables the access to the EC2 serial console, which is a setting of the AWS account per
+// region. It is never disabled again, as other shoots or workloads in the same account may rely on it.
+func enableSerialConsoleAccess(ctx context.Context, awsClient awsclient.Interface) error {
+	enabled, err := awsClient.GetSerialConsoleAccessStatus(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get serial console access status: %w", err)
+	}
+	if enabled {
+		return nil
+	}
+	if err := awsClient.EnableSerialConsoleAccess(ctx); err != nil {
+		return fmt.Errorf("failed to enable serial console access: %w", err)
+	}
+	return nil
+}
+
+// desiredPlacementGroups returns the placement groups of all worker pools by their names. Placement groups are created
+// per zone, as placement groups with the cluster strategy cannot span multiple availability zones.
+func (w *workerDelegate) desiredPlacementGroups() (map[string]*awsclient.PlacementGroup, error) {
+	groups := map[string]*awsclient.PlacementGroup{}
+	for _, pool := range w.worker.Spec.Pools {
+		workerConfig, err := w.decodeWorkerConfig(pool.ProviderConfig)
+		if err != nil {
+			return nil, err
+		}
+		if workerConfig.PlacementGroup == nil {
+			continue
+		}
+
+		for _, zone := range pool.Zones {
+			name := placementGroupName(w.worker.Namespace, pool.Name, zone, workerConfig.PlacementGroup)
+			tags := w.placementGroupTags()
+			tags["Name"] = name
+			groups[name] = &awsclient.PlacementGroup{
+				Tags:           tags,
+				GroupName:      name,
+				Strategy:       string(workerConfig.PlacementGroup.Strategy),
+				PartitionCount: workerConfig.PlacementGroup.PartitionCount,
+			}
+		}
+	}
+	return groups, nil
+}
+
+// placementGroupName returns the name of the placement group of a worker pool in the given zone. As placement groups
+// cannot be modified, the strategy is part of the name, i.e. a changed strategy results in a new placement group.
+func placementGroupName(namespace, poolName, zone string, placementGroup *awsapi.PlacementGroup) string {
+	name := fmt.Sprintf("%s-%s-%s-%s", namespace, poolName, zone, placementGroup.Strategy)
+	if placementGroup.PartitionCount != nil {
+		name += fmt.Sprintf("-%d", *placementGroup.PartitionCount)
+	}
+	return name
+}
+
+func (w *workerDelegate) placementGroupTags() awsclient.Tags {
+	return awsclient.Tags{fmt.Sprintf("kubernetes.io/cluster/%s", w.worker.Namespace): "1"}
+}
+
+// instanceTypeInfo returns the EC2 data of the machine type of the given worker pool.
+func (w *workerDelegate) instanceTypeInfo(ctx context.Context, pool extensionsv1alpha1.WorkerPool) (*awsclient.InstanceTypeInfo, error) {
+	awsClient, err := w.newAWSClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	info, err := awsClient.GetInstanceTypeInfo(ctx, pool.MachineType)
+	if err != nil {
+		return nil, fmt.Errorf("could not get instance type %s of worker pool %s: %w", pool.MachineType, pool.Name, err)
+	}
+	if info == nil {
+		return nil, fmt.Errorf("instance type %s of worker pool %s is unknown", pool.MachineType, pool.Name)
+	}
+	return info, nil
+}
+
+func (w *workerDelegate) newAWSClient(ctx context.Context) (awsclient.Interface, error) {
+	credentials, err := aws.GetCredentialsFromSecretRef(ctx, w.client, w.worker.Spec.SecretRef, false)
+	if err != nil {
+		return nil, fmt.Errorf("could not get AWS credentials: %w", err)
+	}
+	authConfig := aws.NewAuthConfig(credentials, w.worker.Spec.Region)
+	authConfig.Shoot = w.worker.Namespace
+	return w.awsClientFactory.NewClient(authConfig)
+}
+
+func isPlacementGroupInUseError(err error) bool {
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode() == "InvalidPlacementGroup.InUse"
+}
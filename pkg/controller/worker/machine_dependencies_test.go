@@ -0,0 +1,592 @@
+// Copyright (c) 2020 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aws/smithy-go"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"go.uber.org/mock/gomock"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/pointer"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	apiv1alpha1 "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	mockawsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/mock"
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/controller/worker"
+)
+
+var _ = Describe("MachineDependencies", func() {
+	const (
+		namespace = "shoot--foobar--aws"
+		region    = "eu-west-1"
+		zone      = "eu-west-1a"
+	)
+
+	var (
+		ctrl             *gomock.Controller
+		c                *mockclient.MockClient
+		awsClientFactory *mockawsclient.MockFactory
+		awsClient        *mockawsclient.MockInterface
+		scheme           *runtime.Scheme
+		decoder          runtime.Decoder
+
+		w           *extensionsv1alpha1.Worker
+		clusterTags awsclient.Tags
+		groupName   string
+
+		expectPlacementGroupsRecorded = func(names ...string) {
+			statusWriter := mockclient.NewMockStatusWriter(ctrl)
+			c.EXPECT().Status().Return(statusWriter)
+			statusWriter.EXPECT().Patch(ctx, w, gomock.Any()).Do(func(_ context.Context, obj *extensionsv1alpha1.Worker, _ client.Patch, _ ...client.SubResourcePatchOption) {
+				Expect(obj.Status.ProviderStatus.Object).To(PointTo(MatchFields(IgnoreExtras, Fields{"PlacementGroups": Equal(names)})))
+			})
+		}
+		expectAWSClient = func() {
+			c.EXPECT().Get(ctx, kutil.Key(namespace, "secret"), gomock.AssignableToTypeOf(&corev1.Secret{})).DoAndReturn(
+				func(_ context.Context, _ client.ObjectKey, obj *corev1.Secret, _ ...client.GetOption) error {
+					obj.Data = map[string][]byte{
+						aws.AccessKeyID:     []byte("accessKeyID"),
+						aws.SecretAccessKey: []byte("secretAccessKey"),
+					}
+					return nil
+				},
+			)
+			awsClientFactory.EXPECT().NewClient(awsclient.AuthConfig{AccessKeyID: "accessKeyID", SecretAccessKey: "secretAccessKey", Region: region, Shoot: namespace}).Return(awsClient, nil)
+		}
+		expectKarpenterNodeClassesDeleted = func() {
+			c.EXPECT().Get(ctx, kutil.Key(namespace, "extension-worker-karpenter"), gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})).
+				Return(apierrors.NewNotFound(schema.GroupResource{}, "extension-worker-karpenter"))
+			c.EXPECT().Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "managedresource-extension-worker-karpenter", Namespace: namespace}})
+		}
+		expectDevicePluginsDeleted = func() {
+			c.EXPECT().Get(ctx, kutil.Key(namespace, "extension-worker-device-plugins"), gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})).
+				Return(apierrors.NewNotFound(schema.GroupResource{}, "extension-worker-device-plugins"))
+			c.EXPECT().Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "managedresource-extension-worker-device-plugins", Namespace: namespace}})
+		}
+		expectPreProvisionedCapacityDeleted = func() {
+			c.EXPECT().Get(ctx, kutil.Key(namespace, "extension-worker-pre-provisioned-capacity"), gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})).
+				Return(apierrors.NewNotFound(schema.GroupResource{}, "extension-worker-pre-provisioned-capacity"))
+			c.EXPECT().Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "managedresource-extension-worker-pre-provisioned-capacity", Namespace: namespace}})
+		}
+		expectSpotInterruptionHandlerDeleted = func() {
+			c.EXPECT().Get(ctx, kutil.Key(namespace, "extension-worker-spot-interruption-handler"), gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResource{})).
+				Return(apierrors.NewNotFound(schema.GroupResource{}, "extension-worker-spot-interruption-handler"))
+			c.EXPECT().Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "managedresource-extension-worker-spot-interruption-handler", Namespace: namespace}})
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		c = mockclient.NewMockClient(ctrl)
+		awsClientFactory = mockawsclient.NewMockFactory(ctrl)
+		awsClient = mockawsclient.NewMockInterface(ctrl)
+
+		scheme = runtime.NewScheme()
+		_ = api.AddToScheme(scheme)
+		_ = apiv1alpha1.AddToScheme(scheme)
+		decoder = serializer.NewCodecFactory(scheme, serializer.EnableStrict).UniversalDecoder()
+
+		w = &extensionsv1alpha1.Worker{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace},
+			Spec: extensionsv1alpha1.WorkerSpec{
+				SecretRef: corev1.SecretReference{Name: "secret", Namespace: namespace},
+				Region:    region,
+				Pools: []extensionsv1alpha1.WorkerPool{
+					{
+						Name:  "pool",
+						Zones: []string{zone},
+						ProviderConfig: &runtime.RawExtension{Raw: encode(&apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+								Kind:       "WorkerConfig",
+							},
+							PlacementGroup: &apiv1alpha1.PlacementGroup{
+								Strategy:       apiv1alpha1.PlacementGroupStrategyPartition,
+								PartitionCount: pointer.Int64(3),
+							},
+						})},
+					},
+				},
+			},
+		}
+
+		clusterTags = awsclient.Tags{"kubernetes.io/cluster/" + namespace: "1"}
+		groupName = namespace + "-pool-" + zone + "-partition-3"
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#PreReconcileHook", func() {
+		It("should create missing placement groups", func() {
+			expectAWSClient()
+			awsClient.EXPECT().GetPlacementGroup(ctx, groupName).Return(nil, nil)
+			awsClient.EXPECT().CreatePlacementGroup(ctx, &awsclient.PlacementGroup{
+				Tags:           awsclient.Tags{"kubernetes.io/cluster/" + namespace: "1", "Name": groupName},
+				GroupName:      groupName,
+				Strategy:       "partition",
+				PartitionCount: pointer.Int64(3),
+			}).Return(&awsclient.PlacementGroup{GroupName: groupName}, nil)
+			expectPlacementGroupsRecorded(groupName)
+
+			workerDelegate, _ := NewWorkerDelegate(c, decoder, scheme, awsClientFactory, nil, "", w, nil)
+			Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+		})
+
+		It("should not create existing placement groups", func() {
+			w.Status.ProviderStatus = &runtime.RawExtension{Raw: encode(&apiv1alpha1.WorkerStatus{
+				TypeMeta:        metav1.TypeMeta{APIVersion: apiv1alpha1.SchemeGroupVersion.String(), Kind: "WorkerStatus"},
+				PlacementGroups: []string{groupName},
+			})}
+			expectAWSClient()
+			awsClient.EXPECT().GetPlacementGroup(ctx, groupName).Return(&awsclient.PlacementGroup{GroupName: groupName}, nil)
+
+			workerDelegate, _ := NewWorkerDelegate(c, decoder, scheme, awsClientFactory, nil, "", w, nil)
+			Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+		})
+
+		It("should enable the serial console access if a worker pool requires it", func() {
+			w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: encode(&apiv1alpha1.WorkerConfig{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+					Kind:       "WorkerConfig",
+				},
+				SerialConsole: &apiv1alpha1.SerialConsole{Enabled: true},
+			})}
+			expectAWSClient()
+			awsClient.EXPECT().GetSerialConsoleAccessStatus(ctx).Return(false, nil)
+			awsClient.EXPECT().EnableSerialConsoleAccess(ctx).Return(nil)
+
+			workerDelegate, _ := NewWorkerDelegate(c, decoder, nil, awsClientFactory, nil, "", w, nil)
+			Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+		})
+
+		It("should not enable the serial console access if it is already enabled", func() {
+			w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: encode(&apiv1alpha1.WorkerConfig{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+					Kind:       "WorkerConfig",
+				},
+				SerialConsole: &apiv1alpha1.SerialConsole{Enabled: true},
+			})}
+			expectAWSClient()
+			awsClient.EXPECT().GetSerialConsoleAccessStatus(ctx).Return(true, nil)
+
+			workerDelegate, _ := NewWorkerDelegate(c, decoder, nil, awsClientFactory, nil, "", w, nil)
+			Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+		})
+
+		It("should not call AWS if no worker pool uses a placement group", func() {
+			w.Spec.Pools[0].ProviderConfig = nil
+
+			workerDelegate, _ := NewWorkerDelegate(c, decoder, nil, awsClientFactory, nil, "", w, nil)
+			Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+		})
+
+		It("should report the progress of the reconciliation", func() {
+			w.Spec.Pools[0].ProviderConfig = nil
+			w.Status.LastOperation = &gardencorev1beta1.LastOperation{
+				Type:     gardencorev1beta1.LastOperationTypeReconcile,
+				State:    gardencorev1beta1.LastOperationStateProcessing,
+				Progress: 1,
+			}
+			statusWriter := mockclient.NewMockStatusWriter(ctrl)
+			c.EXPECT().Status().Return(statusWriter)
+			statusWriter.EXPECT().Patch(ctx, w, gomock.Any())
+
+			workerDelegate, _ := NewWorkerDelegate(c, decoder, nil, awsClientFactory, nil, "", w, nil)
+			Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+			Expect(w.Status.LastOperation.Progress).To(Equal(int32(10)))
+			Expect(w.Status.LastOperation.Description).To(Equal("Validating machine types and creating placement groups"))
+		})
+
+		It("should not report the progress of the deletion", func() {
+			w.Spec.Pools[0].ProviderConfig = nil
+			w.Status.LastOperation = &gardencorev1beta1.LastOperation{
+				Type:  gardencorev1beta1.LastOperationTypeDelete,
+				State: gardencorev1beta1.LastOperationStateProcessing,
+			}
+
+			workerDelegate, _ := NewWorkerDelegate(c, decoder, nil, awsClientFactory, nil, "", w, nil)
+			Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+			Expect(w.Status.LastOperation.Progress).To(BeZero())
+		})
+	})
+
+	Describe("#PreReconcileHook in edge zones", func() {
+		const localZone = "eu-west-1-ham-1a"
+
+		var cluster *extensionscontroller.Cluster
+
+		BeforeEach(func() {
+			w.Spec.Pools[0].ProviderConfig = nil
+			w.Spec.Pools[0].MachineType = "m5.large"
+			w.Spec.Pools[0].Zones = []string{zone, localZone}
+
+			cluster = &extensionscontroller.Cluster{
+				Shoot: &gardencorev1beta1.Shoot{
+					Spec: gardencorev1beta1.ShootSpec{
+						Provider: gardencorev1beta1.Provider{
+							InfrastructureConfig: &runtime.RawExtension{Raw: encode(&apiv1alpha1.InfrastructureConfig{
+								TypeMeta: metav1.TypeMeta{
+									APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+									Kind:       "InfrastructureConfig",
+								},
+								Networks: apiv1alpha1.Networks{
+									Zones: []apiv1alpha1.Zone{
+										{Name: zone},
+										{Name: localZone, Type: ptr.To(apiv1alpha1.ZoneTypeLocalZone)},
+									},
+								},
+							})},
+						},
+					},
+				},
+			}
+		})
+
+		It("should succeed if the machine types are offered in the edge zones", func() {
+			expectAWSClient()
+			awsClient.EXPECT().GetOfferedInstanceTypes(ctx, localZone).Return(sets.New("m5.large", "t3.medium"), nil)
+
+			workerDelegate, _ := NewWorkerDelegate(c, decoder, nil, awsClientFactory, nil, "", w, cluster)
+			Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+		})
+
+		It("should fail with a configuration problem if a machine type is not offered in an edge zone", func() {
+			expectAWSClient()
+			awsClient.EXPECT().GetOfferedInstanceTypes(ctx, localZone).Return(sets.New("t3.medium"), nil)
+
+			workerDelegate, _ := NewWorkerDelegate(c, decoder, nil, awsClientFactory, nil, "", w, cluster)
+			err := workerDelegate.PreReconcileHook(ctx)
+			Expect(err).To(MatchError("machine types [m5.large] are not offered in zone eu-west-1-ham-1a"))
+			var coder v1beta1helper.Coder
+			Expect(errors.As(err, &coder)).To(BeTrue())
+			Expect(coder.Codes()).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+		})
+	})
+
+	Describe("#PreReconcileHook with network interfaces", func() {
+		BeforeEach(func() {
+			w.Spec.Pools[0].MachineType = "p4d.24xlarge"
+			w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: encode(&apiv1alpha1.WorkerConfig{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+					Kind:       "WorkerConfig",
+				},
+				NetworkInterfaces: &apiv1alpha1.NetworkInterfaces{
+					EFA:        true,
+					Additional: []apiv1alpha1.AdditionalNetworkInterface{{EFA: true, Count: pointer.Int32(3)}, {}},
+				},
+			})}
+		})
+
+		It("should succeed if the machine type supports the network interfaces", func() {
+			expectAWSClient()
+			awsClient.EXPECT().GetInstanceTypeInfo(ctx, "p4d.24xlarge").Return(&awsclient.InstanceTypeInfo{InstanceType: "p4d.24xlarge", MaxNetworkInterfaces: 60, MaxNetworkCards: 4, MaxEFAInterfaces: 4}, nil)
+
+			workerDelegate, _ := NewWorkerDelegate(c, decoder, nil, awsClientFactory, nil, "", w, nil)
+			Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+		})
+
+		DescribeTable("should fail with a configuration problem if the machine type does not support the network interfaces",
+			func(info *awsclient.InstanceTypeInfo, expectedError string) {
+				expectAWSClient()
+				awsClient.EXPECT().GetInstanceTypeInfo(ctx, "p4d.24xlarge").Return(info, nil)
+
+				workerDelegate, _ := NewWorkerDelegate(c, decoder, nil, awsClientFactory, nil, "", w, nil)
+				err := workerDelegate.PreReconcileHook(ctx)
+				Expect(err).To(MatchError(expectedError))
+				var coder v1beta1helper.Coder
+				Expect(errors.As(err, &coder)).To(BeTrue())
+				Expect(coder.Codes()).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+			},
+			Entry("unknown machine type", nil, "machine type p4d.24xlarge of worker pool pool is unknown"),
+			Entry("no EFA support", &awsclient.InstanceTypeInfo{MaxNetworkInterfaces: 60}, "machine type p4d.24xlarge of worker pool pool does not support Elastic Fabric Adapters"),
+			Entry("too many EFAs", &awsclient.InstanceTypeInfo{MaxNetworkInterfaces: 60, MaxEFAInterfaces: 1}, "machine type p4d.24xlarge of worker pool pool supports at most 1 Elastic Fabric Adapters, but 4 are configured"),
+			Entry("too many network interfaces", &awsclient.InstanceTypeInfo{MaxNetworkInterfaces: 4, MaxEFAInterfaces: 4}, "machine type p4d.24xlarge of worker pool pool supports at most 4 network interfaces, but 5 are configured"),
+		)
+	})
+
+	Describe("#PostReconcileHook", func() {
+		It("should delete unused placement groups and ignore groups which are still in use", func() {
+			expectKarpenterNodeClassesDeleted()
+			expectDevicePluginsDeleted()
+			expectPreProvisionedCapacityDeleted()
+			expectSpotInterruptionHandlerDeleted()
+			expectAWSClient()
+			awsClient.EXPECT().FindPlacementGroupsByTags(ctx, clusterTags).Return([]*awsclient.PlacementGroup{
+				{GroupName: groupName},
+				{GroupName: "old"},
+				{GroupName: "in-use"},
+			}, nil)
+			awsClient.EXPECT().DeletePlacementGroup(ctx, "old").Return(nil)
+			awsClient.EXPECT().DeletePlacementGroup(ctx, "in-use").Return(&smithy.GenericAPIError{Code: "InvalidPlacementGroup.InUse", Message: "in use"})
+			expectPlacementGroupsRecorded("in-use", groupName)
+
+			workerDelegate, _ := NewWorkerDelegate(c, decoder, scheme, awsClientFactory, nil, "", w, nil)
+			Expect(workerDelegate.PostReconcileHook(ctx)).To(Succeed())
+		})
+
+		It("should delete the recorded placement groups if no worker pool uses a placement group anymore", func() {
+			w.Spec.Pools[0].ProviderConfig = nil
+			w.Status.ProviderStatus = &runtime.RawExtension{Raw: encode(&apiv1alpha1.WorkerStatus{
+				TypeMeta:        metav1.TypeMeta{APIVersion: apiv1alpha1.SchemeGroupVersion.String(), Kind: "WorkerStatus"},
+				PlacementGroups: []string{groupName},
+			})}
+			expectKarpenterNodeClassesDeleted()
+			expectDevicePluginsDeleted()
+			expectPreProvisionedCapacityDeleted()
+			expectSpotInterruptionHandlerDeleted()
+			expectAWSClient()
+			awsClient.EXPECT().FindPlacementGroupsByTags(ctx, clusterTags).Return([]*awsclient.PlacementGroup{{GroupName: groupName}}, nil)
+			awsClient.EXPECT().DeletePlacementGroup(ctx, groupName).Return(nil)
+			expectPlacementGroupsRecorded([]string{}...)
+
+			workerDelegate, _ := NewWorkerDelegate(c, decoder, scheme, awsClientFactory, nil, "", w, nil)
+			Expect(workerDelegate.PostReconcileHook(ctx)).To(Succeed())
+		})
+
+		It("should not look for placement groups if none were created", func() {
+			w.Spec.Pools[0].ProviderConfig = nil
+			expectKarpenterNodeClassesDeleted()
+			expectDevicePluginsDeleted()
+			expectPreProvisionedCapacityDeleted()
+			expectSpotInterruptionHandlerDeleted()
+
+			workerDelegate, _ := NewWorkerDelegate(c, decoder, scheme, awsClientFactory, nil, "", w, nil)
+			Expect(workerDelegate.PostReconcileHook(ctx)).To(Succeed())
+		})
+
+		It("should deploy the device plugins of accelerated worker pools", func() {
+			devicePluginConfig := &runtime.RawExtension{Raw: encode(&apiv1alpha1.WorkerConfig{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+					Kind:       "WorkerConfig",
+				},
+				DevicePlugin: &apiv1alpha1.DevicePlugin{Enabled: true},
+			})}
+			w.Spec.Pools = []extensionsv1alpha1.WorkerPool{
+				{Name: "gpu-b", MachineType: "g5.xlarge", ProviderConfig: devicePluginConfig},
+				{Name: "gpu-a", MachineType: "p4d.24xlarge", ProviderConfig: devicePluginConfig},
+				{Name: "inferentia", MachineType: "inf2.xlarge", ProviderConfig: devicePluginConfig},
+				{Name: "gpu-without-plugin", MachineType: "g5.xlarge"},
+				{Name: "general-purpose", MachineType: "m5.large", ProviderConfig: devicePluginConfig},
+			}
+
+			fakeClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: namespace},
+				Data: map[string][]byte{
+					aws.AccessKeyID:     []byte("accessKeyID"),
+					aws.SecretAccessKey: []byte("secretAccessKey"),
+				},
+			}).Build()
+			workerDelegate, _ := NewWorkerDelegate(fakeClient, decoder, nil, awsClientFactory, nil, "", w, nil)
+			Expect(workerDelegate.PostReconcileHook(ctx)).To(Succeed())
+
+			managedResource := &resourcesv1alpha1.ManagedResource{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "extension-worker-device-plugins"}, managedResource)).To(Succeed())
+
+			secret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: managedResource.Spec.SecretRefs[0].Name}, secret)).To(Succeed())
+			Expect(secret.Data).To(HaveKey("serviceaccount__kube-system__neuron-device-plugin.yaml"))
+
+			nvidiaDaemonSet := &appsv1.DaemonSet{}
+			Expect(yaml.Unmarshal(secret.Data["daemonset__kube-system__nvidia-device-plugin.yaml"], nvidiaDaemonSet)).To(Succeed())
+			Expect(nvidiaDaemonSet.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions).To(ConsistOf(
+				corev1.NodeSelectorRequirement{Key: "worker.gardener.cloud/pool", Operator: corev1.NodeSelectorOpIn, Values: []string{"gpu-a", "gpu-b"}},
+			))
+
+			neuronDaemonSet := &appsv1.DaemonSet{}
+			Expect(yaml.Unmarshal(secret.Data["daemonset__kube-system__neuron-device-plugin.yaml"], neuronDaemonSet)).To(Succeed())
+			Expect(neuronDaemonSet.Spec.Template.Spec.ServiceAccountName).To(Equal("neuron-device-plugin"))
+			Expect(neuronDaemonSet.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions).To(ConsistOf(
+				corev1.NodeSelectorRequirement{Key: "worker.gardener.cloud/pool", Operator: corev1.NodeSelectorOpIn, Values: []string{"inferentia"}},
+			))
+		})
+
+		It("should deploy the placeholder pods of the pre-provisioned capacity", func() {
+			w.Spec.Pools = []extensionsv1alpha1.WorkerPool{
+				{
+					Name:         "burst",
+					MachineType:  "m5.xlarge",
+					NodeTemplate: &extensionsv1alpha1.NodeTemplate{Capacity: corev1.ResourceList{"cpu": resource.MustParse("4"), "memory": resource.MustParse("16Gi")}},
+					Taints:       []corev1.Taint{{Key: "dedicated", Value: "burst", Effect: corev1.TaintEffectNoSchedule}},
+					ProviderConfig: &runtime.RawExtension{Raw: encode(&apiv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "WorkerConfig",
+						},
+						PreProvisionedCapacity: &apiv1alpha1.PreProvisionedCapacity{Machines: 2},
+					})},
+				},
+				{Name: "default"},
+			}
+
+			fakeClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: namespace},
+				Data: map[string][]byte{
+					aws.AccessKeyID:     []byte("accessKeyID"),
+					aws.SecretAccessKey: []byte("secretAccessKey"),
+				},
+			}).Build()
+			workerDelegate, _ := NewWorkerDelegate(fakeClient, decoder, nil, awsClientFactory, nil, "", w, nil)
+			Expect(workerDelegate.PostReconcileHook(ctx)).To(Succeed())
+
+			managedResource := &resourcesv1alpha1.ManagedResource{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "extension-worker-pre-provisioned-capacity"}, managedResource)).To(Succeed())
+
+			secret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: managedResource.Spec.SecretRefs[0].Name}, secret)).To(Succeed())
+			Expect(secret.Data).To(HaveKey("priorityclass____gardener.cloud_aws_pre-provisioned-capacity.yaml"))
+			Expect(secret.Data).NotTo(HaveKey("deployment__kube-system__pre-provisioned-capacity-default.yaml"))
+
+			deployment := &appsv1.Deployment{}
+			Expect(yaml.Unmarshal(secret.Data["deployment__kube-system__pre-provisioned-capacity-burst.yaml"], deployment)).To(Succeed())
+			Expect(deployment.Spec.Replicas).To(Equal(ptr.To(int32(2))))
+			Expect(deployment.Spec.Template.Spec.NodeSelector).To(Equal(map[string]string{"worker.gardener.cloud/pool": "burst"}))
+			Expect(deployment.Spec.Template.Spec.Tolerations).To(ConsistOf(
+				corev1.Toleration{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "burst", Effect: corev1.TaintEffectNoSchedule},
+			))
+			Expect(deployment.Spec.Template.Spec.Containers[0].Resources.Requests.Cpu().String()).To(Equal("2"))
+			Expect(deployment.Spec.Template.Spec.Containers[0].Resources.Requests.Memory().String()).To(Equal("8Gi"))
+
+			Expect(testutil.GatherAndCompare(metrics.Registry, strings.NewReader(`
+# HELP aws_worker_pre_provisioned_capacity_machines Number of machines of a worker pool which are kept available for scale-ups by the pre-provisioned capacity.
+# TYPE aws_worker_pre_provisioned_capacity_machines gauge
+aws_worker_pre_provisioned_capacity_machines{machine_type="m5.xlarge",namespace="`+namespace+`",pool="burst"} 2
+`), "aws_worker_pre_provisioned_capacity_machines")).To(Succeed())
+		})
+
+		It("should deploy the node termination handler of spot worker pools", func() {
+			spotConfig := func(handling *apiv1alpha1.SpotInterruptionHandling) *runtime.RawExtension {
+				return &runtime.RawExtension{Raw: encode(&apiv1alpha1.WorkerConfig{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						Kind:       "WorkerConfig",
+					},
+					CapacityType:             ptr.To(apiv1alpha1.CapacityTypeSpot),
+					SpotInterruptionHandling: handling,
+				})}
+			}
+			w.Spec.Pools = []extensionsv1alpha1.WorkerPool{
+				{Name: "spot-b", ProviderConfig: spotConfig(nil)},
+				{Name: "spot-a", ProviderConfig: spotConfig(&apiv1alpha1.SpotInterruptionHandling{Enabled: ptr.To(true)})},
+				{Name: "spot-rebalance", ProviderConfig: spotConfig(&apiv1alpha1.SpotInterruptionHandling{DrainOnRebalanceRecommendation: true})},
+				{Name: "spot-disabled", ProviderConfig: spotConfig(&apiv1alpha1.SpotInterruptionHandling{Enabled: ptr.To(false)})},
+				{Name: "on-demand"},
+			}
+
+			fakeClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: namespace},
+				Data: map[string][]byte{
+					aws.AccessKeyID:     []byte("accessKeyID"),
+					aws.SecretAccessKey: []byte("secretAccessKey"),
+				},
+			}).Build()
+			workerDelegate, _ := NewWorkerDelegate(fakeClient, decoder, nil, awsClientFactory, nil, "", w, nil)
+			Expect(workerDelegate.PostReconcileHook(ctx)).To(Succeed())
+
+			managedResource := &resourcesv1alpha1.ManagedResource{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "extension-worker-spot-interruption-handler"}, managedResource)).To(Succeed())
+
+			secret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: managedResource.Spec.SecretRefs[0].Name}, secret)).To(Succeed())
+			Expect(secret.Data).To(HaveKey("serviceaccount__kube-system__aws-node-termination-handler.yaml"))
+
+			daemonSet := &appsv1.DaemonSet{}
+			Expect(yaml.Unmarshal(secret.Data["daemonset__kube-system__aws-node-termination-handler.yaml"], daemonSet)).To(Succeed())
+			Expect(daemonSet.Spec.Template.Spec.HostNetwork).To(BeTrue())
+			Expect(daemonSet.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions).To(ConsistOf(
+				corev1.NodeSelectorRequirement{Key: "worker.gardener.cloud/pool", Operator: corev1.NodeSelectorOpIn, Values: []string{"spot-a", "spot-b"}},
+			))
+			Expect(daemonSet.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "ENABLE_REBALANCE_DRAINING", Value: "false"}))
+
+			rebalanceDaemonSet := &appsv1.DaemonSet{}
+			Expect(yaml.Unmarshal(secret.Data["daemonset__kube-system__aws-node-termination-handler-rebalance-draining.yaml"], rebalanceDaemonSet)).To(Succeed())
+			Expect(rebalanceDaemonSet.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions).To(ConsistOf(
+				corev1.NodeSelectorRequirement{Key: "worker.gardener.cloud/pool", Operator: corev1.NodeSelectorOpIn, Values: []string{"spot-rebalance"}},
+			))
+			Expect(rebalanceDaemonSet.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "ENABLE_REBALANCE_DRAINING", Value: "true"}))
+		})
+	})
+
+	Describe("#PostDeleteHook", func() {
+		BeforeEach(func() {
+			w.Status.ProviderStatus = &runtime.RawExtension{Raw: encode(&apiv1alpha1.WorkerStatus{
+				TypeMeta:        metav1.TypeMeta{APIVersion: apiv1alpha1.SchemeGroupVersion.String(), Kind: "WorkerStatus"},
+				PlacementGroups: []string{groupName},
+			})}
+		})
+
+		It("should delete all placement groups", func() {
+			expectKarpenterNodeClassesDeleted()
+			expectDevicePluginsDeleted()
+			expectPreProvisionedCapacityDeleted()
+			expectSpotInterruptionHandlerDeleted()
+			expectAWSClient()
+			awsClient.EXPECT().FindPlacementGroupsByTags(ctx, clusterTags).Return([]*awsclient.PlacementGroup{{GroupName: groupName}}, nil)
+			awsClient.EXPECT().DeletePlacementGroup(ctx, groupName).Return(nil)
+
+			workerDelegate, _ := NewWorkerDelegate(c, decoder, nil, awsClientFactory, nil, "", w, nil)
+			Expect(workerDelegate.PostDeleteHook(ctx)).To(Succeed())
+		})
+
+		It("should fail if a placement group is still in use", func() {
+			expectKarpenterNodeClassesDeleted()
+			expectDevicePluginsDeleted()
+			expectPreProvisionedCapacityDeleted()
+			expectSpotInterruptionHandlerDeleted()
+			expectAWSClient()
+			awsClient.EXPECT().FindPlacementGroupsByTags(ctx, clusterTags).Return([]*awsclient.PlacementGroup{{GroupName: groupName}}, nil)
+			awsClient.EXPECT().DeletePlacementGroup(ctx, groupName).Return(&smithy.GenericAPIError{Code: "InvalidPlacementGroup.InUse", Message: "in use"})
+
+			workerDelegate, _ := NewWorkerDelegate(c, decoder, nil, awsClientFactory, nil, "", w, nil)
+			Expect(workerDelegate.PostDeleteHook(ctx)).To(MatchError(ContainSubstring("failed to delete placement group")))
+		})
+	})
+})
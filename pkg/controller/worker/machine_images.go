@@ -18,8 +18,10 @@ import (
 	"context"
 	"fmt"
 
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/gardener/gardener/extensions/pkg/controller/worker"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"k8s.io/utils/pointer"
 
 	api "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
@@ -28,7 +30,7 @@ import (
 // UpdateMachineImagesStatus implements genericactuator.WorkerDelegate.
 func (w *workerDelegate) UpdateMachineImagesStatus(ctx context.Context) error {
 	if w.machineImages == nil {
-		if err := w.generateMachineConfig(); err != nil {
+		if err := w.generateMachineConfig(ctx); err != nil {
 			return fmt.Errorf("unable to generate the machine config: %w", err)
 		}
 	}
@@ -44,10 +46,17 @@ func (w *workerDelegate) UpdateMachineImagesStatus(ctx context.Context) error {
 		return fmt.Errorf("unable to update worker provider status: %w", err)
 	}
 
+	// the machine deployments are rolled out after the machine images are reported in the status
+	w.reportProgress(ctx, progressMachines, "Rolling out machine deployments and waiting for the machines")
 	return nil
 }
 
-func (w *workerDelegate) findMachineImage(name, version string, region string, arch *string) (string, error) {
+// findMachineImage returns the AMI of the given machine image in the given region. AMIs which are mapped for the region
+// in the CloudProfile take precedence. Otherwise, the SSM parameter referenced in the CloudProfile is resolved or the
+// source image is copied to the region, unless the machine image is already contained in the worker status. This pins
+// the AMI of a machine image version, so that machines are not rolled when the SSM parameter is updated, e.g. for
+// parameters referencing the latest AMI.
+func (w *workerDelegate) findMachineImage(ctx context.Context, name, version string, region string, arch *string) (string, error) {
 	ami, err := helper.FindAMIForRegionFromCloudProfile(w.cloudProfileConfig, name, version, region, arch)
 	if err == nil {
 		return ami, nil
@@ -60,15 +69,95 @@ func (w *workerDelegate) findMachineImage(name, version string, region string, a
 			return "", fmt.Errorf("could not decode worker status of worker '%s': %w", kutil.ObjectName(w.worker), err)
 		}
 
-		machineImage, err := helper.FindMachineImage(workerStatus.MachineImages, name, version, arch)
+		if machineImage, err := helper.FindMachineImage(workerStatus.MachineImages, name, version, arch); err == nil {
+			return machineImage.AMI, nil
+		}
+	}
+
+	if parameter, err := helper.FindSSMParameterFromCloudProfile(w.cloudProfileConfig, name, version, arch); err == nil {
+		return w.resolveSSMParameter(ctx, name, version, region, parameter)
+	}
+	if sourceImage, err := helper.FindSourceImageFromCloudProfile(w.cloudProfileConfig, name, version, arch); err == nil {
+		return w.ensureImageCopy(ctx, name, version, region, sourceImage)
+	}
+	return "", worker.ErrorMachineImageNotFound(name, version, *arch, region)
+}
+
+// selectMachineImage returns the newest AMI matching the given image selector of the given worker pool.
+func (w *workerDelegate) selectMachineImage(ctx context.Context, poolName string, selector *api.ImageSelector, arch string) (string, error) {
+	awsClient, err := w.newAWSClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	ami, err := awsClient.FindNewestImage(ctx, selector.Owners, selector.Name, selector.Tags, pointer.StringDeref(selector.Architecture, arch))
+	if err != nil {
+		return "", fmt.Errorf("could not select AMI for worker pool %s: %w", poolName, err)
+	}
+	if ami == "" {
+		return "", fmt.Errorf("no available AMI in region %s matches the image selector of worker pool %s", w.worker.Spec.Region, poolName)
+	}
+	return ami, nil
+}
+
+// resolveSSMParameter returns the AMI contained in the given SSM parameter of the given machine image.
+func (w *workerDelegate) resolveSSMParameter(ctx context.Context, name, version, region, parameter string) (string, error) {
+	awsClient, err := w.newAWSClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	ami, err := awsClient.GetSSMParameter(ctx, parameter)
+	if err != nil {
+		return "", fmt.Errorf("could not resolve SSM parameter %s of machine image %s/%s: %w", parameter, name, version, err)
+	}
+	if ami == "" {
+		return "", fmt.Errorf("SSM parameter %s of machine image %s/%s does not exist in region %s", parameter, name, version, region)
+	}
+	return ami, nil
+}
+
+// ensureImageCopy returns the copy of the given source image of the given machine image in the given region. If there
+// is no copy yet, the source image is copied and an error is returned until the copy is available. Copies are shared by
+// all shoots of the account in the region and are not deleted.
+func (w *workerDelegate) ensureImageCopy(ctx context.Context, name, version, region string, sourceImage *api.SourceImage) (string, error) {
+	if sourceImage.Region == region {
+		return sourceImage.AMI, nil
+	}
+
+	awsClient, err := w.newAWSClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	image, err := awsClient.FindImageCopy(ctx, sourceImage.Region, sourceImage.AMI)
+	if err != nil {
+		return "", fmt.Errorf("could not find copy of AMI %s of machine image %s/%s: %w", sourceImage.AMI, name, version, err)
+	}
+	if image == nil {
+		copyName := imageCopyName(name, version, *sourceImage.Architecture, sourceImage.AMI)
+		imageID, err := awsClient.CopyImage(ctx, sourceImage.Region, sourceImage.AMI, copyName, sourceImage.KMSKeyID)
 		if err != nil {
-			return "", worker.ErrorMachineImageNotFound(name, version, *arch, region)
+			return "", fmt.Errorf("could not copy AMI %s of machine image %s/%s from region %s: %w", sourceImage.AMI, name, version, sourceImage.Region, err)
 		}
+		return "", fmt.Errorf("AMI %s of machine image %s/%s is being copied from region %s to %s", sourceImage.AMI, name, version, sourceImage.Region, imageID)
+	}
 
-		return machineImage.AMI, nil
+	switch image.State {
+	case string(ec2types.ImageStateAvailable):
+		return image.ImageId, nil
+	case string(ec2types.ImageStatePending):
+		return "", fmt.Errorf("AMI %s of machine image %s/%s is still being copied from region %s to %s", sourceImage.AMI, name, version, sourceImage.Region, image.ImageId)
+	default:
+		return "", fmt.Errorf("copy %s of AMI %s of machine image %s/%s is in state %s (%s), it must be deregistered to copy the AMI again", image.ImageId, sourceImage.AMI, name, version, image.State, image.StateReason)
 	}
+}
 
-	return "", worker.ErrorMachineImageNotFound(name, version, *arch, region)
+// imageCopyName returns the name of the copy of the given source AMI, which must be unique in the region and may consist
+// of at most 128 characters.
+func imageCopyName(name, version, arch, sourceImageID string) string {
+	copyName := fmt.Sprintf("%s-%s-%s-%s", name, version, arch, sourceImageID)
+	if len(copyName) > 128 {
+		copyName = copyName[len(copyName)-128:]
+	}
+	return copyName
 }
 
 func appendMachineImage(machineImages []api.MachineImage, machineImage api.MachineImage) []api.MachineImage {
@@ -16,6 +16,7 @@ package worker
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"path/filepath"
 	"sort"
@@ -23,18 +24,23 @@ import (
 
 	"github.com/gardener/gardener/extensions/pkg/controller/worker"
 	genericworkeractuator "github.com/gardener/gardener/extensions/pkg/controller/worker/genericactuator"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/utils"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener-extension-provider-aws/charts"
 	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 	awsapihelper "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
 )
 
 // MachineClassKind yields the name of the machine class kind used by AWS provider.
@@ -54,8 +60,10 @@ func (w *workerDelegate) MachineClass() client.Object {
 
 // DeployMachineClasses generates and creates the AWS specific machine classes.
 func (w *workerDelegate) DeployMachineClasses(ctx context.Context) error {
+	w.reportProgress(ctx, progressMachineClasses, "Deploying machine classes")
+
 	if w.machineClasses == nil {
-		if err := w.generateMachineConfig(); err != nil {
+		if err := w.generateMachineConfig(ctx); err != nil {
 			return err
 		}
 	}
@@ -64,20 +72,23 @@ func (w *workerDelegate) DeployMachineClasses(ctx context.Context) error {
 }
 
 // GenerateMachineDeployments generates the configuration for the desired machine deployments.
-func (w *workerDelegate) GenerateMachineDeployments(_ context.Context) (worker.MachineDeployments, error) {
+func (w *workerDelegate) GenerateMachineDeployments(ctx context.Context) (worker.MachineDeployments, error) {
+	w.reportProgress(ctx, progressMachineDeployments, "Generating machine deployments")
+
 	if w.machineDeployments == nil {
-		if err := w.generateMachineConfig(); err != nil {
+		if err := w.generateMachineConfig(ctx); err != nil {
 			return nil, err
 		}
 	}
 	return w.machineDeployments, nil
 }
 
-func (w *workerDelegate) generateMachineConfig() error {
+func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 	var (
 		machineDeployments = worker.MachineDeployments{}
 		machineClasses     []map[string]interface{}
 		machineImages      []awsapi.MachineImage
+		ec2NodeClasses     []map[string]interface{}
 	)
 
 	infrastructureStatus := &awsapi.InfrastructureStatus{}
@@ -90,33 +101,49 @@ func (w *workerDelegate) generateMachineConfig() error {
 		return err
 	}
 
+	infrastructureConfig, err := awsapihelper.InfrastructureConfigFromCluster(w.cluster)
+	if err != nil {
+		return err
+	}
+	var infrastructureTags map[string]string
+	if infrastructureConfig != nil {
+		infrastructureTags = infrastructureConfig.Tags
+	}
+
 	for _, pool := range w.worker.Spec.Pools {
 		zoneLen := int32(len(pool.Zones))
 
-		workerConfig := &awsapi.WorkerConfig{}
-		if pool.ProviderConfig != nil && pool.ProviderConfig.Raw != nil {
-			if _, _, err := w.decoder.Decode(pool.ProviderConfig.Raw, nil, workerConfig); err != nil {
-				return fmt.Errorf("could not decode provider config: %+v", err)
-			}
-		}
-
-		workerPoolHash, err := worker.WorkerPoolHash(pool, w.cluster, computeAdditionalHashData(pool)...)
+		workerConfig, err := w.decodeWorkerConfig(pool.ProviderConfig)
 		if err != nil {
 			return err
 		}
 
 		arch := pointer.StringDeref(pool.Architecture, v1beta1constants.ArchitectureAMD64)
 
-		ami, err := w.findMachineImage(pool.MachineImage.Name, pool.MachineImage.Version, w.worker.Spec.Region, &arch)
-		if err != nil {
-			return err
+		additionalHashData := computeAdditionalHashData(pool)
+
+		var ami string
+		if workerConfig.ImageSelector != nil {
+			// The selected AMI is not added to the machine images of the worker status, as it does not belong to the
+			// machine image version of the pool. Instead, it is part of the hash, so that the machines are rolled when
+			// a newer AMI is selected.
+			ami, err = w.selectMachineImage(ctx, pool.Name, workerConfig.ImageSelector, arch)
+			if err != nil {
+				return err
+			}
+			additionalHashData = append(additionalHashData, ami)
+		} else {
+			ami, err = w.findMachineImage(ctx, pool.MachineImage.Name, pool.MachineImage.Version, w.worker.Spec.Region, &arch)
+			if err != nil {
+				return err
+			}
+			machineImages = appendMachineImage(machineImages, awsapi.MachineImage{
+				Name:         pool.MachineImage.Name,
+				Version:      pool.MachineImage.Version,
+				AMI:          ami,
+				Architecture: &arch,
+			})
 		}
-		machineImages = appendMachineImage(machineImages, awsapi.MachineImage{
-			Name:         pool.MachineImage.Name,
-			Version:      pool.MachineImage.Version,
-			AMI:          ami,
-			Architecture: &arch,
-		})
 
 		blockDevices, err := w.computeBlockDevices(pool, workerConfig)
 		if err != nil {
@@ -130,6 +157,68 @@ func (w *workerDelegate) generateMachineConfig() error {
 
 		instanceMetadataOptions := computeInstanceMetadata(workerConfig)
 
+		userData, err := aws.BuildUserData(pool.UserData, workerConfig.UserData)
+		if err != nil {
+			return v1beta1helper.NewErrorWithCodes(fmt.Errorf("could not compute user data of worker pool %s: %w", pool.Name, err), gardencorev1beta1.ErrorConfigurationProblem)
+		}
+		// Karpenter expects the user data as string, hence only the user data of the machine classes is compressed.
+		machineClassUserData, err := aws.CompressUserData(userData)
+		if err != nil {
+			return v1beta1helper.NewErrorWithCodes(fmt.Errorf("could not compute user data of worker pool %s: %w", pool.Name, err), gardencorev1beta1.ErrorConfigurationProblem)
+		}
+
+		if workerConfig.RollingUpdate != nil {
+			// The machines are rolled when only their launch settings which don't belong to the worker pool change, as
+			// these are otherwise only applied to machines created afterwards.
+			launchSettings, err := computeLaunchSettingsHashData(ami, iamInstanceProfile)
+			if err != nil {
+				return err
+			}
+			additionalHashData = append(additionalHashData, launchSettings)
+		}
+
+		hashedPool, err := withoutPreProvisionedCapacity(pool)
+		if err != nil {
+			return err
+		}
+		workerPoolHash, err := worker.WorkerPoolHash(hashedPool, w.cluster, additionalHashData...)
+		if err != nil {
+			return err
+		}
+
+		// the tags of the worker pool take precedence over the tags of the infrastructure
+		additionalTags := utils.MergeStringMaps(infrastructureTags, workerConfig.Tags)
+
+		kubeletLabels, err := w.computeKubeletLabels(ctx, pool, workerConfig.Kubelet)
+		if err != nil {
+			return err
+		}
+
+		var maxNetworkCards int32
+		if workerConfig.NetworkInterfaces != nil && len(workerConfig.NetworkInterfaces.Additional) > 0 {
+			info, err := w.instanceTypeInfo(ctx, pool)
+			if err != nil {
+				return err
+			}
+			maxNetworkCards = info.MaxNetworkCards
+		}
+
+		var capacityTypeLabels map[string]string
+		if workerConfig.CapacityType != nil && *workerConfig.CapacityType == awsapi.CapacityTypeSpot {
+			capacityTypeLabels = map[string]string{aws.CapacityTypeLabel: string(awsapi.CapacityTypeSpot)}
+		}
+
+		maxSurge, maxUnavailable := pool.MaxSurge, pool.MaxUnavailable
+		if rollingUpdate := workerConfig.RollingUpdate; rollingUpdate != nil {
+			if rollingUpdate.MaxSurge != nil {
+				maxSurge = *rollingUpdate.MaxSurge
+			}
+			if rollingUpdate.MaxUnavailable != nil {
+				maxUnavailable = *rollingUpdate.MaxUnavailable
+			}
+		}
+
+		var subnetIDs []string
 		for zoneIndex, zone := range pool.Zones {
 			zoneIdx := int32(zoneIndex)
 
@@ -137,31 +226,35 @@ func (w *workerDelegate) generateMachineConfig() error {
 			if err != nil {
 				return err
 			}
+			subnetIDs = append(subnetIDs, nodesSubnet.ID)
+			// new machines are placed in the secondary workers subnet of the zone if it exists, as it has been added to
+			// expand the node IPs of the zone; existing machines are not rolled for that
+			machinesSubnetID := nodesSubnet.ID
+			if secondarySubnet, err := awsapihelper.FindSubnetForPurposeAndZone(infrastructureStatus.VPC.Subnets, awsapi.PurposeNodesSecondary, zone); err == nil {
+				subnetIDs = append(subnetIDs, secondarySubnet.ID)
+				machinesSubnetID = secondarySubnet.ID
+			}
 
 			machineClassSpec := map[string]interface{}{
 				"ami":                ami,
 				"region":             w.worker.Spec.Region,
 				"machineType":        pool.MachineType,
 				"iamInstanceProfile": iamInstanceProfile,
-				"networkInterfaces": []map[string]interface{}{
-					{
-						"subnetID":         nodesSubnet.ID,
-						"securityGroupIDs": []string{nodesSecurityGroup.ID},
-					},
-				},
+				"networkInterfaces":  computeNetworkInterfaces(workerConfig.NetworkInterfaces, zone, machinesSubnetID, append([]string{nodesSecurityGroup.ID}, workerConfig.AdditionalSecurityGroupIDs...), maxNetworkCards),
 				"tags": utils.MergeStringMaps(
 					map[string]string{
 						fmt.Sprintf("kubernetes.io/cluster/%s", w.worker.Namespace): "1",
 						"kubernetes.io/role/node":                                   "1",
 					},
 					pool.Labels,
+					additionalTags,
 				),
 				"credentialsSecretRef": map[string]interface{}{
 					"name":      w.worker.Spec.SecretRef.Name,
 					"namespace": w.worker.Spec.SecretRef.Namespace,
 				},
 				"secret": map[string]interface{}{
-					"cloudConfig": string(pool.UserData),
+					"cloudConfig": string(machineClassUserData),
 				},
 				"blockDevices":            blockDevices,
 				"instanceMetadataOptions": instanceMetadataOptions,
@@ -171,6 +264,39 @@ func (w *workerDelegate) generateMachineConfig() error {
 				machineClassSpec["keyName"] = infrastructureStatus.EC2.KeyName
 			}
 
+			if workerConfig.CapacityType != nil && *workerConfig.CapacityType == awsapi.CapacityTypeSpot {
+				// an empty spot price lets AWS use the on-demand price as maximum price
+				machineClassSpec["spotPrice"] = pointer.StringDeref(workerConfig.SpotMaxPrice, "")
+			}
+
+			if workerConfig.EnclaveOptions != nil {
+				machineClassSpec["enclaveOptions"] = map[string]interface{}{
+					"enabled": workerConfig.EnclaveOptions.Enabled,
+				}
+			}
+
+			if workerConfig.Monitoring != nil && workerConfig.Monitoring.Detailed {
+				machineClassSpec["monitoring"] = true
+			}
+
+			if workerConfig.CPUOptions != nil && workerConfig.CPUOptions.AmdSevSnp != nil {
+				machineClassSpec["cpuOptions"] = map[string]interface{}{
+					"amdSevSnp": string(*workerConfig.CPUOptions.AmdSevSnp),
+				}
+			}
+
+			if workerConfig.CapacityReservation != nil {
+				machineClassSpec["capacityReservation"] = computeCapacityReservation(workerConfig.CapacityReservation)
+			}
+
+			placement := computePlacement(workerConfig)
+			if workerConfig.PlacementGroup != nil {
+				placement["groupName"] = placementGroupName(w.worker.Namespace, pool.Name, zone, workerConfig.PlacementGroup)
+			}
+			if len(placement) > 0 {
+				machineClassSpec["placement"] = placement
+			}
+
 			if workerConfig.NodeTemplate != nil {
 				machineClassSpec["nodeTemplate"] = machinev1alpha1.NodeTemplate{
 					Capacity:     workerConfig.NodeTemplate.Capacity,
@@ -199,11 +325,13 @@ func (w *workerDelegate) generateMachineConfig() error {
 				SecretName:     className,
 				Minimum:        worker.DistributeOverZones(zoneIdx, pool.Minimum, zoneLen),
 				Maximum:        worker.DistributeOverZones(zoneIdx, pool.Maximum, zoneLen),
-				MaxSurge:       worker.DistributePositiveIntOrPercent(zoneIdx, pool.MaxSurge, zoneLen, pool.Maximum),
-				MaxUnavailable: worker.DistributePositiveIntOrPercent(zoneIdx, pool.MaxUnavailable, zoneLen, pool.Minimum),
+				MaxSurge:       worker.DistributePositiveIntOrPercent(zoneIdx, maxSurge, zoneLen, pool.Maximum),
+				MaxUnavailable: worker.DistributePositiveIntOrPercent(zoneIdx, maxUnavailable, zoneLen, pool.Minimum),
 				// TODO: remove the csi topology label when AWS CSI driver stops using the aws csi topology key - https://github.com/kubernetes-sigs/aws-ebs-csi-driver/issues/899
 				// add aws csi driver topology label if it's not specified
-				Labels:               utils.MergeStringMaps(pool.Labels, map[string]string{awsCSIDriverTopologyKey: zone}),
+				// The architecture label is added so that the cluster-autoscaler knows the architecture of the nodes when
+				// scaling the worker pool from zero, e.g. for pods selecting arm64 nodes.
+				Labels:               utils.MergeStringMaps(pool.Labels, map[string]string{awsCSIDriverTopologyKey: zone, corev1.LabelArchStable: arch}, capacityTypeLabels, kubeletLabels),
 				Annotations:          pool.Annotations,
 				Taints:               pool.Taints,
 				MachineConfiguration: genericworkeractuator.ReadMachineConfiguration(pool),
@@ -215,11 +343,25 @@ func (w *workerDelegate) generateMachineConfig() error {
 
 			machineClasses = append(machineClasses, machineClassSpec)
 		}
+
+		ec2NodeClasses = append(ec2NodeClasses, w.computeEC2NodeClass(
+			pool,
+			ami,
+			userData,
+			subnetIDs,
+			append([]string{nodesSecurityGroup.ID}, workerConfig.AdditionalSecurityGroupIDs...),
+			iamInstanceProfile,
+			blockDevices[0]["ebs"].(map[string]interface{}),
+			instanceMetadataOptions,
+			workerConfig.Monitoring != nil && workerConfig.Monitoring.Detailed,
+			additionalTags,
+		))
 	}
 
 	w.machineDeployments = machineDeployments
 	w.machineClasses = machineClasses
 	w.machineImages = machineImages
+	w.ec2NodeClasses = ec2NodeClasses
 
 	return nil
 }
@@ -239,6 +381,9 @@ func (w *workerDelegate) computeBlockDevices(pool extensionsv1alpha1.WorkerPool,
 		if workerConfig.Volume.Throughput != nil {
 			rootDisk["throughput"] = *workerConfig.Volume.Throughput
 		}
+		if workerConfig.Volume.KMSKeyID != nil {
+			rootDisk["kmsKeyID"] = *workerConfig.Volume.KMSKeyID
+		}
 	}
 	blockDevices = append(blockDevices, map[string]interface{}{"ebs": rootDisk})
 
@@ -251,12 +396,22 @@ func (w *workerDelegate) computeBlockDevices(pool extensionsv1alpha1.WorkerPool,
 			return dataVolumes[i].Name < dataVolumes[j].Name
 		})
 
-		for i, vol := range dataVolumes {
+		// explicitly configured device names must not be assigned to other data volumes
+		usedDeviceNames := sets.New[string]()
+		for _, dvConfig := range workerConfig.DataVolumes {
+			if dvConfig.DeviceName != nil {
+				usedDeviceNames.Insert(awsapihelper.NormalizeDeviceName(*dvConfig.DeviceName))
+			}
+		}
+		deviceIndex := 0
+
+		for _, vol := range dataVolumes {
 			dataDisk, err := computeEBSForDataVolume(vol)
 			if err != nil {
 				return nil, fmt.Errorf("error when computing EBS for %v: %w", vol, err)
 			}
-			if dvConfig := awsapihelper.FindDataVolumeByName(workerConfig.DataVolumes, vol.Name); dvConfig != nil {
+			dvConfig := awsapihelper.FindDataVolumeByName(workerConfig.DataVolumes, vol.Name)
+			if dvConfig != nil {
 				if dvConfig.IOPS != nil {
 					dataDisk["iops"] = *dvConfig.IOPS
 				}
@@ -266,10 +421,25 @@ func (w *workerDelegate) computeBlockDevices(pool extensionsv1alpha1.WorkerPool,
 				if dvConfig.Throughput != nil {
 					dataDisk["throughput"] = *dvConfig.Throughput
 				}
+				if dvConfig.KMSKeyID != nil {
+					dataDisk["kmsKeyID"] = *dvConfig.KMSKeyID
+				}
 			}
-			deviceName, err := computeEBSDeviceNameForIndex(i)
-			if err != nil {
-				return nil, fmt.Errorf("error when computing EBS device name for %v: %w", vol, err)
+
+			var deviceName string
+			if dvConfig != nil && dvConfig.DeviceName != nil {
+				deviceName = *dvConfig.DeviceName
+			} else {
+				for {
+					deviceName, err = computeEBSDeviceNameForIndex(deviceIndex)
+					if err != nil {
+						return nil, fmt.Errorf("error when computing EBS device name for %v: %w", vol, err)
+					}
+					deviceIndex++
+					if !usedDeviceNames.Has(deviceName) {
+						break
+					}
+				}
 			}
 			blockDevices = append(blockDevices, map[string]interface{}{
 				"deviceName": deviceName,
@@ -348,6 +518,20 @@ func computeAdditionalHashData(pool extensionsv1alpha1.WorkerPool) []string {
 	return additionalData
 }
 
+// computeLaunchSettingsHashData returns the launch settings of the machines of a worker pool which are neither part of
+// the worker pool nor of its WorkerConfig as additional data of the worker pool hash, i.e. the AMI of the machine image
+// version in the CloudProfile and the IAM instance profile of the nodes in the infrastructure status.
+func computeLaunchSettingsHashData(ami string, iamInstanceProfile map[string]interface{}) (string, error) {
+	data, err := json.Marshal(map[string]interface{}{
+		"ami":                ami,
+		"iamInstanceProfile": iamInstanceProfile,
+	})
+	if err != nil {
+		return "", fmt.Errorf("could not compute launch settings hash data: %w", err)
+	}
+	return string(data), nil
+}
+
 func computeIAMInstanceProfile(workerConfig *awsapi.WorkerConfig, infrastructureStatus *awsapi.InfrastructureStatus) (map[string]interface{}, error) {
 	if workerConfig.IAMInstanceProfile == nil {
 		nodesInstanceProfile, err := awsapihelper.FindInstanceProfileForPurpose(infrastructureStatus.IAM.InstanceProfiles, awsapi.PurposeNodes)
@@ -383,5 +567,51 @@ func computeInstanceMetadata(workerConfig *awsapi.WorkerConfig) map[string]inter
 		res["httpTokens"] = *workerConfig.InstanceMetadataOptions.HTTPTokens
 	}
 
+	if workerConfig.InstanceMetadataOptions.InstanceMetadataTags != nil {
+		res["instanceMetadataTags"] = *workerConfig.InstanceMetadataOptions.InstanceMetadataTags
+	}
+
+	return res
+}
+
+func (w *workerDelegate) decodeWorkerConfig(providerConfig *runtime.RawExtension) (*awsapi.WorkerConfig, error) {
+	workerConfig := &awsapi.WorkerConfig{}
+	if providerConfig != nil && providerConfig.Raw != nil {
+		if _, _, err := w.decoder.Decode(providerConfig.Raw, nil, workerConfig); err != nil {
+			return nil, fmt.Errorf("could not decode provider config: %+v", err)
+		}
+	}
+	return workerConfig, nil
+}
+
+func computeCapacityReservation(reservation *awsapi.CapacityReservation) map[string]interface{} {
+	res := make(map[string]interface{})
+
+	if reservation.ID != nil {
+		res["capacityReservationId"] = *reservation.ID
+	}
+
+	if reservation.ResourceGroupArn != nil {
+		res["capacityReservationResourceGroupArn"] = *reservation.ResourceGroupArn
+	}
+
+	if reservation.Preference != nil {
+		res["capacityReservationPreference"] = string(*reservation.Preference)
+	}
+
+	return res
+}
+
+func computePlacement(workerConfig *awsapi.WorkerConfig) map[string]interface{} {
+	res := make(map[string]interface{})
+
+	if workerConfig.Tenancy != nil {
+		res["tenancy"] = string(*workerConfig.Tenancy)
+	}
+
+	if workerConfig.HostResourceGroupArn != nil {
+		res["hostResourceGroupArn"] = *workerConfig.HostResourceGroupArn
+	}
+
 	return res
 }
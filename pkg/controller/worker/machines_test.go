@@ -16,8 +16,11 @@ package worker_test
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"math/rand"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -27,7 +30,9 @@ import (
 	"github.com/gardener/gardener/extensions/pkg/controller/worker"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	mockkubernetes "github.com/gardener/gardener/pkg/client/kubernetes/mock"
 	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
@@ -35,6 +40,7 @@ import (
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -43,10 +49,16 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
 
 	"github.com/gardener/gardener-extension-provider-aws/charts"
 	api "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 	apiv1alpha1 "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	mockawsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/mock"
 	. "github.com/gardener/gardener-extension-provider-aws/pkg/controller/worker"
 )
 
@@ -73,7 +85,7 @@ var _ = Describe("Machines", func() {
 	})
 
 	Context("workerDelegate", func() {
-		workerDelegate, _ := NewWorkerDelegate(nil, nil, nil, nil, "", nil, nil)
+		workerDelegate, _ := NewWorkerDelegate(nil, nil, nil, nil, nil, "", nil, nil)
 
 		Describe("#GenerateMachineDeployments, #DeployMachineClasses", func() {
 			var (
@@ -430,7 +442,7 @@ var _ = Describe("Machines", func() {
 				workerPoolHash1, _ = worker.WorkerPoolHash(w.Spec.Pools[0], cluster, strconv.FormatBool(volumeEncrypted), fmt.Sprintf("%dGi", dataVolume1Size), dataVolume1Type, strconv.FormatBool(dataVolume1Encrypted), fmt.Sprintf("%dGi", dataVolume2Size), dataVolume2Type, strconv.FormatBool(dataVolume2Encrypted))
 				workerPoolHash2, _ = worker.WorkerPoolHash(w.Spec.Pools[1], cluster)
 
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, clusterWithoutImages)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, nil, chartApplier, "", w, clusterWithoutImages)
 			})
 
 			Describe("machine images", func() {
@@ -581,7 +593,7 @@ var _ = Describe("Machines", func() {
 							Maximum:              worker.DistributeOverZones(0, maxPool1, 2),
 							MaxSurge:             worker.DistributePositiveIntOrPercent(0, maxSurgePool1, 2, maxPool1),
 							MaxUnavailable:       worker.DistributePositiveIntOrPercent(0, maxUnavailablePool1, 2, minPool1),
-							Labels:               utils.MergeStringMaps(labels, map[string]string{"topology.ebs.csi.aws.com/zone": zone1}),
+							Labels:               utils.MergeStringMaps(labels, map[string]string{"topology.ebs.csi.aws.com/zone": zone1, "kubernetes.io/arch": archAMD}),
 							MachineConfiguration: machineConfiguration,
 						},
 						{
@@ -592,7 +604,7 @@ var _ = Describe("Machines", func() {
 							Maximum:              worker.DistributeOverZones(1, maxPool1, 2),
 							MaxSurge:             worker.DistributePositiveIntOrPercent(1, maxSurgePool1, 2, maxPool1),
 							MaxUnavailable:       worker.DistributePositiveIntOrPercent(1, maxUnavailablePool1, 2, minPool1),
-							Labels:               utils.MergeStringMaps(labels, map[string]string{"topology.ebs.csi.aws.com/zone": zone2}),
+							Labels:               utils.MergeStringMaps(labels, map[string]string{"topology.ebs.csi.aws.com/zone": zone2, "kubernetes.io/arch": archAMD}),
 							MachineConfiguration: machineConfiguration,
 						},
 						{
@@ -603,7 +615,7 @@ var _ = Describe("Machines", func() {
 							Maximum:              worker.DistributeOverZones(0, maxPool2, 2),
 							MaxSurge:             worker.DistributePositiveIntOrPercent(0, maxSurgePool2, 2, maxPool2),
 							MaxUnavailable:       worker.DistributePositiveIntOrPercent(0, maxUnavailablePool2, 2, minPool2),
-							Labels:               utils.MergeStringMaps(labels, map[string]string{"topology.ebs.csi.aws.com/zone": zone1}),
+							Labels:               utils.MergeStringMaps(labels, map[string]string{"topology.ebs.csi.aws.com/zone": zone1, "kubernetes.io/arch": archAMD}),
 							MachineConfiguration: machineConfiguration,
 						},
 						{
@@ -614,14 +626,14 @@ var _ = Describe("Machines", func() {
 							Maximum:              worker.DistributeOverZones(1, maxPool2, 2),
 							MaxSurge:             worker.DistributePositiveIntOrPercent(1, maxSurgePool2, 2, maxPool2),
 							MaxUnavailable:       worker.DistributePositiveIntOrPercent(1, maxUnavailablePool2, 2, minPool2),
-							Labels:               utils.MergeStringMaps(labels, map[string]string{"topology.ebs.csi.aws.com/zone": zone2}),
+							Labels:               utils.MergeStringMaps(labels, map[string]string{"topology.ebs.csi.aws.com/zone": zone2, "kubernetes.io/arch": archAMD}),
 							MachineConfiguration: machineConfiguration,
 						},
 					}
 				})
 
 				It("should return machine deployments with AWS CSI Label", func() {
-					workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+					workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, nil, chartApplier, "", w, cluster)
 					result, err := workerDelegate.GenerateMachineDeployments(ctx)
 
 					Expect(err).NotTo(HaveOccurred())
@@ -629,7 +641,7 @@ var _ = Describe("Machines", func() {
 				})
 
 				It("should return the expected machine deployments for profile image types", func() {
-					workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+					workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, nil, chartApplier, "", w, cluster)
 
 					// Test workerDelegate.DeployMachineClasses()
 					chartApplier.EXPECT().ApplyFromEmbeddedFS(
@@ -683,7 +695,7 @@ var _ = Describe("Machines", func() {
 					w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
 						Raw: encode(infrastructureProviderStatus),
 					}
-					workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+					workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, nil, chartApplier, "", w, cluster)
 
 					for _, machineClass := range machineClasses["machineClasses"].([]map[string]interface{}) {
 						delete(machineClass, "keyName")
@@ -703,34 +715,60 @@ var _ = Describe("Machines", func() {
 					Expect(err).NotTo(HaveOccurred())
 				})
 
-				Context("using workerConfig.iamInstanceProfile", func() {
-					modifyExpectedMachineClasses := func(expectedIamInstanceProfile map[string]interface{}) {
-						newHash, err := worker.WorkerPoolHash(w.Spec.Pools[1], cluster)
-						Expect(err).NotTo(HaveOccurred())
-
-						var (
-							machineClassNamePool2Zone1     = fmt.Sprintf("%s-%s-z1", namespace, namePool2)
-							machineClassNamePool2Zone2     = fmt.Sprintf("%s-%s-z2", namespace, namePool2)
-							machineClassWithHashPool2Zone1 = fmt.Sprintf("%s-%s", machineClassNamePool2Zone1, newHash)
-							machineClassWithHashPool2Zone2 = fmt.Sprintf("%s-%s", machineClassNamePool2Zone2, newHash)
-						)
-
-						machineClasses["machineClasses"].([]map[string]interface{})[2]["name"] = machineClassWithHashPool2Zone1
-						machineClasses["machineClasses"].([]map[string]interface{})[2]["iamInstanceProfile"] = expectedIamInstanceProfile
-						machineClasses["machineClasses"].([]map[string]interface{})[3]["name"] = machineClassWithHashPool2Zone2
-						machineClasses["machineClasses"].([]map[string]interface{})[3]["iamInstanceProfile"] = expectedIamInstanceProfile
+				It("should place the machines in the secondary workers subnet of a zone without changing the machine class names", func() {
+					infrastructureProviderStatus.VPC.Subnets = append(infrastructureProviderStatus.VPC.Subnets, api.Subnet{
+						ID:      "subnet-secondary",
+						Purpose: api.PurposeNodesSecondary,
+						Zone:    zone1,
+					})
+					w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
+						Raw: encode(infrastructureProviderStatus),
 					}
+					workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, nil, chartApplier, "", w, cluster)
 
-					It("should deploy the correct machine class when using iamInstanceProfile.Name", func() {
-						iamInstanceProfileName := "foo"
-						w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{Raw: encode(&api.WorkerConfig{
-							IAMInstanceProfile: &api.IAMInstanceProfile{
-								Name: &iamInstanceProfileName,
+					for _, i := range []int{0, 2} {
+						machineClass := machineClasses["machineClasses"].([]map[string]interface{})[i]
+						machineClass["networkInterfaces"] = []map[string]interface{}{
+							{
+								"subnetID":         "subnet-secondary",
+								"securityGroupIDs": []string{securityGroupID},
 							},
-						})}
-						modifyExpectedMachineClasses(map[string]interface{}{"name": iamInstanceProfileName})
+						}
+					}
 
-						workerDelegate, _ := NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+					chartApplier.EXPECT().ApplyFromEmbeddedFS(
+						ctx,
+						charts.InternalChart,
+						filepath.Join("internal", "machineclass"),
+						namespace,
+						"machineclass",
+						kubernetes.Values(machineClasses),
+					)
+
+					Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+				})
+
+				var (
+					httpTokensRequired          = api.HTTPTokensRequired
+					instanceMetadataTagsEnabled = api.InstanceMetadataTagsEnabled
+					amdSevSnpEnabled            = api.AmdSevSnpEnabled
+					tenancyHost                 = api.TenancyHost
+				)
+
+				DescribeTable("should deploy the correct machine class when using the worker config",
+					func(workerConfig *api.WorkerConfig, modifyMachineClass func(machineClass map[string]interface{}, zone int)) {
+						w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{Raw: encode(workerConfig)}
+
+						newHash, err := worker.WorkerPoolHash(w.Spec.Pools[1], cluster)
+						Expect(err).NotTo(HaveOccurred())
+
+						for i := range []int{1, 2} {
+							machineClass := machineClasses["machineClasses"].([]map[string]interface{})[2+i]
+							machineClass["name"] = fmt.Sprintf("%s-%s-z%d-%s", namespace, namePool2, i+1, newHash)
+							modifyMachineClass(machineClass, i)
+						}
+
+						workerDelegate, _ := NewWorkerDelegate(c, decoder, scheme, nil, chartApplier, "", w, cluster)
 
 						chartApplier.EXPECT().ApplyFromEmbeddedFS(
 							ctx,
@@ -740,37 +778,422 @@ var _ = Describe("Machines", func() {
 							"machineclass",
 							kubernetes.Values(machineClasses),
 						)
+
 						Expect(workerDelegate.DeployMachineClasses(context.TODO())).NotTo(HaveOccurred())
-					})
+					},
+
+					Entry("iamInstanceProfile.name",
+						&api.WorkerConfig{IAMInstanceProfile: &api.IAMInstanceProfile{Name: pointer.String("foo")}},
+						func(machineClass map[string]interface{}, _ int) {
+							machineClass["iamInstanceProfile"] = map[string]interface{}{"name": "foo"}
+						},
+					),
+					Entry("iamInstanceProfile.arn",
+						&api.WorkerConfig{IAMInstanceProfile: &api.IAMInstanceProfile{ARN: pointer.String("foo")}},
+						func(machineClass map[string]interface{}, _ int) {
+							machineClass["iamInstanceProfile"] = map[string]interface{}{"arn": "foo"}
+						},
+					),
+					Entry("additionalSecurityGroupIDs",
+						&api.WorkerConfig{AdditionalSecurityGroupIDs: []string{"sg-foo", "sg-bar"}},
+						func(machineClass map[string]interface{}, zone int) {
+							machineClass["networkInterfaces"] = []map[string]interface{}{
+								{
+									"subnetID":         []string{subnetZone1, subnetZone2}[zone],
+									"securityGroupIDs": []string{securityGroupID, "sg-foo", "sg-bar"},
+								},
+							}
+						},
+					),
+					Entry("instanceMetadataOptions",
+						&api.WorkerConfig{InstanceMetadataOptions: &api.InstanceMetadataOptions{
+							HTTPTokens:              &httpTokensRequired,
+							HTTPPutResponseHopLimit: pointer.Int64(2),
+							InstanceMetadataTags:    &instanceMetadataTagsEnabled,
+						}},
+						func(machineClass map[string]interface{}, _ int) {
+							machineClass["instanceMetadataOptions"] = map[string]interface{}{
+								"httpTokens":              httpTokensRequired,
+								"httpPutResponseHopLimit": int64(2),
+								"instanceMetadataTags":    instanceMetadataTagsEnabled,
+							}
+						},
+					),
+					Entry("enclaveOptions and cpuOptions",
+						&api.WorkerConfig{
+							EnclaveOptions: &api.EnclaveOptions{Enabled: true},
+							CPUOptions:     &api.CPUOptions{AmdSevSnp: &amdSevSnpEnabled},
+						},
+						func(machineClass map[string]interface{}, _ int) {
+							machineClass["enclaveOptions"] = map[string]interface{}{"enabled": true}
+							machineClass["cpuOptions"] = map[string]interface{}{"amdSevSnp": "enabled"}
+						},
+					),
+					Entry("monitoring",
+						&api.WorkerConfig{Monitoring: &api.Monitoring{Detailed: true}},
+						func(machineClass map[string]interface{}, _ int) {
+							machineClass["monitoring"] = true
+						},
+					),
+					Entry("capacityReservation",
+						&api.WorkerConfig{CapacityReservation: &api.CapacityReservation{ID: pointer.String("cr-0123456789abcdef0")}},
+						func(machineClass map[string]interface{}, _ int) {
+							machineClass["capacityReservation"] = map[string]interface{}{"capacityReservationId": "cr-0123456789abcdef0"}
+						},
+					),
+					Entry("tenancy",
+						&api.WorkerConfig{
+							Tenancy:              &tenancyHost,
+							HostResourceGroupArn: pointer.String("arn:aws:resource-groups:eu-west-1:123456789012:group/my-hosts"),
+						},
+						func(machineClass map[string]interface{}, _ int) {
+							machineClass["placement"] = map[string]interface{}{
+								"tenancy":              "host",
+								"hostResourceGroupArn": "arn:aws:resource-groups:eu-west-1:123456789012:group/my-hosts",
+							}
+						},
+					),
+					Entry("placementGroup",
+						&api.WorkerConfig{PlacementGroup: &api.PlacementGroup{Strategy: api.PlacementGroupStrategyCluster}},
+						func(machineClass map[string]interface{}, zone int) {
+							machineClass["placement"] = map[string]interface{}{
+								"groupName": fmt.Sprintf("%s-%s-%s-cluster", namespace, namePool2, []string{zone1, zone2}[zone]),
+							}
+						},
+					),
+				)
+
+				It("should not change the machine class when using workerConfig.preProvisionedCapacity", func() {
+					w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","preProvisionedCapacity":{"machines":2}}`)}
+
+					workerDelegate, _ := NewWorkerDelegate(c, decoder, scheme, nil, chartApplier, "", w, cluster)
 
-					It("should deploy the correct machine class when using iamInstanceProfile.ARN", func() {
-						iamInstanceProfileARN := "foo"
-						w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{Raw: encode(&api.WorkerConfig{
-							IAMInstanceProfile: &api.IAMInstanceProfile{
-								ARN: &iamInstanceProfileARN,
+					chartApplier.EXPECT().ApplyFromEmbeddedFS(
+						ctx,
+						charts.InternalChart,
+						filepath.Join("internal", "machineclass"),
+						namespace,
+						"machineclass",
+						kubernetes.Values(machineClasses),
+					)
+
+					Expect(workerDelegate.DeployMachineClasses(context.TODO())).NotTo(HaveOccurred())
+				})
+
+				It("should deploy the correct machine classes and deployments when using spot instances", func() {
+					capacityType := api.CapacityTypeSpot
+					w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{Raw: encode(&api.WorkerConfig{
+						CapacityType: &capacityType,
+						SpotMaxPrice: pointer.String("0.05"),
+					})}
+
+					newHash, err := worker.WorkerPoolHash(w.Spec.Pools[1], cluster)
+					Expect(err).NotTo(HaveOccurred())
+
+					for i := range []int{1, 2} {
+						className := fmt.Sprintf("%s-%s-z%d-%s", namespace, namePool2, i+1, newHash)
+						machineClass := machineClasses["machineClasses"].([]map[string]interface{})[2+i]
+						machineClass["name"] = className
+						machineClass["spotPrice"] = "0.05"
+
+						machineDeployments[2+i].ClassName = className
+						machineDeployments[2+i].SecretName = className
+						machineDeployments[2+i].Labels["aws.provider.extensions.gardener.cloud/capacity-type"] = "spot"
+					}
+
+					workerDelegate, _ := NewWorkerDelegate(c, decoder, scheme, nil, chartApplier, "", w, cluster)
+
+					chartApplier.EXPECT().ApplyFromEmbeddedFS(
+						ctx,
+						charts.InternalChart,
+						filepath.Join("internal", "machineclass"),
+						namespace,
+						"machineclass",
+						kubernetes.Values(machineClasses),
+					)
+
+					Expect(workerDelegate.DeployMachineClasses(context.TODO())).NotTo(HaveOccurred())
+
+					result, err := workerDelegate.GenerateMachineDeployments(ctx)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(Equal(machineDeployments))
+				})
+
+				It("should roll the machines when only their launch settings change if workerConfig.rollingUpdate is set", func() {
+					maxSurge, maxUnavailable := intstr.FromInt(0), intstr.FromString("10%")
+					generateMachineDeployments := func(rollingUpdate *api.RollingUpdate) worker.MachineDeployments {
+						w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{Raw: encode(&api.WorkerConfig{RollingUpdate: rollingUpdate})}
+						w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: encode(infrastructureProviderStatus)}
+						workerDelegate, _ := NewWorkerDelegate(c, decoder, scheme, nil, chartApplier, "", w, cluster)
+						result, err := workerDelegate.GenerateMachineDeployments(ctx)
+						Expect(err).NotTo(HaveOccurred())
+						return result
+					}
+
+					result := generateMachineDeployments(&api.RollingUpdate{MaxSurge: &maxSurge, MaxUnavailable: &maxUnavailable})
+					for i := range []int{1, 2} {
+						Expect(result[2+i].MaxSurge).To(Equal(worker.DistributePositiveIntOrPercent(int32(i), maxSurge, 2, maxPool2)))
+						Expect(result[2+i].MaxUnavailable).To(Equal(worker.DistributePositiveIntOrPercent(int32(i), maxUnavailable, 2, minPool2)))
+					}
+					withRollingUpdate := result[2].ClassName
+					withoutRollingUpdate := generateMachineDeployments(nil)[2].ClassName
+
+					infrastructureProviderStatus.IAM.InstanceProfiles[0].Name = "other-instance-profile"
+					Expect(generateMachineDeployments(&api.RollingUpdate{MaxSurge: &maxSurge, MaxUnavailable: &maxUnavailable})[2].ClassName).NotTo(Equal(withRollingUpdate))
+					Expect(generateMachineDeployments(nil)[2].ClassName).To(Equal(withoutRollingUpdate))
+				})
+
+				It("should deploy the correct machine class when using KMS keys and device names for volumes", func() {
+					w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: encode(&api.WorkerConfig{
+						Volume: &api.Volume{
+							IOPS:       &volumeIOPS,
+							Throughput: &volumeThroughput,
+							KMSKeyID:   pointer.String("alias/root"),
+						},
+						DataVolumes: []api.DataVolume{
+							{
+								Name: dataVolume1Name,
+								Volume: api.Volume{
+									IOPS:       &dataVolume1IOPS,
+									Throughput: &dataVolume1Throughput,
+								},
 							},
-						})}
-						modifyExpectedMachineClasses(map[string]interface{}{"arn": iamInstanceProfileARN})
+							{
+								Name:       dataVolume2Name,
+								Volume:     api.Volume{KMSKeyID: pointer.String("alias/data")},
+								SnapshotID: &dataVolume2SnapshotID,
+								DeviceName: pointer.String("/dev/xvdf"),
+							},
+						},
+					})}
 
-						workerDelegate, _ := NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+					newHash, err := worker.WorkerPoolHash(w.Spec.Pools[0], cluster, strconv.FormatBool(volumeEncrypted), fmt.Sprintf("%dGi", dataVolume1Size), dataVolume1Type, strconv.FormatBool(dataVolume1Encrypted), fmt.Sprintf("%dGi", dataVolume2Size), dataVolume2Type, strconv.FormatBool(dataVolume2Encrypted))
+					Expect(err).NotTo(HaveOccurred())
 
-						chartApplier.EXPECT().ApplyFromEmbeddedFS(
-							ctx,
-							charts.InternalChart,
-							filepath.Join("internal", "machineclass"),
-							namespace,
-							"machineclass",
-							kubernetes.Values(machineClasses),
-						)
+					blockDevices := []map[string]interface{}{
+						{
+							"deviceName": "/root",
+							"ebs": map[string]interface{}{
+								"volumeSize":          volumeSize,
+								"volumeType":          volumeType,
+								"iops":                volumeIOPS,
+								"throughput":          volumeThroughput,
+								"kmsKeyID":            "alias/root",
+								"deleteOnTermination": true,
+								"encrypted":           volumeEncrypted,
+							},
+						},
+						{
+							"deviceName": "/dev/sdg",
+							"ebs": map[string]interface{}{
+								"volumeSize":          dataVolume1Size,
+								"volumeType":          dataVolume1Type,
+								"deleteOnTermination": true,
+								"encrypted":           dataVolume1Encrypted,
+								"iops":                dataVolume1IOPS,
+								"throughput":          dataVolume1Throughput,
+							},
+						},
+						{
+							"deviceName": "/dev/xvdf",
+							"ebs": map[string]interface{}{
+								"volumeSize":          dataVolume2Size,
+								"volumeType":          dataVolume2Type,
+								"deleteOnTermination": true,
+								"encrypted":           dataVolume2Encrypted,
+								"snapshotID":          dataVolume2SnapshotID,
+								"kmsKeyID":            "alias/data",
+							},
+						},
+					}
 
-						Expect(workerDelegate.DeployMachineClasses(context.TODO())).NotTo(HaveOccurred())
-					})
+					for i := range []int{1, 2} {
+						machineClass := machineClasses["machineClasses"].([]map[string]interface{})[i]
+						machineClass["name"] = fmt.Sprintf("%s-%s-z%d-%s", namespace, namePool1, i+1, newHash)
+						machineClass["blockDevices"] = blockDevices
+					}
+
+					workerDelegate, _ := NewWorkerDelegate(c, decoder, scheme, nil, chartApplier, "", w, cluster)
+
+					chartApplier.EXPECT().ApplyFromEmbeddedFS(
+						ctx,
+						charts.InternalChart,
+						filepath.Join("internal", "machineclass"),
+						namespace,
+						"machineclass",
+						kubernetes.Values(machineClasses),
+					)
+
+					Expect(workerDelegate.DeployMachineClasses(context.TODO())).NotTo(HaveOccurred())
+				})
+
+				It("should deploy the correct machine classes when using tags in the infrastructure and worker config", func() {
+					cluster.Shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{Raw: encode(&apiv1alpha1.InfrastructureConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "InfrastructureConfig",
+						},
+						Tags: map[string]string{"cost-center": "1234", "team": "foo"},
+					})}
+					w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{Raw: encode(&api.WorkerConfig{
+						Tags: map[string]string{"team": "bar"},
+					})}
+
+					newHash, err := worker.WorkerPoolHash(w.Spec.Pools[1], cluster)
+					Expect(err).NotTo(HaveOccurred())
+
+					for i, class := range machineClasses["machineClasses"].([]map[string]interface{}) {
+						team := "foo"
+						if i >= 2 {
+							team = "bar"
+							class["name"] = fmt.Sprintf("%s-%s-z%d-%s", namespace, namePool2, i-1, newHash)
+						}
+						class["tags"] = utils.MergeStringMaps(class["tags"].(map[string]string), map[string]string{"cost-center": "1234", "team": team})
+					}
+
+					workerDelegate, _ := NewWorkerDelegate(c, decoder, scheme, nil, chartApplier, "", w, cluster)
+
+					chartApplier.EXPECT().ApplyFromEmbeddedFS(
+						ctx,
+						charts.InternalChart,
+						filepath.Join("internal", "machineclass"),
+						namespace,
+						"machineclass",
+						kubernetes.Values(machineClasses),
+					)
+
+					Expect(workerDelegate.DeployMachineClasses(context.TODO())).NotTo(HaveOccurred())
+				})
+
+				It("should deploy the correct machine class when using workerConfig.userData", func() {
+					base64Encoding := api.UserDataEncodingBase64
+					w.Spec.Pools[1].UserData = []byte("#!/bin/bash\necho bootstrap")
+					w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{Raw: encode(&api.WorkerConfig{
+						UserData: &api.UserData{
+							PreBootstrap: []api.UserDataPart{{Content: "#!/bin/bash\necho pre"}},
+							PostBootstrap: []api.UserDataPart{{
+								ContentType: pointer.String("text/cloud-config"),
+								Content:     base64.StdEncoding.EncodeToString([]byte("#cloud-config\nruncmd: [echo post]")),
+								Encoding:    &base64Encoding,
+							}},
+						},
+					})}
+
+					newHash, err := worker.WorkerPoolHash(w.Spec.Pools[1], cluster)
+					Expect(err).NotTo(HaveOccurred())
+
+					for i := range []int{1, 2} {
+						machineClass := machineClasses["machineClasses"].([]map[string]interface{})[2+i]
+						machineClass["name"] = fmt.Sprintf("%s-%s-z%d-%s", namespace, namePool2, i+1, newHash)
+						// the secret values are shared by the machine classes of all worker pools
+						secret := utils.MergeMaps(machineClass["secret"].(map[string]interface{}), nil)
+						machineClass["secret"] = secret
+						secret["cloudConfig"] = "Content-Type: multipart/mixed; boundary=\"==GARDENER-AWS-USER-DATA==\"\r\nMIME-Version: 1.0\r\n\r\n" +
+							"--==GARDENER-AWS-USER-DATA==\r\nContent-Type: text/x-shellscript; charset=\"utf-8\"\r\nMIME-Version: 1.0\r\n\r\n#!/bin/bash\necho pre\r\n" +
+							"--==GARDENER-AWS-USER-DATA==\r\nContent-Type: text/x-shellscript; charset=\"utf-8\"\r\nMIME-Version: 1.0\r\n\r\n#!/bin/bash\necho bootstrap\r\n" +
+							"--==GARDENER-AWS-USER-DATA==\r\nContent-Type: text/cloud-config; charset=\"utf-8\"\r\nMIME-Version: 1.0\r\n\r\n#cloud-config\nruncmd: [echo post]\r\n" +
+							"--==GARDENER-AWS-USER-DATA==--\r\n"
+					}
+
+					workerDelegate, _ := NewWorkerDelegate(c, decoder, scheme, nil, chartApplier, "", w, cluster)
+
+					chartApplier.EXPECT().ApplyFromEmbeddedFS(
+						ctx,
+						charts.InternalChart,
+						filepath.Join("internal", "machineclass"),
+						namespace,
+						"machineclass",
+						kubernetes.Values(machineClasses),
+					)
+
+					Expect(workerDelegate.DeployMachineClasses(context.TODO())).NotTo(HaveOccurred())
+				})
+
+				It("should deploy the correct machine class when using workerConfig.networkInterfaces", func() {
+					w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{Raw: encode(&api.WorkerConfig{
+						PlacementGroup: &api.PlacementGroup{Strategy: api.PlacementGroupStrategyCluster},
+						NetworkInterfaces: &api.NetworkInterfaces{
+							EFA: true,
+							Additional: []api.AdditionalNetworkInterface{
+								{EFA: true, Count: pointer.Int32(2)},
+								{Subnets: []api.NetworkInterfaceSubnet{{Zone: zone2, ID: "subnet-other"}}, SecurityGroupIDs: []string{"sg-other"}},
+							},
+						},
+					})}
+
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "secret"}, gomock.AssignableToTypeOf(&corev1.Secret{})).DoAndReturn(
+						func(_ context.Context, _ client.ObjectKey, obj *corev1.Secret, _ ...client.GetOption) error {
+							obj.Data = map[string][]byte{
+								aws.AccessKeyID:     []byte("accessKeyID"),
+								aws.SecretAccessKey: []byte("secretAccessKey"),
+							}
+							return nil
+						},
+					)
+					awsClientFactory := mockawsclient.NewMockFactory(ctrl)
+					awsClient := mockawsclient.NewMockInterface(ctrl)
+					awsClientFactory.EXPECT().NewClient(gomock.Any()).Return(awsClient, nil)
+					awsClient.EXPECT().GetInstanceTypeInfo(ctx, machineType).Return(&awsclient.InstanceTypeInfo{InstanceType: machineType, MaxNetworkInterfaces: 60, MaxNetworkCards: 2, MaxEFAInterfaces: 4}, nil)
+
+					newHash, err := worker.WorkerPoolHash(w.Spec.Pools[1], cluster)
+					Expect(err).NotTo(HaveOccurred())
+
+					for i, zone := range []string{zone1, zone2} {
+						subnet, otherSubnet := subnetZone1, subnetZone1
+						if zone == zone2 {
+							subnet, otherSubnet = subnetZone2, "subnet-other"
+						}
+						machineClass := machineClasses["machineClasses"].([]map[string]interface{})[2+i]
+						machineClass["name"] = fmt.Sprintf("%s-%s-z%d-%s", namespace, namePool2, i+1, newHash)
+						machineClass["placement"] = map[string]interface{}{"groupName": fmt.Sprintf("%s-%s-%s-cluster", namespace, namePool2, zone)}
+						machineClass["networkInterfaces"] = []map[string]interface{}{
+							{"subnetID": subnet, "securityGroupIDs": []string{securityGroupID}, "interfaceType": "efa"},
+							{"subnetID": subnet, "securityGroupIDs": []string{securityGroupID}, "deviceIndex": int32(1), "networkCardIndex": int32(1), "interfaceType": "efa"},
+							{"subnetID": subnet, "securityGroupIDs": []string{securityGroupID}, "deviceIndex": int32(1), "networkCardIndex": int32(0), "interfaceType": "efa"},
+							{"subnetID": otherSubnet, "securityGroupIDs": []string{"sg-other"}, "deviceIndex": int32(2), "networkCardIndex": int32(1)},
+						}
+					}
+
+					workerDelegate, _ := NewWorkerDelegate(c, decoder, scheme, awsClientFactory, chartApplier, "", w, cluster)
+
+					chartApplier.EXPECT().ApplyFromEmbeddedFS(
+						ctx,
+						charts.InternalChart,
+						filepath.Join("internal", "machineclass"),
+						namespace,
+						"machineclass",
+						kubernetes.Values(machineClasses),
+					)
+
+					Expect(workerDelegate.DeployMachineClasses(context.TODO())).NotTo(HaveOccurred())
+				})
+
+				It("should fail if the user data of the node bootstrap does not support custom user data parts", func() {
+					w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{Raw: encode(&api.WorkerConfig{
+						UserData: &api.UserData{PreBootstrap: []api.UserDataPart{{Content: "#!/bin/bash\necho pre"}}},
+					})}
+
+					workerDelegate, _ := NewWorkerDelegate(c, decoder, scheme, nil, chartApplier, "", w, cluster)
+
+					Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(MatchError(ContainSubstring("custom user data parts are only supported")))
+				})
+
+				It("should fail with a configuration problem if the user data exceeds the limit of EC2 even if compressed", func() {
+					random := make([]byte, 20*1024)
+					rand.New(rand.NewSource(1)).Read(random)
+					w.Spec.Pools[1].UserData = []byte("#!/bin/bash\necho " + hex.EncodeToString(random))
+
+					workerDelegate, _ := NewWorkerDelegate(c, decoder, scheme, nil, chartApplier, "", w, cluster)
+
+					err := workerDelegate.DeployMachineClasses(context.TODO())
+					Expect(err).To(MatchError(ContainSubstring("even if compressed")))
+					Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
 				})
 
 				It("should return err when the infrastructure provider status cannot be decoded", func() {
 					// Deliberately setting InfrastructureProviderStatus to empty
 					w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{}
-					workerDelegate, _ := NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+					workerDelegate, _ := NewWorkerDelegate(c, decoder, scheme, nil, chartApplier, "", w, cluster)
 
 					err := workerDelegate.DeployMachineClasses(context.TODO())
 					Expect(err).To(HaveOccurred())
@@ -779,7 +1202,7 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the version is invalid", func() {
 				clusterWithoutImages.Shoot.Spec.Kubernetes.Version = "invalid"
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, nil, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -789,7 +1212,7 @@ var _ = Describe("Machines", func() {
 			It("should fail because the infrastructure status cannot be decoded", func() {
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{}
 
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, nil, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -801,7 +1224,7 @@ var _ = Describe("Machines", func() {
 					Raw: encode(&api.InfrastructureStatus{}),
 				}
 
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, nil, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -822,7 +1245,7 @@ var _ = Describe("Machines", func() {
 					}),
 				}
 
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, nil, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -832,7 +1255,7 @@ var _ = Describe("Machines", func() {
 			It("should fail because the ami for this region cannot be found", func() {
 				w.Spec.Region = "another-region"
 
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, nil, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -842,13 +1265,246 @@ var _ = Describe("Machines", func() {
 			It("should fail because the ami for this architecture cannot be found", func() {
 				w.Spec.Pools[0].Architecture = pointer.String(archARM)
 
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, nil, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
 				Expect(result).To(BeNil())
 			})
 
+			It("should support worker pools with different architectures", func() {
+				const machineImageAMIARM = "ami-arm64"
+
+				cloudProfileConfigJSON, _ := json.Marshal(&apiv1alpha1.CloudProfileConfig{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						Kind:       "CloudProfileConfig",
+					},
+					MachineImages: []apiv1alpha1.MachineImages{{
+						Name: machineImageName,
+						Versions: []apiv1alpha1.MachineImageVersion{{
+							Version: machineImageVersion,
+							Regions: []apiv1alpha1.RegionAMIMapping{
+								{Name: region, AMI: machineImageAMI, Architecture: pointer.String(archAMD)},
+								{Name: region, AMI: machineImageAMIARM, Architecture: pointer.String(archARM)},
+							},
+						}},
+					}},
+				})
+				cluster.CloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: cloudProfileConfigJSON}
+				w.Spec.Pools[1].Architecture = pointer.String(archARM)
+
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, nil, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(HaveLen(4))
+				for i, deployment := range result {
+					arch := archAMD
+					if i >= 2 {
+						arch = archARM
+					}
+					Expect(deployment.Labels).To(HaveKeyWithValue("kubernetes.io/arch", arch))
+				}
+
+				c.EXPECT().Status().Return(statusWriter)
+				statusWriter.EXPECT().Patch(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, obj *extensionsv1alpha1.Worker, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+						Expect(obj.Status.ProviderStatus.Object).To(Equal(&apiv1alpha1.WorkerStatus{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+								Kind:       "WorkerStatus",
+							},
+							MachineImages: []apiv1alpha1.MachineImage{
+								{Name: machineImageName, Version: machineImageVersion, AMI: machineImageAMI, Architecture: pointer.String(archAMD)},
+								{Name: machineImageName, Version: machineImageVersion, AMI: machineImageAMIARM, Architecture: pointer.String(archARM)},
+							},
+						}))
+						return nil
+					},
+				)
+
+				Expect(workerDelegate.UpdateMachineImagesStatus(ctx)).To(Succeed())
+			})
+
+			Context("SSM parameters", func() {
+				const parameter = "/aws/service/gardenlinux/amd64/ami-id"
+
+				var (
+					awsClientFactory *mockawsclient.MockFactory
+					awsClient        *mockawsclient.MockInterface
+				)
+
+				BeforeEach(func() {
+					awsClientFactory = mockawsclient.NewMockFactory(ctrl)
+					awsClient = mockawsclient.NewMockInterface(ctrl)
+
+					cloudProfileConfigJSON, _ := json.Marshal(&apiv1alpha1.CloudProfileConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "CloudProfileConfig",
+						},
+						MachineImages: []apiv1alpha1.MachineImages{{
+							Name: machineImageName,
+							Versions: []apiv1alpha1.MachineImageVersion{{
+								Version:       machineImageVersion,
+								SSMParameters: []apiv1alpha1.SSMParameter{{Path: parameter, Architecture: pointer.String(archAMD)}},
+							}},
+						}},
+					})
+					cluster.CloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: cloudProfileConfigJSON}
+
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "secret"}, gomock.AssignableToTypeOf(&corev1.Secret{})).DoAndReturn(
+						func(_ context.Context, _ client.ObjectKey, obj *corev1.Secret, _ ...client.GetOption) error {
+							obj.Data = map[string][]byte{
+								aws.AccessKeyID:     []byte("accessKeyID"),
+								aws.SecretAccessKey: []byte("secretAccessKey"),
+							}
+							return nil
+						},
+					).AnyTimes()
+					awsClientFactory.EXPECT().NewClient(gomock.Any()).Return(awsClient, nil).AnyTimes()
+				})
+
+				It("should resolve the ami from the SSM parameter", func() {
+					awsClient.EXPECT().GetSSMParameter(ctx, parameter).Return(machineImageAMI, nil).MinTimes(1)
+
+					workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, awsClientFactory, chartApplier, "", w, cluster)
+
+					result, err := workerDelegate.GenerateMachineDeployments(ctx)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).NotTo(BeEmpty())
+				})
+
+				It("should fail because the SSM parameter does not exist", func() {
+					awsClient.EXPECT().GetSSMParameter(ctx, parameter).Return("", nil)
+
+					workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, awsClientFactory, chartApplier, "", w, cluster)
+
+					result, err := workerDelegate.GenerateMachineDeployments(ctx)
+					Expect(err).To(MatchError(ContainSubstring("SSM parameter " + parameter + " of machine image")))
+					Expect(result).To(BeNil())
+				})
+			})
+
+			Context("source images", func() {
+				const (
+					sourceRegion = "source-region"
+					sourceAMI    = "ami-source"
+				)
+
+				var (
+					awsClientFactory *mockawsclient.MockFactory
+					awsClient        *mockawsclient.MockInterface
+				)
+
+				BeforeEach(func() {
+					awsClientFactory = mockawsclient.NewMockFactory(ctrl)
+					awsClient = mockawsclient.NewMockInterface(ctrl)
+
+					cloudProfileConfigJSON, _ := json.Marshal(&apiv1alpha1.CloudProfileConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "CloudProfileConfig",
+						},
+						MachineImages: []apiv1alpha1.MachineImages{{
+							Name: machineImageName,
+							Versions: []apiv1alpha1.MachineImageVersion{{
+								Version:      machineImageVersion,
+								SourceImages: []apiv1alpha1.SourceImage{{Region: sourceRegion, AMI: sourceAMI, Architecture: pointer.String(archAMD), KMSKeyID: pointer.String("alias/images")}},
+							}},
+						}},
+					})
+					cluster.CloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: cloudProfileConfigJSON}
+
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "secret"}, gomock.AssignableToTypeOf(&corev1.Secret{})).DoAndReturn(
+						func(_ context.Context, _ client.ObjectKey, obj *corev1.Secret, _ ...client.GetOption) error {
+							obj.Data = map[string][]byte{
+								aws.AccessKeyID:     []byte("accessKeyID"),
+								aws.SecretAccessKey: []byte("secretAccessKey"),
+							}
+							return nil
+						},
+					).AnyTimes()
+					awsClientFactory.EXPECT().NewClient(gomock.Any()).Return(awsClient, nil).AnyTimes()
+
+					workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, awsClientFactory, chartApplier, "", w, cluster)
+				})
+
+				It("should use the available copy of the source image", func() {
+					awsClient.EXPECT().FindImageCopy(ctx, sourceRegion, sourceAMI).Return(&awsclient.Image{ImageId: machineImageAMI, State: "available"}, nil).MinTimes(1)
+
+					result, err := workerDelegate.GenerateMachineDeployments(ctx)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).NotTo(BeEmpty())
+				})
+
+				It("should copy the source image if there is no copy yet", func() {
+					awsClient.EXPECT().FindImageCopy(ctx, sourceRegion, sourceAMI).Return(nil, nil)
+					awsClient.EXPECT().CopyImage(ctx, sourceRegion, sourceAMI, machineImageName+"-"+machineImageVersion+"-"+archAMD+"-"+sourceAMI, pointer.String("alias/images")).Return("ami-copy", nil)
+
+					result, err := workerDelegate.GenerateMachineDeployments(ctx)
+					Expect(err).To(MatchError(ContainSubstring("is being copied from region " + sourceRegion + " to ami-copy")))
+					Expect(result).To(BeNil())
+				})
+
+				It("should fail while the copy is pending", func() {
+					awsClient.EXPECT().FindImageCopy(ctx, sourceRegion, sourceAMI).Return(&awsclient.Image{ImageId: "ami-copy", State: "pending"}, nil)
+
+					result, err := workerDelegate.GenerateMachineDeployments(ctx)
+					Expect(err).To(MatchError(ContainSubstring("is still being copied")))
+					Expect(result).To(BeNil())
+				})
+			})
+
+			Context("image selector", func() {
+				var (
+					awsClientFactory *mockawsclient.MockFactory
+					awsClient        *mockawsclient.MockInterface
+					selector         *api.ImageSelector
+				)
+
+				BeforeEach(func() {
+					awsClientFactory = mockawsclient.NewMockFactory(ctrl)
+					awsClient = mockawsclient.NewMockInterface(ctrl)
+
+					selector = &api.ImageSelector{Owners: []string{"self"}, Name: pointer.String("nightly-*")}
+					w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{Raw: encode(&api.WorkerConfig{ImageSelector: selector})}
+
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "secret"}, gomock.AssignableToTypeOf(&corev1.Secret{})).DoAndReturn(
+						func(_ context.Context, _ client.ObjectKey, obj *corev1.Secret, _ ...client.GetOption) error {
+							obj.Data = map[string][]byte{
+								aws.AccessKeyID:     []byte("accessKeyID"),
+								aws.SecretAccessKey: []byte("secretAccessKey"),
+							}
+							return nil
+						},
+					).AnyTimes()
+					awsClientFactory.EXPECT().NewClient(gomock.Any()).Return(awsClient, nil).AnyTimes()
+
+					workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, awsClientFactory, chartApplier, "", w, cluster)
+				})
+
+				It("should use the newest matching ami and roll the machines when it changes", func() {
+					awsClient.EXPECT().FindNewestImage(ctx, selector.Owners, selector.Name, selector.Tags, archAMD).Return("ami-nightly", nil)
+
+					result, err := workerDelegate.GenerateMachineDeployments(ctx)
+					Expect(err).NotTo(HaveOccurred())
+
+					newHash, err := worker.WorkerPoolHash(w.Spec.Pools[1], cluster, "ami-nightly")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(ContainElement(HaveField("ClassName", fmt.Sprintf("%s-%s-z1-%s", namespace, namePool2, newHash))))
+				})
+
+				It("should fail because no ami matches the image selector", func() {
+					awsClient.EXPECT().FindNewestImage(ctx, selector.Owners, selector.Name, selector.Tags, archAMD).Return("", nil)
+
+					result, err := workerDelegate.GenerateMachineDeployments(ctx)
+					Expect(err).To(MatchError(ContainSubstring("no available AMI in region " + region + " matches the image selector of worker pool " + namePool2)))
+					Expect(result).To(BeNil())
+				})
+			})
+
 			It("should fail because the subnet id cannot be found", func() {
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
 					Raw: encode(&api.InfrastructureStatus{
@@ -872,7 +1528,7 @@ var _ = Describe("Machines", func() {
 					}),
 				}
 
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, nil, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -882,7 +1538,7 @@ var _ = Describe("Machines", func() {
 			It("should fail because the volume size cannot be decoded", func() {
 				w.Spec.Pools[0].Volume.Size = "not-decodeable"
 
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, nil, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -903,7 +1559,7 @@ var _ = Describe("Machines", func() {
 					NodeConditions:         testNodeConditions,
 				}
 
-				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, decoder, scheme, nil, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				resultSettings := result[0].MachineConfiguration
@@ -916,6 +1572,116 @@ var _ = Describe("Machines", func() {
 				Expect(resultSettings.MaxEvictRetries).To(Equal(&testMaxEvictRetries))
 				Expect(resultSettings.NodeConditions).To(Equal(&resultNodeConditions))
 			})
+
+			It("should label the nodes with the kubelet configuration computed from the instance type", func() {
+				w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{Raw: encode(&apiv1alpha1.WorkerConfig{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						Kind:       "WorkerConfig",
+					},
+					Kubelet: &apiv1alpha1.KubeletConfig{KubeReservedFromInstanceType: true, MaxPodsFromNetworkInterfaces: true},
+				})}
+
+				fakeClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: namespace},
+					Data: map[string][]byte{
+						aws.AccessKeyID:     []byte("accessKeyID"),
+						aws.SecretAccessKey: []byte("secretAccessKey"),
+					},
+				}).Build()
+				awsClientFactory := mockawsclient.NewMockFactory(ctrl)
+				awsClient := mockawsclient.NewMockInterface(ctrl)
+				awsClientFactory.EXPECT().NewClient(gomock.Any()).Return(awsClient, nil)
+				awsClient.EXPECT().GetInstanceTypeInfo(ctx, machineType).Return(&awsclient.InstanceTypeInfo{
+					InstanceType:              machineType,
+					VCPUs:                     4,
+					MemoryMiB:                 16384,
+					MaxNetworkInterfaces:      4,
+					IPv4AddressesPerInterface: 15,
+				}, nil)
+
+				workerDelegate, _ = NewWorkerDelegate(fakeClient, decoder, scheme, awsClientFactory, chartApplier, "", w, cluster)
+
+				result, err := workerDelegate.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				for _, machineDeployment := range result {
+					if strings.Contains(machineDeployment.Name, namePool2) {
+						Expect(machineDeployment.Labels).To(And(
+							HaveKeyWithValue(aws.MaxPodsLabel, "58"),
+							HaveKeyWithValue(aws.KubeReservedCPULabel, "80m"),
+							HaveKeyWithValue(aws.KubeReservedMemoryLabel, "893Mi"),
+						))
+					} else {
+						Expect(machineDeployment.Labels).NotTo(HaveKey(aws.MaxPodsLabel))
+					}
+				}
+			})
+
+			It("should fail if the instance type of a worker pool with kubelet configuration from the instance type is unknown", func() {
+				w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{Raw: encode(&apiv1alpha1.WorkerConfig{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						Kind:       "WorkerConfig",
+					},
+					Kubelet: &apiv1alpha1.KubeletConfig{KubeReservedFromInstanceType: true},
+				})}
+
+				fakeClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: namespace},
+					Data: map[string][]byte{
+						aws.AccessKeyID:     []byte("accessKeyID"),
+						aws.SecretAccessKey: []byte("secretAccessKey"),
+					},
+				}).Build()
+				awsClientFactory := mockawsclient.NewMockFactory(ctrl)
+				awsClient := mockawsclient.NewMockInterface(ctrl)
+				awsClientFactory.EXPECT().NewClient(gomock.Any()).Return(awsClient, nil)
+				awsClient.EXPECT().GetInstanceTypeInfo(ctx, machineType).Return(nil, nil)
+
+				workerDelegate, _ = NewWorkerDelegate(fakeClient, decoder, scheme, awsClientFactory, chartApplier, "", w, cluster)
+
+				_, err := workerDelegate.GenerateMachineDeployments(ctx)
+				Expect(err).To(MatchError(ContainSubstring("instance type " + machineType + " of worker pool " + namePool2 + " is unknown")))
+			})
+
+			It("should deploy the EC2NodeClasses of the worker pools if Karpenter is enabled", func() {
+				cluster.Shoot.Spec.Provider.ControlPlaneConfig = &runtime.RawExtension{Raw: encode(&apiv1alpha1.ControlPlaneConfig{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						Kind:       "ControlPlaneConfig",
+					},
+					Karpenter: &apiv1alpha1.KarpenterConfig{Enabled: true},
+				})}
+
+				fakeClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: namespace},
+					Data: map[string][]byte{
+						aws.AccessKeyID:     []byte("accessKeyID"),
+						aws.SecretAccessKey: []byte("secretAccessKey"),
+					},
+				}).Build()
+				workerDelegate, _ = NewWorkerDelegate(fakeClient, decoder, scheme, nil, chartApplier, "", w, cluster)
+				Expect(workerDelegate.PostReconcileHook(ctx)).To(Succeed())
+
+				managedResource := &resourcesv1alpha1.ManagedResource{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "extension-worker-karpenter"}, managedResource)).To(Succeed())
+				Expect(managedResource.Spec.KeepObjects).To(PointTo(BeTrue()))
+
+				secret := &corev1.Secret{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: managedResource.Spec.SecretRefs[0].Name}, secret)).To(Succeed())
+				Expect(secret.Data).To(HaveLen(2))
+
+				nodeClass := map[string]interface{}{}
+				Expect(yaml.Unmarshal(secret.Data["ec2nodeclass__"+namePool2+".yaml"], &nodeClass)).To(Succeed())
+				Expect(nodeClass).To(HaveKeyWithValue("spec", And(
+					HaveKeyWithValue("amiFamily", "Custom"),
+					HaveKeyWithValue("amiSelectorTerms", ConsistOf(HaveKeyWithValue("id", machineImageAMI))),
+					HaveKeyWithValue("subnetSelectorTerms", ConsistOf(HaveKeyWithValue("id", subnetZone1), HaveKeyWithValue("id", subnetZone2))),
+					HaveKeyWithValue("securityGroupSelectorTerms", ConsistOf(HaveKeyWithValue("id", securityGroupID))),
+					HaveKeyWithValue("instanceProfile", instanceProfileName),
+					HaveKeyWithValue("userData", string(userData)),
+				)))
+			})
 		})
 	})
 })
@@ -0,0 +1,29 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var preProvisionedCapacityMachines = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "aws_worker_pre_provisioned_capacity_machines",
+	Help: "Number of machines of a worker pool which are kept available for scale-ups by the pre-provisioned capacity.",
+}, []string{"namespace", "pool", "machine_type"})
+
+func init() {
+	metrics.Registry.MustRegister(preProvisionedCapacityMachines)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"k8s.io/utils/pointer"
+
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// interfaceTypeEFA is the interface type of network interfaces which are Elastic Fabric Adapters.
+const interfaceTypeEFA = "efa"
+
+// computeNetworkInterfaces returns the network interfaces of the machine class of a worker pool in the given zone. The
+// additional network interfaces are distributed over the network cards of the machine type, so that each Elastic
+// Fabric Adapter of machine types with multiple network cards gets its own network card.
+func computeNetworkInterfaces(config *awsapi.NetworkInterfaces, zone, subnetID string, securityGroupIDs []string, maxNetworkCards int32) []map[string]interface{} {
+	primary := map[string]interface{}{
+		"subnetID":         subnetID,
+		"securityGroupIDs": securityGroupIDs,
+	}
+	if config == nil {
+		return []map[string]interface{}{primary}
+	}
+	if config.EFA {
+		primary["interfaceType"] = interfaceTypeEFA
+	}
+	networkInterfaces := []map[string]interface{}{primary}
+
+	if maxNetworkCards < 1 {
+		maxNetworkCards = 1
+	}
+	// The primary network interface has the device index 0 on the first network card, the device indexes of
+	// additional network interfaces start at 1 on every network card.
+	nextDeviceIndex := map[int32]int32{}
+	for _, additional := range config.Additional {
+		additionalSubnetID := subnetID
+		for _, subnet := range additional.Subnets {
+			if subnet.Zone == zone {
+				additionalSubnetID = subnet.ID
+			}
+		}
+		additionalSecurityGroupIDs := securityGroupIDs
+		if len(additional.SecurityGroupIDs) > 0 {
+			additionalSecurityGroupIDs = additional.SecurityGroupIDs
+		}
+
+		for i := int32(0); i < pointer.Int32Deref(additional.Count, 1); i++ {
+			networkCardIndex := int32(len(networkInterfaces)) % maxNetworkCards
+			deviceIndex := nextDeviceIndex[networkCardIndex]
+			if deviceIndex == 0 {
+				deviceIndex = 1
+			}
+			nextDeviceIndex[networkCardIndex] = deviceIndex + 1
+
+			networkInterface := map[string]interface{}{
+				"subnetID":         additionalSubnetID,
+				"securityGroupIDs": additionalSecurityGroupIDs,
+				"deviceIndex":      deviceIndex,
+				"networkCardIndex": networkCardIndex,
+			}
+			if additional.EFA {
+				networkInterface["interfaceType"] = interfaceTypeEFA
+			}
+			networkInterfaces = append(networkInterfaces, networkInterface)
+		}
+	}
+	return networkInterfaces
+}
+
+// countNetworkInterfaces returns the number of Elastic Fabric Adapters and the total number of network interfaces of
+// the given configuration including the primary network interface.
+func countNetworkInterfaces(config *awsapi.NetworkInterfaces) (int32, int32) {
+	var efa, total int32 = 0, 1
+	if config.EFA {
+		efa++
+	}
+	for _, additional := range config.Additional {
+		count := pointer.Int32Deref(additional.Count, 1)
+		if additional.EFA {
+			efa += count
+		}
+		total += count
+	}
+	return efa, total
+}
+
+// networkInterfacesConfigured returns whether any worker pool configures its network interfaces.
+func (w *workerDelegate) networkInterfacesConfigured() (bool, error) {
+	for _, pool := range w.worker.Spec.Pools {
+		workerConfig, err := w.decodeWorkerConfig(pool.ProviderConfig)
+		if err != nil {
+			return false, err
+		}
+		if workerConfig.NetworkInterfaces != nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// validateNetworkInterfaces validates that the machine types of the worker pools support their Elastic Fabric Adapters
+// and number of network interfaces.
+func (w *workerDelegate) validateNetworkInterfaces(ctx context.Context, awsClient awsclient.Interface) error {
+	for _, pool := range w.worker.Spec.Pools {
+		workerConfig, err := w.decodeWorkerConfig(pool.ProviderConfig)
+		if err != nil {
+			return err
+		}
+		if workerConfig.NetworkInterfaces == nil {
+			continue
+		}
+
+		info, err := awsClient.GetInstanceTypeInfo(ctx, pool.MachineType)
+		if err != nil {
+			return fmt.Errorf("failed to get instance type %s of worker pool %s: %w", pool.MachineType, pool.Name, err)
+		}
+		if info == nil {
+			return v1beta1helper.NewErrorWithCodes(fmt.Errorf("machine type %s of worker pool %s is unknown", pool.MachineType, pool.Name), gardencorev1beta1.ErrorConfigurationProblem)
+		}
+
+		efa, total := countNetworkInterfaces(workerConfig.NetworkInterfaces)
+		if efa > 0 && info.MaxEFAInterfaces == 0 {
+			return v1beta1helper.NewErrorWithCodes(fmt.Errorf("machine type %s of worker pool %s does not support Elastic Fabric Adapters", pool.MachineType, pool.Name), gardencorev1beta1.ErrorConfigurationProblem)
+		}
+		if efa > info.MaxEFAInterfaces {
+			return v1beta1helper.NewErrorWithCodes(fmt.Errorf("machine type %s of worker pool %s supports at most %d Elastic Fabric Adapters, but %d are configured", pool.MachineType, pool.Name, info.MaxEFAInterfaces, efa), gardencorev1beta1.ErrorConfigurationProblem)
+		}
+		if total > info.MaxNetworkInterfaces {
+			return v1beta1helper.NewErrorWithCodes(fmt.Errorf("machine type %s of worker pool %s supports at most %d network interfaces, but %d are configured", pool.MachineType, pool.Name, info.MaxNetworkInterfaces, total), gardencorev1beta1.ErrorConfigurationProblem)
+		}
+	}
+	return nil
+}
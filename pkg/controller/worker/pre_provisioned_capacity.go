@@ -0,0 +1,231 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+	"github.com/prometheus/client_golang/prometheus"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-aws/imagevector"
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+)
+
+const (
+	// preProvisionedCapacityManagedResourceName is the name of the managed resource containing the placeholder pods
+	// reserving the pre-provisioned capacity of the worker pools.
+	preProvisionedCapacityManagedResourceName = "extension-worker-pre-provisioned-capacity"
+
+	preProvisionedCapacityName = "pre-provisioned-capacity"
+	// preProvisionedCapacityPriorityClassName is the name of the priority class of the placeholder pods.
+	preProvisionedCapacityPriorityClassName = "gardener.cloud:aws:" + preProvisionedCapacityName
+	// preProvisionedCapacityPriority is the priority of the placeholder pods. It is lower than the default priority of
+	// pods, so that the placeholder pods are preempted by all other pods, but not lower than the default cutoff of
+	// expendable pods of the cluster-autoscaler, so that machines are still added for pending placeholder pods.
+	preProvisionedCapacityPriority int32 = -1
+)
+
+// reconcilePreProvisionedCapacity deploys placeholder pods to the shoot which reserve the capacity of the worker pools
+// with pre-provisioned capacity. Pending pods preempt the placeholder pods and are scheduled immediately, while the
+// cluster-autoscaler adds machines for the then pending placeholder pods. If no worker pool has pre-provisioned
+// capacity, the managed resource is deleted. The number of machines kept available is exposed as metric, so that their
+// costs can be reported.
+func (w *workerDelegate) reconcilePreProvisionedCapacity(ctx context.Context) error {
+	preProvisionedCapacityMachines.DeletePartialMatch(prometheus.Labels{"namespace": w.worker.Namespace})
+
+	var objects []client.Object
+	for _, pool := range w.worker.Spec.Pools {
+		workerConfig, err := w.decodeWorkerConfig(pool.ProviderConfig)
+		if err != nil {
+			return err
+		}
+		if workerConfig.PreProvisionedCapacity == nil {
+			continue
+		}
+		deployment, err := preProvisionedCapacityDeployment(pool, workerConfig)
+		if err != nil {
+			return err
+		}
+		objects = append(objects, deployment)
+		preProvisionedCapacityMachines.WithLabelValues(w.worker.Namespace, pool.Name, pool.MachineType).Set(float64(workerConfig.PreProvisionedCapacity.Machines))
+	}
+	if len(objects) == 0 {
+		return w.deletePreProvisionedCapacity(ctx)
+	}
+
+	objects = append(objects, &schedulingv1.PriorityClass{
+		ObjectMeta:       metav1.ObjectMeta{Name: preProvisionedCapacityPriorityClassName},
+		Value:            preProvisionedCapacityPriority,
+		PreemptionPolicy: ptr.To(corev1.PreemptNever),
+		Description:      "Used by the placeholder pods reserving the pre-provisioned capacity of worker pools.",
+	})
+
+	registry := managedresources.NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer)
+	data, err := registry.AddAllAndSerialize(objects...)
+	if err != nil {
+		return err
+	}
+	return managedresources.CreateForShoot(ctx, w.client, w.worker.Namespace, preProvisionedCapacityManagedResourceName, aws.Name, false, data)
+}
+
+func (w *workerDelegate) deletePreProvisionedCapacity(ctx context.Context) error {
+	preProvisionedCapacityMachines.DeletePartialMatch(prometheus.Labels{"namespace": w.worker.Namespace})
+	if err := managedresources.DeleteForShoot(ctx, w.client, w.worker.Namespace, preProvisionedCapacityManagedResourceName); err != nil {
+		return fmt.Errorf("failed to delete managed resource of pre-provisioned capacity: %w", err)
+	}
+	return nil
+}
+
+// preProvisionedCapacityDeployment returns the deployment of the placeholder pods of the given worker pool. Each
+// placeholder pod runs on a separate machine of the worker pool and reserves the configured resources.
+func preProvisionedCapacityDeployment(pool extensionsv1alpha1.WorkerPool, workerConfig *awsapi.WorkerConfig) (*appsv1.Deployment, error) {
+	resources, err := preProvisionedCapacityResources(pool, workerConfig)
+	if err != nil {
+		return nil, err
+	}
+	image, err := imagevector.ImageVector().FindImage(aws.PauseContainerImageName)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := map[string]string{
+		v1beta1constants.LabelApp:        preProvisionedCapacityName,
+		v1beta1constants.LabelWorkerPool: pool.Name,
+	}
+	var tolerations []corev1.Toleration
+	for _, taint := range pool.Taints {
+		tolerations = append(tolerations, corev1.Toleration{
+			Key:      taint.Key,
+			Operator: corev1.TolerationOpEqual,
+			Value:    taint.Value,
+			Effect:   taint.Effect,
+		})
+	}
+
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      preProvisionedCapacityName + "-" + pool.Name,
+			Namespace: metav1.NamespaceSystem,
+			Labels:    labels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To(workerConfig.PreProvisionedCapacity.Machines),
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					PriorityClassName:             preProvisionedCapacityPriorityClassName,
+					NodeSelector:                  map[string]string{v1beta1constants.LabelWorkerPool: pool.Name},
+					Tolerations:                   tolerations,
+					AutomountServiceAccountToken:  ptr.To(false),
+					TerminationGracePeriodSeconds: ptr.To[int64](0),
+					Affinity: &corev1.Affinity{
+						PodAntiAffinity: &corev1.PodAntiAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{{
+								LabelSelector: &metav1.LabelSelector{MatchLabels: labels},
+								TopologyKey:   corev1.LabelHostname,
+							}},
+						},
+					},
+					Containers: []corev1.Container{{
+						Name:      "pause",
+						Image:     image.String(),
+						Resources: corev1.ResourceRequirements{Requests: resources},
+						SecurityContext: &corev1.SecurityContext{
+							AllowPrivilegeEscalation: ptr.To(false),
+							Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+						},
+					}},
+				},
+			},
+		},
+	}, nil
+}
+
+// preProvisionedCapacityResources returns the resources reserved on each machine of the given worker pool. They
+// default to half of the CPU and memory of the machine type, which also keeps the utilization of otherwise empty
+// machines at the default threshold of the cluster-autoscaler, so that they are not scaled down.
+func preProvisionedCapacityResources(pool extensionsv1alpha1.WorkerPool, workerConfig *awsapi.WorkerConfig) (corev1.ResourceList, error) {
+	if len(workerConfig.PreProvisionedCapacity.Resources) > 0 {
+		return workerConfig.PreProvisionedCapacity.Resources, nil
+	}
+
+	var capacity corev1.ResourceList
+	if workerConfig.NodeTemplate != nil {
+		capacity = workerConfig.NodeTemplate.Capacity
+	} else if pool.NodeTemplate != nil {
+		capacity = pool.NodeTemplate.Capacity
+	}
+	cpu, hasCPU := capacity[corev1.ResourceCPU]
+	memory, hasMemory := capacity[corev1.ResourceMemory]
+	if !hasCPU || !hasMemory {
+		return nil, fmt.Errorf("the resources of the pre-provisioned capacity of worker pool %s must be configured as the capacity of its machine type is unknown", pool.Name)
+	}
+
+	return corev1.ResourceList{
+		corev1.ResourceCPU:    *resource.NewMilliQuantity(cpu.MilliValue()/2, resource.DecimalSI),
+		corev1.ResourceMemory: *resource.NewQuantity(memory.Value()/2, resource.BinarySI),
+	}, nil
+}
+
+// withoutPreProvisionedCapacity returns the given worker pool without the pre-provisioned capacity in its provider
+// config, so that changing it does not roll the machines of the worker pool. The provider config is only re-encoded if
+// it contains the pre-provisioned capacity, hence the hash of the other worker pools does not change.
+func withoutPreProvisionedCapacity(pool extensionsv1alpha1.WorkerPool) (extensionsv1alpha1.WorkerPool, error) {
+	if pool.ProviderConfig == nil || pool.ProviderConfig.Raw == nil {
+		return pool, nil
+	}
+
+	providerConfig := map[string]interface{}{}
+	decoder := json.NewDecoder(bytes.NewReader(pool.ProviderConfig.Raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&providerConfig); err != nil {
+		return pool, fmt.Errorf("could not decode provider config of worker pool %s: %w", pool.Name, err)
+	}
+	if _, ok := providerConfig["preProvisionedCapacity"]; !ok {
+		return pool, nil
+	}
+	delete(providerConfig, "preProvisionedCapacity")
+
+	// A provider config which only contained the pre-provisioned capacity is treated like a missing one.
+	if len(providerConfig) == len(sets.KeySet(providerConfig).Intersection(sets.New("apiVersion", "kind"))) {
+		pool.ProviderConfig = nil
+		return pool, nil
+	}
+
+	raw, err := json.Marshal(providerConfig)
+	if err != nil {
+		return pool, fmt.Errorf("could not encode provider config of worker pool %s: %w", pool.Name, err)
+	}
+	pool.ProviderConfig = &runtime.RawExtension{Raw: raw}
+	return pool, nil
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// progressMachineDependencies is the progress of the reconciliation when the machine dependencies, e.g. the
+	// placement groups, are created.
+	progressMachineDependencies int32 = 10
+	// progressMachineDeployments is the progress of the reconciliation when the machine deployments are generated.
+	progressMachineDeployments int32 = 30
+	// progressMachineClasses is the progress of the reconciliation when the machine classes are deployed.
+	progressMachineClasses int32 = 50
+	// progressMachines is the progress of the reconciliation when the machine deployments are rolled out.
+	progressMachines int32 = 60
+	// progressPostReconcile is the progress of the reconciliation when the node classes, the device plugins and the
+	// placement groups are reconciled after the machines are available.
+	progressPostReconcile int32 = 95
+)
+
+// reportProgress updates the progress and the description of the last operation of the worker while it is being
+// reconciled or restored, so that users can see which stage of a long-running reconciliation is running. The progress
+// never decreases. Errors are only logged, as the progress is purely informational.
+func (w *workerDelegate) reportProgress(ctx context.Context, progress int32, description string) {
+	lastOperation := w.worker.Status.LastOperation
+	if lastOperation == nil || lastOperation.State != gardencorev1beta1.LastOperationStateProcessing || lastOperation.Type == gardencorev1beta1.LastOperationTypeDelete {
+		return
+	}
+	if progress < lastOperation.Progress {
+		progress = lastOperation.Progress
+	}
+	if progress == lastOperation.Progress && description == lastOperation.Description {
+		return
+	}
+
+	patch := client.MergeFrom(w.worker.DeepCopy())
+	lastOperation.Progress = progress
+	lastOperation.Description = description
+	lastOperation.LastUpdateTime = metav1.Now()
+	if err := w.client.Status().Patch(ctx, w.worker, patch); err != nil {
+		logf.FromContext(ctx).Error(err, "Could not report progress", "progress", progress, "description", description)
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-aws/imagevector"
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+)
+
+const (
+	// spotInterruptionHandlerManagedResourceName is the name of the managed resource containing the node termination
+	// handler of the spot worker pools.
+	spotInterruptionHandlerManagedResourceName = "extension-worker-spot-interruption-handler"
+
+	nodeTerminationHandlerName = "aws-node-termination-handler"
+)
+
+// reconcileSpotInterruptionHandler deploys the AWS node termination handler to the nodes of the spot worker pools in
+// the shoot. It watches the instance metadata of the spot instances and cordons and drains the nodes before they are
+// interrupted, and cordons them on rebalance recommendations. If no worker pool requires it, the managed resource is
+// deleted.
+func (w *workerDelegate) reconcileSpotInterruptionHandler(ctx context.Context) error {
+	cordonPools, drainPools, err := w.spotInterruptionHandlerPools()
+	if err != nil {
+		return err
+	}
+	if len(cordonPools) == 0 && len(drainPools) == 0 {
+		return w.deleteSpotInterruptionHandler(ctx)
+	}
+
+	image, err := imagevector.ImageVector().FindImage(aws.NodeTerminationHandlerImageName)
+	if err != nil {
+		return err
+	}
+
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: nodeTerminationHandlerName, Namespace: metav1.NamespaceSystem},
+	}
+	clusterRole := &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{Name: "gardener.cloud:aws:" + nodeTerminationHandlerName},
+		Rules: []rbacv1.PolicyRule{
+			{APIGroups: []string{""}, Resources: []string{"nodes"}, Verbs: []string{"get", "list", "patch", "update"}},
+			{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get", "list"}},
+			{APIGroups: []string{""}, Resources: []string{"pods/eviction"}, Verbs: []string{"create"}},
+			{APIGroups: []string{"apps"}, Resources: []string{"daemonsets"}, Verbs: []string{"get"}},
+		},
+	}
+	clusterRoleBinding := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: clusterRole.Name},
+		RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: clusterRole.Name},
+		Subjects:   []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: serviceAccount.Name, Namespace: serviceAccount.Namespace}},
+	}
+	objects := []client.Object{serviceAccount, clusterRole, clusterRoleBinding}
+
+	// The behaviour on rebalance recommendations is a setting of the whole node termination handler, hence the worker
+	// pools draining their nodes on rebalance recommendations get a separate DaemonSet.
+	if len(cordonPools) > 0 {
+		objects = append(objects, nodeTerminationHandlerDaemonSet(nodeTerminationHandlerName, image.String(), cordonPools, false))
+	}
+	if len(drainPools) > 0 {
+		objects = append(objects, nodeTerminationHandlerDaemonSet(nodeTerminationHandlerName+"-rebalance-draining", image.String(), drainPools, true))
+	}
+
+	registry := managedresources.NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer)
+	data, err := registry.AddAllAndSerialize(objects...)
+	if err != nil {
+		return err
+	}
+	return managedresources.CreateForShoot(ctx, w.client, w.worker.Namespace, spotInterruptionHandlerManagedResourceName, aws.Name, false, data)
+}
+
+func (w *workerDelegate) deleteSpotInterruptionHandler(ctx context.Context) error {
+	if err := managedresources.DeleteForShoot(ctx, w.client, w.worker.Namespace, spotInterruptionHandlerManagedResourceName); err != nil {
+		return fmt.Errorf("failed to delete managed resource of spot interruption handler: %w", err)
+	}
+	return nil
+}
+
+// spotInterruptionHandlerPools returns the names of the spot worker pools with an enabled interruption handling,
+// separated by whether their nodes are only cordoned or also drained on rebalance recommendations.
+func (w *workerDelegate) spotInterruptionHandlerPools() ([]string, []string, error) {
+	var cordonPools, drainPools []string
+	for _, pool := range w.worker.Spec.Pools {
+		workerConfig, err := w.decodeWorkerConfig(pool.ProviderConfig)
+		if err != nil {
+			return nil, nil, err
+		}
+		if workerConfig.CapacityType == nil || *workerConfig.CapacityType != awsapi.CapacityTypeSpot {
+			continue
+		}
+		handling := workerConfig.SpotInterruptionHandling
+		switch {
+		case handling == nil:
+			cordonPools = append(cordonPools, pool.Name)
+		case handling.Enabled != nil && !*handling.Enabled:
+			continue
+		case handling.DrainOnRebalanceRecommendation:
+			drainPools = append(drainPools, pool.Name)
+		default:
+			cordonPools = append(cordonPools, pool.Name)
+		}
+	}
+	return cordonPools, drainPools, nil
+}
+
+// nodeTerminationHandlerDaemonSet returns the DaemonSet of the node termination handler in IMDS mode running on the
+// nodes of the given worker pools. It uses the host network, so that the instance metadata is reachable independent
+// of the hop limit of the machines.
+func nodeTerminationHandlerDaemonSet(name, image string, pools []string, drainOnRebalanceRecommendation bool) *appsv1.DaemonSet {
+	labels := map[string]string{v1beta1constants.LabelApp: name}
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: metav1.NamespaceSystem,
+			Labels:    labels,
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			UpdateStrategy: appsv1.DaemonSetUpdateStrategy{
+				Type: appsv1.RollingUpdateDaemonSetStrategyType,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					PriorityClassName:  "system-node-critical",
+					ServiceAccountName: nodeTerminationHandlerName,
+					HostNetwork:        true,
+					DNSPolicy:          corev1.DNSClusterFirstWithHostNet,
+					Affinity: &corev1.Affinity{
+						NodeAffinity: &corev1.NodeAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+								NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+									MatchExpressions: []corev1.NodeSelectorRequirement{{
+										Key:      v1beta1constants.LabelWorkerPool,
+										Operator: corev1.NodeSelectorOpIn,
+										Values:   sets.List(sets.New(pools...)),
+									}},
+								}},
+							},
+						},
+					},
+					// The handler must keep running on cordoned and tainted nodes until they are drained.
+					Tolerations: []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+					Containers: []corev1.Container{{
+						Name:  nodeTerminationHandlerName,
+						Image: image,
+						Env: []corev1.EnvVar{
+							{Name: "NODE_NAME", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "spec.nodeName"}}},
+							{Name: "POD_NAME", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"}}},
+							{Name: "NAMESPACE", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.namespace"}}},
+							{Name: "ENABLE_SPOT_INTERRUPTION_DRAINING", Value: "true"},
+							{Name: "ENABLE_SCHEDULED_EVENT_DRAINING", Value: "true"},
+							{Name: "ENABLE_REBALANCE_MONITORING", Value: "true"},
+							{Name: "ENABLE_REBALANCE_DRAINING", Value: strconv.FormatBool(drainOnRebalanceRecommendation)},
+							{Name: "IGNORE_DAEMON_SETS", Value: "true"},
+							{Name: "DELETE_LOCAL_DATA", Value: "true"},
+							{Name: "UPTIME_FROM_FILE", Value: "/proc/uptime"},
+						},
+						SecurityContext: &corev1.SecurityContext{
+							AllowPrivilegeEscalation: pointer.Bool(false),
+							ReadOnlyRootFilesystem:   pointer.Bool(true),
+							RunAsNonRoot:             pointer.Bool(true),
+							RunAsUser:                pointer.Int64(1000),
+							Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+						},
+						VolumeMounts: []corev1.VolumeMount{{Name: "uptime", MountPath: "/proc/uptime", ReadOnly: true}},
+					}},
+					Volumes: []corev1.Volume{{
+						Name: "uptime",
+						VolumeSource: corev1.VolumeSource{
+							HostPath: &corev1.HostPathVolumeSource{Path: "/proc/uptime"},
+						},
+					}},
+				},
+			},
+		},
+	}
+}
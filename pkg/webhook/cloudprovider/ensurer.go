@@ -37,9 +37,26 @@ type ensurer struct {
 	logger logr.Logger
 }
 
+// webIdentityTokenFile is the path of the web identity token in the containers which mount the cloudprovider secret
+// and use its shared credentials file.
+const webIdentityTokenFile = "/srv/cloudprovider/" + aws.WebIdentityToken
+
 // EnsureCloudProviderSecret ensures that cloudprovider secret contains
 // the shared credentials file.
 func (e *ensurer) EnsureCloudProviderSecret(_ context.Context, _ gcontext.GardenContext, new, _ *corev1.Secret) error {
+	if _, ok := new.Data[aws.WebIdentityToken]; ok {
+		if _, ok := new.Data[aws.RoleARN]; !ok {
+			return fmt.Errorf("could not mutate cloudprovider secret as %q field is missing", aws.RoleARN)
+		}
+
+		e.logger.V(5).Info("mutate cloudprovider secret", "namespace", new.Namespace, "name", new.Name)
+		new.Data[aws.SharedCredentialsFile] = []byte("[default]\n" +
+			fmt.Sprintf("role_arn=%s\n", string(new.Data[aws.RoleARN])) +
+			fmt.Sprintf("web_identity_token_file=%s", webIdentityTokenFile),
+		)
+		return nil
+	}
+
 	if _, ok := new.Data[aws.AccessKeyID]; !ok {
 		return fmt.Errorf("could not mutate cloudprovider secret as %q field is missing", aws.AccessKeyID)
 	}
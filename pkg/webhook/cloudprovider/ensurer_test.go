@@ -65,6 +65,29 @@ var _ = Describe("Ensurer", func() {
 			err := ensurer.EnsureCloudProviderSecret(ctx, nil, secret, nil)
 			Expect(err).To(MatchError(ContainSubstring("could not mutate cloudprovider secret as %q field is missing", aws.SecretAccessKey)))
 		})
+		It("should fail as no roleARN is present for the web identity token", func() {
+			secret.Data = map[string][]byte{
+				aws.WebIdentityToken: []byte("token"),
+			}
+			err := ensurer.EnsureCloudProviderSecret(ctx, nil, secret, nil)
+			Expect(err).To(MatchError(ContainSubstring("could not mutate cloudprovider secret as %q field is missing", aws.RoleARN)))
+		})
+		It("should write a credentials file referring to the web identity token", func() {
+			secret.Data = map[string][]byte{
+				aws.RoleARN:          []byte("arn:aws:iam::123456789012:role/foo"),
+				aws.WebIdentityToken: []byte("token"),
+			}
+
+			err := ensurer.EnsureCloudProviderSecret(ctx, nil, secret, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secret.Data).To(Equal(map[string][]byte{
+				aws.RoleARN:          []byte("arn:aws:iam::123456789012:role/foo"),
+				aws.WebIdentityToken: []byte("token"),
+				aws.SharedCredentialsFile: []byte(`[default]
+role_arn=arn:aws:iam::123456789012:role/foo
+web_identity_token_file=/srv/cloudprovider/token`),
+			}))
+		})
 		It("should replace esixting credentials file", func() {
 			secret.Data[aws.SharedCredentialsFile] = []byte("shared-credentials-file")
 
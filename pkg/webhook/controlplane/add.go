@@ -15,6 +15,8 @@
 package controlplane
 
 import (
+	"time"
+
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/extensions/pkg/webhook/controlplane"
 	"github.com/gardener/gardener/extensions/pkg/webhook/controlplane/genericmutator"
@@ -27,8 +29,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
 )
 
+// awsQueryCacheTTL is the time for which the instance types retrieved from EC2 are cached.
+const awsQueryCacheTTL = time.Hour
+
 var (
 	logger = log.Log.WithName("aws-controlplane-webhook")
 	// NodeAgentEnabled indicates whether the gardener node-agent feature flag is enabled in gardenlet.
@@ -47,7 +53,9 @@ func AddToManager(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
 			{Obj: &vpaautoscalingv1.VerticalPodAutoscaler{}},
 			{Obj: &extensionsv1alpha1.OperatingSystemConfig{}},
 		},
-		Mutator: genericmutator.NewMutator(mgr, NewEnsurer(logger, mgr.GetClient(), NodeAgentEnabled), oscutils.NewUnitSerializer(),
-			kubelet.NewConfigCodec(fciCodec), fciCodec, logger),
+		Mutator: newWorkerPoolMutator(genericmutator.NewMutator(mgr, NewEnsurer(logger, mgr.GetClient(), NodeAgentEnabled), oscutils.NewUnitSerializer(),
+			kubelet.NewConfigCodec(fciCodec), fciCodec, logger), mgr.GetClient(),
+			awsclient.NewControllerFactory(awsclient.NewCachingFactory(awsclient.FactoryFunc(awsclient.NewInterface), awsQueryCacheTTL), "controlplane-webhook"),
+			kubelet.NewConfigCodec(fciCodec)),
 	})
 }
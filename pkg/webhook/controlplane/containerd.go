@@ -0,0 +1,79 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/utils/pointer"
+
+	api "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+)
+
+const (
+	// containerdRegistryHostsDir is the directory of the registry host configurations of containerd, which is
+	// configured by Gardener as `config_path` of the CRI registry plugin.
+	containerdRegistryHostsDir = "/etc/containerd/certs.d"
+	// containerdConfigPatchPath is the path of the configuration patch of containerd in the directory which is
+	// imported by Gardener into the configuration of containerd.
+	containerdConfigPatchPath = "/etc/containerd/conf.d/provider-aws.toml"
+)
+
+// ensureContainerdConfig adds the registry mirrors and the configuration patch of the given containerd configuration
+// to the given operating system config.
+func ensureContainerdConfig(osc *extensionsv1alpha1.OperatingSystemConfig, config *api.ContainerdConfig) {
+	for _, mirror := range config.RegistryMirrors {
+		osc.Spec.Files = extensionswebhook.EnsureFileWithPath(osc.Spec.Files, extensionsv1alpha1.File{
+			Path:        filepath.Join(containerdRegistryHostsDir, mirror.Upstream, "hosts.toml"),
+			Permissions: pointer.Int32(0644),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{
+					Data: registryHostsConfig(mirror),
+				},
+			},
+		})
+	}
+
+	if config.ConfigPatch != nil {
+		osc.Spec.Files = extensionswebhook.EnsureFileWithPath(osc.Spec.Files, extensionsv1alpha1.File{
+			Path:        containerdConfigPatchPath,
+			Permissions: pointer.Int32(0644),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{
+					Data: *config.ConfigPatch,
+				},
+			},
+		})
+	}
+}
+
+// registryHostsConfig returns the hosts.toml of containerd for the given registry mirror.
+func registryHostsConfig(mirror api.RegistryMirror) string {
+	server := "https://" + mirror.Upstream
+	if mirror.Upstream == "docker.io" {
+		server = "https://registry-1.docker.io"
+	}
+
+	var config strings.Builder
+	fmt.Fprintf(&config, "server = %q\n", server)
+	for _, endpoint := range mirror.Endpoints {
+		fmt.Fprintf(&config, "\n[host.%q]\n  capabilities = [\"pull\", \"resolve\"]\n", endpoint)
+	}
+	return config.String()
+}
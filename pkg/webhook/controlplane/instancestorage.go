@@ -0,0 +1,162 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"fmt"
+	"strconv"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/utils/pointer"
+)
+
+const (
+	instanceStorageScriptPath = "/opt/bin/instance-storage.sh"
+	instanceStorageUnitName   = "instance-storage.service"
+
+	instanceStorageScript = `#!/bin/bash -eu
+# Sets up the NVMe instance store volumes of the machine as ephemeral storage of kubelet and containerd. The volumes are
+# mounted with mount units, which are started again on every boot. The data of kubelet and containerd on the root disk
+# is only copied to the volumes right after they have been formatted, as it is outdated afterwards.
+
+MOUNT_PATH=/var/lib/instance-storage
+UNIT_PATH=/etc/systemd/system
+RAID0=${RAID0:-true}
+
+devices=()
+for device in /dev/nvme*n1; do
+  [[ -e "$device" ]] || continue
+  model=$(cat "/sys/block/$(basename "$device")/device/model" 2>/dev/null | xargs)
+  if [[ "$model" == "Amazon EC2 NVMe Instance Storage" ]]; then
+    devices+=("$device")
+  fi
+done
+
+if [[ ${#devices[@]} -eq 0 ]]; then
+  echo "no instance store volumes found"
+  exit 0
+fi
+
+if [[ "$RAID0" == "true" && ${#devices[@]} -gt 1 ]]; then
+  device=/dev/md/instance-storage
+  if [[ ! -e "$device" ]]; then
+    mdadm --assemble "$device" "${devices[@]}" 2>/dev/null || \
+      mdadm --create "$device" --level=0 --raid-devices=${#devices[@]} --run --force "${devices[@]}"
+  fi
+else
+  device=${devices[0]}
+fi
+
+# The volumes are empty after the machine has been stopped and started again, they only keep their data on reboots.
+formatted=false
+if ! blkid "$device" >/dev/null 2>&1; then
+  echo "formatting $device"
+  mkfs.ext4 -F "$device"
+  formatted=true
+fi
+
+# write_mount_unit writes a mount unit for the given path and prints its name.
+write_mount_unit() {
+  local what=$1 where=$2 options=$3 requires=$4 unit dependencies=""
+  unit=$(systemd-escape -p --suffix=mount "$where")
+  if [[ -n "$requires" ]]; then
+    dependencies="Requires=$requires
+After=$requires"
+  fi
+  cat > "$UNIT_PATH/$unit" <<EOF
+[Unit]
+Description=Instance storage for $where
+Before=containerd.service kubelet.service
+$dependencies
+
+[Mount]
+What=$what
+Where=$where
+Options=$options
+EOF
+  echo "$unit"
+}
+
+mkdir -p "$MOUNT_PATH"
+storage_unit=$(write_mount_unit "/dev/disk/by-uuid/$(blkid -s UUID -o value "$device")" "$MOUNT_PATH" defaults,noatime "")
+bind_units=()
+for dir in kubelet containerd; do
+  mkdir -p "/var/lib/$dir"
+  bind_units+=("$(write_mount_unit "$MOUNT_PATH/$dir" "/var/lib/$dir" bind "$storage_unit")")
+done
+systemctl daemon-reload
+
+if mountpoint -q "$MOUNT_PATH" && mountpoint -q /var/lib/kubelet && mountpoint -q /var/lib/containerd; then
+  echo "instance storage is already mounted"
+  exit 0
+fi
+
+restart=()
+for service in kubelet containerd; do
+  if systemctl is-active -q "$service"; then
+    systemctl stop "$service"
+    restart+=("$service")
+  fi
+done
+
+systemctl start "$storage_unit"
+for dir in kubelet containerd; do
+  mkdir -p "$MOUNT_PATH/$dir"
+  if [[ "$formatted" == "true" ]]; then
+    cp -a "/var/lib/$dir/." "$MOUNT_PATH/$dir/"
+  fi
+done
+systemctl start "${bind_units[@]}"
+echo "mounted instance storage $device for kubelet and containerd"
+
+for ((i=${#restart[@]}-1; i>=0; i--)); do
+  systemctl start "${restart[$i]}"
+done
+`
+)
+
+func ensureInstanceStorage(osc *extensionsv1alpha1.OperatingSystemConfig, raid0 bool) {
+	unitContent := fmt.Sprintf(`[Unit]
+Description=Set up the instance store volumes as ephemeral storage
+After=local-fs.target
+Before=containerd.service kubelet.service
+
+[Install]
+WantedBy=multi-user.target
+
+[Service]
+Type=oneshot
+RemainAfterExit=yes
+Environment=RAID0=%s
+ExecStart=%s
+`, strconv.FormatBool(raid0), instanceStorageScriptPath)
+
+	osc.Spec.Files = extensionswebhook.EnsureFileWithPath(osc.Spec.Files, extensionsv1alpha1.File{
+		Path:        instanceStorageScriptPath,
+		Permissions: pointer.Int32(0755),
+		Content: extensionsv1alpha1.FileContent{
+			Inline: &extensionsv1alpha1.FileContentInline{
+				Data: instanceStorageScript,
+			},
+		},
+	})
+	extensionswebhook.AppendUniqueUnit(&osc.Spec.Units, extensionsv1alpha1.Unit{
+		Name:    instanceStorageUnitName,
+		Enable:  pointer.Bool(true),
+		Command: extensionsv1alpha1.UnitCommandPtr(extensionsv1alpha1.CommandStart),
+		Content: &unitContent,
+	})
+}
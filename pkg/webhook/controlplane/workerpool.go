@@ -0,0 +1,160 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"fmt"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	gcontext "github.com/gardener/gardener/extensions/pkg/webhook/context"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	api "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+)
+
+// workerPoolMutator wraps the generic control plane mutator and adds the configuration of the WorkerConfig, i.e. the
+// setup of the instance store volumes, the containerd configuration and the reserved resources of the kubelet, to the
+// operating system configs of the worker pools. This cannot be done by the ensurer as it is not aware of the worker
+// pool an operating system config belongs to.
+type workerPoolMutator struct {
+	extensionswebhook.Mutator
+	client             client.Client
+	awsClientFactory   awsclient.Factory
+	kubeletConfigCodec kubelet.ConfigCodec
+}
+
+func newWorkerPoolMutator(mutator extensionswebhook.Mutator, client client.Client, awsClientFactory awsclient.Factory, kubeletConfigCodec kubelet.ConfigCodec) extensionswebhook.Mutator {
+	return &workerPoolMutator{Mutator: mutator, client: client, awsClientFactory: awsClientFactory, kubeletConfigCodec: kubeletConfigCodec}
+}
+
+// Mutate implements extensionswebhook.Mutator.
+func (m *workerPoolMutator) Mutate(ctx context.Context, newObj, oldObj client.Object) error {
+	if err := m.Mutator.Mutate(ctx, newObj, oldObj); err != nil {
+		return err
+	}
+
+	osc, ok := newObj.(*extensionsv1alpha1.OperatingSystemConfig)
+	if !ok || osc.DeletionTimestamp != nil || osc.Spec.Purpose != extensionsv1alpha1.OperatingSystemConfigPurposeReconcile {
+		return nil
+	}
+	poolName, ok := osc.Labels[v1beta1constants.LabelWorkerPool]
+	if !ok {
+		return nil
+	}
+
+	cluster, err := gcontext.NewGardenContext(m.client, osc).GetCluster(ctx)
+	if err != nil {
+		return err
+	}
+	if cluster.Shoot == nil || cluster.Shoot.Spec.Provider.Workers == nil {
+		return nil
+	}
+
+	for _, worker := range cluster.Shoot.Spec.Provider.Workers {
+		if worker.Name != poolName {
+			continue
+		}
+
+		workerConfig, err := helper.WorkerConfigFromRawExtension(worker.ProviderConfig)
+		if err != nil {
+			return fmt.Errorf("could not decode provider config of worker pool %s: %w", poolName, err)
+		}
+
+		if workerConfig.InstanceStorage != nil {
+			ensureInstanceStorage(osc, pointer.BoolDeref(workerConfig.InstanceStorage.RAID0, true))
+		}
+		if workerConfig.Containerd != nil {
+			ensureContainerdConfig(osc, workerConfig.Containerd)
+		}
+		if kubeletConfig := workerConfig.Kubelet; kubeletConfig != nil && (kubeletConfig.KubeReservedFromInstanceType || kubeletConfig.MaxPodsFromNetworkInterfaces) {
+			if err := m.ensureKubeletConfigFromInstanceType(ctx, osc, cluster, worker, kubeletConfig); err != nil {
+				return fmt.Errorf("could not compute kubelet configuration of worker pool %s: %w", poolName, err)
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
+// ensureKubeletConfigFromInstanceType sets the maximum number of pods and the CPU and memory of kubeReserved in the
+// kubelet configuration of the given operating system config according to the instance type of the given worker pool,
+// if enabled by the given configuration.
+func (m *workerPoolMutator) ensureKubeletConfigFromInstanceType(ctx context.Context, osc *extensionsv1alpha1.OperatingSystemConfig, cluster *extensionscontroller.Cluster, worker gardencorev1beta1.Worker, config *api.KubeletConfig) error {
+	file := extensionswebhook.FileWithPath(osc.Spec.Files, v1beta1constants.OperatingSystemConfigFilePathKubeletConfig)
+	if file == nil || file.Content.Inline == nil {
+		return nil
+	}
+	kubeletConfig, err := m.kubeletConfigCodec.Decode(file.Content.Inline)
+	if err != nil {
+		return fmt.Errorf("could not decode kubelet configuration: %w", err)
+	}
+
+	infrastructureConfig, err := helper.InfrastructureConfigFromCluster(cluster)
+	if err != nil {
+		return err
+	}
+	credentials, err := aws.GetCredentialsFromSecretRef(ctx, m.client, corev1.SecretReference{Name: v1beta1constants.SecretNameCloudProvider, Namespace: osc.Namespace}, false)
+	if err != nil {
+		return fmt.Errorf("could not get AWS credentials: %w", err)
+	}
+	authConfig := aws.NewAuthConfig(credentials, cluster.Shoot.Spec.Region)
+	authConfig.Shoot = osc.Namespace
+	if infrastructureConfig != nil {
+		aws.ApplyEndpoints(&authConfig, infrastructureConfig.Endpoints)
+	}
+	awsClient, err := m.awsClientFactory.NewClient(authConfig)
+	if err != nil {
+		return err
+	}
+
+	info, err := awsClient.GetInstanceTypeInfo(ctx, worker.Machine.Type)
+	if err != nil {
+		return err
+	}
+	if info == nil {
+		return fmt.Errorf("instance type %s is unknown", worker.Machine.Type)
+	}
+
+	// The maximum number of pods is computed first, as the reserved memory depends on it.
+	if config.MaxPodsFromNetworkInterfaces {
+		kubeletConfig.MaxPods = aws.ComputeMaxPodsFromNetworkInterfaces(info)
+	}
+	if config.KubeReservedFromInstanceType {
+		if kubeletConfig.KubeReserved == nil {
+			kubeletConfig.KubeReserved = map[string]string{}
+		}
+		cpu, memory := aws.ComputeKubeReserved(info.VCPUs, kubeletConfig.MaxPods)
+		kubeletConfig.KubeReserved[string(corev1.ResourceCPU)] = cpu.String()
+		kubeletConfig.KubeReserved[string(corev1.ResourceMemory)] = memory.String()
+	}
+
+	fci, err := m.kubeletConfigCodec.Encode(kubeletConfig, file.Content.Inline.Encoding)
+	if err != nil {
+		return fmt.Errorf("could not encode kubelet configuration: %w", err)
+	}
+	file.Content.Inline = fci
+	return nil
+}
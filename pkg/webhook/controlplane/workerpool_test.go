@@ -0,0 +1,267 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"encoding/json"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
+	oscutils "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/utils"
+	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeletconfigv1beta1 "k8s.io/kubelet/config/v1beta1"
+	"k8s.io/utils/pointer"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
+	mockawsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client/mock"
+)
+
+type mutatorFunc func(ctx context.Context, newObj, oldObj k8sclient.Object) error
+
+func (f mutatorFunc) Mutate(ctx context.Context, newObj, oldObj k8sclient.Object) error {
+	return f(ctx, newObj, oldObj)
+}
+
+var _ = Describe("WorkerPoolMutator", func() {
+	var (
+		ctrl             *gomock.Controller
+		c                *mockclient.MockClient
+		awsClientFactory *mockawsclient.MockFactory
+		awsClient        *mockawsclient.MockInterface
+		ctx              = context.TODO()
+
+		kubeletConfigCodec = kubelet.NewConfigCodec(oscutils.NewFileContentInlineCodec())
+
+		innerCalled bool
+		mutator     = func() *workerPoolMutator {
+			return newWorkerPoolMutator(mutatorFunc(func(_ context.Context, _, _ k8sclient.Object) error {
+				innerCalled = true
+				return nil
+			}), c, awsClientFactory, kubeletConfigCodec).(*workerPoolMutator)
+		}
+
+		osc *extensionsv1alpha1.OperatingSystemConfig
+
+		expectCluster = func(workerConfig *v1alpha1.WorkerConfig) {
+			worker := gardencorev1beta1.Worker{Name: "pool", Machine: gardencorev1beta1.Machine{Type: "m5.xlarge"}}
+			if workerConfig != nil {
+				workerConfig.TypeMeta = metav1.TypeMeta{
+					APIVersion: v1alpha1.SchemeGroupVersion.String(),
+					Kind:       "WorkerConfig",
+				}
+				raw, err := json.Marshal(workerConfig)
+				Expect(err).NotTo(HaveOccurred())
+				worker.ProviderConfig = &runtime.RawExtension{Raw: raw}
+			}
+			shoot, err := json.Marshal(&gardencorev1beta1.Shoot{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: gardencorev1beta1.SchemeGroupVersion.String(),
+					Kind:       "Shoot",
+				},
+				Spec: gardencorev1beta1.ShootSpec{
+					Region:   "eu-west-1",
+					Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{worker}},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			c.EXPECT().Get(ctx, k8sclient.ObjectKey{Name: namespace}, gomock.AssignableToTypeOf(&extensionsv1alpha1.Cluster{})).DoAndReturn(
+				func(_ context.Context, _ k8sclient.ObjectKey, obj *extensionsv1alpha1.Cluster, _ ...k8sclient.GetOption) error {
+					obj.Spec.Shoot = runtime.RawExtension{Raw: shoot}
+					return nil
+				},
+			)
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+		awsClientFactory = mockawsclient.NewMockFactory(ctrl)
+		awsClient = mockawsclient.NewMockInterface(ctrl)
+		innerCalled = false
+
+		osc = &extensionsv1alpha1.OperatingSystemConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "osc",
+				Namespace: namespace,
+				Labels:    map[string]string{v1beta1constants.LabelWorkerPool: "pool"},
+			},
+			Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+				Purpose: extensionsv1alpha1.OperatingSystemConfigPurposeReconcile,
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should add the instance storage unit and script if enabled for the worker pool", func() {
+		expectCluster(&v1alpha1.WorkerConfig{InstanceStorage: &v1alpha1.InstanceStorage{RAID0: pointer.Bool(false)}})
+
+		Expect(mutator().Mutate(ctx, osc, nil)).To(Succeed())
+		Expect(innerCalled).To(BeTrue())
+
+		Expect(osc.Spec.Files).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Path":        Equal(instanceStorageScriptPath),
+			"Permissions": PointTo(Equal(int32(0755))),
+		})))
+		Expect(osc.Spec.Units).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Name":    Equal(instanceStorageUnitName),
+			"Content": PointTo(ContainSubstring("Environment=RAID0=false")),
+		})))
+	})
+
+	It("should not add the instance storage unit if not enabled for the worker pool", func() {
+		expectCluster(nil)
+
+		Expect(mutator().Mutate(ctx, osc, nil)).To(Succeed())
+		Expect(innerCalled).To(BeTrue())
+		Expect(osc.Spec.Files).To(BeEmpty())
+		Expect(osc.Spec.Units).To(BeEmpty())
+	})
+
+	It("should add the registry mirrors and the configuration patch of containerd", func() {
+		expectCluster(&v1alpha1.WorkerConfig{Containerd: &v1alpha1.ContainerdConfig{
+			RegistryMirrors: []v1alpha1.RegistryMirror{
+				{Upstream: "docker.io", Endpoints: []string{"https://mirror.example.com", "http://10.0.0.1:5000"}},
+				{Upstream: "ghcr.io", Endpoints: []string{"https://ghcr-mirror.example.com"}},
+			},
+			ConfigPatch: pointer.String("[plugins.\"io.containerd.grpc.v1.cri\"]\n  enable_cdi = true\n"),
+		}})
+
+		Expect(mutator().Mutate(ctx, osc, nil)).To(Succeed())
+		Expect(innerCalled).To(BeTrue())
+
+		Expect(osc.Spec.Files).To(ConsistOf(
+			MatchFields(IgnoreExtras, Fields{
+				"Path": Equal("/etc/containerd/certs.d/docker.io/hosts.toml"),
+				"Content": MatchFields(IgnoreExtras, Fields{"Inline": PointTo(MatchFields(IgnoreExtras, Fields{"Data": Equal(`server = "https://registry-1.docker.io"
+
+[host."https://mirror.example.com"]
+  capabilities = ["pull", "resolve"]
+
+[host."http://10.0.0.1:5000"]
+  capabilities = ["pull", "resolve"]
+`)}))}),
+			}),
+			MatchFields(IgnoreExtras, Fields{
+				"Path": Equal("/etc/containerd/certs.d/ghcr.io/hosts.toml"),
+				"Content": MatchFields(IgnoreExtras, Fields{"Inline": PointTo(MatchFields(IgnoreExtras, Fields{"Data": Equal(`server = "https://ghcr.io"
+
+[host."https://ghcr-mirror.example.com"]
+  capabilities = ["pull", "resolve"]
+`)}))}),
+			}),
+			MatchFields(IgnoreExtras, Fields{
+				"Path":    Equal(containerdConfigPatchPath),
+				"Content": MatchFields(IgnoreExtras, Fields{"Inline": PointTo(MatchFields(IgnoreExtras, Fields{"Data": Equal("[plugins.\"io.containerd.grpc.v1.cri\"]\n  enable_cdi = true\n")}))}),
+			}),
+		))
+	})
+
+	Context("kubelet configuration from instance type", func() {
+		var kubeletConfig *v1alpha1.KubeletConfig
+
+		BeforeEach(func() {
+			kubeletConfig = &v1alpha1.KubeletConfig{KubeReservedFromInstanceType: true}
+
+			fci, err := kubeletConfigCodec.Encode(&kubeletconfigv1beta1.KubeletConfiguration{
+				MaxPods:      58,
+				KubeReserved: map[string]string{"cpu": "80m", "memory": "1Gi", "pid": "20k"},
+			}, "")
+			Expect(err).NotTo(HaveOccurred())
+			osc.Spec.Files = []extensionsv1alpha1.File{{
+				Path:    v1beta1constants.OperatingSystemConfigFilePathKubeletConfig,
+				Content: extensionsv1alpha1.FileContent{Inline: fci},
+			}}
+		})
+
+		JustBeforeEach(func() {
+			expectCluster(&v1alpha1.WorkerConfig{Kubelet: kubeletConfig})
+			c.EXPECT().Get(ctx, k8sclient.ObjectKey{Namespace: namespace, Name: v1beta1constants.SecretNameCloudProvider}, gomock.AssignableToTypeOf(&corev1.Secret{})).DoAndReturn(
+				func(_ context.Context, _ k8sclient.ObjectKey, obj *corev1.Secret, _ ...k8sclient.GetOption) error {
+					obj.Data = map[string][]byte{
+						aws.AccessKeyID:     []byte("access-key-id"),
+						aws.SecretAccessKey: []byte("secret-access-key"),
+					}
+					return nil
+				},
+			)
+			awsClientFactory.EXPECT().NewClient(awsclient.AuthConfig{
+				AccessKeyID:     "access-key-id",
+				SecretAccessKey: "secret-access-key",
+				Region:          "eu-west-1",
+				Partition:       aws.DefaultPartition,
+				Shoot:           namespace,
+			}).Return(awsClient, nil)
+		})
+
+		It("should set the CPU and memory of kubeReserved according to the instance type", func() {
+			awsClient.EXPECT().GetInstanceTypeInfo(ctx, "m5.xlarge").Return(&awsclient.InstanceTypeInfo{InstanceType: "m5.xlarge", VCPUs: 4, MemoryMiB: 16384}, nil)
+
+			Expect(mutator().Mutate(ctx, osc, nil)).To(Succeed())
+
+			config, err := kubeletConfigCodec.Decode(osc.Spec.Files[0].Content.Inline)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.KubeReserved).To(Equal(map[string]string{"cpu": "80m", "memory": "893Mi", "pid": "20k"}))
+		})
+
+		Context("with maxPods from network interfaces", func() {
+			BeforeEach(func() {
+				kubeletConfig.MaxPodsFromNetworkInterfaces = true
+			})
+
+			It("should set the maximum number of pods according to the network interfaces of the instance type", func() {
+				awsClient.EXPECT().GetInstanceTypeInfo(ctx, "m5.xlarge").Return(&awsclient.InstanceTypeInfo{InstanceType: "m5.xlarge", VCPUs: 4, MemoryMiB: 16384, MaxNetworkInterfaces: 3, IPv4AddressesPerInterface: 10}, nil)
+
+				Expect(mutator().Mutate(ctx, osc, nil)).To(Succeed())
+
+				config, err := kubeletConfigCodec.Decode(osc.Spec.Files[0].Content.Inline)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(config.MaxPods).To(Equal(int32(29)))
+				Expect(config.KubeReserved).To(Equal(map[string]string{"cpu": "80m", "memory": "574Mi", "pid": "20k"}))
+			})
+		})
+
+		It("should fail if the instance type is unknown", func() {
+			awsClient.EXPECT().GetInstanceTypeInfo(ctx, "m5.xlarge").Return(nil, nil)
+
+			Expect(mutator().Mutate(ctx, osc, nil)).To(MatchError(ContainSubstring("instance type m5.xlarge is unknown")))
+		})
+	})
+
+	It("should not mutate provisioning operating system configs", func() {
+		osc.Spec.Purpose = extensionsv1alpha1.OperatingSystemConfigPurposeProvision
+
+		Expect(mutator().Mutate(ctx, osc, nil)).To(Succeed())
+		Expect(innerCalled).To(BeTrue())
+		Expect(osc.Spec.Units).To(BeEmpty())
+	})
+})
@@ -0,0 +1,131 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+)
+
+// ServiceWebhookName is the name of the shoot webhook which adds the default annotations to services of type
+// LoadBalancer.
+const ServiceWebhookName = "shoot-service"
+
+// annotationGroups are groups of annotations which are only defaulted if a service specifies none of them. The scheme
+// of a load balancer is controlled by different annotations for the cloud-controller-manager and the
+// aws-load-balancer-controller, and the access log settings of a service must not be mixed with the defaults.
+var annotationGroups = []sets.Set[string]{
+	sets.New(aws.AnnotationLoadBalancerInternal, aws.AnnotationLoadBalancerScheme),
+	sets.New(
+		aws.AnnotationLoadBalancerAccessLogEnabled,
+		aws.AnnotationLoadBalancerAccessLogBucketName,
+		aws.AnnotationLoadBalancerAccessLogBucketPrefix,
+		aws.AnnotationLoadBalancerAccessLogEmitInterval,
+	),
+}
+
+// AddServiceWebhookToManager creates the shoot service webhook and adds it to the manager.
+func AddServiceWebhookToManager(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
+	logger.Info("Adding service webhook to manager")
+
+	types := []extensionswebhook.Type{{Obj: &corev1.Service{}}}
+	handler, err := extensionswebhook.NewHandlerWithShootClient(mgr, types, NewServiceMutator(), logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensionswebhook.Webhook{
+		Name:   ServiceWebhookName,
+		Types:  types,
+		Path:   ServiceWebhookName,
+		Target: extensionswebhook.TargetShoot,
+		// services of all namespaces are mutated
+		Selector: &metav1.LabelSelector{},
+		Handler:  handler,
+	}, nil
+}
+
+type serviceMutator struct {
+	logger logr.Logger
+}
+
+// NewServiceMutator creates a new mutator which adds the load balancer defaults of the shoot to services of type
+// LoadBalancer.
+func NewServiceMutator() extensionswebhook.MutatorWithShootClient {
+	return &serviceMutator{
+		logger: log.Log.WithName("shoot-service-mutator"),
+	}
+}
+
+// Mutate adds the default annotations to services of type LoadBalancer when they are created. Existing services are
+// not mutated, as changing e.g. the scheme would replace their load balancers.
+func (m *serviceMutator) Mutate(ctx context.Context, new, old client.Object, shootClient client.Client) error {
+	service, ok := new.(*corev1.Service)
+	if !ok || old != nil || service.DeletionTimestamp != nil || service.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return nil
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := shootClient.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceSystem, Name: aws.LoadBalancerDefaultsConfigMapName}, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("could not read load balancer defaults: %w", err)
+	}
+
+	defaults := map[string]string{}
+	if err := json.Unmarshal([]byte(configMap.Data[aws.LoadBalancerDefaultsAnnotationsKey]), &defaults); err != nil {
+		return fmt.Errorf("could not decode load balancer defaults: %w", err)
+	}
+	if len(defaults) == 0 {
+		return nil
+	}
+
+	extensionswebhook.LogMutation(m.logger, "Service", service.Namespace, service.Name)
+	mutateServiceAnnotations(service, defaults)
+	return nil
+}
+
+func mutateServiceAnnotations(service *corev1.Service, defaults map[string]string) {
+	skipped := sets.New[string]()
+	for _, group := range annotationGroups {
+		for key := range service.Annotations {
+			if group.Has(key) {
+				skipped = skipped.Union(group)
+				break
+			}
+		}
+	}
+
+	for key, value := range defaults {
+		if _, ok := service.Annotations[key]; ok || skipped.Has(key) {
+			continue
+		}
+		metav1.SetMetaDataAnnotation(&service.ObjectMeta, key, value)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Service Mutator", func() {
+	var (
+		ctx         = context.TODO()
+		shootClient client.Client
+		mutator     *serviceMutator
+		service     *corev1.Service
+	)
+
+	BeforeEach(func() {
+		shootClient = fakeclient.NewClientBuilder().WithObjects(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "aws-load-balancer-defaults", Namespace: metav1.NamespaceSystem},
+			Data: map[string]string{
+				"annotations": `{"service.beta.kubernetes.io/aws-load-balancer-internal":"true","service.beta.kubernetes.io/aws-load-balancer-scheme":"internal","service.beta.kubernetes.io/aws-load-balancer-subnets":"subnet-1,subnet-2"}`,
+			},
+		}).Build()
+		mutator = &serviceMutator{logger: logger}
+		service = &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+			Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+		}
+	})
+
+	It("should add the default annotations to new services of type LoadBalancer", func() {
+		Expect(mutator.Mutate(ctx, service, nil, shootClient)).To(Succeed())
+		Expect(service.Annotations).To(Equal(map[string]string{
+			"service.beta.kubernetes.io/aws-load-balancer-internal": "true",
+			"service.beta.kubernetes.io/aws-load-balancer-scheme":   "internal",
+			"service.beta.kubernetes.io/aws-load-balancer-subnets":  "subnet-1,subnet-2",
+		}))
+	})
+
+	It("should not overwrite annotations of the service", func() {
+		service.Annotations = map[string]string{
+			"service.beta.kubernetes.io/aws-load-balancer-scheme":  "internet-facing",
+			"service.beta.kubernetes.io/aws-load-balancer-subnets": "subnet-3",
+		}
+
+		Expect(mutator.Mutate(ctx, service, nil, shootClient)).To(Succeed())
+		Expect(service.Annotations).To(Equal(map[string]string{
+			"service.beta.kubernetes.io/aws-load-balancer-scheme":  "internet-facing",
+			"service.beta.kubernetes.io/aws-load-balancer-subnets": "subnet-3",
+		}))
+	})
+
+	It("should not default access log annotations if the service configures access logs", func() {
+		shootClient = fakeclient.NewClientBuilder().WithObjects(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "aws-load-balancer-defaults", Namespace: metav1.NamespaceSystem},
+			Data: map[string]string{
+				"annotations": `{"service.beta.kubernetes.io/aws-load-balancer-access-log-enabled":"true","service.beta.kubernetes.io/aws-load-balancer-access-log-s3-bucket-name":"bucket","service.beta.kubernetes.io/aws-load-balancer-ssl-negotiation-policy":"ELBSecurityPolicy-TLS13-1-2-2021-06"}`,
+			},
+		}).Build()
+		service.Annotations = map[string]string{
+			"service.beta.kubernetes.io/aws-load-balancer-access-log-enabled": "false",
+		}
+
+		Expect(mutator.Mutate(ctx, service, nil, shootClient)).To(Succeed())
+		Expect(service.Annotations).To(Equal(map[string]string{
+			"service.beta.kubernetes.io/aws-load-balancer-access-log-enabled":     "false",
+			"service.beta.kubernetes.io/aws-load-balancer-ssl-negotiation-policy": "ELBSecurityPolicy-TLS13-1-2-2021-06",
+		}))
+	})
+
+	It("should not mutate existing services", func() {
+		Expect(mutator.Mutate(ctx, service, service.DeepCopy(), shootClient)).To(Succeed())
+		Expect(service.Annotations).To(BeEmpty())
+	})
+
+	It("should not mutate services of other types", func() {
+		service.Spec.Type = corev1.ServiceTypeClusterIP
+
+		Expect(mutator.Mutate(ctx, service, nil, shootClient)).To(Succeed())
+		Expect(service.Annotations).To(BeEmpty())
+	})
+
+	It("should not mutate services if no defaults are configured", func() {
+		Expect(mutator.Mutate(ctx, service, nil, fakeclient.NewClientBuilder().Build())).To(Succeed())
+		Expect(service.Annotations).To(BeEmpty())
+	})
+})
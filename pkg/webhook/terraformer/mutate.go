@@ -0,0 +1,76 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer
+
+import (
+	"context"
+	"fmt"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/config"
+	"github.com/gardener/gardener-extension-provider-aws/pkg/aws"
+)
+
+// containerName is the name of the container of the Terraformer pods.
+const containerName = "terraform"
+
+type mutator struct {
+	logger logr.Logger
+	config func() *config.TerraformerConfig
+}
+
+// New returns a new mutator which applies the resources and the active deadline of the Terraformer configuration of
+// the controller to Terraformer pods.
+func New(logger logr.Logger) extensionswebhook.Mutator {
+	return &mutator{
+		logger: logger,
+		config: func() *config.TerraformerConfig { return aws.DefaultTerraformerConfig },
+	}
+}
+
+// Mutate mutates the given Terraformer pod on creation. The Terraformer library doesn't allow to configure the
+// resources of the pods, which therefore are overwritten here.
+func (m *mutator) Mutate(_ context.Context, new, old client.Object) error {
+	if old != nil || new.GetDeletionTimestamp() != nil {
+		return nil
+	}
+
+	pod, ok := new.(*corev1.Pod)
+	if !ok {
+		return fmt.Errorf("could not mutate: object is not of type Pod")
+	}
+
+	cfg := m.config()
+	if cfg == nil || (cfg.ActiveDeadlineSeconds == nil && cfg.Resources == nil) {
+		return nil
+	}
+
+	extensionswebhook.LogMutation(m.logger, "Pod", pod.Namespace, pod.GenerateName+pod.Name)
+	if cfg.ActiveDeadlineSeconds != nil {
+		pod.Spec.ActiveDeadlineSeconds = cfg.ActiveDeadlineSeconds
+	}
+	if cfg.Resources != nil {
+		for i := range pod.Spec.Containers {
+			if pod.Spec.Containers[i].Name == containerName {
+				pod.Spec.Containers[i].Resources = *cfg.Resources.DeepCopy()
+			}
+		}
+	}
+	return nil
+}
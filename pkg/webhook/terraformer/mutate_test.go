@@ -0,0 +1,100 @@
+// Copyright (c) 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/config"
+)
+
+func TestTerraformer(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Terraformer Webhook Suite")
+}
+
+var _ = Describe("Mutate", func() {
+	var (
+		ctx       = context.TODO()
+		cfg       *config.TerraformerConfig
+		m         *mutator
+		pod       *corev1.Pod
+		resources = corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+			Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("4Gi")},
+		}
+	)
+
+	BeforeEach(func() {
+		cfg = &config.TerraformerConfig{
+			Resources:             resources.DeepCopy(),
+			ActiveDeadlineSeconds: pointer.Int64(1800),
+		}
+		m = &mutator{
+			logger: logr.Discard(),
+			config: func() *config.TerraformerConfig { return cfg },
+		}
+		pod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot-infra-tf-apply", Namespace: "shoot--foo--bar"},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name: "terraform",
+						Resources: corev1.ResourceRequirements{
+							Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1.5Gi")},
+						},
+					},
+					{Name: "other"},
+				},
+			},
+		}
+	})
+
+	It("should set the resources and the active deadline of the terraform container", func() {
+		Expect(m.Mutate(ctx, pod, nil)).To(Succeed())
+
+		Expect(pod.Spec.ActiveDeadlineSeconds).To(Equal(pointer.Int64(1800)))
+		Expect(pod.Spec.Containers[0].Resources).To(Equal(resources))
+		Expect(pod.Spec.Containers[1].Resources).To(BeZero())
+	})
+
+	It("should not mutate the pod if no configuration is set", func() {
+		cfg = nil
+		expected := pod.DeepCopy()
+
+		Expect(m.Mutate(ctx, pod, nil)).To(Succeed())
+		Expect(pod).To(Equal(expected))
+	})
+
+	It("should not mutate the pod on update", func() {
+		expected := pod.DeepCopy()
+
+		Expect(m.Mutate(ctx, pod, pod.DeepCopy())).To(Succeed())
+		Expect(pod).To(Equal(expected))
+	})
+
+	It("should fail if the object is not a pod", func() {
+		Expect(m.Mutate(ctx, &corev1.ConfigMap{}, nil)).To(MatchError(ContainSubstring("not of type Pod")))
+	})
+})
@@ -22,7 +22,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/aws/aws-sdk-go/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/extensions"
@@ -193,7 +193,7 @@ var _ = BeforeSuite(func() {
 	flag.Parse()
 	validateFlags()
 
-	awsClient, err = awsclient.NewClient(*accessKeyID, *secretAccessKey, aws.DefaultDNSRegion)
+	awsClient, err = awsclient.NewClient(awsclient.AuthConfig{AccessKeyID: *accessKeyID, SecretAccessKey: *secretAccessKey, Region: aws.DefaultDNSRegion})
 	Expect(err).NotTo(HaveOccurred())
 
 	By("setting up shoot environment")
@@ -310,16 +310,16 @@ var _ = Describe("DNSRecord tests", func() {
 				[]string{"3.3.3.3", "1.1.1.1"},
 				func() {
 					By("creating AWS DNS recordset and its meta recordset")
-					Expect(awsClient.CreateOrUpdateDNSRecordSet(ctx, zoneID, dns.Spec.Name, route53.RRTypeA, []string{"8.8.8.8"}, 120, stack)).To(Succeed())
-					Expect(awsClient.CreateOrUpdateDNSRecordSet(ctx, zoneID, "comment-"+dns.Spec.Name, route53.RRTypeTxt, []string{"foo"}, 600, stack)).To(Succeed())
+					Expect(awsClient.CreateOrUpdateDNSRecordSet(ctx, zoneID, dns.Spec.Name, string(route53types.RRTypeA), []string{"8.8.8.8"}, 120, stack, nil)).To(Succeed())
+					Expect(awsClient.CreateOrUpdateDNSRecordSet(ctx, zoneID, "comment-"+dns.Spec.Name, string(route53types.RRTypeTxt), []string{"foo"}, 600, stack, nil)).To(Succeed())
 				},
 				func() {
 					By("updating AWS DNS recordset")
-					Expect(awsClient.CreateOrUpdateDNSRecordSet(ctx, zoneID, dns.Spec.Name, route53.RRTypeA, []string{"8.8.8.8"}, 120, stack)).To(Succeed())
+					Expect(awsClient.CreateOrUpdateDNSRecordSet(ctx, zoneID, dns.Spec.Name, string(route53types.RRTypeA), []string{"8.8.8.8"}, 120, stack, nil)).To(Succeed())
 				},
 				func() {
 					By("updating AWS DNS recordset")
-					Expect(awsClient.CreateOrUpdateDNSRecordSet(ctx, zoneID, dns.Spec.Name, route53.RRTypeA, []string{"8.8.8.8"}, 120, stack)).To(Succeed())
+					Expect(awsClient.CreateOrUpdateDNSRecordSet(ctx, zoneID, dns.Spec.Name, string(route53types.RRTypeA), []string{"8.8.8.8"}, 120, stack, nil)).To(Succeed())
 				},
 			)
 		})
@@ -335,12 +335,12 @@ var _ = Describe("DNSRecord tests", func() {
 				nil,
 				func() {
 					By("creating AWS DNS recordset")
-					Expect(awsClient.CreateOrUpdateDNSRecordSet(ctx, zoneID, dns.Spec.Name, route53.RRTypeA, []string{"8.8.8.8"}, 120, stack)).To(Succeed())
+					Expect(awsClient.CreateOrUpdateDNSRecordSet(ctx, zoneID, dns.Spec.Name, string(route53types.RRTypeA), []string{"8.8.8.8"}, 120, stack, nil)).To(Succeed())
 				},
 				nil,
 				func() {
 					By("deleting AWS DNS recordset")
-					Expect(awsClient.DeleteDNSRecordSet(ctx, zoneID, dns.Spec.Name, route53.RRTypeA, nil, 0, stack)).To(Succeed())
+					Expect(awsClient.DeleteDNSRecordSet(ctx, zoneID, dns.Spec.Name, string(route53types.RRTypeA), nil, 0, stack, nil)).To(Succeed())
 				},
 			)
 		})
@@ -445,7 +445,7 @@ func verifyDNSRecordSet(ctx context.Context, awsClient *awsclient.Client, dns *e
 		Expect(rrss).To(HaveLen(1))
 		rrs := rrss[0]
 		Expect(rrs.Name).To(PointTo(Equal(ensureTrailingDot(dns.Spec.Name))))
-		Expect(rrs.Type).To(PointTo(Equal(recordType)))
+		Expect(rrs.Type).To(Equal(route53types.RRType(recordType)))
 		Expect(rrs.ResourceRecords).To(ConsistOf(resourceRecords(recordType, dns.Spec.Values)))
 		Expect(rrs.AliasTarget).To(BeNil())
 		Expect(rrs.TTL).To(PointTo(Equal(pointer.Int64Deref(dns.Spec.TTL, 120))))
@@ -464,15 +464,15 @@ func verifyDNSRecordSet(ctx context.Context, awsClient *awsclient.Client, dns *e
 			default:
 				Fail(fmt.Sprintf("unexpected value: %s", dns.Spec.Values[0]))
 			}
-			Expect(rrs.AliasTarget).To(Equal(&route53.AliasTarget{
+			Expect(rrs.AliasTarget).To(Equal(&route53types.AliasTarget{
 				DNSName:              pointer.String(ensureTrailingDot(dns.Spec.Values[0])),
 				HostedZoneId:         pointer.String(expectedHostedZoneId),
-				EvaluateTargetHealth: pointer.Bool(true),
+				EvaluateTargetHealth: true,
 			}))
 			Expect(rrs.TTL).To(BeNil())
 			found := false
 			for _, expectedType := range expectedTypes {
-				if expectedType == pointer.StringDeref(rrs.Type, "") {
+				if expectedType == string(rrs.Type) {
 					found = true
 				}
 			}
@@ -488,13 +488,13 @@ func verifyDNSRecordSetDeleted(ctx context.Context, awsClient *awsclient.Client,
 }
 
 func verifyMetaDNSRecordSetDeleted(ctx context.Context, awsClient *awsclient.Client, dns *extensionsv1alpha1.DNSRecord) {
-	rrss, err := awsClient.GetDNSRecordSets(ctx, *dns.Status.Zone, "comment-"+dns.Spec.Name, route53.RRTypeTxt)
+	rrss, err := awsClient.GetDNSRecordSets(ctx, *dns.Status.Zone, "comment-"+dns.Spec.Name, string(route53types.RRTypeTxt))
 	Expect(err).NotTo(HaveOccurred())
 	Expect(rrss).To(BeNil())
 }
 
 func deleteDNSRecordSet(ctx context.Context, awsClient *awsclient.Client, dns *extensionsv1alpha1.DNSRecord) {
-	err := awsClient.DeleteDNSRecordSet(ctx, *dns.Status.Zone, dns.Spec.Name, getRecordType(dns), nil, 0, awsclient.IPStackIPv4)
+	err := awsClient.DeleteDNSRecordSet(ctx, *dns.Status.Zone, dns.Spec.Name, getRecordType(dns), nil, 0, awsclient.IPStackIPv4, nil)
 	Expect(err).NotTo(HaveOccurred())
 }
 
@@ -507,13 +507,13 @@ func expectAliasTarget(dns *extensionsv1alpha1.DNSRecord) bool {
 		(strings.HasSuffix(dns.Spec.Values[0], ".elb.eu-west-1.amazonaws.com") || strings.HasSuffix(dns.Spec.Values[0], ".eu-west-1.elb.amazonaws.com"))
 }
 
-func resourceRecords(recordType string, values []string) []*route53.ResourceRecord {
-	var resourceRecords []*route53.ResourceRecord
+func resourceRecords(recordType string, values []string) []route53types.ResourceRecord {
+	var resourceRecords []route53types.ResourceRecord
 	for _, value := range values {
-		if recordType == route53.RRTypeTxt {
+		if recordType == string(route53types.RRTypeTxt) {
 			value = ensureQuoted(value)
 		}
-		resourceRecords = append(resourceRecords, &route53.ResourceRecord{
+		resourceRecords = append(resourceRecords, route53types.ResourceRecord{
 			Value: pointer.String(value),
 		})
 	}
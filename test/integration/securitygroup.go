@@ -17,8 +17,9 @@ package integration
 import (
 	"context"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ec2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/go-logr/logr"
 
 	awsclient "github.com/gardener/gardener-extension-provider-aws/pkg/aws/client"
@@ -26,14 +27,14 @@ import (
 
 // CreateSecurityGroup creates a new security group.
 func CreateSecurityGroup(ctx context.Context, awsClient *awsclient.Client, groupName string, vpcID string) (string, error) {
-	output, err := awsClient.EC2.CreateSecurityGroupWithContext(ctx, &ec2.CreateSecurityGroupInput{
+	output, err := awsClient.EC2.CreateSecurityGroup(ctx, &ec2.CreateSecurityGroupInput{
 		Description: awssdk.String("group for worker nodes"),
 		GroupName:   awssdk.String(groupName),
 		VpcId:       awssdk.String(vpcID),
-		TagSpecifications: []*ec2.TagSpecification{
+		TagSpecifications: []ec2types.TagSpecification{
 			{
-				ResourceType: awssdk.String("security-group"),
-				Tags: []*ec2.Tag{
+				ResourceType: ec2types.ResourceTypeSecurityGroup,
+				Tags: []ec2types.Tag{
 					{
 						Key:   awssdk.String("Name"),
 						Value: awssdk.String(groupName),
@@ -50,8 +51,8 @@ func CreateSecurityGroup(ctx context.Context, awsClient *awsclient.Client, group
 }
 
 // DestroySecurityGroup deletes an existing security group.
-func DestroySecurityGroup(_ context.Context, _ logr.Logger, awsClient *awsclient.Client, securityGroupID string) error {
-	_, err := awsClient.EC2.DeleteSecurityGroup(&ec2.DeleteSecurityGroupInput{
+func DestroySecurityGroup(ctx context.Context, _ logr.Logger, awsClient *awsclient.Client, securityGroupID string) error {
+	_, err := awsClient.EC2.DeleteSecurityGroup(ctx, &ec2.DeleteSecurityGroupInput{
 		GroupId: awssdk.String(securityGroupID),
 	})
 
@@ -16,11 +16,13 @@ package integration
 
 import (
 	"context"
+	"errors"
 	"time"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/service/ec2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/util/wait"
 
@@ -30,8 +32,8 @@ import (
 // CreateVPC creates a new VPC and waits for it to become available. It returns
 // the VPC ID, the Internet Gateway ID or an error in case something unexpected happens.
 func CreateVPC(ctx context.Context, log logr.Logger, awsClient *awsclient.Client, vpcCIDR string, enableDnsHostnames, dualstack bool) (string, string, error) {
-	createVpcOutput, err := awsClient.EC2.CreateVpc(&ec2.CreateVpcInput{
-		TagSpecifications:           awsclient.Tags{"Name": "aws-infrastructure-it-create-vpc"}.ToTagSpecifications(ec2.ResourceTypeVpc),
+	createVpcOutput, err := awsClient.EC2.CreateVpc(ctx, &ec2.CreateVpcInput{
+		TagSpecifications:           awsclient.Tags{"Name": "aws-infrastructure-it-create-vpc"}.ToTagSpecifications(ec2types.ResourceTypeVpc),
 		CidrBlock:                   awssdk.String(vpcCIDR),
 		AmazonProvidedIpv6CidrBlock: awssdk.Bool(dualstack),
 	})
@@ -43,15 +45,15 @@ func CreateVPC(ctx context.Context, log logr.Logger, awsClient *awsclient.Client
 	if err := wait.PollUntilContextCancel(ctx, 5*time.Second, false, func(_ context.Context) (bool, error) {
 		log.Info("Waiting until vpc is available...", "vpcID", *vpcID)
 
-		describeVpcOutput, err := awsClient.EC2.DescribeVpcs(&ec2.DescribeVpcsInput{
-			VpcIds: []*string{vpcID},
+		describeVpcOutput, err := awsClient.EC2.DescribeVpcs(ctx, &ec2.DescribeVpcsInput{
+			VpcIds: []string{*vpcID},
 		})
 		if err != nil {
 			return false, err
 		}
 
 		vpc := describeVpcOutput.Vpcs[0]
-		if *vpc.State != "available" {
+		if vpc.State != "available" {
 			return false, nil
 		}
 
@@ -61,8 +63,8 @@ func CreateVPC(ctx context.Context, log logr.Logger, awsClient *awsclient.Client
 	}
 
 	if enableDnsHostnames {
-		_, err = awsClient.EC2.ModifyVpcAttribute(&ec2.ModifyVpcAttributeInput{
-			EnableDnsHostnames: &ec2.AttributeBooleanValue{
+		_, err = awsClient.EC2.ModifyVpcAttribute(ctx, &ec2.ModifyVpcAttributeInput{
+			EnableDnsHostnames: &ec2types.AttributeBooleanValue{
 				Value: awssdk.Bool(true),
 			},
 			VpcId: vpcID,
@@ -72,8 +74,8 @@ func CreateVPC(ctx context.Context, log logr.Logger, awsClient *awsclient.Client
 		}
 	}
 
-	_, err = awsClient.EC2.ModifyVpcAttribute(&ec2.ModifyVpcAttributeInput{
-		EnableDnsSupport: &ec2.AttributeBooleanValue{
+	_, err = awsClient.EC2.ModifyVpcAttribute(ctx, &ec2.ModifyVpcAttributeInput{
+		EnableDnsSupport: &ec2types.AttributeBooleanValue{
 			Value: awssdk.Bool(true),
 		},
 		VpcId: vpcID,
@@ -82,15 +84,15 @@ func CreateVPC(ctx context.Context, log logr.Logger, awsClient *awsclient.Client
 		return "", "", err
 	}
 
-	createIgwOutput, err := awsClient.EC2.CreateInternetGateway(&ec2.CreateInternetGatewayInput{
-		TagSpecifications: awsclient.Tags{"Name": "aws-infrastructure-it-create-vpc"}.ToTagSpecifications(ec2.ResourceTypeInternetGateway),
+	createIgwOutput, err := awsClient.EC2.CreateInternetGateway(ctx, &ec2.CreateInternetGatewayInput{
+		TagSpecifications: awsclient.Tags{"Name": "aws-infrastructure-it-create-vpc"}.ToTagSpecifications(ec2types.ResourceTypeInternetGateway),
 	})
 	if err != nil {
 		return "", "", err
 	}
 	igwID := createIgwOutput.InternetGateway.InternetGatewayId
 
-	_, err = awsClient.EC2.AttachInternetGateway(&ec2.AttachInternetGatewayInput{
+	_, err = awsClient.EC2.AttachInternetGateway(ctx, &ec2.AttachInternetGatewayInput{
 		InternetGatewayId: igwID,
 		VpcId:             vpcID,
 	})
@@ -101,8 +103,8 @@ func CreateVPC(ctx context.Context, log logr.Logger, awsClient *awsclient.Client
 	if err := wait.PollUntilContextCancel(ctx, 5*time.Second, false, func(_ context.Context) (bool, error) {
 		log.Info("Waiting until internet gateway is attached to vpc...", "internetGatewayID", *igwID, "vpcID", *vpcID)
 
-		describeIgwOutput, err := awsClient.EC2.DescribeInternetGateways(&ec2.DescribeInternetGatewaysInput{
-			InternetGatewayIds: []*string{igwID},
+		describeIgwOutput, err := awsClient.EC2.DescribeInternetGateways(ctx, &ec2.DescribeInternetGatewaysInput{
+			InternetGatewayIds: []string{*igwID},
 		})
 		if err != nil {
 			return false, err
@@ -112,7 +114,7 @@ func CreateVPC(ctx context.Context, log logr.Logger, awsClient *awsclient.Client
 		if len(igw.Attachments) == 0 {
 			return false, nil
 		}
-		if *igw.Attachments[0].State != "available" {
+		if igw.Attachments[0].State != "available" {
 			return false, nil
 		}
 
@@ -126,12 +128,10 @@ func CreateVPC(ctx context.Context, log logr.Logger, awsClient *awsclient.Client
 
 // DestroyVPC deletes the Internet Gateway and the VPC itself.
 func DestroyVPC(ctx context.Context, log logr.Logger, awsClient *awsclient.Client, vpcID string) error {
-	describeInternetGatewaysOutput, err := awsClient.EC2.DescribeInternetGatewaysWithContext(ctx, &ec2.DescribeInternetGatewaysInput{Filters: []*ec2.Filter{
+	describeInternetGatewaysOutput, err := awsClient.EC2.DescribeInternetGateways(ctx, &ec2.DescribeInternetGatewaysInput{Filters: []ec2types.Filter{
 		{
-			Name: awssdk.String("attachment.vpc-id"),
-			Values: []*string{
-				awssdk.String(vpcID),
-			},
+			Name:   awssdk.String("attachment.vpc-id"),
+			Values: []string{vpcID},
 		},
 	}})
 	if err != nil {
@@ -139,7 +139,7 @@ func DestroyVPC(ctx context.Context, log logr.Logger, awsClient *awsclient.Clien
 	}
 	igwID := describeInternetGatewaysOutput.InternetGateways[0].InternetGatewayId
 
-	_, err = awsClient.EC2.DetachInternetGateway(&ec2.DetachInternetGatewayInput{
+	_, err = awsClient.EC2.DetachInternetGateway(ctx, &ec2.DetachInternetGatewayInput{
 		InternetGatewayId: igwID,
 		VpcId:             awssdk.String(vpcID),
 	})
@@ -150,8 +150,8 @@ func DestroyVPC(ctx context.Context, log logr.Logger, awsClient *awsclient.Clien
 	if err := wait.PollUntilContextCancel(ctx, 5*time.Second, false, func(_ context.Context) (bool, error) {
 		log.Info("Waiting until internet gateway is detached from vpc...", "internetGatewayID", *igwID, "vpcID", vpcID)
 
-		describeIgwOutput, err := awsClient.EC2.DescribeInternetGateways(&ec2.DescribeInternetGatewaysInput{
-			InternetGatewayIds: []*string{igwID},
+		describeIgwOutput, err := awsClient.EC2.DescribeInternetGateways(ctx, &ec2.DescribeInternetGatewaysInput{
+			InternetGatewayIds: []string{*igwID},
 		})
 		if err != nil {
 			return false, err
@@ -163,7 +163,7 @@ func DestroyVPC(ctx context.Context, log logr.Logger, awsClient *awsclient.Clien
 		return err
 	}
 
-	_, err = awsClient.EC2.DeleteInternetGateway(&ec2.DeleteInternetGatewayInput{
+	_, err = awsClient.EC2.DeleteInternetGateway(ctx, &ec2.DeleteInternetGatewayInput{
 		InternetGatewayId: igwID,
 	})
 	if err != nil {
@@ -173,12 +173,12 @@ func DestroyVPC(ctx context.Context, log logr.Logger, awsClient *awsclient.Clien
 	if err := wait.PollUntilContextCancel(ctx, 5*time.Second, false, func(_ context.Context) (bool, error) {
 		log.Info("Waiting until internet gateway is deleted...", "internetGatewayID", *igwID)
 
-		_, err := awsClient.EC2.DescribeInternetGateways(&ec2.DescribeInternetGatewaysInput{
-			InternetGatewayIds: []*string{igwID},
+		_, err := awsClient.EC2.DescribeInternetGateways(ctx, &ec2.DescribeInternetGatewaysInput{
+			InternetGatewayIds: []string{*igwID},
 		})
 		if err != nil {
-			ec2err, ok := err.(awserr.Error)
-			if ok && ec2err.Code() == "InvalidInternetGatewayID.NotFound" {
+			var apiErr smithy.APIError
+			if errors.As(err, &apiErr) && apiErr.ErrorCode() == "InvalidInternetGatewayID.NotFound" {
 				return true, nil
 			}
 
@@ -190,7 +190,7 @@ func DestroyVPC(ctx context.Context, log logr.Logger, awsClient *awsclient.Clien
 		return err
 	}
 
-	_, err = awsClient.EC2.DeleteVpc(&ec2.DeleteVpcInput{
+	_, err = awsClient.EC2.DeleteVpc(ctx, &ec2.DeleteVpcInput{
 		VpcId: &vpcID,
 	})
 	if err != nil {
@@ -200,12 +200,12 @@ func DestroyVPC(ctx context.Context, log logr.Logger, awsClient *awsclient.Clien
 	return wait.PollUntilContextCancel(ctx, 5*time.Second, false, func(_ context.Context) (bool, error) {
 		log.Info("Waiting until vpc is deleted...", "vpcID", vpcID)
 
-		_, err := awsClient.EC2.DescribeVpcs(&ec2.DescribeVpcsInput{
-			VpcIds: []*string{&vpcID},
+		_, err := awsClient.EC2.DescribeVpcs(ctx, &ec2.DescribeVpcsInput{
+			VpcIds: []string{vpcID},
 		})
 		if err != nil {
-			ec2err, ok := err.(awserr.Error)
-			if ok && ec2err.Code() == "InvalidVpcID.NotFound" {
+			var apiErr smithy.APIError
+			if errors.As(err, &apiErr) && apiErr.ErrorCode() == "InvalidVpcID.NotFound" {
 				return true, nil
 			}
 